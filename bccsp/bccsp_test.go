@@ -99,8 +99,40 @@ func TestECDSAOpts(t *testing.T) {
 	assert.Empty(t, opts.ExpansionValue())
 }
 
+func TestED25519Opts(t *testing.T) {
+	test := func(ephemeral bool) {
+		for _, opts := range []KeyGenOpts{
+			&ED25519KeyGenOpts{ephemeral},
+			&ED25519PKIXPublicKeyImportOpts{ephemeral},
+			&ED25519PrivateKeyImportOpts{ephemeral},
+			&ED25519GoPublicKeyImportOpts{ephemeral},
+		} {
+			assert.Equal(t, "ED25519", opts.Algorithm())
+			assert.Equal(t, ephemeral, opts.Ephemeral())
+		}
+	}
+	test(true)
+	test(false)
+}
+
+func TestSM2Opts(t *testing.T) {
+	test := func(ephemeral bool) {
+		for _, opts := range []KeyGenOpts{
+			&SM2KeyGenOpts{ephemeral},
+			&SM2PKIXPublicKeyImportOpts{ephemeral},
+			&SM2PrivateKeyImportOpts{ephemeral},
+			&SM2GoPublicKeyImportOpts{ephemeral},
+		} {
+			assert.Equal(t, "SM2", opts.Algorithm())
+			assert.Equal(t, ephemeral, opts.Ephemeral())
+		}
+	}
+	test(true)
+	test(false)
+}
+
 func TestHashOpts(t *testing.T) {
-	for _, ho := range []HashOpts{&SHA256Opts{}, &SHA384Opts{}, &SHA3_256Opts{}, &SHA3_384Opts{}} {
+	for _, ho := range []HashOpts{&SHA256Opts{}, &SHA384Opts{}, &SHA3_256Opts{}, &SHA3_384Opts{}, &SM3Opts{}} {
 		s := strings.Replace(reflect.TypeOf(ho).String(), "*bccsp.", "", -1)
 		algorithm := strings.Replace(s, "Opts", "", -1)
 		assert.Equal(t, algorithm, ho.Algorithm())
@@ -18,6 +18,7 @@ package factory
 import (
 	"github.com/hyperledger/fabric/bccsp"
 	"github.com/hyperledger/fabric/bccsp/sw"
+	"github.com/hyperledger/fabric/bccsp/vault"
 	"github.com/pkg/errors"
 )
 
@@ -46,6 +47,12 @@ func (f *SWFactory) Get(config *FactoryOpts) (bccsp.BCCSP, error) {
 	var ks bccsp.KeyStore
 	if swOpts.Ephemeral == true {
 		ks = sw.NewDummyKeyStore()
+	} else if swOpts.SecretStoreKeystore != nil {
+		sks, err := newSecretStoreKeyStore(swOpts.SecretStoreKeystore)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to initialize software key store")
+		}
+		ks = sks
 	} else if swOpts.FileKeystore != nil {
 		fks, err := sw.NewFileBasedKeyStore(nil, swOpts.FileKeystore.KeyStorePath, false)
 		if err != nil {
@@ -62,6 +69,33 @@ func (f *SWFactory) Get(config *FactoryOpts) (bccsp.BCCSP, error) {
 	return sw.NewWithParams(swOpts.SecLevel, swOpts.HashFamily, ks)
 }
 
+func newSecretStoreKeyStore(opts *SecretStoreKeystoreOpts) (bccsp.KeyStore, error) {
+	var store sw.SecretStore
+	var err error
+	switch opts.Type {
+	case "file":
+		store, err = sw.NewFileSecretStore(opts.Path)
+	case "vault":
+		if opts.Vault == nil {
+			return nil, errors.New("vault options are required for a vault secret store")
+		}
+		store, err = vault.NewSecretStore(vault.Config{
+			Address:   opts.Vault.Address,
+			Token:     opts.Vault.Token,
+			MountPath: opts.Vault.MountPath,
+			Path:      opts.Vault.Path,
+			RootCert:  opts.Vault.RootCert,
+		})
+	default:
+		return nil, errors.Errorf("unknown secret store type [%s]", opts.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return sw.NewSecretStoreKeyStore(store, []byte(opts.Password), false)
+}
+
 // SwOpts contains options for the SWFactory
 type SwOpts struct {
 	// Default algorithms when not specified (Deprecated?)
@@ -73,6 +107,8 @@ type SwOpts struct {
 	FileKeystore  *FileKeystoreOpts  `mapstructure:"filekeystore,omitempty" json:"filekeystore,omitempty" yaml:"FileKeyStore"`
 	DummyKeystore *DummyKeystoreOpts `mapstructure:"dummykeystore,omitempty" json:"dummykeystore,omitempty"`
 	InmemKeystore *InmemKeystoreOpts `mapstructure:"inmemkeystore,omitempty" json:"inmemkeystore,omitempty"`
+
+	SecretStoreKeystore *SecretStoreKeystoreOpts `mapstructure:"secretstorekeystore,omitempty" json:"secretstorekeystore,omitempty" yaml:"SecretStoreKeyStore"`
 }
 
 // Pluggable Keystores, could add JKS, P12, etc..
@@ -84,3 +120,24 @@ type DummyKeystoreOpts struct{}
 
 // InmemKeystoreOpts - empty, as there is no config for the in-memory keystore
 type InmemKeystoreOpts struct{}
+
+// SecretStoreKeystoreOpts configures a keystore which keeps the keys in
+// an external secret store. Type is either "file", for a directory of
+// files at Path, or "vault", for a HashiCorp Vault server. When Password
+// is set, the keys are encrypted with it before they are stored.
+type SecretStoreKeystoreOpts struct {
+	Type     string     `mapstructure:"type" json:"type" yaml:"Type"`
+	Password string     `mapstructure:"password" json:"password" yaml:"Password"`
+	Path     string     `mapstructure:"path" json:"path" yaml:"Path"`
+	Vault    *VaultOpts `mapstructure:"vault,omitempty" json:"vault,omitempty" yaml:"Vault"`
+}
+
+// VaultOpts are the options of a Vault secret store. The token may be left
+// empty, in which case it is read from the VAULT_TOKEN environment variable.
+type VaultOpts struct {
+	Address   string `mapstructure:"address" json:"address" yaml:"Address"`
+	Token     string `mapstructure:"token" json:"token" yaml:"Token"`
+	MountPath string `mapstructure:"mountpath" json:"mountpath" yaml:"MountPath"`
+	Path      string `mapstructure:"path" json:"path" yaml:"Path"`
+	RootCert  string `mapstructure:"rootcert" json:"rootcert" yaml:"RootCert"`
+}
@@ -16,9 +16,13 @@ limitations under the License.
 package factory
 
 import (
+	"encoding/hex"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/hyperledger/fabric/bccsp"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -68,3 +72,45 @@ func TestSWFactoryGet(t *testing.T) {
 	assert.NotNil(t, csp)
 
 }
+
+func TestSWFactoryGetSecretStoreKeystore(t *testing.T) {
+	f := &SWFactory{}
+
+	dir, err := ioutil.TempDir("", "swfactory")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	opts := &FactoryOpts{
+		SwOpts: &SwOpts{
+			SecLevel:   256,
+			HashFamily: "SHA2",
+			SecretStoreKeystore: &SecretStoreKeystoreOpts{
+				Type:     "file",
+				Path:     dir,
+				Password: "password",
+			},
+			FileKeystore: &FileKeystoreOpts{KeyStorePath: os.TempDir()},
+		},
+	}
+	csp, err := f.Get(opts)
+	assert.NoError(t, err)
+	assert.NotNil(t, csp)
+
+	// The secret store takes precedence over the file keystore
+	k, err := csp.KeyGen(&bccsp.ECDSAP256KeyGenOpts{Temporary: false})
+	assert.NoError(t, err)
+	_, err = os.Stat(filepath.Join(dir, hex.EncodeToString(k.SKI())+"_sk"))
+	assert.NoError(t, err)
+
+	opts.SwOpts.SecretStoreKeystore = &SecretStoreKeystoreOpts{Type: "vault"}
+	_, err = f.Get(opts)
+	assert.EqualError(t, err, "Failed to initialize software key store: vault options are required for a vault secret store")
+
+	opts.SwOpts.SecretStoreKeystore = &SecretStoreKeystoreOpts{Type: "vault", Vault: &VaultOpts{}}
+	_, err = f.Get(opts)
+	assert.EqualError(t, err, "Failed to initialize software key store: invalid vault configuration: the address cannot be empty")
+
+	opts.SwOpts.SecretStoreKeystore = &SecretStoreKeystoreOpts{Type: "kms"}
+	_, err = f.Get(opts)
+	assert.EqualError(t, err, "Failed to initialize software key store: unknown secret store type [kms]")
+}
@@ -0,0 +1,239 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package gm
+
+import (
+	"crypto"
+	"crypto/elliptic"
+	"encoding/asn1"
+	"errors"
+	"io"
+	"math/big"
+	"sync"
+)
+
+// DefaultUID is the user identifier used to compute the Z value of a
+// public key when none is agreed upon, as specified in GM/T 0009-2012.
+var DefaultUID = []byte("1234567812345678")
+
+var (
+	initOnce sync.Once
+	sm2P256  *sm2Curve
+)
+
+func initSM2P256() {
+	params := &elliptic.CurveParams{Name: "SM2-P-256"}
+	params.P, _ = new(big.Int).SetString("FFFFFFFEFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF00000000FFFFFFFFFFFFFFFF", 16)
+	params.N, _ = new(big.Int).SetString("FFFFFFFEFFFFFFFFFFFFFFFFFFFFFFFF7203DF6B21C6052B53BBF40939D54123", 16)
+	params.B, _ = new(big.Int).SetString("28E9FA9E9D9F5E344D5A9E4BCF6509A7F39789F515AB8F92DDBCBD414D940E93", 16)
+	params.Gx, _ = new(big.Int).SetString("32C4AE2C1F1981195F9904466A39C9948FE30BBFF2660BE1715A4589334C74C7", 16)
+	params.Gy, _ = new(big.Int).SetString("BC3736A2F4F6779C59BDCEE36B692153D0A9877CC62A474002DF32E52139F0A0", 16)
+	params.BitSize = 256
+	initFieldConstants(params)
+	sm2P256 = &sm2Curve{params: params}
+}
+
+// P256Sm2 returns the curve recommended by GB/T 32918 for SM2, which is
+// a short Weierstrass curve with a = -3, like the NIST curves.
+// The point operations of the returned curve, including the scalar
+// multiplications, are constant time.
+func P256Sm2() elliptic.Curve {
+	initOnce.Do(initSM2P256)
+	return sm2P256
+}
+
+// PublicKey represents an SM2 public key.
+type PublicKey struct {
+	elliptic.Curve
+	X, Y *big.Int
+}
+
+// PrivateKey represents an SM2 private key.
+type PrivateKey struct {
+	PublicKey
+	D *big.Int
+}
+
+// Public returns the public key corresponding to priv.
+func (priv *PrivateKey) Public() crypto.PublicKey {
+	return &priv.PublicKey
+}
+
+// Sign signs digest with priv, as returned by Digest, and returns
+// the ASN.1 encoded signature. It implements crypto.Signer.
+func (priv *PrivateKey) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	return SignASN1(rand, priv, digest)
+}
+
+type sm2Signature struct {
+	R, S *big.Int
+}
+
+var one = big.NewInt(1)
+
+// randScalar returns a random scalar in [1, n-2], n being the order of c
+func randScalar(c elliptic.Curve, rand io.Reader) (*big.Int, error) {
+	params := c.Params()
+	b := make([]byte, params.BitSize/8+8)
+	if _, err := io.ReadFull(rand, b); err != nil {
+		return nil, err
+	}
+
+	k := new(big.Int).SetBytes(b)
+	n := new(big.Int).Sub(params.N, big.NewInt(2))
+	k.Mod(k, n)
+	k.Add(k, one)
+	return k, nil
+}
+
+// GenerateKey generates an SM2 key pair on the SM2 curve.
+func GenerateKey(rand io.Reader) (*PrivateKey, error) {
+	c := P256Sm2()
+	d, err := randScalar(c, rand)
+	if err != nil {
+		return nil, err
+	}
+
+	priv := &PrivateKey{D: d}
+	priv.PublicKey.Curve = c
+	priv.PublicKey.X, priv.PublicKey.Y = c.ScalarBaseMult(fieldBytes(c, d))
+	return priv, nil
+}
+
+// fieldBytes returns the big endian representation of x on the
+// number of bytes of the field elements of c
+func fieldBytes(c elliptic.Curve, x *big.Int) []byte {
+	b := make([]byte, (c.Params().BitSize+7)/8)
+	xb := x.Bytes()
+	copy(b[len(b)-len(xb):], xb)
+	return b
+}
+
+// ZA returns the Z value of pub for the user identifier uid, that is
+// the SM3 hash of the identifier, the curve parameters and the key.
+func ZA(pub *PublicKey, uid []byte) ([]byte, error) {
+	if len(uid) >= 8192 {
+		return nil, errors.New("the user identifier is too long")
+	}
+
+	params := pub.Curve.Params()
+	a := new(big.Int).Sub(params.P, big.NewInt(3))
+	entl := len(uid) * 8
+
+	h := NewSM3()
+	h.Write([]byte{byte(entl >> 8), byte(entl)})
+	h.Write(uid)
+	for _, v := range []*big.Int{a, params.B, params.Gx, params.Gy, pub.X, pub.Y} {
+		h.Write(fieldBytes(pub.Curve, v))
+	}
+	return h.Sum(nil), nil
+}
+
+// Digest returns the digest of msg to be signed with the private key
+// of pub, that is the SM3 hash of the Z value of pub followed by msg.
+func Digest(pub *PublicKey, uid, msg []byte) ([]byte, error) {
+	za, err := ZA(pub, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	h := NewSM3()
+	h.Write(za)
+	h.Write(msg)
+	return h.Sum(nil), nil
+}
+
+// fermatInverse calculates the inverse of k in GF(N) using Fermat's method.
+// This has better constant-time properties than Euclid's method (implemented
+// in math/big.Int.ModInverse) although math/big itself isn't strictly
+// constant-time so it's not perfect.
+func fermatInverse(k, N *big.Int) *big.Int {
+	two := big.NewInt(2)
+	nMinus2 := new(big.Int).Sub(N, two)
+	return new(big.Int).Exp(k, nMinus2, N)
+}
+
+// Sign signs digest with priv, as specified in GB/T 32918.2-2016.
+// The digest is expected to be computed with Digest.
+func Sign(rand io.Reader, priv *PrivateKey, digest []byte) (r, s *big.Int, err error) {
+	n := priv.Curve.Params().N
+	if priv.D == nil || priv.D.Sign() <= 0 || priv.D.Cmp(new(big.Int).Sub(n, one)) >= 0 {
+		return nil, nil, errors.New("invalid SM2 private key")
+	}
+
+	e := new(big.Int).SetBytes(digest)
+	dInv := fermatInverse(new(big.Int).Add(priv.D, one), n)
+
+	for {
+		k, err := randScalar(priv.Curve, rand)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		x1, _ := priv.Curve.ScalarBaseMult(fieldBytes(priv.Curve, k))
+		r = new(big.Int).Add(e, x1)
+		r.Mod(r, n)
+		if r.Sign() == 0 || new(big.Int).Add(r, k).Cmp(n) == 0 {
+			continue
+		}
+
+		// s = (1 + d)^-1 * (k - r * d) mod n
+		s = new(big.Int).Mul(r, priv.D)
+		s.Sub(k, s)
+		s.Mul(s, dInv)
+		s.Mod(s, n)
+		if s.Sign() != 0 {
+			return r, s, nil
+		}
+	}
+}
+
+// Verify verifies the signature (r, s) of digest with pub.
+func Verify(pub *PublicKey, digest []byte, r, s *big.Int) bool {
+	if pub == nil || pub.Curve == nil || pub.X == nil || pub.Y == nil {
+		return false
+	}
+
+	n := pub.Curve.Params().N
+	if r.Sign() <= 0 || s.Sign() <= 0 || r.Cmp(n) >= 0 || s.Cmp(n) >= 0 {
+		return false
+	}
+
+	t := new(big.Int).Add(r, s)
+	t.Mod(t, n)
+	if t.Sign() == 0 {
+		return false
+	}
+
+	x1, y1 := pub.Curve.ScalarBaseMult(s.Bytes())
+	x2, y2 := pub.Curve.ScalarMult(pub.X, pub.Y, t.Bytes())
+	x, _ := pub.Curve.Add(x1, y1, x2, y2)
+
+	e := new(big.Int).SetBytes(digest)
+	e.Add(e, x)
+	e.Mod(e, n)
+	return e.Cmp(r) == 0
+}
+
+// SignASN1 signs digest with priv and returns the ASN.1 encoded signature.
+func SignASN1(rand io.Reader, priv *PrivateKey, digest []byte) ([]byte, error) {
+	r, s, err := Sign(rand, priv, digest)
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(sm2Signature{R: r, S: s})
+}
+
+// VerifyASN1 verifies the ASN.1 encoded signature sig of digest with pub.
+func VerifyASN1(pub *PublicKey, digest, sig []byte) bool {
+	var s sm2Signature
+	rest, err := asn1.Unmarshal(sig, &s)
+	if err != nil || len(rest) != 0 || s.R == nil || s.S == nil {
+		return false
+	}
+	return Verify(pub, digest, s.R, s.S)
+}
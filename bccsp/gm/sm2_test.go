@@ -0,0 +1,99 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package gm
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readPEM(t *testing.T, name string) []byte {
+	raw, err := ioutil.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	block, _ := pem.Decode(raw)
+	require.NotNil(t, block)
+	return block.Bytes
+}
+
+func TestSM2Curve(t *testing.T) {
+	c := P256Sm2()
+	params := c.Params()
+	assert.True(t, c.IsOnCurve(params.Gx, params.Gy))
+
+	// n * G is the point at infinity
+	x, y := c.ScalarBaseMult(params.N.Bytes())
+	assert.Equal(t, 0, x.Sign())
+	assert.Equal(t, 0, y.Sign())
+}
+
+func TestSM2SignVerify(t *testing.T) {
+	priv, err := GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	assert.True(t, priv.Curve.IsOnCurve(priv.X, priv.Y))
+
+	pub := priv.Public().(*PublicKey)
+	msg := []byte("hello, world")
+	digest, err := Digest(pub, DefaultUID, msg)
+	require.NoError(t, err)
+
+	sig, err := priv.Sign(rand.Reader, digest, nil)
+	assert.NoError(t, err)
+	assert.True(t, VerifyASN1(pub, digest, sig))
+
+	// A digest computed with another user identifier does not match
+	otherDigest, err := Digest(pub, []byte("ALICE123@YAHOO.COM"), msg)
+	require.NoError(t, err)
+	assert.False(t, VerifyASN1(pub, otherDigest, sig))
+	assert.False(t, VerifyASN1(pub, digest, sig[1:]))
+
+	r, s, err := Sign(rand.Reader, priv, digest)
+	assert.NoError(t, err)
+	assert.True(t, Verify(pub, digest, r, s))
+	assert.False(t, Verify(pub, digest, s, r))
+	assert.False(t, Verify(pub, digest, r, new(big.Int).Sub(pub.Params().N, s)))
+	assert.False(t, Verify(pub, digest, big.NewInt(0), s))
+	assert.False(t, Verify(pub, digest, r, pub.Params().N))
+	assert.False(t, Verify(&PublicKey{}, digest, r, s))
+
+	other, err := GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	assert.False(t, VerifyASN1(&other.PublicKey, digest, sig))
+
+	_, _, err = Sign(rand.Reader, &PrivateKey{PublicKey: *pub, D: pub.Params().N}, digest)
+	assert.EqualError(t, err, "invalid SM2 private key")
+
+	_, err = ZA(pub, make([]byte, 8192))
+	assert.EqualError(t, err, "the user identifier is too long")
+}
+
+func TestSM2OpenSSLInterop(t *testing.T) {
+	// testdata/key.pem was generated with openssl genpkey -algorithm SM2,
+	// and sig is the signature of msg generated with
+	// openssl pkeyutl -sign -rawin -digest sm3 -pkeyopt distid:1234567812345678
+	msg := []byte("hello, world")
+	sig, err := base64.StdEncoding.DecodeString("MEQCIFLNx+Bf1QHpzcLplz5Hi8kpHj3MoksfCgyzFjGpd4ITAiBxyOBl48XqfOE9djkmkrQASBstUxX6DkP4fV5+EObldQ==")
+	require.NoError(t, err)
+
+	priv, err := ParsePKCS8PrivateKey(readPEM(t, "key.pem"))
+	require.NoError(t, err)
+	digest, err := Digest(&priv.PublicKey, DefaultUID, msg)
+	require.NoError(t, err)
+	assert.True(t, VerifyASN1(&priv.PublicKey, digest, sig))
+
+	sec1Priv, err := ParseECPrivateKey(readPEM(t, "sec1key.pem"))
+	assert.NoError(t, err)
+	assert.Equal(t, priv.D, sec1Priv.D)
+	assert.Equal(t, priv.X, sec1Priv.X)
+}
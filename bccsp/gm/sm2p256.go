@@ -0,0 +1,441 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package gm
+
+import (
+	"crypto/elliptic"
+	"encoding/binary"
+	"math/big"
+	"math/bits"
+)
+
+// sm2Curve implements elliptic.Curve for the SM2 curve. The group operations
+// are computed in constant time with complete projective formulas over
+// field elements in the Montgomery domain, and scalar multiplications use a
+// fixed window with constant time table lookups. The conversions from and to
+// the affine coordinates of the elliptic.Curve interface go through math/big,
+// as coordinates are public.
+type sm2Curve struct {
+	params *elliptic.CurveParams
+}
+
+// fieldElement is an element of the field of the SM2 curve in the
+// Montgomery domain, that is x * 2^256 mod p, as four 64-bit little
+// endian limbs. A fieldElement is always fully reduced modulo p.
+type fieldElement [4]uint64
+
+// point is a point of the SM2 curve in projective coordinates (X:Y:Z),
+// the point at infinity being (0:1:0).
+type point struct {
+	x, y, z fieldElement
+}
+
+var (
+	// sm2P is the prime p of the field of the SM2 curve
+	sm2P = fieldElement{0xffffffffffffffff, 0xffffffff00000000, 0xffffffffffffffff, 0xfffffffeffffffff}
+	// sm2PMinus2 is the exponent used to invert field elements
+	sm2PMinus2 = fieldElement{0xfffffffffffffffd, 0xffffffff00000000, 0xffffffffffffffff, 0xfffffffeffffffff}
+
+	// feOne, feRR and feB are 1, 2^512 mod p and b in the Montgomery domain,
+	// feRR being used to enter the Montgomery domain
+	feOne fieldElement
+	feRR  fieldElement
+	feB   fieldElement
+)
+
+// initFieldConstants computes the constants of the field from the
+// parameters of the curve
+func initFieldConstants(params *elliptic.CurveParams) {
+	r := new(big.Int).Lsh(big.NewInt(1), 256)
+	feOne = limbs(new(big.Int).Mod(r, params.P))
+	feRR = limbs(new(big.Int).Mod(new(big.Int).Mul(r, r), params.P))
+	feB = limbs(new(big.Int).Mod(new(big.Int).Mul(params.B, r), params.P))
+}
+
+// limbs returns the limbs of x, which must be lower than 2^256
+func limbs(x *big.Int) fieldElement {
+	var b [32]byte
+	x.FillBytes(b[:])
+	return limbsFromBytes(&b)
+}
+
+func limbsFromBytes(b *[32]byte) fieldElement {
+	var e fieldElement
+	for i := range e {
+		e[i] = binary.BigEndian.Uint64(b[24-8*i:])
+	}
+	return e
+}
+
+// setBytes sets e to the field element encoded in big endian by b, which
+// must be lower than p
+func (e *fieldElement) setBytes(b *[32]byte) *fieldElement {
+	raw := limbsFromBytes(b)
+	return e.mul(&raw, &feRR)
+}
+
+// bytes returns the big endian encoding of e
+func (e *fieldElement) bytes() [32]byte {
+	var raw fieldElement
+	raw.mul(e, &fieldElement{1})
+
+	var b [32]byte
+	for i := range raw {
+		binary.BigEndian.PutUint64(b[24-8*i:], raw[i])
+	}
+	return b
+}
+
+// reduce sets e to t + carry * 2^256 minus p if it is not lower than p,
+// and to t otherwise. t + carry * 2^256 must be lower than 2p.
+func (e *fieldElement) reduce(t *fieldElement, carry uint64) {
+	var r fieldElement
+	var b uint64
+	r[0], b = bits.Sub64(t[0], sm2P[0], 0)
+	r[1], b = bits.Sub64(t[1], sm2P[1], b)
+	r[2], b = bits.Sub64(t[2], sm2P[2], b)
+	r[3], b = bits.Sub64(t[3], sm2P[3], b)
+	_, b = bits.Sub64(carry, 0, b)
+
+	// b is 1 if t + carry * 2^256 is lower than p
+	mask := -b
+	for i := range e {
+		e[i] = t[i]&mask | r[i]&^mask
+	}
+}
+
+// add sets e to a + b mod p
+func (e *fieldElement) add(a, b *fieldElement) *fieldElement {
+	var t fieldElement
+	var c uint64
+	t[0], c = bits.Add64(a[0], b[0], 0)
+	t[1], c = bits.Add64(a[1], b[1], c)
+	t[2], c = bits.Add64(a[2], b[2], c)
+	t[3], c = bits.Add64(a[3], b[3], c)
+	e.reduce(&t, c)
+	return e
+}
+
+// sub sets e to a - b mod p
+func (e *fieldElement) sub(a, b *fieldElement) *fieldElement {
+	var t fieldElement
+	var borrow uint64
+	t[0], borrow = bits.Sub64(a[0], b[0], 0)
+	t[1], borrow = bits.Sub64(a[1], b[1], borrow)
+	t[2], borrow = bits.Sub64(a[2], b[2], borrow)
+	t[3], borrow = bits.Sub64(a[3], b[3], borrow)
+
+	// p is added back if a is lower than b
+	mask := -borrow
+	var c uint64
+	e[0], c = bits.Add64(t[0], sm2P[0]&mask, 0)
+	e[1], c = bits.Add64(t[1], sm2P[1]&mask, c)
+	e[2], c = bits.Add64(t[2], sm2P[2]&mask, c)
+	e[3], _ = bits.Add64(t[3], sm2P[3]&mask, c)
+	return e
+}
+
+// mul sets e to a * b * 2^-256 mod p, that is the product of a and b in the
+// Montgomery domain, with the CIOS method. As the lowest limb of p is
+// 2^64 - 1, -p^-1 mod 2^64 is 1.
+func (e *fieldElement) mul(a, b *fieldElement) *fieldElement {
+	var t [6]uint64
+	for i := 0; i < 4; i++ {
+		// t += a * b[i]
+		var c uint64
+		for j := 0; j < 4; j++ {
+			hi, lo := bits.Mul64(a[j], b[i])
+			var cc uint64
+			lo, cc = bits.Add64(lo, t[j], 0)
+			hi += cc
+			lo, cc = bits.Add64(lo, c, 0)
+			hi += cc
+			t[j], c = lo, hi
+		}
+		var cc uint64
+		t[4], cc = bits.Add64(t[4], c, 0)
+		t[5] = cc
+
+		// t = (t + m * p) / 2^64, with m = t[0]
+		m := t[0]
+		hi, lo := bits.Mul64(m, sm2P[0])
+		_, cc = bits.Add64(lo, t[0], 0)
+		c = hi + cc
+		for j := 1; j < 4; j++ {
+			hi, lo := bits.Mul64(m, sm2P[j])
+			lo, cc = bits.Add64(lo, t[j], 0)
+			hi += cc
+			lo, cc = bits.Add64(lo, c, 0)
+			hi += cc
+			t[j-1], c = lo, hi
+		}
+		t[3], cc = bits.Add64(t[4], c, 0)
+		t[4] = t[5] + cc
+	}
+
+	e.reduce(&fieldElement{t[0], t[1], t[2], t[3]}, t[4])
+	return e
+}
+
+// invert sets e to a^-1 mod p, computed as a^(p-2) mod p, and to 0 if a is 0
+func (e *fieldElement) invert(a *fieldElement) *fieldElement {
+	// The exponent is public, hence its bits may be branched on
+	z := feOne
+	for i := 255; i >= 0; i-- {
+		z.mul(&z, &z)
+		if sm2PMinus2[i/64]>>(uint(i)%64)&1 == 1 {
+			z.mul(&z, a)
+		}
+	}
+	*e = z
+	return e
+}
+
+// selectFrom sets e to a if cond is 1, and leaves it unchanged if cond is 0
+func (e *fieldElement) selectFrom(a *fieldElement, cond uint64) {
+	mask := -cond
+	for i := range e {
+		e[i] = a[i]&mask | e[i]&^mask
+	}
+}
+
+// newIdentity returns the point at infinity
+func newIdentity() *point {
+	return &point{y: feOne}
+}
+
+// add sets q to p1 + p2, with the complete addition formula for a = -3
+// of "Complete addition formulas for prime order elliptic curves"
+// (https://eprint.iacr.org/2015/1060), algorithm 4
+func (q *point) add(p1, p2 *point) *point {
+	var t0, t1, t2, t3, t4, x3, y3, z3 fieldElement
+	t0.mul(&p1.x, &p2.x) // t0 := X1 * X2
+	t1.mul(&p1.y, &p2.y) // t1 := Y1 * Y2
+	t2.mul(&p1.z, &p2.z) // t2 := Z1 * Z2
+	t3.add(&p1.x, &p1.y) // t3 := X1 + Y1
+	t4.add(&p2.x, &p2.y) // t4 := X2 + Y2
+	t3.mul(&t3, &t4)     // t3 := t3 * t4
+	t4.add(&t0, &t1)     // t4 := t0 + t1
+	t3.sub(&t3, &t4)     // t3 := t3 - t4
+	t4.add(&p1.y, &p1.z) // t4 := Y1 + Z1
+	x3.add(&p2.y, &p2.z) // X3 := Y2 + Z2
+	t4.mul(&t4, &x3)     // t4 := t4 * X3
+	x3.add(&t1, &t2)     // X3 := t1 + t2
+	t4.sub(&t4, &x3)     // t4 := t4 - X3
+	x3.add(&p1.x, &p1.z) // X3 := X1 + Z1
+	y3.add(&p2.x, &p2.z) // Y3 := X2 + Z2
+	x3.mul(&x3, &y3)     // X3 := X3 * Y3
+	y3.add(&t0, &t2)     // Y3 := t0 + t2
+	y3.sub(&x3, &y3)     // Y3 := X3 - Y3
+	z3.mul(&feB, &t2)    // Z3 := b * t2
+	x3.sub(&y3, &z3)     // X3 := Y3 - Z3
+	z3.add(&x3, &x3)     // Z3 := X3 + X3
+	x3.add(&x3, &z3)     // X3 := X3 + Z3
+	z3.sub(&t1, &x3)     // Z3 := t1 - X3
+	x3.add(&t1, &x3)     // X3 := t1 + X3
+	y3.mul(&feB, &y3)    // Y3 := b * Y3
+	t1.add(&t2, &t2)     // t1 := t2 + t2
+	t2.add(&t1, &t2)     // t2 := t1 + t2
+	y3.sub(&y3, &t2)     // Y3 := Y3 - t2
+	y3.sub(&y3, &t0)     // Y3 := Y3 - t0
+	t1.add(&y3, &y3)     // t1 := Y3 + Y3
+	y3.add(&t1, &y3)     // Y3 := t1 + Y3
+	t1.add(&t0, &t0)     // t1 := t0 + t0
+	t0.add(&t1, &t0)     // t0 := t1 + t0
+	t0.sub(&t0, &t2)     // t0 := t0 - t2
+	t1.mul(&t4, &y3)     // t1 := t4 * Y3
+	t2.mul(&t0, &y3)     // t2 := t0 * Y3
+	y3.mul(&x3, &z3)     // Y3 := X3 * Z3
+	y3.add(&y3, &t2)     // Y3 := Y3 + t2
+	x3.mul(&t3, &x3)     // X3 := t3 * X3
+	x3.sub(&x3, &t1)     // X3 := X3 - t1
+	z3.mul(&t4, &z3)     // Z3 := t4 * Z3
+	t1.mul(&t3, &t0)     // t1 := t3 * t0
+	z3.add(&z3, &t1)     // Z3 := Z3 + t1
+
+	q.x, q.y, q.z = x3, y3, z3
+	return q
+}
+
+// double sets q to 2p, with the complete doubling formula for a = -3
+// of "Complete addition formulas for prime order elliptic curves"
+// (https://eprint.iacr.org/2015/1060), algorithm 6
+func (q *point) double(p *point) *point {
+	var t0, t1, t2, t3, x3, y3, z3 fieldElement
+	t0.mul(&p.x, &p.x) // t0 := X ^ 2
+	t1.mul(&p.y, &p.y) // t1 := Y ^ 2
+	t2.mul(&p.z, &p.z) // t2 := Z ^ 2
+	t3.mul(&p.x, &p.y) // t3 := X * Y
+	t3.add(&t3, &t3)   // t3 := t3 + t3
+	z3.mul(&p.x, &p.z) // Z3 := X * Z
+	z3.add(&z3, &z3)   // Z3 := Z3 + Z3
+	y3.mul(&feB, &t2)  // Y3 := b * t2
+	y3.sub(&y3, &z3)   // Y3 := Y3 - Z3
+	x3.add(&y3, &y3)   // X3 := Y3 + Y3
+	y3.add(&x3, &y3)   // Y3 := X3 + Y3
+	x3.sub(&t1, &y3)   // X3 := t1 - Y3
+	y3.add(&t1, &y3)   // Y3 := t1 + Y3
+	y3.mul(&x3, &y3)   // Y3 := X3 * Y3
+	x3.mul(&x3, &t3)   // X3 := X3 * t3
+	t3.add(&t2, &t2)   // t3 := t2 + t2
+	t2.add(&t2, &t3)   // t2 := t2 + t3
+	z3.mul(&feB, &z3)  // Z3 := b * Z3
+	z3.sub(&z3, &t2)   // Z3 := Z3 - t2
+	z3.sub(&z3, &t0)   // Z3 := Z3 - t0
+	t3.add(&z3, &z3)   // t3 := Z3 + Z3
+	z3.add(&z3, &t3)   // Z3 := Z3 + t3
+	t3.add(&t0, &t0)   // t3 := t0 + t0
+	t0.add(&t3, &t0)   // t0 := t3 + t0
+	t0.sub(&t0, &t2)   // t0 := t0 - t2
+	t0.mul(&t0, &z3)   // t0 := t0 * Z3
+	y3.add(&y3, &t0)   // Y3 := Y3 + t0
+	t0.mul(&p.y, &p.z) // t0 := Y * Z
+	t0.add(&t0, &t0)   // t0 := t0 + t0
+	z3.mul(&t0, &z3)   // Z3 := t0 * Z3
+	x3.sub(&x3, &z3)   // X3 := X3 - Z3
+	z3.mul(&t0, &t1)   // Z3 := t0 * t1
+	z3.add(&z3, &z3)   // Z3 := Z3 + Z3
+	z3.add(&z3, &z3)   // Z3 := Z3 + Z3
+
+	q.x, q.y, q.z = x3, y3, z3
+	return q
+}
+
+// selectFrom sets q to p if cond is 1, and leaves it unchanged if cond is 0
+func (q *point) selectFrom(p *point, cond uint64) {
+	q.x.selectFrom(&p.x, cond)
+	q.y.selectFrom(&p.y, cond)
+	q.z.selectFrom(&p.z, cond)
+}
+
+// scalarMult sets q to scalar * p, scalar being encoded in big endian, with
+// a fixed window of 4 bits. Each window is looked up in the whole table of
+// multiples of p, so that the memory accesses do not depend on the scalar.
+func (q *point) scalarMult(p *point, scalar *[32]byte) *point {
+	var table [16]point
+	table[0] = *newIdentity()
+	for i := 1; i < 16; i++ {
+		table[i].add(&table[i-1], p)
+	}
+
+	r := newIdentity()
+	var t point
+	for i, b := range scalar {
+		for j, window := range [2]byte{b >> 4, b & 0xf} {
+			if i > 0 || j > 0 {
+				r.double(r)
+				r.double(r)
+				r.double(r)
+				r.double(r)
+			}
+			t = table[0]
+			for k := 1; k < 16; k++ {
+				t.selectFrom(&table[k], ctEqual(byte(k), window))
+			}
+			r.add(r, &t)
+		}
+	}
+
+	*q = *r
+	return q
+}
+
+// ctEqual returns 1 if a and b are equal, and 0 otherwise
+func ctEqual(a, b byte) uint64 {
+	x := uint64(a ^ b)
+	return (x - 1) >> 63
+}
+
+// pointFromAffine returns the point of the affine coordinates (x, y), (0, 0)
+// being the point at infinity as for elliptic.Curve
+func (c *sm2Curve) pointFromAffine(x, y *big.Int) *point {
+	if x.Sign() == 0 && y.Sign() == 0 {
+		return newIdentity()
+	}
+
+	var xb, yb [32]byte
+	c.reduced(x).FillBytes(xb[:])
+	c.reduced(y).FillBytes(yb[:])
+	q := &point{z: feOne}
+	q.x.setBytes(&xb)
+	q.y.setBytes(&yb)
+	return q
+}
+
+// reduced returns v modulo p. The coordinates of points on the curve are
+// already reduced.
+func (c *sm2Curve) reduced(v *big.Int) *big.Int {
+	if v.Sign() < 0 || v.Cmp(c.params.P) >= 0 {
+		return new(big.Int).Mod(v, c.params.P)
+	}
+	return v
+}
+
+// affine returns the affine coordinates of q, or (0, 0) for the point at
+// infinity
+func (q *point) affine() (x, y *big.Int) {
+	var zInv, xa, ya fieldElement
+	zInv.invert(&q.z)
+	xa.mul(&q.x, &zInv)
+	ya.mul(&q.y, &zInv)
+
+	xb, yb := xa.bytes(), ya.bytes()
+	return new(big.Int).SetBytes(xb[:]), new(big.Int).SetBytes(yb[:])
+}
+
+// scalarBytes returns k as 32 bytes in big endian, reducing it modulo n
+// if it is longer
+func (c *sm2Curve) scalarBytes(k []byte) *[32]byte {
+	var b [32]byte
+	if len(k) > len(b) {
+		new(big.Int).Mod(new(big.Int).SetBytes(k), c.params.N).FillBytes(b[:])
+		return &b
+	}
+	copy(b[len(b)-len(k):], k)
+	return &b
+}
+
+func (c *sm2Curve) Params() *elliptic.CurveParams {
+	return c.params
+}
+
+func (c *sm2Curve) IsOnCurve(x, y *big.Int) bool {
+	if x.Sign() < 0 || x.Cmp(c.params.P) >= 0 || y.Sign() < 0 || y.Cmp(c.params.P) >= 0 {
+		return false
+	}
+
+	// y² = x³ - 3x + b
+	p := c.params.P
+	y2 := new(big.Int).Mul(y, y)
+	y2.Mod(y2, p)
+
+	x3 := new(big.Int).Mul(x, x)
+	x3.Mul(x3, x)
+	threeX := new(big.Int).Lsh(x, 1)
+	threeX.Add(threeX, x)
+	x3.Sub(x3, threeX)
+	x3.Add(x3, c.params.B)
+	x3.Mod(x3, p)
+
+	return x3.Cmp(y2) == 0
+}
+
+func (c *sm2Curve) Add(x1, y1, x2, y2 *big.Int) (x, y *big.Int) {
+	return new(point).add(c.pointFromAffine(x1, y1), c.pointFromAffine(x2, y2)).affine()
+}
+
+func (c *sm2Curve) Double(x1, y1 *big.Int) (x, y *big.Int) {
+	return new(point).double(c.pointFromAffine(x1, y1)).affine()
+}
+
+func (c *sm2Curve) ScalarMult(x1, y1 *big.Int, k []byte) (x, y *big.Int) {
+	return new(point).scalarMult(c.pointFromAffine(x1, y1), c.scalarBytes(k)).affine()
+}
+
+func (c *sm2Curve) ScalarBaseMult(k []byte) (x, y *big.Int) {
+	return c.ScalarMult(c.params.Gx, c.params.Gy, k)
+}
@@ -0,0 +1,103 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package gm
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSM2CurveMatchesGenericImplementation(t *testing.T) {
+	c := P256Sm2()
+	// The generic implementation of elliptic.CurveParams is used as reference
+	generic := c.Params()
+
+	for i := 0; i < 20; i++ {
+		k := make([]byte, 32)
+		_, err := rand.Read(k)
+		require.NoError(t, err)
+		j := make([]byte, 32)
+		_, err = rand.Read(j)
+		require.NoError(t, err)
+
+		x1, y1 := c.ScalarBaseMult(k)
+		ex1, ey1 := generic.ScalarBaseMult(k)
+		assert.Equal(t, ex1, x1)
+		assert.Equal(t, ey1, y1)
+		assert.True(t, c.IsOnCurve(x1, y1))
+
+		x2, y2 := c.ScalarMult(x1, y1, j)
+		ex2, ey2 := generic.ScalarMult(x1, y1, j)
+		assert.Equal(t, ex2, x2)
+		assert.Equal(t, ey2, y2)
+
+		x3, y3 := c.Add(x1, y1, x2, y2)
+		ex3, ey3 := generic.Add(x1, y1, x2, y2)
+		assert.Equal(t, ex3, x3)
+		assert.Equal(t, ey3, y3)
+
+		x4, y4 := c.Double(x1, y1)
+		ex4, ey4 := generic.Double(x1, y1)
+		assert.Equal(t, ex4, x4)
+		assert.Equal(t, ey4, y4)
+
+		// The formulas are complete: P + P is 2P, and P + (-P) is the point at infinity
+		x5, y5 := c.Add(x1, y1, x1, y1)
+		assert.Equal(t, x4, x5)
+		assert.Equal(t, y4, y5)
+		x6, y6 := c.Add(x1, y1, x1, new(big.Int).Sub(generic.P, y1))
+		assert.Equal(t, 0, x6.Sign())
+		assert.Equal(t, 0, y6.Sign())
+	}
+}
+
+func TestSM2CurveEdgeCases(t *testing.T) {
+	c := P256Sm2()
+	params := c.Params()
+	zero := new(big.Int)
+
+	// The point at infinity is the identity
+	x, y := c.Add(params.Gx, params.Gy, zero, zero)
+	assert.Equal(t, params.Gx, x)
+	assert.Equal(t, params.Gy, y)
+	x, y = c.Double(zero, zero)
+	assert.Equal(t, 0, x.Sign())
+	assert.Equal(t, 0, y.Sign())
+
+	// 0 * G and n * G are the point at infinity, (n + 1) * G is G
+	x, y = c.ScalarBaseMult(nil)
+	assert.Equal(t, 0, x.Sign())
+	assert.Equal(t, 0, y.Sign())
+	x, y = c.ScalarBaseMult(new(big.Int).Add(params.N, one).Bytes())
+	assert.Equal(t, params.Gx, x)
+	assert.Equal(t, params.Gy, y)
+
+	// Scalars longer than the order are reduced modulo the order
+	long := append([]byte{0, 0, 0}, new(big.Int).Sub(params.N, one).Bytes()...)
+	x, y = c.ScalarBaseMult(long)
+	ex, ey := params.ScalarBaseMult(long)
+	assert.Equal(t, ex, x)
+	assert.Equal(t, ey, y)
+
+	assert.False(t, c.IsOnCurve(params.Gx, params.Gx))
+	assert.False(t, c.IsOnCurve(params.P, params.Gy))
+	assert.False(t, c.IsOnCurve(new(big.Int).Neg(params.Gx), params.Gy))
+}
+
+func BenchmarkSM2ScalarBaseMult(b *testing.B) {
+	c := P256Sm2()
+	k := make([]byte, 32)
+	rand.Read(k)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.ScalarBaseMult(k)
+	}
+}
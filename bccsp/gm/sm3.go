@@ -0,0 +1,159 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package gm
+
+import (
+	"encoding/binary"
+	"hash"
+	"math/bits"
+)
+
+// Size is the size of an SM3 checksum in bytes.
+const Size = 32
+
+// BlockSize is the block size of SM3 in bytes.
+const BlockSize = 64
+
+var iv = [8]uint32{
+	0x7380166f, 0x4914b2b9, 0x172442d7, 0xda8a0600,
+	0xa96f30bc, 0x163138aa, 0xe38dee4d, 0xb0fb0e4e,
+}
+
+// sm3 is an implementation of the SM3 cryptographic hash function,
+// as specified in GB/T 32905-2016.
+type sm3 struct {
+	h   [8]uint32
+	x   [BlockSize]byte
+	nx  int
+	len uint64
+}
+
+// NewSM3 returns a new hash.Hash computing the SM3 checksum.
+func NewSM3() hash.Hash {
+	d := &sm3{}
+	d.Reset()
+	return d
+}
+
+// SumSM3 returns the SM3 checksum of data.
+func SumSM3(data []byte) [Size]byte {
+	d := &sm3{}
+	d.Reset()
+	d.Write(data)
+	var sum [Size]byte
+	copy(sum[:], d.Sum(nil))
+	return sum
+}
+
+func (d *sm3) Reset() {
+	d.h = iv
+	d.nx = 0
+	d.len = 0
+}
+
+func (d *sm3) Size() int { return Size }
+
+func (d *sm3) BlockSize() int { return BlockSize }
+
+func (d *sm3) Write(p []byte) (nn int, err error) {
+	nn = len(p)
+	d.len += uint64(nn)
+	if d.nx > 0 {
+		n := copy(d.x[d.nx:], p)
+		d.nx += n
+		if d.nx == BlockSize {
+			d.block(d.x[:])
+			d.nx = 0
+		}
+		p = p[n:]
+	}
+	for len(p) >= BlockSize {
+		d.block(p[:BlockSize])
+		p = p[BlockSize:]
+	}
+	if len(p) > 0 {
+		d.nx = copy(d.x[:], p)
+	}
+	return
+}
+
+func (d *sm3) Sum(in []byte) []byte {
+	// Make a copy of d so that the caller can keep writing and summing
+	d0 := *d
+
+	// Padding: a single 1 bit, zeros, and the message length in bits
+	length := d0.len
+	var tmp [BlockSize]byte
+	tmp[0] = 0x80
+	if length%BlockSize < 56 {
+		d0.Write(tmp[0 : 56-length%BlockSize])
+	} else {
+		d0.Write(tmp[0 : BlockSize+56-length%BlockSize])
+	}
+	binary.BigEndian.PutUint64(tmp[:8], length<<3)
+	d0.Write(tmp[0:8])
+
+	var digest [Size]byte
+	for i, v := range d0.h {
+		binary.BigEndian.PutUint32(digest[i*4:], v)
+	}
+	return append(in, digest[:]...)
+}
+
+func p0(x uint32) uint32 {
+	return x ^ bits.RotateLeft32(x, 9) ^ bits.RotateLeft32(x, 17)
+}
+
+func p1(x uint32) uint32 {
+	return x ^ bits.RotateLeft32(x, 15) ^ bits.RotateLeft32(x, 23)
+}
+
+// block compresses a single 64 bytes block into the state of d
+func (d *sm3) block(p []byte) {
+	var w [68]uint32
+	for i := 0; i < 16; i++ {
+		w[i] = binary.BigEndian.Uint32(p[i*4:])
+	}
+	for i := 16; i < 68; i++ {
+		w[i] = p1(w[i-16]^w[i-9]^bits.RotateLeft32(w[i-3], 15)) ^ bits.RotateLeft32(w[i-13], 7) ^ w[i-6]
+	}
+
+	a, b, c, dd, e, f, g, h := d.h[0], d.h[1], d.h[2], d.h[3], d.h[4], d.h[5], d.h[6], d.h[7]
+	for j := 0; j < 64; j++ {
+		var t, ff, gg uint32
+		if j < 16 {
+			t = 0x79cc4519
+			ff = a ^ b ^ c
+			gg = e ^ f ^ g
+		} else {
+			t = 0x7a879d8a
+			ff = (a & b) | (a & c) | (b & c)
+			gg = (e & f) | (^e & g)
+		}
+		ss1 := bits.RotateLeft32(bits.RotateLeft32(a, 12)+e+bits.RotateLeft32(t, j%32), 7)
+		ss2 := ss1 ^ bits.RotateLeft32(a, 12)
+		tt1 := ff + dd + ss2 + (w[j] ^ w[j+4])
+		tt2 := gg + h + ss1 + w[j]
+		dd = c
+		c = bits.RotateLeft32(b, 9)
+		b = a
+		a = tt1
+		h = g
+		g = bits.RotateLeft32(f, 19)
+		f = e
+		e = p0(tt2)
+	}
+
+	d.h[0] ^= a
+	d.h[1] ^= b
+	d.h[2] ^= c
+	d.h[3] ^= dd
+	d.h[4] ^= e
+	d.h[5] ^= f
+	d.h[6] ^= g
+	d.h[7] ^= h
+}
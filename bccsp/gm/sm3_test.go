@@ -0,0 +1,46 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package gm
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSM3(t *testing.T) {
+	// Test vectors from GB/T 32905-2016
+	for _, tc := range []struct {
+		msg    string
+		digest string
+	}{
+		{"abc", "66c7f0f462eeedd9d1f2d46bdc10e4e24167c4875cf2f7a2297da02b8f4ba8e0"},
+		{strings.Repeat("abcd", 16), "debe9ff92275b8a138604889c18e5a4d6fdb70e5387e5765293dcba39c0c5732"},
+		{"", "1ab21d8355cfa17f8e61194831e81a8f22bec8c728fefb747ed035eb5082aa2b"},
+	} {
+		sum := SumSM3([]byte(tc.msg))
+		assert.Equal(t, tc.digest, hex.EncodeToString(sum[:]))
+
+		// Writing the message byte by byte gives the same digest
+		h := NewSM3()
+		for i := 0; i < len(tc.msg); i++ {
+			h.Write([]byte{tc.msg[i]})
+		}
+		assert.Equal(t, tc.digest, hex.EncodeToString(h.Sum(nil)))
+		assert.Equal(t, tc.digest, hex.EncodeToString(h.Sum(nil)), "Sum must not change the state of the hash")
+
+		h.Reset()
+		h.Write([]byte(tc.msg))
+		assert.Equal(t, tc.digest, hex.EncodeToString(h.Sum(nil)))
+	}
+
+	h := NewSM3()
+	assert.Equal(t, Size, h.Size())
+	assert.Equal(t, BlockSize, h.BlockSize())
+}
@@ -0,0 +1,300 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package gm
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	oidPublicKeyECDSA   = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidNamedCurveP256   = asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7}
+	oidNamedCurveSM2    = asn1.ObjectIdentifier{1, 2, 156, 10197, 1, 301}
+	oidSignatureSM2SM3  = asn1.ObjectIdentifier{1, 2, 156, 10197, 1, 501}
+	errNotSM2PublicKey  = errors.New("not an SM2 public key")
+	errNotSM2PrivateKey = errors.New("not an SM2 private key")
+)
+
+type publicKeyInfo struct {
+	Raw       asn1.RawContent
+	Algorithm pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+type pkcs8 struct {
+	Version    int
+	Algo       pkix.AlgorithmIdentifier
+	PrivateKey []byte
+}
+
+type ecPrivateKey struct {
+	Version       int
+	PrivateKey    []byte
+	NamedCurveOID asn1.ObjectIdentifier `asn1:"optional,explicit,tag:0"`
+	PublicKey     asn1.BitString        `asn1:"optional,explicit,tag:1"`
+}
+
+// MarshalPKIXPublicKey converts an SM2 public key to PKIX, ASN.1 DER form.
+func MarshalPKIXPublicKey(pub *PublicKey) ([]byte, error) {
+	params, err := asn1.Marshal(oidNamedCurveSM2)
+	if err != nil {
+		return nil, err
+	}
+
+	point := elliptic.Marshal(pub.Curve, pub.X, pub.Y)
+	return asn1.Marshal(publicKeyInfo{
+		Algorithm: pkix.AlgorithmIdentifier{
+			Algorithm:  oidPublicKeyECDSA,
+			Parameters: asn1.RawValue{FullBytes: params},
+		},
+		PublicKey: asn1.BitString{Bytes: point, BitLength: 8 * len(point)},
+	})
+}
+
+func parsePublicKeyInfo(pki *publicKeyInfo) (*PublicKey, error) {
+	var curveOID asn1.ObjectIdentifier
+	if !pki.Algorithm.Algorithm.Equal(oidPublicKeyECDSA) {
+		return nil, errNotSM2PublicKey
+	}
+	if _, err := asn1.Unmarshal(pki.Algorithm.Parameters.FullBytes, &curveOID); err != nil || !curveOID.Equal(oidNamedCurveSM2) {
+		return nil, errNotSM2PublicKey
+	}
+
+	c := P256Sm2()
+	x, y := elliptic.Unmarshal(c, pki.PublicKey.RightAlign())
+	if x == nil {
+		return nil, errors.New("failed to unmarshal SM2 curve point")
+	}
+	return &PublicKey{Curve: c, X: x, Y: y}, nil
+}
+
+// ParsePKIXPublicKey parses an SM2 public key in PKIX, ASN.1 DER form.
+func ParsePKIXPublicKey(der []byte) (*PublicKey, error) {
+	var pki publicKeyInfo
+	if rest, err := asn1.Unmarshal(der, &pki); err != nil {
+		return nil, errors.Wrap(err, "failed to parse public key")
+	} else if len(rest) != 0 {
+		return nil, errors.New("trailing data after ASN.1 of public key")
+	}
+	return parsePublicKeyInfo(&pki)
+}
+
+// MarshalECPrivateKey converts an SM2 private key to SEC 1, ASN.1 DER form.
+func MarshalECPrivateKey(priv *PrivateKey) ([]byte, error) {
+	point := elliptic.Marshal(priv.Curve, priv.X, priv.Y)
+	return asn1.Marshal(ecPrivateKey{
+		Version:       1,
+		PrivateKey:    fieldBytes(priv.Curve, priv.D),
+		NamedCurveOID: oidNamedCurveSM2,
+		PublicKey:     asn1.BitString{Bytes: point, BitLength: 8 * len(point)},
+	})
+}
+
+// ParseECPrivateKey parses an SM2 private key in SEC 1, ASN.1 DER form.
+func ParseECPrivateKey(der []byte) (*PrivateKey, error) {
+	return parseECPrivateKey(nil, der)
+}
+
+func parseECPrivateKey(namedCurveOID asn1.ObjectIdentifier, der []byte) (*PrivateKey, error) {
+	var privKey ecPrivateKey
+	if _, err := asn1.Unmarshal(der, &privKey); err != nil {
+		return nil, errors.Wrap(err, "failed to parse EC private key")
+	}
+	if privKey.Version != 1 {
+		return nil, errors.Errorf("unknown EC private key version %d", privKey.Version)
+	}
+	if namedCurveOID == nil {
+		namedCurveOID = privKey.NamedCurveOID
+	}
+	if !namedCurveOID.Equal(oidNamedCurveSM2) {
+		return nil, errNotSM2PrivateKey
+	}
+
+	c := P256Sm2()
+	n := c.Params().N
+	d := new(big.Int).SetBytes(privKey.PrivateKey)
+	if d.Sign() <= 0 || d.Cmp(n) >= 0 {
+		return nil, errors.New("invalid SM2 private key value")
+	}
+
+	priv := &PrivateKey{D: d}
+	priv.Curve = c
+	priv.X, priv.Y = c.ScalarBaseMult(fieldBytes(c, d))
+	return priv, nil
+}
+
+// MarshalPKCS8PrivateKey converts an SM2 private key to PKCS #8, ASN.1 DER form.
+func MarshalPKCS8PrivateKey(priv *PrivateKey) ([]byte, error) {
+	params, err := asn1.Marshal(oidNamedCurveSM2)
+	if err != nil {
+		return nil, err
+	}
+
+	// The curve is given by the algorithm parameters
+	point := elliptic.Marshal(priv.Curve, priv.X, priv.Y)
+	ecKey, err := asn1.Marshal(ecPrivateKey{
+		Version:    1,
+		PrivateKey: fieldBytes(priv.Curve, priv.D),
+		PublicKey:  asn1.BitString{Bytes: point, BitLength: 8 * len(point)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return asn1.Marshal(pkcs8{
+		Algo: pkix.AlgorithmIdentifier{
+			Algorithm:  oidPublicKeyECDSA,
+			Parameters: asn1.RawValue{FullBytes: params},
+		},
+		PrivateKey: ecKey,
+	})
+}
+
+// ParsePKCS8PrivateKey parses an SM2 private key in PKCS #8, ASN.1 DER form.
+func ParsePKCS8PrivateKey(der []byte) (*PrivateKey, error) {
+	var privKey pkcs8
+	if _, err := asn1.Unmarshal(der, &privKey); err != nil {
+		return nil, errors.Wrap(err, "failed to parse PKCS#8 private key")
+	}
+
+	var curveOID asn1.ObjectIdentifier
+	if !privKey.Algo.Algorithm.Equal(oidPublicKeyECDSA) {
+		return nil, errNotSM2PrivateKey
+	}
+	if _, err := asn1.Unmarshal(privKey.Algo.Parameters.FullBytes, &curveOID); err != nil {
+		return nil, errNotSM2PrivateKey
+	}
+	return parseECPrivateKey(curveOID, privKey.PrivateKey)
+}
+
+type certificate struct {
+	TBSCertificate     asn1.RawValue
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	SignatureValue     asn1.BitString
+}
+
+type tbsCertificate struct {
+	Raw                asn1.RawContent
+	Version            int `asn1:"optional,explicit,default:0,tag:0"`
+	SerialNumber       asn1.RawValue
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Issuer             asn1.RawValue
+	Validity           asn1.RawValue
+	Subject            asn1.RawValue
+	PublicKey          publicKeyInfo
+}
+
+// ParseCertificate parses a single certificate from the given ASN.1 DER
+// data. It behaves as x509.ParseCertificate, except that it supports
+// certificates with SM2 public keys, in which case the PublicKey
+// of the returned certificate is a *PublicKey.
+func ParseCertificate(der []byte) (*x509.Certificate, error) {
+	var c certificate
+	var tbs tbsCertificate
+	if _, err := asn1.Unmarshal(der, &c); err != nil {
+		return x509.ParseCertificate(der)
+	}
+	if _, err := asn1.Unmarshal(c.TBSCertificate.FullBytes, &tbs); err != nil {
+		return x509.ParseCertificate(der)
+	}
+	pub, err := parsePublicKeyInfo(&tbs.PublicKey)
+	if err == errNotSM2PublicKey {
+		return x509.ParseCertificate(der)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The standard library does not support the SM2 curve, hence the
+	// certificate is parsed with a P-256 public key of the same length
+	// in place of the SM2 one, which is put back afterwards
+	spki := []byte(tbs.PublicKey.Raw)
+	p256 := elliptic.P256().Params()
+	substitute, err := x509.MarshalPKIXPublicKey(&ecdsa.PublicKey{Curve: elliptic.P256(), X: p256.Gx, Y: p256.Gy})
+	if err != nil {
+		return nil, err
+	}
+	if len(substitute) != len(spki) {
+		return nil, errors.New("unsupported SM2 public key encoding")
+	}
+	offset := bytes.Index(der, spki)
+	if offset < 0 {
+		return nil, errors.New("failed to locate the public key of the certificate")
+	}
+	substituted := append(append(append([]byte{}, der[:offset]...), substitute...), der[offset+len(spki):]...)
+
+	cert, err := x509.ParseCertificate(substituted)
+	if err != nil {
+		return nil, err
+	}
+	cert.Raw = der
+	cert.RawTBSCertificate = c.TBSCertificate.FullBytes
+	cert.RawSubjectPublicKeyInfo = spki
+	cert.PublicKey = pub
+	return cert, nil
+}
+
+// IsSM2SignedCert returns true if cert is signed with SM2 and SM3.
+func IsSM2SignedCert(cert *x509.Certificate) bool {
+	var c certificate
+	if _, err := asn1.Unmarshal(cert.Raw, &c); err != nil {
+		return false
+	}
+	return c.SignatureAlgorithm.Algorithm.Equal(oidSignatureSM2SM3)
+}
+
+// CheckSignatureFrom verifies that the SM2 signature on cert is a valid
+// signature from parent, which must be a CA certificate with an SM2 key.
+func CheckSignatureFrom(cert, parent *x509.Certificate) error {
+	// As the standard library does, certificates that do not have
+	// basic constraints are accepted when they are not X.509 v3
+	if parent.Version == 3 && !parent.BasicConstraintsValid ||
+		parent.BasicConstraintsValid && !parent.IsCA {
+		return x509.ConstraintViolationError{}
+	}
+	if parent.KeyUsage != 0 && parent.KeyUsage&x509.KeyUsageCertSign == 0 {
+		return x509.ConstraintViolationError{}
+	}
+	if !IsSM2SignedCert(cert) {
+		return x509.ErrUnsupportedAlgorithm
+	}
+
+	return checkSignature(parent, cert.RawTBSCertificate, cert.Signature)
+}
+
+// CheckCRLSignature verifies that the SM2 signature on crl is a valid
+// signature from parent.
+func CheckCRLSignature(crl *pkix.CertificateList, parent *x509.Certificate) error {
+	if !crl.SignatureAlgorithm.Algorithm.Equal(oidSignatureSM2SM3) {
+		return x509.ErrUnsupportedAlgorithm
+	}
+	return checkSignature(parent, crl.TBSCertList.Raw, crl.SignatureValue.RightAlign())
+}
+
+func checkSignature(parent *x509.Certificate, signed, signature []byte) error {
+	pub, ok := parent.PublicKey.(*PublicKey)
+	if !ok {
+		return errors.New("the parent certificate does not have an SM2 public key")
+	}
+
+	digest, err := Digest(pub, DefaultUID, signed)
+	if err != nil {
+		return err
+	}
+	if !VerifyASN1(pub, digest, signature) {
+		return errors.New("SM2 verification failure")
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package gm
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalKeys(t *testing.T) {
+	priv, err := GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	der, err := MarshalPKIXPublicKey(&priv.PublicKey)
+	assert.NoError(t, err)
+	pub, err := ParsePKIXPublicKey(der)
+	assert.NoError(t, err)
+	assert.Equal(t, &priv.PublicKey, pub)
+
+	der, err = MarshalPKCS8PrivateKey(priv)
+	assert.NoError(t, err)
+	privBack, err := ParsePKCS8PrivateKey(der)
+	assert.NoError(t, err)
+	assert.Equal(t, priv, privBack)
+
+	der, err = MarshalECPrivateKey(priv)
+	assert.NoError(t, err)
+	privBack, err = ParseECPrivateKey(der)
+	assert.NoError(t, err)
+	assert.Equal(t, priv, privBack)
+
+	// Keys on other curves are not SM2 keys
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err = x509.MarshalPKIXPublicKey(&ecdsaKey.PublicKey)
+	require.NoError(t, err)
+	_, err = ParsePKIXPublicKey(der)
+	assert.EqualError(t, err, "not an SM2 public key")
+	der, err = x509.MarshalPKCS8PrivateKey(ecdsaKey)
+	require.NoError(t, err)
+	_, err = ParsePKCS8PrivateKey(der)
+	assert.EqualError(t, err, "not an SM2 private key")
+	der, err = x509.MarshalECPrivateKey(ecdsaKey)
+	require.NoError(t, err)
+	_, err = ParseECPrivateKey(der)
+	assert.EqualError(t, err, "not an SM2 private key")
+
+	_, err = ParsePKIXPublicKey([]byte("barf"))
+	assert.Error(t, err)
+}
+
+func TestParseCertificate(t *testing.T) {
+	// The certificates and the CRL in testdata were generated with openssl,
+	// signing with -sigopt distid:1234567812345678
+	caDER := readPEM(t, "cacert.pem")
+	ca, err := ParseCertificate(caDER)
+	require.NoError(t, err)
+	assert.IsType(t, &PublicKey{}, ca.PublicKey)
+	assert.Equal(t, caDER, ca.Raw)
+	assert.True(t, ca.IsCA)
+	assert.Equal(t, "ca.org1.example.com", ca.Subject.CommonName)
+
+	cert, err := ParseCertificate(readPEM(t, "cert.pem"))
+	require.NoError(t, err)
+	assert.True(t, IsSM2SignedCert(cert))
+	assert.Equal(t, []string{"client"}, cert.Subject.OrganizationalUnit)
+
+	priv, err := ParsePKCS8PrivateKey(readPEM(t, "key.pem"))
+	require.NoError(t, err)
+	assert.Equal(t, &priv.PublicKey, cert.PublicKey)
+
+	// The public key info of the certificate is the original one
+	pub, err := ParsePKIXPublicKey(cert.RawSubjectPublicKeyInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, &priv.PublicKey, pub)
+
+	assert.NoError(t, CheckSignatureFrom(cert, ca))
+	assert.NoError(t, CheckSignatureFrom(ca, ca))
+	assert.Equal(t, x509.ConstraintViolationError{}, CheckSignatureFrom(ca, cert))
+
+	tampered := *cert
+	tampered.RawTBSCertificate = append([]byte{}, cert.RawTBSCertificate...)
+	tampered.RawTBSCertificate[len(tampered.RawTBSCertificate)-1] ^= 1
+	assert.EqualError(t, CheckSignatureFrom(&tampered, ca), "SM2 verification failure")
+
+	crl, err := x509.ParseCRL(readPEM(t, "crl.pem"))
+	require.NoError(t, err)
+	assert.NoError(t, CheckCRLSignature(crl, ca))
+	assert.Equal(t, 0, crl.TBSCertList.RevokedCertificates[0].SerialNumber.Cmp(cert.SerialNumber))
+}
+
+func TestParseCertificateNotSM2(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := ParseCertificate(der)
+	assert.NoError(t, err)
+	assert.Equal(t, &key.PublicKey, cert.PublicKey)
+	assert.False(t, IsSM2SignedCert(cert))
+	assert.Equal(t, x509.ErrUnsupportedAlgorithm, CheckSignatureFrom(cert, cert))
+
+	_, err = ParseCertificate([]byte("barf"))
+	assert.Error(t, err)
+}
@@ -64,7 +64,7 @@ func (*Revocation) Verify(pk *ecdsa.PublicKey, criRaw []byte, epoch int, alg bcc
 		pk,
 		cri.EpochPk,
 		cri.EpochPkSig,
-		int(cri.Epoch),
-		cryptolib.RevocationAlgorithm(cri.RevocationAlg),
+		epoch,
+		cryptolib.RevocationAlgorithm(alg),
 	)
 }
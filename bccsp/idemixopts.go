@@ -20,6 +20,9 @@ const (
 const (
 	// AlgNoRevocation means no revocation support
 	AlgNoRevocation RevocationAlgorithm = iota
+	// AlgPlainSignature means that the revocation authority signs each unrevoked
+	// revocation handle, and signers prove that their revocation handle was signed
+	AlgPlainSignature
 )
 
 // IdemixIssuerKeyGenOpts contains the options for the Idemix Issuer key-generation.
@@ -32,6 +32,13 @@ const (
 	// ECDSAReRand ECDSA key re-randomization
 	ECDSAReRand = "ECDSA_RERAND"
 
+	// ED25519 Edwards-curve Digital Signature Algorithm over Curve25519 (key gen, import, sign, verify)
+	ED25519 = "ED25519"
+
+	// SM2 Elliptic Curve Digital Signature Algorithm over the SM2 curve, as specified
+	// by the Chinese national cryptography standards (key gen, import, sign, verify)
+	SM2 = "SM2"
+
 	// RSA at the default security level.
 	// Each BCCSP may or may not support default security level. If not supported than
 	// an error will be returned.
@@ -79,6 +86,9 @@ const (
 	SHA3_256 = "SHA3_256"
 	// SHA3_384
 	SHA3_384 = "SHA3_384"
+	// SM3 is the hash function specified by the Chinese national cryptography
+	// standards. It is also an identifier for the hash family made of SM3 only
+	SM3 = "SM3"
 
 	// X509Certificate Label for X509 certificate related operation
 	X509Certificate = "X509Certificate"
@@ -12,6 +12,7 @@ import (
 	"encoding/asn1"
 	"fmt"
 	"hash"
+	"time"
 
 	"golang.org/x/crypto/sha3"
 )
@@ -88,6 +89,10 @@ type PKCS11Opts struct {
 	Pin        string `mapstructure:"pin" json:"pin"`
 	SoftVerify bool   `mapstructure:"softwareverify,omitempty" json:"softwareverify,omitempty"`
 	Immutable  bool   `mapstructure:"immutable,omitempty" json:"immutable,omitempty"`
+
+	// Session pool options
+	SessionCacheSize           int           `mapstructure:"sessioncachesize,omitempty" json:"sessioncachesize,omitempty"`
+	SessionHealthCheckInterval time.Duration `mapstructure:"sessionhealthcheckinterval,omitempty" json:"sessionhealthcheckinterval,omitempty"`
 }
 
 // FileKeystoreOpts currently only ECDSA operations go to PKCS11, need a keystore still
@@ -11,6 +11,7 @@ import (
 	"crypto/rsa"
 	"crypto/x509"
 	"os"
+	"time"
 
 	"github.com/hyperledger/fabric/bccsp"
 	"github.com/hyperledger/fabric/bccsp/sw"
@@ -20,8 +21,9 @@ import (
 )
 
 var (
-	logger           = flogging.MustGetLogger("bccsp_p11")
-	sessionCacheSize = 10
+	logger                     = flogging.MustGetLogger("bccsp_p11")
+	sessionCacheSize           = 10
+	sessionHealthCheckInterval = 30 * time.Second
 )
 
 // New WithParams returns a new instance of the software-based BCCSP
@@ -53,8 +55,28 @@ func New(opts PKCS11Opts, keyStore bccsp.KeyStore) (bccsp.BCCSP, error) {
 			lib, label)
 	}
 
-	sessions := make(chan pkcs11.SessionHandle, sessionCacheSize)
-	csp := &impl{swCSP, conf, keyStore, ctx, sessions, slot, lib, opts.SoftVerify, opts.Immutable}
+	cacheSize := opts.SessionCacheSize
+	if cacheSize <= 0 {
+		cacheSize = sessionCacheSize
+	}
+	healthCheckInterval := opts.SessionHealthCheckInterval
+	if healthCheckInterval <= 0 {
+		healthCheckInterval = sessionHealthCheckInterval
+	}
+
+	csp := &impl{
+		BCCSP:               swCSP,
+		conf:                conf,
+		ks:                  keyStore,
+		ctx:                 ctx,
+		sessions:            make(chan pooledSession, cacheSize),
+		slot:                slot,
+		pin:                 pin,
+		healthCheckInterval: healthCheckInterval,
+		lib:                 lib,
+		softVerify:          opts.SoftVerify,
+		immutable:           opts.Immutable,
+	}
 	csp.returnSession(*session)
 	return csp, nil
 }
@@ -66,8 +88,11 @@ type impl struct {
 	ks   bccsp.KeyStore
 
 	ctx      *pkcs11.Ctx
-	sessions chan pkcs11.SessionHandle
+	sessions chan pooledSession
 	slot     uint
+	pin      string
+	// Pooled sessions idle for longer are checked before being reused
+	healthCheckInterval time.Duration
 
 	lib        string
 	softVerify bool
@@ -14,7 +14,9 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/miekg/pkcs11"
 	"go.uber.org/zap/zapcore"
@@ -54,62 +56,116 @@ func loadLib(lib, pin, label string) (*pkcs11.Ctx, uint, *pkcs11.SessionHandle,
 		return nil, slot, nil, fmt.Errorf("Could not find token with label %s", label)
 	}
 
+	if pin == "" {
+		return nil, slot, nil, fmt.Errorf("No PIN set")
+	}
+	session, err := openSession(ctx, slot, pin)
+	if err != nil {
+		return nil, slot, nil, err
+	}
+
+	return ctx, slot, &session, nil
+}
+
+// Session states, as defined by the PKCS#11 specification
+const (
+	cksROUserFunctions = 1
+	cksRWUserFunctions = 3
+)
+
+// openSessionRetries is the number of attempts made at opening a session,
+// and openSessionRetryDelay the time waited between two attempts
+var (
+	openSessionRetries    = 10
+	openSessionRetryDelay = 100 * time.Millisecond
+)
+
+// openSession opens a new session on the given slot, and logs the user in,
+// unless the token already has the user logged in.
+func openSession(ctx *pkcs11.Ctx, slot uint, pin string) (pkcs11.SessionHandle, error) {
 	var session pkcs11.SessionHandle
-	for i := 0; i < 10; i++ {
+	var err error
+	for i := 0; i < openSessionRetries; i++ {
 		session, err = ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
-		if err != nil {
-			logger.Warningf("OpenSession failed, retrying [%s]\n", err)
-		} else {
+		if err == nil {
 			break
 		}
+		logger.Warningf("OpenSession failed, retrying [%s]\n", err)
+		time.Sleep(openSessionRetryDelay)
 	}
 	if err != nil {
-		logger.Fatalf("OpenSession [%s]\n", err)
+		return 0, fmt.Errorf("OpenSession failed [%s]", err)
 	}
 	logger.Debugf("Created new pkcs11 session %+v on slot %d\n", session, slot)
 
-	if pin == "" {
-		return nil, slot, nil, fmt.Errorf("No PIN set")
-	}
-	err = ctx.Login(session, pkcs11.CKU_USER, pin)
-	if err != nil {
-		if err != pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN) {
-			return nil, slot, nil, fmt.Errorf("Login failed [%s]", err)
-		}
+	if err := login(ctx, session, pin); err != nil {
+		ctx.CloseSession(session)
+		return 0, err
 	}
+	return session, nil
+}
 
-	return ctx, slot, &session, nil
+func login(ctx *pkcs11.Ctx, session pkcs11.SessionHandle, pin string) error {
+	err := ctx.Login(session, pkcs11.CKU_USER, pin)
+	if err != nil && err != pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN) {
+		return fmt.Errorf("Login failed [%s]", err)
+	}
+	return nil
 }
 
-func (csp *impl) getSession() (session pkcs11.SessionHandle) {
-	select {
-	case session = <-csp.sessions:
-		logger.Debugf("Reusing existing pkcs11 session %+v on slot %d\n", session, csp.slot)
+// pooledSession is a session held by the session pool
+type pooledSession struct {
+	handle   pkcs11.SessionHandle
+	returned time.Time
+}
 
-	default:
-		// cache is empty (or completely in use), create a new session
-		var s pkcs11.SessionHandle
-		var err error
-		for i := 0; i < 10; i++ {
-			s, err = csp.ctx.OpenSession(csp.slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
-			if err != nil {
-				logger.Warningf("OpenSession failed, retrying [%s]\n", err)
-			} else {
-				break
+// getSession returns a session from the pool, or opens a new one when the pool
+// is empty. Sessions that were idle in the pool for longer than the health check
+// interval are checked before being reused.
+func (csp *impl) getSession() (pkcs11.SessionHandle, error) {
+	for {
+		select {
+		case ps := <-csp.sessions:
+			if csp.checkSession(ps) {
+				logger.Debugf("Reusing existing pkcs11 session %+v on slot %d\n", ps.handle, csp.slot)
+				return ps.handle, nil
 			}
+			csp.ctx.CloseSession(ps.handle)
+
+		default:
+			// cache is empty (or completely in use), create a new session
+			return openSession(csp.ctx, csp.slot, csp.pin)
 		}
-		if err != nil {
-			panic(fmt.Errorf("OpenSession failed [%s]", err))
-		}
-		logger.Debugf("Created new pkcs11 session %+v on slot %d\n", s, csp.slot)
-		session = s
 	}
-	return session
+}
+
+// checkSession returns whether the given pooled session can be reused.
+// Sessions the token logged out of, e.g. because it restarted, are logged in again.
+func (csp *impl) checkSession(ps pooledSession) bool {
+	if time.Since(ps.returned) < csp.healthCheckInterval {
+		return true
+	}
+
+	info, err := csp.ctx.GetSessionInfo(ps.handle)
+	if err != nil {
+		logger.Warningf("Discarding pkcs11 session %+v on slot %d [%s]", ps.handle, csp.slot, err)
+		return false
+	}
+	if info.State == cksROUserFunctions || info.State == cksRWUserFunctions {
+		return true
+	}
+
+	logger.Infof("pkcs11 session %+v on slot %d is not logged in, logging in again", ps.handle, csp.slot)
+	if err := login(csp.ctx, ps.handle, csp.pin); err != nil {
+		logger.Warningf("Discarding pkcs11 session %+v on slot %d [%s]", ps.handle, csp.slot, err)
+		return false
+	}
+	return true
 }
 
 func (csp *impl) returnSession(session pkcs11.SessionHandle) {
 	select {
-	case csp.sessions <- session:
+	case csp.sessions <- pooledSession{handle: session, returned: time.Now()}:
 		// returned session back to session cache
 	default:
 		// have plenty of sessions in cache, dropping
@@ -117,12 +173,61 @@ func (csp *impl) returnSession(session pkcs11.SessionHandle) {
 	}
 }
 
+// handleSessionReturn returns the session to the pool, unless the given error,
+// returned by an operation on the session, shows the session or the token can't
+// be used anymore. In that case, the session is closed along with all the pooled
+// sessions, which are likely to be broken as well, and the next operations open
+// new sessions.
+func (csp *impl) handleSessionReturn(err error, session pkcs11.SessionHandle) {
+	if !isSessionError(err) {
+		csp.returnSession(session)
+		return
+	}
+
+	logger.Warningf("Closing pkcs11 sessions on slot %d [%s]", csp.slot, err)
+	csp.ctx.CloseSession(session)
+	for {
+		select {
+		case ps := <-csp.sessions:
+			csp.ctx.CloseSession(ps.handle)
+		default:
+			return
+		}
+	}
+}
+
+// sessionErrors are the errors that invalidate a session
+var sessionErrors = []pkcs11.Error{
+	pkcs11.CKR_DEVICE_ERROR,
+	pkcs11.CKR_DEVICE_REMOVED,
+	pkcs11.CKR_SESSION_CLOSED,
+	pkcs11.CKR_SESSION_HANDLE_INVALID,
+	pkcs11.CKR_TOKEN_NOT_PRESENT,
+	pkcs11.CKR_USER_NOT_LOGGED_IN,
+}
+
+func isSessionError(err error) bool {
+	if err == nil {
+		return false
+	}
+	// Errors are wrapped into formatted errors, so the PKCS11 error is looked up by name
+	for _, sessionErr := range sessionErrors {
+		if strings.Contains(err.Error(), sessionErr.Error()) {
+			return true
+		}
+	}
+	return false
+}
+
 // Look for an EC key by SKI, stored in CKA_ID
 // This function can probably be adapted for both EC and RSA keys.
 func (csp *impl) getECKey(ski []byte) (pubKey *ecdsa.PublicKey, isPriv bool, err error) {
 	p11lib := csp.ctx
-	session := csp.getSession()
-	defer csp.returnSession(session)
+	session, err := csp.getSession()
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() { csp.handleSessionReturn(err, session) }()
 	isPriv = true
 	_, err = findKeyPairFromSKI(p11lib, session, ski, privateKeyFlag)
 	if err != nil {
@@ -197,8 +302,11 @@ func namedCurveFromOID(oid asn1.ObjectIdentifier) elliptic.Curve {
 
 func (csp *impl) generateECKey(curve asn1.ObjectIdentifier, ephemeral bool) (ski []byte, pubKey *ecdsa.PublicKey, err error) {
 	p11lib := csp.ctx
-	session := csp.getSession()
-	defer csp.returnSession(session)
+	session, err := csp.getSession()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { csp.handleSessionReturn(err, session) }()
 
 	id := nextIDCtr()
 	publabel := fmt.Sprintf("BCPUB%s", id.Text(16))
@@ -311,8 +419,11 @@ func (csp *impl) generateECKey(curve asn1.ObjectIdentifier, ephemeral bool) (ski
 
 func (csp *impl) signP11ECDSA(ski []byte, msg []byte) (R, S *big.Int, err error) {
 	p11lib := csp.ctx
-	session := csp.getSession()
-	defer csp.returnSession(session)
+	session, err := csp.getSession()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { csp.handleSessionReturn(err, session) }()
 
 	privateKey, err := findKeyPairFromSKI(p11lib, session, ski, privateKeyFlag)
 	if err != nil {
@@ -339,10 +450,13 @@ func (csp *impl) signP11ECDSA(ski []byte, msg []byte) (R, S *big.Int, err error)
 	return R, S, nil
 }
 
-func (csp *impl) verifyP11ECDSA(ski []byte, msg []byte, R, S *big.Int, byteSize int) (bool, error) {
+func (csp *impl) verifyP11ECDSA(ski []byte, msg []byte, R, S *big.Int, byteSize int) (valid bool, err error) {
 	p11lib := csp.ctx
-	session := csp.getSession()
-	defer csp.returnSession(session)
+	session, err := csp.getSession()
+	if err != nil {
+		return false, err
+	}
+	defer func() { csp.handleSessionReturn(err, session) }()
 
 	logger.Debugf("Verify ECDSA\n")
 
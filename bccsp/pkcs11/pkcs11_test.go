@@ -11,6 +11,7 @@ import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"encoding/asn1"
+	"fmt"
 	"testing"
 
 	"github.com/hyperledger/fabric/bccsp"
@@ -90,7 +91,9 @@ func TestPKCS11GetSession(t *testing.T) {
 	}
 	var sessions []pkcs11.SessionHandle
 	for i := 0; i < 3*sessionCacheSize; i++ {
-		sessions = append(sessions, currentBCCSP.(*impl).getSession())
+		session, err := currentBCCSP.(*impl).getSession()
+		assert.NoError(t, err)
+		sessions = append(sessions, session)
 	}
 
 	// Return all sessions, should leave sessionCacheSize cached
@@ -105,13 +108,15 @@ func TestPKCS11GetSession(t *testing.T) {
 
 	// Should be able to get sessionCacheSize cached sessions
 	for i := 0; i < sessionCacheSize; i++ {
-		sessions = append(sessions, currentBCCSP.(*impl).getSession())
+		session, err := currentBCCSP.(*impl).getSession()
+		assert.NoError(t, err)
+		sessions = append(sessions, session)
 	}
 
 	// This one should fail
-	assert.Panics(t, func() {
-		currentBCCSP.(*impl).getSession()
-	}, "Should not been able to create another session")
+	_, err := currentBCCSP.(*impl).getSession()
+	assert.Error(t, err, "Should not been able to create another session")
+	assert.Contains(t, err.Error(), "OpenSession failed")
 
 	// Cleanup
 	for _, session := range sessions {
@@ -120,6 +125,55 @@ func TestPKCS11GetSession(t *testing.T) {
 	currentBCCSP.(*impl).slot = oldSlot
 }
 
+func TestPKCS11SessionHealthCheck(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping TestPKCS11SessionHealthCheck")
+	}
+	csp := currentBCCSP.(*impl)
+	oldInterval := csp.healthCheckInterval
+	csp.healthCheckInterval = 0
+	defer func() { csp.healthCheckInterval = oldInterval }()
+
+	// Empty the pool, and pool a session closed behind its back, as an HSM restart would
+	for len(csp.sessions) > 0 {
+		ps := <-csp.sessions
+		csp.ctx.CloseSession(ps.handle)
+	}
+	session, err := csp.getSession()
+	assert.NoError(t, err)
+	csp.ctx.CloseSession(session)
+	csp.returnSession(session)
+
+	// The broken session is discarded, and a working one is returned instead
+	session, err = csp.getSession()
+	assert.NoError(t, err)
+	info, err := csp.ctx.GetSessionInfo(session)
+	assert.NoError(t, err)
+	assert.Contains(t, []uint{cksROUserFunctions, cksRWUserFunctions}, info.State)
+
+	// Session errors close the session, along with the pooled ones
+	pooled, err := csp.getSession()
+	assert.NoError(t, err)
+	csp.returnSession(pooled)
+	csp.handleSessionReturn(fmt.Errorf("P11: sign failed [%s]", pkcs11.Error(pkcs11.CKR_DEVICE_REMOVED)), session)
+	assert.Len(t, csp.sessions, 0)
+	_, err = csp.ctx.GetSessionInfo(pooled)
+	assert.Error(t, err)
+
+	// Other errors return the session to the pool
+	session, err = csp.getSession()
+	assert.NoError(t, err)
+	csp.handleSessionReturn(fmt.Errorf("Private key not found"), session)
+	assert.Len(t, csp.sessions, 1)
+}
+
+func TestIsSessionError(t *testing.T) {
+	assert.False(t, isSessionError(nil))
+	assert.False(t, isSessionError(fmt.Errorf("P11: sign failed [%s]", pkcs11.Error(pkcs11.CKR_KEY_HANDLE_INVALID))))
+	assert.True(t, isSessionError(fmt.Errorf("P11: sign failed [%s]", pkcs11.Error(pkcs11.CKR_SESSION_HANDLE_INVALID))))
+	assert.True(t, isSessionError(pkcs11.Error(pkcs11.CKR_USER_NOT_LOGGED_IN)))
+}
+
 func TestPKCS11ECKeySignVerify(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping TestPKCS11ECKeySignVerify")
@@ -0,0 +1,162 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sw
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyED25519(t *testing.T) {
+	t.Parallel()
+
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	msg := []byte("hello world")
+	sigma, err := signED25519(privKey, msg, nil)
+	assert.NoError(t, err)
+
+	valid, err := verifyED25519(pubKey, sigma, msg, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = verifyED25519(pubKey, sigma, []byte("hello worlds"), nil)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	_, err = verifyED25519(pubKey, sigma[1:], msg, nil)
+	assert.EqualError(t, err, "Invalid signature length [63]. Must be 64 bytes")
+}
+
+func TestED25519KeyGen(t *testing.T) {
+	t.Parallel()
+	provider, _, cleanup := currentTestConfig.Provider(t)
+	defer cleanup()
+
+	k, err := provider.KeyGen(&bccsp.ED25519KeyGenOpts{Temporary: false})
+	assert.NoError(t, err)
+	assert.True(t, k.Private())
+	assert.False(t, k.Symmetric())
+	_, err = k.Bytes()
+	assert.Error(t, err)
+
+	// The private key is retrieved from the key store
+	k2, err := provider.GetKey(k.SKI())
+	assert.NoError(t, err)
+	assert.True(t, k2.Private())
+	assert.Equal(t, k.SKI(), k2.SKI())
+
+	pk, err := k.PublicKey()
+	assert.NoError(t, err)
+	assert.False(t, pk.Private())
+	assert.False(t, pk.Symmetric())
+	assert.Equal(t, k.SKI(), pk.SKI())
+	pkRaw, err := pk.Bytes()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, pkRaw)
+}
+
+func TestED25519SignVerify(t *testing.T) {
+	t.Parallel()
+	provider, ks, cleanup := currentTestConfig.Provider(t)
+	defer cleanup()
+
+	k, err := provider.KeyGen(&bccsp.ED25519KeyGenOpts{Temporary: true})
+	assert.NoError(t, err)
+
+	msg := []byte("Hello World")
+	signature, err := provider.Sign(k, msg, nil)
+	assert.NoError(t, err)
+	assert.Len(t, signature, ed25519.SignatureSize)
+
+	valid, err := provider.Verify(k, signature, msg, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	pk, err := k.PublicKey()
+	assert.NoError(t, err)
+	valid, err = provider.Verify(pk, signature, msg, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	// Store the public key, and verify with the one retrieved from the key store
+	err = ks.StoreKey(pk)
+	assert.NoError(t, err)
+	pk2, err := ks.GetKey(pk.SKI())
+	assert.NoError(t, err)
+	valid, err = provider.Verify(pk2, signature, msg, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = provider.Verify(pk, signature, []byte("Hello Worlds"), nil)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+}
+
+func TestED25519KeyImport(t *testing.T) {
+	t.Parallel()
+	provider, _, cleanup := currentTestConfig.Provider(t)
+	defer cleanup()
+
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	msg := []byte("Hello World")
+	signature := ed25519.Sign(privKey, msg)
+
+	pkixRaw, err := x509.MarshalPKIXPublicKey(pubKey)
+	assert.NoError(t, err)
+	pk, err := provider.KeyImport(pkixRaw, &bccsp.ED25519PKIXPublicKeyImportOpts{Temporary: true})
+	assert.NoError(t, err)
+	valid, err := provider.Verify(pk, signature, msg, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	pk, err = provider.KeyImport(pubKey, &bccsp.ED25519GoPublicKeyImportOpts{Temporary: true})
+	assert.NoError(t, err)
+	valid, err = provider.Verify(pk, signature, msg, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	pkcs8Raw, err := x509.MarshalPKCS8PrivateKey(privKey)
+	assert.NoError(t, err)
+	sk, err := provider.KeyImport(pkcs8Raw, &bccsp.ED25519PrivateKeyImportOpts{Temporary: true})
+	assert.NoError(t, err)
+	assert.Equal(t, pk.SKI(), sk.SKI())
+	sig, err := provider.Sign(sk, msg, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, signature, sig)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "ed25519"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certRaw, err := x509.CreateCertificate(rand.Reader, template, template, pubKey, privKey)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(certRaw)
+	assert.NoError(t, err)
+	pk, err = provider.KeyImport(cert, &bccsp.X509PublicKeyImportOpts{Temporary: true})
+	assert.NoError(t, err)
+	assert.Equal(t, sk.SKI(), pk.SKI())
+
+	_, err = provider.KeyImport([]byte("not a key"), &bccsp.ED25519PrivateKeyImportOpts{Temporary: true})
+	assert.Error(t, err)
+	_, err = provider.KeyImport(pkixRaw, &bccsp.ED25519PrivateKeyImportOpts{Temporary: true})
+	assert.Error(t, err)
+	_, err = provider.KeyImport(privKey, &bccsp.ED25519GoPublicKeyImportOpts{Temporary: true})
+	assert.Contains(t, err.Error(), "Invalid raw material. Expected ed25519.PublicKey.")
+}
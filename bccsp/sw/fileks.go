@@ -18,6 +18,7 @@ package sw
 import (
 	"bytes"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rsa"
 	"encoding/hex"
 	"errors"
@@ -29,6 +30,7 @@ import (
 	"sync"
 
 	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/gm"
 	"github.com/hyperledger/fabric/bccsp/utils"
 )
 
@@ -139,6 +141,10 @@ func (ks *fileBasedKeyStore) GetKey(ski []byte) (bccsp.Key, error) {
 			return &ecdsaPrivateKey{key.(*ecdsa.PrivateKey)}, nil
 		case *rsa.PrivateKey:
 			return &rsaPrivateKey{key.(*rsa.PrivateKey)}, nil
+		case ed25519.PrivateKey:
+			return &ed25519PrivateKey{key.(ed25519.PrivateKey)}, nil
+		case *gm.PrivateKey:
+			return &sm2PrivateKey{key.(*gm.PrivateKey)}, nil
 		default:
 			return nil, errors.New("Secret key type not recognized")
 		}
@@ -154,6 +160,10 @@ func (ks *fileBasedKeyStore) GetKey(ski []byte) (bccsp.Key, error) {
 			return &ecdsaPublicKey{key.(*ecdsa.PublicKey)}, nil
 		case *rsa.PublicKey:
 			return &rsaPublicKey{key.(*rsa.PublicKey)}, nil
+		case ed25519.PublicKey:
+			return &ed25519PublicKey{key.(ed25519.PublicKey)}, nil
+		case *gm.PublicKey:
+			return &sm2PublicKey{key.(*gm.PublicKey)}, nil
 		default:
 			return nil, errors.New("Public key type not recognized")
 		}
@@ -205,6 +215,38 @@ func (ks *fileBasedKeyStore) StoreKey(k bccsp.Key) (err error) {
 			return fmt.Errorf("Failed storing RSA public key [%s]", err)
 		}
 
+	case *ed25519PrivateKey:
+		kk := k.(*ed25519PrivateKey)
+
+		err = ks.storePrivateKey(hex.EncodeToString(k.SKI()), kk.privKey)
+		if err != nil {
+			return fmt.Errorf("Failed storing Ed25519 private key [%s]", err)
+		}
+
+	case *ed25519PublicKey:
+		kk := k.(*ed25519PublicKey)
+
+		err = ks.storePublicKey(hex.EncodeToString(k.SKI()), kk.pubKey)
+		if err != nil {
+			return fmt.Errorf("Failed storing Ed25519 public key [%s]", err)
+		}
+
+	case *sm2PrivateKey:
+		kk := k.(*sm2PrivateKey)
+
+		err = ks.storePrivateKey(hex.EncodeToString(k.SKI()), kk.privKey)
+		if err != nil {
+			return fmt.Errorf("Failed storing SM2 private key [%s]", err)
+		}
+
+	case *sm2PublicKey:
+		kk := k.(*sm2PublicKey)
+
+		err = ks.storePublicKey(hex.EncodeToString(k.SKI()), kk.pubKey)
+		if err != nil {
+			return fmt.Errorf("Failed storing SM2 public key [%s]", err)
+		}
+
 	case *aesPrivateKey:
 		kk := k.(*aesPrivateKey)
 
@@ -247,6 +289,10 @@ func (ks *fileBasedKeyStore) searchKeystoreForSKI(ski []byte) (k bccsp.Key, err
 			k = &ecdsaPrivateKey{key.(*ecdsa.PrivateKey)}
 		case *rsa.PrivateKey:
 			k = &rsaPrivateKey{key.(*rsa.PrivateKey)}
+		case ed25519.PrivateKey:
+			k = &ed25519PrivateKey{key.(ed25519.PrivateKey)}
+		case *gm.PrivateKey:
+			k = &sm2PrivateKey{key.(*gm.PrivateKey)}
 		default:
 			continue
 		}
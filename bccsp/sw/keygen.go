@@ -18,12 +18,14 @@ package sw
 
 import (
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"fmt"
 
 	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/gm"
 )
 
 type ecdsaKeyGenerator struct {
@@ -39,6 +41,28 @@ func (kg *ecdsaKeyGenerator) KeyGen(opts bccsp.KeyGenOpts) (bccsp.Key, error) {
 	return &ecdsaPrivateKey{privKey}, nil
 }
 
+type ed25519KeyGenerator struct{}
+
+func (kg *ed25519KeyGenerator) KeyGen(opts bccsp.KeyGenOpts) (bccsp.Key, error) {
+	_, privKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("Failed generating Ed25519 key [%s]", err)
+	}
+
+	return &ed25519PrivateKey{privKey}, nil
+}
+
+type sm2KeyGenerator struct{}
+
+func (kg *sm2KeyGenerator) KeyGen(opts bccsp.KeyGenOpts) (bccsp.Key, error) {
+	privKey, err := gm.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("Failed generating SM2 key [%s]", err)
+	}
+
+	return &sm2PrivateKey{privKey}, nil
+}
+
 type aesKeyGenerator struct {
 	length int
 }
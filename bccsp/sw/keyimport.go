@@ -18,6 +18,7 @@ package sw
 
 import (
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rsa"
 	"crypto/x509"
 	"errors"
@@ -25,6 +26,7 @@ import (
 	"reflect"
 
 	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/gm"
 	"github.com/hyperledger/fabric/bccsp/utils"
 )
 
@@ -123,6 +125,128 @@ func (*ecdsaGoPublicKeyImportOptsKeyImporter) KeyImport(raw interface{}, opts bc
 	return &ecdsaPublicKey{lowLevelKey}, nil
 }
 
+type ed25519PKIXPublicKeyImportOptsKeyImporter struct{}
+
+func (*ed25519PKIXPublicKeyImportOptsKeyImporter) KeyImport(raw interface{}, opts bccsp.KeyImportOpts) (bccsp.Key, error) {
+	der, ok := raw.([]byte)
+	if !ok {
+		return nil, errors.New("Invalid raw material. Expected byte array.")
+	}
+
+	if len(der) == 0 {
+		return nil, errors.New("Invalid raw. It must not be nil.")
+	}
+
+	lowLevelKey, err := utils.DERToPublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("Failed converting PKIX to Ed25519 public key [%s]", err)
+	}
+
+	ed25519PK, ok := lowLevelKey.(ed25519.PublicKey)
+	if !ok {
+		return nil, errors.New("Failed casting to Ed25519 public key. Invalid raw material.")
+	}
+
+	return &ed25519PublicKey{ed25519PK}, nil
+}
+
+type ed25519PrivateKeyImportOptsKeyImporter struct{}
+
+func (*ed25519PrivateKeyImportOptsKeyImporter) KeyImport(raw interface{}, opts bccsp.KeyImportOpts) (bccsp.Key, error) {
+	der, ok := raw.([]byte)
+	if !ok {
+		return nil, errors.New("[ED25519PrivateKeyImportOpts] Invalid raw material. Expected byte array.")
+	}
+
+	if len(der) == 0 {
+		return nil, errors.New("[ED25519PrivateKeyImportOpts] Invalid raw. It must not be nil.")
+	}
+
+	lowLevelKey, err := utils.DERToPrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("Failed converting PKCS#8 to Ed25519 private key [%s]", err)
+	}
+
+	ed25519SK, ok := lowLevelKey.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errors.New("Failed casting to Ed25519 private key. Invalid raw material.")
+	}
+
+	return &ed25519PrivateKey{ed25519SK}, nil
+}
+
+type ed25519GoPublicKeyImportOptsKeyImporter struct{}
+
+func (*ed25519GoPublicKeyImportOptsKeyImporter) KeyImport(raw interface{}, opts bccsp.KeyImportOpts) (bccsp.Key, error) {
+	lowLevelKey, ok := raw.(ed25519.PublicKey)
+	if !ok {
+		return nil, errors.New("Invalid raw material. Expected ed25519.PublicKey.")
+	}
+
+	return &ed25519PublicKey{lowLevelKey}, nil
+}
+
+type sm2PKIXPublicKeyImportOptsKeyImporter struct{}
+
+func (*sm2PKIXPublicKeyImportOptsKeyImporter) KeyImport(raw interface{}, opts bccsp.KeyImportOpts) (bccsp.Key, error) {
+	der, ok := raw.([]byte)
+	if !ok {
+		return nil, errors.New("Invalid raw material. Expected byte array.")
+	}
+
+	if len(der) == 0 {
+		return nil, errors.New("Invalid raw. It must not be nil.")
+	}
+
+	lowLevelKey, err := utils.DERToPublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("Failed converting PKIX to SM2 public key [%s]", err)
+	}
+
+	sm2PK, ok := lowLevelKey.(*gm.PublicKey)
+	if !ok {
+		return nil, errors.New("Failed casting to SM2 public key. Invalid raw material.")
+	}
+
+	return &sm2PublicKey{sm2PK}, nil
+}
+
+type sm2PrivateKeyImportOptsKeyImporter struct{}
+
+func (*sm2PrivateKeyImportOptsKeyImporter) KeyImport(raw interface{}, opts bccsp.KeyImportOpts) (bccsp.Key, error) {
+	der, ok := raw.([]byte)
+	if !ok {
+		return nil, errors.New("[SM2PrivateKeyImportOpts] Invalid raw material. Expected byte array.")
+	}
+
+	if len(der) == 0 {
+		return nil, errors.New("[SM2PrivateKeyImportOpts] Invalid raw. It must not be nil.")
+	}
+
+	lowLevelKey, err := utils.DERToPrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("Failed converting PKIX to SM2 private key [%s]", err)
+	}
+
+	sm2SK, ok := lowLevelKey.(*gm.PrivateKey)
+	if !ok {
+		return nil, errors.New("Failed casting to SM2 private key. Invalid raw material.")
+	}
+
+	return &sm2PrivateKey{sm2SK}, nil
+}
+
+type sm2GoPublicKeyImportOptsKeyImporter struct{}
+
+func (*sm2GoPublicKeyImportOptsKeyImporter) KeyImport(raw interface{}, opts bccsp.KeyImportOpts) (bccsp.Key, error) {
+	lowLevelKey, ok := raw.(*gm.PublicKey)
+	if !ok {
+		return nil, errors.New("Invalid raw material. Expected *gm.PublicKey.")
+	}
+
+	return &sm2PublicKey{lowLevelKey}, nil
+}
+
 type rsaGoPublicKeyImportOptsKeyImporter struct{}
 
 func (*rsaGoPublicKeyImportOptsKeyImporter) KeyImport(raw interface{}, opts bccsp.KeyImportOpts) (bccsp.Key, error) {
@@ -155,7 +279,15 @@ func (ki *x509PublicKeyImportOptsKeyImporter) KeyImport(raw interface{}, opts bc
 		return ki.bccsp.KeyImporters[reflect.TypeOf(&bccsp.RSAGoPublicKeyImportOpts{})].KeyImport(
 			pk,
 			&bccsp.RSAGoPublicKeyImportOpts{Temporary: opts.Ephemeral()})
+	case ed25519.PublicKey:
+		return ki.bccsp.KeyImporters[reflect.TypeOf(&bccsp.ED25519GoPublicKeyImportOpts{})].KeyImport(
+			pk,
+			&bccsp.ED25519GoPublicKeyImportOpts{Temporary: opts.Ephemeral()})
+	case *gm.PublicKey:
+		return ki.bccsp.KeyImporters[reflect.TypeOf(&bccsp.SM2GoPublicKeyImportOpts{})].KeyImport(
+			pk,
+			&bccsp.SM2GoPublicKeyImportOpts{Temporary: opts.Ephemeral()})
 	default:
-		return nil, errors.New("Certificate's public key type not recognized. Supported keys: [ECDSA, RSA]")
+		return nil, errors.New("Certificate's public key type not recognized. Supported keys: [ECDSA, RSA, ED25519, SM2]")
 	}
 }
@@ -0,0 +1,248 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sw
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/gm"
+	"github.com/hyperledger/fabric/bccsp/utils"
+	"github.com/pkg/errors"
+)
+
+// ErrSecretNotFound is returned by a SecretStore
+// when there is no secret with the requested name.
+var ErrSecretNotFound = errors.New("secret not found")
+
+// SecretStore stores secrets by name outside of the node, e.g. in
+// a key management service or a secret manager.
+type SecretStore interface {
+	// GetSecret returns the secret with the given name,
+	// or ErrSecretNotFound if there is none.
+	GetSecret(name string) ([]byte, error)
+	// PutSecret stores the given secret under the given name.
+	PutSecret(name string, secret []byte) error
+}
+
+// NewSecretStoreKeyStore instantiates a KeyStore that keeps its keys
+// in the given SecretStore. Each key is stored as a PEM encoded secret
+// whose name is made of the key's SKI and a suffix telling the key's type,
+// as in the file-based KeyStore. The keys are encrypted with the password,
+// if it is not empty. A read only KeyStore forbids storing keys.
+func NewSecretStoreKeyStore(store SecretStore, pwd []byte, readOnly bool) (bccsp.KeyStore, error) {
+	if store == nil {
+		return nil, errors.New("invalid secret store, it must not be nil")
+	}
+
+	return &secretStoreKeyStore{
+		store:    store,
+		pwd:      utils.Clone(pwd),
+		readOnly: readOnly,
+	}, nil
+}
+
+type secretStoreKeyStore struct {
+	store    SecretStore
+	pwd      []byte
+	readOnly bool
+}
+
+// ReadOnly returns true if this KeyStore is read only, false otherwise.
+// If ReadOnly is true then StoreKey will fail.
+func (ks *secretStoreKeyStore) ReadOnly() bool {
+	return ks.readOnly
+}
+
+// GetKey returns a key object whose SKI is the one passed.
+func (ks *secretStoreKeyStore) GetKey(ski []byte) (bccsp.Key, error) {
+	if len(ski) == 0 {
+		return nil, errors.New("invalid SKI, it cannot be of zero length")
+	}
+	alias := hex.EncodeToString(ski)
+
+	for _, suffix := range []string{"sk", "pk", "key"} {
+		raw, err := ks.store.GetSecret(alias + "_" + suffix)
+		if err == ErrSecretNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("failed getting key [%s] from the secret store", alias))
+		}
+
+		key, err := ks.parseKey(suffix, raw)
+		if err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("failed parsing key [%s]", alias))
+		}
+		return key, nil
+	}
+
+	return nil, errors.Errorf("key with SKI %s not found in the secret store", alias)
+}
+
+func (ks *secretStoreKeyStore) parseKey(suffix string, raw []byte) (bccsp.Key, error) {
+	switch suffix {
+	case "sk":
+		key, err := utils.PEMtoPrivateKey(raw, ks.pwd)
+		if err != nil {
+			return nil, err
+		}
+		switch key := key.(type) {
+		case *ecdsa.PrivateKey:
+			return &ecdsaPrivateKey{key}, nil
+		case *rsa.PrivateKey:
+			return &rsaPrivateKey{key}, nil
+		case ed25519.PrivateKey:
+			return &ed25519PrivateKey{key}, nil
+		case *gm.PrivateKey:
+			return &sm2PrivateKey{key}, nil
+		default:
+			return nil, errors.New("secret key type not recognized")
+		}
+	case "pk":
+		key, err := utils.PEMtoPublicKey(raw, ks.pwd)
+		if err != nil {
+			return nil, err
+		}
+		switch key := key.(type) {
+		case *ecdsa.PublicKey:
+			return &ecdsaPublicKey{key}, nil
+		case *rsa.PublicKey:
+			return &rsaPublicKey{key}, nil
+		case ed25519.PublicKey:
+			return &ed25519PublicKey{key}, nil
+		case *gm.PublicKey:
+			return &sm2PublicKey{key}, nil
+		default:
+			return nil, errors.New("public key type not recognized")
+		}
+	default:
+		key, err := utils.PEMtoAES(raw, ks.pwd)
+		if err != nil {
+			return nil, err
+		}
+		return &aesPrivateKey{key, false}, nil
+	}
+}
+
+// StoreKey stores the key k in this KeyStore.
+// If this KeyStore is read only then the method will fail.
+func (ks *secretStoreKeyStore) StoreKey(k bccsp.Key) error {
+	if ks.readOnly {
+		return errors.New("read only KeyStore")
+	}
+	if k == nil {
+		return errors.New("invalid key, it must not be nil")
+	}
+
+	var raw []byte
+	var suffix string
+	var err error
+	switch k := k.(type) {
+	case *ecdsaPrivateKey:
+		raw, err = utils.PrivateKeyToPEM(k.privKey, ks.pwd)
+		suffix = "sk"
+	case *rsaPrivateKey:
+		raw, err = utils.PrivateKeyToPEM(k.privKey, ks.pwd)
+		suffix = "sk"
+	case *ed25519PrivateKey:
+		raw, err = utils.PrivateKeyToPEM(k.privKey, ks.pwd)
+		suffix = "sk"
+	case *sm2PrivateKey:
+		raw, err = utils.PrivateKeyToPEM(k.privKey, ks.pwd)
+		suffix = "sk"
+	case *ecdsaPublicKey:
+		raw, err = utils.PublicKeyToPEM(k.pubKey, ks.pwd)
+		suffix = "pk"
+	case *rsaPublicKey:
+		raw, err = utils.PublicKeyToPEM(k.pubKey, ks.pwd)
+		suffix = "pk"
+	case *ed25519PublicKey:
+		raw, err = utils.PublicKeyToPEM(k.pubKey, ks.pwd)
+		suffix = "pk"
+	case *sm2PublicKey:
+		raw, err = utils.PublicKeyToPEM(k.pubKey, ks.pwd)
+		suffix = "pk"
+	case *aesPrivateKey:
+		raw, err = utils.AEStoEncryptedPEM(k.privKey, ks.pwd)
+		suffix = "key"
+	default:
+		return errors.Errorf("key type not recognized [%s]", k)
+	}
+	if err != nil {
+		return errors.WithMessage(err, "failed converting key to PEM")
+	}
+
+	alias := hex.EncodeToString(k.SKI())
+	if err := ks.store.PutSecret(alias+"_"+suffix, raw); err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("failed storing key [%s] in the secret store", alias))
+	}
+	return nil
+}
+
+// secretNameRegexp matches the names of the secrets a SecretStore
+// needs to handle, namely those of keys stored by a KeyStore
+var secretNameRegexp = regexp.MustCompile(`^[[:alnum:]_.-]+$`)
+
+// ValidSecretName returns an error if the given name is not
+// one a SecretStore can safely use as a file name or path element.
+func ValidSecretName(name string) error {
+	if !secretNameRegexp.MatchString(name) || name == "." || name == ".." {
+		return errors.Errorf("invalid secret name [%s]", name)
+	}
+	return nil
+}
+
+// NewFileSecretStore returns a SecretStore that keeps each secret in a file
+// of the given directory, readable only by its owner. It is a reference
+// implementation of a SecretStore, e.g. for development and tests; it should
+// be used along with a KeyStore password so that keys are encrypted at rest.
+func NewFileSecretStore(dir string) (SecretStore, error) {
+	if len(dir) == 0 {
+		return nil, errors.New("invalid secret store directory, it cannot be an empty string")
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrapf(err, "failed creating secret store directory [%s]", dir)
+	}
+	return &fileSecretStore{dir: dir}, nil
+}
+
+type fileSecretStore struct {
+	dir string
+}
+
+func (s *fileSecretStore) GetSecret(name string) ([]byte, error) {
+	if err := ValidSecretName(name); err != nil {
+		return nil, err
+	}
+	secret, err := ioutil.ReadFile(filepath.Join(s.dir, name))
+	if os.IsNotExist(err) {
+		return nil, ErrSecretNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed reading secret [%s]", name)
+	}
+	return secret, nil
+}
+
+func (s *fileSecretStore) PutSecret(name string, secret []byte) error {
+	if err := ValidSecretName(name); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(s.dir, name), secret, 0600); err != nil {
+		return errors.Wrapf(err, "failed writing secret [%s]", name)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sw
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretStoreKeyStore(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "secretstoreks")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := NewFileSecretStore(dir)
+	require.NoError(t, err)
+	ks, err := NewSecretStoreKeyStore(store, []byte("password"), false)
+	require.NoError(t, err)
+	assert.False(t, ks.ReadOnly())
+
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pubKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	keys := []struct {
+		name   string
+		key    bccsp.Key
+		suffix string
+	}{
+		{"ECDSAPrivateKey", &ecdsaPrivateKey{privKey}, "sk"},
+		{"ECDSAPublicKey", &ecdsaPublicKey{&pubKey.PublicKey}, "pk"},
+		{"AESKey", &aesPrivateKey{[]byte("0123456789abcdef0123456789abcdef"), false}, "key"},
+	}
+
+	for _, k := range keys {
+		k := k
+		t.Run(k.name, func(t *testing.T) {
+			err := ks.StoreKey(k.key)
+			assert.NoError(t, err)
+
+			// The key is encrypted at rest, and only readable by its owner
+			path := filepath.Join(dir, hex.EncodeToString(k.key.SKI())+"_"+k.suffix)
+			raw, err := ioutil.ReadFile(path)
+			require.NoError(t, err)
+			assert.Contains(t, string(raw), "ENCRYPTED")
+			info, err := os.Stat(path)
+			require.NoError(t, err)
+			assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+			loaded, err := ks.GetKey(k.key.SKI())
+			assert.NoError(t, err)
+			assert.Equal(t, k.key, loaded)
+		})
+	}
+}
+
+func TestSecretStoreKeyStoreWithoutPassword(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "secretstoreks")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := NewFileSecretStore(dir)
+	require.NoError(t, err)
+	ks, err := NewSecretStoreKeyStore(store, nil, false)
+	require.NoError(t, err)
+
+	_, privKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	key := &ed25519PrivateKey{privKey}
+
+	err = ks.StoreKey(key)
+	assert.NoError(t, err)
+	loaded, err := ks.GetKey(key.SKI())
+	assert.NoError(t, err)
+	assert.Equal(t, key, loaded)
+}
+
+func TestSecretStoreKeyStoreErrors(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "secretstoreks")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = NewSecretStoreKeyStore(nil, nil, false)
+	assert.EqualError(t, err, "invalid secret store, it must not be nil")
+
+	_, err = NewFileSecretStore("")
+	assert.EqualError(t, err, "invalid secret store directory, it cannot be an empty string")
+
+	store, err := NewFileSecretStore(dir)
+	require.NoError(t, err)
+
+	ks, err := NewSecretStoreKeyStore(store, nil, true)
+	require.NoError(t, err)
+	assert.True(t, ks.ReadOnly())
+
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	err = ks.StoreKey(&ecdsaPrivateKey{privKey})
+	assert.EqualError(t, err, "read only KeyStore")
+
+	_, err = ks.GetKey(nil)
+	assert.EqualError(t, err, "invalid SKI, it cannot be of zero length")
+
+	_, err = ks.GetKey([]byte{1, 2, 3})
+	assert.EqualError(t, err, "key with SKI 010203 not found in the secret store")
+
+	err = ioutil.WriteFile(filepath.Join(dir, "010203_sk"), []byte("not a key"), 0600)
+	require.NoError(t, err)
+	_, err = ks.GetKey([]byte{1, 2, 3})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed parsing key [010203]")
+
+	ks, err = NewSecretStoreKeyStore(store, nil, false)
+	require.NoError(t, err)
+	err = ks.StoreKey(nil)
+	assert.EqualError(t, err, "invalid key, it must not be nil")
+
+	_, err = store.GetSecret("../secret")
+	assert.EqualError(t, err, "invalid secret name [../secret]")
+	err = store.PutSecret("..", nil)
+	assert.EqualError(t, err, "invalid secret name [..]")
+}
@@ -0,0 +1,50 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sw
+
+import (
+	"crypto/rand"
+	"fmt"
+
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/gm"
+	"github.com/hyperledger/fabric/bccsp/utils"
+)
+
+// signSM2 signs digest with k. SM2 signs the SM3 hash of the Z value
+// of the public key followed by the message, hence callers pass the
+// digest returned by gm.Digest.
+func signSM2(k *gm.PrivateKey, digest []byte, opts bccsp.SignerOpts) ([]byte, error) {
+	return gm.SignASN1(rand.Reader, k, digest)
+}
+
+func verifySM2(k *gm.PublicKey, signature, digest []byte, opts bccsp.SignerOpts) (bool, error) {
+	r, s, err := utils.UnmarshalECDSASignature(signature)
+	if err != nil {
+		return false, fmt.Errorf("Failed unmashalling signature [%s]", err)
+	}
+
+	return gm.Verify(k, digest, r, s), nil
+}
+
+type sm2Signer struct{}
+
+func (s *sm2Signer) Sign(k bccsp.Key, digest []byte, opts bccsp.SignerOpts) ([]byte, error) {
+	return signSM2(k.(*sm2PrivateKey).privKey, digest, opts)
+}
+
+type sm2PrivateKeyVerifier struct{}
+
+func (v *sm2PrivateKeyVerifier) Verify(k bccsp.Key, signature, digest []byte, opts bccsp.SignerOpts) (bool, error) {
+	return verifySM2(&(k.(*sm2PrivateKey).privKey.PublicKey), signature, digest, opts)
+}
+
+type sm2PublicKeyKeyVerifier struct{}
+
+func (v *sm2PublicKeyKeyVerifier) Verify(k bccsp.Key, signature, digest []byte, opts bccsp.SignerOpts) (bool, error) {
+	return verifySM2(k.(*sm2PublicKey).pubKey, signature, digest, opts)
+}
@@ -0,0 +1,194 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sw
+
+import (
+	"crypto/rand"
+	"encoding/pem"
+	"io/ioutil"
+	"testing"
+
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/gm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifySM2(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := gm.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	digest, err := gm.Digest(&privKey.PublicKey, gm.DefaultUID, []byte("hello world"))
+	assert.NoError(t, err)
+	sigma, err := signSM2(privKey, digest, nil)
+	assert.NoError(t, err)
+
+	valid, err := verifySM2(&privKey.PublicKey, sigma, digest, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = verifySM2(&privKey.PublicKey, sigma, digest[1:], nil)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	_, err = verifySM2(&privKey.PublicKey, sigma[1:], digest, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Failed unmashalling signature")
+}
+
+func TestSM2KeyGen(t *testing.T) {
+	t.Parallel()
+	provider, _, cleanup := currentTestConfig.Provider(t)
+	defer cleanup()
+
+	k, err := provider.KeyGen(&bccsp.SM2KeyGenOpts{Temporary: false})
+	assert.NoError(t, err)
+	assert.True(t, k.Private())
+	assert.False(t, k.Symmetric())
+	_, err = k.Bytes()
+	assert.Error(t, err)
+
+	// The private key is retrieved from the key store
+	k2, err := provider.GetKey(k.SKI())
+	assert.NoError(t, err)
+	assert.True(t, k2.Private())
+	assert.Equal(t, k.SKI(), k2.SKI())
+	assert.IsType(t, &sm2PrivateKey{}, k2)
+
+	pk, err := k.PublicKey()
+	assert.NoError(t, err)
+	assert.False(t, pk.Private())
+	assert.False(t, pk.Symmetric())
+	assert.Equal(t, k.SKI(), pk.SKI())
+	pkRaw, err := pk.Bytes()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, pkRaw)
+}
+
+func TestSM2SignVerify(t *testing.T) {
+	t.Parallel()
+	provider, ks, cleanup := currentTestConfig.Provider(t)
+	defer cleanup()
+
+	k, err := provider.KeyGen(&bccsp.SM2KeyGenOpts{Temporary: true})
+	assert.NoError(t, err)
+	pk, err := k.PublicKey()
+	assert.NoError(t, err)
+
+	// SM2 signs the SM3 hash of the Z value of the key followed by the message
+	pkRaw, err := pk.Bytes()
+	assert.NoError(t, err)
+	pubKey, err := gm.ParsePKIXPublicKey(pkRaw)
+	assert.NoError(t, err)
+	za, err := gm.ZA(pubKey, gm.DefaultUID)
+	assert.NoError(t, err)
+	digest, err := provider.Hash(append(za, []byte("Hello World")...), &bccsp.SM3Opts{})
+	assert.NoError(t, err)
+
+	signature, err := provider.Sign(k, digest, nil)
+	assert.NoError(t, err)
+
+	valid, err := provider.Verify(k, signature, digest, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = provider.Verify(pk, signature, digest, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+	assert.True(t, gm.VerifyASN1(pubKey, digest, signature))
+
+	// Store the public key, and verify with the one retrieved from the key store
+	err = ks.StoreKey(pk)
+	assert.NoError(t, err)
+	pk2, err := ks.GetKey(pk.SKI())
+	assert.NoError(t, err)
+	valid, err = provider.Verify(pk2, signature, digest, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	otherDigest, err := provider.Hash([]byte("Hello World"), &bccsp.SM3Opts{})
+	assert.NoError(t, err)
+	valid, err = provider.Verify(pk, signature, otherDigest, nil)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+}
+
+func TestSM2KeyImport(t *testing.T) {
+	t.Parallel()
+	provider, _, cleanup := currentTestConfig.Provider(t)
+	defer cleanup()
+
+	privKey, err := gm.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	digest, err := gm.Digest(&privKey.PublicKey, gm.DefaultUID, []byte("Hello World"))
+	assert.NoError(t, err)
+	signature, err := gm.SignASN1(rand.Reader, privKey, digest)
+	assert.NoError(t, err)
+
+	pkixRaw, err := gm.MarshalPKIXPublicKey(&privKey.PublicKey)
+	assert.NoError(t, err)
+	pk, err := provider.KeyImport(pkixRaw, &bccsp.SM2PKIXPublicKeyImportOpts{Temporary: true})
+	assert.NoError(t, err)
+	valid, err := provider.Verify(pk, signature, digest, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	pk, err = provider.KeyImport(&privKey.PublicKey, &bccsp.SM2GoPublicKeyImportOpts{Temporary: true})
+	assert.NoError(t, err)
+	valid, err = provider.Verify(pk, signature, digest, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	pkcs8Raw, err := gm.MarshalPKCS8PrivateKey(privKey)
+	assert.NoError(t, err)
+	sk, err := provider.KeyImport(pkcs8Raw, &bccsp.SM2PrivateKeyImportOpts{Temporary: true})
+	assert.NoError(t, err)
+	assert.Equal(t, pk.SKI(), sk.SKI())
+	sig, err := provider.Sign(sk, digest, nil)
+	assert.NoError(t, err)
+	assert.True(t, gm.VerifyASN1(&privKey.PublicKey, digest, sig))
+
+	// testdata/cert.pem of the gm package is a certificate with an SM2 key
+	certPEM, err := ioutil.ReadFile("../gm/testdata/cert.pem")
+	require.NoError(t, err)
+	block, _ := pem.Decode(certPEM)
+	require.NotNil(t, block)
+	cert, err := gm.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	pk, err = provider.KeyImport(cert, &bccsp.X509PublicKeyImportOpts{Temporary: true})
+	assert.NoError(t, err)
+	assert.IsType(t, &sm2PublicKey{}, pk)
+
+	_, err = provider.KeyImport([]byte("not a key"), &bccsp.SM2PrivateKeyImportOpts{Temporary: true})
+	assert.Error(t, err)
+	_, err = provider.KeyImport(pkixRaw, &bccsp.SM2PrivateKeyImportOpts{Temporary: true})
+	assert.Error(t, err)
+	_, err = provider.KeyImport(privKey, &bccsp.SM2GoPublicKeyImportOpts{Temporary: true})
+	assert.Contains(t, err.Error(), "Invalid raw material. Expected *gm.PublicKey.")
+}
+
+func TestSM3HashFamily(t *testing.T) {
+	t.Parallel()
+
+	csp, err := NewWithParams(256, "SM3", NewDummyKeyStore())
+	assert.NoError(t, err)
+
+	msg := []byte("abc")
+	expected := gm.SumSM3(msg)
+	digest, err := csp.Hash(msg, &bccsp.SHAOpts{})
+	assert.NoError(t, err)
+	assert.Equal(t, expected[:], digest)
+	digest, err = csp.Hash(msg, &bccsp.SM3Opts{})
+	assert.NoError(t, err)
+	assert.Equal(t, expected[:], digest)
+
+	_, err = NewWithParams(384, "SM3", NewDummyKeyStore())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Security level not supported [384]")
+}
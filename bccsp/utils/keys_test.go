@@ -18,6 +18,7 @@ package utils
 
 import (
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
@@ -26,6 +27,7 @@ import (
 	"encoding/pem"
 	"testing"
 
+	"github.com/hyperledger/fabric/bccsp/gm"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -318,6 +320,59 @@ func TestECDSAKeys(t *testing.T) {
 	}
 }
 
+func TestED25519Keys(t *testing.T) {
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	pemBytes, err := PrivateKeyToPEM(privKey, nil)
+	assert.NoError(t, err)
+	key, err := PEMtoPrivateKey(pemBytes, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, privKey, key)
+
+	pemBytes, err = PublicKeyToPEM(pubKey, nil)
+	assert.NoError(t, err)
+	pub, err := PEMtoPublicKey(pemBytes, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, pubKey, pub)
+
+	der, err := PublicKeyToDER(pubKey)
+	assert.NoError(t, err)
+	pub, err = DERToPublicKey(der)
+	assert.NoError(t, err)
+	assert.Equal(t, pubKey, pub)
+}
+
+func TestSM2Keys(t *testing.T) {
+	privKey, err := gm.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	pemBytes, err := PrivateKeyToPEM(privKey, nil)
+	assert.NoError(t, err)
+	key, err := PEMtoPrivateKey(pemBytes, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, privKey, key)
+
+	// SM2 keys are also read in SEC 1 form
+	der, err := gm.MarshalECPrivateKey(privKey)
+	assert.NoError(t, err)
+	key, err = DERToPrivateKey(der)
+	assert.NoError(t, err)
+	assert.Equal(t, privKey, key)
+
+	pemBytes, err = PublicKeyToPEM(&privKey.PublicKey, nil)
+	assert.NoError(t, err)
+	pub, err := PEMtoPublicKey(pemBytes, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &privKey.PublicKey, pub)
+
+	der, err = PublicKeyToDER(&privKey.PublicKey)
+	assert.NoError(t, err)
+	pub, err = DERToPublicKey(der)
+	assert.NoError(t, err)
+	assert.Equal(t, &privKey.PublicKey, pub)
+}
+
 func TestAESKey(t *testing.T) {
 	k := []byte{0, 1, 2, 3, 4, 5}
 	pem := AEStoPEM(k)
@@ -0,0 +1,209 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package vault
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/fabric/bccsp/sw"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultMountPath is the mount path of the KV secrets engine used
+	// when none is configured
+	DefaultMountPath = "secret"
+
+	// TokenEnvVar is the environment variable the Vault token is read
+	// from when none is configured
+	TokenEnvVar = "VAULT_TOKEN"
+
+	defaultTimeout = 10 * time.Second
+)
+
+// Config contains the options of a Vault secret store.
+type Config struct {
+	// Address is the URL of the Vault server, e.g. https://vault:8200
+	Address string
+	// Token authenticates to Vault. If empty, it is read from VAULT_TOKEN
+	Token string
+	// MountPath is where the KV version 2 secrets engine is mounted
+	MountPath string
+	// Path is the path under the mount the secrets are stored at
+	Path string
+	// RootCert is a file with the PEM encoded CA certificates
+	// used to verify the Vault server's TLS certificate
+	RootCert string
+	// Timeout bounds each request to Vault
+	Timeout time.Duration
+}
+
+// SecretStore is a sw.SecretStore backed by the KV version 2
+// secrets engine of a HashiCorp Vault server. Each secret is
+// kept base64 encoded in the 'value' field of a Vault secret.
+type SecretStore struct {
+	address   string
+	token     string
+	mountPath string
+	path      string
+	client    *http.Client
+}
+
+// NewSecretStore returns a SecretStore for the given configuration.
+func NewSecretStore(conf Config) (*SecretStore, error) {
+	if conf.Address == "" {
+		return nil, errors.New("invalid vault configuration: the address cannot be empty")
+	}
+	if _, err := url.Parse(conf.Address); err != nil {
+		return nil, errors.Wrap(err, "invalid vault configuration: bad address")
+	}
+
+	token := conf.Token
+	if token == "" {
+		token = os.Getenv(TokenEnvVar)
+	}
+	if token == "" {
+		return nil, errors.Errorf("invalid vault configuration: no token is configured and %s is not set", TokenEnvVar)
+	}
+
+	mountPath := strings.Trim(conf.MountPath, "/")
+	if mountPath == "" {
+		mountPath = DefaultMountPath
+	}
+
+	timeout := conf.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if conf.RootCert != "" {
+		pem, err := ioutil.ReadFile(conf.RootCert)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed reading vault root certificate %s", conf.RootCert)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("no certificates found in vault root certificate %s", conf.RootCert)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	}
+
+	return &SecretStore{
+		address:   strings.TrimRight(conf.Address, "/"),
+		token:     token,
+		mountPath: mountPath,
+		path:      strings.Trim(conf.Path, "/"),
+		client:    &http.Client{Transport: transport, Timeout: timeout},
+	}, nil
+}
+
+type secretData struct {
+	Value string `json:"value"`
+}
+
+type readResponse struct {
+	Data struct {
+		Data secretData `json:"data"`
+	} `json:"data"`
+}
+
+type writeRequest struct {
+	Data secretData `json:"data"`
+}
+
+// GetSecret returns the secret with the given name,
+// or sw.ErrSecretNotFound if there is none.
+func (s *SecretStore) GetSecret(name string) ([]byte, error) {
+	req, err := s.newRequest(http.MethodGet, name, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed reading secret [%s] from vault", name)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, sw.ErrSecretNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed reading secret [%s] from vault: %s", name, resp.Status)
+	}
+
+	var secret readResponse
+	if err := json.NewDecoder(resp.Body).Decode(&secret); err != nil {
+		return nil, errors.Wrapf(err, "failed decoding secret [%s] read from vault", name)
+	}
+	if secret.Data.Data.Value == "" {
+		return nil, errors.Errorf("secret [%s] read from vault has no value", name)
+	}
+
+	value, err := base64.StdEncoding.DecodeString(secret.Data.Data.Value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed decoding secret [%s] read from vault", name)
+	}
+	return value, nil
+}
+
+// PutSecret stores the given secret under the given name.
+func (s *SecretStore) PutSecret(name string, secret []byte) error {
+	body, err := json.Marshal(&writeRequest{
+		Data: secretData{Value: base64.StdEncoding.EncodeToString(secret)},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed encoding secret [%s]", name)
+	}
+
+	req, err := s.newRequest(http.MethodPost, name, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed writing secret [%s] to vault", name)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return errors.Errorf("failed writing secret [%s] to vault: %s", name, resp.Status)
+	}
+	return nil
+}
+
+func (s *SecretStore) newRequest(method, name string, body []byte) (*http.Request, error) {
+	if err := sw.ValidSecretName(name); err != nil {
+		return nil, err
+	}
+
+	elements := []string{s.address, "v1", s.mountPath, "data"}
+	if s.path != "" {
+		elements = append(elements, s.path)
+	}
+	elements = append(elements, name)
+
+	req, err := http.NewRequest(method, strings.Join(elements, "/"), bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed creating vault request for secret [%s]", name)
+	}
+	req.Header.Set("X-Vault-Token", s.token)
+	return req, nil
+}
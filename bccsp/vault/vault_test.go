@@ -0,0 +1,183 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package vault
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/sw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeVault mimics the KV version 2 secrets engine of a Vault server
+type fakeVault struct {
+	mutex   sync.Mutex
+	token   string
+	secrets map[string]string
+}
+
+func (v *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	if r.Header.Get("X-Vault-Token") != v.token {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		value, ok := v.secrets[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"data":{"data":{"value":%q},"metadata":{"version":1}}}`, value)
+	case http.MethodPost:
+		var req writeRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		v.secrets[r.URL.Path] = req.Data.Value
+		w.Write([]byte(`{"data":{"version":1}}`))
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestSecretStore(t *testing.T) {
+	vault := &fakeVault{token: "s.token", secrets: map[string]string{}}
+	server := httptest.NewServer(vault)
+	defer server.Close()
+
+	store, err := NewSecretStore(Config{
+		Address:   server.URL,
+		Token:     "s.token",
+		MountPath: "/kv/",
+		Path:      "fabric/peer0",
+	})
+	require.NoError(t, err)
+
+	_, err = store.GetSecret("foo_sk")
+	assert.Equal(t, sw.ErrSecretNotFound, err)
+
+	err = store.PutSecret("foo_sk", []byte("secret"))
+	assert.NoError(t, err)
+	assert.Contains(t, vault.secrets, "/v1/kv/data/fabric/peer0/foo_sk")
+
+	secret, err := store.GetSecret("foo_sk")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("secret"), secret)
+
+	_, err = store.GetSecret("../foo_sk")
+	assert.EqualError(t, err, "invalid secret name [../foo_sk]")
+
+	vault.mutex.Lock()
+	vault.token = "s.other"
+	vault.mutex.Unlock()
+	_, err = store.GetSecret("foo_sk")
+	assert.EqualError(t, err, "failed reading secret [foo_sk] from vault: 403 Forbidden")
+	err = store.PutSecret("foo_sk", []byte("secret"))
+	assert.EqualError(t, err, "failed writing secret [foo_sk] to vault: 403 Forbidden")
+}
+
+func TestSecretStoreKeyStore(t *testing.T) {
+	vault := &fakeVault{token: "s.token", secrets: map[string]string{}}
+	server := httptest.NewServer(vault)
+	defer server.Close()
+
+	store, err := NewSecretStore(Config{Address: server.URL, Token: "s.token"})
+	require.NoError(t, err)
+	ks, err := sw.NewSecretStoreKeyStore(store, nil, false)
+	require.NoError(t, err)
+
+	csp, err := sw.NewWithParams(256, "SHA2", ks)
+	require.NoError(t, err)
+	key, err := csp.KeyGen(&bccsp.ECDSAP256KeyGenOpts{Temporary: false})
+	require.NoError(t, err)
+	assert.Len(t, vault.secrets, 1)
+
+	loaded, err := csp.GetKey(key.SKI())
+	assert.NoError(t, err)
+	assert.Equal(t, key, loaded)
+
+	// The key is found even if it was never generated by this instance
+	csp, err = sw.NewWithParams(256, "SHA2", ks)
+	require.NoError(t, err)
+	loaded, err = csp.GetKey(key.SKI())
+	assert.NoError(t, err)
+	assert.Equal(t, key.SKI(), loaded.SKI())
+	assert.True(t, loaded.Private())
+}
+
+func TestNewSecretStore(t *testing.T) {
+	_, err := NewSecretStore(Config{})
+	assert.EqualError(t, err, "invalid vault configuration: the address cannot be empty")
+
+	os.Unsetenv(TokenEnvVar)
+	_, err = NewSecretStore(Config{Address: "http://vault:8200"})
+	assert.EqualError(t, err, "invalid vault configuration: no token is configured and VAULT_TOKEN is not set")
+
+	os.Setenv(TokenEnvVar, "s.token")
+	defer os.Unsetenv(TokenEnvVar)
+	store, err := NewSecretStore(Config{Address: "http://vault:8200/"})
+	assert.NoError(t, err)
+	assert.Equal(t, "s.token", store.token)
+	assert.Equal(t, DefaultMountPath, store.mountPath)
+	assert.Equal(t, "http://vault:8200", store.address)
+
+	_, err = NewSecretStore(Config{Address: "https://vault:8200", RootCert: "nonexistent.pem"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed reading vault root certificate nonexistent.pem")
+
+	dir, err := ioutil.TempDir("", "vault")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	notACert := filepath.Join(dir, "cert.pem")
+	err = ioutil.WriteFile(notACert, []byte("not a certificate"), 0644)
+	require.NoError(t, err)
+	_, err = NewSecretStore(Config{Address: "https://vault:8200", RootCert: notACert})
+	assert.EqualError(t, err, "no certificates found in vault root certificate "+notACert)
+}
+
+func TestSecretStoreTLS(t *testing.T) {
+	vault := &fakeVault{token: "s.token", secrets: map[string]string{}}
+	server := httptest.NewTLSServer(vault)
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "vault")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// Without the root certificate, the server is not trusted
+	store, err := NewSecretStore(Config{Address: server.URL, Token: "s.token"})
+	require.NoError(t, err)
+	err = store.PutSecret("foo_sk", []byte("secret"))
+	assert.Error(t, err)
+
+	rootCert := filepath.Join(dir, "cert.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	err = ioutil.WriteFile(rootCert, certPEM, 0644)
+	require.NoError(t, err)
+
+	store, err = NewSecretStore(Config{Address: server.URL, Token: "s.token", RootCert: rootCert})
+	require.NoError(t, err)
+	err = store.PutSecret("foo_sk", []byte("secret"))
+	assert.NoError(t, err)
+}
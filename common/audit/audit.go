@@ -0,0 +1,211 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package audit
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/pkg/errors"
+)
+
+var logger = flogging.MustGetLogger("audit")
+
+// The types of the events recorded in the audit log.
+const (
+	AdminOperation   = "admin_operation"
+	ChannelJoin      = "channel_join"
+	ChaincodeInstall = "chaincode_install"
+	ChaincodeApprove = "chaincode_approve"
+	ACLDenied        = "acl_denied"
+)
+
+// Outcome is the result of the action of an event.
+type Outcome string
+
+// The outcomes of the actions of the events.
+const (
+	Success Outcome = "success"
+	Failure Outcome = "failure"
+	Denied  Outcome = "denied"
+)
+
+// Event is a security relevant event.
+type Event struct {
+	// Type is the type of the event, such as ChannelJoin
+	Type string `json:"type"`
+	// Outcome is the result of the action of the event
+	Outcome Outcome `json:"outcome"`
+	// Channel is the channel the event pertains to, if any
+	Channel string `json:"channel,omitempty"`
+	// Identity identifies the client which triggered the event, if known
+	Identity string `json:"identity,omitempty"`
+	// Details holds the attributes specific to the type of the event
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// Record is an event recorded in the audit log. The records are chained by
+// their hashes, so that removing or altering a record is detected when the
+// log is verified.
+type Record struct {
+	Event
+	// Sequence is the position of the record in the log
+	Sequence uint64 `json:"sequence"`
+	// Timestamp is the time the event was recorded at
+	Timestamp time.Time `json:"timestamp"`
+	// PrevHash is the hash of the previous record in the log
+	PrevHash []byte `json:"prev_hash,omitempty"`
+	// Hash is the hash of the record, computed over all the other fields
+	Hash []byte `json:"hash"`
+}
+
+// computeHash returns the SHA256 hash of the JSON encoding of the record
+// without its hash.
+func (r *Record) computeHash() []byte {
+	unhashed := *r
+	unhashed.Hash = nil
+	encoded, err := json.Marshal(&unhashed)
+	if err != nil {
+		logger.Panicf("failed encoding audit record: %s", err)
+	}
+	hash := sha256.Sum256(encoded)
+	return hash[:]
+}
+
+// Sink stores the records of the audit log.
+type Sink interface {
+	// Write stores the record.
+	Write(record *Record) error
+}
+
+// Logger records events in a hash chained audit log, which it writes to its
+// sinks. A Logger without sinks discards the events.
+type Logger struct {
+	mutex    sync.Mutex
+	sinks    []Sink
+	sequence uint64
+	lastHash []byte
+	now      func() time.Time
+}
+
+// NewLogger creates a Logger writing to the given sinks. If last is not nil,
+// the log is resumed after it, so that the chain of hashes spans the records
+// written by earlier instances of the process.
+func NewLogger(last *Record, sinks ...Sink) *Logger {
+	l := &Logger{
+		sinks: sinks,
+		now:   time.Now,
+	}
+	if last != nil {
+		l.sequence = last.Sequence + 1
+		l.lastHash = last.Hash
+	}
+	return l
+}
+
+// Log records the event. Failures to write the record to a sink are logged
+// to the operational log.
+func (l *Logger) Log(event Event) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if len(l.sinks) == 0 {
+		return
+	}
+
+	record := &Record{
+		Event:     event,
+		Sequence:  l.sequence,
+		Timestamp: l.now().UTC(),
+		PrevHash:  l.lastHash,
+	}
+	record.Hash = record.computeHash()
+
+	for _, sink := range l.sinks {
+		if err := sink.Write(record); err != nil {
+			logger.Errorf("Failed writing audit record %d of type %s: %s", record.Sequence, record.Type, err)
+		}
+	}
+
+	l.sequence++
+	l.lastHash = record.Hash
+}
+
+// Verify reads the records of an audit log from the reader, and checks that
+// they form an unbroken chain of hashes. It returns the last record read,
+// along with the first error found, so that a log can be resumed even if it
+// fails the verification.
+func Verify(r io.Reader) (*Record, error) {
+	var last *Record
+	var verifyErr error
+	decoder := json.NewDecoder(r)
+	for {
+		record := &Record{}
+		err := decoder.Decode(record)
+		if err == io.EOF {
+			return last, verifyErr
+		}
+		if err != nil {
+			return last, errors.Wrapf(err, "failed decoding audit record following record %s", sequenceOf(last))
+		}
+
+		if verifyErr == nil {
+			verifyErr = verifyRecord(last, record)
+		}
+		last = record
+	}
+}
+
+func verifyRecord(prev, record *Record) error {
+	if !bytes.Equal(record.computeHash(), record.Hash) {
+		return errors.Errorf("audit record %d does not match its hash", record.Sequence)
+	}
+	if prev == nil {
+		return nil
+	}
+	if record.Sequence != prev.Sequence+1 {
+		return errors.Errorf("audit record %d follows record %d", record.Sequence, prev.Sequence)
+	}
+	if !bytes.Equal(record.PrevHash, prev.Hash) {
+		return errors.Errorf("audit record %d does not match the hash of record %d", record.Sequence, prev.Sequence)
+	}
+	return nil
+}
+
+func sequenceOf(record *Record) string {
+	if record == nil {
+		return "<none>"
+	}
+	return strconv.FormatUint(record.Sequence, 10)
+}
+
+var (
+	globalMutex sync.RWMutex
+	global      = NewLogger(nil)
+)
+
+// Configure replaces the process wide audit log by a log written to the
+// given sinks, resumed after the last record, if not nil.
+func Configure(last *Record, sinks ...Sink) {
+	globalMutex.Lock()
+	defer globalMutex.Unlock()
+	global = NewLogger(last, sinks...)
+}
+
+// Log records the event in the process wide audit log.
+func Log(event Event) {
+	globalMutex.RLock()
+	l := global
+	globalMutex.RUnlock()
+	l.Log(event)
+}
@@ -0,0 +1,175 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/common/crypto/tlsgen"
+	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type failingSink struct{}
+
+func (failingSink) Write(*Record) error {
+	return errors.New("disk full")
+}
+
+func TestLoggerChainsRecords(t *testing.T) {
+	buf := &bytes.Buffer{}
+	l := NewLogger(nil, NewWriterSink(buf), failingSink{})
+	l.now = func() time.Time { return time.Unix(1000, 0) }
+
+	l.Log(Event{Type: ChannelJoin, Outcome: Success, Channel: "mychannel", Identity: "Org1MSP:CN=admin"})
+	l.Log(Event{Type: ChaincodeInstall, Outcome: Failure, Details: map[string]string{"name": "mycc", "version": "1.0"}})
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	first, second := &Record{}, &Record{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), first))
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), second))
+
+	assert.Equal(t, uint64(0), first.Sequence)
+	assert.Nil(t, first.PrevHash)
+	assert.Equal(t, "mychannel", first.Channel)
+	assert.Equal(t, time.Unix(1000, 0).UTC(), first.Timestamp)
+	assert.Equal(t, uint64(1), second.Sequence)
+	assert.Equal(t, first.Hash, second.PrevHash)
+	assert.Equal(t, map[string]string{"name": "mycc", "version": "1.0"}, second.Details)
+
+	last, err := Verify(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, second, last)
+
+	// the log is resumed after the last record
+	l = NewLogger(last, NewWriterSink(buf))
+	l.Log(Event{Type: ACLDenied, Outcome: Denied})
+	last, err = Verify(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), last.Sequence)
+	assert.Equal(t, ACLDenied, last.Type)
+}
+
+func TestLoggerWithoutSinks(t *testing.T) {
+	l := NewLogger(nil)
+	l.Log(Event{Type: ChannelJoin, Outcome: Success})
+	assert.Equal(t, uint64(0), l.sequence)
+}
+
+func TestVerifyDetectsTampering(t *testing.T) {
+	newLog := func() []string {
+		buf := &bytes.Buffer{}
+		l := NewLogger(nil, NewWriterSink(buf))
+		for _, channel := range []string{"a", "b", "c"} {
+			l.Log(Event{Type: ChannelJoin, Outcome: Success, Channel: channel})
+		}
+		return strings.SplitAfter(buf.String(), "\n")
+	}
+
+	tests := []struct {
+		desc        string
+		tamper      func(lines []string) []string
+		expectedErr string
+	}{
+		{
+			desc: "altered record",
+			tamper: func(lines []string) []string {
+				lines[1] = strings.Replace(lines[1], `"channel":"b"`, `"channel":"x"`, 1)
+				return lines
+			},
+			expectedErr: "audit record 1 does not match its hash",
+		},
+		{
+			desc: "removed record",
+			tamper: func(lines []string) []string {
+				return append(lines[:1], lines[2:]...)
+			},
+			expectedErr: "audit record 2 follows record 0",
+		},
+		{
+			desc: "malformed record",
+			tamper: func(lines []string) []string {
+				return append(lines[:2], "{garbage\n")
+			},
+			expectedErr: "failed decoding audit record following record 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			lines := tt.tamper(newLog())
+			_, err := Verify(strings.NewReader(strings.Join(lines, "")))
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	path := filepath.Join(tempDir, "audit", "audit.log")
+
+	last, err := VerifyFile(path)
+	assert.NoError(t, err)
+	assert.Nil(t, last)
+
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+	NewLogger(nil, sink).Log(Event{Type: AdminOperation, Outcome: Success})
+	require.NoError(t, sink.Close())
+
+	last, err = VerifyFile(path)
+	require.NoError(t, err)
+	require.NotNil(t, last)
+
+	sink, err = NewFileSink(path)
+	require.NoError(t, err)
+	defer sink.Close()
+	NewLogger(last, sink).Log(Event{Type: AdminOperation, Outcome: Denied})
+
+	last, err = VerifyFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), last.Sequence)
+	assert.Equal(t, Denied, last.Outcome)
+
+	_, err = NewFileSink(filepath.Join(path, "audit.log"))
+	assert.Error(t, err)
+}
+
+func TestGlobalLog(t *testing.T) {
+	defer Configure(nil)
+
+	buf := &bytes.Buffer{}
+	Configure(nil, NewWriterSink(buf))
+	Log(Event{Type: ChaincodeApprove, Outcome: Success, Channel: "mychannel"})
+	last, err := Verify(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, ChaincodeApprove, last.Type)
+}
+
+func TestIdentity(t *testing.T) {
+	ca, err := tlsgen.NewCA()
+	require.NoError(t, err)
+
+	assert.Equal(t, "", Identity(nil))
+	assert.Equal(t, "", Identity([]byte("garbage")))
+	assert.Equal(t, "Org1MSP", Identity(protoutil.MarshalOrPanic(&msp.SerializedIdentity{Mspid: "Org1MSP", IdBytes: []byte("not a certificate")})))
+	assert.Regexp(t, "^Org1MSP:.+", Identity(protoutil.MarshalOrPanic(&msp.SerializedIdentity{Mspid: "Org1MSP", IdBytes: ca.CertBytes()})))
+}
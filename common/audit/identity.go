@@ -0,0 +1,74 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package audit
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/msp"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protoutil"
+)
+
+// Identity returns the MSP ID and the subject of the certificate of the
+// serialized identity, separated by a colon, for the Identity of an Event.
+// Only the MSP ID is returned if the certificate cannot be parsed.
+func Identity(serializedIdentity []byte) string {
+	if len(serializedIdentity) == 0 {
+		return ""
+	}
+	sID := &msp.SerializedIdentity{}
+	if err := proto.Unmarshal(serializedIdentity, sID); err != nil {
+		return ""
+	}
+	block, _ := pem.Decode(sID.IdBytes)
+	if block == nil {
+		return sID.Mspid
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return sID.Mspid
+	}
+	return sID.Mspid + ":" + cert.Subject.String()
+}
+
+// ProposalIdentity returns the Identity of the creator of the signed
+// proposal, or an empty string if it cannot be extracted.
+func ProposalIdentity(signedProp *pb.SignedProposal) string {
+	if signedProp == nil {
+		return ""
+	}
+	proposal, err := protoutil.GetProposal(signedProp.ProposalBytes)
+	if err != nil {
+		return ""
+	}
+	header, err := protoutil.GetHeader(proposal.Header)
+	if err != nil {
+		return ""
+	}
+	shdr, err := protoutil.GetSignatureHeader(header.SignatureHeader)
+	if err != nil {
+		return ""
+	}
+	return Identity(shdr.Creator)
+}
+
+// EnvelopeIdentity returns the Identity of the creator of the envelope, or
+// an empty string if it cannot be extracted.
+func EnvelopeIdentity(env *common.Envelope) string {
+	if env == nil {
+		return ""
+	}
+	sd, err := protoutil.EnvelopeAsSignedData(env)
+	if err != nil || len(sd) == 0 {
+		return ""
+	}
+	return Identity(sd[0].Identity)
+}
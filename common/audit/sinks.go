@@ -0,0 +1,91 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package audit
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// WriterSink writes the records to a writer, one JSON object per line.
+type WriterSink struct {
+	mutex  sync.Mutex
+	writer io.Writer
+}
+
+// NewWriterSink creates a WriterSink writing to the given writer.
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{writer: w}
+}
+
+// Write writes the record as a line of JSON.
+func (s *WriterSink) Write(record *Record) error {
+	encoded, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "failed encoding audit record")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	_, err = s.writer.Write(append(encoded, '\n'))
+	return err
+}
+
+// FileSink appends the records to a file, one JSON object per line, and
+// syncs the file after each record.
+type FileSink struct {
+	*WriterSink
+	file *os.File
+}
+
+// NewFileSink opens the file at the given path for appending, creating it
+// along with its directory if it does not exist.
+func NewFileSink(path string) (*FileSink, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return nil, errors.Wrapf(err, "failed creating directory of audit log %s", path)
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0640)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed opening audit log %s", path)
+	}
+	return &FileSink{
+		WriterSink: NewWriterSink(file),
+		file:       file,
+	}, nil
+}
+
+// Write appends the record to the file.
+func (s *FileSink) Write(record *Record) error {
+	if err := s.WriterSink.Write(record); err != nil {
+		return err
+	}
+	return s.file.Sync()
+}
+
+// Close closes the file.
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
+
+// VerifyFile verifies the audit log in the file at the given path with
+// Verify. A file which does not exist is an empty log.
+func VerifyFile(path string) (*Record, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed opening audit log %s", path)
+	}
+	defer file.Close()
+	return Verify(file)
+}
@@ -19,6 +19,9 @@ const (
 
 	// ChannelV1_3 is the capabilties string for standard new non-backwards compatible fabric v1.3 channel capabilities.
 	ChannelV1_3 = "V1_3"
+
+	// ChannelV2_0 is the capabilties string for standard new non-backwards compatible fabric v2.0 channel capabilities.
+	ChannelV2_0 = "V2_0"
 )
 
 // ChannelProvider provides capabilities information for channel level config.
@@ -26,6 +29,7 @@ type ChannelProvider struct {
 	*registry
 	v11 bool
 	v13 bool
+	v20 bool
 }
 
 // NewChannelProvider creates a channel capabilities provider.
@@ -34,6 +38,7 @@ func NewChannelProvider(capabilities map[string]*cb.Capability) *ChannelProvider
 	cp.registry = newRegistry(cp, capabilities)
 	_, cp.v11 = capabilities[ChannelV1_1]
 	_, cp.v13 = capabilities[ChannelV1_3]
+	_, cp.v20 = capabilities[ChannelV2_0]
 	return cp
 }
 
@@ -46,6 +51,8 @@ func (cp *ChannelProvider) Type() string {
 func (cp *ChannelProvider) HasCapability(capability string) bool {
 	switch capability {
 	// Add new capability names here
+	case ChannelV2_0:
+		return true
 	case ChannelV1_3:
 		return true
 	case ChannelV1_1:
@@ -58,6 +65,8 @@ func (cp *ChannelProvider) HasCapability(capability string) bool {
 // MSPVersion returns the level of MSP support required by this channel.
 func (cp *ChannelProvider) MSPVersion() msp.MSPVersion {
 	switch {
+	case cp.v20:
+		return msp.MSPv2_0
 	case cp.v13:
 		return msp.MSPv1_3
 	case cp.v11:
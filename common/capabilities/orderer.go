@@ -23,13 +23,20 @@ const (
 	// consensus-type migration commands. Migration is supported from Kafka to Raft only.
 	// If not present, these config updates will be rejected.
 	OrdererV2_0 = "V2_0"
+
+	// OrdererExactMessageSize is the capabilities string for measuring messages by their marshaled
+	// size when cutting batches and enforcing bandwidth quotas, as the size filter does against
+	// AbsoluteMaxBytes. Without it, only the payload and signature of messages are counted.
+	// All orderers of a channel must support it before it is enabled, or they would cut different blocks.
+	OrdererExactMessageSize = "V2_0_EXACT_MESSAGE_SIZE"
 )
 
 // OrdererProvider provides capabilities information for orderer level config.
 type OrdererProvider struct {
 	*registry
-	v11BugFixes bool
-	V20         bool
+	v11BugFixes      bool
+	V20              bool
+	exactMessageSize bool
 }
 
 // NewOrdererProvider creates an orderer capabilities provider.
@@ -38,6 +45,7 @@ func NewOrdererProvider(capabilities map[string]*cb.Capability) *OrdererProvider
 	cp.registry = newRegistry(cp, capabilities)
 	_, cp.v11BugFixes = capabilities[OrdererV1_1]
 	_, cp.V20 = capabilities[OrdererV2_0]
+	_, cp.exactMessageSize = capabilities[OrdererExactMessageSize]
 	return cp
 }
 
@@ -54,6 +62,8 @@ func (cp *OrdererProvider) HasCapability(capability string) bool {
 		return true
 	case OrdererV2_0:
 		return true
+	case OrdererExactMessageSize:
+		return true
 	default:
 		return false
 	}
@@ -87,3 +97,21 @@ func (cp *OrdererProvider) Kafka2RaftMigration() bool {
 func (cp *OrdererProvider) UseChannelCreationPolicyAsAdmins() bool {
 	return cp.V20
 }
+
+// OrgEndpoints specifies whether the orderer orgs may define the endpoints of
+// their ordering service nodes.
+func (cp *OrdererProvider) OrgEndpoints() bool {
+	return cp.V20
+}
+
+// ExactMessageSize specifies whether messages are measured by their marshaled size
+// when cutting batches, rather than by the size of their payload and signature.
+func (cp *OrdererProvider) ExactMessageSize() bool {
+	return cp.exactMessageSize
+}
+
+// ChannelDecommission specifies whether a channel may be decommissioned by all the
+// orderers serving it through its config.
+func (cp *OrdererProvider) ChannelDecommission() bool {
+	return cp.V20
+}
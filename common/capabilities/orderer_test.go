@@ -21,6 +21,9 @@ func TestOrdererV10(t *testing.T) {
 	assert.False(t, op.Resubmission())
 	assert.False(t, op.ExpirationCheck())
 	assert.False(t, op.Kafka2RaftMigration())
+	assert.False(t, op.OrgEndpoints())
+	assert.False(t, op.ChannelDecommission())
+	assert.False(t, op.ExactMessageSize())
 }
 
 func TestOrdererV11(t *testing.T) {
@@ -32,6 +35,9 @@ func TestOrdererV11(t *testing.T) {
 	assert.True(t, op.Resubmission())
 	assert.True(t, op.ExpirationCheck())
 	assert.False(t, op.Kafka2RaftMigration())
+	assert.False(t, op.OrgEndpoints())
+	assert.False(t, op.ChannelDecommission())
+	assert.False(t, op.ExactMessageSize())
 }
 
 func TestOrdererV20(t *testing.T) {
@@ -44,6 +50,19 @@ func TestOrdererV20(t *testing.T) {
 	assert.True(t, op.Resubmission())
 	assert.True(t, op.ExpirationCheck())
 	assert.True(t, op.Kafka2RaftMigration())
+	assert.True(t, op.OrgEndpoints())
+	assert.True(t, op.ChannelDecommission())
+	assert.False(t, op.ExactMessageSize())
+}
+
+func TestOrdererExactMessageSize(t *testing.T) {
+	op := NewOrdererProvider(map[string]*cb.Capability{
+		OrdererV2_0:             {},
+		OrdererExactMessageSize: {},
+	})
+	assert.NoError(t, op.Supported())
+	assert.True(t, op.OrgEndpoints())
+	assert.True(t, op.ExactMessageSize())
 }
 
 func TestNotSuported(t *testing.T) {
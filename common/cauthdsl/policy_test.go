@@ -59,7 +59,7 @@ func TestAccept(t *testing.T) {
 		Policies: map[string]*cb.ConfigPolicy{
 			policyID: {Policy: acceptAllPolicy},
 		},
-	})
+	}, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, m)
 
@@ -75,7 +75,7 @@ func TestReject(t *testing.T) {
 		Policies: map[string]*cb.ConfigPolicy{
 			policyID: {Policy: rejectAllPolicy},
 		},
-	})
+	}, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, m)
 	policy, ok := m.GetPolicy(policyID)
@@ -85,7 +85,7 @@ func TestReject(t *testing.T) {
 }
 
 func TestRejectOnUnknown(t *testing.T) {
-	m, err := policies.NewManagerImpl("test", providerMap(), &cb.ConfigGroup{})
+	m, err := policies.NewManagerImpl("test", providerMap(), &cb.ConfigGroup{}, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, m)
 	policy, ok := m.GetPolicy("FakePolicyID")
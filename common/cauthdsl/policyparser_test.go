@@ -109,6 +109,29 @@ func TestAndClientPeerOrderer(t *testing.T) {
 
 }
 
+func TestOrderer(t *testing.T) {
+	p1, err := FromString("OR('A.orderer', 'B.peer')")
+	assert.NoError(t, err)
+
+	principals := make([]*msp.MSPPrincipal, 0)
+
+	principals = append(principals, &msp.MSPPrincipal{
+		PrincipalClassification: msp.MSPPrincipal_ROLE,
+		Principal:               protoutil.MarshalOrPanic(&msp.MSPRole{Role: msp.MSPRole_ORDERER, MspIdentifier: "A"})})
+
+	principals = append(principals, &msp.MSPPrincipal{
+		PrincipalClassification: msp.MSPPrincipal_ROLE,
+		Principal:               protoutil.MarshalOrPanic(&msp.MSPRole{Role: msp.MSPRole_PEER, MspIdentifier: "B"})})
+
+	p2 := &common.SignaturePolicyEnvelope{
+		Version:    0,
+		Rule:       Or(SignedBy(0), SignedBy(1)),
+		Identities: principals,
+	}
+
+	assert.True(t, reflect.DeepEqual(p1, p2))
+}
+
 func TestOr(t *testing.T) {
 	p1, err := FromString("OR('A.member', 'B.member')")
 	assert.NoError(t, err)
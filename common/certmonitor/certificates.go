@@ -0,0 +1,131 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package certmonitor
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/msp"
+	cb "github.com/hyperledger/fabric/protos/common"
+	mspprotos "github.com/hyperledger/fabric/protos/msp"
+	"github.com/pkg/errors"
+)
+
+// The kinds of certificates that are monitored
+const (
+	KindLocalMSP   = "local_msp"
+	KindTLS        = "tls"
+	KindChannelMSP = "channel_msp"
+)
+
+// Certificate is a certificate monitored for expiration.
+type Certificate struct {
+	// Kind is where the certificate comes from, e.g. KindLocalMSP
+	Kind string
+	// Channel is the channel whose configuration holds the certificate, if any
+	Channel string
+	// MSPID is the ID of the MSP the certificate belongs to, if any
+	MSPID string
+	Cert  *x509.Certificate
+}
+
+// PEMCertificates parses the given PEM encoded certificates.
+func PEMCertificates(kind, channel, mspID string, pemCerts ...[]byte) ([]Certificate, error) {
+	var certs []Certificate
+	for _, pemCert := range pemCerts {
+		for rest := pemCert; len(rest) > 0; {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			if block.Type != "CERTIFICATE" {
+				continue
+			}
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed parsing certificate")
+			}
+			certs = append(certs, Certificate{
+				Kind:    kind,
+				Channel: channel,
+				MSPID:   mspID,
+				Cert:    cert,
+			})
+		}
+	}
+	return certs, nil
+}
+
+// MSPCertificates returns the certificates of the given MSP configuration:
+// its root, intermediate, admin and TLS certificates, and the certificate of
+// its signing identity if it has one. Only X.509 based MSPs have certificates.
+func MSPCertificates(kind, channel string, conf *mspprotos.MSPConfig) ([]Certificate, error) {
+	if conf == nil || conf.Type != int32(msp.FABRIC) {
+		return nil, nil
+	}
+
+	fabricConf := &mspprotos.FabricMSPConfig{}
+	if err := proto.Unmarshal(conf.Config, fabricConf); err != nil {
+		return nil, errors.Wrap(err, "failed unmarshalling MSP config")
+	}
+
+	pemCerts := [][]byte{}
+	pemCerts = append(pemCerts, fabricConf.RootCerts...)
+	pemCerts = append(pemCerts, fabricConf.IntermediateCerts...)
+	pemCerts = append(pemCerts, fabricConf.Admins...)
+	pemCerts = append(pemCerts, fabricConf.TlsRootCerts...)
+	pemCerts = append(pemCerts, fabricConf.TlsIntermediateCerts...)
+	if fabricConf.SigningIdentity != nil {
+		pemCerts = append(pemCerts, fabricConf.SigningIdentity.PublicSigner)
+	}
+
+	certs, err := PEMCertificates(kind, channel, fabricConf.Name, pemCerts...)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed getting certificates of MSP "+fabricConf.Name)
+	}
+	return certs, nil
+}
+
+// ChannelCertificates returns the certificates of all MSPs defined in the given channel configuration.
+func ChannelCertificates(channel string, config *cb.Config) ([]Certificate, error) {
+	if config == nil {
+		return nil, nil
+	}
+	return groupCertificates(channel, config.ChannelGroup)
+}
+
+func groupCertificates(channel string, group *cb.ConfigGroup) ([]Certificate, error) {
+	if group == nil {
+		return nil, nil
+	}
+
+	var certs []Certificate
+	if value, ok := group.Values[channelconfig.MSPKey]; ok {
+		conf := &mspprotos.MSPConfig{}
+		if err := proto.Unmarshal(value.Value, conf); err != nil {
+			return nil, errors.Wrap(err, "failed unmarshalling MSP config")
+		}
+		mspCerts, err := MSPCertificates(KindChannelMSP, channel, conf)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, mspCerts...)
+	}
+
+	for _, subGroup := range group.Groups {
+		groupCerts, err := groupCertificates(channel, subGroup)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, groupCerts...)
+	}
+	return certs, nil
+}
@@ -0,0 +1,97 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package certmonitor
+
+import (
+	"encoding/pem"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/crypto/tlsgen"
+	"github.com/hyperledger/fabric/msp"
+	cb "github.com/hyperledger/fabric/protos/common"
+	mspprotos "github.com/hyperledger/fabric/protos/msp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPEMCertificates(t *testing.T) {
+	ca, err := tlsgen.NewCA()
+	require.NoError(t, err)
+	kp, err := ca.NewServerCertKeyPair("localhost")
+	require.NoError(t, err)
+
+	certs, err := PEMCertificates(KindTLS, "", "", append(kp.Cert, kp.Key...), ca.CertBytes())
+	assert.NoError(t, err)
+	require.Len(t, certs, 2)
+	assert.Equal(t, KindTLS, certs[0].Kind)
+	assert.Equal(t, []string{"localhost"}, certs[0].Cert.DNSNames)
+	assert.True(t, certs[1].Cert.IsCA)
+
+	_, err = PEMCertificates(KindTLS, "", "", []byte("-----BEGIN CERTIFICATE-----\nYmFk\n-----END CERTIFICATE-----\n"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed parsing certificate")
+}
+
+func TestChannelCertificates(t *testing.T) {
+	rootCA, err := tlsgen.NewCA()
+	require.NoError(t, err)
+	tlsCA, err := tlsgen.NewCA()
+	require.NoError(t, err)
+
+	fabricConf, err := proto.Marshal(&mspprotos.FabricMSPConfig{
+		Name:         "Org1MSP",
+		RootCerts:    [][]byte{rootCA.CertBytes()},
+		TlsRootCerts: [][]byte{tlsCA.CertBytes()},
+	})
+	require.NoError(t, err)
+	mspValue := &cb.ConfigValue{Value: protoMarshal(t, &mspprotos.MSPConfig{Type: int32(msp.FABRIC), Config: fabricConf})}
+	idemixValue := &cb.ConfigValue{Value: protoMarshal(t, &mspprotos.MSPConfig{Type: int32(msp.IDEMIX), Config: []byte("idemix")})}
+
+	config := &cb.Config{
+		ChannelGroup: &cb.ConfigGroup{
+			Groups: map[string]*cb.ConfigGroup{
+				"Application": {
+					Groups: map[string]*cb.ConfigGroup{
+						"Org1":   {Values: map[string]*cb.ConfigValue{"MSP": mspValue}},
+						"Idemix": {Values: map[string]*cb.ConfigValue{"MSP": idemixValue}},
+					},
+				},
+			},
+		},
+	}
+
+	certs, err := ChannelCertificates("mychannel", config)
+	assert.NoError(t, err)
+	require.Len(t, certs, 2)
+	for _, c := range certs {
+		assert.Equal(t, KindChannelMSP, c.Kind)
+		assert.Equal(t, "mychannel", c.Channel)
+		assert.Equal(t, "Org1MSP", c.MSPID)
+	}
+	assert.Equal(t, rootCA.CertBytes(), pemEncode(certs[0].Cert.Raw))
+	assert.Equal(t, tlsCA.CertBytes(), pemEncode(certs[1].Cert.Raw))
+
+	config.ChannelGroup.Groups["Application"].Groups["Org1"].Values["MSP"] = &cb.ConfigValue{Value: []byte("garbage")}
+	_, err = ChannelCertificates("mychannel", config)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed unmarshalling MSP config")
+
+	certs, err = ChannelCertificates("mychannel", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, certs)
+}
+
+func protoMarshal(t *testing.T, msg proto.Message) []byte {
+	b, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	return b
+}
+
+func pemEncode(der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
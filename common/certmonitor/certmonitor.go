@@ -0,0 +1,155 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package certmonitor
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric/common/flogging"
+)
+
+var logger = flogging.MustGetLogger("certmonitor")
+
+// DefaultScanInterval is the interval at which the certificates are checked
+// when the interval given to Run is not positive.
+const DefaultScanInterval = 24 * time.Hour
+
+// Source returns certificates to monitor.
+type Source func() ([]Certificate, error)
+
+// Monitor periodically checks when the certificates of its sources expire.
+// It exports the number of days until the first of the certificates of each
+// kind, channel and MSP expires, and logs a warning when a certificate gets
+// closer to its expiration than a threshold, and an error once it has expired.
+type Monitor struct {
+	sources    []Source
+	thresholds []time.Duration
+	metrics    *Metrics
+	now        func() time.Time
+
+	mutex sync.Mutex
+	// alerted holds the smallest threshold each certificate has
+	// been reported for; zero means it was reported as expired
+	alerted map[string]time.Duration
+}
+
+// NewMonitor creates a monitor of the certificates of the given sources,
+// which warns about certificates that expire within any of the thresholds.
+func NewMonitor(thresholds []time.Duration, metrics *Metrics, sources ...Source) *Monitor {
+	sorted := append([]time.Duration{}, thresholds...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	return &Monitor{
+		sources:    sources,
+		thresholds: sorted,
+		metrics:    metrics,
+		now:        time.Now,
+		alerted:    map[string]time.Duration{},
+	}
+}
+
+// Run checks the certificates right away and then every interval,
+// or DefaultScanInterval if the interval is not positive, until done is closed.
+func (m *Monitor) Run(interval time.Duration, done <-chan struct{}) {
+	if interval <= 0 {
+		logger.Warningf("Invalid certificate scan interval %s, using %s instead", interval, DefaultScanInterval)
+		interval = DefaultScanInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		m.Scan()
+
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Scan checks the certificates of all sources once.
+func (m *Monitor) Scan() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	now := m.now()
+	// the certificates are not told apart in the metrics, as a series per
+	// certificate would be kept forever once the certificate is replaced
+	firstExpiry := map[expiryLabels]time.Duration{}
+	for _, source := range m.sources {
+		certs, err := source()
+		if err != nil {
+			logger.Warningf("Failed getting certificates to monitor: %s", err)
+			continue
+		}
+		for _, c := range certs {
+			remaining := m.check(c, now)
+			labels := expiryLabels{kind: c.Kind, channel: c.Channel, mspID: c.MSPID}
+			if first, ok := firstExpiry[labels]; !ok || remaining < first {
+				firstExpiry[labels] = remaining
+			}
+		}
+	}
+
+	for labels, remaining := range firstExpiry {
+		m.metrics.DaysUntilExpiry.With(
+			"kind", labels.kind,
+			"channel", labels.channel,
+			"msp_id", labels.mspID,
+		).Set(remaining.Hours() / 24)
+	}
+}
+
+type expiryLabels struct {
+	kind, channel, mspID string
+}
+
+// check logs the expiration of the certificate if it crossed a threshold
+// since it was last checked, and returns the time remaining until it expires
+func (m *Monitor) check(c Certificate, now time.Time) time.Duration {
+	serial := c.Cert.SerialNumber.Text(16)
+	remaining := c.Cert.NotAfter.Sub(now)
+
+	key := fmt.Sprintf("%s/%s/%s/%s", c.Kind, c.Channel, c.MSPID, serial)
+	alerted, wasAlerted := m.alerted[key]
+
+	if remaining <= 0 {
+		if !wasAlerted || alerted > 0 {
+			logger.Errorf("%s expired on %s", describe(c, serial), c.Cert.NotAfter.Format(time.RFC3339))
+			m.alerted[key] = 0
+		}
+		return remaining
+	}
+
+	for _, threshold := range m.thresholds {
+		if remaining > threshold {
+			continue
+		}
+		if !wasAlerted || threshold < alerted {
+			logger.Warningf("%s expires in %s, on %s", describe(c, serial), remaining.Round(time.Minute), c.Cert.NotAfter.Format(time.RFC3339))
+			m.alerted[key] = threshold
+		}
+		return remaining
+	}
+	return remaining
+}
+
+func describe(c Certificate, serial string) string {
+	description := fmt.Sprintf("%s certificate %s (serial %s)", c.Kind, c.Cert.Subject, serial)
+	if c.MSPID != "" {
+		description += " of MSP " + c.MSPID
+	}
+	if c.Channel != "" {
+		description += " in channel " + c.Channel
+	}
+	return description
+}
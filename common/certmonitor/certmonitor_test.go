@@ -0,0 +1,181 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package certmonitor
+
+import (
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/common/crypto/tlsgen"
+	"github.com/hyperledger/fabric/common/flogging/floggingtest"
+	"github.com/hyperledger/fabric/common/metrics/metricsfakes"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCert(t *testing.T) *x509.Certificate {
+	ca, err := tlsgen.NewCA()
+	require.NoError(t, err)
+	certs, err := PEMCertificates(KindTLS, "", "", ca.CertBytes())
+	require.NoError(t, err)
+	require.Len(t, certs, 1)
+	return certs[0].Cert
+}
+
+func TestMonitorScan(t *testing.T) {
+	cert := newTestCert(t)
+	serial := cert.SerialNumber.Text(16)
+
+	gauge := &metricsfakes.Gauge{}
+	gauge.WithReturns(gauge)
+
+	source := func() ([]Certificate, error) {
+		return []Certificate{{Kind: KindChannelMSP, Channel: "mychannel", MSPID: "Org1MSP", Cert: cert}}, nil
+	}
+	failingSource := func() ([]Certificate, error) {
+		return nil, errors.New("no certificates for you")
+	}
+
+	m := NewMonitor([]time.Duration{24 * time.Hour, 30 * 24 * time.Hour, 7 * 24 * time.Hour}, &Metrics{DaysUntilExpiry: gauge}, failingSource, source)
+	assert.Equal(t, []time.Duration{24 * time.Hour, 7 * 24 * time.Hour, 30 * 24 * time.Hour}, m.thresholds)
+
+	oldLogger := logger
+	defer func() { logger = oldLogger }()
+	l, recorder := floggingtest.NewTestLogger(t)
+	logger = l
+
+	tests := []struct {
+		remaining time.Duration
+		log       string
+	}{
+		{remaining: 60 * 24 * time.Hour},
+		{remaining: 20 * 24 * time.Hour, log: "channel_msp certificate .* \\(serial " + serial + "\\) of MSP Org1MSP in channel mychannel expires in 480h0m0s"},
+		{remaining: 19 * 24 * time.Hour},
+		{remaining: 5 * 24 * time.Hour, log: "expires in 120h0m0s"},
+		{remaining: 12 * time.Hour, log: "expires in 12h0m0s"},
+		{remaining: time.Hour},
+		{remaining: -time.Hour, log: "expired on " + cert.NotAfter.Format(time.RFC3339)},
+		{remaining: -2 * time.Hour},
+	}
+
+	for i, tt := range tests {
+		m.now = func() time.Time { return cert.NotAfter.Add(-tt.remaining) }
+		recorder.Reset()
+		m.Scan()
+
+		assert.Equal(t, i+1, gauge.WithCallCount())
+		assert.Equal(t, []string{"kind", "channel_msp", "channel", "mychannel", "msp_id", "Org1MSP"}, gauge.WithArgsForCall(i))
+		assert.InDelta(t, tt.remaining.Hours()/24, gauge.SetArgsForCall(i), 0.0001)
+
+		assert.Len(t, recorder.MessagesContaining("Failed getting certificates to monitor: no certificates for you"), 1)
+		if tt.log == "" {
+			assert.Len(t, recorder.Messages(), 1, "unexpected logs when %s remain: %v", tt.remaining, recorder.Messages())
+		} else {
+			assert.Len(t, recorder.Messages(), 2, "expected logs when %s remain: %v", tt.remaining, recorder.Messages())
+			assert.NotEmpty(t, recorder.EntriesMatching(tt.log), "expected log matching %q", tt.log)
+		}
+	}
+}
+
+func TestMonitorScanFirstExpiry(t *testing.T) {
+	cert := newTestCert(t)
+	laterCert := newTestCert(t)
+	laterCert.NotAfter = cert.NotAfter.Add(48 * time.Hour)
+
+	gauge := &metricsfakes.Gauge{}
+	gauge.WithReturns(gauge)
+
+	source := func() ([]Certificate, error) {
+		return []Certificate{
+			{Kind: KindChannelMSP, Channel: "mychannel", MSPID: "Org1MSP", Cert: laterCert},
+			{Kind: KindChannelMSP, Channel: "mychannel", MSPID: "Org1MSP", Cert: cert},
+			{Kind: KindChannelMSP, Channel: "mychannel", MSPID: "Org2MSP", Cert: laterCert},
+		}, nil
+	}
+
+	m := NewMonitor(nil, &Metrics{DaysUntilExpiry: gauge}, source)
+	m.now = func() time.Time { return cert.NotAfter.Add(-24 * time.Hour) }
+	m.Scan()
+
+	// The certificates of the same kind, channel and MSP are reported
+	// by the one which expires first
+	require.Equal(t, 2, gauge.SetCallCount())
+	days := map[string]float64{}
+	for i := 0; i < gauge.SetCallCount(); i++ {
+		days[gauge.WithArgsForCall(i)[5]] = gauge.SetArgsForCall(i)
+	}
+	assert.InDelta(t, 1, days["Org1MSP"], 0.0001)
+	assert.InDelta(t, 3, days["Org2MSP"], 0.0001)
+}
+
+func TestMonitorRun(t *testing.T) {
+	cert := newTestCert(t)
+
+	gauge := &metricsfakes.Gauge{}
+	gauge.WithReturns(gauge)
+
+	scanned := make(chan struct{}, 10)
+	source := func() ([]Certificate, error) {
+		scanned <- struct{}{}
+		return []Certificate{{Kind: KindLocalMSP, MSPID: "SampleOrg", Cert: cert}}, nil
+	}
+
+	m := NewMonitor(nil, &Metrics{DaysUntilExpiry: gauge}, source)
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		m.Run(10*time.Millisecond, done)
+		close(stopped)
+	}()
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-scanned:
+		case <-time.After(5 * time.Second):
+			t.Fatal("the certificates should have been scanned")
+		}
+	}
+
+	close(done)
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run should have returned")
+	}
+	assert.True(t, gauge.SetCallCount() >= 2)
+}
+
+func TestMonitorRunInvalidInterval(t *testing.T) {
+	scanned := make(chan struct{}, 10)
+	source := func() ([]Certificate, error) {
+		scanned <- struct{}{}
+		return nil, nil
+	}
+
+	m := NewMonitor(nil, &Metrics{}, source)
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		m.Run(0, done)
+		close(stopped)
+	}()
+
+	select {
+	case <-scanned:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the certificates should have been scanned")
+	}
+
+	close(done)
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run should have returned")
+	}
+}
@@ -0,0 +1,31 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package certmonitor
+
+import (
+	"github.com/hyperledger/fabric/common/metrics"
+)
+
+var (
+	daysUntilExpiry = metrics.GaugeOpts{
+		Namespace:    "certificate",
+		Name:         "days_until_expiry",
+		Help:         "The number of days until the first of the certificates of the kind, channel and MSP expires. Negative if it has expired.",
+		LabelNames:   []string{"kind", "channel", "msp_id"},
+		StatsdFormat: "%{#fqname}.%{kind}.%{channel}.%{msp_id}",
+	}
+)
+
+type Metrics struct {
+	DaysUntilExpiry metrics.Gauge
+}
+
+func NewMetrics(p metrics.Provider) *Metrics {
+	return &Metrics{
+		DaysUntilExpiry: p.NewGauge(daysUntilExpiry),
+	}
+}
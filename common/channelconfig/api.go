@@ -32,6 +32,17 @@ type ApplicationOrg interface {
 
 	// AnchorPeers returns the list of gossip anchor peers
 	AnchorPeers() []*pb.AnchorPeer
+
+	// PeerEndpoints returns the list of endpoints of the peers of the org
+	PeerEndpoints() []string
+}
+
+// OrdererOrg stores the per org orderer config
+type OrdererOrg interface {
+	Org
+
+	// Endpoints returns the endpoints of the ordering service nodes of the org
+	Endpoints() []string
 }
 
 // Application stores the common shared application config
@@ -44,6 +55,10 @@ type Application interface {
 
 	// Capabilities defines the capabilities for the application portion of a channel
 	Capabilities() ApplicationCapabilities
+
+	// InvocationQuota returns the quota on the proposals endorsed for each
+	// client identity, or nil if there is none
+	InvocationQuota() *pb.InvocationQuota
 }
 
 // Channel gives read only access to the channel configuration
@@ -106,8 +121,11 @@ type Orderer interface {
 	// used for ordering
 	KafkaBrokers() []string
 
+	// Decommissioned returns whether the channel is decommissioned by all the orderers serving it
+	Decommissioned() bool
+
 	// Organizations returns the organizations for the ordering service
-	Organizations() map[string]Org
+	Organizations() map[string]OrdererOrg
 
 	// Capabilities defines the capabilities for the orderer portion of a channel
 	Capabilities() OrdererCapabilities
@@ -205,6 +223,18 @@ type OrdererCapabilities interface {
 	// channel creation logic using channel creation policy as the Admins policy if
 	// the creation transaction appears to support it.
 	UseChannelCreationPolicyAsAdmins() bool
+
+	// OrgEndpoints specifies whether the orderer orgs may define the endpoints of
+	// their ordering service nodes.
+	OrgEndpoints() bool
+
+	// ExactMessageSize specifies whether messages are measured by their marshaled size
+	// when cutting batches, rather than by the size of their payload and signature.
+	ExactMessageSize() bool
+
+	// ChannelDecommission specifies whether a channel may be decommissioned by all the
+	// orderers serving it through its config.
+	ChannelDecommission() bool
 }
 
 // PolicyMapper is an interface for
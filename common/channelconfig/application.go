@@ -19,12 +19,16 @@ const (
 
 	// ACLsKey is the name of the ACLs config
 	ACLsKey = "ACLs"
+
+	// InvocationQuotaKey is the name of the invocation quota config
+	InvocationQuotaKey = "InvocationQuota"
 )
 
 // ApplicationProtos is used as the source of the ApplicationConfig
 type ApplicationProtos struct {
-	ACLs         *pb.ACLs
-	Capabilities *cb.Capabilities
+	ACLs            *pb.ACLs
+	Capabilities    *cb.Capabilities
+	InvocationQuota *pb.InvocationQuota
 }
 
 // ApplicationConfig implements the Application interface
@@ -50,8 +54,20 @@ func NewApplicationConfig(appGroup *cb.ConfigGroup, mspConfig *MSPConfigHandler)
 		}
 	}
 
+	if _, ok := appGroup.Values[InvocationQuotaKey]; ok {
+		if !ac.Capabilities().V2_0Validation() {
+			return nil, errors.New("InvocationQuota may not be specified without the required capability")
+		}
+		if ac.protos.InvocationQuota.MaxInvocations > 0 && ac.protos.InvocationQuota.BlockInterval == 0 {
+			return nil, errors.New("InvocationQuota must specify a block interval")
+		}
+	}
+
 	var err error
 	for orgName, orgGroup := range appGroup.Groups {
+		if _, ok := orgGroup.Values[PeerEndpointsKey]; ok && !ac.Capabilities().V2_0Validation() {
+			return nil, errors.Errorf("PeerEndpoints of org %s may not be specified without the required capability", orgName)
+		}
 		ac.applicationOrgs[orgName], err = NewApplicationOrgConfig(orgName, orgGroup, mspConfig)
 		if err != nil {
 			return nil, err
@@ -77,3 +93,12 @@ func (ac *ApplicationConfig) APIPolicyMapper() PolicyMapper {
 
 	return pm
 }
+
+// InvocationQuota returns the quota on the proposals endorsed for each client
+// identity, or nil if there is none
+func (ac *ApplicationConfig) InvocationQuota() *pb.InvocationQuota {
+	if ac.protos.InvocationQuota.GetMaxInvocations() == 0 {
+		return nil
+	}
+	return ac.protos.InvocationQuota
+}
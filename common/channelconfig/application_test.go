@@ -52,3 +52,81 @@ func TestACL(t *testing.T) {
 		g.Expect(err).To(MatchError("ACLs may not be specified without the required capability"))
 	})
 }
+
+func TestInvocationQuota(t *testing.T) {
+	g := NewGomegaWithT(t)
+	cgt := &cb.ConfigGroup{
+		Values: map[string]*cb.ConfigValue{
+			InvocationQuotaKey: {
+				Value: protoutil.MarshalOrPanic(
+					InvocationQuotaValue(10, 5).Value(),
+				),
+			},
+			CapabilitiesKey: {
+				Value: protoutil.MarshalOrPanic(
+					CapabilitiesValue(map[string]bool{
+						capabilities.ApplicationV2_0: true,
+					}).Value(),
+				),
+			},
+		},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		cg := proto.Clone(cgt).(*cb.ConfigGroup)
+		ac, err := NewApplicationConfig(cg, nil)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(ac.InvocationQuota().MaxInvocations).To(Equal(uint32(10)))
+		g.Expect(ac.InvocationQuota().BlockInterval).To(Equal(uint64(5)))
+	})
+
+	t.Run("NoQuota", func(t *testing.T) {
+		cg := proto.Clone(cgt).(*cb.ConfigGroup)
+		delete(cg.Values, InvocationQuotaKey)
+		ac, err := NewApplicationConfig(cg, nil)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(ac.InvocationQuota()).To(BeNil())
+	})
+
+	t.Run("NoLimit", func(t *testing.T) {
+		cg := proto.Clone(cgt).(*cb.ConfigGroup)
+		cg.Values[InvocationQuotaKey].Value = protoutil.MarshalOrPanic(InvocationQuotaValue(0, 0).Value())
+		ac, err := NewApplicationConfig(cg, nil)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(ac.InvocationQuota()).To(BeNil())
+	})
+
+	t.Run("MissingBlockInterval", func(t *testing.T) {
+		cg := proto.Clone(cgt).(*cb.ConfigGroup)
+		cg.Values[InvocationQuotaKey].Value = protoutil.MarshalOrPanic(InvocationQuotaValue(10, 0).Value())
+		_, err := NewApplicationConfig(cg, nil)
+		g.Expect(err).To(MatchError("InvocationQuota must specify a block interval"))
+	})
+
+	t.Run("MissingCapability", func(t *testing.T) {
+		cg := proto.Clone(cgt).(*cb.ConfigGroup)
+		delete(cg.Values, CapabilitiesKey)
+		_, err := NewApplicationConfig(cg, nil)
+		g.Expect(err).To(MatchError("InvocationQuota may not be specified without the required capability"))
+	})
+}
+
+func TestPeerEndpointsCapability(t *testing.T) {
+	g := NewGomegaWithT(t)
+	cg := &cb.ConfigGroup{
+		Groups: map[string]*cb.ConfigGroup{
+			"Org1": {
+				Values: map[string]*cb.ConfigValue{
+					PeerEndpointsKey: {
+						Value: protoutil.MarshalOrPanic(
+							PeerEndpointsValue([]string{"peer0:7051"}).Value(),
+						),
+					},
+				},
+			},
+		},
+	}
+
+	_, err := NewApplicationConfig(cg, nil)
+	g.Expect(err).To(MatchError("PeerEndpoints of org Org1 may not be specified without the required capability"))
+}
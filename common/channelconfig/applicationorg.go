@@ -8,6 +8,7 @@ package channelconfig
 
 import (
 	"fmt"
+	"net"
 
 	cb "github.com/hyperledger/fabric/protos/common"
 	pb "github.com/hyperledger/fabric/protos/peer"
@@ -17,11 +18,15 @@ import (
 const (
 	// AnchorPeersKey is the key name for the AnchorPeers ConfigValue
 	AnchorPeersKey = "AnchorPeers"
+
+	// PeerEndpointsKey is the key name for the PeerEndpoints ConfigValue
+	PeerEndpointsKey = "PeerEndpoints"
 )
 
 // ApplicationOrgProtos are deserialized from the config
 type ApplicationOrgProtos struct {
-	AnchorPeers *pb.AnchorPeers
+	AnchorPeers   *pb.AnchorPeers
+	PeerEndpoints *pb.PeerEndpoints
 }
 
 // ApplicationOrgConfig defines the configuration for an application org
@@ -66,7 +71,17 @@ func (aog *ApplicationOrgConfig) AnchorPeers() []*pb.AnchorPeer {
 	return aog.protos.AnchorPeers.AnchorPeers
 }
 
+// PeerEndpoints returns the list of endpoints of the peers of this Organization
+func (aog *ApplicationOrgConfig) PeerEndpoints() []string {
+	return aog.protos.PeerEndpoints.Endpoints
+}
+
 func (aoc *ApplicationOrgConfig) Validate() error {
 	logger.Debugf("Anchor peers for org %s are %v", aoc.name, aoc.protos.AnchorPeers)
+	for _, endpoint := range aoc.protos.PeerEndpoints.Endpoints {
+		if _, _, err := net.SplitHostPort(endpoint); err != nil {
+			return errors.Wrapf(err, "invalid peer endpoint for org %s", aoc.name)
+		}
+	}
 	return aoc.OrganizationConfig.Validate()
 }
@@ -8,8 +8,25 @@ package channelconfig
 
 import (
 	"testing"
+
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestApplicationOrgInterface(t *testing.T) {
 	_ = ApplicationOrg(&ApplicationOrgConfig{})
 }
+
+func TestApplicationOrgPeerEndpoints(t *testing.T) {
+	aoc := &ApplicationOrgConfig{
+		name: "Org1",
+		protos: &ApplicationOrgProtos{
+			AnchorPeers:   &pb.AnchorPeers{},
+			PeerEndpoints: &pb.PeerEndpoints{Endpoints: []string{"peer0:7051", "peer1"}},
+		},
+	}
+	assert.Equal(t, []string{"peer0:7051", "peer1"}, aoc.PeerEndpoints())
+
+	err := aoc.Validate()
+	assert.EqualError(t, err, "invalid peer endpoint for org Org1: address peer1: missing port in address")
+}
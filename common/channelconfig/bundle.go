@@ -189,11 +189,23 @@ func NewBundleFromEnvelope(env *cb.Envelope) (*Bundle, error) {
 
 // NewBundle creates a new immutable bundle of configuration
 func NewBundle(channelID string, config *cb.Config) (*Bundle, error) {
+	return NewBundleWithMetrics(channelID, config, nil)
+}
+
+// NewBundleWithMetrics creates a new immutable bundle of configuration,
+// whose MSPs and policies report the given metrics, or none if nil
+func NewBundleWithMetrics(channelID string, config *cb.Config, metrics *Metrics) (*Bundle, error) {
 	if err := preValidate(config); err != nil {
 		return nil, err
 	}
 
-	channelConfig, err := NewChannelConfig(config.ChannelGroup)
+	var mspMetrics *msp.Metrics
+	var policiesMetrics *policies.Metrics
+	if metrics != nil {
+		mspMetrics, policiesMetrics = metrics.MSP, metrics.Policies
+	}
+
+	channelConfig, err := NewChannelConfig(config.ChannelGroup, mspMetrics)
 	if err != nil {
 		return nil, errors.Wrap(err, "initializing channelconfig failed")
 	}
@@ -211,7 +223,7 @@ func NewBundle(channelID string, config *cb.Config) (*Bundle, error) {
 		}
 	}
 
-	policyManager, err := policies.NewManagerImpl(RootGroupKey, policyProviderMap, config.ChannelGroup)
+	policyManager, err := policies.NewManagerImpl(RootGroupKey, policyProviderMap, config.ChannelGroup, policiesMetrics)
 	if err != nil {
 		return nil, errors.Wrap(err, "initializing policymanager failed")
 	}
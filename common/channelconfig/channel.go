@@ -76,8 +76,9 @@ type ChannelConfig struct {
 	consortiumsConfig *ConsortiumsConfig
 }
 
-// NewChannelConfig creates a new ChannelConfig
-func NewChannelConfig(channelGroup *cb.ConfigGroup) (*ChannelConfig, error) {
+// NewChannelConfig creates a new ChannelConfig, whose MSPs report
+// the given metrics, or none if nil
+func NewChannelConfig(channelGroup *cb.ConfigGroup, mspMetrics *msp.Metrics) (*ChannelConfig, error) {
 	cc := &ChannelConfig{
 		protos: &ChannelProtos{},
 	}
@@ -91,7 +92,7 @@ func NewChannelConfig(channelGroup *cb.ConfigGroup) (*ChannelConfig, error) {
 	}
 
 	capabilities := cc.Capabilities()
-	mspConfigHandler := NewMSPConfigHandler(capabilities.MSPVersion())
+	mspConfigHandler := NewMSPConfigHandler(capabilities.MSPVersion(), mspMetrics)
 
 	var err error
 	for groupName, group := range channelGroup.Groups {
@@ -110,6 +111,10 @@ func NewChannelConfig(channelGroup *cb.ConfigGroup) (*ChannelConfig, error) {
 		}
 	}
 
+	if cc.ordererConfig != nil && cc.ordererConfig.Decommissioned() && cc.consortiumsConfig != nil {
+		return nil, errors.New("the system channel may not be decommissioned")
+	}
+
 	if cc.mspManager, err = mspConfigHandler.CreateMSPManager(); err != nil {
 		return nil, err
 	}
@@ -26,7 +26,7 @@ func TestChannelConfig(t *testing.T) {
 		Groups: map[string]*cb.ConfigGroup{
 			"UnknownGroupKey": {},
 		},
-	})
+	}, nil)
 	assert.Error(t, err)
 	assert.Nil(t, cc)
 }
@@ -14,7 +14,7 @@ import (
 )
 
 func TestConsortiumConfig(t *testing.T) {
-	cc, err := NewConsortiumConfig(&cb.ConfigGroup{}, NewMSPConfigHandler(msp.MSPv1_0))
+	cc, err := NewConsortiumConfig(&cb.ConfigGroup{}, NewMSPConfigHandler(msp.MSPv1_0, nil))
 	assert.NoError(t, err)
 	orgs := cc.Organizations()
 	assert.Equal(t, 0, len(orgs))
@@ -0,0 +1,26 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package channelconfig
+
+import (
+	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/hyperledger/fabric/common/policies"
+	"github.com/hyperledger/fabric/msp"
+)
+
+// Metrics holds the metrics reported by the MSPs and the policies of bundles
+type Metrics struct {
+	MSP      *msp.Metrics
+	Policies *policies.Metrics
+}
+
+func NewMetrics(p metrics.Provider) *Metrics {
+	return &Metrics{
+		MSP:      msp.NewMetrics(p),
+		Policies: policies.NewMetrics(p),
+	}
+}
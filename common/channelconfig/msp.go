@@ -7,9 +7,12 @@ SPDX-License-Identifier: Apache-2.0
 package channelconfig
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/bccsp"
 	"github.com/hyperledger/fabric/msp"
 	"github.com/hyperledger/fabric/msp/cache"
 	mspprotos "github.com/hyperledger/fabric/protos/msp"
@@ -25,12 +28,16 @@ type pendingMSPConfig struct {
 type MSPConfigHandler struct {
 	version msp.MSPVersion
 	idMap   map[string]*pendingMSPConfig
+	metrics *msp.Metrics
 }
 
-func NewMSPConfigHandler(mspVersion msp.MSPVersion) *MSPConfigHandler {
+// NewMSPConfigHandler creates a MSPConfigHandler whose MSPs report
+// the given metrics, or none if nil
+func NewMSPConfigHandler(mspVersion msp.MSPVersion, metrics *msp.Metrics) *MSPConfigHandler {
 	return &MSPConfigHandler{
 		version: mspVersion,
 		idMap:   make(map[string]*pendingMSPConfig),
+		metrics: metrics,
 	}
 }
 
@@ -42,20 +49,53 @@ func (bh *MSPConfigHandler) ProposeMSP(mspConfig *mspprotos.MSPConfig) (msp.MSP,
 	switch mspConfig.Type {
 	case int32(msp.FABRIC):
 		// create the bccsp msp instance
-		mspInst, err := msp.New(&msp.BCCSPNewOpts{NewBaseOpts: msp.NewBaseOpts{Version: bh.version}})
+		mspInst, err := msp.New(&msp.BCCSPNewOpts{NewBaseOpts: msp.NewBaseOpts{Version: bh.version, Metrics: bh.metrics}})
 		if err != nil {
 			return nil, errors.WithMessage(err, "creating the MSP manager failed")
 		}
 
+		// Ed25519 keys and certificates, as well as the SM3 hash function,
+		// are only understood by MSPs at v2.0 or later
+		if bh.version < msp.MSPv2_0 {
+			// a malformed config is reported by the MSP setup
+			fabricConfig := &mspprotos.FabricMSPConfig{}
+			if proto.Unmarshal(mspConfig.Config, fabricConfig) == nil {
+				if err := checkNoEd25519Certs(fabricConfig); err != nil {
+					return nil, err
+				}
+				if err := checkNoCertValidationPolicy(fabricConfig); err != nil {
+					return nil, err
+				}
+				if err := checkNoAdminOrOrdererOUs(fabricConfig); err != nil {
+					return nil, err
+				}
+				if err := checkNoSM3CryptoConfig(fabricConfig); err != nil {
+					return nil, err
+				}
+			}
+			mspInst = &ed25519RestrictedMSP{MSP: mspInst}
+		}
+
 		// add a cache layer on top
 		theMsp, err = cache.New(mspInst)
 		if err != nil {
 			return nil, errors.WithMessage(err, "creating the MSP cache failed")
 		}
 	case int32(msp.IDEMIX):
+		// Issuer key rotation is only understood by MSPs at v2.0 or later
+		if bh.version < msp.MSPv2_0 {
+			// a malformed config is reported by the MSP setup
+			idemixConfig := &mspprotos.IdemixMSPConfig{}
+			if proto.Unmarshal(mspConfig.Config, idemixConfig) == nil {
+				if err := checkNoPreviousIssuerPublicKeys(idemixConfig); err != nil {
+					return nil, err
+				}
+			}
+		}
+
 		// create the idemix msp instance
 		theMsp, err = msp.New(&msp.IdemixNewOpts{
-			NewBaseOpts: msp.NewBaseOpts{Version: bh.version},
+			NewBaseOpts: msp.NewBaseOpts{Version: bh.version, Metrics: bh.metrics},
 		})
 		if err != nil {
 			return nil, errors.WithMessage(err, "creating the MSP manager failed")
@@ -96,7 +136,94 @@ func (bh *MSPConfigHandler) CreateMSPManager() (msp.MSPManager, error) {
 		i++
 	}
 
-	manager := msp.NewMSPManager()
+	manager := msp.NewMSPManager(bh.metrics)
 	err := manager.Setup(mspList)
 	return manager, err
 }
+
+// ed25519RestrictedMSP is an MSP which rejects the identities with
+// Ed25519 keys or certificates, for channels without the V2_0 capability
+type ed25519RestrictedMSP struct {
+	msp.MSP
+}
+
+// DeserializeIdentity deserializes an identity, unless it has an Ed25519 key or certificate
+func (m *ed25519RestrictedMSP) DeserializeIdentity(serializedIdentity []byte) (msp.Identity, error) {
+	sID := &mspprotos.SerializedIdentity{}
+	if err := proto.Unmarshal(serializedIdentity, sID); err != nil {
+		return nil, errors.Wrap(err, "could not deserialize a SerializedIdentity")
+	}
+	if isEd25519Cert(sID.IdBytes) {
+		return nil, errors.New("identities with Ed25519 keys or certificates require the V2_0 channel capability")
+	}
+	return m.MSP.DeserializeIdentity(serializedIdentity)
+}
+
+// checkNoEd25519Certs returns an error if the certificates of the given MSP
+// config have Ed25519 keys or are signed with Ed25519
+func checkNoEd25519Certs(fabricConfig *mspprotos.FabricMSPConfig) error {
+	var certs [][]byte
+	certs = append(certs, fabricConfig.RootCerts...)
+	certs = append(certs, fabricConfig.IntermediateCerts...)
+	certs = append(certs, fabricConfig.Admins...)
+	certs = append(certs, fabricConfig.TlsRootCerts...)
+	certs = append(certs, fabricConfig.TlsIntermediateCerts...)
+	for _, cert := range certs {
+		if isEd25519Cert(cert) {
+			return errors.Errorf("MSP %s has Ed25519 keys or certificates, which require the V2_0 channel capability", fabricConfig.Name)
+		}
+	}
+	return nil
+}
+
+// checkNoCertValidationPolicy returns an error if the given
+// MSP config has a certificate validation policy
+func checkNoCertValidationPolicy(fabricConfig *mspprotos.FabricMSPConfig) error {
+	if fabricConfig.CertValidationPolicy != nil {
+		return errors.Errorf("MSP %s has a certificate validation policy, which requires the V2_0 channel capability", fabricConfig.Name)
+	}
+	return nil
+}
+
+// checkNoAdminOrOrdererOUs returns an error if the given MSP config
+// defines the NodeOUs of admins or orderers
+func checkNoAdminOrOrdererOUs(fabricConfig *mspprotos.FabricMSPConfig) error {
+	nodeOUs := fabricConfig.FabricNodeOus
+	if nodeOUs.GetAdminOuIdentifier() != nil || nodeOUs.GetOrdererOuIdentifier() != nil {
+		return errors.Errorf("MSP %s defines admin or orderer NodeOUs, which require the V2_0 channel capability", fabricConfig.Name)
+	}
+	return nil
+}
+
+// checkNoSM3CryptoConfig returns an error if the given MSP config
+// selects SM3 as signature hash family or identity identifier hash function
+func checkNoSM3CryptoConfig(fabricConfig *mspprotos.FabricMSPConfig) error {
+	cryptoConfig := fabricConfig.CryptoConfig
+	if cryptoConfig.GetSignatureHashFamily() == bccsp.SM3 || cryptoConfig.GetIdentityIdentifierHashFunction() == bccsp.SM3 {
+		return errors.Errorf("MSP %s uses the SM3 hash function, which requires the V2_0 channel capability", fabricConfig.Name)
+	}
+	return nil
+}
+
+// checkNoPreviousIssuerPublicKeys returns an error if the given
+// idemix MSP config has issuer public keys which were rotated out
+func checkNoPreviousIssuerPublicKeys(idemixConfig *mspprotos.IdemixMSPConfig) error {
+	if len(idemixConfig.PreviousIpks) != 0 {
+		return errors.Errorf("MSP %s has previous issuer public keys, which require the V2_0 channel capability", idemixConfig.Name)
+	}
+	return nil
+}
+
+// isEd25519Cert returns whether the given PEM encoded certificate
+// has an Ed25519 key or is signed with Ed25519
+func isEd25519Cert(pemBytes []byte) bool {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return false
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return false
+	}
+	return cert.PublicKeyAlgorithm == x509.Ed25519 || cert.SignatureAlgorithm == x509.PureEd25519
+}
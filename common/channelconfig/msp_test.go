@@ -7,8 +7,11 @@ SPDX-License-Identifier: Apache-2.0
 package channelconfig
 
 import (
+	"io/ioutil"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/bccsp"
 	"github.com/hyperledger/fabric/core/config/configtest"
 	"github.com/hyperledger/fabric/msp"
 	mspprotos "github.com/hyperledger/fabric/protos/msp"
@@ -26,7 +29,7 @@ func TestMSPConfigManager(t *testing.T) {
 	mspVers := []msp.MSPVersion{msp.MSPv1_0, msp.MSPv1_1}
 
 	for _, ver := range mspVers {
-		mspCH := NewMSPConfigHandler(ver)
+		mspCH := NewMSPConfigHandler(ver, nil)
 
 		_, err = mspCH.ProposeMSP(conf)
 		assert.NoError(t, err)
@@ -49,7 +52,7 @@ func TestMSPConfigManager(t *testing.T) {
 }
 
 func TestMSPConfigFailure(t *testing.T) {
-	mspCH := NewMSPConfigHandler(msp.MSPv1_0)
+	mspCH := NewMSPConfigHandler(msp.MSPv1_0, nil)
 
 	// begin/propose/commit
 	t.Run("Bad proto", func(t *testing.T) {
@@ -62,3 +65,160 @@ func TestMSPConfigFailure(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestMSPConfigEd25519(t *testing.T) {
+	ed25519Conf, err := msp.GetVerifyingMspConfig("../../msp/testdata/ed25519", "Ed25519Org", "bccsp")
+	assert.NoError(t, err)
+	ed25519Cert, err := ioutil.ReadFile("../../msp/testdata/ed25519/signcerts/cert.pem")
+	assert.NoError(t, err)
+
+	mspDir, err := configtest.GetDevMspDir()
+	assert.NoError(t, err)
+	conf, err := msp.GetVerifyingMspConfig(mspDir, "SampleOrg", "bccsp")
+	assert.NoError(t, err)
+
+	t.Run("Without V2_0", func(t *testing.T) {
+		mspCH := NewMSPConfigHandler(msp.MSPv1_3, nil)
+		_, err := mspCH.ProposeMSP(ed25519Conf)
+		assert.EqualError(t, err, "MSP Ed25519Org has Ed25519 keys or certificates, which require the V2_0 channel capability")
+
+		_, err = mspCH.ProposeMSP(conf)
+		assert.NoError(t, err)
+		mgr, err := mspCH.CreateMSPManager()
+		assert.NoError(t, err)
+
+		sID, err := proto.Marshal(&mspprotos.SerializedIdentity{Mspid: "SampleOrg", IdBytes: ed25519Cert})
+		assert.NoError(t, err)
+		_, err = mgr.DeserializeIdentity(sID)
+		assert.EqualError(t, err, "identities with Ed25519 keys or certificates require the V2_0 channel capability")
+	})
+
+	t.Run("With V2_0", func(t *testing.T) {
+		mspCH := NewMSPConfigHandler(msp.MSPv2_0, nil)
+		_, err := mspCH.ProposeMSP(ed25519Conf)
+		assert.NoError(t, err)
+		mgr, err := mspCH.CreateMSPManager()
+		assert.NoError(t, err)
+
+		sID, err := proto.Marshal(&mspprotos.SerializedIdentity{Mspid: "Ed25519Org", IdBytes: ed25519Cert})
+		assert.NoError(t, err)
+		id, err := mgr.DeserializeIdentity(sID)
+		assert.NoError(t, err)
+		assert.NoError(t, id.Validate())
+	})
+}
+
+func TestMSPConfigCertValidationPolicy(t *testing.T) {
+	mspDir, err := configtest.GetDevMspDir()
+	assert.NoError(t, err)
+	conf, err := msp.GetVerifyingMspConfig(mspDir, "SampleOrg", "bccsp")
+	assert.NoError(t, err)
+
+	fabricConf := &mspprotos.FabricMSPConfig{}
+	err = proto.Unmarshal(conf.Config, fabricConf)
+	assert.NoError(t, err)
+	fabricConf.CertValidationPolicy = &mspprotos.FabricCertValidationPolicy{MaxIntermediateDepth: 1}
+	conf.Config, err = proto.Marshal(fabricConf)
+	assert.NoError(t, err)
+
+	t.Run("Without V2_0", func(t *testing.T) {
+		mspCH := NewMSPConfigHandler(msp.MSPv1_3, nil)
+		_, err := mspCH.ProposeMSP(conf)
+		assert.EqualError(t, err, "MSP SampleOrg has a certificate validation policy, which requires the V2_0 channel capability")
+	})
+
+	t.Run("With V2_0", func(t *testing.T) {
+		mspCH := NewMSPConfigHandler(msp.MSPv2_0, nil)
+		_, err := mspCH.ProposeMSP(conf)
+		assert.NoError(t, err)
+	})
+}
+
+func TestMSPConfigAdminAndOrdererNodeOUs(t *testing.T) {
+	conf, err := msp.GetVerifyingMspConfig("../../msp/testdata/nodeous9", "SampleOrg", "bccsp")
+	assert.NoError(t, err)
+
+	t.Run("Without V2_0", func(t *testing.T) {
+		mspCH := NewMSPConfigHandler(msp.MSPv1_3, nil)
+		_, err := mspCH.ProposeMSP(conf)
+		assert.EqualError(t, err, "MSP SampleOrg defines admin or orderer NodeOUs, which require the V2_0 channel capability")
+	})
+
+	t.Run("With V2_0", func(t *testing.T) {
+		mspCH := NewMSPConfigHandler(msp.MSPv2_0, nil)
+		_, err := mspCH.ProposeMSP(conf)
+		assert.NoError(t, err)
+	})
+}
+
+func TestMSPConfigSM2(t *testing.T) {
+	conf, err := msp.GetVerifyingMspConfig("../../msp/testdata/sm2", "SampleOrg", "bccsp")
+	assert.NoError(t, err)
+
+	t.Run("Without V2_0", func(t *testing.T) {
+		mspCH := NewMSPConfigHandler(msp.MSPv1_3, nil)
+		_, err := mspCH.ProposeMSP(conf)
+		assert.Error(t, err)
+	})
+
+	t.Run("With V2_0", func(t *testing.T) {
+		mspCH := NewMSPConfigHandler(msp.MSPv2_0, nil)
+		_, err := mspCH.ProposeMSP(conf)
+		assert.NoError(t, err)
+	})
+}
+
+func TestMSPConfigSM3CryptoConfig(t *testing.T) {
+	mspDir, err := configtest.GetDevMspDir()
+	assert.NoError(t, err)
+	conf, err := msp.GetVerifyingMspConfig(mspDir, "SampleOrg", "bccsp")
+	assert.NoError(t, err)
+
+	fabricConf := &mspprotos.FabricMSPConfig{}
+	err = proto.Unmarshal(conf.Config, fabricConf)
+	assert.NoError(t, err)
+	fabricConf.CryptoConfig = &mspprotos.FabricCryptoConfig{
+		SignatureHashFamily:            bccsp.SM3,
+		IdentityIdentifierHashFunction: bccsp.SM3,
+	}
+	conf.Config, err = proto.Marshal(fabricConf)
+	assert.NoError(t, err)
+
+	t.Run("Without V2_0", func(t *testing.T) {
+		mspCH := NewMSPConfigHandler(msp.MSPv1_3, nil)
+		_, err := mspCH.ProposeMSP(conf)
+		assert.EqualError(t, err, "MSP SampleOrg uses the SM3 hash function, which requires the V2_0 channel capability")
+	})
+
+	t.Run("With V2_0", func(t *testing.T) {
+		mspCH := NewMSPConfigHandler(msp.MSPv2_0, nil)
+		_, err := mspCH.ProposeMSP(conf)
+		assert.NoError(t, err)
+	})
+}
+
+func TestMSPConfigPreviousIssuerPublicKeys(t *testing.T) {
+	conf, err := msp.GetIdemixMspConfig("../../msp/testdata/idemix/MSP1Verifier", "MSP1")
+	assert.NoError(t, err)
+	previousIpk, err := ioutil.ReadFile("../../msp/testdata/idemix/MSP2OU1/msp/IssuerPublicKey")
+	assert.NoError(t, err)
+
+	idemixConf := &mspprotos.IdemixMSPConfig{}
+	err = proto.Unmarshal(conf.Config, idemixConf)
+	assert.NoError(t, err)
+	idemixConf.PreviousIpks = []*mspprotos.IdemixPreviousIssuerPublicKey{{Ipk: previousIpk}}
+	conf.Config, err = proto.Marshal(idemixConf)
+	assert.NoError(t, err)
+
+	t.Run("Without V2_0", func(t *testing.T) {
+		mspCH := NewMSPConfigHandler(msp.MSPv1_3, nil)
+		_, err := mspCH.ProposeMSP(conf)
+		assert.EqualError(t, err, "MSP MSP1 has previous issuer public keys, which require the V2_0 channel capability")
+	})
+
+	t.Run("With V2_0", func(t *testing.T) {
+		mspCH := NewMSPConfigHandler(msp.MSPv2_0, nil)
+		_, err := mspCH.ProposeMSP(conf)
+		assert.NoError(t, err)
+	})
+}
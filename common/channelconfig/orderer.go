@@ -39,6 +39,9 @@ const (
 
 	// KafkaBrokersKey is the cb.ConfigItem type key name for the KafkaBrokers message.
 	KafkaBrokersKey = "KafkaBrokers"
+
+	// DecommissionKey is the cb.ConfigItem type key name for the Decommission message.
+	DecommissionKey = "Decommission"
 )
 
 // OrdererProtos is used as the source of the OrdererConfig.
@@ -49,12 +52,13 @@ type OrdererProtos struct {
 	KafkaBrokers        *ab.KafkaBrokers
 	ChannelRestrictions *ab.ChannelRestrictions
 	Capabilities        *cb.Capabilities
+	Decommission        *ab.Decommission
 }
 
 // OrdererConfig holds the orderer configuration information.
 type OrdererConfig struct {
 	protos *OrdererProtos
-	orgs   map[string]Org
+	orgs   map[string]OrdererOrg
 
 	batchTimeout time.Duration
 }
@@ -63,7 +67,7 @@ type OrdererConfig struct {
 func NewOrdererConfig(ordererGroup *cb.ConfigGroup, mspConfig *MSPConfigHandler) (*OrdererConfig, error) {
 	oc := &OrdererConfig{
 		protos: &OrdererProtos{},
-		orgs:   make(map[string]Org),
+		orgs:   make(map[string]OrdererOrg),
 	}
 
 	if err := DeserializeProtoValuesFromGroup(ordererGroup, oc.protos); err != nil {
@@ -74,9 +78,16 @@ func NewOrdererConfig(ordererGroup *cb.ConfigGroup, mspConfig *MSPConfigHandler)
 		return nil, err
 	}
 
+	if _, ok := ordererGroup.Values[DecommissionKey]; ok && !oc.Capabilities().ChannelDecommission() {
+		return nil, errors.New("Decommission may not be specified without the required capability")
+	}
+
 	for orgName, orgGroup := range ordererGroup.Groups {
+		if _, ok := orgGroup.Values[EndpointsKey]; ok && !oc.Capabilities().OrgEndpoints() {
+			return nil, errors.Errorf("Endpoints of org %s may not be specified without the required capability", orgName)
+		}
 		var err error
-		if oc.orgs[orgName], err = NewOrganizationConfig(orgName, orgGroup, mspConfig); err != nil {
+		if oc.orgs[orgName], err = NewOrdererOrgConfig(orgName, orgGroup, mspConfig); err != nil {
 			return nil, err
 		}
 	}
@@ -125,8 +136,14 @@ func (oc *OrdererConfig) MaxChannelsCount() uint64 {
 	return oc.protos.ChannelRestrictions.MaxCount
 }
 
+// Decommissioned returns whether the channel is decommissioned by all the
+// orderers serving it.
+func (oc *OrdererConfig) Decommissioned() bool {
+	return oc.protos.Decommission.Decommissioned
+}
+
 // Organizations returns a map of the orgs in the channel.
-func (oc *OrdererConfig) Organizations() map[string]Org {
+func (oc *OrdererConfig) Organizations() map[string]OrdererOrg {
 	return oc.orgs
 }
 
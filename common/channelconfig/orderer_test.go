@@ -9,7 +9,9 @@ package channelconfig
 import (
 	"testing"
 
+	cb "github.com/hyperledger/fabric/protos/common"
 	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protoutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -49,3 +51,58 @@ func TestKafkaBrokers(t *testing.T) {
 	oc = &OrdererConfig{protos: &OrdererProtos{KafkaBrokers: &ab.KafkaBrokers{Brokers: []string{"127.0.0.1", "foo.bar", "127.0.0.1:-1", "localhost:65536", "foo.bar.:9092", ".127.0.0.1:9092", "-foo.bar:9092"}}}}
 	assert.Error(t, oc.validateKafkaBrokers(), "Invalid kafka brokers")
 }
+
+func TestOrdererOrgEndpointsCapability(t *testing.T) {
+	ordererGroup := &cb.ConfigGroup{
+		Groups: map[string]*cb.ConfigGroup{
+			"OrdererOrg": {
+				Values: map[string]*cb.ConfigValue{
+					EndpointsKey: {
+						Value: protoutil.MarshalOrPanic(EndpointsValue([]string{"orderer0:7050"}).Value()),
+					},
+				},
+			},
+		},
+		Values: map[string]*cb.ConfigValue{
+			BatchSizeKey: {
+				Value: protoutil.MarshalOrPanic(BatchSizeValue(10, 1000, 500).Value()),
+			},
+			BatchTimeoutKey: {
+				Value: protoutil.MarshalOrPanic(BatchTimeoutValue("1s").Value()),
+			},
+		},
+	}
+
+	_, err := NewOrdererConfig(ordererGroup, nil)
+	assert.EqualError(t, err, "Endpoints of org OrdererOrg may not be specified without the required capability")
+}
+
+func TestOrdererDecommission(t *testing.T) {
+	ordererGroup := &cb.ConfigGroup{
+		Values: map[string]*cb.ConfigValue{
+			BatchSizeKey: {
+				Value: protoutil.MarshalOrPanic(BatchSizeValue(10, 1000, 500).Value()),
+			},
+			BatchTimeoutKey: {
+				Value: protoutil.MarshalOrPanic(BatchTimeoutValue("1s").Value()),
+			},
+		},
+	}
+
+	oc, err := NewOrdererConfig(ordererGroup, nil)
+	assert.NoError(t, err)
+	assert.False(t, oc.Decommissioned())
+
+	ordererGroup.Values[DecommissionKey] = &cb.ConfigValue{
+		Value: protoutil.MarshalOrPanic(DecommissionValue().Value()),
+	}
+	_, err = NewOrdererConfig(ordererGroup, nil)
+	assert.EqualError(t, err, "Decommission may not be specified without the required capability")
+
+	ordererGroup.Values[CapabilitiesKey] = &cb.ConfigValue{
+		Value: protoutil.MarshalOrPanic(CapabilitiesValue(map[string]bool{"V2_0": true}).Value()),
+	}
+	oc, err = NewOrdererConfig(ordererGroup, nil)
+	assert.NoError(t, err)
+	assert.True(t, oc.Decommissioned())
+}
@@ -0,0 +1,76 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package channelconfig
+
+import (
+	"fmt"
+	"net"
+
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/pkg/errors"
+)
+
+const (
+	// EndpointsKey is the key name for the Endpoints ConfigValue of orderer orgs
+	EndpointsKey = "Endpoints"
+)
+
+// OrdererOrgProtos are deserialized from the config
+type OrdererOrgProtos struct {
+	Endpoints *cb.OrdererAddresses
+}
+
+// OrdererOrgConfig defines the configuration for an orderer org
+type OrdererOrgConfig struct {
+	*OrganizationConfig
+	protos *OrdererOrgProtos
+	name   string
+}
+
+// NewOrdererOrgConfig creates a new config for an orderer org
+func NewOrdererOrgConfig(id string, orgGroup *cb.ConfigGroup, mspConfig *MSPConfigHandler) (*OrdererOrgConfig, error) {
+	if len(orgGroup.Groups) > 0 {
+		return nil, fmt.Errorf("OrdererOrg config does not allow sub-groups")
+	}
+
+	protos := &OrdererOrgProtos{}
+	orgProtos := &OrganizationProtos{}
+
+	if err := DeserializeProtoValuesFromGroup(orgGroup, protos, orgProtos); err != nil {
+		return nil, errors.Wrap(err, "failed to deserialize values")
+	}
+
+	ooc := &OrdererOrgConfig{
+		name:   id,
+		protos: protos,
+		OrganizationConfig: &OrganizationConfig{
+			name:             id,
+			protos:           orgProtos,
+			mspConfigHandler: mspConfig,
+		},
+	}
+
+	if err := ooc.Validate(); err != nil {
+		return nil, err
+	}
+
+	return ooc, nil
+}
+
+// Endpoints returns the endpoints of the ordering service nodes of this Organization
+func (ooc *OrdererOrgConfig) Endpoints() []string {
+	return ooc.protos.Endpoints.GetAddresses()
+}
+
+func (ooc *OrdererOrgConfig) Validate() error {
+	for _, endpoint := range ooc.protos.Endpoints.GetAddresses() {
+		if _, _, err := net.SplitHostPort(endpoint); err != nil {
+			return errors.Wrapf(err, "invalid orderer endpoint for org %s", ooc.name)
+		}
+	}
+	return ooc.OrganizationConfig.Validate()
+}
@@ -0,0 +1,34 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package channelconfig
+
+import (
+	"testing"
+
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrdererOrgInterface(t *testing.T) {
+	_ = OrdererOrg(&OrdererOrgConfig{})
+}
+
+func TestOrdererOrgEndpoints(t *testing.T) {
+	ooc := &OrdererOrgConfig{
+		name: "OrdererOrg1",
+		protos: &OrdererOrgProtos{
+			Endpoints: &cb.OrdererAddresses{Addresses: []string{"orderer0:7050", "orderer1"}},
+		},
+	}
+	assert.Equal(t, []string{"orderer0:7050", "orderer1"}, ooc.Endpoints())
+
+	err := ooc.Validate()
+	assert.EqualError(t, err, "invalid orderer endpoint for org OrdererOrg1: address orderer1: missing port in address")
+
+	ooc.protos.Endpoints = nil
+	assert.Empty(t, ooc.Endpoints())
+}
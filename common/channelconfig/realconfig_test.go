@@ -10,11 +10,15 @@ import (
 	"testing"
 
 	newchannelconfig "github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/common/configtx"
+	"github.com/hyperledger/fabric/common/metrics/metricsfakes"
+	"github.com/hyperledger/fabric/common/policies"
 	"github.com/hyperledger/fabric/common/tools/configtxgen/configtxgentest"
 	"github.com/hyperledger/fabric/common/tools/configtxgen/encoder"
 	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
 	"github.com/hyperledger/fabric/protoutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestWithRealConfigtx(t *testing.T) {
@@ -25,3 +29,35 @@ func TestWithRealConfigtx(t *testing.T) {
 	_, err := newchannelconfig.NewBundleFromEnvelope(env)
 	assert.NoError(t, err)
 }
+
+func TestWithRealConfigtxAndMetrics(t *testing.T) {
+	conf := configtxgentest.Load(genesisconfig.SampleDevModeSoloProfile)
+
+	gb := encoder.New(conf).GenesisBlockForChannel("foo")
+	env := protoutil.ExtractEnvelopeOrPanic(gb, 0)
+	payload := protoutil.UnmarshalPayloadOrPanic(env.Payload)
+	configEnv, err := configtx.UnmarshalConfigEnvelope(payload.Data)
+	require.NoError(t, err)
+
+	fakeCounter := &metricsfakes.Counter{}
+	fakeCounter.WithReturns(fakeCounter)
+	fakeProvider := &metricsfakes.Provider{}
+	fakeProvider.NewCounterReturns(fakeCounter)
+	fakeHistogram := &metricsfakes.Histogram{}
+	fakeHistogram.WithReturns(fakeHistogram)
+	fakeProvider.NewHistogramReturns(fakeHistogram)
+
+	bundle, err := newchannelconfig.NewBundleWithMetrics("foo", configEnv.Config, newchannelconfig.NewMetrics(fakeProvider))
+	require.NoError(t, err)
+
+	// The policies and the MSPs of the bundle report the metrics
+	policy, ok := bundle.PolicyManager().GetPolicy(policies.ChannelReaders)
+	require.True(t, ok)
+	assert.Error(t, policy.Evaluate(nil))
+	evaluations := fakeCounter.AddCallCount()
+	assert.NotZero(t, evaluations)
+	assert.Equal(t, evaluations, fakeHistogram.ObserveCallCount())
+	_, err = bundle.MSPManager().DeserializeIdentity([]byte("barf"))
+	assert.Error(t, err)
+	assert.Equal(t, evaluations+1, fakeCounter.AddCallCount())
+}
@@ -164,6 +164,17 @@ func KafkaBrokersValue(brokers []string) *StandardConfigValue {
 	}
 }
 
+// DecommissionValue returns the config definition which decommissions the channel.
+// It is a value for the /Channel/Orderer group.
+func DecommissionValue() *StandardConfigValue {
+	return &StandardConfigValue{
+		key: DecommissionKey,
+		value: &ab.Decommission{
+			Decommissioned: true,
+		},
+	}
+}
+
 // MSPValue returns the config definition for an MSP.
 // It is a value for the /Channel/Orderer/*, /Channel/Application/*, and /Channel/Consortiums/*/*/* groups.
 func MSPValue(mspDef *mspprotos.MSPConfig) *StandardConfigValue {
@@ -202,6 +213,26 @@ func AnchorPeersValue(anchorPeers []*pb.AnchorPeer) *StandardConfigValue {
 	}
 }
 
+// PeerEndpointsValue returns the config definition for the endpoints of an org's peers.
+// It is a value for the /Channel/Application/*.
+func PeerEndpointsValue(endpoints []string) *StandardConfigValue {
+	return &StandardConfigValue{
+		key:   PeerEndpointsKey,
+		value: &pb.PeerEndpoints{Endpoints: endpoints},
+	}
+}
+
+// EndpointsValue returns the config definition for the endpoints of an orderer org's ordering service nodes.
+// It is a value for the /Channel/Orderer/*.
+func EndpointsValue(addresses []string) *StandardConfigValue {
+	return &StandardConfigValue{
+		key: EndpointsKey,
+		value: &cb.OrdererAddresses{
+			Addresses: addresses,
+		},
+	}
+}
+
 // ChannelCreationPolicyValue returns the config definition for a consortium's channel creation policy
 // It is a value for the /Channel/Consortiums/*/*.
 func ChannelCreationPolicyValue(policy *cb.Policy) *StandardConfigValue {
@@ -228,6 +259,16 @@ func ACLValues(acls map[string]string) *StandardConfigValue {
 	}
 }
 
+// InvocationQuotaValue returns the config definition for the quota on the proposals
+// endorsed for each client identity.
+// It is a value for the /Channel/Application/.
+func InvocationQuotaValue(maxInvocations uint32, blockInterval uint64) *StandardConfigValue {
+	return &StandardConfigValue{
+		key:   InvocationQuotaKey,
+		value: &pb.InvocationQuota{MaxInvocations: maxInvocations, BlockInterval: blockInterval},
+	}
+}
+
 // ValidateCapabilities validates whether the peer can meet the capabilities requirement in the given config block
 func ValidateCapabilities(block *cb.Block) error {
 	envelopeConfig, err := protoutil.ExtractEnvelope(block, 0)
@@ -259,7 +300,7 @@ func ValidateCapabilities(block *cb.Block) error {
 			"configuration group", ApplicationGroupKey)
 	}
 
-	cc, err := NewChannelConfig(configEnv.Config.ChannelGroup)
+	cc, err := NewChannelConfig(configEnv.Config.ChannelGroup, nil)
 	if err != nil {
 		return errors.Errorf("no valid channel configuration found due to %s", err)
 	}
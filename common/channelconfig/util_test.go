@@ -48,8 +48,11 @@ func TestUtilsBasic(t *testing.T) {
 	basicTest(t, MSPValue(&mspprotos.MSPConfig{}))
 	basicTest(t, CapabilitiesValue(map[string]bool{"foo": true, "bar": false}))
 	basicTest(t, AnchorPeersValue([]*pb.AnchorPeer{{}, {}}))
+	basicTest(t, PeerEndpointsValue([]string{"foo:1", "bar:2"}))
+	basicTest(t, EndpointsValue([]string{"foo:1", "bar:2"}))
 	basicTest(t, ChannelCreationPolicyValue(&cb.Policy{}))
 	basicTest(t, ACLValues(map[string]string{"foo": "fooval", "bar": "barval"}))
+	basicTest(t, InvocationQuotaValue(10, 5))
 }
 
 // createCfgBlockWithSupportedCapabilities will create a config block that contains valid capabilities and should be accepted by the peer
@@ -0,0 +1,351 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package channelconfig
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/policies"
+	cb "github.com/hyperledger/fabric/protos/common"
+	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/orderer/etcdraft"
+)
+
+// ConfigError is a semantic constraint of the channel config which is
+// violated by the group, value or policy at Path.
+type ConfigError struct {
+	// Path is the path of the element in the config tree, such as
+	// /Channel/Orderer/BatchSize
+	Path string
+	// Message describes the violated constraint
+	Message string
+}
+
+func (ce *ConfigError) Error() string {
+	return fmt.Sprintf("%s: %s", ce.Path, ce.Message)
+}
+
+// ConfigErrors is the list of the constraints violated by a channel config.
+type ConfigErrors []*ConfigError
+
+func (ce ConfigErrors) Error() string {
+	messages := make([]string, len(ce))
+	for i, err := range ce {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// ValidateConfig checks the semantic constraints of the channel config which
+// its structure does not enforce: that the capabilities are supported and fit
+// the consensus type, that the mod_policy of the elements, the sub-policies
+// of the implicit meta policies and the ACLs refer to existing policies, that
+// the MSP definitions can be set up, and that the batch size and timeout are
+// sane. Unlike NewBundle, which stops at the first error, it returns all the
+// violations found, sorted by path, or nil if there are none.
+func ValidateConfig(config *cb.Config) ConfigErrors {
+	rootPath := policies.PathSeparator + RootGroupKey
+	if config == nil || config.ChannelGroup == nil {
+		return ConfigErrors{{Path: rootPath, Message: "config must contain a channel group"}}
+	}
+
+	cv := &configValidator{root: config.ChannelGroup}
+	if err := preValidate(config); err != nil {
+		cv.errorf(rootPath, "%s", err)
+	}
+	cv.validateChannel(rootPath, config.ChannelGroup)
+	cv.validatePolicyReferences(rootPath, config.ChannelGroup)
+
+	sort.SliceStable(cv.errors, func(i, j int) bool {
+		return cv.errors[i].Error() < cv.errors[j].Error()
+	})
+	return cv.errors
+}
+
+// ValidateConfigUpdate returns the violations which ValidateConfig finds in
+// the proposed config but not in the current config, so that an update is
+// not rejected because of the violations it did not introduce.
+func ValidateConfigUpdate(current, proposed *cb.Config) ConfigErrors {
+	existing := map[string]struct{}{}
+	for _, err := range ValidateConfig(current) {
+		existing[err.Error()] = struct{}{}
+	}
+
+	var result ConfigErrors
+	for _, err := range ValidateConfig(proposed) {
+		if _, ok := existing[err.Error()]; !ok {
+			result = append(result, err)
+		}
+	}
+	return result
+}
+
+type configValidator struct {
+	root       *cb.ConfigGroup
+	mspHandler *MSPConfigHandler
+	errors     ConfigErrors
+}
+
+func (cv *configValidator) errorf(path string, format string, args ...interface{}) {
+	cv.errors = append(cv.errors, &ConfigError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// deserializeValues deserializes the values of the group into the protos
+// structs, reporting each value which cannot be deserialized. It returns
+// the keys of these values.
+func (cv *configValidator) deserializeValues(path string, group *cb.ConfigGroup, protosStructs ...interface{}) map[string]bool {
+	sv, err := NewStandardValues(protosStructs...)
+	if err != nil {
+		logger.Panicf("This is a compile time bug only, the proto structures are somehow invalid: %s", err)
+	}
+
+	invalid := map[string]bool{}
+	for key, value := range group.Values {
+		if _, err := sv.Deserialize(key, value.Value); err != nil {
+			cv.errorf(path+policies.PathSeparator+key, "invalid value: %s", err)
+			invalid[key] = true
+		}
+	}
+	return invalid
+}
+
+// runValidators runs the validators of the values at the given keys of the
+// group, reporting each error at the path of its value.
+func (cv *configValidator) runValidators(path string, validators map[string]func() error) {
+	for key, validator := range validators {
+		if err := validator(); err != nil {
+			cv.errorf(path+policies.PathSeparator+key, "%s", err)
+		}
+	}
+}
+
+func (cv *configValidator) validateChannel(path string, group *cb.ConfigGroup) {
+	cc := &ChannelConfig{protos: &ChannelProtos{}}
+	cv.deserializeValues(path, group, cc.protos)
+	cv.runValidators(path, map[string]func() error{
+		HashingAlgorithmKey:          cc.validateHashingAlgorithm,
+		BlockDataHashingStructureKey: cc.validateBlockDataHashingStructure,
+		OrdererAddressesKey:          cc.validateOrdererAddresses,
+	})
+
+	capabilities := cc.Capabilities()
+	if err := capabilities.Supported(); err != nil {
+		cv.errorf(path+policies.PathSeparator+CapabilitiesKey, "%s", err)
+	}
+	cv.mspHandler = NewMSPConfigHandler(capabilities.MSPVersion(), nil)
+
+	for name, child := range group.Groups {
+		childPath := path + policies.PathSeparator + name
+		switch name {
+		case OrdererGroupKey:
+			cv.validateOrderer(childPath, child)
+		case ApplicationGroupKey:
+			cv.validateApplication(childPath, child)
+		case ConsortiumsGroupKey:
+			cv.validateConsortiums(childPath, child)
+		default:
+			cv.errorf(childPath, "disallowed channel group")
+		}
+	}
+}
+
+func (cv *configValidator) validateOrderer(path string, group *cb.ConfigGroup) {
+	oc := &OrdererConfig{protos: &OrdererProtos{}}
+	cv.deserializeValues(path, group, oc.protos)
+	cv.runValidators(path, map[string]func() error{
+		BatchSizeKey:    oc.validateBatchSize,
+		BatchTimeoutKey: oc.validateBatchTimeout,
+		KafkaBrokersKey: oc.validateKafkaBrokers,
+	})
+
+	capabilities := oc.Capabilities()
+	if err := capabilities.Supported(); err != nil {
+		cv.errorf(path+policies.PathSeparator+CapabilitiesKey, "%s", err)
+	}
+	cv.validateConsensusType(path+policies.PathSeparator+ConsensusTypeKey, oc.protos.ConsensusType, capabilities)
+
+	for name, org := range group.Groups {
+		cv.validateOrganization(path+policies.PathSeparator+name, name, org)
+	}
+}
+
+func (cv *configValidator) validateConsensusType(path string, consensusType *ab.ConsensusType, capabilities OrdererCapabilities) {
+	switch consensusType.Type {
+	case "solo", "kafka":
+	case "etcdraft":
+		// The Raft chains rely on the re-validated messages being
+		// re-submitted after a config change
+		if !capabilities.Resubmission() {
+			cv.errorf(path, "consensus type etcdraft requires the V1_1 orderer capability or later")
+		}
+		metadata := &etcdraft.Metadata{}
+		if err := proto.Unmarshal(consensusType.Metadata, metadata); err != nil {
+			cv.errorf(path, "invalid etcdraft metadata: %s", err)
+		} else if len(metadata.Consenters) == 0 {
+			cv.errorf(path, "etcdraft metadata has no consenters")
+		}
+	case "":
+		cv.errorf(path, "consensus type is not set")
+	default:
+		cv.errorf(path, "unknown consensus type %s", consensusType.Type)
+	}
+
+	if consensusType.MigrationState != ab.ConsensusType_MIG_STATE_NONE || consensusType.MigrationContext != 0 {
+		if !capabilities.Kafka2RaftMigration() {
+			cv.errorf(path, "consensus-type migration requires the V2_0 orderer capability")
+		}
+	}
+}
+
+func (cv *configValidator) validateApplication(path string, group *cb.ConfigGroup) {
+	ac := &ApplicationConfig{protos: &ApplicationProtos{}}
+	cv.deserializeValues(path, group, ac.protos)
+
+	capabilities := ac.Capabilities()
+	if err := capabilities.Supported(); err != nil {
+		cv.errorf(path+policies.PathSeparator+CapabilitiesKey, "%s", err)
+	}
+
+	aclsPath := path + policies.PathSeparator + ACLsKey
+	if _, ok := group.Values[ACLsKey]; ok && !capabilities.ACLs() {
+		cv.errorf(aclsPath, "ACLs may not be specified without the required capability")
+	}
+	for name, acl := range ac.protos.ACLs.Acls {
+		if acl.PolicyRef == "" {
+			cv.errorf(aclsPath, "ACL %s has no policy reference", name)
+			continue
+		}
+		policyRef := acl.PolicyRef
+		if !strings.HasPrefix(policyRef, policies.PathSeparator) {
+			policyRef = path + policies.PathSeparator + policyRef
+		}
+		if !cv.policyExists(policyRef) {
+			cv.errorf(aclsPath, "ACL %s refers to policy %s which does not exist", name, policyRef)
+		}
+	}
+
+	for name, org := range group.Groups {
+		cv.validateOrganization(path+policies.PathSeparator+name, name, org, &ApplicationOrgProtos{})
+	}
+}
+
+func (cv *configValidator) validateConsortiums(path string, group *cb.ConfigGroup) {
+	for consortiumName, consortium := range group.Groups {
+		consortiumPath := path + policies.PathSeparator + consortiumName
+		cv.deserializeValues(consortiumPath, consortium, &ConsortiumProtos{})
+		for name, org := range consortium.Groups {
+			cv.validateOrganization(consortiumPath+policies.PathSeparator+name, name, org)
+		}
+	}
+}
+
+// validateOrganization checks that the MSP definition of the organization
+// can be set up, and deserializes its other values into the protos structs.
+func (cv *configValidator) validateOrganization(path, name string, group *cb.ConfigGroup, protosStructs ...interface{}) {
+	if len(group.Groups) > 0 {
+		cv.errorf(path, "organizations do not support sub-groups")
+	}
+
+	oc := &OrganizationConfig{
+		protos:           &OrganizationProtos{},
+		name:             name,
+		mspConfigHandler: cv.mspHandler,
+	}
+	invalid := cv.deserializeValues(path, group, append(protosStructs, oc.protos)...)
+
+	mspPath := path + policies.PathSeparator + MSPKey
+	if _, ok := group.Values[MSPKey]; !ok {
+		cv.errorf(mspPath, "organization has no MSP definition")
+		return
+	}
+	if invalid[MSPKey] {
+		return
+	}
+	if err := oc.validateMSP(); err != nil {
+		cv.errorf(mspPath, "invalid MSP definition: %s", err)
+	}
+}
+
+// validatePolicyReferences checks that the mod_policy of the group and of its
+// values and policies, and the sub-policies of its implicit meta policies,
+// refer to existing policies, recursing into the sub-groups. An empty
+// mod_policy, which prevents the modification of the element, is accepted.
+func (cv *configValidator) validatePolicyReferences(path string, group *cb.ConfigGroup) {
+	cv.validateModPolicy(path, path, group.ModPolicy)
+	for name, value := range group.Values {
+		cv.validateModPolicy(path+policies.PathSeparator+name, path, value.ModPolicy)
+	}
+
+	for name, configPolicy := range group.Policies {
+		policyPath := path + policies.PathSeparator + name
+		cv.validateModPolicy(policyPath, path, configPolicy.ModPolicy)
+		if configPolicy.Policy == nil || configPolicy.Policy.Type != int32(cb.Policy_IMPLICIT_META) {
+			continue
+		}
+
+		implicitMeta := &cb.ImplicitMetaPolicy{}
+		if err := proto.Unmarshal(configPolicy.Policy.Value, implicitMeta); err != nil {
+			cv.errorf(policyPath, "invalid implicit meta policy: %s", err)
+			continue
+		}
+		if len(group.Groups) == 0 {
+			continue
+		}
+		defined := false
+		for _, child := range group.Groups {
+			if _, ok := child.Policies[implicitMeta.SubPolicy]; ok {
+				defined = true
+				break
+			}
+		}
+		if !defined {
+			cv.errorf(policyPath, "implicit meta policy refers to sub-policy %s which is not defined by any sub-group", implicitMeta.SubPolicy)
+		}
+	}
+
+	for name, child := range group.Groups {
+		cv.validatePolicyReferences(path+policies.PathSeparator+name, child)
+	}
+}
+
+// validateModPolicy checks that the mod_policy of the element at the given
+// path, which is relative to the given group path unless it is absolute,
+// refers to an existing policy.
+func (cv *configValidator) validateModPolicy(path, groupPath, modPolicy string) {
+	if modPolicy == "" {
+		return
+	}
+	policyPath := modPolicy
+	if !strings.HasPrefix(modPolicy, policies.PathSeparator) {
+		policyPath = groupPath + policies.PathSeparator + modPolicy
+	}
+	if !cv.policyExists(policyPath) {
+		cv.errorf(path, "mod_policy %s refers to policy %s which does not exist", modPolicy, policyPath)
+	}
+}
+
+// policyExists returns whether the config defines a policy at the given
+// absolute path.
+func (cv *configValidator) policyExists(policyPath string) bool {
+	elements := strings.Split(strings.TrimPrefix(policyPath, policies.PathSeparator), policies.PathSeparator)
+	if len(elements) < 2 || elements[0] != RootGroupKey {
+		return false
+	}
+
+	group := cv.root
+	for _, name := range elements[1 : len(elements)-1] {
+		var ok bool
+		if group, ok = group.Groups[name]; !ok {
+			return false
+		}
+	}
+	_, ok := group.Policies[elements[len(elements)-1]]
+	return ok
+}
@@ -0,0 +1,144 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package channelconfig_test
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/common/tools/configtxgen/configtxgentest"
+	"github.com/hyperledger/fabric/common/tools/configtxgen/encoder"
+	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
+	cb "github.com/hyperledger/fabric/protos/common"
+	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/orderer/etcdraft"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sampleConfig(t *testing.T, profile string) *cb.Config {
+	channelGroup, err := encoder.NewChannelGroup(configtxgentest.Load(profile))
+	require.NoError(t, err)
+	return &cb.Config{ChannelGroup: channelGroup}
+}
+
+func messages(errs channelconfig.ConfigErrors) []string {
+	var result []string
+	for _, err := range errs {
+		result = append(result, err.Error())
+	}
+	return result
+}
+
+func TestValidateConfigSampleProfiles(t *testing.T) {
+	for _, profile := range []string{
+		genesisconfig.SampleInsecureSoloProfile,
+		genesisconfig.SampleDevModeSoloProfile,
+		genesisconfig.SampleSingleMSPSoloProfile,
+		genesisconfig.SampleInsecureKafkaProfile,
+		genesisconfig.SampleDevModeKafkaProfile,
+		genesisconfig.SampleSingleMSPKafkaProfile,
+	} {
+		t.Run(profile, func(t *testing.T) {
+			assert.Empty(t, messages(channelconfig.ValidateConfig(sampleConfig(t, profile))))
+		})
+	}
+}
+
+func TestValidateConfigEtcdRaft(t *testing.T) {
+	config := sampleConfig(t, genesisconfig.SampleDevModeSoloProfile)
+	orderer := config.ChannelGroup.Groups[channelconfig.OrdererGroupKey]
+	orderer.Values[channelconfig.ConsensusTypeKey].Value = protoutil.MarshalOrPanic(&ab.ConsensusType{
+		Type: "etcdraft",
+		Metadata: protoutil.MarshalOrPanic(&etcdraft.Metadata{
+			Consenters: []*etcdraft.Consenter{{Host: "raft0.example.com", Port: 7050}},
+		}),
+	})
+	assert.Empty(t, messages(channelconfig.ValidateConfig(config)))
+
+	orderer.Values[channelconfig.CapabilitiesKey].Value = protoutil.MarshalOrPanic(&cb.Capabilities{})
+	assert.Equal(t, []string{
+		"/Channel/Orderer/ConsensusType: consensus type etcdraft requires the V1_1 orderer capability or later",
+	}, messages(channelconfig.ValidateConfig(config)))
+}
+
+func TestValidateConfig(t *testing.T) {
+	assert.Equal(t, []string{"/Channel: config must contain a channel group"}, messages(channelconfig.ValidateConfig(&cb.Config{})))
+
+	config := sampleConfig(t, genesisconfig.SampleDevModeSoloProfile)
+	orderer := config.ChannelGroup.Groups[channelconfig.OrdererGroupKey]
+	orderer.Values[channelconfig.BatchSizeKey].Value = protoutil.MarshalOrPanic(&ab.BatchSize{
+		MaxMessageCount:   10,
+		AbsoluteMaxBytes:  1024,
+		PreferredMaxBytes: 2048,
+	})
+	orderer.Values[channelconfig.ConsensusTypeKey].Value = protoutil.MarshalOrPanic(&ab.ConsensusType{
+		Type: "etcdraft",
+	})
+	orderer.Values[channelconfig.BatchTimeoutKey].ModPolicy = "Missing"
+	orderer.Groups["SampleOrg"].Values[channelconfig.MSPKey].Value = []byte("garbage")
+	orderer.Policies[channelconfig.AdminsPolicyKey].Policy.Value = protoutil.MarshalOrPanic(&cb.ImplicitMetaPolicy{
+		SubPolicy: "Missing",
+		Rule:      cb.ImplicitMetaPolicy_MAJORITY,
+	})
+	application := config.ChannelGroup.Groups[channelconfig.ApplicationGroupKey]
+	application.Values[channelconfig.ACLsKey] = &cb.ConfigValue{
+		ModPolicy: channelconfig.AdminsPolicyKey,
+		Value: protoutil.MarshalOrPanic(&pb.ACLs{
+			Acls: map[string]*pb.APIResource{
+				"peer/Propose":  {PolicyRef: "Writers"},
+				"event/Block":   {PolicyRef: "/Channel/Application/Missing"},
+				"qscc/GetBlock": {},
+			},
+		}),
+	}
+
+	assert.Equal(t, []string{
+		"/Channel/Application/ACLs: ACL event/Block refers to policy /Channel/Application/Missing which does not exist",
+		"/Channel/Application/ACLs: ACL qscc/GetBlock has no policy reference",
+		"/Channel/Orderer/Admins: implicit meta policy refers to sub-policy Missing which is not defined by any sub-group",
+		"/Channel/Orderer/BatchSize: Attempted to set the batch size preferred max bytes (2048) greater than the absolute max bytes (1024).",
+		"/Channel/Orderer/BatchTimeout: mod_policy Missing refers to policy /Channel/Orderer/Missing which does not exist",
+		"/Channel/Orderer/ConsensusType: etcdraft metadata has no consenters",
+		"/Channel/Orderer/SampleOrg/MSP: invalid value: proto: can't skip unknown wire type 7",
+	}, messages(channelconfig.ValidateConfig(config)))
+}
+
+func TestValidateConfigCapabilities(t *testing.T) {
+	config := sampleConfig(t, genesisconfig.SampleDevModeSoloProfile)
+	orderer := config.ChannelGroup.Groups[channelconfig.OrdererGroupKey]
+	orderer.Values[channelconfig.CapabilitiesKey].Value = protoutil.MarshalOrPanic(&cb.Capabilities{
+		Capabilities: map[string]*cb.Capability{"V9_9": {}},
+	})
+	orderer.Values[channelconfig.ConsensusTypeKey].Value = protoutil.MarshalOrPanic(&ab.ConsensusType{
+		Type:           "kafka",
+		MigrationState: ab.ConsensusType_MIG_STATE_START,
+	})
+	config.ChannelGroup.Groups["Unknown"] = &cb.ConfigGroup{}
+
+	assert.Equal(t, []string{
+		"/Channel/Orderer/Capabilities: Orderer capability V9_9 is required but not supported",
+		"/Channel/Orderer/ConsensusType: consensus-type migration requires the V2_0 orderer capability",
+		"/Channel/Unknown: disallowed channel group",
+	}, messages(channelconfig.ValidateConfig(config)))
+}
+
+func TestValidateConfigUpdate(t *testing.T) {
+	current := sampleConfig(t, genesisconfig.SampleDevModeSoloProfile)
+	current.ChannelGroup.Values[channelconfig.HashingAlgorithmKey].ModPolicy = "Missing"
+	assert.Len(t, channelconfig.ValidateConfig(current), 1)
+
+	proposed := proto.Clone(current).(*cb.Config)
+	assert.Nil(t, channelconfig.ValidateConfigUpdate(current, proposed))
+
+	proposed.ChannelGroup.Values[channelconfig.OrdererAddressesKey].ModPolicy = "/Channel/Missing"
+	errs := channelconfig.ValidateConfigUpdate(current, proposed)
+	assert.EqualError(t, errs, "/Channel/OrdererAddresses: mod_policy /Channel/Missing refers to policy /Channel/Missing which does not exist")
+}
@@ -0,0 +1,62 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package deliver
+
+import (
+	"bytes"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/ledger/blockledger"
+	cb "github.com/hyperledger/fabric/protos/common"
+	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+)
+
+// CheckpointToken returns the resume token of the given block, which a client
+// can use in a SeekCheckpoint to resume the delivery after the block.
+func CheckpointToken(block *cb.Block) []byte {
+	if block == nil || block.Header == nil {
+		return nil
+	}
+	return protoutil.MarshalOrPanic(&ab.DeliverCheckpoint{
+		Number:     block.Header.Number,
+		HeaderHash: protoutil.BlockHeaderHash(block.Header),
+	})
+}
+
+// resumePosition returns the position of the block following the block of
+// the given checkpoint, after verifying that the block of the checkpoint is
+// part of the ledger.
+func resumePosition(reader blockledger.Reader, checkpoint *ab.SeekCheckpoint) (*ab.SeekPosition, error) {
+	if checkpoint == nil {
+		return nil, errors.New("missing checkpoint")
+	}
+	cp := &ab.DeliverCheckpoint{}
+	if err := proto.Unmarshal(checkpoint.Token, cp); err != nil {
+		return nil, errors.Wrap(err, "malformed resume token")
+	}
+	if height := reader.Height(); cp.Number >= height {
+		return nil, errors.Errorf("resume token refers to block [%d] beyond the ledger height %d", cp.Number, height)
+	}
+
+	itr, _ := reader.Iterator(&ab.SeekPosition{
+		Type: &ab.SeekPosition_Specified{Specified: &ab.SeekSpecified{Number: cp.Number}},
+	})
+	defer itr.Close()
+	block, status := itr.Next()
+	if status != cb.Status_SUCCESS {
+		return nil, errors.Errorf("failed reading block [%d] of resume token: %s", cp.Number, status)
+	}
+	if !bytes.Equal(protoutil.BlockHeaderHash(block.Header), cp.HeaderHash) {
+		return nil, errors.Errorf("resume token does not match the header of block [%d]", cp.Number)
+	}
+
+	return &ab.SeekPosition{
+		Type: &ab.SeekPosition_Specified{Specified: &ab.SeekSpecified{Number: cp.Number + 1}},
+	}, nil
+}
@@ -91,6 +91,8 @@ type Handler struct {
 	TimeWindow       time.Duration
 	BindingInspector Inspector
 	Metrics          *Metrics
+	// SendQueue configures the queue of blocks sent to each client
+	SendQueue SendQueueConfig
 }
 
 //go:generate counterfeiter -o mock/receiver.go -fake-name Receiver . Receiver
@@ -106,7 +108,9 @@ type Receiver interface {
 // responses.
 type ResponseSender interface {
 	SendStatusResponse(status cb.Status) error
-	SendBlockResponse(block *cb.Block) error
+	// SendBlockResponse sends a block of the chain to the client whose request
+	// is identified by the signed data
+	SendBlockResponse(block *cb.Block, channelID string, chain Chain, signedData *protoutil.SignedData) error
 }
 
 // Filtered is a marker interface that indicates a response sender
@@ -210,6 +214,13 @@ func (h *Handler) deliverBlocks(ctx context.Context, srv *Server, envelope *cb.E
 		return cb.Status_BAD_REQUEST, nil
 	}
 
+	shdr, err := protoutil.GetSignatureHeader(payload.Header.SignatureHeader)
+	if err != nil {
+		logger.Warningf("Failed to unmarshal signature header from %s: %s", addr, err)
+		return cb.Status_BAD_REQUEST, nil
+	}
+	signedData := &protoutil.SignedData{Data: envelope.Payload, Identity: shdr.Creator, Signature: envelope.Signature}
+
 	chain := h.ChainManager.GetChain(chdr.ChannelId)
 	if chain == nil {
 		// Note, we log this at DEBUG because SDKs will poll waiting for channels to be created
@@ -258,10 +269,24 @@ func (h *Handler) deliverBlocks(ctx context.Context, srv *Server, envelope *cb.E
 		return cb.Status_BAD_REQUEST, nil
 	}
 
+	if _, ok := ab.SeekInfo_SeekContentFilter_name[int32(seekInfo.ContentFilter)]; !ok {
+		logger.Warningf("[channel: %s] Received seekInfo message from %s with unknown content filter %d", chdr.ChannelId, addr, seekInfo.ContentFilter)
+		return cb.Status_BAD_REQUEST, nil
+	}
+
 	logger.Debugf("[channel: %s] Received seekInfo (%p) %v from %s", chdr.ChannelId, seekInfo, seekInfo, addr)
 
-	cursor, number := chain.Reader().Iterator(seekInfo.Start)
-	defer cursor.Close()
+	start := seekInfo.Start
+	if checkpoint, ok := start.Type.(*ab.SeekPosition_Checkpoint); ok {
+		start, err = resumePosition(chain.Reader(), checkpoint.Checkpoint)
+		if err != nil {
+			logger.Warningf("[channel: %s] Received seekInfo message from %s with invalid checkpoint: %s", chdr.ChannelId, addr, err)
+			return cb.Status_BAD_REQUEST, nil
+		}
+	}
+
+	cursor, number := chain.Reader().Iterator(start)
+	defer func() { cursor.Close() }()
 	var stopNum uint64
 	switch stop := seekInfo.Stop.Type.(type) {
 	case *ab.SeekPosition_Oldest:
@@ -282,6 +307,27 @@ func (h *Handler) deliverBlocks(ctx context.Context, srv *Server, envelope *cb.E
 			logger.Warningf("[channel: %s] Received invalid seekInfo message from %s: start number %d greater than stop number %d", chdr.ChannelId, addr, number, stopNum)
 			return cb.Status_BAD_REQUEST, nil
 		}
+	case *ab.SeekPosition_Timestamp:
+		logger.Warningf("[channel: %s] Received invalid seekInfo message from %s: a timestamp can only be used as start position", chdr.ChannelId, addr)
+		return cb.Status_BAD_REQUEST, nil
+	case *ab.SeekPosition_Checkpoint:
+		logger.Warningf("[channel: %s] Received invalid seekInfo message from %s: a checkpoint can only be used as start position", chdr.ChannelId, addr)
+		return cb.Status_BAD_REQUEST, nil
+	}
+
+	var queue *sendQueue
+	if h.SendQueue.Size > 0 {
+		send := func(block *cb.Block) error {
+			return srv.SendBlockResponse(block, chdr.ChannelId, chain, signedData)
+		}
+		queue = newSendQueue(h.SendQueue.Size, send, func() { h.Metrics.BlocksSent.With(labels...).Add(1) })
+		defer func() {
+			// the block being sent to a disconnected slow client is abandoned,
+			// as the stream is only closed once the handler returns
+			if err != errSlowConsumer {
+				queue.shutdown(ctx)
+			}
+		}()
 	}
 
 	for {
@@ -324,25 +370,86 @@ func (h *Handler) deliverBlocks(ctx context.Context, srv *Server, envelope *cb.E
 			return cb.Status_FORBIDDEN, nil
 		}
 
-		logger.Debugf("[channel: %s] Delivering block [%d] for (%p) for %s", chdr.ChannelId, block.Header.Number, seekInfo, addr)
-
-		if err := srv.SendBlockResponse(block); err != nil {
-			logger.Warningf("[channel: %s] Error sending to %s: %s", chdr.ChannelId, addr, err)
-			return cb.Status_INTERNAL_SERVER_ERROR, err
+		if matchesContentFilter(seekInfo.ContentFilter, block) {
+			logger.Debugf("[channel: %s] Delivering block [%d] for (%p) for %s", chdr.ChannelId, block.Header.Number, seekInfo, addr)
+
+			if queue == nil {
+				if err := srv.SendBlockResponse(block, chdr.ChannelId, chain, signedData); err != nil {
+					logger.Warningf("[channel: %s] Error sending to %s: %s", chdr.ChannelId, addr, err)
+					return cb.Status_INTERNAL_SERVER_ERROR, err
+				}
+				h.Metrics.BlocksSent.With(labels...).Add(1)
+			} else {
+				queued, err := queue.enqueue(ctx, block, h.SendQueue.Timeout)
+				if err != nil {
+					logger.Warningf("[channel: %s] Error sending to %s: %s", chdr.ChannelId, addr, err)
+					return cb.Status_INTERNAL_SERVER_ERROR, err
+				}
+				if !queued {
+					h.Metrics.SlowConsumers.With(labels...).Add(1)
+					if h.SendQueue.Policy != DropToCheckpoint {
+						logger.Warningf("[channel: %s] Disconnecting %s because it did not receive the %d blocks queued for it within %s", chdr.ChannelId, addr, h.SendQueue.Size, h.SendQueue.Timeout)
+						// the queued blocks are dropped, and the status is only sent once
+						// the block being sent is received, as they share the stream
+						queue.drop()
+						shutdownCtx, cancel := context.WithTimeout(ctx, h.SendQueue.Timeout)
+						defer cancel()
+						if err := queue.shutdown(shutdownCtx); err != nil {
+							return cb.Status_SERVICE_UNAVAILABLE, errSlowConsumer
+						}
+						return cb.Status_SERVICE_UNAVAILABLE, nil
+					}
+
+					checkpoint, dropped := queue.drop()
+					if checkpoint == nil {
+						checkpoint = block
+					}
+					h.Metrics.BlocksDropped.With(labels...).Add(float64(dropped + 1))
+					logger.Warningf("[channel: %s] Dropped %d blocks queued for %s because it did not receive them within %s, resuming from block [%d] once the blocks being sent are received", chdr.ChannelId, dropped+1, addr, h.SendQueue.Timeout, checkpoint.Header.Number)
+					if err := queue.flush(ctx); err != nil {
+						logger.Warningf("[channel: %s] Error sending to %s: %s", chdr.ChannelId, addr, err)
+						return cb.Status_INTERNAL_SERVER_ERROR, err
+					}
+
+					cursor.Close()
+					cursor, number = chain.Reader().Iterator(&ab.SeekPosition{
+						Type: &ab.SeekPosition_Specified{Specified: &ab.SeekSpecified{Number: checkpoint.Header.Number}},
+					})
+					continue
+				}
+			}
+		} else {
+			logger.Debugf("[channel: %s] Skipping block [%d] for (%p) for %s, filtered by %s", chdr.ChannelId, block.Header.Number, seekInfo, addr, seekInfo.ContentFilter)
 		}
 
-		h.Metrics.BlocksSent.With(labels...).Add(1)
-
 		if stopNum == block.Header.Number {
 			break
 		}
 	}
 
+	if queue != nil {
+		if err := queue.flush(ctx); err != nil {
+			logger.Warningf("[channel: %s] Error sending to %s: %s", chdr.ChannelId, addr, err)
+			return cb.Status_INTERNAL_SERVER_ERROR, err
+		}
+	}
+
 	logger.Debugf("[channel: %s] Done delivering to %s for (%p)", chdr.ChannelId, addr, seekInfo)
 
 	return cb.Status_SUCCESS, nil
 }
 
+// matchesContentFilter returns whether the block should be delivered
+// to a client which requested the given content filter.
+func matchesContentFilter(filter ab.SeekInfo_SeekContentFilter, block *cb.Block) bool {
+	switch filter {
+	case ab.SeekInfo_CONFIG_BLOCKS_ONLY:
+		return protoutil.IsConfigBlock(block)
+	default:
+		return true
+	}
+}
+
 func (h *Handler) validateChannelHeader(ctx context.Context, chdr *cb.ChannelHeader) error {
 	if chdr.GetTimestamp() == nil {
 		err := errors.New("channel header in envelope must contain timestamp")
@@ -85,6 +85,24 @@ var _ = Describe("Deliver", func() {
 		})
 	})
 
+	Describe("CheckpointToken", func() {
+		It("encodes the number and the header hash of the block", func() {
+			block := &cb.Block{Header: &cb.BlockHeader{Number: 5, DataHash: []byte("data-hash")}}
+
+			checkpoint := &ab.DeliverCheckpoint{}
+			err := proto.Unmarshal(deliver.CheckpointToken(block), checkpoint)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checkpoint.Number).To(Equal(uint64(5)))
+			Expect(checkpoint.HeaderHash).To(Equal(protoutil.BlockHeaderHash(block.Header)))
+		})
+
+		Context("when the block has no header", func() {
+			It("returns nil", func() {
+				Expect(deliver.CheckpointToken(&cb.Block{})).To(BeNil())
+			})
+		})
+	})
+
 	Describe("Handle", func() {
 		var (
 			errCh                 chan struct{}
@@ -101,6 +119,8 @@ var _ = Describe("Deliver", func() {
 			fakeRequestsReceived  *metricsfakes.Counter
 			fakeRequestsCompleted *metricsfakes.Counter
 			fakeBlocksSent        *metricsfakes.Counter
+			fakeSlowConsumers     *metricsfakes.Counter
+			fakeBlocksDropped     *metricsfakes.Counter
 
 			handler *deliver.Handler
 			server  *deliver.Server
@@ -149,6 +169,10 @@ var _ = Describe("Deliver", func() {
 			fakeRequestsCompleted.WithReturns(fakeRequestsCompleted)
 			fakeBlocksSent = &metricsfakes.Counter{}
 			fakeBlocksSent.WithReturns(fakeBlocksSent)
+			fakeSlowConsumers = &metricsfakes.Counter{}
+			fakeSlowConsumers.WithReturns(fakeSlowConsumers)
+			fakeBlocksDropped = &metricsfakes.Counter{}
+			fakeBlocksDropped.WithReturns(fakeBlocksDropped)
 
 			deliverMetrics := &deliver.Metrics{
 				StreamsOpened:     fakeStreamsOpened,
@@ -156,6 +180,8 @@ var _ = Describe("Deliver", func() {
 				RequestsReceived:  fakeRequestsReceived,
 				RequestsCompleted: fakeRequestsCompleted,
 				BlocksSent:        fakeBlocksSent,
+				SlowConsumers:     fakeSlowConsumers,
+				BlocksDropped:     fakeBlocksDropped,
 			}
 
 			handler = &deliver.Handler{
@@ -301,6 +327,18 @@ var _ = Describe("Deliver", func() {
 			Expect(proto.Equal(startPosition, seekInfo.Start)).To(BeTrue())
 		})
 
+		It("sends the blocks along with the channel, the chain and the signed data of the request", func() {
+			err := handler.Handle(context.Background(), server)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeResponseSender.SendBlockResponseCallCount()).To(Equal(1))
+			block, channelID, chain, signedData := fakeResponseSender.SendBlockResponseArgsForCall(0)
+			Expect(block.Header.Number).To(Equal(uint64(100)))
+			Expect(channelID).To(Equal("chain-id"))
+			Expect(chain).To(Equal(fakeChain))
+			Expect(signedData).To(Equal(&protoutil.SignedData{Data: envelope.Payload, Signature: envelope.Signature}))
+		})
+
 		Context("when multiple blocks are requested", func() {
 			BeforeEach(func() {
 				fakeBlockIterator.NextStub = func() (*cb.Block, cb.Status) {
@@ -323,7 +361,7 @@ var _ = Describe("Deliver", func() {
 
 				Expect(fakeResponseSender.SendBlockResponseCallCount()).To(Equal(5))
 				for i := 0; i < 5; i++ {
-					b := fakeResponseSender.SendBlockResponseArgsForCall(i)
+					b, _, _, _ := fakeResponseSender.SendBlockResponseArgsForCall(i)
 					Expect(b).To(Equal(&cb.Block{
 						Header: &cb.BlockHeader{Number: 995 + uint64(i)},
 					}))
@@ -381,7 +419,7 @@ var _ = Describe("Deliver", func() {
 				Expect(fakeBlockIterator.NextCallCount()).To(Equal(1))
 
 				Expect(fakeResponseSender.SendBlockResponseCallCount()).To(Equal(1))
-				b := fakeResponseSender.SendBlockResponseArgsForCall(0)
+				b, _, _, _ := fakeResponseSender.SendBlockResponseArgsForCall(0)
 				Expect(b).To(Equal(&cb.Block{
 					Header: &cb.BlockHeader{Number: 100},
 				}))
@@ -412,7 +450,7 @@ var _ = Describe("Deliver", func() {
 				Expect(fakeBlockIterator.NextCallCount()).To(Equal(2))
 				Expect(fakeResponseSender.SendBlockResponseCallCount()).To(Equal(2))
 				for i := 0; i < fakeResponseSender.SendBlockResponseCallCount(); i++ {
-					b := fakeResponseSender.SendBlockResponseArgsForCall(i)
+					b, _, _, _ := fakeResponseSender.SendBlockResponseArgsForCall(i)
 					Expect(b).To(Equal(&cb.Block{
 						Header: &cb.BlockHeader{Number: uint64(i + 1)},
 					}))
@@ -443,7 +481,7 @@ var _ = Describe("Deliver", func() {
 				Expect(fakeBlockIterator.NextCallCount()).To(Equal(1))
 				Expect(fakeResponseSender.SendBlockResponseCallCount()).To(Equal(1))
 				for i := 0; i < fakeResponseSender.SendBlockResponseCallCount(); i++ {
-					b := fakeResponseSender.SendBlockResponseArgsForCall(i)
+					b, _, _, _ := fakeResponseSender.SendBlockResponseArgsForCall(i)
 					Expect(b).To(Equal(&cb.Block{
 						Header: &cb.BlockHeader{Number: uint64(i)},
 					}))
@@ -792,6 +830,185 @@ var _ = Describe("Deliver", func() {
 			})
 		})
 
+		Context("when only config blocks are requested", func() {
+			var configBlock *cb.Block
+
+			BeforeEach(func() {
+				configBlock = &cb.Block{
+					Header: &cb.BlockHeader{Number: 997},
+					Data: &cb.BlockData{
+						Data: [][]byte{protoutil.MarshalOrPanic(&cb.Envelope{
+							Payload: protoutil.MarshalOrPanic(&cb.Payload{
+								Header: &cb.Header{
+									ChannelHeader: protoutil.MarshalOrPanic(&cb.ChannelHeader{
+										Type: int32(cb.HeaderType_CONFIG),
+									}),
+								},
+							}),
+						})},
+					},
+				}
+				fakeBlockIterator.NextStub = func() (*cb.Block, cb.Status) {
+					number := 994 + uint64(fakeBlockIterator.NextCallCount())
+					if number == configBlock.Header.Number {
+						return configBlock, cb.Status_SUCCESS
+					}
+					return &cb.Block{Header: &cb.BlockHeader{Number: number}}, cb.Status_SUCCESS
+				}
+				seekInfo = &ab.SeekInfo{
+					Start: &ab.SeekPosition{
+						Type: &ab.SeekPosition_Specified{Specified: &ab.SeekSpecified{Number: 995}},
+					},
+					Stop:          seekNewest,
+					ContentFilter: ab.SeekInfo_CONFIG_BLOCKS_ONLY,
+				}
+			})
+
+			It("skips blocks which are not config blocks", func() {
+				err := handler.Handle(context.Background(), server)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeBlockIterator.NextCallCount()).To(Equal(5))
+				Expect(fakeResponseSender.SendBlockResponseCallCount()).To(Equal(1))
+				block, _, _, _ := fakeResponseSender.SendBlockResponseArgsForCall(0)
+				Expect(block).To(Equal(configBlock))
+				Expect(fakeBlocksSent.AddCallCount()).To(Equal(1))
+
+				Expect(fakeResponseSender.SendStatusResponseCallCount()).To(Equal(1))
+				Expect(fakeResponseSender.SendStatusResponseArgsForCall(0)).To(Equal(cb.Status_SUCCESS))
+			})
+		})
+
+		Context("when the blocks are sent from a send queue", func() {
+			var sentBlocks chan uint64
+
+			BeforeEach(func() {
+				handler.SendQueue = deliver.SendQueueConfig{Size: 1, Timeout: 10 * time.Millisecond}
+				fakeBlockReader.IteratorStub = func(start *ab.SeekPosition) (blockledger.Iterator, uint64) {
+					number := start.GetSpecified().Number
+					iterator := &mock.BlockIterator{}
+					iterator.NextStub = func() (*cb.Block, cb.Status) {
+						block := &cb.Block{Header: &cb.BlockHeader{Number: number}}
+						number++
+						return block, cb.Status_SUCCESS
+					}
+					return iterator, number
+				}
+				seekInfo.Stop = &ab.SeekPosition{
+					Type: &ab.SeekPosition_Specified{Specified: &ab.SeekSpecified{Number: 103}},
+				}
+				sentBlocks = make(chan uint64, 10)
+				fakeResponseSender.SendBlockResponseStub = func(block *cb.Block, _ string, _ deliver.Chain, _ *protoutil.SignedData) error {
+					sentBlocks <- block.Header.Number
+					return nil
+				}
+			})
+
+			It("sends the blocks before the status", func() {
+				err := handler.Handle(context.Background(), server)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(sentBlocks).To(HaveLen(4))
+				Expect(fakeBlocksSent.AddCallCount()).To(Equal(4))
+				Expect(fakeResponseSender.SendStatusResponseCallCount()).To(Equal(1))
+				Expect(fakeResponseSender.SendStatusResponseArgsForCall(0)).To(Equal(cb.Status_SUCCESS))
+			})
+
+			Context("when the client does not keep up", func() {
+				var release chan struct{}
+
+				BeforeEach(func() {
+					release = make(chan struct{})
+					sent := sentBlocks
+					fakeResponseSender.SendBlockResponseStub = func(block *cb.Block, _ string, _ deliver.Chain, _ *protoutil.SignedData) error {
+						if block.Header.Number == 100 {
+							<-release
+						}
+						sent <- block.Header.Number
+						return nil
+					}
+				})
+
+				It("disconnects the client", func() {
+					handler.SendQueue.Timeout = 100 * time.Millisecond
+					go func() {
+						defer GinkgoRecover()
+						Eventually(fakeSlowConsumers.AddCallCount).Should(Equal(1))
+						close(release)
+					}()
+
+					err := handler.Handle(context.Background(), server)
+					Expect(err).NotTo(HaveOccurred())
+
+					// the block being sent is sent before the status, and the queued blocks are dropped
+					Expect(sentBlocks).To(Receive(Equal(uint64(100))))
+					Expect(sentBlocks).NotTo(Receive())
+					Expect(fakeBlocksSent.AddCallCount()).To(Equal(1))
+					Expect(fakeResponseSender.SendStatusResponseCallCount()).To(Equal(1))
+					Expect(fakeResponseSender.SendStatusResponseArgsForCall(0)).To(Equal(cb.Status_SERVICE_UNAVAILABLE))
+				})
+
+				Context("when the block being sent is not received in time", func() {
+					It("disconnects the client without sending the status", func() {
+						err := handler.Handle(context.Background(), server)
+						Expect(err).To(MatchError("client is too slow to receive the blocks queued for it"))
+
+						Expect(fakeSlowConsumers.AddCallCount()).To(Equal(1))
+						Expect(fakeResponseSender.SendStatusResponseCallCount()).To(Equal(0))
+						close(release)
+						Eventually(sentBlocks).Should(Receive(Equal(uint64(100))))
+						Consistently(sentBlocks).ShouldNot(Receive())
+					})
+				})
+
+				Context("when the policy is to drop to checkpoint", func() {
+					BeforeEach(func() {
+						handler.SendQueue.Policy = deliver.DropToCheckpoint
+						handler.SendQueue.Timeout = 100 * time.Millisecond
+						go func() {
+							defer GinkgoRecover()
+							Eventually(fakeBlocksDropped.AddCallCount).Should(Equal(1))
+							close(release)
+						}()
+					})
+
+					It("reads the dropped blocks again once the client caught up", func() {
+						err := handler.Handle(context.Background(), server)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakeSlowConsumers.AddCallCount()).To(Equal(1))
+						Expect(fakeBlocksDropped.AddArgsForCall(0)).To(BeNumerically("~", 2.0))
+						Expect(fakeBlockReader.IteratorCallCount()).To(Equal(2))
+						Expect(fakeBlockReader.IteratorArgsForCall(1).GetSpecified().Number).To(Equal(uint64(101)))
+
+						close(sentBlocks)
+						var numbers []uint64
+						for number := range sentBlocks {
+							numbers = append(numbers, number)
+						}
+						Expect(numbers).To(Equal([]uint64{100, 101, 102, 103}))
+						Expect(fakeResponseSender.SendStatusResponseArgsForCall(0)).To(Equal(cb.Status_SUCCESS))
+					})
+				})
+			})
+		})
+
+		Context("when the content filter is unknown", func() {
+			BeforeEach(func() {
+				seekInfo.ContentFilter = ab.SeekInfo_SeekContentFilter(42)
+			})
+
+			It("sends status bad request", func() {
+				err := handler.Handle(context.Background(), server)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeBlockReader.IteratorCallCount()).To(Equal(0))
+				Expect(fakeResponseSender.SendStatusResponseCallCount()).To(Equal(1))
+				resp := fakeResponseSender.SendStatusResponseArgsForCall(0)
+				Expect(resp).To(Equal(cb.Status_BAD_REQUEST))
+			})
+		})
+
 		Context("when unmarshaling seek info fails", func() {
 			BeforeEach(func() {
 				seekInfoPayload = []byte("complete-nonsense")
@@ -842,6 +1059,126 @@ var _ = Describe("Deliver", func() {
 			})
 		})
 
+		Context("when seek info stops at a timestamp", func() {
+			BeforeEach(func() {
+				seekInfo = &ab.SeekInfo{
+					Start: seekNewest,
+					Stop: &ab.SeekPosition{
+						Type: &ab.SeekPosition_Timestamp{Timestamp: &ab.SeekTimestamp{Timestamp: &timestamp.Timestamp{Seconds: 100}}},
+					},
+				}
+			})
+
+			It("sends status bad request", func() {
+				err := handler.Handle(context.Background(), server)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeResponseSender.SendStatusResponseCallCount()).To(Equal(1))
+				resp := fakeResponseSender.SendStatusResponseArgsForCall(0)
+				Expect(resp).To(Equal(cb.Status_BAD_REQUEST))
+			})
+		})
+
+		Context("when seek info starts at a checkpoint", func() {
+			var checkpointBlock *cb.Block
+
+			BeforeEach(func() {
+				checkpointBlock = &cb.Block{Header: &cb.BlockHeader{Number: 99, DataHash: []byte("data-hash")}}
+				fakeBlockIterator.NextReturnsOnCall(0, checkpointBlock, cb.Status_SUCCESS)
+				seekInfo = &ab.SeekInfo{
+					Start: &ab.SeekPosition{
+						Type: &ab.SeekPosition_Checkpoint{Checkpoint: &ab.SeekCheckpoint{Token: deliver.CheckpointToken(checkpointBlock)}},
+					},
+					Stop: &ab.SeekPosition{
+						Type: &ab.SeekPosition_Specified{Specified: &ab.SeekSpecified{Number: 100}},
+					},
+				}
+			})
+
+			It("resumes the delivery after the block of the checkpoint", func() {
+				err := handler.Handle(context.Background(), server)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeBlockReader.IteratorCallCount()).To(Equal(2))
+				Expect(proto.Equal(fakeBlockReader.IteratorArgsForCall(0), &ab.SeekPosition{
+					Type: &ab.SeekPosition_Specified{Specified: &ab.SeekSpecified{Number: 99}},
+				})).To(BeTrue())
+				Expect(proto.Equal(fakeBlockReader.IteratorArgsForCall(1), &ab.SeekPosition{
+					Type: &ab.SeekPosition_Specified{Specified: &ab.SeekSpecified{Number: 100}},
+				})).To(BeTrue())
+
+				Expect(fakeResponseSender.SendBlockResponseCallCount()).To(Equal(1))
+				block, _, _, _ := fakeResponseSender.SendBlockResponseArgsForCall(0)
+				Expect(block.Header.Number).To(Equal(uint64(100)))
+				Expect(fakeResponseSender.SendStatusResponseCallCount()).To(Equal(1))
+				Expect(fakeResponseSender.SendStatusResponseArgsForCall(0)).To(Equal(cb.Status_SUCCESS))
+			})
+
+			Context("when the block of the checkpoint does not match the ledger", func() {
+				BeforeEach(func() {
+					fakeBlockIterator.NextReturnsOnCall(0, &cb.Block{Header: &cb.BlockHeader{Number: 99}}, cb.Status_SUCCESS)
+				})
+
+				It("sends status bad request", func() {
+					err := handler.Handle(context.Background(), server)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeResponseSender.SendBlockResponseCallCount()).To(Equal(0))
+					Expect(fakeResponseSender.SendStatusResponseCallCount()).To(Equal(1))
+					Expect(fakeResponseSender.SendStatusResponseArgsForCall(0)).To(Equal(cb.Status_BAD_REQUEST))
+				})
+			})
+
+			Context("when the block of the checkpoint is beyond the ledger height", func() {
+				BeforeEach(func() {
+					fakeBlockReader.HeightReturns(99)
+				})
+
+				It("sends status bad request without reading the ledger", func() {
+					err := handler.Handle(context.Background(), server)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeBlockReader.IteratorCallCount()).To(Equal(0))
+					Expect(fakeResponseSender.SendStatusResponseCallCount()).To(Equal(1))
+					Expect(fakeResponseSender.SendStatusResponseArgsForCall(0)).To(Equal(cb.Status_BAD_REQUEST))
+				})
+			})
+
+			Context("when the resume token is malformed", func() {
+				BeforeEach(func() {
+					seekInfo.Start.GetCheckpoint().Token = []byte("garbage")
+				})
+
+				It("sends status bad request", func() {
+					err := handler.Handle(context.Background(), server)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeResponseSender.SendStatusResponseCallCount()).To(Equal(1))
+					Expect(fakeResponseSender.SendStatusResponseArgsForCall(0)).To(Equal(cb.Status_BAD_REQUEST))
+				})
+			})
+		})
+
+		Context("when seek info stops at a checkpoint", func() {
+			BeforeEach(func() {
+				seekInfo = &ab.SeekInfo{
+					Start: seekNewest,
+					Stop: &ab.SeekPosition{
+						Type: &ab.SeekPosition_Checkpoint{Checkpoint: &ab.SeekCheckpoint{Token: deliver.CheckpointToken(&cb.Block{Header: &cb.BlockHeader{Number: 99}})}},
+					},
+				}
+			})
+
+			It("sends status bad request", func() {
+				err := handler.Handle(context.Background(), server)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeResponseSender.SendStatusResponseCallCount()).To(Equal(1))
+				resp := fakeResponseSender.SendStatusResponseArgsForCall(0)
+				Expect(resp).To(Equal(cb.Status_BAD_REQUEST))
+			})
+		})
+
 		Context("when fail if not ready is set and the next block is unavailable", func() {
 			BeforeEach(func() {
 				fakeBlockReader.HeightReturns(1000)
@@ -44,6 +44,21 @@ var (
 		LabelNames:   []string{"channel", "filtered"},
 		StatsdFormat: "%{#fqname}.%{channel}.%{filtered}",
 	}
+
+	slowConsumers = metrics.CounterOpts{
+		Namespace:    "deliver",
+		Name:         "slow_consumers",
+		Help:         "The number of times a client did not receive the blocks queued for it in time.",
+		LabelNames:   []string{"channel", "filtered"},
+		StatsdFormat: "%{#fqname}.%{channel}.%{filtered}",
+	}
+	blocksDropped = metrics.CounterOpts{
+		Namespace:    "deliver",
+		Name:         "blocks_dropped",
+		Help:         "The number of blocks dropped from the queues of slow clients, to be read again from the ledger.",
+		LabelNames:   []string{"channel", "filtered"},
+		StatsdFormat: "%{#fqname}.%{channel}.%{filtered}",
+	}
 )
 
 type Metrics struct {
@@ -52,6 +67,8 @@ type Metrics struct {
 	RequestsReceived  metrics.Counter
 	RequestsCompleted metrics.Counter
 	BlocksSent        metrics.Counter
+	SlowConsumers     metrics.Counter
+	BlocksDropped     metrics.Counter
 }
 
 func NewMetrics(p metrics.Provider) *Metrics {
@@ -61,5 +78,7 @@ func NewMetrics(p metrics.Provider) *Metrics {
 		RequestsReceived:  p.NewCounter(requestsReceived),
 		RequestsCompleted: p.NewCounter(requestsCompleted),
 		BlocksSent:        p.NewCounter(blocksSent),
+		SlowConsumers:     p.NewCounter(slowConsumers),
+		BlocksDropped:     p.NewCounter(blocksDropped),
 	}
 }
@@ -0,0 +1,179 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package deliver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/pkg/errors"
+)
+
+// SlowConsumerPolicy defines what the handler does with a deliver request
+// whose client does not keep up with the blocks queued for it.
+type SlowConsumerPolicy string
+
+const (
+	// Disconnect terminates the deliver stream of a slow client.
+	Disconnect SlowConsumerPolicy = "disconnect"
+	// DropToCheckpoint drops the blocks queued for a slow client, and reads
+	// them again from the ledger once the client received the blocks that
+	// were already being sent, so that no block is lost.
+	DropToCheckpoint SlowConsumerPolicy = "dropToCheckpoint"
+)
+
+// SendQueueConfig configures the queue of blocks sent to a deliver client.
+// A Size of 0 disables the queue, and the blocks are sent as they are read
+// from the ledger.
+type SendQueueConfig struct {
+	// Size is the maximum number of blocks queued for a client
+	Size int
+	// Timeout is the time to wait for room in a full queue before the
+	// client is considered slow, and the time to wait for a disconnected
+	// slow client to receive the block being sent before it is sent the
+	// status of its request
+	Timeout time.Duration
+	// Policy is applied to the deliver requests of slow clients, or
+	// Disconnect if not set
+	Policy SlowConsumerPolicy
+}
+
+// errSlowConsumer is returned to disconnect a slow client, which did not
+// receive the block being sent in time to be sent the status of its request.
+var errSlowConsumer = errors.New("client is too slow to receive the blocks queued for it")
+
+// sendQueue sends the blocks of a deliver request from a bounded queue, so
+// that reading the blocks from the ledger is decoupled from sending them to
+// the client, and a stalled client pins at most the blocks of its queue in
+// memory.
+type sendQueue struct {
+	send    func(*cb.Block) error
+	onSent  func()
+	blocks  chan *cb.Block
+	flushed chan struct{}
+	stopped chan struct{}
+	stop    sync.Once
+	done    chan struct{}
+	// err is the error which stopped the sending, set before done is closed
+	err error
+}
+
+func newSendQueue(size int, send func(*cb.Block) error, onSent func()) *sendQueue {
+	q := &sendQueue{
+		send:    send,
+		onSent:  onSent,
+		blocks:  make(chan *cb.Block, size),
+		flushed: make(chan struct{}, 1),
+		stopped: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go q.run()
+	return q
+}
+
+func (q *sendQueue) run() {
+	defer close(q.done)
+	for {
+		select {
+		case block := <-q.blocks:
+			// a nil block is queued by flush
+			if block == nil {
+				q.flushed <- struct{}{}
+				continue
+			}
+			// the queued blocks are not sent anymore once stopped
+			select {
+			case <-q.stopped:
+				return
+			default:
+			}
+			if err := q.send(block); err != nil {
+				q.err = err
+				return
+			}
+			q.onSent()
+		case <-q.stopped:
+			return
+		}
+	}
+}
+
+// enqueue queues the block, waiting up to the given timeout for room in the
+// queue. It returns false if the queue is still full after the timeout.
+func (q *sendQueue) enqueue(ctx context.Context, block *cb.Block, timeout time.Duration) (bool, error) {
+	select {
+	case q.blocks <- block:
+		return true, nil
+	default:
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case q.blocks <- block:
+		return true, nil
+	case <-timer.C:
+		return false, nil
+	case <-q.done:
+		return false, q.err
+	case <-ctx.Done():
+		return false, errors.Wrapf(ctx.Err(), "context finished before block queued")
+	}
+}
+
+// drop removes the queued blocks, and returns the first of them, or nil if
+// the queue is empty, and the number of blocks removed.
+func (q *sendQueue) drop() (*cb.Block, int) {
+	var first *cb.Block
+	count := 0
+	for {
+		select {
+		case block := <-q.blocks:
+			if first == nil {
+				first = block
+			}
+			count++
+		default:
+			return first, count
+		}
+	}
+}
+
+// flush waits until the queued blocks are sent.
+func (q *sendQueue) flush(ctx context.Context) error {
+	select {
+	case q.blocks <- nil:
+	case <-q.done:
+		return q.err
+	case <-ctx.Done():
+		return errors.Wrapf(ctx.Err(), "context finished before blocks sent")
+	}
+
+	select {
+	case <-q.flushed:
+		return nil
+	case <-q.done:
+		return q.err
+	case <-ctx.Done():
+		return errors.Wrapf(ctx.Err(), "context finished before blocks sent")
+	}
+}
+
+// shutdown stops the sending of the queued blocks, and waits until the block
+// being sent, if any, is sent, so that the caller can send its own responses.
+// It returns an error if the context finishes first.
+func (q *sendQueue) shutdown(ctx context.Context) error {
+	q.stop.Do(func() { close(q.stopped) })
+	select {
+	case <-q.done:
+		return nil
+	case <-ctx.Done():
+		return errors.Wrapf(ctx.Err(), "context finished before block sent")
+	}
+}
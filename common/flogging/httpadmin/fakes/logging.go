@@ -5,6 +5,7 @@ import (
 	sync "sync"
 
 	httpadmin "github.com/hyperledger/fabric/common/flogging/httpadmin"
+	zapcore "go.uber.org/zap/zapcore"
 )
 
 type Logging struct {
@@ -19,6 +20,17 @@ type Logging struct {
 	activateSpecReturnsOnCall map[int]struct {
 		result1 error
 	}
+	LevelStub        func(string) zapcore.Level
+	levelMutex       sync.RWMutex
+	levelArgsForCall []struct {
+		arg1 string
+	}
+	levelReturns struct {
+		result1 zapcore.Level
+	}
+	levelReturnsOnCall map[int]struct {
+		result1 zapcore.Level
+	}
 	SpecStub        func() string
 	specMutex       sync.RWMutex
 	specArgsForCall []struct {
@@ -93,6 +105,66 @@ func (fake *Logging) ActivateSpecReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *Logging) Level(arg1 string) zapcore.Level {
+	fake.levelMutex.Lock()
+	ret, specificReturn := fake.levelReturnsOnCall[len(fake.levelArgsForCall)]
+	fake.levelArgsForCall = append(fake.levelArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("Level", []interface{}{arg1})
+	fake.levelMutex.Unlock()
+	if fake.LevelStub != nil {
+		return fake.LevelStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.levelReturns
+	return fakeReturns.result1
+}
+
+func (fake *Logging) LevelCallCount() int {
+	fake.levelMutex.RLock()
+	defer fake.levelMutex.RUnlock()
+	return len(fake.levelArgsForCall)
+}
+
+func (fake *Logging) LevelCalls(stub func(string) zapcore.Level) {
+	fake.levelMutex.Lock()
+	defer fake.levelMutex.Unlock()
+	fake.LevelStub = stub
+}
+
+func (fake *Logging) LevelArgsForCall(i int) string {
+	fake.levelMutex.RLock()
+	defer fake.levelMutex.RUnlock()
+	argsForCall := fake.levelArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Logging) LevelReturns(result1 zapcore.Level) {
+	fake.levelMutex.Lock()
+	defer fake.levelMutex.Unlock()
+	fake.LevelStub = nil
+	fake.levelReturns = struct {
+		result1 zapcore.Level
+	}{result1}
+}
+
+func (fake *Logging) LevelReturnsOnCall(i int, result1 zapcore.Level) {
+	fake.levelMutex.Lock()
+	defer fake.levelMutex.Unlock()
+	fake.LevelStub = nil
+	if fake.levelReturnsOnCall == nil {
+		fake.levelReturnsOnCall = make(map[int]struct {
+			result1 zapcore.Level
+		})
+	}
+	fake.levelReturnsOnCall[i] = struct {
+		result1 zapcore.Level
+	}{result1}
+}
+
 func (fake *Logging) Spec() string {
 	fake.specMutex.Lock()
 	ret, specificReturn := fake.specReturnsOnCall[len(fake.specArgsForCall)]
@@ -150,6 +222,8 @@ func (fake *Logging) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.activateSpecMutex.RLock()
 	defer fake.activateSpecMutex.RUnlock()
+	fake.levelMutex.RLock()
+	defer fake.levelMutex.RUnlock()
 	fake.specMutex.RLock()
 	defer fake.specMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,95 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package httpadmin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hyperledger/fabric/common/flogging"
+)
+
+// ShimURL is the path under which the shim level handler is served.
+const ShimURL = "/logspec/shim"
+
+//go:generate counterfeiter -o fakes/shim_logging.go -fake-name ShimLogging . ShimLogging
+
+// ShimLogging controls the logging level of the shim of the chaincodes
+// run by the peer.
+type ShimLogging interface {
+	// ShimLogLevel returns the logging level of the shim
+	ShimLogLevel() string
+	// SetShimLogLevel sets the logging level of the shim of the running
+	// chaincodes and of the chaincodes launched afterwards. It returns an
+	// error if the level is invalid or if some running chaincodes cannot
+	// apply it.
+	SetShimLogLevel(level string) error
+}
+
+type ShimLevel struct {
+	Level string `json:"level,omitempty"`
+}
+
+func NewShimLevelHandler(s ShimLogging) *ShimLevelHandler {
+	return &ShimLevelHandler{
+		ShimLogging: s,
+		Logger:      flogging.MustGetLogger("flogging.httpadmin"),
+	}
+}
+
+// ShimLevelHandler serves the shim logging level endpoint of the operations
+// system.
+//
+// GET /logspec/shim returns the logging level of the chaincode shim, and PUT
+// /logspec/shim with {"level": "<level>"} propagates the level to the shim of
+// the running chaincodes without restarting them. The PUT request fails when
+// some running chaincodes cannot apply the level.
+type ShimLevelHandler struct {
+	ShimLogging ShimLogging
+	Logger      *flogging.FabricLogger
+}
+
+func (h *ShimLevelHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodPut:
+		var shimLevel ShimLevel
+		decoder := json.NewDecoder(req.Body)
+		if err := decoder.Decode(&shimLevel); err != nil {
+			h.sendResponse(resp, http.StatusBadRequest, err)
+			return
+		}
+		req.Body.Close()
+
+		if err := h.ShimLogging.SetShimLogLevel(shimLevel.Level); err != nil {
+			h.sendResponse(resp, http.StatusBadRequest, err)
+			return
+		}
+		resp.WriteHeader(http.StatusNoContent)
+
+	case http.MethodGet:
+		h.sendResponse(resp, http.StatusOK, &ShimLevel{Level: h.ShimLogging.ShimLogLevel()})
+
+	default:
+		err := fmt.Errorf("invalid request method: %s", req.Method)
+		h.sendResponse(resp, http.StatusBadRequest, err)
+	}
+}
+
+func (h *ShimLevelHandler) sendResponse(resp http.ResponseWriter, code int, payload interface{}) {
+	encoder := json.NewEncoder(resp)
+	if err, ok := payload.(error); ok {
+		payload = &ErrorResponse{Error: err.Error()}
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(code)
+
+	if err := encoder.Encode(payload); err != nil {
+		h.Logger.Errorw("failed to encode payload", "error", err)
+	}
+}
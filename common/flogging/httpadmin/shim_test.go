@@ -0,0 +1,92 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package httpadmin_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/hyperledger/fabric/common/flogging/httpadmin"
+	"github.com/hyperledger/fabric/common/flogging/httpadmin/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ShimLevelHandler", func() {
+	var (
+		fakeShimLogging *fakes.ShimLogging
+		handler         *httpadmin.ShimLevelHandler
+	)
+
+	BeforeEach(func() {
+		fakeShimLogging = &fakes.ShimLogging{}
+		fakeShimLogging.ShimLogLevelReturns("WARNING")
+		handler = httpadmin.NewShimLevelHandler(fakeShimLogging)
+	})
+
+	It("responds with the shim logging level", func() {
+		req := httptest.NewRequest("GET", httpadmin.ShimURL, nil)
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+
+		Expect(fakeShimLogging.ShimLogLevelCallCount()).To(Equal(1))
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		Expect(resp.Body).To(MatchJSON(`{"level": "WARNING"}`))
+		Expect(resp.Header().Get("Content-Type")).To(Equal("application/json"))
+	})
+
+	It("sets the shim logging level", func() {
+		req := httptest.NewRequest("PUT", httpadmin.ShimURL, strings.NewReader(`{"level": "debug"}`))
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+
+		Expect(resp.Code).To(Equal(http.StatusNoContent))
+		Expect(fakeShimLogging.SetShimLogLevelCallCount()).To(Equal(1))
+		Expect(fakeShimLogging.SetShimLogLevelArgsForCall(0)).To(Equal("debug"))
+	})
+
+	Context("when the payload cannot be decoded", func() {
+		It("responds with an error payload", func() {
+			req := httptest.NewRequest("PUT", httpadmin.ShimURL, strings.NewReader(`goo`))
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(fakeShimLogging.SetShimLogLevelCallCount()).To(Equal(0))
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+			Expect(resp.Body).To(MatchJSON(`{"error": "invalid character 'g' looking for beginning of value"}`))
+		})
+	})
+
+	Context("when setting the level fails", func() {
+		BeforeEach(func() {
+			fakeShimLogging.SetShimLogLevelReturns(errors.New("invalid level"))
+		})
+
+		It("responds with an error payload", func() {
+			req := httptest.NewRequest("PUT", httpadmin.ShimURL, strings.NewReader(`{"level": "loud"}`))
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+			Expect(resp.Body).To(MatchJSON(`{"error": "invalid level"}`))
+		})
+	})
+
+	Context("when an unsupported method is used", func() {
+		It("responds with an error", func() {
+			req := httptest.NewRequest("POST", httpadmin.ShimURL, strings.NewReader(`{}`))
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+			Expect(resp.Body).To(MatchJSON(`{"error": "invalid request method: POST"}`))
+			Expect(fakeShimLogging.SetShimLogLevelCallCount()).To(Equal(0))
+		})
+	})
+})
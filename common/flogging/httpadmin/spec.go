@@ -12,6 +12,7 @@ import (
 	"net/http"
 
 	"github.com/hyperledger/fabric/common/flogging"
+	"go.uber.org/zap/zapcore"
 )
 
 //go:generate counterfeiter -o fakes/logging.go -fake-name Logging . Logging
@@ -19,12 +20,20 @@ import (
 type Logging interface {
 	ActivateSpec(spec string) error
 	Spec() string
+	Level(loggerName string) zapcore.Level
 }
 
 type LogSpec struct {
 	Spec string `json:"spec,omitempty"`
 }
 
+// ModuleLevel is the logging level of a single module, that is a logger name
+// or a prefix of logger names.
+type ModuleLevel struct {
+	Module string `json:"module,omitempty"`
+	Level  string `json:"level,omitempty"`
+}
+
 type ErrorResponse struct {
 	Error string `json:"error"`
 }
@@ -36,6 +45,14 @@ func NewSpecHandler() *SpecHandler {
 	}
 }
 
+// SpecHandler serves the logging spec endpoint of the operations system.
+//
+// GET /logspec returns the active logging spec, and PUT /logspec with
+// {"spec": "<spec>"} replaces it.
+//
+// GET /logspec?module=<module> returns the logging level of the module, and
+// PUT /logspec with {"module": "<module>", "level": "<level>"} sets the level
+// of the module, leaving the rest of the active spec unchanged.
 type SpecHandler struct {
 	Logging Logging
 	Logger  *flogging.FabricLogger
@@ -44,21 +61,36 @@ type SpecHandler struct {
 func (h *SpecHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
 	switch req.Method {
 	case http.MethodPut:
-		var logSpec LogSpec
+		var update struct {
+			LogSpec
+			ModuleLevel
+		}
 		decoder := json.NewDecoder(req.Body)
-		if err := decoder.Decode(&logSpec); err != nil {
+		if err := decoder.Decode(&update); err != nil {
 			h.sendResponse(resp, http.StatusBadRequest, err)
 			return
 		}
 		req.Body.Close()
 
-		if err := h.Logging.ActivateSpec(logSpec.Spec); err != nil {
+		spec := update.Spec
+		if update.Module != "" {
+			if update.Spec != "" {
+				h.sendResponse(resp, http.StatusBadRequest, fmt.Errorf("spec and module cannot both be set"))
+				return
+			}
+			spec = fmt.Sprintf("%s:%s=%s", h.Logging.Spec(), update.Module, update.Level)
+		}
+		if err := h.Logging.ActivateSpec(spec); err != nil {
 			h.sendResponse(resp, http.StatusBadRequest, err)
 			return
 		}
 		resp.WriteHeader(http.StatusNoContent)
 
 	case http.MethodGet:
+		if module := req.URL.Query().Get("module"); module != "" {
+			h.sendResponse(resp, http.StatusOK, &ModuleLevel{Module: module, Level: h.Logging.Level(module).String()})
+			return
+		}
 		h.sendResponse(resp, http.StatusOK, &LogSpec{Spec: h.Logging.Spec()})
 
 	default:
@@ -17,6 +17,7 @@ import (
 	"github.com/hyperledger/fabric/common/flogging/httpadmin/fakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"go.uber.org/zap/zapcore"
 )
 
 var _ = Describe("SpecHandler", func() {
@@ -54,6 +55,41 @@ var _ = Describe("SpecHandler", func() {
 		Expect(fakeLogging.ActivateSpecArgsForCall(0)).To(Equal("updated-spec"))
 	})
 
+	It("responds with the logging level of a module", func() {
+		fakeLogging.LevelReturns(zapcore.DebugLevel)
+		req := httptest.NewRequest("GET", "/ignored?module=gossip.privdata", nil)
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+
+		Expect(fakeLogging.LevelCallCount()).To(Equal(1))
+		Expect(fakeLogging.LevelArgsForCall(0)).To(Equal("gossip.privdata"))
+		Expect(fakeLogging.SpecCallCount()).To(Equal(0))
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		Expect(resp.Body).To(MatchJSON(`{"module": "gossip.privdata", "level": "debug"}`))
+	})
+
+	It("sets the logging level of a module", func() {
+		req := httptest.NewRequest("PUT", "/ignored", strings.NewReader(`{"module": "gossip", "level": "debug"}`))
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+
+		Expect(resp.Code).To(Equal(http.StatusNoContent))
+		Expect(fakeLogging.ActivateSpecCallCount()).To(Equal(1))
+		Expect(fakeLogging.ActivateSpecArgsForCall(0)).To(Equal("the-returned-specification:gossip=debug"))
+	})
+
+	Context("when both a spec and a module are set", func() {
+		It("responds with an error payload", func() {
+			req := httptest.NewRequest("PUT", "/ignored", strings.NewReader(`{"spec": "debug", "module": "gossip", "level": "debug"}`))
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(fakeLogging.ActivateSpecCallCount()).To(Equal(0))
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+			Expect(resp.Body).To(MatchJSON(`{"error": "spec and module cannot both be set"}`))
+		})
+	})
+
 	Context("when the update spec payload cannot be decoded", func() {
 		It("responds with an error payload", func() {
 			req := httptest.NewRequest("PUT", "/ignored", strings.NewReader(`goo`))
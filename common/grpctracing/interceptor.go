@@ -0,0 +1,72 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package grpctracing
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/fabric/common/otlp"
+	"github.com/hyperledger/fabric/common/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TraceparentKey is the metadata key of the W3C trace context of a request.
+const TraceparentKey = "traceparent"
+
+// UnaryServerInterceptor records a span for each unary request.
+func UnaryServerInterceptor(t *Tracer) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		span := startSpan(ctx, info.FullMethod)
+		resp, err := handler(ctx, req)
+		t.Record(endSpan(span, err))
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor records a span for each streaming request, which
+// lasts until the stream ends.
+func StreamServerInterceptor(t *Tracer) grpc.StreamServerInterceptor {
+	return func(svc interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		span := startSpan(stream.Context(), info.FullMethod)
+		err := handler(svc, stream)
+		t.Record(endSpan(span, err))
+		return err
+	}
+}
+
+func startSpan(ctx context.Context, fullMethod string) *Span {
+	var traceparent string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(TraceparentKey); len(values) > 0 {
+			traceparent = values[0]
+		}
+	}
+
+	span := newSpan(strings.TrimPrefix(fullMethod, "/"), traceparent)
+	span.Attributes = []otlp.KeyValue{otlp.StringAttribute("rpc.system", "grpc")}
+	if parts := strings.Split(fullMethod, "/"); len(parts) == 3 {
+		span.Attributes = append(span.Attributes,
+			otlp.StringAttribute("rpc.service", parts[1]),
+			otlp.StringAttribute("rpc.method", parts[2]),
+		)
+	}
+	if addr := util.ExtractRemoteAddress(ctx); addr != "" {
+		span.Attributes = append(span.Attributes, otlp.StringAttribute("net.peer.address", addr))
+	}
+	return span
+}
+
+func endSpan(span *Span, err error) *Span {
+	span.End = time.Now()
+	span.Err = err
+	span.Attributes = append(span.Attributes, otlp.IntAttribute("rpc.grpc.status_code", int64(status.Code(err))))
+	return span
+}
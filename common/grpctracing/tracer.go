@@ -0,0 +1,202 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package grpctracing records a span for each request served by a gRPC
+// server, and exports the spans to an OpenTelemetry collector with the
+// OTLP/HTTP protocol.
+package grpctracing
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric/common/otlp"
+)
+
+// DefaultMaxQueuedSpans is the default number of spans queued for export.
+const DefaultMaxQueuedSpans = 2048
+
+// The OTLP SpanKind and StatusCode values of the spans.
+const (
+	spanKindServer  = 2
+	statusCodeOK    = 1
+	statusCodeError = 2
+)
+
+// Span records a request served by the server.
+type Span struct {
+	TraceID      [16]byte
+	SpanID       [8]byte
+	ParentSpanID []byte
+	Name         string
+	Start        time.Time
+	End          time.Time
+	Attributes   []otlp.KeyValue
+	Err          error
+}
+
+// Tracer queues the spans of the requests until they are exported. When the
+// queue is full, the spans of the requests are dropped.
+type Tracer struct {
+	mutex          sync.Mutex
+	spans          []*Span
+	maxQueuedSpans int
+	dropped        int
+}
+
+// NewTracer creates a Tracer which queues up to maxQueuedSpans spans, or
+// DefaultMaxQueuedSpans if maxQueuedSpans is not positive.
+func NewTracer(maxQueuedSpans int) *Tracer {
+	if maxQueuedSpans <= 0 {
+		maxQueuedSpans = DefaultMaxQueuedSpans
+	}
+	return &Tracer{maxQueuedSpans: maxQueuedSpans}
+}
+
+// Record queues the span for export.
+func (t *Tracer) Record(span *Span) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if len(t.spans) >= t.maxQueuedSpans {
+		t.dropped++
+		return
+	}
+	t.spans = append(t.spans, span)
+}
+
+// Dropped returns the number of spans dropped since the last export.
+func (t *Tracer) Dropped() int {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.dropped
+}
+
+// Export sends the queued spans to the collector. The spans are removed from
+// the queue whether or not the export succeeds.
+func (t *Tracer) Export(client *otlp.Client, resource otlp.Resource) error {
+	t.mutex.Lock()
+	spans := t.spans
+	t.spans = nil
+	t.dropped = 0
+	t.mutex.Unlock()
+
+	if len(spans) == 0 {
+		return nil
+	}
+
+	exported := make([]SpanData, len(spans))
+	for i, s := range spans {
+		exported[i] = s.data()
+	}
+	return client.Send(otlp.TracesPath, &ExportTraceServiceRequest{
+		ResourceSpans: []ResourceSpans{{
+			Resource: resource,
+			ScopeSpans: []ScopeSpans{{
+				Scope: otlp.FabricScope,
+				Spans: exported,
+			}},
+		}},
+	})
+}
+
+// SendLoop exports the queued spans on every tick of the channel, until the
+// channel is closed. Export failures are passed to onError.
+func (t *Tracer) SendLoop(c <-chan time.Time, client *otlp.Client, resource otlp.Resource, onError func(error)) {
+	for range c {
+		if err := t.Export(client, resource); err != nil {
+			onError(err)
+		}
+	}
+}
+
+// ExportTraceServiceRequest is the JSON encoding of the request of the OTLP
+// trace service.
+type ExportTraceServiceRequest struct {
+	ResourceSpans []ResourceSpans `json:"resourceSpans"`
+}
+
+type ResourceSpans struct {
+	Resource   otlp.Resource `json:"resource"`
+	ScopeSpans []ScopeSpans  `json:"scopeSpans"`
+}
+
+type ScopeSpans struct {
+	Scope otlp.Scope `json:"scope"`
+	Spans []SpanData `json:"spans"`
+}
+
+// SpanData is the JSON encoding of a span. The identifiers are encoded in
+// hexadecimal, as mandated by the OTLP/HTTP protocol.
+type SpanData struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlp.KeyValue `json:"attributes,omitempty"`
+	Status            Status          `json:"status"`
+}
+
+type Status struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+func (s *Span) data() SpanData {
+	status := Status{Code: statusCodeOK}
+	if s.Err != nil {
+		status = Status{Code: statusCodeError, Message: s.Err.Error()}
+	}
+	return SpanData{
+		TraceID:           hex.EncodeToString(s.TraceID[:]),
+		SpanID:            hex.EncodeToString(s.SpanID[:]),
+		ParentSpanID:      hex.EncodeToString(s.ParentSpanID),
+		Name:              s.Name,
+		Kind:              spanKindServer,
+		StartTimeUnixNano: otlp.Timestamp(s.Start),
+		EndTimeUnixNano:   otlp.Timestamp(s.End),
+		Attributes:        s.Attributes,
+		Status:            status,
+	}
+}
+
+// newSpan starts a span for the request. When the request carries the trace
+// context of its caller in a W3C traceparent header, the span joins the trace
+// of the caller.
+func newSpan(name string, traceparent string) *Span {
+	span := &Span{Name: name, Start: time.Now()}
+	rand.Read(span.SpanID[:])
+
+	if traceID, parentID, ok := parseTraceparent(traceparent); ok {
+		span.TraceID = traceID
+		span.ParentSpanID = parentID[:]
+		return span
+	}
+	rand.Read(span.TraceID[:])
+	return span
+}
+
+// parseTraceparent parses a traceparent header of the form
+// <version>-<trace-id>-<parent-id>-<trace-flags>.
+func parseTraceparent(traceparent string) (traceID [16]byte, parentID [8]byte, ok bool) {
+	parts := strings.Split(strings.TrimSpace(traceparent), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" || len(parts[1]) != 32 || len(parts[2]) != 16 {
+		return traceID, parentID, false
+	}
+	if _, err := hex.Decode(traceID[:], []byte(parts[1])); err != nil || traceID == [16]byte{} {
+		return traceID, parentID, false
+	}
+	if _, err := hex.Decode(parentID[:], []byte(parts[2])); err != nil || parentID == [8]byte{} {
+		return traceID, parentID, false
+	}
+	return traceID, parentID, true
+}
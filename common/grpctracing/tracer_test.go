@@ -0,0 +1,175 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package grpctracing
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/common/otlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (ss *serverStream) Context() context.Context {
+	return ss.ctx
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	tracer := NewTracer(0)
+	interceptor := UnaryServerInterceptor(tracer)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		TraceparentKey, "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01",
+	))
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 7051}})
+	resp, err := interceptor(ctx, "request", &grpc.UnaryServerInfo{FullMethod: "/protos.Endorser/ProcessProposal"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "response", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "response", resp)
+
+	require.Len(t, tracer.spans, 1)
+	span := tracer.spans[0]
+	assert.Equal(t, "protos.Endorser/ProcessProposal", span.Name)
+	assert.Equal(t, "0af7651916cd43dd8448eb211c80319c", hex.EncodeToString(span.TraceID[:]))
+	assert.Equal(t, "b7ad6b7169203331", hex.EncodeToString(span.ParentSpanID))
+	assert.NotEqual(t, [8]byte{}, span.SpanID)
+	assert.False(t, span.End.Before(span.Start))
+	assert.Equal(t, []otlp.KeyValue{
+		otlp.StringAttribute("rpc.system", "grpc"),
+		otlp.StringAttribute("rpc.service", "protos.Endorser"),
+		otlp.StringAttribute("rpc.method", "ProcessProposal"),
+		otlp.StringAttribute("net.peer.address", "127.0.0.1:7051"),
+		otlp.IntAttribute("rpc.grpc.status_code", 0),
+	}, span.Attributes)
+	assert.NoError(t, span.Err)
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	tracer := NewTracer(0)
+	interceptor := StreamServerInterceptor(tracer)
+
+	handlerErr := status.Error(codes.Unavailable, "unavailable")
+	stream := &serverStream{ctx: context.Background()}
+	err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/protos.Deliver/Deliver"}, func(svc interface{}, ss grpc.ServerStream) error {
+		assert.Equal(t, stream, ss)
+		return handlerErr
+	})
+	assert.Equal(t, handlerErr, err)
+
+	require.Len(t, tracer.spans, 1)
+	span := tracer.spans[0]
+	assert.Equal(t, "protos.Deliver/Deliver", span.Name)
+	assert.NotEqual(t, [16]byte{}, span.TraceID)
+	assert.Nil(t, span.ParentSpanID)
+	assert.Equal(t, handlerErr, span.Err)
+	assert.Equal(t, otlp.IntAttribute("rpc.grpc.status_code", int64(codes.Unavailable)), span.Attributes[len(span.Attributes)-1])
+}
+
+func TestParseTraceparent(t *testing.T) {
+	tests := []struct {
+		traceparent string
+		valid       bool
+	}{
+		{"00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01", true},
+		{"01-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-00-future", true},
+		{"", false},
+		{"ff-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01", false},
+		{"00-00000000000000000000000000000000-b7ad6b7169203331-01", false},
+		{"00-0af7651916cd43dd8448eb211c80319c-0000000000000000-01", false},
+		{"00-0af7651916cd43dd8448eb211c8031zz-b7ad6b7169203331-01", false},
+		{"00-0af7651916cd43dd-b7ad6b7169203331-01", false},
+	}
+	for _, tt := range tests {
+		_, _, ok := parseTraceparent(tt.traceparent)
+		assert.Equal(t, tt.valid, ok, tt.traceparent)
+	}
+}
+
+func TestTracerQueue(t *testing.T) {
+	tracer := NewTracer(2)
+	for i := 0; i < 3; i++ {
+		tracer.Record(&Span{})
+	}
+	assert.Len(t, tracer.spans, 2)
+	assert.Equal(t, 1, tracer.Dropped())
+}
+
+func TestTracerExport(t *testing.T) {
+	requests := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/traces", r.URL.Path)
+		body, _ := ioutil.ReadAll(r.Body)
+		requests <- body
+	}))
+	defer server.Close()
+	client := &otlp.Client{Endpoint: server.URL}
+	resource := otlp.NewResource("orderer", "")
+
+	tracer := NewTracer(0)
+	// nothing is sent without spans
+	require.NoError(t, tracer.Export(client, resource))
+	assert.Len(t, requests, 0)
+
+	span := newSpan("protos.Endorser/ProcessProposal", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+	span.SpanID = [8]byte{1, 2, 3, 4, 5, 6, 7, 8}
+	span.Start = time.Unix(1, 0)
+	span.End = time.Unix(2, 0)
+	span.Err = status.Error(codes.Internal, "failed")
+	tracer.Record(span)
+
+	ticks := make(chan time.Time, 1)
+	ticks <- time.Now()
+	close(ticks)
+	tracer.SendLoop(ticks, client, resource, func(err error) { t.Fatal(err) })
+
+	request := &ExportTraceServiceRequest{}
+	require.NoError(t, json.Unmarshal(<-requests, request))
+	assert.Equal(t, &ExportTraceServiceRequest{
+		ResourceSpans: []ResourceSpans{{
+			Resource: resource,
+			ScopeSpans: []ScopeSpans{{
+				Scope: otlp.FabricScope,
+				Spans: []SpanData{{
+					TraceID:           "0af7651916cd43dd8448eb211c80319c",
+					SpanID:            "0102030405060708",
+					ParentSpanID:      "b7ad6b7169203331",
+					Name:              "protos.Endorser/ProcessProposal",
+					Kind:              2,
+					StartTimeUnixNano: "1000000000",
+					EndTimeUnixNano:   "2000000000",
+					Status:            Status{Code: 2, Message: "rpc error: code = Internal desc = failed"},
+				}},
+			}},
+		}},
+	}, request)
+	assert.Empty(t, tracer.spans)
+
+	// the spans are dropped when the export fails
+	tracer.Record(span)
+	err := tracer.Export(&otlp.Client{Endpoint: "http://127.0.0.1:0"}, resource)
+	assert.Error(t, err)
+	assert.Empty(t, tracer.spans)
+}
@@ -7,6 +7,8 @@ SPDX-License-Identifier: Apache-2.0
 package blkstorage
 
 import (
+	"time"
+
 	"github.com/hyperledger/fabric/common/ledger"
 	l "github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/protos/common"
@@ -25,6 +27,7 @@ const (
 	IndexableAttrBlockNumTranNum  = IndexableAttr("BlockNumTranNum")
 	IndexableAttrBlockTxID        = IndexableAttr("BlockTxID")
 	IndexableAttrTxValidationCode = IndexableAttr("TxValidationCode")
+	IndexableAttrBlockTime        = IndexableAttr("BlockTime")
 )
 
 // IndexConfig - a configuration that includes a list of attributes that should be indexed
@@ -38,6 +41,10 @@ var (
 
 	// ErrAttrNotIndexed is used to indicate that an attribute is not indexed
 	ErrAttrNotIndexed = errors.New("attribute not indexed")
+
+	// ErrBlockTimeNotIndexed is used to indicate that the blocks committed before the
+	// block time index was enabled are not indexed by time
+	ErrBlockTimeNotIndexed = errors.New("blocks committed before the block time index was enabled are not indexed by time")
 )
 
 // BlockStoreProvider provides an handle to a BlockStore
@@ -46,9 +53,40 @@ type BlockStoreProvider interface {
 	OpenBlockStore(ledgerid string) (BlockStore, error)
 	Exists(ledgerid string) (bool, error)
 	List() ([]string, error)
+	// Remove deletes the blocks and index of the given ledger, whose
+	// BlockStore must have been shut down beforehand
+	Remove(ledgerid string) error
+	// BootstrapBlockStore creates the block store of a new ledger that starts
+	// after the last block of the given snapshot, instead of at the genesis block.
+	// The ids of the transactions of the snapshot are added to the index, so that
+	// the transactions committed after the snapshot can be checked for duplicates
+	BootstrapBlockStore(ledgerid string, info *SnapshotInfo, txIDs SnapshotTxIDIterator) error
 	Close()
 }
 
+// SnapshotInfo describes the last block of the snapshot a block store is
+// bootstrapped from. The block store holds none of the blocks up to and
+// including LastBlockNum, and LastBlockNum+1 is the first block it accepts
+type SnapshotInfo struct {
+	LastBlockNum      uint64
+	LastBlockHash     []byte
+	PreviousBlockHash []byte
+}
+
+// SnapshotTxID is the id of a transaction committed up to the last block of a
+// snapshot, along with the number of its block and its validation code
+type SnapshotTxID struct {
+	TxID           string
+	BlockNum       uint64
+	ValidationCode peer.TxValidationCode
+}
+
+// SnapshotTxIDIterator iterates over the ids of the transactions of a snapshot.
+// Next returns nil after the last transaction id
+type SnapshotTxIDIterator interface {
+	Next() (*SnapshotTxID, error)
+}
+
 // BlockStore - an interface for persisting and retrieving blocks
 // An implementation of this interface is expected to take an argument
 // of type `IndexConfig` which configures the block store on what items should be indexed
@@ -62,5 +100,12 @@ type BlockStore interface {
 	RetrieveTxByBlockNumTranNum(blockNum uint64, tranNum uint64) (*common.Envelope, error)
 	RetrieveBlockByTxID(txID string) (*common.Block, error)
 	RetrieveTxValidationCodeByTxID(txID string) (peer.TxValidationCode, error)
+	// RetrieveBlockNumberByTime returns the lowest number of the blocks whose
+	// timestamp is not before the given time
+	RetrieveBlockNumberByTime(t time.Time) (uint64, error)
+	// ExportTxIDs passes the id of each transaction in the block store to the given function,
+	// including the ids imported from the snapshot the block store was bootstrapped from.
+	// A transaction id committed more than once is passed only for its first transaction
+	ExportTxIDs(export func(txID *SnapshotTxID) error) error
 	Shutdown()
 }
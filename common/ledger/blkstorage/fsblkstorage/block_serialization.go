@@ -8,6 +8,7 @@ package fsblkstorage
 
 import (
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	ledgerutil "github.com/hyperledger/fabric/common/ledger/util"
 	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protoutil"
@@ -17,6 +18,7 @@ type serializedBlockInfo struct {
 	blockHeader *common.BlockHeader
 	txOffsets   []*txindexInfo
 	metadata    *common.BlockMetadata
+	timestamp   *timestamp.Timestamp
 }
 
 //The order of the transactions must be maintained for history
@@ -32,6 +34,7 @@ func serializeBlock(block *common.Block) ([]byte, *serializedBlockInfo, error) {
 	info := &serializedBlockInfo{}
 	info.blockHeader = block.Header
 	info.metadata = block.Metadata
+	info.timestamp = blockTimestamp(block.Data)
 	if err = addHeaderBytes(block.Header, buf); err != nil {
 		return nil, nil, err
 	}
@@ -68,10 +71,12 @@ func extractSerializedBlockInfo(serializedBlockBytes []byte) (*serializedBlockIn
 	if err != nil {
 		return nil, err
 	}
-	_, info.txOffsets, err = extractData(b)
+	data, txOffsets, err := extractData(b)
 	if err != nil {
 		return nil, err
 	}
+	info.txOffsets = txOffsets
+	info.timestamp = blockTimestamp(data)
 
 	info.metadata, err = extractMetadata(b)
 	if err != nil {
@@ -207,3 +212,13 @@ func extractTxID(txEnvelopBytes []byte) (string, error) {
 	}
 	return chdr.TxId, nil
 }
+
+// blockTimestamp returns the timestamp of the block with the given data, or nil
+// if the block has no timestamp, in which case the block is not indexed by time
+func blockTimestamp(blockData *common.BlockData) *timestamp.Timestamp {
+	ts, err := protoutil.GetTimestampFromBlock(&common.Block{Data: blockData})
+	if err != nil {
+		return nil
+	}
+	return ts
+}
@@ -0,0 +1,111 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fsblkstorage
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+)
+
+// BlockfilesCheckResult describes the block files of a ledger as found by CheckBlockfiles.
+type BlockfilesCheckResult struct {
+	// Height is the number of complete blocks in the block files.
+	Height uint64
+	// TornBlockfile is the path of the last block file, if it ends with a partially
+	// written block, as left behind by a crash while appending a block.
+	TornBlockfile string
+	// TornBytes is the size of the partially written block.
+	TornBytes int64
+	// Truncated reports whether the partially written block has been removed.
+	Truncated bool
+}
+
+// CheckBlockfiles reads all the blocks stored in the block files under the given ledger
+// directory and verifies that they are numbered consecutively, that the data hash in
+// their header matches their data, and that each one carries the header hash of its
+// predecessor. Every block is then passed to verifyBlock, if not nil, for further checks.
+// If the last block file ends with a partially written block, it is reported in the
+// result and, if truncate is set, removed from the file.
+// CheckBlockfiles must not be invoked while the block store of the ledger is open.
+func CheckBlockfiles(ledgerDir string, truncate bool, verifyBlock func(*common.Block) error) (*BlockfilesCheckResult, error) {
+	lastFileNum, err := retrieveLastFileSuffix(ledgerDir)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &BlockfilesCheckResult{}
+	var previous *common.Block
+	for fileNum := 0; fileNum <= lastFileNum; fileNum++ {
+		stream, err := newBlockfileStream(ledgerDir, fileNum, 0)
+		if err != nil {
+			return nil, err
+		}
+		for {
+			blockBytes, _, err := stream.nextBlockBytesAndPlacementInfo()
+			if err == ErrUnexpectedEndOfBlockfile && fileNum == lastFileNum {
+				result.TornBlockfile = deriveBlockfilePath(ledgerDir, fileNum)
+				result.TornBytes = getFileInfoOrPanic(ledgerDir, fileNum).Size() - stream.currentOffset
+				break
+			}
+			if err != nil {
+				stream.close()
+				return nil, errors.Wrapf(err, "failed reading block %d from block file %d", result.Height, fileNum)
+			}
+			if blockBytes == nil {
+				break
+			}
+
+			block, err := deserializeBlock(blockBytes)
+			if err != nil {
+				stream.close()
+				return nil, errors.Wrapf(err, "failed deserializing block %d", result.Height)
+			}
+			if err := verifyChaining(block, previous, result.Height); err != nil {
+				stream.close()
+				return nil, err
+			}
+			if verifyBlock != nil {
+				if err := verifyBlock(block); err != nil {
+					stream.close()
+					return nil, errors.Wrapf(err, "block %d failed verification", block.Header.Number)
+				}
+			}
+			previous = block
+			result.Height++
+		}
+
+		offset := stream.currentOffset
+		if err := stream.close(); err != nil {
+			return nil, err
+		}
+		if result.TornBlockfile != "" && truncate {
+			if err := os.Truncate(result.TornBlockfile, offset); err != nil {
+				return nil, errors.Wrapf(err, "failed truncating block file %s", result.TornBlockfile)
+			}
+			result.Truncated = true
+		}
+	}
+
+	return result, nil
+}
+
+func verifyChaining(block, previous *common.Block, expectedNumber uint64) error {
+	if block.Header.Number != expectedNumber {
+		return errors.Errorf("expected block %d but found block %d", expectedNumber, block.Header.Number)
+	}
+	if !bytes.Equal(block.Header.DataHash, protoutil.BlockDataHash(block.Data)) {
+		return errors.Errorf("data hash of block %d does not match its data", block.Header.Number)
+	}
+	if previous != nil && !bytes.Equal(block.Header.PreviousHash, protoutil.BlockHeaderHash(previous.Header)) {
+		return errors.Errorf("previous hash of block %d does not match the hash of block %d", block.Header.Number, previous.Header.Number)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fsblkstorage
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/ledger/testutil"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckBlockfiles(t *testing.T) {
+	testPath := "/tmp/tests/fabric/common/ledger/blkstorage/fsblkstorage"
+	ledgerid := "testLedger"
+	conf := NewConf(testPath, 0)
+	blkStoreDir := conf.getLedgerBlockDir(ledgerid)
+	env := newTestEnv(t, conf)
+	defer env.Cleanup()
+
+	w := newTestBlockfileWrapper(env, ledgerid)
+	defer w.close()
+	blockfileMgr := w.blockfileMgr
+	bg, gb := testutil.NewBlockGenerator(t, ledgerid, false)
+
+	// Spread the blocks over two block files
+	blockfileMgr.addBlock(gb)
+	for _, blk := range bg.NextTestBlocks(3) {
+		blockfileMgr.addBlock(blk)
+	}
+	blockfileMgr.moveToNextFile()
+	for _, blk := range bg.NextTestBlocks(2) {
+		blockfileMgr.addBlock(blk)
+	}
+
+	var verified []uint64
+	result, err := CheckBlockfiles(blkStoreDir, false, func(block *common.Block) error {
+		verified = append(verified, block.Header.Number)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &BlockfilesCheckResult{Height: 6}, result)
+	assert.Equal(t, []uint64{0, 1, 2, 3, 4, 5}, verified)
+
+	_, err = CheckBlockfiles(blkStoreDir, false, func(block *common.Block) error {
+		if block.Header.Number == 4 {
+			return errors.New("bad metadata")
+		}
+		return nil
+	})
+	assert.EqualError(t, err, "block 4 failed verification: bad metadata")
+
+	// Write a partial block to simulate a crash while appending it
+	blockBytes, _, err := serializeBlock(bg.NextTestBlocks(1)[0])
+	assert.NoError(t, err)
+	partialBytes := append(proto.EncodeVarint(uint64(len(blockBytes))), blockBytes[len(blockBytes)/2:]...)
+	blockfileMgr.currentFileWriter.append(partialBytes, true)
+	lastFile := deriveBlockfilePath(blkStoreDir, 1)
+	sizeWithTornBlock := getFileInfoOrPanic(blkStoreDir, 1).Size()
+
+	// Without truncation, the torn block is only reported
+	result, err = CheckBlockfiles(blkStoreDir, false, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &BlockfilesCheckResult{
+		Height:        6,
+		TornBlockfile: lastFile,
+		TornBytes:     int64(len(partialBytes)),
+	}, result)
+	assert.Equal(t, sizeWithTornBlock, getFileInfoOrPanic(blkStoreDir, 1).Size())
+
+	result, err = CheckBlockfiles(blkStoreDir, true, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &BlockfilesCheckResult{
+		Height:        6,
+		TornBlockfile: lastFile,
+		TornBytes:     int64(len(partialBytes)),
+		Truncated:     true,
+	}, result)
+	assert.Equal(t, sizeWithTornBlock-int64(len(partialBytes)), getFileInfoOrPanic(blkStoreDir, 1).Size())
+
+	result, err = CheckBlockfiles(blkStoreDir, false, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &BlockfilesCheckResult{Height: 6}, result)
+}
+
+func TestCheckBlockfilesBrokenChain(t *testing.T) {
+	testPath := "/tmp/tests/fabric/common/ledger/blkstorage/fsblkstorage"
+	ledgerid := "testLedger"
+	conf := NewConf(testPath, 0)
+	blkStoreDir := conf.getLedgerBlockDir(ledgerid)
+	env := newTestEnv(t, conf)
+	defer env.Cleanup()
+
+	w := newTestBlockfileWrapper(env, ledgerid)
+	defer w.close()
+	bg, gb := testutil.NewBlockGenerator(t, ledgerid, false)
+
+	blocks := append([]*common.Block{gb}, bg.NextTestBlocks(3)...)
+	// Append the blocks directly, bypassing the checks of the block file manager
+	for _, block := range blocks {
+		if block.Header.Number == 2 {
+			block.Header.PreviousHash = []byte("tampered")
+		}
+		blockBytes, _, err := serializeBlock(block)
+		assert.NoError(t, err)
+		assert.NoError(t, w.blockfileMgr.currentFileWriter.append(append(proto.EncodeVarint(uint64(len(blockBytes))), blockBytes...), true))
+	}
+
+	_, err := CheckBlockfiles(blkStoreDir, true, nil)
+	assert.EqualError(t, err, "previous hash of block 2 does not match the hash of block 1")
+}
+
+func TestCheckBlockfilesMissingDir(t *testing.T) {
+	_, err := CheckBlockfiles("/tmp/tests/fabric/common/ledger/blkstorage/fsblkstorage/missing", false, nil)
+	assert.Contains(t, err.Error(), "error reading dir")
+}
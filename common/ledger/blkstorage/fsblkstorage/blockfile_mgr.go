@@ -12,6 +12,7 @@ import (
 	"math"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/golang/protobuf/proto"
@@ -44,6 +45,8 @@ type blockfileMgr struct {
 	cpInfoCond        *sync.Cond
 	currentFileWriter *blockfileWriter
 	bcInfo            atomic.Value
+	stats             *ledgerStats
+	snapshotInfo      *blkstorage.SnapshotInfo
 }
 
 /*
@@ -87,7 +90,7 @@ At start up a new manager:
 		-- If index and file system are not in sync, syncs index from the FS
   *)  Updates blockchain info used by the APIs
 */
-func newBlockfileMgr(id string, conf *Conf, indexConfig *blkstorage.IndexConfig, indexStore *leveldbhelper.DBHandle) *blockfileMgr {
+func newBlockfileMgr(id string, conf *Conf, indexConfig *blkstorage.IndexConfig, indexStore *leveldbhelper.DBHandle, stats *ledgerStats) *blockfileMgr {
 	logger.Debugf("newBlockfileMgr() initializing file-based block storage for ledger: %s ", id)
 	//Determine the root directory for the blockfile storage, if it does not exist create it
 	rootDir := conf.getLedgerBlockDir(id)
@@ -96,7 +99,12 @@ func newBlockfileMgr(id string, conf *Conf, indexConfig *blkstorage.IndexConfig,
 		panic(fmt.Sprintf("Error creating block storage root dir [%s]: %s", rootDir, err))
 	}
 	// Instantiate the manager, i.e. blockFileMgr structure
-	mgr := &blockfileMgr{rootDir: rootDir, conf: conf, db: indexStore}
+	mgr := &blockfileMgr{rootDir: rootDir, conf: conf, db: indexStore, stats: stats}
+
+	// A block store bootstrapped from a snapshot starts after the last block of the snapshot
+	if mgr.snapshotInfo, err = loadSnapshotInfo(indexStore); err != nil {
+		panic(fmt.Sprintf("Could not load the snapshot info of the block storage from db: %s", err))
+	}
 
 	// cp = checkpointInfo, retrieve from the database the file suffix or number of where blocks were stored.
 	// It also retrieves the current size of that file and the last block number that was written to that file.
@@ -113,7 +121,7 @@ func newBlockfileMgr(id string, conf *Conf, indexConfig *blkstorage.IndexConfig,
 		logger.Debugf("Info constructed by scanning the blocks dir = %s", spew.Sdump(cpInfo))
 	} else {
 		logger.Debug(`Synching block information from block storage (if needed)`)
-		syncCPInfoFromFS(rootDir, cpInfo)
+		syncCPInfoFromFS(rootDir, cpInfo, firstBlockNum(mgr.snapshotInfo))
 	}
 	err = mgr.saveCurrentInfo(cpInfo, true)
 	if err != nil {
@@ -149,6 +157,12 @@ func newBlockfileMgr(id string, conf *Conf, indexConfig *blkstorage.IndexConfig,
 		Height:            0,
 		CurrentBlockHash:  nil,
 		PreviousBlockHash: nil}
+	if mgr.snapshotInfo != nil {
+		bcInfo = &common.BlockchainInfo{
+			Height:            mgr.snapshotInfo.LastBlockNum + 1,
+			CurrentBlockHash:  mgr.snapshotInfo.LastBlockHash,
+			PreviousBlockHash: mgr.snapshotInfo.PreviousBlockHash}
+	}
 
 	if !cpInfo.isChainEmpty {
 		//If start up is a restart of an existing storage, sync the index from block storage and update BlockchainInfo for external API's
@@ -172,7 +186,9 @@ func newBlockfileMgr(id string, conf *Conf, indexConfig *blkstorage.IndexConfig,
 // the file of where the last block was written.  Also retrieves contains the
 // last block number that was written.  At init
 //checkpointInfo:latestFileChunkSuffixNum=[0], latestFileChunksize=[0], lastBlockNumber=[0]
-func syncCPInfoFromFS(rootDir string, cpInfo *checkpointInfo) {
+//firstBlockNum is the number of the first block in the block files, which is not
+//zero for a block storage bootstrapped from a snapshot
+func syncCPInfoFromFS(rootDir string, cpInfo *checkpointInfo, firstBlockNum uint64) {
 	logger.Debugf("Starting checkpoint=%s", cpInfo)
 	//Checks if the file suffix of where the last block was written exists
 	filePath := deriveBlockfilePath(rootDir, cpInfo.latestFileChunkSuffixNum)
@@ -200,7 +216,7 @@ func syncCPInfoFromFS(rootDir string, cpInfo *checkpointInfo) {
 	}
 	//Updates the checkpoint info for the actual last block number stored and it's end location
 	if cpInfo.isChainEmpty {
-		cpInfo.lastBlockNumber = uint64(numBlocks - 1)
+		cpInfo.lastBlockNumber = firstBlockNum + uint64(numBlocks-1)
 	} else {
 		cpInfo.lastBlockNumber += uint64(numBlocks)
 	}
@@ -313,11 +329,14 @@ func (mgr *blockfileMgr) addBlock(block *common.Block) error {
 		txOffset.loc.offset += len(blockBytesEncodedLen)
 	}
 	//save the index in the database
+	startIndexBlock := time.Now()
 	if err = mgr.index.indexBlock(&blockIdxInfo{
 		blockNum: block.Header.Number, blockHash: blockHash,
-		flp: blockFLP, txOffsets: txOffsets, metadata: block.Metadata}); err != nil {
+		flp: blockFLP, txOffsets: txOffsets, metadata: block.Metadata,
+		timestamp: info.timestamp}); err != nil {
 		return err
 	}
+	mgr.stats.updateBlockIndexTime(time.Since(startIndexBlock))
 
 	//update the checkpoint info (for storage) and the blockchain info (for APIs) in the manager
 	mgr.updateCheckpoint(newCPInfo)
@@ -414,6 +433,7 @@ func (mgr *blockfileMgr) syncIndex() error {
 			locPointer: locPointer{offset: int(blockPlacementInfo.blockStartOffset)}}
 		blockIdxInfo.txOffsets = info.txOffsets
 		blockIdxInfo.metadata = info.metadata
+		blockIdxInfo.timestamp = info.timestamp
 
 		logger.Debugf("syncIndex() indexing block [%d]", blockIdxInfo.blockNum)
 		if err = mgr.index.indexBlock(blockIdxInfo); err != nil {
@@ -489,6 +509,11 @@ func (mgr *blockfileMgr) retrieveTxValidationCodeByTxID(txID string) (peer.TxVal
 	return mgr.index.getTxValidationCodeByTxID(txID)
 }
 
+func (mgr *blockfileMgr) retrieveBlockNumberByTime(t time.Time) (uint64, error) {
+	logger.Debugf("retrieveBlockNumberByTime() - time = [%s]", t)
+	return mgr.index.getBlockNumByTime(t)
+}
+
 func (mgr *blockfileMgr) retrieveBlockHeaderByNumber(blockNum uint64) (*common.BlockHeader, error) {
 	logger.Debugf("retrieveBlockHeaderByNumber() - blockNum = [%d]", blockNum)
 	loc, err := mgr.index.getBlockLocByBlockNum(blockNum)
@@ -507,6 +532,9 @@ func (mgr *blockfileMgr) retrieveBlockHeaderByNumber(blockNum uint64) (*common.B
 }
 
 func (mgr *blockfileMgr) retrieveBlocks(startNum uint64) (*blocksItr, error) {
+	if startNum < firstBlockNum(mgr.snapshotInfo) {
+		return nil, errBlockBeforeSnapshot(startNum, mgr.snapshotInfo)
+	}
 	return newBlockItr(mgr, startNum), nil
 }
 
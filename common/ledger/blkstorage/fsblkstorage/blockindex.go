@@ -9,11 +9,15 @@ package fsblkstorage
 import (
 	"bytes"
 	"fmt"
+	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/hyperledger/fabric/common/ledger/blkstorage"
 	"github.com/hyperledger/fabric/common/ledger/util"
 	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
+	"github.com/hyperledger/fabric/core/ledger"
 	ledgerUtil "github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/peer"
@@ -27,10 +31,14 @@ const (
 	blockNumTranNumIdxKeyPrefix    = 'a'
 	blockTxIDIdxKeyPrefix          = 'b'
 	txValidationResultIdxKeyPrefix = 'v'
+	blockTimeIdxKeyPrefix          = 'c'
+	snapshotTxIDIdxKeyPrefix       = 's'
 	indexCheckpointKeyStr          = "indexCheckpointKey"
+	blockTimeIdxStateKeyStr        = "indexBlockTimeStateKey"
 )
 
 var indexCheckpointKey = []byte(indexCheckpointKeyStr)
+var blockTimeIdxStateKey = []byte(blockTimeIdxStateKeyStr)
 var errIndexEmpty = errors.New("NoBlockIndexed")
 
 type index interface {
@@ -42,6 +50,7 @@ type index interface {
 	getTXLocByBlockNumTranNum(blockNum uint64, tranNum uint64) (*fileLocPointer, error)
 	getBlockLocByTxID(txID string) (*fileLocPointer, error)
 	getTxValidationCodeByTxID(txID string) (peer.TxValidationCode, error)
+	getBlockNumByTime(t time.Time) (uint64, error)
 }
 
 type blockIdxInfo struct {
@@ -50,6 +59,7 @@ type blockIdxInfo struct {
 	flp       *fileLocPointer
 	txOffsets []*txindexInfo
 	metadata  *common.BlockMetadata
+	timestamp *timestamp.Timestamp
 }
 
 type blockIndex struct {
@@ -167,6 +177,13 @@ func (index *blockIndex) indexBlock(blockIdxInfo *blockIdxInfo) error {
 		}
 	}
 
+	// Index7 - Store BlockNumber by the latest timestamp of the blocks up to it, used to find the blocks since a given time
+	if _, ok := index.indexItemsMap[blkstorage.IndexableAttrBlockTime]; ok {
+		if err := index.indexBlockTime(blockIdxInfo, batch); err != nil {
+			return err
+		}
+	}
+
 	batch.Put(indexCheckpointKey, encodeBlockNum(blockIdxInfo.blockNum))
 	// Setting snyc to true as a precaution, false may be an ok optimization after further testing.
 	if err := index.db.WriteBatch(batch, true); err != nil {
@@ -175,6 +192,51 @@ func (index *blockIndex) indexBlock(blockIdxInfo *blockIdxInfo) error {
 	return nil
 }
 
+// indexBlockTime adds the block to the block time index. As the timestamps of the blocks are set
+// by the clients, they are not monotonic, and the blocks are indexed by the running maximum of
+// their timestamps instead, so that the first block indexed at or after a given time is also the
+// first block whose own timestamp is not before that time. Blocks without a valid timestamp are
+// not indexed, as they are never created since any time.
+func (index *blockIndex) indexBlockTime(blockIdxInfo *blockIdxInfo, batch *leveldbhelper.UpdateBatch) error {
+	state, err := index.getBlockTimeIdxState()
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		state = &blockTimeIdxState{firstBlockNum: blockIdxInfo.blockNum}
+	}
+
+	if blockIdxInfo.timestamp == nil {
+		logger.Debugf("Not indexing block [%d] in block time index as it has no timestamp", blockIdxInfo.blockNum)
+	} else if blockTime, err := ptypes.Timestamp(blockIdxInfo.timestamp); err != nil {
+		logger.Warningf("Not indexing block [%d] in block time index as its timestamp is invalid: %s", blockIdxInfo.blockNum, err)
+	} else {
+		if nanos := timeToNanos(blockTime); nanos > state.maxNanos {
+			state.maxNanos = nanos
+		}
+		batch.Put(constructBlockTimeNanosKey(state.maxNanos, blockIdxInfo.blockNum), encodeBlockNum(blockIdxInfo.blockNum))
+	}
+
+	stateBytes, err := state.marshal()
+	if err != nil {
+		return err
+	}
+	batch.Put(blockTimeIdxStateKey, stateBytes)
+	return nil
+}
+
+func (index *blockIndex) getBlockTimeIdxState() (*blockTimeIdxState, error) {
+	b, err := index.db.Get(blockTimeIdxStateKey)
+	if err != nil || b == nil {
+		return nil, err
+	}
+	state := &blockTimeIdxState{}
+	if err := state.unmarshal(b); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling the state of the block time index")
+	}
+	return state, nil
+}
+
 func (index *blockIndex) markDuplicateTxids(blockIdxInfo *blockIdxInfo) error {
 	uniqueTxids := make(map[string]bool)
 	for _, txIdxInfo := range blockIdxInfo.txOffsets {
@@ -189,6 +251,10 @@ func (index *blockIndex) markDuplicateTxids(blockIdxInfo *blockIdxInfo) error {
 			txIdxInfo.isDuplicate = true
 			continue
 		}
+		if _, ok := err.(*ledger.ErrTxBeforeSnapshot); ok { // txid is duplicate of a tx of the snapshot
+			txIdxInfo.isDuplicate = true
+			continue
+		}
 		if err != blkstorage.ErrNotFoundInIndex {
 			return err
 		}
@@ -238,7 +304,7 @@ func (index *blockIndex) getTxLoc(txID string) (*fileLocPointer, error) {
 		return nil, err
 	}
 	if b == nil {
-		return nil, blkstorage.ErrNotFoundInIndex
+		return nil, index.errTxNotFound(txID)
 	}
 	txFLP := &fileLocPointer{}
 	txFLP.unmarshal(b)
@@ -254,7 +320,7 @@ func (index *blockIndex) getBlockLocByTxID(txID string) (*fileLocPointer, error)
 		return nil, err
 	}
 	if b == nil {
-		return nil, blkstorage.ErrNotFoundInIndex
+		return nil, index.errTxNotFound(txID)
 	}
 	txFLP := &fileLocPointer{}
 	txFLP.unmarshal(b)
@@ -287,7 +353,14 @@ func (index *blockIndex) getTxValidationCodeByTxID(txID string) (peer.TxValidati
 	if err != nil {
 		return peer.TxValidationCode(-1), err
 	} else if raw == nil {
-		return peer.TxValidationCode(-1), blkstorage.ErrNotFoundInIndex
+		snapshotTxID, err := index.getSnapshotTxID(txID)
+		if err != nil {
+			return peer.TxValidationCode(-1), err
+		}
+		if snapshotTxID == nil {
+			return peer.TxValidationCode(-1), blkstorage.ErrNotFoundInIndex
+		}
+		return snapshotTxID.ValidationCode, nil
 	} else if len(raw) != 1 {
 		return peer.TxValidationCode(-1), errors.New("invalid value in indexItems")
 	}
@@ -297,6 +370,64 @@ func (index *blockIndex) getTxValidationCodeByTxID(txID string) (peer.TxValidati
 	return result, nil
 }
 
+// getSnapshotTxID returns the transaction id imported from the snapshot the block
+// store was bootstrapped from, or nil if the id is not one of the snapshot
+func (index *blockIndex) getSnapshotTxID(txID string) (*blkstorage.SnapshotTxID, error) {
+	b, err := index.db.Get(constructSnapshotTxIDKey(txID))
+	if err != nil || b == nil {
+		return nil, err
+	}
+	return unmarshalSnapshotTxID(txID, b)
+}
+
+// errTxNotFound returns the error for a transaction id missing from the index, which
+// tells apart the ids of the transactions of the snapshot the block store was bootstrapped
+// from, as the transactions themselves are not available
+func (index *blockIndex) errTxNotFound(txID string) error {
+	snapshotTxID, err := index.getSnapshotTxID(txID)
+	if err != nil {
+		return err
+	}
+	if snapshotTxID == nil {
+		return blkstorage.ErrNotFoundInIndex
+	}
+	return &ledger.ErrTxBeforeSnapshot{TxID: txID, BlockNum: snapshotTxID.BlockNum}
+}
+
+func (index *blockIndex) getBlockNumByTime(t time.Time) (uint64, error) {
+	if _, ok := index.indexItemsMap[blkstorage.IndexableAttrBlockTime]; !ok {
+		return 0, blkstorage.ErrAttrNotIndexed
+	}
+
+	// The blocks committed before the block time index was enabled are not indexed by
+	// time, so the first block created since the given time may be one of them
+	state, err := index.getBlockTimeIdxState()
+	if err != nil {
+		return 0, err
+	}
+	if state == nil {
+		if _, err := index.getLastBlockIndexed(); err == errIndexEmpty {
+			return 0, blkstorage.ErrNotFoundInIndex
+		} else if err != nil {
+			return 0, err
+		}
+		return 0, blkstorage.ErrBlockTimeNotIndexed
+	}
+	if state.firstBlockNum > 0 {
+		return 0, blkstorage.ErrBlockTimeNotIndexed
+	}
+
+	itr := index.db.GetIterator(constructBlockTimeKey(t, 0), []byte{blockTimeIdxKeyPrefix + 1})
+	defer itr.Release()
+	if !itr.Next() {
+		if err := itr.Error(); err != nil {
+			return 0, err
+		}
+		return 0, blkstorage.ErrNotFoundInIndex
+	}
+	return decodeBlockNum(itr.Value()), nil
+}
+
 func constructBlockNumKey(blockNum uint64) []byte {
 	blkNumBytes := util.EncodeOrderPreservingVarUint64(blockNum)
 	return append([]byte{blockNumIdxKeyPrefix}, blkNumBytes...)
@@ -318,6 +449,10 @@ func constructTxValidationCodeIDKey(txID string) []byte {
 	return append([]byte{txValidationResultIdxKeyPrefix}, []byte(txID)...)
 }
 
+func constructSnapshotTxIDKey(txID string) []byte {
+	return append([]byte{snapshotTxIDIdxKeyPrefix}, []byte(txID)...)
+}
+
 func constructBlockNumTranNumKey(blockNum uint64, txNum uint64) []byte {
 	blkNumBytes := util.EncodeOrderPreservingVarUint64(blockNum)
 	tranNumBytes := util.EncodeOrderPreservingVarUint64(txNum)
@@ -325,6 +460,26 @@ func constructBlockNumTranNumKey(blockNum uint64, txNum uint64) []byte {
 	return append([]byte{blockNumTranNumIdxKeyPrefix}, key...)
 }
 
+// constructBlockTimeKey constructs the key of a block in the block time index,
+// which sorts the blocks by time, and then by their numbers
+func constructBlockTimeKey(blockTime time.Time, blockNum uint64) []byte {
+	return constructBlockTimeNanosKey(timeToNanos(blockTime), blockNum)
+}
+
+func constructBlockTimeNanosKey(nanos uint64, blockNum uint64) []byte {
+	key := append(util.EncodeOrderPreservingVarUint64(nanos), util.EncodeOrderPreservingVarUint64(blockNum)...)
+	return append([]byte{blockTimeIdxKeyPrefix}, key...)
+}
+
+// timeToNanos returns the nanoseconds elapsed since the Unix epoch. The times
+// before the epoch are treated as the epoch.
+func timeToNanos(t time.Time) uint64 {
+	if t.After(time.Unix(0, 0)) {
+		return uint64(t.UnixNano())
+	}
+	return 0
+}
+
 func encodeBlockNum(blockNum uint64) []byte {
 	return proto.EncodeVarint(blockNum)
 }
@@ -395,6 +550,40 @@ func (flp *fileLocPointer) unmarshal(b []byte) error {
 	return nil
 }
 
+// blockTimeIdxState is the state of the block time index
+type blockTimeIdxState struct {
+	// firstBlockNum is the number of the first block indexed by time
+	firstBlockNum uint64
+	// maxNanos is the latest timestamp of the blocks indexed by time
+	maxNanos uint64
+}
+
+func (s *blockTimeIdxState) marshal() ([]byte, error) {
+	buffer := proto.NewBuffer([]byte{})
+	if err := buffer.EncodeVarint(s.firstBlockNum); err != nil {
+		return nil, err
+	}
+	if err := buffer.EncodeVarint(s.maxNanos); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func (s *blockTimeIdxState) unmarshal(b []byte) error {
+	buffer := proto.NewBuffer(b)
+	i, e := buffer.DecodeVarint()
+	if e != nil {
+		return e
+	}
+	s.firstBlockNum = i
+	i, e = buffer.DecodeVarint()
+	if e != nil {
+		return e
+	}
+	s.maxNanos = i
+	return nil
+}
+
 func (flp *fileLocPointer) String() string {
 	return fmt.Sprintf("fileSuffixNum=%d, %s", flp.fileSuffixNum, flp.locPointer.String())
 }
@@ -9,7 +9,9 @@ package fsblkstorage
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/hyperledger/fabric/common/ledger/blkstorage"
 	"github.com/hyperledger/fabric/common/ledger/testutil"
 	"github.com/hyperledger/fabric/core/ledger/util"
@@ -49,6 +51,10 @@ func (i *noopIndex) getTxValidationCodeByTxID(txID string) (peer.TxValidationCod
 	return peer.TxValidationCode(-1), nil
 }
 
+func (i *noopIndex) getBlockNumByTime(t time.Time) (uint64, error) {
+	return 0, nil
+}
+
 func TestBlockIndexSync(t *testing.T) {
 	testBlockIndexSync(t, 10, 5, false)
 	testBlockIndexSync(t, 10, 5, true)
@@ -142,6 +148,7 @@ func TestBlockIndexSelectiveIndexing(t *testing.T) {
 	testBlockIndexSelectiveIndexing(t, []blkstorage.IndexableAttr{blkstorage.IndexableAttrTxID, blkstorage.IndexableAttrBlockNumTranNum})
 	testBlockIndexSelectiveIndexing(t, []blkstorage.IndexableAttr{blkstorage.IndexableAttrTxID, blkstorage.IndexableAttrBlockTxID})
 	testBlockIndexSelectiveIndexing(t, []blkstorage.IndexableAttr{blkstorage.IndexableAttrTxID, blkstorage.IndexableAttrTxValidationCode})
+	testBlockIndexSelectiveIndexing(t, []blkstorage.IndexableAttr{blkstorage.IndexableAttrBlockTime})
 }
 
 func testBlockIndexSelectiveIndexing(t *testing.T, indexItems []blkstorage.IndexableAttr) {
@@ -216,6 +223,15 @@ func testBlockIndexSelectiveIndexing(t *testing.T, indexItems []blkstorage.Index
 			assert.Exactly(t, blkstorage.ErrAttrNotIndexed, err)
 		}
 
+		// test 'retrieveBlockNumberByTime'
+		blockNum, err := blockfileMgr.retrieveBlockNumberByTime(time.Unix(0, 0))
+		if containsAttr(indexItems, blkstorage.IndexableAttrBlockTime) {
+			assert.NoError(t, err, "Error while retrieving block number by time")
+			assert.Equal(t, uint64(0), blockNum)
+		} else {
+			assert.Exactly(t, blkstorage.ErrAttrNotIndexed, err)
+		}
+
 		for _, block := range blocks {
 			flags := util.TxValidationFlags(block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER])
 
@@ -239,6 +255,98 @@ func testBlockIndexSelectiveIndexing(t *testing.T, indexItems []blkstorage.Index
 	})
 }
 
+func TestBlockIndexBlockTime(t *testing.T) {
+	env := newTestEnvSelectiveIndexing(t, NewConf(testPath(), 0), []blkstorage.IndexableAttr{
+		blkstorage.IndexableAttrBlockNum,
+		blkstorage.IndexableAttrBlockTime,
+	})
+	defer env.Cleanup()
+	blkfileMgrWrapper := newTestBlockfileWrapper(env, "testledger")
+	defer blkfileMgrWrapper.close()
+	blkfileMgr := blkfileMgrWrapper.blockfileMgr
+
+	_, err := blkfileMgr.retrieveBlockNumberByTime(time.Unix(0, 0))
+	assert.Exactly(t, blkstorage.ErrNotFoundInIndex, err)
+
+	// the timestamps of the blocks are set by the clients, and are not monotonic
+	blocks := constructBlocksWithTimestamps(0, nil, 100, 300, 200, 0, 400, 350)
+	blkfileMgrWrapper.addBlocks(blocks[:4])
+
+	// The last blocks are indexed when the index is synced
+	blkfileMgr.index = &noopIndex{}
+	blkfileMgrWrapper.addBlocks(blocks[4:])
+	blkfileMgrWrapper.close()
+	blkfileMgrWrapper = newTestBlockfileWrapper(env, "testledger")
+	defer blkfileMgrWrapper.close()
+	blkfileMgr = blkfileMgrWrapper.blockfileMgr
+
+	for _, testCase := range []struct {
+		seconds  int64
+		blockNum uint64
+	}{
+		{seconds: 50, blockNum: 0},
+		{seconds: 100, blockNum: 0},
+		{seconds: 150, blockNum: 1},
+		{seconds: 200, blockNum: 1},
+		{seconds: 300, blockNum: 1},
+		{seconds: 350, blockNum: 4},
+		{seconds: 400, blockNum: 4},
+	} {
+		blockNum, err := blkfileMgr.retrieveBlockNumberByTime(time.Unix(testCase.seconds, 0))
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.blockNum, blockNum, "unexpected block number for time %d", testCase.seconds)
+	}
+
+	_, err = blkfileMgr.retrieveBlockNumberByTime(time.Unix(450, 0))
+	assert.Exactly(t, blkstorage.ErrNotFoundInIndex, err)
+}
+
+func TestBlockIndexBlockTimeEnabledLater(t *testing.T) {
+	conf := NewConf(testPath(), 0)
+	env := newTestEnvSelectiveIndexing(t, conf, []blkstorage.IndexableAttr{blkstorage.IndexableAttrBlockNum})
+	blkfileMgrWrapper := newTestBlockfileWrapper(env, "testledger")
+	blocks := constructBlocksWithTimestamps(0, nil, 100, 200, 300)
+	blkfileMgrWrapper.addBlocks(blocks[:2])
+	blkfileMgrWrapper.close()
+	env.provider.Close()
+
+	env = newTestEnvSelectiveIndexing(t, conf, []blkstorage.IndexableAttr{
+		blkstorage.IndexableAttrBlockNum,
+		blkstorage.IndexableAttrBlockTime,
+	})
+	defer env.Cleanup()
+	blkfileMgrWrapper = newTestBlockfileWrapper(env, "testledger")
+	defer blkfileMgrWrapper.close()
+	blkfileMgr := blkfileMgrWrapper.blockfileMgr
+
+	// the blocks committed before the block time index was enabled are not indexed by time
+	_, err := blkfileMgr.retrieveBlockNumberByTime(time.Unix(150, 0))
+	assert.Exactly(t, blkstorage.ErrBlockTimeNotIndexed, err)
+
+	blkfileMgrWrapper.addBlocks(blocks[2:])
+	_, err = blkfileMgr.retrieveBlockNumberByTime(time.Unix(250, 0))
+	assert.Exactly(t, blkstorage.ErrBlockTimeNotIndexed, err)
+}
+
+// constructBlocksWithTimestamps constructs a block per given number of seconds since the epoch,
+// whose first transaction is timestamped at that time. Zero seconds stand for no timestamp.
+func constructBlocksWithTimestamps(firstBlockNum uint64, previousHash []byte, seconds ...int64) []*common.Block {
+	var blocks []*common.Block
+	for i, s := range seconds {
+		chdr := &common.ChannelHeader{TxId: fmt.Sprintf("tx%d", firstBlockNum+uint64(i))}
+		if s != 0 {
+			chdr.Timestamp = &timestamp.Timestamp{Seconds: s}
+		}
+		env := &common.Envelope{Payload: protoutil.MarshalOrPanic(&common.Payload{
+			Header: &common.Header{ChannelHeader: protoutil.MarshalOrPanic(chdr)},
+		})}
+		block := testutil.NewBlock([]*common.Envelope{env}, firstBlockNum+uint64(i), previousHash)
+		previousHash = protoutil.BlockHeaderHash(block.Header)
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
 func containsAttr(indexItems []blkstorage.IndexableAttr, attr blkstorage.IndexableAttr) bool {
 	for _, element := range indexItems {
 		if element == attr {
@@ -17,6 +17,8 @@ limitations under the License.
 package fsblkstorage
 
 import (
+	"time"
+
 	"github.com/hyperledger/fabric/common/ledger"
 	"github.com/hyperledger/fabric/common/ledger/blkstorage"
 	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
@@ -33,8 +35,8 @@ type fsBlockStore struct {
 
 // NewFsBlockStore constructs a `FsBlockStore`
 func newFsBlockStore(id string, conf *Conf, indexConfig *blkstorage.IndexConfig,
-	dbHandle *leveldbhelper.DBHandle) *fsBlockStore {
-	return &fsBlockStore{id, conf, newBlockfileMgr(id, conf, indexConfig, dbHandle)}
+	dbHandle *leveldbhelper.DBHandle, stats *ledgerStats) *fsBlockStore {
+	return &fsBlockStore{id, conf, newBlockfileMgr(id, conf, indexConfig, dbHandle, stats)}
 }
 
 // AddBlock adds a new block
@@ -80,6 +82,17 @@ func (store *fsBlockStore) RetrieveTxValidationCodeByTxID(txID string) (peer.TxV
 	return store.fileMgr.retrieveTxValidationCodeByTxID(txID)
 }
 
+// RetrieveBlockNumberByTime returns the lowest number of the blocks whose
+// timestamp is not before the given time
+func (store *fsBlockStore) RetrieveBlockNumberByTime(t time.Time) (uint64, error) {
+	return store.fileMgr.retrieveBlockNumberByTime(t)
+}
+
+// ExportTxIDs passes the id of each transaction in the block store to the given function
+func (store *fsBlockStore) ExportTxIDs(export func(txID *blkstorage.SnapshotTxID) error) error {
+	return store.fileMgr.exportTxIDs(export)
+}
+
 // Shutdown shuts down the block store
 func (store *fsBlockStore) Shutdown() {
 	logger.Debugf("closing fs blockStore:%s", store.id)
@@ -17,9 +17,13 @@ limitations under the License.
 package fsblkstorage
 
 import (
+	"os"
+
 	"github.com/hyperledger/fabric/common/ledger/blkstorage"
 	"github.com/hyperledger/fabric/common/ledger/util"
 	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
+	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/pkg/errors"
 )
 
 // FsBlockstoreProvider provides handle to block storage - this is not thread-safe
@@ -27,12 +31,13 @@ type FsBlockstoreProvider struct {
 	conf            *Conf
 	indexConfig     *blkstorage.IndexConfig
 	leveldbProvider *leveldbhelper.Provider
+	stats           *stats
 }
 
 // NewProvider constructs a filesystem based block store provider
-func NewProvider(conf *Conf, indexConfig *blkstorage.IndexConfig) blkstorage.BlockStoreProvider {
+func NewProvider(conf *Conf, indexConfig *blkstorage.IndexConfig, metricsProvider metrics.Provider) blkstorage.BlockStoreProvider {
 	p := leveldbhelper.NewProvider(&leveldbhelper.Conf{DBPath: conf.getIndexDir()})
-	return &FsBlockstoreProvider{conf, indexConfig, p}
+	return &FsBlockstoreProvider{conf, indexConfig, p, newStats(metricsProvider)}
 }
 
 // CreateBlockStore simply calls OpenBlockStore
@@ -45,7 +50,7 @@ func (p *FsBlockstoreProvider) CreateBlockStore(ledgerid string) (blkstorage.Blo
 // This method should be invoked only once for a particular ledgerid
 func (p *FsBlockstoreProvider) OpenBlockStore(ledgerid string) (blkstorage.BlockStore, error) {
 	indexStoreHandle := p.leveldbProvider.GetDBHandle(ledgerid)
-	return newFsBlockStore(ledgerid, p.conf, p.indexConfig, indexStoreHandle), nil
+	return newFsBlockStore(ledgerid, p.conf, p.indexConfig, indexStoreHandle, p.stats.ledgerStats(ledgerid)), nil
 }
 
 // Exists tells whether the BlockStore with given id exists
@@ -59,6 +64,25 @@ func (p *FsBlockstoreProvider) List() ([]string, error) {
 	return util.ListSubdirs(p.conf.getChainsDir())
 }
 
+// Remove deletes the block files and the index entries of the given ledger.
+// The BlockStore for the ledger should be shut down before calling Remove
+func (p *FsBlockstoreProvider) Remove(ledgerid string) error {
+	indexStoreHandle := p.leveldbProvider.GetDBHandle(ledgerid)
+	itr := indexStoreHandle.GetIterator(nil, nil)
+	batch := leveldbhelper.NewUpdateBatch()
+	for itr.Next() {
+		batch.Delete(append([]byte{}, itr.Key()...))
+	}
+	itr.Release()
+	if err := indexStoreHandle.WriteBatch(batch, true); err != nil {
+		return errors.Wrapf(err, "error removing index of ledger %s", ledgerid)
+	}
+	if err := os.RemoveAll(p.conf.getLedgerBlockDir(ledgerid)); err != nil {
+		return errors.Wrapf(err, "error removing blocks of ledger %s", ledgerid)
+	}
+	return nil
+}
+
 // Close closes the FsBlockstoreProvider
 func (p *FsBlockstoreProvider) Close() {
 	p.leveldbProvider.Close()
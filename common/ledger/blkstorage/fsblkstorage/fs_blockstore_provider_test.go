@@ -137,6 +137,50 @@ func TestBlockStoreProvider(t *testing.T) {
 
 }
 
+func TestRemoveBlockStore(t *testing.T) {
+	env := newTestEnv(t, NewConf(testPath(), 0))
+	defer env.Cleanup()
+
+	provider := env.provider
+	blocks := testutil.ConstructTestBlocks(t, 3)
+
+	store1, _ := provider.OpenBlockStore("ledger1")
+	for _, b := range blocks {
+		assert.NoError(t, store1.AddBlock(b))
+	}
+	store2, _ := provider.OpenBlockStore("ledger2")
+	defer store2.Shutdown()
+	for _, b := range blocks {
+		assert.NoError(t, store2.AddBlock(b))
+	}
+
+	store1.Shutdown()
+	assert.NoError(t, provider.Remove("ledger1"))
+
+	exists, err := provider.Exists("ledger1")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+	storeNames, _ := provider.List()
+	assert.Equal(t, []string{"ledger2"}, storeNames)
+
+	// the other ledger is untouched
+	bcInfo, err := store2.GetBlockchainInfo()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), bcInfo.Height)
+	block, err := store2.RetrieveBlockByHash(protoutil.BlockHeaderHash(blocks[1].Header))
+	assert.NoError(t, err)
+	assert.Equal(t, blocks[1], block)
+
+	// a ledger re-created with the same id starts from scratch
+	store1, _ = provider.OpenBlockStore("ledger1")
+	defer store1.Shutdown()
+	bcInfo, err = store1.GetBlockchainInfo()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), bcInfo.Height)
+	_, err = store1.RetrieveBlockByHash(protoutil.BlockHeaderHash(blocks[1].Header))
+	assert.Equal(t, blkstorage.ErrNotFoundInIndex, err)
+}
+
 func constructLedgerid(id int) string {
 	return fmt.Sprintf("ledger_%d", id)
 }
@@ -0,0 +1,49 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fsblkstorage
+
+import (
+	"time"
+
+	"github.com/hyperledger/fabric/common/metrics"
+)
+
+type stats struct {
+	blockIndexTime metrics.Histogram
+}
+
+func newStats(metricsProvider metrics.Provider) *stats {
+	stats := &stats{}
+	stats.blockIndexTime = metricsProvider.NewHistogram(blockIndexTimeOpts)
+	return stats
+}
+
+type ledgerStats struct {
+	stats    *stats
+	ledgerid string
+}
+
+func (s *stats) ledgerStats(ledgerid string) *ledgerStats {
+	return &ledgerStats{
+		s, ledgerid,
+	}
+}
+
+func (s *ledgerStats) updateBlockIndexTime(timeTaken time.Duration) {
+	s.stats.blockIndexTime.With("channel", s.ledgerid).Observe(timeTaken.Seconds())
+}
+
+var (
+	blockIndexTimeOpts = metrics.HistogramOpts{
+		Namespace:    "ledger",
+		Subsystem:    "",
+		Name:         "block_index_time",
+		Help:         "Time taken in seconds for indexing the block in the block store.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+		Buckets:      []float64{0.005, 0.01, 0.015, 0.05, 0.1, 1, 10},
+	}
+)
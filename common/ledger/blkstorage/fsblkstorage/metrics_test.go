@@ -0,0 +1,42 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fsblkstorage
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/common/ledger/blkstorage"
+	"github.com/hyperledger/fabric/common/ledger/testutil"
+	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/hyperledger/fabric/common/metrics/metricsfakes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatsBlockIndex(t *testing.T) {
+	fakeBlockIndexTimeHist := &metricsfakes.Histogram{}
+	fakeBlockIndexTimeHist.WithReturns(fakeBlockIndexTimeHist)
+	fakeProvider := &metricsfakes.Provider{}
+	fakeProvider.NewHistogramStub = func(opts metrics.HistogramOpts) metrics.Histogram {
+		assert.Equal(t, blockIndexTimeOpts, opts)
+		return fakeBlockIndexTimeHist
+	}
+
+	indexConfig := &blkstorage.IndexConfig{AttrsToIndex: []blkstorage.IndexableAttr{blkstorage.IndexableAttrBlockNum}}
+	env := &testEnv{t, NewProvider(NewConf(testPath(), 0), indexConfig, fakeProvider).(*FsBlockstoreProvider)}
+	defer env.Cleanup()
+	store, err := env.provider.OpenBlockStore("testLedger")
+	assert.NoError(t, err)
+	defer store.Shutdown()
+
+	blocks := testutil.ConstructTestBlocks(t, 2)
+	for _, block := range blocks {
+		assert.NoError(t, store.AddBlock(block))
+	}
+
+	assert.Equal(t, 2, fakeBlockIndexTimeHist.WithCallCount())
+	assert.Equal(t, []string{"channel", "testLedger"}, fakeBlockIndexTimeHist.WithArgsForCall(1))
+	assert.Equal(t, 2, fakeBlockIndexTimeHist.ObserveCallCount())
+}
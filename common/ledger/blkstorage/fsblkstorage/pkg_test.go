@@ -24,6 +24,7 @@ import (
 
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/ledger/blkstorage"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
 	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protoutil"
 	"github.com/stretchr/testify/assert"
@@ -61,7 +62,7 @@ func newTestEnv(t testing.TB, conf *Conf) *testEnv {
 
 func newTestEnvSelectiveIndexing(t testing.TB, conf *Conf, attrsToIndex []blkstorage.IndexableAttr) *testEnv {
 	indexConfig := &blkstorage.IndexConfig{AttrsToIndex: attrsToIndex}
-	return &testEnv{t, NewProvider(conf, indexConfig).(*FsBlockstoreProvider)}
+	return &testEnv{t, NewProvider(conf, indexConfig, &disabled.Provider{}).(*FsBlockstoreProvider)}
 }
 
 func (env *testEnv) Cleanup() {
@@ -0,0 +1,159 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fsblkstorage
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/hyperledger/fabric/common/ledger/blkstorage"
+	"github.com/hyperledger/fabric/common/ledger/util"
+	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
+	"github.com/pkg/errors"
+)
+
+// ValidateRollbackParams checks that a ledger with the given id exists in the block
+// store under blockStorageDir, and that it has blocks after targetBlockNum
+func ValidateRollbackParams(blockStorageDir, ledgerID string, targetBlockNum uint64) error {
+	conf := NewConf(blockStorageDir, 0)
+	indexProvider := leveldbhelper.NewProvider(&leveldbhelper.Conf{DBPath: conf.getIndexDir()})
+	defer indexProvider.Close()
+
+	_, err := loadCheckpointInfoForRollback(conf, indexProvider.GetDBHandle(ledgerID), ledgerID, targetBlockNum)
+	return err
+}
+
+// Rollback removes the blocks after targetBlockNum from the block store of the ledger
+// with the given id. The block files are truncated after the target block and the
+// block index of the ledger is dropped, so that it is rebuilt from the block files
+// when the block store is next opened.
+// Rollback must not be invoked while the block store of the ledger is open.
+func Rollback(blockStorageDir, ledgerID string, targetBlockNum uint64) error {
+	conf := NewConf(blockStorageDir, 0)
+	indexProvider := leveldbhelper.NewProvider(&leveldbhelper.Conf{DBPath: conf.getIndexDir()})
+	defer indexProvider.Close()
+	indexStore := indexProvider.GetDBHandle(ledgerID)
+
+	cpInfo, err := loadCheckpointInfoForRollback(conf, indexStore, ledgerID, targetBlockNum)
+	if err != nil {
+		return err
+	}
+	ledgerDir := conf.getLedgerBlockDir(ledgerID)
+
+	// locate the end of the target block in the block files
+	index, err := newBlockIndex(&blkstorage.IndexConfig{
+		AttrsToIndex: []blkstorage.IndexableAttr{blkstorage.IndexableAttrBlockNum},
+	}, indexStore)
+	if err != nil {
+		return err
+	}
+	flp, err := index.getBlockLocByBlockNum(targetBlockNum)
+	if err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("error locating block [%d] in the block files", targetBlockNum))
+	}
+	endOffset, err := endOffsetOfBlock(ledgerDir, flp, targetBlockNum)
+	if err != nil {
+		return err
+	}
+
+	// Drop the block index and record the target block as the last block in the
+	// checkpoint info, in an atomic operation
+	batch := leveldbhelper.NewUpdateBatch()
+	itr := indexStore.GetIterator(nil, nil)
+	for itr.Next() {
+		if !bytes.Equal(itr.Key(), blkMgrInfoKey) {
+			batch.Delete(append([]byte{}, itr.Key()...))
+		}
+	}
+	itr.Release()
+	newCPInfo := &checkpointInfo{
+		latestFileChunkSuffixNum: flp.fileSuffixNum,
+		latestFileChunksize:      int(endOffset),
+		isChainEmpty:             false,
+		lastBlockNumber:          targetBlockNum,
+	}
+	cpInfoBytes, err := newCPInfo.marshal()
+	if err != nil {
+		return err
+	}
+	batch.Put(blkMgrInfoKey, cpInfoBytes)
+	if err := indexStore.WriteBatch(batch, true); err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("error dropping the block index of ledger [%s]", ledgerID))
+	}
+
+	// remove the blocks after the target block from the block files
+	for fileNum := cpInfo.latestFileChunkSuffixNum; fileNum > flp.fileSuffixNum; fileNum-- {
+		if err := os.Remove(deriveBlockfilePath(ledgerDir, fileNum)); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "error removing block file [%d]", fileNum)
+		}
+	}
+	if err := os.Truncate(deriveBlockfilePath(ledgerDir, flp.fileSuffixNum), endOffset); err != nil {
+		return errors.Wrapf(err, "error truncating block file [%d]", flp.fileSuffixNum)
+	}
+
+	logger.Infof("Rolled back the block store of ledger [%s] from block [%d] to block [%d]", ledgerID, cpInfo.lastBlockNumber, targetBlockNum)
+	return nil
+}
+
+func loadCheckpointInfoForRollback(conf *Conf, indexStore *leveldbhelper.DBHandle, ledgerID string, targetBlockNum uint64) (*checkpointInfo, error) {
+	exists, _, err := util.FileExists(conf.getLedgerBlockDir(ledgerID))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.Errorf("ledger [%s] does not exist", ledgerID)
+	}
+
+	mgr := &blockfileMgr{db: indexStore}
+	cpInfo, err := mgr.loadCurrentInfo()
+	if err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("error loading the block storage info of ledger [%s]", ledgerID))
+	}
+	if cpInfo == nil || cpInfo.isChainEmpty {
+		return nil, errors.Errorf("ledger [%s] has no blocks", ledgerID)
+	}
+	snapshotInfo, err := loadSnapshotInfo(indexStore)
+	if err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("error loading the snapshot info of ledger [%s]", ledgerID))
+	}
+	if targetBlockNum < firstBlockNum(snapshotInfo) {
+		return nil, errBlockBeforeSnapshot(targetBlockNum, snapshotInfo)
+	}
+	if targetBlockNum >= cpInfo.lastBlockNumber {
+		return nil, errors.Errorf("target block number [%d] should be less than the last block number [%d] of ledger [%s]",
+			targetBlockNum, cpInfo.lastBlockNumber, ledgerID)
+	}
+	return cpInfo, nil
+}
+
+// endOffsetOfBlock returns the offset at which the block with the given number,
+// which starts at flp, ends in its block file
+func endOffsetOfBlock(ledgerDir string, flp *fileLocPointer, blockNum uint64) (int64, error) {
+	stream, err := newBlockfileStream(ledgerDir, flp.fileSuffixNum, int64(flp.offset))
+	if err != nil {
+		return 0, err
+	}
+	defer stream.close()
+
+	blockBytes, err := stream.nextBlockBytes()
+	if err != nil {
+		return 0, errors.WithMessage(err, fmt.Sprintf("error reading block [%d]", blockNum))
+	}
+	if blockBytes == nil {
+		return 0, errors.Errorf("block [%d] is not found in block file [%d]", blockNum, flp.fileSuffixNum)
+	}
+	info, err := extractSerializedBlockInfo(blockBytes)
+	if err != nil {
+		return 0, err
+	}
+	if info.blockHeader.Number != blockNum {
+		return 0, errors.Errorf("expected block [%d] in block file [%d] at offset [%d] but found block [%d]",
+			blockNum, flp.fileSuffixNum, flp.offset, info.blockHeader.Number)
+	}
+	return stream.currentOffset, nil
+}
@@ -0,0 +1,97 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fsblkstorage
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/common/ledger/blkstorage"
+	"github.com/hyperledger/fabric/common/ledger/testutil"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollback(t *testing.T) {
+	path := testPath()
+	// a small max file size makes the blocks span several block files
+	conf := NewConf(path, 2000)
+	env := newTestEnv(t, conf)
+	defer env.Cleanup()
+
+	ledgerID := "testLedger"
+	blocks := testutil.ConstructTestBlocks(t, 30)
+	blkfileMgrWrapper := newTestBlockfileWrapper(env, ledgerID)
+	blkfileMgrWrapper.addBlocks(blocks)
+	lastFileNum := blkfileMgrWrapper.blockfileMgr.cpInfo.latestFileChunkSuffixNum
+	require.True(t, lastFileNum > 1, "expected the blocks to span several block files")
+	env.provider.Close()
+
+	assert.NoError(t, ValidateRollbackParams(path, ledgerID, 10))
+	assert.NoError(t, Rollback(path, ledgerID, 10))
+
+	env = newTestEnv(t, conf)
+	defer env.provider.Close()
+	blkfileMgrWrapper = newTestBlockfileWrapper(env, ledgerID)
+	bcInfo := blkfileMgrWrapper.blockfileMgr.getBlockchainInfo()
+	assert.Equal(t, uint64(11), bcInfo.Height)
+	assert.Equal(t, protoutil.BlockHeaderHash(blocks[10].Header), bcInfo.CurrentBlockHash)
+	assert.True(t, blkfileMgrWrapper.blockfileMgr.cpInfo.latestFileChunkSuffixNum < lastFileNum)
+
+	// the blocks up to the target block are available, and the index is rebuilt
+	blkfileMgrWrapper.testGetBlockByHash(blocks[:11])
+	blkfileMgrWrapper.testGetBlockByNumber(blocks[:11], 0)
+	_, err := blkfileMgrWrapper.blockfileMgr.retrieveBlockByNumber(11)
+	assert.Error(t, err)
+	_, err = blkfileMgrWrapper.blockfileMgr.retrieveBlockByHash(protoutil.BlockHeaderHash(blocks[11].Header))
+	assert.Error(t, err)
+
+	// the rolled back blocks can be committed again
+	blkfileMgrWrapper.addBlocks(blocks[11:])
+	blkfileMgrWrapper.testGetBlockByNumber(blocks, 0)
+}
+
+func TestRollbackWithSelectiveIndexing(t *testing.T) {
+	path := testPath()
+	conf := NewConf(path, 0)
+	env := newTestEnvSelectiveIndexing(t, conf, []blkstorage.IndexableAttr{blkstorage.IndexableAttrBlockHash})
+	defer env.Cleanup()
+
+	ledgerID := "testLedger"
+	blocks := testutil.ConstructTestBlocks(t, 10)
+	blkfileMgrWrapper := newTestBlockfileWrapper(env, ledgerID)
+	blkfileMgrWrapper.addBlocks(blocks)
+	env.provider.Close()
+
+	// the block number index is not maintained by the block store, but is
+	// required to locate the target block
+	err := Rollback(path, ledgerID, 5)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "error locating block [5] in the block files")
+}
+
+func TestValidateRollbackParams(t *testing.T) {
+	path := testPath()
+	env := newTestEnv(t, NewConf(path, 0))
+	defer env.Cleanup()
+
+	blkfileMgrWrapper := newTestBlockfileWrapper(env, "testLedger")
+	blkfileMgrWrapper.addBlocks(testutil.ConstructTestBlocks(t, 10))
+	newTestBlockfileWrapper(env, "emptyLedger")
+	env.provider.Close()
+
+	err := ValidateRollbackParams(path, "nonExistentLedger", 5)
+	assert.EqualError(t, err, "ledger [nonExistentLedger] does not exist")
+
+	err = ValidateRollbackParams(path, "emptyLedger", 5)
+	assert.EqualError(t, err, "ledger [emptyLedger] has no blocks")
+
+	err = ValidateRollbackParams(path, "testLedger", 9)
+	assert.EqualError(t, err, "target block number [9] should be less than the last block number [9] of ledger [testLedger]")
+
+	assert.NoError(t, ValidateRollbackParams(path, "testLedger", 8))
+}
@@ -0,0 +1,251 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fsblkstorage
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/ledger/blkstorage"
+	"github.com/hyperledger/fabric/common/ledger/util"
+	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
+	"github.com/hyperledger/fabric/core/ledger"
+	ledgerUtil "github.com/hyperledger/fabric/core/ledger/util"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+)
+
+var snapshotInfoKey = []byte("bootstrappingSnapshotInfo")
+
+// maxSnapshotTxIDsBatchSize is the number of transaction ids of a
+// snapshot written to the index of the block store in a single batch
+const maxSnapshotTxIDsBatchSize = 10000
+
+// BootstrapBlockStore creates the block store of the given ledger, which starts
+// after the last block of the snapshot described by info. The ids of the transactions
+// of the snapshot are added to the index of the ledger first, in batches, followed by the
+// snapshot info, and the checkpoint info, which marks the chain as empty until the first
+// block after the snapshot is added. An existing block store can be bootstrapped only if
+// it holds no blocks, e.g., if it was left behind by a failed ledger creation.
+func (p *FsBlockstoreProvider) BootstrapBlockStore(ledgerid string, info *blkstorage.SnapshotInfo, txIDs blkstorage.SnapshotTxIDIterator) error {
+	db := p.leveldbProvider.GetDBHandle(ledgerid)
+	empty, err := p.isEmpty(ledgerid, db)
+	if err != nil {
+		return err
+	}
+	if !empty {
+		return errors.Errorf("block store of ledger [%s] already exists", ledgerid)
+	}
+	if _, err := util.CreateDirIfMissing(p.conf.getLedgerBlockDir(ledgerid)); err != nil {
+		return errors.Wrapf(err, "error creating block storage dir of ledger [%s]", ledgerid)
+	}
+
+	batch := leveldbhelper.NewUpdateBatch()
+	numTxIDs := 0
+	for {
+		txID, err := txIDs.Next()
+		if err != nil {
+			return err
+		}
+		if txID == nil {
+			break
+		}
+		if txID.BlockNum > info.LastBlockNum {
+			return errors.Errorf("transaction [%s] of the snapshot is in block [%d], after the last block [%d] of the snapshot",
+				txID.TxID, txID.BlockNum, info.LastBlockNum)
+		}
+		batch.Put(constructSnapshotTxIDKey(txID.TxID), marshalSnapshotTxID(txID))
+		numTxIDs++
+		if len(batch.KVs) >= maxSnapshotTxIDsBatchSize {
+			if err := db.WriteBatch(batch, true); err != nil {
+				return errors.Wrapf(err, "error saving the transaction ids of the snapshot of ledger [%s]", ledgerid)
+			}
+			batch = leveldbhelper.NewUpdateBatch()
+		}
+	}
+
+	infoBytes, err := marshalSnapshotInfo(info)
+	if err != nil {
+		return err
+	}
+	cpInfoBytes, err := (&checkpointInfo{isChainEmpty: true, lastBlockNumber: info.LastBlockNum}).marshal()
+	if err != nil {
+		return err
+	}
+	batch.Put(snapshotInfoKey, infoBytes)
+	batch.Put(blkMgrInfoKey, cpInfoBytes)
+	if err := db.WriteBatch(batch, true); err != nil {
+		return errors.Wrapf(err, "error saving the snapshot info of ledger [%s]", ledgerid)
+	}
+	logger.Infof("Bootstrapped the block store of ledger [%s] from a snapshot at block [%d] with [%d] transaction ids", ledgerid, info.LastBlockNum, numTxIDs)
+	return nil
+}
+
+// isEmpty returns true if the block store of the given ledger does not exist,
+// or if it exists but was neither bootstrapped nor had any block added
+func (p *FsBlockstoreProvider) isEmpty(ledgerid string, db *leveldbhelper.DBHandle) (bool, error) {
+	exists, err := p.Exists(ledgerid)
+	if err != nil || !exists {
+		return !exists, err
+	}
+	snapshotInfo, err := loadSnapshotInfo(db)
+	if err != nil || snapshotInfo != nil {
+		return false, err
+	}
+	b, err := db.Get(blkMgrInfoKey)
+	if err != nil {
+		return false, err
+	}
+	cpInfo := &checkpointInfo{}
+	if b != nil {
+		err = cpInfo.unmarshal(b)
+	} else {
+		cpInfo, err = constructCheckpointInfoFromBlockFiles(p.conf.getLedgerBlockDir(ledgerid))
+	}
+	if err != nil {
+		return false, err
+	}
+	return cpInfo.isChainEmpty, nil
+}
+
+// loadSnapshotInfo returns the info of the snapshot the block store was
+// bootstrapped from, or nil if the block store starts at the genesis block
+func loadSnapshotInfo(db *leveldbhelper.DBHandle) (*blkstorage.SnapshotInfo, error) {
+	b, err := db.Get(snapshotInfoKey)
+	if b == nil || err != nil {
+		return nil, err
+	}
+	return unmarshalSnapshotInfo(b)
+}
+
+func marshalSnapshotInfo(info *blkstorage.SnapshotInfo) ([]byte, error) {
+	buffer := proto.NewBuffer([]byte{})
+	if err := buffer.EncodeVarint(info.LastBlockNum); err != nil {
+		return nil, err
+	}
+	if err := buffer.EncodeRawBytes(info.LastBlockHash); err != nil {
+		return nil, err
+	}
+	if err := buffer.EncodeRawBytes(info.PreviousBlockHash); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func unmarshalSnapshotInfo(b []byte) (*blkstorage.SnapshotInfo, error) {
+	buffer := proto.NewBuffer(b)
+	info := &blkstorage.SnapshotInfo{}
+	var err error
+	if info.LastBlockNum, err = buffer.DecodeVarint(); err != nil {
+		return nil, err
+	}
+	if info.LastBlockHash, err = buffer.DecodeRawBytes(true); err != nil {
+		return nil, err
+	}
+	if info.PreviousBlockHash, err = buffer.DecodeRawBytes(true); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+func marshalSnapshotTxID(txID *blkstorage.SnapshotTxID) []byte {
+	b := proto.EncodeVarint(txID.BlockNum)
+	return append(b, proto.EncodeVarint(uint64(txID.ValidationCode))...)
+}
+
+func unmarshalSnapshotTxID(txID string, b []byte) (*blkstorage.SnapshotTxID, error) {
+	buffer := proto.NewBuffer(b)
+	blockNum, err := buffer.DecodeVarint()
+	if err != nil {
+		return nil, errors.Wrapf(err, "error unmarshaling the transaction id [%s] of the snapshot", txID)
+	}
+	validationCode, err := buffer.DecodeVarint()
+	if err != nil {
+		return nil, errors.Wrapf(err, "error unmarshaling the transaction id [%s] of the snapshot", txID)
+	}
+	return &blkstorage.SnapshotTxID{TxID: txID, BlockNum: blockNum, ValidationCode: peer.TxValidationCode(validationCode)}, nil
+}
+
+// exportTxIDs passes the ids of the transactions imported from the snapshot the block store
+// was bootstrapped from, followed by the ids of the transactions in the blocks, to the given
+// function. Only the first transaction with a given id is indexed, and so exported
+func (mgr *blockfileMgr) exportTxIDs(export func(*blkstorage.SnapshotTxID) error) error {
+	itr := mgr.db.GetIterator([]byte{snapshotTxIDIdxKeyPrefix}, []byte{snapshotTxIDIdxKeyPrefix + 1})
+	defer itr.Release()
+	for itr.Next() {
+		txID, err := unmarshalSnapshotTxID(string(itr.Key()[1:]), itr.Value())
+		if err != nil {
+			return err
+		}
+		if err := export(txID); err != nil {
+			return err
+		}
+	}
+	if err := itr.Error(); err != nil {
+		return errors.Wrap(err, "error iterating over the transaction ids of the snapshot")
+	}
+
+	height := mgr.getBlockchainInfo().Height
+	for blockNum := firstBlockNum(mgr.snapshotInfo); blockNum < height; blockNum++ {
+		block, err := mgr.retrieveBlockByNumber(blockNum)
+		if err != nil {
+			return err
+		}
+		txsfltr := ledgerUtil.TxValidationFlags(block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER])
+		for txNum, envBytes := range block.Data.Data {
+			txID, err := extractTxID(envBytes)
+			if err != nil {
+				return errors.WithMessage(err, fmt.Sprintf("error extracting the id of transaction [%d] of block [%d]", txNum, blockNum))
+			}
+			if txID == "" {
+				continue
+			}
+			isIndexed, err := mgr.isIndexedTx(txID, blockNum, uint64(txNum))
+			if err != nil {
+				return err
+			}
+			if !isIndexed {
+				continue
+			}
+			if err := export(&blkstorage.SnapshotTxID{TxID: txID, BlockNum: blockNum, ValidationCode: txsfltr.Flag(txNum)}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// isIndexedTx returns true if the given transaction is the one indexed by its id, i.e.,
+// if no transaction with the same id precedes it
+func (mgr *blockfileMgr) isIndexedTx(txID string, blockNum, txNum uint64) (bool, error) {
+	loc, err := mgr.index.getTxLoc(txID)
+	if _, ok := err.(*ledger.ErrTxBeforeSnapshot); ok {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	txLoc, err := mgr.index.getTXLocByBlockNumTranNum(blockNum, txNum)
+	if err != nil {
+		return false, err
+	}
+	return *loc == *txLoc, nil
+}
+
+// firstBlockNum returns the number of the first block the block store holds
+func firstBlockNum(snapshotInfo *blkstorage.SnapshotInfo) uint64 {
+	if snapshotInfo == nil {
+		return 0
+	}
+	return snapshotInfo.LastBlockNum + 1
+}
+
+func errBlockBeforeSnapshot(blockNum uint64, snapshotInfo *blkstorage.SnapshotInfo) error {
+	return errors.Errorf("block [%d] is not available, the ledger was bootstrapped from a snapshot at block [%d]",
+		blockNum, snapshotInfo.LastBlockNum)
+}
@@ -0,0 +1,219 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fsblkstorage
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/common/ledger/blkstorage"
+	"github.com/hyperledger/fabric/common/ledger/testutil"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBootstrapBlockStore(t *testing.T) {
+	path := testPath()
+	conf := NewConf(path, 0)
+	env := newTestEnv(t, conf)
+	defer env.Cleanup()
+
+	ledgerID := "testLedger"
+	blocks := testutil.ConstructTestBlocks(t, 15)
+	snapshotInfo := &blkstorage.SnapshotInfo{
+		LastBlockNum:      9,
+		LastBlockHash:     protoutil.BlockHeaderHash(blocks[9].Header),
+		PreviousBlockHash: blocks[9].Header.PreviousHash,
+	}
+	// an empty block store, e.g., left behind by a failed ledger creation, can be bootstrapped
+	store, err := env.provider.OpenBlockStore(ledgerID)
+	require.NoError(t, err)
+	store.Shutdown()
+	require.NoError(t, env.provider.BootstrapBlockStore(ledgerID, snapshotInfo, &snapshotTxIDs{}))
+	err = env.provider.BootstrapBlockStore(ledgerID, snapshotInfo, &snapshotTxIDs{})
+	assert.EqualError(t, err, "block store of ledger [testLedger] already exists")
+
+	store, err = env.provider.OpenBlockStore(ledgerID)
+	require.NoError(t, err)
+	bcInfo, err := store.GetBlockchainInfo()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), bcInfo.Height)
+	assert.Equal(t, snapshotInfo.LastBlockHash, bcInfo.CurrentBlockHash)
+	assert.Equal(t, snapshotInfo.PreviousBlockHash, bcInfo.PreviousBlockHash)
+
+	err = store.AddBlock(blocks[11])
+	assert.EqualError(t, err, "block number should have been 10 but was 11")
+	for _, block := range blocks[10:] {
+		require.NoError(t, store.AddBlock(block))
+	}
+
+	_, err = store.RetrieveBlocks(5)
+	assert.EqualError(t, err, "block [5] is not available, the ledger was bootstrapped from a snapshot at block [9]")
+	_, err = store.RetrieveBlockByNumber(5)
+	assert.Equal(t, blkstorage.ErrNotFoundInIndex, err)
+
+	itr, err := store.RetrieveBlocks(10)
+	require.NoError(t, err)
+	for _, expected := range blocks[10:] {
+		block, err := itr.Next()
+		require.NoError(t, err)
+		assert.Equal(t, expected, block)
+	}
+	itr.Close()
+	store.Shutdown()
+	env.provider.Close()
+
+	// the snapshot info is kept across restarts
+	env = newTestEnv(t, conf)
+	store, err = env.provider.OpenBlockStore(ledgerID)
+	require.NoError(t, err)
+	bcInfo, err = store.GetBlockchainInfo()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(15), bcInfo.Height)
+	assert.Equal(t, protoutil.BlockHeaderHash(blocks[14].Header), bcInfo.CurrentBlockHash)
+	block, err := store.RetrieveBlockByNumber(12)
+	require.NoError(t, err)
+	assert.Equal(t, blocks[12], block)
+	store.Shutdown()
+	env.provider.Close()
+
+	err = ValidateRollbackParams(path, ledgerID, 8)
+	assert.EqualError(t, err, "block [8] is not available, the ledger was bootstrapped from a snapshot at block [9]")
+}
+
+func TestBootstrapBlockStoreSyncFromFS(t *testing.T) {
+	path := testPath()
+	conf := NewConf(path, 0)
+	env := newTestEnv(t, conf)
+	defer env.Cleanup()
+
+	ledgerID := "testLedger"
+	blocks := testutil.ConstructTestBlocks(t, 13)
+	snapshotInfo := &blkstorage.SnapshotInfo{
+		LastBlockNum:      9,
+		LastBlockHash:     protoutil.BlockHeaderHash(blocks[9].Header),
+		PreviousBlockHash: blocks[9].Header.PreviousHash,
+	}
+	require.NoError(t, env.provider.BootstrapBlockStore(ledgerID, snapshotInfo, &snapshotTxIDs{}))
+	blkfileMgrWrapper := newTestBlockfileWrapper(env, ledgerID)
+	blkfileMgrWrapper.addBlocks(blocks[10:])
+	err := env.provider.BootstrapBlockStore(ledgerID, snapshotInfo, &snapshotTxIDs{})
+	assert.EqualError(t, err, "block store of ledger [testLedger] already exists")
+
+	// simulate a crash before the checkpoint info of the blocks after the snapshot is saved
+	cpInfo := &checkpointInfo{isChainEmpty: true, lastBlockNumber: 9}
+	require.NoError(t, blkfileMgrWrapper.blockfileMgr.saveCurrentInfo(cpInfo, true))
+	blkfileMgrWrapper.close()
+	env.provider.Close()
+
+	env = newTestEnv(t, conf)
+	blkfileMgrWrapper = newTestBlockfileWrapper(env, ledgerID)
+	defer blkfileMgrWrapper.close()
+	assert.False(t, blkfileMgrWrapper.blockfileMgr.cpInfo.isChainEmpty)
+	assert.Equal(t, uint64(12), blkfileMgrWrapper.blockfileMgr.cpInfo.lastBlockNumber)
+	assert.Equal(t, uint64(13), blkfileMgrWrapper.blockfileMgr.getBlockchainInfo().Height)
+}
+
+func TestBootstrapBlockStoreWithBlocks(t *testing.T) {
+	env := newTestEnv(t, NewConf(testPath(), 0))
+	defer env.Cleanup()
+
+	blocks := testutil.ConstructTestBlocks(t, 10)
+	blkfileMgrWrapper := newTestBlockfileWrapper(env, "testLedger")
+	blkfileMgrWrapper.addBlocks(blocks[:1])
+	blkfileMgrWrapper.close()
+
+	err := env.provider.BootstrapBlockStore("testLedger", &blkstorage.SnapshotInfo{
+		LastBlockNum:  9,
+		LastBlockHash: protoutil.BlockHeaderHash(blocks[9].Header),
+	}, &snapshotTxIDs{})
+	assert.EqualError(t, err, "block store of ledger [testLedger] already exists")
+}
+
+func TestBootstrapBlockStoreTxIDs(t *testing.T) {
+	env := newTestEnv(t, NewConf(testPath(), 0))
+	defer env.Cleanup()
+
+	blocks := testutil.ConstructTestBlocks(t, 10)
+	blocks = append(blocks, testutil.ConstructBlockWithTxid(t, 10, protoutil.BlockHeaderHash(blocks[9].Header),
+		[][]byte{[]byte("tx10")}, []string{"txid10", "txid10"}, false))
+	// the transaction of block 11 replays a transaction of the snapshot
+	replayedTxID, err := extractTxID(blocks[3].Data.Data[0])
+	require.NoError(t, err)
+	blocks = append(blocks, testutil.ConstructBlockWithTxid(t, 11, protoutil.BlockHeaderHash(blocks[10].Header),
+		[][]byte{[]byte("tx11")}, []string{replayedTxID}, false))
+
+	store, err := env.provider.OpenBlockStore("sourceLedger")
+	require.NoError(t, err)
+	for _, block := range blocks[:10] {
+		require.NoError(t, store.AddBlock(block))
+	}
+	txIDs := &snapshotTxIDs{}
+	require.NoError(t, store.ExportTxIDs(func(txID *blkstorage.SnapshotTxID) error {
+		txIDs.txIDs = append(txIDs.txIDs, txID)
+		return nil
+	}))
+	store.Shutdown()
+	// the genesis block holds a single transaction, and the other blocks ten transactions each
+	require.Len(t, txIDs.txIDs, 91)
+	assert.Equal(t, &blkstorage.SnapshotTxID{TxID: replayedTxID, BlockNum: 3, ValidationCode: peer.TxValidationCode_VALID}, txIDs.txIDs[21])
+
+	err = env.provider.BootstrapBlockStore("testLedger", &blkstorage.SnapshotInfo{
+		LastBlockNum:  2,
+		LastBlockHash: protoutil.BlockHeaderHash(blocks[2].Header),
+	}, &snapshotTxIDs{txIDs: txIDs.txIDs})
+	assert.EqualError(t, err, "transaction ["+replayedTxID+"] of the snapshot is in block [3], after the last block [2] of the snapshot")
+
+	txIDs.next = 0
+	require.NoError(t, env.provider.BootstrapBlockStore("testLedger", &blkstorage.SnapshotInfo{
+		LastBlockNum:      9,
+		LastBlockHash:     protoutil.BlockHeaderHash(blocks[9].Header),
+		PreviousBlockHash: blocks[9].Header.PreviousHash,
+	}, txIDs))
+	store, err = env.provider.OpenBlockStore("testLedger")
+	require.NoError(t, err)
+	defer store.Shutdown()
+	for _, block := range blocks[10:] {
+		require.NoError(t, store.AddBlock(block))
+	}
+
+	// only the ids and the validation codes of the transactions of the snapshot are available
+	_, err = store.RetrieveTxByID(replayedTxID)
+	assert.Equal(t, &ledger.ErrTxBeforeSnapshot{TxID: replayedTxID, BlockNum: 3}, err)
+	_, err = store.RetrieveBlockByTxID(replayedTxID)
+	assert.Equal(t, &ledger.ErrTxBeforeSnapshot{TxID: replayedTxID, BlockNum: 3}, err)
+	validationCode, err := store.RetrieveTxValidationCodeByTxID(replayedTxID)
+	require.NoError(t, err)
+	assert.Equal(t, peer.TxValidationCode_VALID, validationCode)
+	_, err = store.RetrieveTxByID("nonExistentTxID")
+	assert.Equal(t, blkstorage.ErrNotFoundInIndex, err)
+
+	// the replayed transaction and the second transaction with the same id in a block are not indexed
+	exported := []*blkstorage.SnapshotTxID{}
+	require.NoError(t, store.ExportTxIDs(func(txID *blkstorage.SnapshotTxID) error {
+		exported = append(exported, txID)
+		return nil
+	}))
+	require.Len(t, exported, 92)
+	assert.ElementsMatch(t, txIDs.txIDs, exported[:91])
+	assert.Equal(t, &blkstorage.SnapshotTxID{TxID: "txid10", BlockNum: 10, ValidationCode: peer.TxValidationCode_VALID}, exported[91])
+}
+
+type snapshotTxIDs struct {
+	txIDs []*blkstorage.SnapshotTxID
+	next  int
+}
+
+func (s *snapshotTxIDs) Next() (*blkstorage.SnapshotTxID, error) {
+	if s.next == len(s.txIDs) {
+		return nil, nil
+	}
+	s.next++
+	return s.txIDs[s.next-1], nil
+}
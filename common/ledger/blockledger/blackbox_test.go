@@ -11,6 +11,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/hyperledger/fabric/common/ledger/blockledger"
 	cb "github.com/hyperledger/fabric/protos/common"
 	ab "github.com/hyperledger/fabric/protos/orderer"
@@ -175,6 +176,55 @@ func testBlockedRetrieval(lf ledgerTestFactory, t *testing.T) {
 	}
 }
 
+func TestTimestampRetrieval(t *testing.T) {
+	allTest(t, testTimestampRetrieval)
+}
+
+func testTimestampRetrieval(lf ledgerTestFactory, t *testing.T) {
+	_, li := lf.New()
+	envelopeAt := func(seconds int64) *cb.Envelope {
+		return &cb.Envelope{Payload: protoutil.MarshalOrPanic(&cb.Payload{
+			Header: &cb.Header{ChannelHeader: protoutil.MarshalOrPanic(&cb.ChannelHeader{
+				Timestamp: &timestamp.Timestamp{Seconds: seconds},
+			})},
+		})}
+	}
+	seekTimestamp := func(seconds int64) *ab.SeekPosition {
+		return &ab.SeekPosition{Type: &ab.SeekPosition_Timestamp{
+			Timestamp: &ab.SeekTimestamp{Timestamp: &timestamp.Timestamp{Seconds: seconds}},
+		}}
+	}
+	li.Append(blockledger.CreateNextBlock(li, []*cb.Envelope{envelopeAt(100)}))
+	li.Append(blockledger.CreateNextBlock(li, []*cb.Envelope{envelopeAt(200)}))
+
+	it, num := li.Iterator(seekTimestamp(150))
+	defer it.Close()
+	if num != 2 {
+		t.Fatalf("Expected block iterator at 2, but got %d", num)
+	}
+	block, status := it.Next()
+	if status != cb.Status_SUCCESS {
+		t.Fatalf("Expected to successfully read the third block")
+	}
+	if block.Header.Number != 2 {
+		t.Fatalf("Expected to successfully retrieve the third block but got block number %d", block.Header.Number)
+	}
+
+	it2, num := li.Iterator(seekTimestamp(300))
+	defer it2.Close()
+	if num != 3 {
+		t.Fatalf("Expected block iterator at 3, but got %d", num)
+	}
+	li.Append(blockledger.CreateNextBlock(li, []*cb.Envelope{envelopeAt(400)}))
+	block, status = it2.Next()
+	if status != cb.Status_SUCCESS {
+		t.Fatalf("Expected to successfully read the fourth block")
+	}
+	if block.Header.Number != 3 {
+		t.Fatalf("Expected to successfully retrieve the fourth block but got block number %d", block.Header.Number)
+	}
+}
+
 func TestMultichain(t *testing.T) {
 	allTest(t, testMultichain)
 }
@@ -230,3 +280,47 @@ func testMultichain(lf ledgerTestFactory, t *testing.T) {
 		t.Fatalf("Did not properly store block 1 on chain 1")
 	}
 }
+
+func TestRemove(t *testing.T) {
+	allTest(t, testRemove)
+}
+
+func testRemove(lf ledgerTestFactory, t *testing.T) {
+	f, _ := lf.New()
+	chain1 := "chain1"
+	chain2 := "chain2"
+
+	c1, err := f.GetOrCreate(chain1)
+	if err != nil {
+		t.Fatalf("Error creating chain1: %s", err)
+	}
+	c1.Append(blockledger.CreateNextBlock(c1, []*cb.Envelope{{Payload: []byte("c1 payload1")}}))
+
+	c2, err := f.GetOrCreate(chain2)
+	if err != nil {
+		t.Fatalf("Error creating chain2: %s", err)
+	}
+	c2.Append(blockledger.CreateNextBlock(c2, []*cb.Envelope{{Payload: []byte("c2 payload1")}}))
+
+	if err := f.Remove(chain1); err != nil {
+		t.Fatalf("Error removing chain1: %s", err)
+	}
+
+	for _, id := range f.ChainIDs() {
+		if id == chain1 {
+			t.Fatalf("Removed chain1 should not be listed")
+		}
+	}
+
+	if c2.Height() != 1 {
+		t.Fatalf("Block height for c2 should still be 1")
+	}
+
+	c1, err = f.GetOrCreate(chain1)
+	if err != nil {
+		t.Fatalf("Error re-creating chain1: %s", err)
+	}
+	if c1.Height() != 0 {
+		t.Fatalf("Re-created chain1 should be empty")
+	}
+}
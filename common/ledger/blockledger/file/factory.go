@@ -22,11 +22,13 @@ import (
 	"github.com/hyperledger/fabric/common/ledger/blkstorage"
 	"github.com/hyperledger/fabric/common/ledger/blkstorage/fsblkstorage"
 	"github.com/hyperledger/fabric/common/ledger/blockledger"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
 )
 
 type fileLedgerFactory struct {
 	blkstorageProvider blkstorage.BlockStoreProvider
 	ledgers            map[string]blockledger.ReadWriter
+	blockStores        map[string]blkstorage.BlockStore
 	mutex              sync.Mutex
 }
 
@@ -48,6 +50,7 @@ func (flf *fileLedgerFactory) GetOrCreate(chainID string) (blockledger.ReadWrite
 	}
 	ledger = NewFileLedger(blockStore)
 	flf.ledgers[key] = ledger
+	flf.blockStores[key] = blockStore
 	return ledger, nil
 }
 
@@ -60,6 +63,19 @@ func (flf *fileLedgerFactory) ChainIDs() []string {
 	return chainIDs
 }
 
+// Remove shuts down the block store of the given chain and removes it from disk
+func (flf *fileLedgerFactory) Remove(chainID string) error {
+	flf.mutex.Lock()
+	defer flf.mutex.Unlock()
+
+	if blockStore, ok := flf.blockStores[chainID]; ok {
+		blockStore.Shutdown()
+		delete(flf.blockStores, chainID)
+		delete(flf.ledgers, chainID)
+	}
+	return flf.blkstorageProvider.Remove(chainID)
+}
+
 // Close releases all resources acquired by the factory
 func (flf *fileLedgerFactory) Close() {
 	flf.blkstorageProvider.Close()
@@ -71,8 +87,13 @@ func New(directory string) blockledger.Factory {
 		blkstorageProvider: fsblkstorage.NewProvider(
 			fsblkstorage.NewConf(directory, -1),
 			&blkstorage.IndexConfig{
-				AttrsToIndex: []blkstorage.IndexableAttr{blkstorage.IndexableAttrBlockNum}},
+				AttrsToIndex: []blkstorage.IndexableAttr{
+					blkstorage.IndexableAttrBlockNum,
+					blkstorage.IndexableAttrBlockTime,
+				}},
+			&disabled.Provider{},
 		),
-		ledgers: make(map[string]blockledger.ReadWriter),
+		ledgers:     make(map[string]blockledger.ReadWriter),
+		blockStores: make(map[string]blkstorage.BlockStore),
 	}
 }
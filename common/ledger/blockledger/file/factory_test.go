@@ -50,6 +50,14 @@ func (mbsp *mockBlockStoreProvider) List() ([]string, error) {
 	return mbsp.list, mbsp.error
 }
 
+func (mbsp *mockBlockStoreProvider) Remove(ledgerid string) error {
+	return mbsp.error
+}
+
+func (mbsp *mockBlockStoreProvider) BootstrapBlockStore(ledgerid string, info *blkstorage.SnapshotInfo, txIDs blkstorage.SnapshotTxIDIterator) error {
+	return mbsp.error
+}
+
 func (mbsp *mockBlockStoreProvider) Close() {
 }
 
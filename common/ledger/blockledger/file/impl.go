@@ -7,8 +7,12 @@ SPDX-License-Identifier: Apache-2.0
 package fileledger
 
 import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/ledger"
+	"github.com/hyperledger/fabric/common/ledger/blkstorage"
 	"github.com/hyperledger/fabric/common/ledger/blockledger"
 	cb "github.com/hyperledger/fabric/protos/common"
 	ab "github.com/hyperledger/fabric/protos/orderer"
@@ -28,6 +32,7 @@ type FileLedgerBlockStore interface {
 	AddBlock(block *cb.Block) error
 	GetBlockchainInfo() (*cb.BlockchainInfo, error)
 	RetrieveBlocks(startBlockNumber uint64) (ledger.ResultsIterator, error)
+	RetrieveBlockNumberByTime(t time.Time) (uint64, error)
 }
 
 // NewFileLedger creates a new FileLedger for interaction with the ledger
@@ -81,6 +86,20 @@ func (fl *FileLedger) Iterator(startPosition *ab.SeekPosition) (blockledger.Iter
 		if startingBlockNumber > height {
 			return &blockledger.NotFoundErrorIterator{}, 0
 		}
+	case *ab.SeekPosition_Timestamp:
+		startTime, err := ptypes.Timestamp(start.Timestamp.GetTimestamp())
+		if err != nil {
+			return &blockledger.NotFoundErrorIterator{}, 0
+		}
+		startingBlockNumber, err = fl.blockStore.RetrieveBlockNumberByTime(startTime)
+		if err == blkstorage.ErrNotFoundInIndex {
+			// No block was created since the given time yet, so the
+			// iterator starts with the next block to be appended
+			startingBlockNumber = fl.Height()
+		} else if err != nil {
+			logger.Warningf("Failed retrieving the block created since %s: %s", startTime, err)
+			return &blockledger.NotFoundErrorIterator{}, 0
+		}
 	default:
 		return &blockledger.NotFoundErrorIterator{}, 0
 	}
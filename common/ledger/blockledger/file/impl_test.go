@@ -12,9 +12,12 @@ import (
 	"io/ioutil"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/hyperledger/fabric/common/flogging"
 	cl "github.com/hyperledger/fabric/common/ledger"
+	"github.com/hyperledger/fabric/common/ledger/blkstorage"
 	"github.com/hyperledger/fabric/common/ledger/blockledger"
 	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
 	cb "github.com/hyperledger/fabric/protos/common"
@@ -70,6 +73,8 @@ type mockBlockStore struct {
 	defaultError               error
 	getBlockchainInfoError     error
 	retrieveBlockByNumberError error
+	blockNumberByTime          uint64
+	blockNumberByTimeError     error
 }
 
 func (mbs *mockBlockStore) AddBlock(block *cb.Block) error {
@@ -108,6 +113,14 @@ func (mbs *mockBlockStore) RetrieveTxValidationCodeByTxID(txID string) (peer.TxV
 	return mbs.txValidationCode, mbs.defaultError
 }
 
+func (mbs *mockBlockStore) RetrieveBlockNumberByTime(t time.Time) (uint64, error) {
+	return mbs.blockNumberByTime, mbs.blockNumberByTimeError
+}
+
+func (mbs *mockBlockStore) ExportTxIDs(export func(txID *blkstorage.SnapshotTxID) error) error {
+	return mbs.defaultError
+}
+
 func (*mockBlockStore) Shutdown() {
 }
 
@@ -234,6 +247,48 @@ func TestBlockedRetrieval(t *testing.T) {
 	assert.Equal(t, uint64(2), block.Header.Number, "Expected to successfully retrieve the third block")
 }
 
+func TestTimestampRetrieval(t *testing.T) {
+	tev, fl := initialize(t)
+	defer tev.tearDown()
+
+	seekTimestamp := func(seconds int64) *ab.SeekPosition {
+		return &ab.SeekPosition{Type: &ab.SeekPosition_Timestamp{
+			Timestamp: &ab.SeekTimestamp{Timestamp: &timestamp.Timestamp{Seconds: seconds}},
+		}}
+	}
+	envelopeAt := func(seconds int64) *cb.Envelope {
+		return &cb.Envelope{Payload: protoutil.MarshalOrPanic(&cb.Payload{
+			Header: &cb.Header{ChannelHeader: protoutil.MarshalOrPanic(&cb.ChannelHeader{
+				Timestamp: &timestamp.Timestamp{Seconds: seconds},
+			})},
+		})}
+	}
+	fl.Append(blockledger.CreateNextBlock(fl, []*cb.Envelope{envelopeAt(100)}))
+	fl.Append(blockledger.CreateNextBlock(fl, []*cb.Envelope{envelopeAt(200)}))
+
+	for seconds, expected := range map[int64]uint64{50: 1, 100: 1, 150: 2, 200: 2} {
+		it, num := fl.Iterator(seekTimestamp(seconds))
+		assert.Equal(t, expected, num, "Expected block iterator at %d for time %d, but got %d", expected, seconds, num)
+		block, status := it.Next()
+		assert.Equal(t, cb.Status_SUCCESS, status)
+		assert.Equal(t, expected, block.Header.Number)
+		it.Close()
+	}
+
+	// The iterator of a time after the last block starts with the next block
+	it, num := fl.Iterator(seekTimestamp(300))
+	defer it.Close()
+	assert.Equal(t, uint64(3), num, "Expected block iterator at 3, but got %d", num)
+	fl.Append(blockledger.CreateNextBlock(fl, []*cb.Envelope{envelopeAt(400)}))
+	block, status := it.Next()
+	assert.Equal(t, cb.Status_SUCCESS, status)
+	assert.Equal(t, uint64(3), block.Header.Number)
+
+	// A seek position without a timestamp is not found
+	it, _ = fl.Iterator(&ab.SeekPosition{Type: &ab.SeekPosition_Timestamp{Timestamp: &ab.SeekTimestamp{}}})
+	assert.IsType(t, &blockledger.NotFoundErrorIterator{}, it)
+}
+
 func TestBlockstoreError(t *testing.T) {
 	// Since this test only ensures failed GetBlockchainInfo
 	// is properly handled. We don't bother creating fully
@@ -295,4 +350,23 @@ func TestBlockstoreError(t *testing.T) {
 		_, status := it.Next()
 		assert.Equal(t, cb.Status_SERVICE_UNAVAILABLE, status, "Expected service unavailable error")
 	}
+
+	for _, err := range []error{blkstorage.ErrAttrNotIndexed, blkstorage.ErrBlockTimeNotIndexed} {
+		fl := &FileLedger{
+			blockStore: &mockBlockStore{
+				blockchainInfo:         &cb.BlockchainInfo{Height: uint64(1)},
+				blockNumberByTimeError: err,
+			},
+			signal: make(chan struct{}),
+		}
+		it, _ := fl.Iterator(&ab.SeekPosition{Type: &ab.SeekPosition_Timestamp{
+			Timestamp: &ab.SeekTimestamp{Timestamp: &timestamp.Timestamp{Seconds: 100}},
+		}})
+		defer it.Close()
+		assert.IsType(
+			t,
+			&blockledger.NotFoundErrorIterator{},
+			it,
+			"Expected Not Found Error if the blocks are not indexed by time")
+	}
 }
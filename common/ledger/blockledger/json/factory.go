@@ -114,6 +114,19 @@ func (jlf *jsonLedgerFactory) ChainIDs() []string {
 	return ids
 }
 
+// Remove deletes the directory holding the blocks of the given chain
+func (jlf *jsonLedgerFactory) Remove(chainID string) error {
+	jlf.mutex.Lock()
+	defer jlf.mutex.Unlock()
+
+	directory := filepath.Join(jlf.directory, fmt.Sprintf(chainDirectoryFormatString, chainID))
+	if err := os.RemoveAll(directory); err != nil {
+		return errors.Wrapf(err, "error removing channel %s", chainID)
+	}
+	delete(jlf.ledgers, chainID)
+	return nil
+}
+
 // Close is a no-op for the JSON ledger
 func (jlf *jsonLedgerFactory) Close() {
 	return // nothing to do
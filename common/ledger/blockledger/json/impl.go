@@ -14,6 +14,7 @@ import (
 	"sync"
 
 	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/ledger/blockledger"
 	cb "github.com/hyperledger/fabric/protos/common"
@@ -115,6 +116,25 @@ func (jl *jsonLedger) Iterator(startPosition *ab.SeekPosition) (blockledger.Iter
 			return &blockledger.NotFoundErrorIterator{}, 0
 		}
 		return &cursor{jl: jl, blockNumber: start.Specified.Number}, start.Specified.Number
+	case *ab.SeekPosition_Timestamp:
+		startTime, err := ptypes.Timestamp(start.Timestamp.GetTimestamp())
+		if err != nil {
+			return &blockledger.NotFoundErrorIterator{}, 0
+		}
+		// Start with the first block created since the given time, or with
+		// the next block to be appended if no block was created since then
+		var number uint64
+		for ; number < jl.height; number++ {
+			block, found := jl.readBlock(number)
+			if block == nil {
+				logger.Warningf("Failed reading block %d, found: %t", number, found)
+				return &blockledger.NotFoundErrorIterator{}, 0
+			}
+			if blockledger.CreatedSince(block, startTime) {
+				break
+			}
+		}
+		return &cursor{jl: jl, blockNumber: number}, number
 	default:
 		return &blockledger.NotFoundErrorIterator{}, 0
 	}
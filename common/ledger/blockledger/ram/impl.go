@@ -10,6 +10,7 @@ import (
 	"bytes"
 	"sync"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/ledger/blockledger"
 	cb "github.com/hyperledger/fabric/protos/common"
@@ -121,6 +122,23 @@ func (rl *ramLedger) Iterator(startPosition *ab.SeekPosition) (blockledger.Itera
 			}
 			list = list.getNext() // No need for nil check, because of range check above
 		}
+	case *ab.SeekPosition_Timestamp:
+		startTime, err := ptypes.Timestamp(start.Timestamp.GetTimestamp())
+		if err != nil {
+			return &blockledger.NotFoundErrorIterator{}, 0
+		}
+
+		// Position the cursor before the first block created since the given
+		// time, or at the newest block if no block was created since then
+		list = &simpleList{
+			block:  &cb.Block{Header: &cb.BlockHeader{Number: rl.oldest.block.Header.Number - 1}},
+			next:   rl.oldest,
+			signal: make(chan struct{}),
+		}
+		close(list.signal)
+		for list != rl.newest && !blockledger.CreatedSince(list.getNext().block, startTime) {
+			list = list.getNext()
+		}
 	}
 	cursor := &cursor{list: list}
 	blockNum := list.block.Header.Number + 1
@@ -7,7 +7,10 @@ SPDX-License-Identifier: Apache-2.0
 package blockledger
 
 import (
+	"time"
+
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	cb "github.com/hyperledger/fabric/protos/common"
 	ab "github.com/hyperledger/fabric/protos/orderer"
 	"github.com/hyperledger/fabric/protoutil"
@@ -95,3 +98,15 @@ func GetBlock(rl Reader, index uint64) *cb.Block {
 	}
 	return block
 }
+
+// CreatedSince returns whether the block was created at or after the given
+// time, according to the timestamp of the channel header of its first
+// transaction. Blocks without a valid timestamp are never created since.
+func CreatedSince(block *cb.Block, t time.Time) bool {
+	ts, err := protoutil.GetTimestampFromBlock(block)
+	if err != nil {
+		return false
+	}
+	blockTime, err := ptypes.Timestamp(ts)
+	return err == nil && !blockTime.Before(t)
+}
@@ -8,10 +8,13 @@ package blockledger_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/hyperledger/fabric/common/deliver/mock"
 	"github.com/hyperledger/fabric/common/ledger/blockledger"
 	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protoutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -53,3 +56,18 @@ func TestClose(t *testing.T) {
 		})
 	}
 }
+
+func TestCreatedSince(t *testing.T) {
+	block := &common.Block{Data: &common.BlockData{Data: [][]byte{protoutil.MarshalOrPanic(&common.Envelope{
+		Payload: protoutil.MarshalOrPanic(&common.Payload{
+			Header: &common.Header{ChannelHeader: protoutil.MarshalOrPanic(&common.ChannelHeader{
+				Timestamp: &timestamp.Timestamp{Seconds: 100},
+			})},
+		}),
+	})}}}
+
+	assert.True(t, blockledger.CreatedSince(block, time.Unix(50, 0)))
+	assert.True(t, blockledger.CreatedSince(block, time.Unix(100, 0)))
+	assert.False(t, blockledger.CreatedSince(block, time.Unix(150, 0)))
+	assert.False(t, blockledger.CreatedSince(protoutil.NewBlock(0, nil), time.Unix(0, 0)))
+}
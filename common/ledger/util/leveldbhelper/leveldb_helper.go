@@ -9,6 +9,7 @@ package leveldbhelper
 import (
 	"fmt"
 	"sync"
+	"syscall"
 
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/ledger/util"
@@ -164,3 +165,49 @@ func (dbInst *DB) WriteBatch(batch *leveldb.Batch, sync bool) error {
 	}
 	return nil
 }
+
+// FileLock is a lock on a directory, that is held by a single process at a time.
+// It is implemented by opening a leveldb in the directory, as leveldb acquires a
+// file lock while a db is open, and the lock is released when the db is closed
+// or the process exits. A FileLock is meant to be used by a single goroutine.
+type FileLock struct {
+	db       *leveldb.DB
+	filePath string
+}
+
+// NewFileLock returns a FileLock on the given directory
+func NewFileLock(filePath string) *FileLock {
+	return &FileLock{
+		filePath: filePath,
+	}
+}
+
+// Lock acquires the lock. An error is returned if the lock is held by
+// another FileLock, in this or in another process.
+func (f *FileLock) Lock() error {
+	dirEmpty, err := util.CreateDirIfMissing(f.filePath)
+	if err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("error creating directory %s", f.filePath))
+	}
+	dbOpts := &opt.Options{ErrorIfMissing: !dirEmpty}
+	f.db, err = leveldb.OpenFile(f.filePath, dbOpts)
+	if err == syscall.EAGAIN {
+		return errors.Errorf("lock is already acquired on file %s", f.filePath)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "error acquiring lock on file %s", f.filePath)
+	}
+	return nil
+}
+
+// Unlock releases the lock, if it is held. Unlock can be called multiple times.
+func (f *FileLock) Unlock() {
+	if f.db == nil {
+		return
+	}
+	if err := f.db.Close(); err != nil {
+		logger.Warningf("Unable to release the lock on file %s: %s", f.filePath, err)
+		return
+	}
+	f.db = nil
+}
@@ -137,3 +137,25 @@ func TestCreateDBInNonEmptyDir(t *testing.T) {
 	}()
 	db.Open()
 }
+
+func TestFileLock(t *testing.T) {
+	fileLockPath := testDBPath + "/fileLock"
+	defer os.RemoveAll(fileLockPath)
+
+	fileLock1 := NewFileLock(fileLockPath)
+	assert.NoError(t, fileLock1.Lock())
+
+	// The lock is held by fileLock1
+	fileLock2 := NewFileLock(fileLockPath)
+	err := fileLock2.Lock()
+	assert.EqualError(t, err, "lock is already acquired on file "+fileLockPath)
+
+	// Once released, the lock can be acquired again
+	fileLock1.Unlock()
+	assert.NoError(t, fileLock2.Lock())
+	fileLock2.Unlock()
+
+	// Unlock can be called multiple times
+	fileLock2.Unlock()
+	fileLock1.Unlock()
+}
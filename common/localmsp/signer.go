@@ -25,6 +25,7 @@ import (
 )
 
 type mspSigner struct {
+	identity string
 }
 
 // NewSigner returns a new instance of the msp-based LocalSigner.
@@ -34,9 +35,17 @@ func NewSigner() crypto.LocalSigner {
 	return &mspSigner{}
 }
 
+// NewSignerForIdentity returns a new instance of the msp-based LocalSigner
+// that signs with the local identity of the given name, or with the local
+// msp if the name is empty.
+// Look at mspmgmt.LoadLocalIdentity for further information.
+func NewSignerForIdentity(identity string) crypto.LocalSigner {
+	return &mspSigner{identity: identity}
+}
+
 // NewSignatureHeader creates a SignatureHeader with the correct signing identity and a valid nonce
 func (s *mspSigner) NewSignatureHeader() (*cb.SignatureHeader, error) {
-	signer, err := mspmgmt.GetLocalMSP().GetDefaultSigningIdentity()
+	signer, err := mspmgmt.GetLocalSigningIdentity(s.identity)
 	if err != nil {
 		return nil, fmt.Errorf("Failed getting MSP-based signer [%s]", err)
 	}
@@ -60,7 +69,7 @@ func (s *mspSigner) NewSignatureHeader() (*cb.SignatureHeader, error) {
 
 // Sign a message which should embed a signature header created by NewSignatureHeader
 func (s *mspSigner) Sign(message []byte) ([]byte, error) {
-	signer, err := mspmgmt.GetLocalMSP().GetDefaultSigningIdentity()
+	signer, err := mspmgmt.GetLocalSigningIdentity(s.identity)
 	if err != nil {
 		return nil, fmt.Errorf("Failed getting MSP-based signer [%s]", err)
 	}
@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/hyperledger/fabric/common/crypto"
+	"github.com/hyperledger/fabric/core/config/configtest"
 	mspmgmt "github.com/hyperledger/fabric/msp/mgmt"
 	msptesttools "github.com/hyperledger/fabric/msp/mgmt/testtools"
 	"github.com/stretchr/testify/assert"
@@ -62,3 +63,28 @@ func TestMspSigner_Sign(t *testing.T) {
 	err = mspIdentity.Verify(msg, sigma)
 	assert.NoError(t, err, "Failed verifiing signature")
 }
+
+func TestMspSignerForIdentity(t *testing.T) {
+	signer := NewSignerForIdentity("unknown")
+	_, err := signer.NewSignatureHeader()
+	assert.EqualError(t, err, "Failed getting MSP-based signer [local identity unknown is not loaded]")
+	_, err = signer.Sign([]byte("Hello World"))
+	assert.EqualError(t, err, "Failed getting MSP-based signer [local identity unknown is not loaded]")
+
+	dir, err := configtest.GetDevMspDir()
+	assert.NoError(t, err)
+	err = mspmgmt.LoadLocalIdentity("next", dir, nil, "SampleOrg", "bccsp")
+	assert.NoError(t, err)
+
+	signer = NewSignerForIdentity("next")
+	sh, err := signer.NewSignatureHeader()
+	assert.NoError(t, err)
+	msg := []byte("Hello World")
+	sigma, err := signer.Sign(msg)
+	assert.NoError(t, err)
+
+	// Verify signature
+	mspIdentity, err := mspmgmt.GetLocalMSP().DeserializeIdentity(sh.Creator)
+	assert.NoError(t, err)
+	assert.NoError(t, mspIdentity.Verify(msg, sigma))
+}
@@ -0,0 +1,394 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package otlp implements a metrics provider which keeps the values of the
+// metrics in memory and periodically pushes them to an OpenTelemetry
+// collector, with the OTLP/HTTP protocol.
+package otlp
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/hyperledger/fabric/common/metrics/internal/namer"
+	"github.com/hyperledger/fabric/common/otlp"
+)
+
+// DefaultBuckets are the bucket boundaries of histograms which do not set any.
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// aggregationTemporalityCumulative is the AGGREGATION_TEMPORALITY_CUMULATIVE
+// value of the OTLP AggregationTemporality enum.
+const aggregationTemporalityCumulative = 2
+
+type kind int
+
+const (
+	sumKind kind = iota
+	gaugeKind
+	histogramKind
+)
+
+// Provider is a metrics.Provider whose metrics are exported to an
+// OpenTelemetry collector. Counters are exported as monotonic sums, and all
+// values are cumulative since the creation of the provider.
+type Provider struct {
+	mutex     sync.Mutex
+	metrics   []*metric
+	startTime time.Time
+	now       func() time.Time
+}
+
+// NewProvider creates a Provider.
+func NewProvider() *Provider {
+	return &Provider{
+		startTime: time.Now(),
+		now:       time.Now,
+	}
+}
+
+type metric struct {
+	kind    kind
+	name    string
+	help    string
+	namer   *namer.Namer
+	buckets []float64
+	// points are the data points of the metric, keyed by their label values
+	points map[string]*point
+}
+
+type point struct {
+	labels       []otlp.KeyValue
+	value        float64
+	count        uint64
+	sum          float64
+	bucketCounts []uint64
+}
+
+func (p *Provider) newMetric(k kind, n *namer.Namer, help string, buckets []float64) *metric {
+	m := &metric{
+		kind:    k,
+		name:    n.FullyQualifiedName(),
+		help:    help,
+		namer:   n,
+		buckets: buckets,
+		points:  map[string]*point{},
+	}
+
+	p.mutex.Lock()
+	p.metrics = append(p.metrics, m)
+	p.mutex.Unlock()
+
+	return m
+}
+
+func (p *Provider) NewCounter(o metrics.CounterOpts) metrics.Counter {
+	return &Counter{
+		provider:    p,
+		metric:      p.newMetric(sumKind, namer.NewCounterNamer(o), o.Help, nil),
+		needsLabels: len(o.LabelNames) != 0,
+	}
+}
+
+func (p *Provider) NewGauge(o metrics.GaugeOpts) metrics.Gauge {
+	return &Gauge{
+		provider:    p,
+		metric:      p.newMetric(gaugeKind, namer.NewGaugeNamer(o), o.Help, nil),
+		needsLabels: len(o.LabelNames) != 0,
+	}
+}
+
+func (p *Provider) NewHistogram(o metrics.HistogramOpts) metrics.Histogram {
+	buckets := o.Buckets
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	return &Histogram{
+		provider:    p,
+		metric:      p.newMetric(histogramKind, namer.NewHistogramNamer(o), o.Help, buckets),
+		needsLabels: len(o.LabelNames) != 0,
+	}
+}
+
+// update applies the function to the data point of the metric with the given
+// label values, creating the point if needed.
+func (p *Provider) update(m *metric, labelValues []string, update func(*point)) {
+	attributes := labels(labelValues)
+	var key strings.Builder
+	for _, kv := range attributes {
+		key.WriteString(kv.Key + "\x00" + *kv.Value.StringValue + "\x00")
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	pt, ok := m.points[key.String()]
+	if !ok {
+		pt = &point{labels: attributes}
+		if m.kind == histogramKind {
+			pt.bucketCounts = make([]uint64, len(m.buckets)+1)
+		}
+		m.points[key.String()] = pt
+	}
+	update(pt)
+}
+
+// labels returns the attributes of the label name and value pairs, sorted by
+// label name.
+func labels(labelValues []string) []otlp.KeyValue {
+	var attributes []otlp.KeyValue
+	for i := 0; i < len(labelValues); i += 2 {
+		value := "unknown"
+		if i+1 < len(labelValues) {
+			value = labelValues[i+1]
+		}
+		attributes = append(attributes, otlp.StringAttribute(labelValues[i], value))
+	}
+	sort.Slice(attributes, func(i, j int) bool { return attributes[i].Key < attributes[j].Key })
+	return attributes
+}
+
+type Counter struct {
+	provider    *Provider
+	metric      *metric
+	labelValues []string
+	needsLabels bool
+}
+
+func (c *Counter) With(labelValues ...string) metrics.Counter {
+	c.metric.namer.Format(labelValues...) // validates the label names
+	return &Counter{
+		provider:    c.provider,
+		metric:      c.metric,
+		labelValues: append(append([]string{}, c.labelValues...), labelValues...),
+	}
+}
+
+func (c *Counter) Add(delta float64) {
+	if c.needsLabels {
+		panic("label values must be provided by calling With")
+	}
+	c.provider.update(c.metric, c.labelValues, func(pt *point) { pt.value += delta })
+}
+
+type Gauge struct {
+	provider    *Provider
+	metric      *metric
+	labelValues []string
+	needsLabels bool
+}
+
+func (g *Gauge) With(labelValues ...string) metrics.Gauge {
+	g.metric.namer.Format(labelValues...) // validates the label names
+	return &Gauge{
+		provider:    g.provider,
+		metric:      g.metric,
+		labelValues: append(append([]string{}, g.labelValues...), labelValues...),
+	}
+}
+
+func (g *Gauge) Add(delta float64) {
+	if g.needsLabels {
+		panic("label values must be provided by calling With")
+	}
+	g.provider.update(g.metric, g.labelValues, func(pt *point) { pt.value += delta })
+}
+
+func (g *Gauge) Set(value float64) {
+	if g.needsLabels {
+		panic("label values must be provided by calling With")
+	}
+	g.provider.update(g.metric, g.labelValues, func(pt *point) { pt.value = value })
+}
+
+type Histogram struct {
+	provider    *Provider
+	metric      *metric
+	labelValues []string
+	needsLabels bool
+}
+
+func (h *Histogram) With(labelValues ...string) metrics.Histogram {
+	h.metric.namer.Format(labelValues...) // validates the label names
+	return &Histogram{
+		provider:    h.provider,
+		metric:      h.metric,
+		labelValues: append(append([]string{}, h.labelValues...), labelValues...),
+	}
+}
+
+func (h *Histogram) Observe(value float64) {
+	if h.needsLabels {
+		panic("label values must be provided by calling With")
+	}
+	buckets := h.metric.buckets
+	h.provider.update(h.metric, h.labelValues, func(pt *point) {
+		pt.count++
+		pt.sum += value
+		// a value equal to a boundary belongs to the bucket the boundary closes
+		pt.bucketCounts[sort.SearchFloat64s(buckets, value)]++
+	})
+}
+
+// ExportMetricsServiceRequest is the JSON encoding of the request of the
+// OTLP metrics service.
+type ExportMetricsServiceRequest struct {
+	ResourceMetrics []ResourceMetrics `json:"resourceMetrics"`
+}
+
+type ResourceMetrics struct {
+	Resource     otlp.Resource  `json:"resource"`
+	ScopeMetrics []ScopeMetrics `json:"scopeMetrics"`
+}
+
+type ScopeMetrics struct {
+	Scope   otlp.Scope `json:"scope"`
+	Metrics []Metric   `json:"metrics"`
+}
+
+type Metric struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Sum         *SumData       `json:"sum,omitempty"`
+	Gauge       *GaugeData     `json:"gauge,omitempty"`
+	Histogram   *HistogramData `json:"histogram,omitempty"`
+}
+
+type SumData struct {
+	DataPoints             []NumberDataPoint `json:"dataPoints"`
+	AggregationTemporality int               `json:"aggregationTemporality"`
+	IsMonotonic            bool              `json:"isMonotonic"`
+}
+
+type GaugeData struct {
+	DataPoints []NumberDataPoint `json:"dataPoints"`
+}
+
+type HistogramData struct {
+	DataPoints             []HistogramDataPoint `json:"dataPoints"`
+	AggregationTemporality int                  `json:"aggregationTemporality"`
+}
+
+type NumberDataPoint struct {
+	Attributes        []otlp.KeyValue `json:"attributes,omitempty"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	TimeUnixNano      string          `json:"timeUnixNano"`
+	AsDouble          float64         `json:"asDouble"`
+}
+
+type HistogramDataPoint struct {
+	Attributes        []otlp.KeyValue `json:"attributes,omitempty"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	TimeUnixNano      string          `json:"timeUnixNano"`
+	Count             string          `json:"count"`
+	Sum               float64         `json:"sum"`
+	BucketCounts      []string        `json:"bucketCounts"`
+	ExplicitBounds    []float64       `json:"explicitBounds"`
+}
+
+// Collect returns the request exporting the current values of the metrics
+// which were recorded at least once.
+func (p *Provider) Collect(resource otlp.Resource) *ExportMetricsServiceRequest {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	startTime := otlp.Timestamp(p.startTime)
+	now := otlp.Timestamp(p.now())
+
+	var exported []Metric
+	for _, m := range p.metrics {
+		if len(m.points) == 0 {
+			continue
+		}
+		points := m.sortedPoints()
+		metric := Metric{Name: m.name, Description: m.help}
+		switch m.kind {
+		case sumKind, gaugeKind:
+			var dataPoints []NumberDataPoint
+			for _, pt := range points {
+				dataPoints = append(dataPoints, NumberDataPoint{
+					Attributes:        pt.labels,
+					StartTimeUnixNano: startTime,
+					TimeUnixNano:      now,
+					AsDouble:          pt.value,
+				})
+			}
+			if m.kind == sumKind {
+				metric.Sum = &SumData{
+					DataPoints:             dataPoints,
+					AggregationTemporality: aggregationTemporalityCumulative,
+					IsMonotonic:            true,
+				}
+			} else {
+				metric.Gauge = &GaugeData{DataPoints: dataPoints}
+			}
+		case histogramKind:
+			var dataPoints []HistogramDataPoint
+			for _, pt := range points {
+				bucketCounts := make([]string, len(pt.bucketCounts))
+				for i, c := range pt.bucketCounts {
+					bucketCounts[i] = strconv.FormatUint(c, 10)
+				}
+				dataPoints = append(dataPoints, HistogramDataPoint{
+					Attributes:        pt.labels,
+					StartTimeUnixNano: startTime,
+					TimeUnixNano:      now,
+					Count:             strconv.FormatUint(pt.count, 10),
+					Sum:               pt.sum,
+					BucketCounts:      bucketCounts,
+					ExplicitBounds:    m.buckets,
+				})
+			}
+			metric.Histogram = &HistogramData{
+				DataPoints:             dataPoints,
+				AggregationTemporality: aggregationTemporalityCumulative,
+			}
+		}
+		exported = append(exported, metric)
+	}
+
+	return &ExportMetricsServiceRequest{
+		ResourceMetrics: []ResourceMetrics{{
+			Resource: resource,
+			ScopeMetrics: []ScopeMetrics{{
+				Scope:   otlp.FabricScope,
+				Metrics: exported,
+			}},
+		}},
+	}
+}
+
+func (m *metric) sortedPoints() []*point {
+	var keys []string
+	for key := range m.points {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	points := make([]*point, len(keys))
+	for i, key := range keys {
+		points[i] = m.points[key]
+	}
+	return points
+}
+
+// Export sends the current values of the metrics to the collector.
+func (p *Provider) Export(client *otlp.Client, resource otlp.Resource) error {
+	return client.Send(otlp.MetricsPath, p.Collect(resource))
+}
+
+// SendLoop exports the metrics to the collector on every tick of the channel,
+// until the channel is closed. Export failures are passed to onError.
+func (p *Provider) SendLoop(c <-chan time.Time, client *otlp.Client, resource otlp.Resource, onError func(error)) {
+	for range c {
+		if err := p.Export(client, resource); err != nil {
+			onError(err)
+		}
+	}
+}
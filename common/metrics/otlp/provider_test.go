@@ -0,0 +1,191 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package otlp_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/hyperledger/fabric/common/metrics/otlp"
+	commonotlp "github.com/hyperledger/fabric/common/otlp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Provider", func() {
+	var (
+		provider *otlp.Provider
+		resource commonotlp.Resource
+	)
+
+	BeforeEach(func() {
+		provider = otlp.NewProvider()
+		resource = commonotlp.NewResource("peer", "2.0.0")
+	})
+
+	It("implements metrics.Provider", func() {
+		var p metrics.Provider = otlp.NewProvider()
+		Expect(p).NotTo(BeNil())
+	})
+
+	metricsOf := func(request *otlp.ExportMetricsServiceRequest) []otlp.Metric {
+		Expect(request.ResourceMetrics).To(HaveLen(1))
+		Expect(request.ResourceMetrics[0].Resource).To(Equal(resource))
+		Expect(request.ResourceMetrics[0].ScopeMetrics).To(HaveLen(1))
+		Expect(request.ResourceMetrics[0].ScopeMetrics[0].Scope).To(Equal(commonotlp.FabricScope))
+		return request.ResourceMetrics[0].ScopeMetrics[0].Metrics
+	}
+
+	It("does not export metrics which were never recorded", func() {
+		provider.NewCounter(metrics.CounterOpts{Name: "counter"})
+		Expect(metricsOf(provider.Collect(resource))).To(BeEmpty())
+	})
+
+	It("exports counters as cumulative monotonic sums", func() {
+		counter := provider.NewCounter(metrics.CounterOpts{
+			Namespace:  "namespace",
+			Subsystem:  "subsystem",
+			Name:       "name",
+			Help:       "help",
+			LabelNames: []string{"alpha", "beta"},
+		})
+		counter.With("beta", "b", "alpha", "a").Add(1)
+		counter.With("alpha", "a", "beta", "b").Add(2)
+		counter.With("alpha", "x").With("beta", "b").Add(3)
+
+		exported := metricsOf(provider.Collect(resource))
+		Expect(exported).To(HaveLen(1))
+		Expect(exported[0].Name).To(Equal("namespace.subsystem.name"))
+		Expect(exported[0].Description).To(Equal("help"))
+		Expect(exported[0].Gauge).To(BeNil())
+		Expect(exported[0].Histogram).To(BeNil())
+
+		sum := exported[0].Sum
+		Expect(sum.AggregationTemporality).To(Equal(2))
+		Expect(sum.IsMonotonic).To(BeTrue())
+		Expect(sum.DataPoints).To(HaveLen(2))
+		Expect(sum.DataPoints[0].Attributes).To(Equal([]commonotlp.KeyValue{
+			commonotlp.StringAttribute("alpha", "a"),
+			commonotlp.StringAttribute("beta", "b"),
+		}))
+		Expect(sum.DataPoints[0].AsDouble).To(Equal(3.0))
+		Expect(sum.DataPoints[1].Attributes[0]).To(Equal(commonotlp.StringAttribute("alpha", "x")))
+		Expect(sum.DataPoints[1].AsDouble).To(Equal(3.0))
+		Expect(sum.DataPoints[0].StartTimeUnixNano).NotTo(BeEmpty())
+		Expect(sum.DataPoints[0].TimeUnixNano).NotTo(BeEmpty())
+	})
+
+	It("exports gauges", func() {
+		gauge := provider.NewGauge(metrics.GaugeOpts{Name: "gauge"})
+		gauge.Set(5)
+		gauge.Add(-2)
+
+		exported := metricsOf(provider.Collect(resource))
+		Expect(exported).To(HaveLen(1))
+		Expect(exported[0].Sum).To(BeNil())
+		Expect(exported[0].Gauge.DataPoints).To(HaveLen(1))
+		Expect(exported[0].Gauge.DataPoints[0].Attributes).To(BeEmpty())
+		Expect(exported[0].Gauge.DataPoints[0].AsDouble).To(Equal(3.0))
+	})
+
+	It("exports histograms with explicit bucket boundaries", func() {
+		histogram := provider.NewHistogram(metrics.HistogramOpts{
+			Name:       "histogram",
+			Buckets:    []float64{1, 5},
+			LabelNames: []string{"alpha"},
+		})
+		for _, v := range []float64{0.5, 1, 3, 10} {
+			histogram.With("alpha", "a").Observe(v)
+		}
+
+		exported := metricsOf(provider.Collect(resource))
+		Expect(exported).To(HaveLen(1))
+		Expect(exported[0].Histogram.AggregationTemporality).To(Equal(2))
+		Expect(exported[0].Histogram.DataPoints).To(HaveLen(1))
+		point := exported[0].Histogram.DataPoints[0]
+		Expect(point.Count).To(Equal("4"))
+		Expect(point.Sum).To(Equal(14.5))
+		Expect(point.BucketCounts).To(Equal([]string{"2", "1", "1"}))
+		Expect(point.ExplicitBounds).To(Equal([]float64{1, 5}))
+	})
+
+	It("uses the default buckets when a histogram sets none", func() {
+		provider.NewHistogram(metrics.HistogramOpts{Name: "histogram"}).Observe(1)
+		point := metricsOf(provider.Collect(resource))[0].Histogram.DataPoints[0]
+		Expect(point.ExplicitBounds).To(Equal(otlp.DefaultBuckets))
+		Expect(point.BucketCounts).To(HaveLen(len(otlp.DefaultBuckets) + 1))
+	})
+
+	It("requires label values when label names are declared", func() {
+		counter := provider.NewCounter(metrics.CounterOpts{Name: "counter", LabelNames: []string{"alpha"}})
+		Expect(func() { counter.Add(1) }).To(Panic())
+		Expect(func() { counter.With("gamma", "c") }).To(Panic())
+		gauge := provider.NewGauge(metrics.GaugeOpts{Name: "gauge", LabelNames: []string{"alpha"}})
+		Expect(func() { gauge.Set(1) }).To(Panic())
+		histogram := provider.NewHistogram(metrics.HistogramOpts{Name: "histogram", LabelNames: []string{"alpha"}})
+		Expect(func() { histogram.Observe(1) }).To(Panic())
+	})
+
+	Describe("SendLoop", func() {
+		var (
+			server   *httptest.Server
+			requests chan []byte
+		)
+
+		BeforeEach(func() {
+			requests = make(chan []byte, 10)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/metrics" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				body, _ := ioutil.ReadAll(r.Body)
+				requests <- body
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("exports the metrics on every tick", func() {
+			provider.NewCounter(metrics.CounterOpts{Name: "counter"}).Add(1)
+			ticks := make(chan time.Time)
+			done := make(chan struct{})
+			go func() {
+				provider.SendLoop(ticks, &commonotlp.Client{Endpoint: server.URL}, resource, func(err error) { Fail(err.Error()) })
+				close(done)
+			}()
+
+			ticks <- time.Now()
+			var body []byte
+			Eventually(requests).Should(Receive(&body))
+			request := &otlp.ExportMetricsServiceRequest{}
+			Expect(json.Unmarshal(body, request)).To(Succeed())
+			Expect(metricsOf(request)[0].Name).To(Equal("counter"))
+			Expect(metricsOf(request)[0].Sum.DataPoints[0].AsDouble).To(Equal(1.0))
+
+			close(ticks)
+			Eventually(done).Should(BeClosed())
+		})
+
+		It("reports export failures", func() {
+			ticks := make(chan time.Time, 1)
+			errs := make(chan error, 1)
+			ticks <- time.Now()
+			close(ticks)
+			provider.SendLoop(ticks, &commonotlp.Client{Endpoint: server.URL + "/missing"}, resource, func(err error) { errs <- err })
+			var err error
+			Expect(errs).To(Receive(&err))
+			Expect(err).To(MatchError("collector responded with 404 Not Found"))
+		})
+	})
+})
@@ -8,11 +8,13 @@ package config
 
 import (
 	"github.com/hyperledger/fabric/common/channelconfig"
+	pb "github.com/hyperledger/fabric/protos/peer"
 )
 
 type MockApplication struct {
-	CapabilitiesRv channelconfig.ApplicationCapabilities
-	Acls           map[string]string
+	CapabilitiesRv    channelconfig.ApplicationCapabilities
+	Acls              map[string]string
+	InvocationQuotaRv *pb.InvocationQuota
 }
 
 func (m *MockApplication) Organizations() map[string]channelconfig.ApplicationOrg {
@@ -35,6 +37,10 @@ func (m *MockApplication) APIPolicyMapper() channelconfig.PolicyMapper {
 	return m
 }
 
+func (m *MockApplication) InvocationQuota() *pb.InvocationQuota {
+	return m.InvocationQuotaRv
+}
+
 type MockApplicationCapabilities struct {
 	SupportedRv                  error
 	ForbidDuplicateTXIdInBlockRv bool
@@ -32,8 +32,10 @@ type Orderer struct {
 	KafkaBrokersVal []string
 	// MaxChannelsCountVal is returns as the result of MaxChannelsCount()
 	MaxChannelsCountVal uint64
+	// DecommissionedVal is returned as the result of Decommissioned()
+	DecommissionedVal bool
 	// OrganizationsVal is returned as the result of Organizations()
-	OrganizationsVal map[string]channelconfig.Org
+	OrganizationsVal map[string]channelconfig.OrdererOrg
 	// CapabilitiesVal is returned as the result of Capabilities()
 	CapabilitiesVal channelconfig.OrdererCapabilities
 }
@@ -73,13 +75,18 @@ func (o *Orderer) KafkaBrokers() []string {
 	return o.KafkaBrokersVal
 }
 
+// Decommissioned returns the DecommissionedVal
+func (o *Orderer) Decommissioned() bool {
+	return o.DecommissionedVal
+}
+
 // MaxChannelsCount returns the MaxChannelsCountVal
 func (o *Orderer) MaxChannelsCount() uint64 {
 	return o.MaxChannelsCountVal
 }
 
 // Organizations returns OrganizationsVal
-func (o *Orderer) Organizations() map[string]channelconfig.Org {
+func (o *Orderer) Organizations() map[string]channelconfig.OrdererOrg {
 	return o.OrganizationsVal
 }
 
@@ -105,6 +112,12 @@ type OrdererCapabilities struct {
 	Kafka2RaftMigVal bool
 
 	UseChannelCreationPolicyAsAdminsVal bool
+
+	OrgEndpointsVal bool
+
+	ExactMessageSizeVal bool
+
+	ChannelDecommissionVal bool
 }
 
 // Supported returns SupportedErr
@@ -136,3 +149,18 @@ func (oc *OrdererCapabilities) Kafka2RaftMigration() bool {
 func (oc *OrdererCapabilities) UseChannelCreationPolicyAsAdmins() bool {
 	return oc.UseChannelCreationPolicyAsAdminsVal
 }
+
+// OrgEndpoints returns OrgEndpointsVal
+func (oc *OrdererCapabilities) OrgEndpoints() bool {
+	return oc.OrgEndpointsVal
+}
+
+// ExactMessageSize returns ExactMessageSizeVal
+func (oc *OrdererCapabilities) ExactMessageSize() bool {
+	return oc.ExactMessageSizeVal
+}
+
+// ChannelDecommission returns ChannelDecommissionVal
+func (oc *OrdererCapabilities) ChannelDecommission() bool {
+	return oc.ChannelDecommissionVal
+}
@@ -176,6 +176,10 @@ func (s *MockCCComm) respond(msg *pb.ChaincodeMessage) error {
 		}
 		return nil
 	}
+	if msg != nil && msg.Type == pb.ChaincodeMessage_SHIM_LOG_LEVEL {
+		// shim log levels are not part of the scripted responses
+		return nil
+	}
 
 	s.respLock.Lock()
 	defer s.respLock.Unlock()
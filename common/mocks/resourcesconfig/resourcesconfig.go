@@ -7,13 +7,14 @@ SPDX-License-Identifier: Apache-2.0
 package resourceconfig
 
 type MockChaincodeDefinition struct {
-	NameRv          string
-	VersionRv       string
-	EndorsementStr  string
-	ValidationStr   string
-	ValidationBytes []byte
-	HashRv          []byte
-	RequiresInitRv  bool
+	NameRv           string
+	VersionRv        string
+	EndorsementStr   string
+	ValidationStr    string
+	ValidationBytes  []byte
+	HashRv           []byte
+	RequiresInitRv   bool
+	ReadYourWritesRv bool
 }
 
 func (m *MockChaincodeDefinition) CCName() string {
@@ -39,3 +40,7 @@ func (m *MockChaincodeDefinition) Endorsement() string {
 func (m *MockChaincodeDefinition) RequiresInit() bool {
 	return m.RequiresInitRv
 }
+
+func (m *MockChaincodeDefinition) ReadYourWrites() bool {
+	return m.ReadYourWritesRv
+}
@@ -0,0 +1,127 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package otlp sends telemetry to OpenTelemetry collectors with the OTLP/HTTP
+// protocol, using its JSON encoding.
+package otlp
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// The paths of the OTLP/HTTP endpoints of a collector.
+const (
+	MetricsPath = "/v1/metrics"
+	TracesPath  = "/v1/traces"
+)
+
+// DefaultTimeout is the default time allowed for a collector to respond.
+const DefaultTimeout = 10 * time.Second
+
+// Client sends OTLP/HTTP requests to a collector.
+type Client struct {
+	// Endpoint is the base URL of the collector, such as
+	// http://otel-collector:4318
+	Endpoint string
+	// Headers are added to the requests, such as authorization headers
+	Headers map[string]string
+	// HTTPClient sends the requests, or a client with DefaultTimeout if nil
+	HTTPClient *http.Client
+}
+
+// Send posts the JSON encoding of the request to the given path of the
+// collector.
+func (c *Client) Send(path string, request interface{}) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return errors.Wrap(err, "failed encoding OTLP request")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(c.Endpoint, "/")+path, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed creating OTLP request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range c.Headers {
+		req.Header.Set(name, value)
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: DefaultTimeout}
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed sending OTLP request")
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("collector responded with %s", resp.Status)
+	}
+	return nil
+}
+
+// AnyValue is the value of an attribute. Integers are encoded as strings, as
+// mandated by the JSON mapping of protocol buffers for 64 bit integers.
+type AnyValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"`
+}
+
+// KeyValue is an attribute of a resource, a data point or a span.
+type KeyValue struct {
+	Key   string   `json:"key"`
+	Value AnyValue `json:"value"`
+}
+
+// StringAttribute returns an attribute with a string value.
+func StringAttribute(key, value string) KeyValue {
+	return KeyValue{Key: key, Value: AnyValue{StringValue: &value}}
+}
+
+// IntAttribute returns an attribute with an integer value.
+func IntAttribute(key string, value int64) KeyValue {
+	encoded := strconv.FormatInt(value, 10)
+	return KeyValue{Key: key, Value: AnyValue{IntValue: &encoded}}
+}
+
+// Resource describes the process the telemetry originates from.
+type Resource struct {
+	Attributes []KeyValue `json:"attributes"`
+}
+
+// NewResource returns the resource of the given service.
+func NewResource(serviceName, serviceVersion string) Resource {
+	attributes := []KeyValue{StringAttribute("service.name", serviceName)}
+	if serviceVersion != "" {
+		attributes = append(attributes, StringAttribute("service.version", serviceVersion))
+	}
+	return Resource{Attributes: attributes}
+}
+
+// Scope identifies the instrumentation the telemetry is produced by.
+type Scope struct {
+	Name string `json:"name"`
+}
+
+// FabricScope is the instrumentation scope of the telemetry of Fabric.
+var FabricScope = Scope{Name: "github.com/hyperledger/fabric"}
+
+// Timestamp returns the encoding of the time as nanoseconds since the epoch.
+func Timestamp(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
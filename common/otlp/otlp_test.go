@@ -0,0 +1,68 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package otlp
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientSend(t *testing.T) {
+	var received *http.Request
+	var body []byte
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = ioutil.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		Endpoint: server.URL + "/",
+		Headers:  map[string]string{"Authorization": "Bearer token"},
+	}
+	err := client.Send(MetricsPath, &Resource{Attributes: []KeyValue{StringAttribute("service.name", "peer")}})
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPost, received.Method)
+	assert.Equal(t, "/v1/metrics", received.URL.Path)
+	assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+	assert.Equal(t, "Bearer token", received.Header.Get("Authorization"))
+	assert.JSONEq(t, `{"attributes": [{"key": "service.name", "value": {"stringValue": "peer"}}]}`, string(body))
+
+	status = http.StatusBadRequest
+	err = client.Send(TracesPath, &Resource{})
+	assert.EqualError(t, err, "collector responded with 400 Bad Request")
+
+	client.Endpoint = "http://127.0.0.1:0"
+	err = client.Send(TracesPath, &Resource{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed sending OTLP request")
+}
+
+func TestAttributes(t *testing.T) {
+	encoded, err := json.Marshal(NewResource("orderer", "2.0.0"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"attributes": [
+		{"key": "service.name", "value": {"stringValue": "orderer"}},
+		{"key": "service.version", "value": {"stringValue": "2.0.0"}}
+	]}`, string(encoded))
+
+	encoded, err = json.Marshal(IntAttribute("rpc.grpc.status_code", 14))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"key": "rpc.grpc.status_code", "value": {"intValue": "14"}}`, string(encoded))
+
+	assert.Len(t, NewResource("peer", "").Attributes, 1)
+	assert.Equal(t, "1000000001", Timestamp(time.Unix(1, 1)))
+}
@@ -40,6 +40,7 @@ func makeManagers(count, passing int) map[string]*ManagerImpl {
 
 		result[fmt.Sprintf("%d", i)] = &ManagerImpl{
 			policies: policyMap,
+			metrics:  metricsOrDisabled(nil),
 		}
 	}
 	return result
@@ -0,0 +1,49 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package policies
+
+import (
+	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
+)
+
+var (
+	evaluationDuration = metrics.HistogramOpts{
+		Namespace:    "policy",
+		Name:         "evaluation_duration",
+		Help:         "The time to evaluate a policy in seconds.",
+		LabelNames:   []string{"path", "satisfied"},
+		StatsdFormat: "%{#fqname}.%{path}.%{satisfied}",
+	}
+	evaluationCount = metrics.CounterOpts{
+		Namespace:    "policy",
+		Name:         "evaluation_count",
+		Help:         "The number of policy evaluations, by whether the policy was satisfied or denied.",
+		LabelNames:   []string{"path", "satisfied"},
+		StatsdFormat: "%{#fqname}.%{path}.%{satisfied}",
+	}
+)
+
+type Metrics struct {
+	EvaluationDuration metrics.Histogram
+	EvaluationCount    metrics.Counter
+}
+
+func NewMetrics(p metrics.Provider) *Metrics {
+	return &Metrics{
+		EvaluationDuration: p.NewHistogram(evaluationDuration),
+		EvaluationCount:    p.NewCounter(evaluationCount),
+	}
+}
+
+// metricsOrDisabled returns the given metrics, or disabled metrics if nil
+func metricsOrDisabled(m *Metrics) *Metrics {
+	if m == nil {
+		return NewMetrics(&disabled.Provider{})
+	}
+	return m
+}
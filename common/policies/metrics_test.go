@@ -0,0 +1,49 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package policies
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/common/metrics/metricsfakes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyEvaluationMetrics(t *testing.T) {
+	fakeCounter := &metricsfakes.Counter{}
+	fakeCounter.WithReturns(fakeCounter)
+	fakeHistogram := &metricsfakes.Histogram{}
+	fakeHistogram.WithReturns(fakeHistogram)
+	fakeProvider := &metricsfakes.Provider{}
+	fakeProvider.NewCounterReturns(fakeCounter)
+	fakeProvider.NewHistogramReturns(fakeHistogram)
+
+	m := &ManagerImpl{
+		path: "Channel",
+		policies: map[string]Policy{
+			"Readers": acceptPolicy{},
+		},
+		metrics: NewMetrics(fakeProvider),
+	}
+
+	p, ok := m.GetPolicy("Readers")
+	assert.True(t, ok)
+	assert.NoError(t, p.Evaluate(nil))
+	assert.Equal(t, 1, fakeCounter.AddCallCount())
+	assert.Equal(t, float64(1), fakeCounter.AddArgsForCall(0))
+	assert.Equal(t, []string{"path", "/Channel/Readers", "satisfied", "true"}, fakeCounter.WithArgsForCall(0))
+	assert.Equal(t, 1, fakeHistogram.ObserveCallCount())
+	assert.Equal(t, []string{"path", "/Channel/Readers", "satisfied", "true"}, fakeHistogram.WithArgsForCall(0))
+
+	m.policies["Writers"] = rejectPolicy("Writers")
+	p, ok = m.GetPolicy("Writers")
+	assert.True(t, ok)
+	assert.Error(t, p.Evaluate(nil))
+	assert.Equal(t, 2, fakeCounter.AddCallCount())
+	assert.Equal(t, []string{"path", "/Channel/Writers", "satisfied", "false"}, fakeCounter.WithArgsForCall(1))
+	assert.Equal(t, []string{"path", "/Channel/Writers", "satisfied", "false"}, fakeHistogram.WithArgsForCall(1))
+}
@@ -8,7 +8,9 @@ package policies
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/common/flogging"
@@ -49,6 +51,9 @@ const (
 
 	// BlockValidation is the label for the policy which should validate the block signatures for the channel
 	BlockValidation = PathSeparator + ChannelPrefix + PathSeparator + OrdererPrefix + PathSeparator + "BlockValidation"
+
+	// ClusterMessageValidation is the label for the policy which should validate the signatures of the messages exchanged by the ordering service nodes of the channel
+	ClusterMessageValidation = PathSeparator + ChannelPrefix + PathSeparator + OrdererPrefix + PathSeparator + "ClusterMessageValidation"
 )
 
 var logger = flogging.MustGetLogger("policies")
@@ -153,10 +158,13 @@ type ManagerImpl struct {
 	path     string // The group level path
 	policies map[string]Policy
 	managers map[string]*ManagerImpl
+	metrics  *Metrics
 }
 
-// NewManagerImpl creates a new ManagerImpl with the given CryptoHelper
-func NewManagerImpl(path string, providers map[int32]Provider, root *cb.ConfigGroup) (*ManagerImpl, error) {
+// NewManagerImpl creates a new ManagerImpl with the given CryptoHelper,
+// whose policies report the given metrics, or none if nil
+func NewManagerImpl(path string, providers map[int32]Provider, root *cb.ConfigGroup, metrics *Metrics) (*ManagerImpl, error) {
+	metrics = metricsOrDisabled(metrics)
 	var err error
 	_, ok := providers[int32(cb.Policy_IMPLICIT_META)]
 	if ok {
@@ -166,7 +174,7 @@ func NewManagerImpl(path string, providers map[int32]Provider, root *cb.ConfigGr
 	managers := make(map[string]*ManagerImpl)
 
 	for groupName, group := range root.Groups {
-		managers[groupName], err = NewManagerImpl(path+PathSeparator+groupName, providers, group)
+		managers[groupName], err = NewManagerImpl(path+PathSeparator+groupName, providers, group, metrics)
 		if err != nil {
 			return nil, err
 		}
@@ -215,6 +223,7 @@ func NewManagerImpl(path string, providers map[int32]Provider, root *cb.ConfigGr
 		path:     path,
 		policies: policies,
 		managers: managers,
+		metrics:  metrics,
 	}, nil
 }
 
@@ -245,6 +254,7 @@ func (pm *ManagerImpl) Manager(path []string) (Manager, bool) {
 type policyLogger struct {
 	policy     Policy
 	policyName string
+	metrics    *Metrics
 }
 
 func (pl *policyLogger) Evaluate(signatureSet []*protoutil.SignedData) error {
@@ -253,12 +263,18 @@ func (pl *policyLogger) Evaluate(signatureSet []*protoutil.SignedData) error {
 		defer logger.Debugf("== Done Evaluating %T Policy %s", pl.policy, pl.policyName)
 	}
 
+	startTime := time.Now()
 	err := pl.policy.Evaluate(signatureSet)
 	if err != nil {
 		logger.Debugf("Signature set did not satisfy policy %s", pl.policyName)
 	} else {
 		logger.Debugf("Signature set satisfies policy %s", pl.policyName)
 	}
+
+	labels := []string{"path", pl.policyName, "satisfied", strconv.FormatBool(err == nil)}
+	pl.metrics.EvaluationDuration.With(labels...).Observe(time.Since(startTime).Seconds())
+	pl.metrics.EvaluationCount.With(labels...).Add(1)
+
 	return err
 }
 
@@ -290,5 +306,6 @@ func (pm *ManagerImpl) GetPolicy(id string) (Policy, bool) {
 	return &policyLogger{
 		policy:     policy,
 		policyName: PathSeparator + pm.path + PathSeparator + relpath,
+		metrics:    pm.metrics,
 	}, true
 }
@@ -41,7 +41,7 @@ func TestUnnestedManager(t *testing.T) {
 		},
 	}
 
-	m, err := NewManagerImpl("test", defaultProviders(), config)
+	m, err := NewManagerImpl("test", defaultProviders(), config, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, m)
 
@@ -94,7 +94,7 @@ func TestNestedManager(t *testing.T) {
 		},
 	}
 
-	m, err := NewManagerImpl("nest0", defaultProviders(), config)
+	m, err := NewManagerImpl("nest0", defaultProviders(), config, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, m)
 
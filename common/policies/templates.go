@@ -0,0 +1,358 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package policies
+
+import (
+	"strconv"
+	"strings"
+
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+)
+
+// maxWeightedRoles is the maximum number of roles of a weighted policy.  As a
+// weighted policy is expanded into the disjunction of the minimal sets of
+// roles reaching the threshold, its size may grow exponentially.
+const maxWeightedRoles = 16
+
+// OrgRole identifies the members of an organization which have a role, such
+// as the admins of the organization.
+type OrgRole struct {
+	MSPID string
+	Role  msp.MSPRole_MSPRoleType
+}
+
+// Member returns the OrgRole of any member of the organization.
+func Member(mspID string) OrgRole {
+	return OrgRole{MSPID: mspID, Role: msp.MSPRole_MEMBER}
+}
+
+// Admin returns the OrgRole of the admins of the organization.
+func Admin(mspID string) OrgRole {
+	return OrgRole{MSPID: mspID, Role: msp.MSPRole_ADMIN}
+}
+
+// Client returns the OrgRole of the clients of the organization.
+func Client(mspID string) OrgRole {
+	return OrgRole{MSPID: mspID, Role: msp.MSPRole_CLIENT}
+}
+
+// Peer returns the OrgRole of the peers of the organization.
+func Peer(mspID string) OrgRole {
+	return OrgRole{MSPID: mspID, Role: msp.MSPRole_PEER}
+}
+
+// Orderer returns the OrgRole of the orderers of the organization.
+func Orderer(mspID string) OrgRole {
+	return OrgRole{MSPID: mspID, Role: msp.MSPRole_ORDERER}
+}
+
+// String returns the OrgRole in the form used by signature policy rules,
+// such as SampleOrg.admin.
+func (or OrgRole) String() string {
+	return or.MSPID + "." + strings.ToLower(or.Role.String())
+}
+
+// WeightedOrgRole is an OrgRole whose signature counts Weight times toward
+// the threshold of a weighted policy.
+type WeightedOrgRole struct {
+	OrgRole
+	Weight int32
+}
+
+// AnyOf returns a signature policy requiring one signature from any of the
+// roles.
+func AnyOf(roles ...OrgRole) *cb.SignaturePolicyEnvelope {
+	return NOutOf(1, roles...)
+}
+
+// AllOf returns a signature policy requiring one signature from each of the
+// roles.
+func AllOf(roles ...OrgRole) *cb.SignaturePolicyEnvelope {
+	return NOutOf(int32(len(roles)), roles...)
+}
+
+// MajorityOf returns a signature policy requiring one signature from each of
+// a strict majority of the roles.
+func MajorityOf(roles ...OrgRole) *cb.SignaturePolicyEnvelope {
+	return NOutOf(int32(len(roles)/2+1), roles...)
+}
+
+// NOutOf returns a signature policy requiring one signature from each of n of
+// the roles.
+func NOutOf(n int32, roles ...OrgRole) *cb.SignaturePolicyEnvelope {
+	b := &envelopeBuilder{}
+	return b.envelope(b.nOutOf(n, roles))
+}
+
+// WeightedNOutOf returns a signature policy requiring signatures from roles
+// whose weights sum up to the threshold or more.  As signature policies have
+// no notion of weight, the policy is the disjunction of the minimal sets of
+// roles reaching the threshold.
+func WeightedNOutOf(threshold int32, roles ...WeightedOrgRole) (*cb.SignaturePolicyEnvelope, error) {
+	if threshold < 1 {
+		return nil, errors.Errorf("threshold must be positive, got %d", threshold)
+	}
+	if len(roles) > maxWeightedRoles {
+		return nil, errors.Errorf("weighted policies are limited to %d roles, got %d", maxWeightedRoles, len(roles))
+	}
+
+	var total int32
+	uniform := true
+	for _, role := range roles {
+		if role.Weight < 1 {
+			return nil, errors.Errorf("weight of %s must be positive, got %d", role.OrgRole, role.Weight)
+		}
+		total += role.Weight
+		uniform = uniform && role.Weight == roles[0].Weight
+	}
+	if total < threshold {
+		return nil, errors.Errorf("threshold %d exceeds the total weight %d of the roles", threshold, total)
+	}
+
+	orgRoles := make([]OrgRole, len(roles))
+	for i, role := range roles {
+		orgRoles[i] = role.OrgRole
+	}
+
+	// With uniform weights, or when any role reaches the threshold, the
+	// policy is a plain N out of policy
+	if uniform {
+		weight := roles[0].Weight
+		return NOutOf((threshold+weight-1)/weight, orgRoles...), nil
+	}
+	if threshold <= minWeight(roles) {
+		return AnyOf(orgRoles...), nil
+	}
+
+	b := &envelopeBuilder{}
+	var rules []*cb.SignaturePolicy
+	for _, set := range minimalSets(threshold, roles) {
+		setRoles := make([]OrgRole, len(set))
+		for i, index := range set {
+			setRoles[i] = orgRoles[index]
+		}
+		rules = append(rules, b.nOutOf(int32(len(setRoles)), setRoles))
+	}
+	if len(rules) == 1 {
+		return b.envelope(rules[0]), nil
+	}
+
+	return b.envelope(&cb.SignaturePolicy{
+		Type: &cb.SignaturePolicy_NOutOf_{
+			NOutOf: &cb.SignaturePolicy_NOutOf{N: 1, Rules: rules},
+		},
+	}), nil
+}
+
+// minimalSets returns the indexes of the minimal sets of roles whose weights
+// sum up to the threshold or more, that is the sets from which no role can
+// be removed without going below the threshold.
+func minimalSets(threshold int32, roles []WeightedOrgRole) [][]int {
+	remaining := make([]int32, len(roles)+1)
+	for i := len(roles) - 1; i >= 0; i-- {
+		remaining[i] = remaining[i+1] + roles[i].Weight
+	}
+
+	var sets [][]int
+	var visit func(start int, set []int, sum, minWeight int32)
+	visit = func(start int, set []int, sum, minWeight int32) {
+		if sum >= threshold {
+			if sum-minWeight < threshold {
+				sets = append(sets, append([]int(nil), set...))
+			}
+			return
+		}
+		for i := start; i < len(roles); i++ {
+			if sum+remaining[i] < threshold {
+				return
+			}
+			weight := minWeight
+			if len(set) == 0 || roles[i].Weight < weight {
+				weight = roles[i].Weight
+			}
+			visit(i+1, append(set, i), sum+roles[i].Weight, weight)
+		}
+	}
+	visit(0, nil, 0, 0)
+
+	return sets
+}
+
+// envelopeBuilder accumulates the distinct principals referenced by the rules
+// of a signature policy.
+type envelopeBuilder struct {
+	principals []*msp.MSPPrincipal
+	indexes    map[OrgRole]int32
+}
+
+func (b *envelopeBuilder) signedBy(role OrgRole) *cb.SignaturePolicy {
+	if b.indexes == nil {
+		b.indexes = map[OrgRole]int32{}
+	}
+	index, ok := b.indexes[role]
+	if !ok {
+		index = int32(len(b.principals))
+		b.indexes[role] = index
+		b.principals = append(b.principals, &msp.MSPPrincipal{
+			PrincipalClassification: msp.MSPPrincipal_ROLE,
+			Principal:               protoutil.MarshalOrPanic(&msp.MSPRole{MspIdentifier: role.MSPID, Role: role.Role}),
+		})
+	}
+
+	return &cb.SignaturePolicy{
+		Type: &cb.SignaturePolicy_SignedBy{SignedBy: index},
+	}
+}
+
+func (b *envelopeBuilder) nOutOf(n int32, roles []OrgRole) *cb.SignaturePolicy {
+	rules := make([]*cb.SignaturePolicy, len(roles))
+	for i, role := range roles {
+		rules[i] = b.signedBy(role)
+	}
+
+	return &cb.SignaturePolicy{
+		Type: &cb.SignaturePolicy_NOutOf_{
+			NOutOf: &cb.SignaturePolicy_NOutOf{N: n, Rules: rules},
+		},
+	}
+}
+
+func (b *envelopeBuilder) envelope(rule *cb.SignaturePolicy) *cb.SignaturePolicyEnvelope {
+	return &cb.SignaturePolicyEnvelope{
+		Version:    0,
+		Rule:       rule,
+		Identities: b.principals,
+	}
+}
+
+// SignaturePolicyFromTemplate parses a policy template, which is a rule
+// followed by space separated roles, such as
+//
+//	ANY Org1.admin Org2.admin
+//	ALL Org1.peer Org2.peer
+//	MAJORITY Org1.admin Org2.admin Org3.admin
+//	3 OF Org1.admin:2 Org2.admin Org3.admin
+//
+// where a role may be suffixed with its weight, which defaults to 1.  ANY
+// requires a signature from any role, ALL and MAJORITY signatures from roles
+// whose weights sum up to, respectively, the total weight or more than half
+// of it, and N OF signatures from roles whose weights sum up to N.
+func SignaturePolicyFromTemplate(template string) (*cb.SignaturePolicyEnvelope, error) {
+	args := strings.Fields(template)
+	if len(args) < 2 {
+		return nil, errors.New("expected a rule followed by at least one role")
+	}
+
+	rule, roleArgs := args[0], args[1:]
+	n, err := strconv.ParseInt(rule, 10, 32)
+	isNOf := err == nil
+	if isNOf {
+		if len(args) < 3 || args[1] != "OF" {
+			return nil, errors.Errorf("expected '%s OF' followed by at least one role", rule)
+		}
+		roleArgs = args[2:]
+	}
+
+	roles := make([]WeightedOrgRole, len(roleArgs))
+	var total int32
+	for i, arg := range roleArgs {
+		role, err := weightedOrgRoleFromString(arg)
+		if err != nil {
+			return nil, err
+		}
+		roles[i] = role
+		total += role.Weight
+	}
+
+	var threshold int32
+	switch {
+	case isNOf:
+		threshold = int32(n)
+	case rule == "ANY":
+		threshold = minWeight(roles)
+	case rule == "ALL":
+		threshold = total
+	case rule == "MAJORITY":
+		threshold = total/2 + 1
+	default:
+		return nil, errors.Errorf("unknown rule '%s', expected ANY, ALL, MAJORITY or N OF", rule)
+	}
+
+	return WeightedNOutOf(threshold, roles...)
+}
+
+func minWeight(roles []WeightedOrgRole) int32 {
+	min := roles[0].Weight
+	for _, role := range roles[1:] {
+		if role.Weight < min {
+			min = role.Weight
+		}
+	}
+	return min
+}
+
+var roleNames = map[string]msp.MSPRole_MSPRoleType{
+	"member":  msp.MSPRole_MEMBER,
+	"admin":   msp.MSPRole_ADMIN,
+	"client":  msp.MSPRole_CLIENT,
+	"peer":    msp.MSPRole_PEER,
+	"orderer": msp.MSPRole_ORDERER,
+}
+
+func weightedOrgRoleFromString(input string) (WeightedOrgRole, error) {
+	role := WeightedOrgRole{Weight: 1}
+	if i := strings.LastIndex(input, ":"); i >= 0 {
+		weight, err := strconv.ParseInt(input[i+1:], 10, 32)
+		if err != nil {
+			return role, errors.Errorf("invalid weight in '%s'", input)
+		}
+		role.Weight = int32(weight)
+		input = input[:i]
+	}
+
+	i := strings.LastIndex(input, ".")
+	if i <= 0 {
+		return role, errors.Errorf("invalid role '%s', expected <MSP ID>.<role>", input)
+	}
+	roleType, ok := roleNames[input[i+1:]]
+	if !ok {
+		return role, errors.Errorf("unknown role '%s' in '%s', expected member, admin, client, peer or orderer", input[i+1:], input)
+	}
+	role.OrgRole = OrgRole{MSPID: input[:i], Role: roleType}
+
+	return role, nil
+}
+
+// StandardOrgPolicies returns the policies usually defined by an
+// organization: Readers and Writers satisfied by any member, Admins by any
+// admin, and Endorsement by any peer when nodeOUs is set, or by any member
+// otherwise.
+func StandardOrgPolicies(mspID string, nodeOUs bool) []*StandardConfigPolicy {
+	endorser := Member(mspID)
+	if nodeOUs {
+		endorser = Peer(mspID)
+	}
+
+	return []*StandardConfigPolicy{
+		SignaturePolicy("Readers", AnyOf(Member(mspID))),
+		SignaturePolicy("Writers", AnyOf(Member(mspID))),
+		SignaturePolicy("Admins", AnyOf(Admin(mspID))),
+		SignaturePolicy("Endorsement", AnyOf(endorser)),
+	}
+}
+
+// ImplicitMetaPolicy defines an implicit meta policy with key policyName
+// whose sub_policy is subPolicyName.
+func ImplicitMetaPolicy(policyName, subPolicyName string, rule cb.ImplicitMetaPolicy_Rule) *StandardConfigPolicy {
+	return &StandardConfigPolicy{
+		key:   policyName,
+		value: makeImplicitMetaPolicy(subPolicyName, rule),
+	}
+}
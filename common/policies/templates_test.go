@@ -0,0 +1,174 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package policies_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/cauthdsl"
+	"github.com/hyperledger/fabric/common/policies"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// describe renders the rule of the policy with its principals rather than
+// their indexes, as the builders do not repeat identical principals.
+func describe(t *testing.T, envelope *cb.SignaturePolicyEnvelope, rule *cb.SignaturePolicy) string {
+	switch r := rule.Type.(type) {
+	case *cb.SignaturePolicy_SignedBy:
+		role := &msp.MSPRole{}
+		require.NoError(t, proto.Unmarshal(envelope.Identities[r.SignedBy].Principal, role))
+		return fmt.Sprintf("'%s.%s'", role.MspIdentifier, role.Role)
+	case *cb.SignaturePolicy_NOutOf_:
+		var rules []string
+		for _, rule := range r.NOutOf.Rules {
+			rules = append(rules, describe(t, envelope, rule))
+		}
+		return fmt.Sprintf("OutOf(%d, %s)", r.NOutOf.N, strings.Join(rules, ", "))
+	}
+	t.Fatalf("unexpected rule %v", rule)
+	return ""
+}
+
+func assertSamePolicy(t *testing.T, expectedRule string, actual *cb.SignaturePolicyEnvelope) {
+	expected, err := cauthdsl.FromString(expectedRule)
+	require.NoError(t, err)
+	assert.Equal(t, describe(t, expected, expected.Rule), describe(t, actual, actual.Rule))
+}
+
+func TestOrgRoles(t *testing.T) {
+	assert.Equal(t, "Org1.member", policies.Member("Org1").String())
+	assert.Equal(t, "Org1.admin", policies.Admin("Org1").String())
+	assert.Equal(t, "Org1.client", policies.Client("Org1").String())
+	assert.Equal(t, "Org1.peer", policies.Peer("Org1").String())
+	assert.Equal(t, "Org1.orderer", policies.Orderer("Org1").String())
+}
+
+func TestSignaturePolicyBuilders(t *testing.T) {
+	a, b, c := policies.Admin("A"), policies.Admin("B"), policies.Peer("C")
+
+	assertSamePolicy(t, "OR('A.admin', 'B.admin', 'C.peer')", policies.AnyOf(a, b, c))
+	assertSamePolicy(t, "AND('A.admin', 'B.admin', 'C.peer')", policies.AllOf(a, b, c))
+	assertSamePolicy(t, "OutOf(2, 'A.admin', 'B.admin', 'C.peer')", policies.MajorityOf(a, b, c))
+	assertSamePolicy(t, "OutOf(3, 'A.admin', 'B.admin', 'C.peer', 'A.admin')", policies.MajorityOf(a, b, c, a))
+	assertSamePolicy(t, "OutOf(2, 'A.admin', 'B.admin')", policies.NOutOf(2, a, b))
+}
+
+func TestWeightedNOutOf(t *testing.T) {
+	a := policies.WeightedOrgRole{OrgRole: policies.Admin("A"), Weight: 2}
+	b := policies.WeightedOrgRole{OrgRole: policies.Admin("B"), Weight: 1}
+	c := policies.WeightedOrgRole{OrgRole: policies.Admin("C"), Weight: 1}
+
+	policy, err := policies.WeightedNOutOf(2, a, b, c)
+	require.NoError(t, err)
+	assertSamePolicy(t, "OR(AND('A.admin'), AND('B.admin', 'C.admin'))", policy)
+
+	policy, err = policies.WeightedNOutOf(3, a, b, c)
+	require.NoError(t, err)
+	assertSamePolicy(t, "OR(AND('A.admin', 'B.admin'), AND('A.admin', 'C.admin'))", policy)
+
+	policy, err = policies.WeightedNOutOf(4, a, b, c)
+	require.NoError(t, err)
+	assertSamePolicy(t, "AND('A.admin', 'B.admin', 'C.admin')", policy)
+
+	a.Weight = 1
+	policy, err = policies.WeightedNOutOf(2, a, b, c)
+	require.NoError(t, err)
+	assertSamePolicy(t, "OutOf(2, 'A.admin', 'B.admin', 'C.admin')", policy)
+
+	_, err = policies.WeightedNOutOf(0, a, b, c)
+	assert.EqualError(t, err, "threshold must be positive, got 0")
+	_, err = policies.WeightedNOutOf(4, a, b, c)
+	assert.EqualError(t, err, "threshold 4 exceeds the total weight 3 of the roles")
+	c.Weight = 0
+	_, err = policies.WeightedNOutOf(1, a, b, c)
+	assert.EqualError(t, err, "weight of C.admin must be positive, got 0")
+	_, err = policies.WeightedNOutOf(1, make([]policies.WeightedOrgRole, 17)...)
+	assert.EqualError(t, err, "weighted policies are limited to 16 roles, got 17")
+}
+
+func TestSignaturePolicyFromTemplate(t *testing.T) {
+	for _, tc := range []struct {
+		template string
+		expected string
+	}{
+		{"ANY Org1.admin Org2.admin", "OR('Org1.admin', 'Org2.admin')"},
+		{"ANY Org1.admin:2 Org2.admin:3", "OR('Org1.admin', 'Org2.admin')"},
+		{"ALL Org1.peer Org2.peer", "AND('Org1.peer', 'Org2.peer')"},
+		{"MAJORITY Org1.member Org2.member Org3.member Org4.member", "OutOf(3, 'Org1.member', 'Org2.member', 'Org3.member', 'Org4.member')"},
+		{"MAJORITY Org1.admin:2 Org2.admin Org3.admin", "OR(AND('Org1.admin', 'Org2.admin'), AND('Org1.admin', 'Org3.admin'))"},
+		{"2 OF Org1.admin:2 Org2.admin Org3.admin", "OR(AND('Org1.admin'), AND('Org2.admin', 'Org3.admin'))"},
+		{"1 OF My.Org.client", "OR('My.Org.client')"},
+	} {
+		t.Run(tc.template, func(t *testing.T) {
+			policy, err := policies.SignaturePolicyFromTemplate(tc.template)
+			require.NoError(t, err)
+			assertSamePolicy(t, tc.expected, policy)
+		})
+	}
+
+	for _, tc := range []struct {
+		template string
+		err      string
+	}{
+		{"ANY", "expected a rule followed by at least one role"},
+		{"2 Org1.admin Org2.admin", "expected '2 OF' followed by at least one role"},
+		{"SOME Org1.admin", "unknown rule 'SOME', expected ANY, ALL, MAJORITY or N OF"},
+		{"ANY Org1", "invalid role 'Org1', expected <MSP ID>.<role>"},
+		{"ANY Org1.owner", "unknown role 'owner' in 'Org1.owner', expected member, admin, client, peer or orderer"},
+		{"ANY Org1.admin:x", "invalid weight in 'Org1.admin:x'"},
+		{"3 OF Org1.admin Org2.admin", "threshold 3 exceeds the total weight 2 of the roles"},
+	} {
+		t.Run(tc.template, func(t *testing.T) {
+			_, err := policies.SignaturePolicyFromTemplate(tc.template)
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestStandardOrgPolicies(t *testing.T) {
+	orgPolicies := policies.StandardOrgPolicies("Org1", true)
+	require.Len(t, orgPolicies, 4)
+
+	expected := map[string]string{
+		"Readers":     "OR('Org1.member')",
+		"Writers":     "OR('Org1.member')",
+		"Admins":      "OR('Org1.admin')",
+		"Endorsement": "OR('Org1.peer')",
+	}
+	for _, policy := range orgPolicies {
+		assert.Equal(t, int32(cb.Policy_SIGNATURE), policy.Value().Type)
+		envelope := &cb.SignaturePolicyEnvelope{}
+		require.NoError(t, proto.Unmarshal(policy.Value().Value, envelope))
+		assertSamePolicy(t, expected[policy.Key()], envelope)
+	}
+
+	endorsement := policies.StandardOrgPolicies("Org1", false)[3]
+	envelope := &cb.SignaturePolicyEnvelope{}
+	require.NoError(t, proto.Unmarshal(endorsement.Value().Value, envelope))
+	principal := &msp.MSPRole{}
+	require.NoError(t, proto.Unmarshal(envelope.Identities[0].Principal, principal))
+	assert.Equal(t, msp.MSPRole_MEMBER, principal.Role)
+}
+
+func TestImplicitMetaPolicy(t *testing.T) {
+	policy := policies.ImplicitMetaPolicy("LifecycleEndorsement", "Endorsement", cb.ImplicitMetaPolicy_MAJORITY)
+	assert.Equal(t, "LifecycleEndorsement", policy.Key())
+	assert.Equal(t, &cb.Policy{
+		Type: int32(cb.Policy_IMPLICIT_META),
+		Value: protoutil.MarshalOrPanic(&cb.ImplicitMetaPolicy{
+			SubPolicy: "Endorsement",
+			Rule:      cb.ImplicitMetaPolicy_MAJORITY,
+		}),
+	}, policy.Value())
+}
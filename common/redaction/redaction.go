@@ -0,0 +1,132 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package redaction keeps the values of the chaincode input args, which
+// frequently contain personal data, out of the logs, the metrics labels and
+// the error messages of the peer.
+package redaction
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Mode determines how the values of the chaincode input args are rendered.
+type Mode string
+
+const (
+	// None renders the args as they are.
+	None Mode = "none"
+	// Redact replaces each arg with a placeholder.
+	Redact Mode = "redact"
+	// Hash replaces each arg with a prefix of its SHA256 hash, so that the
+	// occurrences of the same value can still be correlated.
+	Hash Mode = "hash"
+)
+
+// Placeholder replaces the args in Redact mode.
+const Placeholder = "<redacted>"
+
+// minScrubLength is the length below which arg values are not scrubbed from
+// messages, as short values such as "a" or "10" would mangle any message
+// without hiding anything of interest.
+const minScrubLength = 3
+
+// ParseMode returns the mode named by s. The empty string is None.
+func ParseMode(s string) (Mode, error) {
+	switch Mode(strings.ToLower(s)) {
+	case "", None:
+		return None, nil
+	case Redact:
+		return Redact, nil
+	case Hash:
+		return Hash, nil
+	default:
+		return "", errors.Errorf("unknown args redaction mode '%s', expected one of '%s', '%s' or '%s'", s, None, Redact, Hash)
+	}
+}
+
+// Render returns how the value of an arg appears in Redact or Hash mode.
+func (m Mode) Render(arg []byte) string {
+	switch m {
+	case Redact:
+		return Placeholder
+	case Hash:
+		hash := sha256.Sum256(arg)
+		return "sha256:" + hex.EncodeToString(hash[:8])
+	default:
+		return string(arg)
+	}
+}
+
+// Args renders the args of a chaincode invocation. The function name, which
+// is the first arg, is kept as it is.
+func (m Mode) Args(args [][]byte) string {
+	rendered := make([]string, len(args))
+	for i, arg := range args {
+		if i == 0 {
+			rendered[i] = string(arg)
+			continue
+		}
+		rendered[i] = m.Render(arg)
+	}
+	return "[" + strings.Join(rendered, " ") + "]"
+}
+
+// Message scrubs the values of the args, except the function name, from a
+// message, such as the error returned by a chaincode, which may embed them.
+func (m Mode) Message(msg string, args [][]byte) string {
+	if m == None || len(args) < 2 {
+		return msg
+	}
+	values := make([][]byte, 0, len(args)-1)
+	for _, arg := range args[1:] {
+		if len(arg) >= minScrubLength {
+			values = append(values, arg)
+		}
+	}
+	// scrub the longest values first, so that values which contain other
+	// values are not left partially in the message
+	sort.SliceStable(values, func(i, j int) bool { return len(values[i]) > len(values[j]) })
+	for _, value := range values {
+		msg = strings.Replace(msg, string(value), m.Render(value), -1)
+	}
+	return msg
+}
+
+var (
+	globalMutex sync.RWMutex
+	global      = None
+)
+
+// Configure sets the process wide redaction mode.
+func Configure(mode Mode) {
+	globalMutex.Lock()
+	defer globalMutex.Unlock()
+	global = mode
+}
+
+// CurrentMode returns the process wide redaction mode.
+func CurrentMode() Mode {
+	globalMutex.RLock()
+	defer globalMutex.RUnlock()
+	return global
+}
+
+// Args renders the args of a chaincode invocation with the process wide mode.
+func Args(args [][]byte) string {
+	return CurrentMode().Args(args)
+}
+
+// Message scrubs the args from a message with the process wide mode.
+func Message(msg string, args [][]byte) string {
+	return CurrentMode().Message(msg, args)
+}
@@ -0,0 +1,63 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package redaction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMode(t *testing.T) {
+	for s, expected := range map[string]Mode{
+		"":       None,
+		"none":   None,
+		"redact": Redact,
+		"Hash":   Hash,
+	} {
+		mode, err := ParseMode(s)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, mode)
+	}
+
+	_, err := ParseMode("encrypt")
+	assert.EqualError(t, err, "unknown args redaction mode 'encrypt', expected one of 'none', 'redact' or 'hash'")
+}
+
+func TestArgs(t *testing.T) {
+	args := [][]byte{[]byte("transfer"), []byte("alice"), []byte("bob"), []byte("100")}
+
+	assert.Equal(t, "[transfer alice bob 100]", None.Args(args))
+	assert.Equal(t, "[transfer <redacted> <redacted> <redacted>]", Redact.Args(args))
+	assert.Equal(t, "[transfer sha256:2bd806c97f0e00af sha256:81b637d8fcd2c6da sha256:ad57366865126e55]", Hash.Args(args))
+	assert.Equal(t, "[]", Redact.Args(nil))
+}
+
+func TestMessage(t *testing.T) {
+	args := [][]byte{[]byte("transfer"), []byte("alice"), []byte("alice@example.com"), []byte("10")}
+	msg := "transfer failed: no account for alice@example.com, owner alice has 10 tokens"
+
+	assert.Equal(t, msg, None.Message(msg, args))
+	assert.Equal(t, "transfer failed: no account for <redacted>, owner <redacted> has 10 tokens", Redact.Message(msg, args))
+	assert.Equal(t, "transfer failed: no account for sha256:ff8d9819fc0e12bf, owner sha256:2bd806c97f0e00af has 10 tokens", Hash.Message(msg, args))
+
+	// the function name is kept
+	assert.Equal(t, "transfer failed", Redact.Message("transfer failed", [][]byte{[]byte("transfer")}))
+}
+
+func TestGlobal(t *testing.T) {
+	defer Configure(None)
+	args := [][]byte{[]byte("put"), []byte("secret")}
+
+	assert.Equal(t, None, CurrentMode())
+	assert.Equal(t, "[put secret]", Args(args))
+
+	Configure(Redact)
+	assert.Equal(t, Redact, CurrentMode())
+	assert.Equal(t, "[put <redacted>]", Args(args))
+	assert.Equal(t, "bad value <redacted>", Message("bad value secret", args))
+}
@@ -52,6 +52,10 @@ const (
 
 	// ImplicitMetaPolicyType is the 'Type' string for implicit meta policies
 	ImplicitMetaPolicyType = "ImplicitMeta"
+
+	// TemplatePolicyType is the 'Type' string for signature policies defined
+	// by a policy template, such as "MAJORITY Org1.admin Org2.admin Org3.admin"
+	TemplatePolicyType = "Template"
 )
 
 func addValue(cg *cb.ConfigGroup, value channelconfig.ConfigValue, modPolicy string) {
@@ -106,6 +110,18 @@ func AddPolicies(cg *cb.ConfigGroup, policyMap map[string]*genesisconfig.Policy,
 					Value: protoutil.MarshalOrPanic(sp),
 				},
 			}
+		case TemplatePolicyType:
+			sp, err := policies.SignaturePolicyFromTemplate(policy.Rule)
+			if err != nil {
+				return errors.Wrapf(err, "invalid policy template '%s'", policy.Rule)
+			}
+			cg.Policies[policyName] = &cb.ConfigPolicy{
+				ModPolicy: modPolicy,
+				Policy: &cb.Policy{
+					Type:  int32(cb.Policy_SIGNATURE),
+					Value: protoutil.MarshalOrPanic(sp),
+				},
+			}
 		default:
 			return errors.Errorf("unknown policy type: %s", policy.Type)
 		}
@@ -174,9 +190,13 @@ func NewOrdererGroup(conf *genesisconfig.Orderer) (*cb.ConfigGroup, error) {
 	if err := AddPolicies(ordererGroup, conf.Policies, channelconfig.AdminsPolicyKey); err != nil {
 		return nil, errors.Wrapf(err, "error adding policies to orderer group")
 	}
-	ordererGroup.Policies[BlockValidationPolicyKey] = &cb.ConfigPolicy{
-		Policy:    policies.ImplicitMetaAnyPolicy(channelconfig.WritersPolicyKey).Value(),
-		ModPolicy: channelconfig.AdminsPolicyKey,
+	// The block validation policy may be defined explicitly, for instance to require
+	// the signatures of several orderers on every block, and defaults to any writer
+	if _, ok := ordererGroup.Policies[BlockValidationPolicyKey]; !ok {
+		ordererGroup.Policies[BlockValidationPolicyKey] = &cb.ConfigPolicy{
+			Policy:    policies.ImplicitMetaAnyPolicy(channelconfig.WritersPolicyKey).Value(),
+			ModPolicy: channelconfig.AdminsPolicyKey,
+		}
 	}
 	addValue(ordererGroup, channelconfig.BatchSizeValue(
 		conf.BatchSize.MaxMessageCount,
@@ -240,6 +260,10 @@ func NewOrdererOrgGroup(conf *genesisconfig.Organization) (*cb.ConfigGroup, erro
 
 	addValue(ordererOrgGroup, channelconfig.MSPValue(mspConfig), channelconfig.AdminsPolicyKey)
 
+	if len(conf.OrdererEndpoints) > 0 {
+		addValue(ordererOrgGroup, channelconfig.EndpointsValue(conf.OrdererEndpoints), channelconfig.AdminsPolicyKey)
+	}
+
 	return ordererOrgGroup, nil
 }
 
@@ -259,6 +283,10 @@ func NewApplicationGroup(conf *genesisconfig.Application) (*cb.ConfigGroup, erro
 		addValue(applicationGroup, channelconfig.CapabilitiesValue(conf.Capabilities), channelconfig.AdminsPolicyKey)
 	}
 
+	if conf.InvocationQuota != nil {
+		addValue(applicationGroup, channelconfig.InvocationQuotaValue(conf.InvocationQuota.MaxInvocations, conf.InvocationQuota.BlockInterval), channelconfig.AdminsPolicyKey)
+	}
+
 	for _, org := range conf.Organizations {
 		var err error
 		applicationGroup.Groups[org.Name], err = NewApplicationOrgGroup(org)
@@ -306,6 +334,10 @@ func NewApplicationOrgGroup(conf *genesisconfig.Organization) (*cb.ConfigGroup,
 		addValue(applicationOrgGroup, channelconfig.AnchorPeersValue(anchorProtos), channelconfig.AdminsPolicyKey)
 	}
 
+	if len(conf.PeerEndpoints) > 0 {
+		addValue(applicationOrgGroup, channelconfig.PeerEndpointsValue(conf.PeerEndpoints), channelconfig.AdminsPolicyKey)
+	}
+
 	return applicationOrgGroup, nil
 }
 
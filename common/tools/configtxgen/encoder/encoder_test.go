@@ -13,6 +13,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/cauthdsl"
 	"github.com/hyperledger/fabric/common/tools/configtxgen/encoder"
 	"github.com/hyperledger/fabric/common/tools/configtxgen/encoder/mock"
 	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
@@ -20,6 +21,7 @@ import (
 	cb "github.com/hyperledger/fabric/protos/common"
 	ab "github.com/hyperledger/fabric/protos/orderer"
 	"github.com/hyperledger/fabric/protos/orderer/etcdraft"
+	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/hyperledger/fabric/protoutil"
 )
 
@@ -129,6 +131,34 @@ var _ = Describe("Encoder", func() {
 			})
 		})
 
+		Context("when the policy is defined by a template", func() {
+			BeforeEach(func() {
+				policies["Readers"].Type = "Template"
+				policies["Readers"].Rule = "MAJORITY Org1.member Org2.member Org3.member"
+			})
+
+			It("adds the equivalent signature policy", func() {
+				err := encoder.AddPolicies(cg, policies, "Admins")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cg.Policies["Readers"].Policy.Type).To(Equal(int32(cb.Policy_SIGNATURE)))
+				expected, err := cauthdsl.FromString("OutOf(2, 'Org1.member', 'Org2.member', 'Org3.member')")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cg.Policies["Readers"].Policy.Value).To(Equal(protoutil.MarshalOrPanic(expected)))
+			})
+		})
+
+		Context("when the policy template is bad", func() {
+			BeforeEach(func() {
+				policies["Readers"].Type = "Template"
+				policies["Readers"].Rule = "garbage"
+			})
+
+			It("wraps and returns the error", func() {
+				err := encoder.AddPolicies(cg, policies, "Readers")
+				Expect(err).To(MatchError("invalid policy template 'garbage': expected a rule followed by at least one role"))
+			})
+		})
+
 		Context("when the policy type is unknown", func() {
 			BeforeEach(func() {
 				policies["Readers"].Type = "garbage"
@@ -279,6 +309,22 @@ var _ = Describe("Encoder", func() {
 			Expect(cg.Values["Capabilities"]).NotTo(BeNil())
 		})
 
+		Context("when the block validation policy is defined", func() {
+			BeforeEach(func() {
+				conf.Policies["BlockValidation"] = &genesisconfig.Policy{
+					Type: "Signature",
+					Rule: "OutOf(2, 'SampleMSP.member', 'SampleMSP.member', 'SampleMSP.member')",
+				}
+			})
+
+			It("uses it instead of the default one", func() {
+				cg, err := encoder.NewOrdererGroup(conf)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(cg.Policies)).To(Equal(4))
+				Expect(cg.Policies["BlockValidation"].Policy.Type).To(Equal(int32(cb.Policy_SIGNATURE)))
+			})
+		})
+
 		Context("when the policy definition is bad", func() {
 			BeforeEach(func() {
 				conf.Policies["Admins"].Rule = "garbage"
@@ -406,6 +452,25 @@ var _ = Describe("Encoder", func() {
 			Expect(cg.Values["Capabilities"]).NotTo(BeNil())
 		})
 
+		Context("when an invocation quota is set", func() {
+			BeforeEach(func() {
+				conf.InvocationQuota = &genesisconfig.InvocationQuota{MaxInvocations: 100, BlockInterval: 10}
+			})
+
+			It("adds the invocation quota to the config group", func() {
+				cg, err := encoder.NewApplicationGroup(conf)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(cg.Values)).To(Equal(3))
+				Expect(cg.Values["InvocationQuota"]).NotTo(BeNil())
+				Expect(cg.Values["InvocationQuota"].ModPolicy).To(Equal("Admins"))
+				quota := &pb.InvocationQuota{}
+				err = proto.Unmarshal(cg.Values["InvocationQuota"].Value, quota)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(quota.MaxInvocations).To(Equal(uint32(100)))
+				Expect(quota.BlockInterval).To(Equal(uint64(10)))
+			})
+		})
+
 		Context("when the policy definition is bad", func() {
 			BeforeEach(func() {
 				conf.Policies["Admins"].Rule = "garbage"
@@ -455,6 +520,24 @@ var _ = Describe("Encoder", func() {
 			Expect(cg.Policies["Writers"]).NotTo(BeNil())
 		})
 
+		Context("when orderer endpoints are defined", func() {
+			BeforeEach(func() {
+				conf.OrdererEndpoints = []string{"orderer0:7050", "orderer1:7050"}
+			})
+
+			It("encodes the orderer endpoints", func() {
+				cg, err := encoder.NewOrdererOrgGroup(conf)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(cg.Values)).To(Equal(2))
+				Expect(cg.Values["Endpoints"]).NotTo(BeNil())
+				Expect(cg.Values["Endpoints"].ModPolicy).To(Equal("Admins"))
+				endpoints := &cb.OrdererAddresses{}
+				err = proto.Unmarshal(cg.Values["Endpoints"].Value, endpoints)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(endpoints.Addresses).To(Equal([]string{"orderer0:7050", "orderer1:7050"}))
+			})
+		})
+
 		Context("when the org is marked to be skipped as foreign", func() {
 			BeforeEach(func() {
 				conf.SkipAsForeign = true
@@ -596,6 +679,24 @@ var _ = Describe("Encoder", func() {
 				Expect(cg.Values["AnchorPeers"]).To(BeNil())
 			})
 		})
+
+		Context("when peer endpoints are defined", func() {
+			BeforeEach(func() {
+				conf.PeerEndpoints = []string{"peer0:7051", "peer1:7051"}
+			})
+
+			It("encodes the peer endpoints", func() {
+				cg, err := encoder.NewApplicationOrgGroup(conf)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(cg.Values)).To(Equal(3))
+				Expect(cg.Values["PeerEndpoints"]).NotTo(BeNil())
+				Expect(cg.Values["PeerEndpoints"].ModPolicy).To(Equal("Admins"))
+				peerEndpoints := &pb.PeerEndpoints{}
+				err = proto.Unmarshal(cg.Values["PeerEndpoints"].Value, peerEndpoints)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(peerEndpoints.Endpoints).To(Equal([]string{"peer0:7051", "peer1:7051"}))
+			})
+		})
 	})
 
 	Describe("ChannelCreationOperations", func() {
@@ -0,0 +1,150 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package encoder
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/common/configtx"
+	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
+	"github.com/hyperledger/fabric/common/tools/configtxlator/update"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+)
+
+// NewOrganization returns the definition of an organization whose MSP ID is
+// its name, and whose MSP is loaded from the given directory.  Its Readers,
+// Writers and Endorsement policies are satisfied by any member of the MSP, and
+// its Admins policy by any admin, as for the SampleOrg of configtx.yaml.
+func NewOrganization(name, mspDir string) *genesisconfig.Organization {
+	member := fmt.Sprintf("OR('%s.member')", name)
+	return &genesisconfig.Organization{
+		Name:    name,
+		ID:      name,
+		MSPDir:  mspDir,
+		MSPType: "bccsp",
+		Policies: map[string]*genesisconfig.Policy{
+			channelconfig.ReadersPolicyKey: {Type: SignaturePolicyType, Rule: member},
+			channelconfig.WritersPolicyKey: {Type: SignaturePolicyType, Rule: member},
+			channelconfig.AdminsPolicyKey:  {Type: SignaturePolicyType, Rule: fmt.Sprintf("OR('%s.admin')", name)},
+			"Endorsement":                  {Type: SignaturePolicyType, Rule: member},
+		},
+	}
+}
+
+// AddApplicationOrgConfigUpdate computes the config update which adds the
+// organization to the application group of the channel whose latest config
+// block is given.  The config update is set with the ID of the channel.
+func AddApplicationOrgConfigUpdate(configBlock *cb.Block, org *genesisconfig.Organization) (*cb.ConfigUpdate, error) {
+	channelID, original, err := configFromBlock(configBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := proto.Clone(original).(*cb.Config)
+	applicationGroup, ok := updated.ChannelGroup.Groups[channelconfig.ApplicationGroupKey]
+	if !ok {
+		return nil, errors.Errorf("channel %s has no application group", channelID)
+	}
+	if _, ok := applicationGroup.Groups[org.Name]; ok {
+		return nil, errors.Errorf("org %s is already a member of channel %s", org.Name, channelID)
+	}
+
+	applicationGroup.Groups[org.Name], err = NewApplicationOrgGroup(org)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create application org %s", org.Name)
+	}
+
+	return orgAdditionConfigUpdate(channelID, original, updated)
+}
+
+// AddConsortiumOrgConfigUpdate computes the config update which adds the
+// organization to the given consortium of the orderer system channel whose
+// latest config block is given.  The config update is set with the ID of the
+// system channel.
+func AddConsortiumOrgConfigUpdate(configBlock *cb.Block, consortium string, org *genesisconfig.Organization) (*cb.ConfigUpdate, error) {
+	channelID, original, err := configFromBlock(configBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := proto.Clone(original).(*cb.Config)
+	consortiumsGroup, ok := updated.ChannelGroup.Groups[channelconfig.ConsortiumsGroupKey]
+	if !ok {
+		return nil, errors.Errorf("channel %s has no consortiums group", channelID)
+	}
+	consortiumGroup, ok := consortiumsGroup.Groups[consortium]
+	if !ok {
+		return nil, errors.Errorf("consortium %s does not exist in channel %s", consortium, channelID)
+	}
+	if _, ok := consortiumGroup.Groups[org.Name]; ok {
+		return nil, errors.Errorf("org %s is already a member of consortium %s", org.Name, consortium)
+	}
+
+	// Note, NewOrdererOrgGroup is correct here, as the structure is identical
+	consortiumGroup.Groups[org.Name], err = NewOrdererOrgGroup(org)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create consortium org %s", org.Name)
+	}
+
+	return orgAdditionConfigUpdate(channelID, original, updated)
+}
+
+// orgAdditionConfigUpdate validates the config with the added org, so that an
+// invalid org definition is reported before the update is submitted, and
+// computes the update.
+func orgAdditionConfigUpdate(channelID string, original, updated *cb.Config) (*cb.ConfigUpdate, error) {
+	if errs := channelconfig.ValidateConfigUpdate(original, updated); errs != nil {
+		return nil, errors.Wrap(errs, "config with the added org is not valid")
+	}
+
+	configUpdate, err := update.Compute(original, updated)
+	if err != nil {
+		return nil, errors.WithMessage(err, "could not compute update")
+	}
+	configUpdate.ChannelId = channelID
+
+	return configUpdate, nil
+}
+
+// configFromBlock returns the ID of the channel and the config contained in
+// the config block.
+func configFromBlock(block *cb.Block) (string, *cb.Config, error) {
+	if block == nil || block.Data == nil || len(block.Data.Data) == 0 {
+		return "", nil, errors.New("block contains no data")
+	}
+	envelope, err := protoutil.ExtractEnvelope(block, 0)
+	if err != nil {
+		return "", nil, errors.WithMessage(err, "failed to extract envelope from block")
+	}
+	payload, err := protoutil.UnmarshalPayload(envelope.Payload)
+	if err != nil {
+		return "", nil, errors.WithMessage(err, "failed to unmarshal payload from envelope")
+	}
+	if payload.Header == nil {
+		return "", nil, errors.New("envelope header cannot be nil")
+	}
+	chdr, err := protoutil.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	if err != nil {
+		return "", nil, errors.WithMessage(err, "failed to unmarshal channel header")
+	}
+	if cb.HeaderType(chdr.Type) != cb.HeaderType_CONFIG {
+		return "", nil, errors.Errorf("block is not a config block, its transaction is of type %s", cb.HeaderType(chdr.Type))
+	}
+	configEnvelope, err := configtx.UnmarshalConfigEnvelope(payload.Data)
+	if err != nil {
+		return "", nil, errors.WithMessage(err, "failed to unmarshal config envelope from payload")
+	}
+	if configEnvelope.Config == nil || configEnvelope.Config.ChannelGroup == nil {
+		return "", nil, errors.New("config envelope contains no channel group")
+	}
+
+	return chdr.ChannelId, configEnvelope.Config, nil
+}
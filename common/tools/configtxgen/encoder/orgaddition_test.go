@@ -0,0 +1,154 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package encoder_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/common/tools/configtxgen/configtxgentest"
+	"github.com/hyperledger/fabric/common/tools/configtxgen/encoder"
+	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protoutil"
+)
+
+var _ = Describe("OrgAddition", func() {
+	var (
+		configBlock *cb.Block
+		org         *genesisconfig.Organization
+	)
+
+	BeforeEach(func() {
+		configBlock = encoder.New(configtxgentest.Load(genesisconfig.SampleDevModeSoloProfile)).GenesisBlockForChannel("foo")
+		org = encoder.NewOrganization("Org2", "../../../../sampleconfig/msp")
+	})
+
+	Describe("NewOrganization", func() {
+		It("uses the name as MSP ID and defines the standard policies", func() {
+			Expect(org.Name).To(Equal("Org2"))
+			Expect(org.ID).To(Equal("Org2"))
+			Expect(org.MSPType).To(Equal("bccsp"))
+			Expect(org.Policies).To(HaveLen(4))
+			Expect(org.Policies[channelconfig.ReadersPolicyKey].Rule).To(Equal("OR('Org2.member')"))
+			Expect(org.Policies[channelconfig.AdminsPolicyKey].Rule).To(Equal("OR('Org2.admin')"))
+			Expect(org.Policies["Endorsement"].Type).To(Equal(encoder.SignaturePolicyType))
+		})
+	})
+
+	Describe("AddApplicationOrgConfigUpdate", func() {
+		It("adds the org to the application group", func() {
+			configUpdate, err := encoder.AddApplicationOrgConfigUpdate(configBlock, org)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(configUpdate.ChannelId).To(Equal("foo"))
+
+			application := configUpdate.WriteSet.Groups[channelconfig.ApplicationGroupKey]
+			Expect(application.Version).To(Equal(uint64(1)))
+			Expect(application.Groups).To(HaveKey("Org2"))
+			Expect(application.Groups["Org2"].Values).To(HaveKey(channelconfig.MSPKey))
+			Expect(application.Groups["Org2"].Policies).To(HaveKey("Endorsement"))
+			Expect(configUpdate.ReadSet.Groups[channelconfig.ApplicationGroupKey].Groups).NotTo(HaveKey("Org2"))
+		})
+
+		Context("when the org is already a member of the channel", func() {
+			BeforeEach(func() {
+				org.Name = "SampleOrg"
+			})
+
+			It("returns an error", func() {
+				_, err := encoder.AddApplicationOrgConfigUpdate(configBlock, org)
+				Expect(err).To(MatchError("org SampleOrg is already a member of channel foo"))
+			})
+		})
+
+		Context("when the channel has no application group", func() {
+			BeforeEach(func() {
+				configBlock = encoder.New(configtxgentest.Load(genesisconfig.SampleSingleMSPSoloProfile)).GenesisBlockForChannel("foo")
+			})
+
+			It("returns an error", func() {
+				_, err := encoder.AddApplicationOrgConfigUpdate(configBlock, org)
+				Expect(err).To(MatchError("channel foo has no application group"))
+			})
+		})
+
+		Context("when the MSP of the org cannot be loaded", func() {
+			BeforeEach(func() {
+				org.MSPDir = "garbage"
+			})
+
+			It("returns an error", func() {
+				_, err := encoder.AddApplicationOrgConfigUpdate(configBlock, org)
+				Expect(err).To(MatchError(ContainSubstring("failed to create application org Org2")))
+			})
+		})
+
+		Context("when the org has no MSP ID", func() {
+			BeforeEach(func() {
+				org.ID = ""
+			})
+
+			It("returns an error", func() {
+				_, err := encoder.AddApplicationOrgConfigUpdate(configBlock, org)
+				Expect(err).To(MatchError("config with the added org is not valid: /Channel/Application/Org2/MSP: invalid MSP definition: MSP for org Org2 has empty MSP ID"))
+			})
+		})
+
+		Context("when the block is not a config block", func() {
+			BeforeEach(func() {
+				env, err := protoutil.CreateSignedEnvelope(cb.HeaderType_ENDORSER_TRANSACTION, "foo", nil, &cb.Envelope{}, 0, 0)
+				Expect(err).NotTo(HaveOccurred())
+				configBlock = &cb.Block{Data: &cb.BlockData{Data: [][]byte{protoutil.MarshalOrPanic(env)}}}
+			})
+
+			It("returns an error", func() {
+				_, err := encoder.AddApplicationOrgConfigUpdate(configBlock, org)
+				Expect(err).To(MatchError("block is not a config block, its transaction is of type ENDORSER_TRANSACTION"))
+			})
+		})
+
+		Context("when the block contains no data", func() {
+			It("returns an error", func() {
+				_, err := encoder.AddApplicationOrgConfigUpdate(&cb.Block{}, org)
+				Expect(err).To(MatchError("block contains no data"))
+			})
+		})
+	})
+
+	Describe("AddConsortiumOrgConfigUpdate", func() {
+		It("adds the org to the consortium", func() {
+			configUpdate, err := encoder.AddConsortiumOrgConfigUpdate(configBlock, genesisconfig.SampleConsortiumName, org)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(configUpdate.ChannelId).To(Equal("foo"))
+
+			consortium := configUpdate.WriteSet.Groups[channelconfig.ConsortiumsGroupKey].Groups[genesisconfig.SampleConsortiumName]
+			Expect(consortium.Version).To(Equal(uint64(1)))
+			Expect(consortium.Groups).To(HaveKey("Org2"))
+			Expect(consortium.Groups["Org2"].Values).To(HaveKey(channelconfig.MSPKey))
+			Expect(consortium.Groups["Org2"].Values).NotTo(HaveKey(channelconfig.AnchorPeersKey))
+		})
+
+		Context("when the org is already a member of the consortium", func() {
+			BeforeEach(func() {
+				org.Name = "SampleOrg"
+			})
+
+			It("returns an error", func() {
+				_, err := encoder.AddConsortiumOrgConfigUpdate(configBlock, genesisconfig.SampleConsortiumName, org)
+				Expect(err).To(MatchError("org SampleOrg is already a member of consortium SampleConsortium"))
+			})
+		})
+
+		Context("when the consortium does not exist", func() {
+			It("returns an error", func() {
+				_, err := encoder.AddConsortiumOrgConfigUpdate(configBlock, "Missing", org)
+				Expect(err).To(MatchError("consortium Missing does not exist in channel foo"))
+			})
+		})
+	})
+})
@@ -0,0 +1,102 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/tools/protolator"
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// The formats in which configtxgen prints the artifacts it inspects.
+const (
+	// JSONFormat is the JSON of the protolator, with the fields in the order
+	// of their definition in the messages.
+	JSONFormat = "json"
+	// CanonicalJSONFormat is the canonical JSON of the protolator, with the keys of
+	// all objects sorted, so that equal artifacts are printed identically and
+	// can be compared with text tools.
+	CanonicalJSONFormat = "canonical-json"
+	// YAMLFormat is the YAML equivalent of the canonical JSON.
+	YAMLFormat = "yaml"
+)
+
+func validateFormat(format string) error {
+	switch format {
+	case JSONFormat, CanonicalJSONFormat, YAMLFormat:
+		return nil
+	default:
+		return errors.Errorf("unknown output format '%s', must be one of %s, %s or %s", format, JSONFormat, CanonicalJSONFormat, YAMLFormat)
+	}
+}
+
+// writeMessage writes the message, with its nested messages decoded, to the
+// writer in the given format.
+func writeMessage(w io.Writer, msg proto.Message, format string) error {
+	switch format {
+	case JSONFormat:
+		return protolator.DeepMarshalJSON(w, msg)
+	case CanonicalJSONFormat:
+		out, err := protolator.MarshalCanonicalJSON(msg)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(out)
+		return err
+	case YAMLFormat:
+		buf := &bytes.Buffer{}
+		if err := protolator.DeepMarshalJSON(buf, msg); err != nil {
+			return err
+		}
+		var doc interface{}
+		decoder := json.NewDecoder(buf)
+		decoder.UseNumber()
+		if err := decoder.Decode(&doc); err != nil {
+			return errors.Wrap(err, "could not decode JSON")
+		}
+		out, err := yaml.Marshal(yamlValue(doc))
+		if err != nil {
+			return errors.Wrap(err, "could not encode YAML")
+		}
+		_, err = w.Write(out)
+		return err
+	default:
+		return validateFormat(format)
+	}
+}
+
+// yamlValue converts the numbers of the decoded JSON document to integers or
+// floats, so that they are not encoded as strings in YAML.
+func yamlValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v.String()
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = yamlValue(value)
+		}
+		return v
+	case []interface{}:
+		for i, value := range v {
+			v[i] = yamlValue(value)
+		}
+		return v
+	default:
+		return v
+	}
+}
@@ -121,6 +121,16 @@ type Application struct {
 	Resources     *Resources         `yaml:"Resources"`
 	Policies      map[string]*Policy `yaml:"Policies"`
 	ACLs          map[string]string  `yaml:"ACLs"`
+	// InvocationQuota limits the number of proposals endorsed for each
+	// client identity within an interval of blocks
+	InvocationQuota *InvocationQuota `yaml:"InvocationQuota"`
+}
+
+// InvocationQuota contains configuration for the quota on the proposals
+// endorsed for each client identity on a channel
+type InvocationQuota struct {
+	MaxInvocations uint32 `yaml:"MaxInvocations"`
+	BlockInterval  uint64 `yaml:"BlockInterval"`
 }
 
 // Resources encodes the application-level resources configuration needed to
@@ -141,7 +151,9 @@ type Organization struct {
 	// Note: Viper deserialization does not seem to care for
 	// embedding of types, so we use one organization struct
 	// for both orderers and applications.
-	AnchorPeers []*AnchorPeer `yaml:"AnchorPeers"`
+	AnchorPeers      []*AnchorPeer `yaml:"AnchorPeers"`
+	PeerEndpoints    []string      `yaml:"PeerEndpoints"`
+	OrdererEndpoints []string      `yaml:"OrdererEndpoints"`
 
 	// AdminPrincipal is deprecated and may be removed in a future release
 	// it was used for modifying the default policy generation, but policies
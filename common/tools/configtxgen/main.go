@@ -21,7 +21,6 @@ import (
 	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
 	"github.com/hyperledger/fabric/common/tools/configtxgen/metadata"
 	"github.com/hyperledger/fabric/common/tools/configtxlator/update"
-	"github.com/hyperledger/fabric/common/tools/protolator"
 	cb "github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protoutil"
 	"github.com/pkg/errors"
@@ -123,7 +122,7 @@ func doOutputAnchorPeersUpdate(conf *genesisconfig.Profile, channelID string, ou
 	return nil
 }
 
-func doInspectBlock(inspectBlock string) error {
+func doInspectBlock(inspectBlock string, format string) error {
 	logger.Info("Inspecting block")
 	data, err := ioutil.ReadFile(inspectBlock)
 	if err != nil {
@@ -135,14 +134,14 @@ func doInspectBlock(inspectBlock string) error {
 	if err != nil {
 		return fmt.Errorf("error unmarshaling to block: %s", err)
 	}
-	err = protolator.DeepMarshalJSON(os.Stdout, block)
+	err = writeMessage(os.Stdout, block, format)
 	if err != nil {
 		return fmt.Errorf("malformed block contents: %s", err)
 	}
 	return nil
 }
 
-func doInspectChannelCreateTx(inspectChannelCreateTx string) error {
+func doInspectChannelCreateTx(inspectChannelCreateTx string, format string) error {
 	logger.Info("Inspecting transaction")
 	data, err := ioutil.ReadFile(inspectChannelCreateTx)
 	if err != nil {
@@ -155,7 +154,7 @@ func doInspectChannelCreateTx(inspectChannelCreateTx string) error {
 		return fmt.Errorf("Error unmarshaling envelope: %s", err)
 	}
 
-	err = protolator.DeepMarshalJSON(os.Stdout, env)
+	err = writeMessage(os.Stdout, env, format)
 	if err != nil {
 		return fmt.Errorf("malformed transaction contents: %s", err)
 	}
@@ -163,7 +162,7 @@ func doInspectChannelCreateTx(inspectChannelCreateTx string) error {
 	return nil
 }
 
-func doPrintOrg(t *genesisconfig.TopLevel, printOrg string) error {
+func doPrintOrg(t *genesisconfig.TopLevel, printOrg string, format string) error {
 	for _, org := range t.Organizations {
 		if org.Name == printOrg {
 			og, err := encoder.NewOrdererOrgGroup(org)
@@ -171,7 +170,7 @@ func doPrintOrg(t *genesisconfig.TopLevel, printOrg string) error {
 				return errors.Wrapf(err, "bad org definition for org %s", org.Name)
 			}
 
-			if err := protolator.DeepMarshalJSON(os.Stdout, og); err != nil {
+			if err := writeMessage(os.Stdout, og, format); err != nil {
 				return errors.Wrapf(err, "malformed org definition for org: %s", org.Name)
 			}
 			return nil
@@ -181,7 +180,7 @@ func doPrintOrg(t *genesisconfig.TopLevel, printOrg string) error {
 }
 
 func main() {
-	var outputBlock, outputChannelCreateTx, channelCreateTxBaseProfile, profile, configPath, channelID, inspectBlock, inspectChannelCreateTx, outputAnchorPeersUpdate, asOrg, printOrg string
+	var outputBlock, outputChannelCreateTx, channelCreateTxBaseProfile, profile, configPath, channelID, inspectBlock, inspectChannelCreateTx, outputAnchorPeersUpdate, asOrg, printOrg, outputFormat, verifyBlock, verifyChannelCreateTx string
 
 	flag.StringVar(&outputBlock, "outputBlock", "", "The path to write the genesis block to (if set)")
 	flag.StringVar(&channelID, "channelID", "", "The channel ID to use in the configtx")
@@ -194,6 +193,9 @@ func main() {
 	flag.StringVar(&outputAnchorPeersUpdate, "outputAnchorPeersUpdate", "", "Creates an config update to update an anchor peer (works only with the default channel creation, and only for the first update)")
 	flag.StringVar(&asOrg, "asOrg", "", "Performs the config generation as a particular organization (by name), only including values in the write set that org (likely) has privilege to set")
 	flag.StringVar(&printOrg, "printOrg", "", "Prints the definition of an organization as JSON. (useful for adding an org to a channel manually)")
+	flag.StringVar(&outputFormat, "outputFormat", JSONFormat, "The format in which inspected artifacts and organizations are printed: json, canonical-json (with sorted keys, for comparison) or yaml")
+	flag.StringVar(&verifyBlock, "verifyBlock", "", "Verifies that the configuration contained in the block at the specified path matches the profile")
+	flag.StringVar(&verifyChannelCreateTx, "verifyChannelCreateTx", "", "Verifies that the config update contained in the channel creation transaction at the specified path matches the profile")
 
 	version := flag.Bool("version", false, "Show version information")
 
@@ -209,6 +211,10 @@ func main() {
 		os.Exit(exitCode)
 	}
 
+	if err := validateFormat(outputFormat); err != nil {
+		logger.Fatalf("Invalid outputFormat: %s", err)
+	}
+
 	// don't need to panic when running via command line
 	defer func() {
 		if err := recover(); err != nil {
@@ -231,7 +237,7 @@ func main() {
 	logger.Info("Loading configuration")
 	factory.InitFactories(nil)
 	var profileConfig *genesisconfig.Profile
-	if outputBlock != "" || outputChannelCreateTx != "" || outputAnchorPeersUpdate != "" {
+	if outputBlock != "" || outputChannelCreateTx != "" || outputAnchorPeersUpdate != "" || verifyBlock != "" || verifyChannelCreateTx != "" {
 		if configPath != "" {
 			profileConfig = genesisconfig.Load(profile, configPath)
 		} else {
@@ -247,8 +253,8 @@ func main() {
 
 	var baseProfile *genesisconfig.Profile
 	if channelCreateTxBaseProfile != "" {
-		if outputChannelCreateTx == "" {
-			logger.Warning("Specified 'channelCreateTxBaseProfile', but did not specify 'outputChannelCreateTx', 'channelCreateTxBaseProfile' will not affect output.")
+		if outputChannelCreateTx == "" && verifyChannelCreateTx == "" {
+			logger.Warning("Specified 'channelCreateTxBaseProfile', but did not specify 'outputChannelCreateTx' or 'verifyChannelCreateTx', 'channelCreateTxBaseProfile' will not affect output.")
 		}
 		if configPath != "" {
 			baseProfile = genesisconfig.Load(channelCreateTxBaseProfile, configPath)
@@ -270,13 +276,13 @@ func main() {
 	}
 
 	if inspectBlock != "" {
-		if err := doInspectBlock(inspectBlock); err != nil {
+		if err := doInspectBlock(inspectBlock, outputFormat); err != nil {
 			logger.Fatalf("Error on inspectBlock: %s", err)
 		}
 	}
 
 	if inspectChannelCreateTx != "" {
-		if err := doInspectChannelCreateTx(inspectChannelCreateTx); err != nil {
+		if err := doInspectChannelCreateTx(inspectChannelCreateTx, outputFormat); err != nil {
 			logger.Fatalf("Error on inspectChannelCreateTx: %s", err)
 		}
 	}
@@ -288,10 +294,22 @@ func main() {
 	}
 
 	if printOrg != "" {
-		if err := doPrintOrg(topLevelConfig, printOrg); err != nil {
+		if err := doPrintOrg(topLevelConfig, printOrg, outputFormat); err != nil {
 			logger.Fatalf("Error on printOrg: %s", err)
 		}
 	}
+
+	if verifyBlock != "" {
+		if err := doVerifyBlock(profileConfig, verifyBlock); err != nil {
+			logger.Fatalf("Error on verifyBlock: %s", err)
+		}
+	}
+
+	if verifyChannelCreateTx != "" {
+		if err := doVerifyChannelCreateTx(profileConfig, baseProfile, verifyChannelCreateTx); err != nil {
+			logger.Fatalf("Error on verifyChannelCreateTx: %s", err)
+		}
+	}
 }
 
 func printVersion() {
@@ -17,6 +17,7 @@ limitations under the License.
 package main
 
 import (
+	"bytes"
 	"flag"
 	"io/ioutil"
 	"os"
@@ -27,6 +28,8 @@ import (
 	"github.com/hyperledger/fabric/common/tools/configtxgen/configtxgentest"
 	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
 	"github.com/hyperledger/fabric/core/config/configtest"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protoutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -45,7 +48,7 @@ func TestMain(m *testing.M) {
 }
 
 func TestInspectMissing(t *testing.T) {
-	assert.Error(t, doInspectBlock("NonSenseBlockFileThatDoesn'tActuallyExist"), "Missing block")
+	assert.Error(t, doInspectBlock("NonSenseBlockFileThatDoesn'tActuallyExist", JSONFormat), "Missing block")
 }
 
 func TestInspectBlock(t *testing.T) {
@@ -54,7 +57,7 @@ func TestInspectBlock(t *testing.T) {
 	config := configtxgentest.Load(genesisconfig.SampleInsecureSoloProfile)
 
 	assert.NoError(t, doOutputBlock(config, "foo", blockDest), "Good block generation request")
-	assert.NoError(t, doInspectBlock(blockDest), "Good block inspection request")
+	assert.NoError(t, doInspectBlock(blockDest, JSONFormat), "Good block inspection request")
 }
 
 func TestMissingOrdererSection(t *testing.T) {
@@ -94,7 +97,7 @@ func TestMissingApplicationValue(t *testing.T) {
 }
 
 func TestInspectMissingConfigTx(t *testing.T) {
-	assert.Error(t, doInspectChannelCreateTx("ChannelCreateTxFileWhichDoesn'tReallyExist"), "Missing channel create tx file")
+	assert.Error(t, doInspectChannelCreateTx("ChannelCreateTxFileWhichDoesn'tReallyExist", JSONFormat), "Missing channel create tx file")
 }
 
 func TestInspectConfigTx(t *testing.T) {
@@ -103,7 +106,7 @@ func TestInspectConfigTx(t *testing.T) {
 	config := configtxgentest.Load(genesisconfig.SampleSingleMSPChannelProfile)
 
 	assert.NoError(t, doOutputChannelCreateTx(config, nil, "foo", configTxDest), "Good outputChannelCreateTx generation request")
-	assert.NoError(t, doInspectChannelCreateTx(configTxDest), "Good configtx inspection request")
+	assert.NoError(t, doInspectChannelCreateTx(configTxDest, JSONFormat), "Good configtx inspection request")
 }
 
 func TestGenerateAnchorPeersUpdate(t *testing.T) {
@@ -192,14 +195,121 @@ func TestPrintOrg(t *testing.T) {
 	factory.InitFactories(nil)
 	config := configtxgentest.LoadTopLevel()
 
-	assert.NoError(t, doPrintOrg(config, genesisconfig.SampleOrgName), "Good org to print")
+	assert.NoError(t, doPrintOrg(config, genesisconfig.SampleOrgName, JSONFormat), "Good org to print")
 
-	err := doPrintOrg(config, genesisconfig.SampleOrgName+".wrong")
+	err := doPrintOrg(config, genesisconfig.SampleOrgName+".wrong", JSONFormat)
 	assert.Error(t, err, "Bad org name")
 	assert.Regexp(t, "organization [^ ]* not found", err.Error())
 
 	config.Organizations[0] = &genesisconfig.Organization{Name: "FakeOrg", ID: "FakeOrg"}
-	err = doPrintOrg(config, "FakeOrg")
+	err = doPrintOrg(config, "FakeOrg", JSONFormat)
 	assert.Error(t, err, "Fake org")
 	assert.Regexp(t, "bad org definition", err.Error())
 }
+
+func TestWriteMessageFormats(t *testing.T) {
+	msg := &cb.BlockHeader{Number: 3, DataHash: []byte("data"), PreviousHash: []byte("previous")}
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, writeMessage(buf, msg, JSONFormat))
+	assert.Regexp(t, `(?s)"data_hash".*"number".*"previous_hash"`, buf.String())
+
+	buf.Reset()
+	assert.NoError(t, writeMessage(buf, msg, CanonicalJSONFormat))
+	assert.Equal(t, "{\n  \"data_hash\": \"ZGF0YQ==\",\n  \"number\": \"3\",\n  \"previous_hash\": \"cHJldmlvdXM=\"\n}\n", buf.String())
+
+	buf.Reset()
+	assert.NoError(t, writeMessage(buf, &cb.BlockchainInfo{Height: 10}, YAMLFormat))
+	assert.Equal(t, "currentBlockHash: null\nheight: \"10\"\npreviousBlockHash: null\n", buf.String())
+
+	buf.Reset()
+	assert.NoError(t, writeMessage(buf, &cb.ConfigValue{Version: 2, ModPolicy: "Admins"}, YAMLFormat))
+	assert.Equal(t, "mod_policy: Admins\nvalue: null\nversion: \"2\"\n", buf.String())
+
+	assert.EqualError(t, writeMessage(buf, msg, "xml"), "unknown output format 'xml', must be one of json, canonical-json or yaml")
+	assert.NoError(t, validateFormat(YAMLFormat))
+}
+
+func TestVerifyBlock(t *testing.T) {
+	blockDest := filepath.Join(tmpDir, "verifyblock")
+
+	config := configtxgentest.Load(genesisconfig.SampleDevModeSoloProfile)
+	assert.NoError(t, doOutputBlock(config, "foo", blockDest))
+
+	assert.NoError(t, doVerifyBlock(config, blockDest))
+
+	config.Orderer.BatchSize.MaxMessageCount++
+	config.Application.Organizations = nil
+	err := doVerifyBlock(config, blockDest)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "artifact does not match the profile")
+	assert.Contains(t, err.Error(), "value [Channel/Orderer/BatchSize] differs")
+	assert.Contains(t, err.Error(), "group [Channel/Application/SampleOrg] is not defined by the profile")
+
+	assert.Error(t, doVerifyBlock(config, "NonSenseBlockFileThatDoesn'tActuallyExist"))
+}
+
+func TestVerifyChannelCreateTx(t *testing.T) {
+	configTxDest := filepath.Join(tmpDir, "verifyconfigtx")
+
+	config := configtxgentest.Load(genesisconfig.SampleSingleMSPChannelProfile)
+	assert.NoError(t, doOutputChannelCreateTx(config, nil, "foo", configTxDest))
+
+	assert.NoError(t, doVerifyChannelCreateTx(config, nil, configTxDest))
+
+	config.Consortium = "OtherConsortium"
+	err := doVerifyChannelCreateTx(config, nil, configTxDest)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "value [WriteSet/Consortium] differs")
+
+	blockDest := filepath.Join(tmpDir, "verifyconfigtxblock")
+	assert.NoError(t, doOutputBlock(configtxgentest.Load(genesisconfig.SampleDevModeSoloProfile), "foo", blockDest))
+	err = doVerifyChannelCreateTx(config, nil, blockDest)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid channel create tx")
+}
+
+func TestGroupDifferences(t *testing.T) {
+	expected := &cb.Config{
+		ChannelGroup: &cb.ConfigGroup{
+			Version: 1,
+			Groups: map[string]*cb.ConfigGroup{
+				"Orderer":     {ModPolicy: "Admins"},
+				"Application": {},
+			},
+			Values: map[string]*cb.ConfigValue{
+				"Consortium": {Value: protoutil.MarshalOrPanic(&cb.Consortium{Name: "SampleConsortium"})},
+			},
+		},
+	}
+	actual := &cb.Config{
+		ChannelGroup: &cb.ConfigGroup{
+			Groups: map[string]*cb.ConfigGroup{
+				"Orderer":     {ModPolicy: "Writers"},
+				"Consortiums": {},
+			},
+			Values: map[string]*cb.ConfigValue{
+				"Consortium": {Value: protoutil.MarshalOrPanic(&cb.Consortium{Name: "OtherConsortium"})},
+			},
+			Policies: map[string]*cb.ConfigPolicy{
+				"Admins": {},
+			},
+		},
+	}
+
+	expectedDoc, err := decodeMessage(expected)
+	assert.NoError(t, err)
+	actualDoc, err := decodeMessage(actual)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"group [Channel] has version 0, expected 1",
+		"group [Channel/Application] is missing",
+		"group [Channel/Consortiums] is not defined by the profile",
+		"group [Channel/Orderer] has mod policy 'Writers', expected 'Admins'",
+		"value [Channel/Consortium] differs",
+		"policy [Channel/Admins] is not defined by the profile",
+	}, groupDifferences("Channel", object(expectedDoc["channel_group"]), object(actualDoc["channel_group"])))
+	assert.Empty(t, groupDifferences("Channel", object(expectedDoc["channel_group"]), object(expectedDoc["channel_group"])))
+	assert.Empty(t, groupDifferences("Channel", object(nil), map[string]interface{}{}))
+}
@@ -0,0 +1,231 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/tools/configtxgen/encoder"
+	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
+	"github.com/hyperledger/fabric/common/tools/protolator"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+)
+
+func doVerifyBlock(conf *genesisconfig.Profile, verifyBlock string) error {
+	logger.Info("Verifying block")
+	data, err := ioutil.ReadFile(verifyBlock)
+	if err != nil {
+		return errors.Wrapf(err, "could not read block %s", verifyBlock)
+	}
+	block, err := protoutil.UnmarshalBlock(data)
+	if err != nil {
+		return errors.Wrap(err, "error unmarshaling to block")
+	}
+	env, err := protoutil.ExtractEnvelope(block, 0)
+	if err != nil {
+		return errors.Wrap(err, "block does not contain a transaction")
+	}
+	configEnv := &cb.ConfigEnvelope{}
+	if _, err := protoutil.UnmarshalEnvelopeOfType(env, cb.HeaderType_CONFIG, configEnv); err != nil {
+		return errors.Wrap(err, "block is not a config block")
+	}
+	if configEnv.Config == nil {
+		return errors.New("config block does not contain a config")
+	}
+
+	expected, err := encoder.NewChannelGroup(conf)
+	if err != nil {
+		return errors.WithMessage(err, "could not create channel group from profile")
+	}
+
+	expectedDoc, err := decodeMessage(&cb.Config{ChannelGroup: expected})
+	if err != nil {
+		return err
+	}
+	actualDoc, err := decodeMessage(&cb.Config{ChannelGroup: configEnv.Config.ChannelGroup})
+	if err != nil {
+		return errors.WithMessage(err, "malformed config")
+	}
+
+	return differencesError(groupDifferences("Channel", object(expectedDoc["channel_group"]), object(actualDoc["channel_group"])))
+}
+
+func doVerifyChannelCreateTx(conf, baseProfile *genesisconfig.Profile, verifyChannelCreateTx string) error {
+	logger.Info("Verifying channel create tx")
+	data, err := ioutil.ReadFile(verifyChannelCreateTx)
+	if err != nil {
+		return errors.Wrapf(err, "could not read channel create tx %s", verifyChannelCreateTx)
+	}
+	env, err := protoutil.UnmarshalEnvelope(data)
+	if err != nil {
+		return errors.Wrap(err, "error unmarshaling envelope")
+	}
+	actual, err := configUpdate(env)
+	if err != nil {
+		return errors.WithMessage(err, "invalid channel create tx")
+	}
+
+	// the transaction is generated again for the channel of the artifact
+	var generated *cb.Envelope
+	if baseProfile == nil {
+		generated, err = encoder.MakeChannelCreationTransaction(actual.ChannelId, nil, conf)
+	} else {
+		generated, err = encoder.MakeChannelCreationTransactionWithSystemChannelContext(actual.ChannelId, nil, conf, baseProfile)
+	}
+	if err != nil {
+		return errors.WithMessage(err, "could not create channel create tx from profile")
+	}
+	expected, err := configUpdate(generated)
+	if err != nil {
+		return err
+	}
+
+	expectedDoc, err := decodeMessage(expected)
+	if err != nil {
+		return err
+	}
+	actualDoc, err := decodeMessage(actual)
+	if err != nil {
+		return errors.WithMessage(err, "malformed config update")
+	}
+
+	differences := groupDifferences("ReadSet", object(expectedDoc["read_set"]), object(actualDoc["read_set"]))
+	differences = append(differences, groupDifferences("WriteSet", object(expectedDoc["write_set"]), object(actualDoc["write_set"]))...)
+	return differencesError(differences)
+}
+
+func configUpdate(env *cb.Envelope) (*cb.ConfigUpdate, error) {
+	configUpdateEnv := &cb.ConfigUpdateEnvelope{}
+	if _, err := protoutil.UnmarshalEnvelopeOfType(env, cb.HeaderType_CONFIG_UPDATE, configUpdateEnv); err != nil {
+		return nil, err
+	}
+	configUpdate := &cb.ConfigUpdate{}
+	if err := proto.Unmarshal(configUpdateEnv.ConfigUpdate, configUpdate); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling config update")
+	}
+	return configUpdate, nil
+}
+
+func differencesError(differences []string) error {
+	if len(differences) == 0 {
+		logger.Info("Artifact matches the profile")
+		return nil
+	}
+	return errors.Errorf("artifact does not match the profile:\n\t%s", strings.Join(differences, "\n\t"))
+}
+
+// decodeMessage returns the JSON document of the message, with its nested
+// messages decoded. The config values are compared in this form, as their
+// encoding is not deterministic when they contain maps.
+func decodeMessage(msg proto.Message) (map[string]interface{}, error) {
+	msg = proto.Clone(msg)
+	switch m := msg.(type) {
+	case *cb.Config:
+		clearEmptyValues(m.ChannelGroup)
+	case *cb.ConfigUpdate:
+		clearEmptyValues(m.ReadSet)
+		clearEmptyValues(m.WriteSet)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := protolator.DeepMarshalJSON(buf, msg); err != nil {
+		return nil, err
+	}
+	doc := map[string]interface{}{}
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		return nil, errors.Wrap(err, "could not decode JSON")
+	}
+	return doc, nil
+}
+
+// clearEmptyValues sets the empty values of the group to nil, as they are
+// once marshaled, so that they are decoded alike.
+func clearEmptyValues(group *cb.ConfigGroup) {
+	if group == nil {
+		return
+	}
+	for _, value := range group.Values {
+		if len(value.Value) == 0 {
+			value.Value = nil
+		}
+	}
+	for _, subGroup := range group.Groups {
+		clearEmptyValues(subGroup)
+	}
+}
+
+func object(v interface{}) map[string]interface{} {
+	if m, ok := v.(map[string]interface{}); ok {
+		return m
+	}
+	return map[string]interface{}{}
+}
+
+// groupDifferences returns a description of each difference between the
+// JSON documents of the expected and the actual config group, whose elements
+// are identified by their path from the given path.
+func groupDifferences(path string, expected, actual map[string]interface{}) []string {
+	var differences []string
+	if !reflect.DeepEqual(expected["version"], actual["version"]) {
+		differences = append(differences, fmt.Sprintf("group [%s] has version %v, expected %v", path, actual["version"], expected["version"]))
+	}
+	if !reflect.DeepEqual(expected["mod_policy"], actual["mod_policy"]) {
+		differences = append(differences, fmt.Sprintf("group [%s] has mod policy '%v', expected '%v'", path, actual["mod_policy"], expected["mod_policy"]))
+	}
+
+	for _, kind := range []struct{ field, element string }{
+		{"groups", "group"},
+		{"values", "value"},
+		{"policies", "policy"},
+	} {
+		expectedElements, actualElements := object(expected[kind.field]), object(actual[kind.field])
+		element := kind.element
+
+		for _, key := range unionKeys(expectedElements, actualElements) {
+			subPath := path + "/" + key
+			expectedElement, inExpected := expectedElements[key]
+			actualElement, inActual := actualElements[key]
+			switch {
+			case !inActual:
+				differences = append(differences, fmt.Sprintf("%s [%s] is missing", element, subPath))
+			case !inExpected:
+				differences = append(differences, fmt.Sprintf("%s [%s] is not defined by the profile", element, subPath))
+			case kind.field == "groups":
+				differences = append(differences, groupDifferences(subPath, object(expectedElement), object(actualElement))...)
+			case !reflect.DeepEqual(expectedElement, actualElement):
+				differences = append(differences, fmt.Sprintf("%s [%s] differs", element, subPath))
+			}
+		}
+	}
+
+	return differences
+}
+
+func unionKeys(a, b map[string]interface{}) []string {
+	set := map[string]struct{}{}
+	for key := range a {
+		set[key] = struct{}{}
+	}
+	for key := range b {
+		set[key] = struct{}{}
+	}
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -12,20 +12,11 @@ import (
 	"net"
 	"net/http"
 	"os"
-	"reflect"
 
-	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/tools/configtxlator/metadata"
 	"github.com/hyperledger/fabric/common/tools/configtxlator/rest"
-	"github.com/hyperledger/fabric/common/tools/configtxlator/update"
-	"github.com/hyperledger/fabric/common/tools/protolator"
-	_ "github.com/hyperledger/fabric/protos/common"
-	cb "github.com/hyperledger/fabric/protos/common" // Import these to register the proto types
-	_ "github.com/hyperledger/fabric/protos/msp"
-	_ "github.com/hyperledger/fabric/protos/orderer"
-	_ "github.com/hyperledger/fabric/protos/orderer/etcdraft"
-	_ "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/common/tools/configtxlator/translate"
 
 	"github.com/gorilla/handlers"
 	"github.com/pkg/errors"
@@ -127,20 +118,9 @@ func printVersion() {
 }
 
 func encodeProto(msgName string, input, output *os.File) error {
-	msgType := proto.MessageType(msgName)
-	if msgType == nil {
-		return errors.Errorf("message of type %s unknown", msgType)
-	}
-	msg := reflect.New(msgType.Elem()).Interface().(proto.Message)
-
-	err := protolator.DeepUnmarshalJSON(input, msg)
-	if err != nil {
-		return errors.Wrapf(err, "error decoding input")
-	}
-
-	out, err := proto.Marshal(msg)
+	out, err := translate.EncodeProto(msgName, input)
 	if err != nil {
-		return errors.Wrapf(err, "error marshaling")
+		return err
 	}
 
 	_, err = output.Write(out)
@@ -152,28 +132,12 @@ func encodeProto(msgName string, input, output *os.File) error {
 }
 
 func decodeProto(msgName string, input, output *os.File) error {
-	msgType := proto.MessageType(msgName)
-	if msgType == nil {
-		return errors.Errorf("message of type %s unknown", msgType)
-	}
-	msg := reflect.New(msgType.Elem()).Interface().(proto.Message)
-
 	in, err := ioutil.ReadAll(input)
 	if err != nil {
 		return errors.Wrapf(err, "error reading input")
 	}
 
-	err = proto.Unmarshal(in, msg)
-	if err != nil {
-		return errors.Wrapf(err, "error unmarshaling")
-	}
-
-	err = protolator.DeepMarshalJSON(output, msg)
-	if err != nil {
-		return errors.Wrapf(err, "error encoding output")
-	}
-
-	return nil
+	return translate.DecodeProto(msgName, in, output)
 }
 
 func computeUpdt(original, updated, output *os.File, channelID string) error {
@@ -182,33 +146,14 @@ func computeUpdt(original, updated, output *os.File, channelID string) error {
 		return errors.Wrapf(err, "error reading original config")
 	}
 
-	origConf := &cb.Config{}
-	err = proto.Unmarshal(origIn, origConf)
-	if err != nil {
-		return errors.Wrapf(err, "error unmarshaling original config")
-	}
-
 	updtIn, err := ioutil.ReadAll(updated)
 	if err != nil {
 		return errors.Wrapf(err, "error reading updated config")
 	}
 
-	updtConf := &cb.Config{}
-	err = proto.Unmarshal(updtIn, updtConf)
-	if err != nil {
-		return errors.Wrapf(err, "error unmarshaling updated config")
-	}
-
-	cu, err := update.Compute(origConf, updtConf)
-	if err != nil {
-		return errors.Wrapf(err, "error computing config update")
-	}
-
-	cu.ChannelId = channelID
-
-	outBytes, err := proto.Marshal(cu)
+	outBytes, err := translate.ComputeUpdate(origIn, updtIn, channelID)
 	if err != nil {
-		return errors.Wrapf(err, "error marshaling computed config update")
+		return err
 	}
 
 	_, err = output.Write(outBytes)
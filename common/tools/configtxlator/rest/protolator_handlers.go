@@ -21,10 +21,10 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
-	"reflect"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/gorilla/mux"
+	"github.com/hyperledger/fabric/common/tools/configtxlator/translate"
 	"github.com/hyperledger/fabric/common/tools/protolator"
 )
 
@@ -32,11 +32,11 @@ func getMsgType(r *http.Request) (proto.Message, error) {
 	vars := mux.Vars(r)
 	msgName := vars["msgName"] // Will not arrive is unset
 
-	msgType := proto.MessageType(msgName)
-	if msgType == nil {
+	msg, err := translate.NewMessage(msgName)
+	if err != nil {
 		return nil, fmt.Errorf("message name not found")
 	}
-	return reflect.New(msgType.Elem()).Interface().(proto.Message), nil
+	return msg, nil
 }
 
 func Decode(w http.ResponseWriter, r *http.Request) {
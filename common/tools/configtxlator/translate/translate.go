@@ -0,0 +1,121 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package translate provides the conversions of the configtxlator tool, that
+// is the encoding of the JSON representation of Fabric messages to protobuf,
+// the decoding of protobuf messages to JSON, and the computation of config
+// updates, so that Go programs can perform them without running the tool.
+package translate
+
+import (
+	"io"
+	"reflect"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/tools/configtxlator/update"
+	"github.com/hyperledger/fabric/common/tools/protolator"
+	cb "github.com/hyperledger/fabric/protos/common" // Import these to register the proto types
+	_ "github.com/hyperledger/fabric/protos/msp"
+	_ "github.com/hyperledger/fabric/protos/orderer"
+	_ "github.com/hyperledger/fabric/protos/orderer/etcdraft"
+	_ "github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+)
+
+// NewMessage returns a new message of the proto type with the given name,
+// such as common.Config.
+func NewMessage(msgName string) (proto.Message, error) {
+	msgType := proto.MessageType(msgName)
+	if msgType == nil {
+		return nil, errors.Errorf("message of type %s unknown", msgName)
+	}
+	return reflect.New(msgType.Elem()).Interface().(proto.Message), nil
+}
+
+// EncodeProto reads the JSON representation of a message of the proto type
+// with the given name, and returns the marshaled message.
+func EncodeProto(msgName string, input io.Reader) ([]byte, error) {
+	msg, err := NewMessage(msgName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = protolator.DeepUnmarshalJSON(input, msg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decoding input")
+	}
+
+	out, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error marshaling")
+	}
+
+	return out, nil
+}
+
+// DecodeProto unmarshals a message of the proto type with the given name,
+// and writes its JSON representation to the output.
+func DecodeProto(msgName string, input []byte, output io.Writer) error {
+	msg, err := NewMessage(msgName)
+	if err != nil {
+		return err
+	}
+
+	err = proto.Unmarshal(input, msg)
+	if err != nil {
+		return errors.Wrapf(err, "error unmarshaling")
+	}
+
+	err = protolator.DeepMarshalJSON(output, msg)
+	if err != nil {
+		return errors.Wrapf(err, "error encoding output")
+	}
+
+	return nil
+}
+
+// ComputeUpdate unmarshals the original and updated common.Config messages,
+// and returns the marshaled config update of the given channel which
+// transitions between the two.
+func ComputeUpdate(original, updated []byte, channelID string) ([]byte, error) {
+	return ComputeUpdateWithPolicies(original, updated, channelID, nil)
+}
+
+// ComputeUpdateWithPolicies is like ComputeUpdate, but prunes the
+// modifications which require a mod_policy the filter does not accept, as
+// update.ComputeWithPolicies does. A nil filter accepts every policy.
+func ComputeUpdateWithPolicies(original, updated []byte, channelID string, filter update.PolicyFilter) ([]byte, error) {
+	origConf := &cb.Config{}
+	err := proto.Unmarshal(original, origConf)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error unmarshaling original config")
+	}
+
+	updtConf := &cb.Config{}
+	err = proto.Unmarshal(updated, updtConf)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error unmarshaling updated config")
+	}
+
+	var cu *cb.ConfigUpdate
+	if filter == nil {
+		cu, err = update.Compute(origConf, updtConf)
+	} else {
+		cu, err = update.ComputeWithPolicies(origConf, updtConf, filter)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error computing config update")
+	}
+
+	cu.ChannelId = channelID
+
+	out, err := proto.Marshal(cu)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error marshaling computed config update")
+	}
+
+	return out, nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package translate
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/tools/configtxlator/update"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMessage(t *testing.T) {
+	msg, err := NewMessage("common.Config")
+	require.NoError(t, err)
+	assert.IsType(t, &cb.Config{}, msg)
+
+	_, err = NewMessage("common.Missing")
+	assert.EqualError(t, err, "message of type common.Missing unknown")
+}
+
+func TestEncodeDecode(t *testing.T) {
+	header := &cb.ChannelHeader{ChannelId: "mychannel", TxId: "tx1"}
+
+	var buffer bytes.Buffer
+	err := DecodeProto("common.ChannelHeader", protoutil.MarshalOrPanic(header), &buffer)
+	require.NoError(t, err)
+	assert.Contains(t, buffer.String(), `"channel_id": "mychannel"`)
+
+	encoded, err := EncodeProto("common.ChannelHeader", &buffer)
+	require.NoError(t, err)
+	decoded := &cb.ChannelHeader{}
+	require.NoError(t, proto.Unmarshal(encoded, decoded))
+	assert.True(t, proto.Equal(header, decoded))
+
+	_, err = EncodeProto("common.ChannelHeader", strings.NewReader("{"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "error decoding input")
+
+	err = DecodeProto("common.ChannelHeader", []byte("garbage"), &buffer)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "error unmarshaling")
+
+	_, err = EncodeProto("common.Missing", &buffer)
+	assert.EqualError(t, err, "message of type common.Missing unknown")
+	err = DecodeProto("common.Missing", nil, &buffer)
+	assert.EqualError(t, err, "message of type common.Missing unknown")
+}
+
+func TestComputeUpdate(t *testing.T) {
+	original := &cb.Config{
+		ChannelGroup: &cb.ConfigGroup{
+			ModPolicy: "Admins",
+			Values: map[string]*cb.ConfigValue{
+				"Foo": {ModPolicy: "Admins", Value: []byte("foo")},
+				"Bar": {ModPolicy: "Writers", Value: []byte("bar")},
+			},
+		},
+	}
+	updated := proto.Clone(original).(*cb.Config)
+	updated.ChannelGroup.Values["Foo"].Value = []byte("foo2")
+	updated.ChannelGroup.Values["Bar"].Value = []byte("bar2")
+
+	out, err := ComputeUpdate(protoutil.MarshalOrPanic(original), protoutil.MarshalOrPanic(updated), "mychannel")
+	require.NoError(t, err)
+	cu := &cb.ConfigUpdate{}
+	require.NoError(t, proto.Unmarshal(out, cu))
+	assert.Equal(t, "mychannel", cu.ChannelId)
+	assert.Len(t, cu.WriteSet.Values, 2)
+
+	out, err = ComputeUpdateWithPolicies(protoutil.MarshalOrPanic(original), protoutil.MarshalOrPanic(updated), "mychannel", update.AcceptPolicies("/Channel/Writers"))
+	require.NoError(t, err)
+	cu = &cb.ConfigUpdate{}
+	require.NoError(t, proto.Unmarshal(out, cu))
+	assert.Len(t, cu.WriteSet.Values, 1)
+	assert.Equal(t, []byte("bar2"), cu.WriteSet.Values["Bar"].Value)
+
+	_, err = ComputeUpdate([]byte("garbage"), protoutil.MarshalOrPanic(updated), "mychannel")
+	assert.Contains(t, err.Error(), "error unmarshaling original config")
+	_, err = ComputeUpdate(protoutil.MarshalOrPanic(original), []byte("garbage"), "mychannel")
+	assert.Contains(t, err.Error(), "error unmarshaling updated config")
+	_, err = ComputeUpdate(protoutil.MarshalOrPanic(original), protoutil.MarshalOrPanic(original), "mychannel")
+	assert.EqualError(t, err, "error computing config update: no differences detected between original and updated config")
+}
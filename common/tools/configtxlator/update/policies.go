@@ -0,0 +1,141 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package update
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/common/policies"
+	cb "github.com/hyperledger/fabric/protos/common"
+)
+
+// PolicyFilter reports whether the signers of a config update are expected
+// to satisfy the policy at the given fully qualified path, such as
+// /Channel/Application/Org1MSP/Admins.
+type PolicyFilter func(policyPath string) bool
+
+// AcceptPolicies returns a PolicyFilter which accepts the policies at the
+// given fully qualified paths.
+func AcceptPolicies(policyPaths ...string) PolicyFilter {
+	accepted := make(map[string]struct{}, len(policyPaths))
+	for _, policyPath := range policyPaths {
+		accepted[policyPath] = struct{}{}
+	}
+	return func(policyPath string) bool {
+		_, ok := accepted[policyPath]
+		return ok
+	}
+}
+
+// ComputeWithPolicies computes the config update which transitions between
+// the two configs, as Compute does, but prunes the modifications which
+// require a mod_policy the filter does not accept. The values and policies
+// which were modified are retained if the filter accepts their mod_policy in
+// the original config, while the elements added to or removed from a group,
+// and the changes of the mod_policy of the group itself, are retained if the
+// filter accepts the mod_policy of the group.
+func ComputeWithPolicies(original, updated *cb.Config, filter PolicyFilter) (*cb.ConfigUpdate, error) {
+	if original.ChannelGroup == nil {
+		return nil, fmt.Errorf("no channel group included for original config")
+	}
+
+	if updated.ChannelGroup == nil {
+		return nil, fmt.Errorf("no channel group included for updated config")
+	}
+
+	pruned := &cb.Config{
+		Sequence:     updated.Sequence,
+		ChannelGroup: pruneGroup(policies.PathSeparator+channelconfig.RootGroupKey, original.ChannelGroup, updated.ChannelGroup, filter),
+	}
+	return Compute(original, pruned)
+}
+
+// pruneGroup returns the updated group, with the modifications whose
+// mod_policy is not accepted by the filter reverted to the original group.
+func pruneGroup(path string, original, updated *cb.ConfigGroup, filter PolicyFilter) *cb.ConfigGroup {
+	groupAccepted := filter(policyPath(path, original.ModPolicy))
+
+	result := &cb.ConfigGroup{
+		Version:   updated.Version,
+		ModPolicy: updated.ModPolicy,
+		Groups:    map[string]*cb.ConfigGroup{},
+		Values:    map[string]*cb.ConfigValue{},
+		Policies:  map[string]*cb.ConfigPolicy{},
+	}
+	if !groupAccepted {
+		result.ModPolicy = original.ModPolicy
+	}
+
+	for name, updatedGroup := range updated.Groups {
+		originalGroup, ok := original.Groups[name]
+		switch {
+		case ok:
+			result.Groups[name] = pruneGroup(path+policies.PathSeparator+name, originalGroup, updatedGroup, filter)
+		case groupAccepted:
+			result.Groups[name] = updatedGroup
+		}
+	}
+
+	for name, updatedValue := range updated.Values {
+		originalValue, ok := original.Values[name]
+		switch {
+		case ok && filter(policyPath(path, originalValue.ModPolicy)):
+			result.Values[name] = updatedValue
+		case ok:
+			result.Values[name] = originalValue
+		case groupAccepted:
+			result.Values[name] = updatedValue
+		}
+	}
+
+	for name, updatedPolicy := range updated.Policies {
+		originalPolicy, ok := original.Policies[name]
+		switch {
+		case ok && filter(policyPath(path, originalPolicy.ModPolicy)):
+			result.Policies[name] = updatedPolicy
+		case ok:
+			result.Policies[name] = originalPolicy
+		case groupAccepted:
+			result.Policies[name] = updatedPolicy
+		}
+	}
+
+	if groupAccepted {
+		return result
+	}
+
+	// The removal of elements from the group is pruned as well
+	for name, originalGroup := range original.Groups {
+		if _, ok := updated.Groups[name]; !ok {
+			result.Groups[name] = originalGroup
+		}
+	}
+	for name, originalValue := range original.Values {
+		if _, ok := updated.Values[name]; !ok {
+			result.Values[name] = originalValue
+		}
+	}
+	for name, originalPolicy := range original.Policies {
+		if _, ok := updated.Policies[name]; !ok {
+			result.Policies[name] = originalPolicy
+		}
+	}
+
+	return result
+}
+
+// policyPath returns the fully qualified path of a mod_policy of an element
+// of the group at the given path, or of the group itself, as the config
+// update validation resolves it.
+func policyPath(groupPath, modPolicy string) string {
+	if strings.HasPrefix(modPolicy, policies.PathSeparator) {
+		return modPolicy
+	}
+	return groupPath + policies.PathSeparator + modPolicy
+}
@@ -0,0 +1,112 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package update
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func policiesTestConfig() *cb.Config {
+	return &cb.Config{
+		ChannelGroup: &cb.ConfigGroup{
+			ModPolicy: "Admins",
+			Values: map[string]*cb.ConfigValue{
+				"BatchSize": {ModPolicy: "/Channel/Orderer/Admins", Value: []byte("10")},
+			},
+			Groups: map[string]*cb.ConfigGroup{
+				"Application": {
+					Version:   2,
+					ModPolicy: "Admins",
+					Groups: map[string]*cb.ConfigGroup{
+						"Org1": {
+							Version:   1,
+							ModPolicy: "Admins",
+							Values: map[string]*cb.ConfigValue{
+								"AnchorPeers": {ModPolicy: "Admins", Value: []byte("peer0")},
+							},
+						},
+						"Org2": {
+							ModPolicy: "Admins",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestComputeWithPolicies(t *testing.T) {
+	original := policiesTestConfig()
+	updated := proto.Clone(original).(*cb.Config)
+	updated.ChannelGroup.Values["BatchSize"].Value = []byte("20")
+	application := updated.ChannelGroup.Groups["Application"]
+	application.Groups["Org1"].Values["AnchorPeers"].Value = []byte("peer1")
+	application.Groups["Org3"] = &cb.ConfigGroup{ModPolicy: "Admins"}
+	delete(application.Groups, "Org2")
+
+	t.Run("OrgAdmins", func(t *testing.T) {
+		cu, err := ComputeWithPolicies(original, updated, AcceptPolicies("/Channel/Application/Org1/Admins"))
+		require.NoError(t, err)
+
+		// Only the anchor peers of Org1 are modified
+		assert.Empty(t, cu.WriteSet.Values)
+		writeApplication := cu.WriteSet.Groups["Application"]
+		assert.Equal(t, uint64(2), writeApplication.Version)
+		assert.Len(t, writeApplication.Groups, 1)
+		assert.Equal(t, &cb.ConfigValue{
+			Version:   1,
+			ModPolicy: "Admins",
+			Value:     []byte("peer1"),
+		}, writeApplication.Groups["Org1"].Values["AnchorPeers"])
+	})
+
+	t.Run("ApplicationAdmins", func(t *testing.T) {
+		cu, err := ComputeWithPolicies(original, updated, AcceptPolicies("/Channel/Application/Admins"))
+		require.NoError(t, err)
+
+		// The membership of the application group is modified, but not the
+		// anchor peers of Org1
+		writeApplication := cu.WriteSet.Groups["Application"]
+		assert.Equal(t, uint64(3), writeApplication.Version)
+		assert.Contains(t, writeApplication.Groups, "Org3")
+		assert.NotContains(t, writeApplication.Groups, "Org2")
+		assert.Equal(t, &cb.ConfigGroup{Version: 1}, writeApplication.Groups["Org1"])
+	})
+
+	t.Run("AbsoluteModPolicy", func(t *testing.T) {
+		cu, err := ComputeWithPolicies(original, updated, AcceptPolicies("/Channel/Orderer/Admins"))
+		require.NoError(t, err)
+
+		assert.Empty(t, cu.WriteSet.Groups)
+		assert.Equal(t, []byte("20"), cu.WriteSet.Values["BatchSize"].Value)
+	})
+
+	t.Run("NothingAccepted", func(t *testing.T) {
+		_, err := ComputeWithPolicies(original, updated, AcceptPolicies())
+		assert.EqualError(t, err, "no differences detected between original and updated config")
+	})
+
+	t.Run("AllAccepted", func(t *testing.T) {
+		expected, err := Compute(original, updated)
+		require.NoError(t, err)
+		cu, err := ComputeWithPolicies(original, updated, func(string) bool { return true })
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(expected, cu))
+	})
+
+	t.Run("MissingGroup", func(t *testing.T) {
+		_, err := ComputeWithPolicies(&cb.Config{}, updated, AcceptPolicies())
+		assert.EqualError(t, err, "no channel group included for original config")
+		_, err = ComputeWithPolicies(original, &cb.Config{}, AcceptPolicies())
+		assert.EqualError(t, err, "no channel group included for updated config")
+	})
+}
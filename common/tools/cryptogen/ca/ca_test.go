@@ -199,6 +199,80 @@ func TestGenerateSignCertificate(t *testing.T) {
 
 }
 
+func TestRenewCA(t *testing.T) {
+
+	caDir := filepath.Join(testDir, "ca")
+	certDir := filepath.Join(testDir, "certs")
+	priv, _, err := csp.GeneratePrivateKey(certDir)
+	require.NoError(t, err)
+	ecPubKey, err := csp.GetECPublicKey(priv)
+	require.NoError(t, err)
+
+	rootCA, err := ca.NewCA(caDir, testCAName, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode)
+	require.NoError(t, err, "Error generating CA")
+	cert, err := rootCA.SignCertificate(certDir, testName, nil, nil, ecPubKey,
+		x509.KeyUsageDigitalSignature, []x509.ExtKeyUsage{})
+	require.NoError(t, err)
+
+	oldCACert := rootCA.SignCert
+	err = rootCA.Renew(caDir)
+	assert.NoError(t, err, "Failed to renew CA")
+	assert.NotEqual(t, oldCACert.SerialNumber, rootCA.SignCert.SerialNumber)
+	assert.Equal(t, oldCACert.RawSubject, rootCA.SignCert.RawSubject)
+	assert.Equal(t, oldCACert.SubjectKeyId, rootCA.SignCert.SubjectKeyId)
+	assert.Equal(t, oldCACert.PublicKey, rootCA.SignCert.PublicKey)
+	assert.True(t, rootCA.SignCert.IsCA)
+
+	// the renewed certificate replaces the previous one
+	loaded, err := ca.LoadCertificateECDSA(caDir)
+	require.NoError(t, err)
+	assert.Equal(t, rootCA.SignCert.Raw, loaded.Raw)
+
+	// certificates signed before the renewal remain valid
+	assert.NoError(t, cert.CheckSignatureFrom(rootCA.SignCert))
+
+	badCA := &ca.CA{Name: "badCA"}
+	err = badCA.Renew(caDir)
+	assert.EqualError(t, err, "CA badCA has no certificate or signing key")
+	cleanup(testDir)
+}
+
+func TestReissueCertificate(t *testing.T) {
+
+	caDir := filepath.Join(testDir, "ca")
+	certDir := filepath.Join(testDir, "certs")
+	priv, _, err := csp.GeneratePrivateKey(certDir)
+	require.NoError(t, err)
+	ecPubKey, err := csp.GetECPublicKey(priv)
+	require.NoError(t, err)
+
+	rootCA, err := ca.NewCA(caDir, testCAName, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode)
+	require.NoError(t, err, "Error generating CA")
+	cert, err := rootCA.SignCertificate(certDir, testName, []string{"PeerOU"}, []string{testName, testIP}, ecPubKey,
+		x509.KeyUsageDigitalSignature|x509.KeyUsageKeyEncipherment,
+		[]x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth})
+	require.NoError(t, err)
+
+	reissued, err := rootCA.ReissueCertificate(certDir, testName, cert, []string{testName, testName2, testIP, "10.0.0.1"})
+	assert.NoError(t, err, "Failed to reissue certificate")
+	assert.NotEqual(t, cert.SerialNumber, reissued.SerialNumber)
+	assert.Equal(t, cert.RawSubject, reissued.RawSubject)
+	assert.Equal(t, cert.PublicKey, reissued.PublicKey)
+	assert.Equal(t, cert.KeyUsage, reissued.KeyUsage)
+	assert.Equal(t, cert.ExtKeyUsage, reissued.ExtKeyUsage)
+	assert.Equal(t, []string{testName, testName2}, reissued.DNSNames)
+	assert.Len(t, reissued.IPAddresses, 2)
+	assert.NoError(t, reissued.CheckSignatureFrom(rootCA.SignCert))
+
+	loaded, err := ca.LoadCertificateECDSA(certDir)
+	require.NoError(t, err)
+	assert.Equal(t, reissued.Raw, loaded.Raw)
+
+	_, err = rootCA.ReissueCertificate(certDir, testName, &x509.Certificate{}, nil)
+	assert.Error(t, err, "Certificate without ECDSA public key should fail")
+	cleanup(testDir)
+}
+
 func cleanup(dir string) {
 	os.RemoveAll(dir)
 }
@@ -131,6 +131,83 @@ func (ca *CA) SignCertificate(baseDir, name string, ous, sans []string, pub *ecd
 	return cert, nil
 }
 
+// Renew re-issues the self-signed certificate of the CA with a new validity
+// period, and saves it in baseDir/name.  The subject and key of the CA are
+// kept, so that the certificates it previously signed remain valid.
+func (ca *CA) Renew(baseDir string) error {
+	if ca.SignCert == nil || ca.Signer == nil {
+		return errors.Errorf("CA %s has no certificate or signing key", ca.Name)
+	}
+	ecPubKey, ok := ca.SignCert.PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return errors.Errorf("CA %s does not have an ECDSA public key", ca.Name)
+	}
+
+	template := x509Template()
+	template.IsCA = true
+	template.KeyUsage = ca.SignCert.KeyUsage
+	template.ExtKeyUsage = ca.SignCert.ExtKeyUsage
+	template.Subject = ca.SignCert.Subject
+	template.SubjectKeyId = ca.SignCert.SubjectKeyId
+
+	x509Cert, err := genCertificateECDSA(baseDir, ca.Name, &template, &template,
+		ecPubKey, ca.Signer)
+	if err != nil {
+		return err
+	}
+	ca.SignCert = x509Cert
+
+	return nil
+}
+
+// ReissueCertificate re-issues a certificate previously signed by the CA with
+// a new validity period, adding the given subject alternative names to the
+// ones it already has, and saves it in baseDir/name.  The subject, key and
+// key usages of the certificate are kept.
+func (ca *CA) ReissueCertificate(baseDir, name string, cert *x509.Certificate, sans []string) (*x509.Certificate, error) {
+	pub, ok := cert.PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, errors.Errorf("certificate %s does not have an ECDSA public key", cert.Subject.CommonName)
+	}
+
+	template := x509Template()
+	template.KeyUsage = cert.KeyUsage
+	template.ExtKeyUsage = cert.ExtKeyUsage
+	template.Subject = cert.Subject
+	template.DNSNames = cert.DNSNames
+	template.IPAddresses = cert.IPAddresses
+	for _, san := range sans {
+		// try to parse as an IP address first
+		if ip := net.ParseIP(san); ip != nil {
+			if !containsIP(template.IPAddresses, ip) {
+				template.IPAddresses = append(template.IPAddresses, ip)
+			}
+		} else if !containsString(template.DNSNames, san) {
+			template.DNSNames = append(template.DNSNames, san)
+		}
+	}
+
+	return genCertificateECDSA(baseDir, name, &template, ca.SignCert, pub, ca.Signer)
+}
+
+func containsIP(ips []net.IP, ip net.IP) bool {
+	for _, i := range ips {
+		if i.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(strs []string, str string) bool {
+	for _, s := range strs {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
+
 // default template for X509 subject
 func subjectTemplate() pkix.Name {
 	return pkix.Name{
@@ -210,6 +210,10 @@ var (
 	ext           = app.Command("extend", "Extend existing network")
 	inputDir      = ext.Flag("input", "The input directory in which existing network place").Default("crypto-config").String()
 	extConfigFile = ext.Flag("config", "The configuration template to use").File()
+
+	ren             = app.Command("renew", "Renew the certificates of existing network")
+	renewInputDir   = ren.Flag("input", "The input directory in which existing network place").Default("crypto-config").String()
+	renewConfigFile = ren.Flag("config", "The configuration template to use").File()
 )
 
 func main() {
@@ -223,6 +227,9 @@ func main() {
 	case ext.FullCommand():
 		extend()
 
+	case ren.FullCommand():
+		renew()
+
 		// "showtemplate" command
 	case showtemplate.FullCommand():
 		fmt.Print(defaultConfig)
@@ -251,6 +258,13 @@ func getConfig() (*Config, error) {
 			return nil, fmt.Errorf("Error reading configuration: %s", err)
 		}
 
+		configData = string(data)
+	} else if *renewConfigFile != nil {
+		data, err := ioutil.ReadAll(*renewConfigFile)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading configuration: %s", err)
+		}
+
 		configData = string(data)
 	} else {
 		configData = defaultConfig
@@ -370,6 +384,209 @@ func extendOrdererOrg(orgSpec OrgSpec) {
 	}
 }
 
+// renew re-issues the certificates of the existing network with a new
+// validity period, keeping the existing keys, so that the network can keep
+// running with the crypto material it was started with.  The SANs of the
+// configuration are added to the TLS certificates of the nodes, and the
+// organizations and nodes missing from the network are generated as extend
+// does.
+func renew() {
+	config, err := getConfig()
+	if err != nil {
+		fmt.Printf("Error reading config: %s", err)
+		os.Exit(-1)
+	}
+
+	for _, orgSpec := range config.PeerOrgs {
+		err = renderOrgSpec(&orgSpec, "peer")
+		if err != nil {
+			fmt.Printf("Error processing peer configuration: %s", err)
+			os.Exit(-1)
+		}
+		renewPeerOrg(orgSpec)
+	}
+
+	for _, orgSpec := range config.OrdererOrgs {
+		err = renderOrgSpec(&orgSpec, "orderer")
+		if err != nil {
+			fmt.Printf("Error processing orderer configuration: %s", err)
+			os.Exit(-1)
+		}
+		renewOrdererOrg(orgSpec)
+	}
+}
+
+func renewPeerOrg(orgSpec OrgSpec) {
+	orgName := orgSpec.Domain
+	orgDir := filepath.Join(*renewInputDir, "peerOrganizations", orgName)
+	if _, err := os.Stat(orgDir); os.IsNotExist(err) {
+		generatePeerOrg(*renewInputDir, orgSpec)
+		return
+	}
+
+	mspDir := filepath.Join(orgDir, "msp")
+	peersDir := filepath.Join(orgDir, "peers")
+	usersDir := filepath.Join(orgDir, "users")
+
+	signCA := renewCA(filepath.Join(orgDir, "ca"), orgSpec, orgSpec.CA.CommonName)
+	tlsCA := renewCA(filepath.Join(orgDir, "tlsca"), orgSpec, "tls"+orgSpec.CA.CommonName)
+
+	err := msp.RenewVerifyingMSP(mspDir, signCA, tlsCA)
+	if err != nil {
+		fmt.Printf("Error renewing MSP for org %s:\n%v\n", orgName, err)
+		os.Exit(1)
+	}
+
+	renewNodes(peersDir, orgSpec.Specs, signCA, tlsCA, msp.PEER, orgSpec.EnableNodeOUs)
+
+	// TODO: add ability to specify usernames
+	users := []NodeSpec{}
+	for j := 1; j <= orgSpec.Users.Count; j++ {
+		user := NodeSpec{
+			CommonName: fmt.Sprintf("%s%d@%s", userBaseName, j, orgName),
+		}
+
+		users = append(users, user)
+	}
+	adminUser := NodeSpec{
+		CommonName: fmt.Sprintf("%s@%s", adminBaseName, orgName),
+	}
+
+	users = append(users, adminUser)
+	renewNodes(usersDir, users, signCA, tlsCA, msp.CLIENT, orgSpec.EnableNodeOUs)
+
+	// replace the admin cert in the org's MSP admincerts and in each of the
+	// org's peer's MSP admincerts with the renewed one
+	adminCertsDirs := []string{filepath.Join(mspDir, "admincerts")}
+	for _, spec := range orgSpec.Specs {
+		adminCertsDirs = append(adminCertsDirs, filepath.Join(peersDir, spec.CommonName, "msp", "admincerts"))
+	}
+	for _, adminCertsDir := range adminCertsDirs {
+		err = renewAdminCert(usersDir, adminCertsDir, adminUser.CommonName)
+		if err != nil {
+			fmt.Printf("Error copying admin cert for org %s to %s:\n%v\n",
+				orgName, adminCertsDir, err)
+			os.Exit(1)
+		}
+	}
+}
+
+func renewOrdererOrg(orgSpec OrgSpec) {
+	orgName := orgSpec.Domain
+	orgDir := filepath.Join(*renewInputDir, "ordererOrganizations", orgName)
+	if _, err := os.Stat(orgDir); os.IsNotExist(err) {
+		generateOrdererOrg(*renewInputDir, orgSpec)
+		return
+	}
+
+	mspDir := filepath.Join(orgDir, "msp")
+	orderersDir := filepath.Join(orgDir, "orderers")
+	usersDir := filepath.Join(orgDir, "users")
+
+	signCA := renewCA(filepath.Join(orgDir, "ca"), orgSpec, orgSpec.CA.CommonName)
+	tlsCA := renewCA(filepath.Join(orgDir, "tlsca"), orgSpec, "tls"+orgSpec.CA.CommonName)
+
+	err := msp.RenewVerifyingMSP(mspDir, signCA, tlsCA)
+	if err != nil {
+		fmt.Printf("Error renewing MSP for org %s:\n%v\n", orgName, err)
+		os.Exit(1)
+	}
+
+	renewNodes(orderersDir, orgSpec.Specs, signCA, tlsCA, msp.ORDERER, false)
+
+	adminUser := NodeSpec{
+		CommonName: fmt.Sprintf("%s@%s", adminBaseName, orgName),
+	}
+	renewNodes(usersDir, []NodeSpec{adminUser}, signCA, tlsCA, msp.CLIENT, false)
+
+	// replace the admin cert in the org's MSP admincerts and in each of the
+	// org's orderers's MSP admincerts with the renewed one
+	adminCertsDirs := []string{filepath.Join(mspDir, "admincerts")}
+	for _, spec := range orgSpec.Specs {
+		adminCertsDirs = append(adminCertsDirs, filepath.Join(orderersDir, spec.CommonName, "msp", "admincerts"))
+	}
+	for _, adminCertsDir := range adminCertsDirs {
+		err = renewAdminCert(usersDir, adminCertsDir, adminUser.CommonName)
+		if err != nil {
+			fmt.Printf("Error copying admin cert for org %s to %s:\n%v\n",
+				orgName, adminCertsDir, err)
+			os.Exit(1)
+		}
+	}
+}
+
+// renewCA loads the CA of the organization from caDir and re-issues its
+// certificate.
+func renewCA(caDir string, spec OrgSpec, name string) *ca.CA {
+	_, signer, err := csp.LoadPrivateKey(caDir)
+	if err == nil && signer == nil {
+		err = fmt.Errorf("no private key found in %s", caDir)
+	}
+	if err != nil {
+		fmt.Printf("Error loading CA %s:\n%v\n", name, err)
+		os.Exit(1)
+	}
+	cert, err := ca.LoadCertificateECDSA(caDir)
+	if err == nil && cert == nil {
+		err = fmt.Errorf("no certificate found in %s", caDir)
+	}
+	if err != nil {
+		fmt.Printf("Error loading CA %s:\n%v\n", name, err)
+		os.Exit(1)
+	}
+
+	signCA := &ca.CA{
+		Name:               name,
+		Signer:             signer,
+		SignCert:           cert,
+		Country:            spec.CA.Country,
+		Province:           spec.CA.Province,
+		Locality:           spec.CA.Locality,
+		OrganizationalUnit: spec.CA.OrganizationalUnit,
+		StreetAddress:      spec.CA.StreetAddress,
+		PostalCode:         spec.CA.PostalCode,
+	}
+	err = signCA.Renew(caDir)
+	if err != nil {
+		fmt.Printf("Error renewing CA %s:\n%v\n", name, err)
+		os.Exit(1)
+	}
+
+	return signCA
+}
+
+// renewNodes re-issues the certificates of the existing nodes, and generates
+// the missing ones.
+func renewNodes(baseDir string, nodes []NodeSpec, signCA *ca.CA, tlsCA *ca.CA, nodeType int, nodeOUs bool) {
+	for _, node := range nodes {
+		nodeDir := filepath.Join(baseDir, node.CommonName)
+		if _, err := os.Stat(nodeDir); os.IsNotExist(err) {
+			err := msp.GenerateLocalMSP(nodeDir, node.CommonName, node.SANS, signCA, tlsCA, nodeType, nodeOUs)
+			if err != nil {
+				fmt.Printf("Error generating local MSP for %s:\n%v\n", node, err)
+				os.Exit(1)
+			}
+			continue
+		}
+
+		err := msp.RenewLocalMSP(nodeDir, node.CommonName, node.SANS, signCA, tlsCA, nodeType)
+		if err != nil {
+			fmt.Printf("Error renewing local MSP for %s:\n%v\n", node, err)
+			os.Exit(1)
+		}
+	}
+}
+
+// renewAdminCert replaces the content of admincerts with the admin cert,
+// even if a previous admin cert of the same name is already there.
+func renewAdminCert(usersDir, adminCertsDir, adminUserName string) error {
+	err := os.RemoveAll(adminCertsDir)
+	if err != nil {
+		return err
+	}
+	return copyAdminCert(usersDir, adminCertsDir, adminUserName)
+}
+
 func generate() {
 
 	config, err := getConfig()
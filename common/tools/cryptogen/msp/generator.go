@@ -9,6 +9,7 @@ import (
 	"crypto/x509"
 	"encoding/hex"
 	"encoding/pem"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/hyperledger/fabric/common/tools/cryptogen/ca"
 	"github.com/hyperledger/fabric/common/tools/cryptogen/csp"
 	fabricmsp "github.com/hyperledger/fabric/msp"
+	"github.com/pkg/errors"
 	"gopkg.in/yaml.v2"
 )
 
@@ -199,6 +201,74 @@ func GenerateVerifyingMSP(baseDir string, signCA *ca.CA, tlsCA *ca.CA, nodeOUs b
 	return nil
 }
 
+// RenewLocalMSP re-issues the signing and TLS certificates of the local MSP
+// previously generated in baseDir with GenerateLocalMSP, keeping their keys.
+// The given SANs are added to the TLS certificate, and the CA certificates
+// are replaced with the ones of signCA and tlsCA.
+func RenewLocalMSP(baseDir, name string, sans []string, signCA *ca.CA,
+	tlsCA *ca.CA, nodeType int) error {
+
+	mspDir := filepath.Join(baseDir, "msp")
+	tlsDir := filepath.Join(baseDir, "tls")
+
+	signCertsDir := filepath.Join(mspDir, "signcerts")
+	cert, err := ca.LoadCertificateECDSA(signCertsDir)
+	if err != nil {
+		return err
+	}
+	if cert == nil {
+		return errors.Errorf("no signing certificate found in %s", signCertsDir)
+	}
+	_, err = signCA.ReissueCertificate(signCertsDir, name, cert, nil)
+	if err != nil {
+		return err
+	}
+
+	// the signing CA certificate goes into cacerts
+	err = x509Export(filepath.Join(mspDir, "cacerts", x509Filename(signCA.Name)), signCA.SignCert)
+	if err != nil {
+		return err
+	}
+	// the TLS CA certificate goes into tlscacerts
+	err = x509Export(filepath.Join(mspDir, "tlscacerts", x509Filename(tlsCA.Name)), tlsCA.SignCert)
+	if err != nil {
+		return err
+	}
+
+	tlsFilePrefix := "server"
+	if nodeType == CLIENT {
+		tlsFilePrefix = "client"
+	}
+	tlsCertPath := filepath.Join(tlsDir, tlsFilePrefix+".crt")
+	tlsCert, err := loadCertificate(tlsCertPath)
+	if err != nil {
+		return err
+	}
+	_, err = tlsCA.ReissueCertificate(tlsDir, name, tlsCert, sans)
+	if err != nil {
+		return err
+	}
+	err = os.Rename(filepath.Join(tlsDir, x509Filename(name)), tlsCertPath)
+	if err != nil {
+		return err
+	}
+
+	return x509Export(filepath.Join(tlsDir, "ca.crt"), tlsCA.SignCert)
+}
+
+// RenewVerifyingMSP replaces the CA certificates of the verifying MSP
+// previously generated in baseDir with GenerateVerifyingMSP with the ones of
+// signCA and tlsCA.
+func RenewVerifyingMSP(baseDir string, signCA *ca.CA, tlsCA *ca.CA) error {
+	// the signing CA certificate goes into cacerts
+	err := x509Export(filepath.Join(baseDir, "cacerts", x509Filename(signCA.Name)), signCA.SignCert)
+	if err != nil {
+		return err
+	}
+	// the TLS CA certificate goes into tlscacerts
+	return x509Export(filepath.Join(baseDir, "tlscacerts", x509Filename(tlsCA.Name)), tlsCA.SignCert)
+}
+
 func createFolderStructure(rootDir string, local bool) error {
 
 	var folders []string
@@ -237,6 +307,18 @@ func keyExport(keystore, output string, key bccsp.Key) error {
 	return os.Rename(filepath.Join(keystore, id+"_sk"), output)
 }
 
+func loadCertificate(path string) (*x509.Certificate, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(raw)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.Errorf("%s: wrong PEM encoding", path)
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
 func pemExport(path, pemType string, bytes []byte) error {
 	//write pem out to file
 	file, err := os.Create(path)
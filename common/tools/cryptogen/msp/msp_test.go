@@ -6,6 +6,8 @@ SPDX-License-Identifier: Apache-2.0
 package msp_test
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -15,6 +17,7 @@ import (
 	"github.com/hyperledger/fabric/common/tools/cryptogen/msp"
 	fabricmsp "github.com/hyperledger/fabric/msp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v2"
 )
 
@@ -171,6 +174,105 @@ func TestGenerateVerifyingMSP(t *testing.T) {
 	cleanup(testDir)
 }
 
+func TestRenewLocalMSP(t *testing.T) {
+
+	cleanup(testDir)
+
+	caDir := filepath.Join(testDir, "ca")
+	tlsCADir := filepath.Join(testDir, "tlsca")
+	mspDir := filepath.Join(testDir, "msp")
+	tlsDir := filepath.Join(testDir, "tls")
+
+	signCA, err := ca.NewCA(caDir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode)
+	assert.NoError(t, err, "Error generating CA")
+	tlsCA, err := ca.NewCA(tlsCADir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode)
+	assert.NoError(t, err, "Error generating CA")
+
+	err = msp.RenewLocalMSP(testDir, testName, nil, signCA, tlsCA, msp.PEER)
+	assert.Error(t, err, "Renewing a missing MSP should have failed")
+
+	err = msp.GenerateLocalMSP(testDir, testName, []string{testName}, signCA, tlsCA, msp.PEER, true)
+	assert.NoError(t, err, "Failed to generate local MSP")
+	signCert, err := ca.LoadCertificateECDSA(filepath.Join(mspDir, "signcerts"))
+	assert.NoError(t, err)
+	tlsKey, err := ioutil.ReadFile(filepath.Join(tlsDir, "server.key"))
+	assert.NoError(t, err)
+
+	assert.NoError(t, signCA.Renew(caDir), "Failed to renew CA")
+	assert.NoError(t, tlsCA.Renew(tlsCADir), "Failed to renew CA")
+	err = msp.RenewLocalMSP(testDir, testName, []string{testName, "peer0.example.com"}, signCA, tlsCA, msp.PEER)
+	assert.NoError(t, err, "Failed to renew local MSP")
+
+	renewedSignCert, err := ca.LoadCertificateECDSA(filepath.Join(mspDir, "signcerts"))
+	assert.NoError(t, err)
+	assert.NotEqual(t, signCert.SerialNumber, renewedSignCert.SerialNumber)
+	assert.Equal(t, signCert.PublicKey, renewedSignCert.PublicKey)
+
+	tlsCertPEM, err := ioutil.ReadFile(filepath.Join(tlsDir, "server.crt"))
+	assert.NoError(t, err)
+	block, _ := pem.Decode(tlsCertPEM)
+	require.NotNil(t, block)
+	renewedTLSCert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	assert.Equal(t, []string{testName, "peer0.example.com"}, renewedTLSCert.DNSNames)
+	renewedTLSKey, err := ioutil.ReadFile(filepath.Join(tlsDir, "server.key"))
+	assert.NoError(t, err)
+	assert.Equal(t, tlsKey, renewedTLSKey)
+	assert.False(t, checkForFile(filepath.Join(tlsDir, testName+"-cert.pem")))
+
+	caCert, err := ioutil.ReadFile(filepath.Join(mspDir, "cacerts", testCAName+"-cert.pem"))
+	assert.NoError(t, err)
+	renewedCACert, err := ioutil.ReadFile(filepath.Join(caDir, testCAName+"-cert.pem"))
+	assert.NoError(t, err)
+	assert.Equal(t, renewedCACert, caCert)
+
+	// finally check to see if we can load this as a local MSP config
+	testMSPConfig, err := fabricmsp.GetLocalMspConfig(mspDir, nil, testName)
+	assert.NoError(t, err, "Error parsing local MSP config")
+	testMSP, err := fabricmsp.New(&fabricmsp.BCCSPNewOpts{NewBaseOpts: fabricmsp.NewBaseOpts{Version: fabricmsp.MSPv1_0}})
+	assert.NoError(t, err, "Error creating new BCCSP MSP")
+	err = testMSP.Setup(testMSPConfig)
+	assert.NoError(t, err, "Error setting up local MSP")
+
+	cleanup(testDir)
+}
+
+func TestRenewVerifyingMSP(t *testing.T) {
+
+	caDir := filepath.Join(testDir, "ca")
+	tlsCADir := filepath.Join(testDir, "tlsca")
+	mspDir := filepath.Join(testDir, "msp")
+	signCA, err := ca.NewCA(caDir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode)
+	assert.NoError(t, err, "Error generating CA")
+	tlsCA, err := ca.NewCA(tlsCADir, testCAOrg, "tls"+testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode)
+	assert.NoError(t, err, "Error generating CA")
+
+	err = msp.GenerateVerifyingMSP(mspDir, signCA, tlsCA, false)
+	assert.NoError(t, err, "Failed to generate verifying MSP")
+
+	assert.NoError(t, signCA.Renew(caDir), "Failed to renew CA")
+	assert.NoError(t, tlsCA.Renew(tlsCADir), "Failed to renew CA")
+	err = msp.RenewVerifyingMSP(mspDir, signCA, tlsCA)
+	assert.NoError(t, err, "Failed to renew verifying MSP")
+
+	for dir, name := range map[string]string{caDir: testCAName, tlsCADir: "tls" + testCAName} {
+		expected, err := ioutil.ReadFile(filepath.Join(dir, name+"-cert.pem"))
+		assert.NoError(t, err)
+		subDir := "cacerts"
+		if dir == tlsCADir {
+			subDir = "tlscacerts"
+		}
+		actual, err := ioutil.ReadFile(filepath.Join(mspDir, subDir, name+"-cert.pem"))
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	}
+
+	tlsCA.Name = "test/fail"
+	err = msp.RenewVerifyingMSP(mspDir, signCA, tlsCA)
+	assert.Error(t, err, "Should have failed with CA name 'test/fail'")
+	cleanup(testDir)
+}
+
 func TestExportConfig(t *testing.T) {
 	path := filepath.Join(testDir, "export-test")
 	configFile := filepath.Join(path, "config.yaml")
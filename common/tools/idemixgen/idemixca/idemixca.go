@@ -8,6 +8,7 @@ package idemixca
 
 import (
 	"crypto/ecdsa"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric-amcl/amcl/FP256BN"
@@ -96,3 +97,48 @@ func GenerateSignerConfig(roleMask int, ouString string, enrollmentId string, re
 
 	return proto.Marshal(signer)
 }
+
+// GenerateCRI creates the credential revocation information of the given epoch,
+// in which only the credentials with the given revocation handles are not revoked.
+// Signers use it to prove that their credential is not revoked.
+func GenerateCRI(revKey *ecdsa.PrivateKey, unrevokedHandles []int, epoch int) ([]byte, error) {
+	rng, err := idemix.GetRand()
+	if err != nil {
+		return nil, errors.WithMessage(err, "Error getting PRNG")
+	}
+
+	handles := make([]*FP256BN.BIG, len(unrevokedHandles))
+	for i, rh := range unrevokedHandles {
+		handles[i] = FP256BN.NewBIGint(rh)
+	}
+	cri, err := idemix.CreateCRI(revKey, handles, epoch, idemix.ALG_PLAIN_SIGNATURE, rng)
+	if err != nil {
+		return nil, err
+	}
+	criBytes, err := proto.Marshal(cri)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to marshal CRI")
+	}
+	return criBytes, nil
+}
+
+// GeneratePreviousIssuerPublicKey wraps a serialized issuer public key which is
+// rotated out, so that the MSP keeps accepting the credentials it issued until
+// notAfter, while their holders get new credentials from the new issuer key.
+func GeneratePreviousIssuerPublicKey(ipkBytes []byte, notAfter time.Time) ([]byte, error) {
+	ipk := &idemix.IssuerPublicKey{}
+	err := proto.Unmarshal(ipkBytes, ipk)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal issuer public key")
+	}
+
+	previous := &m.IdemixPreviousIssuerPublicKey{
+		Ipk:      ipkBytes,
+		NotAfter: notAfter.Unix(),
+	}
+	previousBytes, err := proto.Marshal(previous)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to marshal previous issuer public key")
+	}
+	return previousBytes, nil
+}
@@ -13,6 +13,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/idemix"
@@ -56,6 +57,20 @@ func TestIdemixCa(t *testing.T) {
 	assert.NoError(t, writeSignerToFile(conf))
 	assert.NoError(t, setupMSP())
 
+	// Revoke the second signer in epoch 1
+	cri, err := GenerateCRI(revocationkey, []int{1}, 1)
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(testDir, m.IdemixConfigDirMsp, m.IdemixConfigFileCRI), cri, 0644))
+	err = setupMSP()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "the credential is revoked in epoch 1")
+
+	conf, err = GenerateSignerConfig(m.GetRoleMaskFromIdemixRole(m.MEMBER), "OU1", "enrollmentid1", 1, key, revocationkey)
+	assert.NoError(t, err)
+	cleanupSigner()
+	assert.NoError(t, writeSignerToFile(conf))
+	assert.NoError(t, setupMSP())
+
 	// Without the verifier dir present, setup should give an error
 	cleanupVerifier()
 	assert.Error(t, setupMSP())
@@ -67,6 +82,50 @@ func TestIdemixCa(t *testing.T) {
 	assert.EqualError(t, err, "the enrollment id value is empty")
 }
 
+func TestIdemixCaRotation(t *testing.T) {
+	cleanup()
+
+	isk, ipkBytes, err := GenerateIssuerKey()
+	assert.NoError(t, err)
+	ipk := &idemix.IssuerPublicKey{}
+	assert.NoError(t, proto.Unmarshal(ipkBytes, ipk))
+
+	revocationkey, err := idemix.GenerateLongTermRevocationKey()
+	assert.NoError(t, err)
+	encodedRevocationPK, err := x509.MarshalPKIXPublicKey(revocationkey.Public())
+	assert.NoError(t, err)
+	pemEncodedRevocationPK := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: encodedRevocationPK})
+
+	conf, err := GenerateSignerConfig(m.GetRoleMaskFromIdemixRole(m.MEMBER), "OU1", "enrollmentid1", 1, &idemix.IssuerKey{Isk: isk, Ipk: ipk}, revocationkey)
+	assert.NoError(t, err)
+	assert.NoError(t, writeSignerToFile(conf))
+
+	// Rotate the issuer key
+	_, newIpkBytes, err := GenerateIssuerKey()
+	assert.NoError(t, err)
+	assert.NoError(t, writeVerifierToFile(newIpkBytes, pemEncodedRevocationPK))
+	err = setupMSP()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Credential is not cryptographically valid")
+
+	// The credential issued with the previous key is accepted until the key expires
+	previous, err := GeneratePreviousIssuerPublicKey(ipkBytes, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	previousDir := filepath.Join(testDir, m.IdemixConfigDirMsp, m.IdemixConfigDirPreviousIssuerPublicKeys)
+	assert.NoError(t, os.Mkdir(previousDir, os.ModePerm))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(previousDir, "ipk1"), previous, 0644))
+	assert.NoError(t, setupMSP())
+
+	_, err = GeneratePreviousIssuerPublicKey([]byte("barf"), time.Now())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to unmarshal issuer public key")
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(previousDir, "ipk2"), []byte("barf"), 0644))
+	err = setupMSP()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to unmarshal previous issuer public key ipk2")
+}
+
 func cleanup() error {
 	// clean up any previous files
 	err := os.RemoveAll(testDir)
@@ -14,17 +14,20 @@ package main
 import (
 	"crypto/ecdsa"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/pem"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/common/tools/idemixgen/idemixca"
 	"github.com/hyperledger/fabric/common/tools/idemixgen/metadata"
 	"github.com/hyperledger/fabric/idemix"
 	"github.com/hyperledger/fabric/msp"
+	m "github.com/hyperledger/fabric/protos/msp"
 	"github.com/pkg/errors"
 	"gopkg.in/alecthomas/kingpin.v2"
 )
@@ -42,11 +45,16 @@ var (
 	outputDir = app.Flag("output", "The output directory in which to place artifacts").Default("idemix-config").String()
 
 	genIssuerKey            = app.Command("ca-keygen", "Generate CA key material")
+	genRotateIssuerKey      = app.Command("ca-rotate", "Replace the CA key with a new one, accepting the credentials issued with the current key for a while")
+	genRotateValidity       = genRotateIssuerKey.Flag("validity", "How long the credentials issued with the current key are still accepted").Short('v').Default("720h").Duration()
 	genSignerConfig         = app.Command("signerconfig", "Generate a default signer for this Idemix MSP")
 	genCredOU               = genSignerConfig.Flag("org-unit", "The Organizational Unit of the default signer").Short('u').String()
 	genCredIsAdmin          = genSignerConfig.Flag("admin", "Make the default signer admin").Short('a').Bool()
 	genCredEnrollmentId     = genSignerConfig.Flag("enrollmentId", "The enrollment id of the default signer").Short('e').String()
 	genCredRevocationHandle = genSignerConfig.Flag("revocationHandle", "The handle used to revoke this signer").Short('r').Int()
+	genCRI                  = app.Command("cri", "Generate the credential revocation information of an epoch, revoking all signers but the given ones")
+	genCRIEpoch             = genCRI.Flag("epoch", "The epoch of the credential revocation information").Short('e').Required().Int()
+	genCRIUnrevokedHandles  = genCRI.Flag("unrevokedHandle", "The revocation handle of a signer that is not revoked").Short('u').Ints()
 
 	version = app.Command("version", "Show version information")
 )
@@ -86,6 +94,28 @@ func main() {
 		writeFile(filepath.Join(*outputDir, msp.IdemixConfigDirMsp, msp.IdemixConfigFileRevocationPublicKey), pemEncodedRevocationPK)
 		writeFile(filepath.Join(*outputDir, msp.IdemixConfigDirMsp, msp.IdemixConfigFileIssuerPublicKey), ipk)
 
+	case genRotateIssuerKey.FullCommand():
+		current := readIssuerKey()
+		currentIpk, err := proto.Marshal(current.Ipk)
+		handleError(err)
+		previous, err := idemixca.GeneratePreviousIssuerPublicKey(currentIpk, time.Now().Add(*genRotateValidity))
+		handleError(err)
+
+		isk, ipk, err := idemixca.GenerateIssuerKey()
+		handleError(err)
+
+		// Keep accepting the credentials issued with the current key, and
+		// stop accepting those of the keys which expired in the meantime
+		previousDir := filepath.Join(*outputDir, msp.IdemixConfigDirMsp, msp.IdemixConfigDirPreviousIssuerPublicKeys)
+		handleError(os.MkdirAll(previousDir, 0770))
+		removeExpiredIssuerPublicKeys(previousDir)
+		writeFile(filepath.Join(previousDir, hex.EncodeToString(current.Ipk.Hash)), previous)
+
+		// The current secret key is replaced, so that no more credentials are issued with it
+		writeFile(filepath.Join(*outputDir, IdemixDirIssuer, IdemixConfigIssuerSecretKey), isk)
+		writeFile(filepath.Join(*outputDir, IdemixDirIssuer, msp.IdemixConfigFileIssuerPublicKey), ipk)
+		writeFile(filepath.Join(*outputDir, msp.IdemixConfigDirMsp, msp.IdemixConfigFileIssuerPublicKey), ipk)
+
 	case genSignerConfig.FullCommand():
 		roleMask := 0
 		if *genCredIsAdmin {
@@ -103,6 +133,13 @@ func main() {
 		handleError(os.Mkdir(filepath.Join(*outputDir, msp.IdemixConfigDirUser), 0770))
 		writeFile(filepath.Join(*outputDir, msp.IdemixConfigDirUser, msp.IdemixConfigFileSigner), config)
 
+	case genCRI.FullCommand():
+		cri, err := idemixca.GenerateCRI(readRevocationKey(), *genCRIUnrevokedHandles, *genCRIEpoch)
+		handleError(err)
+
+		// Write the CRI next to the verifier config, replacing the one of the previous epoch
+		writeFile(filepath.Join(*outputDir, msp.IdemixConfigDirMsp, msp.IdemixConfigFileCRI), cri)
+
 	case version.FullCommand():
 		printVersion()
 	}
@@ -153,6 +190,24 @@ func readRevocationKey() *ecdsa.PrivateKey {
 	return key
 }
 
+// removeExpiredIssuerPublicKeys removes the previous issuer public keys which expired from the given directory
+func removeExpiredIssuerPublicKeys(dir string) {
+	files, err := ioutil.ReadDir(dir)
+	handleError(err)
+	for _, f := range files {
+		path := filepath.Join(dir, f.Name())
+		raw, err := ioutil.ReadFile(path)
+		handleError(err)
+		previous := &m.IdemixPreviousIssuerPublicKey{}
+		if err := proto.Unmarshal(raw, previous); err != nil {
+			handleError(errors.Wrapf(err, "failed to unmarshal previous issuer public key file: %s", path))
+		}
+		if time.Now().After(time.Unix(previous.NotAfter, 0)) {
+			handleError(os.Remove(path))
+		}
+	}
+}
+
 // checkDirectoryNotExists checks whether a directory with the given path already exists and exits if this is the case
 func checkDirectoryNotExists(path string, errorMessage string) {
 	_, err := os.Stat(path)
@@ -0,0 +1,80 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package protolator
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/pkg/errors"
+)
+
+// MarshalCanonicalJSON returns the canonical JSON representation of the
+// message.  It is the representation produced by DeepMarshalJSON, as used by
+// configtxlator, in which the opaque bytes fields holding nested messages,
+// such as the payload of an envelope or the config of a config transaction,
+// are decoded according to their type, the bytes which are not messages are
+// base64 encoded and 64 bit integers are strings.  In addition, the keys of
+// all objects are sorted and the document is indented with two spaces and
+// terminated by a newline, so that equal messages are always represented by
+// the same bytes and can be compared with text tools.
+func MarshalCanonicalJSON(msg proto.Message) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := DeepMarshalJSON(buf, msg); err != nil {
+		return nil, errors.Wrapf(err, "error encoding %s to JSON", proto.MessageName(msg))
+	}
+
+	var doc interface{}
+	decoder := json.NewDecoder(buf)
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, errors.Wrapf(err, "error decoding JSON of %s", proto.MessageName(msg))
+	}
+
+	// maps are encoded with their keys sorted
+	out := &bytes.Buffer{}
+	encoder := json.NewEncoder(out)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return nil, errors.Wrapf(err, "error encoding %s to JSON", proto.MessageName(msg))
+	}
+	return out.Bytes(), nil
+}
+
+// UnmarshalCanonicalJSON decodes the JSON representation of a message into
+// msg, re-encoding its nested messages.  Any JSON produced by DeepMarshalJSON
+// is accepted, whatever the order of its keys and its indentation.
+func UnmarshalCanonicalJSON(data []byte, msg proto.Message) error {
+	if err := DeepUnmarshalJSON(bytes.NewReader(data), msg); err != nil {
+		return errors.Wrapf(err, "error decoding %s from JSON", proto.MessageName(msg))
+	}
+	return nil
+}
+
+// UnmarshalBlockJSON decodes the JSON representation of a block
+func UnmarshalBlockJSON(data []byte) (*common.Block, error) {
+	block := &common.Block{}
+	err := UnmarshalCanonicalJSON(data, block)
+	return block, err
+}
+
+// UnmarshalEnvelopeJSON decodes the JSON representation of an envelope
+func UnmarshalEnvelopeJSON(data []byte) (*common.Envelope, error) {
+	env := &common.Envelope{}
+	err := UnmarshalCanonicalJSON(data, env)
+	return env, err
+}
+
+// UnmarshalConfigJSON decodes the JSON representation of a channel config
+func UnmarshalConfigJSON(data []byte) (*common.Config, error) {
+	config := &common.Config{}
+	err := UnmarshalCanonicalJSON(data, config)
+	return config, err
+}
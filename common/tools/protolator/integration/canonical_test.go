@@ -0,0 +1,102 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package integration
+
+import (
+	"encoding/json"
+	"testing"
+
+	configtxtest "github.com/hyperledger/fabric/common/configtx/test"
+	"github.com/hyperledger/fabric/common/tools/protolator"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalJSONBlock(t *testing.T) {
+	block, err := configtxtest.MakeGenesisBlock("mychannel")
+	require.NoError(t, err)
+
+	blockJSON, err := protolator.MarshalCanonicalJSON(block)
+	require.NoError(t, err)
+
+	// the nested messages are decoded
+	var doc struct {
+		Data struct {
+			Data []struct {
+				Payload struct {
+					Header struct {
+						ChannelHeader struct {
+							ChannelID string `json:"channel_id"`
+							Type      int    `json:"type"`
+						} `json:"channel_header"`
+					} `json:"header"`
+					Data struct {
+						Config struct {
+							ChannelGroup json.RawMessage `json:"channel_group"`
+						} `json:"config"`
+					} `json:"data"`
+				} `json:"payload"`
+			} `json:"data"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(blockJSON, &doc))
+	require.Len(t, doc.Data.Data, 1)
+	assert.Equal(t, "mychannel", doc.Data.Data[0].Payload.Header.ChannelHeader.ChannelID)
+	assert.Equal(t, int(cb.HeaderType_CONFIG), doc.Data.Data[0].Payload.Header.ChannelHeader.Type)
+	assert.NotEmpty(t, doc.Data.Data[0].Payload.Data.Config.ChannelGroup)
+
+	// the keys are sorted and the document is indented
+	assert.Regexp(t, `^\{\n  "data": \{`, string(blockJSON))
+	assert.Regexp(t, `\n  "header": \{`, string(blockJSON))
+	assert.Regexp(t, `\n  "metadata": \{`, string(blockJSON))
+	assert.Regexp(t, `\}\n$`, string(blockJSON))
+
+	decoded, err := protolator.UnmarshalBlockJSON(blockJSON)
+	require.NoError(t, err)
+	assert.Equal(t, block.Header, decoded.Header)
+	decodedJSON, err := protolator.MarshalCanonicalJSON(decoded)
+	require.NoError(t, err)
+	assert.Equal(t, string(blockJSON), string(decodedJSON))
+}
+
+func TestCanonicalJSONEnvelopeAndConfig(t *testing.T) {
+	block, err := configtxtest.MakeGenesisBlock("mychannel")
+	require.NoError(t, err)
+	env, err := protoutil.ExtractEnvelope(block, 0)
+	require.NoError(t, err)
+
+	envJSON, err := protolator.MarshalCanonicalJSON(env)
+	require.NoError(t, err)
+	decodedEnv, err := protolator.UnmarshalEnvelopeJSON(envJSON)
+	require.NoError(t, err)
+	chdr, err := protoutil.ChannelHeader(decodedEnv)
+	require.NoError(t, err)
+	assert.Equal(t, "mychannel", chdr.ChannelId)
+
+	configEnv := &cb.ConfigEnvelope{}
+	_, err = protoutil.UnmarshalEnvelopeOfType(env, cb.HeaderType_CONFIG, configEnv)
+	require.NoError(t, err)
+	configJSON, err := protolator.MarshalCanonicalJSON(configEnv.Config)
+	require.NoError(t, err)
+	config, err := protolator.UnmarshalConfigJSON(configJSON)
+	require.NoError(t, err)
+	assert.Equal(t, configEnv.Config.Sequence, config.Sequence)
+	assert.Equal(t, len(configEnv.Config.ChannelGroup.Groups), len(config.ChannelGroup.Groups))
+
+	// the representation does not depend on the encoding of the input
+	compact := &cb.Config{}
+	require.NoError(t, protolator.UnmarshalCanonicalJSON([]byte(`{"sequence":"3"}`), compact))
+	compactJSON, err := protolator.MarshalCanonicalJSON(compact)
+	require.NoError(t, err)
+	assert.Contains(t, string(compactJSON), `"sequence": "3"`)
+
+	_, err = protolator.UnmarshalBlockJSON([]byte("garbage"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "error decoding common.Block from JSON")
+}
@@ -75,6 +75,23 @@ func TestGenesisBlock(t *testing.T) {
 	bidirectionalMarshal(t, gb)
 }
 
+func TestConfigValuesDecoded(t *testing.T) {
+	cg, err := encoder.NewChannelGroup(configtxgentest.Load(genesisconfig.SampleSingleMSPSoloProfile))
+	assert.NoError(t, err)
+
+	var buffer bytes.Buffer
+	assert.NoError(t, protolator.DeepMarshalJSON(&buffer, &cb.Config{ChannelGroup: cg}))
+
+	// the values of the channel, orderer, consortium and org groups are
+	// decoded rather than left as base64
+	doc := buffer.String()
+	assert.Contains(t, doc, `"name": "SHA256"`)
+	assert.Contains(t, doc, `"max_message_count": 10`)
+	assert.Contains(t, doc, `"name": "SampleOrg"`)
+
+	bidirectionalMarshal(t, &cb.Config{ChannelGroup: cg})
+}
+
 func TestEmitDefaultsBug(t *testing.T) {
 	block := &cb.Block{
 		Header: &cb.BlockHeader{
@@ -54,6 +54,10 @@ func (dcg *DynamicChannelGroup) Underlying() proto.Message {
 	return dcg.ConfigGroup
 }
 
+func (dcg *DynamicChannelGroup) DynamicMapFields() []string {
+	return []string{"groups", "values"}
+}
+
 func (dcg *DynamicChannelGroup) DynamicMapFieldProto(name string, key string, base proto.Message) (proto.Message, error) {
 	switch name {
 	case "groups":
@@ -90,8 +94,12 @@ func (dccv *DynamicChannelConfigValue) Underlying() proto.Message {
 	return dccv.ConfigValue
 }
 
+func (dccv *DynamicChannelConfigValue) VariablyOpaqueFields() []string {
+	return []string{"value"}
+}
+
 func (dccv *DynamicChannelConfigValue) VariablyOpaqueFieldProto(name string) (proto.Message, error) {
-	if name != "value " {
+	if name != "value" {
 		return nil, fmt.Errorf("not a marshaled field: %s", name)
 	}
 	switch dccv.name {
@@ -126,6 +134,10 @@ func (dcg *DynamicConsortiumsGroup) Underlying() proto.Message {
 	return dcg.ConfigGroup
 }
 
+func (dcg *DynamicConsortiumsGroup) DynamicMapFields() []string {
+	return []string{"groups", "values"}
+}
+
 func (dcg *DynamicConsortiumsGroup) DynamicMapFieldProto(name string, key string, base proto.Message) (proto.Message, error) {
 	switch name {
 	case "groups":
@@ -152,6 +164,10 @@ func (dcg *DynamicConsortiumGroup) Underlying() proto.Message {
 	return dcg.ConfigGroup
 }
 
+func (dcg *DynamicConsortiumGroup) DynamicMapFields() []string {
+	return []string{"groups", "values"}
+}
+
 func (dcg *DynamicConsortiumGroup) DynamicMapFieldProto(name string, key string, base proto.Message) (proto.Message, error) {
 	switch name {
 	case "groups":
@@ -186,6 +202,10 @@ func (dccv *DynamicConsortiumConfigValue) Underlying() proto.Message {
 	return dccv.ConfigValue
 }
 
+func (dccv *DynamicConsortiumConfigValue) VariablyOpaqueFields() []string {
+	return []string{"value"}
+}
+
 func (dccv *DynamicConsortiumConfigValue) VariablyOpaqueFieldProto(name string) (proto.Message, error) {
 	if name != "value" {
 		return nil, fmt.Errorf("not a marshaled field: %s", name)
@@ -206,6 +226,10 @@ func (dcg *DynamicConsortiumOrgGroup) Underlying() proto.Message {
 	return dcg.ConfigGroup
 }
 
+func (dcg *DynamicConsortiumOrgGroup) DynamicMapFields() []string {
+	return []string{"groups", "values"}
+}
+
 func (dcg *DynamicConsortiumOrgGroup) DynamicMapFieldProto(name string, key string, base proto.Message) (proto.Message, error) {
 	switch name {
 	case "groups":
@@ -234,6 +258,10 @@ func (dcocv *DynamicConsortiumOrgConfigValue) Underlying() proto.Message {
 	return dcocv.ConfigValue
 }
 
+func (dcocv *DynamicConsortiumOrgConfigValue) VariablyOpaqueFields() []string {
+	return []string{"value"}
+}
+
 func (dcocv *DynamicConsortiumOrgConfigValue) VariablyOpaqueFieldProto(name string) (proto.Message, error) {
 	if name != "value" {
 		return nil, fmt.Errorf("not a marshaled field: %s", name)
@@ -37,6 +37,10 @@ func (dcg *DynamicOrdererGroup) Underlying() proto.Message {
 	return dcg.ConfigGroup
 }
 
+func (dcg *DynamicOrdererGroup) DynamicMapFields() []string {
+	return []string{"groups", "values"}
+}
+
 func (dcg *DynamicOrdererGroup) DynamicMapFieldProto(name string, key string, base proto.Message) (proto.Message, error) {
 	switch name {
 	case "groups":
@@ -101,6 +105,10 @@ func (dcg *DynamicOrdererOrgGroup) Underlying() proto.Message {
 	return dcg.ConfigGroup
 }
 
+func (dcg *DynamicOrdererOrgGroup) DynamicMapFields() []string {
+	return []string{"groups", "values"}
+}
+
 func (dcg *DynamicOrdererOrgGroup) DynamicMapFieldProto(name string, key string, base proto.Message) (proto.Message, error) {
 	switch name {
 	case "groups":
@@ -129,6 +137,10 @@ func (docv *DynamicOrdererConfigValue) Underlying() proto.Message {
 	return docv.ConfigValue
 }
 
+func (docv *DynamicOrdererConfigValue) VariablyOpaqueFields() []string {
+	return []string{"value"}
+}
+
 func (docv *DynamicOrdererConfigValue) VariablyOpaqueFieldProto(name string) (proto.Message, error) {
 	if name != "value" {
 		return nil, fmt.Errorf("not a marshaled field: %s", name)
@@ -144,6 +156,8 @@ func (docv *DynamicOrdererConfigValue) VariablyOpaqueFieldProto(name string) (pr
 		return &orderer.KafkaBrokers{}, nil
 	case "ChannelRestrictions":
 		return &orderer.ChannelRestrictions{}, nil
+	case "Decommission":
+		return &orderer.Decommission{}, nil
 	case "Capabilities":
 		return &common.Capabilities{}, nil
 	default:
@@ -160,6 +174,10 @@ func (doocv *DynamicOrdererOrgConfigValue) Underlying() proto.Message {
 	return doocv.ConfigValue
 }
 
+func (doocv *DynamicOrdererOrgConfigValue) VariablyOpaqueFields() []string {
+	return []string{"value"}
+}
+
 func (doocv *DynamicOrdererOrgConfigValue) VariablyOpaqueFieldProto(name string) (proto.Message, error) {
 	if name != "value" {
 		return nil, fmt.Errorf("not a marshaled field: %s", name)
@@ -167,6 +185,8 @@ func (doocv *DynamicOrdererOrgConfigValue) VariablyOpaqueFieldProto(name string)
 	switch doocv.name {
 	case "MSP":
 		return &msp.MSPConfig{}, nil
+	case "Endpoints":
+		return &common.OrdererAddresses{}, nil
 	default:
 		return nil, fmt.Errorf("unknown Orderer Org ConfigValue name: %s", doocv.name)
 	}
@@ -36,6 +36,10 @@ func (dag *DynamicApplicationGroup) Underlying() proto.Message {
 	return dag.ConfigGroup
 }
 
+func (dag *DynamicApplicationGroup) DynamicMapFields() []string {
+	return []string{"groups", "values"}
+}
+
 func (dag *DynamicApplicationGroup) DynamicMapFieldProto(name string, key string, base proto.Message) (proto.Message, error) {
 	switch name {
 	case "groups":
@@ -69,6 +73,10 @@ func (dag *DynamicApplicationOrgGroup) Underlying() proto.Message {
 	return dag.ConfigGroup
 }
 
+func (dag *DynamicApplicationOrgGroup) DynamicMapFields() []string {
+	return []string{"groups", "values"}
+}
+
 func (dag *DynamicApplicationOrgGroup) DynamicMapFieldProto(name string, key string, base proto.Message) (proto.Message, error) {
 	switch name {
 	case "groups":
@@ -97,6 +105,10 @@ func (ccv *DynamicApplicationConfigValue) Underlying() proto.Message {
 	return ccv.ConfigValue
 }
 
+func (ccv *DynamicApplicationConfigValue) VariablyOpaqueFields() []string {
+	return []string{"value"}
+}
+
 func (ccv *DynamicApplicationConfigValue) VariablyOpaqueFieldProto(name string) (proto.Message, error) {
 	if name != "value" {
 		return nil, fmt.Errorf("Not a marshaled field: %s", name)
@@ -106,6 +118,8 @@ func (ccv *DynamicApplicationConfigValue) VariablyOpaqueFieldProto(name string)
 		return &common.Capabilities{}, nil
 	case "ACLs":
 		return &peer.ACLs{}, nil
+	case "InvocationQuota":
+		return &peer.InvocationQuota{}, nil
 	default:
 		return nil, fmt.Errorf("Unknown Application ConfigValue name: %s", ccv.name)
 	}
@@ -120,6 +134,10 @@ func (daocv *DynamicApplicationOrgConfigValue) Underlying() proto.Message {
 	return daocv.ConfigValue
 }
 
+func (daocv *DynamicApplicationOrgConfigValue) VariablyOpaqueFields() []string {
+	return []string{"value"}
+}
+
 func (daocv *DynamicApplicationOrgConfigValue) VariablyOpaqueFieldProto(name string) (proto.Message, error) {
 	if name != "value" {
 		return nil, fmt.Errorf("Not a marshaled field: %s", name)
@@ -129,6 +147,8 @@ func (daocv *DynamicApplicationOrgConfigValue) VariablyOpaqueFieldProto(name str
 		return &msp.MSPConfig{}, nil
 	case "AnchorPeers":
 		return &peer.AnchorPeers{}, nil
+	case "PeerEndpoints":
+		return &peer.PeerEndpoints{}, nil
 	default:
 		return nil, fmt.Errorf("Unknown Application Org ConfigValue name: %s", daocv.name)
 	}
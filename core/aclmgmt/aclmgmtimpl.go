@@ -6,6 +6,12 @@ SPDX-License-Identifier: Apache-2.0
 
 package aclmgmt
 
+import (
+	"github.com/hyperledger/fabric/common/audit"
+	"github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+)
+
 //implementation of aclMgmt. CheckACL calls in fabric result in the following flow
 //    if resourceProvider[resourceName]
 //       return resourceProvider[resourceName].CheckACL(...)
@@ -22,7 +28,29 @@ type aclMgmtImpl struct {
 //id can be extracted for testing against a policy
 func (am *aclMgmtImpl) CheckACL(resName string, channelID string, idinfo interface{}) error {
 	//use the resource based config provider (which will in turn default to 1.0 provider)
-	return am.rescfgProvider.CheckACL(resName, channelID, idinfo)
+	err := am.rescfgProvider.CheckACL(resName, channelID, idinfo)
+	if err != nil {
+		audit.Log(audit.Event{
+			Type:     audit.ACLDenied,
+			Outcome:  audit.Denied,
+			Channel:  channelID,
+			Identity: identity(idinfo),
+			Details:  map[string]string{"resource": resName, "reason": err.Error()},
+		})
+	}
+	return err
+}
+
+//identity returns the audit identity of the creator of the idinfo
+func identity(idinfo interface{}) string {
+	switch idinfo := idinfo.(type) {
+	case *pb.SignedProposal:
+		return audit.ProposalIdentity(idinfo)
+	case *common.Envelope:
+		return audit.EnvelopeIdentity(idinfo)
+	default:
+		return ""
+	}
 }
 
 //ACLProvider consists of two providers, supplied one and a default one (1.0 ACL management
@@ -0,0 +1,51 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package aclmgmt
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/hyperledger/fabric/common/audit"
+	"github.com/hyperledger/fabric/common/localmsp"
+	"github.com/hyperledger/fabric/core/aclmgmt/mocks"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckACLAuditsDenials(t *testing.T) {
+	buf := &bytes.Buffer{}
+	audit.Configure(nil, audit.NewWriterSink(buf))
+	defer audit.Configure(nil)
+
+	defAclProvider := &mocks.DefaultACLProvider{}
+	defAclProvider.IsPtypePolicyReturns(true)
+	am := &aclMgmtImpl{rescfgProvider: &resourceProvider{defaultProvider: defAclProvider}}
+
+	env, err := protoutil.CreateSignedEnvelope(common.HeaderType_CONFIG, "myc", localmsp.NewSigner(), &common.ConfigEnvelope{}, 0, 0)
+	require.NoError(t, err)
+
+	err = am.CheckACL("peer/Propose", "myc", env)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, buf.Len())
+
+	defAclProvider.CheckACLReturns(errors.New("policy not satisfied"))
+	err = am.CheckACL("peer/Propose", "myc", env)
+	assert.EqualError(t, err, "policy not satisfied")
+
+	record, err := audit.Verify(buf)
+	require.NoError(t, err)
+	require.NotNil(t, record)
+	assert.Equal(t, audit.ACLDenied, record.Type)
+	assert.Equal(t, audit.Denied, record.Outcome)
+	assert.Equal(t, "myc", record.Channel)
+	assert.Regexp(t, "^SampleOrg:", record.Identity)
+	assert.Equal(t, map[string]string{"resource": "peer/Propose", "reason": "policy not satisfied"}, record.Details)
+}
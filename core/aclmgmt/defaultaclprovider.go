@@ -81,21 +81,26 @@ func (d *defaultACLProviderImpl) initialize() {
 	d.cResourcePolicyMap[resources.Qscc_GetBlockByHash] = CHANNELREADERS
 	d.cResourcePolicyMap[resources.Qscc_GetTransactionByID] = CHANNELREADERS
 	d.cResourcePolicyMap[resources.Qscc_GetBlockByTxID] = CHANNELREADERS
+	d.cResourcePolicyMap[resources.Qscc_GetBlocksByRange] = CHANNELREADERS
+	d.cResourcePolicyMap[resources.Qscc_GetBlockByNumberHeaderOnly] = CHANNELREADERS
 
 	//--------------- CSCC resources -----------
 	//p resources (implemented by the chaincode currently)
 	d.pResourcePolicyMap[resources.Cscc_JoinChain] = mgmt.Admins
+	d.pResourcePolicyMap[resources.Cscc_JoinChainBySnapshot] = mgmt.Admins
 	d.pResourcePolicyMap[resources.Cscc_GetChannels] = mgmt.Members
 
 	//c resources
 	d.cResourcePolicyMap[resources.Cscc_GetConfigBlock] = CHANNELREADERS
 	d.cResourcePolicyMap[resources.Cscc_GetConfigTree] = CHANNELREADERS
 	d.cResourcePolicyMap[resources.Cscc_SimulateConfigTreeUpdate] = CHANNELWRITERS
+	d.cResourcePolicyMap[resources.Cscc_GetChannelConfigInfo] = CHANNELREADERS
 
 	//---------------- non-scc resources ------------
 	//Peer resources
 	d.cResourcePolicyMap[resources.Peer_Propose] = CHANNELWRITERS
 	d.cResourcePolicyMap[resources.Peer_ChaincodeToChaincode] = CHANNELWRITERS
+	d.cResourcePolicyMap[resources.Peer_RecordSimulation] = CHANNELWRITERS
 	d.cResourcePolicyMap[resources.Token_Issue] = CHANNELWRITERS
 	d.cResourcePolicyMap[resources.Token_Transfer] = CHANNELWRITERS
 	d.cResourcePolicyMap[resources.Token_List] = CHANNELREADERS
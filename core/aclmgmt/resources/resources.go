@@ -23,22 +23,27 @@ const (
 	Lscc_GetCollectionsConfig      = "lscc/GetCollectionsConfig"
 
 	//Qscc resources
-	Qscc_GetChainInfo       = "qscc/GetChainInfo"
-	Qscc_GetBlockByNumber   = "qscc/GetBlockByNumber"
-	Qscc_GetBlockByHash     = "qscc/GetBlockByHash"
-	Qscc_GetTransactionByID = "qscc/GetTransactionByID"
-	Qscc_GetBlockByTxID     = "qscc/GetBlockByTxID"
+	Qscc_GetChainInfo               = "qscc/GetChainInfo"
+	Qscc_GetBlockByNumber           = "qscc/GetBlockByNumber"
+	Qscc_GetBlockByHash             = "qscc/GetBlockByHash"
+	Qscc_GetTransactionByID         = "qscc/GetTransactionByID"
+	Qscc_GetBlockByTxID             = "qscc/GetBlockByTxID"
+	Qscc_GetBlocksByRange           = "qscc/GetBlocksByRange"
+	Qscc_GetBlockByNumberHeaderOnly = "qscc/GetBlockByNumberHeaderOnly"
 
 	//Cscc resources
 	Cscc_JoinChain                = "cscc/JoinChain"
+	Cscc_JoinChainBySnapshot      = "cscc/JoinChainBySnapshot"
 	Cscc_GetConfigBlock           = "cscc/GetConfigBlock"
 	Cscc_GetChannels              = "cscc/GetChannels"
 	Cscc_GetConfigTree            = "cscc/GetConfigTree"
 	Cscc_SimulateConfigTreeUpdate = "cscc/SimulateConfigTreeUpdate"
+	Cscc_GetChannelConfigInfo     = "cscc/GetChannelConfigInfo"
 
 	//Peer resources
 	Peer_Propose              = "peer/Propose"
 	Peer_ChaincodeToChaincode = "peer/ChaincodeToChaincode"
+	Peer_RecordSimulation     = "peer/RecordSimulation"
 
 	//Events
 	Event_Block         = "event/Block"
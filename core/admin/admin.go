@@ -12,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/hyperledger/fabric/common/audit"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/protos/common"
 	pb "github.com/hyperledger/fabric/protos/peer"
@@ -97,6 +98,7 @@ func (s *ServerAdmin) SetModuleLogLevel(ctx context.Context, env *common.Envelop
 
 	spec := fmt.Sprintf("%s:%s=%s", flogging.Global.Spec(), request.LogModule, request.LogLevel)
 	err = flogging.Global.ActivateSpec(spec)
+	auditOperation(env, "SetModuleLogLevel", err)
 	if err != nil {
 		err = status.Errorf(codes.InvalidArgument, "error setting log spec to '%s': %s", spec, err.Error())
 		return nil, err
@@ -111,6 +113,7 @@ func (s *ServerAdmin) RevertLogLevels(ctx context.Context, env *common.Envelope)
 		return nil, err
 	}
 	flogging.ActivateSpec(s.specAtStartup)
+	auditOperation(env, "RevertLogLevels", nil)
 	return &empty.Empty{}, nil
 }
 
@@ -133,6 +136,7 @@ func (s *ServerAdmin) SetLogSpec(ctx context.Context, env *common.Envelope) (*pb
 		return nil, errors.New("request is nil")
 	}
 	err = flogging.Global.ActivateSpec(request.LogSpec)
+	auditOperation(env, "SetLogSpec", err)
 	logResponse := &pb.LogSpecResponse{
 		LogSpec: request.LogSpec,
 	}
@@ -141,3 +145,19 @@ func (s *ServerAdmin) SetLogSpec(ctx context.Context, env *common.Envelope) (*pb
 	}
 	return logResponse, nil
 }
+
+// auditOperation records the outcome of the admin operation of the envelope
+// in the audit log.
+func auditOperation(env *common.Envelope, operation string, err error) {
+	event := audit.Event{
+		Type:     audit.AdminOperation,
+		Outcome:  audit.Success,
+		Identity: audit.EnvelopeIdentity(env),
+		Details:  map[string]string{"operation": operation},
+	}
+	if err != nil {
+		event.Outcome = audit.Failure
+		event.Details["reason"] = err.Error()
+	}
+	audit.Log(event)
+}
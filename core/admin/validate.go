@@ -10,6 +10,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hyperledger/fabric/common/audit"
 	"github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/peer"
@@ -34,6 +35,12 @@ func (v *validator) validate(ctx context.Context, env *common.Envelope) (*peer.A
 	addr := util.ExtractRemoteAddress(ctx)
 	if err := v.ace.Evaluate(sd); err != nil {
 		logger.Warningf("Request from %s unauthorized due to authentication: %v", addr, err)
+		audit.Log(audit.Event{
+			Type:     audit.AdminOperation,
+			Outcome:  audit.Denied,
+			Identity: audit.EnvelopeIdentity(env),
+			Details:  map[string]string{"remote_address": addr, "reason": err.Error()},
+		})
 		return nil, accessDenied
 	}
 
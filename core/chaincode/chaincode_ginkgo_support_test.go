@@ -213,4 +213,27 @@ var _ = Describe("ChaincodeSupport", func() {
 			})
 		})
 	})
+	Describe("ShimLogLevel", func() {
+		BeforeEach(func() {
+			chaincodeSupport.HandlerRegistry = chaincode.NewHandlerRegistry(true)
+			chaincodeSupport.DefaultShimLogLevel = "WARNING"
+		})
+
+		It("returns the level of the configuration until it is set", func() {
+			Expect(chaincodeSupport.ShimLogLevel()).To(Equal("WARNING"))
+
+			err := chaincodeSupport.SetShimLogLevel("debug")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(chaincodeSupport.ShimLogLevel()).To(Equal("DEBUG"))
+			Expect(chaincodeSupport.HandlerRegistry.ShimLogLevel()).To(Equal("DEBUG"))
+		})
+
+		Context("when the level is invalid", func() {
+			It("returns an error", func() {
+				err := chaincodeSupport.SetShimLogLevel("loud")
+				Expect(err).To(MatchError("invalid shim log level 'loud'"))
+				Expect(chaincodeSupport.ShimLogLevel()).To(Equal("WARNING"))
+			})
+		})
+	})
 })
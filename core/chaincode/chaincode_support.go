@@ -9,10 +9,12 @@ package chaincode
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/hyperledger/fabric/common/redaction"
 	"github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/core/chaincode/platforms"
 	"github.com/hyperledger/fabric/core/common/ccprovider"
@@ -21,6 +23,7 @@ import (
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/peer"
 	pb "github.com/hyperledger/fabric/protos/peer"
+	logging "github.com/op/go-logging"
 	"github.com/pkg/errors"
 )
 
@@ -73,6 +76,7 @@ type ChaincodeSupport struct {
 	HandlerMetrics         *HandlerMetrics
 	LaunchMetrics          *LaunchMetrics
 	DeployedCCInfoProvider ledger.DeployedChaincodeInfoProvider
+	DefaultShimLogLevel    string
 }
 
 // NewChaincodeSupport creates a new ChaincodeSupport instance.
@@ -104,6 +108,7 @@ func NewChaincodeSupport(
 		HandlerMetrics:         NewHandlerMetrics(metricsProvider),
 		LaunchMetrics:          NewLaunchMetrics(metricsProvider),
 		DeployedCCInfoProvider: deployedCCInfoProvider,
+		DefaultShimLogLevel:    config.ShimLogLevel,
 	}
 
 	// Keep TestQueries working
@@ -135,6 +140,28 @@ func NewChaincodeSupport(
 	return cs
 }
 
+// ShimLogLevel returns the logging level of the shim of the chaincodes, which
+// is the level set at runtime, if any, or the level of the configuration.
+func (cs *ChaincodeSupport) ShimLogLevel() string {
+	if level := cs.HandlerRegistry.ShimLogLevel(); level != "" {
+		return level
+	}
+	return cs.DefaultShimLogLevel
+}
+
+// SetShimLogLevel sets the logging level of the shim of the running
+// chaincodes, and of the chaincodes launched afterwards, whose shim supports
+// setting it at runtime. It returns an error if the level is invalid, or if
+// the shim of some running chaincodes does not support it.
+func (cs *ChaincodeSupport) SetShimLogLevel(level string) error {
+	if _, err := logging.LogLevel(level); err != nil {
+		return errors.Errorf("invalid shim log level '%s'", level)
+	}
+	level = strings.ToUpper(level)
+	chaincodeLogger.Infof("Setting the shim log level of the chaincodes to %s", level)
+	return cs.HandlerRegistry.SetShimLogLevel(level)
+}
+
 // LaunchInit bypasses getting the chaincode spec from the LSCC table
 // as in the case of v1.0-v1.2 lifecycle, the chaincode will not yet be
 // defined in the LSCC table
@@ -249,16 +276,19 @@ func (cs *ChaincodeSupport) ExecuteLegacyInit(txParams *ccprovider.TransactionPa
 	}
 
 	resp, err := cs.execute(pb.ChaincodeMessage_INIT, txParams, cccid, spec.GetChaincodeSpec().Input, h)
-	return processChaincodeExecutionResult(txParams.TxID, cccid.Name, resp, err)
+	return processChaincodeExecutionResult(txParams.TxID, cccid.Name, spec.GetChaincodeSpec().GetInput().GetArgs(), resp, err)
 }
 
 // Execute invokes chaincode and returns the original response.
 func (cs *ChaincodeSupport) Execute(txParams *ccprovider.TransactionParams, cccid *ccprovider.CCContext, input *pb.ChaincodeInput) (*pb.Response, *pb.ChaincodeEvent, error) {
 	resp, err := cs.Invoke(txParams, cccid, input)
-	return processChaincodeExecutionResult(txParams.TxID, cccid.Name, resp, err)
+	return processChaincodeExecutionResult(txParams.TxID, cccid.Name, input.GetArgs(), resp, err)
 }
 
-func processChaincodeExecutionResult(txid, ccName string, resp *pb.ChaincodeMessage, err error) (*pb.Response, *pb.ChaincodeEvent, error) {
+// processChaincodeExecutionResult extracts the response of the chaincode from
+// the result of its execution. The values of the args are scrubbed from the
+// failures returned by the chaincode according to the args redaction mode.
+func processChaincodeExecutionResult(txid, ccName string, args [][]byte, resp *pb.ChaincodeMessage, err error) (*pb.Response, *pb.ChaincodeEvent, error) {
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "failed to execute transaction %s", txid)
 	}
@@ -281,7 +311,7 @@ func processChaincodeExecutionResult(txid, ccName string, resp *pb.ChaincodeMess
 		return res, resp.ChaincodeEvent, nil
 
 	case pb.ChaincodeMessage_ERROR:
-		return nil, resp.ChaincodeEvent, errors.Errorf("transaction returned with failure: %s", resp.Payload)
+		return nil, resp.ChaincodeEvent, errors.Errorf("transaction returned with failure: %s", redaction.Message(string(resp.Payload), args))
 
 	default:
 		return nil, nil, errors.Errorf("unexpected response type %d for transaction %s", resp.Type, txid)
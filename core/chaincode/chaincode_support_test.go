@@ -26,6 +26,7 @@ import (
 	mc "github.com/hyperledger/fabric/common/mocks/config"
 	mocklgr "github.com/hyperledger/fabric/common/mocks/ledger"
 	mockpeer "github.com/hyperledger/fabric/common/mocks/peer"
+	"github.com/hyperledger/fabric/common/redaction"
 	"github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/core/aclmgmt/mocks"
 	"github.com/hyperledger/fabric/core/aclmgmt/resources"
@@ -1339,3 +1340,16 @@ func TestCCFramework(t *testing.T) {
 
 	ccSide.Quit()
 }
+
+func TestProcessChaincodeExecutionResultRedaction(t *testing.T) {
+	defer redaction.Configure(redaction.None)
+	args := [][]byte{[]byte("transfer"), []byte("alice"), []byte("100")}
+	resp := &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_ERROR, Payload: []byte("no account for alice")}
+
+	_, _, err := processChaincodeExecutionResult("txid", "cc", args, resp, nil)
+	assert.EqualError(t, err, "transaction returned with failure: no account for alice")
+
+	redaction.Configure(redaction.Redact)
+	_, _, err = processChaincodeExecutionResult("txid", "cc", args, resp, nil)
+	assert.EqualError(t, err, "transaction returned with failure: no account for <redacted>")
+}
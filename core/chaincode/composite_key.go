@@ -0,0 +1,102 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package chaincode
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	minUnicodeRuneValue   = 0            // U+0000
+	maxUnicodeRuneValue   = utf8.MaxRune // U+10FFFF - maximum (and unallocated) code point
+	compositeKeyNamespace = "\x00"
+)
+
+// CreateCompositeKey combines the object type and the attributes into a
+// composite key. The key starts with the composite key namespace, and each of
+// its components is terminated by U+0000, which is why neither U+0000 nor
+// U+10FFFF, which terminates the range of a partial composite key, may
+// appear in the object type or the attributes.
+func CreateCompositeKey(objectType string, attributes []string) (string, error) {
+	if err := validateCompositeKeyAttribute(objectType); err != nil {
+		return "", err
+	}
+	ck := compositeKeyNamespace + objectType + string(rune(minUnicodeRuneValue))
+	for _, att := range attributes {
+		if err := validateCompositeKeyAttribute(att); err != nil {
+			return "", err
+		}
+		ck += att + string(rune(minUnicodeRuneValue))
+	}
+	return ck, nil
+}
+
+// CompositeKeyRangeEnd returns the exclusive end key of the range of all
+// composite keys which have the given (partial) composite key as a prefix.
+func CompositeKeyRangeEnd(compositeKey string) string {
+	return compositeKey + string(rune(maxUnicodeRuneValue))
+}
+
+// ValidateCompositeKey validates the keys which chaincodes write. Keys in the
+// composite key namespace must be composite keys as created by CreateCompositeKey:
+// the composite key namespace followed by the object type and the attributes,
+// each terminated by U+0000, none of which may contain U+0000 or U+10FFFF.
+func ValidateCompositeKey(key string) error {
+	if !strings.HasPrefix(key, compositeKeyNamespace) {
+		return nil
+	}
+	_, _, err := SplitCompositeKey(key)
+	return err
+}
+
+// ValidateCompositeKeyRange validates the keys of a range query of a
+// chaincode. Besides keys which pass ValidateCompositeKey, the end key may
+// be the end of the range of a partial composite key, which is the partial
+// composite key followed by U+10FFFF.
+func ValidateCompositeKeyRange(startKey, endKey string) error {
+	if err := ValidateCompositeKey(startKey); err != nil {
+		return err
+	}
+	return ValidateCompositeKey(strings.TrimSuffix(endKey, string(rune(maxUnicodeRuneValue))))
+}
+
+// SplitCompositeKey splits a composite key created by CreateCompositeKey into
+// its object type and attributes. Keys which are not in the composite key namespace, which are
+// not terminated by U+0000, or whose components are not valid composite key
+// attributes are rejected.
+func SplitCompositeKey(compositeKey string) (string, []string, error) {
+	if !strings.HasPrefix(compositeKey, compositeKeyNamespace) {
+		return "", nil, errors.Errorf("not a composite key: [%x] does not start with the composite key namespace", compositeKey)
+	}
+	if len(compositeKey) < 2 || compositeKey[len(compositeKey)-1] != minUnicodeRuneValue {
+		return "", nil, errors.Errorf("not a composite key: [%x] is not terminated by %#U", compositeKey, minUnicodeRuneValue)
+	}
+
+	components := strings.Split(compositeKey[1:len(compositeKey)-1], string(rune(minUnicodeRuneValue)))
+	for _, component := range components {
+		if err := validateCompositeKeyAttribute(component); err != nil {
+			return "", nil, errors.WithMessage(err, "not a composite key")
+		}
+	}
+	return components[0], components[1:], nil
+}
+
+func validateCompositeKeyAttribute(str string) error {
+	if !utf8.ValidString(str) {
+		return errors.Errorf("not a valid utf8 string: [%x]", str)
+	}
+	for index, runeValue := range str {
+		if runeValue == minUnicodeRuneValue || runeValue == maxUnicodeRuneValue {
+			return errors.Errorf(`input contain unicode %#U starting at position [%d]. %#U and %#U are not allowed in the input attribute of a composite key`,
+				runeValue, index, minUnicodeRuneValue, maxUnicodeRuneValue)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package chaincode_test
+
+import (
+	"github.com/hyperledger/fabric/core/chaincode"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CompositeKey", func() {
+	Describe("CreateCompositeKey", func() {
+		It("terminates the object type and each attribute with U+0000", func() {
+			key, err := chaincode.CreateCompositeKey("marble", []string{"set-1", "red"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(key).To(Equal("\x00marble\x00set-1\x00red\x00"))
+		})
+
+		It("rejects an object type containing U+0000", func() {
+			_, err := chaincode.CreateCompositeKey("mar\x00ble", nil)
+			Expect(err).To(MatchError("input contain unicode U+0000 starting at position [3]. U+0000 and U+10FFFF are not allowed in the input attribute of a composite key"))
+		})
+
+		It("rejects an attribute containing U+10FFFF", func() {
+			_, err := chaincode.CreateCompositeKey("marble", []string{"set\U0010FFFF"})
+			Expect(err).To(MatchError("input contain unicode U+10FFFF starting at position [3]. U+0000 and U+10FFFF are not allowed in the input attribute of a composite key"))
+		})
+
+		It("rejects an attribute which is not valid utf8", func() {
+			_, err := chaincode.CreateCompositeKey("marble", []string{"\xff"})
+			Expect(err).To(MatchError("not a valid utf8 string: [ff]"))
+		})
+	})
+
+	Describe("CompositeKeyRangeEnd", func() {
+		It("appends U+10FFFF to the key", func() {
+			Expect(chaincode.CompositeKeyRangeEnd("\x00marble\x00")).To(Equal("\x00marble\x00\U0010FFFF"))
+		})
+	})
+
+	Describe("ValidateCompositeKey", func() {
+		It("accepts simple keys", func() {
+			Expect(chaincode.ValidateCompositeKey("marble")).To(Succeed())
+			Expect(chaincode.ValidateCompositeKey("")).To(Succeed())
+		})
+
+		It("accepts composite keys", func() {
+			Expect(chaincode.ValidateCompositeKey("\x00marble\x00set-1\x00red\x00")).To(Succeed())
+		})
+
+		It("rejects malformed keys in the composite key namespace", func() {
+			err := chaincode.ValidateCompositeKey("\x00marble\x00set-1")
+			Expect(err).To(MatchError("not a composite key: [006d6172626c65007365742d31] is not terminated by U+0000"))
+
+			err = chaincode.ValidateCompositeKey("\x00marble\x00set\U0010FFFF\x00")
+			Expect(err).To(MatchError("not a composite key: input contain unicode U+10FFFF starting at position [3]. U+0000 and U+10FFFF are not allowed in the input attribute of a composite key"))
+		})
+	})
+
+	Describe("ValidateCompositeKeyRange", func() {
+		It("accepts ranges of simple keys", func() {
+			Expect(chaincode.ValidateCompositeKeyRange("a", "z")).To(Succeed())
+			Expect(chaincode.ValidateCompositeKeyRange("", "")).To(Succeed())
+		})
+
+		It("accepts ranges of partial composite keys", func() {
+			Expect(chaincode.ValidateCompositeKeyRange("\x00marble\x00", "\x00marble\x00\U0010FFFF")).To(Succeed())
+		})
+
+		It("rejects malformed start keys", func() {
+			err := chaincode.ValidateCompositeKeyRange("\x00marble", "\x00marble\x00\U0010FFFF")
+			Expect(err).To(MatchError("not a composite key: [006d6172626c65] is not terminated by U+0000"))
+		})
+
+		It("rejects malformed end keys", func() {
+			err := chaincode.ValidateCompositeKeyRange("\x00marble\x00", "\x00marble\U0010FFFF")
+			Expect(err).To(MatchError("not a composite key: [006d6172626c65] is not terminated by U+0000"))
+		})
+	})
+
+	Describe("SplitCompositeKey", func() {
+		It("returns the components of a key created by CreateCompositeKey", func() {
+			key, err := chaincode.CreateCompositeKey("marble", []string{"set-1", "", "red"})
+			Expect(err).NotTo(HaveOccurred())
+
+			objectType, attributes, err := chaincode.SplitCompositeKey(key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objectType).To(Equal("marble"))
+			Expect(attributes).To(Equal([]string{"set-1", "", "red"}))
+		})
+
+		It("rejects keys outside of the composite key namespace", func() {
+			_, _, err := chaincode.SplitCompositeKey("marble\x00")
+			Expect(err).To(MatchError("not a composite key: [6d6172626c6500] does not start with the composite key namespace"))
+		})
+
+		It("rejects keys which are not terminated by U+0000", func() {
+			_, _, err := chaincode.SplitCompositeKey("\x00marble\x00set-1")
+			Expect(err).To(MatchError("not a composite key: [006d6172626c65007365742d31] is not terminated by U+0000"))
+
+			_, _, err = chaincode.SplitCompositeKey("\x00")
+			Expect(err).To(MatchError("not a composite key: [00] is not terminated by U+0000"))
+		})
+
+		It("rejects keys with invalid components", func() {
+			_, _, err := chaincode.SplitCompositeKey("\x00marble\x00\xff\x00")
+			Expect(err).To(MatchError("not a composite key: not a valid utf8 string: [ff]"))
+		})
+	})
+})
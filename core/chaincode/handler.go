@@ -18,6 +18,7 @@ import (
 	"github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/common/flogging"
 	commonledger "github.com/hyperledger/fabric/common/ledger"
+	"github.com/hyperledger/fabric/common/redaction"
 	"github.com/hyperledger/fabric/core/aclmgmt/resources"
 	"github.com/hyperledger/fabric/core/common/ccprovider"
 	"github.com/hyperledger/fabric/core/common/privdata"
@@ -168,6 +169,13 @@ type Handler struct {
 	chatStream ccintf.ChaincodeStream
 	// errChan is used to communicate errors from the async send to the receive loop
 	errChan chan error
+	// shimLogLevelLock covers shimLogLevel and shimLogLevelSupported.
+	shimLogLevelLock sync.Mutex
+	// shimLogLevel holds the shim logging level set at runtime, if any.
+	shimLogLevel string
+	// shimLogLevelSupported is set when the shim announces that it can set
+	// its logging level at runtime.
+	shimLogLevelSupported bool
 	// Metrics holds chaincode handler metrics
 	Metrics *HandlerMetrics
 }
@@ -179,6 +187,10 @@ func (h *Handler) handleMessage(msg *pb.ChaincodeMessage) error {
 	if msg.Type == pb.ChaincodeMessage_KEEPALIVE {
 		return nil
 	}
+	if msg.Type == pb.ChaincodeMessage_SHIM_LOG_LEVEL {
+		h.HandleShimLogLevel(msg)
+		return nil
+	}
 
 	switch h.state {
 	case Created:
@@ -229,6 +241,10 @@ func (h *Handler) handleMessageReadyState(msg *pb.ChaincodeMessage) error {
 		go h.HandleTransaction(msg, h.HandleGetStateMetadata)
 	case pb.ChaincodeMessage_PUT_STATE_METADATA:
 		go h.HandleTransaction(msg, h.HandlePutStateMetadata)
+	case pb.ChaincodeMessage_CREATE_COMPOSITE_KEY:
+		go h.HandleTransaction(msg, h.HandleCreateCompositeKey)
+	case pb.ChaincodeMessage_SPLIT_COMPOSITE_KEY:
+		go h.HandleTransaction(msg, h.HandleSplitCompositeKey)
 	default:
 		return fmt.Errorf("[%s] Fabric side handler cannot handle message (%s) while in ready state", msg.Txid, msg.Type)
 	}
@@ -358,6 +374,35 @@ func (h *Handler) serialSendAsync(msg *pb.ChaincodeMessage) {
 	}()
 }
 
+// SetShimLogLevel sets the logging level of the shim of the chaincode. It
+// returns an error if the shim has not announced that it can set its level
+// at runtime, in which case the level is only sent if it does later on.
+func (h *Handler) SetShimLogLevel(level string) error {
+	h.shimLogLevelLock.Lock()
+	defer h.shimLogLevelLock.Unlock()
+
+	h.shimLogLevel = level
+	if !h.shimLogLevelSupported {
+		return errors.Errorf("the shim of chaincode %s does not support setting its log level at runtime", h.chaincodeID.Name)
+	}
+	chaincodeLogger.Debugf("setting shim log level of chaincode %s to %s", h.chaincodeID.Name, level)
+	h.serialSendAsync(&pb.ChaincodeMessage{Type: pb.ChaincodeMessage_SHIM_LOG_LEVEL, Payload: []byte(level)})
+	return nil
+}
+
+// HandleShimLogLevel handles the announcement of a shim which can set its
+// logging level at runtime, and sends it the level set so far, if any.
+func (h *Handler) HandleShimLogLevel(msg *pb.ChaincodeMessage) {
+	h.shimLogLevelLock.Lock()
+	defer h.shimLogLevelLock.Unlock()
+
+	chaincodeLogger.Debugf("Received %s in state %s", msg.Type, h.state)
+	h.shimLogLevelSupported = true
+	if h.shimLogLevel != "" {
+		h.serialSendAsync(&pb.ChaincodeMessage{Type: pb.ChaincodeMessage_SHIM_LOG_LEVEL, Payload: []byte(h.shimLogLevel)})
+	}
+}
+
 // Check if the transactor is allow to call this chaincode on this channel
 func (h *Handler) checkACL(signedProp *pb.SignedProposal, proposal *pb.Proposal, ccIns *sysccprovider.ChaincodeInstance) error {
 	// ensure that we don't invoke a system chaincode
@@ -645,9 +690,9 @@ func (h *Handler) HandleGetState(msg *pb.ChaincodeMessage, txContext *Transactio
 		if err := errorIfCreatorHasNoReadPermission(chaincodeName, collection, txContext); err != nil {
 			return nil, err
 		}
-		res, err = txContext.TXSimulator.GetPrivateData(chaincodeName, collection, getState.Key)
+		res, err = h.getPrivateData(chaincodeName, collection, getState.Key, txContext)
 	} else {
-		res, err = txContext.TXSimulator.GetState(chaincodeName, getState.Key)
+		res, err = h.getState(chaincodeName, getState.Key, txContext)
 	}
 	if err != nil {
 		return nil, errors.WithStack(err)
@@ -660,6 +705,31 @@ func (h *Handler) HandleGetState(msg *pb.ChaincodeMessage, txContext *Transactio
 	return &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_RESPONSE, Payload: res, Txid: msg.Txid, ChannelId: msg.ChannelId}, nil
 }
 
+// getState returns the value of the key, or, if the chaincode definition
+// enables read your writes, the value written earlier in the transaction.
+func (h *Handler) getState(chaincodeName, key string, txContext *TransactionContext) ([]byte, error) {
+	if txContext.ReadYourWrites {
+		value, written, err := txContext.TXSimulator.GetPendingState(chaincodeName, key)
+		if err != nil || written {
+			return value, err
+		}
+	}
+	return txContext.TXSimulator.GetState(chaincodeName, key)
+}
+
+// getPrivateData returns the value of the private data key, or, if the
+// chaincode definition enables read your writes, the value written earlier in
+// the transaction.
+func (h *Handler) getPrivateData(chaincodeName, collection, key string, txContext *TransactionContext) ([]byte, error) {
+	if txContext.ReadYourWrites {
+		value, written, err := txContext.TXSimulator.GetPendingPrivateData(chaincodeName, collection, key)
+		if err != nil || written {
+			return value, err
+		}
+	}
+	return txContext.TXSimulator.GetPrivateData(chaincodeName, collection, key)
+}
+
 func (h *Handler) HandleGetPrivateDataHash(msg *pb.ChaincodeMessage, txContext *TransactionContext) (*pb.ChaincodeMessage, error) {
 	getState := &pb.GetState{}
 	err := proto.Unmarshal(msg.Payload, getState)
@@ -739,6 +809,10 @@ func (h *Handler) HandleGetStateByRange(msg *pb.ChaincodeMessage, txContext *Tra
 		return nil, errors.Wrap(err, "unmarshal failed")
 	}
 
+	if err := ValidateCompositeKeyRange(getStateByRange.StartKey, getStateByRange.EndKey); err != nil {
+		return nil, err
+	}
+
 	metadata, err := getQueryMetadataFromBytes(getStateByRange.Metadata)
 	if err != nil {
 		return nil, err
@@ -921,6 +995,54 @@ func (h *Handler) HandleGetQueryResult(msg *pb.ChaincodeMessage, txContext *Tran
 	return &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_RESPONSE, Payload: payloadBytes, Txid: msg.Txid, ChannelId: msg.ChannelId}, nil
 }
 
+// Handles creation of a composite key so that all shims share the encoding
+func (h *Handler) HandleCreateCompositeKey(msg *pb.ChaincodeMessage, txContext *TransactionContext) (*pb.ChaincodeMessage, error) {
+	createCompositeKey := &pb.CreateCompositeKey{}
+	err := proto.Unmarshal(msg.Payload, createCompositeKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal failed")
+	}
+
+	key, err := CreateCompositeKey(createCompositeKey.ObjectType, createCompositeKey.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	payloadBytes, err := proto.Marshal(&pb.CompositeKeyResult{
+		Key:         key,
+		RangeEndKey: CompositeKeyRangeEnd(key),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal failed")
+	}
+
+	return &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_RESPONSE, Payload: payloadBytes, Txid: msg.Txid, ChannelId: msg.ChannelId}, nil
+}
+
+// Handles splitting of a composite key so that all shims share the encoding
+func (h *Handler) HandleSplitCompositeKey(msg *pb.ChaincodeMessage, txContext *TransactionContext) (*pb.ChaincodeMessage, error) {
+	splitCompositeKey := &pb.SplitCompositeKey{}
+	err := proto.Unmarshal(msg.Payload, splitCompositeKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal failed")
+	}
+
+	objectType, attributes, err := SplitCompositeKey(splitCompositeKey.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	payloadBytes, err := proto.Marshal(&pb.SplitCompositeKeyResult{
+		ObjectType: objectType,
+		Attributes: attributes,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal failed")
+	}
+
+	return &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_RESPONSE, Payload: payloadBytes, Txid: msg.Txid, ChannelId: msg.ChannelId}, nil
+}
+
 // Handles query to ledger history db
 func (h *Handler) HandleGetHistoryForKey(msg *pb.ChaincodeMessage, txContext *TransactionContext) (*pb.ChaincodeMessage, error) {
 	iterID := h.UUIDGenerator.New()
@@ -1053,6 +1175,10 @@ func (h *Handler) HandlePutState(msg *pb.ChaincodeMessage, txContext *Transactio
 		return nil, errors.Wrap(err, "unmarshal failed")
 	}
 
+	if err := ValidateCompositeKey(putState.Key); err != nil {
+		return nil, err
+	}
+
 	chaincodeName := h.ChaincodeName()
 	collection := putState.Collection
 	if isCollectionSet(collection) {
@@ -1160,11 +1286,11 @@ func (h *Handler) HandleInvokeChaincode(msg *pb.ChaincodeMessage, txContext *Tra
 	err = h.checkACL(txContext.SignedProp, txContext.Proposal, targetInstance)
 	if err != nil {
 		chaincodeLogger.Errorf(
-			"[%s] C-call-C %s on channel %s failed check ACL [%v]: [%s]",
+			"[%s] C-call-C %s on channel %s with args %s failed check ACL: [%s]",
 			shorttxid(msg.Txid),
 			targetInstance.ChaincodeName,
 			targetInstance.ChainID,
-			txContext.SignedProp,
+			redaction.Args(chaincodeSpec.GetInput().GetArgs()),
 			err,
 		)
 		return nil, errors.WithStack(err)
@@ -1207,6 +1333,7 @@ func (h *Handler) HandleInvokeChaincode(msg *pb.ChaincodeMessage, txContext *Tra
 	version := h.SystemCCVersion
 	var idBytes []byte
 	requiresInit := false
+	readYourWrites := false
 	if !h.SystemCCProvider.IsSysCC(targetInstance.ChaincodeName) {
 		// if its a user chaincode, get the details
 		cd, err := h.DefinitionGetter.ChaincodeDefinition(targetInstance.ChaincodeName, txParams.TXSimulator)
@@ -1217,6 +1344,7 @@ func (h *Handler) HandleInvokeChaincode(msg *pb.ChaincodeMessage, txContext *Tra
 		version = cd.CCVersion()
 		idBytes = cd.Hash()
 		requiresInit = cd.RequiresInit()
+		readYourWrites = cd.ReadYourWrites()
 
 		if cData, ok := cd.(*ccprovider.ChaincodeData); ok {
 			err = h.InstantiationPolicyChecker.CheckInstantiationPolicy(targetInstance.ChaincodeName, version, cData)
@@ -1230,10 +1358,11 @@ func (h *Handler) HandleInvokeChaincode(msg *pb.ChaincodeMessage, txContext *Tra
 	chaincodeLogger.Debugf("[%s] launching chaincode %s on channel %s", shorttxid(msg.Txid), targetInstance.ChaincodeName, targetInstance.ChainID)
 
 	cccid := &ccprovider.CCContext{
-		Name:         targetInstance.ChaincodeName,
-		Version:      version,
-		InitRequired: requiresInit,
-		ID:           idBytes,
+		Name:           targetInstance.ChaincodeName,
+		Version:        version,
+		InitRequired:   requiresInit,
+		ReadYourWrites: readYourWrites,
+		ID:             idBytes,
 	}
 
 	// Execute the chaincode... this CANNOT be an init at least for now
@@ -1264,6 +1393,7 @@ func (h *Handler) Execute(txParams *ccprovider.TransactionParams, cccid *ccprovi
 		return nil, err
 	}
 	defer h.TXContexts.Delete(msg.ChannelId, msg.Txid)
+	txctx.ReadYourWrites = cccid.ReadYourWrites
 
 	if err := h.setChaincodeProposal(txParams.SignedProp, txParams.Proposal, msg); err != nil {
 		return nil, err
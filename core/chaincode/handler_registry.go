@@ -7,6 +7,8 @@ SPDX-License-Identifier: Apache-2.0
 package chaincode
 
 import (
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -16,9 +18,10 @@ import (
 type HandlerRegistry struct {
 	allowUnsolicitedRegistration bool // from cs.userRunsCC
 
-	mutex     sync.Mutex              // lock covering handlers and launching
-	handlers  map[string]*Handler     // chaincode cname to associated handler
-	launching map[string]*LaunchState // launching chaincodes to LaunchState
+	mutex        sync.Mutex              // lock covering handlers, launching and shimLogLevel
+	handlers     map[string]*Handler     // chaincode cname to associated handler
+	launching    map[string]*LaunchState // launching chaincodes to LaunchState
+	shimLogLevel string                  // shim logging level set at runtime, if any
 }
 
 type LaunchState struct {
@@ -142,11 +145,45 @@ func (r *HandlerRegistry) Register(h *Handler) error {
 	}
 
 	r.handlers[key] = h
+	if r.shimLogLevel != "" {
+		// shims which cannot set their level at runtime keep the level
+		// they were launched with
+		h.SetShimLogLevel(r.shimLogLevel)
+	}
 
 	chaincodeLogger.Debugf("registered handler complete for chaincode %s", key)
 	return nil
 }
 
+// SetShimLogLevel sets the shim logging level of the registered chaincodes
+// and of the chaincodes registering afterwards. It returns an error naming
+// the registered chaincodes whose shim cannot set its level at runtime.
+func (r *HandlerRegistry) SetShimLogLevel(level string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.shimLogLevel = level
+	var unsupported []string
+	for cname, h := range r.handlers {
+		if err := h.SetShimLogLevel(level); err != nil {
+			unsupported = append(unsupported, cname)
+		}
+	}
+	if len(unsupported) != 0 {
+		sort.Strings(unsupported)
+		return errors.Errorf("the shim log level could not be set for chaincodes [%s], whose shim does not support setting it at runtime", strings.Join(unsupported, ", "))
+	}
+	return nil
+}
+
+// ShimLogLevel returns the shim logging level set at runtime, or an empty
+// string if it has not been set.
+func (r *HandlerRegistry) ShimLogLevel() string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.shimLogLevel
+}
+
 // Deregister clears references to state associated specified chaincode.
 // As part of the cleanup, it closes the handler so it can cleanup any state.
 // If the registry does not contain the provided handler, an error is returned.
@@ -201,6 +201,61 @@ var _ = Describe("HandlerRegistry", func() {
 		})
 	})
 
+	Describe("SetShimLogLevel", func() {
+		var fakeChatStream *mock.ChaincodeStream
+
+		BeforeEach(func() {
+			fakeChatStream = &mock.ChaincodeStream{}
+			chaincode.SetHandlerChatStream(handler, fakeChatStream)
+		})
+
+		It("sends the level to the registered chaincodes", func() {
+			handler.HandleShimLogLevel(&pb.ChaincodeMessage{Type: pb.ChaincodeMessage_SHIM_LOG_LEVEL})
+			err := hr.Register(handler)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hr.ShimLogLevel()).To(BeEmpty())
+			Consistently(fakeChatStream.SendCallCount).Should(Equal(0))
+
+			err = hr.SetShimLogLevel("DEBUG")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hr.ShimLogLevel()).To(Equal("DEBUG"))
+			Eventually(fakeChatStream.SendCallCount).Should(Equal(1))
+			Expect(fakeChatStream.SendArgsForCall(0)).To(Equal(&pb.ChaincodeMessage{
+				Type:    pb.ChaincodeMessage_SHIM_LOG_LEVEL,
+				Payload: []byte("DEBUG"),
+			}))
+		})
+
+		It("sends the level to the chaincodes registering afterwards", func() {
+			err := hr.SetShimLogLevel("WARNING")
+			Expect(err).NotTo(HaveOccurred())
+			Consistently(fakeChatStream.SendCallCount).Should(Equal(0))
+
+			handler.HandleShimLogLevel(&pb.ChaincodeMessage{Type: pb.ChaincodeMessage_SHIM_LOG_LEVEL})
+			Consistently(fakeChatStream.SendCallCount).Should(Equal(0))
+
+			err = hr.Register(handler)
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(fakeChatStream.SendCallCount).Should(Equal(1))
+			Expect(fakeChatStream.SendArgsForCall(0)).To(Equal(&pb.ChaincodeMessage{
+				Type:    pb.ChaincodeMessage_SHIM_LOG_LEVEL,
+				Payload: []byte("WARNING"),
+			}))
+		})
+
+		Context("when the shim of a chaincode does not support setting the level", func() {
+			It("returns an error naming the chaincode", func() {
+				err := hr.Register(handler)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = hr.SetShimLogLevel("DEBUG")
+				Expect(err).To(MatchError("the shim log level could not be set for chaincodes [chaincode-name], whose shim does not support setting it at runtime"))
+				Expect(hr.ShimLogLevel()).To(Equal("DEBUG"))
+				Consistently(fakeChatStream.SendCallCount).Should(Equal(0))
+			})
+		})
+	})
+
 	Describe("Deregister", func() {
 		var fakeResultsIterator *mock.QueryResultsIterator
 
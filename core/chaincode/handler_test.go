@@ -551,6 +551,39 @@ var _ = Describe("Handler", func() {
 			})
 		})
 
+		Context("when the key is a composite key", func() {
+			BeforeEach(func() {
+				request.Key = "\x00marble\x00set-1\x00"
+				payload, err := proto.Marshal(request)
+				Expect(err).NotTo(HaveOccurred())
+				incomingMessage.Payload = payload
+			})
+
+			It("calls SetState on the transaction simulator", func() {
+				_, err := handler.HandlePutState(incomingMessage, txContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeTxSimulator.SetStateCallCount()).To(Equal(1))
+				_, key, _ := fakeTxSimulator.SetStateArgsForCall(0)
+				Expect(key).To(Equal("\x00marble\x00set-1\x00"))
+			})
+
+			Context("and the key is malformed", func() {
+				BeforeEach(func() {
+					request.Key = "\x00marble\x00set-1"
+					payload, err := proto.Marshal(request)
+					Expect(err).NotTo(HaveOccurred())
+					incomingMessage.Payload = payload
+				})
+
+				It("returns an error", func() {
+					_, err := handler.HandlePutState(incomingMessage, txContext)
+					Expect(err).To(MatchError("not a composite key: [006d6172626c65007365742d31] is not terminated by U+0000"))
+					Expect(fakeTxSimulator.SetStateCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		Context("when the collection is not provided", func() {
 			It("calls SetState on the transaction simulator", func() {
 				_, err := handler.HandlePutState(incomingMessage, txContext)
@@ -1067,6 +1100,56 @@ var _ = Describe("Handler", func() {
 					ChannelId: "channel-id",
 				}))
 			})
+
+			It("does not look for pending writes", func() {
+				_, err := handler.HandleGetState(incomingMessage, txContext)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeTxSimulator.GetPendingPrivateDataCallCount()).To(Equal(0))
+			})
+
+			Context("and read your writes is enabled", func() {
+				BeforeEach(func() {
+					txContext.ReadYourWrites = true
+					fakeTxSimulator.GetPendingPrivateDataReturns([]byte("pending-private-data"), true, nil)
+				})
+
+				It("returns the pending write", func() {
+					resp, err := handler.HandleGetState(incomingMessage, txContext)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(resp.Payload).To(Equal([]byte("pending-private-data")))
+
+					Expect(fakeTxSimulator.GetPendingPrivateDataCallCount()).To(Equal(1))
+					ccname, collection, key := fakeTxSimulator.GetPendingPrivateDataArgsForCall(0)
+					Expect(ccname).To(Equal("cc-instance-name"))
+					Expect(collection).To(Equal("collection-name"))
+					Expect(key).To(Equal("get-state-key"))
+					Expect(fakeTxSimulator.GetPrivateDataCallCount()).To(Equal(0))
+				})
+
+				Context("when the key has not been written", func() {
+					BeforeEach(func() {
+						fakeTxSimulator.GetPendingPrivateDataReturns(nil, false, nil)
+					})
+
+					It("returns the response from GetPrivateData", func() {
+						resp, err := handler.HandleGetState(incomingMessage, txContext)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(resp.Payload).To(Equal([]byte("get-private-data-response")))
+					})
+				})
+
+				Context("when looking up the pending write fails", func() {
+					BeforeEach(func() {
+						fakeTxSimulator.GetPendingPrivateDataReturns(nil, false, errors.New("onion rings"))
+					})
+
+					It("returns the error", func() {
+						_, err := handler.HandleGetState(incomingMessage, txContext)
+						Expect(err).To(MatchError("onion rings"))
+						Expect(fakeTxSimulator.GetPrivateDataCallCount()).To(Equal(0))
+					})
+				})
+			})
 		})
 
 		Context("when collection is not set", func() {
@@ -1106,6 +1189,67 @@ var _ = Describe("Handler", func() {
 					ChannelId: "channel-id",
 				}))
 			})
+
+			It("does not look for pending writes", func() {
+				_, err := handler.HandleGetState(incomingMessage, txContext)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeTxSimulator.GetPendingStateCallCount()).To(Equal(0))
+			})
+
+			Context("and read your writes is enabled", func() {
+				BeforeEach(func() {
+					txContext.ReadYourWrites = true
+					fakeTxSimulator.GetPendingStateReturns([]byte("pending-state"), true, nil)
+				})
+
+				It("returns the pending write", func() {
+					resp, err := handler.HandleGetState(incomingMessage, txContext)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(resp.Payload).To(Equal([]byte("pending-state")))
+
+					Expect(fakeTxSimulator.GetPendingStateCallCount()).To(Equal(1))
+					ccname, key := fakeTxSimulator.GetPendingStateArgsForCall(0)
+					Expect(ccname).To(Equal("cc-instance-name"))
+					Expect(key).To(Equal("get-state-key"))
+					Expect(fakeTxSimulator.GetStateCallCount()).To(Equal(0))
+				})
+
+				Context("when the pending write is a delete", func() {
+					BeforeEach(func() {
+						fakeTxSimulator.GetPendingStateReturns(nil, true, nil)
+					})
+
+					It("returns an empty payload", func() {
+						resp, err := handler.HandleGetState(incomingMessage, txContext)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(resp.Payload).To(BeNil())
+						Expect(fakeTxSimulator.GetStateCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the key has not been written", func() {
+					BeforeEach(func() {
+						fakeTxSimulator.GetPendingStateReturns(nil, false, nil)
+					})
+
+					It("returns the response from GetState", func() {
+						resp, err := handler.HandleGetState(incomingMessage, txContext)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(resp.Payload).To(Equal([]byte("get-state-response")))
+					})
+				})
+
+				Context("when looking up the pending write fails", func() {
+					BeforeEach(func() {
+						fakeTxSimulator.GetPendingStateReturns(nil, false, errors.New("potato"))
+					})
+
+					It("returns the error", func() {
+						_, err := handler.HandleGetState(incomingMessage, txContext)
+						Expect(err).To(MatchError("potato"))
+					})
+				})
+			})
 		})
 	})
 
@@ -1454,6 +1598,42 @@ var _ = Describe("Handler", func() {
 			Expect(resp).To(Equal(expectedResponse))
 		})
 
+		Context("when the range is the range of a partial composite key", func() {
+			BeforeEach(func() {
+				request.StartKey = "\x00marble\x00set-1\x00"
+				request.EndKey = "\x00marble\x00set-1\x00\U0010FFFF"
+				payload, err := proto.Marshal(request)
+				Expect(err).NotTo(HaveOccurred())
+				incomingMessage.Payload = payload
+			})
+
+			It("calls GetStateRangeScanIterator on the transaction simulator", func() {
+				_, err := handler.HandleGetStateByRange(incomingMessage, txContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeTxSimulator.GetStateRangeScanIteratorCallCount()).To(Equal(1))
+				_, startKey, endKey := fakeTxSimulator.GetStateRangeScanIteratorArgsForCall(0)
+				Expect(startKey).To(Equal("\x00marble\x00set-1\x00"))
+				Expect(endKey).To(Equal("\x00marble\x00set-1\x00\U0010FFFF"))
+			})
+
+			Context("and the partial composite key is malformed", func() {
+				BeforeEach(func() {
+					request.StartKey = "\x00marble\x00set-1"
+					request.EndKey = "\x00marble\x00set-1\U0010FFFF"
+					payload, err := proto.Marshal(request)
+					Expect(err).NotTo(HaveOccurred())
+					incomingMessage.Payload = payload
+				})
+
+				It("returns an error", func() {
+					_, err := handler.HandleGetStateByRange(incomingMessage, txContext)
+					Expect(err).To(MatchError("not a composite key: [006d6172626c65007365742d31] is not terminated by U+0000"))
+					Expect(fakeTxSimulator.GetStateRangeScanIteratorCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		Context("when collection is not set", func() {
 			It("calls GetStateRangeScanIterator on the transaction simulator", func() {
 				_, err := handler.HandleGetStateByRange(incomingMessage, txContext)
@@ -2002,6 +2182,129 @@ var _ = Describe("Handler", func() {
 		})
 	})
 
+	Describe("HandleCreateCompositeKey", func() {
+		var (
+			request         *pb.CreateCompositeKey
+			incomingMessage *pb.ChaincodeMessage
+		)
+
+		BeforeEach(func() {
+			request = &pb.CreateCompositeKey{
+				ObjectType: "marble",
+				Attributes: []string{"set-1", "red"},
+			}
+			payload, err := proto.Marshal(request)
+			Expect(err).NotTo(HaveOccurred())
+
+			incomingMessage = &pb.ChaincodeMessage{
+				Type:      pb.ChaincodeMessage_CREATE_COMPOSITE_KEY,
+				Payload:   payload,
+				Txid:      "tx-id",
+				ChannelId: "channel-id",
+			}
+		})
+
+		It("returns the composite key and the end of its range", func() {
+			resp, err := handler.HandleCreateCompositeKey(incomingMessage, txContext)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Type).To(Equal(pb.ChaincodeMessage_RESPONSE))
+			Expect(resp.Txid).To(Equal("tx-id"))
+			Expect(resp.ChannelId).To(Equal("channel-id"))
+
+			result := &pb.CompositeKeyResult{}
+			err = proto.Unmarshal(resp.Payload, result)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Key).To(Equal("\x00marble\x00set-1\x00red\x00"))
+			Expect(result.RangeEndKey).To(Equal("\x00marble\x00set-1\x00red\x00\U0010FFFF"))
+		})
+
+		Context("when unmarshalling the request fails", func() {
+			BeforeEach(func() {
+				incomingMessage.Payload = []byte("this-is-a-bogus-payload")
+			})
+
+			It("returns an error", func() {
+				_, err := handler.HandleCreateCompositeKey(incomingMessage, txContext)
+				Expect(err).To(MatchError("unmarshal failed: proto: can't skip unknown wire type 4"))
+			})
+		})
+
+		Context("when an attribute contains U+0000", func() {
+			BeforeEach(func() {
+				request.Attributes = []string{"set\x001"}
+				payload, err := proto.Marshal(request)
+				Expect(err).NotTo(HaveOccurred())
+				incomingMessage.Payload = payload
+			})
+
+			It("returns an error", func() {
+				_, err := handler.HandleCreateCompositeKey(incomingMessage, txContext)
+				Expect(err).To(MatchError("input contain unicode U+0000 starting at position [3]. U+0000 and U+10FFFF are not allowed in the input attribute of a composite key"))
+			})
+		})
+	})
+
+	Describe("HandleSplitCompositeKey", func() {
+		var (
+			request         *pb.SplitCompositeKey
+			incomingMessage *pb.ChaincodeMessage
+		)
+
+		BeforeEach(func() {
+			request = &pb.SplitCompositeKey{
+				Key: "\x00marble\x00set-1\x00red\x00",
+			}
+			payload, err := proto.Marshal(request)
+			Expect(err).NotTo(HaveOccurred())
+
+			incomingMessage = &pb.ChaincodeMessage{
+				Type:      pb.ChaincodeMessage_SPLIT_COMPOSITE_KEY,
+				Payload:   payload,
+				Txid:      "tx-id",
+				ChannelId: "channel-id",
+			}
+		})
+
+		It("returns the object type and the attributes", func() {
+			resp, err := handler.HandleSplitCompositeKey(incomingMessage, txContext)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Type).To(Equal(pb.ChaincodeMessage_RESPONSE))
+			Expect(resp.Txid).To(Equal("tx-id"))
+			Expect(resp.ChannelId).To(Equal("channel-id"))
+
+			result := &pb.SplitCompositeKeyResult{}
+			err = proto.Unmarshal(resp.Payload, result)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.ObjectType).To(Equal("marble"))
+			Expect(result.Attributes).To(Equal([]string{"set-1", "red"}))
+		})
+
+		Context("when unmarshalling the request fails", func() {
+			BeforeEach(func() {
+				incomingMessage.Payload = []byte("this-is-a-bogus-payload")
+			})
+
+			It("returns an error", func() {
+				_, err := handler.HandleSplitCompositeKey(incomingMessage, txContext)
+				Expect(err).To(MatchError("unmarshal failed: proto: can't skip unknown wire type 4"))
+			})
+		})
+
+		Context("when the key is not a composite key", func() {
+			BeforeEach(func() {
+				request.Key = "marble"
+				payload, err := proto.Marshal(request)
+				Expect(err).NotTo(HaveOccurred())
+				incomingMessage.Payload = payload
+			})
+
+			It("returns an error", func() {
+				_, err := handler.HandleSplitCompositeKey(incomingMessage, txContext)
+				Expect(err).To(MatchError("not a composite key: [6d6172626c65] does not start with the composite key namespace"))
+			})
+		})
+	})
+
 	Describe("HandleGetHistoryForKey", func() {
 		var (
 			request               *pb.GetHistoryForKey
@@ -2539,6 +2842,14 @@ var _ = Describe("Handler", func() {
 			Expect(fakeContextRegistry.CreateArgsForCall(0)).To(Equal(txParams))
 		})
 
+		It("sets read your writes on the transaction context from the chaincode context", func() {
+			cccid.ReadYourWrites = true
+			close(responseNotifier)
+			handler.Execute(txParams, cccid, incomingMessage, time.Second)
+
+			Expect(txContext.ReadYourWrites).To(BeTrue())
+		})
+
 		It("sends an execute message to the chaincode with the correct proposal", func() {
 			expectedMessage := *incomingMessage
 			expectedMessage.Proposal = expectedSignedProp
@@ -0,0 +1,32 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package chaincode
+
+import (
+	"context"
+	"net"
+
+	"github.com/pkg/errors"
+)
+
+// LauncherHealthChecker checks whether the chaincode launcher is ready, that
+// is, whether the chaincode server that launched chaincodes connect to is
+// accepting connections.
+type LauncherHealthChecker struct {
+	// Address is the listen address of the chaincode server
+	Address string
+}
+
+// HealthCheck dials the chaincode server.
+func (l *LauncherHealthChecker) HealthCheck(ctx context.Context) error {
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", l.Address)
+	if err != nil {
+		return errors.Wrap(err, "chaincode server is not accepting connections")
+	}
+	conn.Close()
+	return nil
+}
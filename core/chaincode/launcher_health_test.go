@@ -0,0 +1,60 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package chaincode_test
+
+import (
+	"context"
+	"net"
+
+	"github.com/hyperledger/fabric/core/chaincode"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LauncherHealthChecker", func() {
+	var (
+		listener      net.Listener
+		healthChecker *chaincode.LauncherHealthChecker
+	)
+
+	BeforeEach(func() {
+		var err error
+		listener, err = net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		go func() {
+			for {
+				conn, err := listener.Accept()
+				if err != nil {
+					return
+				}
+				conn.Close()
+			}
+		}()
+
+		healthChecker = &chaincode.LauncherHealthChecker{Address: listener.Addr().String()}
+	})
+
+	AfterEach(func() {
+		listener.Close()
+	})
+
+	It("succeeds when the chaincode server accepts connections", func() {
+		err := healthChecker.HealthCheck(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Context("when the chaincode server is not listening", func() {
+		BeforeEach(func() {
+			listener.Close()
+		})
+
+		It("returns an error", func() {
+			err := healthChecker.HealthCheck(context.Background())
+			Expect(err).To(MatchError(ContainSubstring("chaincode server is not accepting connections")))
+		})
+	})
+})
@@ -294,11 +294,21 @@ func (l *Lifecycle) LifecycleEndorsementPolicyAsBytes(channelID string) ([]byte,
 // if the unexpected error is not nil and mark the transaction as invalid if the validation
 // error is not nil.
 func (l *Lifecycle) ValidationInfo(channelID, chaincodeName string, qe ledger.SimpleQueryExecutor) (plugin string, args []byte, unexpectedErr error, validationErr error) {
-	// TODO, this is a bit of an overkill check, and will need to be scaled back for non-chaincode type namespaces
-	exists, definedChaincode, err := l.ChaincodeDefinitionIfDefined(chaincodeName, &SimpleQueryExecutorShim{
+	state := &SimpleQueryExecutorShim{
 		Namespace:           LifecycleNamespace,
 		SimpleQueryExecutor: qe,
-	})
+	}
+
+	isToken, definedToken, err := l.tokenDefinitionIfDefined(chaincodeName, state)
+	if err != nil {
+		return "", nil, errors.WithMessage(err, "could not get token definition"), nil
+	}
+	if isToken {
+		return definedToken.ValidationInfo.ValidationPlugin, definedToken.ValidationInfo.ValidationParameter, nil, nil
+	}
+
+	// TODO, this is a bit of an overkill check, and will need to be scaled back for non-chaincode type namespaces
+	exists, definedChaincode, err := l.ChaincodeDefinitionIfDefined(chaincodeName, state)
 	if err != nil {
 		return "", nil, errors.WithMessage(err, "could not get chaincode"), nil
 	}
@@ -321,6 +331,27 @@ func (l *Lifecycle) ValidationInfo(channelID, chaincodeName string, qe ledger.Si
 	return definedChaincode.ValidationInfo.ValidationPlugin, definedChaincode.ValidationInfo.ValidationParameter, nil, nil
 }
 
+// tokenDefinitionIfDefined returns whether the namespace is defined as a token
+// namespace and, if so, its definition
+func (l *Lifecycle) tokenDefinitionIfDefined(name string, state ReadableState) (bool, *TokenDefinition, error) {
+	metadata, ok, err := l.Serializer.DeserializeMetadata(NamespacesName, name, state)
+	if err != nil {
+		return false, nil, errors.WithMessage(err, fmt.Sprintf("could not deserialize metadata for namespace %s", name))
+	}
+
+	if !ok || metadata.Datatype != TokenDefinitionType {
+		return false, nil, nil
+	}
+
+	definedToken := &TokenDefinition{}
+	err = l.Serializer.Deserialize(NamespacesName, name, metadata, definedToken, state)
+	if err != nil {
+		return false, nil, errors.WithMessage(err, fmt.Sprintf("could not deserialize token definition for namespace %s", name))
+	}
+
+	return true, definedToken, nil
+}
+
 // CollectionValidationInfo returns information about collections to the validation component
 func (l *Lifecycle) CollectionValidationInfo(channelID, chaincodeName, collectionName string, state validationState.State) (args []byte, unexpectedErr, validationErr error) {
 	exists, definedChaincode, err := l.ChaincodeDefinitionIfDefined(chaincodeName, &ValidatorStateShim{
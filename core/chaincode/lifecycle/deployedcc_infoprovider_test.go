@@ -509,7 +509,39 @@ var _ = Describe("Lifecycle", func() {
 
 				It("wraps and returns the error", func() {
 					_, _, uerr, _ := l.ValidationInfo("channel-id", "cc-name", fakeQueryExecutor)
-					Expect(uerr).To(MatchError("could not get chaincode: could not deserialize metadata for chaincode cc-name: could not query metadata for namespace namespaces/cc-name: state-error"))
+					Expect(uerr).To(MatchError("could not get token definition: could not deserialize metadata for namespace cc-name: could not query metadata for namespace namespaces/cc-name: state-error"))
+				})
+			})
+
+			Context("when the namespace is a token namespace", func() {
+				BeforeEach(func() {
+					err := l.Serializer.Serialize(lifecycle.NamespacesName, "token-name", &lifecycle.TokenDefinition{
+						Sequence: 1,
+						ValidationInfo: &lb.ChaincodeValidationInfo{
+							ValidationPlugin:    "token-plugin",
+							ValidationParameter: []byte("issuing-policy"),
+						},
+					}, fakePublicState)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("returns the validation info of the token definition", func() {
+					vPlugin, vParm, uerr, verr := l.ValidationInfo("channel-id", "token-name", fakeQueryExecutor)
+					Expect(uerr).NotTo(HaveOccurred())
+					Expect(verr).NotTo(HaveOccurred())
+					Expect(vPlugin).To(Equal("token-plugin"))
+					Expect(vParm).To(Equal([]byte("issuing-policy")))
+				})
+
+				Context("when the token definition is corrupt", func() {
+					BeforeEach(func() {
+						fakePublicState["namespaces/fields/token-name/ValidationInfo"] = []byte("garbage")
+					})
+
+					It("wraps and returns that error", func() {
+						_, _, uerr, _ := l.ValidationInfo("channel-id", "token-name", fakeQueryExecutor)
+						Expect(uerr).To(MatchError("could not get token definition: could not deserialize token definition for namespace token-name: could not unmarshal state for key namespaces/fields/token-name/ValidationInfo: proto: can't skip unknown wire type 7"))
+					})
 				})
 			})
 
@@ -27,6 +27,7 @@ type LegacyDefinition struct {
 	ValidationPlugin    string
 	ValidationParameter []byte
 	RequiresInitField   bool
+	ReadYourWritesField bool
 }
 
 // CCName returns the chaincode name
@@ -63,6 +64,12 @@ func (ld *LegacyDefinition) RequiresInit() bool {
 	return ld.RequiresInitField
 }
 
+// ReadYourWrites returns whether reads of this chaincode observe the writes
+// made earlier in the same transaction.
+func (ld *LegacyDefinition) ReadYourWrites() bool {
+	return ld.ReadYourWritesField
+}
+
 // ChaincodeDefinition returns the details for a chaincode by name
 func (l *Lifecycle) ChaincodeDefinition(chaincodeName string, qe ledger.SimpleQueryExecutor) (ccprovider.ChaincodeDefinition, error) {
 	exists, definedChaincode, err := l.ChaincodeDefinitionIfDefined(chaincodeName, &SimpleQueryExecutorShim{
@@ -82,6 +89,7 @@ func (l *Lifecycle) ChaincodeDefinition(chaincodeName string, qe ledger.SimpleQu
 		HashField:           definedChaincode.EndorsementInfo.Id,
 		EndorsementPlugin:   definedChaincode.EndorsementInfo.EndorsementPlugin,
 		RequiresInitField:   definedChaincode.EndorsementInfo.InitRequired,
+		ReadYourWritesField: definedChaincode.EndorsementInfo.ReadYourWrites,
 		ValidationPlugin:    definedChaincode.ValidationInfo.ValidationPlugin,
 		ValidationParameter: definedChaincode.ValidationInfo.ValidationParameter,
 	}, nil
@@ -295,6 +295,7 @@ var _ = Describe("Lifecycle", func() {
 				ValidationPlugin:    "validation-plugin",
 				ValidationParameter: []byte("validation-parameter"),
 				RequiresInitField:   true,
+				ReadYourWritesField: true,
 			}
 		})
 
@@ -328,6 +329,12 @@ var _ = Describe("Lifecycle", func() {
 			})
 		})
 
+		Describe("ReadYourWrites", func() {
+			It("returns the endorsement read your writes field", func() {
+				Expect(ld.ReadYourWrites()).To(BeTrue())
+			})
+		})
+
 		Describe("Validation", func() {
 			It("returns the validation plugin name and parameter", func() {
 				validationPlugin, validationParameter := ld.Validation()
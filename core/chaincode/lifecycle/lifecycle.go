@@ -33,6 +33,12 @@ const (
 
 	// FriendlyChaincodeDefinitionType is the name exposed to the outside world for the chaincode namespace
 	FriendlyChaincodeDefinitionType = "Chaincode"
+
+	// TokenDefinitionType is the name of the type used to store defined token namespaces
+	TokenDefinitionType = "TokenDefinition"
+
+	// FriendlyTokenDefinitionType is the name exposed to the outside world for the token namespace
+	FriendlyTokenDefinitionType = "Token"
 )
 
 // Sequences are the underpinning of the definition framework for lifecycle.  All definitions
@@ -97,6 +103,31 @@ func (cd *ChaincodeDefinition) Parameters() *ChaincodeParameters {
 	}
 }
 
+// TokenParameters are the parts of the token definition which are serialized
+// as values in the statedb.
+// WARNING: This structure is serialized/deserialized from the DB, re-ordering or adding fields
+// will cause opaque checks to fail.
+type TokenParameters struct {
+	ValidationInfo *lb.ChaincodeValidationInfo
+}
+
+// TokenDefinition defines a token namespace, such as the one of FabToken.  The ValidationInfo
+// names the plugin which validates the token transactions at commit time, and its
+// ValidationParameter is the policy that the creators of issue transactions must satisfy.
+// WARNING: This structure is serialized/deserialized from the DB, re-ordering or adding fields
+// will cause opaque checks to fail.
+type TokenDefinition struct {
+	Sequence       int64
+	ValidationInfo *lb.ChaincodeValidationInfo
+}
+
+// Parameters returns the non-sequence info of the token definition
+func (td *TokenDefinition) Parameters() *TokenParameters {
+	return &TokenParameters{
+		ValidationInfo: td.ValidationInfo,
+	}
+}
+
 // ChaincodeStore provides a way to persist chaincodes
 type ChaincodeStore interface {
 	Save(name, version string, ccInstallPkg []byte) (hash []byte, err error)
@@ -144,6 +175,10 @@ func (l *Lifecycle) CommitChaincodeDefinition(name string, cd *ChaincodeDefiniti
 		return nil, errors.Errorf("requested sequence is %d, but new definition must be sequence %d", cd.Sequence, currentSequence+1)
 	}
 
+	if err := l.checkNamespaceType(name, currentSequence, ChaincodeDefinitionType, publicState); err != nil {
+		return nil, err
+	}
+
 	agreement := make([]bool, len(orgStates))
 	privateName := fmt.Sprintf("%s#%d", name, cd.Sequence)
 	for i, orgState := range orgStates {
@@ -158,6 +193,58 @@ func (l *Lifecycle) CommitChaincodeDefinition(name string, cd *ChaincodeDefiniti
 	return agreement, nil
 }
 
+// CommitTokenDefinition takes a token definition, checks that its sequence number is the next allowable sequence number,
+// checks which organizations agree with the definition, and applies the definition to the public world state.
+// As for chaincode definitions, it is the responsibility of the caller to check the agreement.
+func (l *Lifecycle) CommitTokenDefinition(name string, td *TokenDefinition, publicState ReadWritableState, orgStates []OpaqueState) ([]bool, error) {
+	currentSequence, err := l.Serializer.DeserializeFieldAsInt64(NamespacesName, name, "Sequence", publicState)
+	if err != nil {
+		return nil, errors.WithMessage(err, "could not get current sequence")
+	}
+
+	if td.Sequence != currentSequence+1 {
+		return nil, errors.Errorf("requested sequence is %d, but new definition must be sequence %d", td.Sequence, currentSequence+1)
+	}
+
+	if err := l.checkNamespaceType(name, currentSequence, TokenDefinitionType, publicState); err != nil {
+		return nil, err
+	}
+
+	agreement := make([]bool, len(orgStates))
+	privateName := fmt.Sprintf("%s#%d", name, td.Sequence)
+	for i, orgState := range orgStates {
+		match, err := l.Serializer.IsSerialized(NamespacesName, privateName, td.Parameters(), orgState)
+		agreement[i] = (err == nil && match)
+	}
+
+	if err = l.Serializer.Serialize(NamespacesName, name, td, publicState); err != nil {
+		return nil, errors.WithMessage(err, "could not serialize token definition")
+	}
+
+	return agreement, nil
+}
+
+// checkNamespaceType returns an error if the namespace is already defined, but
+// not as the given type.  A namespace may not change its type once defined.
+func (l *Lifecycle) checkNamespaceType(name string, currentSequence int64, datatype string, publicState ReadableState) error {
+	if currentSequence == 0 {
+		return nil
+	}
+
+	metadata, ok, err := l.Serializer.DeserializeMetadata(NamespacesName, name, publicState)
+	if err != nil {
+		return errors.WithMessage(err, "could not fetch metadata for current definition")
+	}
+	if !ok {
+		return errors.Errorf("missing metadata for currently committed sequence number (%d)", currentSequence)
+	}
+	if metadata.Datatype != datatype {
+		return errors.Errorf("namespace %s is already defined as type %s, and cannot be redefined as type %s", name, metadata.Datatype, datatype)
+	}
+
+	return nil
+}
+
 // ApproveChaincodeDefinitionForOrg adds a chaincode definition entry into the passed in Org state.  The definition must be
 // for either the currently defined sequence number or the next sequence number.  If the definition is
 // for the current sequence number, then it must match exactly the current definition or it will be rejected.
@@ -182,6 +269,10 @@ func (l *Lifecycle) ApproveChaincodeDefinitionForOrg(name string, cd *ChaincodeD
 		return errors.Errorf("requested sequence %d is larger than the next available sequence number %d", requestedSequence, currentSequence+1)
 	}
 
+	if err := l.checkNamespaceType(name, currentSequence, ChaincodeDefinitionType, publicState); err != nil {
+		return err
+	}
+
 	if requestedSequence == currentSequence {
 		metadata, ok, err := l.Serializer.DeserializeMetadata(NamespacesName, name, publicState)
 		if err != nil {
@@ -201,6 +292,8 @@ func (l *Lifecycle) ApproveChaincodeDefinitionForOrg(name string, cd *ChaincodeD
 			return errors.Errorf("attempted to define the current sequence (%d) for namespace %s, but Version '%s' != '%s'", currentSequence, name, definedChaincode.EndorsementInfo.Version, cd.EndorsementInfo.Version)
 		case definedChaincode.EndorsementInfo.EndorsementPlugin != cd.EndorsementInfo.EndorsementPlugin:
 			return errors.Errorf("attempted to define the current sequence (%d) for namespace %s, but EndorsementPlugin '%s' != '%s'", currentSequence, name, definedChaincode.EndorsementInfo.EndorsementPlugin, cd.EndorsementInfo.EndorsementPlugin)
+		case definedChaincode.EndorsementInfo.ReadYourWrites != cd.EndorsementInfo.ReadYourWrites:
+			return errors.Errorf("attempted to define the current sequence (%d) for namespace %s, but ReadYourWrites '%t' != '%t'", currentSequence, name, definedChaincode.EndorsementInfo.ReadYourWrites, cd.EndorsementInfo.ReadYourWrites)
 		case definedChaincode.ValidationInfo.ValidationPlugin != cd.ValidationInfo.ValidationPlugin:
 			return errors.Errorf("attempted to define the current sequence (%d) for namespace %s, but ValidationPlugin '%s' != '%s'", currentSequence, name, definedChaincode.ValidationInfo.ValidationPlugin, cd.ValidationInfo.ValidationPlugin)
 		case !bytes.Equal(definedChaincode.ValidationInfo.ValidationParameter, cd.ValidationInfo.ValidationParameter):
@@ -224,6 +317,74 @@ func (l *Lifecycle) ApproveChaincodeDefinitionForOrg(name string, cd *ChaincodeD
 	return nil
 }
 
+// ApproveTokenDefinitionForOrg adds a token definition entry into the passed in Org state.  The definition must be
+// for either the currently defined sequence number or the next sequence number.  If the definition is
+// for the current sequence number, then it must match exactly the current definition or it will be rejected.
+func (l *Lifecycle) ApproveTokenDefinitionForOrg(name string, td *TokenDefinition, publicState ReadableState, orgState ReadWritableState) error {
+	currentSequence, err := l.Serializer.DeserializeFieldAsInt64(NamespacesName, name, "Sequence", publicState)
+	if err != nil {
+		return errors.WithMessage(err, "could not get current sequence")
+	}
+
+	requestedSequence := td.Sequence
+
+	if currentSequence == requestedSequence && requestedSequence == 0 {
+		return errors.Errorf("requested sequence is 0, but first definable sequence number is 1")
+	}
+
+	if requestedSequence < currentSequence {
+		return errors.Errorf("currently defined sequence %d is larger than requested sequence %d", currentSequence, requestedSequence)
+	}
+
+	if requestedSequence > currentSequence+1 {
+		return errors.Errorf("requested sequence %d is larger than the next available sequence number %d", requestedSequence, currentSequence+1)
+	}
+
+	if err := l.checkNamespaceType(name, currentSequence, TokenDefinitionType, publicState); err != nil {
+		return err
+	}
+
+	if requestedSequence == currentSequence {
+		definedToken, err := l.QueryTokenDefinition(name, publicState)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case definedToken.ValidationInfo.ValidationPlugin != td.ValidationInfo.ValidationPlugin:
+			return errors.Errorf("attempted to define the current sequence (%d) for namespace %s, but ValidationPlugin '%s' != '%s'", currentSequence, name, definedToken.ValidationInfo.ValidationPlugin, td.ValidationInfo.ValidationPlugin)
+		case !bytes.Equal(definedToken.ValidationInfo.ValidationParameter, td.ValidationInfo.ValidationParameter):
+			return errors.Errorf("attempted to define the current sequence (%d) for namespace %s, but ValidationParameter '%x' != '%x'", currentSequence, name, definedToken.ValidationInfo.ValidationParameter, td.ValidationInfo.ValidationParameter)
+		}
+	}
+
+	privateName := fmt.Sprintf("%s#%d", name, requestedSequence)
+	if err := l.Serializer.Serialize(NamespacesName, privateName, td.Parameters(), orgState); err != nil {
+		return errors.WithMessage(err, "could not serialize token parameters to state")
+	}
+
+	return nil
+}
+
+// QueryTokenDefinition returns the defined token namespace by the given name (if it is defined, and a token namespace)
+// or otherwise returns an error.
+func (l *Lifecycle) QueryTokenDefinition(name string, publicState ReadableState) (*TokenDefinition, error) {
+	metadata, ok, err := l.Serializer.DeserializeMetadata(NamespacesName, name, publicState)
+	if err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("could not fetch metadata for namespace %s", name))
+	}
+	if !ok {
+		return nil, errors.Errorf("namespace %s is not defined", name)
+	}
+
+	definedToken := &TokenDefinition{}
+	if err := l.Serializer.Deserialize(NamespacesName, name, metadata, definedToken, publicState); err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("could not deserialize namespace %s as token", name))
+	}
+
+	return definedToken, nil
+}
+
 // QueryChaincodeDefinition returns the defined chaincode by the given name (if it is defined, and a chaincode)
 // or otherwise returns an error.
 func (l *Lifecycle) QueryChaincodeDefinition(name string, publicState ReadableState) (*ChaincodeDefinition, error) {
@@ -260,9 +421,9 @@ func (l *Lifecycle) InstallChaincode(name, version string, chaincodeInstallPacka
 	return hash, nil
 }
 
-// QueryNamespaceDefinitions lists the publicly defined namespaces in a channel.  Today it should only ever
-// find Datatype encodings of 'ChaincodeDefinition'.  In the future as we support encodings like 'TokenManagementSystem'
-// or similar, additional statements will be added to the switch.
+// QueryNamespaceDefinitions lists the publicly defined namespaces in a channel.  It should only ever
+// find Datatype encodings of 'ChaincodeDefinition' and 'TokenDefinition'.  As further namespace types
+// are supported, additional statements will be added to the switch.
 func (l *Lifecycle) QueryNamespaceDefinitions(publicState RangeableState) (map[string]string, error) {
 	metadatas, err := l.Serializer.DeserializeAllMetadata(NamespacesName, publicState)
 	if err != nil {
@@ -274,6 +435,8 @@ func (l *Lifecycle) QueryNamespaceDefinitions(publicState RangeableState) (map[s
 		switch value.Datatype {
 		case ChaincodeDefinitionType:
 			result[key] = FriendlyChaincodeDefinitionType
+		case TokenDefinitionType:
+			result[key] = FriendlyTokenDefinitionType
 		default:
 			// This should never execute, but seems preferable to returning an error
 			result[key] = value.Datatype
@@ -254,7 +254,7 @@ var _ = Describe("Lifecycle", func() {
 
 				It("returns an error", func() {
 					err := l.ApproveChaincodeDefinitionForOrg("cc-name", testDefinition, fakePublicState, fakeOrgState)
-					Expect(err).To(MatchError("could not deserialize namespace cc-name as chaincode: type name mismatch 'ChaincodeDefinition' != 'OtherStruct'"))
+					Expect(err).To(MatchError("namespace cc-name is already defined as type OtherStruct, and cannot be redefined as type ChaincodeDefinition"))
 				})
 			})
 
@@ -288,6 +288,17 @@ var _ = Describe("Lifecycle", func() {
 				})
 			})
 
+			Context("when ReadYourWrites differs from the current definition", func() {
+				BeforeEach(func() {
+					testDefinition.EndorsementInfo.ReadYourWrites = true
+				})
+
+				It("returns an error", func() {
+					err := l.ApproveChaincodeDefinitionForOrg("cc-name", testDefinition, fakePublicState, fakeOrgState)
+					Expect(err).To(MatchError("attempted to define the current sequence (5) for namespace cc-name, but ReadYourWrites 'false' != 'true'"))
+				})
+			})
+
 			Context("when the ValidationPlugin differs from the current definition", func() {
 				BeforeEach(func() {
 					testDefinition.ValidationInfo.ValidationPlugin = "different"
@@ -491,6 +502,365 @@ var _ = Describe("Lifecycle", func() {
 				Expect(err).To(MatchError("requested sequence is 5, but new definition must be sequence 4"))
 			})
 		})
+
+		Context("when the namespace is defined as a token namespace", func() {
+			BeforeEach(func() {
+				l.Serializer.Serialize("namespaces", "cc-name", &lifecycle.TokenDefinition{
+					Sequence:       4,
+					ValidationInfo: &lb.ChaincodeValidationInfo{},
+				}, fakePublicState)
+			})
+
+			It("returns an error", func() {
+				_, err := l.CommitChaincodeDefinition("cc-name", testDefinition, fakePublicState, []lifecycle.OpaqueState{fakeOrgStates[0], fakeOrgStates[1]})
+				Expect(err).To(MatchError("namespace cc-name is already defined as type TokenDefinition, and cannot be redefined as type ChaincodeDefinition"))
+			})
+		})
+	})
+
+	Describe("ApproveTokenDefinitionForOrg", func() {
+		var (
+			fakePublicState *mock.ReadWritableState
+			fakeOrgState    *mock.ReadWritableState
+
+			fakePublicKVStore MapLedgerShim
+			fakeOrgKVStore    MapLedgerShim
+
+			testDefinition *lifecycle.TokenDefinition
+		)
+
+		BeforeEach(func() {
+			testDefinition = &lifecycle.TokenDefinition{
+				Sequence: 5,
+				ValidationInfo: &lb.ChaincodeValidationInfo{
+					ValidationPlugin:    "validation-plugin",
+					ValidationParameter: []byte("issuing-policy"),
+				},
+			}
+
+			fakePublicKVStore = MapLedgerShim(map[string][]byte{})
+			fakePublicState = &mock.ReadWritableState{}
+			fakePublicState.PutStateStub = fakePublicKVStore.PutState
+			fakePublicState.GetStateStub = fakePublicKVStore.GetState
+
+			fakeOrgKVStore = MapLedgerShim(map[string][]byte{})
+			fakeOrgState = &mock.ReadWritableState{}
+			fakeOrgState.PutStateStub = fakeOrgKVStore.PutState
+			fakeOrgState.GetStateStub = fakeOrgKVStore.GetState
+
+			err := l.Serializer.Serialize("namespaces", "token-name", &lifecycle.TokenDefinition{
+				Sequence: 4,
+			}, fakePublicKVStore)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("serializes the token parameters to the org scoped collection", func() {
+			err := l.ApproveTokenDefinitionForOrg("token-name", testDefinition, fakePublicState, fakeOrgState)
+			Expect(err).NotTo(HaveOccurred())
+
+			metadata, ok, err := l.Serializer.DeserializeMetadata("namespaces", "token-name#5", fakeOrgState)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			approvedDefinition := &lifecycle.TokenParameters{}
+			err = l.Serializer.Deserialize("namespaces", "token-name#5", metadata, approvedDefinition, fakeOrgState)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(approvedDefinition.ValidationInfo.ValidationPlugin).To(Equal("validation-plugin"))
+			Expect(approvedDefinition.ValidationInfo.ValidationParameter).To(Equal([]byte("issuing-policy")))
+		})
+
+		Context("when the current sequence is undefined and the requested sequence is 0", func() {
+			It("returns an error", func() {
+				err := l.ApproveTokenDefinitionForOrg("unknown-name", &lifecycle.TokenDefinition{}, fakePublicState, fakeOrgState)
+				Expect(err).To(MatchError("requested sequence is 0, but first definable sequence number is 1"))
+			})
+		})
+
+		Context("when the namespace is defined as a chaincode", func() {
+			BeforeEach(func() {
+				err := l.Serializer.Serialize("namespaces", "token-name", &lifecycle.ChaincodeDefinition{
+					Sequence: 4,
+				}, fakePublicState)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns an error", func() {
+				err := l.ApproveTokenDefinitionForOrg("token-name", testDefinition, fakePublicState, fakeOrgState)
+				Expect(err).To(MatchError("namespace token-name is already defined as type ChaincodeDefinition, and cannot be redefined as type TokenDefinition"))
+			})
+		})
+
+		Context("when the sequence number already has a definition", func() {
+			BeforeEach(func() {
+				err := l.Serializer.Serialize("namespaces", "token-name", &lifecycle.TokenDefinition{
+					Sequence: 5,
+					ValidationInfo: &lb.ChaincodeValidationInfo{
+						ValidationPlugin:    "validation-plugin",
+						ValidationParameter: []byte("issuing-policy"),
+					},
+				}, fakePublicState)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("verifies that the definition matches before writing", func() {
+				err := l.ApproveTokenDefinitionForOrg("token-name", testDefinition, fakePublicState, fakeOrgState)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("when the ValidationPlugin differs from the current definition", func() {
+				BeforeEach(func() {
+					testDefinition.ValidationInfo.ValidationPlugin = "other-plugin"
+				})
+
+				It("returns an error", func() {
+					err := l.ApproveTokenDefinitionForOrg("token-name", testDefinition, fakePublicState, fakeOrgState)
+					Expect(err).To(MatchError("attempted to define the current sequence (5) for namespace token-name, but ValidationPlugin 'validation-plugin' != 'other-plugin'"))
+				})
+			})
+
+			Context("when the ValidationParameter differs from the current definition", func() {
+				BeforeEach(func() {
+					testDefinition.ValidationInfo.ValidationParameter = []byte("other-policy")
+				})
+
+				It("returns an error", func() {
+					err := l.ApproveTokenDefinitionForOrg("token-name", testDefinition, fakePublicState, fakeOrgState)
+					Expect(err).To(MatchError("attempted to define the current sequence (5) for namespace token-name, but ValidationParameter '69737375696e672d706f6c696379' != '6f746865722d706f6c696379'"))
+				})
+			})
+		})
+
+		Context("when the definition is for an expired sequence number", func() {
+			BeforeEach(func() {
+				testDefinition.Sequence = 3
+			})
+
+			It("fails", func() {
+				err := l.ApproveTokenDefinitionForOrg("token-name", testDefinition, fakePublicState, fakeOrgState)
+				Expect(err).To(MatchError("currently defined sequence 4 is larger than requested sequence 3"))
+			})
+		})
+
+		Context("when the definition is for a distant sequence number", func() {
+			BeforeEach(func() {
+				testDefinition.Sequence = 9
+			})
+
+			It("fails", func() {
+				err := l.ApproveTokenDefinitionForOrg("token-name", testDefinition, fakePublicState, fakeOrgState)
+				Expect(err).To(MatchError("requested sequence 9 is larger than the next available sequence number 5"))
+			})
+		})
+
+		Context("when querying the public state fails", func() {
+			BeforeEach(func() {
+				fakePublicState.GetStateReturns(nil, fmt.Errorf("get-state-error"))
+			})
+
+			It("wraps and returns the error", func() {
+				err := l.ApproveTokenDefinitionForOrg("token-name", testDefinition, fakePublicState, fakeOrgState)
+				Expect(err).To(MatchError("could not get current sequence: could not get state for key namespaces/fields/token-name/Sequence: get-state-error"))
+			})
+		})
+
+		Context("when writing to the org state fails", func() {
+			BeforeEach(func() {
+				fakeOrgState.PutStateReturns(fmt.Errorf("put-state-error"))
+			})
+
+			It("wraps and returns the error", func() {
+				err := l.ApproveTokenDefinitionForOrg("token-name", testDefinition, fakePublicState, fakeOrgState)
+				Expect(err).To(MatchError("could not serialize token parameters to state: could not write key into state: put-state-error"))
+			})
+		})
+	})
+
+	Describe("CommitTokenDefinition", func() {
+		var (
+			fakePublicState *mock.ReadWritableState
+			fakeOrgStates   []*mock.ReadWritableState
+
+			testDefinition *lifecycle.TokenDefinition
+
+			publicKVS, org0KVS, org1KVS MapLedgerShim
+		)
+
+		BeforeEach(func() {
+			testDefinition = &lifecycle.TokenDefinition{
+				Sequence: 2,
+				ValidationInfo: &lb.ChaincodeValidationInfo{
+					ValidationPlugin:    "validation-plugin",
+					ValidationParameter: []byte("issuing-policy"),
+				},
+			}
+
+			publicKVS = MapLedgerShim(map[string][]byte{})
+			fakePublicState = &mock.ReadWritableState{}
+			fakePublicState.GetStateStub = publicKVS.GetState
+			fakePublicState.PutStateStub = publicKVS.PutState
+
+			l.Serializer.Serialize("namespaces", "token-name", &lifecycle.TokenDefinition{
+				Sequence: 1,
+				ValidationInfo: &lb.ChaincodeValidationInfo{
+					ValidationPlugin:    "validation-plugin",
+					ValidationParameter: []byte("old-issuing-policy"),
+				},
+			}, publicKVS)
+
+			org0KVS = MapLedgerShim(map[string][]byte{})
+			org1KVS = MapLedgerShim(map[string][]byte{})
+			fakeOrgStates = []*mock.ReadWritableState{{}, {}}
+			for i, kvs := range []MapLedgerShim{org0KVS, org1KVS} {
+				kvs := kvs
+				fakeOrgStates[i].GetStateStub = kvs.GetState
+				fakeOrgStates[i].GetStateHashStub = kvs.GetStateHash
+				fakeOrgStates[i].PutStateStub = kvs.PutState
+			}
+
+			l.Serializer.Serialize("namespaces", "token-name#2", testDefinition.Parameters(), fakeOrgStates[0])
+			l.Serializer.Serialize("namespaces", "token-name#2", &lifecycle.TokenParameters{}, fakeOrgStates[1])
+		})
+
+		It("applies the token definition and returns the agreements", func() {
+			agreements, err := l.CommitTokenDefinition("token-name", testDefinition, fakePublicState, []lifecycle.OpaqueState{fakeOrgStates[0], fakeOrgStates[1]})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(agreements).To(Equal([]bool{true, false}))
+
+			td, err := l.QueryTokenDefinition("token-name", fakePublicState)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(td.Sequence).To(Equal(int64(2)))
+			Expect(td.ValidationInfo.ValidationParameter).To(Equal([]byte("issuing-policy")))
+		})
+
+		Context("when an org approved a chaincode definition of the same name", func() {
+			BeforeEach(func() {
+				l.Serializer.Serialize("namespaces", "token-name#2", &lifecycle.ChaincodeParameters{
+					ValidationInfo: testDefinition.ValidationInfo,
+				}, fakeOrgStates[0])
+			})
+
+			It("does not count it as agreement", func() {
+				agreements, err := l.CommitTokenDefinition("token-name", testDefinition, fakePublicState, []lifecycle.OpaqueState{fakeOrgStates[0], fakeOrgStates[1]})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(agreements).To(Equal([]bool{false, false}))
+			})
+		})
+
+		Context("when the public state is not readable", func() {
+			BeforeEach(func() {
+				fakePublicState.GetStateReturns(nil, fmt.Errorf("getstate-error"))
+			})
+
+			It("wraps and returns the error", func() {
+				_, err := l.CommitTokenDefinition("token-name", testDefinition, fakePublicState, []lifecycle.OpaqueState{fakeOrgStates[0], fakeOrgStates[1]})
+				Expect(err).To(MatchError("could not get current sequence: could not get state for key namespaces/fields/token-name/Sequence: getstate-error"))
+			})
+		})
+
+		Context("when the public state is not writable", func() {
+			BeforeEach(func() {
+				fakePublicState.PutStateReturns(fmt.Errorf("putstate-error"))
+			})
+
+			It("wraps and returns the error", func() {
+				_, err := l.CommitTokenDefinition("token-name", testDefinition, fakePublicState, []lifecycle.OpaqueState{fakeOrgStates[0], fakeOrgStates[1]})
+				Expect(err).To(MatchError("could not serialize token definition: could not write key into state: putstate-error"))
+			})
+		})
+
+		Context("when the current sequence is not immediately prior to the new", func() {
+			BeforeEach(func() {
+				testDefinition.Sequence = 3
+			})
+
+			It("returns an error", func() {
+				_, err := l.CommitTokenDefinition("token-name", testDefinition, fakePublicState, []lifecycle.OpaqueState{fakeOrgStates[0], fakeOrgStates[1]})
+				Expect(err).To(MatchError("requested sequence is 3, but new definition must be sequence 2"))
+			})
+		})
+
+		Context("when the namespace is defined as a chaincode", func() {
+			BeforeEach(func() {
+				l.Serializer.Serialize("namespaces", "token-name", &lifecycle.ChaincodeDefinition{
+					Sequence: 1,
+				}, fakePublicState)
+			})
+
+			It("returns an error", func() {
+				_, err := l.CommitTokenDefinition("token-name", testDefinition, fakePublicState, []lifecycle.OpaqueState{fakeOrgStates[0], fakeOrgStates[1]})
+				Expect(err).To(MatchError("namespace token-name is already defined as type ChaincodeDefinition, and cannot be redefined as type TokenDefinition"))
+			})
+		})
+
+		Context("when the metadata of the current definition is missing", func() {
+			BeforeEach(func() {
+				delete(publicKVS, "namespaces/metadata/token-name")
+			})
+
+			It("returns an error", func() {
+				_, err := l.CommitTokenDefinition("token-name", testDefinition, fakePublicState, []lifecycle.OpaqueState{fakeOrgStates[0], fakeOrgStates[1]})
+				Expect(err).To(MatchError("missing metadata for currently committed sequence number (1)"))
+			})
+		})
+	})
+
+	Describe("QueryTokenDefinition", func() {
+		var (
+			fakePublicState *mock.ReadWritableState
+
+			publicKVS MapLedgerShim
+		)
+
+		BeforeEach(func() {
+			publicKVS = MapLedgerShim(map[string][]byte{})
+			fakePublicState = &mock.ReadWritableState{}
+			fakePublicState.GetStateStub = publicKVS.GetState
+
+			l.Serializer.Serialize("namespaces", "token-name", &lifecycle.TokenDefinition{
+				Sequence: 3,
+				ValidationInfo: &lb.ChaincodeValidationInfo{
+					ValidationPlugin:    "validation-plugin",
+					ValidationParameter: []byte("issuing-policy"),
+				},
+			}, publicKVS)
+			l.Serializer.Serialize("namespaces", "cc-name", &lifecycle.ChaincodeDefinition{}, publicKVS)
+		})
+
+		It("returns the defined token namespace", func() {
+			td, err := l.QueryTokenDefinition("token-name", fakePublicState)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(td).To(Equal(&lifecycle.TokenDefinition{
+				Sequence: 3,
+				ValidationInfo: &lb.ChaincodeValidationInfo{
+					ValidationPlugin:    "validation-plugin",
+					ValidationParameter: []byte("issuing-policy"),
+				},
+			}))
+		})
+
+		Context("when the namespace is not defined", func() {
+			It("returns an error", func() {
+				_, err := l.QueryTokenDefinition("unknown-name", fakePublicState)
+				Expect(err).To(MatchError("namespace unknown-name is not defined"))
+			})
+		})
+
+		Context("when the namespace is a chaincode", func() {
+			It("returns an error", func() {
+				_, err := l.QueryTokenDefinition("cc-name", fakePublicState)
+				Expect(err).To(MatchError("could not deserialize namespace cc-name as token: type name mismatch 'TokenDefinition' != 'ChaincodeDefinition'"))
+			})
+		})
+
+		Context("when getting the metadata fails", func() {
+			BeforeEach(func() {
+				fakePublicState.GetStateReturns(nil, fmt.Errorf("metadata-error"))
+			})
+
+			It("returns an error", func() {
+				_, err := l.QueryTokenDefinition("token-name", fakePublicState)
+				Expect(err).To(MatchError("could not fetch metadata for namespace token-name: could not query metadata for namespace namespaces/token-name: metadata-error"))
+			})
+		})
 	})
 
 	Describe("QueryChaincodeDefinition", func() {
@@ -585,6 +955,7 @@ var _ = Describe("Lifecycle", func() {
 			fakePublicState.GetStateStub = publicKVS.GetState
 			fakePublicState.GetStateRangeStub = publicKVS.GetStateRange
 			l.Serializer.Serialize("namespaces", "cc-name", &lifecycle.ChaincodeDefinition{}, publicKVS)
+			l.Serializer.Serialize("namespaces", "token-name", &lifecycle.TokenDefinition{}, publicKVS)
 			l.Serializer.Serialize("namespaces", "other-name", &lifecycle.ChaincodeParameters{}, publicKVS)
 		})
 
@@ -593,6 +964,7 @@ var _ = Describe("Lifecycle", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(result).To(Equal(map[string]string{
 				"cc-name":    "Chaincode",
+				"token-name": "Token",
 				"other-name": "ChaincodeParameters",
 			}))
 		})
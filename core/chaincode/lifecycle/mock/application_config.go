@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/protos/peer"
 )
 
 type ApplicationConfig struct {
@@ -28,6 +29,16 @@ type ApplicationConfig struct {
 	capabilitiesReturnsOnCall map[int]struct {
 		result1 channelconfig.ApplicationCapabilities
 	}
+	InvocationQuotaStub        func() *peer.InvocationQuota
+	invocationQuotaMutex       sync.RWMutex
+	invocationQuotaArgsForCall []struct {
+	}
+	invocationQuotaReturns struct {
+		result1 *peer.InvocationQuota
+	}
+	invocationQuotaReturnsOnCall map[int]struct {
+		result1 *peer.InvocationQuota
+	}
 	OrganizationsStub        func() map[string]channelconfig.ApplicationOrg
 	organizationsMutex       sync.RWMutex
 	organizationsArgsForCall []struct {
@@ -146,6 +157,58 @@ func (fake *ApplicationConfig) CapabilitiesReturnsOnCall(i int, result1 channelc
 	}{result1}
 }
 
+func (fake *ApplicationConfig) InvocationQuota() *peer.InvocationQuota {
+	fake.invocationQuotaMutex.Lock()
+	ret, specificReturn := fake.invocationQuotaReturnsOnCall[len(fake.invocationQuotaArgsForCall)]
+	fake.invocationQuotaArgsForCall = append(fake.invocationQuotaArgsForCall, struct {
+	}{})
+	fake.recordInvocation("InvocationQuota", []interface{}{})
+	fake.invocationQuotaMutex.Unlock()
+	if fake.InvocationQuotaStub != nil {
+		return fake.InvocationQuotaStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.invocationQuotaReturns
+	return fakeReturns.result1
+}
+
+func (fake *ApplicationConfig) InvocationQuotaCallCount() int {
+	fake.invocationQuotaMutex.RLock()
+	defer fake.invocationQuotaMutex.RUnlock()
+	return len(fake.invocationQuotaArgsForCall)
+}
+
+func (fake *ApplicationConfig) InvocationQuotaCalls(stub func() *peer.InvocationQuota) {
+	fake.invocationQuotaMutex.Lock()
+	defer fake.invocationQuotaMutex.Unlock()
+	fake.InvocationQuotaStub = stub
+}
+
+func (fake *ApplicationConfig) InvocationQuotaReturns(result1 *peer.InvocationQuota) {
+	fake.invocationQuotaMutex.Lock()
+	defer fake.invocationQuotaMutex.Unlock()
+	fake.InvocationQuotaStub = nil
+	fake.invocationQuotaReturns = struct {
+		result1 *peer.InvocationQuota
+	}{result1}
+}
+
+func (fake *ApplicationConfig) InvocationQuotaReturnsOnCall(i int, result1 *peer.InvocationQuota) {
+	fake.invocationQuotaMutex.Lock()
+	defer fake.invocationQuotaMutex.Unlock()
+	fake.InvocationQuotaStub = nil
+	if fake.invocationQuotaReturnsOnCall == nil {
+		fake.invocationQuotaReturnsOnCall = make(map[int]struct {
+			result1 *peer.InvocationQuota
+		})
+	}
+	fake.invocationQuotaReturnsOnCall[i] = struct {
+		result1 *peer.InvocationQuota
+	}{result1}
+}
+
 func (fake *ApplicationConfig) Organizations() map[string]channelconfig.ApplicationOrg {
 	fake.organizationsMutex.Lock()
 	ret, specificReturn := fake.organizationsReturnsOnCall[len(fake.organizationsArgsForCall)]
@@ -205,6 +268,8 @@ func (fake *ApplicationConfig) Invocations() map[string][][]interface{} {
 	defer fake.aPIPolicyMapperMutex.RUnlock()
 	fake.capabilitiesMutex.RLock()
 	defer fake.capabilitiesMutex.RUnlock()
+	fake.invocationQuotaMutex.RLock()
+	defer fake.invocationQuotaMutex.RUnlock()
 	fake.organizationsMutex.RLock()
 	defer fake.organizationsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -38,6 +38,16 @@ type ApplicationOrgConfig struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	PeerEndpointsStub        func() []string
+	peerEndpointsMutex       sync.RWMutex
+	peerEndpointsArgsForCall []struct {
+	}
+	peerEndpointsReturns struct {
+		result1 []string
+	}
+	peerEndpointsReturnsOnCall map[int]struct {
+		result1 []string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -198,6 +208,58 @@ func (fake *ApplicationOrgConfig) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *ApplicationOrgConfig) PeerEndpoints() []string {
+	fake.peerEndpointsMutex.Lock()
+	ret, specificReturn := fake.peerEndpointsReturnsOnCall[len(fake.peerEndpointsArgsForCall)]
+	fake.peerEndpointsArgsForCall = append(fake.peerEndpointsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PeerEndpoints", []interface{}{})
+	fake.peerEndpointsMutex.Unlock()
+	if fake.PeerEndpointsStub != nil {
+		return fake.PeerEndpointsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.peerEndpointsReturns
+	return fakeReturns.result1
+}
+
+func (fake *ApplicationOrgConfig) PeerEndpointsCallCount() int {
+	fake.peerEndpointsMutex.RLock()
+	defer fake.peerEndpointsMutex.RUnlock()
+	return len(fake.peerEndpointsArgsForCall)
+}
+
+func (fake *ApplicationOrgConfig) PeerEndpointsCalls(stub func() []string) {
+	fake.peerEndpointsMutex.Lock()
+	defer fake.peerEndpointsMutex.Unlock()
+	fake.PeerEndpointsStub = stub
+}
+
+func (fake *ApplicationOrgConfig) PeerEndpointsReturns(result1 []string) {
+	fake.peerEndpointsMutex.Lock()
+	defer fake.peerEndpointsMutex.Unlock()
+	fake.PeerEndpointsStub = nil
+	fake.peerEndpointsReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *ApplicationOrgConfig) PeerEndpointsReturnsOnCall(i int, result1 []string) {
+	fake.peerEndpointsMutex.Lock()
+	defer fake.peerEndpointsMutex.Unlock()
+	fake.PeerEndpointsStub = nil
+	if fake.peerEndpointsReturnsOnCall == nil {
+		fake.peerEndpointsReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.peerEndpointsReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
 func (fake *ApplicationOrgConfig) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -207,6 +269,8 @@ func (fake *ApplicationOrgConfig) Invocations() map[string][][]interface{} {
 	defer fake.mSPIDMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.peerEndpointsMutex.RLock()
+	defer fake.peerEndpointsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -23,6 +23,20 @@ type SCCFunctions struct {
 	approveChaincodeDefinitionForOrgReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ApproveTokenDefinitionForOrgStub        func(string, *lifecycle.TokenDefinition, lifecycle.ReadableState, lifecycle.ReadWritableState) error
+	approveTokenDefinitionForOrgMutex       sync.RWMutex
+	approveTokenDefinitionForOrgArgsForCall []struct {
+		arg1 string
+		arg2 *lifecycle.TokenDefinition
+		arg3 lifecycle.ReadableState
+		arg4 lifecycle.ReadWritableState
+	}
+	approveTokenDefinitionForOrgReturns struct {
+		result1 error
+	}
+	approveTokenDefinitionForOrgReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CommitChaincodeDefinitionStub        func(string, *lifecycle.ChaincodeDefinition, lifecycle.ReadWritableState, []lifecycle.OpaqueState) ([]bool, error)
 	commitChaincodeDefinitionMutex       sync.RWMutex
 	commitChaincodeDefinitionArgsForCall []struct {
@@ -39,6 +53,22 @@ type SCCFunctions struct {
 		result1 []bool
 		result2 error
 	}
+	CommitTokenDefinitionStub        func(string, *lifecycle.TokenDefinition, lifecycle.ReadWritableState, []lifecycle.OpaqueState) ([]bool, error)
+	commitTokenDefinitionMutex       sync.RWMutex
+	commitTokenDefinitionArgsForCall []struct {
+		arg1 string
+		arg2 *lifecycle.TokenDefinition
+		arg3 lifecycle.ReadWritableState
+		arg4 []lifecycle.OpaqueState
+	}
+	commitTokenDefinitionReturns struct {
+		result1 []bool
+		result2 error
+	}
+	commitTokenDefinitionReturnsOnCall map[int]struct {
+		result1 []bool
+		result2 error
+	}
 	InstallChaincodeStub        func(string, string, []byte) ([]byte, error)
 	installChaincodeMutex       sync.RWMutex
 	installChaincodeArgsForCall []struct {
@@ -107,6 +137,20 @@ type SCCFunctions struct {
 		result1 map[string]string
 		result2 error
 	}
+	QueryTokenDefinitionStub        func(string, lifecycle.ReadableState) (*lifecycle.TokenDefinition, error)
+	queryTokenDefinitionMutex       sync.RWMutex
+	queryTokenDefinitionArgsForCall []struct {
+		arg1 string
+		arg2 lifecycle.ReadableState
+	}
+	queryTokenDefinitionReturns struct {
+		result1 *lifecycle.TokenDefinition
+		result2 error
+	}
+	queryTokenDefinitionReturnsOnCall map[int]struct {
+		result1 *lifecycle.TokenDefinition
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -174,6 +218,69 @@ func (fake *SCCFunctions) ApproveChaincodeDefinitionForOrgReturnsOnCall(i int, r
 	}{result1}
 }
 
+func (fake *SCCFunctions) ApproveTokenDefinitionForOrg(arg1 string, arg2 *lifecycle.TokenDefinition, arg3 lifecycle.ReadableState, arg4 lifecycle.ReadWritableState) error {
+	fake.approveTokenDefinitionForOrgMutex.Lock()
+	ret, specificReturn := fake.approveTokenDefinitionForOrgReturnsOnCall[len(fake.approveTokenDefinitionForOrgArgsForCall)]
+	fake.approveTokenDefinitionForOrgArgsForCall = append(fake.approveTokenDefinitionForOrgArgsForCall, struct {
+		arg1 string
+		arg2 *lifecycle.TokenDefinition
+		arg3 lifecycle.ReadableState
+		arg4 lifecycle.ReadWritableState
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("ApproveTokenDefinitionForOrg", []interface{}{arg1, arg2, arg3, arg4})
+	fake.approveTokenDefinitionForOrgMutex.Unlock()
+	if fake.ApproveTokenDefinitionForOrgStub != nil {
+		return fake.ApproveTokenDefinitionForOrgStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.approveTokenDefinitionForOrgReturns
+	return fakeReturns.result1
+}
+
+func (fake *SCCFunctions) ApproveTokenDefinitionForOrgCallCount() int {
+	fake.approveTokenDefinitionForOrgMutex.RLock()
+	defer fake.approveTokenDefinitionForOrgMutex.RUnlock()
+	return len(fake.approveTokenDefinitionForOrgArgsForCall)
+}
+
+func (fake *SCCFunctions) ApproveTokenDefinitionForOrgCalls(stub func(string, *lifecycle.TokenDefinition, lifecycle.ReadableState, lifecycle.ReadWritableState) error) {
+	fake.approveTokenDefinitionForOrgMutex.Lock()
+	defer fake.approveTokenDefinitionForOrgMutex.Unlock()
+	fake.ApproveTokenDefinitionForOrgStub = stub
+}
+
+func (fake *SCCFunctions) ApproveTokenDefinitionForOrgArgsForCall(i int) (string, *lifecycle.TokenDefinition, lifecycle.ReadableState, lifecycle.ReadWritableState) {
+	fake.approveTokenDefinitionForOrgMutex.RLock()
+	defer fake.approveTokenDefinitionForOrgMutex.RUnlock()
+	argsForCall := fake.approveTokenDefinitionForOrgArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *SCCFunctions) ApproveTokenDefinitionForOrgReturns(result1 error) {
+	fake.approveTokenDefinitionForOrgMutex.Lock()
+	defer fake.approveTokenDefinitionForOrgMutex.Unlock()
+	fake.ApproveTokenDefinitionForOrgStub = nil
+	fake.approveTokenDefinitionForOrgReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SCCFunctions) ApproveTokenDefinitionForOrgReturnsOnCall(i int, result1 error) {
+	fake.approveTokenDefinitionForOrgMutex.Lock()
+	defer fake.approveTokenDefinitionForOrgMutex.Unlock()
+	fake.ApproveTokenDefinitionForOrgStub = nil
+	if fake.approveTokenDefinitionForOrgReturnsOnCall == nil {
+		fake.approveTokenDefinitionForOrgReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.approveTokenDefinitionForOrgReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *SCCFunctions) CommitChaincodeDefinition(arg1 string, arg2 *lifecycle.ChaincodeDefinition, arg3 lifecycle.ReadWritableState, arg4 []lifecycle.OpaqueState) ([]bool, error) {
 	var arg4Copy []lifecycle.OpaqueState
 	if arg4 != nil {
@@ -245,6 +352,77 @@ func (fake *SCCFunctions) CommitChaincodeDefinitionReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *SCCFunctions) CommitTokenDefinition(arg1 string, arg2 *lifecycle.TokenDefinition, arg3 lifecycle.ReadWritableState, arg4 []lifecycle.OpaqueState) ([]bool, error) {
+	var arg4Copy []lifecycle.OpaqueState
+	if arg4 != nil {
+		arg4Copy = make([]lifecycle.OpaqueState, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.commitTokenDefinitionMutex.Lock()
+	ret, specificReturn := fake.commitTokenDefinitionReturnsOnCall[len(fake.commitTokenDefinitionArgsForCall)]
+	fake.commitTokenDefinitionArgsForCall = append(fake.commitTokenDefinitionArgsForCall, struct {
+		arg1 string
+		arg2 *lifecycle.TokenDefinition
+		arg3 lifecycle.ReadWritableState
+		arg4 []lifecycle.OpaqueState
+	}{arg1, arg2, arg3, arg4Copy})
+	fake.recordInvocation("CommitTokenDefinition", []interface{}{arg1, arg2, arg3, arg4Copy})
+	fake.commitTokenDefinitionMutex.Unlock()
+	if fake.CommitTokenDefinitionStub != nil {
+		return fake.CommitTokenDefinitionStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.commitTokenDefinitionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SCCFunctions) CommitTokenDefinitionCallCount() int {
+	fake.commitTokenDefinitionMutex.RLock()
+	defer fake.commitTokenDefinitionMutex.RUnlock()
+	return len(fake.commitTokenDefinitionArgsForCall)
+}
+
+func (fake *SCCFunctions) CommitTokenDefinitionCalls(stub func(string, *lifecycle.TokenDefinition, lifecycle.ReadWritableState, []lifecycle.OpaqueState) ([]bool, error)) {
+	fake.commitTokenDefinitionMutex.Lock()
+	defer fake.commitTokenDefinitionMutex.Unlock()
+	fake.CommitTokenDefinitionStub = stub
+}
+
+func (fake *SCCFunctions) CommitTokenDefinitionArgsForCall(i int) (string, *lifecycle.TokenDefinition, lifecycle.ReadWritableState, []lifecycle.OpaqueState) {
+	fake.commitTokenDefinitionMutex.RLock()
+	defer fake.commitTokenDefinitionMutex.RUnlock()
+	argsForCall := fake.commitTokenDefinitionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *SCCFunctions) CommitTokenDefinitionReturns(result1 []bool, result2 error) {
+	fake.commitTokenDefinitionMutex.Lock()
+	defer fake.commitTokenDefinitionMutex.Unlock()
+	fake.CommitTokenDefinitionStub = nil
+	fake.commitTokenDefinitionReturns = struct {
+		result1 []bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SCCFunctions) CommitTokenDefinitionReturnsOnCall(i int, result1 []bool, result2 error) {
+	fake.commitTokenDefinitionMutex.Lock()
+	defer fake.commitTokenDefinitionMutex.Unlock()
+	fake.CommitTokenDefinitionStub = nil
+	if fake.commitTokenDefinitionReturnsOnCall == nil {
+		fake.commitTokenDefinitionReturnsOnCall = make(map[int]struct {
+			result1 []bool
+			result2 error
+		})
+	}
+	fake.commitTokenDefinitionReturnsOnCall[i] = struct {
+		result1 []bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *SCCFunctions) InstallChaincode(arg1 string, arg2 string, arg3 []byte) ([]byte, error) {
 	var arg3Copy []byte
 	if arg3 != nil {
@@ -561,13 +739,81 @@ func (fake *SCCFunctions) QueryNamespaceDefinitionsReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *SCCFunctions) QueryTokenDefinition(arg1 string, arg2 lifecycle.ReadableState) (*lifecycle.TokenDefinition, error) {
+	fake.queryTokenDefinitionMutex.Lock()
+	ret, specificReturn := fake.queryTokenDefinitionReturnsOnCall[len(fake.queryTokenDefinitionArgsForCall)]
+	fake.queryTokenDefinitionArgsForCall = append(fake.queryTokenDefinitionArgsForCall, struct {
+		arg1 string
+		arg2 lifecycle.ReadableState
+	}{arg1, arg2})
+	fake.recordInvocation("QueryTokenDefinition", []interface{}{arg1, arg2})
+	fake.queryTokenDefinitionMutex.Unlock()
+	if fake.QueryTokenDefinitionStub != nil {
+		return fake.QueryTokenDefinitionStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.queryTokenDefinitionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SCCFunctions) QueryTokenDefinitionCallCount() int {
+	fake.queryTokenDefinitionMutex.RLock()
+	defer fake.queryTokenDefinitionMutex.RUnlock()
+	return len(fake.queryTokenDefinitionArgsForCall)
+}
+
+func (fake *SCCFunctions) QueryTokenDefinitionCalls(stub func(string, lifecycle.ReadableState) (*lifecycle.TokenDefinition, error)) {
+	fake.queryTokenDefinitionMutex.Lock()
+	defer fake.queryTokenDefinitionMutex.Unlock()
+	fake.QueryTokenDefinitionStub = stub
+}
+
+func (fake *SCCFunctions) QueryTokenDefinitionArgsForCall(i int) (string, lifecycle.ReadableState) {
+	fake.queryTokenDefinitionMutex.RLock()
+	defer fake.queryTokenDefinitionMutex.RUnlock()
+	argsForCall := fake.queryTokenDefinitionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *SCCFunctions) QueryTokenDefinitionReturns(result1 *lifecycle.TokenDefinition, result2 error) {
+	fake.queryTokenDefinitionMutex.Lock()
+	defer fake.queryTokenDefinitionMutex.Unlock()
+	fake.QueryTokenDefinitionStub = nil
+	fake.queryTokenDefinitionReturns = struct {
+		result1 *lifecycle.TokenDefinition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SCCFunctions) QueryTokenDefinitionReturnsOnCall(i int, result1 *lifecycle.TokenDefinition, result2 error) {
+	fake.queryTokenDefinitionMutex.Lock()
+	defer fake.queryTokenDefinitionMutex.Unlock()
+	fake.QueryTokenDefinitionStub = nil
+	if fake.queryTokenDefinitionReturnsOnCall == nil {
+		fake.queryTokenDefinitionReturnsOnCall = make(map[int]struct {
+			result1 *lifecycle.TokenDefinition
+			result2 error
+		})
+	}
+	fake.queryTokenDefinitionReturnsOnCall[i] = struct {
+		result1 *lifecycle.TokenDefinition
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *SCCFunctions) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.approveChaincodeDefinitionForOrgMutex.RLock()
 	defer fake.approveChaincodeDefinitionForOrgMutex.RUnlock()
+	fake.approveTokenDefinitionForOrgMutex.RLock()
+	defer fake.approveTokenDefinitionForOrgMutex.RUnlock()
 	fake.commitChaincodeDefinitionMutex.RLock()
 	defer fake.commitChaincodeDefinitionMutex.RUnlock()
+	fake.commitTokenDefinitionMutex.RLock()
+	defer fake.commitTokenDefinitionMutex.RUnlock()
 	fake.installChaincodeMutex.RLock()
 	defer fake.installChaincodeMutex.RUnlock()
 	fake.queryChaincodeDefinitionMutex.RLock()
@@ -578,6 +824,8 @@ func (fake *SCCFunctions) Invocations() map[string][][]interface{} {
 	defer fake.queryInstalledChaincodesMutex.RUnlock()
 	fake.queryNamespaceDefinitionsMutex.RLock()
 	defer fake.queryNamespaceDefinitionsMutex.RUnlock()
+	fake.queryTokenDefinitionMutex.RLock()
+	defer fake.queryTokenDefinitionMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
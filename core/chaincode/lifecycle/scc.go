@@ -8,7 +8,9 @@ package lifecycle
 
 import (
 	"fmt"
+	"strconv"
 
+	"github.com/hyperledger/fabric/common/audit"
 	"github.com/hyperledger/fabric/common/chaincode"
 	"github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/core/chaincode/shim"
@@ -48,6 +50,16 @@ const (
 	// QueryNamespaceDefinitions is the chaincode function name used query which namespaces are currently defined
 	// and what type those namespaces are.
 	QueryNamespaceDefinitionsFuncName = "QueryNamespaceDefinitions"
+
+	// ApproveTokenDefinitionForMyOrgFuncName is the chaincode function name used to approve a token definition
+	// for the user's own org
+	ApproveTokenDefinitionForMyOrgFuncName = "ApproveTokenDefinitionForMyOrg"
+
+	// CommitTokenDefinitionFuncName is the chaincode function name used to define a token namespace in a channel.
+	CommitTokenDefinitionFuncName = "CommitTokenDefinition"
+
+	// QueryTokenDefinitionFuncName is the chaincode function name used to query a token definition.
+	QueryTokenDefinitionFuncName = "QueryTokenDefinition"
 )
 
 // SCCFunctions provides a backing implementation with concrete arguments
@@ -73,6 +85,15 @@ type SCCFunctions interface {
 
 	// QueryNamespaceDefinitions returns all defined namespaces
 	QueryNamespaceDefinitions(publicState RangeableState) (map[string]string, error)
+
+	// ApproveTokenDefinitionForOrg records a token definition into this org's implicit collection.
+	ApproveTokenDefinitionForOrg(name string, td *TokenDefinition, publicState ReadableState, orgState ReadWritableState) error
+
+	// CommitTokenDefinition records a new token definition into the public state and returns the orgs which agreed with that definition.
+	CommitTokenDefinition(name string, td *TokenDefinition, publicState ReadWritableState, orgStates []OpaqueState) ([]bool, error)
+
+	// QueryTokenDefinition reads a token definition from the public state.
+	QueryTokenDefinition(name string, publicState ReadableState) (*TokenDefinition, error)
 }
 
 //go:generate counterfeiter -o mock/channel_config_source.go --fake-name ChannelConfigSource . ChannelConfigSource
@@ -198,6 +219,10 @@ type Invocation struct {
 // lifecycle implementation.
 func (i *Invocation) InstallChaincode(input *lb.InstallChaincodeArgs) (proto.Message, error) {
 	hash, err := i.SCC.Functions.InstallChaincode(input.Name, input.Version, input.ChaincodeInstallPackage)
+	i.auditEvent(audit.ChaincodeInstall, err, map[string]string{
+		"name":    input.Name,
+		"version": input.Version,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -245,7 +270,7 @@ func (i *Invocation) QueryInstalledChaincodes(input *lb.QueryInstalledChaincodes
 // lifecycle implementation
 func (i *Invocation) ApproveChaincodeDefinitionForMyOrg(input *lb.ApproveChaincodeDefinitionForMyOrgArgs) (proto.Message, error) {
 	collectionName := ImplicitCollectionNameForOrg(i.SCC.OrgMSPID)
-	if err := i.SCC.Functions.ApproveChaincodeDefinitionForOrg(
+	err := i.SCC.Functions.ApproveChaincodeDefinitionForOrg(
 		input.Name,
 		&ChaincodeDefinition{
 			Sequence: input.Sequence,
@@ -254,6 +279,7 @@ func (i *Invocation) ApproveChaincodeDefinitionForMyOrg(input *lb.ApproveChainco
 				Id:                input.Hash,
 				EndorsementPlugin: input.EndorsementPlugin,
 				InitRequired:      input.InitRequired,
+				ReadYourWrites:    input.ReadYourWrites,
 			},
 			ValidationInfo: &lb.ChaincodeValidationInfo{
 				ValidationPlugin:    input.ValidationPlugin,
@@ -266,15 +292,41 @@ func (i *Invocation) ApproveChaincodeDefinitionForMyOrg(input *lb.ApproveChainco
 			Collection: collectionName,
 			Stub:       i.Stub,
 		},
-	); err != nil {
+	)
+	i.auditEvent(audit.ChaincodeApprove, err, map[string]string{
+		"name":     input.Name,
+		"version":  input.Version,
+		"sequence": strconv.FormatInt(input.Sequence, 10),
+	})
+	if err != nil {
 		return nil, err
 	}
 	return &lb.ApproveChaincodeDefinitionForMyOrgResult{}, nil
 }
 
-func (i *Invocation) CommitChaincodeDefinition(input *lb.CommitChaincodeDefinitionArgs) (proto.Message, error) {
+// auditEvent records the outcome of the invocation in the audit log.
+func (i *Invocation) auditEvent(eventType string, err error, details map[string]string) {
+	event := audit.Event{
+		Type:    eventType,
+		Outcome: audit.Success,
+		Channel: i.Stub.GetChannelID(),
+		Details: details,
+	}
+	if creator, cerr := i.Stub.GetCreator(); cerr == nil {
+		event.Identity = audit.Identity(creator)
+	}
+	if err != nil {
+		event.Outcome = audit.Failure
+		event.Details["reason"] = err.Error()
+	}
+	audit.Log(event)
+}
+
+// orgStates returns the implicit collections of the orgs of the channel, and the
+// index of the collection of this peer's org.
+func (i *Invocation) orgStates() ([]OpaqueState, int, error) {
 	if i.ApplicationConfig == nil {
-		return nil, errors.Errorf("no application config for channel '%s'", i.Stub.GetChannelID())
+		return nil, 0, errors.Errorf("no application config for channel '%s'", i.Stub.GetChannelID())
 	}
 
 	orgs := i.ApplicationConfig.Organizations()
@@ -291,7 +343,16 @@ func (i *Invocation) CommitChaincodeDefinition(input *lb.CommitChaincodeDefiniti
 	}
 
 	if myOrgIndex == -1 {
-		return nil, errors.Errorf("impossibly, this peer's org is processing requests for a channel it is not a member of")
+		return nil, 0, errors.Errorf("impossibly, this peer's org is processing requests for a channel it is not a member of")
+	}
+
+	return opaqueStates, myOrgIndex, nil
+}
+
+func (i *Invocation) CommitChaincodeDefinition(input *lb.CommitChaincodeDefinitionArgs) (proto.Message, error) {
+	opaqueStates, myOrgIndex, err := i.orgStates()
+	if err != nil {
+		return nil, err
 	}
 
 	agreement, err := i.SCC.Functions.CommitChaincodeDefinition(
@@ -303,6 +364,7 @@ func (i *Invocation) CommitChaincodeDefinition(input *lb.CommitChaincodeDefiniti
 				Version:           input.Version,
 				EndorsementPlugin: input.EndorsementPlugin,
 				InitRequired:      input.InitRequired,
+				ReadYourWrites:    input.ReadYourWrites,
 			},
 			ValidationInfo: &lb.ChaincodeValidationInfo{
 				ValidationPlugin:    input.ValidationPlugin,
@@ -339,6 +401,7 @@ func (i *Invocation) QueryChaincodeDefinition(input *lb.QueryChaincodeDefinition
 		ValidationParameter: definedChaincode.ValidationInfo.ValidationParameter,
 		Hash:                definedChaincode.EndorsementInfo.Id,
 		InitRequired:        definedChaincode.EndorsementInfo.InitRequired,
+		ReadYourWrites:      definedChaincode.EndorsementInfo.ReadYourWrites,
 		Collections:         definedChaincode.Collections,
 	}, nil
 }
@@ -358,3 +421,70 @@ func (i *Invocation) QueryNamespaceDefinitions(input *lb.QueryNamespaceDefinitio
 		Namespaces: result,
 	}, nil
 }
+
+// ApproveTokenDefinitionForMyOrg is a SCC function that may be dispatched to which routes to the underlying
+// lifecycle implementation
+func (i *Invocation) ApproveTokenDefinitionForMyOrg(input *lb.ApproveTokenDefinitionForMyOrgArgs) (proto.Message, error) {
+	collectionName := ImplicitCollectionNameForOrg(i.SCC.OrgMSPID)
+	err := i.SCC.Functions.ApproveTokenDefinitionForOrg(
+		input.Name,
+		&TokenDefinition{
+			Sequence: input.Sequence,
+			ValidationInfo: &lb.ChaincodeValidationInfo{
+				ValidationPlugin:    input.ValidationPlugin,
+				ValidationParameter: input.ValidationParameter,
+			},
+		},
+		i.Stub,
+		&ChaincodePrivateLedgerShim{
+			Collection: collectionName,
+			Stub:       i.Stub,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &lb.ApproveTokenDefinitionForMyOrgResult{}, nil
+}
+
+func (i *Invocation) CommitTokenDefinition(input *lb.CommitTokenDefinitionArgs) (proto.Message, error) {
+	opaqueStates, myOrgIndex, err := i.orgStates()
+	if err != nil {
+		return nil, err
+	}
+
+	agreement, err := i.SCC.Functions.CommitTokenDefinition(
+		input.Name,
+		&TokenDefinition{
+			Sequence: input.Sequence,
+			ValidationInfo: &lb.ChaincodeValidationInfo{
+				ValidationPlugin:    input.ValidationPlugin,
+				ValidationParameter: input.ValidationParameter,
+			},
+		},
+		i.Stub,
+		opaqueStates,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !agreement[myOrgIndex] {
+		return nil, errors.Errorf("token definition not agreed to by this org (%s)", i.SCC.OrgMSPID)
+	}
+
+	return &lb.CommitTokenDefinitionResult{}, nil
+}
+
+func (i *Invocation) QueryTokenDefinition(input *lb.QueryTokenDefinitionArgs) (proto.Message, error) {
+	definedToken, err := i.SCC.Functions.QueryTokenDefinition(input.Name, i.Stub)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lb.QueryTokenDefinitionResult{
+		Sequence:            definedToken.Sequence,
+		ValidationPlugin:    definedToken.ValidationInfo.ValidationPlugin,
+		ValidationParameter: definedToken.ValidationInfo.ValidationParameter,
+	}, nil
+}
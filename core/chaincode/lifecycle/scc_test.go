@@ -7,9 +7,11 @@ SPDX-License-Identifier: Apache-2.0
 package lifecycle_test
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/audit"
 	"github.com/hyperledger/fabric/common/chaincode"
 	"github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/core/chaincode/lifecycle"
@@ -176,6 +178,19 @@ var _ = Describe("SCC", func() {
 				Expect(ccInstallPackage).To(Equal([]byte("chaincode-package")))
 			})
 
+			It("records the install in the audit log", func() {
+				auditLog := &bytes.Buffer{}
+				audit.Configure(nil, audit.NewWriterSink(auditLog))
+				defer audit.Configure(nil)
+
+				scc.Invoke(fakeStub)
+				record, err := audit.Verify(auditLog)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(record.Type).To(Equal(audit.ChaincodeInstall))
+				Expect(record.Outcome).To(Equal(audit.Success))
+				Expect(record.Details).To(Equal(map[string]string{"name": "name", "version": "version"}))
+			})
+
 			Context("when the underlying function implementation fails", func() {
 				BeforeEach(func() {
 					fakeSCCFuncs.InstallChaincodeReturns(nil, fmt.Errorf("underlying-error"))
@@ -186,6 +201,18 @@ var _ = Describe("SCC", func() {
 					Expect(res.Status).To(Equal(int32(500)))
 					Expect(res.Message).To(Equal("failed to invoke backing implementation of 'InstallChaincode': underlying-error"))
 				})
+
+				It("records the failure in the audit log", func() {
+					auditLog := &bytes.Buffer{}
+					audit.Configure(nil, audit.NewWriterSink(auditLog))
+					defer audit.Configure(nil)
+
+					scc.Invoke(fakeStub)
+					record, err := audit.Verify(auditLog)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(record.Outcome).To(Equal(audit.Failure))
+					Expect(record.Details).To(HaveKeyWithValue("reason", "underlying-error"))
+				})
 			})
 		})
 
@@ -317,6 +344,7 @@ var _ = Describe("SCC", func() {
 					ValidationParameter: []byte("validation-parameter"),
 					Collections:         &cb.CollectionConfigPackage{},
 					InitRequired:        true,
+					ReadYourWrites:      true,
 				}
 
 				marshaledArg, err = proto.Marshal(arg)
@@ -342,6 +370,7 @@ var _ = Describe("SCC", func() {
 						Id:                []byte("hash"),
 						EndorsementPlugin: "endorsement-plugin",
 						InitRequired:      true,
+						ReadYourWrites:    true,
 					},
 					ValidationInfo: &lb.ChaincodeValidationInfo{
 						ValidationPlugin:    "validation-plugin",
@@ -354,6 +383,21 @@ var _ = Describe("SCC", func() {
 				Expect(privState.(*lifecycle.ChaincodePrivateLedgerShim).Collection).To(Equal("_implicit_org_fake-mspid"))
 			})
 
+			It("records the approval in the audit log", func() {
+				auditLog := &bytes.Buffer{}
+				audit.Configure(nil, audit.NewWriterSink(auditLog))
+				defer audit.Configure(nil)
+				fakeStub.GetChannelIDReturns("test-channel")
+
+				scc.Invoke(fakeStub)
+				record, err := audit.Verify(auditLog)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(record.Type).To(Equal(audit.ChaincodeApprove))
+				Expect(record.Outcome).To(Equal(audit.Success))
+				Expect(record.Channel).To(Equal("test-channel"))
+				Expect(record.Details).To(Equal(map[string]string{"name": "name", "version": "version", "sequence": "7"}))
+			})
+
 			Context("when the underlying function implementation fails", func() {
 				BeforeEach(func() {
 					fakeSCCFuncs.ApproveChaincodeDefinitionForOrgReturns(fmt.Errorf("underlying-error"))
@@ -396,6 +440,7 @@ var _ = Describe("SCC", func() {
 					ValidationParameter: []byte("validation-parameter"),
 					Collections:         &cb.CollectionConfigPackage{},
 					InitRequired:        true,
+					ReadYourWrites:      true,
 				}
 
 				marshaledArg, err = proto.Marshal(arg)
@@ -433,6 +478,7 @@ var _ = Describe("SCC", func() {
 						Id:                []byte("hash"),
 						EndorsementPlugin: "endorsement-plugin",
 						InitRequired:      true,
+						ReadYourWrites:    true,
 					},
 					ValidationInfo: &lb.ChaincodeValidationInfo{
 						ValidationPlugin:    "validation-plugin",
@@ -544,6 +590,7 @@ var _ = Describe("SCC", func() {
 						Version:           "version",
 						EndorsementPlugin: "endorsement-plugin",
 						Id:                []byte("hash"),
+						ReadYourWrites:    true,
 					},
 					ValidationInfo: &lb.ChaincodeValidationInfo{
 						ValidationPlugin:    "validation-plugin",
@@ -567,6 +614,7 @@ var _ = Describe("SCC", func() {
 					ValidationPlugin:    "validation-plugin",
 					ValidationParameter: []byte("validation-parameter"),
 					Collections:         &cb.CollectionConfigPackage{},
+					ReadYourWrites:      true,
 				})).To(BeTrue())
 
 				Expect(fakeSCCFuncs.QueryChaincodeDefinitionCallCount()).To(Equal(1))
@@ -631,6 +679,190 @@ var _ = Describe("SCC", func() {
 				})
 			})
 		})
+
+		Describe("ApproveTokenDefinitionForMyOrg", func() {
+			BeforeEach(func() {
+				marshaledArg, err := proto.Marshal(&lb.ApproveTokenDefinitionForMyOrgArgs{
+					Sequence:            3,
+					Name:                "token-name",
+					ValidationPlugin:    "validation-plugin",
+					ValidationParameter: []byte("issuing-policy"),
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				fakeStub.GetArgsReturns([][]byte{[]byte("ApproveTokenDefinitionForMyOrg"), marshaledArg})
+			})
+
+			It("passes the arguments to and returns the results from the backing scc function implementation", func() {
+				res := scc.Invoke(fakeStub)
+				Expect(res.Status).To(Equal(int32(200)))
+				payload := &lb.ApproveTokenDefinitionForMyOrgResult{}
+				err := proto.Unmarshal(res.Payload, payload)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeSCCFuncs.ApproveTokenDefinitionForOrgCallCount()).To(Equal(1))
+				name, td, pubState, privState := fakeSCCFuncs.ApproveTokenDefinitionForOrgArgsForCall(0)
+				Expect(name).To(Equal("token-name"))
+				Expect(td).To(Equal(&lifecycle.TokenDefinition{
+					Sequence: 3,
+					ValidationInfo: &lb.ChaincodeValidationInfo{
+						ValidationPlugin:    "validation-plugin",
+						ValidationParameter: []byte("issuing-policy"),
+					},
+				}))
+				Expect(pubState).To(Equal(fakeStub))
+				Expect(privState).To(BeAssignableToTypeOf(&lifecycle.ChaincodePrivateLedgerShim{}))
+				Expect(privState.(*lifecycle.ChaincodePrivateLedgerShim).Collection).To(Equal("_implicit_org_fake-mspid"))
+			})
+
+			Context("when the underlying function implementation fails", func() {
+				BeforeEach(func() {
+					fakeSCCFuncs.ApproveTokenDefinitionForOrgReturns(fmt.Errorf("underlying-error"))
+				})
+
+				It("wraps and returns the error", func() {
+					res := scc.Invoke(fakeStub)
+					Expect(res.Status).To(Equal(int32(500)))
+					Expect(res.Message).To(Equal("failed to invoke backing implementation of 'ApproveTokenDefinitionForMyOrg': underlying-error"))
+				})
+			})
+		})
+
+		Describe("CommitTokenDefinition", func() {
+			var fakeOrgConfigs []*mock.ApplicationOrgConfig
+
+			BeforeEach(func() {
+				marshaledArg, err := proto.Marshal(&lb.CommitTokenDefinitionArgs{
+					Sequence:            3,
+					Name:                "token-name",
+					ValidationPlugin:    "validation-plugin",
+					ValidationParameter: []byte("issuing-policy"),
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				fakeStub.GetArgsReturns([][]byte{[]byte("CommitTokenDefinition"), marshaledArg})
+
+				fakeOrgConfigs = []*mock.ApplicationOrgConfig{{}, {}}
+				fakeOrgConfigs[0].MSPIDReturns("fake-mspid")
+				fakeOrgConfigs[1].MSPIDReturns("other-mspid")
+
+				fakeApplicationConfig.OrganizationsReturns(map[string]channelconfig.ApplicationOrg{
+					"org0": fakeOrgConfigs[0],
+					"org1": fakeOrgConfigs[1],
+				})
+
+				fakeSCCFuncs.CommitTokenDefinitionReturns([]bool{true, true}, nil)
+			})
+
+			It("passes the arguments to and returns the results from the backing scc function implementation", func() {
+				res := scc.Invoke(fakeStub)
+				Expect(res.Message).To(Equal(""))
+				Expect(res.Status).To(Equal(int32(200)))
+				payload := &lb.CommitTokenDefinitionResult{}
+				err := proto.Unmarshal(res.Payload, payload)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeSCCFuncs.CommitTokenDefinitionCallCount()).To(Equal(1))
+				name, td, pubState, orgStates := fakeSCCFuncs.CommitTokenDefinitionArgsForCall(0)
+				Expect(name).To(Equal("token-name"))
+				Expect(td).To(Equal(&lifecycle.TokenDefinition{
+					Sequence: 3,
+					ValidationInfo: &lb.ChaincodeValidationInfo{
+						ValidationPlugin:    "validation-plugin",
+						ValidationParameter: []byte("issuing-policy"),
+					},
+				}))
+				Expect(pubState).To(Equal(fakeStub))
+				Expect(len(orgStates)).To(Equal(2))
+				collection0 := orgStates[0].(*lifecycle.ChaincodePrivateLedgerShim).Collection
+				collection1 := orgStates[1].(*lifecycle.ChaincodePrivateLedgerShim).Collection
+				Expect([]string{collection0, collection1}).To(ConsistOf("_implicit_org_fake-mspid", "_implicit_org_other-mspid"))
+			})
+
+			Context("when there is no agreement from this peer's org", func() {
+				BeforeEach(func() {
+					fakeSCCFuncs.CommitTokenDefinitionReturns([]bool{false, false}, nil)
+				})
+
+				It("returns an error indicating the lack of agreement", func() {
+					res := scc.Invoke(fakeStub)
+					Expect(res.Status).To(Equal(int32(500)))
+					Expect(res.Message).To(Equal("failed to invoke backing implementation of 'CommitTokenDefinition': token definition not agreed to by this org (fake-mspid)"))
+				})
+			})
+
+			Context("when there is no match for this peer's org's MSPID", func() {
+				BeforeEach(func() {
+					fakeOrgConfigs[0].MSPIDReturns("other-mspid")
+				})
+
+				It("returns an error", func() {
+					res := scc.Invoke(fakeStub)
+					Expect(res.Status).To(Equal(int32(500)))
+					Expect(res.Message).To(Equal("failed to invoke backing implementation of 'CommitTokenDefinition': impossibly, this peer's org is processing requests for a channel it is not a member of"))
+				})
+			})
+
+			Context("when the underlying function implementation fails", func() {
+				BeforeEach(func() {
+					fakeSCCFuncs.CommitTokenDefinitionReturns(nil, fmt.Errorf("underlying-error"))
+				})
+
+				It("wraps and returns the error", func() {
+					res := scc.Invoke(fakeStub)
+					Expect(res.Status).To(Equal(int32(500)))
+					Expect(res.Message).To(Equal("failed to invoke backing implementation of 'CommitTokenDefinition': underlying-error"))
+				})
+			})
+		})
+
+		Describe("QueryTokenDefinition", func() {
+			BeforeEach(func() {
+				marshaledArg, err := proto.Marshal(&lb.QueryTokenDefinitionArgs{
+					Name: "token-name",
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				fakeStub.GetArgsReturns([][]byte{[]byte("QueryTokenDefinition"), marshaledArg})
+				fakeSCCFuncs.QueryTokenDefinitionReturns(&lifecycle.TokenDefinition{
+					Sequence: 2,
+					ValidationInfo: &lb.ChaincodeValidationInfo{
+						ValidationPlugin:    "validation-plugin",
+						ValidationParameter: []byte("issuing-policy"),
+					},
+				}, nil)
+			})
+
+			It("passes the arguments to and returns the results from the backing scc function implementation", func() {
+				res := scc.Invoke(fakeStub)
+				Expect(res.Status).To(Equal(int32(200)))
+				payload := &lb.QueryTokenDefinitionResult{}
+				err := proto.Unmarshal(res.Payload, payload)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(proto.Equal(payload, &lb.QueryTokenDefinitionResult{
+					Sequence:            2,
+					ValidationPlugin:    "validation-plugin",
+					ValidationParameter: []byte("issuing-policy"),
+				})).To(BeTrue())
+
+				Expect(fakeSCCFuncs.QueryTokenDefinitionCallCount()).To(Equal(1))
+				name, pubState := fakeSCCFuncs.QueryTokenDefinitionArgsForCall(0)
+				Expect(name).To(Equal("token-name"))
+				Expect(pubState).To(Equal(fakeStub))
+			})
+
+			Context("when the underlying function implementation fails", func() {
+				BeforeEach(func() {
+					fakeSCCFuncs.QueryTokenDefinitionReturns(nil, fmt.Errorf("underlying-error"))
+				})
+
+				It("wraps and returns the error", func() {
+					res := scc.Invoke(fakeStub)
+					Expect(res.Status).To(Equal(int32(500)))
+					Expect(res.Message).To(Equal("failed to invoke backing implementation of 'QueryTokenDefinition': underlying-error"))
+				})
+			})
+		})
 	})
 
 })
@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/protos/peer"
 )
 
 type ApplicationConfig struct {
@@ -35,6 +36,15 @@ type ApplicationConfig struct {
 	capabilitiesReturnsOnCall map[int]struct {
 		result1 channelconfig.ApplicationCapabilities
 	}
+	InvocationQuotaStub        func() *peer.InvocationQuota
+	invocationQuotaMutex       sync.RWMutex
+	invocationQuotaArgsForCall []struct{}
+	invocationQuotaReturns     struct {
+		result1 *peer.InvocationQuota
+	}
+	invocationQuotaReturnsOnCall map[int]struct {
+		result1 *peer.InvocationQuota
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -159,6 +169,46 @@ func (fake *ApplicationConfig) CapabilitiesReturnsOnCall(i int, result1 channelc
 	}{result1}
 }
 
+func (fake *ApplicationConfig) InvocationQuota() *peer.InvocationQuota {
+	fake.invocationQuotaMutex.Lock()
+	ret, specificReturn := fake.invocationQuotaReturnsOnCall[len(fake.invocationQuotaArgsForCall)]
+	fake.invocationQuotaArgsForCall = append(fake.invocationQuotaArgsForCall, struct{}{})
+	fake.recordInvocation("InvocationQuota", []interface{}{})
+	fake.invocationQuotaMutex.Unlock()
+	if fake.InvocationQuotaStub != nil {
+		return fake.InvocationQuotaStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.invocationQuotaReturns.result1
+}
+
+func (fake *ApplicationConfig) InvocationQuotaCallCount() int {
+	fake.invocationQuotaMutex.RLock()
+	defer fake.invocationQuotaMutex.RUnlock()
+	return len(fake.invocationQuotaArgsForCall)
+}
+
+func (fake *ApplicationConfig) InvocationQuotaReturns(result1 *peer.InvocationQuota) {
+	fake.InvocationQuotaStub = nil
+	fake.invocationQuotaReturns = struct {
+		result1 *peer.InvocationQuota
+	}{result1}
+}
+
+func (fake *ApplicationConfig) InvocationQuotaReturnsOnCall(i int, result1 *peer.InvocationQuota) {
+	fake.InvocationQuotaStub = nil
+	if fake.invocationQuotaReturnsOnCall == nil {
+		fake.invocationQuotaReturnsOnCall = make(map[int]struct {
+			result1 *peer.InvocationQuota
+		})
+	}
+	fake.invocationQuotaReturnsOnCall[i] = struct {
+		result1 *peer.InvocationQuota
+	}{result1}
+}
+
 func (fake *ApplicationConfig) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -168,6 +218,8 @@ func (fake *ApplicationConfig) Invocations() map[string][][]interface{} {
 	defer fake.aPIPolicyMapperMutex.RUnlock()
 	fake.capabilitiesMutex.RLock()
 	defer fake.capabilitiesMutex.RUnlock()
+	fake.invocationQuotaMutex.RLock()
+	defer fake.invocationQuotaMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -3,6 +3,7 @@ package mock
 
 import (
 	"sync"
+	"time"
 
 	ledgera "github.com/hyperledger/fabric/common/ledger"
 	"github.com/hyperledger/fabric/core/ledger"
@@ -78,6 +79,19 @@ type PeerLedger struct {
 		result1 *common.Block
 		result2 error
 	}
+	GetBlockNumberByTimeStub        func(time.Time) (uint64, error)
+	getBlockNumberByTimeMutex       sync.RWMutex
+	getBlockNumberByTimeArgsForCall []struct {
+		arg1 time.Time
+	}
+	getBlockNumberByTimeReturns struct {
+		result1 uint64
+		result2 error
+	}
+	getBlockNumberByTimeReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 error
+	}
 	GetBlockchainInfoStub        func() (*common.BlockchainInfo, error)
 	getBlockchainInfoMutex       sync.RWMutex
 	getBlockchainInfoArgsForCall []struct {
@@ -567,6 +581,69 @@ func (fake *PeerLedger) GetBlockByTxIDReturnsOnCall(i int, result1 *common.Block
 	}{result1, result2}
 }
 
+func (fake *PeerLedger) GetBlockNumberByTime(arg1 time.Time) (uint64, error) {
+	fake.getBlockNumberByTimeMutex.Lock()
+	ret, specificReturn := fake.getBlockNumberByTimeReturnsOnCall[len(fake.getBlockNumberByTimeArgsForCall)]
+	fake.getBlockNumberByTimeArgsForCall = append(fake.getBlockNumberByTimeArgsForCall, struct {
+		arg1 time.Time
+	}{arg1})
+	fake.recordInvocation("GetBlockNumberByTime", []interface{}{arg1})
+	fake.getBlockNumberByTimeMutex.Unlock()
+	if fake.GetBlockNumberByTimeStub != nil {
+		return fake.GetBlockNumberByTimeStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getBlockNumberByTimeReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *PeerLedger) GetBlockNumberByTimeCallCount() int {
+	fake.getBlockNumberByTimeMutex.RLock()
+	defer fake.getBlockNumberByTimeMutex.RUnlock()
+	return len(fake.getBlockNumberByTimeArgsForCall)
+}
+
+func (fake *PeerLedger) GetBlockNumberByTimeCalls(stub func(time.Time) (uint64, error)) {
+	fake.getBlockNumberByTimeMutex.Lock()
+	defer fake.getBlockNumberByTimeMutex.Unlock()
+	fake.GetBlockNumberByTimeStub = stub
+}
+
+func (fake *PeerLedger) GetBlockNumberByTimeArgsForCall(i int) time.Time {
+	fake.getBlockNumberByTimeMutex.RLock()
+	defer fake.getBlockNumberByTimeMutex.RUnlock()
+	argsForCall := fake.getBlockNumberByTimeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *PeerLedger) GetBlockNumberByTimeReturns(result1 uint64, result2 error) {
+	fake.getBlockNumberByTimeMutex.Lock()
+	defer fake.getBlockNumberByTimeMutex.Unlock()
+	fake.GetBlockNumberByTimeStub = nil
+	fake.getBlockNumberByTimeReturns = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *PeerLedger) GetBlockNumberByTimeReturnsOnCall(i int, result1 uint64, result2 error) {
+	fake.getBlockNumberByTimeMutex.Lock()
+	defer fake.getBlockNumberByTimeMutex.Unlock()
+	fake.GetBlockNumberByTimeStub = nil
+	if fake.getBlockNumberByTimeReturnsOnCall == nil {
+		fake.getBlockNumberByTimeReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 error
+		})
+	}
+	fake.getBlockNumberByTimeReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *PeerLedger) GetBlockchainInfo() (*common.BlockchainInfo, error) {
 	fake.getBlockchainInfoMutex.Lock()
 	ret, specificReturn := fake.getBlockchainInfoReturnsOnCall[len(fake.getBlockchainInfoArgsForCall)]
@@ -1237,6 +1314,8 @@ func (fake *PeerLedger) Invocations() map[string][][]interface{} {
 	defer fake.getBlockByNumberMutex.RUnlock()
 	fake.getBlockByTxIDMutex.RLock()
 	defer fake.getBlockByTxIDMutex.RUnlock()
+	fake.getBlockNumberByTimeMutex.RLock()
+	defer fake.getBlockNumberByTimeMutex.RUnlock()
 	fake.getBlockchainInfoMutex.RLock()
 	defer fake.getBlockchainInfoMutex.RUnlock()
 	fake.getBlocksIteratorMutex.RLock()
@@ -118,6 +118,39 @@ type TxSimulator struct {
 	executeUpdateReturnsOnCall map[int]struct {
 		result1 error
 	}
+	GetPendingPrivateDataStub        func(string, string, string) ([]byte, bool, error)
+	getPendingPrivateDataMutex       sync.RWMutex
+	getPendingPrivateDataArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	getPendingPrivateDataReturns struct {
+		result1 []byte
+		result2 bool
+		result3 error
+	}
+	getPendingPrivateDataReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 bool
+		result3 error
+	}
+	GetPendingStateStub        func(string, string) ([]byte, bool, error)
+	getPendingStateMutex       sync.RWMutex
+	getPendingStateArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getPendingStateReturns struct {
+		result1 []byte
+		result2 bool
+		result3 error
+	}
+	getPendingStateReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 bool
+		result3 error
+	}
 	GetPrivateDataStub        func(string, string, string) ([]byte, error)
 	getPrivateDataMutex       sync.RWMutex
 	getPrivateDataArgsForCall []struct {
@@ -900,6 +933,141 @@ func (fake *TxSimulator) ExecuteUpdateReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *TxSimulator) GetPendingPrivateData(arg1 string, arg2 string, arg3 string) ([]byte, bool, error) {
+	fake.getPendingPrivateDataMutex.Lock()
+	ret, specificReturn := fake.getPendingPrivateDataReturnsOnCall[len(fake.getPendingPrivateDataArgsForCall)]
+	fake.getPendingPrivateDataArgsForCall = append(fake.getPendingPrivateDataArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetPendingPrivateData", []interface{}{arg1, arg2, arg3})
+	fake.getPendingPrivateDataMutex.Unlock()
+	if fake.GetPendingPrivateDataStub != nil {
+		return fake.GetPendingPrivateDataStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getPendingPrivateDataReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *TxSimulator) GetPendingPrivateDataCallCount() int {
+	fake.getPendingPrivateDataMutex.RLock()
+	defer fake.getPendingPrivateDataMutex.RUnlock()
+	return len(fake.getPendingPrivateDataArgsForCall)
+}
+
+func (fake *TxSimulator) GetPendingPrivateDataCalls(stub func(string, string, string) ([]byte, bool, error)) {
+	fake.getPendingPrivateDataMutex.Lock()
+	defer fake.getPendingPrivateDataMutex.Unlock()
+	fake.GetPendingPrivateDataStub = stub
+}
+
+func (fake *TxSimulator) GetPendingPrivateDataArgsForCall(i int) (string, string, string) {
+	fake.getPendingPrivateDataMutex.RLock()
+	defer fake.getPendingPrivateDataMutex.RUnlock()
+	argsForCall := fake.getPendingPrivateDataArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *TxSimulator) GetPendingPrivateDataReturns(result1 []byte, result2 bool, result3 error) {
+	fake.getPendingPrivateDataMutex.Lock()
+	defer fake.getPendingPrivateDataMutex.Unlock()
+	fake.GetPendingPrivateDataStub = nil
+	fake.getPendingPrivateDataReturns = struct {
+		result1 []byte
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *TxSimulator) GetPendingPrivateDataReturnsOnCall(i int, result1 []byte, result2 bool, result3 error) {
+	fake.getPendingPrivateDataMutex.Lock()
+	defer fake.getPendingPrivateDataMutex.Unlock()
+	fake.GetPendingPrivateDataStub = nil
+	if fake.getPendingPrivateDataReturnsOnCall == nil {
+		fake.getPendingPrivateDataReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 bool
+			result3 error
+		})
+	}
+	fake.getPendingPrivateDataReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *TxSimulator) GetPendingState(arg1 string, arg2 string) ([]byte, bool, error) {
+	fake.getPendingStateMutex.Lock()
+	ret, specificReturn := fake.getPendingStateReturnsOnCall[len(fake.getPendingStateArgsForCall)]
+	fake.getPendingStateArgsForCall = append(fake.getPendingStateArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetPendingState", []interface{}{arg1, arg2})
+	fake.getPendingStateMutex.Unlock()
+	if fake.GetPendingStateStub != nil {
+		return fake.GetPendingStateStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getPendingStateReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *TxSimulator) GetPendingStateCallCount() int {
+	fake.getPendingStateMutex.RLock()
+	defer fake.getPendingStateMutex.RUnlock()
+	return len(fake.getPendingStateArgsForCall)
+}
+
+func (fake *TxSimulator) GetPendingStateCalls(stub func(string, string) ([]byte, bool, error)) {
+	fake.getPendingStateMutex.Lock()
+	defer fake.getPendingStateMutex.Unlock()
+	fake.GetPendingStateStub = stub
+}
+
+func (fake *TxSimulator) GetPendingStateArgsForCall(i int) (string, string) {
+	fake.getPendingStateMutex.RLock()
+	defer fake.getPendingStateMutex.RUnlock()
+	argsForCall := fake.getPendingStateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *TxSimulator) GetPendingStateReturns(result1 []byte, result2 bool, result3 error) {
+	fake.getPendingStateMutex.Lock()
+	defer fake.getPendingStateMutex.Unlock()
+	fake.GetPendingStateStub = nil
+	fake.getPendingStateReturns = struct {
+		result1 []byte
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *TxSimulator) GetPendingStateReturnsOnCall(i int, result1 []byte, result2 bool, result3 error) {
+	fake.getPendingStateMutex.Lock()
+	defer fake.getPendingStateMutex.Unlock()
+	fake.GetPendingStateStub = nil
+	if fake.getPendingStateReturnsOnCall == nil {
+		fake.getPendingStateReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 bool
+			result3 error
+		})
+	}
+	fake.getPendingStateReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *TxSimulator) GetPrivateData(arg1 string, arg2 string, arg3 string) ([]byte, error) {
 	fake.getPrivateDataMutex.Lock()
 	ret, specificReturn := fake.getPrivateDataReturnsOnCall[len(fake.getPrivateDataArgsForCall)]
@@ -2088,6 +2256,10 @@ func (fake *TxSimulator) Invocations() map[string][][]interface{} {
 	defer fake.executeQueryWithMetadataMutex.RUnlock()
 	fake.executeUpdateMutex.RLock()
 	defer fake.executeUpdateMutex.RUnlock()
+	fake.getPendingPrivateDataMutex.RLock()
+	defer fake.getPendingPrivateDataMutex.RUnlock()
+	fake.getPendingStateMutex.RLock()
+	defer fake.getPendingStateMutex.RUnlock()
 	fake.getPrivateDataMutex.RLock()
 	defer fake.getPrivateDataMutex.RUnlock()
 	fake.getPrivateDataHashMutex.RLock()
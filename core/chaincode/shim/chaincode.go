@@ -17,7 +17,6 @@ import (
 	"os"
 	"strings"
 	"sync"
-	"time"
 	"unicode/utf8"
 
 	"github.com/golang/protobuf/proto"
@@ -306,10 +305,7 @@ func getPeerAddress() string {
 func newPeerClientConnection() (*grpc.ClientConn, error) {
 	var peerAddress = getPeerAddress()
 	// set the keepalive options to match static settings for chaincode server
-	kaOpts := &comm.KeepaliveOptions{
-		ClientInterval: time.Duration(1) * time.Minute,
-		ClientTimeout:  time.Duration(20) * time.Second,
-	}
+	kaOpts := comm.DefaultKeepaliveOptions.Clone()
 	if viper.GetBool("peer.tls.enabled") {
 		return comm.NewClientConnectionWithAddress(peerAddress, true, true,
 			comm.InitTLSForShim(key, cert), kaOpts)
@@ -329,6 +325,12 @@ func chatWithPeer(chaincodename string, stream PeerChaincodeStream, cc Chaincode
 		return errors.Wrap(err, "error marshalling chaincodeID during chaincode registration")
 	}
 
+	// Announce that the logging level of the shim can be set at runtime,
+	// before registering so that the peer can send it once registered
+	if err = handler.serialSend(&pb.ChaincodeMessage{Type: pb.ChaincodeMessage_SHIM_LOG_LEVEL}); err != nil {
+		return errors.WithMessage(err, "error sending chaincode SHIM_LOG_LEVEL")
+	}
+
 	// Register on the stream
 	chaincodeLogger.Debugf("Registering.. sending %s", pb.ChaincodeMessage_REGISTER)
 	if err = handler.serialSend(&pb.ChaincodeMessage{Type: pb.ChaincodeMessage_REGISTER, Payload: payload}); err != nil {
@@ -565,14 +567,12 @@ func (stub *ChaincodeStub) GetPrivateDataByRange(collection, startKey, endKey st
 }
 
 func (stub *ChaincodeStub) createRangeKeysForPartialCompositeKey(objectType string, attributes []string) (string, string, error) {
-	partialCompositeKey, err := stub.CreateCompositeKey(objectType, attributes)
+	result, err := stub.handler.handleCreateCompositeKey(objectType, attributes, stub.ChannelId, stub.TxID)
 	if err != nil {
 		return "", "", err
 	}
-	startKey := partialCompositeKey
-	endKey := partialCompositeKey + string(maxUnicodeRuneValue)
 
-	return startKey, endKey, nil
+	return result.Key, result.RangeEndKey, nil
 }
 
 // GetPrivateDataByPartialCompositeKey documentation can be found in interfaces.go
@@ -716,35 +716,50 @@ func (stub *ChaincodeStub) GetHistoryForKey(key string) (HistoryQueryIteratorInt
 
 //CreateCompositeKey documentation can be found in interfaces.go
 func (stub *ChaincodeStub) CreateCompositeKey(objectType string, attributes []string) (string, error) {
-	return createCompositeKey(objectType, attributes)
+	result, err := stub.handler.handleCreateCompositeKey(objectType, attributes, stub.ChannelId, stub.TxID)
+	if err != nil {
+		return "", err
+	}
+	return result.Key, nil
 }
 
 //SplitCompositeKey documentation can be found in interfaces.go
 func (stub *ChaincodeStub) SplitCompositeKey(compositeKey string) (string, []string, error) {
-	return splitCompositeKey(compositeKey)
+	result, err := stub.handler.handleSplitCompositeKey(compositeKey, stub.ChannelId, stub.TxID)
+	if err != nil {
+		return "", nil, err
+	}
+	return result.ObjectType, result.Attributes, nil
 }
 
+// createCompositeKey mirrors the creation of composite keys by the peer, for use by the MockStub
 func createCompositeKey(objectType string, attributes []string) (string, error) {
 	if err := validateCompositeKeyAttribute(objectType); err != nil {
 		return "", err
 	}
-	ck := compositeKeyNamespace + objectType + string(minUnicodeRuneValue)
+	ck := compositeKeyNamespace + objectType + string(rune(minUnicodeRuneValue))
 	for _, att := range attributes {
 		if err := validateCompositeKeyAttribute(att); err != nil {
 			return "", err
 		}
-		ck += att + string(minUnicodeRuneValue)
+		ck += att + string(rune(minUnicodeRuneValue))
 	}
 	return ck, nil
 }
 
+// splitCompositeKey mirrors the splitting of composite keys by the peer, for use by the MockStub
 func splitCompositeKey(compositeKey string) (string, []string, error) {
-	componentIndex := 1
-	components := []string{}
-	for i := 1; i < len(compositeKey); i++ {
-		if compositeKey[i] == minUnicodeRuneValue {
-			components = append(components, compositeKey[componentIndex:i])
-			componentIndex = i + 1
+	if !strings.HasPrefix(compositeKey, compositeKeyNamespace) {
+		return "", nil, errors.Errorf("not a composite key: [%x] does not start with the composite key namespace", compositeKey)
+	}
+	if len(compositeKey) < 2 || compositeKey[len(compositeKey)-1] != minUnicodeRuneValue {
+		return "", nil, errors.Errorf("not a composite key: [%x] is not terminated by %#U", compositeKey, minUnicodeRuneValue)
+	}
+
+	components := strings.Split(compositeKey[1:len(compositeKey)-1], string(rune(minUnicodeRuneValue)))
+	for _, component := range components {
+		if err := validateCompositeKeyAttribute(component); err != nil {
+			return "", nil, errors.WithMessage(err, "not a composite key")
 		}
 	}
 	return components[0], components[1:], nil
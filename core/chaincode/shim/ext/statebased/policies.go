@@ -0,0 +1,79 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package statebased
+
+import (
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/cauthdsl"
+	cb "github.com/hyperledger/fabric/protos/common"
+	mb "github.com/hyperledger/fabric/protos/msp"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+)
+
+// NOutOfPolicy returns the key-level endorsement policy, as bytes, that
+// requires the endorsement of n of the given orgs with the given role. The
+// policy does not depend on the order of the orgs, so that all endorsers
+// build the same policy. Unlike the policies built with KeyEndorsementPolicy,
+// it is not meant to be modified with NewStateEP, which only supports
+// policies requiring all their orgs.
+func NOutOfPolicy(n int, role RoleType, orgs ...string) ([]byte, error) {
+	mspRole, err := mspRoleType(role)
+	if err != nil {
+		return nil, err
+	}
+	mspids := uniqueSorted(orgs)
+	if len(mspids) == 0 {
+		return nil, errors.New("at least one org is required")
+	}
+	if n < 1 || n > len(mspids) {
+		return nil, errors.Errorf("invalid number of required endorsements %d, must be between 1 and %d", n, len(mspids))
+	}
+
+	principals := make([]*mb.MSPPrincipal, len(mspids))
+	sigspolicy := make([]*cb.SignaturePolicy, len(mspids))
+	for i, id := range mspids {
+		principals[i] = &mb.MSPPrincipal{
+			PrincipalClassification: mb.MSPPrincipal_ROLE,
+			Principal:               protoutil.MarshalOrPanic(&mb.MSPRole{Role: mspRole, MspIdentifier: id}),
+		}
+		sigspolicy[i] = cauthdsl.SignedBy(int32(i))
+	}
+	return proto.Marshal(&cb.SignaturePolicyEnvelope{
+		Version:    0,
+		Rule:       cauthdsl.NOutOf(int32(n), sigspolicy),
+		Identities: principals,
+	})
+}
+
+// AnyOfPolicy returns the key-level endorsement policy, as bytes, that
+// requires the endorsement of any of the given orgs with the given role
+func AnyOfPolicy(role RoleType, orgs ...string) ([]byte, error) {
+	return NOutOfPolicy(1, role, orgs...)
+}
+
+// AllOfPolicy returns the key-level endorsement policy, as bytes, that
+// requires the endorsement of all the given orgs with the given role
+func AllOfPolicy(role RoleType, orgs ...string) ([]byte, error) {
+	return NOutOfPolicy(len(uniqueSorted(orgs)), role, orgs...)
+}
+
+// uniqueSorted returns the given orgs sorted, without duplicates
+func uniqueSorted(orgs []string) []string {
+	unique := make(map[string]struct{}, len(orgs))
+	for _, org := range orgs {
+		unique[org] = struct{}{}
+	}
+	sorted := make([]string, 0, len(unique))
+	for org := range unique {
+		sorted = append(sorted, org)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
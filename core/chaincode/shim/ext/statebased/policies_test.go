@@ -0,0 +1,77 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package statebased_test
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/cauthdsl"
+	"github.com/hyperledger/fabric/core/chaincode/shim/ext/statebased"
+	cb "github.com/hyperledger/fabric/protos/common"
+	mb "github.com/hyperledger/fabric/protos/msp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func unmarshalPolicy(t *testing.T, policy []byte) (int32, []string, []mb.MSPRole_MSPRoleType) {
+	spe := &cb.SignaturePolicyEnvelope{}
+	require.NoError(t, proto.Unmarshal(policy, spe))
+	var mspids []string
+	var roles []mb.MSPRole_MSPRoleType
+	for _, identity := range spe.Identities {
+		role := &mb.MSPRole{}
+		require.NoError(t, proto.Unmarshal(identity.Principal, role))
+		mspids = append(mspids, role.MspIdentifier)
+		roles = append(roles, role.Role)
+	}
+	return spe.Rule.GetNOutOf().N, mspids, roles
+}
+
+func TestNOutOfPolicy(t *testing.T) {
+	policy, err := statebased.NOutOfPolicy(2, statebased.RoleTypeMember, "Org3", "Org1", "Org2", "Org1")
+	require.NoError(t, err)
+	n, mspids, roles := unmarshalPolicy(t, policy)
+	assert.Equal(t, int32(2), n)
+	assert.Equal(t, []string{"Org1", "Org2", "Org3"}, mspids)
+	assert.Equal(t, []mb.MSPRole_MSPRoleType{mb.MSPRole_MEMBER, mb.MSPRole_MEMBER, mb.MSPRole_MEMBER}, roles)
+
+	// the policy does not depend on the order of the orgs
+	samePolicy, err := statebased.NOutOfPolicy(2, statebased.RoleTypeMember, "Org1", "Org2", "Org3")
+	require.NoError(t, err)
+	assert.Equal(t, policy, samePolicy)
+
+	_, err = statebased.NOutOfPolicy(1, "unknown", "Org1")
+	assert.EqualError(t, err, "role type unknown does not exist")
+	_, err = statebased.NOutOfPolicy(1, statebased.RoleTypePeer)
+	assert.EqualError(t, err, "at least one org is required")
+	_, err = statebased.NOutOfPolicy(3, statebased.RoleTypePeer, "Org1", "Org2", "Org2")
+	assert.EqualError(t, err, "invalid number of required endorsements 3, must be between 1 and 2")
+	_, err = statebased.NOutOfPolicy(0, statebased.RoleTypePeer, "Org1")
+	assert.EqualError(t, err, "invalid number of required endorsements 0, must be between 1 and 1")
+}
+
+func TestAnyOfPolicy(t *testing.T) {
+	policy, err := statebased.AnyOfPolicy(statebased.RoleTypePeer, "Org2", "Org1")
+	require.NoError(t, err)
+	expectedPolicy, err := proto.Marshal(cauthdsl.SignedByAnyPeer([]string{"Org1", "Org2"}))
+	require.NoError(t, err)
+	assert.Equal(t, expectedPolicy, policy)
+}
+
+func TestAllOfPolicy(t *testing.T) {
+	policy, err := statebased.AllOfPolicy(statebased.RoleTypePeer, "Org2", "Org1", "Org2")
+	require.NoError(t, err)
+
+	// it is the same policy as the one built with KeyEndorsementPolicy
+	ep, err := statebased.NewStateEP(nil)
+	require.NoError(t, err)
+	require.NoError(t, ep.AddOrgs(statebased.RoleTypePeer, "Org1", "Org2"))
+	expectedPolicy, err := ep.Policy()
+	require.NoError(t, err)
+	assert.Equal(t, expectedPolicy, policy)
+}
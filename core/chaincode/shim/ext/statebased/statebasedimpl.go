@@ -53,14 +53,9 @@ func (s *stateEP) Policy() ([]byte, error) {
 
 // AddOrgs adds the specified channel orgs to the existing key-level EP
 func (s *stateEP) AddOrgs(role RoleType, neworgs ...string) error {
-	var mspRole mb.MSPRole_MSPRoleType
-	switch role {
-	case RoleTypeMember:
-		mspRole = mb.MSPRole_MEMBER
-	case RoleTypePeer:
-		mspRole = mb.MSPRole_PEER
-	default:
-		return &RoleTypeDoesNotExistError{RoleType: role}
+	mspRole, err := mspRoleType(role)
+	if err != nil {
+		return err
 	}
 
 	// add new orgs
@@ -87,6 +82,18 @@ func (s *stateEP) ListOrgs() []string {
 	return orgNames
 }
 
+// mspRoleType returns the MSP role type of the given role type
+func mspRoleType(role RoleType) (mb.MSPRole_MSPRoleType, error) {
+	switch role {
+	case RoleTypeMember:
+		return mb.MSPRole_MEMBER, nil
+	case RoleTypePeer:
+		return mb.MSPRole_PEER, nil
+	default:
+		return 0, &RoleTypeDoesNotExistError{RoleType: role}
+	}
+}
+
 func (s *stateEP) setMSPIDsFromSP(sp *cb.SignaturePolicyEnvelope) error {
 	// iterate over the identities in this envelope
 	for _, identity := range sp.Identities {
@@ -0,0 +1,84 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package statebased
+
+// ValidationParameterStub is the subset of the ChaincodeStubInterface of the
+// shim that gets and sets the validation parameters of public keys and of
+// the keys of private data collections
+type ValidationParameterStub interface {
+	// GetStateValidationParameter retrieves the key-level endorsement policy
+	// for `key`.
+	GetStateValidationParameter(key string) ([]byte, error)
+
+	// SetStateValidationParameter sets the key-level endorsement policy for `key`.
+	SetStateValidationParameter(key string, ep []byte) error
+
+	// GetPrivateDataValidationParameter retrieves the key-level endorsement
+	// policy for the private data specified by `key`.
+	GetPrivateDataValidationParameter(collection, key string) ([]byte, error)
+
+	// SetPrivateDataValidationParameter sets the key-level endorsement policy
+	// for the private data specified by `key`.
+	SetPrivateDataValidationParameter(collection, key string, ep []byte) error
+}
+
+// GetKeyEndorsementPolicy returns the key-level endorsement policy of the
+// given key of the given private data collection, or of the public key if
+// the collection is empty. The policy is empty if the key has none.
+func GetKeyEndorsementPolicy(stub ValidationParameterStub, collection, key string) (KeyEndorsementPolicy, error) {
+	var policy []byte
+	var err error
+	if collection == "" {
+		policy, err = stub.GetStateValidationParameter(key)
+	} else {
+		policy, err = stub.GetPrivateDataValidationParameter(collection, key)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return NewStateEP(policy)
+}
+
+// SetKeyEndorsementPolicy sets the key-level endorsement policy of the given
+// key of the given private data collection, or of the public key if the
+// collection is empty
+func SetKeyEndorsementPolicy(stub ValidationParameterStub, collection, key string, ep KeyEndorsementPolicy) error {
+	policy, err := ep.Policy()
+	if err != nil {
+		return err
+	}
+	if collection == "" {
+		return stub.SetStateValidationParameter(key, policy)
+	}
+	return stub.SetPrivateDataValidationParameter(collection, key, policy)
+}
+
+// AddKeyEndorsers adds the given orgs with the given role to the orgs
+// required to endorse the changes of the given key of the given private data
+// collection, or of the public key if the collection is empty
+func AddKeyEndorsers(stub ValidationParameterStub, collection, key string, role RoleType, orgs ...string) error {
+	ep, err := GetKeyEndorsementPolicy(stub, collection, key)
+	if err != nil {
+		return err
+	}
+	if err := ep.AddOrgs(role, orgs...); err != nil {
+		return err
+	}
+	return SetKeyEndorsementPolicy(stub, collection, key, ep)
+}
+
+// DelKeyEndorsers removes the given orgs from the orgs required to endorse
+// the changes of the given key of the given private data collection, or of
+// the public key if the collection is empty
+func DelKeyEndorsers(stub ValidationParameterStub, collection, key string, orgs ...string) error {
+	ep, err := GetKeyEndorsementPolicy(stub, collection, key)
+	if err != nil {
+		return err
+	}
+	ep.DelOrgs(orgs...)
+	return SetKeyEndorsementPolicy(stub, collection, key, ep)
+}
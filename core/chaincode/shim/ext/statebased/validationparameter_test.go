@@ -0,0 +1,68 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package statebased_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/hyperledger/fabric/core/chaincode/shim/ext/statebased"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyEndorsers(t *testing.T) {
+	stub := shim.NewMockStub("statebased", nil)
+
+	for _, collection := range []string{"", "collection"} {
+		ep, err := statebased.GetKeyEndorsementPolicy(stub, collection, "key")
+		require.NoError(t, err)
+		assert.Empty(t, ep.ListOrgs())
+
+		require.NoError(t, statebased.AddKeyEndorsers(stub, collection, "key", statebased.RoleTypePeer, "Org1", "Org2"))
+		require.NoError(t, statebased.AddKeyEndorsers(stub, collection, "key", statebased.RoleTypePeer, "Org3"))
+		require.NoError(t, statebased.DelKeyEndorsers(stub, collection, "key", "Org2"))
+
+		ep, err = statebased.GetKeyEndorsementPolicy(stub, collection, "key")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"Org1", "Org3"}, ep.ListOrgs())
+
+		err = statebased.AddKeyEndorsers(stub, collection, "key", "unknown", "Org4")
+		assert.EqualError(t, err, "role type unknown does not exist")
+	}
+
+	// the public key and the key of the collection have separate policies
+	policy, err := statebased.AllOfPolicy(statebased.RoleTypePeer, "Org1", "Org3")
+	require.NoError(t, err)
+	publicPolicy, err := stub.GetStateValidationParameter("key")
+	require.NoError(t, err)
+	assert.Equal(t, policy, publicPolicy)
+	require.NoError(t, statebased.DelKeyEndorsers(stub, "collection", "key", "Org1"))
+	publicPolicy, err = stub.GetStateValidationParameter("key")
+	require.NoError(t, err)
+	assert.Equal(t, policy, publicPolicy)
+	privatePolicy, err := stub.GetPrivateDataValidationParameter("collection", "key")
+	require.NoError(t, err)
+	assert.NotEqual(t, policy, privatePolicy)
+}
+
+type failingStub struct {
+	shim.MockStub
+}
+
+func (s *failingStub) GetPrivateDataValidationParameter(collection, key string) ([]byte, error) {
+	return nil, errors.New("no read permission")
+}
+
+func TestKeyEndorsersError(t *testing.T) {
+	stub := &failingStub{*shim.NewMockStub("statebased", nil)}
+	err := statebased.AddKeyEndorsers(stub, "collection", "key", statebased.RoleTypePeer, "Org1")
+	assert.EqualError(t, err, "no read permission")
+	err = statebased.DelKeyEndorsers(stub, "collection", "key", "Org1")
+	assert.EqualError(t, err, "no read permission")
+}
@@ -396,6 +396,76 @@ func (handler *Handler) handleGetStateMetadata(collection string, key string, ch
 	return nil, errors.Errorf("[%s]incorrect chaincode message %s received. Expecting %s or %s", shorttxid(responseMsg.Txid), responseMsg.Type, pb.ChaincodeMessage_RESPONSE, pb.ChaincodeMessage_ERROR)
 }
 
+// handleCreateCompositeKey communicates with the peer to create a composite key
+// from the object type and attributes.
+func (handler *Handler) handleCreateCompositeKey(objectType string, attributes []string, channelID string, txid string) (*pb.CompositeKeyResult, error) {
+	// Construct payload for CREATE_COMPOSITE_KEY
+	payloadBytes, _ := proto.Marshal(&pb.CreateCompositeKey{ObjectType: objectType, Attributes: attributes})
+
+	msg := &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_CREATE_COMPOSITE_KEY, Payload: payloadBytes, Txid: txid, ChannelId: channelID}
+	chaincodeLogger.Debugf("[%s] Sending %s", shorttxid(msg.Txid), pb.ChaincodeMessage_CREATE_COMPOSITE_KEY)
+
+	responseMsg, err := handler.callPeerWithChaincodeMsg(msg, channelID, txid)
+	if err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("[%s] error sending CREATE_COMPOSITE_KEY", shorttxid(txid)))
+	}
+
+	if responseMsg.Type.String() == pb.ChaincodeMessage_RESPONSE.String() {
+		// Success response
+		chaincodeLogger.Debugf("[%s] CreateCompositeKey received payload %s", shorttxid(responseMsg.Txid), pb.ChaincodeMessage_RESPONSE)
+		result := &pb.CompositeKeyResult{}
+		if err := proto.Unmarshal(responseMsg.Payload, result); err != nil {
+			chaincodeLogger.Errorf("[%s] CreateCompositeKey could not unmarshal result", shorttxid(responseMsg.Txid))
+			return nil, errors.New("could not unmarshal composite key response")
+		}
+		return result, nil
+	}
+	if responseMsg.Type.String() == pb.ChaincodeMessage_ERROR.String() {
+		// Error response
+		chaincodeLogger.Errorf("[%s] CreateCompositeKey received error %s", shorttxid(responseMsg.Txid), pb.ChaincodeMessage_ERROR)
+		return nil, errors.New(string(responseMsg.Payload[:]))
+	}
+
+	// Incorrect chaincode message received
+	chaincodeLogger.Errorf("[%s] Incorrect chaincode message %s received. Expecting %s or %s", shorttxid(responseMsg.Txid), responseMsg.Type, pb.ChaincodeMessage_RESPONSE, pb.ChaincodeMessage_ERROR)
+	return nil, errors.Errorf("[%s] incorrect chaincode message %s received. Expecting %s or %s", shorttxid(responseMsg.Txid), responseMsg.Type, pb.ChaincodeMessage_RESPONSE, pb.ChaincodeMessage_ERROR)
+}
+
+// handleSplitCompositeKey communicates with the peer to split a composite key
+// into its object type and attributes.
+func (handler *Handler) handleSplitCompositeKey(compositeKey string, channelID string, txid string) (*pb.SplitCompositeKeyResult, error) {
+	// Construct payload for SPLIT_COMPOSITE_KEY
+	payloadBytes, _ := proto.Marshal(&pb.SplitCompositeKey{Key: compositeKey})
+
+	msg := &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_SPLIT_COMPOSITE_KEY, Payload: payloadBytes, Txid: txid, ChannelId: channelID}
+	chaincodeLogger.Debugf("[%s] Sending %s", shorttxid(msg.Txid), pb.ChaincodeMessage_SPLIT_COMPOSITE_KEY)
+
+	responseMsg, err := handler.callPeerWithChaincodeMsg(msg, channelID, txid)
+	if err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("[%s] error sending SPLIT_COMPOSITE_KEY", shorttxid(txid)))
+	}
+
+	if responseMsg.Type.String() == pb.ChaincodeMessage_RESPONSE.String() {
+		// Success response
+		chaincodeLogger.Debugf("[%s] SplitCompositeKey received payload %s", shorttxid(responseMsg.Txid), pb.ChaincodeMessage_RESPONSE)
+		result := &pb.SplitCompositeKeyResult{}
+		if err := proto.Unmarshal(responseMsg.Payload, result); err != nil {
+			chaincodeLogger.Errorf("[%s] SplitCompositeKey could not unmarshal result", shorttxid(responseMsg.Txid))
+			return nil, errors.New("could not unmarshal split composite key response")
+		}
+		return result, nil
+	}
+	if responseMsg.Type.String() == pb.ChaincodeMessage_ERROR.String() {
+		// Error response
+		chaincodeLogger.Errorf("[%s] SplitCompositeKey received error %s", shorttxid(responseMsg.Txid), pb.ChaincodeMessage_ERROR)
+		return nil, errors.New(string(responseMsg.Payload[:]))
+	}
+
+	// Incorrect chaincode message received
+	chaincodeLogger.Errorf("[%s] Incorrect chaincode message %s received. Expecting %s or %s", shorttxid(responseMsg.Txid), responseMsg.Type, pb.ChaincodeMessage_RESPONSE, pb.ChaincodeMessage_ERROR)
+	return nil, errors.Errorf("[%s] incorrect chaincode message %s received. Expecting %s or %s", shorttxid(responseMsg.Txid), responseMsg.Type, pb.ChaincodeMessage_RESPONSE, pb.ChaincodeMessage_ERROR)
+}
+
 // TODO: Implement a method to set multiple keys at a time [FAB-1244]
 // handlePutState communicates with the peer to put state information into the ledger.
 func (handler *Handler) handlePutState(collection string, key string, value []byte, channelId string, txid string) error {
@@ -807,6 +877,18 @@ func (handler *Handler) handleReady(msg *pb.ChaincodeMessage, errc chan error) e
 	return errors.Errorf("[%s] Chaincode handler cannot handle message (%s) with payload size (%d) while in state: %s", msg.Txid, msg.Type, len(msg.Payload), handler.state)
 }
 
+// setShimLogLevel sets the logging level of the shim to the level received
+// from the peer
+func (handler *Handler) setShimLogLevel(levelString string) {
+	level, err := LogLevel(levelString)
+	if err != nil {
+		chaincodeLogger.Warningf("Error: %s for shim log level received from the peer: %s", err, levelString)
+		return
+	}
+	SetLoggingLevel(level)
+	chaincodeLogger.Infof("Shim log level set to %s by the peer", levelString)
+}
+
 //handle established state
 func (handler *Handler) handleEstablished(msg *pb.ChaincodeMessage, errc chan error) error {
 	if msg.Type == pb.ChaincodeMessage_READY {
@@ -832,6 +914,10 @@ func (handler *Handler) handleMessage(msg *pb.ChaincodeMessage, errc chan error)
 		handler.serialSendAsync(msg, nil) // ignore errors, maybe next KEEPALIVE will work
 		return nil
 	}
+	if msg.Type == pb.ChaincodeMessage_SHIM_LOG_LEVEL {
+		handler.setShimLogLevel(string(msg.Payload))
+		return nil
+	}
 	chaincodeLogger.Debugf("[%s] Handling ChaincodeMessage of type: %s(state:%s)", shorttxid(msg.Txid), msg.Type, handler.state)
 
 	var err error
@@ -182,7 +182,8 @@ type ChaincodeStubInterface interface {
 	// SplitCompositeKey splits the specified key into attributes on which the
 	// composite key was formed. Composite keys found during range queries
 	// or partial composite key queries can therefore be split into their
-	// composite parts.
+	// composite parts. An error is returned if the key is not a composite
+	// key as created by CreateCompositeKey.
 	SplitCompositeKey(compositeKey string) (string, []string, error)
 
 	// GetQueryResult performs a "rich" query against a state database. It is
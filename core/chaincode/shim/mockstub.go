@@ -309,7 +309,7 @@ func (stub *MockStub) GetStateByPartialCompositeKey(objectType string, attribute
 	if err != nil {
 		return nil, err
 	}
-	return NewMockStateRangeQueryIterator(stub, partialCompositeKey, partialCompositeKey+string(maxUnicodeRuneValue)), nil
+	return NewMockStateRangeQueryIterator(stub, partialCompositeKey, partialCompositeKey+string(rune(maxUnicodeRuneValue))), nil
 }
 
 // CreateCompositeKey combines the list of attributes
@@ -320,3 +320,22 @@ func TestMockMock(t *testing.T) {
 	getBytes("f", []string{"a", "b"})
 	getFuncArgs([][]byte{[]byte("a")})
 }
+
+func TestSplitCompositeKey(t *testing.T) {
+	stub := NewMockStub("SplitCompositeKeyTest", nil)
+
+	objectType, attributes, err := stub.SplitCompositeKey("\x00marble\x00set-1\x00red\x00")
+	assert.NoError(t, err)
+	assert.Equal(t, "marble", objectType)
+	assert.Equal(t, []string{"set-1", "red"}, attributes)
+
+	// malformed composite keys are rejected as by the peer, instead of panicking
+	_, _, err = stub.SplitCompositeKey("marble")
+	assert.EqualError(t, err, "not a composite key: [6d6172626c65] does not start with the composite key namespace")
+	_, _, err = stub.SplitCompositeKey("\x00")
+	assert.EqualError(t, err, "not a composite key: [00] is not terminated by U+0000")
+	_, _, err = stub.SplitCompositeKey("\x00marble")
+	assert.EqualError(t, err, "not a composite key: [006d6172626c65] is not terminated by U+0000")
+	_, _, err = stub.SplitCompositeKey("\x00marble\x00\xff\x00")
+	assert.EqualError(t, err, "not a composite key: not a valid utf8 string: [ff]")
+}
@@ -430,6 +430,24 @@ func TestShimLogging(t *testing.T) {
 	}
 }
 
+func TestSetShimLogLevelFromPeer(t *testing.T) {
+	defer SetLoggingLevel(LogInfo)
+	handler := &Handler{}
+
+	err := handler.handleMessage(&pb.ChaincodeMessage{Type: pb.ChaincodeMessage_SHIM_LOG_LEVEL, Payload: []byte("debug")}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, LogDebug, shimLoggingLevel)
+	assert.True(t, chaincodeLogger.IsEnabledFor(logging.DEBUG))
+
+	// an invalid level leaves the level unchanged
+	handler.setShimLogLevel("loud")
+	assert.Equal(t, LogDebug, shimLoggingLevel)
+
+	handler.setShimLogLevel("WARNING")
+	assert.Equal(t, LogWarning, shimLoggingLevel)
+	assert.False(t, chaincodeLogger.IsEnabledFor(logging.INFO))
+}
+
 // TestChaincodeLogging tests the logging APIs for chaincodes.
 func TestChaincodeLogging(t *testing.T) {
 
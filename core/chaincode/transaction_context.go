@@ -25,6 +25,10 @@ type TransactionContext struct {
 	CollectionStore      privdata.CollectionStore
 	IsInitTransaction    bool
 
+	// ReadYourWrites indicates whether reads should observe the writes made
+	// earlier in the transaction, as set by the chaincode definition
+	ReadYourWrites bool
+
 	// tracks open iterators used for range queries
 	queryMutex          sync.Mutex
 	queryIteratorMap    map[string]commonledger.ResultsIterator
@@ -15,7 +15,6 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/keepalive"
 )
 
 type GRPCClient struct {
@@ -42,21 +41,8 @@ func NewGRPCClient(config ClientConfig) (*GRPCClient, error) {
 		return client, err
 	}
 
-	// keepalive options
-	var kap keepalive.ClientParameters
-	if config.KaOpts != nil {
-		kap = keepalive.ClientParameters{
-			Time:    config.KaOpts.ClientInterval,
-			Timeout: config.KaOpts.ClientTimeout}
-	} else {
-		// use defaults
-		kap = keepalive.ClientParameters{
-			Time:    DefaultKeepaliveOptions.ClientInterval,
-			Timeout: DefaultKeepaliveOptions.ClientTimeout}
-	}
-	kap.PermitWithoutStream = true
 	// set keepalive
-	client.dialOpts = append(client.dialOpts, grpc.WithKeepaliveParams(kap))
+	client.dialOpts = append(client.dialOpts, ClientKeepaliveOptions(config.KaOpts)...)
 	// Unless asynchronous connect is set, make connection establishment blocking.
 	if !config.AsyncConnect {
 		client.dialOpts = append(client.dialOpts, grpc.WithBlock())
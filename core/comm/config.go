@@ -102,10 +102,14 @@ type SecureOptions struct {
 	RequireClientCert bool
 	// CipherSuites is a list of supported cipher suites for TLS
 	CipherSuites []uint16
+	// Whether or not TLS clients may resume their previous sessions
+	// through session tickets, instead of performing full handshakes
+	SessionResumption bool
 }
 
-// KeepaliveOptions is used to set the gRPC keepalive settings for both
-// clients and servers
+// KeepaliveOptions is used to set the gRPC keepalive and connection
+// management settings for both clients and servers.  The client settings are
+// ignored by servers and the server settings by clients
 type KeepaliveOptions struct {
 	// ClientInterval is the duration after which if the client does not see
 	// any activity from the server it pings the server to see if it is alive
@@ -122,6 +126,32 @@ type KeepaliveOptions struct {
 	// ServerMinInterval is the minimum permitted time between client pings.
 	// If clients send pings more frequently, the server will disconnect them
 	ServerMinInterval time.Duration
+	// MaxConnectionIdle is the duration after which the server closes a
+	// connection on which no RPC is active.  Zero means forever
+	MaxConnectionIdle time.Duration
+	// MaxConnectionAge is the maximum duration a connection may exist before
+	// the server gracefully closes it, so that clients reconnect and the load
+	// is spread over the servers.  A jitter of +/- 10% is added to it by gRPC.
+	// Zero means forever
+	MaxConnectionAge time.Duration
+	// MaxConnectionAgeGrace is the additional duration given to the active
+	// RPCs of a connection which reached its max age before it is forcibly
+	// closed.  Zero means forever
+	MaxConnectionAgeGrace time.Duration
+	// MaxConcurrentStreams is the maximum number of concurrent streams, or
+	// RPCs, the server accepts on each connection.  Zero means no limit
+	MaxConcurrentStreams uint32
+}
+
+// Clone returns a copy of the keepalive options, which can be modified
+// without affecting the original ones, or a copy of the default keepalive
+// options if ka is nil.
+func (ka *KeepaliveOptions) Clone() *KeepaliveOptions {
+	if ka == nil {
+		ka = DefaultKeepaliveOptions
+	}
+	clone := *ka
+	return &clone
 }
 
 type Metrics struct {
@@ -140,8 +170,11 @@ func ServerKeepaliveOptions(ka *KeepaliveOptions) []grpc.ServerOption {
 	}
 	var serverOpts []grpc.ServerOption
 	kap := keepalive.ServerParameters{
-		Time:    ka.ServerInterval,
-		Timeout: ka.ServerTimeout,
+		Time:                  ka.ServerInterval,
+		Timeout:               ka.ServerTimeout,
+		MaxConnectionIdle:     ka.MaxConnectionIdle,
+		MaxConnectionAge:      ka.MaxConnectionAge,
+		MaxConnectionAgeGrace: ka.MaxConnectionAgeGrace,
 	}
 	serverOpts = append(serverOpts, grpc.KeepaliveParams(kap))
 	kep := keepalive.EnforcementPolicy{
@@ -150,6 +183,9 @@ func ServerKeepaliveOptions(ka *KeepaliveOptions) []grpc.ServerOption {
 		PermitWithoutStream: true,
 	}
 	serverOpts = append(serverOpts, grpc.KeepaliveEnforcementPolicy(kep))
+	if ka.MaxConcurrentStreams > 0 {
+		serverOpts = append(serverOpts, grpc.MaxConcurrentStreams(ka.MaxConcurrentStreams))
+	}
 	return serverOpts
 }
 
@@ -8,6 +8,7 @@ package comm
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -20,4 +21,25 @@ func TestConfig(t *testing.T) {
 
 	clientOptions := ClientKeepaliveOptions(nil)
 	assert.NotNil(t, clientOptions)
+
+	kaOpts := DefaultKeepaliveOptions.Clone()
+	kaOpts.MaxConcurrentStreams = 10
+	assert.Len(t, ServerKeepaliveOptions(kaOpts), len(serverOptions)+1)
+}
+
+func TestKeepaliveOptionsClone(t *testing.T) {
+	t.Parallel()
+
+	var nilOpts *KeepaliveOptions
+	clone := nilOpts.Clone()
+	assert.Equal(t, DefaultKeepaliveOptions, clone)
+
+	clone.ServerInterval = time.Second
+	clone.MaxConnectionAge = time.Minute
+	assert.Equal(t, 2*time.Hour, DefaultKeepaliveOptions.ServerInterval)
+	assert.Zero(t, DefaultKeepaliveOptions.MaxConnectionAge)
+
+	cloneOfClone := clone.Clone()
+	assert.Equal(t, clone, cloneOfClone)
+	assert.False(t, clone == cloneOfClone)
 }
@@ -35,9 +35,18 @@ type CredentialSupport struct {
 	sync.RWMutex
 	AppRootCAsByChain     map[string][][]byte
 	OrdererRootCAsByChain map[string][][]byte
-	ClientRootCAs         [][]byte
-	ServerRootCAs         [][]byte
-	clientCert            tls.Certificate
+	// AppOrgRootCAsByChain and OrdererOrgRootCAsByChain map the MSP IDs of
+	// the organizations of each channel to their TLS root and intermediate CAs
+	AppOrgRootCAsByChain     map[string]map[string][][]byte
+	OrdererOrgRootCAsByChain map[string]map[string][][]byte
+	// OrdererEndpointOrgsByChain maps the ordering service endpoints of each
+	// channel to the MSP ID of the orderer organization publishing them
+	OrdererEndpointOrgsByChain map[string]map[string]string
+	ClientRootCAs              [][]byte
+	ServerRootCAs              [][]byte
+	clientCert                 tls.Certificate
+	peerSessionCache           tls.ClientSessionCache
+	orgCAPinning               bool
 }
 
 // GetCredentialSupport returns the singleton CredentialSupport instance
@@ -45,8 +54,11 @@ func GetCredentialSupport() *CredentialSupport {
 
 	once.Do(func() {
 		credSupport = &CredentialSupport{
-			AppRootCAsByChain:     make(map[string][][]byte),
-			OrdererRootCAsByChain: make(map[string][][]byte),
+			AppRootCAsByChain:          make(map[string][][]byte),
+			OrdererRootCAsByChain:      make(map[string][][]byte),
+			AppOrgRootCAsByChain:       make(map[string]map[string][][]byte),
+			OrdererOrgRootCAsByChain:   make(map[string]map[string][][]byte),
+			OrdererEndpointOrgsByChain: make(map[string]map[string]string),
 		}
 	})
 	return credSupport
@@ -63,6 +75,14 @@ func (cs *CredentialSupport) GetClientCertificate() tls.Certificate {
 	return cs.clientCert
 }
 
+// SetPeerSessionCache sets the cache of the TLS sessions that gRPC client
+// connections to peers resume. A nil cache disables session resumption.
+func (cs *CredentialSupport) SetPeerSessionCache(cache tls.ClientSessionCache) {
+	cs.Lock()
+	defer cs.Unlock()
+	cs.peerSessionCache = cache
+}
+
 // GetDeliverServiceCredentials returns gRPC transport credentials for given
 // channel to be used by gRPC clients which communicate with ordering service endpoints.
 // If the channel isn't found, an error is returned.
@@ -73,7 +93,6 @@ func (cs *CredentialSupport) GetDeliverServiceCredentials(channelID string) (cre
 	tlsConfig := &tls.Config{
 		Certificates: []tls.Certificate{cs.clientCert},
 	}
-	certPool := x509.NewCertPool()
 
 	rootCACerts, exists := cs.OrdererRootCAsByChain[channelID]
 	if !exists {
@@ -81,6 +100,42 @@ func (cs *CredentialSupport) GetDeliverServiceCredentials(channelID string) (cre
 		return nil, fmt.Errorf("didn't find any root CA certs for channel %s", channelID)
 	}
 
+	tlsConfig.RootCAs = ordererCertPool(rootCACerts)
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// GetDeliverServiceCredentialsForEndpoint returns gRPC transport credentials
+// to be used by gRPC clients which communicate with the given ordering service
+// endpoint of the channel. If the endpoint is published by an orderer
+// organization of the channel, its TLS certificate must chain to the TLS CAs
+// of that organization. Otherwise, the credentials are those returned by
+// GetDeliverServiceCredentials, unless org CA pinning is enabled, in which
+// case an error is returned as the organization of the endpoint is unknown.
+func (cs *CredentialSupport) GetDeliverServiceCredentialsForEndpoint(channelID, endpoint string) (credentials.TransportCredentials, error) {
+	cs.RLock()
+	mspID, exists := cs.OrdererEndpointOrgsByChain[channelID][endpoint]
+	rootCACerts := cs.OrdererOrgRootCAsByChain[channelID][mspID]
+	orgCAPinning := cs.orgCAPinning
+	cs.RUnlock()
+
+	if !exists || mspID == "" {
+		if orgCAPinning {
+			return nil, fmt.Errorf("endpoint %s of channel %s isn't published by an orderer organization, its TLS CAs can't be pinned", endpoint, channelID)
+		}
+		return cs.GetDeliverServiceCredentials(channelID)
+	}
+	if len(rootCACerts) == 0 {
+		return nil, fmt.Errorf("didn't find any root CA certs of organization %s for endpoint %s of channel %s", mspID, endpoint, channelID)
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cs.GetClientCertificate()},
+		RootCAs:      ordererCertPool(rootCACerts),
+	}), nil
+}
+
+func ordererCertPool(rootCACerts [][]byte) *x509.CertPool {
+	certPool := x509.NewCertPool()
 	for _, cert := range rootCACerts {
 		block, _ := pem.Decode(cert)
 		if block != nil {
@@ -94,8 +149,7 @@ func (cs *CredentialSupport) GetDeliverServiceCredentials(channelID string) (cre
 			commLogger.Warning("Failed to add root cert to credentials")
 		}
 	}
-	tlsConfig.RootCAs = certPool
-	return credentials.NewTLS(tlsConfig), nil
+	return certPool
 }
 
 // GetPeerCredentials returns gRPC transport credentials for use by gRPC
@@ -105,7 +159,8 @@ func (cs *CredentialSupport) GetPeerCredentials() credentials.TransportCredentia
 	defer cs.RUnlock()
 
 	tlsConfig := &tls.Config{
-		Certificates: []tls.Certificate{cs.clientCert},
+		Certificates:       []tls.Certificate{cs.clientCert},
+		ClientSessionCache: cs.peerSessionCache,
 	}
 	certPool := x509.NewCertPool()
 	appRootCAs := [][]byte{}
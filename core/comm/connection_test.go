@@ -296,6 +296,62 @@ func TestImpersonation(t *testing.T) {
 
 }
 
+func TestEndpointCredentials(t *testing.T) {
+	t.Parallel()
+	// Scenario: orgA and orgB both run ordering service nodes of channel A.
+	// The endpoints published by an org must present a TLS certificate
+	// issued by the CAs of that org, while other endpoints may present a TLS
+	// certificate issued by the CAs of any orderer org of the channel.
+
+	osA := newServer("orgA")
+	defer osA.Stop()
+	osB := newServer("orgB")
+	defer osB.Stop()
+	time.Sleep(time.Second)
+
+	cs := &CredentialSupport{
+		OrdererRootCAsByChain: map[string][][]byte{
+			"A": {osA.caCert, osB.caCert},
+		},
+		OrdererOrgRootCAsByChain: map[string]map[string][][]byte{
+			"A": {"orgA": {osA.caCert}, "orgB": {osB.caCert}},
+		},
+		OrdererEndpointOrgsByChain: map[string]map[string]string{
+			"A": {osA.address: "orgA", osB.address: "orgA", "orgC:7050": "orgC"},
+		},
+	}
+
+	dial := func(endpoint, address string) error {
+		creds, err := cs.GetDeliverServiceCredentialsForEndpoint("A", endpoint)
+		assert.NoError(t, err)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		conn, err := grpc.DialContext(ctx, address, grpc.WithTransportCredentials(creds), grpc.WithBlock())
+		if err == nil {
+			conn.Close()
+		}
+		return err
+	}
+
+	// osA presents a certificate of the org publishing its endpoint
+	assert.NoError(t, dial(osA.address, osA.address))
+	// osB presents a certificate of another org than the one publishing its endpoint
+	assert.Error(t, dial(osB.address, osB.address))
+	// Endpoints published by no org are verified against the CAs of all orderer orgs
+	assert.NoError(t, dial("global:7050", osB.address))
+
+	_, err := cs.GetDeliverServiceCredentialsForEndpoint("A", "orgC:7050")
+	assert.EqualError(t, err, "didn't find any root CA certs of organization orgC for endpoint orgC:7050 of channel A")
+	_, err = cs.GetDeliverServiceCredentialsForEndpoint("B", osA.address)
+	assert.EqualError(t, err, "didn't find any root CA certs for channel B")
+
+	// Endpoints published by no org are rejected when org CA pinning is enabled
+	cs.SetOrgCAPinning(true)
+	_, err = cs.GetDeliverServiceCredentialsForEndpoint("A", "global:7050")
+	assert.EqualError(t, err, "endpoint global:7050 of channel A isn't published by an orderer organization, its TLS CAs can't be pinned")
+	assert.NoError(t, dial(osA.address, osA.address))
+}
+
 func testInvoke(
 	t *testing.T,
 	channelID string,
@@ -8,11 +8,15 @@ package comm
 
 import (
 	"context"
+	"crypto/rand"
 	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"net"
+	"sync"
 
 	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/common/metrics"
 	"google.golang.org/grpc/credentials"
 )
 
@@ -31,24 +35,84 @@ var (
 // NewServerTransportCredentials returns a new initialized
 // grpc/credentials.TransportCredentials
 func NewServerTransportCredentials(
-	serverConfig *tls.Config,
+	serverConfig *TLSConfig,
 	logger *flogging.FabricLogger) credentials.TransportCredentials {
 
 	// NOTE: unlike the default grpc/credentials implementation, we do not
 	// clone the tls.Config which allows us to update it dynamically
-	serverConfig.NextProtos = alpnProtoStr
+	serverConfig.config.NextProtos = alpnProtoStr
 	// override TLS version and ensure it is 1.2
-	serverConfig.MinVersion = tls.VersionTLS12
-	serverConfig.MaxVersion = tls.VersionTLS12
+	serverConfig.config.MinVersion = tls.VersionTLS12
+	serverConfig.config.MaxVersion = tls.VersionTLS12
 	return &serverCreds{
 		serverConfig: serverConfig,
 		logger:       logger}
 }
 
+// newServerTransportCredentialsWithMetrics returns server transport
+// credentials which count the connections dropped because of a failed TLS
+// handshake
+func newServerTransportCredentialsWithMetrics(
+	serverConfig *TLSConfig,
+	logger *flogging.FabricLogger,
+	droppedConnCounter metrics.Counter) credentials.TransportCredentials {
+
+	creds := NewServerTransportCredentials(serverConfig, logger).(*serverCreds)
+	creds.droppedConnCounter = droppedConnCounter
+	return creds
+}
+
+// TLSConfig is a tls.Config whose client root CAs can be replaced while
+// the server is serving.  Each handshake uses a copy of the configuration, so
+// that a replacement never races with handshakes in progress, and connections
+// which are already established are not affected by it.
+type TLSConfig struct {
+	config *tls.Config
+	lock   sync.RWMutex
+}
+
+// NewTLSConfig returns a TLSConfig wrapping config.
+func NewTLSConfig(config *tls.Config) *TLSConfig {
+	return &TLSConfig{
+		config: config,
+	}
+}
+
+// Config returns a copy of the current tls.Config.
+func (t *TLSConfig) Config() *tls.Config {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.config.Clone()
+}
+
+// ClientAuth returns the client authentication policy of the configuration.
+func (t *TLSConfig) ClientAuth() tls.ClientAuthType {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.config.ClientAuth
+}
+
+// SetClientCAs replaces the pool of authorities used to verify client
+// certificates of new connections. If session tickets are enabled, the
+// session ticket key is replaced as well, so that the sessions established
+// with certificates of removed authorities can't be resumed.
+func (t *TLSConfig) SetClientCAs(certPool *x509.CertPool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.config.ClientCAs = certPool
+	if !t.config.SessionTicketsDisabled {
+		if _, err := rand.Read(t.config.SessionTicketKey[:]); err != nil {
+			// keep the previous key, but don't let sessions be resumed anymore
+			t.config.SessionTicketsDisabled = true
+		}
+	}
+}
+
 // serverCreds is an implementation of grpc/credentials.TransportCredentials.
 type serverCreds struct {
-	serverConfig *tls.Config
-	logger       *flogging.FabricLogger
+	serverConfig       *TLSConfig
+	logger             *flogging.FabricLogger
+	droppedConnCounter metrics.Counter
 }
 
 // ClientHandShake is not implemented for `serverCreds`.
@@ -59,12 +123,15 @@ func (sc *serverCreds) ClientHandshake(context.Context,
 
 // ServerHandshake does the authentication handshake for servers.
 func (sc *serverCreds) ServerHandshake(rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
-	conn := tls.Server(rawConn, sc.serverConfig)
+	conn := tls.Server(rawConn, sc.serverConfig.Config())
 	if err := conn.Handshake(); err != nil {
 		if sc.logger != nil {
 			sc.logger.With("remote address",
 				conn.RemoteAddr().String()).Errorf("TLS handshake failed with error %s", err)
 		}
+		if sc.droppedConnCounter != nil {
+			sc.droppedConnCounter.Add(1)
+		}
 		return nil, nil, err
 	}
 	return conn, credentials.TLSInfo{State: conn.ConnectionState()}, nil
@@ -80,7 +147,7 @@ func (sc *serverCreds) Info() credentials.ProtocolInfo {
 
 // Clone makes a copy of this TransportCredentials.
 func (sc *serverCreds) Clone() credentials.TransportCredentials {
-	creds := NewServerTransportCredentials(sc.serverConfig, sc.logger)
+	creds := newServerTransportCredentialsWithMetrics(sc.serverConfig, sc.logger, sc.droppedConnCounter)
 	return creds
 }
 
@@ -45,7 +45,7 @@ func TestCreds(t *testing.T) {
 
 	logger, recorder := floggingtest.NewTestLogger(t)
 
-	creds := comm.NewServerTransportCredentials(tlsConfig, logger)
+	creds := comm.NewServerTransportCredentials(comm.NewTLSConfig(tlsConfig), logger)
 	_, _, err = creds.ClientHandshake(nil, "", nil)
 	assert.EqualError(t, err, comm.ClientHandshakeNotImplError.Error())
 	err = creds.OverrideServerName("")
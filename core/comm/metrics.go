@@ -22,11 +22,27 @@ var (
 		Name:      "conn_closed",
 		Help:      "gRPC connections closed. Open minus closed is the active number of connections.",
 	}
+
+	agedOutConnCounterOpts = metrics.CounterOpts{
+		Namespace: "grpc",
+		Subsystem: "comm",
+		Name:      "conn_aged_out",
+		Help:      "gRPC connections closed after reaching the max connection age.",
+	}
+
+	droppedConnCounterOpts = metrics.CounterOpts{
+		Namespace: "grpc",
+		Subsystem: "comm",
+		Name:      "conn_dropped",
+		Help:      "gRPC connections dropped before being opened because of a failed TLS handshake.",
+	}
 )
 
 func NewServerStatsHandler(p metrics.Provider) *ServerStatsHandler {
 	return &ServerStatsHandler{
-		OpenConnCounter:   p.NewCounter(openConnCounterOpts),
-		ClosedConnCounter: p.NewCounter(closedConnCounterOpts),
+		OpenConnCounter:    p.NewCounter(openConnCounterOpts),
+		ClosedConnCounter:  p.NewCounter(closedConnCounterOpts),
+		AgedOutConnCounter: p.NewCounter(agedOutConnCounterOpts),
+		DroppedConnCounter: p.NewCounter(droppedConnCounterOpts),
 	}
 }
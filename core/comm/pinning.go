@@ -0,0 +1,81 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package comm
+
+import (
+	"crypto/x509"
+
+	"github.com/pkg/errors"
+)
+
+// SetOrgCAPinning enables or disables org CA pinning, in which case the
+// TLS certificates of remote endpoints must chain to the TLS CAs of their
+// own organization, rather than to those of any organization.
+func (cs *CredentialSupport) SetOrgCAPinning(enabled bool) {
+	cs.Lock()
+	defer cs.Unlock()
+	cs.orgCAPinning = enabled
+}
+
+// OrgCAPinning returns whether org CA pinning is enabled
+func (cs *CredentialSupport) OrgCAPinning() bool {
+	cs.RLock()
+	defer cs.RUnlock()
+	return cs.orgCAPinning
+}
+
+// AppOrgRootCAs returns the TLS root and intermediate CAs of the
+// application organization with the given MSP ID, in all channels
+func (cs *CredentialSupport) AppOrgRootCAs(mspID string) [][]byte {
+	cs.RLock()
+	defer cs.RUnlock()
+
+	var rootCAs [][]byte
+	for _, orgRootCAs := range cs.AppOrgRootCAsByChain {
+		rootCAs = append(rootCAs, orgRootCAs[mspID]...)
+	}
+	return rootCAs
+}
+
+// VerifyCertificateOfOrg verifies that the given DER encoded certificate chain,
+// as presented in a TLS handshake, chains to the given PEM encoded TLS root
+// and intermediate CAs of an organization
+func VerifyCertificateOfOrg(rawCerts [][]byte, orgRootCAs [][]byte) error {
+	if len(rawCerts) == 0 {
+		return errors.New("no certificate presented")
+	}
+	if len(orgRootCAs) == 0 {
+		return errors.New("no TLS CA certificates are known for the organization")
+	}
+
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for i, rawCert := range rawCerts {
+		cert, err := x509.ParseCertificate(rawCert)
+		if err != nil {
+			return errors.Wrap(err, "failed parsing certificate")
+		}
+		certs[i] = cert
+	}
+
+	roots := x509.NewCertPool()
+	for _, rootCA := range orgRootCAs {
+		if err := AddPemToCertPool(rootCA, roots); err != nil {
+			commLogger.Warningf("Failed adding TLS CA certificate of organization to pool: %s", err)
+		}
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	return errors.Wrap(err, "certificate is not issued by the TLS CAs of the organization")
+}
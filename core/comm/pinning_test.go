@@ -0,0 +1,87 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package comm
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/common/crypto/tlsgen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyCertificateOfOrg(t *testing.T) {
+	t.Parallel()
+	org1CA, err := tlsgen.NewCA()
+	require.NoError(t, err)
+	org2CA, err := tlsgen.NewCA()
+	require.NoError(t, err)
+	org1Cert, err := org1CA.NewServerCertKeyPair("127.0.0.1")
+	require.NoError(t, err)
+	org2Cert, err := org2CA.NewClientCertKeyPair()
+	require.NoError(t, err)
+
+	rawCerts := [][]byte{org1Cert.TLSCert.Raw}
+	assert.NoError(t, VerifyCertificateOfOrg(rawCerts, [][]byte{org1CA.CertBytes()}))
+	// Bad CA certificates of the organization are skipped
+	assert.NoError(t, VerifyCertificateOfOrg(rawCerts, [][]byte{[]byte(badPEM), org1CA.CertBytes()}))
+
+	err = VerifyCertificateOfOrg(rawCerts, [][]byte{org2CA.CertBytes()})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "certificate is not issued by the TLS CAs of the organization")
+	err = VerifyCertificateOfOrg([][]byte{org2Cert.TLSCert.Raw}, [][]byte{org1CA.CertBytes()})
+	assert.Error(t, err)
+
+	err = VerifyCertificateOfOrg(nil, [][]byte{org1CA.CertBytes()})
+	assert.EqualError(t, err, "no certificate presented")
+	err = VerifyCertificateOfOrg(rawCerts, nil)
+	assert.EqualError(t, err, "no TLS CA certificates are known for the organization")
+	err = VerifyCertificateOfOrg([][]byte{[]byte("barf")}, [][]byte{org1CA.CertBytes()})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed parsing certificate")
+}
+
+func TestOrgCAPinning(t *testing.T) {
+	t.Parallel()
+	cs := &CredentialSupport{
+		AppRootCAsByChain:        make(map[string][][]byte),
+		OrdererRootCAsByChain:    make(map[string][][]byte),
+		AppOrgRootCAsByChain:     make(map[string]map[string][][]byte),
+		OrdererOrgRootCAsByChain: make(map[string]map[string][][]byte),
+	}
+	assert.False(t, cs.OrgCAPinning())
+	cs.SetOrgCAPinning(true)
+	assert.True(t, cs.OrgCAPinning())
+
+	cs.AppOrgRootCAsByChain["channel1"] = map[string][][]byte{
+		"Org1MSP": {[]byte("org1-ca-1")},
+		"Org2MSP": {[]byte("org2-ca")},
+	}
+	cs.AppOrgRootCAsByChain["channel2"] = map[string][][]byte{
+		"Org1MSP": {[]byte("org1-ca-2")},
+	}
+	assert.ElementsMatch(t, [][]byte{[]byte("org1-ca-1"), []byte("org1-ca-2")}, cs.AppOrgRootCAs("Org1MSP"))
+	assert.Equal(t, [][]byte{[]byte("org2-ca")}, cs.AppOrgRootCAs("Org2MSP"))
+	assert.Empty(t, cs.AppOrgRootCAs("Org3MSP"))
+
+	// The organization of ordering service endpoints published by no
+	// orderer organization is unknown, hence their TLS CAs can't be pinned
+	ordererCA, err := tlsgen.NewCA()
+	require.NoError(t, err)
+	cs.OrdererRootCAsByChain["channel1"] = [][]byte{ordererCA.CertBytes()}
+	cs.OrdererOrgRootCAsByChain["channel1"] = map[string][][]byte{
+		"OrdererMSP": {ordererCA.CertBytes()},
+	}
+	cs.OrdererEndpointOrgsByChain = map[string]map[string]string{
+		"channel1": {"orderer.example.com:7050": "OrdererMSP"},
+	}
+	creds, err := cs.GetDeliverServiceCredentialsForEndpoint("channel1", "orderer.example.com:7050")
+	assert.NoError(t, err)
+	assert.Equal(t, "1.2", creds.Info().SecurityVersion)
+	_, err = cs.GetDeliverServiceCredentialsForEndpoint("channel1", "global.example.com:7050")
+	assert.EqualError(t, err, "endpoint global.example.com:7050 of channel channel1 isn't published by an orderer organization, its TLS CAs can't be pinned")
+}
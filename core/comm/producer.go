@@ -39,9 +39,15 @@ type ConnectionProducer interface {
 	GetEndpoints() []string
 }
 
+// connProducer rotates over its endpoints: each new connection is attempted
+// first with the endpoint following the one of the previous connection, so
+// that a client that lost its connection moves on to another endpoint.
+// The endpoints are shuffled upon creation and update, in order to spread
+// the clients over them.
 type connProducer struct {
 	sync.RWMutex
 	endpoints         []string
+	next              int
 	disabledEndpoints map[string]time.Time
 	connect           ConnectionFactory
 }
@@ -52,7 +58,7 @@ func NewConnectionProducer(factory ConnectionFactory, endpoints []string) Connec
 	if len(endpoints) == 0 {
 		return nil
 	}
-	return &connProducer{endpoints: endpoints, connect: factory, disabledEndpoints: make(map[string]time.Time)}
+	return &connProducer{endpoints: shuffle(endpoints), connect: factory, disabledEndpoints: make(map[string]time.Time)}
 }
 
 // NewConnection creates a new connection.
@@ -68,9 +74,10 @@ func (cp *connProducer) NewConnection() (*grpc.ClientConn, string, error) {
 		}
 	}
 
-	endpoints := shuffle(cp.endpoints)
 	checkedEndpoints := make([]string, 0)
-	for _, endpoint := range endpoints {
+	for i := range cp.endpoints {
+		index := (cp.next + i) % len(cp.endpoints)
+		endpoint := cp.endpoints[index]
 		if _, ok := cp.disabledEndpoints[endpoint]; !ok {
 			checkedEndpoints = append(checkedEndpoints, endpoint)
 			conn, err := cp.connect(endpoint)
@@ -78,6 +85,7 @@ func (cp *connProducer) NewConnection() (*grpc.ClientConn, string, error) {
 				logger.Error("Failed connecting to", endpoint, ", error:", err)
 				continue
 			}
+			cp.next = (index + 1) % len(cp.endpoints)
 			return conn, endpoint, nil
 		}
 	}
@@ -100,7 +108,8 @@ func (cp *connProducer) UpdateEndpoints(endpoints []string) {
 			newDisabled[endpoints[i]] = startTime
 		}
 	}
-	cp.endpoints = endpoints
+	cp.endpoints = shuffle(endpoints)
+	cp.next = 0
 	cp.disabledEndpoints = newDisabled
 }
 
@@ -132,3 +132,35 @@ func TestDisableEndpoint(t *testing.T) {
 	assert.Equal(t, "b", a)
 
 }
+
+func TestEndpointRotation(t *testing.T) {
+	t.Parallel()
+	shouldConnFail := map[string]bool{}
+	connFactory := func(endpoint string) (*grpc.ClientConn, error) {
+		if shouldConnFail[endpoint] {
+			return nil, fmt.Errorf("Failed connecting to %s", endpoint)
+		}
+		return &grpc.ClientConn{}, nil
+	}
+	producer := NewConnectionProducer(connFactory, []string{"a", "b", "c"})
+	// Consecutive connections are made to each of the endpoints in turn
+	_, first, err := producer.NewConnection()
+	assert.NoError(t, err)
+	_, second, err := producer.NewConnection()
+	assert.NoError(t, err)
+	_, third, err := producer.NewConnection()
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, []string{first, second, third})
+	_, endpoint, err := producer.NewConnection()
+	assert.NoError(t, err)
+	assert.Equal(t, first, endpoint)
+
+	// A failing endpoint is skipped in favor of the following one
+	shouldConnFail[second] = true
+	_, endpoint, err = producer.NewConnection()
+	assert.NoError(t, err)
+	assert.Equal(t, third, endpoint)
+	_, endpoint, err = producer.NewConnection()
+	assert.NoError(t, err)
+	assert.Equal(t, first, endpoint)
+}
@@ -0,0 +1,176 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package comm
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TLSLoader returns the PEM-encoded key pair and client root CAs a
+// GRPCServer uses for TLS.  Only the Certificate, Key and ClientRootCAs of
+// the returned SecureOptions are used.
+type TLSLoader func() (*SecureOptions, error)
+
+// FileTLSLoader returns a TLSLoader which reads the PEM-encoded certificate,
+// key and client root CAs of a server from the given files.
+func FileTLSLoader(certFile, keyFile string, clientRootCAFiles ...string) TLSLoader {
+	return func() (*SecureOptions, error) {
+		cert, err := ioutil.ReadFile(certFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed reading TLS certificate")
+		}
+		key, err := ioutil.ReadFile(keyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed reading TLS key")
+		}
+		var clientRootCAs [][]byte
+		for _, file := range clientRootCAFiles {
+			clientRootCA, err := ioutil.ReadFile(file)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed reading TLS client root CA")
+			}
+			clientRootCAs = append(clientRootCAs, clientRootCA)
+		}
+		return &SecureOptions{
+			Certificate:   cert,
+			Key:           key,
+			ClientRootCAs: clientRootCAs,
+		}, nil
+	}
+}
+
+// ReloadTLS loads the TLS material of the server with load, and replaces the
+// server key pair and, if any are returned, the client root CAs with it.
+// All of it is validated before any of it is applied, so a failed reload
+// leaves the server as it was.  Established connections are not affected;
+// only the handshakes of new connections use the reloaded material.
+func (gServer *GRPCServer) ReloadTLS(load TLSLoader) error {
+	if !gServer.TLSEnabled() {
+		return errors.New("TLS is not enabled for the server")
+	}
+
+	_, err := gServer.applyTLS(load)
+	return err
+}
+
+// applyTLS loads the TLS material with load and applies it, and returns the
+// loaded material.  The server lock is held from the load to the apply, so
+// that client root CAs computed from state shared with UpdateClientRootCAs
+// are never applied after a more recent update.
+func (gServer *GRPCServer) applyTLS(load TLSLoader) (*SecureOptions, error) {
+	gServer.lock.Lock()
+	defer gServer.lock.Unlock()
+
+	secOpts, err := load()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed loading TLS material")
+	}
+	cert, err := tls.X509KeyPair(secOpts.Certificate, secOpts.Key)
+	if err != nil {
+		return secOpts, errors.Wrap(err, "invalid TLS key pair")
+	}
+	var clientRootCAs map[string]*x509.Certificate
+	if len(secOpts.ClientRootCAs) > 0 {
+		clientRootCAs, err = parseClientRootCAs(secOpts.ClientRootCAs)
+		if err != nil {
+			return secOpts, err
+		}
+	}
+
+	gServer.SetServerCertificate(cert)
+	if clientRootCAs != nil {
+		gServer.clientRootCAs = clientRootCAs
+		gServer.tlsConfig.SetClientCAs(gServer.clientRootCAPool())
+	}
+	return secOpts, nil
+}
+
+// WatchTLS calls load every interval, and reloads the TLS material of the
+// server whenever what load returns changes.  If the reloaded material is
+// not valid, the server keeps using the current one.  It returns once done
+// is closed.
+func (gServer *GRPCServer) WatchTLS(load TLSLoader, interval time.Duration, done <-chan struct{}) {
+	if !gServer.TLSEnabled() {
+		commLogger.Warning("Not watching the TLS material of the server as TLS is not enabled")
+		return
+	}
+
+	commLogger.Infof("Watching the TLS material of the server listening on %s every %s", gServer.address, interval)
+
+	last, err := load()
+	if err != nil {
+		commLogger.Warningf("Failed loading TLS material: %s", err)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+
+		secOpts, err := load()
+		if err != nil {
+			commLogger.Warningf("Failed loading TLS material: %s", err)
+			continue
+		}
+		if sameTLSMaterial(last, secOpts) {
+			continue
+		}
+
+		commLogger.Infof("TLS material of the server listening on %s changed, reloading it", gServer.address)
+		applied, err := gServer.applyTLS(load)
+		if err != nil {
+			commLogger.Errorf("Failed reloading TLS material, keeping the current one: %s", err)
+		}
+		// Either way, wait for the next change before trying again
+		if applied != nil {
+			secOpts = applied
+		}
+		last = secOpts
+	}
+}
+
+// sameTLSMaterial compares the client root CAs as a set, as they are
+// usually gathered from maps and their order is therefore not stable.
+func sameTLSMaterial(a, b *SecureOptions) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !bytes.Equal(a.Certificate, b.Certificate) || !bytes.Equal(a.Key, b.Key) {
+		return false
+	}
+	return sameRoots(a.ClientRootCAs, b.ClientRootCAs)
+}
+
+func sameRoots(a, b [][]byte) bool {
+	set := make(map[string]bool, len(a))
+	for _, root := range a {
+		set[string(root)] = false
+	}
+	for _, root := range b {
+		if _, exists := set[string(root)]; !exists {
+			return false
+		}
+		set[string(root)] = true
+	}
+	for _, seen := range set {
+		if !seen {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,36 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package comm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSameTLSMaterial(t *testing.T) {
+	secOpts := func(roots ...string) *SecureOptions {
+		s := &SecureOptions{Certificate: []byte("cert"), Key: []byte("key")}
+		for _, root := range roots {
+			s.ClientRootCAs = append(s.ClientRootCAs, []byte(root))
+		}
+		return s
+	}
+
+	assert.True(t, sameTLSMaterial(nil, nil))
+	assert.False(t, sameTLSMaterial(secOpts(), nil))
+	assert.True(t, sameTLSMaterial(secOpts(), secOpts()))
+	assert.True(t, sameTLSMaterial(secOpts("a", "b", "c"), secOpts("c", "a", "b")))
+	assert.True(t, sameTLSMaterial(secOpts("a", "b", "a"), secOpts("b", "a")))
+	assert.False(t, sameTLSMaterial(secOpts("a", "b"), secOpts("a", "c")))
+	assert.False(t, sameTLSMaterial(secOpts("a", "b"), secOpts("a")))
+	assert.False(t, sameTLSMaterial(secOpts("a"), secOpts("a", "b")))
+
+	otherKey := secOpts("a")
+	otherKey.Key = []byte("other key")
+	assert.False(t, sameTLSMaterial(secOpts("a"), otherKey))
+}
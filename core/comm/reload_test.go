@@ -0,0 +1,227 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package comm_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/core/comm"
+	"github.com/hyperledger/fabric/core/comm/testpb"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+func readTestCert(gt *GomegaWithT, name string) []byte {
+	pem, err := ioutil.ReadFile(filepath.Join("testdata", "certs", name))
+	gt.Expect(err).NotTo(HaveOccurred())
+	return pem
+}
+
+func TestFileTLSLoader(t *testing.T) {
+	t.Parallel()
+	gt := NewGomegaWithT(t)
+
+	certsDir := filepath.Join("testdata", "certs")
+	load := comm.FileTLSLoader(
+		filepath.Join(certsDir, "Org1-server1-cert.pem"),
+		filepath.Join(certsDir, "Org1-server1-key.pem"),
+		filepath.Join(certsDir, "Org1-cert.pem"),
+		filepath.Join(certsDir, "Org2-cert.pem"),
+	)
+	secOpts, err := load()
+	gt.Expect(err).NotTo(HaveOccurred())
+	gt.Expect(secOpts.Certificate).To(Equal(readTestCert(gt, "Org1-server1-cert.pem")))
+	gt.Expect(secOpts.Key).To(Equal(readTestCert(gt, "Org1-server1-key.pem")))
+	gt.Expect(secOpts.ClientRootCAs).To(Equal([][]byte{
+		readTestCert(gt, "Org1-cert.pem"),
+		readTestCert(gt, "Org2-cert.pem"),
+	}))
+
+	load = comm.FileTLSLoader(filepath.Join(certsDir, "missing-cert.pem"), filepath.Join(certsDir, "Org1-server1-key.pem"))
+	_, err = load()
+	gt.Expect(err).To(MatchError(ContainSubstring("failed reading TLS certificate")))
+
+	load = comm.FileTLSLoader(filepath.Join(certsDir, "Org1-server1-cert.pem"), filepath.Join(certsDir, "missing-key.pem"))
+	_, err = load()
+	gt.Expect(err).To(MatchError(ContainSubstring("failed reading TLS key")))
+
+	load = comm.FileTLSLoader(
+		filepath.Join(certsDir, "Org1-server1-cert.pem"),
+		filepath.Join(certsDir, "Org1-server1-key.pem"),
+		filepath.Join(certsDir, "missing-ca.pem"),
+	)
+	_, err = load()
+	gt.Expect(err).To(MatchError(ContainSubstring("failed reading TLS client root CA")))
+}
+
+func TestReloadTLS(t *testing.T) {
+	t.Parallel()
+	gt := NewGomegaWithT(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	gt.Expect(err).NotTo(HaveOccurred())
+	srv, err := comm.NewGRPCServerFromListener(listener, comm.ServerConfig{
+		SecOpts: &comm.SecureOptions{
+			UseTLS:            true,
+			Certificate:       readTestCert(gt, "Org1-server1-cert.pem"),
+			Key:               readTestCert(gt, "Org1-server1-key.pem"),
+			RequireClientCert: true,
+			ClientRootCAs:     [][]byte{readTestCert(gt, "Org1-cert.pem")},
+		},
+	})
+	gt.Expect(err).NotTo(HaveOccurred())
+	testpb.RegisterEmptyServiceServer(srv.Server(), &emptyServiceServer{})
+	go srv.Start()
+	defer srv.Stop()
+
+	serverRootCAs := x509.NewCertPool()
+	serverRootCAs.AppendCertsFromPEM(readTestCert(gt, "Org1-cert.pem"))
+	serverRootCAs.AppendCertsFromPEM(readTestCert(gt, "Org2-cert.pem"))
+	clientTLSConfig := func(org string) *tls.Config {
+		cert, err := tls.X509KeyPair(readTestCert(gt, org+"-client1-cert.pem"), readTestCert(gt, org+"-client1-key.pem"))
+		gt.Expect(err).NotTo(HaveOccurred())
+		return &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			RootCAs:      serverRootCAs,
+		}
+	}
+	dial := func(org string) (*grpc.ClientConn, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		return grpc.DialContext(
+			ctx,
+			listener.Addr().String(),
+			grpc.WithTransportCredentials(credentials.NewTLS(clientTLSConfig(org))),
+			grpc.WithBlock(),
+		)
+	}
+
+	org1Conn, err := dial("Org1")
+	gt.Expect(err).NotTo(HaveOccurred())
+	defer org1Conn.Close()
+	_, err = testpb.NewEmptyServiceClient(org1Conn).EmptyCall(context.Background(), &testpb.Empty{})
+	gt.Expect(err).NotTo(HaveOccurred())
+
+	err = srv.ReloadTLS(func() (*comm.SecureOptions, error) {
+		return &comm.SecureOptions{
+			Certificate:   readTestCert(gt, "Org2-server1-cert.pem"),
+			Key:           readTestCert(gt, "Org2-server1-key.pem"),
+			ClientRootCAs: [][]byte{readTestCert(gt, "Org2-cert.pem")},
+		}, nil
+	})
+	gt.Expect(err).NotTo(HaveOccurred())
+
+	// the established connection stays up
+	_, err = testpb.NewEmptyServiceClient(org1Conn).EmptyCall(context.Background(), &testpb.Empty{})
+	gt.Expect(err).NotTo(HaveOccurred())
+
+	// new connections use the reloaded key pair and client root CAs
+	conn, err := tls.Dial("tcp", listener.Addr().String(), clientTLSConfig("Org2"))
+	gt.Expect(err).NotTo(HaveOccurred())
+	gt.Expect(conn.ConnectionState().PeerCertificates[0].Subject.Organization).To(ConsistOf("Org2-server1"))
+	conn.Close()
+	org2Conn, err := dial("Org2")
+	gt.Expect(err).NotTo(HaveOccurred())
+	defer org2Conn.Close()
+	_, err = testpb.NewEmptyServiceClient(org2Conn).EmptyCall(context.Background(), &testpb.Empty{})
+	gt.Expect(err).NotTo(HaveOccurred())
+	_, err = dial("Org1")
+	gt.Expect(err).To(HaveOccurred())
+
+	// the client root CAs can be updated independently of the key pair
+	err = srv.UpdateClientRootCAs(func() [][]byte {
+		return [][]byte{readTestCert(gt, "Org1-cert.pem"), readTestCert(gt, "Org2-cert.pem")}
+	})
+	gt.Expect(err).NotTo(HaveOccurred())
+	org1Conn2, err := dial("Org1")
+	gt.Expect(err).NotTo(HaveOccurred())
+	org1Conn2.Close()
+
+	// an invalid key pair leaves the server unchanged
+	currentCert := srv.ServerCertificate()
+	err = srv.ReloadTLS(func() (*comm.SecureOptions, error) {
+		return &comm.SecureOptions{
+			Certificate: readTestCert(gt, "Org1-server1-cert.pem"),
+			Key:         readTestCert(gt, "Org2-server1-key.pem"),
+		}, nil
+	})
+	gt.Expect(err).To(MatchError(ContainSubstring("invalid TLS key pair")))
+	gt.Expect(srv.ServerCertificate()).To(Equal(currentCert))
+
+	err = srv.ReloadTLS(func() (*comm.SecureOptions, error) {
+		return nil, errors.New("disk on fire")
+	})
+	gt.Expect(err).To(MatchError("failed loading TLS material: disk on fire"))
+	gt.Expect(srv.ServerCertificate()).To(Equal(currentCert))
+}
+
+func TestReloadTLSWithoutTLS(t *testing.T) {
+	t.Parallel()
+	gt := NewGomegaWithT(t)
+
+	srv, err := comm.NewGRPCServer("127.0.0.1:0", comm.ServerConfig{
+		SecOpts: &comm.SecureOptions{UseTLS: false},
+	})
+	gt.Expect(err).NotTo(HaveOccurred())
+	defer srv.Listener().Close()
+
+	err = srv.ReloadTLS(comm.FileTLSLoader("cert.pem", "key.pem"))
+	gt.Expect(err).To(MatchError("TLS is not enabled for the server"))
+}
+
+func TestWatchTLS(t *testing.T) {
+	t.Parallel()
+	gt := NewGomegaWithT(t)
+
+	tempDir, err := ioutil.TempDir("", "watchtls")
+	gt.Expect(err).NotTo(HaveOccurred())
+	defer os.RemoveAll(tempDir)
+
+	certFile := filepath.Join(tempDir, "cert.pem")
+	keyFile := filepath.Join(tempDir, "key.pem")
+	writeKeyPair := func(prefix string) {
+		err := ioutil.WriteFile(certFile, readTestCert(gt, prefix+"-cert.pem"), 0600)
+		gt.Expect(err).NotTo(HaveOccurred())
+		err = ioutil.WriteFile(keyFile, readTestCert(gt, prefix+"-key.pem"), 0600)
+		gt.Expect(err).NotTo(HaveOccurred())
+	}
+	writeKeyPair("Org1-server1")
+
+	srv, err := comm.NewGRPCServer("127.0.0.1:0", comm.ServerConfig{
+		SecOpts: &comm.SecureOptions{
+			UseTLS:      true,
+			Certificate: readTestCert(gt, "Org1-server1-cert.pem"),
+			Key:         readTestCert(gt, "Org1-server1-key.pem"),
+		},
+	})
+	gt.Expect(err).NotTo(HaveOccurred())
+	defer srv.Listener().Close()
+
+	done := make(chan struct{})
+	defer close(done)
+	go srv.WatchTLS(comm.FileTLSLoader(certFile, keyFile), 10*time.Millisecond, done)
+
+	leafOrganization := func() []string {
+		cert, err := x509.ParseCertificate(srv.ServerCertificate().Certificate[0])
+		gt.Expect(err).NotTo(HaveOccurred())
+		return cert.Subject.Organization
+	}
+	gt.Consistently(leafOrganization, 50*time.Millisecond).Should(ConsistOf("Org1-server1"))
+
+	writeKeyPair("Org2-server1")
+	gt.Eventually(leafOrganization, 5*time.Second).Should(ConsistOf("Org2-server1"))
+}
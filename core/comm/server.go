@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package comm
 
 import (
+	"crypto/rand"
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
@@ -39,7 +41,7 @@ type GRPCServer struct {
 	// the tlsConfig.ClientCAs indexed by subject
 	clientRootCAs map[string]*x509.Certificate
 	// TLS configuration used by the grpc server
-	tlsConfig *tls.Config
+	tlsConfig *TLSConfig
 	// Server for gRPC Health Check Protocol.
 	healthServer *health.Server
 }
@@ -71,6 +73,12 @@ func NewGRPCServerFromListener(listener net.Listener, serverConfig ServerConfig)
 	//set up our server options
 	var serverOpts []grpc.ServerOption
 
+	var statsHandler *ServerStatsHandler
+	if serverConfig.MetricsProvider != nil {
+		statsHandler = NewServerStatsHandler(serverConfig.MetricsProvider)
+		statsHandler.MaxConnectionAge = serverConfig.KaOpts.Clone().MaxConnectionAge
+	}
+
 	//check SecOpts
 	var secureConfig SecureOptions
 	if serverConfig.SecOpts != nil {
@@ -94,33 +102,49 @@ func NewGRPCServerFromListener(listener net.Listener, serverConfig ServerConfig)
 				cert := grpcServer.serverCertificate.Load().(tls.Certificate)
 				return &cert, nil
 			}
+			// resumed sessions skip the verification of client certificates,
+			// so they are not allowed when a custom verification is configured
+			sessionResumption := secureConfig.SessionResumption && secureConfig.VerifyCertificate == nil
 			//base server certificate
-			grpcServer.tlsConfig = &tls.Config{
+			tlsConfig := &tls.Config{
 				VerifyPeerCertificate:  secureConfig.VerifyCertificate,
 				GetCertificate:         getCert,
-				SessionTicketsDisabled: true,
+				SessionTicketsDisabled: !sessionResumption,
 				CipherSuites:           secureConfig.CipherSuites,
 			}
-			grpcServer.tlsConfig.ClientAuth = tls.RequestClientCert
+			// every handshake uses a copy of the config, so the session
+			// ticket key must be shared rather than generated by each copy
+			if sessionResumption {
+				if _, err := rand.Read(tlsConfig.SessionTicketKey[:]); err != nil {
+					return nil, err
+				}
+			}
+			tlsConfig.ClientAuth = tls.RequestClientCert
 			//check if client authentication is required
 			if secureConfig.RequireClientCert {
 				//require TLS client auth
-				grpcServer.tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
-				//if we have client root CAs, create a certPool
-				if len(secureConfig.ClientRootCAs) > 0 {
-					grpcServer.clientRootCAs = make(map[string]*x509.Certificate)
-					grpcServer.tlsConfig.ClientCAs = x509.NewCertPool()
-					for _, clientRootCA := range secureConfig.ClientRootCAs {
-						err = grpcServer.appendClientRootCA(clientRootCA)
-						if err != nil {
-							return nil, err
-						}
+				tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+			grpcServer.tlsConfig = NewTLSConfig(tlsConfig)
+			//if we have client root CAs, create a certPool
+			if secureConfig.RequireClientCert && len(secureConfig.ClientRootCAs) > 0 {
+				grpcServer.clientRootCAs = make(map[string]*x509.Certificate)
+				for _, clientRootCA := range secureConfig.ClientRootCAs {
+					err = grpcServer.appendClientRootCA(clientRootCA)
+					if err != nil {
+						return nil, err
 					}
 				}
+				grpcServer.tlsConfig.SetClientCAs(grpcServer.clientRootCAPool())
 			}
 
 			// create credentials and add to server options
-			creds := NewServerTransportCredentials(grpcServer.tlsConfig, serverConfig.Logger)
+			var creds credentials.TransportCredentials
+			if statsHandler != nil {
+				creds = newServerTransportCredentialsWithMetrics(grpcServer.tlsConfig, serverConfig.Logger, statsHandler.DroppedConnCounter)
+			} else {
+				creds = NewServerTransportCredentials(grpcServer.tlsConfig, serverConfig.Logger)
+			}
 			serverOpts = append(serverOpts, grpc.Creds(creds))
 		} else {
 			return nil, errors.New("serverConfig.SecOpts must contain both Key and Certificate when UseTLS is true")
@@ -152,9 +176,8 @@ func NewGRPCServerFromListener(listener net.Listener, serverConfig ServerConfig)
 		)
 	}
 
-	if serverConfig.MetricsProvider != nil {
-		sh := NewServerStatsHandler(serverConfig.MetricsProvider)
-		serverOpts = append(serverOpts, grpc.StatsHandler(sh))
+	if statsHandler != nil {
+		serverOpts = append(serverOpts, grpc.StatsHandler(statsHandler))
 	}
 
 	grpcServer.server = grpc.NewServer(serverOpts...)
@@ -202,7 +225,7 @@ func (gServer *GRPCServer) TLSEnabled() bool {
 // are required for this GRPCServer instance
 func (gServer *GRPCServer) MutualTLSRequired() bool {
 	return gServer.tlsConfig != nil &&
-		gServer.tlsConfig.ClientAuth ==
+		gServer.tlsConfig.ClientAuth() ==
 			tls.RequireAndVerifyClientCert
 }
 
@@ -240,6 +263,9 @@ func (gServer *GRPCServer) AppendClientRootCAs(clientRoots [][]byte) error {
 			return err
 		}
 	}
+
+	//replace the current ClientCAs pool
+	gServer.tlsConfig.SetClientCAs(gServer.clientRootCAPool())
 	return nil
 }
 
@@ -257,15 +283,27 @@ func (gServer *GRPCServer) appendClientRootCA(clientRoot []byte) error {
 		return fmt.Errorf(errMsg, "No client root certificates found")
 	}
 
+	if gServer.clientRootCAs == nil {
+		gServer.clientRootCAs = make(map[string]*x509.Certificate)
+	}
 	for i, cert := range certs {
-		//first add to the ClientCAs
-		gServer.tlsConfig.ClientCAs.AddCert(cert)
 		//add it to our clientRootCAs map using subject as key
 		gServer.clientRootCAs[subjects[i]] = cert
 	}
 	return nil
 }
 
+// clientRootCAPool returns a new CertPool populated with the current
+// clientRootCAs.  The pool of the TLS config is never modified in place, as
+// handshakes in progress may be reading it
+func (gServer *GRPCServer) clientRootCAPool() *x509.CertPool {
+	certPool := x509.NewCertPool()
+	for _, clientRoot := range gServer.clientRootCAs {
+		certPool.AddCert(clientRoot)
+	}
+	return certPool
+}
+
 // RemoveClientRootCAs removes PEM-encoded X509 certificate authorities from
 // the list of authorities used to verify client certificates
 func (gServer *GRPCServer) RemoveClientRootCAs(clientRoots [][]byte) error {
@@ -279,14 +317,8 @@ func (gServer *GRPCServer) RemoveClientRootCAs(clientRoots [][]byte) error {
 		}
 	}
 
-	//create a new CertPool and populate with current clientRootCAs
-	certPool := x509.NewCertPool()
-	for _, clientRoot := range gServer.clientRootCAs {
-		certPool.AddCert(clientRoot)
-	}
-
 	//replace the current ClientCAs pool
-	gServer.tlsConfig.ClientCAs = certPool
+	gServer.tlsConfig.SetClientCAs(gServer.clientRootCAPool())
 	return nil
 }
 
@@ -320,31 +352,52 @@ func (gServer *GRPCServer) SetClientRootCAs(clientRoots [][]byte) error {
 	gServer.lock.Lock()
 	defer gServer.lock.Unlock()
 
+	clientRootCAs, err := parseClientRootCAs(clientRoots)
+	if err != nil {
+		return err
+	}
+
+	//replace the internal map
+	gServer.clientRootCAs = clientRootCAs
+	//replace the current ClientCAs pool
+	gServer.tlsConfig.SetClientCAs(gServer.clientRootCAPool())
+	return nil
+}
+
+// UpdateClientRootCAs replaces the authorities used to verify client
+// certificates with the PEM-encoded X509 certificate authorities returned by
+// roots.  roots is called with the lock of the server held, the same one held
+// while reloading the TLS material, so that roots computed from state shared
+// with a TLSLoader are applied in the order they are computed.
+func (gServer *GRPCServer) UpdateClientRootCAs(roots func() [][]byte) error {
+	gServer.lock.Lock()
+	defer gServer.lock.Unlock()
+
+	clientRootCAs, err := parseClientRootCAs(roots())
+	if err != nil {
+		return err
+	}
+
+	gServer.clientRootCAs = clientRootCAs
+	gServer.tlsConfig.SetClientCAs(gServer.clientRootCAPool())
+	return nil
+}
+
+// internal function to convert PEM-encoded clientRootCAs to a map of
+// certificates indexed by subject
+func parseClientRootCAs(clientRoots [][]byte) (map[string]*x509.Certificate, error) {
 	errMsg := "Failed to set client root certificate(s): %s"
 
-	//create a new map and CertPool
 	clientRootCAs := make(map[string]*x509.Certificate)
 	for _, clientRoot := range clientRoots {
 		certs, subjects, err := pemToX509Certs(clientRoot)
 		if err != nil {
-			return fmt.Errorf(errMsg, err.Error())
+			return nil, fmt.Errorf(errMsg, err.Error())
 		}
-		if len(certs) >= 1 {
-			for i, cert := range certs {
-				//add it to our clientRootCAs map using subject as key
-				clientRootCAs[subjects[i]] = cert
-			}
+		for i, cert := range certs {
+			//add it to our clientRootCAs map using subject as key
+			clientRootCAs[subjects[i]] = cert
 		}
 	}
-
-	//create a new CertPool and populate with the new clientRootCAs
-	certPool := x509.NewCertPool()
-	for _, clientRoot := range clientRootCAs {
-		certPool.AddCert(clientRoot)
-	}
-	//replace the internal map
-	gServer.clientRootCAs = clientRootCAs
-	//replace the current ClientCAs pool
-	gServer.tlsConfig.ClientCAs = certPool
-	return nil
+	return clientRootCAs, nil
 }
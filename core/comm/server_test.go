@@ -31,6 +31,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
@@ -1668,6 +1669,80 @@ func TestUpdateTLSCert(t *testing.T) {
 	assert.Contains(t, err.Error(), "context deadline exceeded")
 }
 
+func TestSessionResumption(t *testing.T) {
+	t.Parallel()
+
+	fName := func(path string) string {
+		return filepath.Join("testdata", "dynamic_cert_update", path)
+	}
+	cert, err := ioutil.ReadFile(fName(filepath.Join("localhost", "server.crt")))
+	assert.NoError(t, err)
+	key, err := ioutil.ReadFile(fName(filepath.Join("localhost", "server.key")))
+	assert.NoError(t, err)
+	caCert, err := ioutil.ReadFile(fName("ca.crt"))
+	assert.NoError(t, err)
+	certPool := x509.NewCertPool()
+	certPool.AppendCertsFromPEM(caCert)
+
+	verifyCertificate := func([][]byte, [][]*x509.Certificate) error { return nil }
+	tests := []struct {
+		name              string
+		sessionResumption bool
+		verifyCertificate func([][]byte, [][]*x509.Certificate) error
+		expectedResume    bool
+	}{
+		{name: "enabled", sessionResumption: true, expectedResume: true},
+		{name: "disabled", sessionResumption: false, expectedResume: false},
+		{name: "custom verification", sessionResumption: true, verifyCertificate: verifyCertificate, expectedResume: false},
+	}
+
+	for _, test := range tests {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		assert.NoError(t, err)
+		srv, err := comm.NewGRPCServerFromListener(lis, comm.ServerConfig{
+			SecOpts: &comm.SecureOptions{
+				UseTLS:            true,
+				Key:               key,
+				Certificate:       cert,
+				SessionResumption: test.sessionResumption,
+				VerifyCertificate: test.verifyCertificate,
+			},
+		})
+		assert.NoError(t, err)
+		testpb.RegisterEmptyServiceServer(srv.Server(), &emptyServiceServer{})
+		go srv.Start()
+
+		clientTLSConfig := &tls.Config{
+			RootCAs:            certPool,
+			ClientSessionCache: tls.NewLRUClientSessionCache(10),
+		}
+		// didResume dials the server over a new connection, and returns
+		// whether the connection resumed a previous TLS session
+		didResume := func() bool {
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+			clientConn, err := grpc.DialContext(ctx, lis.Addr().String(),
+				grpc.WithTransportCredentials(credentials.NewTLS(clientTLSConfig)), grpc.WithBlock())
+			assert.NoError(t, err)
+			defer clientConn.Close()
+
+			var p peer.Peer
+			_, err = testpb.NewEmptyServiceClient(clientConn).EmptyCall(context.Background(), new(testpb.Empty), grpc.Peer(&p))
+			assert.NoError(t, err)
+			return p.AuthInfo.(credentials.TLSInfo).State.DidResume
+		}
+
+		assert.False(t, didResume(), test.name)
+		assert.Equal(t, test.expectedResume, didResume(), test.name)
+
+		// Sessions established before the client root CAs change can't be resumed
+		assert.NoError(t, srv.SetClientRootCAs([][]byte{caCert}))
+		assert.False(t, didResume(), test.name)
+		assert.Equal(t, test.expectedResume, didResume(), test.name)
+		srv.Stop()
+	}
+}
+
 func TestCipherSuites(t *testing.T) {
 	t.Parallel()
 
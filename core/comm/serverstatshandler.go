@@ -8,16 +8,24 @@ package comm
 
 import (
 	"context"
+	"time"
 
 	"github.com/hyperledger/fabric/common/metrics"
 	"google.golang.org/grpc/stats"
 )
 
 type ServerStatsHandler struct {
-	OpenConnCounter   metrics.Counter
-	ClosedConnCounter metrics.Counter
+	OpenConnCounter    metrics.Counter
+	ClosedConnCounter  metrics.Counter
+	AgedOutConnCounter metrics.Counter
+	DroppedConnCounter metrics.Counter
+	// MaxConnectionAge is the max connection age of the server, after which
+	// closed connections are counted as aged out
+	MaxConnectionAge time.Duration
 }
 
+type connBeginKey struct{}
+
 func (h *ServerStatsHandler) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
 	return ctx
 }
@@ -25,7 +33,7 @@ func (h *ServerStatsHandler) TagRPC(ctx context.Context, info *stats.RPCTagInfo)
 func (h *ServerStatsHandler) HandleRPC(ctx context.Context, s stats.RPCStats) {}
 
 func (h *ServerStatsHandler) TagConn(ctx context.Context, info *stats.ConnTagInfo) context.Context {
-	return ctx
+	return context.WithValue(ctx, connBeginKey{}, time.Now())
 }
 
 func (h *ServerStatsHandler) HandleConn(ctx context.Context, s stats.ConnStats) {
@@ -34,5 +42,21 @@ func (h *ServerStatsHandler) HandleConn(ctx context.Context, s stats.ConnStats)
 		h.OpenConnCounter.Add(1)
 	case *stats.ConnEnd:
 		h.ClosedConnCounter.Add(1)
+		if h.agedOut(ctx) {
+			h.AgedOutConnCounter.Add(1)
+		}
+	}
+}
+
+// agedOut returns whether the connection of the context lived for the max
+// connection age, minus the jitter of 10% gRPC may subtract from it.
+func (h *ServerStatsHandler) agedOut(ctx context.Context) bool {
+	if h.MaxConnectionAge <= 0 || h.AgedOutConnCounter == nil {
+		return false
+	}
+	begin, ok := ctx.Value(connBeginKey{}).(time.Time)
+	if !ok {
+		return false
 	}
+	return time.Since(begin) >= h.MaxConnectionAge-h.MaxConnectionAge/10
 }
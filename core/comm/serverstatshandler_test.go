@@ -8,7 +8,9 @@ package comm_test
 
 import (
 	"context"
+	"io/ioutil"
 	"net"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -43,6 +45,34 @@ func TestConnectionCounters(t *testing.T) {
 	}
 }
 
+func TestAgedOutConnectionCounter(t *testing.T) {
+	t.Parallel()
+	gt := NewGomegaWithT(t)
+
+	closedConn := &metricsfakes.Counter{}
+	agedOutConn := &metricsfakes.Counter{}
+	sh := &comm.ServerStatsHandler{
+		OpenConnCounter:    &metricsfakes.Counter{},
+		ClosedConnCounter:  closedConn,
+		AgedOutConnCounter: agedOutConn,
+		MaxConnectionAge:   time.Hour,
+	}
+
+	ctx := sh.TagConn(context.Background(), &stats.ConnTagInfo{})
+	sh.HandleConn(ctx, &stats.ConnBegin{})
+	sh.HandleConn(ctx, &stats.ConnEnd{})
+	gt.Expect(closedConn.AddCallCount()).To(Equal(1))
+	gt.Expect(agedOutConn.AddCallCount()).To(Equal(0))
+
+	sh.MaxConnectionAge = time.Millisecond
+	ctx = sh.TagConn(context.Background(), &stats.ConnTagInfo{})
+	sh.HandleConn(ctx, &stats.ConnBegin{})
+	time.Sleep(2 * time.Millisecond)
+	sh.HandleConn(ctx, &stats.ConnEnd{})
+	gt.Expect(closedConn.AddCallCount()).To(Equal(2))
+	gt.Expect(agedOutConn.AddCallCount()).To(Equal(1))
+}
+
 func TestConnMetricsGRPCServer(t *testing.T) {
 	t.Parallel()
 	gt := NewGomegaWithT(t)
@@ -56,6 +86,8 @@ func TestConnMetricsGRPCServer(t *testing.T) {
 			return openConn
 		case "conn_closed":
 			return closedConn
+		case "conn_aged_out", "conn_dropped":
+			return &metricsfakes.Counter{}
 		default:
 			panic("unknown counter")
 		}
@@ -106,3 +138,92 @@ func TestConnMetricsGRPCServer(t *testing.T) {
 		gt.Eventually(closedConn.AddCallCount, time.Second).Should(Equal(i + 1))
 	}
 }
+
+func TestConnMetricsMaxConnectionAge(t *testing.T) {
+	t.Parallel()
+	gt := NewGomegaWithT(t)
+
+	closedConn := &metricsfakes.Counter{}
+	agedOutConn := &metricsfakes.Counter{}
+	fakeProvider := &metricsfakes.Provider{}
+	fakeProvider.NewCounterStub = func(o metrics.CounterOpts) metrics.Counter {
+		switch o.Name {
+		case "conn_closed":
+			return closedConn
+		case "conn_aged_out":
+			return agedOutConn
+		default:
+			return &metricsfakes.Counter{}
+		}
+	}
+
+	kaOpts := comm.DefaultKeepaliveOptions.Clone()
+	kaOpts.MaxConnectionAge = 200 * time.Millisecond
+	kaOpts.MaxConnectionAgeGrace = 200 * time.Millisecond
+	listener, err := net.Listen("tcp", "localhost:0")
+	gt.Expect(err).NotTo(HaveOccurred())
+	srv, err := comm.NewGRPCServerFromListener(
+		listener,
+		comm.ServerConfig{
+			SecOpts:         &comm.SecureOptions{UseTLS: false},
+			KaOpts:          kaOpts,
+			MetricsProvider: fakeProvider,
+		},
+	)
+	gt.Expect(err).NotTo(HaveOccurred())
+	testpb.RegisterEmptyServiceServer(srv.Server(), &emptyServiceServer{})
+	go srv.Start()
+	defer srv.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	clientConn, err := grpc.DialContext(ctx, listener.Addr().String(), grpc.WithInsecure())
+	gt.Expect(err).NotTo(HaveOccurred())
+	defer clientConn.Close()
+	_, err = testpb.NewEmptyServiceClient(clientConn).EmptyCall(context.Background(), &testpb.Empty{})
+	gt.Expect(err).NotTo(HaveOccurred())
+
+	// the server closes the connection once it reaches its max age
+	gt.Eventually(agedOutConn.AddCallCount, 5*time.Second).Should(BeNumerically(">=", 1))
+	gt.Expect(closedConn.AddCallCount()).To(BeNumerically(">=", 1))
+}
+
+func TestConnMetricsDroppedConnections(t *testing.T) {
+	t.Parallel()
+	gt := NewGomegaWithT(t)
+
+	droppedConn := &metricsfakes.Counter{}
+	fakeProvider := &metricsfakes.Provider{}
+	fakeProvider.NewCounterStub = func(o metrics.CounterOpts) metrics.Counter {
+		if o.Name == "conn_dropped" {
+			return droppedConn
+		}
+		return &metricsfakes.Counter{}
+	}
+
+	cert, err := ioutil.ReadFile(filepath.Join("testdata", "certs", "Org1-server1-cert.pem"))
+	gt.Expect(err).NotTo(HaveOccurred())
+	key, err := ioutil.ReadFile(filepath.Join("testdata", "certs", "Org1-server1-key.pem"))
+	gt.Expect(err).NotTo(HaveOccurred())
+	listener, err := net.Listen("tcp", "localhost:0")
+	gt.Expect(err).NotTo(HaveOccurred())
+	srv, err := comm.NewGRPCServerFromListener(
+		listener,
+		comm.ServerConfig{
+			SecOpts:         &comm.SecureOptions{UseTLS: true, Certificate: cert, Key: key},
+			MetricsProvider: fakeProvider,
+		},
+	)
+	gt.Expect(err).NotTo(HaveOccurred())
+	go srv.Start()
+	defer srv.Stop()
+
+	// a client which does not speak TLS fails the handshake
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	gt.Expect(err).NotTo(HaveOccurred())
+	_, err = conn.Write([]byte("garbage garbage garbage garbage\n"))
+	gt.Expect(err).NotTo(HaveOccurred())
+	defer conn.Close()
+
+	gt.Eventually(droppedConn.AddCallCount, 5*time.Second).Should(Equal(1))
+}
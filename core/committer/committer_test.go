@@ -10,6 +10,7 @@ import (
 	"errors"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/hyperledger/fabric/common/configtx/test"
 	"github.com/hyperledger/fabric/common/ledger"
@@ -80,6 +81,11 @@ func (m *mockLedger) GetTxValidationCodeByTxID(txID string) (peer.TxValidationCo
 	return args.Get(0).(peer.TxValidationCode), args.Error(1)
 }
 
+func (m *mockLedger) GetBlockNumberByTime(t time.Time) (uint64, error) {
+	args := m.Called(t)
+	return args.Get(0).(uint64), args.Error(1)
+}
+
 func (m *mockLedger) NewTxSimulator(txid string) (ledger2.TxSimulator, error) {
 	args := m.Called(txid)
 	return args.Get(0).(ledger2.TxSimulator), args.Error(1)
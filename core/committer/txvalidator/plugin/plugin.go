@@ -6,7 +6,14 @@ SPDX-License-Identifier: Apache-2.0
 
 package plugin
 
-import validation "github.com/hyperledger/fabric/core/handlers/validation/api"
+import (
+	"github.com/hyperledger/fabric/core/handlers/decoration"
+	validation "github.com/hyperledger/fabric/core/handlers/validation/api"
+	decorations "github.com/hyperledger/fabric/core/handlers/validation/api/decorations"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+)
 
 // Name defines the name of the plugin as it appears in the configuration
 type Name string
@@ -17,6 +24,40 @@ type Mapper interface {
 	FactoryByName(name Name) validation.PluginFactory
 }
 
+// DecoratingMapper is a Mapper that also provides the decorators of the
+// chaincode input, whose decorations are made available to the plugins
+type DecoratingMapper interface {
+	Mapper
+	// Decorators returns the decorators of the chaincode input, in the order
+	// they are applied
+	Decorators() []decoration.Decorator
+}
+
+// DecorationsFetcher returns the DecorationsFetcher passed to the plugins,
+// which applies the decorators of the given Mapper if it is a
+// DecoratingMapper, and no decorators otherwise
+func DecorationsFetcher(m Mapper) decorations.DecorationsFetcher {
+	if decoratingMapper, isDecorating := m.(DecoratingMapper); isDecorating {
+		return decorationsFetcher(decoratingMapper.Decorators())
+	}
+	return decorationsFetcher(nil)
+}
+
+type decorationsFetcher []decoration.Decorator
+
+// FetchDecorations returns the decorations of the chaincode input of the
+// transaction at the given position of the block
+func (df decorationsFetcher) FetchDecorations(block *common.Block, txPosition int) (map[string][]byte, error) {
+	if block == nil || block.Data == nil || txPosition < 0 || txPosition >= len(block.Data.Data) {
+		return nil, errors.Errorf("no transaction at position %d of the block", txPosition)
+	}
+	env, err := protoutil.ExtractEnvelope(block, txPosition)
+	if err != nil {
+		return nil, err
+	}
+	return decoration.TransactionDecorations(env, df...)
+}
+
 // MapBasedMapper maps plugin names to their corresponding factories
 type MapBasedMapper map[string]validation.PluginFactory
 
@@ -474,8 +474,9 @@ func (v *TxValidator) checkTxIdDupsLedger(tIdx int, chdr *common.ChannelHeader,
 	_, err := ldgr.GetTransactionByID(txID)
 
 	switch err.(type) {
-	case nil:
-		// invalid case, returned error is nil. It means that there is already a tx in the ledger with the same id
+	case nil, *ledger.ErrTxBeforeSnapshot:
+		// invalid case, returned error is nil or of type ErrTxBeforeSnapshot. It means that there is already a tx
+		// in the ledger with the same id, possibly committed before the snapshot the ledger was created from
 		logger.Error("Duplicate transaction found, ", txID, ", skipping")
 		return &blockValidationResult{
 			tIdx:           tIdx,
@@ -9,7 +9,9 @@ package txvalidator_test
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -33,6 +35,7 @@ import (
 	validation "github.com/hyperledger/fabric/core/handlers/validation/api"
 	"github.com/hyperledger/fabric/core/handlers/validation/builtin"
 	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
 	"github.com/hyperledger/fabric/core/ledger/ledgermgmt"
 	lutils "github.com/hyperledger/fabric/core/ledger/util"
@@ -110,21 +113,23 @@ func setupLedgerAndValidatorExplicitWithMSP(t *testing.T, cpb *mockconfig.MockAp
 	assert.NoError(t, err)
 	theLedger, err := ledgermgmt.CreateLedger(gb)
 	assert.NoError(t, err)
+	return theLedger, newValidatorExplicitWithMSP(theLedger, cpb, plugin, mspMgr)
+}
+
+func newValidatorExplicitWithMSP(theLedger ledger.PeerLedger, cpb *mockconfig.MockApplicationCapabilities, plugin validation.Plugin, mspMgr msp.MSPManager) txvalidator.Validator {
 	mp := (&scc.MocksccProviderFactory{}).NewSystemChaincodeProvider()
 	pm := &mocks.Mapper{}
 	factory := &mocks.PluginFactory{}
 	pm.On("FactoryByName", vp.Name("vscc")).Return(factory)
 	factory.On("New").Return(plugin)
 
-	theValidator := txvalidatorv14.NewTxValidator(
+	return txvalidatorv14.NewTxValidator(
 		"",
 		semaphore.New(10),
 		&mocktxvalidator.Support{LedgerVal: theLedger, ACVal: cpb, MSPManagerVal: mspMgr},
 		mp,
 		pm,
 	)
-
-	return theLedger, theValidator
 }
 
 func createRWset(t *testing.T, ccnames ...string) []byte {
@@ -413,7 +418,7 @@ func testInvokeOK(t *testing.T, l ledger.PeerLedger, v txvalidator.Validator) {
 
 func TestInvokeNoRWSet(t *testing.T) {
 	plugin := &mocks.Plugin{}
-	plugin.On("Init", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	plugin.On("Init", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	t.Run("Pre-1.2Capability", func(t *testing.T) {
 		l, v := setupLedgerAndValidatorExplicit(t, preV12Capabilities(), plugin)
@@ -1534,6 +1539,12 @@ func (m *mockLedger) GetTxValidationCodeByTxID(txID string) (peer.TxValidationCo
 	return args.Get(0).(peer.TxValidationCode), nil
 }
 
+// GetBlockNumberByTime returns the number of the first block created since the given time
+func (m *mockLedger) GetBlockNumberByTime(t time.Time) (uint64, error) {
+	args := m.Called(t)
+	return args.Get(0).(uint64), nil
+}
+
 // NewTxSimulator creates new transaction simulator
 func (m *mockLedger) NewTxSimulator(txid string) (ledger.TxSimulator, error) {
 	args := m.Called()
@@ -1857,6 +1868,58 @@ func TestDuplicateTxId(t *testing.T) {
 	assertion.True(txsfltr.Flag(0) == peer.TxValidationCode_DUPLICATE_TXID)
 }
 
+func TestDuplicateTxIdBeforeSnapshot(t *testing.T) {
+	mspmgr := &mocks2.MSPManager{}
+	idThatSatisfiesPrincipal := &mocks2.Identity{}
+	idThatSatisfiesPrincipal.SatisfiesPrincipalReturns(nil)
+	idThatSatisfiesPrincipal.GetIdentifierReturns(&msp.IdentityIdentifier{})
+	mspmgr.DeserializeIdentityReturns(idThatSatisfiesPrincipal, nil)
+
+	l, v := setupLedgerAndValidatorExplicitWithMSP(t, v13Capabilities(), &builtin.DefaultValidation{}, mspmgr)
+	defer ledgermgmt.CleanupTestEnv()
+
+	ccID := "mycc"
+	putCCInfo(l, ccID, signedByAnyMember([]string{"SampleOrg"}), t)
+	tx := getEnv(ccID, nil, createRWset(t, ccID), t)
+	b := newTestBlock(t, l, tx)
+	assert.NoError(t, v.Validate(b))
+	assertValid(b, t)
+	assert.NoError(t, l.CommitWithPvtData(&ledger.BlockAndPvtData{Block: b}))
+	l.Close()
+	ledgermgmt.Close()
+
+	snapshotDir, err := ioutil.TempDir("", "snapshot")
+	assert.NoError(t, err)
+	defer os.RemoveAll(snapshotDir)
+	snapshotDir = filepath.Join(snapshotDir, "TestLedger")
+	assert.NoError(t, kvledger.GenerateSnapshot("TestLedger", snapshotDir))
+
+	// the transaction replayed on a peer that joined the channel from the snapshot is a duplicate
+	ledgermgmt.InitializeTestEnv()
+	l, _, err = ledgermgmt.CreateLedgerFromSnapshot(snapshotDir)
+	assert.NoError(t, err)
+	defer l.Close()
+	v = newValidatorExplicitWithMSP(l, v13Capabilities(), &builtin.DefaultValidation{}, mspmgr)
+
+	b = newTestBlock(t, l, tx, getEnv(ccID, nil, createRWset(t, ccID), t))
+	assert.NoError(t, v.Validate(b))
+	txsFilter := lutils.TxValidationFlags(b.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER])
+	assert.True(t, txsFilter.IsSetTo(0, peer.TxValidationCode_DUPLICATE_TXID))
+	assert.True(t, txsFilter.IsValid(1))
+}
+
+// newTestBlock returns the block with the given transactions to be committed next to the given ledger
+func newTestBlock(t *testing.T, l ledger.PeerLedger, txs ...*common.Envelope) *common.Block {
+	bcInfo, err := l.GetBlockchainInfo()
+	assert.NoError(t, err)
+	b := protoutil.NewBlock(bcInfo.Height, bcInfo.CurrentBlockHash)
+	for _, tx := range txs {
+		b.Data.Data = append(b.Data.Data, protoutil.MarshalOrPanic(tx))
+	}
+	b.Header.DataHash = protoutil.BlockDataHash(b.Data)
+	return b
+}
+
 func TestValidationInvalidEndorsing(t *testing.T) {
 	theLedger := new(mockLedger)
 	mp := (&scc.MocksccProviderFactory{}).NewSystemChaincodeProvider()
@@ -1864,7 +1927,7 @@ func TestValidationInvalidEndorsing(t *testing.T) {
 	factory := &mocks.PluginFactory{}
 	plugin := &mocks.Plugin{}
 	factory.On("New").Return(plugin)
-	plugin.On("Init", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	plugin.On("Init", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	plugin.On("Validate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("invalid tx"))
 	pm.On("FactoryByName", vp.Name("vscc")).Return(factory)
 	validator := txvalidatorv14.NewTxValidator(
@@ -1924,7 +1987,7 @@ func createMockLedger(t *testing.T, ccID string) *mockLedger {
 
 func TestValidationPluginExecutionError(t *testing.T) {
 	plugin := &mocks.Plugin{}
-	plugin.On("Init", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	plugin.On("Init", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	l, v := setupLedgerAndValidatorExplicit(t, &mockconfig.MockApplicationCapabilities{}, plugin)
 	defer ledgermgmt.CleanupTestEnv()
@@ -57,6 +57,10 @@ type LifecycleResources interface {
 
 var logger = flogging.MustGetLogger("committer.txvalidator")
 
+// tokenNamespace is the namespace of the state of FabToken.  The validation
+// plugin and issuing policy of its token definition validate token transactions.
+const tokenNamespace = "_fabtoken"
+
 // dispatcherImpl is the implementation used to call
 // the validation plugin and validate block transactions
 type dispatcherImpl struct {
@@ -231,6 +235,80 @@ func (v *dispatcherImpl) Dispatch(seq int, payload *common.Payload, envBytes []b
 	return nil, peer.TxValidationCode_VALID
 }
 
+// DispatchToken executes the validation plugin of the token definition for a token transaction.
+// If no token definition has been committed, the transaction is not checked further.
+func (v *dispatcherImpl) DispatchToken(seq int, payload *common.Payload, envBytes []byte, block *common.Block) (error, peer.TxValidationCode) {
+	chdr, err := protoutil.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	if err != nil {
+		return err, peer.TxValidationCode_BAD_CHANNEL_HEADER
+	}
+
+	plugin, policy, defined, err := v.getTokenValidationInfo(chdr.ChannelId)
+	if err != nil {
+		logger.Errorf("Getting the token definition for txId = %s returned error: %+v", chdr.TxId, err)
+		return err, peer.TxValidationCode_INVALID_OTHER_REASON
+	}
+	if !defined {
+		logger.Debugf("[%s] No token definition, not validating token transaction %s with a plugin", v.chainID, chdr.TxId)
+		return nil, peer.TxValidationCode_VALID
+	}
+
+	ctx := &Context{
+		Seq:        seq,
+		Envelope:   envBytes,
+		Block:      block,
+		TxID:       chdr.TxId,
+		Channel:    chdr.ChannelId,
+		Namespace:  tokenNamespace,
+		Policy:     policy,
+		PluginName: plugin,
+	}
+	if err = v.invokeValidationPlugin(ctx); err != nil {
+		switch err.(type) {
+		case *commonerrors.VSCCEndorsementPolicyError:
+			return err, peer.TxValidationCode_ENDORSEMENT_POLICY_FAILURE
+		default:
+			return err, peer.TxValidationCode_INVALID_OTHER_REASON
+		}
+	}
+
+	return nil, peer.TxValidationCode_VALID
+}
+
+// getTokenValidationInfo returns the validation plugin and issuing policy of
+// the token definition, and whether there is a token definition at all
+func (v *dispatcherImpl) getTokenValidationInfo(channelID string) (string, []byte, bool, error) {
+	qe, err := v.ler.NewQueryExecutor()
+	if err != nil {
+		return "", nil, false, errors.WithMessage(err, "could not retrieve QueryExecutor")
+	}
+	defer qe.Done()
+
+	plugin, args, unexpectedErr, validationErr := v.lcr.ValidationInfo(channelID, tokenNamespace, qe)
+	if unexpectedErr != nil {
+		return "", nil, false, &commonerrors.VSCCInfoLookupFailureError{
+			Reason: fmt.Sprintf("Could not retrieve the token definition, error %s", unexpectedErr),
+		}
+	}
+	if validationErr != nil {
+		// Token namespaces can only be defined with the new lifecycle, which
+		// never returns validation errors.  The legacy lifecycle, which is
+		// looked up when the new one does not know the namespace, does
+		// so for undefined names.
+		return "", nil, false, nil
+	}
+
+	if plugin == "" {
+		return "", nil, false, errors.Errorf("token definition for [%s] is invalid, plugin field must be set", tokenNamespace)
+	}
+
+	if len(args) == 0 {
+		return "", nil, false, errors.Errorf("token definition for [%s] is invalid, policy field must be set", tokenNamespace)
+	}
+
+	return plugin, args, true, nil
+}
+
 func (v *dispatcherImpl) invokeValidationPlugin(ctx *Context) error {
 	logger.Debug("Validating", ctx, "with plugin")
 	err := v.pluginValidator.ValidateWithPlugin(ctx)
@@ -161,8 +161,9 @@ func (pbc *pluginsByChannel) initPlugin(plugin validation.Plugin, channel string
 	}
 
 	pe := &PolicyEvaluatorWrapper{IdentityDeserializer: pbc.pv.IdentityDeserializer, PolicyEvaluator: pp}
-	sf := &StateFetcherImpl{QueryExecutorCreator: pbc.pv}
-	if err := plugin.Init(pe, sf, pbc.pv.capabilities); err != nil {
+	sf := &StateFetcherImpl{QueryExecutorCreator: pbc.pv.QueryExecutorCreator}
+	df := vp.DecorationsFetcher(pbc.pv.Mapper)
+	if err := plugin.Init(pe, sf, pbc.pv.capabilities, df); err != nil {
 		return nil, errors.Wrap(err, "failed initializing plugin")
 	}
 	return plugin, nil
@@ -211,6 +212,36 @@ func (sf *StateFetcherImpl) FetchState() (State, error) {
 	return &StateImpl{qe}, nil
 }
 
+// HistoryQueryExecutorCreator creates new history query executors
+type HistoryQueryExecutorCreator interface {
+	NewHistoryQueryExecutor() (ledger.HistoryQueryExecutor, error)
+}
+
+// FetchHistoricalState fetches historical state, if the ledger supports it
+func (sf *StateFetcherImpl) FetchHistoricalState() (HistoricalState, error) {
+	hqec, isHistoryCreator := sf.QueryExecutorCreator.(HistoryQueryExecutorCreator)
+	if !isHistoryCreator {
+		return nil, errors.New("the ledger does not support historical state")
+	}
+	hqe, err := hqec.NewHistoryQueryExecutor()
+	if err != nil {
+		return nil, err
+	}
+	hsqe, isHistoricalState := hqe.(ledger.HistoricalStateQueryExecutor)
+	if !isHistoricalState {
+		return nil, errors.New("the history database does not support historical state")
+	}
+	return &HistoricalStateImpl{hsqe}, nil
+}
+
+type HistoricalStateImpl struct {
+	ledger.HistoricalStateQueryExecutor
+}
+
+// Done releases resources occupied by the HistoricalState
+func (s *HistoricalStateImpl) Done() {
+}
+
 type StateImpl struct {
 	ledger.QueryExecutor
 }
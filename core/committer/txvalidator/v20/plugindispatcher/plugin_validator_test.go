@@ -48,7 +48,7 @@ func TestValidateWithPlugin(t *testing.T) {
 	// Scenario II: The plugin initialization fails
 	factory := &mocks.PluginFactory{}
 	plugin := &mocks.Plugin{}
-	plugin.On("Init", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("foo")).Once()
+	plugin.On("Init", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("foo")).Once()
 	factory.On("New").Return(plugin)
 	pm["vscc"] = factory
 	err = v.ValidateWithPlugin(ctx)
@@ -56,7 +56,7 @@ func TestValidateWithPlugin(t *testing.T) {
 
 	// Scenario III: The plugin initialization succeeds but an execution error occurs.
 	// The plugin should pass the error as is.
-	plugin.On("Init", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	plugin.On("Init", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	validationErr := &validation.ExecutionFailureError{
 		Reason: "bar",
 	}
@@ -65,7 +65,7 @@ func TestValidateWithPlugin(t *testing.T) {
 	assert.Equal(t, validationErr, err)
 
 	// Scenario IV: The plugin initialization succeeds and the validation passes
-	plugin.On("Init", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	plugin.On("Init", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	plugin.On("Validate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	err = v.ValidateWithPlugin(ctx)
 	assert.NoError(t, err)
@@ -40,6 +40,10 @@ func (v *mockDispatcher) Dispatch(seq int, payload *common.Payload, envBytes []b
 	return v.DispatchErr, v.DispatchRv
 }
 
+func (v *mockDispatcher) DispatchToken(seq int, payload *common.Payload, envBytes []byte, block *common.Block) (error, peer.TxValidationCode) {
+	return v.DispatchErr, v.DispatchRv
+}
+
 func testValidationWithNTXes(t *testing.T, nBlocks int) {
 	rwsb := rwsetutil.NewRWSetBuilder()
 	rwsb.AddToWriteSet("ns1", "key1", []byte("value1"))
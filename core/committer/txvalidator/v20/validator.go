@@ -74,6 +74,8 @@ type LedgerResources interface {
 type Dispatcher interface {
 	// Dispatch invokes the appropriate validation plugin for the supplied transaction in the block
 	Dispatch(seq int, payload *common.Payload, envBytes []byte, block *common.Block) (error, peer.TxValidationCode)
+	// DispatchToken invokes the validation plugin of the token definition for the supplied token transaction in the block
+	DispatchToken(seq int, payload *common.Payload, envBytes []byte, block *common.Block) (error, peer.TxValidationCode)
 }
 
 //go:generate mockery -dir . -name ChannelResources -case underscore -output mocks/
@@ -392,6 +394,26 @@ func (v *TxValidator) validateTx(req *blockValidationRequest, results chan<- *bl
 				results <- erroneousResultEntry
 				return
 			}
+
+			// Validate tx with the plugin of the token definition
+			logger.Debug("Validating token transaction with plugins")
+			err, cde := v.Dispatcher.DispatchToken(tIdx, payload, d, block)
+			if err != nil {
+				logger.Errorf("DispatchToken for transaction txId = %s returned error: %s", txID, err)
+				switch err.(type) {
+				case *commonerrors.VSCCExecutionFailureError, *commonerrors.VSCCInfoLookupFailureError:
+					results <- &blockValidationResult{
+						tIdx: tIdx,
+						err:  err,
+					}
+				default:
+					results <- &blockValidationResult{
+						tIdx:           tIdx,
+						validationCode: cde,
+					}
+				}
+				return
+			}
 		} else if common.HeaderType(chdr.Type) == common.HeaderType_CONFIG {
 			configEnvelope, err := configtx.UnmarshalConfigEnvelope(payload.Data)
 			if err != nil {
@@ -463,8 +485,9 @@ func (v *TxValidator) checkTxIdDupsLedger(tIdx int, chdr *common.ChannelHeader,
 	_, err := ldgr.GetTransactionByID(txID)
 
 	switch err.(type) {
-	case nil:
-		// invalid case, returned error is nil. It means that there is already a tx in the ledger with the same id
+	case nil, *ledger.ErrTxBeforeSnapshot:
+		// invalid case, returned error is nil or of type ErrTxBeforeSnapshot. It means that there is already a tx
+		// in the ledger with the same id, possibly committed before the snapshot the ledger was created from
 		logger.Error("Duplicate transaction found, ", txID, ", skipping")
 		return &blockValidationResult{
 			tIdx:           tIdx,
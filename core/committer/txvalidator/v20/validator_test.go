@@ -910,8 +910,68 @@ func TestValidateTxWithStateBasedEndorsement(t *testing.T) {
 }
 
 func TestTokenValidTransaction(t *testing.T) {
-	v, _, _ := setupValidator()
+	v, mockQE, _ := setupValidator()
 	v.ChannelResources.(*mocktxvalidator.Support).ACVal = fabTokenCapabilities()
+	// without a token definition, token transactions are not validated by a plugin
+	mockQE.On("GetState", "lscc", "_fabtoken").Return(nil, nil)
+
+	tx := getTokenTx(t)
+	b := &common.Block{Data: &common.BlockData{Data: [][]byte{protoutil.MarshalOrPanic(tx)}}, Header: &common.BlockHeader{Number: 1}}
+
+	err := v.Validate(b)
+	assert.NoError(t, err)
+	assertValid(b, t)
+}
+
+type tokenLifecycleResources struct {
+	plugin        string
+	policy        []byte
+	unexpectedErr error
+}
+
+func (r *tokenLifecycleResources) ValidationInfo(channelID, chaincodeName string, qe ledger.SimpleQueryExecutor) (string, []byte, error, error) {
+	if chaincodeName != "_fabtoken" {
+		return "", nil, nil, fmt.Errorf("chaincode %s not found", chaincodeName)
+	}
+	return r.plugin, r.policy, r.unexpectedErr, nil
+}
+
+func setupTokenValidator(lcr *tokenLifecycleResources, validationErr error) (*txvalidatorv20.TxValidator, *mocks.Plugin) {
+	mp := (&scc.MocksccProviderFactory{}).NewSystemChaincodeProvider()
+	pm := &mocks.Mapper{}
+	factory := &mocks.PluginFactory{}
+	pm.On("FactoryByName", vp.Name("tvscc")).Return(factory)
+	plugin := &mocks.Plugin{}
+	factory.On("New").Return(plugin)
+	plugin.On("Init", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	plugin.On("Validate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(validationErr)
+
+	mockQE := &mocks3.QueryExecutor{}
+	mockQE.On("Done").Return(nil)
+
+	mockLedger := &mocks3.LedgerResources{}
+	mockLedger.On("GetTransactionByID", mock.Anything).Return(nil, ledger.NotFoundInIndexErr("As idle as a painted ship upon a painted ocean"))
+	mockLedger.On("NewQueryExecutor").Return(mockQE, nil)
+
+	mockCpmg := &mocks.ChannelPolicyManagerGetter{}
+	mockCpmg.On("Manager", mock.Anything).Return(nil, true)
+
+	v := txvalidatorv20.NewTxValidator(
+		"",
+		semaphore.New(10),
+		&mocktxvalidator.Support{ACVal: fabTokenCapabilities(), MSPManagerVal: &mocks2.MSPManager{}},
+		mockLedger,
+		lcr,
+		mp,
+		pm,
+		mockCpmg,
+	)
+
+	return v, plugin
+}
+
+func TestTokenDefinitionValidTransaction(t *testing.T) {
+	v, plugin := setupTokenValidator(&tokenLifecycleResources{plugin: "tvscc", policy: []byte("issuing-policy")}, nil)
 
 	tx := getTokenTx(t)
 	b := &common.Block{Data: &common.BlockData{Data: [][]byte{protoutil.MarshalOrPanic(tx)}}, Header: &common.BlockHeader{Number: 1}}
@@ -919,6 +979,41 @@ func TestTokenValidTransaction(t *testing.T) {
 	err := v.Validate(b)
 	assert.NoError(t, err)
 	assertValid(b, t)
+	plugin.AssertCalled(t, "Validate", b, "_fabtoken", 0, 0, mock.Anything)
+}
+
+func TestTokenDefinitionInvalidTransaction(t *testing.T) {
+	v, _ := setupTokenValidator(&tokenLifecycleResources{plugin: "tvscc", policy: []byte("issuing-policy")}, errors.New("invalid token tx"))
+
+	tx := getTokenTx(t)
+	b := &common.Block{Data: &common.BlockData{Data: [][]byte{protoutil.MarshalOrPanic(tx)}}, Header: &common.BlockHeader{Number: 1}}
+
+	err := v.Validate(b)
+	assert.NoError(t, err)
+	assertInvalid(b, t, peer.TxValidationCode_ENDORSEMENT_POLICY_FAILURE)
+}
+
+func TestTokenDefinitionWithoutPolicy(t *testing.T) {
+	v, plugin := setupTokenValidator(&tokenLifecycleResources{plugin: "tvscc"}, nil)
+
+	tx := getTokenTx(t)
+	b := &common.Block{Data: &common.BlockData{Data: [][]byte{protoutil.MarshalOrPanic(tx)}}, Header: &common.BlockHeader{Number: 1}}
+
+	err := v.Validate(b)
+	assert.NoError(t, err)
+	assertInvalid(b, t, peer.TxValidationCode_INVALID_OTHER_REASON)
+	plugin.AssertNotCalled(t, "Validate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestTokenDefinitionLookupFailure(t *testing.T) {
+	v, _ := setupTokenValidator(&tokenLifecycleResources{unexpectedErr: errors.New("ledger is gone")}, nil)
+
+	tx := getTokenTx(t)
+	b := &common.Block{Data: &common.BlockData{Data: [][]byte{protoutil.MarshalOrPanic(tx)}}, Header: &common.BlockHeader{Number: 1}}
+
+	err := v.Validate(b)
+	assert.IsType(t, &commonerrors.VSCCInfoLookupFailureError{}, err)
+	assert.Contains(t, err.Error(), "Could not retrieve the token definition, error ledger is gone")
 }
 
 func TestTokenCapabilityNotEnabled(t *testing.T) {
@@ -963,6 +1058,29 @@ func TestTokenDuplicateTxId(t *testing.T) {
 	assertion.True(txsfltr.Flag(0) == peer.TxValidationCode_DUPLICATE_TXID)
 }
 
+func TestTokenDuplicateTxIdBeforeSnapshot(t *testing.T) {
+	v, _, _ := setupValidator()
+	v.ChannelResources.(*mocktxvalidator.Support).ACVal = fabTokenCapabilities()
+
+	mockLedger := &mocks3.LedgerResources{}
+	v.LedgerResources = mockLedger
+	mockLedger.On("GetTransactionByID", mock.Anything).Return(nil, &ledger.ErrTxBeforeSnapshot{TxID: "txid", BlockNum: 5})
+
+	tx := getTokenTx(t)
+
+	b := testutil.NewBlock([]*common.Envelope{tx}, 0, nil)
+
+	err := v.Validate(b)
+
+	assertion := assert.New(t)
+	assertion.NoError(err)
+
+	// We expect the tx to be invalid because the txid was committed before the snapshot the ledger was created from
+	txsfltr := lutils.TxValidationFlags(b.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER])
+	assertion.True(txsfltr.IsInvalid(0))
+	assertion.True(txsfltr.Flag(0) == peer.TxValidationCode_DUPLICATE_TXID)
+}
+
 func TestDynamicCapabilitiesAndMSP(t *testing.T) {
 	ccID := "mycc"
 
@@ -1098,7 +1216,7 @@ func TestValidationInvalidEndorsing(t *testing.T) {
 	pm.On("FactoryByName", vp.Name("vscc")).Return(factory)
 	plugin := &mocks.Plugin{}
 	factory.On("New").Return(plugin)
-	plugin.On("Init", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	plugin.On("Init", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	plugin.On("Validate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("invalid tx"))
 
 	mockQE := &mocks3.QueryExecutor{}
@@ -1162,7 +1280,7 @@ func TestValidationPluginExecutionError(t *testing.T) {
 	pm.On("FactoryByName", vp.Name("vscc")).Return(factory)
 	plugin := &mocks.Plugin{}
 	factory.On("New").Return(plugin)
-	plugin.On("Init", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	plugin.On("Init", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	plugin.On("Validate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&validation.ExecutionFailureError{
 		Reason: "I/O error",
 	})
@@ -392,6 +392,10 @@ type CCContext struct {
 	// InitRequired indicates whether the chaincode must have 'Init' invoked
 	// before other transactions can proceed.
 	InitRequired bool
+
+	// ReadYourWrites indicates whether reads of the chaincode should observe
+	// the writes made earlier in the same transaction.
+	ReadYourWrites bool
 }
 
 // GetCanonicalName returns the canonical name associated with the proposal context
@@ -424,6 +428,10 @@ type ChaincodeDefinition interface {
 
 	// RequiresInit indicates whether or not we must enforce Init exactly once semantics
 	RequiresInit() bool
+
+	// ReadYourWrites indicates whether reads of the chaincode should observe
+	// the writes made earlier in the same transaction.
+	ReadYourWrites() bool
 }
 
 //-------- ChaincodeData is stored on the LSCC -------
@@ -492,6 +500,11 @@ func (cd *ChaincodeData) RequiresInit() bool {
 	return true
 }
 
+// ReadYourWrites always returns false as this is the legacy form of chaincode.
+func (cd *ChaincodeData) ReadYourWrites() bool {
+	return false
+}
+
 // implement functions needed from proto.Message for proto's mar/unmarshal functions
 
 // Reset resets
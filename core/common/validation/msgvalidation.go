@@ -8,10 +8,12 @@ package validation
 
 import (
 	"bytes"
+	"sync"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/common/flogging"
+	mspi "github.com/hyperledger/fabric/msp"
 	mspmgmt "github.com/hyperledger/fabric/msp/mgmt"
 	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/msp"
@@ -65,6 +67,10 @@ func validateChaincodeProposalMessage(prop *pb.Proposal, hdr *common.Header) (*p
 // this function returns Header and ChaincodeHeaderExtension messages since they
 // have been unmarshalled and validated
 func ValidateProposalMessage(signedProp *pb.SignedProposal) (*pb.Proposal, *common.Header, *pb.ChaincodeHeaderExtension, error) {
+	return validateProposalMessage(signedProp, checkSignatureFromCreator)
+}
+
+func validateProposalMessage(signedProp *pb.SignedProposal, checkSignature func(creatorBytes []byte, sig []byte, msg []byte, ChainID string) error) (*pb.Proposal, *common.Header, *pb.ChaincodeHeaderExtension, error) {
 	if signedProp == nil {
 		return nil, nil, nil, errors.New("nil arguments")
 	}
@@ -90,7 +96,7 @@ func ValidateProposalMessage(signedProp *pb.SignedProposal) (*pb.Proposal, *comm
 	}
 
 	// validate the signature
-	err = checkSignatureFromCreator(shdr.Creator, signedProp.Signature, signedProp.ProposalBytes, chdr.ChannelId)
+	err = checkSignature(shdr.Creator, signedProp.Signature, signedProp.ProposalBytes, chdr.ChannelId)
 	if err != nil {
 		// log the exact message on the peer but return a generic error message to
 		// avoid malicious users scanning for channels
@@ -149,23 +155,10 @@ func checkSignatureFromCreator(creatorBytes []byte, sig []byte, msg []byte, Chai
 		return errors.New("nil arguments")
 	}
 
-	mspObj := mspmgmt.GetIdentityDeserializer(ChainID)
-	if mspObj == nil {
-		return errors.Errorf("could not get msp for channel [%s]", ChainID)
-	}
-
-	// get the identity of the creator
-	creator, err := mspObj.DeserializeIdentity(creatorBytes)
+	// get the identity of the creator, and ensure that it is a valid certificate
+	creator, err := validateCreator(creatorBytes, ChainID)
 	if err != nil {
-		return errors.WithMessage(err, "MSP error")
-	}
-
-	putilsLogger.Debugf("creator is %s", creator.GetIdentifier())
-
-	// ensure that creator is a valid certificate
-	err = creator.Validate()
-	if err != nil {
-		return errors.WithMessage(err, "creator certificate is not valid")
+		return err
 	}
 
 	putilsLogger.Debugf("creator is valid")
@@ -181,6 +174,87 @@ func checkSignatureFromCreator(creatorBytes []byte, sig []byte, msg []byte, Chai
 	return nil
 }
 
+// CreatorValidator validates the proposals of a batch, deserializing and
+// validating the identity of each creator only once per channel. The
+// signature of each proposal is still verified against the identity of its
+// creator. A CreatorValidator is meant to be used for a single batch, as the
+// validity of an identity may change over time.
+type CreatorValidator struct {
+	mutex    sync.Mutex
+	creators map[creatorKey]*validatedCreator
+}
+
+type creatorKey struct {
+	channel string
+	creator string
+}
+
+type validatedCreator struct {
+	once     sync.Once
+	identity mspi.Identity
+	err      error
+}
+
+// NewCreatorValidator creates a new CreatorValidator
+func NewCreatorValidator() *CreatorValidator {
+	return &CreatorValidator{creators: make(map[creatorKey]*validatedCreator)}
+}
+
+// ValidateProposalMessage checks the validity of a SignedProposal message as
+// ValidateProposalMessage does, reusing the validation of its creator if the
+// creator was already validated for the channel of the proposal
+func (v *CreatorValidator) ValidateProposalMessage(signedProp *pb.SignedProposal) (*pb.Proposal, *common.Header, *pb.ChaincodeHeaderExtension, error) {
+	return validateProposalMessage(signedProp, v.checkSignatureFromCreator)
+}
+
+func (v *CreatorValidator) checkSignatureFromCreator(creatorBytes []byte, sig []byte, msg []byte, ChainID string) error {
+	if creatorBytes == nil || sig == nil || msg == nil {
+		return errors.New("nil arguments")
+	}
+
+	key := creatorKey{channel: ChainID, creator: string(creatorBytes)}
+	v.mutex.Lock()
+	vc, exists := v.creators[key]
+	if !exists {
+		vc = &validatedCreator{}
+		v.creators[key] = vc
+	}
+	v.mutex.Unlock()
+
+	vc.once.Do(func() {
+		vc.identity, vc.err = validateCreator(creatorBytes, ChainID)
+	})
+	if vc.err != nil {
+		return vc.err
+	}
+
+	if err := vc.identity.Verify(msg, sig); err != nil {
+		return errors.WithMessage(err, "creator's signature over the proposal is not valid")
+	}
+	return nil
+}
+
+// validateCreator deserializes the creator with the MSP of the channel, and
+// checks that it is a valid certificate
+func validateCreator(creatorBytes []byte, ChainID string) (mspi.Identity, error) {
+	mspObj := mspmgmt.GetIdentityDeserializer(ChainID)
+	if mspObj == nil {
+		return nil, errors.Errorf("could not get msp for channel [%s]", ChainID)
+	}
+
+	creator, err := mspObj.DeserializeIdentity(creatorBytes)
+	if err != nil {
+		return nil, errors.WithMessage(err, "MSP error")
+	}
+
+	putilsLogger.Debugf("creator is %s", creator.GetIdentifier())
+
+	if err := creator.Validate(); err != nil {
+		return nil, errors.WithMessage(err, "creator certificate is not valid")
+	}
+	return creator, nil
+}
+
 // checks for a valid SignatureHeader
 func validateSignatureHeader(sHdr *common.SignatureHeader) error {
 	// check for nil argument
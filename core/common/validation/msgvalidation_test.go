@@ -191,6 +191,33 @@ func TestValidateProposalMessage(t *testing.T) {
 	assert.Contains(t, err.Error(), fmt.Sprintf("access denied: channel [%s] creator org [%s]", util.GetTestChainID(), signerMSPId))
 }
 
+func TestCreatorValidator(t *testing.T) {
+	v := NewCreatorValidator()
+
+	_, sProp1, err := createTestProposalAndSignedProposal(util.GetTestChainID())
+	assert.NoError(t, err)
+	_, sProp2, err := createTestProposalAndSignedProposal(util.GetTestChainID())
+	assert.NoError(t, err)
+
+	// the creator is validated with the first proposal and reused for the second
+	_, _, _, err = v.ValidateProposalMessage(sProp1)
+	assert.NoError(t, err)
+	_, _, _, err = v.ValidateProposalMessage(sProp2)
+	assert.NoError(t, err)
+	assert.Len(t, v.creators, 1)
+
+	// the signature of each proposal is still verified
+	_, _, _, err = v.ValidateProposalMessage(&peer.SignedProposal{ProposalBytes: sProp2.ProposalBytes, Signature: sProp1.Signature})
+	assert.EqualError(t, err, fmt.Sprintf("access denied: channel [%s] creator org [%s]", util.GetTestChainID(), signerMSPId))
+
+	// the creator is validated again for another channel
+	_, sProp3, err := createTestProposalAndSignedProposal("fakechannel")
+	assert.NoError(t, err)
+	_, _, _, err = v.ValidateProposalMessage(sProp3)
+	assert.EqualError(t, err, fmt.Sprintf("access denied: channel [fakechannel] creator org [%s]", signerMSPId))
+	assert.Len(t, v.creators, 2)
+}
+
 func TestValidateTokenTransaction(t *testing.T) {
 	tokenTx := getTokenTransaction()
 	txBytes := protoMarshal(t, tokenTx)
@@ -51,6 +51,9 @@ type DockerVM struct {
 	PeerID       string
 	NetworkID    string
 	BuildMetrics *BuildMetrics
+	// StatsCollector, if set, collects the stats of the containers started
+	// by the DockerVM
+	StatsCollector *StatsCollector
 }
 
 // dockerClient represents a docker client
@@ -85,27 +88,34 @@ type dockerClient interface {
 	// WaitContainer blocks until the given container stops, and returns the exit
 	// code of the container status.
 	WaitContainer(containerID string) (int, error)
+	// Stats sends the resource usage statistics of a docker container to the
+	// channel of the options, and closes the channel when done.
+	Stats(opts docker.StatsOptions) error
 }
 
 // Provider implements container.VMProvider
 type Provider struct {
-	PeerID       string
-	NetworkID    string
-	BuildMetrics *BuildMetrics
+	PeerID         string
+	NetworkID      string
+	BuildMetrics   *BuildMetrics
+	StatsCollector *StatsCollector
 }
 
 // NewProvider creates a new instance of Provider
 func NewProvider(peerID, networkID string, metricsProvider metrics.Provider) *Provider {
 	return &Provider{
-		PeerID:       peerID,
-		NetworkID:    networkID,
-		BuildMetrics: NewBuildMetrics(metricsProvider),
+		PeerID:         peerID,
+		NetworkID:      networkID,
+		BuildMetrics:   NewBuildMetrics(metricsProvider),
+		StatsCollector: NewStatsCollector(NewContainerMetrics(metricsProvider)),
 	}
 }
 
 // NewVM creates a new DockerVM instance
 func (p *Provider) NewVM() container.VM {
-	return NewDockerVM(p.PeerID, p.NetworkID, p.BuildMetrics)
+	vm := NewDockerVM(p.PeerID, p.NetworkID, p.BuildMetrics)
+	vm.StatsCollector = p.StatsCollector
+	return vm
 }
 
 // NewDockerVM returns a new DockerVM instance
@@ -308,6 +318,10 @@ func (vm *DockerVM) Start(ccid ccintf.CCID, args, env []string, filesToUpload ma
 		return err
 	}
 
+	if vm.StatsCollector != nil {
+		vm.StatsCollector.Track(containerName, ccid)
+	}
+
 	dockerLogger.Debugf("Started container %s", containerName)
 	return nil
 }
@@ -381,6 +395,10 @@ func (vm *DockerVM) Stop(ccid ccintf.CCID, timeout uint, dontkill bool, dontremo
 	}
 	id := vm.ccidToContainerID(ccid)
 
+	if vm.StatsCollector != nil {
+		vm.StatsCollector.Untrack(id)
+	}
+
 	return vm.stopInternal(client, id, timeout, dontkill, dontremove)
 }
 
@@ -439,6 +439,7 @@ type mockClient struct {
 	waitErr     error
 
 	attachToContainerStub func(docker.AttachToContainerOptions) error
+	statsStub             func(docker.StatsOptions) error
 }
 
 var getClientErr, createErr, uploadErr, noSuchImgErr, buildErr, removeImgErr,
@@ -525,3 +526,11 @@ func (c *mockClient) WaitContainer(id string) (int, error) {
 	c.containerID = id
 	return c.exitCode, c.waitErr
 }
+
+func (c *mockClient) Stats(opts docker.StatsOptions) error {
+	defer close(opts.Stats)
+	if c.statsStub != nil {
+		return c.statsStub(opts)
+	}
+	return nil
+}
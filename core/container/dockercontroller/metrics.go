@@ -16,6 +16,35 @@ var (
 		LabelNames:   []string{"chaincode", "success"},
 		StatsdFormat: "%{#fqname}.%{chaincode}.%{success}",
 	}
+
+	chaincodeContainerCPUUsage = metrics.GaugeOpts{
+		Namespace:    "dockercontroller",
+		Name:         "chaincode_container_cpu_usage",
+		Help:         "The total CPU time consumed by a chaincode container in seconds.",
+		LabelNames:   []string{"chaincode"},
+		StatsdFormat: "%{#fqname}.%{chaincode}",
+	}
+	chaincodeContainerMemoryUsage = metrics.GaugeOpts{
+		Namespace:    "dockercontroller",
+		Name:         "chaincode_container_memory_usage",
+		Help:         "The memory used by a chaincode container in bytes.",
+		LabelNames:   []string{"chaincode"},
+		StatsdFormat: "%{#fqname}.%{chaincode}",
+	}
+	chaincodeContainerNetworkReceived = metrics.GaugeOpts{
+		Namespace:    "dockercontroller",
+		Name:         "chaincode_container_network_received_bytes",
+		Help:         "The total number of bytes received by a chaincode container.",
+		LabelNames:   []string{"chaincode"},
+		StatsdFormat: "%{#fqname}.%{chaincode}",
+	}
+	chaincodeContainerNetworkTransmitted = metrics.GaugeOpts{
+		Namespace:    "dockercontroller",
+		Name:         "chaincode_container_network_transmitted_bytes",
+		Help:         "The total number of bytes transmitted by a chaincode container.",
+		LabelNames:   []string{"chaincode"},
+		StatsdFormat: "%{#fqname}.%{chaincode}",
+	}
 )
 
 type BuildMetrics struct {
@@ -27,3 +56,19 @@ func NewBuildMetrics(p metrics.Provider) *BuildMetrics {
 		ChaincodeImageBuildDuration: p.NewHistogram(chaincodeImageBuildDuration),
 	}
 }
+
+type ContainerMetrics struct {
+	CPUUsage           metrics.Gauge
+	MemoryUsage        metrics.Gauge
+	NetworkReceived    metrics.Gauge
+	NetworkTransmitted metrics.Gauge
+}
+
+func NewContainerMetrics(p metrics.Provider) *ContainerMetrics {
+	return &ContainerMetrics{
+		CPUUsage:           p.NewGauge(chaincodeContainerCPUUsage),
+		MemoryUsage:        p.NewGauge(chaincodeContainerMemoryUsage),
+		NetworkReceived:    p.NewGauge(chaincodeContainerNetworkReceived),
+		NetworkTransmitted: p.NewGauge(chaincodeContainerNetworkTransmitted),
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package dockercontroller
+
+import (
+	"sync"
+	"time"
+
+	docker "github.com/fsouza/go-dockerclient"
+	"github.com/hyperledger/fabric/core/container/ccintf"
+	"github.com/pkg/errors"
+)
+
+// StatsCollector periodically collects the resource usage of the running
+// chaincode containers from the docker daemon, and exports it as metrics
+// labeled by the name and version of the chaincodes.
+type StatsCollector struct {
+	getClientFnc getClient
+	metrics      *ContainerMetrics
+
+	mutex      sync.Mutex
+	containers map[string]ccintf.CCID
+}
+
+// NewStatsCollector creates a StatsCollector which exports the stats of the
+// containers to the given metrics.
+func NewStatsCollector(containerMetrics *ContainerMetrics) *StatsCollector {
+	return &StatsCollector{
+		getClientFnc: getDockerClient,
+		metrics:      containerMetrics,
+		containers:   map[string]ccintf.CCID{},
+	}
+}
+
+// Track adds the container of the given chaincode to the containers whose
+// stats are collected.
+func (s *StatsCollector) Track(containerID string, ccid ccintf.CCID) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.containers[containerID] = ccid
+}
+
+// Untrack removes the container from the containers whose stats are
+// collected.
+func (s *StatsCollector) Untrack(containerID string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.containers, containerID)
+}
+
+// Run collects the stats of the containers at the given interval until the
+// done channel is closed.
+func (s *StatsCollector) Run(interval time.Duration, done <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.Collect(interval)
+
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Collect collects the stats of the tracked containers once, waiting up to
+// the given timeout for the stats of each container.
+func (s *StatsCollector) Collect(timeout time.Duration) {
+	s.mutex.Lock()
+	containers := make(map[string]ccintf.CCID, len(s.containers))
+	for id, ccid := range s.containers {
+		containers[id] = ccid
+	}
+	s.mutex.Unlock()
+
+	if len(containers) == 0 {
+		return
+	}
+
+	client, err := s.getClientFnc()
+	if err != nil {
+		dockerLogger.Warningf("Failed getting docker client to collect container stats: %s", err)
+		return
+	}
+
+	for id, ccid := range containers {
+		stats, err := containerStats(client, id, timeout)
+		if err != nil {
+			dockerLogger.Debugf("Failed collecting stats of container %s: %s", id, err)
+			continue
+		}
+		s.export(ccid, stats)
+	}
+}
+
+func (s *StatsCollector) export(ccid ccintf.CCID, stats *docker.Stats) {
+	chaincode := ccid.Name + ":" + ccid.Version
+
+	var received, transmitted uint64
+	if len(stats.Networks) == 0 {
+		received, transmitted = stats.Network.RxBytes, stats.Network.TxBytes
+	}
+	for _, network := range stats.Networks {
+		received += network.RxBytes
+		transmitted += network.TxBytes
+	}
+
+	s.metrics.CPUUsage.With("chaincode", chaincode).Set(float64(stats.CPUStats.CPUUsage.TotalUsage) / float64(time.Second))
+	s.metrics.MemoryUsage.With("chaincode", chaincode).Set(float64(stats.MemoryStats.Usage))
+	s.metrics.NetworkReceived.With("chaincode", chaincode).Set(float64(received))
+	s.metrics.NetworkTransmitted.With("chaincode", chaincode).Set(float64(transmitted))
+}
+
+// containerStats returns a single sample of the stats of the container.
+func containerStats(client dockerClient, containerID string, timeout time.Duration) (*docker.Stats, error) {
+	statsC := make(chan *docker.Stats)
+	errC := make(chan error, 1)
+	go func() {
+		errC <- client.Stats(docker.StatsOptions{
+			ID:      containerID,
+			Stats:   statsC,
+			Stream:  false,
+			Timeout: timeout,
+		})
+	}()
+
+	// the channel is closed by the client once the stats are received
+	var stats *docker.Stats
+	for s := range statsC {
+		stats = s
+	}
+	if err := <-errC; err != nil {
+		return nil, err
+	}
+	if stats == nil {
+		return nil, errors.Errorf("no stats received for container %s", containerID)
+	}
+	return stats, nil
+}
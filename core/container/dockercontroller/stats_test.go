@@ -0,0 +1,137 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package dockercontroller
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	docker "github.com/fsouza/go-dockerclient"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
+	"github.com/hyperledger/fabric/common/metrics/metricsfakes"
+	"github.com/hyperledger/fabric/core/container/ccintf"
+	"github.com/stretchr/testify/assert"
+)
+
+func newFakeContainerMetrics() *ContainerMetrics {
+	newGauge := func() *metricsfakes.Gauge {
+		gauge := &metricsfakes.Gauge{}
+		gauge.WithReturns(gauge)
+		return gauge
+	}
+	return &ContainerMetrics{
+		CPUUsage:           newGauge(),
+		MemoryUsage:        newGauge(),
+		NetworkReceived:    newGauge(),
+		NetworkTransmitted: newGauge(),
+	}
+}
+
+func TestStatsCollector(t *testing.T) {
+	containerMetrics := newFakeContainerMetrics()
+	client := &mockClient{}
+	var requested []string
+	client.statsStub = func(opts docker.StatsOptions) error {
+		requested = append(requested, opts.ID)
+		assert.False(t, opts.Stream)
+		assert.Equal(t, time.Second, opts.Timeout)
+		if opts.ID == "failing" {
+			return errors.New("no-stats-for-you")
+		}
+		stats := &docker.Stats{}
+		stats.CPUStats.CPUUsage.TotalUsage = 2500000000
+		stats.MemoryStats.Usage = 1024
+		stats.Networks = map[string]docker.NetworkStats{
+			"eth0": {RxBytes: 100, TxBytes: 10},
+			"eth1": {RxBytes: 200, TxBytes: 20},
+		}
+		opts.Stats <- stats
+		return nil
+	}
+
+	collector := NewStatsCollector(containerMetrics)
+	collector.getClientFnc = func() (dockerClient, error) { return client, nil }
+
+	// no container is tracked
+	collector.Collect(time.Second)
+	assert.Empty(t, requested)
+
+	collector.Track("cc-container", ccintf.CCID{Name: "mycc", Version: "1.0"})
+	collector.Track("failing", ccintf.CCID{Name: "othercc", Version: "2.0"})
+	collector.Collect(time.Second)
+	assert.ElementsMatch(t, []string{"cc-container", "failing"}, requested)
+
+	cpuUsage := containerMetrics.CPUUsage.(*metricsfakes.Gauge)
+	assert.Equal(t, 1, cpuUsage.WithCallCount())
+	assert.Equal(t, []string{"chaincode", "mycc:1.0"}, cpuUsage.WithArgsForCall(0))
+	assert.Equal(t, 2.5, cpuUsage.SetArgsForCall(0))
+	memoryUsage := containerMetrics.MemoryUsage.(*metricsfakes.Gauge)
+	assert.Equal(t, []string{"chaincode", "mycc:1.0"}, memoryUsage.WithArgsForCall(0))
+	assert.Equal(t, float64(1024), memoryUsage.SetArgsForCall(0))
+	networkReceived := containerMetrics.NetworkReceived.(*metricsfakes.Gauge)
+	assert.Equal(t, float64(300), networkReceived.SetArgsForCall(0))
+	networkTransmitted := containerMetrics.NetworkTransmitted.(*metricsfakes.Gauge)
+	assert.Equal(t, float64(30), networkTransmitted.SetArgsForCall(0))
+
+	// untracked containers are no longer collected
+	requested = nil
+	collector.Untrack("failing")
+	collector.Collect(time.Second)
+	assert.Equal(t, []string{"cc-container"}, requested)
+
+	// nothing is collected without a docker client
+	requested = nil
+	collector.getClientFnc = func() (dockerClient, error) { return nil, errors.New("gorilla-goo") }
+	collector.Collect(time.Second)
+	assert.Empty(t, requested)
+}
+
+func TestStatsCollectorLegacyNetworkStats(t *testing.T) {
+	containerMetrics := newFakeContainerMetrics()
+	client := &mockClient{
+		statsStub: func(opts docker.StatsOptions) error {
+			stats := &docker.Stats{}
+			stats.Network.RxBytes = 42
+			stats.Network.TxBytes = 24
+			opts.Stats <- stats
+			return nil
+		},
+	}
+	collector := NewStatsCollector(containerMetrics)
+	collector.getClientFnc = func() (dockerClient, error) { return client, nil }
+	collector.Track("cc-container", ccintf.CCID{Name: "mycc", Version: "1.0"})
+
+	done := make(chan struct{})
+	close(done)
+	collector.Run(time.Minute, done)
+
+	assert.Equal(t, float64(42), containerMetrics.NetworkReceived.(*metricsfakes.Gauge).SetArgsForCall(0))
+	assert.Equal(t, float64(24), containerMetrics.NetworkTransmitted.(*metricsfakes.Gauge).SetArgsForCall(0))
+}
+
+func TestStatsCollectorTracksStartedContainers(t *testing.T) {
+	collector := NewStatsCollector(newFakeContainerMetrics())
+	dvm := &DockerVM{
+		PeerID:         "peer",
+		NetworkID:      "net",
+		BuildMetrics:   NewBuildMetrics(&disabled.Provider{}),
+		StatsCollector: collector,
+	}
+	dvm.getClientFnc = getMockClient
+	ccid := ccintf.CCID{Name: "mycc", Version: "1.0"}
+
+	err := dvm.Start(ccid, nil, nil, nil, &mockBuilder{buildFunc: func() (io.Reader, error) { return &bytes.Buffer{}, nil }})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]ccintf.CCID{"net-peer-mycc-1.0": ccid}, collector.containers)
+
+	err = dvm.Stop(ccid, 10, true, true)
+	assert.NoError(t, err)
+	assert.Empty(t, collector.containers)
+}
@@ -0,0 +1,138 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kubernetescontroller
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const serviceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+
+// apiError is returned for the requests the Kubernetes API server rejects
+type apiError struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Message    string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("kubernetes API request %s %s failed with status %d: %s", e.Method, e.Path, e.StatusCode, e.Message)
+}
+
+func isNotFound(err error) bool {
+	apiErr, ok := errors.Cause(err).(*apiError)
+	return ok && apiErr.StatusCode == http.StatusNotFound
+}
+
+// client is a minimal client of the REST API of Kubernetes
+type client struct {
+	endpoint   string
+	tokenFile  string
+	httpClient *http.Client
+}
+
+func newClient(config Config) (*client, error) {
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+		if host == "" || port == "" {
+			return nil, errors.New("no Kubernetes API endpoint is configured and the peer is not running in a Kubernetes cluster")
+		}
+		endpoint = "https://" + host + ":" + port
+	}
+
+	tlsConfig := &tls.Config{}
+	caFile := config.CAFile
+	if caFile == "" && config.Endpoint == "" {
+		caFile = serviceAccountDir + "/ca.crt"
+	}
+	if caFile != "" {
+		caPEM, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed reading the CA certificate of the Kubernetes API server")
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caPEM) {
+			return nil, errors.Errorf("no CA certificate found in %s", caFile)
+		}
+	}
+
+	tokenFile := config.TokenFile
+	if tokenFile == "" && config.Endpoint == "" {
+		tokenFile = serviceAccountDir + "/token"
+	}
+
+	return &client{
+		endpoint:  strings.TrimSuffix(endpoint, "/"),
+		tokenFile: tokenFile,
+		httpClient: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+	}, nil
+}
+
+// do sends a request to the API server, and decodes the response into out,
+// if not nil
+func (c *client) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		payload, err := json.Marshal(in)
+		if err != nil {
+			return errors.Wrap(err, "failed marshaling request")
+		}
+		body = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequest(method, c.endpoint+path, body)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.tokenFile != "" {
+		// the token is read for each request, as it may be rotated
+		token, err := ioutil.ReadFile(c.tokenFile)
+		if err != nil {
+			return errors.Wrap(err, "failed reading the Kubernetes API token")
+		}
+		req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "kubernetes API request %s %s failed", method, path)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "failed reading the response of kubernetes API request %s %s", method, path)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &apiError{Method: method, Path: path, StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(respBody))}
+	}
+	if out == nil {
+		return nil
+	}
+	return errors.Wrapf(json.Unmarshal(respBody, out), "failed unmarshaling the response of kubernetes API request %s %s", method, path)
+}
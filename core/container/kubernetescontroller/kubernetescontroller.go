@@ -0,0 +1,322 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kubernetescontroller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/core/container"
+	"github.com/hyperledger/fabric/core/container/ccintf"
+	"github.com/pkg/errors"
+)
+
+// ContainerType is the string which the kubernetes container type
+// is registered with the container.VMController
+const ContainerType = "KUBERNETES"
+
+// DefaultPollInterval is the interval at which the status of a chaincode
+// job is polled while waiting for it to terminate, if not configured
+const DefaultPollInterval = time.Second
+
+var (
+	kubernetesLogger = flogging.MustGetLogger("kubernetescontroller")
+	invalidName      = regexp.MustCompile("[^a-z0-9-]+")
+	invalidImage     = regexp.MustCompile("[^a-z0-9._-]+")
+)
+
+// Config configures how the chaincode jobs are created
+type Config struct {
+	// Endpoint is the URL of the Kubernetes API server. If empty, the
+	// in-cluster endpoint and service account credentials are used.
+	Endpoint string
+	// TokenFile is the file holding the bearer token of the API requests
+	TokenFile string
+	// CAFile is the file holding the CA certificates of the API server
+	CAFile string
+	// Namespace is the namespace of the chaincode jobs. If empty, the
+	// namespace of the service account of the peer is used, or "default".
+	Namespace string
+	// ImageRegistry is the registry the chaincode images are pulled from
+	ImageRegistry string
+	// ImagePullSecrets are the names of the secrets used to pull the images
+	ImagePullSecrets []string
+	// Limits and Requests are the resource limits and requests of the
+	// chaincode containers, such as "cpu" and "memory"
+	Limits   map[string]string
+	Requests map[string]string
+	// PollInterval is the interval at which the status of a job is polled
+	// while waiting for it to terminate, or DefaultPollInterval if not positive
+	PollInterval time.Duration
+}
+
+// Provider implements container.VMProvider
+type Provider struct {
+	PeerID    string
+	NetworkID string
+	Config    Config
+	client    *client
+}
+
+// NewProvider creates a new instance of Provider
+func NewProvider(peerID, networkID string, config Config) (*Provider, error) {
+	client, err := newClient(config)
+	if err != nil {
+		return nil, err
+	}
+	if config.Namespace == "" {
+		config.Namespace = "default"
+		if namespace, err := ioutil.ReadFile(serviceAccountDir + "/namespace"); err == nil {
+			config.Namespace = strings.TrimSpace(string(namespace))
+		}
+	}
+	return &Provider{
+		PeerID:    peerID,
+		NetworkID: networkID,
+		Config:    config,
+		client:    client,
+	}, nil
+}
+
+// NewVM creates a new KubernetesVM instance
+func (p *Provider) NewVM() container.VM {
+	return &KubernetesVM{
+		PeerID:    p.PeerID,
+		NetworkID: p.NetworkID,
+		Config:    p.Config,
+		client:    p.client,
+	}
+}
+
+// KubernetesVM is a vm that runs each chaincode as a Kubernetes job. The
+// images of the chaincodes are not built by the peer, but pulled from the
+// configured image registry, where they are named after the chaincode and
+// tagged with the hash of its code package.
+type KubernetesVM struct {
+	PeerID    string
+	NetworkID string
+	Config    Config
+	client    *client
+}
+
+// GetVMName returns the name of the job and secret of the chaincode, which
+// is a DNS label unique to the network, peer and chaincode
+func (vm *KubernetesVM) GetVMName(ccid ccintf.CCID) string {
+	name := ccid.GetName()
+	if vm.PeerID != "" {
+		name = vm.PeerID + "-" + name
+	}
+	if vm.NetworkID != "" {
+		name = vm.NetworkID + "-" + name
+	}
+	hash := sha256.Sum256([]byte(name))
+	saniName := strings.Trim(invalidName.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if len(saniName) > 54 {
+		saniName = strings.TrimRight(saniName[:54], "-")
+	}
+	return fmt.Sprintf("%s-%s", saniName, hex.EncodeToString(hash[:4]))
+}
+
+// GetImageName returns the image of the chaincode, which is tagged with the
+// hex encoded SHA-256 hash of its code package, so that the chaincode runs
+// the code installed on the peer rather than whatever image was pushed last
+func (vm *KubernetesVM) GetImageName(ccid ccintf.CCID, codePackage []byte) string {
+	image := invalidImage.ReplaceAllString(strings.ToLower(ccid.Name), "-")
+	if vm.Config.ImageRegistry != "" {
+		image = strings.TrimSuffix(vm.Config.ImageRegistry, "/") + "/" + image
+	}
+	hash := sha256.Sum256(codePackage)
+	return image + ":" + hex.EncodeToString(hash[:])
+}
+
+// Start creates the job of the chaincode, replacing any previous job of the
+// chaincode. The files to upload are mounted from a secret. The builder is
+// not used to build the image, which is pulled from the image registry, but
+// only to look up the code package the image is tagged after.
+func (vm *KubernetesVM) Start(ccid ccintf.CCID, args, env []string, filesToUpload map[string][]byte, builder container.Builder) error {
+	pb, ok := builder.(*container.PlatformBuilder)
+	if !ok {
+		return errors.Errorf("the code package of chaincode %s is required to look up its image", ccid.GetName())
+	}
+	name := vm.GetVMName(ccid)
+	image := vm.GetImageName(ccid, pb.CodePackage)
+	logger := kubernetesLogger.With("jobName", name, "image", image)
+	ctx := context.Background()
+
+	vm.stop(ctx, name, nil)
+
+	labels := map[string]string{
+		"app.kubernetes.io/managed-by": "hyperledger-fabric-peer",
+		"hyperledger.org/chaincode":    name,
+	}
+	c := containerSpec{
+		Name:  "chaincode",
+		Image: image,
+		Args:  args,
+		Resources: resourceRequirements{
+			Limits:   vm.Config.Limits,
+			Requests: vm.Config.Requests,
+		},
+	}
+	for _, e := range env {
+		kv := strings.SplitN(e, "=", 2)
+		if len(kv) == 1 {
+			kv = append(kv, "")
+		}
+		c.Env = append(c.Env, envVar{Name: kv[0], Value: kv[1]})
+	}
+	spec := podSpec{RestartPolicy: "Never"}
+	for _, s := range vm.Config.ImagePullSecrets {
+		spec.ImagePullSecrets = append(spec.ImagePullSecrets, localObjectReference{Name: s})
+	}
+
+	if len(filesToUpload) != 0 {
+		paths := make([]string, 0, len(filesToUpload))
+		for path := range filesToUpload {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		s := &secret{
+			APIVersion: "v1",
+			Kind:       "Secret",
+			Metadata:   objectMeta{Name: name, Namespace: vm.Config.Namespace, Labels: labels},
+			Data:       make(map[string][]byte),
+		}
+		for i, path := range paths {
+			key := fmt.Sprintf("file%d", i)
+			s.Data[key] = filesToUpload[path]
+			c.VolumeMounts = append(c.VolumeMounts, volumeMount{Name: "files", MountPath: path, SubPath: key, ReadOnly: true})
+		}
+		logger.Debugw("creating secret")
+		if err := vm.client.do(ctx, http.MethodPost, vm.secretsPath(), s, nil); err != nil {
+			return errors.WithMessage(err, "failed creating the secret of the chaincode")
+		}
+		spec.Volumes = []volume{{Name: "files", Secret: secretVolumeSource{SecretName: name}}}
+	}
+	spec.Containers = []containerSpec{c}
+
+	j := &job{
+		APIVersion: "batch/v1",
+		Kind:       "Job",
+		Metadata:   objectMeta{Name: name, Namespace: vm.Config.Namespace, Labels: labels},
+		Spec: jobSpec{
+			BackoffLimit: 0,
+			Template: podTemplateSpec{
+				Metadata: objectMeta{Labels: labels},
+				Spec:     spec,
+			},
+		},
+	}
+	logger.Debugw("creating job")
+	if err := vm.client.do(ctx, http.MethodPost, vm.jobsPath(), j, nil); err != nil {
+		return errors.WithMessage(err, "failed creating the job of the chaincode")
+	}
+	logger.Debugw("created job")
+	return nil
+}
+
+// Stop deletes the job of the chaincode, along with its pod and secret. The
+// pod is given timeout seconds to terminate, regardless of dontkill, and
+// dontremove has no effect, since the job cannot be stopped but deleted.
+func (vm *KubernetesVM) Stop(ccid ccintf.CCID, timeout uint, dontkill bool, dontremove bool) error {
+	gracePeriod := int64(timeout)
+	return vm.stop(context.Background(), vm.GetVMName(ccid), &gracePeriod)
+}
+
+func (vm *KubernetesVM) stop(ctx context.Context, name string, gracePeriod *int64) error {
+	logger := kubernetesLogger.With("jobName", name)
+	logger.Debugw("deleting job")
+	opts := &deleteOptions{
+		APIVersion:         "v1",
+		Kind:               "DeleteOptions",
+		GracePeriodSeconds: gracePeriod,
+		PropagationPolicy:  "Background",
+	}
+	err := vm.client.do(ctx, http.MethodDelete, vm.jobsPath()+"/"+name, opts, nil)
+	if err != nil && !isNotFound(err) {
+		logger.Debugw("delete job result", "error", err)
+		return err
+	}
+	err = vm.client.do(ctx, http.MethodDelete, vm.secretsPath()+"/"+name, nil, nil)
+	if err != nil && !isNotFound(err) {
+		logger.Debugw("delete secret result", "error", err)
+		return err
+	}
+	return nil
+}
+
+// Wait blocks until the job of the chaincode terminates and returns the exit
+// code of the chaincode container.
+func (vm *KubernetesVM) Wait(ccid ccintf.CCID) (int, error) {
+	name := vm.GetVMName(ccid)
+	pollInterval := vm.Config.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DefaultPollInterval
+	}
+	ctx := context.Background()
+	for {
+		j := &job{}
+		if err := vm.client.do(ctx, http.MethodGet, vm.jobsPath()+"/"+name, nil, j); err != nil {
+			return 0, err
+		}
+		if j.Status.Succeeded > 0 {
+			return 0, nil
+		}
+		if j.Status.Failed > 0 {
+			return vm.exitCode(ctx, name)
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// exitCode returns the exit code of the terminated container of the job
+func (vm *KubernetesVM) exitCode(ctx context.Context, name string) (int, error) {
+	pods := &podList{}
+	path := vm.podsPath() + "?labelSelector=" + url.QueryEscape("job-name="+name)
+	if err := vm.client.do(ctx, http.MethodGet, path, nil, pods); err != nil {
+		return 0, err
+	}
+	for _, p := range pods.Items {
+		for _, status := range p.Status.ContainerStatuses {
+			if status.State.Terminated != nil {
+				return int(status.State.Terminated.ExitCode), nil
+			}
+		}
+	}
+	return 0, errors.Errorf("job %s failed without a terminated container", name)
+}
+
+// HealthCheck checks if the KubernetesVM is able to list the jobs of its
+// namespace.
+func (vm *KubernetesVM) HealthCheck(ctx context.Context) error {
+	if err := vm.client.do(ctx, http.MethodGet, vm.jobsPath()+"?limit=1", nil, nil); err != nil {
+		return errors.WithMessage(err, "failed to list jobs with the Kubernetes API")
+	}
+	return nil
+}
+
+func (vm *KubernetesVM) jobsPath() string {
+	return "/apis/batch/v1/namespaces/" + vm.Config.Namespace + "/jobs"
+}
+
+func (vm *KubernetesVM) secretsPath() string {
+	return "/api/v1/namespaces/" + vm.Config.Namespace + "/secrets"
+}
+
+func (vm *KubernetesVM) podsPath() string {
+	return "/api/v1/namespaces/" + vm.Config.Namespace + "/pods"
+}
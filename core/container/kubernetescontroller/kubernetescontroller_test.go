@@ -0,0 +1,245 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kubernetescontroller
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/core/container"
+	"github.com/hyperledger/fabric/core/container/ccintf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAPIServer serves the jobs, secrets and pods of the namespace "fabric"
+type fakeAPIServer struct {
+	mutex    sync.Mutex
+	jobs     map[string]*job
+	secrets  map[string]*secret
+	pods     podList
+	requests []string
+	auth     []string
+}
+
+func newFakeAPIServer() *fakeAPIServer {
+	return &fakeAPIServer{
+		jobs:    make(map[string]*job),
+		secrets: make(map[string]*secret),
+	}
+}
+
+func (s *fakeAPIServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	s.auth = append(s.auth, r.Header.Get("Authorization"))
+
+	const jobs, secrets, pods = "/apis/batch/v1/namespaces/fabric/jobs", "/api/v1/namespaces/fabric/secrets", "/api/v1/namespaces/fabric/pods"
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == jobs:
+		j := &job{}
+		json.NewDecoder(r.Body).Decode(j)
+		s.jobs[j.Metadata.Name] = j
+	case r.Method == http.MethodPost && r.URL.Path == secrets:
+		sec := &secret{}
+		json.NewDecoder(r.Body).Decode(sec)
+		s.secrets[sec.Metadata.Name] = sec
+	case r.Method == http.MethodGet && r.URL.Path == jobs:
+		w.Write([]byte(`{"items":[]}`))
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, jobs+"/"):
+		j, exists := s.jobs[strings.TrimPrefix(r.URL.Path, jobs+"/")]
+		if !exists {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(j)
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, jobs+"/"):
+		name := strings.TrimPrefix(r.URL.Path, jobs+"/")
+		if _, exists := s.jobs[name]; !exists {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		delete(s.jobs, name)
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, secrets+"/"):
+		name := strings.TrimPrefix(r.URL.Path, secrets+"/")
+		if _, exists := s.secrets[name]; !exists {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		delete(s.secrets, name)
+	case r.Method == http.MethodGet && r.URL.Path == pods:
+		json.NewEncoder(w).Encode(s.pods)
+	default:
+		http.Error(w, "forbidden", http.StatusForbidden)
+	}
+}
+
+func (s *fakeAPIServer) setJobStatus(name string, status jobStatus) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.jobs[name].Status = status
+}
+
+func newTestVM(t *testing.T, server *httptest.Server) (*KubernetesVM, func()) {
+	tempDir, err := ioutil.TempDir("", "kubernetescontroller")
+	require.NoError(t, err)
+	tokenFile := filepath.Join(tempDir, "token")
+	require.NoError(t, ioutil.WriteFile(tokenFile, []byte("secret-token\n"), 0600))
+
+	p, err := NewProvider("peer0", "dev", Config{
+		Endpoint:         server.URL,
+		TokenFile:        tokenFile,
+		Namespace:        "fabric",
+		ImageRegistry:    "registry.example.com/chaincodes/",
+		ImagePullSecrets: []string{"regcred"},
+		Limits:           map[string]string{"memory": "2Gi"},
+		Requests:         map[string]string{"cpu": "100m"},
+		PollInterval:     time.Millisecond,
+	})
+	require.NoError(t, err)
+	return p.NewVM().(*KubernetesVM), func() { os.RemoveAll(tempDir) }
+}
+
+func TestNames(t *testing.T) {
+	vm := &KubernetesVM{PeerID: "peer0.org1.example.com", NetworkID: "dev"}
+	ccid := ccintf.CCID{Name: "My_CC", Version: "1.0"}
+	assert.Equal(t, "dev-peer0-org1-example-com-my-cc-1-0-7f48bf5b", vm.GetVMName(ccid))
+	assert.Equal(t, "my_cc:5694d08a2e53ffcae0c3103e5ad6f6076abd960eb1f8a56577040bc1028f702b", vm.GetImageName(ccid, []byte("code")))
+
+	long := ccintf.CCID{Name: strings.Repeat("chaincode", 10), Version: "1.0"}
+	name := vm.GetVMName(long)
+	assert.Len(t, name, 63)
+	assert.NotEqual(t, name, vm.GetVMName(ccintf.CCID{Name: long.Name, Version: "1.1"}))
+
+	vm.Config.ImageRegistry = "registry.example.com"
+	assert.Equal(t, "registry.example.com/mycc:5694d08a2e53ffcae0c3103e5ad6f6076abd960eb1f8a56577040bc1028f702b", vm.GetImageName(ccintf.CCID{Name: "mycc"}, []byte("code")))
+	// the image is bound to the code package rather than to the version
+	assert.NotEqual(t, vm.GetImageName(ccid, []byte("code")), vm.GetImageName(ccid, []byte("other code")))
+}
+
+func TestStartStop(t *testing.T) {
+	fake := newFakeAPIServer()
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	vm, cleanup := newTestVM(t, server)
+	defer cleanup()
+
+	ccid := ccintf.CCID{Name: "mycc", Version: "1.0"}
+	name := vm.GetVMName(ccid)
+	files := map[string][]byte{
+		"/etc/hyperledger/fabric/peer.crt":   []byte("ca"),
+		"/etc/hyperledger/fabric/client.crt": []byte("cert"),
+	}
+	builder := &container.PlatformBuilder{CodePackage: []byte("code")}
+	err := vm.Start(ccid, []string{"chaincode", "-peer.address=peer0:7052"}, []string{"CORE_CHAINCODE_ID_NAME=mycc:1.0", "EMPTY"}, files, builder)
+	require.NoError(t, err)
+
+	require.Contains(t, fake.secrets, name)
+	assert.Equal(t, map[string][]byte{"file0": []byte("cert"), "file1": []byte("ca")}, fake.secrets[name].Data)
+	require.Contains(t, fake.jobs, name)
+	j := fake.jobs[name]
+	assert.Equal(t, int32(0), j.Spec.BackoffLimit)
+	spec := j.Spec.Template.Spec
+	assert.Equal(t, "Never", spec.RestartPolicy)
+	assert.Equal(t, []localObjectReference{{Name: "regcred"}}, spec.ImagePullSecrets)
+	assert.Equal(t, []volume{{Name: "files", Secret: secretVolumeSource{SecretName: name}}}, spec.Volumes)
+	require.Len(t, spec.Containers, 1)
+	c := spec.Containers[0]
+	assert.Equal(t, "registry.example.com/chaincodes/mycc:5694d08a2e53ffcae0c3103e5ad6f6076abd960eb1f8a56577040bc1028f702b", c.Image)
+	assert.Equal(t, []string{"chaincode", "-peer.address=peer0:7052"}, c.Args)
+	assert.Equal(t, []envVar{{Name: "CORE_CHAINCODE_ID_NAME", Value: "mycc:1.0"}, {Name: "EMPTY"}}, c.Env)
+	assert.Equal(t, resourceRequirements{Limits: map[string]string{"memory": "2Gi"}, Requests: map[string]string{"cpu": "100m"}}, c.Resources)
+	assert.Equal(t, []volumeMount{
+		{Name: "files", MountPath: "/etc/hyperledger/fabric/client.crt", SubPath: "file0", ReadOnly: true},
+		{Name: "files", MountPath: "/etc/hyperledger/fabric/peer.crt", SubPath: "file1", ReadOnly: true},
+	}, c.VolumeMounts)
+	assert.Equal(t, "Bearer secret-token", fake.auth[0])
+
+	// starting again replaces the job
+	fake.requests = nil
+	err = vm.Start(ccid, nil, nil, nil, builder)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"DELETE /apis/batch/v1/namespaces/fabric/jobs/" + name,
+		"DELETE /api/v1/namespaces/fabric/secrets/" + name,
+		"POST /apis/batch/v1/namespaces/fabric/jobs",
+	}, fake.requests)
+
+	assert.NoError(t, vm.Stop(ccid, 10, false, false))
+	assert.Empty(t, fake.jobs)
+	// stopping a chaincode without a job is not an error
+	assert.NoError(t, vm.Stop(ccid, 10, false, false))
+
+	// the image cannot be looked up without the code package
+	fake.requests = nil
+	err = vm.Start(ccid, nil, nil, nil, nil)
+	assert.EqualError(t, err, "the code package of chaincode mycc-1.0 is required to look up its image")
+	assert.Empty(t, fake.requests)
+}
+
+func TestWait(t *testing.T) {
+	fake := newFakeAPIServer()
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	vm, cleanup := newTestVM(t, server)
+	defer cleanup()
+
+	ccid := ccintf.CCID{Name: "mycc", Version: "1.0"}
+	name := vm.GetVMName(ccid)
+	require.NoError(t, vm.Start(ccid, nil, nil, nil, &container.PlatformBuilder{}))
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		fake.setJobStatus(name, jobStatus{Succeeded: 1})
+	}()
+	exitCode, err := vm.Wait(ccid)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, exitCode)
+
+	fake.pods.Items = []pod{{Status: podStatus{ContainerStatuses: []containerStatus{{State: containerState{Terminated: &containerStateTerminated{ExitCode: 2}}}}}}}
+	fake.setJobStatus(name, jobStatus{Failed: 1})
+	exitCode, err = vm.Wait(ccid)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, exitCode)
+
+	require.NoError(t, vm.Stop(ccid, 0, false, false))
+	_, err = vm.Wait(ccid)
+	assert.EqualError(t, err, "kubernetes API request GET /apis/batch/v1/namespaces/fabric/jobs/"+name+" failed with status 404: not found")
+}
+
+func TestHealthCheck(t *testing.T) {
+	fake := newFakeAPIServer()
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	vm, cleanup := newTestVM(t, server)
+	defer cleanup()
+
+	assert.NoError(t, vm.HealthCheck(context.Background()))
+
+	vm.Config.Namespace = "other"
+	err := vm.HealthCheck(context.Background())
+	assert.EqualError(t, err, "failed to list jobs with the Kubernetes API: kubernetes API request GET /apis/batch/v1/namespaces/other/jobs?limit=1 failed with status 403: forbidden")
+}
+
+func TestNewProvider(t *testing.T) {
+	os.Unsetenv("KUBERNETES_SERVICE_HOST")
+	_, err := NewProvider("peer0", "dev", Config{})
+	assert.EqualError(t, err, "no Kubernetes API endpoint is configured and the peer is not running in a Kubernetes cluster")
+
+	_, err = NewProvider("peer0", "dev", Config{Endpoint: "https://localhost:6443", CAFile: "nonexistent.crt"})
+	assert.Contains(t, err.Error(), "failed reading the CA certificate of the Kubernetes API server")
+}
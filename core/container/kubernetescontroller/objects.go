@@ -0,0 +1,123 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kubernetescontroller
+
+// The subset of the Kubernetes API objects the controller creates and reads
+
+type objectMeta struct {
+	Name      string            `json:"name"`
+	Namespace string            `json:"namespace,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+}
+
+type secret struct {
+	APIVersion string            `json:"apiVersion"`
+	Kind       string            `json:"kind"`
+	Metadata   objectMeta        `json:"metadata"`
+	Data       map[string][]byte `json:"data"`
+}
+
+type job struct {
+	APIVersion string     `json:"apiVersion"`
+	Kind       string     `json:"kind"`
+	Metadata   objectMeta `json:"metadata"`
+	Spec       jobSpec    `json:"spec"`
+	Status     jobStatus  `json:"status,omitempty"`
+}
+
+type jobSpec struct {
+	BackoffLimit int32           `json:"backoffLimit"`
+	Template     podTemplateSpec `json:"template"`
+}
+
+type jobStatus struct {
+	Active    int32 `json:"active,omitempty"`
+	Succeeded int32 `json:"succeeded,omitempty"`
+	Failed    int32 `json:"failed,omitempty"`
+}
+
+type podTemplateSpec struct {
+	Metadata objectMeta `json:"metadata"`
+	Spec     podSpec    `json:"spec"`
+}
+
+type podSpec struct {
+	Containers       []containerSpec        `json:"containers"`
+	RestartPolicy    string                 `json:"restartPolicy"`
+	ImagePullSecrets []localObjectReference `json:"imagePullSecrets,omitempty"`
+	Volumes          []volume               `json:"volumes,omitempty"`
+}
+
+type containerSpec struct {
+	Name         string               `json:"name"`
+	Image        string               `json:"image"`
+	Args         []string             `json:"args,omitempty"`
+	Env          []envVar             `json:"env,omitempty"`
+	Resources    resourceRequirements `json:"resources,omitempty"`
+	VolumeMounts []volumeMount        `json:"volumeMounts,omitempty"`
+}
+
+type envVar struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type resourceRequirements struct {
+	Limits   map[string]string `json:"limits,omitempty"`
+	Requests map[string]string `json:"requests,omitempty"`
+}
+
+type localObjectReference struct {
+	Name string `json:"name"`
+}
+
+type volume struct {
+	Name   string             `json:"name"`
+	Secret secretVolumeSource `json:"secret"`
+}
+
+type secretVolumeSource struct {
+	SecretName string `json:"secretName"`
+}
+
+type volumeMount struct {
+	Name      string `json:"name"`
+	MountPath string `json:"mountPath"`
+	SubPath   string `json:"subPath,omitempty"`
+	ReadOnly  bool   `json:"readOnly,omitempty"`
+}
+
+type podList struct {
+	Items []pod `json:"items"`
+}
+
+type pod struct {
+	Status podStatus `json:"status"`
+}
+
+type podStatus struct {
+	ContainerStatuses []containerStatus `json:"containerStatuses,omitempty"`
+}
+
+type containerStatus struct {
+	State containerState `json:"state"`
+}
+
+type containerState struct {
+	Terminated *containerStateTerminated `json:"terminated,omitempty"`
+}
+
+type containerStateTerminated struct {
+	ExitCode int32 `json:"exitCode"`
+}
+
+type deleteOptions struct {
+	APIVersion         string `json:"apiVersion"`
+	Kind               string `json:"kind"`
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds,omitempty"`
+	PropagationPolicy  string `json:"propagationPolicy"`
+}
@@ -0,0 +1,82 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package deliverclient
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// circuitBreaker decides when the delivery client of a channel retries to
+// connect to the ordering service. Failed attempts are retried with a
+// jittered exponential backoff, bounded by maxBackoff. Once the attempts
+// have been failing for openThreshold, the breaker opens: the client then
+// either gives up, if giveUp is set, or keeps probing the ordering service
+// once every cooldown until a connection succeeds and closes the breaker.
+type circuitBreaker struct {
+	channelID     string
+	baseBackoff   time.Duration
+	maxBackoff    time.Duration
+	openThreshold time.Duration
+	cooldown      time.Duration
+	giveUp        bool
+	metrics       *Metrics
+
+	mutex sync.Mutex
+	open  bool
+}
+
+// retryPolicy implements the retryPolicy of the broadcastClient
+func (cb *circuitBreaker) retryPolicy(attemptNum int, elapsedTime time.Duration) (time.Duration, bool) {
+	cb.metrics.ReconnectAttempts.With("channel", cb.channelID).Add(1)
+
+	if elapsedTime < cb.openThreshold {
+		return cb.backoff(attemptNum), true
+	}
+
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+	if !cb.open {
+		cb.open = true
+		cb.metrics.CircuitBreakerOpen.With("channel", cb.channelID).Set(1)
+		cb.metrics.CircuitBreakerTrips.With("channel", cb.channelID).Add(1)
+		if cb.giveUp {
+			logger.Warningf("[%s] Could not connect to the ordering service for %v, giving up", cb.channelID, elapsedTime)
+		} else {
+			logger.Warningf("[%s] Could not connect to the ordering service for %v, retrying every %v", cb.channelID, elapsedTime, cb.cooldown)
+		}
+	}
+	if cb.giveUp {
+		return 0, false
+	}
+	return cb.cooldown, true
+}
+
+// backoff returns the time to wait before the given attempt: an exponential
+// backoff with equal jitter, so that the peers of a channel do not reconnect
+// to a recovering ordering service all at once.
+func (cb *circuitBreaker) backoff(attemptNum int) time.Duration {
+	backoff := math.Min(math.Pow(2, float64(attemptNum))*float64(cb.baseBackoff), float64(cb.maxBackoff))
+	half := int64(backoff / 2)
+	if half <= 0 {
+		return time.Duration(backoff)
+	}
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// reset closes the breaker after a successful connection
+func (cb *circuitBreaker) reset() {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+	if cb.open {
+		logger.Infof("[%s] Reconnected to the ordering service", cb.channelID)
+		cb.open = false
+		cb.metrics.CircuitBreakerOpen.With("channel", cb.channelID).Set(0)
+	}
+}
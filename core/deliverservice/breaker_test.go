@@ -0,0 +1,112 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package deliverclient
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/common/metrics/metricsfakes"
+	"github.com/stretchr/testify/assert"
+)
+
+func newFakeMetrics() (*Metrics, *metricsfakes.Counter, *metricsfakes.Gauge, *metricsfakes.Counter) {
+	attempts := &metricsfakes.Counter{}
+	attempts.WithReturns(attempts)
+	open := &metricsfakes.Gauge{}
+	open.WithReturns(open)
+	trips := &metricsfakes.Counter{}
+	trips.WithReturns(trips)
+	return &Metrics{
+		ReconnectAttempts:   attempts,
+		CircuitBreakerOpen:  open,
+		CircuitBreakerTrips: trips,
+	}, attempts, open, trips
+}
+
+func TestCircuitBreakerBackoff(t *testing.T) {
+	m, attempts, open, _ := newFakeMetrics()
+	cb := &circuitBreaker{
+		channelID:     "testchannel",
+		baseBackoff:   time.Millisecond * 500,
+		maxBackoff:    time.Second * 10,
+		openThreshold: time.Hour,
+		cooldown:      time.Minute,
+		metrics:       m,
+	}
+
+	for attempt := 1; attempt < 100; attempt++ {
+		backoff, retry := cb.retryPolicy(attempt, time.Second)
+		assert.True(t, retry)
+		expected := time.Second * 10
+		if attempt < 5 {
+			expected = time.Millisecond * 500 << uint(attempt)
+		}
+		assert.True(t, backoff >= expected/2 && backoff <= expected, "attempt %d: backoff %v not in [%v, %v]", attempt, backoff, expected/2, expected)
+	}
+
+	assert.Equal(t, 99, attempts.AddCallCount())
+	assert.Equal(t, []string{"channel", "testchannel"}, attempts.WithArgsForCall(0))
+	assert.Equal(t, 0, open.SetCallCount())
+}
+
+func TestCircuitBreakerOpen(t *testing.T) {
+	m, _, open, trips := newFakeMetrics()
+	cb := &circuitBreaker{
+		channelID:     "testchannel",
+		baseBackoff:   time.Millisecond * 500,
+		maxBackoff:    time.Second * 10,
+		openThreshold: time.Minute,
+		cooldown:      time.Second * 30,
+		metrics:       m,
+	}
+
+	backoff, retry := cb.retryPolicy(10, time.Minute)
+	assert.True(t, retry)
+	assert.Equal(t, time.Second*30, backoff)
+	backoff, retry = cb.retryPolicy(11, time.Minute+time.Second*30)
+	assert.True(t, retry)
+	assert.Equal(t, time.Second*30, backoff)
+
+	assert.Equal(t, 1, trips.AddCallCount())
+	assert.Equal(t, 1, open.SetCallCount())
+	assert.Equal(t, float64(1), open.SetArgsForCall(0))
+
+	cb.reset()
+	assert.Equal(t, 2, open.SetCallCount())
+	assert.Equal(t, float64(0), open.SetArgsForCall(1))
+
+	// Resetting a closed breaker has no effect
+	cb.reset()
+	assert.Equal(t, 2, open.SetCallCount())
+
+	// Once closed, the breaker may open again
+	_, retry = cb.retryPolicy(10, time.Minute)
+	assert.True(t, retry)
+	assert.Equal(t, 2, trips.AddCallCount())
+}
+
+func TestCircuitBreakerGiveUp(t *testing.T) {
+	m, _, open, trips := newFakeMetrics()
+	cb := &circuitBreaker{
+		channelID:     "testchannel",
+		baseBackoff:   time.Millisecond * 500,
+		maxBackoff:    time.Second * 10,
+		openThreshold: time.Minute,
+		cooldown:      time.Second * 30,
+		giveUp:        true,
+		metrics:       m,
+	}
+
+	_, retry := cb.retryPolicy(1, time.Second)
+	assert.True(t, retry)
+	backoff, retry := cb.retryPolicy(10, time.Minute)
+	assert.False(t, retry)
+	assert.Equal(t, time.Duration(0), backoff)
+	assert.Equal(t, 1, trips.AddCallCount())
+	assert.Equal(t, 1, open.SetCallCount())
+}
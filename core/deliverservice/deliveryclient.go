@@ -10,11 +10,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"math"
 	"sync"
 	"time"
 
+	"github.com/hyperledger/fabric/common/crypto"
 	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/common/localmsp"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
 	"github.com/hyperledger/fabric/core/comm"
 	"github.com/hyperledger/fabric/core/deliverservice/blocksprovider"
 	"github.com/hyperledger/fabric/gossip/api"
@@ -29,7 +31,9 @@ var logger = flogging.MustGetLogger("deliveryClient")
 const (
 	defaultReConnectTotalTimeThreshold = time.Second * 60 * 60
 	defaultConnectionTimeout           = time.Second * 3
-	defaultReConnectBackoffThreshold   = float64(time.Hour)
+	defaultReConnectBackoffThreshold   = float64(time.Minute)
+	defaultCircuitBreakerCooldown      = time.Minute
+	reConnectBaseBackoff               = time.Millisecond * 500
 )
 
 func getReConnectTotalTimeThreshold() time.Duration {
@@ -44,6 +48,10 @@ func getReConnectBackoffThreshold() float64 {
 	return util.GetFloat64OrDefault("peer.deliveryclient.reConnectBackoffThreshold", defaultReConnectBackoffThreshold)
 }
 
+func getCircuitBreakerCooldown() time.Duration {
+	return util.GetDurationOrDefault("peer.deliveryclient.circuitBreakerCooldown", defaultCircuitBreakerCooldown)
+}
+
 // DeliverService used to communicate with orderers to obtain
 // new blocks and send them to the committer service
 type DeliverService interface {
@@ -90,6 +98,18 @@ type Config struct {
 	Gossip blocksprovider.GossipServiceAdapter
 	// Endpoints specifies the endpoints of the ordering service
 	Endpoints []string
+	// GiveUpOnCircuitOpen makes the delivery of a channel stop once its
+	// connection to the ordering service has been failing for longer than
+	// peer.deliveryclient.reconnectTotalTimeThreshold, instead of probing the
+	// ordering service until it recovers. It is meant for peers elected to
+	// deliver blocks, which should then yield to another peer.
+	GiveUpOnCircuitOpen bool
+	// Metrics records the reconnections to the ordering service.
+	// Metrics are disabled when nil.
+	Metrics *Metrics
+	// Signer signs the requests for blocks sent to the ordering service.
+	// The local MSP signs them when nil.
+	Signer crypto.LocalSigner
 }
 
 // NewDeliverService construction function to create and initialize
@@ -104,6 +124,12 @@ func NewDeliverService(conf *Config) (DeliverService, error) {
 	if err := ds.validateConfiguration(); err != nil {
 		return nil, err
 	}
+	if conf.Metrics == nil {
+		conf.Metrics = NewMetrics(&disabled.Provider{})
+	}
+	if conf.Signer == nil {
+		conf.Signer = localmsp.NewSigner()
+	}
 	return ds, nil
 }
 
@@ -209,25 +235,29 @@ func (d *deliverServiceImpl) Stop() {
 }
 
 func (d *deliverServiceImpl) newClient(chainID string, ledgerInfoProvider blocksprovider.LedgerInfo) *broadcastClient {
-	reconnectBackoffThreshold := getReConnectBackoffThreshold()
-	reconnectTotalTimeThreshold := getReConnectTotalTimeThreshold()
+	breaker := &circuitBreaker{
+		channelID:     chainID,
+		baseBackoff:   reConnectBaseBackoff,
+		maxBackoff:    time.Duration(getReConnectBackoffThreshold()),
+		openThreshold: getReConnectTotalTimeThreshold(),
+		cooldown:      getCircuitBreakerCooldown(),
+		giveUp:        d.conf.GiveUpOnCircuitOpen,
+		metrics:       d.conf.Metrics,
+	}
 	requester := &blocksRequester{
 		tls:     viper.GetBool("peer.tls.enabled"),
 		chainID: chainID,
+		signer:  d.conf.Signer,
 	}
 	broadcastSetup := func(bd blocksprovider.BlocksDeliverer) error {
-		return requester.RequestBlocks(ledgerInfoProvider)
-	}
-	backoffPolicy := func(attemptNum int, elapsedTime time.Duration) (time.Duration, bool) {
-		if elapsedTime >= reconnectTotalTimeThreshold {
-			return 0, false
+		if err := requester.RequestBlocks(ledgerInfoProvider); err != nil {
+			return err
 		}
-		sleepIncrement := float64(time.Millisecond * 500)
-		attempt := float64(attemptNum)
-		return time.Duration(math.Min(math.Pow(2, attempt)*sleepIncrement, reconnectBackoffThreshold)), true
+		breaker.reset()
+		return nil
 	}
 	connProd := comm.NewConnectionProducer(d.conf.ConnFactory(chainID), d.conf.Endpoints)
-	bClient := NewBroadcastClient(connProd, d.conf.ABCFactory, broadcastSetup, backoffPolicy)
+	bClient := NewBroadcastClient(connProd, d.conf.ABCFactory, broadcastSetup, breaker.retryPolicy)
 	requester.client = bClient
 	return bClient
 }
@@ -239,7 +269,7 @@ func DefaultConnectionFactory(channelID string) func(endpoint string) (*grpc.Cli
 		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(comm.MaxRecvMsgSize),
 			grpc.MaxCallSendMsgSize(comm.MaxSendMsgSize)))
 		// set the keepalive options
-		kaOpts := comm.DefaultKeepaliveOptions
+		kaOpts := comm.DefaultKeepaliveOptions.Clone()
 		if viper.IsSet("peer.keepalive.deliveryClient.interval") {
 			kaOpts.ClientInterval = viper.GetDuration(
 				"peer.keepalive.deliveryClient.interval")
@@ -251,7 +281,7 @@ func DefaultConnectionFactory(channelID string) func(endpoint string) (*grpc.Cli
 		dialOpts = append(dialOpts, comm.ClientKeepaliveOptions(kaOpts)...)
 
 		if viper.GetBool("peer.tls.enabled") {
-			creds, err := comm.GetCredentialSupport().GetDeliverServiceCredentials(channelID)
+			creds, err := comm.GetCredentialSupport().GetDeliverServiceCredentialsForEndpoint(channelID, endpoint)
 			if err != nil {
 				return nil, fmt.Errorf("failed obtaining credentials for channel %s: %v", channelID, err)
 			}
@@ -16,6 +16,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hyperledger/fabric/common/metrics/disabled"
 	"github.com/hyperledger/fabric/core/comm"
 	"github.com/hyperledger/fabric/core/deliverservice/blocksprovider"
 	"github.com/hyperledger/fabric/core/deliverservice/mocks"
@@ -669,7 +670,7 @@ func TestRetryPolicyOverflow(t *testing.T) {
 			return nil, errors.New("")
 		}
 	}
-	client := (&deliverServiceImpl{conf: &Config{ConnFactory: connFactory}}).newClient("TEST", &mocks.MockLedgerInfo{Height: uint64(100)})
+	client := (&deliverServiceImpl{conf: &Config{ConnFactory: connFactory, Metrics: NewMetrics(&disabled.Provider{})}}).newClient("TEST", &mocks.MockLedgerInfo{Height: uint64(100)})
 	assert.NotNil(t, client.shouldRetry)
 	for i := 0; i < 100; i++ {
 		retryTime, _ := client.shouldRetry(i, time.Second)
@@ -0,0 +1,52 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package deliverclient
+
+import "github.com/hyperledger/fabric/common/metrics"
+
+var (
+	reconnectAttemptsCounterOpts = metrics.CounterOpts{
+		Namespace:    "deliver_client",
+		Name:         "reconnect_attempts",
+		Help:         "The number of failed attempts to connect to the ordering service that were retried.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
+
+	circuitBreakerOpenGaugeOpts = metrics.GaugeOpts{
+		Namespace:    "deliver_client",
+		Name:         "circuit_breaker_open",
+		Help:         "Whether the circuit breaker of the connection to the ordering service is open (1) or closed (0).",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
+
+	circuitBreakerTripsCounterOpts = metrics.CounterOpts{
+		Namespace:    "deliver_client",
+		Name:         "circuit_breaker_trips",
+		Help:         "The number of times the circuit breaker of the connection to the ordering service opened.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
+)
+
+// Metrics are the metrics of the connections of the delivery service to
+// the ordering service
+type Metrics struct {
+	ReconnectAttempts   metrics.Counter
+	CircuitBreakerOpen  metrics.Gauge
+	CircuitBreakerTrips metrics.Counter
+}
+
+// NewMetrics creates the metrics of the delivery service out of the given provider
+func NewMetrics(p metrics.Provider) *Metrics {
+	return &Metrics{
+		ReconnectAttempts:   p.NewCounter(reconnectAttemptsCounterOpts),
+		CircuitBreakerOpen:  p.NewGauge(circuitBreakerOpenGaugeOpts),
+		CircuitBreakerTrips: p.NewCounter(circuitBreakerTripsCounterOpts),
+	}
+}
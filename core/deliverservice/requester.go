@@ -9,7 +9,7 @@ package deliverclient
 import (
 	"math"
 
-	"github.com/hyperledger/fabric/common/localmsp"
+	"github.com/hyperledger/fabric/common/crypto"
 	"github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/core/comm"
 	"github.com/hyperledger/fabric/core/deliverservice/blocksprovider"
@@ -21,6 +21,7 @@ import (
 type blocksRequester struct {
 	tls     bool
 	chainID string
+	signer  crypto.LocalSigner
 	client  blocksprovider.BlocksDeliverer
 }
 
@@ -64,7 +65,7 @@ func (b *blocksRequester) seekOldest() error {
 	msgVersion := int32(0)
 	epoch := uint64(0)
 	tlsCertHash := b.getTLSCertHash()
-	env, err := protoutil.CreateSignedEnvelopeWithTLSBinding(common.HeaderType_DELIVER_SEEK_INFO, b.chainID, localmsp.NewSigner(), seekInfo, msgVersion, epoch, tlsCertHash)
+	env, err := protoutil.CreateSignedEnvelopeWithTLSBinding(common.HeaderType_DELIVER_SEEK_INFO, b.chainID, b.signer, seekInfo, msgVersion, epoch, tlsCertHash)
 	if err != nil {
 		return err
 	}
@@ -82,7 +83,7 @@ func (b *blocksRequester) seekLatestFromCommitter(height uint64) error {
 	msgVersion := int32(0)
 	epoch := uint64(0)
 	tlsCertHash := b.getTLSCertHash()
-	env, err := protoutil.CreateSignedEnvelopeWithTLSBinding(common.HeaderType_DELIVER_SEEK_INFO, b.chainID, localmsp.NewSigner(), seekInfo, msgVersion, epoch, tlsCertHash)
+	env, err := protoutil.CreateSignedEnvelopeWithTLSBinding(common.HeaderType_DELIVER_SEEK_INFO, b.chainID, b.signer, seekInfo, msgVersion, epoch, tlsCertHash)
 	if err != nil {
 		return err
 	}
@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/localmsp"
 	"github.com/hyperledger/fabric/core/comm"
 	"github.com/hyperledger/fabric/core/deliverservice/blocksprovider"
 	"github.com/hyperledger/fabric/protos/common"
@@ -32,6 +33,7 @@ func TestTLSBinding(t *testing.T) {
 	requester := blocksRequester{
 		tls:     true,
 		chainID: "testchainid",
+		signer:  localmsp.NewSigner(),
 	}
 
 	// Create an AtomicBroadcastServer
@@ -0,0 +1,189 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package endorser
+
+import (
+	"container/list"
+	"crypto/x509"
+	"encoding/pem"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/core/aclmgmt"
+	"github.com/hyperledger/fabric/protos/msp"
+	pb "github.com/hyperledger/fabric/protos/peer"
+)
+
+// DefaultACLCacheTTL is the maximum time an ACL decision is cached for,
+// if not configured otherwise
+const DefaultACLCacheTTL = 5 * time.Minute
+
+// ACLCache caches the ACL decisions granted to the creators of proposals, so
+// that clients sending proposals at a high rate with the same identity do not
+// have the channel policy of the resource evaluated for each proposal.
+//
+// Only granted decisions are cached, and only on channels with the ACLs
+// application capability, on which the policy of a resource is resolved
+// from the channel config. A decision is bound to the sequence of the
+// channel config it was made with, and is dropped as soon as a config
+// update changes the sequence. A decision is also dropped once the
+// certificate of the creator expires, or once it was cached for the maximum
+// TTL of the cache, whichever comes first, and the decisions of creators
+// that are not X.509 identities are not cached. The cache relies on the
+// signature of the proposal and the validity of its creator being checked
+// before the ACL, as the endorser does when validating the proposal message.
+type ACLCache struct {
+	aclProvider   aclmgmt.ACLProvider
+	channelConfig func(channelID string) channelconfig.Resources
+	size          int
+	maxTTL        time.Duration
+
+	mutex   sync.Mutex
+	entries map[aclCacheKey]*list.Element
+	lru     *list.List
+}
+
+type aclCacheKey struct {
+	creator  string
+	channel  string
+	resource string
+}
+
+type aclCacheEntry struct {
+	key      aclCacheKey
+	sequence uint64
+	expiry   time.Time
+}
+
+// NewACLCache creates an ACLCache of the given size that caches decisions for
+// at most maxTTL, checks the ACLs with the given ACL provider, and retrieves
+// the config of a channel with the given function
+func NewACLCache(size int, maxTTL time.Duration, aclProvider aclmgmt.ACLProvider, channelConfig func(channelID string) channelconfig.Resources) *ACLCache {
+	return &ACLCache{
+		aclProvider:   aclProvider,
+		channelConfig: channelConfig,
+		size:          size,
+		maxTTL:        maxTTL,
+		entries:       make(map[aclCacheKey]*list.Element),
+		lru:           list.New(),
+	}
+}
+
+// CheckACL checks the ACL of the resource for the channel for the creator of
+// the signed proposal, using a decision cached for the current config of
+// the channel if there is one
+func (c *ACLCache) CheckACL(resName, channelID string, creator []byte, signedProp *pb.SignedProposal) error {
+	sequence, cacheable := c.sequence(channelID)
+	if !cacheable {
+		return c.aclProvider.CheckACL(resName, channelID, signedProp)
+	}
+
+	key := aclCacheKey{creator: string(creator), channel: channelID, resource: resName}
+	if c.granted(key, sequence) {
+		return nil
+	}
+	if err := c.aclProvider.CheckACL(resName, channelID, signedProp); err != nil {
+		return err
+	}
+	if expiry, cacheable := c.expiry(creator); cacheable {
+		c.add(key, sequence, expiry)
+	}
+	return nil
+}
+
+// Len returns the number of cached decisions
+func (c *ACLCache) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.lru.Len()
+}
+
+// sequence returns the sequence of the config of the channel, and whether
+// decisions may be cached for the channel
+func (c *ACLCache) sequence(channelID string) (uint64, bool) {
+	if c.size <= 0 {
+		return 0, false
+	}
+	res := c.channelConfig(channelID)
+	if res == nil || res.ConfigtxValidator() == nil {
+		return 0, false
+	}
+	ac, exists := res.ApplicationConfig()
+	if !exists || !ac.Capabilities().ACLs() {
+		return 0, false
+	}
+	return res.ConfigtxValidator().Sequence(), true
+}
+
+// expiry returns the time a decision granted now to the creator expires,
+// which is the earliest of the expiration of the certificate of the creator
+// and the maximum TTL, and whether the decision may be cached at all
+func (c *ACLCache) expiry(creator []byte) (time.Time, bool) {
+	sID := &msp.SerializedIdentity{}
+	if err := proto.Unmarshal(creator, sID); err != nil {
+		return time.Time{}, false
+	}
+	bl, _ := pem.Decode(sID.IdBytes)
+	if bl == nil {
+		return time.Time{}, false
+	}
+	cert, err := x509.ParseCertificate(bl.Bytes)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	now := time.Now()
+	expiry := now.Add(c.maxTTL)
+	if cert.NotAfter.Before(expiry) {
+		expiry = cert.NotAfter
+	}
+	return expiry, expiry.After(now)
+}
+
+// granted returns whether an unexpired decision is cached for the key and
+// the given config sequence, and drops the decision of the key if it expired
+// or was made with another config
+func (c *ACLCache) granted(key aclCacheKey, sequence uint64) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	elem, exists := c.entries[key]
+	if !exists {
+		return false
+	}
+	entry := elem.Value.(*aclCacheEntry)
+	if entry.sequence != sequence || !time.Now().Before(entry.expiry) {
+		c.lru.Remove(elem)
+		delete(c.entries, key)
+		return false
+	}
+	c.lru.MoveToFront(elem)
+	return true
+}
+
+// add caches the decision of the key for the given config sequence until the
+// given expiry, and evicts the least recently used decision if the cache is full
+func (c *ACLCache) add(key aclCacheKey, sequence uint64, expiry time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if elem, exists := c.entries[key]; exists {
+		entry := elem.Value.(*aclCacheEntry)
+		entry.sequence = sequence
+		entry.expiry = expiry
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&aclCacheEntry{key: key, sequence: sequence, expiry: expiry})
+	if c.lru.Len() > c.size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*aclCacheEntry).key)
+	}
+}
@@ -0,0 +1,234 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package endorser_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/common/cauthdsl"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	mc "github.com/hyperledger/fabric/common/mocks/config"
+	mockconfigtx "github.com/hyperledger/fabric/common/mocks/configtx"
+	"github.com/hyperledger/fabric/common/policies"
+	"github.com/hyperledger/fabric/core/aclmgmt/mocks"
+	"github.com/hyperledger/fabric/core/aclmgmt/resources"
+	"github.com/hyperledger/fabric/core/endorser"
+	"github.com/hyperledger/fabric/msp/mgmt"
+	"github.com/hyperledger/fabric/protos/msp"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func newACLCacheChannelConfig(sequence uint64, acls bool) *mc.Resources {
+	return &mc.Resources{
+		ConfigtxValidatorVal: &mockconfigtx.Validator{SequenceVal: sequence},
+		ApplicationConfigVal: &mc.MockApplication{
+			CapabilitiesRv: &mc.MockApplicationCapabilities{ACLsRv: acls},
+		},
+	}
+}
+
+// newCreator returns a serialized identity with a self-signed certificate
+// of the given common name, which expires at the given time
+func newCreator(t testing.TB, name string, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	return protoutil.MarshalOrPanic(&msp.SerializedIdentity{
+		Mspid:   "SampleOrg",
+		IdBytes: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	})
+}
+
+func TestACLCache(t *testing.T) {
+	aclProvider := &mocks.DefaultACLProvider{}
+	channelConfigs := map[string]*mc.Resources{
+		"mychannel": newACLCacheChannelConfig(1, true),
+		"v11":       newACLCacheChannelConfig(1, false),
+	}
+	cache := endorser.NewACLCache(2, time.Hour, aclProvider, func(channelID string) channelconfig.Resources {
+		if res, exists := channelConfigs[channelID]; exists {
+			return res
+		}
+		return nil
+	})
+	signedProp := &pb.SignedProposal{}
+	notAfter := time.Now().Add(24 * time.Hour)
+	creators := map[string][]byte{
+		"alice": newCreator(t, "alice", notAfter),
+		"bob":   newCreator(t, "bob", notAfter),
+		"carol": newCreator(t, "carol", notAfter),
+	}
+
+	// The first check of a creator evaluates the ACL, the next ones are cached
+	assert.NoError(t, cache.CheckACL(resources.Peer_Propose, "mychannel", creators["alice"], signedProp))
+	assert.NoError(t, cache.CheckACL(resources.Peer_Propose, "mychannel", creators["alice"], signedProp))
+	assert.Equal(t, 1, aclProvider.CheckACLCallCount())
+	resName, channelID, idinfo := aclProvider.CheckACLArgsForCall(0)
+	assert.Equal(t, resources.Peer_Propose, resName)
+	assert.Equal(t, "mychannel", channelID)
+	assert.Equal(t, signedProp, idinfo)
+
+	// Denied decisions are not cached
+	aclProvider.CheckACLReturns(errors.New("access denied"))
+	assert.EqualError(t, cache.CheckACL(resources.Peer_Propose, "mychannel", creators["bob"], signedProp), "access denied")
+	assert.EqualError(t, cache.CheckACL(resources.Peer_Propose, "mychannel", creators["bob"], signedProp), "access denied")
+	assert.Equal(t, 3, aclProvider.CheckACLCallCount())
+	assert.Equal(t, 1, cache.Len())
+
+	// A config update drops the decisions made with the previous config
+	channelConfigs["mychannel"] = newACLCacheChannelConfig(2, true)
+	assert.EqualError(t, cache.CheckACL(resources.Peer_Propose, "mychannel", creators["alice"], signedProp), "access denied")
+	assert.Equal(t, 4, aclProvider.CheckACLCallCount())
+	assert.Equal(t, 0, cache.Len())
+
+	// Decisions are not cached on channels without the ACLs capability,
+	// or whose config is not found
+	aclProvider.CheckACLReturns(nil)
+	for _, channel := range []string{"v11", "v11", "missing", "missing"} {
+		assert.NoError(t, cache.CheckACL(resources.Peer_Propose, channel, creators["alice"], signedProp))
+	}
+	assert.Equal(t, 8, aclProvider.CheckACLCallCount())
+	assert.Equal(t, 0, cache.Len())
+
+	// The least recently used decision is evicted when the cache is full
+	for _, creator := range []string{"alice", "bob", "alice", "carol", "alice", "bob"} {
+		assert.NoError(t, cache.CheckACL(resources.Peer_Propose, "mychannel", creators[creator], signedProp))
+	}
+	assert.Equal(t, 12, aclProvider.CheckACLCallCount())
+	assert.Equal(t, 2, cache.Len())
+
+	// Decisions of creators that are not X.509 identities are not cached
+	assert.NoError(t, cache.CheckACL(resources.Peer_Propose, "mychannel", []byte("dave"), signedProp))
+	assert.NoError(t, cache.CheckACL(resources.Peer_Propose, "mychannel", []byte("dave"), signedProp))
+	assert.Equal(t, 14, aclProvider.CheckACLCallCount())
+}
+
+func TestACLCacheExpiry(t *testing.T) {
+	aclProvider := &mocks.DefaultACLProvider{}
+	cache := endorser.NewACLCache(10, 200*time.Millisecond, aclProvider, func(string) channelconfig.Resources {
+		return newACLCacheChannelConfig(1, true)
+	})
+	signedProp := &pb.SignedProposal{}
+
+	// Decisions are cached for at most the maximum TTL
+	alice := newCreator(t, "alice", time.Now().Add(24*time.Hour))
+	assert.NoError(t, cache.CheckACL(resources.Peer_Propose, "mychannel", alice, signedProp))
+	assert.NoError(t, cache.CheckACL(resources.Peer_Propose, "mychannel", alice, signedProp))
+	assert.Equal(t, 1, aclProvider.CheckACLCallCount())
+	time.Sleep(300 * time.Millisecond)
+	assert.NoError(t, cache.CheckACL(resources.Peer_Propose, "mychannel", alice, signedProp))
+	assert.Equal(t, 2, aclProvider.CheckACLCallCount())
+
+	// Decisions of expired creators are not cached
+	bob := newCreator(t, "bob", time.Now().Add(-time.Minute))
+	assert.NoError(t, cache.CheckACL(resources.Peer_Propose, "mychannel", bob, signedProp))
+	assert.NoError(t, cache.CheckACL(resources.Peer_Propose, "mychannel", bob, signedProp))
+	assert.Equal(t, 4, aclProvider.CheckACLCallCount())
+
+	// Decisions are dropped once the certificate of the creator expires
+	cache = endorser.NewACLCache(10, time.Hour, aclProvider, func(string) channelconfig.Resources {
+		return newACLCacheChannelConfig(1, true)
+	})
+	carol := newCreator(t, "carol", time.Now().Add(time.Second))
+	assert.NoError(t, cache.CheckACL(resources.Peer_Propose, "mychannel", carol, signedProp))
+	assert.NoError(t, cache.CheckACL(resources.Peer_Propose, "mychannel", carol, signedProp))
+	assert.Equal(t, 5, aclProvider.CheckACLCallCount())
+	time.Sleep(time.Second)
+	assert.NoError(t, cache.CheckACL(resources.Peer_Propose, "mychannel", carol, signedProp))
+	assert.Equal(t, 6, aclProvider.CheckACLCallCount())
+}
+
+func TestACLCacheDisabled(t *testing.T) {
+	aclProvider := &mocks.DefaultACLProvider{}
+	cache := endorser.NewACLCache(0, time.Hour, aclProvider, func(string) channelconfig.Resources {
+		return newACLCacheChannelConfig(1, true)
+	})
+	alice := newCreator(t, "alice", time.Now().Add(24*time.Hour))
+
+	assert.NoError(t, cache.CheckACL(resources.Peer_Propose, "mychannel", alice, &pb.SignedProposal{}))
+	assert.NoError(t, cache.CheckACL(resources.Peer_Propose, "mychannel", alice, &pb.SignedProposal{}))
+	assert.Equal(t, 2, aclProvider.CheckACLCallCount())
+	assert.Equal(t, 0, cache.Len())
+}
+
+// policyACLProvider checks ACLs by evaluating a signature policy over the signed
+// proposal, as the ACL provider of the peer does with the policy of a resource
+type policyACLProvider struct {
+	policy policies.Policy
+}
+
+func (p *policyACLProvider) CheckACL(resName string, channelID string, idinfo interface{}) error {
+	signedProp := idinfo.(*pb.SignedProposal)
+	prop, err := protoutil.GetProposal(signedProp.ProposalBytes)
+	if err != nil {
+		return err
+	}
+	hdr, err := protoutil.GetHeader(prop.Header)
+	if err != nil {
+		return err
+	}
+	shdr, err := protoutil.GetSignatureHeader(hdr.SignatureHeader)
+	if err != nil {
+		return err
+	}
+	return p.policy.Evaluate([]*protoutil.SignedData{{
+		Data:      signedProp.ProposalBytes,
+		Identity:  shdr.Creator,
+		Signature: signedProp.Signature,
+	}})
+}
+
+// BenchmarkACLCache compares the latency of checking the ACL of a proposal
+// by evaluating the policy of the resource with that of a cached decision
+func BenchmarkACLCache(b *testing.B) {
+	policy, _, err := cauthdsl.NewPolicyProvider(mgmt.GetLocalMSP()).NewPolicy(
+		protoutil.MarshalOrPanic(cauthdsl.SignedByMspMember("SampleOrg")),
+	)
+	assert.NoError(b, err)
+	aclProvider := &policyACLProvider{policy: policy}
+	signedProp, _ := protoutil.MockSignedEndorserProposal2OrPanic("mychannel", &pb.ChaincodeSpec{}, signer)
+	creator, err := signer.Serialize()
+	assert.NoError(b, err)
+	channelConfig := newACLCacheChannelConfig(1, true)
+
+	for _, bm := range []struct {
+		name string
+		size int
+	}{
+		{name: "uncached", size: 0},
+		{name: "cached", size: 100},
+	} {
+		cache := endorser.NewACLCache(bm.size, time.Hour, aclProvider, func(string) channelconfig.Resources {
+			return channelConfig
+		})
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if err := cache.CheckACL(resources.Peer_Propose, "mychannel", creator, signedProp); err != nil {
+					b.Fatalf("ACL check failed: %s", err)
+				}
+			}
+		})
+	}
+}
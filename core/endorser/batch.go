@@ -0,0 +1,178 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package endorser
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/core/common/validation"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+)
+
+// DefaultMaxBatchSize is the maximum number of proposals of a batch if
+// peer.maxProposalBatchSize is not set
+const DefaultMaxBatchSize = 100
+
+// ProcessProposals endorses a batch of proposals for the same channel and
+// chaincode, and returns a response for each proposal, in the order of the
+// batch. Each proposal is checked and simulated as if it was sent with
+// ProcessProposal, so a proposal that fails gets a response with status 500
+// while the rest of the batch is endorsed.
+//
+// The checks that only depend on the creator of a proposal are shared
+// between the proposals of the batch: the identity of each creator is
+// deserialized and validated once, and the ACL of the channel is evaluated
+// once per creator. The signature of each proposal is still verified.
+//
+// The proposals are simulated concurrently, each with its own transaction
+// simulator. Sharing a single simulator between the proposals is not safe,
+// as a simulator records the read-write set of a single transaction, and
+// holding the ledger lock across the batch would block the commit of blocks.
+// The proposals are therefore usually, but not necessarily, simulated
+// against the same committed state; the read set of each response is
+// checked for conflicts at validation time as usual.
+func (e *Endorser) ProcessProposals(ctx context.Context, batch *pb.SignedProposals) (*pb.ProposalResponses, error) {
+	if err := e.checkBatch(batch); err != nil {
+		endorserLogger.Warningf("Rejecting batch of %d proposals from %s: %s", len(batch.GetProposals()), util.ExtractRemoteAddress(ctx), err)
+		return nil, err
+	}
+
+	checks := newBatchChecks()
+	responses := make([]*pb.ProposalResponse, len(batch.Proposals))
+	concurrency := make(chan struct{}, runtime.NumCPU())
+	var wg sync.WaitGroup
+	for i, signedProp := range batch.Proposals {
+		wg.Add(1)
+		concurrency <- struct{}{}
+		go func(i int, signedProp *pb.SignedProposal) {
+			defer func() {
+				<-concurrency
+				wg.Done()
+			}()
+			resp, err := e.processProposal(ctx, signedProp, checks)
+			if err != nil {
+				resp = &pb.ProposalResponse{Response: &pb.Response{Status: 500, Message: err.Error()}}
+			}
+			responses[i] = resp
+		}(i, signedProp)
+	}
+	wg.Wait()
+
+	return &pb.ProposalResponses{Responses: responses}, nil
+}
+
+// batchChecks holds the checks shared between the proposals of a batch
+type batchChecks struct {
+	creators *validation.CreatorValidator
+
+	mutex sync.Mutex
+	acls  map[aclKey]*aclDecision
+}
+
+type aclKey struct {
+	channel string
+	creator string
+}
+
+type aclDecision struct {
+	once sync.Once
+	err  error
+}
+
+func newBatchChecks() *batchChecks {
+	return &batchChecks{
+		creators: validation.NewCreatorValidator(),
+		acls:     make(map[aclKey]*aclDecision),
+	}
+}
+
+// checkACL returns the ACL decision of the creator on the channel, calling
+// check for the first proposal of the creator in the batch only. The
+// decision only depends on the identity of the creator, as the signature of
+// each proposal is verified before its ACL is checked.
+func (b *batchChecks) checkACL(channel string, creator []byte, check func() error) error {
+	key := aclKey{channel: channel, creator: string(creator)}
+	b.mutex.Lock()
+	decision, exists := b.acls[key]
+	if !exists {
+		decision = &aclDecision{}
+		b.acls[key] = decision
+	}
+	b.mutex.Unlock()
+
+	decision.once.Do(func() {
+		decision.err = check()
+	})
+	return decision.err
+}
+
+// checkBatch checks that the batch is not empty, does not exceed the
+// maximum batch size, and that all its proposals are for the same channel
+// and chaincode
+func (e *Endorser) checkBatch(batch *pb.SignedProposals) error {
+	if len(batch.GetProposals()) == 0 {
+		return errors.New("empty batch of proposals")
+	}
+	maxBatchSize := e.MaxBatchSize
+	if maxBatchSize <= 0 {
+		maxBatchSize = DefaultMaxBatchSize
+	}
+	if len(batch.Proposals) > maxBatchSize {
+		return errors.Errorf("batch of %d proposals exceeds the maximum batch size of %d", len(batch.Proposals), maxBatchSize)
+	}
+
+	var channel, chaincode string
+	for i, signedProp := range batch.Proposals {
+		propChannel, propChaincode, err := proposalTarget(signedProp)
+		if err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("invalid proposal %d of the batch", i))
+		}
+		if i == 0 {
+			channel, chaincode = propChannel, propChaincode
+			continue
+		}
+		if propChannel != channel || propChaincode != chaincode {
+			return errors.Errorf("proposal %d of the batch is for chaincode %s on channel %s, expected chaincode %s on channel %s",
+				i, propChaincode, propChannel, chaincode, channel)
+		}
+	}
+	return nil
+}
+
+// proposalTarget returns the channel and the name of the chaincode of the
+// given proposal
+func proposalTarget(signedProp *pb.SignedProposal) (string, string, error) {
+	if signedProp == nil {
+		return "", "", errors.New("nil proposal")
+	}
+	prop, err := protoutil.GetProposal(signedProp.ProposalBytes)
+	if err != nil {
+		return "", "", err
+	}
+	hdr, err := protoutil.GetHeader(prop.Header)
+	if err != nil {
+		return "", "", err
+	}
+	chdr, err := protoutil.UnmarshalChannelHeader(hdr.ChannelHeader)
+	if err != nil {
+		return "", "", err
+	}
+	hdrExt, err := protoutil.GetChaincodeHeaderExtension(hdr)
+	if err != nil {
+		return "", "", err
+	}
+	if hdrExt.ChaincodeId == nil {
+		return "", "", errors.New("missing chaincode ID")
+	}
+	return chdr.ChannelId, hdrExt.ChaincodeId.Name, nil
+}
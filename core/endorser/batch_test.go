@@ -0,0 +1,146 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package endorser_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hyperledger/fabric/common/metrics/disabled"
+	mc "github.com/hyperledger/fabric/common/mocks/config"
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/core/chaincode/platforms"
+	"github.com/hyperledger/fabric/core/chaincode/platforms/golang"
+	"github.com/hyperledger/fabric/core/common/ccprovider"
+	"github.com/hyperledger/fabric/core/endorser"
+	em "github.com/hyperledger/fabric/core/mocks/endorser"
+	"github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndorserProcessProposals(t *testing.T) {
+	m := &mock.Mock{}
+	m.On("Sign", mock.Anything).Return([]byte{1, 2, 3, 4, 5}, nil)
+	m.On("Serialize").Return([]byte{1, 1, 1}, nil)
+	m.On("GetTxSimulator", mock.Anything, mock.Anything).Return(newMockTxSim(), nil)
+	support := &em.MockSupport{
+		Mock:                       m,
+		GetApplicationConfigBoolRv: true,
+		GetApplicationConfigRv:     &mc.MockApplication{CapabilitiesRv: &mc.MockApplicationCapabilities{}},
+		GetTransactionByIDErr:      errors.New(""),
+		ChaincodeDefinitionRv:      &ccprovider.ChaincodeData{Name: "ccid", Version: "0", Escc: "ESCC"},
+		ExecuteResp:                &pb.Response{Status: 200, Payload: protoutil.MarshalOrPanic(&pb.ProposalResponse{Response: &pb.Response{}})},
+	}
+	attachPluginEndorser(support, nil)
+	es := endorser.NewEndorserServer(pvtEmptyDistributor, support, platforms.NewRegistry(&golang.Platform{}), &disabled.Provider{})
+
+	unsigned := getSignedProp("ccid", "0", t)
+	unsigned.Signature = nil
+	resps, err := es.ProcessProposals(context.Background(), &pb.SignedProposals{
+		Proposals: []*pb.SignedProposal{getSignedProp("ccid", "0", t), unsigned, getSignedProp("ccid", "0", t)},
+	})
+	require.NoError(t, err)
+	require.Len(t, resps.Responses, 3)
+	assert.EqualValues(t, 200, resps.Responses[0].Response.Status)
+	assert.EqualValues(t, 500, resps.Responses[1].Response.Status)
+	assert.EqualValues(t, 200, resps.Responses[2].Response.Status)
+
+	tests := []struct {
+		name         string
+		proposals    []*pb.SignedProposal
+		maxBatchSize int
+		expectedErr  string
+	}{
+		{
+			name:        "empty batch",
+			expectedErr: "empty batch of proposals",
+		},
+		{
+			name:         "batch too large",
+			proposals:    []*pb.SignedProposal{getSignedProp("ccid", "0", t), getSignedProp("ccid", "0", t)},
+			maxBatchSize: 1,
+			expectedErr:  "batch of 2 proposals exceeds the maximum batch size of 1",
+		},
+		{
+			name:        "different chaincodes",
+			proposals:   []*pb.SignedProposal{getSignedProp("ccid", "0", t), getSignedProp("othercc", "0", t)},
+			expectedErr: "proposal 1 of the batch is for chaincode othercc on channel " + util.GetTestChainID() + ", expected chaincode ccid on channel " + util.GetTestChainID(),
+		},
+		{
+			name:        "different channels",
+			proposals:   []*pb.SignedProposal{getSignedProp("ccid", "0", t), getSignedPropWithCHID("ccid", "0", "otherchannel", t)},
+			expectedErr: "proposal 1 of the batch is for chaincode ccid on channel otherchannel, expected chaincode ccid on channel " + util.GetTestChainID(),
+		},
+		{
+			name:        "malformed proposal",
+			proposals:   []*pb.SignedProposal{getSignedProp("ccid", "0", t), {ProposalBytes: []byte("garbage")}},
+			expectedErr: "invalid proposal 1 of the batch: error unmarshaling Proposal: proto: can't skip unknown wire type 7",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			es.MaxBatchSize = tt.maxBatchSize
+			_, err := es.ProcessProposals(context.Background(), &pb.SignedProposals{Proposals: tt.proposals})
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
+
+type aclCountingSupport struct {
+	*em.MockSupport
+	aclChecks int32
+}
+
+func (s *aclCountingSupport) CheckACL(signedProp *pb.SignedProposal, chdr *common.ChannelHeader, shdr *common.SignatureHeader, hdrext *pb.ChaincodeHeaderExtension) error {
+	atomic.AddInt32(&s.aclChecks, 1)
+	return s.MockSupport.CheckACL(signedProp, chdr, shdr, hdrext)
+}
+
+func TestEndorserProcessProposalsSharedACL(t *testing.T) {
+	m := &mock.Mock{}
+	m.On("Sign", mock.Anything).Return([]byte{1, 2, 3, 4, 5}, nil)
+	m.On("Serialize").Return([]byte{1, 1, 1}, nil)
+	m.On("GetTxSimulator", mock.Anything, mock.Anything).Return(newMockTxSim(), nil)
+	mockSupport := &em.MockSupport{
+		Mock:                       m,
+		GetApplicationConfigBoolRv: true,
+		GetApplicationConfigRv:     &mc.MockApplication{CapabilitiesRv: &mc.MockApplicationCapabilities{}},
+		GetTransactionByIDErr:      errors.New(""),
+		ChaincodeDefinitionRv:      &ccprovider.ChaincodeData{Name: "ccid", Version: "0", Escc: "ESCC"},
+		ExecuteResp:                &pb.Response{Status: 200, Payload: protoutil.MarshalOrPanic(&pb.ProposalResponse{Response: &pb.Response{}})},
+	}
+	attachPluginEndorser(mockSupport, nil)
+	support := &aclCountingSupport{MockSupport: mockSupport}
+	es := endorser.NewEndorserServer(pvtEmptyDistributor, support, platforms.NewRegistry(&golang.Platform{}), &disabled.Provider{})
+
+	// The ACL is evaluated once for the creator of the batch
+	batch := &pb.SignedProposals{
+		Proposals: []*pb.SignedProposal{getSignedProp("ccid", "0", t), getSignedProp("ccid", "0", t), getSignedProp("ccid", "0", t)},
+	}
+	resps, err := es.ProcessProposals(context.Background(), batch)
+	require.NoError(t, err)
+	for _, resp := range resps.Responses {
+		assert.EqualValues(t, 200, resp.Response.Status)
+	}
+	assert.EqualValues(t, 1, atomic.LoadInt32(&support.aclChecks))
+
+	// A denied ACL is reported for each proposal of the creator
+	mockSupport.CheckACLErr = errors.New("access denied")
+	resps, err = es.ProcessProposals(context.Background(), batch)
+	require.NoError(t, err)
+	for _, resp := range resps.Responses {
+		assert.EqualValues(t, 500, resp.Response.Status)
+		assert.Equal(t, "access denied", resp.Response.Message)
+	}
+	assert.EqualValues(t, 2, atomic.LoadInt32(&support.aclChecks))
+}
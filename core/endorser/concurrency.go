@@ -0,0 +1,274 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package endorser
+
+import (
+	"container/list"
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// DefaultRetryAfter is the delay after which clients are told to retry the
+// proposals rejected because the endorser is saturated, if
+// peer.concurrencyLimits.retryAfter is not set
+const DefaultRetryAfter = time.Second
+
+// ConcurrencyLimits defines the limits on the number of proposals that the
+// endorser executes concurrently. A limit of 0 means no limit.
+type ConcurrencyLimits struct {
+	// Global is the maximum number of proposals executed concurrently
+	Global int `yaml:"global"`
+	// PerChaincode is the maximum number of proposals executed concurrently
+	// for the same chaincode
+	PerChaincode int `yaml:"perChaincode"`
+	// MaxQueued is the maximum number of proposals waiting to be executed,
+	// after which proposals are rejected. If 0, proposals are rejected as
+	// soon as the limits are reached.
+	MaxQueued int `yaml:"maxQueued"`
+	// QueueTimeout is the maximum time a proposal waits to be executed,
+	// after which it is rejected. If 0, proposals wait until the client
+	// cancels them.
+	QueueTimeout time.Duration `yaml:"queueTimeout"`
+	// RetryAfter is the delay after which the clients are told to retry the
+	// rejected proposals, or DefaultRetryAfter if 0
+	RetryAfter time.Duration `yaml:"retryAfter"`
+}
+
+// SaturatedError is returned for the proposals rejected because the limits
+// on the concurrent proposals are reached
+type SaturatedError struct {
+	Reason     string
+	RetryAfter time.Duration
+}
+
+func (e *SaturatedError) Error() string {
+	return e.Reason + ", retry after " + e.RetryAfter.String()
+}
+
+// RetryAfterHeader is the gRPC header that tells the clients after how many
+// seconds to retry the proposals rejected because the endorser is saturated
+const RetryAfterHeader = "retry-after"
+
+// saturatedResponse returns the response to a proposal rejected with the
+// given error, and sets the retry-after header of the gRPC response if the
+// endorser is saturated
+func saturatedResponse(ctx context.Context, err error) *pb.ProposalResponse {
+	status := int32(500)
+	if se, ok := err.(*SaturatedError); ok {
+		status = int32(common.Status_SERVICE_UNAVAILABLE)
+		seconds := int64((se.RetryAfter + time.Second - 1) / time.Second)
+		// the header cannot be set outside of a gRPC call, or once the
+		// response of the call is sent, such as for the proposals of a batch
+		grpc.SetHeader(ctx, metadata.Pairs(RetryAfterHeader, strconv.FormatInt(seconds, 10)))
+	}
+	return &pb.ProposalResponse{Response: &pb.Response{Status: status, Message: err.Error()}}
+}
+
+// ConcurrencyLimiter limits the number of proposals executed concurrently,
+// globally and for each chaincode. The proposals that cannot be executed
+// right away are queued by the identity of their creator, and the queues
+// of the creators are served in turn as proposals complete, so that a
+// client sending proposals at a high rate does not delay the proposals of
+// the other clients.
+type ConcurrencyLimiter struct {
+	limits ConcurrencyLimits
+
+	mutex       sync.Mutex
+	running     int
+	runningByCC map[string]int
+	queued      int
+	queues      map[string]*list.List
+	creators    []string
+	next        int
+}
+
+type concurrencyWaiter struct {
+	chaincode string
+	granted   chan struct{}
+}
+
+// NewConcurrencyLimiter creates a ConcurrencyLimiter with the given limits
+func NewConcurrencyLimiter(limits ConcurrencyLimits) *ConcurrencyLimiter {
+	if limits.RetryAfter <= 0 {
+		limits.RetryAfter = DefaultRetryAfter
+	}
+	return &ConcurrencyLimiter{
+		limits:      limits,
+		runningByCC: make(map[string]int),
+		queues:      make(map[string]*list.List),
+	}
+}
+
+// Acquire waits until a proposal of the given creator for the given
+// chaincode can be executed, and returns a function to call once the
+// proposal is executed. It returns a SaturatedError if the proposal is
+// rejected, or the error of the context if it is done first.
+func (l *ConcurrencyLimiter) Acquire(ctx context.Context, creator []byte, chaincode string) (func(), error) {
+	release := func() { l.release(chaincode) }
+
+	l.mutex.Lock()
+	if l.available(chaincode) {
+		l.start(chaincode)
+		l.mutex.Unlock()
+		return release, nil
+	}
+	if l.queued >= l.limits.MaxQueued {
+		l.mutex.Unlock()
+		return nil, l.saturated("too many concurrent proposals")
+	}
+	w := &concurrencyWaiter{chaincode: chaincode, granted: make(chan struct{})}
+	elem := l.enqueue(string(creator), w)
+	l.mutex.Unlock()
+
+	var timeout <-chan time.Time
+	if l.limits.QueueTimeout > 0 {
+		timer := time.NewTimer(l.limits.QueueTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	var err error
+	select {
+	case <-w.granted:
+		return release, nil
+	case <-timeout:
+		err = l.saturated("timed out waiting for concurrent proposals to complete")
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	select {
+	case <-w.granted:
+		// the proposal was granted while giving up on it
+		l.finish(chaincode)
+	default:
+		l.dequeue(string(creator), elem)
+	}
+	return nil, err
+}
+
+func (l *ConcurrencyLimiter) saturated(reason string) error {
+	return &SaturatedError{Reason: reason, RetryAfter: l.limits.RetryAfter}
+}
+
+func (l *ConcurrencyLimiter) release(chaincode string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.finish(chaincode)
+}
+
+// finish accounts for the completion of a proposal for the chaincode, and
+// starts the queued proposals that can then be executed
+func (l *ConcurrencyLimiter) finish(chaincode string) {
+	l.running--
+	l.runningByCC[chaincode]--
+	if l.runningByCC[chaincode] == 0 {
+		delete(l.runningByCC, chaincode)
+	}
+	l.dispatch()
+}
+
+// available returns whether a proposal for the chaincode can be executed
+// without exceeding the limits
+func (l *ConcurrencyLimiter) available(chaincode string) bool {
+	if l.limits.Global > 0 && l.running >= l.limits.Global {
+		return false
+	}
+	return l.limits.PerChaincode <= 0 || l.runningByCC[chaincode] < l.limits.PerChaincode
+}
+
+func (l *ConcurrencyLimiter) start(chaincode string) {
+	l.running++
+	l.runningByCC[chaincode]++
+}
+
+func (l *ConcurrencyLimiter) enqueue(creator string, w *concurrencyWaiter) *list.Element {
+	q, exists := l.queues[creator]
+	if !exists {
+		q = list.New()
+		l.queues[creator] = q
+		l.creators = append(l.creators, creator)
+	}
+	l.queued++
+	return q.PushBack(w)
+}
+
+func (l *ConcurrencyLimiter) dequeue(creator string, elem *list.Element) {
+	q := l.queues[creator]
+	q.Remove(elem)
+	l.queued--
+	if q.Len() == 0 {
+		l.removeCreator(creator)
+	}
+}
+
+func (l *ConcurrencyLimiter) removeCreator(creator string) {
+	delete(l.queues, creator)
+	for i, c := range l.creators {
+		if c != creator {
+			continue
+		}
+		l.creators = append(l.creators[:i], l.creators[i+1:]...)
+		if i < l.next {
+			l.next--
+		}
+		break
+	}
+	if l.next >= len(l.creators) {
+		l.next = 0
+	}
+}
+
+// dispatch starts the queued proposals that can be executed, taking the
+// first executable proposal of each creator in turn
+func (l *ConcurrencyLimiter) dispatch() {
+	for {
+		started := false
+		for n := len(l.creators); n > 0 && len(l.creators) > 0; n-- {
+			if l.limits.Global > 0 && l.running >= l.limits.Global {
+				return
+			}
+			creator := l.creators[l.next]
+			q := l.queues[creator]
+			var elem *list.Element
+			for e := q.Front(); e != nil; e = e.Next() {
+				if l.available(e.Value.(*concurrencyWaiter).chaincode) {
+					elem = e
+					break
+				}
+			}
+			if elem == nil {
+				l.next = (l.next + 1) % len(l.creators)
+				continue
+			}
+
+			w := elem.Value.(*concurrencyWaiter)
+			q.Remove(elem)
+			l.queued--
+			l.start(w.chaincode)
+			close(w.granted)
+			started = true
+			if q.Len() == 0 {
+				l.removeCreator(creator)
+			} else {
+				l.next = (l.next + 1) % len(l.creators)
+			}
+		}
+		if !started {
+			return
+		}
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package endorser
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/protos/common"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (l *ConcurrencyLimiter) queuedProposals() int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.queued
+}
+
+func TestConcurrencyLimiterLimits(t *testing.T) {
+	l := NewConcurrencyLimiter(ConcurrencyLimits{Global: 2, PerChaincode: 1})
+	ctx := context.Background()
+
+	releaseA, err := l.Acquire(ctx, []byte("alice"), "cca")
+	require.NoError(t, err)
+
+	// The chaincode limit is reached for cca but not for ccb
+	_, err = l.Acquire(ctx, []byte("bob"), "cca")
+	assert.EqualError(t, err, "too many concurrent proposals, retry after 1s")
+	assert.Equal(t, &SaturatedError{Reason: "too many concurrent proposals", RetryAfter: DefaultRetryAfter}, err)
+	releaseB, err := l.Acquire(ctx, []byte("bob"), "ccb")
+	require.NoError(t, err)
+
+	// The global limit is reached
+	_, err = l.Acquire(ctx, []byte("carol"), "ccc")
+	assert.IsType(t, &SaturatedError{}, err)
+
+	releaseA()
+	_, err = l.Acquire(ctx, []byte("carol"), "ccc")
+	assert.NoError(t, err)
+	releaseB()
+	_, err = l.Acquire(ctx, []byte("bob"), "cca")
+	assert.NoError(t, err)
+}
+
+func TestConcurrencyLimiterFairQueuing(t *testing.T) {
+	gt := NewGomegaWithT(t)
+	l := NewConcurrencyLimiter(ConcurrencyLimits{Global: 1, MaxQueued: 4})
+	ctx := context.Background()
+
+	release, err := l.Acquire(ctx, []byte("alice"), "mycc")
+	require.NoError(t, err)
+
+	type grant struct {
+		creator string
+		release func()
+	}
+	grants := make(chan grant)
+	queue := func(creator string) {
+		queued := l.queuedProposals()
+		go func() {
+			release, err := l.Acquire(ctx, []byte(creator), "mycc")
+			assert.NoError(t, err)
+			grants <- grant{creator: creator, release: release}
+		}()
+		gt.Eventually(l.queuedProposals).Should(Equal(queued + 1))
+	}
+	queue("alice")
+	queue("alice")
+	queue("alice")
+	queue("bob")
+
+	// The queue is full
+	_, err = l.Acquire(ctx, []byte("carol"), "mycc")
+	assert.IsType(t, &SaturatedError{}, err)
+
+	// The queued proposals of alice and bob are executed in turn
+	var creators []string
+	for i := 0; i < 4; i++ {
+		release()
+		g := <-grants
+		creators = append(creators, g.creator)
+		release = g.release
+	}
+	assert.Equal(t, []string{"alice", "bob", "alice", "alice"}, creators)
+	assert.Equal(t, 0, l.queuedProposals())
+}
+
+func TestConcurrencyLimiterQueuedPerChaincode(t *testing.T) {
+	l := NewConcurrencyLimiter(ConcurrencyLimits{PerChaincode: 1, MaxQueued: 10})
+	ctx := context.Background()
+
+	releaseA, err := l.Acquire(ctx, []byte("alice"), "cca")
+	require.NoError(t, err)
+
+	granted := make(chan struct{})
+	go func() {
+		_, err := l.Acquire(ctx, []byte("alice"), "cca")
+		assert.NoError(t, err)
+		close(granted)
+	}()
+	NewGomegaWithT(t).Eventually(l.queuedProposals).Should(Equal(1))
+
+	// A proposal for another chaincode is not delayed by the queued proposal
+	_, err = l.Acquire(ctx, []byte("bob"), "ccb")
+	assert.NoError(t, err)
+
+	releaseA()
+	<-granted
+}
+
+func TestConcurrencyLimiterGiveUp(t *testing.T) {
+	l := NewConcurrencyLimiter(ConcurrencyLimits{Global: 1, MaxQueued: 10, QueueTimeout: 10 * time.Millisecond, RetryAfter: 5 * time.Second})
+
+	release, err := l.Acquire(context.Background(), []byte("alice"), "mycc")
+	require.NoError(t, err)
+
+	_, err = l.Acquire(context.Background(), []byte("bob"), "mycc")
+	assert.EqualError(t, err, "timed out waiting for concurrent proposals to complete, retry after 5s")
+	assert.Equal(t, 0, l.queuedProposals())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = l.Acquire(ctx, []byte("bob"), "mycc")
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 0, l.queuedProposals())
+
+	release()
+	_, err = l.Acquire(context.Background(), []byte("bob"), "mycc")
+	assert.NoError(t, err)
+}
+
+func TestSaturatedResponse(t *testing.T) {
+	resp := saturatedResponse(context.Background(), &SaturatedError{Reason: "too many concurrent proposals", RetryAfter: time.Second})
+	assert.Equal(t, int32(common.Status_SERVICE_UNAVAILABLE), resp.Response.Status)
+	assert.Equal(t, "too many concurrent proposals, retry after 1s", resp.Response.Message)
+
+	resp = saturatedResponse(context.Background(), errors.New("context canceled"))
+	assert.Equal(t, int32(500), resp.Response.Status)
+	assert.Equal(t, "context canceled", resp.Response.Message)
+}
@@ -17,13 +17,16 @@ import (
 	"github.com/hyperledger/fabric/common/crypto"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/hyperledger/fabric/common/redaction"
 	"github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/core/chaincode/platforms"
 	"github.com/hyperledger/fabric/core/chaincode/shim"
 	"github.com/hyperledger/fabric/core/common/ccprovider"
 	"github.com/hyperledger/fabric/core/common/validation"
 	"github.com/hyperledger/fabric/core/ledger"
+	mspmgmt "github.com/hyperledger/fabric/msp/mgmt"
 	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/ledger/rwset"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/hyperledger/fabric/protos/transientstore"
 	"github.com/hyperledger/fabric/protoutil"
@@ -62,7 +65,7 @@ type Support interface {
 	IsSysCC(name string) bool
 
 	// Execute - execute proposal, return original response of chaincode
-	Execute(txParams *ccprovider.TransactionParams, cid, name, version, txid string, idBytes []byte, initRequired bool, signedProp *pb.SignedProposal, prop *pb.Proposal, input *pb.ChaincodeInput) (*pb.Response, *pb.ChaincodeEvent, error)
+	Execute(txParams *ccprovider.TransactionParams, cid, name, version, txid string, idBytes []byte, initRequired, readYourWrites bool, signedProp *pb.SignedProposal, prop *pb.Proposal, input *pb.ChaincodeInput) (*pb.Response, *pb.ChaincodeEvent, error)
 
 	// ExecuteLegacyInit - executes a deployment proposal, return original response of chaincode
 	ExecuteLegacyInit(txParams *ccprovider.TransactionParams, cid, name, version, txid string, signedProp *pb.SignedProposal, prop *pb.Proposal, spec *pb.ChaincodeDeploymentSpec) (*pb.Response, *pb.ChaincodeEvent, error)
@@ -98,6 +101,14 @@ type Support interface {
 	// GetLedgerHeight returns ledger height for given channelID
 	GetLedgerHeight(channelID string) (uint64, error)
 
+	// GetChannelTipHeight returns the highest ledger height reached by a
+	// majority of the peers of the channel, or 0 if it is unknown
+	GetChannelTipHeight(channelID string) uint64
+
+	// WaitForLedgerHeight waits until the ledger of the channel reaches the
+	// given height, including the state of its last block, or the context is done
+	WaitForLedgerHeight(ctx context.Context, channelID string, height uint64) error
+
 	// GetDeployedCCInfoProvider returns ledger.DeployedChaincodeInfoProvider
 	GetDeployedCCInfoProvider() ledger.DeployedChaincodeInfoProvider
 }
@@ -109,6 +120,35 @@ type Endorser struct {
 	PlatformRegistry      *platforms.Registry
 	PvtRWSetAssembler
 	Metrics *EndorserMetrics
+	// WriteSetPolicy defines the limits on the write-sets of the endorsed
+	// transactions, if any
+	WriteSetPolicy *WriteSetPolicy
+	// SimulationRecorder records the read-write sets of the simulations, if
+	// simulation recording is enabled
+	SimulationRecorder SimulationRecorder
+	// MaxBatchSize is the maximum number of proposals of a batch endorsed
+	// with ProcessProposals, or DefaultMaxBatchSize if not positive
+	MaxBatchSize int
+	// ConcurrencyLimiter limits the number of proposals executed
+	// concurrently, if concurrency limits are set
+	ConcurrencyLimiter *ConcurrencyLimiter
+	// InvocationQuotas enforces the invocation quotas of the channels
+	InvocationQuotas *InvocationQuotas
+	// SimulationHeightTimeout is the maximum time to wait for the ledger of
+	// a channel to reach the simulation height requested by a proposal, or
+	// DefaultSimulationHeightTimeout if not positive
+	SimulationHeightTimeout time.Duration
+	// MaxBlocksBehind is the maximum number of blocks the ledger of a
+	// channel may lag behind the tip of the channel for the peer to endorse
+	// proposals on it, or 0 to endorse regardless of the lag
+	MaxBlocksBehind uint64
+}
+
+// SimulationRecorder records the read-write sets of the simulations of
+// proposals for debugging endorsement mismatches
+type SimulationRecorder interface {
+	// Record records the public read-write set of the simulation of the given proposal
+	Record(signedProp *pb.SignedProposal, channel, txID string, cid *pb.ChaincodeID, results *rwset.TxReadWriteSet)
 }
 
 // validateResult provides the result of endorseProposal verification
@@ -117,6 +157,7 @@ type validateResult struct {
 	hdrExt  *pb.ChaincodeHeaderExtension
 	chainID string
 	txid    string
+	creator []byte
 	resp    *pb.ProposalResponse
 }
 
@@ -128,13 +169,15 @@ func NewEndorserServer(privDist privateDataDistributor, s Support, pr *platforms
 		PlatformRegistry:      pr,
 		PvtRWSetAssembler:     &rwSetAssembler{},
 		Metrics:               NewEndorserMetrics(metricsProv),
+		InvocationQuotas:      NewInvocationQuotas(mspmgmt.GetIdentityDeserializer),
 	}
 	return e
 }
 
 // call specified chaincode (system or user)
-func (e *Endorser) callChaincode(txParams *ccprovider.TransactionParams, version string, idBytes []byte, requiresInit bool, input *pb.ChaincodeInput, cid *pb.ChaincodeID) (*pb.Response, *pb.ChaincodeEvent, error) {
+func (e *Endorser) callChaincode(txParams *ccprovider.TransactionParams, version string, idBytes []byte, requiresInit, readYourWrites bool, input *pb.ChaincodeInput, cid *pb.ChaincodeID) (*pb.Response, *pb.ChaincodeEvent, error) {
 	endorserLogger.Infof("[%s][%s] Entry chaincode: %s", txParams.ChannelID, shorttxid(txParams.TxID), cid)
+	endorserLogger.Debugf("[%s][%s] Invoking chaincode %s with args %s", txParams.ChannelID, shorttxid(txParams.TxID), cid.Name, redaction.Args(input.GetArgs()))
 	defer func(start time.Time) {
 		logger := endorserLogger.WithOptions(zap.AddCallerSkip(1))
 		elapsedMilliseconds := time.Since(start).Round(time.Millisecond) / time.Millisecond
@@ -146,7 +189,7 @@ func (e *Endorser) callChaincode(txParams *ccprovider.TransactionParams, version
 	var ccevent *pb.ChaincodeEvent
 
 	// is this a system chaincode
-	res, ccevent, err = e.s.Execute(txParams, txParams.ChannelID, cid.Name, version, txParams.TxID, idBytes, requiresInit, txParams.SignedProp, txParams.Proposal, input)
+	res, ccevent, err = e.s.Execute(txParams, txParams.ChannelID, cid.Name, version, txParams.TxID, idBytes, requiresInit, readYourWrites, txParams.SignedProp, txParams.Proposal, input)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -229,6 +272,7 @@ func (e *Endorser) SimulateProposal(txParams *ccprovider.TransactionParams, cid
 
 	var idBytes []byte
 	requiresInit := false
+	readYourWrites := false
 	if !e.s.IsSysCC(cid.Name) {
 		cdLedger, err = e.s.GetChaincodeDefinition(cid.Name, txParams.TXSimulator)
 		if err != nil {
@@ -242,6 +286,7 @@ func (e *Endorser) SimulateProposal(txParams *ccprovider.TransactionParams, cid
 			return nil, nil, nil, nil, err
 		}
 		requiresInit = cdLedger.RequiresInit()
+		readYourWrites = cdLedger.ReadYourWrites()
 	} else {
 		version = util.GetSysCCVersion()
 	}
@@ -251,7 +296,7 @@ func (e *Endorser) SimulateProposal(txParams *ccprovider.TransactionParams, cid
 	var pubSimResBytes []byte
 	var res *pb.Response
 	var ccevent *pb.ChaincodeEvent
-	res, ccevent, err = e.callChaincode(txParams, version, idBytes, requiresInit, cis.ChaincodeSpec.Input, cid)
+	res, ccevent, err = e.callChaincode(txParams, version, idBytes, requiresInit, readYourWrites, cis.ChaincodeSpec.Input, cid)
 	if err != nil {
 		endorserLogger.Errorf("[%s][%s] failed to invoke chaincode %s, error: %+v", txParams.ChannelID, shorttxid(txParams.TxID), cid, err)
 		return nil, nil, nil, nil, err
@@ -263,6 +308,16 @@ func (e *Endorser) SimulateProposal(txParams *ccprovider.TransactionParams, cid
 			return nil, nil, nil, nil, err
 		}
 
+		if e.SimulationRecorder != nil {
+			e.SimulationRecorder.Record(txParams.SignedProp, txParams.ChannelID, txParams.TxID, cid, simResult.PubSimulationResults)
+		}
+
+		if err = e.WriteSetPolicy.Check(txParams.ChannelID, simResult.PubSimulationResults); err != nil {
+			txParams.TXSimulator.Done()
+			endorserLogger.Warningf("[%s][%s] rejecting proposal for chaincode %s: %s", txParams.ChannelID, shorttxid(txParams.TxID), cid.Name, err)
+			return nil, nil, nil, nil, err
+		}
+
 		if simResult.PvtSimulationResults != nil {
 			if cid.Name == "lscc" {
 				// TODO: remove once we can store collection configuration outside of LSCC
@@ -351,11 +406,17 @@ func (e *Endorser) endorseProposal(_ context.Context, chainID string, txid strin
 	return e.s.EndorseWithPlugin(ctx)
 }
 
-// preProcess checks the tx proposal headers, uniqueness and ACL
-func (e *Endorser) preProcess(signedProp *pb.SignedProposal) (*validateResult, error) {
+// preProcess checks the tx proposal headers, uniqueness and ACL. The
+// validation of the creator and the ACL decision are shared with the other
+// proposals of the batch if the proposal is part of a batch.
+func (e *Endorser) preProcess(signedProp *pb.SignedProposal, batch *batchChecks) (*validateResult, error) {
 	vr := &validateResult{}
 	// at first, we check whether the message is valid
-	prop, hdr, hdrExt, err := validation.ValidateProposalMessage(signedProp)
+	validateProposalMessage := validation.ValidateProposalMessage
+	if batch != nil {
+		validateProposalMessage = batch.creators.ValidateProposalMessage
+	}
+	prop, hdr, hdrExt, err := validateProposalMessage(signedProp)
 
 	if err != nil {
 		e.Metrics.ProposalValidationFailed.Add(1)
@@ -396,7 +457,9 @@ func (e *Endorser) preProcess(signedProp *pb.SignedProposal) (*validateResult, e
 
 		// Here we handle uniqueness check and ACLs for proposals targeting a chain
 		// Notice that ValidateProposalMessage has already verified that TxID is computed properly
-		if _, err = e.s.GetTransactionByID(chainID, txid); err == nil {
+		// The transactions of the snapshot a ledger was created from are duplicates as well
+		_, err = e.s.GetTransactionByID(chainID, txid)
+		if _, beforeSnapshot := errors.Cause(err).(*ledger.ErrTxBeforeSnapshot); err == nil || beforeSnapshot {
 			// increment failure due to duplicate transactions. Useful for catching replay attacks in
 			// addition to benign retries
 			e.Metrics.DuplicateTxsFailure.With(meterLabels...).Add(1)
@@ -409,7 +472,13 @@ func (e *Endorser) preProcess(signedProp *pb.SignedProposal) (*validateResult, e
 		// for system chaincodes are checked elsewhere
 		if !e.s.IsSysCC(hdrExt.ChaincodeId.Name) {
 			// check that the proposal complies with the Channel's writers
-			if err = e.s.CheckACL(signedProp, chdr, shdr, hdrExt); err != nil {
+			checkACL := func() error { return e.s.CheckACL(signedProp, chdr, shdr, hdrExt) }
+			if batch != nil {
+				err = batch.checkACL(chainID, shdr.Creator, checkACL)
+			} else {
+				err = checkACL()
+			}
+			if err != nil {
 				e.Metrics.ProposalACLCheckFailed.With(meterLabels...).Add(1)
 				vr.resp = &pb.ProposalResponse{Response: &pb.Response{Status: 500, Message: err.Error()}}
 				return vr, err
@@ -422,12 +491,41 @@ func (e *Endorser) preProcess(signedProp *pb.SignedProposal) (*validateResult, e
 		// MSP of the peer instead by the call to ValidateProposalMessage above
 	}
 
-	vr.prop, vr.hdrExt, vr.chainID, vr.txid = prop, hdrExt, chainID, txid
+	vr.prop, vr.hdrExt, vr.chainID, vr.txid, vr.creator = prop, hdrExt, chainID, txid, shdr.Creator
 	return vr, nil
 }
 
+// checkInvocationQuota accounts for a proposal of the creator for the
+// chaincode on the channel, and returns a QuotaExceededError if the creator
+// has exhausted the invocation quota of the channel. Proposals for system
+// chaincodes and chainless proposals are not subject to the quota.
+func (e *Endorser) checkInvocationQuota(chainID string, creator []byte, ccName string) error {
+	if chainID == "" || e.InvocationQuotas == nil || e.s.IsSysCC(ccName) {
+		return nil
+	}
+	ac, exists := e.s.GetApplicationConfig(chainID)
+	if !exists {
+		return nil
+	}
+	quota := ac.InvocationQuota()
+	if quota == nil {
+		return e.InvocationQuotas.Consume(chainID, creator, nil, 0)
+	}
+	height, err := e.s.GetLedgerHeight(chainID)
+	if err != nil {
+		return err
+	}
+	return e.InvocationQuotas.Consume(chainID, creator, quota, height)
+}
+
 // ProcessProposal process the Proposal
 func (e *Endorser) ProcessProposal(ctx context.Context, signedProp *pb.SignedProposal) (*pb.ProposalResponse, error) {
+	return e.processProposal(ctx, signedProp, nil)
+}
+
+// processProposal processes the proposal, sharing the checks of its creator
+// with the other proposals of the batch if the proposal is part of a batch
+func (e *Endorser) processProposal(ctx context.Context, signedProp *pb.SignedProposal, batch *batchChecks) (*pb.ProposalResponse, error) {
 	// start time for computing elapsed time metric for successfully endorsed proposals
 	startTime := time.Now()
 	e.Metrics.ProposalsReceived.Add(1)
@@ -456,7 +554,7 @@ func (e *Endorser) ProcessProposal(ctx context.Context, signedProp *pb.SignedPro
 	}()
 
 	// 0 -- check and validate
-	vr, err := e.preProcess(signedProp)
+	vr, err := e.preProcess(signedProp, batch)
 	if err != nil {
 		resp := vr.resp
 		return resp, err
@@ -464,14 +562,48 @@ func (e *Endorser) ProcessProposal(ctx context.Context, signedProp *pb.SignedPro
 
 	prop, hdrExt, chainID, txid := vr.prop, vr.hdrExt, vr.chainID, vr.txid
 
+	if err := e.checkReadiness(chainID, hdrExt.ChaincodeId.Name); err != nil {
+		endorserLogger.Warningf("[%s][%s] Rejecting proposal for chaincode %s from %s: %s", chainID, shorttxid(txid), hdrExt.ChaincodeId.Name, addr, err)
+		status := int32(500)
+		if _, ok := err.(*NotReadyError); ok {
+			status = int32(common.Status_SERVICE_UNAVAILABLE)
+		}
+		return &pb.ProposalResponse{Response: &pb.Response{Status: status, Message: err.Error()}}, nil
+	}
+
+	if err := e.checkInvocationQuota(chainID, vr.creator, hdrExt.ChaincodeId.Name); err != nil {
+		endorserLogger.Warningf("[%s][%s] Rejecting proposal for chaincode %s from %s: %s", chainID, shorttxid(txid), hdrExt.ChaincodeId.Name, addr, err)
+		status := int32(500)
+		if _, ok := err.(*QuotaExceededError); ok {
+			status = int32(common.Status_FORBIDDEN)
+		}
+		return &pb.ProposalResponse{Response: &pb.Response{Status: status, Message: err.Error()}}, nil
+	}
+
+	if e.ConcurrencyLimiter != nil {
+		release, err := e.ConcurrencyLimiter.Acquire(ctx, vr.creator, hdrExt.ChaincodeId.Name)
+		if err != nil {
+			endorserLogger.Warningf("[%s][%s] Rejecting proposal for chaincode %s from %s: %s", chainID, shorttxid(txid), hdrExt.ChaincodeId.Name, addr, err)
+			return saturatedResponse(ctx, err), nil
+		}
+		defer release()
+	}
+
 	// obtaining once the tx simulator for this proposal. This will be nil
 	// for chainless proposals
 	// Also obtain a history query executor for history queries, since tx simulator does not cover history
 	var txsim ledger.TxSimulator
 	var historyQueryExecutor ledger.HistoryQueryExecutor
 	if acquireTxSimulator(chainID, vr.hdrExt.ChaincodeId) {
-		if txsim, err = e.s.GetTxSimulator(chainID, txid); err != nil {
-			return &pb.ProposalResponse{Response: &pb.Response{Status: 500, Message: err.Error()}}, nil
+		if txsim, err = e.getTxSimulator(ctx, chainID, txid, hdrExt.SimulationHeight); err != nil {
+			status := int32(500)
+			switch err.(type) {
+			case *SimulationHeightError:
+				status = int32(common.Status_SERVICE_UNAVAILABLE)
+			case *SimulationHeightPassedError:
+				status = int32(common.Status_GONE)
+			}
+			return &pb.ProposalResponse{Response: &pb.Response{Status: status, Message: err.Error()}}, nil
 		}
 
 		// txsim acquires a shared lock on the stateDB. As this would impact the block commits (i.e., commit
@@ -486,6 +618,8 @@ func (e *Endorser) ProcessProposal(ctx context.Context, signedProp *pb.SignedPro
 		if historyQueryExecutor, err = e.s.GetHistoryQueryExecutor(chainID); err != nil {
 			return &pb.ProposalResponse{Response: &pb.Response{Status: 500, Message: err.Error()}}, nil
 		}
+	} else if hdrExt.SimulationHeight > 0 {
+		return &pb.ProposalResponse{Response: &pb.Response{Status: int32(common.Status_BAD_REQUEST), Message: fmt.Sprintf("a simulation height cannot be requested for chaincode %s", hdrExt.ChaincodeId.Name)}}, nil
 	}
 
 	txParams := &ccprovider.TransactionParams{
@@ -522,6 +656,7 @@ func (e *Endorser) ProcessProposal(ctx context.Context, signedProp *pb.SignedPro
 			if err != nil {
 				return &pb.ProposalResponse{Response: &pb.Response{Status: 500, Message: err.Error()}}, nil
 			}
+			pResp.SimulationHeight = hdrExt.SimulationHeight
 
 			return pResp, nil
 		}
@@ -563,6 +698,7 @@ func (e *Endorser) ProcessProposal(ctx context.Context, signedProp *pb.SignedPro
 	// contains the "return value" from the
 	// chaincode invocation
 	pResp.Response = res
+	pResp.SimulationHeight = hdrExt.SimulationHeight
 
 	// total failed proposals = ProposalsReceived-SuccessfulProposals
 	e.Metrics.SuccessfulProposals.Add(1)
@@ -41,6 +41,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func pvtEmptyDistributor(_ string, _ string, _ *transientstore.TxPvtReadWriteSetWithConfigInfo, _ uint64) error {
@@ -347,6 +348,31 @@ func TestEndorserDupTXId(t *testing.T) {
 	assert.EqualValues(t, 1, fakeMetrics.duplicateTxsFailure.AddArgsForCall(0))
 }
 
+func TestEndorserDupTXIdBeforeSnapshot(t *testing.T) {
+	es := endorser.NewEndorserServer(pvtEmptyDistributor, &em.MockSupport{
+		GetApplicationConfigBoolRv: true,
+		GetApplicationConfigRv:     &mc.MockApplication{CapabilitiesRv: &mc.MockApplicationCapabilities{}},
+		GetTransactionByIDErr:      errors.WithMessage(&ledger.ErrTxBeforeSnapshot{TxID: "txid", BlockNum: 5}, "GetTransactionByID failed"),
+		ChaincodeDefinitionRv:      &ccprovider.ChaincodeData{Escc: "ESCC"},
+		ExecuteResp:                &pb.Response{Status: 200, Payload: protoutil.MarshalOrPanic(&pb.ProposalResponse{Response: &pb.Response{}})},
+		GetTxSimulatorRv: &mockccprovider.MockTxSim{
+			GetTxSimulationResultsRv: &ledger.TxSimulationResults{
+				PubSimulationResults: &rwset.TxReadWriteSet{},
+			},
+		},
+	}, platforms.NewRegistry(&golang.Platform{}), &disabled.Provider{})
+
+	fakeMetrics := initFakeMetrics(es)
+
+	signedProp := getSignedProp("ccid", "0", t)
+
+	pResp, err := es.ProcessProposal(context.Background(), signedProp)
+	assert.Error(t, err)
+	assert.EqualValues(t, 500, pResp.Response.Status)
+	assert.Regexp(t, "duplicate transaction found", pResp.Response.Message)
+	assert.EqualValues(t, 1, fakeMetrics.duplicateTxsFailure.AddCallCount())
+}
+
 func TestEndorserBadACL(t *testing.T) {
 	es := endorser.NewEndorserServer(pvtEmptyDistributor, &em.MockSupport{
 		GetApplicationConfigBoolRv: true,
@@ -558,6 +584,318 @@ func TestEndorserGoodPath(t *testing.T) {
 	assert.EqualValues(t, 1, fakeMetrics.successfulProposals.AddArgsForCall(0))
 }
 
+func TestEndorserWriteSetLimits(t *testing.T) {
+	txsim := newMockTxSim()
+	txsim.GetTxSimulationResultsRv.PubSimulationResults = newPubSimResults(2, "ccid")
+	m := &mock.Mock{}
+	m.On("Sign", mock.Anything).Return([]byte{1, 2, 3, 4, 5}, nil)
+	m.On("Serialize").Return([]byte{1, 1, 1}, nil)
+	m.On("GetTxSimulator", mock.Anything, mock.Anything).Return(txsim, nil)
+	support := &em.MockSupport{
+		Mock:                       m,
+		GetApplicationConfigBoolRv: true,
+		GetApplicationConfigRv:     &mc.MockApplication{CapabilitiesRv: &mc.MockApplicationCapabilities{}},
+		GetTransactionByIDErr:      errors.New(""),
+		ChaincodeDefinitionRv:      &ccprovider.ChaincodeData{Name: "ccid", Version: "0", Escc: "ESCC"},
+		ExecuteResp:                &pb.Response{Status: 200, Payload: protoutil.MarshalOrPanic(&pb.ProposalResponse{Response: &pb.Response{}})},
+	}
+	attachPluginEndorser(support, nil)
+	es := endorser.NewEndorserServer(pvtEmptyDistributor, support, platforms.NewRegistry(&golang.Platform{}), &disabled.Provider{})
+	es.WriteSetPolicy = &endorser.WriteSetPolicy{WriteSetLimits: endorser.WriteSetLimits{MaxWrites: 1}}
+
+	pResp, err := es.ProcessProposal(context.Background(), getSignedProp("ccid", "0", t))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 500, pResp.Response.Status)
+	assert.Contains(t, pResp.Response.Message, "the transaction writes 2 keys, which exceeds the limit of 1 writes of channel")
+	assert.Nil(t, pResp.Endorsement)
+
+	es.WriteSetPolicy.MaxWrites = 2
+	pResp, err = es.ProcessProposal(context.Background(), getSignedProp("ccid", "0", t))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 200, pResp.Response.Status)
+}
+
+func TestEndorserConcurrencyLimits(t *testing.T) {
+	m := &mock.Mock{}
+	m.On("Sign", mock.Anything).Return([]byte{1, 2, 3, 4, 5}, nil)
+	m.On("Serialize").Return([]byte{1, 1, 1}, nil)
+	m.On("GetTxSimulator", mock.Anything, mock.Anything).Return(newMockTxSim(), nil)
+	support := &em.MockSupport{
+		Mock:                       m,
+		GetApplicationConfigBoolRv: true,
+		GetApplicationConfigRv:     &mc.MockApplication{CapabilitiesRv: &mc.MockApplicationCapabilities{}},
+		GetTransactionByIDErr:      errors.New(""),
+		ChaincodeDefinitionRv:      &ccprovider.ChaincodeData{Name: "ccid", Version: "0", Escc: "ESCC"},
+		ExecuteResp:                &pb.Response{Status: 200, Payload: protoutil.MarshalOrPanic(&pb.ProposalResponse{Response: &pb.Response{}})},
+	}
+	attachPluginEndorser(support, nil)
+	es := endorser.NewEndorserServer(pvtEmptyDistributor, support, platforms.NewRegistry(&golang.Platform{}), &disabled.Provider{})
+	es.ConcurrencyLimiter = endorser.NewConcurrencyLimiter(endorser.ConcurrencyLimits{PerChaincode: 1})
+
+	release, err := es.ConcurrencyLimiter.Acquire(context.Background(), []byte("client"), "ccid")
+	require.NoError(t, err)
+	pResp, err := es.ProcessProposal(context.Background(), getSignedProp("ccid", "0", t))
+	assert.NoError(t, err)
+	assert.EqualValues(t, common.Status_SERVICE_UNAVAILABLE, pResp.Response.Status)
+	assert.Equal(t, "too many concurrent proposals, retry after 1s", pResp.Response.Message)
+
+	release()
+	pResp, err = es.ProcessProposal(context.Background(), getSignedProp("ccid", "0", t))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 200, pResp.Response.Status)
+}
+
+func TestEndorserInvocationQuota(t *testing.T) {
+	m := &mock.Mock{}
+	m.On("Sign", mock.Anything).Return([]byte{1, 2, 3, 4, 5}, nil)
+	m.On("Serialize").Return([]byte{1, 1, 1}, nil)
+	m.On("GetTxSimulator", mock.Anything, mock.Anything).Return(newMockTxSim(), nil)
+	m.On("GetLedgerHeight", util.GetTestChainID()).Return(uint64(7), nil)
+	support := &em.MockSupport{
+		Mock:                       m,
+		GetApplicationConfigBoolRv: true,
+		GetApplicationConfigRv: &mc.MockApplication{
+			CapabilitiesRv:    &mc.MockApplicationCapabilities{},
+			InvocationQuotaRv: &pb.InvocationQuota{MaxInvocations: 1, BlockInterval: 5},
+		},
+		GetTransactionByIDErr: errors.New(""),
+		ChaincodeDefinitionRv: &ccprovider.ChaincodeData{Name: "ccid", Version: "0", Escc: "ESCC"},
+		ExecuteResp:           &pb.Response{Status: 200, Payload: protoutil.MarshalOrPanic(&pb.ProposalResponse{Response: &pb.Response{}})},
+	}
+	attachPluginEndorser(support, nil)
+	es := endorser.NewEndorserServer(pvtEmptyDistributor, support, platforms.NewRegistry(&golang.Platform{}), &disabled.Provider{})
+
+	pResp, err := es.ProcessProposal(context.Background(), getSignedProp("ccid", "0", t))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 200, pResp.Response.Status)
+
+	pResp, err = es.ProcessProposal(context.Background(), getSignedProp("ccid", "0", t))
+	assert.NoError(t, err)
+	assert.EqualValues(t, common.Status_FORBIDDEN, pResp.Response.Status)
+	assert.Equal(t, "invocation quota of channel testchainid exceeded: at most 1 proposals per client identity every 5 blocks", pResp.Response.Message)
+	assert.Nil(t, pResp.Endorsement)
+
+	// system chaincodes are not subject to the quota
+	support.IsSysCCRv = true
+	pResp, err = es.ProcessProposal(context.Background(), getSignedProp("ccid", "0", t))
+	assert.NoError(t, err)
+	assert.NotEqual(t, int32(common.Status_FORBIDDEN), pResp.Response.Status)
+}
+
+func TestEndorserReadiness(t *testing.T) {
+	m := &mock.Mock{}
+	m.On("Sign", mock.Anything).Return([]byte{1, 2, 3, 4, 5}, nil)
+	m.On("Serialize").Return([]byte{1, 1, 1}, nil)
+	m.On("GetTxSimulator", mock.Anything, mock.Anything).Return(newMockTxSim(), nil)
+	m.On("GetLedgerHeight", util.GetTestChainID()).Return(uint64(7), nil)
+	support := &em.MockSupport{
+		Mock:                       m,
+		GetApplicationConfigBoolRv: true,
+		GetApplicationConfigRv:     &mc.MockApplication{CapabilitiesRv: &mc.MockApplicationCapabilities{}},
+		GetTransactionByIDErr:      errors.New(""),
+		ChaincodeDefinitionRv:      &ccprovider.ChaincodeData{Name: "ccid", Version: "0", Escc: "ESCC"},
+		ExecuteResp:                &pb.Response{Status: 200, Payload: protoutil.MarshalOrPanic(&pb.ProposalResponse{Response: &pb.Response{}})},
+		ChannelTipHeightRv:         20,
+	}
+	attachPluginEndorser(support, nil)
+	es := endorser.NewEndorserServer(pvtEmptyDistributor, support, platforms.NewRegistry(&golang.Platform{}), &disabled.Provider{})
+
+	// the readiness gate is disabled by default
+	pResp, err := es.ProcessProposal(context.Background(), getSignedProp("ccid", "0", t))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 200, pResp.Response.Status)
+
+	es.MaxBlocksBehind = 10
+	pResp, err = es.ProcessProposal(context.Background(), getSignedProp("ccid", "0", t))
+	assert.NoError(t, err)
+	assert.EqualValues(t, common.Status_SERVICE_UNAVAILABLE, pResp.Response.Status)
+	assert.Equal(t, "peer is not ready to endorse on channel testchainid: ledger height 7 is more than 10 blocks behind the channel height 20", pResp.Response.Message)
+	assert.Nil(t, pResp.Endorsement)
+
+	// system chaincodes are always invocable
+	support.IsSysCCRv = true
+	pResp, err = es.ProcessProposal(context.Background(), getSignedProp("ccid", "0", t))
+	assert.NoError(t, err)
+	assert.NotEqual(t, int32(common.Status_SERVICE_UNAVAILABLE), pResp.Response.Status)
+	support.IsSysCCRv = false
+
+	es.MaxBlocksBehind = 13
+	pResp, err = es.ProcessProposal(context.Background(), getSignedProp("ccid", "0", t))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 200, pResp.Response.Status)
+}
+
+type heightReportingTxSim struct {
+	*mockccprovider.MockTxSim
+	height uint64
+}
+
+func (s *heightReportingTxSim) GetStateHeight() (uint64, error) {
+	return s.height, nil
+}
+
+func getSignedPropWithSimulationHeight(ccid, ccver string, height uint64, t *testing.T) *pb.SignedProposal {
+	spec := &pb.ChaincodeSpec{Type: 1, ChaincodeId: &pb.ChaincodeID{Name: ccid, Version: ccver}, Input: &pb.ChaincodeInput{Args: [][]byte{[]byte("args")}}}
+	creator, err := signer.Serialize()
+	assert.NoError(t, err)
+	prop, _, err := protoutil.CreateChaincodeProposal(common.HeaderType_ENDORSER_TRANSACTION, util.GetTestChainID(), &pb.ChaincodeInvocationSpec{ChaincodeSpec: spec}, creator)
+	assert.NoError(t, err)
+
+	hdr, err := protoutil.GetHeader(prop.Header)
+	assert.NoError(t, err)
+	chdr, err := protoutil.UnmarshalChannelHeader(hdr.ChannelHeader)
+	assert.NoError(t, err)
+	chdr.Extension = protoutil.MarshalOrPanic(&pb.ChaincodeHeaderExtension{ChaincodeId: spec.ChaincodeId, SimulationHeight: height})
+	hdr.ChannelHeader = protoutil.MarshalOrPanic(chdr)
+	prop.Header = protoutil.MarshalOrPanic(hdr)
+
+	propBytes, err := protoutil.GetBytesProposal(prop)
+	assert.NoError(t, err)
+	signature, err := signer.Sign(propBytes)
+	assert.NoError(t, err)
+	return &pb.SignedProposal{ProposalBytes: propBytes, Signature: signature}
+}
+
+func TestEndorserSimulationHeight(t *testing.T) {
+	newEndorser := func(m *mock.Mock) *endorser.Endorser {
+		m.On("Sign", mock.Anything).Return([]byte{1, 2, 3, 4, 5}, nil)
+		m.On("Serialize").Return([]byte{1, 1, 1}, nil)
+		support := &em.MockSupport{
+			Mock:                       m,
+			GetApplicationConfigBoolRv: true,
+			GetApplicationConfigRv:     &mc.MockApplication{CapabilitiesRv: &mc.MockApplicationCapabilities{}},
+			GetTransactionByIDErr:      errors.New(""),
+			ChaincodeDefinitionRv:      &ccprovider.ChaincodeData{Name: "ccid", Version: "0", Escc: "ESCC"},
+			ExecuteResp:                &pb.Response{Status: 200, Payload: protoutil.MarshalOrPanic(&pb.ProposalResponse{Response: &pb.Response{}})},
+		}
+		attachPluginEndorser(support, nil)
+		return endorser.NewEndorserServer(pvtEmptyDistributor, support, platforms.NewRegistry(&golang.Platform{}), &disabled.Provider{})
+	}
+
+	t.Run("AtHeight", func(t *testing.T) {
+		m := &mock.Mock{}
+		m.On("GetTxSimulator", mock.Anything, mock.Anything).Return(&heightReportingTxSim{newMockTxSim(), 5}, nil)
+		es := newEndorser(m)
+
+		pResp, err := es.ProcessProposal(context.Background(), getSignedPropWithSimulationHeight("ccid", "0", 5, t))
+		assert.NoError(t, err)
+		assert.EqualValues(t, 200, pResp.Response.Status)
+		assert.Equal(t, uint64(5), pResp.SimulationHeight)
+		m.AssertNotCalled(t, "WaitForLedgerHeight", mock.Anything, mock.Anything)
+	})
+
+	t.Run("WithoutHeight", func(t *testing.T) {
+		m := &mock.Mock{}
+		m.On("GetTxSimulator", mock.Anything, mock.Anything).Return(newMockTxSim(), nil)
+		es := newEndorser(m)
+
+		pResp, err := es.ProcessProposal(context.Background(), getSignedProp("ccid", "0", t))
+		assert.NoError(t, err)
+		assert.EqualValues(t, 200, pResp.Response.Status)
+		assert.Equal(t, uint64(0), pResp.SimulationHeight)
+	})
+
+	t.Run("LedgerBehind", func(t *testing.T) {
+		m := &mock.Mock{}
+		m.On("GetTxSimulator", mock.Anything, mock.Anything).Return(&heightReportingTxSim{newMockTxSim(), 4}, nil).Once()
+		m.On("GetTxSimulator", mock.Anything, mock.Anything).Return(&heightReportingTxSim{newMockTxSim(), 5}, nil).Once()
+		m.On("WaitForLedgerHeight", util.GetTestChainID(), uint64(5)).Return(nil).Once()
+		es := newEndorser(m)
+
+		pResp, err := es.ProcessProposal(context.Background(), getSignedPropWithSimulationHeight("ccid", "0", 5, t))
+		assert.NoError(t, err)
+		assert.EqualValues(t, 200, pResp.Response.Status)
+		assert.Equal(t, uint64(5), pResp.SimulationHeight)
+		m.AssertExpectations(t)
+	})
+
+	t.Run("LedgerBehindTimeout", func(t *testing.T) {
+		m := &mock.Mock{}
+		m.On("GetTxSimulator", mock.Anything, mock.Anything).Return(&heightReportingTxSim{newMockTxSim(), 4}, nil)
+		m.On("WaitForLedgerHeight", util.GetTestChainID(), uint64(5)).Return(context.DeadlineExceeded)
+		es := newEndorser(m)
+
+		pResp, err := es.ProcessProposal(context.Background(), getSignedPropWithSimulationHeight("ccid", "0", 5, t))
+		assert.NoError(t, err)
+		assert.EqualValues(t, common.Status_SERVICE_UNAVAILABLE, pResp.Response.Status)
+		assert.Equal(t, "ledger of channel testchainid did not reach the requested simulation height 5: context deadline exceeded", pResp.Response.Message)
+		assert.Nil(t, pResp.Endorsement)
+	})
+
+	t.Run("LedgerBeyond", func(t *testing.T) {
+		m := &mock.Mock{}
+		m.On("GetTxSimulator", mock.Anything, mock.Anything).Return(&heightReportingTxSim{newMockTxSim(), 6}, nil)
+		es := newEndorser(m)
+
+		pResp, err := es.ProcessProposal(context.Background(), getSignedPropWithSimulationHeight("ccid", "0", 5, t))
+		assert.NoError(t, err)
+		assert.EqualValues(t, common.Status_GONE, pResp.Response.Status)
+		assert.Equal(t, "ledger of channel testchainid is at height 6, beyond the requested simulation height 5", pResp.Response.Message)
+		assert.Nil(t, pResp.Endorsement)
+		m.AssertNotCalled(t, "WaitForLedgerHeight", mock.Anything, mock.Anything)
+	})
+
+	t.Run("UnsupportedLedger", func(t *testing.T) {
+		m := &mock.Mock{}
+		m.On("GetTxSimulator", mock.Anything, mock.Anything).Return(newMockTxSim(), nil)
+		es := newEndorser(m)
+
+		pResp, err := es.ProcessProposal(context.Background(), getSignedPropWithSimulationHeight("ccid", "0", 5, t))
+		assert.NoError(t, err)
+		assert.EqualValues(t, 500, pResp.Response.Status)
+		assert.Equal(t, "the ledger does not support simulation at a requested height", pResp.Response.Message)
+	})
+}
+
+type recordedSimulation struct {
+	signedProp *pb.SignedProposal
+	channel    string
+	txID       string
+	chaincode  string
+	results    *rwset.TxReadWriteSet
+}
+
+type fakeSimulationRecorder struct {
+	simulations []recordedSimulation
+}
+
+func (r *fakeSimulationRecorder) Record(signedProp *pb.SignedProposal, channel, txID string, cid *pb.ChaincodeID, results *rwset.TxReadWriteSet) {
+	r.simulations = append(r.simulations, recordedSimulation{signedProp, channel, txID, cid.Name, results})
+}
+
+func TestEndorserSimulationRecorder(t *testing.T) {
+	txsim := newMockTxSim()
+	txsim.GetTxSimulationResultsRv.PubSimulationResults = newPubSimResults(1, "ccid")
+	m := &mock.Mock{}
+	m.On("Sign", mock.Anything).Return([]byte{1, 2, 3, 4, 5}, nil)
+	m.On("Serialize").Return([]byte{1, 1, 1}, nil)
+	m.On("GetTxSimulator", mock.Anything, mock.Anything).Return(txsim, nil)
+	support := &em.MockSupport{
+		Mock:                       m,
+		GetApplicationConfigBoolRv: true,
+		GetApplicationConfigRv:     &mc.MockApplication{CapabilitiesRv: &mc.MockApplicationCapabilities{}},
+		GetTransactionByIDErr:      errors.New(""),
+		ChaincodeDefinitionRv:      &ccprovider.ChaincodeData{Name: "ccid", Version: "0", Escc: "ESCC"},
+		ExecuteResp:                &pb.Response{Status: 200, Payload: protoutil.MarshalOrPanic(&pb.ProposalResponse{Response: &pb.Response{}})},
+	}
+	attachPluginEndorser(support, nil)
+	es := endorser.NewEndorserServer(pvtEmptyDistributor, support, platforms.NewRegistry(&golang.Platform{}), &disabled.Provider{})
+	recorder := &fakeSimulationRecorder{}
+	es.SimulationRecorder = recorder
+
+	signedProp := getSignedProp("ccid", "0", t)
+	pResp, err := es.ProcessProposal(context.Background(), signedProp)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 200, pResp.Response.Status)
+
+	require.Len(t, recorder.simulations, 1)
+	assert.Equal(t, signedProp, recorder.simulations[0].signedProp)
+	assert.Equal(t, util.GetTestChainID(), recorder.simulations[0].channel)
+	assert.NotEmpty(t, recorder.simulations[0].txID)
+	assert.Equal(t, "ccid", recorder.simulations[0].chaincode)
+	assert.Equal(t, txsim.GetTxSimulationResultsRv.PubSimulationResults, recorder.simulations[0].results)
+}
+
 func TestEndorserChaincodeCallLogging(t *testing.T) {
 	gt := NewGomegaWithT(t)
 	m := &mock.Mock{}
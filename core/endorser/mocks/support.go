@@ -2,6 +2,7 @@
 package mocks
 
 import (
+	"context"
 	"sync"
 
 	"github.com/hyperledger/fabric/common/channelconfig"
@@ -100,19 +101,20 @@ type Support struct {
 	isSysCCReturnsOnCall map[int]struct {
 		result1 bool
 	}
-	ExecuteStub        func(txParams *ccprovider.TransactionParams, cid, name, version, txid string, idBytes []byte, initRequired bool, signedProp *pb.SignedProposal, prop *pb.Proposal, input *pb.ChaincodeInput) (*pb.Response, *pb.ChaincodeEvent, error)
+	ExecuteStub        func(txParams *ccprovider.TransactionParams, cid, name, version, txid string, idBytes []byte, initRequired bool, readYourWrites bool, signedProp *pb.SignedProposal, prop *pb.Proposal, input *pb.ChaincodeInput) (*pb.Response, *pb.ChaincodeEvent, error)
 	executeMutex       sync.RWMutex
 	executeArgsForCall []struct {
-		txParams     *ccprovider.TransactionParams
-		cid          string
-		name         string
-		version      string
-		txid         string
-		idBytes      []byte
-		initRequired bool
-		signedProp   *pb.SignedProposal
-		prop         *pb.Proposal
-		input        *pb.ChaincodeInput
+		txParams       *ccprovider.TransactionParams
+		cid            string
+		name           string
+		version        string
+		txid           string
+		idBytes        []byte
+		initRequired   bool
+		readYourWrites bool
+		signedProp     *pb.SignedProposal
+		prop           *pb.Proposal
+		input          *pb.ChaincodeInput
 	}
 	executeReturns struct {
 		result1 *pb.Response
@@ -265,6 +267,30 @@ type Support struct {
 		result1 uint64
 		result2 error
 	}
+	GetChannelTipHeightStub        func(string) uint64
+	getChannelTipHeightMutex       sync.RWMutex
+	getChannelTipHeightArgsForCall []struct {
+		arg1 string
+	}
+	getChannelTipHeightReturns struct {
+		result1 uint64
+	}
+	getChannelTipHeightReturnsOnCall map[int]struct {
+		result1 uint64
+	}
+	WaitForLedgerHeightStub        func(ctx context.Context, channelID string, height uint64) error
+	waitForLedgerHeightMutex       sync.RWMutex
+	waitForLedgerHeightArgsForCall []struct {
+		ctx       context.Context
+		channelID string
+		height    uint64
+	}
+	waitForLedgerHeightReturns struct {
+		result1 error
+	}
+	waitForLedgerHeightReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetDeployedCCInfoProviderStub        func() ledger.DeployedChaincodeInfoProvider
 	getDeployedCCInfoProviderMutex       sync.RWMutex
 	getDeployedCCInfoProviderArgsForCall []struct{}
@@ -628,7 +654,7 @@ func (fake *Support) IsSysCCReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
-func (fake *Support) Execute(txParams *ccprovider.TransactionParams, cid string, name string, version string, txid string, idBytes []byte, initRequired bool, signedProp *pb.SignedProposal, prop *pb.Proposal, input *pb.ChaincodeInput) (*pb.Response, *pb.ChaincodeEvent, error) {
+func (fake *Support) Execute(txParams *ccprovider.TransactionParams, cid string, name string, version string, txid string, idBytes []byte, initRequired bool, readYourWrites bool, signedProp *pb.SignedProposal, prop *pb.Proposal, input *pb.ChaincodeInput) (*pb.Response, *pb.ChaincodeEvent, error) {
 	var idBytesCopy []byte
 	if idBytes != nil {
 		idBytesCopy = make([]byte, len(idBytes))
@@ -637,21 +663,22 @@ func (fake *Support) Execute(txParams *ccprovider.TransactionParams, cid string,
 	fake.executeMutex.Lock()
 	ret, specificReturn := fake.executeReturnsOnCall[len(fake.executeArgsForCall)]
 	fake.executeArgsForCall = append(fake.executeArgsForCall, struct {
-		txParams     *ccprovider.TransactionParams
-		cid          string
-		name         string
-		version      string
-		txid         string
-		idBytes      []byte
-		initRequired bool
-		signedProp   *pb.SignedProposal
-		prop         *pb.Proposal
-		input        *pb.ChaincodeInput
-	}{txParams, cid, name, version, txid, idBytesCopy, initRequired, signedProp, prop, input})
-	fake.recordInvocation("Execute", []interface{}{txParams, cid, name, version, txid, idBytesCopy, initRequired, signedProp, prop, input})
+		txParams       *ccprovider.TransactionParams
+		cid            string
+		name           string
+		version        string
+		txid           string
+		idBytes        []byte
+		initRequired   bool
+		readYourWrites bool
+		signedProp     *pb.SignedProposal
+		prop           *pb.Proposal
+		input          *pb.ChaincodeInput
+	}{txParams, cid, name, version, txid, idBytesCopy, initRequired, readYourWrites, signedProp, prop, input})
+	fake.recordInvocation("Execute", []interface{}{txParams, cid, name, version, txid, idBytesCopy, initRequired, readYourWrites, signedProp, prop, input})
 	fake.executeMutex.Unlock()
 	if fake.ExecuteStub != nil {
-		return fake.ExecuteStub(txParams, cid, name, version, txid, idBytes, initRequired, signedProp, prop, input)
+		return fake.ExecuteStub(txParams, cid, name, version, txid, idBytes, initRequired, readYourWrites, signedProp, prop, input)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -665,10 +692,10 @@ func (fake *Support) ExecuteCallCount() int {
 	return len(fake.executeArgsForCall)
 }
 
-func (fake *Support) ExecuteArgsForCall(i int) (*ccprovider.TransactionParams, string, string, string, string, []byte, bool, *pb.SignedProposal, *pb.Proposal, *pb.ChaincodeInput) {
+func (fake *Support) ExecuteArgsForCall(i int) (*ccprovider.TransactionParams, string, string, string, string, []byte, bool, bool, *pb.SignedProposal, *pb.Proposal, *pb.ChaincodeInput) {
 	fake.executeMutex.RLock()
 	defer fake.executeMutex.RUnlock()
-	return fake.executeArgsForCall[i].txParams, fake.executeArgsForCall[i].cid, fake.executeArgsForCall[i].name, fake.executeArgsForCall[i].version, fake.executeArgsForCall[i].txid, fake.executeArgsForCall[i].idBytes, fake.executeArgsForCall[i].initRequired, fake.executeArgsForCall[i].signedProp, fake.executeArgsForCall[i].prop, fake.executeArgsForCall[i].input
+	return fake.executeArgsForCall[i].txParams, fake.executeArgsForCall[i].cid, fake.executeArgsForCall[i].name, fake.executeArgsForCall[i].version, fake.executeArgsForCall[i].txid, fake.executeArgsForCall[i].idBytes, fake.executeArgsForCall[i].initRequired, fake.executeArgsForCall[i].readYourWrites, fake.executeArgsForCall[i].signedProp, fake.executeArgsForCall[i].prop, fake.executeArgsForCall[i].input
 }
 
 func (fake *Support) ExecuteReturns(result1 *pb.Response, result2 *pb.ChaincodeEvent, result3 error) {
@@ -1221,6 +1248,116 @@ func (fake *Support) GetLedgerHeightReturnsOnCall(i int, result1 uint64, result2
 	}{result1, result2}
 }
 
+func (fake *Support) GetChannelTipHeight(arg1 string) uint64 {
+	fake.getChannelTipHeightMutex.Lock()
+	ret, specificReturn := fake.getChannelTipHeightReturnsOnCall[len(fake.getChannelTipHeightArgsForCall)]
+	fake.getChannelTipHeightArgsForCall = append(fake.getChannelTipHeightArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetChannelTipHeight", []interface{}{arg1})
+	fake.getChannelTipHeightMutex.Unlock()
+	if fake.GetChannelTipHeightStub != nil {
+		return fake.GetChannelTipHeightStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.getChannelTipHeightReturns
+	return fakeReturns.result1
+}
+
+func (fake *Support) GetChannelTipHeightCallCount() int {
+	fake.getChannelTipHeightMutex.RLock()
+	defer fake.getChannelTipHeightMutex.RUnlock()
+	return len(fake.getChannelTipHeightArgsForCall)
+}
+
+func (fake *Support) GetChannelTipHeightCalls(stub func(string) uint64) {
+	fake.getChannelTipHeightMutex.Lock()
+	defer fake.getChannelTipHeightMutex.Unlock()
+	fake.GetChannelTipHeightStub = stub
+}
+
+func (fake *Support) GetChannelTipHeightArgsForCall(i int) string {
+	fake.getChannelTipHeightMutex.RLock()
+	defer fake.getChannelTipHeightMutex.RUnlock()
+	argsForCall := fake.getChannelTipHeightArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Support) GetChannelTipHeightReturns(result1 uint64) {
+	fake.getChannelTipHeightMutex.Lock()
+	defer fake.getChannelTipHeightMutex.Unlock()
+	fake.GetChannelTipHeightStub = nil
+	fake.getChannelTipHeightReturns = struct {
+		result1 uint64
+	}{result1}
+}
+
+func (fake *Support) GetChannelTipHeightReturnsOnCall(i int, result1 uint64) {
+	fake.getChannelTipHeightMutex.Lock()
+	defer fake.getChannelTipHeightMutex.Unlock()
+	fake.GetChannelTipHeightStub = nil
+	if fake.getChannelTipHeightReturnsOnCall == nil {
+		fake.getChannelTipHeightReturnsOnCall = make(map[int]struct {
+			result1 uint64
+		})
+	}
+	fake.getChannelTipHeightReturnsOnCall[i] = struct {
+		result1 uint64
+	}{result1}
+}
+
+func (fake *Support) WaitForLedgerHeight(ctx context.Context, channelID string, height uint64) error {
+	fake.waitForLedgerHeightMutex.Lock()
+	ret, specificReturn := fake.waitForLedgerHeightReturnsOnCall[len(fake.waitForLedgerHeightArgsForCall)]
+	fake.waitForLedgerHeightArgsForCall = append(fake.waitForLedgerHeightArgsForCall, struct {
+		ctx       context.Context
+		channelID string
+		height    uint64
+	}{ctx, channelID, height})
+	fake.recordInvocation("WaitForLedgerHeight", []interface{}{ctx, channelID, height})
+	fake.waitForLedgerHeightMutex.Unlock()
+	if fake.WaitForLedgerHeightStub != nil {
+		return fake.WaitForLedgerHeightStub(ctx, channelID, height)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.waitForLedgerHeightReturns.result1
+}
+
+func (fake *Support) WaitForLedgerHeightCallCount() int {
+	fake.waitForLedgerHeightMutex.RLock()
+	defer fake.waitForLedgerHeightMutex.RUnlock()
+	return len(fake.waitForLedgerHeightArgsForCall)
+}
+
+func (fake *Support) WaitForLedgerHeightArgsForCall(i int) (context.Context, string, uint64) {
+	fake.waitForLedgerHeightMutex.RLock()
+	defer fake.waitForLedgerHeightMutex.RUnlock()
+	return fake.waitForLedgerHeightArgsForCall[i].ctx, fake.waitForLedgerHeightArgsForCall[i].channelID, fake.waitForLedgerHeightArgsForCall[i].height
+}
+
+func (fake *Support) WaitForLedgerHeightReturns(result1 error) {
+	fake.WaitForLedgerHeightStub = nil
+	fake.waitForLedgerHeightReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Support) WaitForLedgerHeightReturnsOnCall(i int, result1 error) {
+	fake.WaitForLedgerHeightStub = nil
+	if fake.waitForLedgerHeightReturnsOnCall == nil {
+		fake.waitForLedgerHeightReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.waitForLedgerHeightReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Support) GetDeployedCCInfoProvider() ledger.DeployedChaincodeInfoProvider {
 	fake.getDeployedCCInfoProviderMutex.Lock()
 	ret, specificReturn := fake.getDeployedCCInfoProviderReturnsOnCall[len(fake.getDeployedCCInfoProviderArgsForCall)]
@@ -1300,6 +1437,10 @@ func (fake *Support) Invocations() map[string][][]interface{} {
 	defer fake.endorseWithPluginMutex.RUnlock()
 	fake.getLedgerHeightMutex.RLock()
 	defer fake.getLedgerHeightMutex.RUnlock()
+	fake.getChannelTipHeightMutex.RLock()
+	defer fake.getChannelTipHeightMutex.RUnlock()
+	fake.waitForLedgerHeightMutex.RLock()
+	defer fake.waitForLedgerHeightMutex.RUnlock()
 	fake.getDeployedCCInfoProviderMutex.RLock()
 	defer fake.getDeployedCCInfoProviderMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
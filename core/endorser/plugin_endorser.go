@@ -51,6 +51,15 @@ func (m MapBasedPluginMapper) PluginFactoryByName(name PluginName) endorsement.P
 	return m[string(name)]
 }
 
+// VersionedPluginMapper is a PluginMapper whose plugins may have several
+// versions registered, one of which is in use
+type VersionedPluginMapper interface {
+	PluginMapper
+	// PluginVersionByName returns the version in use and the factory of the
+	// plugin with the given name, or nil if not found
+	PluginVersionByName(name PluginName) (string, endorsement.PluginFactory)
+}
+
 // Context defines the data that is related to an in-flight endorsement
 type Context struct {
 	PluginName     string
@@ -84,7 +93,7 @@ func NewPluginEndorser(ps *PluginSupport) *PluginEndorser {
 	return &PluginEndorser{
 		SigningIdentityFetcher:  ps.SigningIdentityFetcher,
 		PluginMapper:            ps.PluginMapper,
-		pluginChannelMapping:    make(map[PluginName]*pluginsByChannel),
+		pluginChannelMapping:    make(map[pluginKey]*pluginsByChannel),
 		ChannelStateRetriever:   ps.ChannelStateRetriever,
 		TransientStoreRetriever: ps.TransientStoreRetriever,
 	}
@@ -93,6 +102,12 @@ func NewPluginEndorser(ps *PluginSupport) *PluginEndorser {
 // PluginName defines the name of the plugin as it appears in the configuration
 type PluginName string
 
+// pluginKey identifies a version of a plugin
+type pluginKey struct {
+	name    PluginName
+	version string
+}
+
 type pluginsByChannel struct {
 	sync.RWMutex
 	pluginFactory    endorsement.PluginFactory
@@ -152,7 +167,7 @@ func (pbc *pluginsByChannel) initPlugin(plugin endorsement.Plugin, channel strin
 type PluginEndorser struct {
 	sync.Mutex
 	PluginMapper
-	pluginChannelMapping map[PluginName]*pluginsByChannel
+	pluginChannelMapping map[pluginKey]*pluginsByChannel
 	ChannelStateRetriever
 	endorsement3.SigningIdentityFetcher
 	TransientStoreRetriever
@@ -198,28 +213,39 @@ func (pe *PluginEndorser) EndorseWithPlugin(ctx Context) (*pb.ProposalResponse,
 	return resp, nil
 }
 
-// getOrCreatePlugin returns a plugin instance for the given plugin name and channel
+// getOrCreatePlugin returns a plugin instance for the given plugin name and channel.
+// The plugin instance is of the version of the plugin in use when it is looked up,
+// which the endorsement keeps using even if another version is activated meanwhile.
 func (pe *PluginEndorser) getOrCreatePlugin(plugin PluginName, channel string) (endorsement.Plugin, error) {
-	pluginFactory := pe.PluginFactoryByName(plugin)
+	version, pluginFactory := pe.pluginVersionByName(plugin)
 	if pluginFactory == nil {
 		return nil, errors.Errorf("plugin with name %s wasn't found", plugin)
 	}
 
-	pluginsByChannel := pe.getOrCreatePluginChannelMapping(PluginName(plugin), pluginFactory)
+	pluginsByChannel := pe.getOrCreatePluginChannelMapping(pluginKey{name: plugin, version: version}, pluginFactory)
 	return pluginsByChannel.createPluginIfAbsent(channel)
 }
 
-func (pe *PluginEndorser) getOrCreatePluginChannelMapping(plugin PluginName, pf endorsement.PluginFactory) *pluginsByChannel {
+// pluginVersionByName returns the version in use and the factory of the plugin
+// with the given name. The version is empty if the plugins are not versioned.
+func (pe *PluginEndorser) pluginVersionByName(plugin PluginName) (string, endorsement.PluginFactory) {
+	if versionedMapper, isVersioned := pe.PluginMapper.(VersionedPluginMapper); isVersioned {
+		return versionedMapper.PluginVersionByName(plugin)
+	}
+	return "", pe.PluginFactoryByName(plugin)
+}
+
+func (pe *PluginEndorser) getOrCreatePluginChannelMapping(plugin pluginKey, pf endorsement.PluginFactory) *pluginsByChannel {
 	pe.Lock()
 	defer pe.Unlock()
-	endorserChannelMapping, exists := pe.pluginChannelMapping[PluginName(plugin)]
+	endorserChannelMapping, exists := pe.pluginChannelMapping[plugin]
 	if !exists {
 		endorserChannelMapping = &pluginsByChannel{
 			pluginFactory:    pf,
 			channels2Plugins: make(map[string]endorsement.Plugin),
 			pe:               pe,
 		}
-		pe.pluginChannelMapping[PluginName(plugin)] = endorserChannelMapping
+		pe.pluginChannelMapping[plugin] = endorserChannelMapping
 	}
 	return endorserChannelMapping
 }
@@ -118,6 +118,77 @@ func TestPluginEndorserGreenPath(t *testing.T) {
 	plugin.AssertCalled(t, "Init", sif)
 }
 
+func TestPluginEndorserVersions(t *testing.T) {
+	proposal, _, err := protoutil.CreateChaincodeProposal(common.HeaderType_ENDORSER_TRANSACTION, "mychannel", &peer.ChaincodeInvocationSpec{
+		ChaincodeSpec: &peer.ChaincodeSpec{
+			ChaincodeId: &peer.ChaincodeID{Name: "mycc"},
+		},
+	}, []byte{1, 2, 3})
+	assert.NoError(t, err)
+	newPlugin := func(signature []byte) *mocks.Plugin {
+		plugin := &mocks.Plugin{}
+		plugin.On("Init", mock.Anything, mock.Anything).Return(nil).Once()
+		plugin.On("Endorse", mock.Anything, mock.Anything).Return(&peer.Endorsement{Signature: signature}, []byte{1, 2, 3}, nil)
+		return plugin
+	}
+	v1Factory := &mocks.PluginFactory{}
+	v1Factory.On("New").Return(newPlugin([]byte{1})).Once()
+	v2Factory := &mocks.PluginFactory{}
+	v2Factory.On("New").Return(newPlugin([]byte{2})).Once()
+	pluginMapper := &versionedPluginMapper{
+		active:    "v1",
+		factories: map[string]endorsement.PluginFactory{"v1": v1Factory, "v2": v2Factory},
+	}
+
+	cs := &mocks.ChannelStateRetriever{}
+	cs.On("NewQueryCreator", "mychannel").Return(&mocks.QueryCreator{}, nil)
+	pluginEndorser := endorser.NewPluginEndorser(&endorser.PluginSupport{
+		ChannelStateRetriever:   cs,
+		SigningIdentityFetcher:  &mocks.SigningIdentityFetcher{},
+		PluginMapper:            pluginMapper,
+		TransientStoreRetriever: mockTransientStoreRetriever,
+	})
+	ctx := endorser.Context{
+		Response:    &peer.Response{},
+		PluginName:  "plugin",
+		Proposal:    proposal,
+		ChaincodeID: &peer.ChaincodeID{Name: "mycc"},
+		Channel:     "mychannel",
+	}
+
+	resp, err := pluginEndorser.EndorseWithPlugin(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1}, resp.Endorsement.Signature)
+
+	// Activating another version of the plugin instantiates the plugin of that version
+	pluginMapper.active = "v2"
+	resp, err = pluginEndorser.EndorseWithPlugin(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{2}, resp.Endorsement.Signature)
+
+	// Switching back reuses the instance of the previous version
+	pluginMapper.active = "v1"
+	resp, err = pluginEndorser.EndorseWithPlugin(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1}, resp.Endorsement.Signature)
+	v1Factory.AssertNumberOfCalls(t, "New", 1)
+	v2Factory.AssertNumberOfCalls(t, "New", 1)
+}
+
+type versionedPluginMapper struct {
+	active    string
+	factories map[string]endorsement.PluginFactory
+}
+
+func (vpm *versionedPluginMapper) PluginFactoryByName(name endorser.PluginName) endorsement.PluginFactory {
+	_, factory := vpm.PluginVersionByName(name)
+	return factory
+}
+
+func (vpm *versionedPluginMapper) PluginVersionByName(name endorser.PluginName) (string, endorsement.PluginFactory) {
+	return vpm.active, vpm.factories[vpm.active]
+}
+
 func TestPluginEndorserErrors(t *testing.T) {
 	pluginMapper := &mocks.PluginMapper{}
 	pluginFactory := &mocks.PluginFactory{}
@@ -0,0 +1,140 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package endorser
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/hyperledger/fabric/msp"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+)
+
+// DefaultMaxQuotaIdentities is the maximum number of client identities whose
+// proposals are counted in a window of the invocation quota of a channel,
+// unless InvocationQuotas.MaxIdentities is set
+const DefaultMaxQuotaIdentities = 100000
+
+// QuotaExceededError is returned for the proposals rejected because their
+// creator has exhausted the invocation quota of the channel, or because the
+// proposals of too many client identities were counted in the current window
+type QuotaExceededError struct {
+	Channel        string
+	MaxInvocations uint32
+	BlockInterval  uint64
+	// MaxIdentities is set when the proposal was rejected because
+	// its creator couldn't be counted anymore
+	MaxIdentities int
+}
+
+func (e *QuotaExceededError) Error() string {
+	if e.MaxIdentities > 0 {
+		return fmt.Sprintf("invocation quota of channel %s exceeded: proposals of at most %d client identities every %d blocks",
+			e.Channel, e.MaxIdentities, e.BlockInterval)
+	}
+	return fmt.Sprintf("invocation quota of channel %s exceeded: at most %d proposals per client identity every %d blocks",
+		e.Channel, e.MaxInvocations, e.BlockInterval)
+}
+
+// InvocationQuotas counts the proposals endorsed for each creator on each
+// channel, and enforces the invocation quota defined in the application
+// config of the channel. The blocks of a channel are divided into windows of
+// the block interval of the quota, and the window of a proposal is the one of
+// the current height of the ledger. The counts of a channel are reset as soon
+// as its ledger reaches the next window, or its quota changes.
+//
+// The proposals are counted by each peer independently, so a client may get
+// up to the quota of proposals endorsed by each of the peers of the channel.
+// They are counted per identity of the MSP of the channel, rather than per
+// serialization of the identity, which a client could vary at will.
+type InvocationQuotas struct {
+	// IdentityDeserializer returns the identity deserializer of a channel
+	IdentityDeserializer func(channelID string) msp.IdentityDeserializer
+	// MaxIdentities is the maximum number of client identities whose proposals
+	// are counted in a window, or DefaultMaxQuotaIdentities if not positive.
+	// The proposals of other identities are rejected until the next window.
+	MaxIdentities int
+
+	mutex    sync.Mutex
+	channels map[string]*quotaWindow
+}
+
+type quotaWindow struct {
+	maxInvocations uint32
+	blockInterval  uint64
+	window         uint64
+	counts         map[msp.IdentityIdentifier]uint32
+}
+
+// NewInvocationQuotas creates an empty InvocationQuotas, which identifies
+// the creators of the proposals with the given identity deserializers
+func NewInvocationQuotas(identityDeserializer func(channelID string) msp.IdentityDeserializer) *InvocationQuotas {
+	return &InvocationQuotas{
+		IdentityDeserializer: identityDeserializer,
+		channels:             make(map[string]*quotaWindow),
+	}
+}
+
+// Consume accounts for a proposal of the creator on the channel, given the
+// quota of the channel and the height of its ledger. It returns a
+// QuotaExceededError, without accounting for the proposal, if the creator
+// has exhausted the quota in the current window. A nil quota means no limit.
+func (q *InvocationQuotas) Consume(channelID string, creator []byte, quota *pb.InvocationQuota, height uint64) error {
+	if quota.GetMaxInvocations() == 0 || quota.GetBlockInterval() == 0 {
+		q.mutex.Lock()
+		delete(q.channels, channelID)
+		q.mutex.Unlock()
+		return nil
+	}
+
+	identity, err := q.IdentityDeserializer(channelID).DeserializeIdentity(creator)
+	if err != nil {
+		return errors.WithMessage(err, "failed deserializing the creator of the proposal")
+	}
+	id := *identity.GetIdentifier()
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	window := height / quota.BlockInterval
+	w, exists := q.channels[channelID]
+	if !exists || w.window != window || w.maxInvocations != quota.MaxInvocations || w.blockInterval != quota.BlockInterval {
+		w = &quotaWindow{
+			maxInvocations: quota.MaxInvocations,
+			blockInterval:  quota.BlockInterval,
+			window:         window,
+			counts:         make(map[msp.IdentityIdentifier]uint32),
+		}
+		q.channels[channelID] = w
+	}
+
+	count, tracked := w.counts[id]
+	if maxIdentities := q.maxIdentities(); !tracked && len(w.counts) >= maxIdentities {
+		return &QuotaExceededError{
+			Channel:       channelID,
+			BlockInterval: quota.BlockInterval,
+			MaxIdentities: maxIdentities,
+		}
+	}
+	if count >= quota.MaxInvocations {
+		return &QuotaExceededError{
+			Channel:        channelID,
+			MaxInvocations: quota.MaxInvocations,
+			BlockInterval:  quota.BlockInterval,
+		}
+	}
+	w.counts[id]++
+	return nil
+}
+
+func (q *InvocationQuotas) maxIdentities() int {
+	if q.MaxIdentities <= 0 {
+		return DefaultMaxQuotaIdentities
+	}
+	return q.MaxIdentities
+}
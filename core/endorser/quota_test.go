@@ -0,0 +1,95 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package endorser
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/hyperledger/fabric/msp"
+	"github.com/hyperledger/fabric/msp/mocks"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+// quotaDeserializer deserializes identities serialized as their names,
+// ignoring the surrounding spaces, so that several serializations stand
+// for the same identity
+type quotaDeserializer struct {
+	msp.IdentityDeserializer
+}
+
+func (quotaDeserializer) DeserializeIdentity(serializedIdentity []byte) (msp.Identity, error) {
+	if string(serializedIdentity) == "unknown" {
+		return nil, errors.New("unknown identity")
+	}
+	id := &mocks.MockIdentity{}
+	id.On("GetIdentifier").Return(&msp.IdentityIdentifier{Mspid: "Org1MSP", Id: string(bytes.TrimSpace(serializedIdentity))})
+	return id, nil
+}
+
+func newQuotaDeserializer() func(string) msp.IdentityDeserializer {
+	return func(string) msp.IdentityDeserializer { return quotaDeserializer{} }
+}
+
+func TestInvocationQuotas(t *testing.T) {
+	q := NewInvocationQuotas(newQuotaDeserializer())
+	quota := &pb.InvocationQuota{MaxInvocations: 2, BlockInterval: 10}
+
+	assert.NoError(t, q.Consume("ch1", []byte("alice"), quota, 10))
+	assert.NoError(t, q.Consume("ch1", []byte("alice"), quota, 15))
+	err := q.Consume("ch1", []byte("alice"), quota, 19)
+	assert.EqualError(t, err, "invocation quota of channel ch1 exceeded: at most 2 proposals per client identity every 10 blocks")
+	assert.IsType(t, &QuotaExceededError{}, err)
+
+	// another serialization of the same identity doesn't get a fresh quota
+	assert.Error(t, q.Consume("ch1", []byte("  alice\n"), quota, 19))
+
+	// the quota applies to each creator on each channel
+	assert.NoError(t, q.Consume("ch1", []byte("bob"), quota, 19))
+	assert.NoError(t, q.Consume("ch2", []byte("alice"), quota, 19))
+
+	// the counts are reset in the next window
+	assert.NoError(t, q.Consume("ch1", []byte("alice"), quota, 20))
+	assert.NoError(t, q.Consume("ch1", []byte("alice"), quota, 20))
+	assert.Error(t, q.Consume("ch1", []byte("alice"), quota, 21))
+
+	// and when the quota changes
+	quota = &pb.InvocationQuota{MaxInvocations: 3, BlockInterval: 10}
+	assert.NoError(t, q.Consume("ch1", []byte("alice"), quota, 21))
+
+	// the creator must be an identity of the channel
+	err = q.Consume("ch1", []byte("unknown"), quota, 21)
+	assert.EqualError(t, err, "failed deserializing the creator of the proposal: unknown identity")
+
+	// without quota, proposals are not limited and the counts are dropped
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, q.Consume("ch1", []byte("alice"), nil, 21))
+	}
+	assert.NotContains(t, q.channels, "ch1")
+	assert.NoError(t, q.Consume("ch1", []byte("alice"), &pb.InvocationQuota{}, 21))
+}
+
+func TestInvocationQuotasMaxIdentities(t *testing.T) {
+	q := NewInvocationQuotas(newQuotaDeserializer())
+	q.MaxIdentities = 2
+	quota := &pb.InvocationQuota{MaxInvocations: 2, BlockInterval: 10}
+
+	assert.NoError(t, q.Consume("ch1", []byte("alice"), quota, 10))
+	assert.NoError(t, q.Consume("ch1", []byte("bob"), quota, 10))
+	err := q.Consume("ch1", []byte("carol"), quota, 10)
+	assert.EqualError(t, err, "invocation quota of channel ch1 exceeded: proposals of at most 2 client identities every 10 blocks")
+	assert.IsType(t, &QuotaExceededError{}, err)
+
+	// the identities already counted are still accepted
+	assert.NoError(t, q.Consume("ch1", []byte("alice"), quota, 10))
+	assert.Len(t, q.channels["ch1"].counts, 2)
+
+	// until the next window
+	assert.NoError(t, q.Consume("ch1", []byte("carol"), quota, 20))
+}
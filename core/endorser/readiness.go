@@ -0,0 +1,47 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package endorser
+
+import "fmt"
+
+// NotReadyError is returned for the proposals rejected because the ledger
+// of the channel lags too far behind the height of the channel advertised
+// by its other peers, as the peer is still catching up with the channel
+type NotReadyError struct {
+	Channel         string
+	LedgerHeight    uint64
+	TipHeight       uint64
+	MaxBlocksBehind uint64
+}
+
+func (e *NotReadyError) Error() string {
+	return fmt.Sprintf("peer is not ready to endorse on channel %s: ledger height %d is more than %d blocks behind the channel height %d",
+		e.Channel, e.LedgerHeight, e.MaxBlocksBehind, e.TipHeight)
+}
+
+// checkReadiness returns a NotReadyError if the ledger of the channel is
+// more than MaxBlocksBehind blocks behind the tip of the channel. Proposals
+// for system chaincodes and chainless proposals are always accepted.
+func (e *Endorser) checkReadiness(chainID string, ccName string) error {
+	if e.MaxBlocksBehind == 0 || chainID == "" || e.s.IsSysCC(ccName) {
+		return nil
+	}
+	height, err := e.s.GetLedgerHeight(chainID)
+	if err != nil {
+		return err
+	}
+	tip := e.s.GetChannelTipHeight(chainID)
+	if tip <= height+e.MaxBlocksBehind {
+		return nil
+	}
+	return &NotReadyError{
+		Channel:         chainID,
+		LedgerHeight:    height,
+		TipHeight:       tip,
+		MaxBlocksBehind: e.MaxBlocksBehind,
+	}
+}
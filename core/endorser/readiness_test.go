@@ -0,0 +1,39 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package endorser
+
+import (
+	"testing"
+
+	gossipcommon "github.com/hyperledger/fabric/gossip/common"
+	"github.com/hyperledger/fabric/gossip/discovery"
+	"github.com/hyperledger/fabric/protos/gossip"
+	"github.com/stretchr/testify/assert"
+)
+
+type channelMembership map[string][]discovery.NetworkMember
+
+func (cm channelMembership) PeersOfChannel(channel gossipcommon.ChainID) []discovery.NetworkMember {
+	return cm[string(channel)]
+}
+
+func TestGetChannelTipHeight(t *testing.T) {
+	s := &SupportImpl{}
+	assert.Equal(t, uint64(0), s.GetChannelTipHeight("mychannel"))
+
+	s.ChannelMembership = channelMembership{
+		"mychannel": {
+			{Endpoint: "p0", Properties: &gossip.Properties{LedgerHeight: 10}},
+			{Endpoint: "p1"},
+			{Endpoint: "p2", Properties: &gossip.Properties{LedgerHeight: 12}},
+			{Endpoint: "p3", Properties: &gossip.Properties{LedgerHeight: 12}},
+			{Endpoint: "p4", Properties: &gossip.Properties{LedgerHeight: 1000}},
+		},
+	}
+	assert.Equal(t, uint64(12), s.GetChannelTipHeight("mychannel"))
+	assert.Equal(t, uint64(0), s.GetChannelTipHeight("otherchannel"))
+}
@@ -0,0 +1,177 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package recorder
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
+	"github.com/pkg/errors"
+)
+
+// maxValueLength is the length beyond which written values are truncated in
+// differences
+const maxValueLength = 128
+
+// absent describes an entry that is missing from a read-write set
+const absent = "<absent>"
+
+// Difference is an entry of the read-write sets of two simulations that
+// differs between them
+type Difference struct {
+	Namespace  string `json:"namespace"`
+	Collection string `json:"collection,omitempty"`
+	// Kind is one of read, write, metadata_write or range_query
+	Kind string `json:"kind"`
+	// Key is the key read or written, the hex encoded hash of the key for
+	// private data, or the range of a range query
+	Key    string `json:"key"`
+	First  string `json:"first"`
+	Second string `json:"second"`
+}
+
+type entryKey struct {
+	namespace  string
+	collection string
+	kind       string
+	key        string
+}
+
+// Diff returns the differences between the read-write sets of two
+// simulations, sorted by namespace, collection, kind and key
+func Diff(first, second *Simulation) ([]Difference, error) {
+	firstEntries, err := entries(first.ReadWriteSet)
+	if err != nil {
+		return nil, errors.WithMessage(err, "invalid first simulation")
+	}
+	secondEntries, err := entries(second.ReadWriteSet)
+	if err != nil {
+		return nil, errors.WithMessage(err, "invalid second simulation")
+	}
+
+	differences := []Difference{}
+	addDifference := func(k entryKey) {
+		firstValue, inFirst := firstEntries[k]
+		secondValue, inSecond := secondEntries[k]
+		if inFirst && inSecond && firstValue == secondValue {
+			return
+		}
+		if !inFirst {
+			firstValue = absent
+		}
+		if !inSecond {
+			secondValue = absent
+		}
+		differences = append(differences, Difference{
+			Namespace:  k.namespace,
+			Collection: k.collection,
+			Kind:       k.kind,
+			Key:        k.key,
+			First:      firstValue,
+			Second:     secondValue,
+		})
+	}
+	for k := range firstEntries {
+		addDifference(k)
+	}
+	for k := range secondEntries {
+		if _, inFirst := firstEntries[k]; !inFirst {
+			addDifference(k)
+		}
+	}
+
+	sort.Slice(differences, func(i, j int) bool {
+		a, b := differences[i], differences[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Collection != b.Collection {
+			return a.Collection < b.Collection
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Key < b.Key
+	})
+	return differences, nil
+}
+
+// entries flattens the given marshaled read-write set into descriptions of
+// its reads, writes, metadata writes and range queries
+func entries(rwsetBytes []byte) (map[entryKey]string, error) {
+	txRWSet := &rwset.TxReadWriteSet{}
+	if err := proto.Unmarshal(rwsetBytes, txRWSet); err != nil {
+		return nil, errors.Wrap(err, "failed unmarshaling read-write set")
+	}
+	rws, err := rwsetutil.TxRwSetFromProtoMsg(txRWSet)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed unmarshaling read-write set")
+	}
+
+	entries := map[entryKey]string{}
+	for _, ns := range rws.NsRwSets {
+		for _, r := range ns.KvRwSet.Reads {
+			entries[entryKey{ns.NameSpace, "", "read", r.Key}] = describeVersion(r.Version)
+		}
+		for _, w := range ns.KvRwSet.Writes {
+			entries[entryKey{ns.NameSpace, "", "write", w.Key}] = describeWrite(w.IsDelete, w.Value)
+		}
+		for _, mw := range ns.KvRwSet.MetadataWrites {
+			entries[entryKey{ns.NameSpace, "", "metadata_write", mw.Key}] = describeMetadata(mw.Entries)
+		}
+		for i, rq := range ns.KvRwSet.RangeQueriesInfo {
+			key := fmt.Sprintf("[%s, %s) #%d", rq.StartKey, rq.EndKey, i)
+			entries[entryKey{ns.NameSpace, "", "range_query", key}] = proto.CompactTextString(rq)
+		}
+		for _, coll := range ns.CollHashedRwSets {
+			for _, r := range coll.HashedRwSet.HashedReads {
+				entries[entryKey{ns.NameSpace, coll.CollectionName, "read", hex.EncodeToString(r.KeyHash)}] = describeVersion(r.Version)
+			}
+			for _, w := range coll.HashedRwSet.HashedWrites {
+				entries[entryKey{ns.NameSpace, coll.CollectionName, "write", hex.EncodeToString(w.KeyHash)}] = describeWrite(w.IsDelete, []byte(hex.EncodeToString(w.ValueHash)))
+			}
+			for _, mw := range coll.HashedRwSet.MetadataWrites {
+				entries[entryKey{ns.NameSpace, coll.CollectionName, "metadata_write", hex.EncodeToString(mw.KeyHash)}] = describeMetadata(mw.Entries)
+			}
+		}
+	}
+	return entries, nil
+}
+
+func describeVersion(v *kvrwset.Version) string {
+	if v == nil {
+		return "not found"
+	}
+	return fmt.Sprintf("version %d:%d", v.BlockNum, v.TxNum)
+}
+
+func describeWrite(isDelete bool, value []byte) string {
+	if isDelete {
+		return "delete"
+	}
+	if len(value) > maxValueLength {
+		return fmt.Sprintf("%s... (%d bytes)", strconv.Quote(string(value[:maxValueLength])), len(value))
+	}
+	return strconv.Quote(string(value))
+}
+
+func describeMetadata(entries []*kvrwset.KVMetadataEntry) string {
+	s := ""
+	for i, e := range entries {
+		if i > 0 {
+			s += ", "
+		}
+		s += e.Name + "=" + strconv.Quote(string(e.Value))
+	}
+	return s
+}
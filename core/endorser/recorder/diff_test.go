@@ -0,0 +1,67 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package recorder
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
+	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	simulation := func(build func(b *rwsetutil.RWSetBuilder)) *Simulation {
+		b := rwsetutil.NewRWSetBuilder()
+		build(b)
+		results, err := b.GetTxSimulationResults()
+		require.NoError(t, err)
+		return &Simulation{ReadWriteSet: protoMarshal(t, results.PubSimulationResults)}
+	}
+
+	first := simulation(func(b *rwsetutil.RWSetBuilder) {
+		b.AddToReadSet("mycc", "same", version.NewHeight(1, 0))
+		b.AddToReadSet("mycc", "read", version.NewHeight(1, 1))
+		b.AddToWriteSet("mycc", "write", []byte("first"))
+		b.AddToWriteSet("mycc", "long", []byte(strings.Repeat("a", 200)))
+		b.AddToRangeQuerySet("mycc", &kvrwset.RangeQueryInfo{StartKey: "a", EndKey: "b", ItrExhausted: true})
+		b.AddToPvtAndHashedWriteSet("mycc", "coll", "pvtkey", []byte("first"))
+	})
+	second := simulation(func(b *rwsetutil.RWSetBuilder) {
+		b.AddToReadSet("mycc", "same", version.NewHeight(1, 0))
+		b.AddToReadSet("mycc", "read", nil)
+		b.AddToWriteSet("mycc", "write", nil)
+		b.AddToWriteSet("othercc", "other", []byte("second"))
+		b.AddToMetadataWriteSet("mycc", "write", map[string][]byte{"policy": []byte("p")})
+		b.AddToPvtAndHashedWriteSet("mycc", "coll", "pvtkey", []byte("second"))
+	})
+
+	differences, err := Diff(first, second)
+	require.NoError(t, err)
+	require.Len(t, differences, 7)
+	assert.Equal(t, Difference{Namespace: "mycc", Collection: "", Kind: "metadata_write", Key: "write", First: absent, Second: `policy="p"`}, differences[0])
+	assert.Equal(t, Difference{Namespace: "mycc", Kind: "range_query", Key: "[a, b) #0", First: `start_key:"a" end_key:"b" itr_exhausted:true `, Second: absent}, differences[1])
+	assert.Equal(t, Difference{Namespace: "mycc", Kind: "read", Key: "read", First: "version 1:1", Second: "not found"}, differences[2])
+	assert.Equal(t, Difference{Namespace: "mycc", Kind: "write", Key: "long", First: `"` + strings.Repeat("a", 128) + `"... (200 bytes)`, Second: absent}, differences[3])
+	assert.Equal(t, Difference{Namespace: "mycc", Kind: "write", Key: "write", First: `"first"`, Second: "delete"}, differences[4])
+	assert.Equal(t, "mycc", differences[5].Namespace)
+	assert.Equal(t, "coll", differences[5].Collection)
+	assert.Equal(t, "write", differences[5].Kind)
+	assert.NotEqual(t, differences[5].First, differences[5].Second)
+	assert.Equal(t, Difference{Namespace: "othercc", Kind: "write", Key: "other", First: absent, Second: `"second"`}, differences[6])
+
+	differences, err = Diff(first, first)
+	assert.NoError(t, err)
+	assert.Empty(t, differences)
+
+	_, err = Diff(first, &Simulation{ReadWriteSet: []byte("garbage")})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid second simulation")
+}
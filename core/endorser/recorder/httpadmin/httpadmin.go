@@ -0,0 +1,171 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package httpadmin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/core/endorser/recorder"
+	"github.com/hyperledger/fabric/core/middleware"
+)
+
+// URLBase is the path under which the handler serves recorded simulation requests.
+const URLBase = "/endorser/simulations/"
+
+//go:generate counterfeiter -o fakes/simulations.go -fake-name Simulations . Simulations
+
+// Simulations provides the simulations recorded by the endorser.
+type Simulations interface {
+	// Simulations returns the recorded simulations of the proposal with the
+	// given hash, oldest first
+	Simulations(proposalHash string) []*recorder.Simulation
+}
+
+type SimulationList struct {
+	Simulations []*recorder.Simulation `json:"simulations"`
+}
+
+type DiffResult struct {
+	Differences []recorder.Difference `json:"differences"`
+}
+
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+func NewHandler(s Simulations, checkAdmin middleware.AdminChecker) *Handler {
+	return &Handler{
+		Simulations: s,
+		CheckAdmin:  checkAdmin,
+		Logger:      flogging.MustGetLogger("endorser.recorder.httpadmin"),
+	}
+}
+
+// Handler serves the recorded simulations endpoint of the operations system.
+//
+// GET /endorser/simulations/<proposal hash> returns the recorded simulations
+// of the proposal with the given hash.
+//
+// GET /endorser/simulations/<proposal hash>/diff?first=0&second=1 returns the
+// differences between the read-write sets of two recorded simulations of the
+// proposal, identified by their indexes in the recorded simulations.
+//
+// POST /endorser/simulations/<proposal hash>/diff?first=0 with a simulation of
+// the proposal recorded by another peer returns the differences between the
+// read-write sets of the recorded simulation and the given one.
+//
+// As the read-write sets disclose the values of the ledger, the endpoint is
+// only served to clients whose TLS certificate passes CheckAdmin.
+type Handler struct {
+	Simulations Simulations
+	CheckAdmin  middleware.AdminChecker
+	Logger      *flogging.FabricLogger
+}
+
+func (h *Handler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	middleware.RequireAdmin(h.CheckAdmin)(http.HandlerFunc(h.serveSimulations)).ServeHTTP(resp, req)
+}
+
+func (h *Handler) serveSimulations(resp http.ResponseWriter, req *http.Request) {
+	path := strings.Split(strings.TrimPrefix(req.URL.Path, URLBase), "/")
+	proposalHash := path[0]
+	if proposalHash == "" || len(path) > 2 || (len(path) == 2 && path[1] != "diff") {
+		h.sendResponse(resp, http.StatusNotFound, fmt.Errorf("invalid simulation path: %s", req.URL.Path))
+		return
+	}
+
+	simulations := h.Simulations.Simulations(proposalHash)
+	if len(simulations) == 0 {
+		h.sendResponse(resp, http.StatusNotFound, fmt.Errorf("no simulation recorded for proposal %s", proposalHash))
+		return
+	}
+
+	switch {
+	case len(path) == 1 && req.Method == http.MethodGet:
+		h.sendResponse(resp, http.StatusOK, &SimulationList{Simulations: simulations})
+
+	case len(path) == 2 && req.Method == http.MethodGet:
+		first, err := simulationAt(simulations, req, "first", 0)
+		if err != nil {
+			h.sendResponse(resp, http.StatusBadRequest, err)
+			return
+		}
+		second, err := simulationAt(simulations, req, "second", 1)
+		if err != nil {
+			h.sendResponse(resp, http.StatusBadRequest, err)
+			return
+		}
+		h.sendDiff(resp, first, second)
+
+	case len(path) == 2 && req.Method == http.MethodPost:
+		first, err := simulationAt(simulations, req, "first", 0)
+		if err != nil {
+			h.sendResponse(resp, http.StatusBadRequest, err)
+			return
+		}
+		second := &recorder.Simulation{}
+		decoder := json.NewDecoder(req.Body)
+		if err := decoder.Decode(second); err != nil {
+			h.sendResponse(resp, http.StatusBadRequest, err)
+			return
+		}
+		req.Body.Close()
+		if second.ProposalHash != proposalHash {
+			h.sendResponse(resp, http.StatusBadRequest, fmt.Errorf("the simulation is of proposal %s, not %s", second.ProposalHash, proposalHash))
+			return
+		}
+		h.sendDiff(resp, first, second)
+
+	default:
+		err := fmt.Errorf("invalid request method: %s", req.Method)
+		h.sendResponse(resp, http.StatusBadRequest, err)
+	}
+}
+
+// simulationAt returns the simulation whose index is given by the query
+// parameter with the given name, or by defaultIndex if it is not set
+func simulationAt(simulations []*recorder.Simulation, req *http.Request, param string, defaultIndex int) (*recorder.Simulation, error) {
+	index := defaultIndex
+	if value := req.URL.Query().Get(param); value != "" {
+		var err error
+		if index, err = strconv.Atoi(value); err != nil {
+			return nil, fmt.Errorf("invalid %s simulation index: %s", param, value)
+		}
+	}
+	if index < 0 || index >= len(simulations) {
+		return nil, fmt.Errorf("%s simulation index %d is out of range, %d simulations are recorded", param, index, len(simulations))
+	}
+	return simulations[index], nil
+}
+
+func (h *Handler) sendDiff(resp http.ResponseWriter, first, second *recorder.Simulation) {
+	differences, err := recorder.Diff(first, second)
+	if err != nil {
+		h.sendResponse(resp, http.StatusBadRequest, err)
+		return
+	}
+	h.sendResponse(resp, http.StatusOK, &DiffResult{Differences: differences})
+}
+
+func (h *Handler) sendResponse(resp http.ResponseWriter, code int, payload interface{}) {
+	encoder := json.NewEncoder(resp)
+	if err, ok := payload.(error); ok {
+		payload = &ErrorResponse{Error: err.Error()}
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(code)
+
+	if err := encoder.Encode(payload); err != nil {
+		h.Logger.Errorw("failed to encode payload", "error", err)
+	}
+}
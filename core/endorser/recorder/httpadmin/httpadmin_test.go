@@ -0,0 +1,234 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package httpadmin_test
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/core/endorser/recorder"
+	"github.com/hyperledger/fabric/core/endorser/recorder/httpadmin"
+	"github.com/hyperledger/fabric/core/endorser/recorder/httpadmin/fakes"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		fakeSimulations *fakes.Simulations
+		simulations     []*recorder.Simulation
+		adminCert       *x509.Certificate
+		handler         *httpadmin.Handler
+	)
+
+	// newRequest returns a request authenticated with the certificate of an
+	// admin
+	newRequest := func(method, target string, body io.Reader) *http.Request {
+		req := httptest.NewRequest(method, "https://localhost"+target, body)
+		req.TLS.VerifiedChains = [][]*x509.Certificate{{adminCert}}
+		return req
+	}
+
+	newSimulation := func(value string) *recorder.Simulation {
+		b := rwsetutil.NewRWSetBuilder()
+		b.AddToWriteSet("mycc", "key", []byte(value))
+		results, err := b.GetTxSimulationResults()
+		Expect(err).NotTo(HaveOccurred())
+		rwsetBytes, err := proto.Marshal(results.PubSimulationResults)
+		Expect(err).NotTo(HaveOccurred())
+		return &recorder.Simulation{
+			ProposalHash: "abcd",
+			TxID:         "tx1",
+			Channel:      "mychannel",
+			Chaincode:    "mycc",
+			Time:         time.Unix(0, 0).UTC(),
+			ReadWriteSet: rwsetBytes,
+		}
+	}
+
+	BeforeEach(func() {
+		simulations = []*recorder.Simulation{newSimulation("a"), newSimulation("b"), newSimulation("a")}
+		fakeSimulations = &fakes.Simulations{}
+		fakeSimulations.SimulationsReturns(simulations)
+		adminCert = &x509.Certificate{Raw: []byte("admin")}
+		handler = &httpadmin.Handler{
+			Simulations: fakeSimulations,
+			CheckAdmin: func(cert *x509.Certificate) error {
+				if cert != adminCert {
+					return errors.New("not an admin")
+				}
+				return nil
+			},
+			Logger: flogging.NewFabricLogger(flogging.NewZapLogger(nil)),
+		}
+	})
+
+	Context("when the client does not authenticate", func() {
+		It("responds with unauthorized", func() {
+			req := httptest.NewRequest("GET", "/endorser/simulations/abcd", nil)
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusUnauthorized))
+			Expect(fakeSimulations.SimulationsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the client is not an admin", func() {
+		It("responds with forbidden", func() {
+			req := newRequest("GET", "/endorser/simulations/abcd", nil)
+			req.TLS.VerifiedChains = [][]*x509.Certificate{{{Raw: []byte("someone")}}}
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusForbidden))
+			Expect(fakeSimulations.SimulationsCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("GET", func() {
+		It("returns the recorded simulations of the proposal", func() {
+			req := newRequest("GET", "/endorser/simulations/abcd", nil)
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			Expect(fakeSimulations.SimulationsCallCount()).To(Equal(1))
+			Expect(fakeSimulations.SimulationsArgsForCall(0)).To(Equal("abcd"))
+			Expect(resp.Header().Get("Content-Type")).To(Equal("application/json"))
+			list := &httpadmin.SimulationList{}
+			Expect(json.Unmarshal(resp.Body.Bytes(), list)).To(Succeed())
+			Expect(list.Simulations).To(Equal(simulations))
+		})
+
+		It("returns the differences between two recorded simulations", func() {
+			req := newRequest("GET", "/endorser/simulations/abcd/diff", nil)
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			Expect(resp.Body).To(MatchJSON(`{
+				"differences": [
+					{"namespace": "mycc", "kind": "write", "key": "key", "first": "\"a\"", "second": "\"b\""}
+				]
+			}`))
+		})
+
+		It("returns the differences between the given recorded simulations", func() {
+			req := newRequest("GET", "/endorser/simulations/abcd/diff?first=0&second=2", nil)
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			Expect(resp.Body).To(MatchJSON(`{"differences": []}`))
+		})
+
+		Context("when a simulation index is invalid", func() {
+			It("responds with bad request", func() {
+				req := newRequest("GET", "/endorser/simulations/abcd/diff?second=3", nil)
+				resp := httptest.NewRecorder()
+				handler.ServeHTTP(resp, req)
+
+				Expect(resp.Code).To(Equal(http.StatusBadRequest))
+				Expect(resp.Body).To(MatchJSON(`{"error": "second simulation index 3 is out of range, 3 simulations are recorded"}`))
+
+				req = newRequest("GET", "/endorser/simulations/abcd/diff?first=x", nil)
+				resp = httptest.NewRecorder()
+				handler.ServeHTTP(resp, req)
+
+				Expect(resp.Code).To(Equal(http.StatusBadRequest))
+				Expect(resp.Body).To(MatchJSON(`{"error": "invalid first simulation index: x"}`))
+			})
+		})
+
+		Context("when no simulation of the proposal is recorded", func() {
+			BeforeEach(func() {
+				fakeSimulations.SimulationsReturns(nil)
+			})
+
+			It("responds with not found", func() {
+				req := newRequest("GET", "/endorser/simulations/abcd", nil)
+				resp := httptest.NewRecorder()
+				handler.ServeHTTP(resp, req)
+
+				Expect(resp.Code).To(Equal(http.StatusNotFound))
+				Expect(resp.Body).To(MatchJSON(`{"error": "no simulation recorded for proposal abcd"}`))
+			})
+		})
+	})
+
+	Describe("POST", func() {
+		It("returns the differences between a recorded simulation and the given one", func() {
+			body, err := json.Marshal(newSimulation("c"))
+			Expect(err).NotTo(HaveOccurred())
+			req := newRequest("POST", "/endorser/simulations/abcd/diff?first=1", strings.NewReader(string(body)))
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			Expect(resp.Body).To(MatchJSON(`{
+				"differences": [
+					{"namespace": "mycc", "kind": "write", "key": "key", "first": "\"b\"", "second": "\"c\""}
+				]
+			}`))
+		})
+
+		Context("when the given simulation is of another proposal", func() {
+			It("responds with bad request", func() {
+				req := newRequest("POST", "/endorser/simulations/abcd/diff", strings.NewReader(`{"proposal_hash": "ef"}`))
+				resp := httptest.NewRecorder()
+				handler.ServeHTTP(resp, req)
+
+				Expect(resp.Code).To(Equal(http.StatusBadRequest))
+				Expect(resp.Body).To(MatchJSON(`{"error": "the simulation is of proposal ef, not abcd"}`))
+			})
+		})
+
+		Context("when the request body is malformed", func() {
+			It("responds with bad request", func() {
+				req := newRequest("POST", "/endorser/simulations/abcd/diff", strings.NewReader(`goo`))
+				resp := httptest.NewRecorder()
+				handler.ServeHTTP(resp, req)
+
+				Expect(resp.Code).To(Equal(http.StatusBadRequest))
+				Expect(resp.Body).To(MatchJSON(`{"error": "invalid character 'g' looking for beginning of value"}`))
+			})
+		})
+	})
+
+	Context("when the simulation path is invalid", func() {
+		It("responds with not found", func() {
+			req := newRequest("GET", "/endorser/simulations/abcd/foo", nil)
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusNotFound))
+			Expect(resp.Body).To(MatchJSON(`{"error": "invalid simulation path: /endorser/simulations/abcd/foo"}`))
+			Expect(fakeSimulations.SimulationsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the request method is unsupported", func() {
+		It("responds with bad request", func() {
+			req := newRequest("POST", "/endorser/simulations/abcd", nil)
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+			Expect(resp.Body).To(MatchJSON(`{"error": "invalid request method: POST"}`))
+		})
+	})
+})
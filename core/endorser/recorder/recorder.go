@@ -0,0 +1,114 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package recorder
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/core/aclmgmt/resources"
+	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	pb "github.com/hyperledger/fabric/protos/peer"
+)
+
+var logger = flogging.MustGetLogger("endorser.recorder")
+
+// ACLProvider checks access control of a resource for a signed proposal
+type ACLProvider interface {
+	CheckACL(resName string, channelID string, idinfo interface{}) error
+}
+
+// Simulation is the read-write set recorded for a simulation of a proposal
+type Simulation struct {
+	// ProposalHash is the hex encoded SHA256 hash of the proposal bytes, which
+	// is the same on all the peers the proposal is sent to
+	ProposalHash string    `json:"proposal_hash"`
+	TxID         string    `json:"tx_id"`
+	Channel      string    `json:"channel"`
+	Chaincode    string    `json:"chaincode"`
+	Time         time.Time `json:"time"`
+	// ReadWriteSet is the marshaled public read-write set of the simulation
+	ReadWriteSet []byte `json:"read_write_set"`
+}
+
+// Recorder records the read-write sets of the simulations of the proposals
+// whose creators satisfy the peer/RecordSimulation ACL of the channel, so that
+// the simulations of a proposal can be compared when their results mismatch.
+// The oldest simulations are discarded once the maximum number of recorded
+// simulations is reached.
+type Recorder struct {
+	ACLProvider ACLProvider
+	MaxRecords  int
+
+	mutex       sync.RWMutex
+	simulations []*Simulation
+}
+
+// NewRecorder creates a Recorder keeping at most maxRecords simulations
+func NewRecorder(aclProvider ACLProvider, maxRecords int) *Recorder {
+	return &Recorder{
+		ACLProvider: aclProvider,
+		MaxRecords:  maxRecords,
+	}
+}
+
+// ProposalHash returns the hash identifying the given signed proposal
+func ProposalHash(signedProp *pb.SignedProposal) string {
+	hash := sha256.Sum256(signedProp.ProposalBytes)
+	return hex.EncodeToString(hash[:])
+}
+
+// Record records the public read-write set of the simulation of the given
+// proposal, if its creator satisfies the peer/RecordSimulation ACL
+func (r *Recorder) Record(signedProp *pb.SignedProposal, channel, txID string, cid *pb.ChaincodeID, results *rwset.TxReadWriteSet) {
+	if err := r.ACLProvider.CheckACL(resources.Peer_RecordSimulation, channel, signedProp); err != nil {
+		logger.Debugf("[%s] Not recording simulation of transaction %s: %s", channel, txID, err)
+		return
+	}
+	rwsetBytes, err := proto.Marshal(results)
+	if err != nil {
+		logger.Warningf("[%s] Failed marshaling read-write set of transaction %s: %s", channel, txID, err)
+		return
+	}
+	r.add(&Simulation{
+		ProposalHash: ProposalHash(signedProp),
+		TxID:         txID,
+		Channel:      channel,
+		Chaincode:    cid.Name,
+		Time:         time.Now(),
+		ReadWriteSet: rwsetBytes,
+	})
+}
+
+func (r *Recorder) add(s *Simulation) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.simulations = append(r.simulations, s)
+	if len(r.simulations) > r.MaxRecords {
+		r.simulations = r.simulations[len(r.simulations)-r.MaxRecords:]
+	}
+}
+
+// Simulations returns the recorded simulations of the proposal with the
+// given hash, oldest first
+func (r *Recorder) Simulations(proposalHash string) []*Simulation {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	var simulations []*Simulation
+	for _, s := range r.simulations {
+		if s.ProposalHash == proposalHash {
+			simulations = append(simulations, s)
+		}
+	}
+	return simulations
+}
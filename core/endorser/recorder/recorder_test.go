@@ -0,0 +1,72 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package recorder
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/aclmgmt/mocks"
+	"github.com/hyperledger/fabric/core/aclmgmt/resources"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pubSimResults(t *testing.T, value string) *rwset.TxReadWriteSet {
+	b := rwsetutil.NewRWSetBuilder()
+	b.AddToWriteSet("mycc", "key", []byte(value))
+	results, err := b.GetTxSimulationResults()
+	require.NoError(t, err)
+	return results.PubSimulationResults
+}
+
+func TestRecorder(t *testing.T) {
+	aclProvider := &mocks.DefaultACLProvider{}
+	aclProvider.CheckACLReturnsOnCall(1, errors.New("access denied"))
+	r := NewRecorder(aclProvider, 2)
+
+	prop1 := &pb.SignedProposal{ProposalBytes: []byte("proposal1")}
+	prop2 := &pb.SignedProposal{ProposalBytes: []byte("proposal2")}
+	cid := &pb.ChaincodeID{Name: "mycc"}
+	r.Record(prop1, "mychannel", "tx1", cid, pubSimResults(t, "a"))
+	r.Record(prop2, "mychannel", "tx2", cid, pubSimResults(t, "b"))
+	r.Record(prop1, "mychannel", "tx1", cid, pubSimResults(t, "c"))
+
+	require.Equal(t, 3, aclProvider.CheckACLCallCount())
+	resName, channel, idinfo := aclProvider.CheckACLArgsForCall(0)
+	assert.Equal(t, resources.Peer_RecordSimulation, resName)
+	assert.Equal(t, "mychannel", channel)
+	assert.Equal(t, prop1, idinfo)
+
+	// The simulation of prop2 is not recorded as the ACL check failed
+	assert.Empty(t, r.Simulations(ProposalHash(prop2)))
+	simulations := r.Simulations(ProposalHash(prop1))
+	require.Len(t, simulations, 2)
+	assert.Equal(t, "055b10df2b464ae9944b23da55334509f125620b048772c245771711395b1a3f", simulations[0].ProposalHash)
+	assert.Equal(t, "tx1", simulations[0].TxID)
+	assert.Equal(t, "mychannel", simulations[0].Channel)
+	assert.Equal(t, "mycc", simulations[0].Chaincode)
+	assert.Equal(t, protoMarshal(t, pubSimResults(t, "a")), simulations[0].ReadWriteSet)
+	assert.Equal(t, protoMarshal(t, pubSimResults(t, "c")), simulations[1].ReadWriteSet)
+
+	// The oldest simulation is discarded once the maximum is reached
+	r.Record(prop1, "mychannel", "tx1", cid, pubSimResults(t, "d"))
+	simulations = r.Simulations(ProposalHash(prop1))
+	require.Len(t, simulations, 2)
+	assert.Equal(t, protoMarshal(t, pubSimResults(t, "c")), simulations[0].ReadWriteSet)
+	assert.Equal(t, protoMarshal(t, pubSimResults(t, "d")), simulations[1].ReadWriteSet)
+}
+
+func protoMarshal(t *testing.T, m proto.Message) []byte {
+	b, err := proto.Marshal(m)
+	require.NoError(t, err)
+	return b
+}
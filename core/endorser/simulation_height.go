@@ -0,0 +1,104 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package endorser
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/pkg/errors"
+)
+
+// DefaultSimulationHeightTimeout is the maximum time to wait for the ledger
+// of a channel to reach the simulation height requested by a proposal, if
+// peer.simulationHeightTimeout is not set
+const DefaultSimulationHeightTimeout = 5 * time.Second
+
+// SimulationHeightError is returned for the proposals rejected because the
+// ledger of the channel did not reach the simulation height they request in
+// time, so that they can be retried later. They are answered with a
+// SERVICE_UNAVAILABLE status.
+type SimulationHeightError struct {
+	Channel         string
+	RequestedHeight uint64
+	Err             error
+}
+
+func (e *SimulationHeightError) Error() string {
+	return fmt.Sprintf("ledger of channel %s did not reach the requested simulation height %d: %s",
+		e.Channel, e.RequestedHeight, e.Err)
+}
+
+// SimulationHeightPassedError is returned for the proposals rejected because
+// the ledger of the channel is already beyond the simulation height they
+// request, as the state at a past height is not retained. They are answered
+// with a GONE status, and cannot succeed unless a higher height is requested.
+type SimulationHeightPassedError struct {
+	Channel         string
+	RequestedHeight uint64
+	Height          uint64
+}
+
+func (e *SimulationHeightPassedError) Error() string {
+	return fmt.Sprintf("ledger of channel %s is at height %d, beyond the requested simulation height %d",
+		e.Channel, e.Height, e.RequestedHeight)
+}
+
+// getTxSimulator returns a transaction simulator for the channel. If the
+// simulation height is not 0, the state of the simulator is exactly at that
+// height: the ledger is waited for if it has not reached the height yet, and
+// a SimulationHeightPassedError is returned if it is already beyond it.
+func (e *Endorser) getTxSimulator(ctx context.Context, chainID, txid string, height uint64) (ledger.TxSimulator, error) {
+	if height == 0 {
+		return e.s.GetTxSimulator(chainID, txid)
+	}
+
+	timeout := e.SimulationHeightTimeout
+	if timeout <= 0 {
+		timeout = DefaultSimulationHeightTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		txsim, err := e.s.GetTxSimulator(chainID, txid)
+		if err != nil {
+			return nil, err
+		}
+		// the state of the simulator does not change until it is done, as
+		// it prevents the commit of blocks
+		current, err := stateHeight(txsim)
+		if err != nil {
+			txsim.Done()
+			return nil, err
+		}
+		if current == height {
+			return txsim, nil
+		}
+		txsim.Done()
+		if current > height {
+			return nil, &SimulationHeightPassedError{Channel: chainID, RequestedHeight: height, Height: current}
+		}
+
+		endorserLogger.Debugf("[%s][%s] Waiting for the ledger to reach height %d, currently at %d", chainID, shorttxid(txid), height, current)
+		if err := e.s.WaitForLedgerHeight(ctx, chainID, height); err != nil {
+			return nil, &SimulationHeightError{Channel: chainID, RequestedHeight: height, Err: err}
+		}
+	}
+}
+
+// stateHeight returns the height of the ledger whose state the simulator
+// simulates against
+func stateHeight(txsim ledger.TxSimulator) (uint64, error) {
+	hrqe, ok := txsim.(ledger.HeightReportingQueryExecutor)
+	if !ok {
+		return 0, errors.New("the ledger does not support simulation at a requested height")
+	}
+	return hrqe.GetStateHeight()
+}
@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package endorser
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hyperledger/fabric/common/channelconfig"
@@ -21,6 +22,8 @@ import (
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/peer"
 	"github.com/hyperledger/fabric/core/scc"
+	gossipcommon "github.com/hyperledger/fabric/gossip/common"
+	"github.com/hyperledger/fabric/gossip/discovery"
 	"github.com/hyperledger/fabric/protos/common"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/pkg/errors"
@@ -36,6 +39,18 @@ type SupportImpl struct {
 	ChaincodeSupport *chaincode.ChaincodeSupport
 	SysCCProvider    *scc.Provider
 	ACLProvider      aclmgmt.ACLProvider
+	// ACLCache, if set, caches the ACL decisions of proposals
+	ACLCache *ACLCache
+	// ChannelMembership, if set, provides the heights of the ledgers of
+	// the other peers of the channels
+	ChannelMembership ChannelMembership
+}
+
+// ChannelMembership provides the alive peers of a channel, as known via gossip
+type ChannelMembership interface {
+	// PeersOfChannel returns the NetworkMembers considered alive
+	// and also subscribed to the channel given
+	PeersOfChannel(gossipcommon.ChainID) []discovery.NetworkMember
 }
 
 func (s *SupportImpl) NewQueryCreator(channel string) (QueryCreator, error) {
@@ -105,6 +120,55 @@ func (s *SupportImpl) GetLedgerHeight(channelID string) (uint64, error) {
 	return info.Height, nil
 }
 
+// GetChannelTipHeight returns the highest ledger height reached by a
+// majority of the peers of the channel, or 0 if it is unknown
+func (s *SupportImpl) GetChannelTipHeight(channelID string) uint64 {
+	if s.ChannelMembership == nil {
+		return 0
+	}
+	return discovery.Members(s.ChannelMembership.PeersOfChannel(gossipcommon.ChainID(channelID))).QuorumLedgerHeight()
+}
+
+// WaitForLedgerHeight waits until the ledger of the channel reaches the given
+// height, including the state of its last block, or the context is done
+func (s *SupportImpl) WaitForLedgerHeight(ctx context.Context, channelID string, height uint64) error {
+	lgr := s.Peer.GetLedger(channelID)
+	if lgr == nil {
+		return errors.Errorf("failed to look up the ledger for Channel %s", channelID)
+	}
+	if height == 0 {
+		return nil
+	}
+
+	itr, err := lgr.GetBlocksIterator(height - 1)
+	if err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("failed to obtain a blocks iterator for Channel %s", channelID))
+	}
+	defer itr.Close()
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			// unblocks the iterator waiting for the block
+			itr.Close()
+		case <-done:
+		}
+	}()
+
+	if _, err := itr.Next(); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	// the block is added to the block store before its transactions are
+	// committed to the state, and the blockchain info is only returned once
+	// the commit of the block is complete
+	_, err = lgr.GetBlockchainInfo()
+	return err
+}
+
 // IsSysCC returns true if the name matches a system chaincode's
 // system chaincode names are system, chain wide
 func (s *SupportImpl) IsSysCC(name string) bool {
@@ -132,12 +196,13 @@ func (s *SupportImpl) ExecuteLegacyInit(txParams *ccprovider.TransactionParams,
 }
 
 // Execute a proposal and return the chaincode response
-func (s *SupportImpl) Execute(txParams *ccprovider.TransactionParams, cid, name, version, txid string, idBytes []byte, requiresInit bool, signedProp *pb.SignedProposal, prop *pb.Proposal, input *pb.ChaincodeInput) (*pb.Response, *pb.ChaincodeEvent, error) {
+func (s *SupportImpl) Execute(txParams *ccprovider.TransactionParams, cid, name, version, txid string, idBytes []byte, requiresInit, readYourWrites bool, signedProp *pb.SignedProposal, prop *pb.Proposal, input *pb.ChaincodeInput) (*pb.Response, *pb.ChaincodeEvent, error) {
 	cccid := &ccprovider.CCContext{
-		Name:         name,
-		Version:      version,
-		InitRequired: requiresInit,
-		ID:           idBytes,
+		Name:           name,
+		Version:        version,
+		InitRequired:   requiresInit,
+		ReadYourWrites: readYourWrites,
+		ID:             idBytes,
 	}
 
 	// decorate the chaincode input
@@ -157,6 +222,9 @@ func (s *SupportImpl) GetChaincodeDefinition(chaincodeName string, txsim ledger.
 // CheckACL checks the ACL for the resource for the Channel using the
 // SignedProposal from which an id can be extracted for testing against a policy
 func (s *SupportImpl) CheckACL(signedProp *pb.SignedProposal, chdr *common.ChannelHeader, shdr *common.SignatureHeader, hdrext *pb.ChaincodeHeaderExtension) error {
+	if s.ACLCache != nil {
+		return s.ACLCache.CheckACL(resources.Peer_Propose, chdr.ChannelId, shdr.Creator, signedProp)
+	}
 	return s.ACLProvider.CheckACL(resources.Peer_Propose, chdr.ChannelId, signedProp)
 }
 
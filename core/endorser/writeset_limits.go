@@ -0,0 +1,100 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package endorser
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	"github.com/pkg/errors"
+)
+
+// WriteSetLimits are the limits on the write-set of the transactions endorsed
+// in a channel. A zero limit means that there is no limit.
+type WriteSetLimits struct {
+	// MaxBytes is the maximum size in bytes of the public read-write set
+	MaxBytes int `mapstructure:"maxBytes" yaml:"maxBytes"`
+	// MaxWrites is the maximum number of keys written, including the hashed
+	// writes of private data and the writes of key metadata
+	MaxWrites int `mapstructure:"maxWrites" yaml:"maxWrites"`
+	// ForbiddenNamespaces are the namespaces to which transactions must not write
+	ForbiddenNamespaces []string `mapstructure:"forbiddenNamespaces" yaml:"forbiddenNamespaces"`
+}
+
+// WriteSetPolicy defines the limits on the write-sets of the transactions
+// endorsed by the peer, which are checked before the proposal response is
+// signed so that oversized transactions never reach the ordering service
+type WriteSetPolicy struct {
+	WriteSetLimits `mapstructure:",squash" yaml:",inline"`
+	// Channels overrides the limits for specific channels. The limits that are
+	// not set for a channel are inherited from the default ones.
+	Channels map[string]WriteSetLimits `mapstructure:"channels" yaml:"channels"`
+}
+
+// LimitsForChannel returns the limits of the write-sets of the given channel
+func (p *WriteSetPolicy) LimitsForChannel(channel string) WriteSetLimits {
+	limits := p.WriteSetLimits
+	override, exists := p.Channels[channel]
+	if !exists {
+		return limits
+	}
+	if override.MaxBytes != 0 {
+		limits.MaxBytes = override.MaxBytes
+	}
+	if override.MaxWrites != 0 {
+		limits.MaxWrites = override.MaxWrites
+	}
+	if override.ForbiddenNamespaces != nil {
+		limits.ForbiddenNamespaces = override.ForbiddenNamespaces
+	}
+	return limits
+}
+
+// Check returns an error if the public simulation results of a transaction of
+// the given channel exceed the limits of the channel. A nil policy enforces no
+// limits.
+func (p *WriteSetPolicy) Check(channel string, pubSimResults *rwset.TxReadWriteSet) error {
+	if p == nil || pubSimResults == nil {
+		return nil
+	}
+	limits := p.LimitsForChannel(channel)
+
+	if size := proto.Size(pubSimResults); limits.MaxBytes > 0 && size > limits.MaxBytes {
+		return errors.Errorf("the read-write set is %d bytes, which exceeds the limit of %d bytes of channel %s; "+
+			"split the transaction or raise peer.writeSetLimits.maxBytes", size, limits.MaxBytes, channel)
+	}
+
+	txRWSet, err := rwsetutil.TxRwSetFromProtoMsg(pubSimResults)
+	if err != nil {
+		return errors.WithMessage(err, "failed reading the read-write set")
+	}
+	writes := 0
+	for _, nsRWSet := range txRWSet.NsRwSets {
+		nsWrites := len(nsRWSet.KvRwSet.Writes) + len(nsRWSet.KvRwSet.MetadataWrites)
+		for _, collRWSet := range nsRWSet.CollHashedRwSets {
+			nsWrites += len(collRWSet.HashedRwSet.HashedWrites) + len(collRWSet.HashedRwSet.MetadataWrites)
+		}
+		if nsWrites > 0 && contains(limits.ForbiddenNamespaces, nsRWSet.NameSpace) {
+			return errors.Errorf("writing to namespace %s is forbidden in channel %s by peer.writeSetLimits.forbiddenNamespaces", nsRWSet.NameSpace, channel)
+		}
+		writes += nsWrites
+	}
+	if limits.MaxWrites > 0 && writes > limits.MaxWrites {
+		return errors.Errorf("the transaction writes %d keys, which exceeds the limit of %d writes of channel %s; "+
+			"split the transaction or raise peer.writeSetLimits.maxWrites", writes, limits.MaxWrites, channel)
+	}
+	return nil
+}
+
+func contains(s []string, e string) bool {
+	for _, a := range s {
+		if a == e {
+			return true
+		}
+	}
+	return false
+}
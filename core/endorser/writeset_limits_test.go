@@ -0,0 +1,104 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package endorser_test
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/endorser"
+	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// newPubSimResults returns the public simulation results of a transaction
+// writing the given number of keys to each of the given namespaces
+func newPubSimResults(writes int, namespaces ...string) *rwset.TxReadWriteSet {
+	txRWSet := &rwset.TxReadWriteSet{DataModel: rwset.TxReadWriteSet_KV}
+	for _, ns := range namespaces {
+		kvRWSet := &kvrwset.KVRWSet{}
+		for i := 0; i < writes; i++ {
+			kvRWSet.Writes = append(kvRWSet.Writes, &kvrwset.KVWrite{Key: "key", Value: []byte("value")})
+		}
+		txRWSet.NsRwset = append(txRWSet.NsRwset, &rwset.NsReadWriteSet{
+			Namespace: ns,
+			Rwset:     protoutil.MarshalOrPanic(kvRWSet),
+		})
+	}
+	return txRWSet
+}
+
+func TestWriteSetPolicyLimitsForChannel(t *testing.T) {
+	policy := &endorser.WriteSetPolicy{
+		WriteSetLimits: endorser.WriteSetLimits{MaxBytes: 1000, MaxWrites: 10, ForbiddenNamespaces: []string{"lscc"}},
+		Channels: map[string]endorser.WriteSetLimits{
+			"mychannel":    {MaxWrites: 5},
+			"otherchannel": {MaxBytes: 2000, ForbiddenNamespaces: []string{}},
+		},
+	}
+	assert.Equal(t, endorser.WriteSetLimits{MaxBytes: 1000, MaxWrites: 10, ForbiddenNamespaces: []string{"lscc"}}, policy.LimitsForChannel("testchannel"))
+	assert.Equal(t, endorser.WriteSetLimits{MaxBytes: 1000, MaxWrites: 5, ForbiddenNamespaces: []string{"lscc"}}, policy.LimitsForChannel("mychannel"))
+	assert.Equal(t, endorser.WriteSetLimits{MaxBytes: 2000, MaxWrites: 10, ForbiddenNamespaces: []string{}}, policy.LimitsForChannel("otherchannel"))
+}
+
+func TestWriteSetPolicyCheck(t *testing.T) {
+	var nilPolicy *endorser.WriteSetPolicy
+	assert.NoError(t, nilPolicy.Check("mychannel", newPubSimResults(100, "mycc")))
+
+	results := newPubSimResults(3, "mycc", "othercc")
+	size := proto.Size(results)
+	tests := []struct {
+		name        string
+		limits      endorser.WriteSetLimits
+		expectedErr string
+	}{
+		{
+			name:   "no limits",
+			limits: endorser.WriteSetLimits{},
+		},
+		{
+			name:   "within limits",
+			limits: endorser.WriteSetLimits{MaxBytes: size, MaxWrites: 6, ForbiddenNamespaces: []string{"lscc"}},
+		},
+		{
+			name:        "too many bytes",
+			limits:      endorser.WriteSetLimits{MaxBytes: size - 1},
+			expectedErr: "the read-write set is 107 bytes, which exceeds the limit of 106 bytes of channel mychannel; split the transaction or raise peer.writeSetLimits.maxBytes",
+		},
+		{
+			name:        "too many writes",
+			limits:      endorser.WriteSetLimits{MaxWrites: 5},
+			expectedErr: "the transaction writes 6 keys, which exceeds the limit of 5 writes of channel mychannel; split the transaction or raise peer.writeSetLimits.maxWrites",
+		},
+		{
+			name:        "forbidden namespace",
+			limits:      endorser.WriteSetLimits{ForbiddenNamespaces: []string{"othercc"}},
+			expectedErr: "writing to namespace othercc is forbidden in channel mychannel by peer.writeSetLimits.forbiddenNamespaces",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &endorser.WriteSetPolicy{Channels: map[string]endorser.WriteSetLimits{"mychannel": tt.limits}}
+			err := policy.Check("mychannel", results)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+
+	// Reading a forbidden namespace is allowed
+	policy := &endorser.WriteSetPolicy{WriteSetLimits: endorser.WriteSetLimits{ForbiddenNamespaces: []string{"mycc"}}}
+	assert.NoError(t, policy.Check("mychannel", newPubSimResults(0, "mycc")))
+
+	err := policy.Check("mychannel", &rwset.TxReadWriteSet{NsRwset: []*rwset.NsReadWriteSet{{Namespace: "mycc", Rwset: []byte("garbage")}}})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed reading the read-write set")
+}
@@ -7,11 +7,14 @@ SPDX-License-Identifier: Apache-2.0
 package auth
 
 import (
+	"context"
+
 	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
 )
 
 // Filter defines an authentication filter that intercepts
-// ProcessProposal methods
+// ProcessProposal and ProcessProposals methods
 type Filter interface {
 	peer.EndorserServer
 	// Init initializes the Filter with the next EndorserServer
@@ -35,3 +38,37 @@ func ChainFilters(endorser peer.EndorserServer, filters ...Filter) peer.Endorser
 
 	return filters[0]
 }
+
+// FilterProposals applies the given check to each proposal of the batch, and
+// forwards the proposals that pass it to the next EndorserServer as a single
+// batch. The responses are returned in the order of the batch, the proposals
+// failing the check getting a response with status 500 and the error as
+// message.
+func FilterProposals(ctx context.Context, batch *peer.SignedProposals, next peer.EndorserServer, check func(*peer.SignedProposal) error) (*peer.ProposalResponses, error) {
+	responses := make([]*peer.ProposalResponse, len(batch.GetProposals()))
+	var passed []*peer.SignedProposal
+	var passedIndexes []int
+	for i, signedProp := range batch.GetProposals() {
+		if err := check(signedProp); err != nil {
+			responses[i] = &peer.ProposalResponse{Response: &peer.Response{Status: 500, Message: err.Error()}}
+			continue
+		}
+		passed = append(passed, signedProp)
+		passedIndexes = append(passedIndexes, i)
+	}
+	if len(passed) == 0 {
+		return &peer.ProposalResponses{Responses: responses}, nil
+	}
+
+	nextResponses, err := next.ProcessProposals(ctx, &peer.SignedProposals{Proposals: passed})
+	if err != nil {
+		return nil, err
+	}
+	if len(nextResponses.GetResponses()) != len(passed) {
+		return nil, errors.Errorf("expected %d responses, got %d", len(passed), len(nextResponses.GetResponses()))
+	}
+	for i, resp := range nextResponses.Responses {
+		responses[passedIndexes[i]] = resp
+	}
+	return &peer.ProposalResponses{Responses: responses}, nil
+}
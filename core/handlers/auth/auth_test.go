@@ -12,6 +12,7 @@ import (
 	"testing"
 
 	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -40,6 +41,41 @@ func TestChainFilters(t *testing.T) {
 		"Expected endorser to be invoked first")
 }
 
+func TestFilterProposals(t *testing.T) {
+	endorser := &mockEndorserServer{}
+	batch := &peer.SignedProposals{Proposals: []*peer.SignedProposal{
+		{ProposalBytes: []byte("first")},
+		{ProposalBytes: []byte("rejected")},
+		{ProposalBytes: []byte("third")},
+	}}
+	check := func(signedProp *peer.SignedProposal) error {
+		if string(signedProp.ProposalBytes) == "rejected" {
+			return errors.New("proposal rejected")
+		}
+		return nil
+	}
+
+	resps, err := FilterProposals(context.Background(), batch, endorser, check)
+	assert.NoError(t, err)
+	assert.Equal(t, []*peer.SignedProposal{batch.Proposals[0], batch.Proposals[2]}, endorser.batch.Proposals)
+	assert.Len(t, resps.Responses, 3)
+	assert.Equal(t, []byte("first"), resps.Responses[0].Response.Payload)
+	assert.Equal(t, &peer.Response{Status: 500, Message: "proposal rejected"}, resps.Responses[1].Response)
+	assert.Equal(t, []byte("third"), resps.Responses[2].Response.Payload)
+
+	// No proposal passes the check
+	endorser.batch = nil
+	resps, err = FilterProposals(context.Background(), &peer.SignedProposals{Proposals: batch.Proposals[1:2]}, endorser, check)
+	assert.NoError(t, err)
+	assert.Nil(t, endorser.batch)
+	assert.Len(t, resps.Responses, 1)
+
+	// The next EndorserServer fails
+	endorser.err = errors.New("batch too large")
+	_, err = FilterProposals(context.Background(), batch, endorser, check)
+	assert.EqualError(t, err, "batch too large")
+}
+
 func createNFilters(n int) []Filter {
 	filters := make([]Filter, n)
 	for i := 0; i < n; i++ {
@@ -50,6 +86,8 @@ func createNFilters(n int) []Filter {
 
 type mockEndorserServer struct {
 	sequence uint32
+	batch    *peer.SignedProposals
+	err      error
 }
 
 func (es *mockEndorserServer) ProcessProposal(ctx context.Context, prop *peer.SignedProposal) (*peer.ProposalResponse, error) {
@@ -58,6 +96,18 @@ func (es *mockEndorserServer) ProcessProposal(ctx context.Context, prop *peer.Si
 	return nil, nil
 }
 
+func (es *mockEndorserServer) ProcessProposals(ctx context.Context, batch *peer.SignedProposals) (*peer.ProposalResponses, error) {
+	es.batch = batch
+	if es.err != nil {
+		return nil, es.err
+	}
+	responses := &peer.ProposalResponses{}
+	for _, prop := range batch.Proposals {
+		responses.Responses = append(responses.Responses, &peer.ProposalResponse{Response: &peer.Response{Status: 200, Payload: prop.ProposalBytes}})
+	}
+	return responses, nil
+}
+
 type mockAuthFilter struct {
 	sequence uint32
 	next     peer.EndorserServer
@@ -69,6 +119,10 @@ func (f *mockAuthFilter) ProcessProposal(ctx context.Context, prop *peer.SignedP
 	return f.next.ProcessProposal(ctx, prop)
 }
 
+func (f *mockAuthFilter) ProcessProposals(ctx context.Context, batch *peer.SignedProposals) (*peer.ProposalResponses, error) {
+	return f.next.ProcessProposals(ctx, batch)
+}
+
 func (f *mockAuthFilter) Init(next peer.EndorserServer) {
 	f.next = next
 }
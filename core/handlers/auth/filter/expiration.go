@@ -60,3 +60,9 @@ func (f *expirationCheckFilter) ProcessProposal(ctx context.Context, signedProp
 	}
 	return f.next.ProcessProposal(ctx, signedProp)
 }
+
+// ProcessProposals processes a batch of signed proposals, forwarding only the
+// proposals whose identity has not expired
+func (f *expirationCheckFilter) ProcessProposals(ctx context.Context, batch *peer.SignedProposals) (*peer.ProposalResponses, error) {
+	return auth.FilterProposals(ctx, batch, f.next, validateProposal)
+}
@@ -134,3 +134,35 @@ func TestExpirationCheckFilter(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed parsing header")
 	assert.False(t, nextEndorser.invoked)
 }
+
+func TestExpirationCheckFilterBatch(t *testing.T) {
+	nextEndorser := &mockEndorserServer{}
+	auth := NewExpirationCheckFilter()
+	auth.Init(nextEndorser)
+
+	expired := createValidSignedProposal(t, createX509Identity(t, "expiredCert.pem"))
+	notExpired := createValidSignedProposal(t, createX509Identity(t, "notExpiredCert.pem"))
+	idemix := createValidSignedProposal(t, createIdemixIdentity(t))
+
+	// Only the proposals whose identity has not expired are forwarded
+	resps, err := auth.ProcessProposals(context.Background(), &peer.SignedProposals{
+		Proposals: []*peer.SignedProposal{notExpired, expired, idemix},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*peer.SignedProposal{notExpired, idemix}, nextEndorser.batch.Proposals)
+	assert.Len(t, resps.Responses, 3)
+	assert.Equal(t, int32(200), resps.Responses[0].Response.Status)
+	assert.Equal(t, int32(500), resps.Responses[1].Response.Status)
+	assert.Equal(t, "identity expired", resps.Responses[1].Response.Message)
+	assert.Equal(t, int32(200), resps.Responses[2].Response.Status)
+
+	// The next endorser is not invoked if no proposal passes the check
+	nextEndorser.invoked = false
+	resps, err = auth.ProcessProposals(context.Background(), &peer.SignedProposals{
+		Proposals: []*peer.SignedProposal{expired},
+	})
+	assert.NoError(t, err)
+	assert.False(t, nextEndorser.invoked)
+	assert.Len(t, resps.Responses, 1)
+	assert.Equal(t, int32(500), resps.Responses[0].Response.Status)
+}
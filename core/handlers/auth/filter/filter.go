@@ -31,3 +31,8 @@ func (f *filter) Init(next peer.EndorserServer) {
 func (f *filter) ProcessProposal(ctx context.Context, signedProp *peer.SignedProposal) (*peer.ProposalResponse, error) {
 	return f.next.ProcessProposal(ctx, signedProp)
 }
+
+// ProcessProposals processes a batch of signed proposals
+func (f *filter) ProcessProposals(ctx context.Context, batch *peer.SignedProposals) (*peer.ProposalResponses, error) {
+	return f.next.ProcessProposals(ctx, batch)
+}
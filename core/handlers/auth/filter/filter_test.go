@@ -16,6 +16,7 @@ import (
 
 type mockEndorserServer struct {
 	invoked bool
+	batch   *peer.SignedProposals
 }
 
 func (es *mockEndorserServer) ProcessProposal(context.Context, *peer.SignedProposal) (*peer.ProposalResponse, error) {
@@ -23,10 +24,23 @@ func (es *mockEndorserServer) ProcessProposal(context.Context, *peer.SignedPropo
 	return nil, nil
 }
 
+func (es *mockEndorserServer) ProcessProposals(_ context.Context, batch *peer.SignedProposals) (*peer.ProposalResponses, error) {
+	es.invoked = true
+	es.batch = batch
+	responses := &peer.ProposalResponses{}
+	for range batch.GetProposals() {
+		responses.Responses = append(responses.Responses, &peer.ProposalResponse{Response: &peer.Response{Status: 200}})
+	}
+	return responses, nil
+}
+
 func TestFilter(t *testing.T) {
 	auth := NewFilter()
 	nextEndorser := &mockEndorserServer{}
 	auth.Init(nextEndorser)
 	auth.ProcessProposal(nil, nil)
 	assert.True(t, nextEndorser.invoked)
+	nextEndorser.invoked = false
+	auth.ProcessProposals(nil, nil)
+	assert.True(t, nextEndorser.invoked)
 }
@@ -32,5 +32,10 @@ func (f *filter) ProcessProposal(ctx context.Context, signedProp *peer.SignedPro
 	return f.next.ProcessProposal(ctx, signedProp)
 }
 
+// ProcessProposals processes a batch of signed proposals
+func (f *filter) ProcessProposals(ctx context.Context, batch *peer.SignedProposals) (*peer.ProposalResponses, error) {
+	return f.next.ProcessProposals(ctx, batch)
+}
+
 func main() {
 }
@@ -24,10 +24,19 @@ func (es *mockEndorserServer) ProcessProposal(context.Context,
 	return nil, nil
 }
 
+func (es *mockEndorserServer) ProcessProposals(context.Context,
+	*peer.SignedProposals) (*peer.ProposalResponses, error) {
+	es.invoked = true
+	return nil, nil
+}
+
 func TestFilter(t *testing.T) {
 	auth := NewFilter()
 	nextEndorser := &mockEndorserServer{}
 	auth.Init(nextEndorser)
 	auth.ProcessProposal(nil, nil)
 	assert.True(t, nextEndorser.invoked)
+	nextEndorser.invoked = false
+	auth.ProcessProposals(nil, nil)
+	assert.True(t, nextEndorser.invoked)
 }
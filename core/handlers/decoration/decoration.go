@@ -7,7 +7,11 @@ SPDX-License-Identifier: Apache-2.0
 package decoration
 
 import (
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
 )
 
 // Decorator decorates a chaincode input
@@ -16,6 +20,15 @@ type Decorator interface {
 	Decorate(proposal *peer.Proposal, input *peer.ChaincodeInput) *peer.ChaincodeInput
 }
 
+// ConfigurableDecorator is a Decorator that is configured with the config
+// map of its handler configuration before it decorates any chaincode input
+type ConfigurableDecorator interface {
+	Decorator
+	// Configure configures the decorator with the given config map, whose
+	// keys are lower-cased
+	Configure(config map[string]string) error
+}
+
 // Apply decorators in the order provided
 func Apply(proposal *peer.Proposal, input *peer.ChaincodeInput,
 	decorators ...Decorator) *peer.ChaincodeInput {
@@ -25,3 +38,49 @@ func Apply(proposal *peer.Proposal, input *peer.ChaincodeInput,
 
 	return input
 }
+
+// TransactionDecorations returns the decorations that the given decorators
+// add to the chaincode input of the given endorser transaction, as they did
+// when the transaction was endorsed. The transient map of the proposal is not
+// part of the transaction, so decorators that rely on it may not decorate
+// the input the same way.
+func TransactionDecorations(env *common.Envelope, decorators ...Decorator) (map[string][]byte, error) {
+	payload, err := protoutil.GetPayload(env)
+	if err != nil {
+		return nil, err
+	}
+	if payload.Header == nil {
+		return nil, errors.New("missing header in transaction payload")
+	}
+	tx, err := protoutil.GetTransaction(payload.Data)
+	if err != nil {
+		return nil, err
+	}
+	if len(tx.Actions) == 0 {
+		return nil, errors.New("transaction has no actions")
+	}
+	cap, err := protoutil.GetChaincodeActionPayload(tx.Actions[0].Payload)
+	if err != nil {
+		return nil, err
+	}
+	cpp, err := protoutil.GetChaincodeProposalPayload(cap.ChaincodeProposalPayload)
+	if err != nil {
+		return nil, err
+	}
+	cis := &peer.ChaincodeInvocationSpec{}
+	if err := proto.Unmarshal(cpp.Input, cis); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling ChaincodeInvocationSpec")
+	}
+	if cis.ChaincodeSpec == nil || cis.ChaincodeSpec.Input == nil {
+		return nil, errors.New("missing chaincode input in transaction")
+	}
+	hdrBytes, err := proto.Marshal(payload.Header)
+	if err != nil {
+		return nil, errors.Wrap(err, "error marshaling Header")
+	}
+
+	prop := &peer.Proposal{Header: hdrBytes, Payload: cap.ChaincodeProposalPayload}
+	input := cis.ChaincodeSpec.Input
+	input.Decorations = make(map[string][]byte)
+	return Apply(prop, input, decorators...).Decorations, nil
+}
@@ -10,8 +10,11 @@ import (
 	"encoding/binary"
 	"testing"
 
+	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protoutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -56,3 +59,59 @@ func (d *mockDecorator) Decorate(proposal *peer.Proposal,
 
 	return input
 }
+
+// channelDecorator decorates the input with the channel of the proposal and
+// the first argument of the input
+type channelDecorator struct{}
+
+func (d *channelDecorator) Decorate(proposal *peer.Proposal, input *peer.ChaincodeInput) *peer.ChaincodeInput {
+	hdr, err := protoutil.GetHeader(proposal.Header)
+	if err != nil {
+		return input
+	}
+	chdr, err := protoutil.UnmarshalChannelHeader(hdr.ChannelHeader)
+	if err != nil {
+		return input
+	}
+	input.Decorations["channel"] = []byte(chdr.ChannelId)
+	input.Decorations["function"] = input.Args[0]
+	return input
+}
+
+func createTransaction(args ...string) *common.Envelope {
+	input := &peer.ChaincodeInput{}
+	for _, arg := range args {
+		input.Args = append(input.Args, []byte(arg))
+	}
+	cis := &peer.ChaincodeInvocationSpec{ChaincodeSpec: &peer.ChaincodeSpec{Input: input}}
+	cpp := &peer.ChaincodeProposalPayload{Input: protoutil.MarshalOrPanic(cis)}
+	cap := &peer.ChaincodeActionPayload{ChaincodeProposalPayload: protoutil.MarshalOrPanic(cpp)}
+	tx := &peer.Transaction{Actions: []*peer.TransactionAction{{Payload: protoutil.MarshalOrPanic(cap)}}}
+	payload := &common.Payload{
+		Header: &common.Header{
+			ChannelHeader: protoutil.MarshalOrPanic(&common.ChannelHeader{ChannelId: "mychannel"}),
+		},
+		Data: protoutil.MarshalOrPanic(tx),
+	}
+	return &common.Envelope{Payload: protoutil.MarshalOrPanic(payload)}
+}
+
+func TestTransactionDecorations(t *testing.T) {
+	decorations, err := TransactionDecorations(createTransaction("invoke", "a"), &channelDecorator{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"channel": []byte("mychannel"), "function": []byte("invoke")}, decorations)
+
+	decorations, err = TransactionDecorations(createTransaction("invoke"))
+	require.NoError(t, err)
+	assert.Empty(t, decorations)
+
+	_, err = TransactionDecorations(&common.Envelope{Payload: []byte("garbage")}, &channelDecorator{})
+	assert.Error(t, err)
+
+	noActions := &common.Envelope{Payload: protoutil.MarshalOrPanic(&common.Payload{
+		Header: &common.Header{},
+		Data:   protoutil.MarshalOrPanic(&peer.Transaction{}),
+	})}
+	_, err = TransactionDecorations(noActions, &channelDecorator{})
+	assert.EqualError(t, err, "transaction has no actions")
+}
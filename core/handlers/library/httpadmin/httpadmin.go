@@ -0,0 +1,132 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package httpadmin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/core/handlers/library"
+	"github.com/hyperledger/fabric/core/middleware"
+)
+
+// URLBase is the path under which the handler serves endorsement plugin requests.
+const URLBase = "/plugins/endorsement/"
+
+//go:generate counterfeiter -o fakes/plugins.go -fake-name Plugins . Plugins
+
+// Plugins provides the registered versions of the endorsement plugins of the peer.
+type Plugins interface {
+	// Versions returns the registered versions of the plugin with the given
+	// name, and whether it is registered
+	Versions(name string) (library.PluginVersions, bool)
+	// Register loads the given version of the plugin with the given name,
+	// and makes it the version in use
+	Register(name string, version library.PluginVersion) error
+	// Activate makes the given registered version of the plugin with the
+	// given name the version in use
+	Activate(name, version string) error
+}
+
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+func NewHandler(endorsement Plugins, checkAdmin middleware.AdminChecker) *Handler {
+	return &Handler{
+		Plugins:    endorsement,
+		CheckAdmin: checkAdmin,
+		Logger:     flogging.MustGetLogger("core.handlers.httpadmin"),
+	}
+}
+
+// Handler serves the endorsement plugins endpoint of the operations system.
+// Validation plugins cannot be changed at runtime: all the peers of a channel
+// must validate the transactions of a block with the same plugins.
+//
+// GET /plugins/endorsement/<name> returns the registered versions of the
+// endorsement plugin with the given name, and the version in use.
+//
+// PUT /plugins/endorsement/<name> with {"version": "v2", "library": "/path/to/plugin.so"}
+// or {"version": "v2", "name": "builtin"} registers a new version of the plugin
+// and makes it the version in use, and with {"version": "v1"} alone switches back
+// to a registered version. Plugin libraries are only loaded from the plugin
+// library directory of the peer.
+//
+// As a plugin library runs in the process of the peer, the endpoint is only
+// served to clients whose TLS certificate passes CheckAdmin.
+type Handler struct {
+	Plugins    Plugins
+	CheckAdmin middleware.AdminChecker
+	Logger     *flogging.FabricLogger
+}
+
+func (h *Handler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	middleware.RequireAdmin(h.CheckAdmin)(http.HandlerFunc(h.servePlugins)).ServeHTTP(resp, req)
+}
+
+func (h *Handler) servePlugins(resp http.ResponseWriter, req *http.Request) {
+	name := strings.TrimPrefix(req.URL.Path, URLBase)
+	if name == "" || strings.Contains(name, "/") {
+		h.sendResponse(resp, http.StatusNotFound, fmt.Errorf("invalid plugin path: %s", req.URL.Path))
+		return
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		versions, exists := h.Plugins.Versions(name)
+		if !exists {
+			h.sendResponse(resp, http.StatusNotFound, fmt.Errorf("endorsement plugin %s is not registered", name))
+			return
+		}
+		h.sendResponse(resp, http.StatusOK, &versions)
+
+	case http.MethodPut:
+		var version library.PluginVersion
+		decoder := json.NewDecoder(req.Body)
+		if err := decoder.Decode(&version); err != nil {
+			h.sendResponse(resp, http.StatusBadRequest, err)
+			return
+		}
+		req.Body.Close()
+
+		var err error
+		if version.Name == "" && version.Library == "" {
+			h.Logger.Infof("Activating version %s of endorsement plugin %s", version.Version, name)
+			err = h.Plugins.Activate(name, version.Version)
+		} else {
+			h.Logger.Infof("Registering version %s of endorsement plugin %s", version.Version, name)
+			err = h.Plugins.Register(name, version)
+		}
+		if err != nil {
+			h.sendResponse(resp, http.StatusBadRequest, err)
+			return
+		}
+		resp.WriteHeader(http.StatusNoContent)
+
+	default:
+		err := fmt.Errorf("invalid request method: %s", req.Method)
+		h.sendResponse(resp, http.StatusBadRequest, err)
+	}
+}
+
+func (h *Handler) sendResponse(resp http.ResponseWriter, code int, payload interface{}) {
+	encoder := json.NewEncoder(resp)
+	if err, ok := payload.(error); ok {
+		payload = &ErrorResponse{Error: err.Error()}
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(code)
+
+	if err := encoder.Encode(payload); err != nil {
+		h.Logger.Errorw("failed to encode payload", "error", err)
+	}
+}
@@ -0,0 +1,210 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package httpadmin_test
+
+import (
+	"crypto/x509"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/core/handlers/library"
+	"github.com/hyperledger/fabric/core/handlers/library/httpadmin"
+	"github.com/hyperledger/fabric/core/handlers/library/httpadmin/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		fakeEndorsement *fakes.Plugins
+		adminCert       *x509.Certificate
+		handler         *httpadmin.Handler
+	)
+
+	// newRequest returns a request authenticated with the certificate of an
+	// admin
+	newRequest := func(method, target string, body io.Reader) *http.Request {
+		req := httptest.NewRequest(method, "https://localhost"+target, body)
+		req.TLS.VerifiedChains = [][]*x509.Certificate{{adminCert}}
+		return req
+	}
+
+	BeforeEach(func() {
+		fakeEndorsement = &fakes.Plugins{}
+		fakeEndorsement.VersionsReturns(library.PluginVersions{
+			Active: "v2",
+			Versions: []library.PluginVersion{
+				{Version: "initial", Name: "DefaultEndorsement"},
+				{Version: "v2", Library: "/plugins/escc-v2.so"},
+			},
+		}, true)
+		adminCert = &x509.Certificate{Raw: []byte("admin")}
+		handler = &httpadmin.Handler{
+			Plugins: fakeEndorsement,
+			CheckAdmin: func(cert *x509.Certificate) error {
+				if cert != adminCert {
+					return errors.New("not an admin")
+				}
+				return nil
+			},
+			Logger: flogging.NewFabricLogger(flogging.NewZapLogger(nil)),
+		}
+	})
+
+	Context("when the client does not authenticate", func() {
+		It("responds with unauthorized", func() {
+			req := httptest.NewRequest("PUT", "/plugins/endorsement/escc", strings.NewReader(`{"version": "v3", "library": "/plugins/escc-v3.so"}`))
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusUnauthorized))
+			Expect(fakeEndorsement.RegisterCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the client is not an admin", func() {
+		It("responds with forbidden", func() {
+			req := newRequest("PUT", "/plugins/endorsement/escc", strings.NewReader(`{"version": "v3", "library": "/plugins/escc-v3.so"}`))
+			req.TLS.VerifiedChains = [][]*x509.Certificate{{{Raw: []byte("someone")}}}
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusForbidden))
+			Expect(fakeEndorsement.RegisterCallCount()).To(Equal(0))
+			Expect(fakeEndorsement.VersionsCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("GET", func() {
+		It("returns the registered versions of the plugin", func() {
+			req := newRequest("GET", "/plugins/endorsement/escc", nil)
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			Expect(fakeEndorsement.VersionsCallCount()).To(Equal(1))
+			Expect(fakeEndorsement.VersionsArgsForCall(0)).To(Equal("escc"))
+			Expect(resp.Header().Get("Content-Type")).To(Equal("application/json"))
+			Expect(resp.Body).To(MatchJSON(`{
+				"active": "v2",
+				"versions": [
+					{"version": "initial", "name": "DefaultEndorsement"},
+					{"version": "v2", "library": "/plugins/escc-v2.so"}
+				]
+			}`))
+		})
+
+		Context("when the plugin is not registered", func() {
+			BeforeEach(func() {
+				fakeEndorsement.VersionsReturns(library.PluginVersions{}, false)
+			})
+
+			It("responds with not found", func() {
+				req := newRequest("GET", "/plugins/endorsement/myescc", nil)
+				resp := httptest.NewRecorder()
+				handler.ServeHTTP(resp, req)
+
+				Expect(resp.Code).To(Equal(http.StatusNotFound))
+				Expect(resp.Body).To(MatchJSON(`{"error": "endorsement plugin myescc is not registered"}`))
+			})
+		})
+	})
+
+	Describe("PUT", func() {
+		It("registers a new version of the plugin", func() {
+			req := newRequest("PUT", "/plugins/endorsement/escc", strings.NewReader(`{"version": "v3", "library": "/plugins/escc-v3.so"}`))
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusNoContent))
+			Expect(fakeEndorsement.RegisterCallCount()).To(Equal(1))
+			name, version := fakeEndorsement.RegisterArgsForCall(0)
+			Expect(name).To(Equal("escc"))
+			Expect(version).To(Equal(library.PluginVersion{Version: "v3", Library: "/plugins/escc-v3.so"}))
+			Expect(fakeEndorsement.ActivateCallCount()).To(Equal(0))
+		})
+
+		It("activates a registered version of the plugin", func() {
+			req := newRequest("PUT", "/plugins/endorsement/escc", strings.NewReader(`{"version": "initial"}`))
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusNoContent))
+			Expect(fakeEndorsement.ActivateCallCount()).To(Equal(1))
+			name, version := fakeEndorsement.ActivateArgsForCall(0)
+			Expect(name).To(Equal("escc"))
+			Expect(version).To(Equal("initial"))
+			Expect(fakeEndorsement.RegisterCallCount()).To(Equal(0))
+		})
+
+		Context("when the version cannot be registered", func() {
+			BeforeEach(func() {
+				fakeEndorsement.RegisterReturns(errors.New("boom"))
+			})
+
+			It("responds with bad request", func() {
+				req := newRequest("PUT", "/plugins/endorsement/escc", strings.NewReader(`{"version": "v3", "name": "builtin"}`))
+				resp := httptest.NewRecorder()
+				handler.ServeHTTP(resp, req)
+
+				Expect(resp.Code).To(Equal(http.StatusBadRequest))
+				Expect(resp.Body).To(MatchJSON(`{"error": "boom"}`))
+			})
+		})
+
+		Context("when the request body is malformed", func() {
+			It("responds with bad request", func() {
+				req := newRequest("PUT", "/plugins/endorsement/escc", strings.NewReader(`goo`))
+				resp := httptest.NewRecorder()
+				handler.ServeHTTP(resp, req)
+
+				Expect(resp.Code).To(Equal(http.StatusBadRequest))
+				Expect(resp.Body).To(MatchJSON(`{"error": "invalid character 'g' looking for beginning of value"}`))
+				Expect(fakeEndorsement.RegisterCallCount()).To(Equal(0))
+				Expect(fakeEndorsement.ActivateCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when the plugin path is invalid", func() {
+		It("responds with not found", func() {
+			req := newRequest("GET", "/plugins/endorsement/", nil)
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusNotFound))
+			Expect(resp.Body).To(MatchJSON(`{"error": "invalid plugin path: /plugins/endorsement/"}`))
+		})
+	})
+
+	Context("when the path has more than the plugin name", func() {
+		It("responds with not found", func() {
+			req := newRequest("GET", "/plugins/endorsement/escc/v2", nil)
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusNotFound))
+			Expect(resp.Body).To(MatchJSON(`{"error": "invalid plugin path: /plugins/endorsement/escc/v2"}`))
+			Expect(fakeEndorsement.VersionsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the request method is unsupported", func() {
+		It("responds with bad request", func() {
+			req := newRequest("POST", "/plugins/endorsement/escc", nil)
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+			Expect(resp.Body).To(MatchJSON(`{"error": "invalid request method: POST"}`))
+		})
+	})
+})
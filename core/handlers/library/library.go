@@ -15,6 +15,7 @@ import (
 	"github.com/hyperledger/fabric/core/handlers/endorsement/builtin"
 	"github.com/hyperledger/fabric/core/handlers/validation/api"
 	. "github.com/hyperledger/fabric/core/handlers/validation/builtin"
+	"github.com/hyperledger/fabric/core/handlers/validation/token"
 )
 
 // HandlerLibrary is used to assert
@@ -51,3 +52,9 @@ func (r *HandlerLibrary) DefaultEndorsement() endorsement.PluginFactory {
 func (r *HandlerLibrary) DefaultValidation() validation.PluginFactory {
 	return &DefaultValidationFactory{}
 }
+
+// TokenValidation creates the validation plugin which
+// checks token transactions against the token definition
+func (r *HandlerLibrary) TokenValidation() validation.PluginFactory {
+	return &token.ValidationFactory{}
+}
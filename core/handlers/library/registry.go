@@ -11,12 +11,14 @@ import (
 	"os"
 	"plugin"
 	"reflect"
+	"sort"
 	"sync"
 
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/core/handlers/auth"
 	"github.com/hyperledger/fabric/core/handlers/decoration"
 	endorsement2 "github.com/hyperledger/fabric/core/handlers/endorsement/api"
+	"github.com/hyperledger/fabric/core/handlers/statelistener"
 	"github.com/hyperledger/fabric/core/handlers/validation/api"
 )
 
@@ -28,6 +30,10 @@ type Registry interface {
 	// Lookup returns a handler with a given
 	// registered name, or nil if does not exist
 	Lookup(HandlerType) interface{}
+
+	// EndorserVersions returns the versions
+	// of the endorsement plugins
+	EndorserVersions() *VersionedPlugins
 }
 
 // HandlerType defines custom handlers that can filter and mutate
@@ -42,10 +48,14 @@ const (
 	Decoration
 	Endorsement
 	Validation
-
-	authPluginFactory      = "NewFilter"
-	decoratorPluginFactory = "NewDecorator"
-	pluginFactory          = "NewPluginFactory"
+	// StateListener handler - receive the key-value writes
+	// committed by the peer
+	StateListener
+
+	authPluginFactory          = "NewFilter"
+	decoratorPluginFactory     = "NewDecorator"
+	pluginFactory              = "NewPluginFactory"
+	stateListenerPluginFactory = "NewStateListener"
 )
 
 type registry struct {
@@ -53,6 +63,11 @@ type registry struct {
 	decorators []decoration.Decorator
 	endorsers  map[string]endorsement2.PluginFactory
 	validators map[string]validation.PluginFactory
+	listeners  []statelistener.Listener
+	// endorserVersions are the versions of the endorsement plugins.
+	// Validation plugins aren't versioned, as switching them on a single
+	// peer would make its validation results diverge from the other peers.
+	endorserVersions *VersionedPlugins
 }
 
 var once sync.Once
@@ -65,6 +80,8 @@ type Config struct {
 	Decorators  []*HandlerConfig `mapstructure:"decorators" yaml:"decorators"`
 	Endorsers   PluginMapping    `mapstructure:"endorsers" yaml:"endorsers"`
 	Validators  PluginMapping    `mapstructure:"validators" yaml:"validators"`
+	// StateListeners receive the key-value writes committed by the peer
+	StateListeners []*HandlerConfig `mapstructure:"stateListeners" yaml:"stateListeners,omitempty"`
 }
 
 type PluginMapping map[string]*HandlerConfig
@@ -73,6 +90,13 @@ type PluginMapping map[string]*HandlerConfig
 type HandlerConfig struct {
 	Name    string `mapstructure:"name" yaml:"name"`
 	Library string `mapstructure:"library" yaml:"library"`
+	// Order is the position of a decorator in the chain of decorators, in
+	// ascending order. Decorators of the same order are applied in the
+	// order they are configured.
+	Order int `mapstructure:"order" yaml:"order,omitempty"`
+	// Config is passed to decorators implementing ConfigurableDecorator,
+	// and to state listeners implementing ConfigurableListener
+	Config map[string]string `mapstructure:"config" yaml:"config,omitempty"`
 }
 
 // InitRegistry creates the (only) instance
@@ -80,8 +104,9 @@ type HandlerConfig struct {
 func InitRegistry(c Config) Registry {
 	once.Do(func() {
 		reg = registry{
-			endorsers:  make(map[string]endorsement2.PluginFactory),
-			validators: make(map[string]validation.PluginFactory),
+			endorsers:        make(map[string]endorsement2.PluginFactory),
+			validators:       make(map[string]validation.PluginFactory),
+			endorserVersions: NewVersionedPlugins(),
 		}
 		reg.loadHandlers(c)
 	})
@@ -93,12 +118,25 @@ func (r *registry) loadHandlers(c Config) {
 	for _, config := range c.AuthFilters {
 		r.evaluateModeAndLoad(config, Auth)
 	}
-	for _, config := range c.Decorators {
+	for _, config := range orderDecorators(c.Decorators) {
+		loaded := len(r.decorators)
 		r.evaluateModeAndLoad(config, Decoration)
+		if len(r.decorators) > loaded {
+			configureDecorator(r.decorators[loaded], config)
+		}
+	}
+
+	for _, config := range c.StateListeners {
+		loaded := len(r.listeners)
+		r.evaluateModeAndLoad(config, StateListener)
+		if len(r.listeners) > loaded {
+			configureListener(r.listeners[loaded], config)
+		}
 	}
 
 	for chaincodeID, config := range c.Endorsers {
 		r.evaluateModeAndLoad(config, Endorsement, chaincodeID)
+		r.addInitialVersion(chaincodeID, config, r.endorsers[chaincodeID])
 	}
 
 	for chaincodeID, config := range c.Validators {
@@ -106,6 +144,69 @@ func (r *registry) loadHandlers(c Config) {
 	}
 }
 
+// orderDecorators returns the given decorator configurations sorted by their
+// order, keeping the configured order of the decorators of the same order
+func orderDecorators(configs []*HandlerConfig) []*HandlerConfig {
+	ordered := make([]*HandlerConfig, len(configs))
+	copy(ordered, configs)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Order < ordered[j].Order
+	})
+	return ordered
+}
+
+// configureDecorator configures the given decorator with the config map of
+// its configuration, if any
+func configureDecorator(decorator decoration.Decorator, c *HandlerConfig) {
+	configurable, isConfigurable := decorator.(decoration.ConfigurableDecorator)
+	if !isConfigurable {
+		if len(c.Config) != 0 {
+			logger.Panicf("Decorator %s%s does not accept a config", c.Name, c.Library)
+		}
+		return
+	}
+	config := c.Config
+	if config == nil {
+		config = map[string]string{}
+	}
+	if err := configurable.Configure(config); err != nil {
+		logger.Panicf("Failed configuring decorator %s%s: %s", c.Name, c.Library, err)
+	}
+}
+
+// configureListener configures the given state listener with the config map
+// of its configuration, if any
+func configureListener(listener statelistener.Listener, c *HandlerConfig) {
+	configurable, isConfigurable := listener.(statelistener.ConfigurableListener)
+	if !isConfigurable {
+		if len(c.Config) != 0 {
+			logger.Panicf("State listener %s%s does not accept a config", c.Name, c.Library)
+		}
+		return
+	}
+	config := c.Config
+	if config == nil {
+		config = map[string]string{}
+	}
+	if err := configurable.Configure(config); err != nil {
+		logger.Panicf("Failed configuring state listener %s%s: %s", c.Name, c.Library, err)
+	}
+}
+
+// addInitialVersion registers the configured endorsement plugin under the initial version
+func (r *registry) addInitialVersion(name string, c *HandlerConfig, factory endorsement2.PluginFactory) {
+	if r.endorserVersions == nil {
+		return
+	}
+	version := PluginVersion{Version: InitialVersion, Name: c.Name, Library: c.Library}
+	if c.Library != "" {
+		version.Name = ""
+	}
+	if err := r.endorserVersions.add(name, version, factory); err != nil {
+		logger.Panicf("Failed registering plugin %s: %s", name, err)
+	}
+}
+
 // evaluateModeAndLoad if a library path is provided, load the shared object
 func (r *registry) evaluateModeAndLoad(c *HandlerConfig, handlerType HandlerType, extraArgs ...string) {
 	if c.Library != "" {
@@ -140,6 +241,8 @@ func (r *registry) loadCompiled(handlerFactory string, handlerType HandlerType,
 			logger.Panicf("expected 1 argument in extraArgs")
 		}
 		r.validators[extraArgs[0]] = inst.(validation.PluginFactory)
+	} else if handlerType == StateListener {
+		r.listeners = append(r.listeners, inst.(statelistener.Listener))
 	}
 }
 
@@ -161,6 +264,8 @@ func (r *registry) loadPlugin(pluginPath string, handlerType HandlerType, extraA
 		r.initEndorsementPlugin(p, extraArgs...)
 	} else if handlerType == Validation {
 		r.initValidationPlugin(p, extraArgs...)
+	} else if handlerType == StateListener {
+		r.initStateListenerPlugin(p)
 	}
 }
 
@@ -193,7 +298,7 @@ func (r *registry) initDecoratorPlugin(p *plugin.Plugin) {
 	}
 	decorator := constructor()
 	if decorator != nil {
-		r.decorators = append(r.decorators, constructor())
+		r.decorators = append(r.decorators, decorator)
 	}
 }
 
@@ -237,6 +342,22 @@ func (r *registry) initValidationPlugin(p *plugin.Plugin, extraArgs ...string) {
 	r.validators[extraArgs[0]] = factory
 }
 
+// initStateListenerPlugin constructs a state listener from the given plugin
+func (r *registry) initStateListenerPlugin(p *plugin.Plugin) {
+	constructorSymbol, err := p.Lookup(stateListenerPluginFactory)
+	if err != nil {
+		panicWithLookupError(stateListenerPluginFactory, err)
+	}
+	constructor, ok := constructorSymbol.(func() statelistener.Listener)
+	if !ok {
+		panicWithDefinitionError(stateListenerPluginFactory)
+	}
+	listener := constructor()
+	if listener != nil {
+		r.listeners = append(r.listeners, listener)
+	}
+}
+
 // panicWithLookupError panics when a handler constructor lookup fails
 func panicWithLookupError(factory string, err error) {
 	logger.Panicf(fmt.Sprintf("Plugin must contain constructor with name %s. Error from lookup: %s",
@@ -261,7 +382,14 @@ func (r *registry) Lookup(handlerType HandlerType) interface{} {
 		return r.endorsers
 	} else if handlerType == Validation {
 		return r.validators
+	} else if handlerType == StateListener {
+		return r.listeners
 	}
 
 	return nil
 }
+
+// EndorserVersions returns the versions of the endorsement plugins
+func (r *registry) EndorserVersions() *VersionedPlugins {
+	return r.endorserVersions
+}
@@ -141,3 +141,8 @@ func (es *mockEndorserServer) ProcessProposal(ctx context.Context, prop *peer.Si
 	es.invoked = true
 	return nil, nil
 }
+
+func (es *mockEndorserServer) ProcessProposals(ctx context.Context, batch *peer.SignedProposals) (*peer.ProposalResponses, error) {
+	es.invoked = true
+	return nil, nil
+}
@@ -11,6 +11,9 @@ import (
 
 	"github.com/hyperledger/fabric/core/handlers/auth"
 	"github.com/hyperledger/fabric/core/handlers/decoration"
+	"github.com/hyperledger/fabric/core/handlers/statelistener"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -43,3 +46,100 @@ func TestLoadCompiledInvalid(t *testing.T) {
 	testReg := registry{}
 	testReg.loadCompiled("InvalidFactory", Auth)
 }
+
+func TestOrderDecorators(t *testing.T) {
+	configs := []*HandlerConfig{
+		{Name: "third", Order: 2},
+		{Name: "first"},
+		{Name: "second"},
+		{Name: "zeroth", Order: -1},
+	}
+	var names []string
+	for _, c := range orderDecorators(configs) {
+		names = append(names, c.Name)
+	}
+	assert.Equal(t, []string{"zeroth", "first", "second", "third"}, names)
+	assert.Equal(t, "third", configs[0].Name, "Expected the configured decorators to be left unchanged")
+}
+
+type configurableDecorator struct {
+	config map[string]string
+	err    error
+}
+
+func (d *configurableDecorator) Decorate(proposal *peer.Proposal, input *peer.ChaincodeInput) *peer.ChaincodeInput {
+	return input
+}
+
+func (d *configurableDecorator) Configure(config map[string]string) error {
+	d.config = config
+	return d.err
+}
+
+func TestConfigureDecorator(t *testing.T) {
+	d := &configurableDecorator{}
+	configureDecorator(d, &HandlerConfig{Name: "configurable", Config: map[string]string{"key": "value"}})
+	assert.Equal(t, map[string]string{"key": "value"}, d.config)
+
+	d = &configurableDecorator{}
+	configureDecorator(d, &HandlerConfig{Name: "configurable"})
+	assert.Equal(t, map[string]string{}, d.config)
+
+	d = &configurableDecorator{err: errors.New("missing key")}
+	assert.Panics(t, func() {
+		configureDecorator(d, &HandlerConfig{Name: "configurable"})
+	})
+
+	// Decorators that are not configurable accept no config
+	nonConfigurable := (&HandlerLibrary{}).DefaultDecorator()
+	assert.NotPanics(t, func() {
+		configureDecorator(nonConfigurable, &HandlerConfig{Name: "DefaultDecorator"})
+	})
+	assert.Panics(t, func() {
+		configureDecorator(nonConfigurable, &HandlerConfig{Name: "DefaultDecorator", Config: map[string]string{"key": "value"}})
+	})
+}
+
+type stateListener struct{}
+
+func (l *stateListener) ResumeToken(channel string) ([]byte, error) {
+	return nil, nil
+}
+
+func (l *stateListener) HandleBlockUpdate(update *statelistener.BlockUpdate) error {
+	return nil
+}
+
+type configurableListener struct {
+	stateListener
+	config map[string]string
+	err    error
+}
+
+func (l *configurableListener) Configure(config map[string]string) error {
+	l.config = config
+	return l.err
+}
+
+func TestConfigureListener(t *testing.T) {
+	l := &configurableListener{}
+	configureListener(l, &HandlerConfig{Library: "indexer.so", Config: map[string]string{"url": "http://localhost:9200"}})
+	assert.Equal(t, map[string]string{"url": "http://localhost:9200"}, l.config)
+
+	l = &configurableListener{}
+	configureListener(l, &HandlerConfig{Library: "indexer.so"})
+	assert.Equal(t, map[string]string{}, l.config)
+
+	l = &configurableListener{err: errors.New("missing url")}
+	assert.Panics(t, func() {
+		configureListener(l, &HandlerConfig{Library: "indexer.so"})
+	})
+
+	// Listeners that are not configurable accept no config
+	assert.NotPanics(t, func() {
+		configureListener(&stateListener{}, &HandlerConfig{Library: "indexer.so"})
+	})
+	assert.Panics(t, func() {
+		configureListener(&stateListener{}, &HandlerConfig{Library: "indexer.so", Config: map[string]string{"url": "http://localhost:9200"}})
+	})
+}
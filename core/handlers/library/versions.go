@@ -0,0 +1,224 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package library
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	endorsement2 "github.com/hyperledger/fabric/core/handlers/endorsement/api"
+	"github.com/pkg/errors"
+)
+
+// InitialVersion is the version under which the endorsement plugins of the
+// configuration are registered
+const InitialVersion = "initial"
+
+// PluginVersion is a version of an endorsement plugin, which is either
+// compiled into the peer, or loaded from a plugin library
+type PluginVersion struct {
+	Version string `json:"version"`
+	Name    string `json:"name,omitempty"`
+	Library string `json:"library,omitempty"`
+}
+
+// PluginVersions are the registered versions of a plugin, in the order of
+// their registration, and the version in use
+type PluginVersions struct {
+	Active   string          `json:"active"`
+	Versions []PluginVersion `json:"versions"`
+}
+
+// VersionedPlugins tracks the versions of the endorsement plugins registered
+// under each plugin name, and the version in use. Registering or activating
+// a version of a plugin only affects the proposals that look the plugin up
+// afterwards: proposals being endorsed keep using the factory they looked up.
+//
+// Plugin libraries are only loaded at runtime from the library directory set
+// with SetLibraryDir; without one, only compiled plugins can be registered.
+type VersionedPlugins struct {
+	load func(c *HandlerConfig) (endorsement2.PluginFactory, error)
+
+	mutex      sync.RWMutex
+	plugins    map[string]*versionedPlugin
+	libraryDir string
+}
+
+type versionedPlugin struct {
+	active    string
+	versions  []PluginVersion
+	factories map[string]endorsement2.PluginFactory
+}
+
+// NewVersionedPlugins creates the versioned endorsement plugins
+func NewVersionedPlugins() *VersionedPlugins {
+	return &VersionedPlugins{
+		load:    loadFactory,
+		plugins: make(map[string]*versionedPlugin),
+	}
+}
+
+// Register loads the given version of the plugin with the given name, and
+// makes it the version in use
+func (vp *VersionedPlugins) Register(name string, version PluginVersion) error {
+	if version.Version == "" {
+		return errors.New("the version of the plugin must be set")
+	}
+	if (version.Name == "") == (version.Library == "") {
+		return errors.New("exactly one of the name of a compiled plugin or the path of a plugin library must be set")
+	}
+	if vp.registered(name, version.Version) {
+		return errors.Errorf("version %s of plugin %s is already registered", version.Version, name)
+	}
+	if version.Library != "" {
+		if err := vp.checkLibrary(version.Library); err != nil {
+			return err
+		}
+	}
+
+	factory, err := vp.load(&HandlerConfig{Name: version.Name, Library: version.Library})
+	if err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("failed loading version %s of plugin %s", version.Version, name))
+	}
+	return vp.add(name, version, factory)
+}
+
+// SetLibraryDir sets the directory from which plugin libraries may be loaded
+// at runtime. The directory must be an absolute path.
+func (vp *VersionedPlugins) SetLibraryDir(dir string) error {
+	if !filepath.IsAbs(dir) {
+		return errors.Errorf("the plugin library directory %s is not an absolute path", dir)
+	}
+	resolved, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return errors.Wrapf(err, "failed resolving the plugin library directory %s", dir)
+	}
+
+	vp.mutex.Lock()
+	defer vp.mutex.Unlock()
+	vp.libraryDir = resolved
+	return nil
+}
+
+// checkLibrary returns an error unless the plugin library at the given path
+// lies in the library directory, once symbolic links are resolved
+func (vp *VersionedPlugins) checkLibrary(library string) error {
+	vp.mutex.RLock()
+	libraryDir := vp.libraryDir
+	vp.mutex.RUnlock()
+
+	if libraryDir == "" {
+		return errors.New("plugin libraries cannot be loaded at runtime, as no plugin library directory is set")
+	}
+	if !filepath.IsAbs(library) {
+		return errors.Errorf("the path of plugin library %s is not absolute", library)
+	}
+	resolved, err := filepath.EvalSymlinks(library)
+	if err != nil {
+		return errors.Wrapf(err, "failed resolving the path of plugin library %s", library)
+	}
+	rel, err := filepath.Rel(libraryDir, resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return errors.Errorf("plugin library %s is not in the plugin library directory %s", library, libraryDir)
+	}
+	return nil
+}
+
+// Activate makes the given registered version of the plugin with the given
+// name the version in use
+func (vp *VersionedPlugins) Activate(name, version string) error {
+	vp.mutex.Lock()
+	defer vp.mutex.Unlock()
+
+	p, exists := vp.plugins[name]
+	if !exists {
+		return errors.Errorf("plugin %s is not registered", name)
+	}
+	if _, exists := p.factories[version]; !exists {
+		return errors.Errorf("version %s of plugin %s is not registered", version, name)
+	}
+	p.active = version
+	logger.Infof("Activated version %s of plugin %s", version, name)
+	return nil
+}
+
+// Versions returns the registered versions of the plugin with the given
+// name, and whether it is registered
+func (vp *VersionedPlugins) Versions(name string) (PluginVersions, bool) {
+	vp.mutex.RLock()
+	defer vp.mutex.RUnlock()
+
+	p, exists := vp.plugins[name]
+	if !exists {
+		return PluginVersions{}, false
+	}
+	versions := make([]PluginVersion, len(p.versions))
+	copy(versions, p.versions)
+	return PluginVersions{Active: p.active, Versions: versions}, true
+}
+
+// Factory returns the version in use and the factory of the plugin with the
+// given name, or nil if the plugin is not registered
+func (vp *VersionedPlugins) Factory(name string) (string, endorsement2.PluginFactory) {
+	vp.mutex.RLock()
+	defer vp.mutex.RUnlock()
+
+	p, exists := vp.plugins[name]
+	if !exists {
+		return "", nil
+	}
+	return p.active, p.factories[p.active]
+}
+
+func (vp *VersionedPlugins) registered(name, version string) bool {
+	vp.mutex.RLock()
+	defer vp.mutex.RUnlock()
+
+	p, exists := vp.plugins[name]
+	if !exists {
+		return false
+	}
+	_, exists = p.factories[version]
+	return exists
+}
+
+// add registers the given version and factory of the plugin with the given
+// name, and makes it the version in use
+func (vp *VersionedPlugins) add(name string, version PluginVersion, factory endorsement2.PluginFactory) error {
+	vp.mutex.Lock()
+	defer vp.mutex.Unlock()
+
+	p, exists := vp.plugins[name]
+	if !exists {
+		p = &versionedPlugin{factories: make(map[string]endorsement2.PluginFactory)}
+		vp.plugins[name] = p
+	}
+	if _, exists := p.factories[version.Version]; exists {
+		return errors.Errorf("version %s of plugin %s is already registered", version.Version, name)
+	}
+	p.versions = append(p.versions, version)
+	p.factories[version.Version] = factory
+	p.active = version.Version
+	logger.Infof("Registered and activated version %s of plugin %s", version.Version, name)
+	return nil
+}
+
+// loadFactory loads the endorsement plugin factory of the given configuration,
+// returning an error instead of panicking if it cannot be loaded
+func loadFactory(c *HandlerConfig) (factory endorsement2.PluginFactory, err error) {
+	const key = "plugin"
+	r := &registry{endorsers: make(map[string]endorsement2.PluginFactory)}
+	defer func() {
+		if p := recover(); p != nil {
+			err = errors.Errorf("%v", p)
+		}
+	}()
+	r.evaluateModeAndLoad(c, Endorsement, key)
+	return r.endorsers[key], nil
+}
@@ -0,0 +1,202 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package library
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	endorsement "github.com/hyperledger/fabric/core/handlers/endorsement/api"
+	"github.com/hyperledger/fabric/core/handlers/endorsement/builtin"
+	validation "github.com/hyperledger/fabric/core/handlers/validation/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedPluginsRegisterAndActivate(t *testing.T) {
+	vp := NewVersionedPlugins()
+
+	version, factory := vp.Factory("escc")
+	assert.Empty(t, version)
+	assert.Nil(t, factory)
+	_, exists := vp.Versions("escc")
+	assert.False(t, exists)
+
+	err := vp.Register("escc", PluginVersion{Version: "v1", Name: "DefaultEndorsement"})
+	require.NoError(t, err)
+	version, factory = vp.Factory("escc")
+	assert.Equal(t, "v1", version)
+	require.NotNil(t, factory)
+	v1Factory := factory
+
+	err = vp.Register("escc", PluginVersion{Version: "v2", Name: "DefaultEndorsement"})
+	require.NoError(t, err)
+	version, factory = vp.Factory("escc")
+	assert.Equal(t, "v2", version)
+	assert.NotNil(t, factory)
+
+	versions, exists := vp.Versions("escc")
+	assert.True(t, exists)
+	assert.Equal(t, PluginVersions{
+		Active: "v2",
+		Versions: []PluginVersion{
+			{Version: "v1", Name: "DefaultEndorsement"},
+			{Version: "v2", Name: "DefaultEndorsement"},
+		},
+	}, versions)
+
+	err = vp.Activate("escc", "v1")
+	require.NoError(t, err)
+	version, factory = vp.Factory("escc")
+	assert.Equal(t, "v1", version)
+	assert.Equal(t, v1Factory, factory)
+
+	err = vp.Activate("escc", "v3")
+	assert.EqualError(t, err, "version v3 of plugin escc is not registered")
+	err = vp.Activate("vscc", "v1")
+	assert.EqualError(t, err, "plugin vscc is not registered")
+}
+
+func TestVersionedPluginsRegisterInvalid(t *testing.T) {
+	vp := NewVersionedPlugins()
+	err := vp.Register("escc", PluginVersion{Version: "v1", Name: "DefaultEndorsement"})
+	require.NoError(t, err)
+	_, factory := vp.Factory("escc")
+	assert.NotNil(t, factory)
+
+	tests := []struct {
+		name        string
+		version     PluginVersion
+		expectedErr string
+	}{
+		{
+			name:        "no version",
+			version:     PluginVersion{Name: "DefaultEndorsement"},
+			expectedErr: "the version of the plugin must be set",
+		},
+		{
+			name:        "no name nor library",
+			version:     PluginVersion{Version: "v2"},
+			expectedErr: "exactly one of the name of a compiled plugin or the path of a plugin library must be set",
+		},
+		{
+			name:        "both name and library",
+			version:     PluginVersion{Version: "v2", Name: "DefaultEndorsement", Library: "/plugins/escc.so"},
+			expectedErr: "exactly one of the name of a compiled plugin or the path of a plugin library must be set",
+		},
+		{
+			name:        "already registered",
+			version:     PluginVersion{Version: "v1", Name: "DefaultEndorsement"},
+			expectedErr: "version v1 of plugin escc is already registered",
+		},
+		{
+			name:        "unknown compiled plugin",
+			version:     PluginVersion{Version: "v2", Name: "NoSuchEndorsement"},
+			expectedErr: "failed loading version v2 of plugin escc: Method NoSuchEndorsement isn't a method of HandlerLibrary",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := vp.Register("escc", tt.version)
+			assert.EqualError(t, err, tt.expectedErr)
+			version, _ := vp.Factory("escc")
+			assert.Equal(t, "v1", version)
+		})
+	}
+}
+
+func TestVersionedPluginsLibraryDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "plugins")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	libraryDir := filepath.Join(dir, "lib")
+	require.NoError(t, os.Mkdir(libraryDir, 0755))
+	for _, lib := range []string{filepath.Join(libraryDir, "escc.so"), filepath.Join(dir, "other.so")} {
+		require.NoError(t, ioutil.WriteFile(lib, nil, 0644))
+	}
+	require.NoError(t, os.Symlink(filepath.Join(dir, "other.so"), filepath.Join(libraryDir, "link.so")))
+
+	vp := NewVersionedPlugins()
+	var loaded []string
+	vp.load = func(c *HandlerConfig) (endorsement.PluginFactory, error) {
+		loaded = append(loaded, c.Library)
+		return &builtin.DefaultEndorsementFactory{}, nil
+	}
+
+	err = vp.Register("escc", PluginVersion{Version: "v1", Library: filepath.Join(libraryDir, "escc.so")})
+	assert.EqualError(t, err, "plugin libraries cannot be loaded at runtime, as no plugin library directory is set")
+
+	err = vp.SetLibraryDir("lib")
+	assert.EqualError(t, err, "the plugin library directory lib is not an absolute path")
+	err = vp.SetLibraryDir(libraryDir)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		library     string
+		expectedErr string
+	}{
+		{
+			name:        "relative path",
+			library:     "escc.so",
+			expectedErr: "the path of plugin library escc.so is not absolute",
+		},
+		{
+			name:        "outside the directory",
+			library:     filepath.Join(dir, "other.so"),
+			expectedErr: "plugin library " + filepath.Join(dir, "other.so") + " is not in the plugin library directory " + vp.libraryDir,
+		},
+		{
+			name:        "parent reference",
+			library:     filepath.Join(libraryDir, "..", "other.so"),
+			expectedErr: "plugin library " + filepath.Join(libraryDir, "..", "other.so") + " is not in the plugin library directory " + vp.libraryDir,
+		},
+		{
+			name:        "symbolic link out of the directory",
+			library:     filepath.Join(libraryDir, "link.so"),
+			expectedErr: "plugin library " + filepath.Join(libraryDir, "link.so") + " is not in the plugin library directory " + vp.libraryDir,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := vp.Register("escc", PluginVersion{Version: "v1", Library: tt.library})
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+	assert.Empty(t, loaded)
+
+	err = vp.Register("escc", PluginVersion{Version: "v1", Library: filepath.Join(libraryDir, "escc.so")})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(libraryDir, "escc.so")}, loaded)
+}
+
+func TestInitRegistryVersions(t *testing.T) {
+	r := &registry{
+		endorsers:        make(map[string]endorsement.PluginFactory),
+		validators:       make(map[string]validation.PluginFactory),
+		endorserVersions: NewVersionedPlugins(),
+	}
+	r.loadHandlers(Config{
+		Endorsers:  PluginMapping{"escc": &HandlerConfig{Name: "DefaultEndorsement"}},
+		Validators: PluginMapping{"vscc": &HandlerConfig{Name: "DefaultValidation"}},
+	})
+
+	versions, exists := r.EndorserVersions().Versions("escc")
+	assert.True(t, exists)
+	assert.Equal(t, PluginVersions{
+		Active:   InitialVersion,
+		Versions: []PluginVersion{{Version: InitialVersion, Name: "DefaultEndorsement"}},
+	}, versions)
+	_, factory := r.EndorserVersions().Factory("escc")
+	assert.Equal(t, r.endorsers["escc"], factory)
+	// validation plugins aren't versioned
+	_, exists = r.EndorserVersions().Versions("vscc")
+	assert.False(t, exists)
+	assert.NotNil(t, r.validators["vscc"])
+}
@@ -0,0 +1,310 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package dispatcher
+
+import (
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	commonledger "github.com/hyperledger/fabric/common/ledger"
+	"github.com/hyperledger/fabric/core/handlers/statelistener"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/core/ledger/util"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+)
+
+var logger = flogging.MustGetLogger("core.handlers.statelistener")
+
+// DefaultRetryInterval is the time to wait before retrying to deliver an
+// update to a state listener that failed to process it
+const DefaultRetryInterval = 5 * time.Second
+
+// Ledger is the ledger of a channel whose updates are delivered
+type Ledger interface {
+	// GetBlocksIterator returns an iterator that starts from the given
+	// block number (inclusive), and waits for the blocks to be committed
+	GetBlocksIterator(startBlockNumber uint64) (commonledger.ResultsIterator, error)
+
+	// GetPvtDataByNum returns the private data of the given block
+	GetPvtDataByNum(blockNum uint64, filter ledger.PvtNsCollFilter) ([]*ledger.TxPvtData, error)
+}
+
+// Dispatcher delivers the writes committed to the ledgers of the channels
+// to the state listeners, as soon as the blocks are committed. Each listener
+// receives the updates of a channel in order, independently of the other
+// listeners, and from the update it resumes after.
+type Dispatcher struct {
+	listeners []statelistener.Listener
+	// RetryInterval is the time to wait before retrying to deliver an
+	// update, or DefaultRetryInterval if not positive
+	RetryInterval time.Duration
+
+	mutex     sync.Mutex
+	iterators map[commonledger.ResultsIterator]struct{}
+	stopped   bool
+	done      chan struct{}
+}
+
+// New creates a Dispatcher that delivers updates to the given listeners
+func New(listeners []statelistener.Listener) *Dispatcher {
+	return &Dispatcher{
+		listeners: listeners,
+		iterators: make(map[commonledger.ResultsIterator]struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// Start starts delivering the updates of the channel to the listeners
+func (d *Dispatcher) Start(channel string, lgr Ledger) {
+	for _, listener := range d.listeners {
+		go d.deliver(channel, lgr, listener)
+	}
+}
+
+// Stop stops delivering updates
+func (d *Dispatcher) Stop() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if d.stopped {
+		return
+	}
+	d.stopped = true
+	close(d.done)
+	for itr := range d.iterators {
+		itr.Close()
+		delete(d.iterators, itr)
+	}
+}
+
+func (d *Dispatcher) deliver(channel string, lgr Ledger, listener statelistener.Listener) {
+	var next uint64
+	for {
+		token, err := listener.ResumeToken(channel)
+		if err == nil {
+			next, err = resumeFrom(token)
+		}
+		if err == nil {
+			break
+		}
+		logger.Warningf("[%s] Failed resuming state listener %T: %s", channel, listener, err)
+		if !d.wait() {
+			return
+		}
+	}
+	logger.Infof("[%s] Delivering updates to state listener %T from block %d", channel, listener, next)
+
+	for {
+		err := d.deliverFrom(channel, lgr, listener, &next)
+		if err == nil {
+			return
+		}
+		logger.Warningf("[%s] Failed delivering block %d to state listener %T: %s", channel, next, listener, err)
+		if !d.wait() {
+			return
+		}
+	}
+}
+
+// deliverFrom delivers the updates of the channel from the given block
+// number, which it advances as the updates are processed, until the
+// dispatcher stops or the delivery fails
+func (d *Dispatcher) deliverFrom(channel string, lgr Ledger, listener statelistener.Listener, next *uint64) error {
+	itr, err := d.openIterator(lgr, *next)
+	if err != nil {
+		return err
+	}
+	if itr == nil {
+		return nil
+	}
+	defer d.closeIterator(itr)
+
+	pvtListener, _ := listener.(statelistener.PrivateDataListener)
+	for {
+		res, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if res == nil {
+			// the iterator was closed by Stop
+			return nil
+		}
+		block := res.(*common.Block)
+
+		var pvtData []*ledger.TxPvtData
+		if pvtListener != nil {
+			if pvtData, err = lgr.GetPvtDataByNum(block.Header.Number, nil); err != nil {
+				return errors.WithMessage(err, "failed retrieving private data")
+			}
+		}
+		update, err := newBlockUpdate(channel, block, pvtData, pvtListener)
+		if err != nil {
+			return err
+		}
+		if err := listener.HandleBlockUpdate(update); err != nil {
+			return err
+		}
+		*next = block.Header.Number + 1
+	}
+}
+
+func (d *Dispatcher) openIterator(lgr Ledger, start uint64) (commonledger.ResultsIterator, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if d.stopped {
+		return nil, nil
+	}
+	itr, err := lgr.GetBlocksIterator(start)
+	if err != nil {
+		return nil, err
+	}
+	d.iterators[itr] = struct{}{}
+	return itr, nil
+}
+
+func (d *Dispatcher) closeIterator(itr commonledger.ResultsIterator) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if _, exists := d.iterators[itr]; exists {
+		itr.Close()
+		delete(d.iterators, itr)
+	}
+}
+
+// wait waits for the retry interval, and returns false if the dispatcher
+// stopped in the meantime
+func (d *Dispatcher) wait() bool {
+	retryInterval := d.RetryInterval
+	if retryInterval <= 0 {
+		retryInterval = DefaultRetryInterval
+	}
+	select {
+	case <-d.done:
+		return false
+	case <-time.After(retryInterval):
+		return true
+	}
+}
+
+// resumeToken returns the resume token of the update of the given block
+func resumeToken(blockNum uint64) []byte {
+	token := make([]byte, 8)
+	binary.BigEndian.PutUint64(token, blockNum)
+	return token
+}
+
+// resumeFrom returns the number of the block following the update of the
+// given resume token, or 0 if there is no resume token
+func resumeFrom(token []byte) (uint64, error) {
+	if len(token) == 0 {
+		return 0, nil
+	}
+	if len(token) != 8 {
+		return 0, errors.Errorf("invalid resume token %x", token)
+	}
+	return binary.BigEndian.Uint64(token) + 1, nil
+}
+
+// newBlockUpdate extracts the writes of the valid transactions of the block.
+// The private writes of the given private data are included for the
+// collections the listener is authorized for, if the listener is not nil.
+func newBlockUpdate(channel string, block *common.Block, pvtData []*ledger.TxPvtData, listener statelistener.PrivateDataListener) (*statelistener.BlockUpdate, error) {
+	if block.Header == nil || block.Data == nil {
+		return nil, errors.New("block header or data is missing")
+	}
+	update := &statelistener.BlockUpdate{
+		Channel:     channel,
+		BlockNumber: block.Header.Number,
+		ResumeToken: resumeToken(block.Header.Number),
+	}
+
+	var txFlags util.TxValidationFlags
+	if block.Metadata != nil && len(block.Metadata.Metadata) > int(common.BlockMetadataIndex_TRANSACTIONS_FILTER) {
+		txFlags = util.TxValidationFlags(block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER])
+	}
+	if len(txFlags) < len(block.Data.Data) {
+		return nil, errors.Errorf("block %d has no validation flags for all its transactions", block.Header.Number)
+	}
+
+	pvtWriteSets := make(map[uint64]*rwset.TxPvtReadWriteSet)
+	for _, txPvtData := range pvtData {
+		pvtWriteSets[txPvtData.SeqInBlock] = txPvtData.WriteSet
+	}
+
+	for txIndex, envBytes := range block.Data.Data {
+		if txFlags.IsInvalid(txIndex) {
+			continue
+		}
+		env, err := protoutil.GetEnvelopeFromBlock(envBytes)
+		if err != nil {
+			return nil, err
+		}
+		payload, err := protoutil.UnmarshalPayload(env.Payload)
+		if err != nil {
+			return nil, err
+		}
+		if payload.Header == nil {
+			return nil, errors.Errorf("transaction %d of block %d has no header", txIndex, block.Header.Number)
+		}
+		chdr, err := protoutil.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+		if err != nil {
+			return nil, err
+		}
+		if common.HeaderType(chdr.Type) != common.HeaderType_ENDORSER_TRANSACTION {
+			continue
+		}
+		action, err := protoutil.GetActionFromEnvelopeMsg(env)
+		if err != nil {
+			return nil, err
+		}
+		txRwSet := &rwsetutil.TxRwSet{}
+		if err := txRwSet.FromProtoBytes(action.Results); err != nil {
+			return nil, errors.WithMessage(err, "failed unmarshaling read-write set")
+		}
+
+		tx := &statelistener.Transaction{TxID: chdr.TxId, Index: uint64(txIndex)}
+		for _, nsRwSet := range txRwSet.NsRwSets {
+			for _, write := range nsRwSet.KvRwSet.GetWrites() {
+				tx.Writes = append(tx.Writes, &statelistener.Write{
+					Namespace: nsRwSet.NameSpace,
+					Key:       write.Key,
+					Value:     write.Value,
+					IsDelete:  write.IsDelete,
+				})
+			}
+		}
+		if pvtWriteSet, exists := pvtWriteSets[uint64(txIndex)]; exists && listener != nil {
+			txPvtRwSet, err := rwsetutil.TxPvtRwSetFromProtoMsg(pvtWriteSet)
+			if err != nil {
+				return nil, errors.WithMessage(err, "failed unmarshaling private write set")
+			}
+			for _, nsPvtRwSet := range txPvtRwSet.NsPvtRwSet {
+				for _, collPvtRwSet := range nsPvtRwSet.CollPvtRwSets {
+					if !listener.AuthorizedForCollection(channel, nsPvtRwSet.NameSpace, collPvtRwSet.CollectionName) {
+						continue
+					}
+					for _, write := range collPvtRwSet.KvRwSet.GetWrites() {
+						tx.Writes = append(tx.Writes, &statelistener.Write{
+							Namespace:  nsPvtRwSet.NameSpace,
+							Collection: collPvtRwSet.CollectionName,
+							Key:        write.Key,
+							Value:      write.Value,
+							IsDelete:   write.IsDelete,
+						})
+					}
+				}
+			}
+		}
+		update.Transactions = append(update.Transactions, tx)
+	}
+	return update, nil
+}
@@ -0,0 +1,221 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package dispatcher
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	commonledger "github.com/hyperledger/fabric/common/ledger"
+	"github.com/hyperledger/fabric/common/ledger/testutil"
+	"github.com/hyperledger/fabric/core/handlers/statelistener"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeLedger struct {
+	mutex   sync.Mutex
+	cond    *sync.Cond
+	blocks  []*common.Block
+	pvtData map[uint64][]*ledger.TxPvtData
+}
+
+func newFakeLedger() *fakeLedger {
+	l := &fakeLedger{pvtData: make(map[uint64][]*ledger.TxPvtData)}
+	l.cond = sync.NewCond(&l.mutex)
+	return l
+}
+
+func (l *fakeLedger) commit(block *common.Block, pvtData ...*ledger.TxPvtData) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.blocks = append(l.blocks, block)
+	l.pvtData[block.Header.Number] = pvtData
+	l.cond.Broadcast()
+}
+
+func (l *fakeLedger) GetBlocksIterator(startBlockNumber uint64) (commonledger.ResultsIterator, error) {
+	return &fakeIterator{ledger: l, next: startBlockNumber}, nil
+}
+
+func (l *fakeLedger) GetPvtDataByNum(blockNum uint64, filter ledger.PvtNsCollFilter) ([]*ledger.TxPvtData, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.pvtData[blockNum], nil
+}
+
+type fakeIterator struct {
+	ledger *fakeLedger
+	next   uint64
+	closed bool
+}
+
+func (itr *fakeIterator) Next() (commonledger.QueryResult, error) {
+	itr.ledger.mutex.Lock()
+	defer itr.ledger.mutex.Unlock()
+	for !itr.closed && itr.next >= uint64(len(itr.ledger.blocks)) {
+		itr.ledger.cond.Wait()
+	}
+	if itr.closed {
+		return nil, nil
+	}
+	block := itr.ledger.blocks[itr.next]
+	itr.next++
+	return block, nil
+}
+
+func (itr *fakeIterator) Close() {
+	itr.ledger.mutex.Lock()
+	defer itr.ledger.mutex.Unlock()
+	itr.closed = true
+	itr.ledger.cond.Broadcast()
+}
+
+type listener struct {
+	token    []byte
+	failures int
+	updates  chan *statelistener.BlockUpdate
+}
+
+func (l *listener) ResumeToken(channel string) ([]byte, error) {
+	return l.token, nil
+}
+
+func (l *listener) HandleBlockUpdate(update *statelistener.BlockUpdate) error {
+	if l.failures > 0 {
+		l.failures--
+		return errors.New("index unavailable")
+	}
+	l.updates <- update
+	return nil
+}
+
+type pvtDataListener struct {
+	listener
+	collections map[string]bool
+}
+
+func (l *pvtDataListener) AuthorizedForCollection(channel, namespace, collection string) bool {
+	return l.collections[namespace+"/"+collection]
+}
+
+func newBlock(t *testing.T, blockNum uint64, txids ...string) (*common.Block, []*ledger.TxPvtData) {
+	var simulationResults [][]byte
+	var pvtData []*ledger.TxPvtData
+	for i, txid := range txids {
+		b := rwsetutil.NewRWSetBuilder()
+		b.AddToWriteSet("foo", "key-"+txid, []byte("value-"+txid))
+		b.AddToPvtAndHashedWriteSet("foo", "coll1", "pvtkey-"+txid, []byte("pvtvalue-"+txid))
+		b.AddToPvtAndHashedWriteSet("foo", "coll2", "pvtkey-"+txid, []byte("pvtvalue-"+txid))
+		results, err := b.GetTxSimulationResults()
+		require.NoError(t, err)
+		pubBytes, err := results.GetPubSimulationBytes()
+		require.NoError(t, err)
+		simulationResults = append(simulationResults, pubBytes)
+		pvtData = append(pvtData, &ledger.TxPvtData{SeqInBlock: uint64(i), WriteSet: results.PvtSimulationResults})
+	}
+	return testutil.ConstructBlockWithTxid(t, blockNum, nil, simulationResults, txids, false), pvtData
+}
+
+func TestNewBlockUpdate(t *testing.T) {
+	block, pvtData := newBlock(t, 5, "tx1", "tx2", "tx3")
+	block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER][1] = uint8(peer.TxValidationCode_MVCC_READ_CONFLICT)
+
+	update, err := newBlockUpdate("mychannel", block, pvtData, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "mychannel", update.Channel)
+	assert.Equal(t, uint64(5), update.BlockNumber)
+	require.Len(t, update.Transactions, 2)
+	assert.Equal(t, &statelistener.Transaction{
+		TxID:   "tx1",
+		Index:  0,
+		Writes: []*statelistener.Write{{Namespace: "foo", Key: "key-tx1", Value: []byte("value-tx1")}},
+	}, update.Transactions[0])
+	assert.Equal(t, "tx3", update.Transactions[1].TxID)
+	assert.Equal(t, uint64(2), update.Transactions[1].Index)
+
+	l := &pvtDataListener{collections: map[string]bool{"foo/coll2": true}}
+	update, err = newBlockUpdate("mychannel", block, pvtData, l)
+	require.NoError(t, err)
+	require.Len(t, update.Transactions, 2)
+	assert.Equal(t, []*statelistener.Write{
+		{Namespace: "foo", Key: "key-tx1", Value: []byte("value-tx1")},
+		{Namespace: "foo", Collection: "coll2", Key: "pvtkey-tx1", Value: []byte("pvtvalue-tx1")},
+	}, update.Transactions[0].Writes)
+
+	block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER] = nil
+	_, err = newBlockUpdate("mychannel", block, nil, nil)
+	assert.EqualError(t, err, "block 5 has no validation flags for all its transactions")
+}
+
+func TestResumeToken(t *testing.T) {
+	next, err := resumeFrom(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), next)
+
+	next, err = resumeFrom(resumeToken(41))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(42), next)
+
+	_, err = resumeFrom([]byte{1, 2, 3})
+	assert.EqualError(t, err, "invalid resume token 010203")
+}
+
+func TestDispatcher(t *testing.T) {
+	lgr := newFakeLedger()
+	for i := uint64(0); i < 3; i++ {
+		block, pvtData := newBlock(t, i, "tx")
+		lgr.commit(block, pvtData...)
+	}
+
+	fromGenesis := &listener{updates: make(chan *statelistener.BlockUpdate, 10)}
+	resumed := &pvtDataListener{
+		listener: listener{
+			token:    resumeToken(1),
+			failures: 1,
+			updates:  make(chan *statelistener.BlockUpdate, 10),
+		},
+		collections: map[string]bool{"foo/coll1": true},
+	}
+	d := New([]statelistener.Listener{fromGenesis, resumed})
+	d.RetryInterval = time.Millisecond
+	d.Start("mychannel", lgr)
+
+	for i := uint64(0); i < 3; i++ {
+		update := <-fromGenesis.updates
+		assert.Equal(t, i, update.BlockNumber)
+		assert.Equal(t, resumeToken(i), update.ResumeToken)
+		assert.Len(t, update.Transactions[0].Writes, 1)
+	}
+
+	// the update that failed is delivered again
+	update := <-resumed.updates
+	assert.Equal(t, uint64(2), update.BlockNumber)
+	assert.Len(t, update.Transactions[0].Writes, 2)
+
+	// updates are delivered as blocks are committed
+	block, pvtData := newBlock(t, 3, "tx")
+	lgr.commit(block, pvtData...)
+	assert.Equal(t, uint64(3), (<-fromGenesis.updates).BlockNumber)
+	assert.Equal(t, uint64(3), (<-resumed.updates).BlockNumber)
+
+	d.Stop()
+	d.Stop()
+	block, pvtData = newBlock(t, 4, "tx")
+	lgr.commit(block, pvtData...)
+	select {
+	case update := <-fromGenesis.updates:
+		t.Fatalf("unexpected update of block %d after stop", update.BlockNumber)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
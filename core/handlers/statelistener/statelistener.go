@@ -0,0 +1,78 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package statelistener
+
+// Write is a key-value write committed by a valid transaction
+type Write struct {
+	// Namespace is the chaincode that wrote the key
+	Namespace string
+	// Collection is the private data collection of the key, or empty for
+	// a write to the public state
+	Collection string
+	Key        string
+	Value      []byte
+	IsDelete   bool
+}
+
+// Transaction holds the writes committed by a valid transaction
+type Transaction struct {
+	TxID string
+	// Index is the position of the transaction in its block
+	Index  uint64
+	Writes []*Write
+}
+
+// BlockUpdate holds the writes committed by a block of a channel, in the
+// order of the transactions of the block. Invalid transactions and config
+// transactions of the block are left out.
+type BlockUpdate struct {
+	Channel      string
+	BlockNumber  uint64
+	Transactions []*Transaction
+	// ResumeToken resumes the delivery of the updates of the channel right
+	// after this update, once returned by the ResumeToken of the listener
+	ResumeToken []byte
+}
+
+// Listener receives the key-value writes committed by the peer, block by
+// block and in order, so that it can keep an off-chain store in sync with
+// the state of the channels. Updates are delivered at least once: when the
+// peer restarts, the delivery resumes after the update whose resume token
+// the listener returns, so the listener should persist the resume token of
+// an update along with the update itself.
+type Listener interface {
+	// ResumeToken returns the resume token of the last update of the
+	// channel that the listener processed, or nil to receive the updates
+	// of the channel from the genesis block
+	ResumeToken(channel string) ([]byte, error)
+
+	// HandleBlockUpdate processes an update. If it returns an error, the
+	// update is delivered again until it is processed.
+	HandleBlockUpdate(update *BlockUpdate) error
+}
+
+// PrivateDataListener is a Listener that also receives the private writes
+// of the collections it is authorized for, among the collections whose
+// private data the peer holds. Private data that the peer did not hold when
+// the update was delivered, such as purged data, is left out of the update.
+type PrivateDataListener interface {
+	Listener
+
+	// AuthorizedForCollection returns whether the listener may receive the
+	// private writes of the collection of the chaincode on the channel
+	AuthorizedForCollection(channel, namespace, collection string) bool
+}
+
+// ConfigurableListener is a Listener that is configured with the config
+// map of its handler configuration before it receives any update
+type ConfigurableListener interface {
+	Listener
+
+	// Configure configures the listener with the given config map, whose
+	// keys are lower-cased
+	Configure(config map[string]string) error
+}
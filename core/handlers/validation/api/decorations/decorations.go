@@ -0,0 +1,22 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package validation
+
+import (
+	"github.com/hyperledger/fabric/core/handlers/validation/api"
+	"github.com/hyperledger/fabric/protos/common"
+)
+
+// DecorationsFetcher fetches the decorations that the decorators of the peer
+// add to the chaincode input of transactions
+type DecorationsFetcher interface {
+	validation.Dependency
+
+	// FetchDecorations returns the decorations of the chaincode input of the
+	// transaction at the given position of the block
+	FetchDecorations(block *common.Block, txPosition int) (map[string][]byte, error)
+}
@@ -40,6 +40,29 @@ type StateFetcher interface {
 	FetchState() (State, error)
 }
 
+// HistoricalState defines read access to the world state as of a past height
+// of the ledger
+type HistoricalState interface {
+	// GetStateAtHeight returns the value of the given key as written by the
+	// last valid transaction at or below transaction txNum of block blockNum,
+	// or nil if the key did not exist or was deleted at that height
+	GetStateAtHeight(namespace, key string, blockNum, txNum uint64) ([]byte, error)
+
+	// Done releases resources occupied by the HistoricalState
+	Done()
+}
+
+// HistoricalStateFetcher is a StateFetcher that also retrieves instances of
+// historical state. Plugins that need it should check whether the StateFetcher
+// they are given implements it. Reading historical state fails if the
+// history database of the peer is disabled.
+type HistoricalStateFetcher interface {
+	StateFetcher
+
+	// FetchHistoricalState fetches historical state
+	FetchHistoricalState() (HistoricalState, error)
+}
+
 // ResultsIterator - an iterator for query result set
 type ResultsIterator interface {
 	// Next returns the next item in the result set. The `QueryResult` is expected to be nil when
@@ -7,8 +7,16 @@ SPDX-License-Identifier: Apache-2.0
 package token
 
 import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/core/handlers/validation/api"
+	. "github.com/hyperledger/fabric/core/handlers/validation/api/policies"
 	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/token"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/hyperledger/fabric/token/tms/plain"
+	"github.com/pkg/errors"
 )
 
 type ValidationFactory struct {
@@ -18,13 +26,153 @@ func (*ValidationFactory) New() validation.Plugin {
 	return &ValidationPlugin{}
 }
 
+// ValidationPlugin validates token transactions at commit time.  It checks
+// that the transaction is well formed, and that the creator of an issue
+// transaction satisfies the issuing policy of the token definition, which is
+// passed as the serialized policy.  Checks which require the ledger state,
+// such as whether the inputs of a transfer are unspent, are left to the
+// token transaction processor.
 type ValidationPlugin struct {
+	PolicyEvaluator PolicyEvaluator
 }
 
 func (v *ValidationPlugin) Init(dependencies ...validation.Dependency) error {
+	for _, dep := range dependencies {
+		if policyEvaluator, isPolicyEvaluator := dep.(PolicyEvaluator); isPolicyEvaluator {
+			v.PolicyEvaluator = policyEvaluator
+		}
+	}
+	if v.PolicyEvaluator == nil {
+		return errors.New("policy evaluator not passed in init")
+	}
 	return nil
 }
 
 func (v *ValidationPlugin) Validate(block *common.Block, namespace string, txPosition int, actionPosition int, contextData ...validation.ContextDatum) error {
+	if len(contextData) == 0 {
+		return &validation.ExecutionFailureError{Reason: "expected to receive the issuing policy in context data"}
+	}
+	serializedPolicy, isSerializedPolicy := contextData[0].(SerializedPolicy)
+	if !isSerializedPolicy {
+		return &validation.ExecutionFailureError{Reason: "expected to receive a serialized policy in the first context data"}
+	}
+	if block == nil || block.Data == nil {
+		return errors.New("empty block")
+	}
+	if txPosition >= len(block.Data.Data) {
+		return errors.Errorf("block has only %d transactions, but requested tx at position %d", len(block.Data.Data), txPosition)
+	}
+
+	env, err := protoutil.GetEnvelopeFromBlock(block.Data.Data[txPosition])
+	if err != nil {
+		return err
+	}
+	payload, err := protoutil.UnmarshalPayload(env.Payload)
+	if err != nil {
+		return err
+	}
+	if payload.Header == nil {
+		return errors.New("missing header in token transaction")
+	}
+	chdr, err := protoutil.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	if err != nil {
+		return err
+	}
+	if common.HeaderType(chdr.Type) != common.HeaderType_TOKEN_TRANSACTION {
+		return errors.Errorf("transaction type %s is not a token transaction", common.HeaderType(chdr.Type))
+	}
+
+	ttx := &token.TokenTransaction{}
+	if err := proto.Unmarshal(payload.Data, ttx); err != nil {
+		return errors.Wrap(err, "failed unmarshalling token transaction")
+	}
+	action := ttx.GetTokenAction()
+	if action == nil {
+		return errors.New("token transaction does not contain a token action")
+	}
+
+	switch data := action.Data.(type) {
+	case *token.TokenAction_Issue:
+		if err := checkOutputs(data.Issue.GetOutputs(), true); err != nil {
+			return err
+		}
+		signedData, err := protoutil.EnvelopeAsSignedData(env)
+		if err != nil {
+			return err
+		}
+		if err := v.PolicyEvaluator.Evaluate(serializedPolicy.Bytes(), signedData); err != nil {
+			return errors.WithMessage(err, "creator of the issue transaction does not satisfy the issuing policy")
+		}
+	case *token.TokenAction_Transfer:
+		if err := checkInputs(data.Transfer.GetInputs()); err != nil {
+			return err
+		}
+		if err := checkOutputs(data.Transfer.GetOutputs(), true); err != nil {
+			return err
+		}
+	case *token.TokenAction_Redeem:
+		if err := checkInputs(data.Redeem.GetInputs()); err != nil {
+			return err
+		}
+		outputs := data.Redeem.GetOutputs()
+		if err := checkOutputs(outputs, false); err != nil {
+			return err
+		}
+		if len(outputs) > 2 {
+			return errors.Errorf("too many outputs (%d) in a redeem transaction", len(outputs))
+		}
+		if outputs[0].Owner != nil {
+			return errors.New("owner should be nil in a redeem output")
+		}
+		if len(outputs) == 2 && outputs[1].Owner == nil {
+			return errors.New("owner is missing in the output of the remaining tokens")
+		}
+	default:
+		return errors.Errorf("unknown token action %T", data)
+	}
+
+	return nil
+}
+
+// checkInputs checks that there are inputs, and that none of them is spent twice
+func checkInputs(inputs []*token.TokenId) error {
+	if len(inputs) == 0 {
+		return errors.New("no inputs in token transaction")
+	}
+	seen := map[string]struct{}{}
+	for _, input := range inputs {
+		if input.GetTxId() == "" {
+			return errors.New("input without transaction ID in token transaction")
+		}
+		id := fmt.Sprintf("%s:%d", input.GetTxId(), input.GetIndex())
+		if _, exists := seen[id]; exists {
+			return errors.Errorf("input %s appears more than once in token transaction", id)
+		}
+		seen[id] = struct{}{}
+	}
+	return nil
+}
+
+// checkOutputs checks that there are outputs of a single token type and with
+// valid quantities, and, if ownerRequired, that every output has an owner
+func checkOutputs(outputs []*token.Token, ownerRequired bool) error {
+	if len(outputs) == 0 {
+		return errors.New("no outputs in token transaction")
+	}
+	tokenType := outputs[0].GetType()
+	if tokenType == "" {
+		return errors.New("output without token type in token transaction")
+	}
+	for i, output := range outputs {
+		if output.GetType() != tokenType {
+			return errors.Errorf("multiple token types ('%s', '%s') in outputs of token transaction", tokenType, output.GetType())
+		}
+		if ownerRequired && len(output.GetOwner().GetRaw()) == 0 {
+			return errors.Errorf("owner is missing in output %d of token transaction", i)
+		}
+		if _, err := plain.ToQuantity(output.GetQuantity(), plain.Precision); err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("quantity in output %d of token transaction is invalid", i))
+		}
+	}
 	return nil
 }
@@ -9,24 +9,272 @@ package token_test
 import (
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/core/handlers/validation/token"
+	"github.com/hyperledger/fabric/protos/common"
+	tk "github.com/hyperledger/fabric/protos/token"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
+type policyEvaluator struct {
+	policy     []byte
+	signedData []*protoutil.SignedData
+	err        error
+}
+
+func (pe *policyEvaluator) Evaluate(policyBytes []byte, signatureSet []*protoutil.SignedData) error {
+	pe.policy = policyBytes
+	pe.signedData = signatureSet
+	return pe.err
+}
+
+type serializedPolicy []byte
+
+func (sp serializedPolicy) Bytes() []byte {
+	return sp
+}
+
+func tokenTxBlock(t *testing.T, headerType common.HeaderType, ttx *tk.TokenTransaction) *common.Block {
+	data, err := proto.Marshal(ttx)
+	assert.NoError(t, err)
+	payload := &common.Payload{
+		Header: &common.Header{
+			ChannelHeader:   protoutil.MarshalOrPanic(&common.ChannelHeader{Type: int32(headerType), ChannelId: "mychannel", TxId: "txid"}),
+			SignatureHeader: protoutil.MarshalOrPanic(&common.SignatureHeader{Creator: []byte("creator")}),
+		},
+		Data: data,
+	}
+	env := &common.Envelope{
+		Payload:   protoutil.MarshalOrPanic(payload),
+		Signature: []byte("signature"),
+	}
+	return &common.Block{
+		Header: &common.BlockHeader{},
+		Data:   &common.BlockData{Data: [][]byte{protoutil.MarshalOrPanic(env)}},
+	}
+}
+
+func issueTx(outputs ...*tk.Token) *tk.TokenTransaction {
+	return &tk.TokenTransaction{
+		Action: &tk.TokenTransaction_TokenAction{
+			TokenAction: &tk.TokenAction{
+				Data: &tk.TokenAction_Issue{Issue: &tk.Issue{Outputs: outputs}},
+			},
+		},
+	}
+}
+
+func transferTx(inputs []*tk.TokenId, outputs ...*tk.Token) *tk.TokenTransaction {
+	return &tk.TokenTransaction{
+		Action: &tk.TokenTransaction_TokenAction{
+			TokenAction: &tk.TokenAction{
+				Data: &tk.TokenAction_Transfer{Transfer: &tk.Transfer{Inputs: inputs, Outputs: outputs}},
+			},
+		},
+	}
+}
+
+func redeemTx(inputs []*tk.TokenId, outputs ...*tk.Token) *tk.TokenTransaction {
+	return &tk.TokenTransaction{
+		Action: &tk.TokenTransaction_TokenAction{
+			TokenAction: &tk.TokenAction{
+				Data: &tk.TokenAction_Redeem{Redeem: &tk.Transfer{Inputs: inputs, Outputs: outputs}},
+			},
+		},
+	}
+}
+
+func output(owner string, tokenType string, quantity string) *tk.Token {
+	t := &tk.Token{Type: tokenType, Quantity: quantity}
+	if owner != "" {
+		t.Owner = &tk.TokenOwner{Raw: []byte(owner)}
+	}
+	return t
+}
+
 func TestValidationFactory_New(t *testing.T) {
 	factory := &token.ValidationFactory{}
 	plugin := factory.New()
 	assert.NotNil(t, plugin)
 }
 
-func TestValidation_Validate(t *testing.T) {
-	factory := &token.ValidationFactory{}
-	plugin := factory.New()
+func TestValidation_Init(t *testing.T) {
+	plugin := (&token.ValidationFactory{}).New()
 
 	err := plugin.Init()
+	assert.EqualError(t, err, "policy evaluator not passed in init")
+
+	err = plugin.Init(&policyEvaluator{})
 	assert.NoError(t, err)
+}
+
+func TestValidation_Validate(t *testing.T) {
+	inputs := []*tk.TokenId{{TxId: "tx1", Index: 0}, {TxId: "tx1", Index: 1}}
+
+	tests := []struct {
+		name        string
+		headerType  common.HeaderType
+		ttx         *tk.TokenTransaction
+		expectedErr string
+	}{
+		{
+			name:       "valid issue",
+			headerType: common.HeaderType_TOKEN_TRANSACTION,
+			ttx:        issueTx(output("alice", "TOK", "100"), output("bob", "TOK", "0x10")),
+		},
+		{
+			name:       "valid transfer",
+			headerType: common.HeaderType_TOKEN_TRANSACTION,
+			ttx:        transferTx(inputs, output("bob", "TOK", "100")),
+		},
+		{
+			name:       "valid redeem",
+			headerType: common.HeaderType_TOKEN_TRANSACTION,
+			ttx:        redeemTx(inputs, output("", "TOK", "50"), output("alice", "TOK", "50")),
+		},
+		{
+			name:        "not a token transaction",
+			headerType:  common.HeaderType_ENDORSER_TRANSACTION,
+			ttx:         issueTx(output("alice", "TOK", "100")),
+			expectedErr: "transaction type ENDORSER_TRANSACTION is not a token transaction",
+		},
+		{
+			name:        "no token action",
+			headerType:  common.HeaderType_TOKEN_TRANSACTION,
+			ttx:         &tk.TokenTransaction{},
+			expectedErr: "token transaction does not contain a token action",
+		},
+		{
+			name:        "issue without outputs",
+			headerType:  common.HeaderType_TOKEN_TRANSACTION,
+			ttx:         issueTx(),
+			expectedErr: "no outputs in token transaction",
+		},
+		{
+			name:        "issue without owner",
+			headerType:  common.HeaderType_TOKEN_TRANSACTION,
+			ttx:         issueTx(output("alice", "TOK", "100"), output("", "TOK", "100")),
+			expectedErr: "owner is missing in output 1 of token transaction",
+		},
+		{
+			name:        "issue without token type",
+			headerType:  common.HeaderType_TOKEN_TRANSACTION,
+			ttx:         issueTx(output("alice", "", "100")),
+			expectedErr: "output without token type in token transaction",
+		},
+		{
+			name:        "issue of multiple token types",
+			headerType:  common.HeaderType_TOKEN_TRANSACTION,
+			ttx:         issueTx(output("alice", "TOK", "100"), output("alice", "COIN", "100")),
+			expectedErr: "multiple token types ('TOK', 'COIN') in outputs of token transaction",
+		},
+		{
+			name:        "issue of zero tokens",
+			headerType:  common.HeaderType_TOKEN_TRANSACTION,
+			ttx:         issueTx(output("alice", "TOK", "0")),
+			expectedErr: "quantity in output 0 of token transaction is invalid: quantity must be larger than 0",
+		},
+		{
+			name:        "transfer without inputs",
+			headerType:  common.HeaderType_TOKEN_TRANSACTION,
+			ttx:         transferTx(nil, output("bob", "TOK", "100")),
+			expectedErr: "no inputs in token transaction",
+		},
+		{
+			name:        "transfer with duplicate inputs",
+			headerType:  common.HeaderType_TOKEN_TRANSACTION,
+			ttx:         transferTx([]*tk.TokenId{{TxId: "tx1", Index: 1}, {TxId: "tx1", Index: 1}}, output("bob", "TOK", "100")),
+			expectedErr: "input tx1:1 appears more than once in token transaction",
+		},
+		{
+			name:        "transfer with input without transaction ID",
+			headerType:  common.HeaderType_TOKEN_TRANSACTION,
+			ttx:         transferTx([]*tk.TokenId{{Index: 1}}, output("bob", "TOK", "100")),
+			expectedErr: "input without transaction ID in token transaction",
+		},
+		{
+			name:        "redeem with owner",
+			headerType:  common.HeaderType_TOKEN_TRANSACTION,
+			ttx:         redeemTx(inputs, output("alice", "TOK", "50")),
+			expectedErr: "owner should be nil in a redeem output",
+		},
+		{
+			name:        "redeem with too many outputs",
+			headerType:  common.HeaderType_TOKEN_TRANSACTION,
+			ttx:         redeemTx(inputs, output("", "TOK", "50"), output("alice", "TOK", "25"), output("alice", "TOK", "25")),
+			expectedErr: "too many outputs (3) in a redeem transaction",
+		},
+		{
+			name:        "redeem without owner of the remaining tokens",
+			headerType:  common.HeaderType_TOKEN_TRANSACTION,
+			ttx:         redeemTx(inputs, output("", "TOK", "50"), output("", "TOK", "50")),
+			expectedErr: "owner is missing in the output of the remaining tokens",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := (&token.ValidationFactory{}).New()
+			err := plugin.Init(&policyEvaluator{})
+			assert.NoError(t, err)
 
-	// Validate returns nil, no matter what!
-	err = plugin.Validate(nil, "", 0, 0, nil)
+			err = plugin.Validate(tokenTxBlock(t, tt.headerType, tt.ttx), "_fabtoken", 0, 0, serializedPolicy("policy"))
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestValidation_ValidateIssuingPolicy(t *testing.T) {
+	pe := &policyEvaluator{}
+	plugin := (&token.ValidationFactory{}).New()
+	err := plugin.Init(pe)
 	assert.NoError(t, err)
+
+	block := tokenTxBlock(t, common.HeaderType_TOKEN_TRANSACTION, issueTx(output("alice", "TOK", "100")))
+	err = plugin.Validate(block, "_fabtoken", 0, 0, serializedPolicy("issuing-policy"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("issuing-policy"), pe.policy)
+	assert.Len(t, pe.signedData, 1)
+	assert.Equal(t, []byte("creator"), pe.signedData[0].Identity)
+	assert.Equal(t, []byte("signature"), pe.signedData[0].Signature)
+
+	pe.err = errors.New("signature set did not satisfy policy")
+	err = plugin.Validate(block, "_fabtoken", 0, 0, serializedPolicy("issuing-policy"))
+	assert.EqualError(t, err, "creator of the issue transaction does not satisfy the issuing policy: signature set did not satisfy policy")
+
+	// transfers are not subject to the issuing policy
+	pe.policy = nil
+	block = tokenTxBlock(t, common.HeaderType_TOKEN_TRANSACTION, transferTx([]*tk.TokenId{{TxId: "tx1"}}, output("bob", "TOK", "100")))
+	err = plugin.Validate(block, "_fabtoken", 0, 0, serializedPolicy("issuing-policy"))
+	assert.NoError(t, err)
+	assert.Nil(t, pe.policy)
+}
+
+func TestValidation_ValidateBadInput(t *testing.T) {
+	plugin := (&token.ValidationFactory{}).New()
+	err := plugin.Init(&policyEvaluator{})
+	assert.NoError(t, err)
+
+	err = plugin.Validate(&common.Block{}, "_fabtoken", 0, 0)
+	assert.EqualError(t, err, "expected to receive the issuing policy in context data")
+
+	err = plugin.Validate(&common.Block{}, "_fabtoken", 0, 0, nil)
+	assert.EqualError(t, err, "expected to receive a serialized policy in the first context data")
+
+	err = plugin.Validate(nil, "_fabtoken", 0, 0, serializedPolicy("policy"))
+	assert.EqualError(t, err, "empty block")
+
+	block := tokenTxBlock(t, common.HeaderType_TOKEN_TRANSACTION, issueTx(output("alice", "TOK", "100")))
+	err = plugin.Validate(block, "_fabtoken", 1, 0, serializedPolicy("policy"))
+	assert.EqualError(t, err, "block has only 1 transactions, but requested tx at position 1")
+
+	block.Data.Data[0] = []byte("garbage")
+	err = plugin.Validate(block, "_fabtoken", 0, 0, serializedPolicy("policy"))
+	assert.Error(t, err)
 }
@@ -95,6 +95,18 @@ func (d *db) entryAt(blockNum uint64, ns, key string) (*compositeKV, error) {
 	return &compositeKV{k, v}, nil
 }
 
+func (d *db) exportEntries(export func(*compositeKV) error) error {
+	itr := d.GetIterator(nil, nil)
+	defer itr.Release()
+	for itr.Next() {
+		k, v := decodeCompositeKey(itr.Key()), append([]byte{}, itr.Value()...)
+		if err := export(&compositeKV{k, v}); err != nil {
+			return err
+		}
+	}
+	return errors.Wrap(itr.Error(), "error iterating over the config history")
+}
+
 func encodeCompositeKey(ns, key string, blockNum uint64) []byte {
 	b := []byte(keyPrefix + ns)
 	b = append(b, separatorByte)
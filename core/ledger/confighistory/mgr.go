@@ -28,9 +28,22 @@ const (
 type Mgr interface {
 	ledger.StateListener
 	GetRetriever(ledgerID string, ledgerInfoRetriever LedgerInfoRetriever) ledger.ConfigHistoryRetriever
+	// ExportConfigHistory passes each entry of the config history of the given ledger to the given function
+	ExportConfigHistory(ledgerID string, export func(entry *Entry) error) error
+	// ImportConfigHistory adds the given entries to the config history of the given ledger
+	ImportConfigHistory(ledgerID string, entries []*Entry) error
 	Close()
 }
 
+// Entry is an entry of the config history of a ledger, i.e., the value
+// of the given key of the given namespace as of the given block
+type Entry struct {
+	Namespace string
+	Key       string
+	BlockNum  uint64
+	Value     []byte
+}
+
 type mgr struct {
 	ccInfoProvider ledger.DeployedChaincodeInfoProvider
 	dbProvider     *dbProvider
@@ -106,6 +119,27 @@ func (m *mgr) GetRetriever(ledgerID string, ledgerInfoRetriever LedgerInfoRetrie
 	}
 }
 
+// ExportConfigHistory implements the function in the interface 'Mgr'
+func (m *mgr) ExportConfigHistory(ledgerID string, export func(entry *Entry) error) error {
+	return m.dbProvider.getDB(ledgerID).exportEntries(func(compositeKV *compositeKV) error {
+		return export(&Entry{
+			Namespace: compositeKV.ns,
+			Key:       compositeKV.key,
+			BlockNum:  compositeKV.blockNum,
+			Value:     compositeKV.value,
+		})
+	})
+}
+
+// ImportConfigHistory implements the function in the interface 'Mgr'
+func (m *mgr) ImportConfigHistory(ledgerID string, entries []*Entry) error {
+	batch := newBatch()
+	for _, entry := range entries {
+		batch.add(entry.Namespace, entry.Key, entry.BlockNum, entry.Value)
+	}
+	return m.dbProvider.getDB(ledgerID).writeBatch(batch, true)
+}
+
 // Close implements the function in the interface 'Mgr'
 func (m *mgr) Close() {
 	m.dbProvider.Close()
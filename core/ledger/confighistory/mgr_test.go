@@ -122,6 +122,47 @@ func TestMgr(t *testing.T) {
 	})
 }
 
+func TestExportImportConfigHistory(t *testing.T) {
+	dbPath := "/tmp/fabric/core/ledger/confighistory"
+	mockCCInfoProvider := &mock.DeployedChaincodeInfoProvider{}
+	env := newTestEnv(t, dbPath, mockCCInfoProvider)
+	mgr := env.mgr
+	defer env.cleanup()
+	chaincodeName := "chaincode1"
+	for _, committingBlockNum := range []uint64{5, 10} {
+		testutilEquipMockCCInfoProviderToReturnDesiredCollConfig(mockCCInfoProvider, chaincodeName, sampleCollectionConfigPackage("ledger1", committingBlockNum))
+		assert.NoError(t, mgr.HandleStateUpdates(&ledger.StateUpdateTrigger{
+			LedgerID:           "ledger1",
+			CommittingBlockNum: committingBlockNum},
+		))
+	}
+
+	var entries []*Entry
+	assert.NoError(t, mgr.ExportConfigHistory("ledger1", func(entry *Entry) error {
+		entries = append(entries, entry)
+		return nil
+	}))
+	assert.Len(t, entries, 2)
+	assert.NoError(t, mgr.ImportConfigHistory("ledger2", entries))
+
+	dummyLedgerInfoRetriever := &dummyLedgerInfoRetriever{
+		info: &common.BlockchainInfo{Height: 20},
+		qe:   &mock.QueryExecutor{},
+	}
+	retriever := mgr.GetRetriever("ledger2", dummyLedgerInfoRetriever)
+	for _, committingBlockNum := range []uint64{5, 10} {
+		retrievedConfig, err := retriever.CollectionConfigAt(committingBlockNum, chaincodeName)
+		assert.NoError(t, err)
+		assert.Equal(t, sampleCollectionConfigPackage("ledger1", committingBlockNum), retrievedConfig.CollectionConfig)
+	}
+
+	exportErr := fmt.Errorf("export error")
+	err := mgr.ExportConfigHistory("ledger1", func(entry *Entry) error {
+		return exportErr
+	})
+	assert.Equal(t, exportErr, err)
+}
+
 func TestWithImplicitColls(t *testing.T) {
 	dbPath := "/tmp/fabric/core/ledger/confighistory"
 	collConfigPackage := testutilCreateCollConfigPkg([]string{"Explicit-coll-1", "Explicit-coll-2"})
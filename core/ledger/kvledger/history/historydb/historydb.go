@@ -38,4 +38,8 @@ type HistoryDB interface {
 	GetLastSavepoint() (*version.Height, error)
 	ShouldRecover(lastAvailableBlock uint64) (bool, uint64, error)
 	CommitLostBlock(blockAndPvtdata *ledger.BlockAndPvtData) error
+	// SetSavepoint records the given height as the savepoint of a history DB that
+	// holds no history up to that height, such as the one of a ledger bootstrapped
+	// from a snapshot
+	SetSavepoint(height *version.Height) error
 }
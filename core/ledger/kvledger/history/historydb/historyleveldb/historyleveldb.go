@@ -178,6 +178,13 @@ func (historyDB *historyDB) GetLastSavepoint() (*version.Height, error) {
 	return height, nil
 }
 
+// SetSavepoint implements method in HistoryDB interface
+func (historyDB *historyDB) SetSavepoint(height *version.Height) error {
+	dbBatch := leveldbhelper.NewUpdateBatch()
+	dbBatch.Put(savePointKey, height.ToBytes())
+	return historyDB.db.WriteBatch(dbBatch, true)
+}
+
 // ShouldRecover implements method in interface kvledger.Recoverer
 func (historyDB *historyDB) ShouldRecover(lastAvailableBlock uint64) (bool, uint64, error) {
 	if !ledgerconfig.IsHistoryDBEnabled() {
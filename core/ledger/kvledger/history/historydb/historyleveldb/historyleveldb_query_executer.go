@@ -45,6 +45,45 @@ func (q *LevelHistoryDBQueryExecutor) GetHistoryForKey(namespace string, key str
 	return newHistoryScanner(compositeStartKey, namespace, key, dbItr, q.blockStore), nil
 }
 
+// GetStateAtHeight implements method in interface `ledger.HistoricalStateQueryExecutor`
+func (q *LevelHistoryDBQueryExecutor) GetStateAtHeight(namespace, key string, blockNum, tranNum uint64) ([]byte, error) {
+	if ledgerconfig.IsHistoryDBEnabled() == false {
+		return nil, errors.New("history database not enabled")
+	}
+
+	// the history records of the key at or below the height sort before the
+	// history key of the height followed by 0xff
+	compositePartialKey := historydb.ConstructPartialCompositeHistoryKey(namespace, key, false)
+	compositeEndKey := append(historydb.ConstructCompositeHistoryKey(namespace, key, blockNum, tranNum), 0xff)
+	dbItr := q.historyDB.db.GetIterator(compositePartialKey, compositeEndKey)
+	defer dbItr.Release()
+
+	for ok := dbItr.Last(); ok; ok = dbItr.Prev() {
+		_, blockNumTranNumBytes := historydb.SplitCompositeHistoryKey(dbItr.Key(), compositePartialKey)
+		// skip the history records of other keys, as in historyScanner.Next
+		if bytes.Contains(blockNumTranNumBytes[:len(blockNumTranNumBytes)-1], historydb.CompositeKeySep) {
+			continue
+		}
+		recordBlockNum, bytesConsumed := util.DecodeOrderPreservingVarUint64(blockNumTranNumBytes[0:])
+		recordTranNum, _ := util.DecodeOrderPreservingVarUint64(blockNumTranNumBytes[bytesConsumed:])
+
+		tranEnvelope, err := q.blockStore.RetrieveTxByBlockNumTranNum(recordBlockNum, recordTranNum)
+		if err != nil {
+			return nil, err
+		}
+		queryResult, err := getKeyModificationFromTran(tranEnvelope, namespace, key)
+		if err != nil {
+			return nil, err
+		}
+		keyModification := queryResult.(*queryresult.KeyModification)
+		if keyModification.IsDelete {
+			return nil, nil
+		}
+		return keyModification.Value, nil
+	}
+	return nil, nil
+}
+
 //historyScanner implements ResultsIterator for iterating through history results
 type historyScanner struct {
 	compositePartialKey []byte //compositePartialKey includes namespace~key
@@ -16,6 +16,7 @@ import (
 	"github.com/hyperledger/fabric/common/ledger/testutil"
 	util2 "github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
 	"github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/ledger/queryresult"
@@ -94,6 +95,22 @@ func TestSavepoint(t *testing.T) {
 	assert.Equal(t, uint64(3), blockNum)
 }
 
+func TestSetSavepoint(t *testing.T) {
+	env := newTestHistoryEnv(t)
+	defer env.cleanup()
+
+	assert.NoError(t, env.testHistoryDB.SetSavepoint(version.NewHeight(9, 3)))
+	savepoint, err := env.testHistoryDB.GetLastSavepoint()
+	assert.NoError(t, err)
+	assert.Equal(t, version.NewHeight(9, 3), savepoint)
+
+	// no recovery is needed for the blocks up to the savepoint
+	status, blockNum, err := env.testHistoryDB.ShouldRecover(9)
+	assert.NoError(t, err)
+	assert.False(t, status)
+	assert.Equal(t, uint64(10), blockNum)
+}
+
 func TestHistory(t *testing.T) {
 	env := newTestHistoryEnv(t)
 	defer env.cleanup()
@@ -193,6 +210,76 @@ func TestHistory(t *testing.T) {
 	assert.Equal(t, 4, count)
 }
 
+func TestGetStateAtHeight(t *testing.T) {
+	env := newTestHistoryEnv(t)
+	defer env.cleanup()
+	provider := env.testBlockStorageEnv.provider
+	store1, err := provider.OpenBlockStore("ledger1")
+	assert.NoError(t, err, "Error upon provider.OpenBlockStore()")
+	defer store1.Shutdown()
+
+	bg, gb := testutil.NewBlockGenerator(t, "ledger1", false)
+	assert.NoError(t, store1.AddBlock(gb))
+	assert.NoError(t, env.testHistoryDB.Commit(gb))
+
+	simulate := func(update func(simulator ledger.TxSimulator)) []byte {
+		simulator, _ := env.txmgr.NewTxSimulator(util2.GenerateUUID())
+		update(simulator)
+		simulator.Done()
+		simRes, _ := simulator.GetTxSimulationResults()
+		pubSimResBytes, _ := simRes.GetPubSimulationBytes()
+		return pubSimResBytes
+	}
+	commit := func(simulationResults ...[]byte) {
+		block := bg.NextBlock(simulationResults)
+		assert.NoError(t, store1.AddBlock(block))
+		assert.NoError(t, env.testHistoryDB.Commit(block))
+	}
+
+	// block1: key7 is set, block2: key7 is set twice, block3: another key
+	// whose history records fall in the range of key7 is set, block4: key7
+	// is deleted
+	commit(simulate(func(s ledger.TxSimulator) { s.SetState("ns1", "key7", []byte("value1")) }))
+	commit(
+		simulate(func(s ledger.TxSimulator) { s.SetState("ns1", "key7", []byte("value2")) }),
+		simulate(func(s ledger.TxSimulator) { s.SetState("ns1", "key7", []byte("value3")) }),
+	)
+	commit(simulate(func(s ledger.TxSimulator) { s.SetState("ns1", "key7\x00x", []byte("other")) }))
+	commit(simulate(func(s ledger.TxSimulator) { s.DeleteState("ns1", "key7") }))
+
+	qhistory, err := env.testHistoryDB.NewHistoryQueryExecutor(store1)
+	assert.NoError(t, err, "Error upon NewHistoryQueryExecutor")
+	historicalState := qhistory.(ledger.HistoricalStateQueryExecutor)
+
+	tests := []struct {
+		key      string
+		blockNum uint64
+		tranNum  uint64
+		expected []byte
+	}{
+		{key: "key7", blockNum: 0, tranNum: 0, expected: nil},
+		{key: "key7", blockNum: 1, tranNum: 0, expected: []byte("value1")},
+		{key: "key7", blockNum: 1, tranNum: 5, expected: []byte("value1")},
+		{key: "key7", blockNum: 2, tranNum: 0, expected: []byte("value2")},
+		{key: "key7", blockNum: 2, tranNum: 1, expected: []byte("value3")},
+		{key: "key7", blockNum: 3, tranNum: 0, expected: []byte("value3")},
+		{key: "key7", blockNum: 4, tranNum: 0, expected: nil},
+		{key: "key7", blockNum: 10, tranNum: 0, expected: nil},
+		{key: "key7\x00x", blockNum: 2, tranNum: 1, expected: nil},
+		{key: "key7\x00x", blockNum: 3, tranNum: 0, expected: []byte("other")},
+		{key: "key8", blockNum: 4, tranNum: 0, expected: nil},
+	}
+	for _, tt := range tests {
+		value, err := historicalState.GetStateAtHeight("ns1", tt.key, tt.blockNum, tt.tranNum)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, value, "Unexpected value of key %q at height %d:%d", tt.key, tt.blockNum, tt.tranNum)
+	}
+
+	viper.Set("ledger.history.enableHistoryDatabase", "false")
+	_, err = historicalState.GetStateAtHeight("ns1", "key7", 1, 0)
+	assert.EqualError(t, err, "history database not enabled")
+}
+
 func TestHistoryForInvalidTran(t *testing.T) {
 	env := newTestHistoryEnv(t)
 	defer env.cleanup()
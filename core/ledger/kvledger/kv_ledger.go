@@ -41,6 +41,7 @@ type kvLedger struct {
 	configHistoryRetriever ledger.ConfigHistoryRetriever
 	blockAPIsRWLock        *sync.RWMutex
 	stats                  *ledgerStats
+	snapshotConfigBlock    *common.Block
 }
 
 // NewKVLedger constructs new `KVLedger`
@@ -276,6 +277,15 @@ func (l *kvLedger) GetTxValidationCodeByTxID(txID string) (peer.TxValidationCode
 	return txValidationCode, err
 }
 
+// GetBlockNumberByTime returns the lowest number of the blocks whose
+// timestamp is not before the given time
+func (l *kvLedger) GetBlockNumberByTime(t time.Time) (uint64, error) {
+	blockNum, err := l.blockStore.RetrieveBlockNumberByTime(t)
+	l.blockAPIsRWLock.RLock()
+	l.blockAPIsRWLock.RUnlock()
+	return blockNum, err
+}
+
 // NewTxSimulator returns new `ledger.TxSimulator`
 func (l *kvLedger) NewTxSimulator(txid string) (ledger.TxSimulator, error) {
 	return l.txtmgmt.NewTxSimulator(txid)
@@ -328,11 +338,14 @@ func (l *kvLedger) CommitWithPvtData(pvtdataAndBlock *ledger.BlockAndPvtData) er
 
 	// History database could be written in parallel with state and/or async as a future optimization,
 	// although it has not been a bottleneck...no need to clutter the log with elapsed duration.
+	var elapsedCommitHistory time.Duration
 	if ledgerconfig.IsHistoryDBEnabled() {
 		logger.Debugf("[%s] Committing block [%d] transactions to history database", l.ledgerID, blockNo)
+		startCommitHistory := time.Now()
 		if err := l.historyDB.Commit(block); err != nil {
 			panic(errors.WithMessage(err, "Error during commit to history db"))
 		}
+		elapsedCommitHistory = time.Since(startCommitHistory)
 	}
 
 	elapsedCommitWithPvtData := time.Since(startBlockProcessing)
@@ -348,6 +361,7 @@ func (l *kvLedger) CommitWithPvtData(pvtdataAndBlock *ledger.BlockAndPvtData) er
 		elapsedBlockProcessing,
 		elapsedCommitBlockStorage,
 		elapsedCommitState,
+		elapsedCommitHistory,
 		txstatsInfo,
 	)
 	return nil
@@ -358,12 +372,16 @@ func (l *kvLedger) updateBlockStats(
 	blockProcessingTime time.Duration,
 	blockstorageCommitTime time.Duration,
 	statedbCommitTime time.Duration,
+	historydbCommitTime time.Duration,
 	txstatsInfo []*txmgr.TxStatInfo,
 ) {
 	l.stats.updateBlockchainHeight(blockNum + 1)
 	l.stats.updateBlockProcessingTime(blockProcessingTime)
 	l.stats.updateBlockstorageCommitTime(blockstorageCommitTime)
 	l.stats.updateStatedbCommitTime(statedbCommitTime)
+	if ledgerconfig.IsHistoryDBEnabled() {
+		l.stats.updateHistorydbCommitTime(historydbCommitTime)
+	}
 	l.stats.updateTransactionsStats(txstatsInfo)
 }
 
@@ -395,6 +413,11 @@ func (l *kvLedger) GetConfigHistoryRetriever() (ledger.ConfigHistoryRetriever, e
 	return l.configHistoryRetriever, nil
 }
 
+// GetSnapshotConfigBlock implements method in interface `ledger.SnapshotConfigBlockRetriever`
+func (l *kvLedger) GetSnapshotConfigBlock() (*common.Block, error) {
+	return l.snapshotConfigBlock, nil
+}
+
 func (l *kvLedger) CommitPvtDataOfOldBlocks(pvtData []*ledger.BlockPvtData) ([]*ledger.PvtdataHashMismatch, error) {
 	logger.Debugf("[%s:] Comparing pvtData of [%d] old blocks against the hashes in transaction's rwset to find valid and invalid data",
 		l.ledgerID, len(pvtData))
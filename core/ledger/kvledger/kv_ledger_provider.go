@@ -7,10 +7,10 @@ SPDX-License-Identifier: Apache-2.0
 package kvledger
 
 import (
-	"bytes"
 	"fmt"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/ledger/blkstorage"
 	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/confighistory"
@@ -18,6 +18,7 @@ import (
 	"github.com/hyperledger/fabric/core/ledger/kvledger/history/historydb"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/history/historydb/historyleveldb"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/privacyenabledstate"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
 	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
 	"github.com/hyperledger/fabric/core/ledger/ledgerstorage"
 	"github.com/hyperledger/fabric/protos/common"
@@ -36,6 +37,9 @@ var (
 
 	underConstructionLedgerKey = []byte("underConstructionLedgerKey")
 	ledgerKeyPrefix            = []byte("l")
+	ledgerKeyStop              = []byte("m")
+	snapshotConfigKeyPrefix    = []byte("s")
+	importingSnapshotKeyPrefix = []byte("i")
 )
 
 // Provider implements interface ledger.PeerLedgerProvider
@@ -50,20 +54,26 @@ type Provider struct {
 	initializer         *ledger.Initializer
 	collElgNotifier     *collElgNotifier
 	stats               *stats
+	fileLock            *leveldbhelper.FileLock
 }
 
 // NewProvider instantiates a new Provider.
 // This is not thread-safe and assumed to be synchronized be the caller
 func NewProvider() (ledger.PeerLedgerProvider, error) {
 	logger.Info("Initializing ledger provider")
+	// Acquire the file lock, so that the ledgers are not modified by another
+	// process, such as a peer node rollback, while they are in use
+	fileLock := leveldbhelper.NewFileLock(ledgerconfig.GetFileLockPath())
+	if err := fileLock.Lock(); err != nil {
+		return nil, errors.WithMessage(err, "as another peer node command is executing, wait for that command to complete its execution or terminate it before retrying")
+	}
 	// Initialize the ID store (inventory of chainIds/ledgerIds)
 	idStore := openIDStore(ledgerconfig.GetLedgerProviderPath())
-	ledgerStoreProvider := ledgerstorage.NewProvider()
 	// Initialize the history database (index for history of values by key)
 	historydbProvider := historyleveldb.NewHistoryDBProvider()
 	logger.Info("ledger provider Initialized")
-	provider := &Provider{idStore, ledgerStoreProvider,
-		nil, historydbProvider, nil, nil, nil, nil, nil, nil}
+	provider := &Provider{idStore, nil,
+		nil, historydbProvider, nil, nil, nil, nil, nil, nil, fileLock}
 	return provider, nil
 }
 
@@ -84,6 +94,7 @@ func (provider *Provider) Initialize(initializer *ledger.Initializer) error {
 	provider.configHistoryMgr = configHistoryMgr
 	provider.stateListeners = stateListeners
 	provider.collElgNotifier = collElgNotifier
+	provider.ledgerStoreProvider = ledgerstorage.NewProvider(initializer.MetricsProvider)
 	provider.bookkeepingProvider = bookkeeping.NewProvider()
 	provider.vdbProvider, err = privacyenabledstate.NewCommonStorageDBProvider(provider.bookkeepingProvider, initializer.MetricsProvider, initializer.HealthCheckRegistry)
 	if err != nil {
@@ -131,6 +142,113 @@ func (provider *Provider) Create(genesisBlock *common.Block) (ledger.PeerLedger,
 	return lgr, nil
 }
 
+// CreateFromSnapshot implements the corresponding method from interface ledger.PeerLedgerProvider.
+// As Create, this function sets the under construction flag before doing any thing related to the
+// ledger creation. The hashes of the snapshot files are verified before anything is imported, the state
+// and the config history of the snapshot are imported in bounded batches, the latest config block as of
+// the snapshot is saved in the id store, and the block store is bootstrapped last along with the ids of the
+// transactions of the snapshot, so that a crash in between is either recovered by the
+// 'recoverUnderConstructionLedger' function, or cleaned up such that the creation can be retried with the
+// same snapshot
+func (provider *Provider) CreateFromSnapshot(snapshotDir string) (ledger.PeerLedger, string, error) {
+	if ledgerconfig.IsCouchDBEnabled() {
+		return nil, "", errors.New("creating a ledger from a snapshot is not supported with the CouchDB state database")
+	}
+	metadata, snapshotInfo, configBlock, err := loadSnapshot(snapshotDir)
+	if err != nil {
+		return nil, "", err
+	}
+	ledgerID := metadata.ChannelName
+	exists, err := provider.idStore.ledgerIDExists(ledgerID)
+	if err != nil {
+		return nil, "", err
+	}
+	if exists {
+		return nil, "", ErrLedgerIDExists
+	}
+	if err = provider.idStore.setUnderConstructionFlag(ledgerID); err != nil {
+		return nil, "", err
+	}
+	if err := provider.importSnapshot(ledgerID, snapshotDir, metadata, snapshotInfo, configBlock); err != nil {
+		logger.Errorf("Error importing the snapshot of ledger [%s]. Unsetting under construction flag. Error: %+v", ledgerID, err)
+		panicOnErr(provider.runCleanup(ledgerID), "Error running cleanup for ledger id [%s]", ledgerID)
+		panicOnErr(provider.idStore.unsetUnderConstructionFlag(), "Error while unsetting under construction flag")
+		return nil, "", err
+	}
+	// the under construction flag is left set on an error, as the ledger creation
+	// is then completed by the recovery when the provider is next initialized
+	lgr, err := provider.openInternal(ledgerID)
+	if err != nil {
+		return nil, "", err
+	}
+	panicOnErr(provider.idStore.createLedgerID(ledgerID, configBlock), "Error while marking ledger as created")
+	logger.Infof("Created ledger [%s] from the snapshot at block [%d]", ledgerID, metadata.LastBlockNumber)
+	return lgr, ledgerID, nil
+}
+
+func (provider *Provider) importSnapshot(ledgerID, snapshotDir string, metadata *snapshotMetadata,
+	snapshotInfo *blkstorage.SnapshotInfo, configBlock *common.Block) error {
+	if err := verifySnapshotFiles(snapshotDir, metadata); err != nil {
+		return err
+	}
+	height := version.NewHeight(metadata.LastBlockNumber, 0)
+	vdb, err := provider.vdbProvider.GetDBHandle(ledgerID)
+	if err != nil {
+		return err
+	}
+	savepoint, err := vdb.GetLatestSavePoint()
+	if err != nil {
+		return err
+	}
+	switch {
+	case savepoint == nil:
+		// the state may have been partially imported by a previous attempt, which
+		// is completed only if it was importing the state of the same snapshot
+		importingHash, err := provider.idStore.getImportingSnapshot(ledgerID)
+		if err != nil {
+			return err
+		}
+		if importingHash != "" && importingHash != metadata.StateHash {
+			return errors.Errorf("the state of another snapshot was partially imported into the state database of ledger [%s], retry with the same snapshot", ledgerID)
+		}
+		if err := provider.idStore.setImportingSnapshot(ledgerID, metadata.StateHash); err != nil {
+			return err
+		}
+		if err := importSnapshotState(snapshotDir, metadata, vdb); err != nil {
+			return err
+		}
+		if err := provider.idStore.deleteImportingSnapshot(ledgerID); err != nil {
+			return err
+		}
+	case savepoint.BlockNum == metadata.LastBlockNumber:
+		// the state was imported by a previous attempt to create the ledger
+		logger.Infof("The state of ledger [%s] was already imported from the snapshot", ledgerID)
+	default:
+		return errors.Errorf("the state database of ledger [%s] is at block [%d], cannot import a snapshot at block [%d]",
+			ledgerID, savepoint.BlockNum, metadata.LastBlockNumber)
+	}
+
+	historyDB, err := provider.historydbProvider.GetDBHandle(ledgerID)
+	if err != nil {
+		return err
+	}
+	if err := historyDB.SetSavepoint(height); err != nil {
+		return err
+	}
+	if err := importSnapshotConfigHistory(snapshotDir, metadata, provider.configHistoryMgr); err != nil {
+		return err
+	}
+	if err := provider.idStore.setSnapshotConfigBlock(ledgerID, configBlock); err != nil {
+		return err
+	}
+	txIDs, err := newSnapshotTxIDIterator(snapshotDir)
+	if err != nil {
+		return err
+	}
+	defer txIDs.close()
+	return provider.ledgerStoreProvider.Bootstrap(ledgerID, snapshotInfo, txIDs)
+}
+
 // Open implements the corresponding method from interface ledger.PeerLedgerProvider
 func (provider *Provider) Open(ledgerID string) (ledger.PeerLedger, error) {
 	logger.Debugf("Open() opening kvledger: %s", ledgerID)
@@ -152,6 +270,11 @@ func (provider *Provider) openInternal(ledgerID string) (ledger.PeerLedger, erro
 		return nil, err
 	}
 	provider.collElgNotifier.registerListener(ledgerID, blockStore)
+	if listener := provider.initializer.CollElgListener; listener != nil {
+		blockStore.SetCollElgProcListener(func() {
+			listener.CollsEligibilityEnabled(ledgerID)
+		})
+	}
 
 	// Get the versioned database (state database) for a chain/ledger
 	vDB, err := provider.vdbProvider.GetDBHandle(ledgerID)
@@ -176,6 +299,9 @@ func (provider *Provider) openInternal(ledgerID string) (ledger.PeerLedger, erro
 	if err != nil {
 		return nil, err
 	}
+	if l.snapshotConfigBlock, err = provider.idStore.getSnapshotConfigBlock(ledgerID); err != nil {
+		return nil, err
+	}
 	return l, nil
 }
 
@@ -197,6 +323,7 @@ func (provider *Provider) Close() {
 	provider.historydbProvider.Close()
 	provider.bookkeepingProvider.Close()
 	provider.configHistoryMgr.Close()
+	provider.fileLock.Unlock()
 }
 
 // recoverUnderConstructionLedger checks whether the under construction flag is set - this would be the case
@@ -218,6 +345,20 @@ func (provider *Provider) recoverUnderConstructionLedger() {
 	panicOnErr(err, "Error while getting blockchain info for the under construction ledger [%s]", ledgerID)
 	ledger.Close()
 
+	snapshotConfigBlock, err := provider.idStore.getSnapshotConfigBlock(ledgerID)
+	panicOnErr(err, "Error while retrieving the snapshot config block of the under construction ledger [%s]", ledgerID)
+	switch {
+	case snapshotConfigBlock != nil && bcInfo.Height == 0:
+		logger.Infof("Block store was not bootstrapped from the snapshot. Hence, the peer ledger not created. unsetting the under construction flag")
+		panicOnErr(provider.runCleanup(ledgerID), "Error while running cleanup for ledger id [%s]", ledgerID)
+		panicOnErr(provider.idStore.unsetUnderConstructionFlag(), "Error while unsetting under construction flag")
+		return
+	case snapshotConfigBlock != nil:
+		logger.Infof("Block store was bootstrapped from the snapshot. Hence, marking the peer ledger as created")
+		panicOnErr(provider.idStore.createLedgerID(ledgerID, snapshotConfigBlock), "Error while adding ledgerID [%s] to created list", ledgerID)
+		return
+	}
+
 	switch bcInfo.Height {
 	case 0:
 		logger.Infof("Genesis block was not committed. Hence, the peer ledger not created. unsetting the under construction flag")
@@ -244,7 +385,8 @@ func (provider *Provider) runCleanup(ledgerID string) error {
 	// - blockstorage could remove empty folders
 	// - couchdb backed statedb could delete the database if got created
 	// - leveldb backed statedb and history db need not perform anything as it uses a single db shared across ledgers
-	return nil
+	// The state imported from a snapshot is kept, so that the creation can be retried with the same snapshot
+	return provider.idStore.deleteSnapshotConfigBlock(ledgerID)
 }
 
 func panicOnErr(err error, mgsFormat string, args ...interface{}) {
@@ -315,18 +457,61 @@ func (s *idStore) ledgerIDExists(ledgerID string) (bool, error) {
 
 func (s *idStore) getAllLedgerIds() ([]string, error) {
 	var ids []string
-	itr := s.db.GetIterator(nil, nil)
+	itr := s.db.GetIterator(ledgerKeyPrefix, ledgerKeyStop)
 	defer itr.Release()
-	itr.First()
-	for itr.Valid() {
-		if bytes.Equal(itr.Key(), underConstructionLedgerKey) {
-			continue
-		}
+	for itr.Next() {
 		id := string(s.decodeLedgerID(itr.Key()))
 		ids = append(ids, id)
-		itr.Next()
 	}
-	return ids, nil
+	return ids, itr.Error()
+}
+
+// setSnapshotConfigBlock saves the latest config block as of the snapshot
+// the given ledger is created from
+func (s *idStore) setSnapshotConfigBlock(ledgerID string, configBlock *common.Block) error {
+	val, err := proto.Marshal(configBlock)
+	if err != nil {
+		return err
+	}
+	return s.db.Put(s.encodeSnapshotConfigKey(ledgerID), val, true)
+}
+
+// getSnapshotConfigBlock returns the latest config block as of the snapshot
+// the given ledger was created from, or nil if it was not created from a snapshot
+func (s *idStore) getSnapshotConfigBlock(ledgerID string) (*common.Block, error) {
+	val, err := s.db.Get(s.encodeSnapshotConfigKey(ledgerID))
+	if val == nil || err != nil {
+		return nil, err
+	}
+	configBlock := &common.Block{}
+	if err := proto.Unmarshal(val, configBlock); err != nil {
+		return nil, errors.Wrapf(err, "error unmarshaling the snapshot config block of ledger [%s]", ledgerID)
+	}
+	return configBlock, nil
+}
+
+func (s *idStore) deleteSnapshotConfigBlock(ledgerID string) error {
+	return s.db.Delete(s.encodeSnapshotConfigKey(ledgerID), true)
+}
+
+// setImportingSnapshot records the hash of the state of the snapshot
+// being imported into the state database of the given ledger
+func (s *idStore) setImportingSnapshot(ledgerID, stateHash string) error {
+	return s.db.Put(s.encodeImportingSnapshotKey(ledgerID), []byte(stateHash), true)
+}
+
+// getImportingSnapshot returns the hash of the state of the snapshot being imported
+// into the state database of the given ledger, or an empty string if there is none
+func (s *idStore) getImportingSnapshot(ledgerID string) (string, error) {
+	val, err := s.db.Get(s.encodeImportingSnapshotKey(ledgerID))
+	if err != nil {
+		return "", err
+	}
+	return string(val), nil
+}
+
+func (s *idStore) deleteImportingSnapshot(ledgerID string) error {
+	return s.db.Delete(s.encodeImportingSnapshotKey(ledgerID), true)
 }
 
 func (s *idStore) close() {
@@ -340,3 +525,11 @@ func (s *idStore) encodeLedgerKey(ledgerID string) []byte {
 func (s *idStore) decodeLedgerID(key []byte) string {
 	return string(key[len(ledgerKeyPrefix):])
 }
+
+func (s *idStore) encodeSnapshotConfigKey(ledgerID string) []byte {
+	return append(append([]byte{}, snapshotConfigKeyPrefix...), []byte(ledgerID)...)
+}
+
+func (s *idStore) encodeImportingSnapshotKey(ledgerID string) []byte {
+	return append(append([]byte{}, importingSnapshotKeyPrefix...), []byte(ledgerID)...)
+}
@@ -17,6 +17,7 @@ type stats struct {
 	blockProcessingTime    metrics.Histogram
 	blockstorageCommitTime metrics.Histogram
 	statedbCommitTime      metrics.Histogram
+	historydbCommitTime    metrics.Histogram
 	transactionsCount      metrics.Counter
 }
 
@@ -26,6 +27,7 @@ func newStats(metricsProvider metrics.Provider) *stats {
 	stats.blockProcessingTime = metricsProvider.NewHistogram(blockProcessingTimeOpts)
 	stats.blockstorageCommitTime = metricsProvider.NewHistogram(blockstorageCommitTimeOpts)
 	stats.statedbCommitTime = metricsProvider.NewHistogram(statedbCommitTimeOpts)
+	stats.historydbCommitTime = metricsProvider.NewHistogram(historydbCommitTimeOpts)
 	stats.transactionsCount = metricsProvider.NewCounter(transactionCountOpts)
 	return stats
 }
@@ -59,6 +61,10 @@ func (s *ledgerStats) updateStatedbCommitTime(timeTaken time.Duration) {
 	s.stats.statedbCommitTime.With("channel", s.ledgerid).Observe(timeTaken.Seconds())
 }
 
+func (s *ledgerStats) updateHistorydbCommitTime(timeTaken time.Duration) {
+	s.stats.historydbCommitTime.With("channel", s.ledgerid).Observe(timeTaken.Seconds())
+}
+
 func (s *ledgerStats) updateTransactionsStats(
 	txstatsInfo []*txmgr.TxStatInfo,
 ) {
@@ -122,6 +128,16 @@ var (
 		Buckets:      []float64{0.005, 0.01, 0.015, 0.05, 0.1, 1, 10},
 	}
 
+	historydbCommitTimeOpts = metrics.HistogramOpts{
+		Namespace:    "ledger",
+		Subsystem:    "",
+		Name:         "historydb_commit_time",
+		Help:         "Time taken in seconds for committing block changes to history db.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+		Buckets:      []float64{0.005, 0.01, 0.015, 0.05, 0.1, 1, 10},
+	}
+
 	transactionCountOpts = metrics.CounterOpts{
 		Namespace:    "ledger",
 		Subsystem:    "",
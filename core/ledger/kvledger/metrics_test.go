@@ -57,7 +57,7 @@ func TestStatsBlockchainHeight(t *testing.T) {
 
 	// invoke updateBlockStats api explicitly and verify the calls with fake metrics
 	ledger.updateBlockStats(
-		10, 1*time.Second, 2*time.Second, 3*time.Second, nil,
+		10, 1*time.Second, 2*time.Second, 3*time.Second, 4*time.Second, nil,
 	)
 	assert.Equal(t, []string{"channel", ledgerid}, fakeBlockchainHeightGauge.WithArgsForCall(3))
 	assert.Equal(t, float64(11), fakeBlockchainHeightGauge.SetArgsForCall(3))
@@ -96,6 +96,10 @@ func TestStatsBlockCommit(t *testing.T) {
 		[]string{"channel", ledgerid},
 		testMetricProvider.fakeStatedbCommitTimeHist.WithArgsForCall(0),
 	)
+	assert.Equal(t,
+		[]string{"channel", ledgerid},
+		testMetricProvider.fakeHistorydbCommitTimeHist.WithArgsForCall(0),
+	)
 	assert.Equal(t,
 		[]string{
 			"channel", ledgerid,
@@ -108,7 +112,7 @@ func TestStatsBlockCommit(t *testing.T) {
 
 	// invoke updateBlockStats api explicitly and verify the calls with fake metrics
 	ledger.updateBlockStats(
-		10, 1*time.Second, 2*time.Second, 3*time.Second,
+		10, 1*time.Second, 2*time.Second, 3*time.Second, 4*time.Second,
 		[]*txmgr.TxStatInfo{
 			{
 				ValidationCode: peer.TxValidationCode_VALID,
@@ -146,6 +150,14 @@ func TestStatsBlockCommit(t *testing.T) {
 		float64(3),
 		testMetricProvider.fakeStatedbCommitTimeHist.ObserveArgsForCall(1),
 	)
+	assert.Equal(t,
+		[]string{"channel", ledgerid},
+		testMetricProvider.fakeHistorydbCommitTimeHist.WithArgsForCall(1),
+	)
+	assert.Equal(t,
+		float64(4),
+		testMetricProvider.fakeHistorydbCommitTimeHist.ObserveArgsForCall(1),
+	)
 	assert.Equal(t,
 		[]string{
 			"channel", ledgerid,
@@ -181,6 +193,7 @@ type testMetricProvider struct {
 	fakeBlockProcessingTimeHist    *metricsfakes.Histogram
 	fakeBlockstorageCommitTimeHist *metricsfakes.Histogram
 	fakeStatedbCommitTimeHist      *metricsfakes.Histogram
+	fakeHistorydbCommitTimeHist    *metricsfakes.Histogram
 	fakeTransactionsCount          *metricsfakes.Counter
 }
 
@@ -190,6 +203,7 @@ func testutilConstructMetricProvider() *testMetricProvider {
 	fakeBlockProcessingTimeHist := testutilConstructHist()
 	fakeBlockstorageCommitTimeHist := testutilConstructHist()
 	fakeStatedbCommitTimeHist := testutilConstructHist()
+	fakeHistorydbCommitTimeHist := testutilConstructHist()
 	fakeTransactionsCount := testutilConstructCounter()
 	fakeProvider.NewGaugeStub = func(opts metrics.GaugeOpts) metrics.Gauge {
 		switch opts.Name {
@@ -206,8 +220,11 @@ func testutilConstructMetricProvider() *testMetricProvider {
 			return fakeBlockstorageCommitTimeHist
 		case statedbCommitTimeOpts.Name:
 			return fakeStatedbCommitTimeHist
+		case historydbCommitTimeOpts.Name:
+			return fakeHistorydbCommitTimeHist
 		}
-		return nil
+		// histograms of the block and private data stores
+		return testutilConstructHist()
 	}
 
 	fakeProvider.NewCounterStub = func(opts metrics.CounterOpts) metrics.Counter {
@@ -223,6 +240,7 @@ func testutilConstructMetricProvider() *testMetricProvider {
 		fakeBlockProcessingTimeHist,
 		fakeBlockstorageCommitTimeHist,
 		fakeStatedbCommitTimeHist,
+		fakeHistorydbCommitTimeHist,
 		fakeTransactionsCount,
 	}
 }
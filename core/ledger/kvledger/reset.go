@@ -0,0 +1,32 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kvledger
+
+import (
+	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
+	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
+	"github.com/pkg/errors"
+)
+
+// ResetAllKVLedgers drops the state, history, config history and bookkeeping databases
+// of all the ledgers, while preserving their block stores. As the databases are empty,
+// all the blocks of all the channels are replayed to rebuild them when the ledgers are
+// next opened. ResetAllKVLedgers fails if the ledgers are in use, e.g., by a running peer.
+func ResetAllKVLedgers() error {
+	fileLock := leveldbhelper.NewFileLock(ledgerconfig.GetFileLockPath())
+	if err := fileLock.Lock(); err != nil {
+		return errors.WithMessage(err, "as another peer node command is executing, wait for that command to complete its execution or terminate it before retrying")
+	}
+	defer fileLock.Unlock()
+
+	logger.Info("Resetting all the channel ledgers")
+	if err := dropDBs(); err != nil {
+		return err
+	}
+	logger.Info("All the channel ledgers have been successfully reset, their blocks will be replayed when the peer is next started")
+	return nil
+}
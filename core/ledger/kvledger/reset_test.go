@@ -0,0 +1,106 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kvledger
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hyperledger/fabric/common/ledger/testutil"
+	"github.com/hyperledger/fabric/common/util"
+	lgr "github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResetAllKVLedgers(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+	viper.Set("ledger.history.enableHistoryDatabase", true)
+
+	provider := testutilNewProvider(t)
+	numLedgers := 2
+	for i := 0; i < numLedgers; i++ {
+		ledgerID := constructTestLedgerID(i)
+		bg, gb := testutil.NewBlockGenerator(t, ledgerID, false)
+		ledger, err := provider.Create(gb)
+		require.NoError(t, err)
+		for j := 1; j <= 3; j++ {
+			simulator, err := ledger.NewTxSimulator(util.GenerateUUID())
+			require.NoError(t, err)
+			require.NoError(t, simulator.SetState("ns1", "key1", []byte(fmt.Sprintf("value%d", j))))
+			simulator.Done()
+			simRes, err := simulator.GetTxSimulationResults()
+			require.NoError(t, err)
+			pubSimBytes, err := simRes.GetPubSimulationBytes()
+			require.NoError(t, err)
+			require.NoError(t, ledger.CommitWithPvtData(&lgr.BlockAndPvtData{Block: bg.NextBlock([][]byte{pubSimBytes})}))
+		}
+		ledger.Close()
+	}
+
+	// the ledgers cannot be reset while they are in use
+	err := ResetAllKVLedgers()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "as another peer node command is executing")
+	provider.Close()
+
+	assert.NoError(t, ResetAllKVLedgers())
+	for _, dbPath := range []string{
+		ledgerconfig.GetStateLevelDBPath(),
+		ledgerconfig.GetHistoryLevelDBPath(),
+		ledgerconfig.GetConfigHistoryPath(),
+		ledgerconfig.GetInternalBookkeeperPath(),
+	} {
+		_, err := os.Stat(dbPath)
+		assert.True(t, os.IsNotExist(err))
+	}
+	assert.DirExists(t, ledgerconfig.GetBlockStorePath())
+
+	// the blocks are replayed to rebuild the state and the history of all the ledgers
+	provider = testutilNewProvider(t)
+	defer provider.Close()
+	for i := 0; i < numLedgers; i++ {
+		ledger, err := provider.Open(constructTestLedgerID(i))
+		require.NoError(t, err)
+		bcInfo, err := ledger.GetBlockchainInfo()
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(4), bcInfo.Height)
+
+		qe, err := ledger.NewQueryExecutor()
+		require.NoError(t, err)
+		value, err := qe.GetState("ns1", "key1")
+		qe.Done()
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("value3"), value)
+
+		hqe, err := ledger.NewHistoryQueryExecutor()
+		require.NoError(t, err)
+		itr, err := hqe.GetHistoryForKey("ns1", "key1")
+		require.NoError(t, err)
+		numModifications := 0
+		for {
+			kmod, err := itr.Next()
+			require.NoError(t, err)
+			if kmod == nil {
+				break
+			}
+			numModifications++
+		}
+		itr.Close()
+		assert.Equal(t, 3, numModifications)
+
+		block, err := ledger.GetBlockByNumber(3)
+		assert.NoError(t, err)
+		assert.Equal(t, bcInfo.CurrentBlockHash, protoutil.BlockHeaderHash(block.Header))
+		ledger.Close()
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kvledger
+
+import (
+	"os"
+
+	"github.com/hyperledger/fabric/common/ledger/blkstorage/fsblkstorage"
+	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb/statecouchdb"
+	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
+	"github.com/pkg/errors"
+)
+
+// RollbackKVLedger rolls back the ledger with the given id to the given block number.
+// The blocks after blockNum are removed from the block store, and the state, history,
+// config history and bookkeeping databases of all the ledgers are dropped, so that
+// they are rebuilt from the block stores when the ledgers are next opened.
+// RollbackKVLedger fails if the ledgers are in use, e.g., by a running peer.
+func RollbackKVLedger(ledgerID string, blockNum uint64) error {
+	fileLock := leveldbhelper.NewFileLock(ledgerconfig.GetFileLockPath())
+	if err := fileLock.Lock(); err != nil {
+		return errors.WithMessage(err, "as another peer node command is executing, wait for that command to complete its execution or terminate it before retrying")
+	}
+	defer fileLock.Unlock()
+
+	blockstorePath := ledgerconfig.GetBlockStorePath()
+	if err := fsblkstorage.ValidateRollbackParams(blockstorePath, ledgerID, blockNum); err != nil {
+		return err
+	}
+
+	logger.Info("Dropping the state, history, config history and bookkeeping databases")
+	if err := dropDBs(); err != nil {
+		return err
+	}
+
+	logger.Info("Rolling back the block store")
+	if err := fsblkstorage.Rollback(blockstorePath, ledgerID, blockNum); err != nil {
+		return err
+	}
+	logger.Infof("The channel [%s] has been successfully rolled back to the block number [%d]", ledgerID, blockNum)
+	return nil
+}
+
+func dropDBs() error {
+	// The state database is shared by all the ledgers. Dropping it makes all the
+	// ledgers rebuild their state from their block stores, which also takes care
+	// of the private data that is recommitted from the pvtdata store.
+	if ledgerconfig.IsCouchDBEnabled() {
+		if err := statecouchdb.DropApplicationDBs(); err != nil {
+			return err
+		}
+	}
+	for _, dbPath := range []string{
+		ledgerconfig.GetStateLevelDBPath(),
+		ledgerconfig.GetHistoryLevelDBPath(),
+		ledgerconfig.GetConfigHistoryPath(),
+		ledgerconfig.GetInternalBookkeeperPath(),
+	} {
+		if err := os.RemoveAll(dbPath); err != nil {
+			return errors.Wrapf(err, "error removing the database at %s", dbPath)
+		}
+	}
+	return nil
+}
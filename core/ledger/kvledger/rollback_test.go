@@ -0,0 +1,111 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kvledger
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hyperledger/fabric/common/ledger/testutil"
+	"github.com/hyperledger/fabric/common/util"
+	lgr "github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollbackKVLedger(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+	viper.Set("ledger.history.enableHistoryDatabase", true)
+
+	ledgerID := "testLedger"
+	provider := testutilNewProvider(t)
+	bg, gb := testutil.NewBlockGenerator(t, ledgerID, false)
+	ledger, err := provider.Create(gb)
+	require.NoError(t, err)
+
+	blocks := []*common.Block{gb}
+	for i := 1; i <= 5; i++ {
+		simulator, err := ledger.NewTxSimulator(util.GenerateUUID())
+		require.NoError(t, err)
+		require.NoError(t, simulator.SetState("ns1", "key1", []byte(fmt.Sprintf("value%d", i))))
+		simulator.Done()
+		simRes, err := simulator.GetTxSimulationResults()
+		require.NoError(t, err)
+		pubSimBytes, err := simRes.GetPubSimulationBytes()
+		require.NoError(t, err)
+		block := bg.NextBlock([][]byte{pubSimBytes})
+		require.NoError(t, ledger.CommitWithPvtData(&lgr.BlockAndPvtData{Block: block}))
+		blocks = append(blocks, block)
+	}
+
+	// the ledgers cannot be rolled back while they are in use
+	err = RollbackKVLedger(ledgerID, 3)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "as another peer node command is executing")
+	ledger.Close()
+	provider.Close()
+
+	err = RollbackKVLedger("nonExistentLedger", 3)
+	assert.EqualError(t, err, "ledger [nonExistentLedger] does not exist")
+	err = RollbackKVLedger(ledgerID, 5)
+	assert.EqualError(t, err, "target block number [5] should be less than the last block number [5] of ledger [testLedger]")
+
+	assert.NoError(t, RollbackKVLedger(ledgerID, 3))
+
+	provider = testutilNewProvider(t)
+	defer provider.Close()
+	ledger, err = provider.Open(ledgerID)
+	require.NoError(t, err)
+	defer ledger.Close()
+
+	bcInfo, err := ledger.GetBlockchainInfo()
+	assert.NoError(t, err)
+	assert.Equal(t, &common.BlockchainInfo{
+		Height:            4,
+		CurrentBlockHash:  protoutil.BlockHeaderHash(blocks[3].Header),
+		PreviousBlockHash: protoutil.BlockHeaderHash(blocks[2].Header),
+	}, bcInfo)
+
+	// the state and the history are rebuilt from the remaining blocks
+	qe, err := ledger.NewQueryExecutor()
+	require.NoError(t, err)
+	value, err := qe.GetState("ns1", "key1")
+	qe.Done()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value3"), value)
+
+	hqe, err := ledger.NewHistoryQueryExecutor()
+	require.NoError(t, err)
+	itr, err := hqe.GetHistoryForKey("ns1", "key1")
+	require.NoError(t, err)
+	defer itr.Close()
+	numModifications := 0
+	for {
+		kmod, err := itr.Next()
+		require.NoError(t, err)
+		if kmod == nil {
+			break
+		}
+		numModifications++
+	}
+	assert.Equal(t, 3, numModifications)
+
+	// the rolled back blocks can be committed again
+	for _, block := range blocks[4:] {
+		assert.NoError(t, ledger.CommitWithPvtData(&lgr.BlockAndPvtData{Block: block}))
+	}
+	qe, err = ledger.NewQueryExecutor()
+	require.NoError(t, err)
+	defer qe.Done()
+	value, err = qe.GetState("ns1", "key1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value5"), value)
+}
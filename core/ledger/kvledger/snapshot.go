@@ -0,0 +1,599 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kvledger
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/ledger/blkstorage"
+	"github.com/hyperledger/fabric/common/ledger/util"
+	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
+	"github.com/hyperledger/fabric/core/ledger/confighistory"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/bookkeeping"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/privacyenabledstate"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
+	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
+	"github.com/hyperledger/fabric/core/ledger/ledgerstorage"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+)
+
+const (
+	snapshotMetadataFileName    = "metadata.json"
+	snapshotConfigBlockFileName = "config_block.pb"
+	snapshotStateFileName       = "public_and_hashed_state.data"
+	snapshotTxIDsFileName       = "txids.data"
+	snapshotConfigHistFileName  = "config_history.data"
+)
+
+var (
+	// maxSnapshotRecordSize is the maximum size of a record of a snapshot file,
+	// which bounds the memory allocated for a length read from the file
+	maxSnapshotRecordSize uint64 = 128 * 1024 * 1024
+	// maxSnapshotImportBatchSize is the size of the records of a snapshot file
+	// above which the records read so far are written to the ledger
+	maxSnapshotImportBatchSize = 16 * 1024 * 1024
+)
+
+// snapshotMetadata describes the content of a snapshot directory
+type snapshotMetadata struct {
+	ChannelName       string `json:"channel_name"`
+	LastBlockNumber   uint64 `json:"last_block_number"`
+	LastBlockHash     string `json:"last_block_hash"`
+	PreviousBlockHash string `json:"previous_block_hash"`
+	StateHash         string `json:"state_hash"`
+	TxIDsHash         string `json:"txids_hash"`
+	ConfigHistoryHash string `json:"config_history_hash"`
+}
+
+// GenerateSnapshot writes a snapshot of the ledger with the given id to the given directory.
+// The snapshot holds the public state and the hashes of the private data as of the last block
+// of the ledger, along with the ids of the committed transactions, the history of the collection
+// configs and the latest config block, and can be used to create the ledger on
+// another peer without replaying the blocks. The private data, the history of the keys and the
+// blocks themselves are not part of the snapshot. GenerateSnapshot fails if the ledgers are in
+// use, e.g., by a running peer, and it is supported only with the goleveldb state database.
+func GenerateSnapshot(ledgerID, snapshotDir string) error {
+	fileLock := leveldbhelper.NewFileLock(ledgerconfig.GetFileLockPath())
+	if err := fileLock.Lock(); err != nil {
+		return errors.WithMessage(err, "as another peer node command is executing, wait for that command to complete its execution or terminate it before retrying")
+	}
+	defer fileLock.Unlock()
+
+	if ledgerconfig.IsCouchDBEnabled() {
+		return errors.New("snapshots are not supported with the CouchDB state database")
+	}
+
+	idStore := openIDStore(ledgerconfig.GetLedgerProviderPath())
+	defer idStore.close()
+	exists, err := idStore.ledgerIDExists(ledgerID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.Errorf("ledger [%s] does not exist", ledgerID)
+	}
+	snapshotConfigBlock, err := idStore.getSnapshotConfigBlock(ledgerID)
+	if err != nil {
+		return err
+	}
+
+	ledgerStoreProvider := ledgerstorage.NewProvider(&disabled.Provider{})
+	defer ledgerStoreProvider.Close()
+	blockStore, err := ledgerStoreProvider.Open(ledgerID)
+	if err != nil {
+		return err
+	}
+	defer blockStore.Shutdown()
+	bcInfo, err := blockStore.GetBlockchainInfo()
+	if err != nil {
+		return err
+	}
+	if bcInfo.Height == 0 {
+		return errors.Errorf("ledger [%s] has no blocks", ledgerID)
+	}
+	lastBlockNum := bcInfo.Height - 1
+	configBlock, err := lastConfigBlock(blockStore, lastBlockNum, snapshotConfigBlock)
+	if err != nil {
+		return err
+	}
+
+	bookkeepingProvider := bookkeeping.NewProvider()
+	defer bookkeepingProvider.Close()
+	vdbProvider, err := privacyenabledstate.NewCommonStorageDBProvider(bookkeepingProvider, &disabled.Provider{}, nil)
+	if err != nil {
+		return err
+	}
+	defer vdbProvider.Close()
+	vdb, err := vdbProvider.GetDBHandle(ledgerID)
+	if err != nil {
+		return err
+	}
+	savepoint, err := vdb.GetLatestSavePoint()
+	if err != nil {
+		return err
+	}
+	if savepoint == nil || savepoint.BlockNum != lastBlockNum {
+		return errors.Errorf("the state database of ledger [%s] is not in sync with the block [%d], start the peer to sync it before generating a snapshot", ledgerID, lastBlockNum)
+	}
+
+	empty, err := util.CreateDirIfMissing(snapshotDir)
+	if err != nil {
+		return errors.Wrapf(err, "error creating the snapshot directory %s", snapshotDir)
+	}
+	if !empty {
+		return errors.Errorf("the snapshot directory %s is not empty", snapshotDir)
+	}
+	stateHash, err := writeSnapshotState(filepath.Join(snapshotDir, snapshotStateFileName), vdb)
+	if err != nil {
+		return err
+	}
+	txIDsHash, err := writeSnapshotTxIDs(filepath.Join(snapshotDir, snapshotTxIDsFileName), blockStore)
+	if err != nil {
+		return err
+	}
+	configHistoryMgr := confighistory.NewMgr(nil)
+	defer configHistoryMgr.Close()
+	configHistoryHash, err := writeSnapshotConfigHistory(filepath.Join(snapshotDir, snapshotConfigHistFileName), ledgerID, configHistoryMgr)
+	if err != nil {
+		return err
+	}
+	configBlockBytes, err := proto.Marshal(configBlock)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(snapshotDir, snapshotConfigBlockFileName), configBlockBytes, 0644); err != nil {
+		return errors.Wrapf(err, "error writing the config block to the snapshot directory %s", snapshotDir)
+	}
+	metadataBytes, err := json.MarshalIndent(&snapshotMetadata{
+		ChannelName:       ledgerID,
+		LastBlockNumber:   lastBlockNum,
+		LastBlockHash:     hex.EncodeToString(bcInfo.CurrentBlockHash),
+		PreviousBlockHash: hex.EncodeToString(bcInfo.PreviousBlockHash),
+		StateHash:         hex.EncodeToString(stateHash),
+		TxIDsHash:         hex.EncodeToString(txIDsHash),
+		ConfigHistoryHash: hex.EncodeToString(configHistoryHash),
+	}, "", "    ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(snapshotDir, snapshotMetadataFileName), metadataBytes, 0644); err != nil {
+		return errors.Wrapf(err, "error writing the metadata to the snapshot directory %s", snapshotDir)
+	}
+	logger.Infof("The snapshot of channel [%s] at block [%d] has been successfully generated in %s", ledgerID, lastBlockNum, snapshotDir)
+	return nil
+}
+
+// lastConfigBlock returns the latest config block as of the given block. A ledger created
+// from a snapshot does not hold the blocks of the snapshot, including possibly its config block
+func lastConfigBlock(blockStore *ledgerstorage.Store, blockNum uint64, snapshotConfigBlock *common.Block) (*common.Block, error) {
+	lastBlock, err := blockStore.RetrieveBlockByNumber(blockNum)
+	if err != nil {
+		if snapshotConfigBlock != nil {
+			return snapshotConfigBlock, nil
+		}
+		return nil, err
+	}
+	configBlockNum, err := protoutil.GetLastConfigIndexFromBlock(lastBlock)
+	if err != nil {
+		return nil, err
+	}
+	if snapshotConfigBlock != nil && snapshotConfigBlock.Header.Number == configBlockNum {
+		return snapshotConfigBlock, nil
+	}
+	return blockStore.RetrieveBlockByNumber(configBlockNum)
+}
+
+// writeSnapshotState writes the public and hashed state to the given file, and returns the hash of its content
+func writeSnapshotState(path string, vdb privacyenabledstate.DB) ([]byte, error) {
+	return writeSnapshotFile(path, func(write func(record *proto.Buffer) error) error {
+		return vdb.ExportPubAndHashedState(func(namespace, collection string, key []byte, vv *statedb.VersionedValue) error {
+			record := proto.NewBuffer(nil)
+			if err := record.EncodeStringBytes(namespace); err != nil {
+				return err
+			}
+			if err := record.EncodeStringBytes(collection); err != nil {
+				return err
+			}
+			for _, b := range [][]byte{key, vv.Value, vv.Metadata} {
+				if err := record.EncodeRawBytes(b); err != nil {
+					return err
+				}
+			}
+			if err := record.EncodeVarint(vv.Version.BlockNum); err != nil {
+				return err
+			}
+			if err := record.EncodeVarint(vv.Version.TxNum); err != nil {
+				return err
+			}
+			return write(record)
+		})
+	})
+}
+
+// writeSnapshotTxIDs writes the ids of the committed transactions, along with their
+// block number and validation code, to the given file, and returns the hash of its content
+func writeSnapshotTxIDs(path string, blockStore *ledgerstorage.Store) ([]byte, error) {
+	return writeSnapshotFile(path, func(write func(record *proto.Buffer) error) error {
+		return blockStore.ExportTxIDs(func(txID *blkstorage.SnapshotTxID) error {
+			record := proto.NewBuffer(nil)
+			if err := record.EncodeStringBytes(txID.TxID); err != nil {
+				return err
+			}
+			if err := record.EncodeVarint(txID.BlockNum); err != nil {
+				return err
+			}
+			if err := record.EncodeVarint(uint64(txID.ValidationCode)); err != nil {
+				return err
+			}
+			return write(record)
+		})
+	})
+}
+
+// writeSnapshotConfigHistory writes the history of the collection configs of the
+// given ledger to the given file, and returns the hash of its content
+func writeSnapshotConfigHistory(path, ledgerID string, configHistoryMgr confighistory.Mgr) ([]byte, error) {
+	return writeSnapshotFile(path, func(write func(record *proto.Buffer) error) error {
+		return configHistoryMgr.ExportConfigHistory(ledgerID, func(entry *confighistory.Entry) error {
+			record := proto.NewBuffer(nil)
+			if err := record.EncodeStringBytes(entry.Namespace); err != nil {
+				return err
+			}
+			if err := record.EncodeStringBytes(entry.Key); err != nil {
+				return err
+			}
+			if err := record.EncodeVarint(entry.BlockNum); err != nil {
+				return err
+			}
+			if err := record.EncodeRawBytes(entry.Value); err != nil {
+				return err
+			}
+			return write(record)
+		})
+	})
+}
+
+// writeSnapshotFile creates the given file, writes each record passed by writeRecords as a
+// length prefixed record, and returns the hash of the content of the file
+func writeSnapshotFile(path string, writeRecords func(write func(record *proto.Buffer) error) error) ([]byte, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating the snapshot file %s", path)
+	}
+	defer f.Close()
+	hasher := sha256.New()
+	w := bufio.NewWriter(io.MultiWriter(f, hasher))
+
+	err = writeRecords(func(record *proto.Buffer) error {
+		if _, err := w.Write(proto.EncodeVarint(uint64(len(record.Bytes())))); err != nil {
+			return err
+		}
+		_, err := w.Write(record.Bytes())
+		return err
+	})
+	if err != nil {
+		return nil, errors.WithMessage(err, "error exporting to the snapshot file "+path)
+	}
+	if err := w.Flush(); err != nil {
+		return nil, errors.Wrapf(err, "error writing the snapshot file %s", path)
+	}
+	if err := f.Sync(); err != nil {
+		return nil, errors.Wrapf(err, "error syncing the snapshot file %s", path)
+	}
+	return hasher.Sum(nil), nil
+}
+
+// SnapshotConfigBlock returns the latest config block as of the snapshot in the given directory
+func SnapshotConfigBlock(snapshotDir string) (*common.Block, error) {
+	_, _, configBlock, err := loadSnapshot(snapshotDir)
+	return configBlock, err
+}
+
+// loadSnapshot reads the metadata and the config block of the snapshot in the
+// given directory, and verifies that they describe the same channel
+func loadSnapshot(snapshotDir string) (*snapshotMetadata, *blkstorage.SnapshotInfo, *common.Block, error) {
+	metadataBytes, err := ioutil.ReadFile(filepath.Join(snapshotDir, snapshotMetadataFileName))
+	if err != nil {
+		return nil, nil, nil, errors.Wrapf(err, "error reading the metadata of the snapshot in %s", snapshotDir)
+	}
+	metadata := &snapshotMetadata{}
+	if err := json.Unmarshal(metadataBytes, metadata); err != nil {
+		return nil, nil, nil, errors.Wrapf(err, "error unmarshaling the metadata of the snapshot in %s", snapshotDir)
+	}
+	if metadata.ChannelName == "" {
+		return nil, nil, nil, errors.Errorf("the metadata of the snapshot in %s has no channel name", snapshotDir)
+	}
+	snapshotInfo := &blkstorage.SnapshotInfo{LastBlockNum: metadata.LastBlockNumber}
+	if snapshotInfo.LastBlockHash, err = hex.DecodeString(metadata.LastBlockHash); err != nil {
+		return nil, nil, nil, errors.Wrapf(err, "error decoding the last block hash of the snapshot in %s", snapshotDir)
+	}
+	if snapshotInfo.PreviousBlockHash, err = hex.DecodeString(metadata.PreviousBlockHash); err != nil {
+		return nil, nil, nil, errors.Wrapf(err, "error decoding the previous block hash of the snapshot in %s", snapshotDir)
+	}
+
+	configBlockBytes, err := ioutil.ReadFile(filepath.Join(snapshotDir, snapshotConfigBlockFileName))
+	if err != nil {
+		return nil, nil, nil, errors.Wrapf(err, "error reading the config block of the snapshot in %s", snapshotDir)
+	}
+	configBlock := &common.Block{}
+	if err := proto.Unmarshal(configBlockBytes, configBlock); err != nil {
+		return nil, nil, nil, errors.Wrapf(err, "error unmarshaling the config block of the snapshot in %s", snapshotDir)
+	}
+	if configBlock.Header == nil || configBlock.Header.Number > metadata.LastBlockNumber {
+		return nil, nil, nil, errors.Errorf("the config block of the snapshot in %s is not a block up to the last block [%d]", snapshotDir, metadata.LastBlockNumber)
+	}
+	channelID, err := protoutil.GetChainIDFromBlock(configBlock)
+	if err != nil {
+		return nil, nil, nil, errors.WithMessage(err, "error reading the channel of the config block of the snapshot")
+	}
+	if channelID != metadata.ChannelName {
+		return nil, nil, nil, errors.Errorf("the config block of the snapshot in %s is for channel [%s] instead of [%s]", snapshotDir, channelID, metadata.ChannelName)
+	}
+	return metadata, snapshotInfo, configBlock, nil
+}
+
+// verifySnapshotFiles verifies the hashes of the data files of the snapshot in the given
+// directory, so that nothing is imported from a snapshot that was altered
+func verifySnapshotFiles(snapshotDir string, metadata *snapshotMetadata) error {
+	if err := verifySnapshotFile(filepath.Join(snapshotDir, snapshotStateFileName), metadata.StateHash); err != nil {
+		return err
+	}
+	if err := verifySnapshotFile(filepath.Join(snapshotDir, snapshotTxIDsFileName), metadata.TxIDsHash); err != nil {
+		return err
+	}
+	return verifySnapshotFile(filepath.Join(snapshotDir, snapshotConfigHistFileName), metadata.ConfigHistoryHash)
+}
+
+func verifySnapshotFile(path, expectedHash string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.Wrapf(err, "error opening the snapshot file %s", path)
+	}
+	defer f.Close()
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, f); err != nil {
+		return errors.Wrapf(err, "error reading the snapshot file %s", path)
+	}
+	if err := verifyHash(hasher, expectedHash); err != nil {
+		return errors.WithMessage(err, "error verifying the snapshot file "+path)
+	}
+	return nil
+}
+
+// snapshotFileReader reads the length prefixed records of a snapshot file
+type snapshotFileReader struct {
+	path string
+	f    *os.File
+	r    *bufio.Reader
+}
+
+func openSnapshotFile(path string) (*snapshotFileReader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening the snapshot file %s", path)
+	}
+	return &snapshotFileReader{path, f, bufio.NewReader(f)}, nil
+}
+
+// next returns the next record of the file, or nil after the last record
+func (r *snapshotFileReader) next() (*proto.Buffer, error) {
+	recordLen, err := binary.ReadUvarint(r.r)
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading the snapshot file %s", r.path)
+	}
+	if recordLen > maxSnapshotRecordSize {
+		return nil, errors.Errorf("error reading the snapshot file %s: the size [%d] of a record exceeds the maximum size [%d]",
+			r.path, recordLen, maxSnapshotRecordSize)
+	}
+	recordBytes := make([]byte, recordLen)
+	if _, err := io.ReadFull(r.r, recordBytes); err != nil {
+		return nil, errors.Wrapf(err, "error reading the snapshot file %s", r.path)
+	}
+	return proto.NewBuffer(recordBytes), nil
+}
+
+func (r *snapshotFileReader) close() {
+	r.f.Close()
+}
+
+// importSnapshotState writes the public and hashed state of the snapshot in the given directory to
+// the given state database in bounded batches. The savepoint is written with the last batch only,
+// so the state database has no savepoint until the whole state is imported
+func importSnapshotState(snapshotDir string, metadata *snapshotMetadata, vdb privacyenabledstate.DB) error {
+	r, err := openSnapshotFile(filepath.Join(snapshotDir, snapshotStateFileName))
+	if err != nil {
+		return err
+	}
+	defer r.close()
+
+	batch, batchSize := privacyenabledstate.NewUpdateBatch(), 0
+	for {
+		record, err := r.next()
+		if err != nil {
+			return err
+		}
+		if record == nil {
+			break
+		}
+		batchSize += len(record.Bytes())
+		if err := addSnapshotRecord(batch, record); err != nil {
+			return errors.WithMessage(err, "error decoding the snapshot file "+r.path)
+		}
+		if batchSize >= maxSnapshotImportBatchSize {
+			if err := vdb.ApplyPrivacyAwareUpdates(batch, nil); err != nil {
+				return err
+			}
+			batch, batchSize = privacyenabledstate.NewUpdateBatch(), 0
+		}
+	}
+	return vdb.ApplyPrivacyAwareUpdates(batch, version.NewHeight(metadata.LastBlockNumber, 0))
+}
+
+func addSnapshotRecord(batch *privacyenabledstate.UpdateBatch, record *proto.Buffer) error {
+	namespace, err := record.DecodeStringBytes()
+	if err != nil {
+		return err
+	}
+	collection, err := record.DecodeStringBytes()
+	if err != nil {
+		return err
+	}
+	// the decoded bytes are never nil, as a nil value would delete the key
+	var fields [3][]byte
+	for i := range fields {
+		if fields[i], err = record.DecodeRawBytes(true); err != nil {
+			return err
+		}
+	}
+	key, value, metadata := fields[0], fields[1], fields[2]
+	if len(metadata) == 0 {
+		metadata = nil
+	}
+	blockNum, err := record.DecodeVarint()
+	if err != nil {
+		return err
+	}
+	txNum, err := record.DecodeVarint()
+	if err != nil {
+		return err
+	}
+	ver := version.NewHeight(blockNum, txNum)
+	if collection == "" {
+		batch.PubUpdates.PutValAndMetadata(namespace, string(key), value, metadata, ver)
+	} else {
+		batch.HashUpdates.PutValHashAndMetadata(namespace, collection, key, value, metadata, ver)
+	}
+	return nil
+}
+
+// importSnapshotConfigHistory adds the history of the collection configs of the
+// snapshot in the given directory to the given config history in bounded batches
+func importSnapshotConfigHistory(snapshotDir string, metadata *snapshotMetadata, configHistoryMgr confighistory.Mgr) error {
+	r, err := openSnapshotFile(filepath.Join(snapshotDir, snapshotConfigHistFileName))
+	if err != nil {
+		return err
+	}
+	defer r.close()
+
+	var entries []*confighistory.Entry
+	batchSize := 0
+	for {
+		record, err := r.next()
+		if err != nil {
+			return err
+		}
+		if record == nil {
+			break
+		}
+		batchSize += len(record.Bytes())
+		entry, err := decodeConfigHistoryRecord(record)
+		if err != nil {
+			return errors.WithMessage(err, "error decoding the snapshot file "+r.path)
+		}
+		if entry.BlockNum > metadata.LastBlockNumber {
+			return errors.Errorf("the config history entry of key [%s] of namespace [%s] of the snapshot is at block [%d], after the last block [%d] of the snapshot",
+				entry.Key, entry.Namespace, entry.BlockNum, metadata.LastBlockNumber)
+		}
+		entries = append(entries, entry)
+		if batchSize >= maxSnapshotImportBatchSize {
+			if err := configHistoryMgr.ImportConfigHistory(metadata.ChannelName, entries); err != nil {
+				return err
+			}
+			entries, batchSize = nil, 0
+		}
+	}
+	return configHistoryMgr.ImportConfigHistory(metadata.ChannelName, entries)
+}
+
+func decodeConfigHistoryRecord(record *proto.Buffer) (*confighistory.Entry, error) {
+	namespace, err := record.DecodeStringBytes()
+	if err != nil {
+		return nil, err
+	}
+	key, err := record.DecodeStringBytes()
+	if err != nil {
+		return nil, err
+	}
+	blockNum, err := record.DecodeVarint()
+	if err != nil {
+		return nil, err
+	}
+	value, err := record.DecodeRawBytes(true)
+	if err != nil {
+		return nil, err
+	}
+	return &confighistory.Entry{Namespace: namespace, Key: key, BlockNum: blockNum, Value: value}, nil
+}
+
+// snapshotTxIDIterator reads the transaction ids of the snapshot, so that
+// the block store is bootstrapped without loading all of them in memory
+type snapshotTxIDIterator struct {
+	r *snapshotFileReader
+}
+
+func newSnapshotTxIDIterator(snapshotDir string) (*snapshotTxIDIterator, error) {
+	r, err := openSnapshotFile(filepath.Join(snapshotDir, snapshotTxIDsFileName))
+	if err != nil {
+		return nil, err
+	}
+	return &snapshotTxIDIterator{r}, nil
+}
+
+// Next implements the function in the interface blkstorage.SnapshotTxIDIterator
+func (i *snapshotTxIDIterator) Next() (*blkstorage.SnapshotTxID, error) {
+	record, err := i.r.next()
+	if record == nil || err != nil {
+		return nil, err
+	}
+	txID, err := record.DecodeStringBytes()
+	if err != nil {
+		return nil, errors.WithMessage(err, "error decoding the snapshot file "+i.r.path)
+	}
+	blockNum, err := record.DecodeVarint()
+	if err != nil {
+		return nil, errors.WithMessage(err, "error decoding the snapshot file "+i.r.path)
+	}
+	validationCode, err := record.DecodeVarint()
+	if err != nil {
+		return nil, errors.WithMessage(err, "error decoding the snapshot file "+i.r.path)
+	}
+	return &blkstorage.SnapshotTxID{TxID: txID, BlockNum: blockNum, ValidationCode: peer.TxValidationCode(validationCode)}, nil
+}
+
+func (i *snapshotTxIDIterator) close() {
+	i.r.close()
+}
+
+func verifyHash(hasher hash.Hash, expectedHash string) error {
+	expected, err := hex.DecodeString(expectedHash)
+	if err != nil {
+		return errors.Wrap(err, "error decoding the expected hash")
+	}
+	if !bytes.Equal(hasher.Sum(nil), expected) {
+		return errors.New("the hash does not match the hash in the metadata of the snapshot")
+	}
+	return nil
+}
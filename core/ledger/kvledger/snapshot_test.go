@@ -0,0 +1,344 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kvledger
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/ledger/testutil"
+	"github.com/hyperledger/fabric/common/util"
+	lgr "github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/confighistory"
+	lutil "github.com/hyperledger/fabric/core/ledger/util"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateFromSnapshot(t *testing.T) {
+	snapshotDir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(snapshotDir)
+	snapshotDir = filepath.Join(snapshotDir, "testLedger")
+
+	// import the state in several batches
+	defer func(size int) { maxSnapshotImportBatchSize = size }(maxSnapshotImportBatchSize)
+	maxSnapshotImportBatchSize = 1
+
+	env := newTestEnv(t)
+	defer env.cleanup()
+	blocks, bg, pendingTx := createTestLedgerForSnapshot(t)
+	lastBlockHash := protoutil.BlockHeaderHash(blocks[5].Header)
+	configHistory := []*confighistory.Entry{
+		{Namespace: "lscc", Key: "ns1~collection", BlockNum: 2, Value: []byte("collConfig2")},
+		{Namespace: "lscc", Key: "ns1~collection", BlockNum: 4, Value: []byte("collConfig4")},
+	}
+	provider := testutilNewProvider(t)
+	require.NoError(t, provider.(*Provider).configHistoryMgr.ImportConfigHistory("testLedger", configHistory))
+	provider.Close()
+
+	err = GenerateSnapshot("nonExistentLedger", snapshotDir)
+	assert.EqualError(t, err, "ledger [nonExistentLedger] does not exist")
+	require.NoError(t, GenerateSnapshot("testLedger", snapshotDir))
+	err = GenerateSnapshot("testLedger", snapshotDir)
+	assert.EqualError(t, err, fmt.Sprintf("the snapshot directory %s is not empty", snapshotDir))
+
+	// create the ledger on another peer
+	otherEnv := newTestEnv(t)
+	defer otherEnv.cleanup()
+	provider = testutilNewProviderWithCollectionConfig(t, "ns1", map[string]uint64{"coll1": 0})
+	ledger, ledgerID, err := provider.CreateFromSnapshot(snapshotDir)
+	require.NoError(t, err)
+	assert.Equal(t, "testLedger", ledgerID)
+	_, _, err = provider.CreateFromSnapshot(snapshotDir)
+	assert.Equal(t, ErrLedgerIDExists, err)
+
+	bcInfo, err := ledger.GetBlockchainInfo()
+	require.NoError(t, err)
+	assert.Equal(t, &common.BlockchainInfo{
+		Height:            6,
+		CurrentBlockHash:  lastBlockHash,
+		PreviousBlockHash: blocks[5].Header.PreviousHash,
+	}, bcInfo)
+	configBlock, err := ledger.(lgr.SnapshotConfigBlockRetriever).GetSnapshotConfigBlock()
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(blocks[0], configBlock))
+	_, err = ledger.GetBlockByNumber(3)
+	assert.Error(t, err)
+	verifySnapshotState(t, ledger, "value5")
+	assert.ElementsMatch(t, configHistory, exportTestConfigHistory(t, provider, "testLedger"))
+
+	// only the id and the validation code of the transactions of the snapshot are available
+	txID := testTxID(t, blocks[3])
+	_, err = ledger.GetTransactionByID(txID)
+	assert.Equal(t, &lgr.ErrTxBeforeSnapshot{TxID: txID, BlockNum: 3}, err)
+	_, err = ledger.GetTransactionByID("nonExistentTxID")
+	assert.IsType(t, lgr.NotFoundInIndexErr(""), err)
+
+	// the blocks after the snapshot are committed on top of the imported state, which
+	// keeps the versions of the keys the transactions were simulated against
+	block := bg.NextBlock([][]byte{pendingTx})
+	require.NoError(t, ledger.CommitWithPvtData(&lgr.BlockAndPvtData{Block: block}))
+	txFilter := lutil.TxValidationFlags(block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER])
+	assert.True(t, txFilter.IsValid(0))
+	verifySnapshotState(t, ledger, "value6")
+	ledger.Close()
+	provider.Close()
+
+	provider = testutilNewProvider(t)
+	ledgerIDs, err := provider.List()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"testLedger"}, ledgerIDs)
+	ledger, err = provider.Open("testLedger")
+	require.NoError(t, err)
+	configBlock, err = ledger.(lgr.SnapshotConfigBlockRetriever).GetSnapshotConfigBlock()
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(blocks[0], configBlock))
+	ledger.Close()
+	provider.Close()
+
+	// the snapshot of a ledger created from a snapshot carries the same config block
+	otherSnapshotDir := filepath.Join(filepath.Dir(snapshotDir), "otherLedger")
+	require.NoError(t, GenerateSnapshot("testLedger", otherSnapshotDir))
+	metadata, snapshotInfo, configBlock, err := loadSnapshot(otherSnapshotDir)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6), metadata.LastBlockNumber)
+	assert.Equal(t, protoutil.BlockHeaderHash(block.Header), snapshotInfo.LastBlockHash)
+	assert.Equal(t, lastBlockHash, snapshotInfo.PreviousBlockHash)
+	assert.True(t, proto.Equal(blocks[0], configBlock))
+}
+
+func TestCreateFromSnapshotRecovery(t *testing.T) {
+	snapshotDir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(snapshotDir)
+	snapshotDir = filepath.Join(snapshotDir, "testLedger")
+
+	env := newTestEnv(t)
+	defer env.cleanup()
+	blocks, _, _ := createTestLedgerForSnapshot(t)
+	require.NoError(t, GenerateSnapshot("testLedger", snapshotDir))
+
+	otherEnv := newTestEnv(t)
+	defer otherEnv.cleanup()
+	provider := testutilNewProvider(t)
+	p := provider.(*Provider)
+	metadata, snapshotInfo, configBlock, err := loadSnapshot(snapshotDir)
+	require.NoError(t, err)
+
+	// assume a crash happens after the state is imported but before the block store is bootstrapped
+	require.NoError(t, p.idStore.setUnderConstructionFlag("testLedger"))
+	vdb, err := p.vdbProvider.GetDBHandle("testLedger")
+	require.NoError(t, err)
+	require.NoError(t, importSnapshotState(snapshotDir, metadata, vdb))
+	require.NoError(t, p.idStore.setSnapshotConfigBlock("testLedger", configBlock))
+	provider.Close()
+
+	provider = testutilNewProvider(t)
+	p = provider.(*Provider)
+	flag, err := p.idStore.getUnderConstructionFlag()
+	require.NoError(t, err)
+	assert.Equal(t, "", flag)
+	exists, err := provider.Exists("testLedger")
+	require.NoError(t, err)
+	assert.False(t, exists)
+	snapshotConfigBlock, err := p.idStore.getSnapshotConfigBlock("testLedger")
+	require.NoError(t, err)
+	assert.Nil(t, snapshotConfigBlock)
+
+	// the creation can be retried with the same snapshot, but not with a snapshot at another block
+	otherMetadata := *metadata
+	otherMetadata.LastBlockNumber = 3
+	err = p.importSnapshot("testLedger", snapshotDir, &otherMetadata, snapshotInfo, configBlock)
+	assert.EqualError(t, err, "the state database of ledger [testLedger] is at block [5], cannot import a snapshot at block [3]")
+
+	// assume a crash happens after the block store is bootstrapped
+	require.NoError(t, p.idStore.setUnderConstructionFlag("testLedger"))
+	require.NoError(t, p.importSnapshot("testLedger", snapshotDir, metadata, snapshotInfo, configBlock))
+	provider.Close()
+
+	provider = testutilNewProviderWithCollectionConfig(t, "ns1", map[string]uint64{"coll1": 0})
+	defer provider.Close()
+	ledger, err := provider.Open("testLedger")
+	require.NoError(t, err)
+	defer ledger.Close()
+	bcInfo, err := ledger.GetBlockchainInfo()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6), bcInfo.Height)
+	assert.Equal(t, protoutil.BlockHeaderHash(blocks[5].Header), bcInfo.CurrentBlockHash)
+	verifySnapshotState(t, ledger, "value5")
+}
+
+func TestCreateFromSnapshotTamperedFiles(t *testing.T) {
+	for _, fileName := range []string{snapshotStateFileName, snapshotTxIDsFileName, snapshotConfigHistFileName} {
+		t.Run(fileName, func(t *testing.T) {
+			snapshotDir, err := ioutil.TempDir("", "snapshot")
+			require.NoError(t, err)
+			defer os.RemoveAll(snapshotDir)
+			snapshotDir = filepath.Join(snapshotDir, "testLedger")
+
+			env := newTestEnv(t)
+			defer env.cleanup()
+			createTestLedgerForSnapshot(t)
+			require.NoError(t, GenerateSnapshot("testLedger", snapshotDir))
+
+			file := filepath.Join(snapshotDir, fileName)
+			content, err := ioutil.ReadFile(file)
+			require.NoError(t, err)
+			content = append(content, 0)
+			require.NoError(t, ioutil.WriteFile(file, content, 0644))
+
+			otherEnv := newTestEnv(t)
+			defer otherEnv.cleanup()
+			provider := testutilNewProvider(t)
+			defer provider.Close()
+			_, _, err = provider.CreateFromSnapshot(snapshotDir)
+			assert.EqualError(t, err, fmt.Sprintf("error verifying the snapshot file %s: the hash does not match the hash in the metadata of the snapshot", file))
+			exists, err := provider.Exists("testLedger")
+			require.NoError(t, err)
+			assert.False(t, exists)
+			flag, err := provider.(*Provider).idStore.getUnderConstructionFlag()
+			require.NoError(t, err)
+			assert.Equal(t, "", flag)
+
+			// nothing is imported from a snapshot that was altered
+			vdb, err := provider.(*Provider).vdbProvider.GetDBHandle("testLedger")
+			require.NoError(t, err)
+			savepoint, err := vdb.GetLatestSavePoint()
+			require.NoError(t, err)
+			assert.Nil(t, savepoint)
+		})
+	}
+}
+
+func TestCreateFromSnapshotRecordSize(t *testing.T) {
+	snapshotDir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(snapshotDir)
+	snapshotDir = filepath.Join(snapshotDir, "testLedger")
+
+	env := newTestEnv(t)
+	defer env.cleanup()
+	createTestLedgerForSnapshot(t)
+	require.NoError(t, GenerateSnapshot("testLedger", snapshotDir))
+
+	otherEnv := newTestEnv(t)
+	defer otherEnv.cleanup()
+	provider := testutilNewProvider(t)
+	defer provider.Close()
+	defer func(size uint64) { maxSnapshotRecordSize = size }(maxSnapshotRecordSize)
+	maxSnapshotRecordSize = 8
+	_, _, err = provider.CreateFromSnapshot(snapshotDir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fmt.Sprintf("error reading the snapshot file %s: the size", filepath.Join(snapshotDir, snapshotStateFileName)))
+	assert.Contains(t, err.Error(), "exceeds the maximum size [8]")
+	exists, err := provider.Exists("testLedger")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestCreateFromSnapshotPartialState(t *testing.T) {
+	snapshotDir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(snapshotDir)
+	snapshotDir = filepath.Join(snapshotDir, "testLedger")
+
+	env := newTestEnv(t)
+	defer env.cleanup()
+	createTestLedgerForSnapshot(t)
+	require.NoError(t, GenerateSnapshot("testLedger", snapshotDir))
+
+	otherEnv := newTestEnv(t)
+	defer otherEnv.cleanup()
+	provider := testutilNewProvider(t)
+	defer provider.Close()
+	p := provider.(*Provider)
+	metadata, snapshotInfo, configBlock, err := loadSnapshot(snapshotDir)
+	require.NoError(t, err)
+
+	// assume a crash happened while importing the state of another snapshot
+	require.NoError(t, p.idStore.setImportingSnapshot("testLedger", "otherStateHash"))
+	err = p.importSnapshot("testLedger", snapshotDir, metadata, snapshotInfo, configBlock)
+	assert.EqualError(t, err, "the state of another snapshot was partially imported into the state database of ledger [testLedger], retry with the same snapshot")
+
+	// the import of the same snapshot is completed
+	require.NoError(t, p.idStore.setImportingSnapshot("testLedger", metadata.StateHash))
+	require.NoError(t, p.importSnapshot("testLedger", snapshotDir, metadata, snapshotInfo, configBlock))
+	importingHash, err := p.idStore.getImportingSnapshot("testLedger")
+	require.NoError(t, err)
+	assert.Equal(t, "", importingHash)
+}
+
+// createTestLedgerForSnapshot creates the ledger "testLedger" with the genesis block and five
+// blocks, each of which sets the public key "key1" and the private key "key2" of namespace "ns1".
+// It also returns a transaction simulated against the last block, which is not committed
+func createTestLedgerForSnapshot(t *testing.T) ([]*common.Block, *testutil.BlockGenerator, []byte) {
+	provider := testutilNewProviderWithCollectionConfig(t, "ns1", map[string]uint64{"coll1": 0})
+	defer provider.Close()
+	bg, gb := testutil.NewBlockGenerator(t, "testLedger", false)
+	ledger, err := provider.Create(gb)
+	require.NoError(t, err)
+	defer ledger.Close()
+
+	blocks := []*common.Block{gb}
+	for i := 1; i <= 5; i++ {
+		block := bg.NextBlock([][]byte{simulateTestTx(t, ledger, fmt.Sprintf("value%d", i))})
+		require.NoError(t, ledger.CommitWithPvtData(&lgr.BlockAndPvtData{Block: block}))
+		blocks = append(blocks, block)
+	}
+	return blocks, bg, simulateTestTx(t, ledger, "value6")
+}
+
+func simulateTestTx(t *testing.T, ledger lgr.PeerLedger, value string) []byte {
+	simulator, err := ledger.NewTxSimulator(util.GenerateUUID())
+	require.NoError(t, err)
+	_, err = simulator.GetState("ns1", "key1")
+	require.NoError(t, err)
+	require.NoError(t, simulator.SetState("ns1", "key1", []byte(value)))
+	require.NoError(t, simulator.SetPrivateData("ns1", "coll1", "key2", []byte("pvt_"+value)))
+	simulator.Done()
+	simRes, err := simulator.GetTxSimulationResults()
+	require.NoError(t, err)
+	pubSimBytes, err := simRes.GetPubSimulationBytes()
+	require.NoError(t, err)
+	return pubSimBytes
+}
+
+func exportTestConfigHistory(t *testing.T, provider lgr.PeerLedgerProvider, ledgerID string) []*confighistory.Entry {
+	var entries []*confighistory.Entry
+	require.NoError(t, provider.(*Provider).configHistoryMgr.ExportConfigHistory(ledgerID, func(entry *confighistory.Entry) error {
+		entries = append(entries, entry)
+		return nil
+	}))
+	return entries
+}
+
+func testTxID(t *testing.T, block *common.Block) string {
+	env, err := protoutil.ExtractEnvelope(block, 0)
+	require.NoError(t, err)
+	chdr, err := protoutil.ChannelHeader(env)
+	require.NoError(t, err)
+	return chdr.TxId
+}
+
+func verifySnapshotState(t *testing.T, ledger lgr.PeerLedger, value string) {
+	qe, err := ledger.NewQueryExecutor()
+	require.NoError(t, err)
+	defer qe.Done()
+	val, err := qe.GetState("ns1", "key1")
+	require.NoError(t, err)
+	assert.Equal(t, []byte(value), val)
+	hash, err := qe.GetPrivateDataHash("ns1", "coll1", "key2")
+	require.NoError(t, err)
+	assert.Equal(t, util.ComputeSHA256([]byte("pvt_"+value)), hash)
+}
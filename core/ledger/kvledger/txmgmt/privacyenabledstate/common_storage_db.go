@@ -227,6 +227,42 @@ func (s *CommonStorageDB) ApplyPrivacyAwareUpdates(updates *UpdateBatch, height
 	return s.VersionedDB.ApplyUpdates(combinedUpdates.UpdateBatch, height)
 }
 
+// ExportPubAndHashedState implements corresponding function in interface DB
+func (s *CommonStorageDB) ExportPubAndHashedState(export func(namespace, collection string, key []byte, vv *statedb.VersionedValue) error) error {
+	fullScannable, ok := s.VersionedDB.(statedb.FullScannable)
+	if !ok {
+		return errors.New("the state database does not support exporting the state")
+	}
+	itr, err := fullScannable.GetFullScanIterator()
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+	for {
+		result, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if result == nil {
+			return nil
+		}
+		kv := result.(*statedb.VersionedKV)
+		namespace, collection, isPvtData := splitDerivedNs(kv.Namespace)
+		if isPvtData {
+			continue
+		}
+		key := []byte(kv.Key)
+		if collection != "" && !s.BytesKeySupported() {
+			if key, err = base64.StdEncoding.DecodeString(kv.Key); err != nil {
+				return errors.Wrapf(err, "error decoding the hashed key [%s] of namespace [%s]", kv.Key, kv.Namespace)
+			}
+		}
+		if err := export(namespace, collection, key, &kv.VersionedValue); err != nil {
+			return err
+		}
+	}
+}
+
 // GetStateMetadata implements corresponding function in interface DB. This implementation provides
 // an optimization such that it keeps track if a namespaces has never stored metadata for any of
 // its items, the value 'nil' is returned without going to the db. This is intented to be invoked
@@ -326,6 +362,16 @@ func deriveHashedDataNs(namespace, collection string) string {
 	return namespace + nsJoiner + hashDataPrefix + collection
 }
 
+// splitDerivedNs splits a namespace of the underlying db into the namespace and the collection
+// it is derived from. The collection is empty for the namespaces of the public data
+func splitDerivedNs(derivedNs string) (namespace, collection string, isPvtData bool) {
+	split := strings.SplitN(derivedNs, nsJoiner, 2)
+	if len(split) == 1 || split[1] == "" {
+		return derivedNs, "", false
+	}
+	return split[0], split[1][1:], strings.HasPrefix(split[1], pvtDataPrefix)
+}
+
 func addPvtUpdates(pubUpdateBatch *PubUpdateBatch, pvtUpdateBatch *PvtUpdateBatch) {
 	for ns, nsBatch := range pvtUpdateBatch.UpdateMap {
 		for _, coll := range nsBatch.GetCollectionNames() {
@@ -40,6 +40,10 @@ type DB interface {
 	GetPrivateDataMetadataByHash(namespace, collection string, keyHash []byte) ([]byte, error)
 	ExecuteQueryOnPrivateData(namespace, collection, query string) (statedb.ResultsIterator, error)
 	ApplyPrivacyAwareUpdates(updates *UpdateBatch, height *version.Height) error
+	// ExportPubAndHashedState invokes export for each key of the public state and of the hashed state
+	// of the private data. For a key of the public state, collection is empty; for a hashed key, key
+	// is the hash of the key of the private data. The private data itself is not exported
+	ExportPubAndHashedState(export func(namespace, collection string, key []byte, vv *statedb.VersionedValue) error) error
 }
 
 // PvtdataCompositeKey encloses Namespace, CollectionName and Key components
@@ -18,6 +18,7 @@ import (
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
 	"github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/protos/common"
+	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 )
@@ -576,6 +577,59 @@ func testMetadataRetrieval(t *testing.T, env TestEnv) {
 	assert.Nil(t, vm)
 }
 
+func TestExportPubAndHashedState(t *testing.T) {
+	env := &LevelDBCommonStorageTestEnv{}
+	env.Init(t)
+	defer env.Cleanup()
+	db := env.GetDBHandle("test-ledger-id")
+
+	updates := NewUpdateBatch()
+	updates.PubUpdates.PutValAndMetadata("ns1", "key1", []byte("value1"), []byte("metadata1"), version.NewHeight(1, 1))
+	updates.PubUpdates.Put("ns2", "key2", []byte("value2"), version.NewHeight(1, 2))
+	putPvtUpdatesWithMetadata(t, updates, "ns1", "coll1", "key3", []byte("pvt_value3"), []byte("metadata3"), version.NewHeight(1, 3))
+	assert.NoError(t, db.ApplyPrivacyAwareUpdates(updates, version.NewHeight(1, 3)))
+
+	type exported struct {
+		namespace, collection string
+		key                   []byte
+		vv                    *statedb.VersionedValue
+	}
+	var results []exported
+	err := db.ExportPubAndHashedState(func(namespace, collection string, key []byte, vv *statedb.VersionedValue) error {
+		results = append(results, exported{namespace, collection, key, vv})
+		return nil
+	})
+	assert.NoError(t, err)
+	// the private data itself is not exported
+	assert.Equal(t, []exported{
+		{"ns1", "", []byte("key1"), &statedb.VersionedValue{Value: []byte("value1"), Metadata: []byte("metadata1"), Version: version.NewHeight(1, 1)}},
+		{"ns1", "coll1", util.ComputeStringHash("key3"), &statedb.VersionedValue{Value: util.ComputeHash([]byte("pvt_value3")), Metadata: []byte("metadata3"), Version: version.NewHeight(1, 3)}},
+		{"ns2", "", []byte("key2"), &statedb.VersionedValue{Value: []byte("value2"), Version: version.NewHeight(1, 2)}},
+	}, results)
+
+	err = db.ExportPubAndHashedState(func(namespace, collection string, key []byte, vv *statedb.VersionedValue) error {
+		return errors.New("export failed")
+	})
+	assert.EqualError(t, err, "export failed")
+}
+
+func TestSplitDerivedNs(t *testing.T) {
+	for _, testCase := range []struct {
+		derivedNs, namespace, collection string
+		isPvtData                        bool
+	}{
+		{"", "", "", false},
+		{"ns1", "ns1", "", false},
+		{derivePvtDataNs("ns1", "coll1"), "ns1", "coll1", true},
+		{deriveHashedDataNs("ns1", "coll1"), "ns1", "coll1", false},
+	} {
+		namespace, collection, isPvtData := splitDerivedNs(testCase.derivedNs)
+		assert.Equal(t, testCase.namespace, namespace)
+		assert.Equal(t, testCase.collection, collection)
+		assert.Equal(t, testCase.isPvtData, isPvtData)
+	}
+}
+
 func putPvtUpdates(t *testing.T, updates *UpdateBatch, ns, coll, key string, value []byte, ver *version.Height) {
 	updates.PvtUpdates.Put(ns, coll, key, value, ver)
 	updates.HashUpdates.Put(ns, coll, util.ComputeStringHash(key), util.ComputeHash(value), ver)
@@ -127,6 +127,38 @@ func (b *RWSetBuilder) AddToHashedMetadataWriteSet(ns, coll, key string, metadat
 		metadataWriteMap[key] = mapToMetadataWriteHash(key, metadata)
 }
 
+// GetPendingWrite returns the value in the write-set for the given key and whether
+// the key is present in the write-set. A nil value for a present key indicates a delete
+func (b *RWSetBuilder) GetPendingWrite(ns, key string) ([]byte, bool) {
+	nsPubRwBuilder, ok := b.pubRwBuilderMap[ns]
+	if !ok {
+		return nil, false
+	}
+	kvWrite, ok := nsPubRwBuilder.writeMap[key]
+	if !ok {
+		return nil, false
+	}
+	return kvWrite.Value, true
+}
+
+// GetPendingPvtWrite returns the value in the private write-set for the given key and
+// whether the key is present in the private write-set. A nil value for a present key indicates a delete
+func (b *RWSetBuilder) GetPendingPvtWrite(ns, coll, key string) ([]byte, bool) {
+	nsPvtRwBuilder, ok := b.pvtRwBuilderMap[ns]
+	if !ok {
+		return nil, false
+	}
+	collPvtRwBuilder, ok := nsPvtRwBuilder.collPvtRwBuilders[coll]
+	if !ok {
+		return nil, false
+	}
+	kvWrite, ok := collPvtRwBuilder.writeMap[key]
+	if !ok {
+		return nil, false
+	}
+	return kvWrite.Value, true
+}
+
 // GetTxSimulationResults returns the proto bytes of public rwset
 // (public data + hashes of private data) and the private rwset for the transaction
 func (b *RWSetBuilder) GetTxSimulationResults() (*ledger.TxSimulationResults, error) {
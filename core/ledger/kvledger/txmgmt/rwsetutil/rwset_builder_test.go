@@ -357,3 +357,41 @@ func serializeTestProtoMsg(t *testing.T, protoMsg proto.Message) []byte {
 	assert.NoError(t, err)
 	return msgBytes
 }
+
+func TestGetPendingWrite(t *testing.T) {
+	rwSetBuilder := NewRWSetBuilder()
+	rwSetBuilder.AddToReadSet("ns1", "key1", version.NewHeight(1, 1))
+	rwSetBuilder.AddToWriteSet("ns1", "key2", []byte("value2"))
+	rwSetBuilder.AddToWriteSet("ns1", "key3", nil)
+	rwSetBuilder.AddToPvtAndHashedWriteSet("ns1", "coll1", "key1", []byte("pvt-value1"))
+	rwSetBuilder.AddToPvtAndHashedWriteSet("ns1", "coll1", "key2", nil)
+
+	value, ok := rwSetBuilder.GetPendingWrite("ns1", "key1")
+	assert.False(t, ok)
+	assert.Nil(t, value)
+	value, ok = rwSetBuilder.GetPendingWrite("ns1", "key2")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("value2"), value)
+	value, ok = rwSetBuilder.GetPendingWrite("ns1", "key3")
+	assert.True(t, ok)
+	assert.Nil(t, value)
+	_, ok = rwSetBuilder.GetPendingWrite("ns2", "key2")
+	assert.False(t, ok)
+
+	value, ok = rwSetBuilder.GetPendingPvtWrite("ns1", "coll1", "key1")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("pvt-value1"), value)
+	value, ok = rwSetBuilder.GetPendingPvtWrite("ns1", "coll1", "key2")
+	assert.True(t, ok)
+	assert.Nil(t, value)
+	_, ok = rwSetBuilder.GetPendingPvtWrite("ns1", "coll2", "key1")
+	assert.False(t, ok)
+	_, ok = rwSetBuilder.GetPendingPvtWrite("ns2", "coll1", "key1")
+	assert.False(t, ok)
+
+	// lookups should not create entries in the rwset
+	_, ok = rwSetBuilder.pubRwBuilderMap["ns2"]
+	assert.False(t, ok)
+	_, ok = rwSetBuilder.pvtRwBuilderMap["ns2"]
+	assert.False(t, ok)
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
 	"github.com/hyperledger/fabric/core/common/ccprovider"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
@@ -47,6 +48,30 @@ func NewVersionedDBProvider(metricsProvider metrics.Provider) (*VersionedDBProvi
 	return &VersionedDBProvider{couchInstance, make(map[string]*VersionedDB), sync.Mutex{}, 0}, nil
 }
 
+// DropApplicationDBs drops all the application databases in CouchDB, which hold the
+// state of the channels. This is meant to be invoked while the peer is not running,
+// so that the state is rebuilt from the blocks when the ledgers are next opened.
+func DropApplicationDBs() error {
+	logger.Info("Dropping CouchDB application databases ...")
+	couchDBDef := couchdb.GetCouchDBDefinition()
+	couchInstance, err := couchdb.CreateCouchInstance(couchDBDef.URL, couchDBDef.Username, couchDBDef.Password,
+		couchDBDef.MaxRetries, couchDBDef.MaxRetriesOnStartup, couchDBDef.RequestTimeout, couchDBDef.CreateGlobalChangesDB, &disabled.Provider{})
+	if err != nil {
+		return err
+	}
+	dbNames, err := couchInstance.RetrieveApplicationDBNames()
+	if err != nil {
+		return err
+	}
+	for _, dbName := range dbNames {
+		db := &couchdb.CouchDatabase{CouchInstance: couchInstance, DBName: dbName}
+		if _, err := db.DropDatabase(); err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("error dropping CouchDB database [%s]", dbName))
+		}
+	}
+	return nil
+}
+
 // GetDBHandle gets the handle to a named database
 func (provider *VersionedDBProvider) GetDBHandle(dbName string) (statedb.VersionedDB, error) {
 	provider.mux.Lock()
@@ -88,6 +88,14 @@ type IndexCapable interface {
 	ProcessIndexesForChaincodeDeploy(namespace string, fileEntries []*ccprovider.TarFileEntry) error
 }
 
+//FullScannable interface provides additional functions for
+//databases capable of iterating over the keys of all namespaces
+type FullScannable interface {
+	// GetFullScanIterator returns an iterator over the keys of all namespaces, ordered
+	// by namespace and key. The returned ResultsIterator contains results of type *VersionedKV
+	GetFullScanIterator() (ResultsIterator, error)
+}
+
 // CompositeKey encloses Namespace and Key components
 type CompositeKey struct {
 	Namespace string
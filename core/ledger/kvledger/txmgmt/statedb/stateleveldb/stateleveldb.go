@@ -212,6 +212,11 @@ func (vdb *versionedDB) GetLatestSavePoint() (*version.Height, error) {
 	return version, nil
 }
 
+// GetFullScanIterator implements method in FullScannable interface
+func (vdb *versionedDB) GetFullScanIterator() (statedb.ResultsIterator, error) {
+	return &fullScanner{vdb.db.GetIterator(nil, nil)}, nil
+}
+
 func constructCompositeKey(ns string, key string) []byte {
 	return append(append([]byte(ns), compositeKeySep...), []byte(key)...)
 }
@@ -275,3 +280,33 @@ func (scanner *kvScanner) GetBookmarkAndClose() string {
 	scanner.Close()
 	return retval
 }
+
+// fullScanner iterates over the keys of all namespaces, skipping the savepoint
+type fullScanner struct {
+	dbItr iterator.Iterator
+}
+
+func (scanner *fullScanner) Next() (statedb.QueryResult, error) {
+	for scanner.dbItr.Next() {
+		dbKey := scanner.dbItr.Key()
+		if bytes.Equal(dbKey, savePointKey) {
+			continue
+		}
+		dbVal := scanner.dbItr.Value()
+		dbValCopy := make([]byte, len(dbVal))
+		copy(dbValCopy, dbVal)
+		namespace, key := splitCompositeKey(dbKey)
+		vv, err := decodeValue(dbValCopy)
+		if err != nil {
+			return nil, err
+		}
+		return &statedb.VersionedKV{
+			CompositeKey:   statedb.CompositeKey{Namespace: namespace, Key: key},
+			VersionedValue: *vv}, nil
+	}
+	return nil, nil
+}
+
+func (scanner *fullScanner) Close() {
+	scanner.dbItr.Release()
+}
@@ -136,3 +136,54 @@ func TestApplyUpdatesWithNilHeight(t *testing.T) {
 	defer env.Cleanup()
 	commontests.TestApplyUpdatesWithNilHeight(t, env.DBProvider)
 }
+
+func TestFullScanIterator(t *testing.T) {
+	env := NewTestVDBEnv(t)
+	defer env.Cleanup()
+	db, err := env.DBProvider.GetDBHandle("testfullscan")
+	assert.NoError(t, err)
+	otherDB, err := env.DBProvider.GetDBHandle("testfullscan-other")
+	assert.NoError(t, err)
+
+	batch := statedb.NewUpdateBatch()
+	batch.Put("", "peerkey", []byte("value0"), version.NewHeight(1, 0))
+	batch.Put("ns2", "key1", []byte("value3"), version.NewHeight(1, 3))
+	batch.Put("ns1", "key2", []byte("value2"), version.NewHeight(1, 2))
+	batch.PutValAndMetadata("ns1", "key1", []byte("value1"), []byte("metadata1"), version.NewHeight(1, 1))
+	assert.NoError(t, db.ApplyUpdates(batch, version.NewHeight(1, 3)))
+	otherBatch := statedb.NewUpdateBatch()
+	otherBatch.Put("ns1", "otherkey", []byte("othervalue"), version.NewHeight(1, 0))
+	assert.NoError(t, otherDB.ApplyUpdates(otherBatch, version.NewHeight(1, 0)))
+
+	itr, err := db.(statedb.FullScannable).GetFullScanIterator()
+	assert.NoError(t, err)
+	defer itr.Close()
+	var results []*statedb.VersionedKV
+	for {
+		result, err := itr.Next()
+		assert.NoError(t, err)
+		if result == nil {
+			break
+		}
+		results = append(results, result.(*statedb.VersionedKV))
+	}
+	// the savepoint and the keys of other databases are not returned
+	assert.Equal(t, []*statedb.VersionedKV{
+		{
+			CompositeKey:   statedb.CompositeKey{Namespace: "", Key: "peerkey"},
+			VersionedValue: statedb.VersionedValue{Value: []byte("value0"), Version: version.NewHeight(1, 0)},
+		},
+		{
+			CompositeKey:   statedb.CompositeKey{Namespace: "ns1", Key: "key1"},
+			VersionedValue: statedb.VersionedValue{Value: []byte("value1"), Metadata: []byte("metadata1"), Version: version.NewHeight(1, 1)},
+		},
+		{
+			CompositeKey:   statedb.CompositeKey{Namespace: "ns1", Key: "key2"},
+			VersionedValue: statedb.VersionedValue{Value: []byte("value2"), Version: version.NewHeight(1, 2)},
+		},
+		{
+			CompositeKey:   statedb.CompositeKey{Namespace: "ns2", Key: "key1"},
+			VersionedValue: statedb.VersionedValue{Value: []byte("value3"), Version: version.NewHeight(1, 3)},
+		},
+	}, results)
+}
@@ -28,6 +28,23 @@ func (q *lockBasedQueryExecutor) GetState(ns string, key string) (val []byte, er
 	return
 }
 
+// GetStateHeight implements method in interface `ledger.HeightReportingQueryExecutor`.
+// The state cannot change until the query executor is done, as it holds the
+// read lock of the transaction manager.
+func (q *lockBasedQueryExecutor) GetStateHeight() (uint64, error) {
+	if err := q.helper.checkDone(); err != nil {
+		return 0, err
+	}
+	savepoint, err := q.helper.txmgr.GetLastSavepoint()
+	if err != nil {
+		return 0, err
+	}
+	if savepoint == nil {
+		return 0, nil
+	}
+	return savepoint.BlockNum + 1, nil
+}
+
 // GetStateMetadata implements method in interface `ledger.QueryExecutor`
 func (q *lockBasedQueryExecutor) GetStateMetadata(namespace, key string) (map[string][]byte, error) {
 	return q.helper.getStateMetadata(namespace, key)
@@ -98,6 +98,24 @@ func (s *lockBasedTxSimulator) SetPrivateDataMultipleKeys(ns, coll string, kvs m
 	return nil
 }
 
+// GetPendingState implements method in interface `ledger.TxSimulator`
+func (s *lockBasedTxSimulator) GetPendingState(ns string, key string) ([]byte, bool, error) {
+	if err := s.helper.checkDone(); err != nil {
+		return nil, false, err
+	}
+	value, written := s.rwsetBuilder.GetPendingWrite(ns, key)
+	return value, written, nil
+}
+
+// GetPendingPrivateData implements method in interface `ledger.TxSimulator`
+func (s *lockBasedTxSimulator) GetPendingPrivateData(ns, coll, key string) ([]byte, bool, error) {
+	if err := s.helper.checkDone(); err != nil {
+		return nil, false, err
+	}
+	value, written := s.rwsetBuilder.GetPendingPvtWrite(ns, coll, key)
+	return value, written, nil
+}
+
 // GetPrivateDataRangeScanIterator implements method in interface `ledger.TxSimulator`
 func (s *lockBasedTxSimulator) GetPrivateDataRangeScanIterator(namespace, collection, startKey, endKey string) (commonledger.ResultsIterator, error) {
 	if err := s.checkBeforePvtdataQueries(); err != nil {
@@ -122,6 +122,67 @@ func TestTxSimulatorGetResults(t *testing.T) {
 	assert.Errorf(t, err, "An error is expected when using simulator to get/set data after calling `Done` function()")
 }
 
+func TestTxSimulatorPendingWrites(t *testing.T) {
+	testEnv := testEnvsMap[levelDBtestEnvName]
+	testEnv.init(t, "testLedger", nil)
+	defer testEnv.cleanup()
+	txMgr := testEnv.getTxMgr()
+	populateCollConfigForTest(t, txMgr.(*LockBasedTxMgr),
+		[]collConfigkey{{"ns1", "coll1"}},
+		version.NewHeight(1, 1),
+	)
+
+	simulator, _ := txMgr.NewTxSimulator("test_txid1")
+	value, written, err := simulator.GetPendingState("ns1", "key1")
+	assert.NoError(t, err)
+	assert.False(t, written)
+	assert.Nil(t, value)
+
+	simulator.SetState("ns1", "key1", []byte("value1"))
+	simulator.DeleteState("ns1", "key2")
+	simulator.SetPrivateData("ns1", "coll1", "key1", []byte("pvt-value1"))
+
+	value, written, err = simulator.GetPendingState("ns1", "key1")
+	assert.NoError(t, err)
+	assert.True(t, written)
+	assert.Equal(t, []byte("value1"), value)
+
+	value, written, err = simulator.GetPendingState("ns1", "key2")
+	assert.NoError(t, err)
+	assert.True(t, written)
+	assert.Nil(t, value)
+
+	value, written, err = simulator.GetPendingState("ns2", "key1")
+	assert.NoError(t, err)
+	assert.False(t, written)
+	assert.Nil(t, value)
+
+	value, written, err = simulator.GetPendingPrivateData("ns1", "coll1", "key1")
+	assert.NoError(t, err)
+	assert.True(t, written)
+	assert.Equal(t, []byte("pvt-value1"), value)
+
+	value, written, err = simulator.GetPendingPrivateData("ns1", "coll2", "key1")
+	assert.NoError(t, err)
+	assert.False(t, written)
+	assert.Nil(t, value)
+
+	// reading the pending writes should not add to the read-set
+	simulationResults, err := simulator.GetTxSimulationResults()
+	assert.NoError(t, err)
+	txRWSet, err := rwsetutil.TxRwSetFromProtoMsg(simulationResults.PubSimulationResults)
+	assert.NoError(t, err)
+	assert.Len(t, txRWSet.NsRwSets, 1)
+	assert.Len(t, txRWSet.NsRwSets[0].KvRwSet.Reads, 0)
+	assert.Len(t, txRWSet.NsRwSets[0].KvRwSet.Writes, 2)
+
+	simulator.Done()
+	_, _, err = simulator.GetPendingState("ns1", "key1")
+	assert.EqualError(t, err, "this instance should not be used after calling Done()")
+	_, _, err = simulator.GetPendingPrivateData("ns1", "coll1", "key1")
+	assert.EqualError(t, err, "this instance should not be used after calling Done()")
+}
+
 func TestTxSimulatorWithExistingData(t *testing.T) {
 	for _, testEnv := range testEnvs {
 		t.Run(testEnv.getName(), func(t *testing.T) {
@@ -175,6 +236,40 @@ func testTxSimulatorWithExistingData(t *testing.T, env testEnv) {
 	assert.Equal(t, version.NewHeight(1, 0), vv.Version)
 }
 
+func TestTxSimulatorStateHeight(t *testing.T) {
+	for _, testEnv := range testEnvs {
+		t.Run(testEnv.getName(), func(t *testing.T) {
+			testLedgerID := "testtxsimulatorstateheight"
+			testEnv.init(t, testLedgerID, nil)
+			testTxSimulatorStateHeight(t, testEnv)
+			testEnv.cleanup()
+		})
+	}
+}
+
+func testTxSimulatorStateHeight(t *testing.T, env testEnv) {
+	txMgr := env.getTxMgr()
+	txMgrHelper := newTxMgrTestHelper(t, txMgr)
+
+	s1, _ := txMgr.NewTxSimulator("test_tx1")
+	height, err := s1.(ledger.HeightReportingQueryExecutor).GetStateHeight()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), height)
+	s1.SetState("ns1", "key1", []byte("value1"))
+	s1.Done()
+	_, err = s1.(ledger.HeightReportingQueryExecutor).GetStateHeight()
+	assert.EqualError(t, err, "this instance should not be used after calling Done()")
+	txRWSet1, _ := s1.GetTxSimulationResults()
+	txMgrHelper.validateAndCommitRWSet(txRWSet1.PubSimulationResults)
+
+	// the block generator starts at block 1
+	qe, _ := txMgr.NewQueryExecutor("test_tx2")
+	height, err = qe.(ledger.HeightReportingQueryExecutor).GetStateHeight()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), height)
+	qe.Done()
+}
+
 func TestTxValidation(t *testing.T) {
 	for _, testEnv := range testEnvs {
 		t.Logf("Running test for TestEnv = %s", testEnv.getName())
@@ -8,6 +8,7 @@ package ledger
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric-lib-go/healthz"
@@ -24,6 +25,7 @@ type Initializer struct {
 	StateListeners                []StateListener
 	DeployedChaincodeInfoProvider DeployedChaincodeInfoProvider
 	MembershipInfoProvider        MembershipInfoProvider
+	CollElgListener               CollElgListener
 	MetricsProvider               metrics.Provider
 	HealthCheckRegistry           HealthCheckRegistry
 }
@@ -35,6 +37,11 @@ type PeerLedgerProvider interface {
 	// This function guarantees that the creation of ledger and committing the genesis block would an atomic action
 	// The chain id retrieved from the genesis block is treated as a ledger id
 	Create(genesisBlock *common.Block) (PeerLedger, error)
+	// CreateFromSnapshot creates a new ledger from the snapshot in the given directory,
+	// and returns it along with its id. The ledger holds the public state and the hashes
+	// of the private data of the snapshot, and starts at the block after the last block
+	// of the snapshot
+	CreateFromSnapshot(snapshotDir string) (PeerLedger, string, error)
 	// Open opens an already created ledger
 	Open(ledgerID string) (PeerLedger, error)
 	// Exists tells whether the ledger with given id exists
@@ -45,6 +52,15 @@ type PeerLedgerProvider interface {
 	Close()
 }
 
+// SnapshotConfigBlockRetriever is implemented by the peer ledgers that can be created
+// from a snapshot. Such a ledger does not hold the blocks up to the last block of the
+// snapshot, so the latest config block as of the snapshot is kept along with the ledger
+type SnapshotConfigBlockRetriever interface {
+	// GetSnapshotConfigBlock returns the latest config block as of the snapshot
+	// the ledger was created from, or nil if it was created from a genesis block
+	GetSnapshotConfigBlock() (*common.Block, error)
+}
+
 // PeerLedger differs from the OrdererLedger in that PeerLedger locally maintain a bitmask
 // that tells apart valid transactions from invalid ones
 type PeerLedger interface {
@@ -57,6 +73,9 @@ type PeerLedger interface {
 	GetBlockByTxID(txID string) (*common.Block, error)
 	// GetTxValidationCodeByTxID returns reason code of transaction validation
 	GetTxValidationCodeByTxID(txID string) (peer.TxValidationCode, error)
+	// GetBlockNumberByTime returns the lowest number of the blocks whose
+	// timestamp is not before the given time
+	GetBlockNumberByTime(t time.Time) (uint64, error)
 	// NewTxSimulator gives handle to a transaction simulator.
 	// A client can obtain more than one 'TxSimulator's for parallel execution.
 	// Any snapshoting/synchronization should be performed at the implementation level if required
@@ -172,6 +191,25 @@ type HistoryQueryExecutor interface {
 	GetHistoryForKey(namespace string, key string) (commonledger.ResultsIterator, error)
 }
 
+// HistoricalStateQueryExecutor is a HistoryQueryExecutor that also retrieves
+// the value that a key had at a past height of the ledger
+type HistoricalStateQueryExecutor interface {
+	HistoryQueryExecutor
+	// GetStateAtHeight returns the value of the key written by the last valid
+	// transaction at or below transaction tranNum of block blockNum, or nil
+	// if the key did not exist or was deleted at that height.
+	GetStateAtHeight(namespace, key string, blockNum, tranNum uint64) ([]byte, error)
+}
+
+// HeightReportingQueryExecutor is a QueryExecutor that also reports the
+// height of the ledger whose state it queries
+type HeightReportingQueryExecutor interface {
+	QueryExecutor
+	// GetStateHeight returns the height of the ledger, that is the number of
+	// committed blocks, whose state the query executor queries
+	GetStateHeight() (uint64, error)
+}
+
 // TxSimulator simulates a transaction on a consistent snapshot of the 'as recent state as possible'
 // Set* methods are for supporting KV-based data model. ExecuteUpdate method is for supporting a rich datamodel and query support
 type TxSimulator interface {
@@ -198,6 +236,12 @@ type TxSimulator interface {
 	SetPrivateDataMetadata(namespace, collection, key string, metadata map[string][]byte) error
 	// DeletePrivateDataMetadata deletes the metadata associated with an existing key-tuple <namespace, collection, key>
 	DeletePrivateDataMetadata(namespace, collection, key string) error
+	// GetPendingState returns the value written for the given namespace and key earlier in this simulation.
+	// The returned bool is false if the key has not been written; a nil value for a written key indicates a delete
+	GetPendingState(namespace, key string) ([]byte, bool, error)
+	// GetPendingPrivateData returns the value written for the given tuple <namespace, collection, key> earlier in this simulation.
+	// The returned bool is false if the key has not been written; a nil value for a written key indicates a delete
+	GetPendingPrivateData(namespace, collection, key string) ([]byte, bool, error)
 	// GetTxSimulationResults encapsulates the results of the transaction simulation.
 	// This should contain enough detail for
 	// - The update in the state that would be caused if the transaction is to be committed
@@ -433,6 +477,18 @@ func (NotFoundInIndexErr) Error() string {
 	return "Entry not found in index"
 }
 
+// ErrTxBeforeSnapshot is returned when a transaction is looked up by its id in a ledger
+// created from a snapshot, and the transaction was committed up to the last block of the
+// snapshot. Only the id and the validation code of such a transaction are available
+type ErrTxBeforeSnapshot struct {
+	TxID     string
+	BlockNum uint64
+}
+
+func (e *ErrTxBeforeSnapshot) Error() string {
+	return fmt.Sprintf("transaction [%s] is not available, it was committed in block [%d] of the snapshot the ledger was created from", e.TxID, e.BlockNum)
+}
+
 // CollConfigNotDefinedError is returned whenever an operation
 // is requested on a collection whose config has not been defined
 type CollConfigNotDefinedError struct {
@@ -530,6 +586,14 @@ type MembershipInfoProvider interface {
 	AmMemberOf(channelName string, collectionPolicyConfig *common.CollectionPolicyConfig) (bool, error)
 }
 
+// CollElgListener is a dependency that is notified by ledger when the current peer becomes eligible for
+// existing collections, once the private data that the peer missed for these collections can be reconciled.
+// Gossip module is expected to provide the dependency to ledger
+type CollElgListener interface {
+	// CollsEligibilityEnabled is invoked when the peer becomes eligible for existing collections of the given ledger
+	CollsEligibilityEnabled(ledgerID string)
+}
+
 type HealthCheckRegistry interface {
 	RegisterChecker(string, healthz.HealthChecker) error
 }
@@ -31,6 +31,7 @@ const confBookkeeper = "bookkeeper"
 const confConfigHistory = "configHistory"
 const confChains = "chains"
 const confPvtdataStore = "pvtdataStore"
+const confFileLock = "fileLock"
 const confTotalQueryLimit = "ledger.state.totalQueryLimit"
 const confInternalQueryLimit = "ledger.state.couchDBConfig.internalQueryLimit"
 const confEnableHistoryDatabase = "ledger.history.enableHistoryDatabase"
@@ -83,6 +84,12 @@ func GetConfigHistoryPath() string {
 	return filepath.Join(GetRootPath(), confConfigHistory)
 }
 
+// GetFileLockPath returns the filesystem path that is used to create a file lock, which
+// prevents the ledgers from being opened by more than one process at a time
+func GetFileLockPath() string {
+	return filepath.Join(GetRootPath(), confFileLock)
+}
+
 // GetMaxBlockfileSize returns maximum size of the block file
 func GetMaxBlockfileSize() int {
 	return 64 * 1024 * 1024
@@ -44,6 +44,7 @@ type Initializer struct {
 	PlatformRegistry              *platforms.Registry
 	DeployedChaincodeInfoProvider ledger.DeployedChaincodeInfoProvider
 	MembershipInfoProvider        ledger.MembershipInfoProvider
+	CollElgListener               ledger.CollElgListener
 	MetricsProvider               metrics.Provider
 	HealthCheckRegistry           ledger.HealthCheckRegistry
 }
@@ -75,6 +76,7 @@ func initialize(initializer *Initializer) {
 		StateListeners:                finalStateListeners,
 		DeployedChaincodeInfoProvider: initializer.DeployedChaincodeInfoProvider,
 		MembershipInfoProvider:        initializer.MembershipInfoProvider,
+		CollElgListener:               initializer.CollElgListener,
 		MetricsProvider:               initializer.MetricsProvider,
 		HealthCheckRegistry:           initializer.HealthCheckRegistry,
 	})
@@ -107,6 +109,26 @@ func CreateLedger(genesisBlock *common.Block) (ledger.PeerLedger, error) {
 	return l, nil
 }
 
+// CreateLedgerFromSnapshot creates a new ledger from the snapshot in the given directory,
+// and returns it along with its id. The channel name in the snapshot is treated as a ledger id
+func CreateLedgerFromSnapshot(snapshotDir string) (ledger.PeerLedger, string, error) {
+	lock.Lock()
+	defer lock.Unlock()
+	if !initialized {
+		return nil, "", ErrLedgerMgmtNotInitialized
+	}
+
+	logger.Infof("Creating ledger from the snapshot in %s", snapshotDir)
+	l, id, err := ledgerProvider.CreateFromSnapshot(snapshotDir)
+	if err != nil {
+		return nil, "", err
+	}
+	l = wrapLedger(id, l)
+	openedLedgers[id] = l
+	logger.Infof("Created ledger [%s] from the snapshot", id)
+	return l, id, nil
+}
+
 // OpenLedger returns a ledger for the given id
 func OpenLedger(id string) (ledger.PeerLedger, error) {
 	logger.Infof("Opening ledger with id = %s", id)
@@ -177,6 +199,16 @@ func (l *closableLedger) closeWithoutLock() {
 	delete(openedLedgers, l.id)
 }
 
+// GetSnapshotConfigBlock returns the latest config block as of the snapshot the actual ledger was
+// created from, or nil if it was created from a genesis block or is not created from snapshots
+func (l *closableLedger) GetSnapshotConfigBlock() (*common.Block, error) {
+	retriever, ok := l.PeerLedger.(ledger.SnapshotConfigBlockRetriever)
+	if !ok {
+		return nil, nil
+	}
+	return retriever.GetSnapshotConfigBlock()
+}
+
 // lscc namespace listener for chaincode instantiate transactions (which manipulates data in 'lscc' namespace)
 // this code should be later moved to peer and passed via `Initialize` function of ledgermgmt
 func addListenerForCCEventsHandler(
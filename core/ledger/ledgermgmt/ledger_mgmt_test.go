@@ -49,6 +49,10 @@ func TestLedgerMgmt(t *testing.T) {
 	assert.Nil(t, l)
 	assert.Equal(t, ErrLedgerMgmtNotInitialized, err)
 
+	l, _, err = CreateLedgerFromSnapshot("/path/to/snapshot")
+	assert.Nil(t, l)
+	assert.Equal(t, ErrLedgerMgmtNotInitialized, err)
+
 	ids, err := GetLedgerIDs()
 	assert.Nil(t, ids)
 	assert.Equal(t, ErrLedgerMgmtNotInitialized, err)
@@ -81,6 +85,9 @@ func TestLedgerMgmt(t *testing.T) {
 	l.Close()
 	l, err = OpenLedger(ledgerID)
 	assert.NoError(t, err)
+	configBlock, err := l.(ledger.SnapshotConfigBlockRetriever).GetSnapshotConfigBlock()
+	assert.NoError(t, err)
+	assert.Nil(t, configBlock)
 
 	l, err = OpenLedger(ledgerID)
 	assert.Equal(t, ErrLedgerAlreadyOpened, err)
@@ -0,0 +1,49 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ledgerstorage
+
+import (
+	"time"
+
+	"github.com/hyperledger/fabric/common/metrics"
+)
+
+type stats struct {
+	pvtdataCommitTime metrics.Histogram
+}
+
+func newStats(metricsProvider metrics.Provider) *stats {
+	stats := &stats{}
+	stats.pvtdataCommitTime = metricsProvider.NewHistogram(pvtdataCommitTimeOpts)
+	return stats
+}
+
+type ledgerStats struct {
+	stats    *stats
+	ledgerid string
+}
+
+func (s *stats) ledgerStats(ledgerid string) *ledgerStats {
+	return &ledgerStats{
+		s, ledgerid,
+	}
+}
+
+func (s *ledgerStats) updatePvtdataCommitTime(timeTaken time.Duration) {
+	s.stats.pvtdataCommitTime.With("channel", s.ledgerid).Observe(timeTaken.Seconds())
+}
+
+var (
+	pvtdataCommitTimeOpts = metrics.HistogramOpts{
+		Namespace:    "ledger",
+		Subsystem:    "",
+		Name:         "pvtdata_commit_time",
+		Help:         "Time taken in seconds for committing the private data of the block to the private data store.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+		Buckets:      []float64{0.005, 0.01, 0.015, 0.05, 0.1, 1, 10},
+	}
+)
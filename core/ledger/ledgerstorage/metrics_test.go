@@ -0,0 +1,48 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ledgerstorage
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/hyperledger/fabric/common/metrics/metricsfakes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatsPvtdataCommit(t *testing.T) {
+	testEnv := newTestEnv(t)
+	defer testEnv.cleanup()
+
+	fakePvtdataCommitTimeHist := &metricsfakes.Histogram{}
+	fakePvtdataCommitTimeHist.WithReturns(fakePvtdataCommitTimeHist)
+	fakeProvider := &metricsfakes.Provider{}
+	fakeProvider.NewHistogramStub = func(opts metrics.HistogramOpts) metrics.Histogram {
+		if opts.Name == pvtdataCommitTimeOpts.Name {
+			return fakePvtdataCommitTimeHist
+		}
+		// histograms of the block store
+		fakeHist := &metricsfakes.Histogram{}
+		fakeHist.WithReturns(fakeHist)
+		return fakeHist
+	}
+
+	provider := NewProvider(fakeProvider)
+	defer provider.Close()
+	store, err := provider.Open("testLedger")
+	assert.NoError(t, err)
+	store.Init(btlPolicyForSampleData())
+	defer store.Shutdown()
+
+	sampleData := sampleDataWithPvtdataForSelectiveTx(t)
+	for _, sampleDatum := range sampleData {
+		assert.NoError(t, store.CommitWithPvtData(sampleDatum))
+	}
+
+	// the private data of each block is committed, even when the block has none
+	assert.Equal(t, len(sampleData), fakePvtdataCommitTimeHist.ObserveCallCount())
+	assert.Equal(t, []string{"channel", "testLedger"}, fakePvtdataCommitTimeHist.WithArgsForCall(0))
+}
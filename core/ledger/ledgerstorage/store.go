@@ -8,10 +8,12 @@ package ledgerstorage
 
 import (
 	"sync"
+	"time"
 
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/ledger/blkstorage"
 	"github.com/hyperledger/fabric/common/ledger/blkstorage/fsblkstorage"
+	"github.com/hyperledger/fabric/common/metrics"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
 	"github.com/hyperledger/fabric/core/ledger/pvtdatapolicy"
@@ -27,6 +29,7 @@ var logger = flogging.MustGetLogger("ledgerstorage")
 type Provider struct {
 	blkStoreProvider     blkstorage.BlockStoreProvider
 	pvtdataStoreProvider pvtdatastorage.Provider
+	stats                *stats
 }
 
 // Store encapsulates two stores 1) block store and pvt data store
@@ -34,10 +37,11 @@ type Store struct {
 	blkstorage.BlockStore
 	pvtdataStore pvtdatastorage.Store
 	rwlock       *sync.RWMutex
+	stats        *ledgerStats
 }
 
 // NewProvider returns the handle to the provider
-func NewProvider() *Provider {
+func NewProvider(metricsProvider metrics.Provider) *Provider {
 	// Initialize the block storage
 	attrsToIndex := []blkstorage.IndexableAttr{
 		blkstorage.IndexableAttrBlockHash,
@@ -46,14 +50,16 @@ func NewProvider() *Provider {
 		blkstorage.IndexableAttrBlockNumTranNum,
 		blkstorage.IndexableAttrBlockTxID,
 		blkstorage.IndexableAttrTxValidationCode,
+		blkstorage.IndexableAttrBlockTime,
 	}
 	indexConfig := &blkstorage.IndexConfig{AttrsToIndex: attrsToIndex}
 	blockStoreProvider := fsblkstorage.NewProvider(
 		fsblkstorage.NewConf(ledgerconfig.GetBlockStorePath(), ledgerconfig.GetMaxBlockfileSize()),
-		indexConfig)
+		indexConfig,
+		metricsProvider)
 
 	pvtStoreProvider := pvtdatastorage.NewProvider()
-	return &Provider{blockStoreProvider, pvtStoreProvider}
+	return &Provider{blockStoreProvider, pvtStoreProvider, newStats(metricsProvider)}
 }
 
 // Open opens the store
@@ -68,13 +74,21 @@ func (p *Provider) Open(ledgerid string) (*Store, error) {
 	if pvtdataStore, err = p.pvtdataStoreProvider.OpenStore(ledgerid); err != nil {
 		return nil, err
 	}
-	store := &Store{blockStore, pvtdataStore, &sync.RWMutex{}}
+	store := &Store{blockStore, pvtdataStore, &sync.RWMutex{}, p.stats.ledgerStats(ledgerid)}
 	if err := store.init(); err != nil {
 		return nil, err
 	}
 	return store, nil
 }
 
+// Bootstrap creates the block store of a new ledger, which starts after the last
+// block of the given snapshot and indexes the given transaction ids of the snapshot.
+// The pvt data store of the ledger is brought to the height of the block store when
+// the store is opened
+func (p *Provider) Bootstrap(ledgerid string, info *blkstorage.SnapshotInfo, txIDs blkstorage.SnapshotTxIDIterator) error {
+	return p.blkStoreProvider.BootstrapBlockStore(ledgerid, info, txIDs)
+}
+
 // Close closes the provider
 func (p *Provider) Close() {
 	p.blkStoreProvider.Close()
@@ -97,6 +111,7 @@ func (s *Store) CommitWithPvtData(blockAndPvtdata *ledger.BlockAndPvtData) error
 		return err
 	}
 
+	var pvtdataCommitTime time.Duration
 	writtenToPvtStore := false
 	if pvtBlkStoreHt < blockNum+1 { // The pvt data store sanity check does not allow rewriting the pvt data.
 		// when re-processing blocks (rejoin the channel or re-fetching last few block),
@@ -120,10 +135,12 @@ func (s *Store) CommitWithPvtData(blockAndPvtdata *ledger.BlockAndPvtData) error
 		// RemoveStaleAndCommitPvtDataOfOldBlocks() in stateDB txmgr expects only
 		// valid transactions' pvtdata. Hence, it is necessary to rebuild pvtdatastore
 		// along with the blockstore to keep only valid tx data in the pvtdatastore.
+		startPrepare := time.Now()
 		validTxPvtData, validTxMissingPvtData := constructValidTxPvtDataAndMissingData(blockAndPvtdata)
 		if err := s.pvtdataStore.Prepare(blockAndPvtdata.Block.Header.Number, validTxPvtData, validTxMissingPvtData); err != nil {
 			return err
 		}
+		pvtdataCommitTime = time.Since(startPrepare)
 		writtenToPvtStore = true
 	} else {
 		logger.Debugf("Skipping writing block [%d] to pvt block store as the store height is [%d]", blockNum, pvtBlkStoreHt)
@@ -135,7 +152,11 @@ func (s *Store) CommitWithPvtData(blockAndPvtdata *ledger.BlockAndPvtData) error
 	}
 
 	if writtenToPvtStore {
-		return s.pvtdataStore.Commit()
+		startCommit := time.Now()
+		if err := s.pvtdataStore.Commit(); err != nil {
+			return err
+		}
+		s.stats.updatePvtdataCommitTime(pvtdataCommitTime + time.Since(startCommit))
 	}
 	return nil
 }
@@ -231,6 +252,11 @@ func (s *Store) ProcessCollsEligibilityEnabled(committingBlk uint64, nsCollMap m
 	return s.pvtdataStore.ProcessCollsEligibilityEnabled(committingBlk, nsCollMap)
 }
 
+// SetCollElgProcListener invokes the function on underlying pvtdata store
+func (s *Store) SetCollElgProcListener(listener func()) {
+	s.pvtdataStore.SetCollElgProcListener(listener)
+}
+
 // GetLastUpdatedOldBlocksPvtData invokes the function on underlying pvtdata store
 func (s *Store) GetLastUpdatedOldBlocksPvtData() (map[uint64][]*ledger.TxPvtData, error) {
 	return s.pvtdataStore.GetLastUpdatedOldBlocksPvtData()
@@ -15,6 +15,7 @@ import (
 	"github.com/hyperledger/fabric/common/ledger/blkstorage"
 	"github.com/hyperledger/fabric/common/ledger/blkstorage/fsblkstorage"
 	"github.com/hyperledger/fabric/common/ledger/testutil"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
 	"github.com/hyperledger/fabric/core/ledger/pvtdatapolicy"
@@ -37,7 +38,7 @@ func TestMain(m *testing.M) {
 func TestStore(t *testing.T) {
 	testEnv := newTestEnv(t)
 	defer testEnv.cleanup()
-	provider := NewProvider()
+	provider := NewProvider(&disabled.Provider{})
 	defer provider.Close()
 	store, err := provider.Open("testLedger")
 	store.Init(btlPolicyForSampleData())
@@ -124,7 +125,8 @@ func TestStoreWithExistingBlockchain(t *testing.T) {
 	indexConfig := &blkstorage.IndexConfig{AttrsToIndex: attrsToIndex}
 	blockStoreProvider := fsblkstorage.NewProvider(
 		fsblkstorage.NewConf(ledgerconfig.GetBlockStorePath(), ledgerconfig.GetMaxBlockfileSize()),
-		indexConfig)
+		indexConfig,
+		&disabled.Provider{})
 
 	blkStore, err := blockStoreProvider.OpenBlockStore(testLedgerid)
 	assert.NoError(t, err)
@@ -141,7 +143,7 @@ func TestStoreWithExistingBlockchain(t *testing.T) {
 
 	// Simulating the upgrade from 1.0 situation:
 	// Open the ledger storage - pvtdata store is opened for the first time with an existing block storage
-	provider := NewProvider()
+	provider := NewProvider(&disabled.Provider{})
 	defer provider.Close()
 	store, err := provider.Open(testLedgerid)
 	store.Init(btlPolicyForSampleData())
@@ -163,7 +165,7 @@ func TestStoreWithExistingBlockchain(t *testing.T) {
 func TestCrashAfterPvtdataStorePreparation(t *testing.T) {
 	testEnv := newTestEnv(t)
 	defer testEnv.cleanup()
-	provider := NewProvider()
+	provider := NewProvider(&disabled.Provider{})
 	defer provider.Close()
 	store, err := provider.Open("testLedger")
 	store.Init(btlPolicyForSampleData())
@@ -186,7 +188,7 @@ func TestCrashAfterPvtdataStorePreparation(t *testing.T) {
 	store.pvtdataStore.Prepare(blokNumAtCrash, pvtdataAtCrash, nil)
 	store.Shutdown()
 	provider.Close()
-	provider = NewProvider()
+	provider = NewProvider(&disabled.Provider{})
 	store, err = provider.Open("testLedger")
 	assert.NoError(t, err)
 	store.Init(btlPolicyForSampleData())
@@ -218,7 +220,7 @@ func TestCrashAfterPvtdataStorePreparation(t *testing.T) {
 func TestCrashBeforePvtdataStoreCommit(t *testing.T) {
 	testEnv := newTestEnv(t)
 	defer testEnv.cleanup()
-	provider := NewProvider()
+	provider := NewProvider(&disabled.Provider{})
 	defer provider.Close()
 	store, err := provider.Open("testLedger")
 	store.Init(btlPolicyForSampleData())
@@ -244,7 +246,7 @@ func TestCrashBeforePvtdataStoreCommit(t *testing.T) {
 	store.BlockStore.AddBlock(dataAtCrash.Block)
 	store.Shutdown()
 	provider.Close()
-	provider = NewProvider()
+	provider = NewProvider(&disabled.Provider{})
 	store, err = provider.Open("testLedger")
 	assert.NoError(t, err)
 	store.Init(btlPolicyForSampleData())
@@ -257,7 +259,7 @@ func TestCrashBeforePvtdataStoreCommit(t *testing.T) {
 func TestAddAfterPvtdataStoreError(t *testing.T) {
 	testEnv := newTestEnv(t)
 	defer testEnv.cleanup()
-	provider := NewProvider()
+	provider := NewProvider(&disabled.Provider{})
 	defer provider.Close()
 	store, err := provider.Open("testLedger")
 	store.Init(btlPolicyForSampleData())
@@ -294,7 +296,7 @@ func TestAddAfterPvtdataStoreError(t *testing.T) {
 func TestAddAfterBlkStoreError(t *testing.T) {
 	testEnv := newTestEnv(t)
 	defer testEnv.cleanup()
-	provider := NewProvider()
+	provider := NewProvider(&disabled.Provider{})
 	defer provider.Close()
 	store, err := provider.Open("testLedger")
 	store.Init(btlPolicyForSampleData())
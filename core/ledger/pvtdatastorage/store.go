@@ -65,6 +65,10 @@ type Store interface {
 	// collection upgrade transaction and the parameter 'nsCollMap' contains the collections for which the peer
 	// is now eligible to recieve pvt data
 	ProcessCollsEligibilityEnabled(committingBlk uint64, nsCollMap map[string][]string) error
+	// SetCollElgProcListener sets a function that gets invoked each time the store is done converting
+	// the missing data of the collections for which the peer became eligible into eligible missing data,
+	// i.e., once this missing data can be reconciled
+	SetCollElgProcListener(listener func())
 	// CommitPvtDataOfOldBlocks commits the pvtData (i.e., previously missing data) of old blocks.
 	// The parameter `blocksPvtData` refers a list of old block's pvtdata which are missing in the pvtstore.
 	// This call stores an additional entry called `lastUpdatedOldBlocksList` which keeps the exact list
@@ -833,18 +833,25 @@ func (s *store) retrieveExpiryEntries(minBlkNum, maxBlkNum uint64) ([]*expiryEnt
 func (s *store) launchCollElgProc() {
 	maxBatchSize := ledgerconfig.GetPvtdataStoreCollElgProcMaxDbBatchSize()
 	batchesInterval := ledgerconfig.GetPvtdataStoreCollElgProcDbBatchesInterval()
+	process := func() {
+		if s.processCollElgEvents(maxBatchSize, batchesInterval) > 0 {
+			s.collElgProcSync.notifyListener()
+		}
+	}
 	go func() {
-		s.processCollElgEvents(maxBatchSize, batchesInterval) // process collection eligibility events when store is opened - in case there is an unprocessed events from previous run
+		process() // process collection eligibility events when store is opened - in case there is an unprocessed events from previous run
 		for {
 			logger.Debugf("Waiting for collection eligibility event")
 			s.collElgProcSync.waitForNotification()
-			s.processCollElgEvents(maxBatchSize, batchesInterval)
+			process()
 			s.collElgProcSync.done()
 		}
 	}()
 }
 
-func (s *store) processCollElgEvents(maxBatchSize, batchesInterval int) {
+// processCollElgEvents converts the missing data entries of the collections for which the peer
+// became eligible from ineligible to eligible, and returns the number of entries converted
+func (s *store) processCollElgEvents(maxBatchSize, batchesInterval int) int {
 	logger.Debugf("Starting to process collection eligibility events")
 	s.purgerLock.Lock()
 	defer s.purgerLock.Unlock()
@@ -902,6 +909,7 @@ func (s *store) processCollElgEvents(maxBatchSize, batchesInterval int) {
 
 	s.db.WriteBatch(batch, true)
 	logger.Debugf("Converted [%d] inelligible mising data entries to elligible", totalEntriesConverted)
+	return totalEntriesConverted
 }
 
 // LastCommittedBlockHeight implements the function in the interface `Store`
@@ -952,8 +960,43 @@ func (s *store) getLastCommittedBlockNum() (bool, uint64, error) {
 	return false, decodeLastCommittedBlockVal(v), nil
 }
 
+// SetCollElgProcListener implements the function in the interface `Store`
+func (s *store) SetCollElgProcListener(listener func()) {
+	s.collElgProcSync.setListener(listener)
+}
+
 type collElgProcSync struct {
 	notification, procComplete chan bool
+	listenerLock               sync.Mutex
+	listener                   func()
+	// missedNotification is set when eligibility events were processed
+	// before a listener was set, which is then notified once it is set
+	missedNotification bool
+}
+
+func (sync *collElgProcSync) setListener(listener func()) {
+	sync.listenerLock.Lock()
+	sync.listener = listener
+	missedNotification := sync.missedNotification && listener != nil
+	if missedNotification {
+		sync.missedNotification = false
+	}
+	sync.listenerLock.Unlock()
+	if missedNotification {
+		listener()
+	}
+}
+
+func (sync *collElgProcSync) notifyListener() {
+	sync.listenerLock.Lock()
+	listener := sync.listener
+	if listener == nil {
+		sync.missedNotification = true
+	}
+	sync.listenerLock.Unlock()
+	if listener != nil {
+		listener()
+	}
 }
 
 func (sync *collElgProcSync) notify() {
@@ -701,6 +701,10 @@ func testCollElgEnabled(t *testing.T) {
 	defer env.Cleanup()
 	assert := assert.New(t)
 	store := env.TestStore
+	collElgProcessed := 0
+	store.SetCollElgProcListener(func() {
+		collElgProcessed++
+	})
 
 	// Initial state: eligible for {ns-1:coll-1 and ns-2:coll-1 }
 
@@ -748,6 +752,8 @@ func testCollElgEnabled(t *testing.T) {
 		},
 	)
 	testutilWaitForCollElgProcToFinish(store)
+	// The listener is notified once the missing data is converted
+	assert.Equal(1, collElgProcessed)
 
 	// Retrieve and verify missing data reported
 	// Expected missing data should include newly eiligible collections
@@ -764,6 +770,7 @@ func testCollElgEnabled(t *testing.T) {
 		},
 	)
 	testutilWaitForCollElgProcToFinish(store)
+	assert.Equal(2, collElgProcessed)
 
 	// Retrieve and verify missing data reported
 	// Expected missing data should include newly eiligible collections
@@ -773,6 +780,25 @@ func testCollElgEnabled(t *testing.T) {
 	assert.Equal(expectedMissingPvtDataInfo, missingPvtDataInfo)
 }
 
+func TestCollElgProcSyncMissedNotification(t *testing.T) {
+	sync := &collElgProcSync{}
+	// Events processed before the listener is set, e.g. when the store is opened,
+	// are notified once the listener is set
+	sync.notifyListener()
+	notified := 0
+	sync.setListener(func() {
+		notified++
+	})
+	assert.Equal(t, 1, notified)
+
+	sync.notifyListener()
+	assert.Equal(t, 2, notified)
+	sync.setListener(func() {
+		notified++
+	})
+	assert.Equal(t, 2, notified)
+}
+
 func TestRollBack(t *testing.T) {
 	btlPolicy := btltestutil.SampleBTLPolicy(
 		map[[2]string]uint64{
@@ -451,6 +451,40 @@ func (couchInstance *CouchInstance) HealthCheck(ctx context.Context) error {
 	return nil
 }
 
+// RetrieveApplicationDBNames returns the names of all the application databases
+// in the couch instance, i.e., all the databases except the CouchDB system databases
+func (couchInstance *CouchInstance) RetrieveApplicationDBNames() ([]string, error) {
+	connectURL, err := url.Parse(couchInstance.conf.URL)
+	if err != nil {
+		logger.Errorf("URL parse error: %s", err)
+		return nil, errors.Wrapf(err, "error parsing CouchDB URL: %s", couchInstance.conf.URL)
+	}
+
+	//get the number of retries
+	maxRetries := couchInstance.conf.MaxRetries
+
+	resp, _, err := couchInstance.handleRequest(context.Background(), http.MethodGet, "", "RetrieveApplicationDBNames", connectURL, nil,
+		"", "", maxRetries, true, nil, "_all_dbs")
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponseBody(resp)
+
+	var dbNames []string
+	if err := json.NewDecoder(resp.Body).Decode(&dbNames); err != nil {
+		return nil, errors.Wrap(err, "error decoding response body")
+	}
+
+	var applicationDBNames []string
+	for _, dbName := range dbNames {
+		// the names of the CouchDB system databases start with an underscore
+		if !strings.HasPrefix(dbName, "_") {
+			applicationDBNames = append(applicationDBNames, dbName)
+		}
+	}
+	return applicationDBNames, nil
+}
+
 //DropDatabase provides method to drop an existing database
 func (dbclient *CouchDatabase) DropDatabase() (*DBOperationResponse, error) {
 	dbName := dbclient.DBName
@@ -299,6 +299,29 @@ func TestDBCreateEnsureFullCommit(t *testing.T) {
 	assert.NoError(t, commiterr, "Error when trying to ensure a full commit")
 }
 
+func TestRetrieveApplicationDBNames(t *testing.T) {
+
+	database := "testretrieveapplicationdbnames"
+	err := cleanup(database)
+	assert.NoError(t, err, "Error when trying to cleanup  Error: %s", err)
+	defer cleanup(database)
+
+	//create a new instance and database object
+	couchInstance, err := CreateCouchInstance(couchDBDef.URL, couchDBDef.Username, couchDBDef.Password,
+		couchDBDef.MaxRetries, couchDBDef.MaxRetriesOnStartup, couchDBDef.RequestTimeout, couchDBDef.CreateGlobalChangesDB, &disabled.Provider{})
+	assert.NoError(t, err, "Error when trying to create couch instance")
+	_, err = CreateCouchDatabase(couchInstance, database)
+	assert.NoError(t, err, "Error when trying to create database")
+
+	//the system databases are not returned
+	dbNames, err := couchInstance.RetrieveApplicationDBNames()
+	assert.NoError(t, err, "Error when trying to retrieve the application database names")
+	assert.Contains(t, dbNames, database)
+	for _, dbName := range dbNames {
+		assert.NotEqual(t, '_', dbName[0])
+	}
+}
+
 func TestDBBadDatabaseName(t *testing.T) {
 
 	//create a new instance and database object using a valid database name mixed case
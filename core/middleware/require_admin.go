@@ -0,0 +1,45 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package middleware
+
+import (
+	"crypto/x509"
+	"net/http"
+)
+
+// AdminChecker returns an error unless the given verified TLS client
+// certificate belongs to an administrator.
+type AdminChecker func(cert *x509.Certificate) error
+
+type requireAdmin struct {
+	checkAdmin AdminChecker
+	next       http.Handler
+}
+
+// RequireAdmin is used to ensure that a verified TLS client certificate was
+// used for authentication, and that it belongs to an administrator. Requests
+// without one are unauthorized, those of other clients are forbidden.
+func RequireAdmin(checkAdmin AdminChecker) Middleware {
+	return func(next http.Handler) http.Handler {
+		return &requireAdmin{checkAdmin: checkAdmin, next: next}
+	}
+}
+
+func (r *requireAdmin) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	switch {
+	case req.TLS == nil:
+		fallthrough
+	case len(req.TLS.VerifiedChains) == 0:
+		fallthrough
+	case len(req.TLS.VerifiedChains[0]) == 0:
+		w.WriteHeader(http.StatusUnauthorized)
+	case r.checkAdmin(req.TLS.VerifiedChains[0][0]) != nil:
+		w.WriteHeader(http.StatusForbidden)
+	default:
+		r.next.ServeHTTP(w, req)
+	}
+}
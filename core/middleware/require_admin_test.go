@@ -0,0 +1,101 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package middleware_test
+
+import (
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/hyperledger/fabric/core/middleware"
+	"github.com/hyperledger/fabric/core/middleware/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RequireAdmin", func() {
+	var (
+		cert       *x509.Certificate
+		checked    []*x509.Certificate
+		checkErr   error
+		checkAdmin middleware.AdminChecker
+		handler    *fakes.HTTPHandler
+		chain      http.Handler
+
+		req  *http.Request
+		resp *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		cert = &x509.Certificate{Raw: []byte("admin")}
+		checked = nil
+		checkErr = nil
+		checkAdmin = func(c *x509.Certificate) error {
+			checked = append(checked, c)
+			return checkErr
+		}
+		handler = &fakes.HTTPHandler{}
+		chain = middleware.RequireAdmin(checkAdmin)(handler)
+
+		req = httptest.NewRequest("GET", "https:///", nil)
+		req.TLS.VerifiedChains = [][]*x509.Certificate{{cert, &x509.Certificate{}}}
+		resp = httptest.NewRecorder()
+	})
+
+	It("delegates to the next handler when the client certificate belongs to an admin", func() {
+		chain.ServeHTTP(resp, req)
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		Expect(handler.ServeHTTPCallCount()).To(Equal(1))
+		Expect(checked).To(Equal([]*x509.Certificate{cert}))
+	})
+
+	Context("when the client certificate does not belong to an admin", func() {
+		BeforeEach(func() {
+			checkErr = errors.New("not an admin")
+		})
+
+		It("responds with http.StatusForbidden", func() {
+			chain.ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusForbidden))
+		})
+
+		It("does not call the next handler", func() {
+			chain.ServeHTTP(resp, req)
+			Expect(handler.ServeHTTPCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the TLS connection state is nil", func() {
+		BeforeEach(func() {
+			req.TLS = nil
+		})
+
+		It("responds with http.StatusUnauthorized", func() {
+			chain.ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusUnauthorized))
+			Expect(checked).To(BeEmpty())
+		})
+
+		It("does not call the next handler", func() {
+			chain.ServeHTTP(resp, req)
+			Expect(handler.ServeHTTPCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the first verified chain is empty", func() {
+		BeforeEach(func() {
+			req.TLS.VerifiedChains = [][]*x509.Certificate{{}}
+		})
+
+		It("responds with http.StatusUnauthorized", func() {
+			chain.ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusUnauthorized))
+			Expect(checked).To(BeEmpty())
+		})
+	})
+})
@@ -81,6 +81,14 @@ func (m *MockTxSim) ExecuteUpdate(query string) error {
 	return nil
 }
 
+func (m *MockTxSim) GetPendingState(namespace string, key string) ([]byte, bool, error) {
+	return nil, false, nil
+}
+
+func (m *MockTxSim) GetPendingPrivateData(namespace, collection, key string) ([]byte, bool, error) {
+	return nil, false, nil
+}
+
 func (m *MockTxSim) GetTxSimulationResults() (*ledger.TxSimulationResults, error) {
 	return m.GetTxSimulationResultsRv, nil
 }
@@ -161,13 +169,14 @@ func (c *MockCcProviderImpl) Stop(ccci *ccprovider.ChaincodeContainerInfo) error
 }
 
 type MockChaincodeDefinition struct {
-	NameRv          string
-	VersionRv       string
-	EndorsementStr  string
-	ValidationStr   string
-	ValidationBytes []byte
-	HashRv          []byte
-	RequiresInitRv  bool
+	NameRv           string
+	VersionRv        string
+	EndorsementStr   string
+	ValidationStr    string
+	ValidationBytes  []byte
+	HashRv           []byte
+	RequiresInitRv   bool
+	ReadYourWritesRv bool
 }
 
 func (m *MockChaincodeDefinition) CCName() string {
@@ -193,3 +202,7 @@ func (m *MockChaincodeDefinition) Endorsement() string {
 func (m *MockChaincodeDefinition) RequiresInit() bool {
 	return m.RequiresInitRv
 }
+
+func (m *MockChaincodeDefinition) ReadYourWrites() bool {
+	return m.ReadYourWritesRv
+}
@@ -7,6 +7,8 @@ SPDX-License-Identifier: Apache-2.0
 package endorser
 
 import (
+	"context"
+
 	"github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/core/common/ccprovider"
 	"github.com/hyperledger/fabric/core/endorser"
@@ -41,6 +43,7 @@ type MockSupport struct {
 	GetApplicationConfigRv           channelconfig.Application
 	GetApplicationConfigBoolRv       bool
 	DeployedCCInfoProvider           ledger.DeployedChaincodeInfoProvider
+	ChannelTipHeightRv               uint64
 }
 
 func (s *MockSupport) Serialize() ([]byte, error) {
@@ -87,6 +90,15 @@ func (s *MockSupport) GetLedgerHeight(channelID string) (uint64, error) {
 	return args.Get(0).(uint64), args.Error(1)
 }
 
+func (s *MockSupport) GetChannelTipHeight(channelID string) uint64 {
+	return s.ChannelTipHeightRv
+}
+
+func (s *MockSupport) WaitForLedgerHeight(ctx context.Context, channelID string, height uint64) error {
+	args := s.Called(channelID, height)
+	return args.Error(0)
+}
+
 func (s *MockSupport) IsSysCC(name string) bool {
 	if s.SysCCMap != nil {
 		_, in := s.SysCCMap[name]
@@ -99,7 +111,7 @@ func (s *MockSupport) ExecuteLegacyInit(txParams *ccprovider.TransactionParams,
 	return s.ExecuteCDSResp, s.ExecuteCDSEvent, s.ExecuteCDSError
 }
 
-func (s *MockSupport) Execute(txParams *ccprovider.TransactionParams, cid, name, version, txid string, idBytes []byte, initRequired bool, signedProp *pb.SignedProposal, prop *pb.Proposal, spec *pb.ChaincodeInput) (*pb.Response, *pb.ChaincodeEvent, error) {
+func (s *MockSupport) Execute(txParams *ccprovider.TransactionParams, cid, name, version, txid string, idBytes []byte, initRequired bool, readYourWrites bool, signedProp *pb.SignedProposal, prop *pb.Proposal, spec *pb.ChaincodeInput) (*pb.Response, *pb.ChaincodeEvent, error) {
 	return s.ExecuteResp, s.ExecuteEvent, s.ExecuteError
 }
 
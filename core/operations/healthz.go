@@ -0,0 +1,105 @@
+/*
+Copyright IBM Corp All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package operations
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric-lib-go/healthz"
+)
+
+// healthCheckers keeps the registered health checkers by component, so that
+// the health of a single component can be reported, and whether the process
+// completed its start up.
+type healthCheckers struct {
+	mutex    sync.RWMutex
+	checkers map[string]healthz.HealthChecker
+	ready    bool
+}
+
+func newHealthCheckers() *healthCheckers {
+	return &healthCheckers{checkers: map[string]healthz.HealthChecker{}}
+}
+
+func (hc *healthCheckers) register(component string, checker healthz.HealthChecker) {
+	hc.mutex.Lock()
+	defer hc.mutex.Unlock()
+	hc.checkers[component] = checker
+}
+
+func (hc *healthCheckers) deregister(component string) {
+	hc.mutex.Lock()
+	defer hc.mutex.Unlock()
+	delete(hc.checkers, component)
+}
+
+func (hc *healthCheckers) checker(component string) (healthz.HealthChecker, bool) {
+	hc.mutex.RLock()
+	defer hc.mutex.RUnlock()
+	checker, ok := hc.checkers[component]
+	return checker, ok
+}
+
+func (hc *healthCheckers) setReady() {
+	hc.mutex.Lock()
+	defer hc.mutex.Unlock()
+	hc.ready = true
+}
+
+func (hc *healthCheckers) isReady() bool {
+	hc.mutex.RLock()
+	defer hc.mutex.RUnlock()
+	return hc.ready
+}
+
+// componentHealthHandler serves the health status of the component named by
+// the last element of the request path, in the same format as /healthz.
+type componentHealthHandler struct {
+	checkers *healthCheckers
+}
+
+func (h *componentHealthHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	component := strings.TrimPrefix(req.URL.Path, "/healthz/")
+	checker, ok := h.checkers.checker(component)
+	if !ok {
+		rw.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	handler := healthz.NewHealthHandler()
+	handler.RegisterChecker(component, checker)
+	handler.ServeHTTP(rw, req)
+}
+
+// readinessHandler serves the health status of all components as /healthz
+// does, once the process completed its start up. Until then, the process is
+// reported unavailable.
+type readinessHandler struct {
+	checkers      *healthCheckers
+	healthHandler *healthz.HealthHandler
+}
+
+func (h *readinessHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.Method == http.MethodGet && !h.checkers.isReady() {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(http.StatusServiceUnavailable)
+		json.NewEncoder(rw).Encode(healthz.HealthStatus{
+			Status: healthz.StatusUnavailable,
+			Time:   time.Now(),
+			FailedChecks: []healthz.FailedCheck{{
+				Component: "process",
+				Reason:    "start up is not completed",
+			}},
+		})
+		return
+	}
+	h.healthHandler.ServeHTTP(rw, req)
+}
@@ -0,0 +1,118 @@
+/*
+Copyright IBM Corp All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package operations
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/pprof"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultMaxProfileDuration is the maximum duration of the CPU profiles and
+// execution traces when ProfileOptions.MaxDuration is not set.
+const DefaultMaxProfileDuration = 30 * time.Second
+
+// The durations of a CPU profile and of an execution trace when the request
+// does not specify one, as in net/http/pprof. They are lowered to the
+// maximum duration if it is shorter.
+const (
+	defaultCPUProfileDuration = 30 * time.Second
+	defaultTraceDuration      = time.Second
+)
+
+// ProfileOptions configures the runtime profiling endpoints.
+type ProfileOptions struct {
+	// Enabled serves the pprof and execution trace endpoints under /debug/pprof/
+	Enabled bool
+	// MaxDuration is the maximum duration of a CPU profile or an execution
+	// trace. It must be below the write timeout of the operations server.
+	MaxDuration time.Duration
+}
+
+// profileHandler serves the runtime profiles of the process. As collecting a
+// CPU profile or an execution trace affects the performance of the process,
+// their duration is limited, and only one of them is collected at a time.
+type profileHandler struct {
+	maxDuration time.Duration
+	// busy holds a token while a CPU profile or an execution trace is collected
+	busy chan struct{}
+}
+
+func newProfileHandler(maxDuration time.Duration) *profileHandler {
+	if maxDuration <= 0 {
+		maxDuration = DefaultMaxProfileDuration
+	}
+	return &profileHandler{
+		maxDuration: maxDuration,
+		busy:        make(chan struct{}, 1),
+	}
+}
+
+func (h *profileHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	switch name := strings.TrimPrefix(req.URL.Path, "/debug/pprof/"); name {
+	case "":
+		pprof.Index(resp, req)
+	case "cmdline":
+		pprof.Cmdline(resp, req)
+	case "symbol":
+		pprof.Symbol(resp, req)
+	case "profile":
+		h.serveTimed(resp, req, pprof.Profile, defaultCPUProfileDuration, true)
+	case "trace":
+		h.serveTimed(resp, req, pprof.Trace, defaultTraceDuration, false)
+	default:
+		pprof.Handler(name).ServeHTTP(resp, req)
+	}
+}
+
+// serveTimed serves a CPU profile or an execution trace, after checking that
+// the requested duration is within the limit and that no other one is being
+// collected. The CPU profiles are collected for whole seconds, so a requested
+// duration is rounded up, while the default duration is rounded down.
+func (h *profileHandler) serveTimed(resp http.ResponseWriter, req *http.Request, serve http.HandlerFunc, defaultDuration time.Duration, wholeSeconds bool) {
+	duration := defaultDuration
+	if duration > h.maxDuration {
+		duration = h.maxDuration
+	}
+	if wholeSeconds {
+		duration = duration.Truncate(time.Second)
+		if duration == 0 {
+			duration = time.Second
+		}
+	}
+	if s := req.FormValue("seconds"); s != "" {
+		seconds, err := strconv.ParseFloat(s, 64)
+		if err != nil || seconds <= 0 {
+			http.Error(resp, fmt.Sprintf("invalid duration: %s", s), http.StatusBadRequest)
+			return
+		}
+		if wholeSeconds {
+			seconds = math.Ceil(seconds)
+		}
+		duration = time.Duration(seconds * float64(time.Second))
+	}
+	if duration > h.maxDuration {
+		http.Error(resp, fmt.Sprintf("duration %s exceeds the maximum duration %s", duration, h.maxDuration), http.StatusBadRequest)
+		return
+	}
+
+	select {
+	case h.busy <- struct{}{}:
+		defer func() { <-h.busy }()
+	default:
+		http.Error(resp, "a CPU profile or an execution trace is already being collected", http.StatusConflict)
+		return
+	}
+
+	// the handlers of net/http/pprof read the duration from the seconds form value
+	req.Form.Set("seconds", strconv.FormatFloat(duration.Seconds(), 'f', -1, 64))
+	serve(resp, req)
+}
@@ -19,13 +19,17 @@ import (
 	"github.com/hyperledger/fabric-lib-go/healthz"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/flogging/httpadmin"
+	"github.com/hyperledger/fabric/common/grpctracing"
 	"github.com/hyperledger/fabric/common/metrics"
 	"github.com/hyperledger/fabric/common/metrics/disabled"
+	"github.com/hyperledger/fabric/common/metrics/otlp"
 	"github.com/hyperledger/fabric/common/metrics/prometheus"
 	"github.com/hyperledger/fabric/common/metrics/statsd"
 	"github.com/hyperledger/fabric/common/metrics/statsd/goruntime"
+	commonotlp "github.com/hyperledger/fabric/common/otlp"
 	"github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/core/middleware"
+	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
@@ -43,17 +47,50 @@ type Statsd struct {
 	Prefix        string
 }
 
+// defaultOTLPWriteInterval is the interval between the exports to an
+// OpenTelemetry collector when none is configured.
+const defaultOTLPWriteInterval = 10 * time.Second
+
+// OTLP configures the export of metrics to an OpenTelemetry collector.
+type OTLP struct {
+	// Endpoint is the base URL of the OTLP/HTTP receiver of the collector
+	Endpoint      string
+	WriteInterval time.Duration
+	// Headers are added to the export requests, such as authorization headers
+	Headers map[string]string
+}
+
 type MetricsOptions struct {
 	Provider string
 	Statsd   *Statsd
+	OTLP     *OTLP
+}
+
+// TracingOptions configures the export of a span for each gRPC request
+// served by the process to an OpenTelemetry collector.
+type TracingOptions struct {
+	Enabled bool
+	// Endpoint is the base URL of the OTLP/HTTP receiver of the collector
+	Endpoint      string
+	WriteInterval time.Duration
+	// Headers are added to the export requests, such as authorization headers
+	Headers map[string]string
+	// MaxQueuedSpans is the number of spans queued between two exports,
+	// beyond which spans are dropped
+	MaxQueuedSpans int
 }
 
 type Options struct {
 	Logger        Logger
 	ListenAddress string
 	Metrics       MetricsOptions
+	Tracing       TracingOptions
 	TLS           TLS
+	Profile       ProfileOptions
 	Version       string
+	// ServiceName identifies the process in the telemetry exported to
+	// OpenTelemetry collectors
+	ServiceName string
 }
 
 type System struct {
@@ -61,10 +98,14 @@ type System struct {
 
 	logger          Logger
 	healthHandler   *healthz.HealthHandler
+	checkers        *healthCheckers
 	options         Options
 	statsd          *kitstatsd.Statsd
+	otlpProvider    *otlp.Provider
+	tracer          *grpctracing.Tracer
 	collectorTicker *time.Ticker
 	sendTicker      *time.Ticker
+	traceTicker     *time.Ticker
 	httpServer      *http.Server
 	mux             *http.ServeMux
 	addr            string
@@ -85,7 +126,9 @@ func NewSystem(o Options) *System {
 	system.initializeServer()
 	system.initializeHealthCheckHandler()
 	system.initializeLoggingHandler()
+	system.initializeProfileHandler()
 	system.initializeMetricsProvider()
+	system.initializeTracer()
 
 	return system
 }
@@ -105,10 +148,15 @@ func (s *System) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
 }
 
 func (s *System) Start() error {
+	if s.options.Profile.Enabled && !s.options.TLS.Enabled {
+		return errors.New("the profiling endpoints require TLS to be enabled on the operations service to authenticate their clients")
+	}
+
 	err := s.startMetricsTickers()
 	if err != nil {
 		return err
 	}
+	s.startTraceTicker()
 
 	s.versionGauge.With("version", s.options.Version).Set(1)
 
@@ -132,14 +180,49 @@ func (s *System) Stop() error {
 		s.sendTicker.Stop()
 		s.sendTicker = nil
 	}
+	if s.traceTicker != nil {
+		s.traceTicker.Stop()
+		s.traceTicker = nil
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	return s.httpServer.Shutdown(ctx)
 }
 
+// Tracer returns the tracer recording the gRPC requests served by the
+// process, or nil if tracing is disabled.
+func (s *System) Tracer() *grpctracing.Tracer {
+	return s.tracer
+}
+
+// RegisterChecker registers the health checker of a component. The health of
+// the component is reported along with the other components by the /healthz
+// and /readyz resources, and individually by the /healthz/<component> resource.
 func (s *System) RegisterChecker(component string, checker healthz.HealthChecker) error {
-	return s.healthHandler.RegisterChecker(component, checker)
+	if err := s.healthHandler.RegisterChecker(component, checker); err != nil {
+		return err
+	}
+	s.checkers.register(component, checker)
+	return nil
+}
+
+// DeregisterChecker deregisters the health checker of a component.
+func (s *System) DeregisterChecker(component string) {
+	s.healthHandler.DeregisterChecker(component)
+	s.checkers.deregister(component)
+}
+
+// SetReady marks the process as ready once it completed its start up, after
+// which the /readyz resource reports the health of the components.
+func (s *System) SetReady() {
+	s.checkers.setReady()
+}
+
+// RegisterHandler registers an administrative handler for the given pattern.
+// When TLS is enabled, clients must present a certificate to reach the handler.
+func (s *System) RegisterHandler(pattern string, handler http.Handler) {
+	s.mux.Handle(pattern, s.handlerChain(handler, s.options.TLS.Enabled))
 }
 
 func (s *System) initializeServer() {
@@ -181,6 +264,12 @@ func (s *System) initializeMetricsProvider() error {
 		s.mux.Handle("/metrics", s.handlerChain(promhttp.Handler(), s.options.TLS.Enabled))
 		return nil
 
+	case "otlp":
+		s.otlpProvider = otlp.NewProvider()
+		s.Provider = s.otlpProvider
+		s.versionGauge = versionGauge(s.Provider)
+		return nil
+
 	default:
 		if providerType != "disabled" {
 			s.logger.Warnf("Unknown provider type: %s; metrics disabled", providerType)
@@ -196,9 +285,20 @@ func (s *System) initializeLoggingHandler() {
 	s.mux.Handle("/logspec", s.handlerChain(httpadmin.NewSpecHandler(), s.options.TLS.Enabled))
 }
 
+func (s *System) initializeProfileHandler() {
+	// the profiling endpoints are never served to unauthenticated clients
+	if !s.options.Profile.Enabled || !s.options.TLS.Enabled {
+		return
+	}
+	s.mux.Handle("/debug/pprof/", s.handlerChain(newProfileHandler(s.options.Profile.MaxDuration), true))
+}
+
 func (s *System) initializeHealthCheckHandler() {
 	s.healthHandler = healthz.NewHealthHandler()
+	s.checkers = newHealthCheckers()
 	s.mux.Handle("/healthz", s.handlerChain(s.healthHandler, false))
+	s.mux.Handle("/healthz/", s.handlerChain(&componentHealthHandler{checkers: s.checkers}, false))
+	s.mux.Handle("/readyz", s.handlerChain(&readinessHandler{checkers: s.checkers, healthHandler: s.healthHandler}, false))
 }
 
 func (s *System) startMetricsTickers() error {
@@ -223,9 +323,61 @@ func (s *System) startMetricsTickers() error {
 		go s.statsd.SendLoop(s.sendTicker.C, network, address)
 	}
 
+	if s.otlpProvider != nil {
+		opts := s.options.Metrics.OTLP
+		client := &commonotlp.Client{Endpoint: opts.Endpoint, Headers: opts.Headers}
+
+		writeInterval := opts.WriteInterval
+		if writeInterval <= 0 {
+			writeInterval = defaultOTLPWriteInterval
+		}
+
+		s.collectorTicker = time.NewTicker(writeInterval / 2)
+		goCollector := goruntime.NewCollector(s.Provider)
+		go goCollector.CollectAndPublish(s.collectorTicker.C)
+
+		s.sendTicker = time.NewTicker(writeInterval)
+		go s.otlpProvider.SendLoop(s.sendTicker.C, client, s.resource(), func(err error) {
+			s.logger.Warnf("Failed exporting metrics to %s: %s", opts.Endpoint, err)
+		})
+	}
+
 	return nil
 }
 
+func (s *System) initializeTracer() {
+	if !s.options.Tracing.Enabled {
+		return
+	}
+	s.tracer = grpctracing.NewTracer(s.options.Tracing.MaxQueuedSpans)
+}
+
+func (s *System) startTraceTicker() {
+	if s.tracer == nil {
+		return
+	}
+	opts := s.options.Tracing
+	client := &commonotlp.Client{Endpoint: opts.Endpoint, Headers: opts.Headers}
+
+	writeInterval := opts.WriteInterval
+	if writeInterval <= 0 {
+		writeInterval = defaultOTLPWriteInterval
+	}
+
+	s.traceTicker = time.NewTicker(writeInterval)
+	go s.tracer.SendLoop(s.traceTicker.C, client, s.resource(), func(err error) {
+		s.logger.Warnf("Failed exporting spans to %s: %s", opts.Endpoint, err)
+	})
+}
+
+func (s *System) resource() commonotlp.Resource {
+	serviceName := s.options.ServiceName
+	if serviceName == "" {
+		serviceName = "hyperledger-fabric"
+	}
+	return commonotlp.NewResource(serviceName, s.options.Version)
+}
+
 func (s *System) listen() (net.Listener, error) {
 	listener, err := net.Listen("tcp", s.options.ListenAddress)
 	if err != nil {
@@ -14,13 +14,16 @@ import (
 	"io/ioutil"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"syscall"
 	"time"
 
 	"github.com/hyperledger/fabric-lib-go/healthz"
+	"github.com/hyperledger/fabric/common/grpctracing"
 	"github.com/hyperledger/fabric/common/metrics/disabled"
+	"github.com/hyperledger/fabric/common/metrics/otlp"
 	"github.com/hyperledger/fabric/common/metrics/prometheus"
 	"github.com/hyperledger/fabric/common/metrics/statsd"
 	"github.com/hyperledger/fabric/core/operations"
@@ -93,6 +96,24 @@ var _ = Describe("System", func() {
 		Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
 	})
 
+	It("hosts registered handlers on a secure endpoint", func() {
+		system.RegisterHandler("/custom", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTeapot)
+		}))
+		err := system.Start()
+		Expect(err).NotTo(HaveOccurred())
+
+		customURL := fmt.Sprintf("https://%s/custom", system.Addr())
+		resp, err := client.Get(customURL)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusTeapot))
+		resp.Body.Close()
+
+		resp, err = unauthClient.Get(customURL)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+	})
+
 	Context("when TLS is disabled", func() {
 		BeforeEach(func() {
 			options.TLS.Enabled = false
@@ -213,6 +234,237 @@ var _ = Describe("System", func() {
 		}))
 	})
 
+	It("hosts a health check endpoint per component", func() {
+		err := system.Start()
+		Expect(err).NotTo(HaveOccurred())
+
+		healthy := &fakes.HealthChecker{}
+		unhealthy := &fakes.HealthChecker{}
+		unhealthy.HealthCheckReturns(errors.New("Unfortunately, I am not feeling well."))
+
+		system.RegisterChecker("healthy", healthy)
+		system.RegisterChecker("unhealthy", unhealthy)
+
+		resp, err := client.Get(fmt.Sprintf("https://%s/healthz/healthy", system.Addr()))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		resp.Body.Close()
+
+		resp, err = client.Get(fmt.Sprintf("https://%s/healthz/unhealthy", system.Addr()))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusServiceUnavailable))
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+
+		var healthStatus healthz.HealthStatus
+		err = json.Unmarshal(body, &healthStatus)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(healthStatus.FailedChecks).To(ConsistOf(healthz.FailedCheck{
+			Component: "unhealthy",
+			Reason:    "Unfortunately, I am not feeling well.",
+		}))
+
+		system.DeregisterChecker("unhealthy")
+		resp, err = client.Get(fmt.Sprintf("https://%s/healthz/unhealthy", system.Addr()))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+		resp.Body.Close()
+	})
+
+	It("hosts a readiness endpoint", func() {
+		err := system.Start()
+		Expect(err).NotTo(HaveOccurred())
+
+		healthy := &fakes.HealthChecker{}
+		system.RegisterChecker("healthy", healthy)
+
+		resp, err := client.Get(fmt.Sprintf("https://%s/readyz", system.Addr()))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusServiceUnavailable))
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+
+		var healthStatus healthz.HealthStatus
+		err = json.Unmarshal(body, &healthStatus)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(healthStatus.FailedChecks).To(ConsistOf(healthz.FailedCheck{
+			Component: "process",
+			Reason:    "start up is not completed",
+		}))
+
+		system.SetReady()
+		resp, err = client.Get(fmt.Sprintf("https://%s/readyz", system.Addr()))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		resp.Body.Close()
+
+		healthy.HealthCheckReturns(errors.New("Unfortunately, I am not feeling well."))
+		resp, err = client.Get(fmt.Sprintf("https://%s/readyz", system.Addr()))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusServiceUnavailable))
+		resp.Body.Close()
+	})
+
+	It("does not host the profiling endpoints by default", func() {
+		err := system.Start()
+		Expect(err).NotTo(HaveOccurred())
+
+		resp, err := client.Get(fmt.Sprintf("https://%s/debug/pprof/goroutine", system.Addr()))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+		resp.Body.Close()
+	})
+
+	Context("when profiling is enabled", func() {
+		BeforeEach(func() {
+			options.Profile = operations.ProfileOptions{
+				Enabled:     true,
+				MaxDuration: time.Second,
+			}
+			system = operations.NewSystem(options)
+			Expect(system).NotTo(BeNil())
+		})
+
+		It("hosts the profiling endpoints on a secure endpoint", func() {
+			err := system.Start()
+			Expect(err).NotTo(HaveOccurred())
+
+			goroutineURL := fmt.Sprintf("https://%s/debug/pprof/goroutine", system.Addr())
+			resp, err := client.Get(goroutineURL)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			resp.Body.Close()
+
+			resp, err = unauthClient.Get(goroutineURL)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+			resp.Body.Close()
+		})
+
+		It("collects an execution trace", func() {
+			err := system.Start()
+			Expect(err).NotTo(HaveOccurred())
+
+			resp, err := client.Get(fmt.Sprintf("https://%s/debug/pprof/trace?seconds=0.1", system.Addr()))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			resp.Body.Close()
+		})
+
+		It("rejects durations beyond the maximum duration", func() {
+			err := system.Start()
+			Expect(err).NotTo(HaveOccurred())
+
+			resp, err := client.Get(fmt.Sprintf("https://%s/debug/pprof/profile?seconds=5", system.Addr()))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+			body, err := ioutil.ReadAll(resp.Body)
+			Expect(err).NotTo(HaveOccurred())
+			resp.Body.Close()
+			Expect(string(body)).To(ContainSubstring("duration 5s exceeds the maximum duration 1s"))
+
+			resp, err = client.Get(fmt.Sprintf("https://%s/debug/pprof/profile?seconds=1.5", system.Addr()))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+			resp.Body.Close()
+
+			resp, err = client.Get(fmt.Sprintf("https://%s/debug/pprof/trace?seconds=-1", system.Addr()))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+			resp.Body.Close()
+		})
+
+		It("collects a CPU profile for the maximum duration when none is requested", func() {
+			err := system.Start()
+			Expect(err).NotTo(HaveOccurred())
+
+			start := time.Now()
+			resp, err := client.Get(fmt.Sprintf("https://%s/debug/pprof/profile", system.Addr()))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			resp.Body.Close()
+			Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+		})
+
+		Context("when the maximum duration is below one second", func() {
+			BeforeEach(func() {
+				options.Profile.MaxDuration = 100 * time.Millisecond
+				system = operations.NewSystem(options)
+			})
+
+			It("collects an execution trace for the maximum duration when none is requested", func() {
+				err := system.Start()
+				Expect(err).NotTo(HaveOccurred())
+
+				start := time.Now()
+				resp, err := client.Get(fmt.Sprintf("https://%s/debug/pprof/trace", system.Addr()))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.StatusCode).To(Equal(http.StatusOK))
+				resp.Body.Close()
+				Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+			})
+
+			It("rejects CPU profiles, which last whole seconds", func() {
+				err := system.Start()
+				Expect(err).NotTo(HaveOccurred())
+
+				resp, err := client.Get(fmt.Sprintf("https://%s/debug/pprof/profile", system.Addr()))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+				body, err := ioutil.ReadAll(resp.Body)
+				Expect(err).NotTo(HaveOccurred())
+				resp.Body.Close()
+				Expect(string(body)).To(ContainSubstring("duration 1s exceeds the maximum duration 100ms"))
+			})
+		})
+
+		Context("when TLS is disabled", func() {
+			BeforeEach(func() {
+				options.TLS.Enabled = false
+				system = operations.NewSystem(options)
+			})
+
+			It("fails to start", func() {
+				err := system.Start()
+				Expect(err).To(MatchError("the profiling endpoints require TLS to be enabled on the operations service to authenticate their clients"))
+				system = nil
+			})
+		})
+
+		It("rejects concurrent CPU profiles and execution traces", func() {
+			err := system.Start()
+			Expect(err).NotTo(HaveOccurred())
+
+			stop := make(chan struct{})
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				for {
+					select {
+					case <-stop:
+						return
+					default:
+					}
+					resp, err := client.Get(fmt.Sprintf("https://%s/debug/pprof/profile?seconds=1", system.Addr()))
+					if err == nil {
+						resp.Body.Close()
+					}
+				}
+			}()
+
+			Eventually(func() int {
+				resp, err := client.Get(fmt.Sprintf("https://%s/debug/pprof/trace?seconds=0.01", system.Addr()))
+				Expect(err).NotTo(HaveOccurred())
+				resp.Body.Close()
+				return resp.StatusCode
+			}, 5*time.Second).Should(Equal(http.StatusConflict))
+			close(stop)
+			Eventually(done, 5*time.Second).Should(BeClosed())
+		})
+	})
+
 	Context("when the metrics provider is disabled", func() {
 		BeforeEach(func() {
 			options.Metrics = operations.MetricsOptions{
@@ -359,6 +611,115 @@ var _ = Describe("System", func() {
 		})
 	})
 
+	Context("when the metrics provider is otlp", func() {
+		var (
+			collector *httptest.Server
+			requests  chan *http.Request
+			bodies    *gbytes.Buffer
+		)
+
+		BeforeEach(func() {
+			requests = make(chan *http.Request, 100)
+			bodies = gbytes.NewBuffer()
+			collector = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				io.Copy(bodies, r.Body)
+				requests <- r
+			}))
+
+			options.ServiceName = "peer"
+			options.Metrics = operations.MetricsOptions{
+				Provider: "otlp",
+				OTLP: &operations.OTLP{
+					Endpoint:      collector.URL,
+					WriteInterval: 100 * time.Millisecond,
+					Headers:       map[string]string{"Authorization": "Bearer token"},
+				},
+			}
+			system = operations.NewSystem(options)
+		})
+
+		AfterEach(func() {
+			collector.Close()
+		})
+
+		It("sets up otlp as a provider", func() {
+			_, ok := system.Provider.(*otlp.Provider)
+			Expect(ok).To(BeTrue())
+		})
+
+		It("exports the metrics to the collector", func() {
+			err := system.Start()
+			Expect(err).NotTo(HaveOccurred())
+
+			var r *http.Request
+			Eventually(requests).Should(Receive(&r))
+			Expect(r.URL.Path).To(Equal("/v1/metrics"))
+			Expect(r.Header.Get("Authorization")).To(Equal("Bearer token"))
+			Eventually(bodies).Should(gbytes.Say(`"key":"service.name","value":{"stringValue":"peer"}`))
+			Eventually(bodies).Should(gbytes.Say(`"name":"fabric_version"`))
+			Eventually(bodies).Should(gbytes.Say(`"name":"go.mem.gc_last_epoch_nanotime"`))
+		})
+
+		Context("when the collector fails", func() {
+			BeforeEach(func() {
+				collector.Close()
+			})
+
+			It("logs the failure", func() {
+				err := system.Start()
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(fakeLogger.WarnfCallCount).Should(BeNumerically(">", 0))
+				msg, _ := fakeLogger.WarnfArgsForCall(0)
+				Expect(msg).To(Equal("Failed exporting metrics to %s: %s"))
+			})
+		})
+	})
+
+	Context("when tracing is enabled", func() {
+		var (
+			collector *httptest.Server
+			paths     chan string
+		)
+
+		BeforeEach(func() {
+			paths = make(chan string, 100)
+			collector = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				paths <- r.URL.Path
+			}))
+
+			options.Tracing = operations.TracingOptions{
+				Enabled:       true,
+				Endpoint:      collector.URL,
+				WriteInterval: 100 * time.Millisecond,
+			}
+			system = operations.NewSystem(options)
+		})
+
+		AfterEach(func() {
+			collector.Close()
+		})
+
+		It("exports the recorded spans to the collector", func() {
+			Expect(system.Tracer()).NotTo(BeNil())
+			err := system.Start()
+			Expect(err).NotTo(HaveOccurred())
+
+			system.Tracer().Record(&grpctracing.Span{Name: "protos.Endorser/ProcessProposal"})
+			Eventually(paths).Should(Receive(Equal("/v1/traces")))
+		})
+
+		Context("when tracing is disabled", func() {
+			BeforeEach(func() {
+				options.Tracing.Enabled = false
+				system = operations.NewSystem(options)
+			})
+
+			It("does not create a tracer", func() {
+				Expect(system.Tracer()).To(BeNil())
+			})
+		})
+	})
+
 	Context("when the metrics provider is unknown", func() {
 		BeforeEach(func() {
 			options.Metrics.Provider = "something-unknown"
@@ -143,6 +143,7 @@ func GetServerConfig() (comm.ServerConfig, error) {
 		secureOptions.Certificate = serverCert
 		secureOptions.Key = serverKey
 		secureOptions.RequireClientCert = viper.GetBool("peer.tls.clientAuthRequired")
+		secureOptions.SessionResumption = viper.GetBool("peer.tls.sessionResumption.enabled")
 		if secureOptions.RequireClientCert {
 			var clientRoots [][]byte
 			for _, file := range viper.GetStringSlice("peer.tls.clientRootCAs.files") {
@@ -166,7 +167,7 @@ func GetServerConfig() (comm.ServerConfig, error) {
 		}
 	}
 	// get the default keepalive options
-	serverConfig.KaOpts = comm.DefaultKeepaliveOptions
+	serverConfig.KaOpts = comm.DefaultKeepaliveOptions.Clone()
 	// check to see if interval is set for the env
 	if viper.IsSet("peer.keepalive.interval") {
 		serverConfig.KaOpts.ServerInterval = viper.GetDuration("peer.keepalive.interval")
@@ -179,6 +180,10 @@ func GetServerConfig() (comm.ServerConfig, error) {
 	if viper.IsSet("peer.keepalive.minInterval") {
 		serverConfig.KaOpts.ServerMinInterval = viper.GetDuration("peer.keepalive.minInterval")
 	}
+	serverConfig.KaOpts.MaxConnectionIdle = viper.GetDuration("peer.keepalive.maxConnectionIdle")
+	serverConfig.KaOpts.MaxConnectionAge = viper.GetDuration("peer.keepalive.maxConnectionAge")
+	serverConfig.KaOpts.MaxConnectionAgeGrace = viper.GetDuration("peer.keepalive.maxConnectionAgeGrace")
+	serverConfig.KaOpts.MaxConcurrentStreams = uint32(viper.GetInt("peer.keepalive.maxConcurrentStreams"))
 	return serverConfig, nil
 }
 
@@ -8,6 +8,7 @@ package peer
 import (
 	"crypto/tls"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"path/filepath"
 	"testing"
@@ -186,6 +187,43 @@ func TestGetServerConfig(t *testing.T) {
 
 }
 
+func TestTLSLoader(t *testing.T) {
+	viper.Set("peer.tls.enabled", true)
+	viper.Set("peer.tls.clientAuthRequired", true)
+	viper.Set("peer.tls.cert.file", filepath.Join("testdata", "Org1-server1-cert.pem"))
+	viper.Set("peer.tls.key.file", filepath.Join("testdata", "Org1-server1-key.pem"))
+	viper.Set("peer.tls.rootcert.file", filepath.Join("testdata", "Org1-cert.pem"))
+	viper.Set("peer.tls.clientRootCAs.files", []string{filepath.Join("testdata", "Org2-cert.pem")})
+	defer func() {
+		viper.Set("peer.tls.enabled", false)
+		viper.Set("peer.tls.clientAuthRequired", false)
+	}()
+
+	channelRoot := []byte("channel root")
+	credSupport.Lock()
+	credSupport.AppRootCAsByChain["tlsloaderchannel"] = [][]byte{channelRoot}
+	credSupport.Unlock()
+	defer func() {
+		credSupport.Lock()
+		delete(credSupport.AppRootCAsByChain, "tlsloaderchannel")
+		credSupport.Unlock()
+	}()
+
+	secOpts, err := TLSLoader()()
+	assert.NoError(t, err)
+	cert, _ := ioutil.ReadFile(filepath.Join("testdata", "Org1-server1-cert.pem"))
+	assert.Equal(t, cert, secOpts.Certificate)
+	org1Root, _ := ioutil.ReadFile(filepath.Join("testdata", "Org1-cert.pem"))
+	org2Root, _ := ioutil.ReadFile(filepath.Join("testdata", "Org2-cert.pem"))
+	assert.Contains(t, secOpts.ClientRootCAs, channelRoot)
+	assert.Contains(t, secOpts.ClientRootCAs, org1Root)
+	assert.Contains(t, secOpts.ClientRootCAs, org2Root)
+
+	viper.Set("peer.tls.cert.file", filepath.Join("testdata", "Org11-cert.pem"))
+	_, err = TLSLoader()()
+	assert.Error(t, err, "TLSLoader should return error with bad tls cert path")
+}
+
 func TestGetClientCertificate(t *testing.T) {
 	viper.Set("peer.tls.key.file", "")
 	viper.Set("peer.tls.cert.file", "")
@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package peer
 
 import (
+	"fmt"
 	"runtime/debug"
 	"time"
 
@@ -15,8 +16,11 @@ import (
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/metrics"
 	"github.com/hyperledger/fabric/core/aclmgmt/resources"
+	"github.com/hyperledger/fabric/core/common/privdata"
+	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/ledger/rwset"
 	"github.com/hyperledger/fabric/protos/peer"
 	"github.com/hyperledger/fabric/protoutil"
 	"github.com/pkg/errors"
@@ -33,6 +37,7 @@ type PolicyCheckerProvider func(resourceName string) deliver.PolicyCheckerFunc
 type server struct {
 	dh                    *deliver.Handler
 	policyCheckerProvider PolicyCheckerProvider
+	collectionFilter      privdata.CollectionFilter
 }
 
 // blockResponseSender structure used to send block responses
@@ -49,9 +54,10 @@ func (brs *blockResponseSender) SendStatusResponse(status common.Status) error {
 }
 
 // SendBlockResponse generates deliver response with block message
-func (brs *blockResponseSender) SendBlockResponse(block *common.Block) error {
+func (brs *blockResponseSender) SendBlockResponse(block *common.Block, channelID string, chain deliver.Chain, signedData *protoutil.SignedData) error {
 	response := &peer.DeliverResponse{
-		Type: &peer.DeliverResponse_Block{Block: block},
+		Type:        &peer.DeliverResponse_Block{Block: block},
+		ResumeToken: deliver.CheckpointToken(block),
 	}
 	return brs.Send(response)
 }
@@ -59,6 +65,9 @@ func (brs *blockResponseSender) SendBlockResponse(block *common.Block) error {
 // filteredBlockResponseSender structure used to send filtered block responses
 type filteredBlockResponseSender struct {
 	peer.Deliver_DeliverFilteredServer
+	// withPayloads indicates whether the filtered blocks include the payloads
+	// of the chaincode events and the hashes of the private data collections
+	withPayloads bool
 }
 
 // SendStatusResponse generates status reply proto message
@@ -76,20 +85,147 @@ func (fbrs *filteredBlockResponseSender) IsFiltered() bool {
 }
 
 // SendBlockResponse generates deliver response with block message
-func (fbrs *filteredBlockResponseSender) SendBlockResponse(block *common.Block) error {
+func (fbrs *filteredBlockResponseSender) SendBlockResponse(block *common.Block, channelID string, chain deliver.Chain, signedData *protoutil.SignedData) error {
 	// Generates filtered block response
 	b := blockEvent(*block)
-	filteredBlock, err := b.toFilteredBlock()
+	filteredBlock, err := b.toFilteredBlock(fbrs.withPayloads)
 	if err != nil {
 		logger.Warningf("Failed to generate filtered block due to: %s", err)
 		return fbrs.SendStatusResponse(common.Status_BAD_REQUEST)
 	}
 	response := &peer.DeliverResponse{
-		Type: &peer.DeliverResponse_FilteredBlock{FilteredBlock: filteredBlock},
+		Type:        &peer.DeliverResponse_FilteredBlock{FilteredBlock: filteredBlock},
+		ResumeToken: deliver.CheckpointToken(block),
 	}
 	return fbrs.Send(response)
 }
 
+// blockAndPrivateDataResponseSender structure used to send blocks along with
+// the private data of the collections the client is a member of
+type blockAndPrivateDataResponseSender struct {
+	peer.Deliver_DeliverWithPrivateDataServer
+	collectionFilter privdata.CollectionFilter
+}
+
+// privateDataChain is a channel whose ledger stores the private data
+type privateDataChain interface {
+	Ledger() ledger.PeerLedger
+}
+
+// SendStatusResponse generates status reply proto message
+func (bprs *blockAndPrivateDataResponseSender) SendStatusResponse(status common.Status) error {
+	reply := &peer.DeliverResponse{
+		Type: &peer.DeliverResponse_Status{Status: status},
+	}
+	return bprs.Send(reply)
+}
+
+// SendBlockResponse generates deliver response with the block and the private
+// data of the block that the client is entitled to read
+func (bprs *blockAndPrivateDataResponseSender) SendBlockResponse(block *common.Block, channelID string, chain deliver.Chain, signedData *protoutil.SignedData) error {
+	pvtData, err := bprs.getPrivateData(block, channelID, chain, signedData)
+	if err != nil {
+		return err
+	}
+	response := &peer.DeliverResponse{
+		Type: &peer.DeliverResponse_BlockAndPrivateData{BlockAndPrivateData: &peer.BlockAndPrivateData{
+			Block:          block,
+			PrivateDataMap: pvtData,
+		}},
+		ResumeToken: deliver.CheckpointToken(block),
+	}
+	return bprs.Send(response)
+}
+
+// getPrivateData returns the private data of the transactions of the block,
+// keyed by the sequence of the transactions in the block, restricted to the
+// collections whose member orgs policy is satisfied by the signed data.
+// The policies are the ones of the collection configs committed before the
+// block, as the private data was disseminated according to them.
+func (bprs *blockAndPrivateDataResponseSender) getPrivateData(block *common.Block, channelID string, chain deliver.Chain, signedData *protoutil.SignedData) (map[uint64]*rwset.TxPvtReadWriteSet, error) {
+	pdc, ok := chain.(privateDataChain)
+	if !ok {
+		return nil, errors.Errorf("channel %s does not store private data", channelID)
+	}
+	lgr := pdc.Ledger()
+	pvtData, err := lgr.GetPvtDataByNum(block.Header.Number, nil)
+	if err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("failed retrieving private data of block [%d]", block.Header.Number))
+	}
+	configHistory, err := lgr.GetConfigHistoryRetriever()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed retrieving collection config history")
+	}
+
+	// membership is evaluated once per collection of the block
+	members := map[string]bool{}
+	isMember := func(namespace, collection string) (bool, error) {
+		key := namespace + "/" + collection
+		if member, evaluated := members[key]; evaluated {
+			return member, nil
+		}
+		member, err := bprs.isCollectionMember(configHistory, block.Header.Number, channelID, namespace, collection, signedData)
+		if err != nil {
+			return false, err
+		}
+		members[key] = member
+		return member, nil
+	}
+
+	pvtDataMap := make(map[uint64]*rwset.TxPvtReadWriteSet)
+	for _, txPvtData := range pvtData {
+		if txPvtData.WriteSet == nil {
+			continue
+		}
+		txPvtRWSet := &rwset.TxPvtReadWriteSet{DataModel: txPvtData.WriteSet.DataModel}
+		for _, nsPvtRWSet := range txPvtData.WriteSet.NsPvtRwset {
+			filteredNsPvtRWSet := &rwset.NsPvtReadWriteSet{Namespace: nsPvtRWSet.Namespace}
+			for _, collPvtRWSet := range nsPvtRWSet.CollectionPvtRwset {
+				member, err := isMember(nsPvtRWSet.Namespace, collPvtRWSet.CollectionName)
+				if err != nil {
+					return nil, err
+				}
+				if member {
+					filteredNsPvtRWSet.CollectionPvtRwset = append(filteredNsPvtRWSet.CollectionPvtRwset, collPvtRWSet)
+				}
+			}
+			if len(filteredNsPvtRWSet.CollectionPvtRwset) > 0 {
+				txPvtRWSet.NsPvtRwset = append(txPvtRWSet.NsPvtRwset, filteredNsPvtRWSet)
+			}
+		}
+		if len(txPvtRWSet.NsPvtRwset) > 0 {
+			pvtDataMap[txPvtData.SeqInBlock] = txPvtRWSet
+		}
+	}
+	return pvtDataMap, nil
+}
+
+// isCollectionMember returns whether the signed data satisfies the member orgs
+// policy of the collection as configured before the given block
+func (bprs *blockAndPrivateDataResponseSender) isCollectionMember(configHistory ledger.ConfigHistoryRetriever, blockNum uint64, channelID, namespace, collection string, signedData *protoutil.SignedData) (bool, error) {
+	configInfo, err := configHistory.MostRecentCollectionConfigBelow(blockNum, namespace)
+	if err != nil {
+		return false, errors.WithMessage(err, fmt.Sprintf("failed retrieving collection config of chaincode %s", namespace))
+	}
+	if configInfo == nil || configInfo.CollectionConfig == nil {
+		logger.Debugf("[channel: %s] No collection config found for chaincode %s below block [%d]", channelID, namespace, blockNum)
+		return false, nil
+	}
+	for _, config := range configInfo.CollectionConfig.Config {
+		staticConfig := config.GetStaticCollectionConfig()
+		if staticConfig == nil || staticConfig.Name != collection {
+			continue
+		}
+		filter, err := bprs.collectionFilter.AccessFilter(channelID, staticConfig.MemberOrgsPolicy)
+		if err != nil {
+			return false, errors.WithMessage(err, fmt.Sprintf("failed setting up access policy of collection %s of chaincode %s", collection, namespace))
+		}
+		return filter(*signedData), nil
+	}
+	logger.Debugf("[channel: %s] Collection %s of chaincode %s not found below block [%d]", channelID, collection, namespace, blockNum)
+	return false, nil
+}
+
 // transactionActions aliasing for peer.TransactionAction pointers slice
 type transactionActions []*peer.TransactionAction
 
@@ -112,6 +248,25 @@ func (s *server) DeliverFiltered(srv peer.Deliver_DeliverFilteredServer) error {
 	return s.dh.Handle(srv.Context(), deliverServer)
 }
 
+// DeliverFilteredWithPayloads sends a stream of filtered blocks to a client
+// after commitment, where the chaincode actions include the payloads of the
+// chaincode events and the hashes of the private data written to collections
+func (s *server) DeliverFilteredWithPayloads(srv peer.Deliver_DeliverFilteredWithPayloadsServer) error {
+	logger.Debugf("Starting new DeliverFilteredWithPayloads handler")
+	defer dumpStacktraceOnPanic()
+	// the payloads of the chaincode events are only part of full blocks,
+	// hence the policy checker is based on resources.Event_Block resource name
+	deliverServer := &deliver.Server{
+		Receiver:      srv,
+		PolicyChecker: s.policyCheckerProvider(resources.Event_Block),
+		ResponseSender: &filteredBlockResponseSender{
+			Deliver_DeliverFilteredServer: srv,
+			withPayloads:                  true,
+		},
+	}
+	return s.dh.Handle(srv.Context(), deliverServer)
+}
+
 // Deliver sends a stream of blocks to a client after commitment
 func (s *server) Deliver(srv peer.Deliver_DeliverServer) (err error) {
 	logger.Debugf("Starting new Deliver handler")
@@ -127,20 +282,54 @@ func (s *server) Deliver(srv peer.Deliver_DeliverServer) (err error) {
 	return s.dh.Handle(srv.Context(), deliverServer)
 }
 
+// DeliverWithPrivateData sends a stream of blocks to a client after
+// commitment, along with the private data of the collections the client is a
+// member of
+func (s *server) DeliverWithPrivateData(srv peer.Deliver_DeliverWithPrivateDataServer) error {
+	logger.Debugf("Starting new DeliverWithPrivateData handler")
+	defer dumpStacktraceOnPanic()
+	// the private data is delivered along with entire blocks, hence the
+	// policy checker is based on resources.Event_Block resource name
+	deliverServer := &deliver.Server{
+		PolicyChecker: s.policyCheckerProvider(resources.Event_Block),
+		Receiver:      srv,
+		ResponseSender: &blockAndPrivateDataResponseSender{
+			Deliver_DeliverWithPrivateDataServer: srv,
+			collectionFilter:                     s.collectionFilter,
+		},
+	}
+	return s.dh.Handle(srv.Context(), deliverServer)
+}
+
 // NewDeliverEventsServer creates a peer.Deliver server to deliver block and
-// filtered block events
-func NewDeliverEventsServer(mutualTLS bool, policyCheckerProvider PolicyCheckerProvider, chainManager deliver.ChainManager, metricsProvider metrics.Provider) peer.DeliverServer {
+// filtered block events. The collection filter determines the collections
+// whose private data is delivered to a client along with the blocks.
+func NewDeliverEventsServer(mutualTLS bool, policyCheckerProvider PolicyCheckerProvider, chainManager deliver.ChainManager, metricsProvider metrics.Provider, collectionFilter privdata.CollectionFilter) peer.DeliverServer {
+	timeWindow := authenticationTimeWindow()
+	metrics := deliver.NewMetrics(metricsProvider)
+	dh := deliver.NewHandler(chainManager, timeWindow, mutualTLS, metrics)
+	dh.SendQueue = deliver.SendQueueConfig{
+		Size:    viper.GetInt("peer.deliverService.sendQueueSize"),
+		Timeout: viper.GetDuration("peer.deliverService.slowConsumerTimeout"),
+		Policy:  deliver.SlowConsumerPolicy(viper.GetString("peer.deliverService.slowConsumerPolicy")),
+	}
+	return &server{
+		dh:                    dh,
+		policyCheckerProvider: policyCheckerProvider,
+		collectionFilter:      collectionFilter,
+	}
+}
+
+// authenticationTimeWindow returns the maximum time between the timestamp of
+// the requests and the time of the peer
+func authenticationTimeWindow() time.Duration {
 	timeWindow := viper.GetDuration("peer.authentication.timewindow")
 	if timeWindow == 0 {
 		defaultTimeWindow := 15 * time.Minute
 		logger.Warningf("`peer.authentication.timewindow` not set; defaulting to %s", defaultTimeWindow)
 		timeWindow = defaultTimeWindow
 	}
-	metrics := deliver.NewMetrics(metricsProvider)
-	return &server{
-		dh:                    deliver.NewHandler(chainManager, timeWindow, mutualTLS, metrics),
-		policyCheckerProvider: policyCheckerProvider,
-	}
+	return timeWindow
 }
 
 func (s *server) sendProducer(srv peer.Deliver_DeliverFilteredServer) func(msg proto.Message) error {
@@ -154,7 +343,7 @@ func (s *server) sendProducer(srv peer.Deliver_DeliverFilteredServer) func(msg p
 	}
 }
 
-func (block *blockEvent) toFilteredBlock() (*peer.FilteredBlock, error) {
+func (block *blockEvent) toFilteredBlock(withPayloads bool) (*peer.FilteredBlock, error) {
 	filteredBlock := &peer.FilteredBlock{
 		Number: block.Header.Number,
 	}
@@ -206,7 +395,7 @@ func (block *blockEvent) toFilteredBlock() (*peer.FilteredBlock, error) {
 				return nil, errors.WithMessage(err, "error unmarshal transaction payload for block event")
 			}
 
-			filteredTransaction.Data, err = transactionActions(tx.Actions).toFilteredActions()
+			filteredTransaction.Data, err = transactionActions(tx.Actions).toFilteredActions(withPayloads)
 			if err != nil {
 				logger.Errorf(err.Error())
 				return nil, err
@@ -219,7 +408,7 @@ func (block *blockEvent) toFilteredBlock() (*peer.FilteredBlock, error) {
 	return filteredBlock, nil
 }
 
-func (ta transactionActions) toFilteredActions() (*peer.FilteredTransaction_TransactionActions, error) {
+func (ta transactionActions) toFilteredActions(withPayloads bool) (*peer.FilteredTransaction_TransactionActions, error) {
 	transactionActions := &peer.FilteredTransactionActions{}
 	for _, action := range ta {
 		chaincodeActionPayload, err := protoutil.GetChaincodeActionPayload(action.Payload)
@@ -246,14 +435,29 @@ func (ta transactionActions) toFilteredActions() (*peer.FilteredTransaction_Tran
 			return nil, errors.WithMessage(err, "error unmarshal chaincode event for block event")
 		}
 
-		if ccEvent.GetChaincodeId() != "" {
-			filteredAction := &peer.FilteredChaincodeAction{
-				ChaincodeEvent: &peer.ChaincodeEvent{
-					TxId:        ccEvent.TxId,
-					ChaincodeId: ccEvent.ChaincodeId,
-					EventName:   ccEvent.EventName,
-				},
+		if !withPayloads {
+			if ccEvent.GetChaincodeId() != "" {
+				filteredAction := &peer.FilteredChaincodeAction{
+					ChaincodeEvent: &peer.ChaincodeEvent{
+						TxId:        ccEvent.TxId,
+						ChaincodeId: ccEvent.ChaincodeId,
+						EventName:   ccEvent.EventName,
+					},
+				}
+				transactionActions.ChaincodeActions = append(transactionActions.ChaincodeActions, filteredAction)
 			}
+			continue
+		}
+
+		filteredAction := &peer.FilteredChaincodeAction{}
+		if ccEvent.GetChaincodeId() != "" {
+			filteredAction.ChaincodeEvent = ccEvent
+		}
+		filteredAction.CollectionHashes, err = toFilteredCollectionHashes(caPayload.Results)
+		if err != nil {
+			return nil, err
+		}
+		if filteredAction.ChaincodeEvent != nil || len(filteredAction.CollectionHashes) != 0 {
 			transactionActions.ChaincodeActions = append(transactionActions.ChaincodeActions, filteredAction)
 		}
 	}
@@ -262,6 +466,27 @@ func (ta transactionActions) toFilteredActions() (*peer.FilteredTransaction_Tran
 	}, nil
 }
 
+// toFilteredCollectionHashes returns the hashes of the private data written
+// to collections in the given marshaled read-write set
+func toFilteredCollectionHashes(results []byte) ([]*peer.FilteredCollectionHash, error) {
+	txRWSet := &rwset.TxReadWriteSet{}
+	if err := proto.Unmarshal(results, txRWSet); err != nil {
+		return nil, errors.Wrap(err, "error unmarshal read-write set for block event")
+	}
+
+	var collectionHashes []*peer.FilteredCollectionHash
+	for _, nsRWSet := range txRWSet.NsRwset {
+		for _, collHashedRWSet := range nsRWSet.CollectionHashedRwset {
+			collectionHashes = append(collectionHashes, &peer.FilteredCollectionHash{
+				Namespace:      nsRWSet.Namespace,
+				CollectionName: collHashedRWSet.CollectionName,
+				PvtRwsetHash:   collHashedRWSet.PvtRwsetHash,
+			})
+		}
+	}
+	return collectionHashes, nil
+}
+
 func dumpStacktraceOnPanic() {
 	func() {
 		if r := recover(); r != nil {
@@ -13,12 +13,17 @@ import (
 	"testing"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/cauthdsl"
 	"github.com/hyperledger/fabric/common/deliver"
 	"github.com/hyperledger/fabric/common/ledger/blockledger"
 	"github.com/hyperledger/fabric/common/metrics/disabled"
 	"github.com/hyperledger/fabric/common/policies"
 	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/core/aclmgmt/resources"
+	"github.com/hyperledger/fabric/core/common/privdata"
+	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/ledger/rwset"
 	"github.com/hyperledger/fabric/protos/orderer"
 	"github.com/hyperledger/fabric/protos/peer"
 	"github.com/hyperledger/fabric/protoutil"
@@ -203,6 +208,9 @@ func TestEventsServer_DeliverFiltered(t *testing.T) {
 							case *peer.DeliverResponse_FilteredBlock:
 								block := response.GetFilteredBlock()
 								config.Equal(uint64(0), block.Number)
+								checkpoint := &orderer.DeliverCheckpoint{}
+								config.NoError(proto.Unmarshal(response.ResumeToken, checkpoint))
+								config.Equal(block.Number, checkpoint.Number)
 								config.Equal(config.channelID, block.ChannelId)
 								config.Equal(1, len(block.FilteredTransactions))
 								tx := block.FilteredTransactions[0]
@@ -384,6 +392,7 @@ func TestEventsServer_DeliverFiltered(t *testing.T) {
 				defaultPolicyCheckerProvider,
 				chainManager,
 				&disabled.Provider{},
+				nil,
 			)
 			err := server.DeliverFiltered(deliverServer)
 			wg.Wait()
@@ -392,6 +401,281 @@ func TestEventsServer_DeliverFiltered(t *testing.T) {
 		})
 	}
 }
+func TestEventsServer_DeliverFilteredWithPayloads(t *testing.T) {
+	viper.Set("peer.authentication.timewindow", "1s")
+	config := testConfig{
+		channelID:     "testChainID",
+		eventName:     "testEvent",
+		chaincodeName: "mycc",
+		txID:          "testID",
+		payload: &common.Payload{
+			Header: &common.Header{
+				ChannelHeader: protoutil.MarshalOrPanic(&common.ChannelHeader{
+					ChannelId: "testChainID",
+					Timestamp: util.CreateUtcTimestamp(),
+				}),
+				SignatureHeader: protoutil.MarshalOrPanic(&common.SignatureHeader{}),
+			},
+			Data: protoutil.MarshalOrPanic(&orderer.SeekInfo{
+				Start:    &orderer.SeekPosition{Type: &orderer.SeekPosition_Specified{Specified: &orderer.SeekSpecified{Number: 0}}},
+				Stop:     &orderer.SeekPosition{Type: &orderer.SeekPosition_Newest{Newest: &orderer.SeekNewest{}}},
+				Behavior: orderer.SeekInfo_BLOCK_UNTIL_READY,
+			}),
+		},
+		Assertions: assert.New(t),
+	}
+
+	results := protoutil.MarshalOrPanic(&rwset.TxReadWriteSet{
+		NsRwset: []*rwset.NsReadWriteSet{
+			{
+				Namespace: "mycc",
+				CollectionHashedRwset: []*rwset.CollectionHashedReadWriteSet{
+					{CollectionName: "coll1", HashedRwset: []byte("hashed-rwset"), PvtRwsetHash: []byte("pvt-rwset-hash")},
+				},
+			},
+		},
+	})
+	chaincodeActionPayload := &peer.ChaincodeActionPayload{
+		Action: &peer.ChaincodeEndorsedAction{
+			ProposalResponsePayload: protoutil.MarshalOrPanic(&peer.ProposalResponsePayload{
+				Extension: protoutil.MarshalOrPanic(&peer.ChaincodeAction{
+					ChaincodeId: &peer.ChaincodeID{Name: "mycc"},
+					Results:     results,
+					Events: protoutil.MarshalOrPanic(&peer.ChaincodeEvent{
+						ChaincodeId: "mycc",
+						EventName:   "testEvent",
+						TxId:        "testID",
+						Payload:     []byte("event-payload"),
+					}),
+				}),
+			}),
+		},
+	}
+	chainManager := createDefaultSupportMamangerMock(config, chaincodeActionPayload)
+
+	p := &peer2.Peer{}
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+	deliverServer := &mockDeliverServer{}
+	deliverServer.On("Context").Return(peer2.NewContext(context.TODO(), p))
+	deliverServer.On("Recv").Return(&common.Envelope{
+		Payload: protoutil.MarshalOrPanic(config.payload),
+	}, nil).Run(func(_ mock.Arguments) {
+		deliverServer.Mock = mock.Mock{}
+		deliverServer.On("Context").Return(peer2.NewContext(context.TODO(), p))
+		deliverServer.On("Recv").Return(&common.Envelope{}, io.EOF)
+		deliverServer.On("Send", mock.Anything).Run(func(args mock.Arguments) {
+			defer wg.Done()
+			response := args.Get(0).(*peer.DeliverResponse)
+			switch response.Type.(type) {
+			case *peer.DeliverResponse_Status:
+				config.Equal(common.Status_SUCCESS, response.GetStatus())
+			case *peer.DeliverResponse_FilteredBlock:
+				block := response.GetFilteredBlock()
+				config.Equal(1, len(block.FilteredTransactions))
+				chaincodeActions := block.FilteredTransactions[0].GetTransactionActions().ChaincodeActions
+				config.Equal(1, len(chaincodeActions))
+				config.Equal([]byte("event-payload"), chaincodeActions[0].ChaincodeEvent.Payload)
+				config.Equal([]*peer.FilteredCollectionHash{
+					{Namespace: "mycc", CollectionName: "coll1", PvtRwsetHash: []byte("pvt-rwset-hash")},
+				}, chaincodeActions[0].CollectionHashes)
+			default:
+				config.FailNow("Unexpected response type")
+			}
+		}).Return(nil)
+	})
+
+	var checkedResources []string
+	server := NewDeliverEventsServer(
+		false,
+		func(resourceName string) deliver.PolicyCheckerFunc {
+			checkedResources = append(checkedResources, resourceName)
+			return defaultPolicyCheckerProvider(resourceName)
+		},
+		chainManager,
+		&disabled.Provider{},
+		nil,
+	)
+	err := server.DeliverFilteredWithPayloads(deliverServer)
+	wg.Wait()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{resources.Event_Block}, checkedResources)
+}
+
+// mockPrivateDataChain is a channel whose ledger stores private data
+type mockPrivateDataChain struct {
+	*mockChainSupport
+	ledger ledger.PeerLedger
+}
+
+func (m *mockPrivateDataChain) Ledger() ledger.PeerLedger {
+	return m.ledger
+}
+
+type mockPrivateDataLedger struct {
+	ledger.PeerLedger
+	pvtData          []*ledger.TxPvtData
+	collectionConfig *common.CollectionConfigPackage
+}
+
+func (m *mockPrivateDataLedger) GetPvtDataByNum(blockNum uint64, filter ledger.PvtNsCollFilter) ([]*ledger.TxPvtData, error) {
+	return m.pvtData, nil
+}
+
+func (m *mockPrivateDataLedger) GetConfigHistoryRetriever() (ledger.ConfigHistoryRetriever, error) {
+	return m, nil
+}
+
+func (m *mockPrivateDataLedger) CollectionConfigAt(blockNum uint64, chaincodeName string) (*ledger.CollectionConfigInfo, error) {
+	panic("implement me")
+}
+
+func (m *mockPrivateDataLedger) MostRecentCollectionConfigBelow(blockNum uint64, chaincodeName string) (*ledger.CollectionConfigInfo, error) {
+	if chaincodeName != "mycc" {
+		return nil, nil
+	}
+	return &ledger.CollectionConfigInfo{CollectionConfig: m.collectionConfig}, nil
+}
+
+// mockCollectionFilter grants access to the collections whose member orgs
+// policy includes Org1MSP
+type mockCollectionFilter struct{}
+
+func (mockCollectionFilter) AccessFilter(channelName string, collectionPolicyConfig *common.CollectionPolicyConfig) (privdata.Filter, error) {
+	member := proto.Equal(collectionPolicyConfig.GetSignaturePolicy(), cauthdsl.SignedByAnyMember([]string{"Org1MSP"}))
+	return func(protoutil.SignedData) bool {
+		return member
+	}, nil
+}
+
+func collectionConfig(name string, memberOrgs ...string) *common.CollectionConfig {
+	return &common.CollectionConfig{
+		Payload: &common.CollectionConfig_StaticCollectionConfig{
+			StaticCollectionConfig: &common.StaticCollectionConfig{
+				Name: name,
+				MemberOrgsPolicy: &common.CollectionPolicyConfig{
+					Payload: &common.CollectionPolicyConfig_SignaturePolicy{
+						SignaturePolicy: cauthdsl.SignedByAnyMember(memberOrgs),
+					},
+				},
+			},
+		},
+	}
+}
+
+func collectionPvtRWSet(namespace string, collections ...string) *rwset.TxPvtReadWriteSet {
+	nsPvtRWSet := &rwset.NsPvtReadWriteSet{Namespace: namespace}
+	for _, collection := range collections {
+		nsPvtRWSet.CollectionPvtRwset = append(nsPvtRWSet.CollectionPvtRwset, &rwset.CollectionPvtReadWriteSet{
+			CollectionName: collection,
+			Rwset:          []byte(collection + "-rwset"),
+		})
+	}
+	return &rwset.TxPvtReadWriteSet{
+		DataModel:  rwset.TxReadWriteSet_KV,
+		NsPvtRwset: []*rwset.NsPvtReadWriteSet{nsPvtRWSet},
+	}
+}
+
+func TestEventsServer_DeliverWithPrivateData(t *testing.T) {
+	viper.Set("peer.authentication.timewindow", "1s")
+	config := testConfig{
+		channelID:     "testChainID",
+		eventName:     "testEvent",
+		chaincodeName: "mycc",
+		txID:          "testID",
+		payload: &common.Payload{
+			Header: &common.Header{
+				ChannelHeader: protoutil.MarshalOrPanic(&common.ChannelHeader{
+					ChannelId: "testChainID",
+					Timestamp: util.CreateUtcTimestamp(),
+				}),
+				SignatureHeader: protoutil.MarshalOrPanic(&common.SignatureHeader{}),
+			},
+			Data: protoutil.MarshalOrPanic(&orderer.SeekInfo{
+				Start:    &orderer.SeekPosition{Type: &orderer.SeekPosition_Specified{Specified: &orderer.SeekSpecified{Number: 0}}},
+				Stop:     &orderer.SeekPosition{Type: &orderer.SeekPosition_Newest{Newest: &orderer.SeekNewest{}}},
+				Behavior: orderer.SeekInfo_BLOCK_UNTIL_READY,
+			}),
+		},
+		Assertions: assert.New(t),
+	}
+	chaincodeActionPayload, err := createChaincodeAction(config.chaincodeName, config.eventName, config.txID)
+	assert.NoError(t, err)
+	chain := createDefaultSupportMamangerMock(config, chaincodeActionPayload).GetChain(config.channelID).(*mockChainSupport)
+
+	chainManager := &mockChainManager{}
+	chainManager.On("GetChain", config.channelID).Return(&mockPrivateDataChain{
+		mockChainSupport: chain,
+		ledger: &mockPrivateDataLedger{
+			pvtData: []*ledger.TxPvtData{
+				{SeqInBlock: 0, WriteSet: collectionPvtRWSet("mycc", "coll1", "coll2")},
+				{SeqInBlock: 1, WriteSet: collectionPvtRWSet("mycc", "coll2")},
+				{SeqInBlock: 2, WriteSet: collectionPvtRWSet("othercc", "coll1")},
+			},
+			collectionConfig: &common.CollectionConfigPackage{
+				Config: []*common.CollectionConfig{
+					collectionConfig("coll1", "Org1MSP"),
+					collectionConfig("coll2", "Org2MSP"),
+				},
+			},
+		},
+	})
+
+	p := &peer2.Peer{}
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+	deliverServer := &mockDeliverServer{}
+	deliverServer.On("Context").Return(peer2.NewContext(context.TODO(), p))
+	deliverServer.On("Recv").Return(&common.Envelope{
+		Payload: protoutil.MarshalOrPanic(config.payload),
+	}, nil).Run(func(_ mock.Arguments) {
+		deliverServer.Mock = mock.Mock{}
+		deliverServer.On("Context").Return(peer2.NewContext(context.TODO(), p))
+		deliverServer.On("Recv").Return(&common.Envelope{}, io.EOF)
+		deliverServer.On("Send", mock.Anything).Run(func(args mock.Arguments) {
+			defer wg.Done()
+			response := args.Get(0).(*peer.DeliverResponse)
+			switch response.Type.(type) {
+			case *peer.DeliverResponse_Status:
+				config.Equal(common.Status_SUCCESS, response.GetStatus())
+			case *peer.DeliverResponse_BlockAndPrivateData:
+				blockAndPvtData := response.GetBlockAndPrivateData()
+				config.Equal(uint64(0), blockAndPvtData.Block.Header.Number)
+				config.Equal(map[uint64]*rwset.TxPvtReadWriteSet{
+					0: collectionPvtRWSet("mycc", "coll1"),
+				}, blockAndPvtData.PrivateDataMap)
+				config.NotEmpty(response.ResumeToken)
+			default:
+				config.FailNow("Unexpected response type")
+			}
+		}).Return(nil)
+	})
+
+	var checkedResources []string
+	server := NewDeliverEventsServer(
+		false,
+		func(resourceName string) deliver.PolicyCheckerFunc {
+			checkedResources = append(checkedResources, resourceName)
+			return defaultPolicyCheckerProvider(resourceName)
+		},
+		chainManager,
+		&disabled.Provider{},
+		mockCollectionFilter{},
+	)
+	err = server.DeliverWithPrivateData(deliverServer)
+	wg.Wait()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{resources.Event_Block}, checkedResources)
+}
+
+func TestBlockAndPrivateDataResponseSenderWithoutLedger(t *testing.T) {
+	bprs := &blockAndPrivateDataResponseSender{collectionFilter: mockCollectionFilter{}}
+	block := &common.Block{Header: &common.BlockHeader{Number: 5}}
+	err := bprs.SendBlockResponse(block, "testChainID", &mockChainSupport{}, &protoutil.SignedData{})
+	assert.EqualError(t, err, "channel testChainID does not store private data")
+}
+
 func createDefaultSupportMamangerMock(config testConfig, chaincodeActionPayload *peer.ChaincodeActionPayload) *mockChainManager {
 	chainManager := &mockChainManager{}
 	iter := &mockIterator{}
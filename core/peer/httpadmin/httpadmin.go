@@ -0,0 +1,152 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package httpadmin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hyperledger/fabric/common/flogging"
+)
+
+// URLBase is the path under which the handler serves the status of the
+// channels.
+const URLBase = "/channels/"
+
+//go:generate counterfeiter -o fakes/status_source.go -fake-name StatusSource . StatusSource
+
+// StatusSource provides the status of the channels joined by the peer and of
+// the chaincodes installed on it.
+type StatusSource interface {
+	// ChannelIDs returns the IDs of the channels joined by the peer
+	ChannelIDs() []string
+	// ChannelStatus returns the status of the given channel, or nil if the
+	// peer has not joined it
+	ChannelStatus(channelID string) (*ChannelStatus, error)
+	// InstalledChaincodes returns the chaincodes installed on the peer
+	InstalledChaincodes() ([]Chaincode, error)
+}
+
+// Chaincode identifies an installed or defined chaincode.
+type Chaincode struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	// Hash is the hex encoded hash of the chaincode package
+	Hash string `json:"hash,omitempty"`
+	// Lifecycle is the namespace of the lifecycle managing the chaincode,
+	// that is lscc or _lifecycle
+	Lifecycle string `json:"lifecycle"`
+}
+
+// ChannelStatus is the status of a channel joined by the peer.
+type ChannelStatus struct {
+	ChannelID         string      `json:"channel_id"`
+	Height            uint64      `json:"height"`
+	LastConfigBlock   uint64      `json:"last_config_block"`
+	DefinedChaincodes []Chaincode `json:"defined_chaincodes"`
+	GossipLeader      bool        `json:"gossip_leader"`
+}
+
+// PeerStatus is the status of all the channels joined by the peer.
+type PeerStatus struct {
+	Channels            []*ChannelStatus `json:"channels"`
+	InstalledChaincodes []Chaincode      `json:"installed_chaincodes"`
+}
+
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+func NewHandler(s StatusSource) *Handler {
+	return &Handler{
+		StatusSource: s,
+		Logger:       flogging.MustGetLogger("peer.httpadmin"),
+	}
+}
+
+// Handler serves the read only channel status endpoint of the operations
+// system.
+//
+// GET /channels/ returns the status of all the channels joined by the peer,
+// along with the chaincodes installed on the peer.
+//
+// GET /channels/<channel> returns the status of the channel.
+type Handler struct {
+	StatusSource StatusSource
+	Logger       *flogging.FabricLogger
+}
+
+func (h *Handler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		h.sendResponse(resp, http.StatusBadRequest, fmt.Errorf("invalid request method: %s", req.Method))
+		return
+	}
+
+	path := strings.TrimPrefix(req.URL.Path, URLBase)
+	switch {
+	case path == "":
+		h.servePeerStatus(resp)
+	case strings.Contains(path, "/"):
+		h.sendResponse(resp, http.StatusNotFound, fmt.Errorf("invalid channel path: %s", req.URL.Path))
+	default:
+		h.serveChannelStatus(resp, path)
+	}
+}
+
+func (h *Handler) servePeerStatus(resp http.ResponseWriter) {
+	status := &PeerStatus{Channels: []*ChannelStatus{}}
+	for _, channelID := range h.StatusSource.ChannelIDs() {
+		channelStatus, err := h.StatusSource.ChannelStatus(channelID)
+		if err != nil {
+			h.sendResponse(resp, http.StatusInternalServerError, fmt.Errorf("failed getting status of channel %s: %s", channelID, err))
+			return
+		}
+		// the channel was removed since the IDs were listed
+		if channelStatus == nil {
+			continue
+		}
+		status.Channels = append(status.Channels, channelStatus)
+	}
+
+	installed, err := h.StatusSource.InstalledChaincodes()
+	if err != nil {
+		h.sendResponse(resp, http.StatusInternalServerError, fmt.Errorf("failed getting installed chaincodes: %s", err))
+		return
+	}
+	status.InstalledChaincodes = installed
+
+	h.sendResponse(resp, http.StatusOK, status)
+}
+
+func (h *Handler) serveChannelStatus(resp http.ResponseWriter, channelID string) {
+	channelStatus, err := h.StatusSource.ChannelStatus(channelID)
+	if err != nil {
+		h.sendResponse(resp, http.StatusInternalServerError, fmt.Errorf("failed getting status of channel %s: %s", channelID, err))
+		return
+	}
+	if channelStatus == nil {
+		h.sendResponse(resp, http.StatusNotFound, fmt.Errorf("channel %s not found", channelID))
+		return
+	}
+	h.sendResponse(resp, http.StatusOK, channelStatus)
+}
+
+func (h *Handler) sendResponse(resp http.ResponseWriter, code int, payload interface{}) {
+	encoder := json.NewEncoder(resp)
+	if err, ok := payload.(error); ok {
+		payload = &ErrorResponse{Error: err.Error()}
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(code)
+
+	if err := encoder.Encode(payload); err != nil {
+		h.Logger.Errorw("failed to encode payload", "error", err)
+	}
+}
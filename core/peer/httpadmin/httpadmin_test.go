@@ -0,0 +1,151 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package httpadmin_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/core/peer/httpadmin"
+	"github.com/hyperledger/fabric/core/peer/httpadmin/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		fakeStatusSource *fakes.StatusSource
+		handler          *httpadmin.Handler
+	)
+
+	BeforeEach(func() {
+		fakeStatusSource = &fakes.StatusSource{}
+		fakeStatusSource.ChannelIDsReturns([]string{"mychannel"})
+		fakeStatusSource.ChannelStatusStub = func(channelID string) (*httpadmin.ChannelStatus, error) {
+			if channelID != "mychannel" {
+				return nil, nil
+			}
+			return &httpadmin.ChannelStatus{
+				ChannelID:       "mychannel",
+				Height:          10,
+				LastConfigBlock: 4,
+				DefinedChaincodes: []httpadmin.Chaincode{
+					{Name: "mycc", Version: "1.0", Hash: "0a0b", Lifecycle: "_lifecycle"},
+				},
+				GossipLeader: true,
+			}, nil
+		}
+		fakeStatusSource.InstalledChaincodesReturns([]httpadmin.Chaincode{
+			{Name: "mycc", Version: "1.0", Hash: "0a0b", Lifecycle: "_lifecycle"},
+			{Name: "oldcc", Version: "0.1", Hash: "0c0d", Lifecycle: "lscc"},
+		}, nil)
+		handler = &httpadmin.Handler{
+			StatusSource: fakeStatusSource,
+			Logger:       flogging.NewFabricLogger(flogging.NewZapLogger(nil)),
+		}
+	})
+
+	serve := func(method, path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+		return resp
+	}
+
+	Describe("GET /channels/", func() {
+		It("returns the status of all the channels and the installed chaincodes", func() {
+			resp := serve("GET", "/channels/")
+
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			Expect(resp.Header().Get("Content-Type")).To(Equal("application/json"))
+			Expect(resp.Body).To(MatchJSON(`{
+				"channels": [{
+					"channel_id": "mychannel",
+					"height": 10,
+					"last_config_block": 4,
+					"defined_chaincodes": [{"name": "mycc", "version": "1.0", "hash": "0a0b", "lifecycle": "_lifecycle"}],
+					"gossip_leader": true
+				}],
+				"installed_chaincodes": [
+					{"name": "mycc", "version": "1.0", "hash": "0a0b", "lifecycle": "_lifecycle"},
+					{"name": "oldcc", "version": "0.1", "hash": "0c0d", "lifecycle": "lscc"}
+				]
+			}`))
+		})
+
+		It("skips the channels removed since they were listed", func() {
+			fakeStatusSource.ChannelIDsReturns([]string{"mychannel", "removed"})
+			resp := serve("GET", "/channels/")
+
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			Expect(fakeStatusSource.ChannelStatusCallCount()).To(Equal(2))
+			Expect(resp.Body.String()).NotTo(ContainSubstring("removed"))
+		})
+
+		Context("when the status of a channel cannot be retrieved", func() {
+			BeforeEach(func() {
+				fakeStatusSource.ChannelStatusReturns(nil, errors.New("ledger-error"))
+				fakeStatusSource.ChannelStatusStub = nil
+			})
+
+			It("responds with an internal server error", func() {
+				resp := serve("GET", "/channels/")
+				Expect(resp.Code).To(Equal(http.StatusInternalServerError))
+				Expect(resp.Body).To(MatchJSON(`{"error": "failed getting status of channel mychannel: ledger-error"}`))
+			})
+		})
+
+		Context("when the installed chaincodes cannot be retrieved", func() {
+			BeforeEach(func() {
+				fakeStatusSource.InstalledChaincodesReturns(nil, errors.New("fs-error"))
+			})
+
+			It("responds with an internal server error", func() {
+				resp := serve("GET", "/channels/")
+				Expect(resp.Code).To(Equal(http.StatusInternalServerError))
+				Expect(resp.Body).To(MatchJSON(`{"error": "failed getting installed chaincodes: fs-error"}`))
+			})
+		})
+	})
+
+	Describe("GET /channels/<channel>", func() {
+		It("returns the status of the channel", func() {
+			resp := serve("GET", "/channels/mychannel")
+
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			Expect(fakeStatusSource.ChannelStatusArgsForCall(0)).To(Equal("mychannel"))
+			Expect(fakeStatusSource.InstalledChaincodesCallCount()).To(Equal(0))
+			Expect(resp.Body).To(MatchJSON(`{
+				"channel_id": "mychannel",
+				"height": 10,
+				"last_config_block": 4,
+				"defined_chaincodes": [{"name": "mycc", "version": "1.0", "hash": "0a0b", "lifecycle": "_lifecycle"}],
+				"gossip_leader": true
+			}`))
+		})
+
+		It("responds with not found when the peer has not joined the channel", func() {
+			resp := serve("GET", "/channels/otherchannel")
+			Expect(resp.Code).To(Equal(http.StatusNotFound))
+			Expect(resp.Body).To(MatchJSON(`{"error": "channel otherchannel not found"}`))
+		})
+
+		It("responds with not found for nested paths", func() {
+			resp := serve("GET", "/channels/mychannel/blocks")
+			Expect(resp.Code).To(Equal(http.StatusNotFound))
+			Expect(resp.Body).To(MatchJSON(`{"error": "invalid channel path: /channels/mychannel/blocks"}`))
+		})
+	})
+
+	It("rejects requests other than GET", func() {
+		resp := serve("PUT", "/channels/mychannel")
+		Expect(resp.Code).To(Equal(http.StatusBadRequest))
+		Expect(resp.Body).To(MatchJSON(`{"error": "invalid request method: PUT"}`))
+	})
+})
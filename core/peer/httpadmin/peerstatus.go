@@ -0,0 +1,203 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package httpadmin
+
+import (
+	"encoding/hex"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/chaincode/lifecycle"
+	"github.com/hyperledger/fabric/core/common/ccprovider"
+	"github.com/hyperledger/fabric/core/common/privdata"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/peer"
+	"github.com/hyperledger/fabric/gossip/election"
+	"github.com/hyperledger/fabric/protos/ledger/queryresult"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+)
+
+const legacyLifecycleNamespace = "lscc"
+
+// Elections provides the leader election services of the channels of the peer.
+type Elections interface {
+	// LeaderElection returns the leader election service of the given channel,
+	// or nil if the peer doesn't take part in leader elections for it
+	LeaderElection(channelID string) election.LeaderElectionService
+}
+
+// PeerStatusSource is the StatusSource of the channels joined by the peer
+// process.
+type PeerStatusSource struct {
+	// Lifecycle provides the chaincodes defined and installed with _lifecycle
+	Lifecycle *lifecycle.Lifecycle
+	// Elections provides the gossip leadership of the peer in its channels
+	Elections Elections
+	// StaticLeader is set if the peer is statically configured as the gossip
+	// leader of its organization, rather than elected
+	StaticLeader bool
+}
+
+// ChannelIDs returns the IDs of the channels joined by the peer.
+func (p *PeerStatusSource) ChannelIDs() []string {
+	var channelIDs []string
+	for _, info := range peer.GetChannelsInfo() {
+		channelIDs = append(channelIDs, info.ChannelId)
+	}
+	return channelIDs
+}
+
+// ChannelStatus returns the status of the channel, or nil if the peer has
+// not joined it.
+func (p *PeerStatusSource) ChannelStatus(channelID string) (*ChannelStatus, error) {
+	l := peer.GetLedger(channelID)
+	if l == nil {
+		return nil, nil
+	}
+
+	info, err := l.GetBlockchainInfo()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed getting blockchain info")
+	}
+	lastBlock, err := l.GetBlockByNumber(info.Height - 1)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed getting last block")
+	}
+	lastConfigBlock, err := protoutil.GetLastConfigIndexFromBlock(lastBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	definedChaincodes, err := p.definedChaincodes(l)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChannelStatus{
+		ChannelID:         channelID,
+		Height:            info.Height,
+		LastConfigBlock:   lastConfigBlock,
+		DefinedChaincodes: definedChaincodes,
+		GossipLeader:      p.isLeader(channelID),
+	}, nil
+}
+
+func (p *PeerStatusSource) isLeader(channelID string) bool {
+	if p.Elections == nil {
+		return p.StaticLeader
+	}
+	le := p.Elections.LeaderElection(channelID)
+	if le == nil {
+		return p.StaticLeader
+	}
+	return le.IsLeader()
+}
+
+// definedChaincodes returns the chaincodes defined in the channel of the
+// ledger, with _lifecycle or instantiated with lscc.
+func (p *PeerStatusSource) definedChaincodes(l ledger.PeerLedger) ([]Chaincode, error) {
+	qe, err := l.NewQueryExecutor()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed creating query executor")
+	}
+	defer qe.Done()
+
+	chaincodes := []Chaincode{}
+	if p.Lifecycle != nil {
+		state := &lifecycle.SimpleQueryExecutorShim{
+			Namespace:           lifecycle.LifecycleNamespace,
+			SimpleQueryExecutor: qe,
+		}
+		namespaces, err := p.Lifecycle.QueryNamespaceDefinitions(state)
+		if err != nil {
+			return nil, err
+		}
+		for name, namespaceType := range namespaces {
+			if namespaceType != lifecycle.FriendlyChaincodeDefinitionType {
+				continue
+			}
+			exists, definition, err := p.Lifecycle.ChaincodeDefinitionIfDefined(name, state)
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				continue
+			}
+			chaincodes = append(chaincodes, Chaincode{
+				Name:      name,
+				Version:   definition.EndorsementInfo.Version,
+				Hash:      hex.EncodeToString(definition.EndorsementInfo.Id),
+				Lifecycle: lifecycle.LifecycleNamespace,
+			})
+		}
+	}
+
+	itr, err := qe.GetStateRangeScanIterator(legacyLifecycleNamespace, "", "")
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed querying lscc")
+	}
+	defer itr.Close()
+	for {
+		result, err := itr.Next()
+		if err != nil {
+			return nil, errors.WithMessage(err, "failed querying lscc")
+		}
+		if result == nil {
+			break
+		}
+		kv := result.(*queryresult.KV)
+		if privdata.IsCollectionConfigKey(kv.Key) {
+			continue
+		}
+		ccdata := &ccprovider.ChaincodeData{}
+		if err := proto.Unmarshal(kv.Value, ccdata); err != nil {
+			return nil, errors.Wrapf(err, "failed unmarshaling chaincode data of %s", kv.Key)
+		}
+		chaincodes = append(chaincodes, Chaincode{
+			Name:      ccdata.Name,
+			Version:   ccdata.Version,
+			Hash:      hex.EncodeToString(ccdata.Id),
+			Lifecycle: legacyLifecycleNamespace,
+		})
+	}
+
+	return chaincodes, nil
+}
+
+// InstalledChaincodes returns the chaincodes installed on the peer, for
+// _lifecycle and for lscc.
+func (p *PeerStatusSource) InstalledChaincodes() ([]Chaincode, error) {
+	chaincodes := []Chaincode{}
+	if p.Lifecycle != nil {
+		installed, err := p.Lifecycle.QueryInstalledChaincodes()
+		if err != nil {
+			return nil, err
+		}
+		for _, cc := range installed {
+			chaincodes = append(chaincodes, Chaincode{
+				Name:      cc.Name,
+				Version:   cc.Version,
+				Hash:      hex.EncodeToString(cc.Id),
+				Lifecycle: lifecycle.LifecycleNamespace,
+			})
+		}
+	}
+
+	legacy, err := ccprovider.GetInstalledChaincodes()
+	if err != nil {
+		return nil, err
+	}
+	for _, cc := range legacy.Chaincodes {
+		chaincodes = append(chaincodes, Chaincode{
+			Name:      cc.Name,
+			Version:   cc.Version,
+			Hash:      hex.EncodeToString(cc.Id),
+			Lifecycle: legacyLifecycleNamespace,
+		})
+	}
+	return chaincodes, nil
+}
@@ -0,0 +1,60 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package peer
+
+import (
+	"sort"
+
+	"github.com/hyperledger/fabric/common/channelconfig"
+)
+
+// ordererEndpointOrgs maps the endpoints the peer pulls the blocks of a
+// channel from to the MSP ID of the orderer organization which publishes
+// them in the channel config. The endpoints of the orderer organizations take
+// precedence over the global OrdererAddresses of the channel, which are only
+// used when no orderer organization publishes endpoints, and are mapped to an
+// empty MSP ID.
+func ordererEndpointOrgs(channel channelconfig.Channel, orderer channelconfig.Orderer) map[string]string {
+	endpoints := make(map[string]string)
+	if orderer != nil {
+		orgs := orderer.Organizations()
+		orgNames := make([]string, 0, len(orgs))
+		for orgName := range orgs {
+			orgNames = append(orgNames, orgName)
+		}
+		sort.Strings(orgNames)
+		for _, orgName := range orgNames {
+			org := orgs[orgName]
+			for _, endpoint := range org.Endpoints() {
+				if mspID, exists := endpoints[endpoint]; exists {
+					peerLogger.Warningf("Orderer endpoint %s is published by both %s and %s, using the TLS CAs of %s", endpoint, mspID, org.MSPID(), mspID)
+					continue
+				}
+				endpoints[endpoint] = org.MSPID()
+			}
+		}
+	}
+	if len(endpoints) > 0 {
+		return endpoints
+	}
+
+	for _, endpoint := range channel.OrdererAddresses() {
+		endpoints[endpoint] = ""
+	}
+	return endpoints
+}
+
+// ordererEndpoints returns the sorted endpoints of ordererEndpointOrgs
+func ordererEndpoints(channel channelconfig.Channel, orderer channelconfig.Orderer) []string {
+	endpointOrgs := ordererEndpointOrgs(channel, orderer)
+	endpoints := make([]string, 0, len(endpointOrgs))
+	for endpoint := range endpointOrgs {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+	return endpoints
+}
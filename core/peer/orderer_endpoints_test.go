@@ -0,0 +1,60 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package peer
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/common/channelconfig"
+	mockchannelconfig "github.com/hyperledger/fabric/common/mocks/config"
+	"github.com/stretchr/testify/assert"
+)
+
+type ordererOrg struct {
+	mspID     string
+	endpoints []string
+}
+
+func (o *ordererOrg) Name() string        { return o.mspID }
+func (o *ordererOrg) MSPID() string       { return o.mspID }
+func (o *ordererOrg) Endpoints() []string { return o.endpoints }
+
+func TestOrdererEndpoints(t *testing.T) {
+	channel := &mockchannelconfig.Channel{
+		OrdererAddressesVal: []string{"global0:7050", "global1:7050"},
+	}
+
+	t.Run("NoOrdererConfig", func(t *testing.T) {
+		assert.Equal(t, map[string]string{"global0:7050": "", "global1:7050": ""}, ordererEndpointOrgs(channel, nil))
+		assert.Equal(t, []string{"global0:7050", "global1:7050"}, ordererEndpoints(channel, nil))
+	})
+
+	t.Run("NoOrgEndpoints", func(t *testing.T) {
+		orderer := &mockchannelconfig.Orderer{
+			OrganizationsVal: map[string]channelconfig.OrdererOrg{
+				"OrdererOrg1": &ordererOrg{mspID: "OrdererMSP1"},
+			},
+		}
+		assert.Equal(t, []string{"global0:7050", "global1:7050"}, ordererEndpoints(channel, orderer))
+	})
+
+	t.Run("OrgEndpoints", func(t *testing.T) {
+		orderer := &mockchannelconfig.Orderer{
+			OrganizationsVal: map[string]channelconfig.OrdererOrg{
+				"OrdererOrg1": &ordererOrg{mspID: "OrdererMSP1", endpoints: []string{"org1-orderer0:7050", "shared:7050"}},
+				"OrdererOrg2": &ordererOrg{mspID: "OrdererMSP2", endpoints: []string{"org2-orderer0:7050", "shared:7050"}},
+				"OrdererOrg3": &ordererOrg{mspID: "OrdererMSP3"},
+			},
+		}
+		assert.Equal(t, map[string]string{
+			"org1-orderer0:7050": "OrdererMSP1",
+			"org2-orderer0:7050": "OrdererMSP2",
+			"shared:7050":        "OrdererMSP1",
+		}, ordererEndpointOrgs(channel, orderer))
+		assert.Equal(t, []string{"org1-orderer0:7050", "org2-orderer0:7050", "shared:7050"}, ordererEndpoints(channel, orderer))
+	})
+}
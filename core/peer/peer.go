@@ -10,7 +10,10 @@ import (
 	"fmt"
 	"net"
 	"runtime"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/hyperledger/fabric/common/channelconfig"
 	cc "github.com/hyperledger/fabric/common/config"
@@ -71,9 +74,28 @@ type gossipSupport struct {
 	channelconfig.Application
 	configtx.Validator
 	channelconfig.Channel
+	ordererAddresses []string
+}
+
+// OrdererAddresses returns the endpoints the peer pulls the blocks of the
+// channel from, which may be published by the orderer organizations rather
+// than in the global OrdererAddresses of the channel
+func (gs *gossipSupport) OrdererAddresses() []string {
+	return gs.ordererAddresses
+}
+
+// ordererConfig returns the orderer config of the bundle, or nil if there is none
+func ordererConfig(cm channelconfig.Resources) channelconfig.Orderer {
+	oc, ok := cm.OrdererConfig()
+	if !ok {
+		return nil
+	}
+	return oc
 }
 
 type chainSupport struct {
+	// applyLock serializes config updates with reloads of the channel MSPs
+	applyLock    sync.Mutex
 	bundleSource *channelconfig.BundleSource
 	channelconfig.Resources
 	channelconfig.Application
@@ -108,6 +130,9 @@ func (sp *storeProvider) OpenStore(ledgerID string) (transientstore.Store, error
 }
 
 func (cs *chainSupport) Apply(configtx *common.ConfigEnvelope) error {
+	cs.applyLock.Lock()
+	defer cs.applyLock.Unlock()
+
 	err := cs.ConfigtxValidator().Validate(configtx)
 	if err != nil {
 		return err
@@ -115,7 +140,7 @@ func (cs *chainSupport) Apply(configtx *common.ConfigEnvelope) error {
 
 	// If the chainSupport is being mocked, this field will be nil
 	if cs.bundleSource != nil {
-		bundle, err := channelconfig.NewBundle(cs.ConfigtxValidator().ChainID(), configtx.Config)
+		bundle, err := channelconfig.NewBundleWithMetrics(cs.ConfigtxValidator().ChainID(), configtx.Config, bundleMetrics)
 		if err != nil {
 			return err
 		}
@@ -134,6 +159,30 @@ func (cs *chainSupport) Apply(configtx *common.ConfigEnvelope) error {
 	return nil
 }
 
+// reloadMSPs sets up the channel configuration again from the current config,
+// which replaces the MSPs of the channel with new instances.
+func (cs *chainSupport) reloadMSPs() error {
+	cs.applyLock.Lock()
+	defer cs.applyLock.Unlock()
+
+	if cs.bundleSource == nil {
+		return nil
+	}
+
+	current := cs.bundleSource.ConfigtxValidator()
+	bundle, err := channelconfig.NewBundleWithMetrics(current.ChainID(), current.ConfigProto(), bundleMetrics)
+	if err != nil {
+		return err
+	}
+
+	if err := cs.bundleSource.ValidateNew(bundle); err != nil {
+		return err
+	}
+
+	cs.bundleSource.Update(bundle)
+	return nil
+}
+
 func capabilitiesSupportedOrPanic(res channelconfig.Resources) {
 	ac, ok := res.ApplicationConfig()
 	if !ok {
@@ -193,6 +242,9 @@ var chainInitializer func(string)
 
 var pluginMapper plugin.Mapper
 
+// bundleMetrics are the metrics reported by the MSPs and the policies of the channels
+var bundleMetrics *channelconfig.Metrics
+
 var mockMSPIDGetter func(string) []string
 
 func MockSetMSPIDGetter(mspIDGetter func(string) []string) {
@@ -218,6 +270,7 @@ func Initialize(init func(string), sccp sysccprovider.SystemChaincodeProvider,
 
 	pluginMapper = pm
 	chainInitializer = init
+	bundleMetrics = channelconfig.NewMetrics(metricsProvider)
 
 	var cb *common.Block
 	var ledger ledger.PeerLedger
@@ -272,8 +325,17 @@ func getCurrConfigBlockFromLedger(ledger ledger.PeerLedger) (*common.Block, erro
 	if err != nil {
 		return nil, err
 	}
+	// a ledger created from a snapshot does not hold the blocks of the snapshot,
+	// so the config block as of the snapshot is kept along with the ledger
+	snapshotConfigBlock, err := getSnapshotConfigBlock(ledger)
+	if err != nil {
+		return nil, err
+	}
 	lastBlock, err := ledger.GetBlockByNumber(blockchainInfo.Height - 1)
 	if err != nil {
+		if snapshotConfigBlock != nil {
+			return snapshotConfigBlock, nil
+		}
 		return nil, err
 	}
 
@@ -282,6 +344,9 @@ func getCurrConfigBlockFromLedger(ledger ledger.PeerLedger) (*common.Block, erro
 	if err != nil {
 		return nil, err
 	}
+	if snapshotConfigBlock != nil && snapshotConfigBlock.Header.Number == configBlockIndex {
+		return snapshotConfigBlock, nil
+	}
 
 	// get most recent config block
 	configBlock, err := ledger.GetBlockByNumber(configBlockIndex)
@@ -293,6 +358,14 @@ func getCurrConfigBlockFromLedger(ledger ledger.PeerLedger) (*common.Block, erro
 	return configBlock, nil
 }
 
+func getSnapshotConfigBlock(l ledger.PeerLedger) (*common.Block, error) {
+	retriever, ok := l.(ledger.SnapshotConfigBlockRetriever)
+	if !ok {
+		return nil, nil
+	}
+	return retriever.GetSnapshotConfigBlock()
+}
+
 // createChain creates a new chain object and insert it into the chains
 func createChain(cid string, ledger ledger.PeerLedger, cb *common.Block,
 	sccp sysccprovider.SystemChaincodeProvider, pm plugin.Mapper,
@@ -305,25 +378,27 @@ func createChain(cid string, ledger ledger.PeerLedger, cb *common.Block,
 		return err
 	}
 
-	var bundle *channelconfig.Bundle
-
-	if chanConf != nil {
-		bundle, err = channelconfig.NewBundle(cid, chanConf)
-		if err != nil {
-			return err
-		}
-	} else {
+	if chanConf == nil {
 		// Config was only stored in the statedb starting with v1.1 binaries
 		// so if the config is not found there, extract it manually from the config block
 		envelopeConfig, err := protoutil.ExtractEnvelope(cb, 0)
 		if err != nil {
 			return err
 		}
-
-		bundle, err = channelconfig.NewBundleFromEnvelope(envelopeConfig)
+		payload, err := protoutil.UnmarshalPayload(envelopeConfig.Payload)
 		if err != nil {
-			return err
+			return errors.Wrap(err, "failed to unmarshal payload from envelope")
+		}
+		configEnvelope, err := configtx.UnmarshalConfigEnvelope(payload.Data)
+		if err != nil {
+			return errors.Wrap(err, "failed to unmarshal config envelope from payload")
 		}
+		chanConf = configEnvelope.Config
+	}
+
+	bundle, err := channelconfig.NewBundleWithMetrics(cid, chanConf, bundleMetrics)
+	if err != nil {
+		return err
 	}
 
 	capabilitiesSupportedOrPanic(bundle)
@@ -339,9 +414,10 @@ func createChain(cid string, ledger ledger.PeerLedger, cb *common.Block,
 			ac = nil
 		}
 		gossipEventer.ProcessConfigUpdate(&gossipSupport{
-			Validator:   bundle.ConfigtxValidator(),
-			Application: ac,
-			Channel:     bundle.ChannelConfig(),
+			Validator:        bundle.ConfigtxValidator(),
+			Application:      ac,
+			Channel:          bundle.ChannelConfig(),
+			ordererAddresses: ordererEndpoints(bundle.ChannelConfig(), ordererConfig(bundle)),
 		})
 		service.GetGossipService().SuspectPeers(func(identity api.PeerIdentityType) bool {
 			// TODO: this is a place-holder that would somehow make the MSP layer suspect
@@ -401,7 +477,7 @@ func createChain(cid string, ledger ledger.PeerLedger, cb *common.Block,
 		return SetCurrConfigBlock(block, chainID)
 	})
 
-	ordererAddresses := bundle.ChannelConfig().OrdererAddresses()
+	ordererAddresses := ordererEndpoints(bundle.ChannelConfig(), ordererConfig(bundle))
 	if len(ordererAddresses) == 0 {
 		return errors.New("no ordering service endpoint provided in configuration block")
 	}
@@ -423,6 +499,13 @@ func createChain(cid string, ledger ledger.PeerLedger, cb *common.Block,
 		Store:                store,
 		Cs:                   simpleCollectionStore,
 		IdDeserializeFactory: csStoreSupport,
+		MaxBlockBytes: func() uint32 {
+			oc := ordererConfig(cs.bundleSource.StableBundle())
+			if oc == nil {
+				return 0
+			}
+			return oc.BatchSize().AbsoluteMaxBytes
+		},
 	})
 
 	chains.Lock()
@@ -451,6 +534,20 @@ func CreateChainFromBlock(cb *common.Block, sccp sysccprovider.SystemChaincodePr
 	return createChain(cid, l, cb, sccp, pluginMapper, deployedCCInfoProvider, legacyLifecycleValidation, newLifecycleValidation)
 }
 
+// CreateChainFromSnapshot creates a new chain from the snapshot in the given directory,
+// and returns its id. The chain starts at the block after the last block of the snapshot
+func CreateChainFromSnapshot(snapshotDir string, sccp sysccprovider.SystemChaincodeProvider, deployedCCInfoProvider ledger.DeployedChaincodeInfoProvider, legacyLifecycleValidation, newLifecycleValidation plugindispatcher.LifecycleResources) (string, error) {
+	l, cid, err := ledgermgmt.CreateLedgerFromSnapshot(snapshotDir)
+	if err != nil {
+		return "", errors.WithMessage(err, "cannot create ledger from snapshot")
+	}
+	cb, err := getSnapshotConfigBlock(l)
+	if err != nil {
+		return "", err
+	}
+	return cid, createChain(cid, l, cb, sccp, pluginMapper, deployedCCInfoProvider, legacyLifecycleValidation, newLifecycleValidation)
+}
+
 // GetLedger returns the ledger of the chain with chain ID. Note that this
 // call returns nil if chain cid has not been created.
 func GetLedger(cid string) ledger.PeerLedger {
@@ -484,6 +581,37 @@ func GetChannelConfig(cid string) channelconfig.Resources {
 	return nil
 }
 
+// ReloadChannelMSPs sets up the MSPs of every channel of the peer again from
+// the current channel configuration. Channel MSPs take their CA certificates
+// and CRLs from the channel configuration, so changes to them take effect with
+// the config update that makes them; reloading discards what the current MSP
+// instances have cached, such as identities validated before a CA certificate
+// expired. A channel whose MSPs fail to reload keeps its current ones.
+func ReloadChannelMSPs() error {
+	chains.RLock()
+	css := make(map[string]*chainSupport, len(chains.list))
+	for cid, c := range chains.list {
+		css[cid] = c.cs
+	}
+	chains.RUnlock()
+
+	var failed []string
+	for cid, cs := range css {
+		if err := cs.reloadMSPs(); err != nil {
+			peerLogger.Errorf("[channel %s] Failed reloading the channel MSPs: %s", cid, err)
+			failed = append(failed, cid)
+			continue
+		}
+		peerLogger.Infof("[channel %s] Reloaded the channel MSPs", cid)
+	}
+
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		return errors.Errorf("failed reloading the MSPs of channels %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
 // GetPolicyManager returns the policy manager of the chain with chain ID. Note that this
 // call returns nil if chain cid has not been created.
 func GetPolicyManager(cid string) policies.Manager {
@@ -517,25 +645,13 @@ func updateTrustedRoots(cm channelconfig.Resources) {
 	if err == nil && serverConfig.SecOpts.UseTLS {
 		buildTrustedRootsForChain(cm)
 
-		// now iterate over all roots for all app and orderer chains
-		trustedRoots := [][]byte{}
-		credSupport.RLock()
-		defer credSupport.RUnlock()
-		for _, roots := range credSupport.AppRootCAsByChain {
-			trustedRoots = append(trustedRoots, roots...)
-		}
-		// also need to append statically configured root certs
-		if len(serverConfig.SecOpts.ClientRootCAs) > 0 {
-			trustedRoots = append(trustedRoots, serverConfig.SecOpts.ClientRootCAs...)
-		}
-		if len(serverConfig.SecOpts.ServerRootCAs) > 0 {
-			trustedRoots = append(trustedRoots, serverConfig.SecOpts.ServerRootCAs...)
-		}
-
 		server := peerServer
-		// now update the client roots for the peerServer
+		// now update the client roots for the peerServer. The roots are taken
+		// under the lock of the server, as when its TLS material is reloaded.
 		if server != nil {
-			err := server.SetClientRootCAs(trustedRoots)
+			err := server.UpdateClientRootCAs(func() [][]byte {
+				return trustedClientRoots(serverConfig.SecOpts)
+			})
 			if err != nil {
 				msg := "Failed to update trusted roots for peer from latest config " +
 					"block.  This peer may not be able to communicate " +
@@ -546,6 +662,42 @@ func updateTrustedRoots(cm channelconfig.Resources) {
 	}
 }
 
+// returns the roots of all app chains along with the statically configured
+// root certs of secOpts
+func trustedClientRoots(secOpts *comm.SecureOptions) [][]byte {
+	// iterate over all roots for all app chains
+	trustedRoots := [][]byte{}
+	credSupport.RLock()
+	defer credSupport.RUnlock()
+	for _, roots := range credSupport.AppRootCAsByChain {
+		trustedRoots = append(trustedRoots, roots...)
+	}
+	// also need to append statically configured root certs
+	if len(secOpts.ClientRootCAs) > 0 {
+		trustedRoots = append(trustedRoots, secOpts.ClientRootCAs...)
+	}
+	if len(secOpts.ServerRootCAs) > 0 {
+		trustedRoots = append(trustedRoots, secOpts.ServerRootCAs...)
+	}
+	return trustedRoots
+}
+
+// TLSLoader returns a comm.TLSLoader which reads the TLS key pair and the
+// statically configured root certs of the peer server from disk again, and
+// combines the latter with the roots of the channels the peer is joined to,
+// so that reloading the TLS material does not drop the roots of any channel.
+func TLSLoader() comm.TLSLoader {
+	return func() (*comm.SecureOptions, error) {
+		serverConfig, err := GetServerConfig()
+		if err != nil {
+			return nil, err
+		}
+		secOpts := serverConfig.SecOpts
+		secOpts.ClientRootCAs = trustedClientRoots(secOpts)
+		return secOpts, nil
+	}
+}
+
 // populates the appRootCAs and orderRootCAs maps by getting the
 // root and intermediate certs for all msps associated with the MSPManager
 func buildTrustedRootsForChain(cm channelconfig.Resources) {
@@ -554,6 +706,8 @@ func buildTrustedRootsForChain(cm channelconfig.Resources) {
 
 	appRootCAs := [][]byte{}
 	ordererRootCAs := [][]byte{}
+	appOrgRootCAs := make(map[string][][]byte)
+	ordererOrgRootCAs := make(map[string][][]byte)
 	appOrgMSPs := make(map[string]struct{})
 	ordOrgMSPs := make(map[string]struct{})
 
@@ -586,11 +740,13 @@ func buildTrustedRootsForChain(cm channelconfig.Resources) {
 					if _, ok := appOrgMSPs[k]; ok {
 						peerLogger.Debugf("adding app root CAs for MSP [%s]", k)
 						appRootCAs = append(appRootCAs, root)
+						appOrgRootCAs[k] = append(appOrgRootCAs[k], root)
 					}
 					// check to see of this is an orderer org MSP
 					if _, ok := ordOrgMSPs[k]; ok {
 						peerLogger.Debugf("adding orderer root CAs for MSP [%s]", k)
 						ordererRootCAs = append(ordererRootCAs, root)
+						ordererOrgRootCAs[k] = append(ordererOrgRootCAs[k], root)
 					}
 				}
 				for _, intermediate := range v.GetTLSIntermediateCerts() {
@@ -598,17 +754,22 @@ func buildTrustedRootsForChain(cm channelconfig.Resources) {
 					if _, ok := appOrgMSPs[k]; ok {
 						peerLogger.Debugf("adding app root CAs for MSP [%s]", k)
 						appRootCAs = append(appRootCAs, intermediate)
+						appOrgRootCAs[k] = append(appOrgRootCAs[k], intermediate)
 					}
 					// check to see of this is an orderer org MSP
 					if _, ok := ordOrgMSPs[k]; ok {
 						peerLogger.Debugf("adding orderer root CAs for MSP [%s]", k)
 						ordererRootCAs = append(ordererRootCAs, intermediate)
+						ordererOrgRootCAs[k] = append(ordererOrgRootCAs[k], intermediate)
 					}
 				}
 			}
 		}
 		credSupport.AppRootCAsByChain[cid] = appRootCAs
 		credSupport.OrdererRootCAsByChain[cid] = ordererRootCAs
+		credSupport.AppOrgRootCAsByChain[cid] = appOrgRootCAs
+		credSupport.OrdererOrgRootCAsByChain[cid] = ordererOrgRootCAs
+		credSupport.OrdererEndpointOrgsByChain[cid] = ordererEndpointOrgs(cm.ChannelConfig(), ordererConfig(cm))
 	}
 }
 
@@ -770,6 +931,10 @@ func (flbs fileLedgerBlockStore) RetrieveBlocks(startBlockNumber uint64) (common
 	return flbs.GetBlocksIterator(startBlockNumber)
 }
 
+func (flbs fileLedgerBlockStore) RetrieveBlockNumberByTime(t time.Time) (uint64, error) {
+	return flbs.GetBlockNumberByTime(t)
+}
+
 // NewConfigSupport returns
 func NewConfigSupport() cc.Manager {
 	return &configSupport{}
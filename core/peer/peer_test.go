@@ -22,6 +22,8 @@ import (
 	deliverclient "github.com/hyperledger/fabric/core/deliverservice"
 	"github.com/hyperledger/fabric/core/deliverservice/blocksprovider"
 	validation "github.com/hyperledger/fabric/core/handlers/validation/api"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/ledgermgmt"
 	"github.com/hyperledger/fabric/core/ledger/mock"
 	ledgermocks "github.com/hyperledger/fabric/core/ledger/mock"
 	"github.com/hyperledger/fabric/gossip/api"
@@ -30,6 +32,9 @@ import (
 	msptesttools "github.com/hyperledger/fabric/msp/mgmt/testtools"
 	peergossip "github.com/hyperledger/fabric/peer/gossip"
 	"github.com/hyperledger/fabric/peer/gossip/mocks"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -128,7 +133,7 @@ func TestCreateChainFromBlock(t *testing.T) {
 	msptesttools.LoadMSPSetupForTesting()
 
 	identity, _ := mgmt.GetLocalSigningIdentityOrPanic().Serialize()
-	messageCryptoService := peergossip.NewMCS(&mocks.ChannelPolicyManagerGetter{}, localmsp.NewSigner(), mgmt.NewDeserializersManager())
+	messageCryptoService := peergossip.NewMCS(&mocks.ChannelPolicyManagerGetter{}, localmsp.NewSigner(), mgmt.NewDeserializersManager(), "")
 	secAdv := peergossip.NewSecurityAdvisor(mgmt.NewDeserializersManager())
 	var defaultSecureDialOpts = func() []grpc.DialOption {
 		var dialOpts []grpc.DialOption
@@ -210,20 +215,95 @@ func TestCreateChainFromBlock(t *testing.T) {
 		t.Fatalf("incorrect number of channels")
 	}
 
+	// Reloading the channel MSPs replaces them without changing the config
+	mspManager := GetChannelConfig(testChainID).MSPManager()
+	sequence := GetChannelConfig(testChainID).ConfigtxValidator().Sequence()
+	err = ReloadChannelMSPs()
+	assert.NoError(t, err)
+	assert.NotEqual(t, fmt.Sprintf("%p", mspManager), fmt.Sprintf("%p", GetChannelConfig(testChainID).MSPManager()))
+	assert.Equal(t, sequence, GetChannelConfig(testChainID).ConfigtxValidator().Sequence())
+
 	// cleanup the chain referenes to enable execution with -count n
 	chains.Lock()
 	chains.list = map[string]*chain{}
 	chains.Unlock()
 }
 
+type snapshotLedger struct {
+	ledger.PeerLedger
+	blocks              map[uint64]*common.Block
+	height              uint64
+	snapshotConfigBlock *common.Block
+}
+
+func (l *snapshotLedger) GetBlockchainInfo() (*common.BlockchainInfo, error) {
+	return &common.BlockchainInfo{Height: l.height}, nil
+}
+
+func (l *snapshotLedger) GetBlockByNumber(blockNumber uint64) (*common.Block, error) {
+	if block, ok := l.blocks[blockNumber]; ok {
+		return block, nil
+	}
+	return nil, errors.Errorf("block [%d] is not available", blockNumber)
+}
+
+func (l *snapshotLedger) GetSnapshotConfigBlock() (*common.Block, error) {
+	return l.snapshotConfigBlock, nil
+}
+
+func TestGetCurrConfigBlockFromSnapshotLedger(t *testing.T) {
+	newBlock := func(number, lastConfig uint64) *common.Block {
+		block := protoutil.NewBlock(number, nil)
+		block.Metadata.Metadata[common.BlockMetadataIndex_LAST_CONFIG] = protoutil.MarshalOrPanic(&common.Metadata{
+			Value: protoutil.MarshalOrPanic(&common.LastConfig{Index: lastConfig}),
+		})
+		return block
+	}
+	snapshotConfigBlock := newBlock(3, 3)
+	l := &snapshotLedger{
+		blocks:              map[uint64]*common.Block{},
+		height:              6,
+		snapshotConfigBlock: snapshotConfigBlock,
+	}
+
+	// no block after the snapshot
+	block, err := getCurrConfigBlockFromLedger(l)
+	assert.NoError(t, err)
+	assert.Equal(t, snapshotConfigBlock, block)
+
+	// a block after the snapshot with the config of the snapshot
+	l.blocks[6] = newBlock(6, 3)
+	l.height = 7
+	block, err = getCurrConfigBlockFromLedger(l)
+	assert.NoError(t, err)
+	assert.Equal(t, snapshotConfigBlock, block)
+
+	// a config block after the snapshot
+	l.blocks[7] = newBlock(7, 7)
+	l.height = 8
+	block, err = getCurrConfigBlockFromLedger(l)
+	assert.NoError(t, err)
+	assert.Equal(t, l.blocks[7], block)
+
+	// a ledger that is not created from a snapshot
+	l.snapshotConfigBlock = nil
+	l.height = 7
+	_, err = getCurrConfigBlockFromLedger(l)
+	assert.EqualError(t, err, "block [3] is not available")
+}
+
 func TestGetLocalIP(t *testing.T) {
 	ip := GetLocalIP()
 	t.Log(ip)
 }
 
 func TestDeliverSupportManager(t *testing.T) {
+	cleanup := setupPeerFS(t)
+	defer cleanup()
+
 	// reset chains for testing
 	MockInitialize()
+	defer ledgermgmt.CleanupTestEnv()
 
 	manager := &DeliverChainManager{}
 	chainSupport := manager.GetChain("fake")
@@ -0,0 +1,249 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package peer
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hyperledger/fabric/common/deliver"
+	commonledger "github.com/hyperledger/fabric/common/ledger"
+	"github.com/hyperledger/fabric/common/ledger/blkstorage"
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/core/aclmgmt/resources"
+	"github.com/hyperledger/fabric/core/comm"
+	ledgerUtil "github.com/hyperledger/fabric/core/ledger/util"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+)
+
+// TransactionStatusLedger is the ledger of a channel, which indexes the
+// commit status of the transactions by their ID
+type TransactionStatusLedger interface {
+	// GetBlockchainInfo returns basic info about blockchain
+	GetBlockchainInfo() (*common.BlockchainInfo, error)
+	// GetBlockByTxID returns a block which contains a transaction
+	GetBlockByTxID(txID string) (*common.Block, error)
+	// GetTxValidationCodeByTxID returns reason code of transaction validation
+	GetTxValidationCodeByTxID(txID string) (peer.TxValidationCode, error)
+	// GetBlocksIterator returns an iterator that starts from `startBlockNumber`(inclusive).
+	// The iterator is a blocking iterator i.e., it blocks till the next block gets available in the ledger
+	GetBlocksIterator(startBlockNumber uint64) (commonledger.ResultsIterator, error)
+}
+
+// TransactionStatusLedgerGetter returns the ledger of the given channel, or
+// nil if the peer has not joined the channel
+type TransactionStatusLedgerGetter func(channelID string) TransactionStatusLedger
+
+// transactionStatusServer serves the commit status of transactions, so that
+// clients do not need to open a deliver stream to learn the fate of a single
+// transaction
+type transactionStatusServer struct {
+	getLedger        TransactionStatusLedgerGetter
+	policyChecker    deliver.PolicyChecker
+	timeWindow       time.Duration
+	maxWaitTimeout   time.Duration
+	bindingInspector deliver.Inspector
+}
+
+// NewTransactionStatusServer creates a peer.TransactionStatusServer. As the
+// commit status of the transactions is part of the filtered blocks, the
+// requests are authorized against the resources.Event_FilteredBlock resource.
+func NewTransactionStatusServer(mutualTLS bool, policyCheckerProvider PolicyCheckerProvider, getLedger TransactionStatusLedgerGetter) peer.TransactionStatusServer {
+	return &transactionStatusServer{
+		getLedger:        getLedger,
+		policyChecker:    policyCheckerProvider(resources.Event_FilteredBlock),
+		timeWindow:       authenticationTimeWindow(),
+		maxWaitTimeout:   transactionStatusMaxWaitTimeout(),
+		bindingInspector: deliver.InspectorFunc(comm.NewBindingInspector(mutualTLS, deliver.ExtractChannelHeaderCertHash)),
+	}
+}
+
+// transactionStatusMaxWaitTimeout returns the maximum time a transaction
+// status request waits for its transaction to be committed
+func transactionStatusMaxWaitTimeout() time.Duration {
+	maxWaitTimeout := viper.GetDuration("peer.transactionStatus.maxWaitTimeout")
+	if maxWaitTimeout <= 0 {
+		defaultMaxWaitTimeout := time.Minute
+		logger.Warningf("`peer.transactionStatus.maxWaitTimeout` not set; defaulting to %s", defaultMaxWaitTimeout)
+		maxWaitTimeout = defaultMaxWaitTimeout
+	}
+	return maxWaitTimeout
+}
+
+// GetTransactionStatus returns the commit status of the transaction of the
+// request, waiting for it to be committed up to the wait timeout of the
+// request, which is clamped to the maximum wait timeout of the peer
+func (s *transactionStatusServer) GetTransactionStatus(ctx context.Context, envelope *common.Envelope) (*peer.TransactionStatusResponse, error) {
+	addr := util.ExtractRemoteAddress(ctx)
+	channelID, request, err := s.validateRequest(ctx, envelope)
+	if err != nil {
+		logger.Warningf("Rejecting transaction status request from %s: %s", addr, err)
+		return statusResponse(common.Status_BAD_REQUEST), nil
+	}
+
+	ledger := s.getLedger(channelID)
+	if ledger == nil {
+		logger.Debugf("Rejecting transaction status request from %s because channel %s not found", addr, channelID)
+		return statusResponse(common.Status_NOT_FOUND), nil
+	}
+
+	if err := s.policyChecker.CheckPolicy(envelope, channelID); err != nil {
+		logger.Warningf("[channel: %s] Client authorization revoked for transaction status request from %s: %s", channelID, addr, err)
+		return statusResponse(common.Status_FORBIDDEN), nil
+	}
+
+	var waitTimeout time.Duration
+	if request.WaitTimeout != nil {
+		waitTimeout, err = ptypes.Duration(request.WaitTimeout)
+		if err != nil || waitTimeout < 0 {
+			logger.Warningf("[channel: %s] Rejecting transaction status request from %s with invalid wait timeout %v", channelID, addr, request.WaitTimeout)
+			return statusResponse(common.Status_BAD_REQUEST), nil
+		}
+		if waitTimeout > s.maxWaitTimeout {
+			logger.Debugf("[channel: %s] Clamping wait timeout %s of transaction status request from %s to %s", channelID, waitTimeout, addr, s.maxWaitTimeout)
+			waitTimeout = s.maxWaitTimeout
+		}
+	}
+
+	resp, err := transactionStatus(ctx, ledger, request.TxId, waitTimeout)
+	if err != nil {
+		logger.Errorf("[channel: %s] Failed getting status of transaction %s for %s: %s", channelID, request.TxId, addr, err)
+		return statusResponse(common.Status_INTERNAL_SERVER_ERROR), nil
+	}
+	return resp, nil
+}
+
+func (s *transactionStatusServer) validateRequest(ctx context.Context, envelope *common.Envelope) (string, *peer.TransactionStatusRequest, error) {
+	payload, err := protoutil.UnmarshalPayload(envelope.Payload)
+	if err != nil {
+		return "", nil, err
+	}
+	if payload.Header == nil {
+		return "", nil, errors.New("envelope has no header")
+	}
+	chdr, err := protoutil.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	if err != nil {
+		return "", nil, err
+	}
+	if chdr.GetTimestamp() == nil {
+		return "", nil, errors.New("channel header in envelope must contain timestamp")
+	}
+	envTime := time.Unix(chdr.GetTimestamp().Seconds, int64(chdr.GetTimestamp().Nanos)).UTC()
+	serverTime := time.Now()
+	if math.Abs(float64(serverTime.UnixNano()-envTime.UnixNano())) > float64(s.timeWindow.Nanoseconds()) {
+		return "", nil, errors.Errorf("envelope timestamp %s is more than %s apart from current server time %s", envTime, s.timeWindow, serverTime)
+	}
+	if err := s.bindingInspector.Inspect(ctx, chdr); err != nil {
+		return "", nil, err
+	}
+
+	request := &peer.TransactionStatusRequest{}
+	if err := proto.Unmarshal(payload.Data, request); err != nil {
+		return "", nil, errors.Wrap(err, "malformed transaction status request")
+	}
+	if request.TxId == "" {
+		return "", nil, errors.New("transaction status request has no transaction ID")
+	}
+	return chdr.ChannelId, request, nil
+}
+
+// transactionStatus looks the transaction up in the transaction ID index of
+// the ledger, and scans the blocks committed next for it until the wait
+// timeout expires if it is not found
+func transactionStatus(ctx context.Context, ledger TransactionStatusLedger, txID string, waitTimeout time.Duration) (*peer.TransactionStatusResponse, error) {
+	// the height is retrieved first, so that a transaction committed while
+	// looking it up is found in the blocks scanned next
+	info, err := ledger.GetBlockchainInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := ledger.GetBlockByTxID(txID)
+	switch err {
+	case nil:
+		code, err := ledger.GetTxValidationCodeByTxID(txID)
+		if err != nil {
+			return nil, err
+		}
+		return &peer.TransactionStatusResponse{
+			Status:           common.Status_SUCCESS,
+			TxValidationCode: code,
+			BlockNumber:      block.Header.Number,
+		}, nil
+	case blkstorage.ErrNotFoundInIndex:
+	default:
+		return nil, err
+	}
+
+	if waitTimeout == 0 {
+		return statusResponse(common.Status_NOT_FOUND), nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, waitTimeout)
+	defer cancel()
+	itr, err := ledger.GetBlocksIterator(info.Height)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		// closing the iterator unblocks the pending call to Next
+		<-ctx.Done()
+		itr.Close()
+	}()
+
+	for {
+		result, err := itr.Next()
+		if err != nil {
+			return nil, err
+		}
+		if result == nil {
+			// the iterator was closed as the wait timed out
+			return statusResponse(common.Status_NOT_FOUND), nil
+		}
+		block := result.(*common.Block)
+		if resp := findTransaction(block, txID); resp != nil {
+			return resp, nil
+		}
+	}
+}
+
+// findTransaction returns the status of the transaction in the block, or nil
+// if the block does not contain the transaction
+func findTransaction(block *common.Block, txID string) *peer.TransactionStatusResponse {
+	txsFltr := ledgerUtil.TxValidationFlags(block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER])
+	for txIndex, ebytes := range block.Data.Data {
+		env, err := protoutil.GetEnvelopeFromBlock(ebytes)
+		if err != nil {
+			continue
+		}
+		payload, err := protoutil.GetPayload(env)
+		if err != nil || payload.Header == nil {
+			continue
+		}
+		chdr, err := protoutil.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+		if err != nil || chdr.TxId != txID {
+			continue
+		}
+		return &peer.TransactionStatusResponse{
+			Status:           common.Status_SUCCESS,
+			TxValidationCode: txsFltr.Flag(txIndex),
+			BlockNumber:      block.Header.Number,
+		}
+	}
+	return nil
+}
+
+func statusResponse(status common.Status) *peer.TransactionStatusResponse {
+	return &peer.TransactionStatusResponse{Status: status}
+}
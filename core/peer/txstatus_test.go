@@ -0,0 +1,177 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package peer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hyperledger/fabric/common/deliver"
+	commonledger "github.com/hyperledger/fabric/common/ledger"
+	"github.com/hyperledger/fabric/common/ledger/blkstorage"
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/core/aclmgmt/resources"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockTxStatusLedger struct {
+	height    uint64
+	committed map[string]*common.Block
+	codes     map[string]peer.TxValidationCode
+	next      chan *common.Block
+}
+
+func (l *mockTxStatusLedger) GetBlockchainInfo() (*common.BlockchainInfo, error) {
+	return &common.BlockchainInfo{Height: l.height}, nil
+}
+
+func (l *mockTxStatusLedger) GetBlockByTxID(txID string) (*common.Block, error) {
+	if block, exists := l.committed[txID]; exists {
+		return block, nil
+	}
+	return nil, blkstorage.ErrNotFoundInIndex
+}
+
+func (l *mockTxStatusLedger) GetTxValidationCodeByTxID(txID string) (peer.TxValidationCode, error) {
+	return l.codes[txID], nil
+}
+
+func (l *mockTxStatusLedger) GetBlocksIterator(startBlockNumber uint64) (commonledger.ResultsIterator, error) {
+	return &mockBlocksIterator{blocks: l.next, closed: make(chan struct{})}, nil
+}
+
+type mockBlocksIterator struct {
+	blocks <-chan *common.Block
+	closed chan struct{}
+}
+
+func (itr *mockBlocksIterator) Next() (commonledger.QueryResult, error) {
+	select {
+	case block := <-itr.blocks:
+		return block, nil
+	case <-itr.closed:
+		return nil, nil
+	}
+}
+
+func (itr *mockBlocksIterator) Close() {
+	close(itr.closed)
+}
+
+func txStatusRequest(channelID string, request *peer.TransactionStatusRequest) *common.Envelope {
+	return &common.Envelope{
+		Payload: protoutil.MarshalOrPanic(&common.Payload{
+			Header: &common.Header{
+				ChannelHeader: protoutil.MarshalOrPanic(&common.ChannelHeader{
+					ChannelId: channelID,
+					Timestamp: util.CreateUtcTimestamp(),
+				}),
+				SignatureHeader: protoutil.MarshalOrPanic(&common.SignatureHeader{}),
+			},
+			Data: protoutil.MarshalOrPanic(request),
+		}),
+	}
+}
+
+func txStatusBlock(number uint64, txIDs ...string) *common.Block {
+	block := protoutil.NewBlock(number, nil)
+	for _, txID := range txIDs {
+		block.Data.Data = append(block.Data.Data, protoutil.MarshalOrPanic(&common.Envelope{
+			Payload: protoutil.MarshalOrPanic(&common.Payload{
+				Header: &common.Header{
+					ChannelHeader: protoutil.MarshalOrPanic(&common.ChannelHeader{TxId: txID}),
+				},
+			}),
+		}))
+	}
+	block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER] = []byte{
+		byte(peer.TxValidationCode_VALID),
+		byte(peer.TxValidationCode_MVCC_READ_CONFLICT),
+	}
+	return block
+}
+
+func TestTransactionStatus(t *testing.T) {
+	viper.Set("peer.authentication.timewindow", "1m")
+	viper.Set("peer.transactionStatus.maxWaitTimeout", "1m")
+	defer viper.Reset()
+
+	ledger := &mockTxStatusLedger{
+		height:    10,
+		committed: map[string]*common.Block{"tx1": txStatusBlock(5, "tx1")},
+		codes:     map[string]peer.TxValidationCode{"tx1": peer.TxValidationCode_ENDORSEMENT_POLICY_FAILURE},
+		next:      make(chan *common.Block, 2),
+	}
+	var checkedResource string
+	policyCheckerProvider := func(resourceName string) deliver.PolicyCheckerFunc {
+		checkedResource = resourceName
+		return func(env *common.Envelope, channelID string) error {
+			if channelID == "forbidden" {
+				return errors.New("access denied")
+			}
+			return nil
+		}
+	}
+	server := NewTransactionStatusServer(false, policyCheckerProvider, func(channelID string) TransactionStatusLedger {
+		if channelID == "missing" {
+			return nil
+		}
+		return ledger
+	})
+	assert.Equal(t, resources.Event_FilteredBlock, checkedResource)
+
+	getStatus := func(channelID string, request *peer.TransactionStatusRequest) *peer.TransactionStatusResponse {
+		resp, err := server.GetTransactionStatus(context.Background(), txStatusRequest(channelID, request))
+		require.NoError(t, err)
+		return resp
+	}
+
+	// A committed transaction is found in the index
+	assert.Equal(t, &peer.TransactionStatusResponse{
+		Status:           common.Status_SUCCESS,
+		TxValidationCode: peer.TxValidationCode_ENDORSEMENT_POLICY_FAILURE,
+		BlockNumber:      5,
+	}, getStatus("mychannel", &peer.TransactionStatusRequest{TxId: "tx1"}))
+
+	// A transaction not committed yet is not found without waiting
+	assert.Equal(t, common.Status_NOT_FOUND, getStatus("mychannel", &peer.TransactionStatusRequest{TxId: "tx2"}).Status)
+
+	// A transaction committed while waiting is found in the next blocks
+	ledger.next <- txStatusBlock(10, "tx3")
+	ledger.next <- txStatusBlock(11, "tx4", "tx2")
+	assert.Equal(t, &peer.TransactionStatusResponse{
+		Status:           common.Status_SUCCESS,
+		TxValidationCode: peer.TxValidationCode_MVCC_READ_CONFLICT,
+		BlockNumber:      11,
+	}, getStatus("mychannel", &peer.TransactionStatusRequest{TxId: "tx2", WaitTimeout: ptypes.DurationProto(time.Minute)}))
+
+	// A transaction not committed within the wait timeout is not found
+	resp := getStatus("mychannel", &peer.TransactionStatusRequest{TxId: "tx5", WaitTimeout: ptypes.DurationProto(10 * time.Millisecond)})
+	assert.Equal(t, common.Status_NOT_FOUND, resp.Status)
+
+	// The wait timeout is clamped to the maximum wait timeout
+	server.(*transactionStatusServer).maxWaitTimeout = 10 * time.Millisecond
+	resp = getStatus("mychannel", &peer.TransactionStatusRequest{TxId: "tx5", WaitTimeout: ptypes.DurationProto(time.Hour)})
+	assert.Equal(t, common.Status_NOT_FOUND, resp.Status)
+
+	assert.Equal(t, common.Status_NOT_FOUND, getStatus("missing", &peer.TransactionStatusRequest{TxId: "tx1"}).Status)
+	assert.Equal(t, common.Status_FORBIDDEN, getStatus("forbidden", &peer.TransactionStatusRequest{TxId: "tx1"}).Status)
+	assert.Equal(t, common.Status_BAD_REQUEST, getStatus("mychannel", &peer.TransactionStatusRequest{}).Status)
+	assert.Equal(t, common.Status_BAD_REQUEST, getStatus("mychannel", &peer.TransactionStatusRequest{TxId: "tx1", WaitTimeout: ptypes.DurationProto(-time.Second)}).Status)
+
+	resp, err := server.GetTransactionStatus(context.Background(), &common.Envelope{Payload: []byte("garbage")})
+	assert.NoError(t, err)
+	assert.Equal(t, common.Status_BAD_REQUEST, resp.Status)
+}
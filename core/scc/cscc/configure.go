@@ -13,8 +13,10 @@ package cscc
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/audit"
 	"github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/common/config"
 	"github.com/hyperledger/fabric/common/flogging"
@@ -24,11 +26,15 @@ import (
 	"github.com/hyperledger/fabric/core/committer/txvalidator/v20/plugindispatcher"
 	"github.com/hyperledger/fabric/core/common/sysccprovider"
 	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger"
 	"github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/core/peer"
 	"github.com/hyperledger/fabric/core/policy"
+	"github.com/hyperledger/fabric/core/scc/querycache"
+	"github.com/hyperledger/fabric/msp"
 	"github.com/hyperledger/fabric/msp/mgmt"
 	"github.com/hyperledger/fabric/protos/common"
+	mspprotos "github.com/hyperledger/fabric/protos/msp"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/hyperledger/fabric/protoutil"
 	"github.com/pkg/errors"
@@ -73,6 +79,10 @@ type PeerConfiger struct {
 	deployedCCInfoProvider ledger.DeployedChaincodeInfoProvider
 	legacyLifecycle        plugindispatcher.LifecycleResources
 	newLifecycle           plugindispatcher.LifecycleResources
+
+	// ResponseCache, if set, caches the responses of the queries of the
+	// channel configuration until a block is committed to the channel
+	ResponseCache *querycache.Cache
 }
 
 var cnflogger = flogging.MustGetLogger("cscc")
@@ -80,10 +90,12 @@ var cnflogger = flogging.MustGetLogger("cscc")
 // These are function names from Invoke first parameter
 const (
 	JoinChain                string = "JoinChain"
+	JoinChainBySnapshot      string = "JoinChainBySnapshot"
 	GetConfigBlock           string = "GetConfigBlock"
 	GetChannels              string = "GetChannels"
 	GetConfigTree            string = "GetConfigTree"
 	SimulateConfigTreeUpdate string = "SimulateConfigTreeUpdate"
+	GetChannelConfigInfo     string = "GetChannelConfigInfo"
 )
 
 // Init is mostly useless from an SCC perspective
@@ -100,7 +112,8 @@ func (e *PeerConfiger) Init(stub shim.ChaincodeStubInterface) pb.Response {
 // # args[0] is the function name, which must be JoinChain, GetConfigBlock or
 // UpdateConfigBlock
 // # args[1] is a configuration Block if args[0] is JoinChain or
-// UpdateConfigBlock; otherwise it is the chain id
+// UpdateConfigBlock, the path of a snapshot on the peer if args[0] is
+// JoinChainBySnapshot; otherwise it is the chain id
 // TODO: Improve the scc interface to avoid marshal/unmarshal args
 func (e *PeerConfiger) Invoke(stub shim.ChaincodeStubInterface) pb.Response {
 	args := stub.GetArgs()
@@ -168,27 +181,65 @@ func (e *PeerConfiger) InvokeNoShim(args [][]byte, sp *pb.SignedProposal) pb.Res
 			block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER] = txsFilter
 		}
 
-		return joinChain(cid, block, e.sccp, e.deployedCCInfoProvider, e.legacyLifecycle, e.newLifecycle)
+		resp := joinChain(cid, block, e.sccp, e.deployedCCInfoProvider, e.legacyLifecycle, e.newLifecycle)
+		auditJoin(cid, sp, resp)
+		return resp
+	case JoinChainBySnapshot:
+		snapshotDir := string(args[1])
+		if snapshotDir == "" {
+			return shim.Error("Cannot join the channel, no snapshot path provided")
+		}
+
+		// 1. check join policy, before the snapshot is read from the file system of the peer.
+		if err = e.aclProvider.CheckACL(resources.Cscc_JoinChainBySnapshot, "", sp); err != nil {
+			return shim.Error(fmt.Sprintf("access denied for [%s][%s]: [%s]", fname, snapshotDir, err))
+		}
+
+		// 2. check the format and capabilities requirement of the config block of the snapshot.
+		block, err := kvledger.SnapshotConfigBlock(snapshotDir)
+		if err != nil {
+			return shim.Error(fmt.Sprintf("Failed to read the snapshot, %s", err))
+		}
+		cid, err := protoutil.GetChainIDFromBlock(block)
+		if err != nil {
+			return shim.Error(fmt.Sprintf("\"JoinChainBySnapshot\" request failed to extract "+
+				"channel id from the block due to [%s]", err))
+		}
+		if err := validateConfigBlock(block); err != nil {
+			return shim.Error(fmt.Sprintf("\"JoinChainBySnapshot\" for chainID = %s failed because of validation "+
+				"of configuration block, because of %s", cid, err))
+		}
+
+		resp := joinChainBySnapshot(cid, snapshotDir, e.sccp, e.deployedCCInfoProvider, e.legacyLifecycle, e.newLifecycle)
+		auditJoin(cid, sp, resp)
+		return resp
 	case GetConfigBlock:
 		// 2. check policy
 		if err = e.aclProvider.CheckACL(resources.Cscc_GetConfigBlock, string(args[1]), sp); err != nil {
 			return shim.Error(fmt.Sprintf("access denied for [%s][%s]: %s", fname, args[1], err))
 		}
 
-		return getConfigBlock(args[1])
+		return e.cached(args, func() pb.Response { return getConfigBlock(args[1]) })
 	case GetConfigTree:
 		// 2. check policy
 		if err = e.aclProvider.CheckACL(resources.Cscc_GetConfigTree, string(args[1]), sp); err != nil {
 			return shim.Error(fmt.Sprintf("access denied for [%s][%s]: %s", fname, args[1], err))
 		}
 
-		return e.getConfigTree(args[1])
+		return e.cached(args, func() pb.Response { return e.getConfigTree(args[1]) })
 	case SimulateConfigTreeUpdate:
 		// Check policy
 		if err = e.aclProvider.CheckACL(resources.Cscc_SimulateConfigTreeUpdate, string(args[1]), sp); err != nil {
 			return shim.Error(fmt.Sprintf("access denied for [%s][%s]: %s", fname, args[1], err))
 		}
 		return e.simulateConfigTreeUpdate(args[1], args[2])
+	case GetChannelConfigInfo:
+		// 2. check policy
+		if err = e.aclProvider.CheckACL(resources.Cscc_GetChannelConfigInfo, string(args[1]), sp); err != nil {
+			return shim.Error(fmt.Sprintf("access denied for [%s][%s]: %s", fname, args[1], err))
+		}
+
+		return e.cached(args, func() pb.Response { return e.getChannelConfigInfo(args[1]) })
 	case GetChannels:
 		// 2. check get channels policy
 		if err = e.aclProvider.CheckACL(resources.Cscc_GetChannels, "", sp); err != nil {
@@ -201,6 +252,32 @@ func (e *PeerConfiger) InvokeNoShim(args [][]byte, sp *pb.SignedProposal) pb.Res
 	return shim.Error(fmt.Sprintf("Requested function %s not found.", fname))
 }
 
+// cached returns the response cached for the query of the channel config,
+// or executes the query and caches its response if it succeeds
+func (e *PeerConfiger) cached(args [][]byte, query func() pb.Response) pb.Response {
+	if e.ResponseCache == nil {
+		return query()
+	}
+	cid := string(args[1])
+	l := peer.GetLedger(cid)
+	if l == nil {
+		return query()
+	}
+	bcInfo, err := l.GetBlockchainInfo()
+	if err != nil {
+		return query()
+	}
+	if resp, found := e.ResponseCache.Get(cid, bcInfo.Height, args); found {
+		cnflogger.Debugf("Returning cached response of %s on chain: %s at height %d", args[0], cid, bcInfo.Height)
+		return resp
+	}
+	resp := query()
+	if resp.Status == shim.OK {
+		e.ResponseCache.Put(cid, bcInfo.Height, args, resp)
+	}
+	return resp
+}
+
 // validateConfigBlock validate configuration block to see whenever it's contains valid config transaction
 func validateConfigBlock(block *common.Block) error {
 	envelopeConfig, err := protoutil.ExtractEnvelope(block, 0)
@@ -240,6 +317,22 @@ func validateConfigBlock(block *common.Block) error {
 	return nil
 }
 
+// auditJoin records the outcome of the request to join the channel in the
+// audit log.
+func auditJoin(chainID string, sp *pb.SignedProposal, resp pb.Response) {
+	event := audit.Event{
+		Type:     audit.ChannelJoin,
+		Outcome:  audit.Success,
+		Channel:  chainID,
+		Identity: audit.ProposalIdentity(sp),
+	}
+	if resp.Status != shim.OK {
+		event.Outcome = audit.Failure
+		event.Details = map[string]string{"reason": resp.Message}
+	}
+	audit.Log(event)
+}
+
 // joinChain will join the specified chain in the configuration block.
 // Since it is the first block, it is the genesis block containing configuration
 // for this chain, so we want to update the Chain object with this info
@@ -253,6 +346,19 @@ func joinChain(chainID string, block *common.Block, sccp sysccprovider.SystemCha
 	return shim.Success(nil)
 }
 
+// joinChainBySnapshot will join the chain of the snapshot in the given directory.
+// The ledger of the chain is created from the snapshot, instead of replaying the
+// blocks from the genesis block
+func joinChainBySnapshot(chainID, snapshotDir string, sccp sysccprovider.SystemChaincodeProvider, deployedCCInfoProvider ledger.DeployedChaincodeInfoProvider, lr, nr plugindispatcher.LifecycleResources) pb.Response {
+	if _, err := peer.CreateChainFromSnapshot(snapshotDir, sccp, deployedCCInfoProvider, lr, nr); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	peer.InitChain(chainID)
+
+	return shim.Success(nil)
+}
+
 // Return the current configuration block for the specified chainID. If the
 // peer doesn't belong to the chain, return error
 func getConfigBlock(chainID []byte) pb.Response {
@@ -289,6 +395,28 @@ func (e *PeerConfiger) getConfigTree(chainID []byte) pb.Response {
 	return shim.Success(configBytes)
 }
 
+// getChannelConfigInfo returns the sequence, the capabilities, the
+// organizations and the orderer addresses from the current configuration of
+// the specified chainID. If the peer doesn't belong to the chain, returns error
+func (e *PeerConfiger) getChannelConfigInfo(chainID []byte) pb.Response {
+	if chainID == nil {
+		return shim.Error("Chain ID must not be nil")
+	}
+	cfg := e.configMgr.GetChannelConfig(string(chainID))
+	if cfg == nil || cfg.ConfigProto() == nil {
+		return shim.Error(fmt.Sprintf("Unknown chain ID, %s", string(chainID)))
+	}
+	info, err := channelConfigInfo(string(chainID), cfg.ConfigProto())
+	if err != nil {
+		return shim.Error(fmt.Sprintf("Failed to extract the channel config info for chain ID %s: %s", string(chainID), err))
+	}
+	infoBytes, err := protoutil.Marshal(info)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	return shim.Success(infoBytes)
+}
+
 func (e *PeerConfiger) simulateConfigTreeUpdate(chainID []byte, envb []byte) pb.Response {
 	if chainID == nil {
 		return shim.Error("Chain ID must not be nil")
@@ -345,3 +473,125 @@ func getChannels() pb.Response {
 
 	return shim.Success(cqrbytes)
 }
+
+// channelConfigInfo extracts the basic facts about a channel from its config
+func channelConfigInfo(chainID string, cfg *common.Config) (*pb.ChannelConfigInfo, error) {
+	channelGroup := cfg.ChannelGroup
+	if channelGroup == nil {
+		return nil, errors.New("config has no channel group")
+	}
+
+	info := &pb.ChannelConfigInfo{
+		ChannelId: chainID,
+		Sequence:  cfg.Sequence,
+	}
+
+	var err error
+	if info.ChannelCapabilities, err = capabilityNames(channelGroup); err != nil {
+		return nil, errors.WithMessage(err, "invalid channel capabilities")
+	}
+
+	if value, ok := channelGroup.Values[channelconfig.OrdererAddressesKey]; ok {
+		addresses := &common.OrdererAddresses{}
+		if err := proto.Unmarshal(value.Value, addresses); err != nil {
+			return nil, errors.Wrap(err, "invalid orderer addresses")
+		}
+		info.OrdererAddresses = addresses.Addresses
+	}
+
+	if applicationGroup, ok := channelGroup.Groups[channelconfig.ApplicationGroupKey]; ok {
+		if info.ApplicationCapabilities, err = capabilityNames(applicationGroup); err != nil {
+			return nil, errors.WithMessage(err, "invalid application capabilities")
+		}
+		if info.ApplicationOrganizations, err = organizations(applicationGroup); err != nil {
+			return nil, errors.WithMessage(err, "invalid application organization")
+		}
+	}
+
+	if ordererGroup, ok := channelGroup.Groups[channelconfig.OrdererGroupKey]; ok {
+		if info.OrdererCapabilities, err = capabilityNames(ordererGroup); err != nil {
+			return nil, errors.WithMessage(err, "invalid orderer capabilities")
+		}
+		if info.OrdererOrganizations, err = organizations(ordererGroup); err != nil {
+			return nil, errors.WithMessage(err, "invalid orderer organization")
+		}
+	}
+
+	return info, nil
+}
+
+// capabilityNames returns the sorted names of the capabilities of a config group
+func capabilityNames(group *common.ConfigGroup) ([]string, error) {
+	value, ok := group.Values[channelconfig.CapabilitiesKey]
+	if !ok {
+		return nil, nil
+	}
+	capabilities := &common.Capabilities{}
+	if err := proto.Unmarshal(value.Value, capabilities); err != nil {
+		return nil, err
+	}
+	var names []string
+	for name := range capabilities.Capabilities {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// organizations returns the organizations of a config group, sorted by name
+func organizations(group *common.ConfigGroup) ([]*pb.OrganizationInfo, error) {
+	var orgNames []string
+	for orgName := range group.Groups {
+		orgNames = append(orgNames, orgName)
+	}
+	sort.Strings(orgNames)
+
+	var orgs []*pb.OrganizationInfo
+	for _, orgName := range orgNames {
+		orgGroup := group.Groups[orgName]
+		org := &pb.OrganizationInfo{Name: orgName}
+
+		if value, ok := orgGroup.Values[channelconfig.MSPKey]; ok {
+			mspID, err := mspIdentifier(value.Value)
+			if err != nil {
+				return nil, errors.WithMessage(err, fmt.Sprintf("invalid MSP of organization %s", orgName))
+			}
+			org.MspId = mspID
+		}
+
+		if value, ok := orgGroup.Values[channelconfig.AnchorPeersKey]; ok {
+			anchorPeers := &pb.AnchorPeers{}
+			if err := proto.Unmarshal(value.Value, anchorPeers); err != nil {
+				return nil, errors.Wrapf(err, "invalid anchor peers of organization %s", orgName)
+			}
+			org.AnchorPeers = anchorPeers.AnchorPeers
+		}
+
+		orgs = append(orgs, org)
+	}
+	return orgs, nil
+}
+
+// mspIdentifier returns the identifier of the MSP defined by a serialized MSPConfig
+func mspIdentifier(mspConfigBytes []byte) (string, error) {
+	mspConfig := &mspprotos.MSPConfig{}
+	if err := proto.Unmarshal(mspConfigBytes, mspConfig); err != nil {
+		return "", err
+	}
+	switch msp.ProviderType(mspConfig.Type) {
+	case msp.FABRIC:
+		fabricConfig := &mspprotos.FabricMSPConfig{}
+		if err := proto.Unmarshal(mspConfig.Config, fabricConfig); err != nil {
+			return "", err
+		}
+		return fabricConfig.Name, nil
+	case msp.IDEMIX:
+		idemixConfig := &mspprotos.IdemixMSPConfig{}
+		if err := proto.Unmarshal(mspConfig.Config, idemixConfig); err != nil {
+			return "", err
+		}
+		return idemixConfig.Name, nil
+	default:
+		return "", errors.Errorf("unknown MSP type %d", mspConfig.Type)
+	}
+}
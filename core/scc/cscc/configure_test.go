@@ -7,18 +7,22 @@ SPDX-License-Identifier: Apache-2.0
 package cscc
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/audit"
 	"github.com/hyperledger/fabric/common/config"
 	"github.com/hyperledger/fabric/common/configtx"
 	configtxtest "github.com/hyperledger/fabric/common/configtx/test"
 	"github.com/hyperledger/fabric/common/crypto/tlsgen"
 	"github.com/hyperledger/fabric/common/genesis"
+	"github.com/hyperledger/fabric/common/ledger/testutil"
 	"github.com/hyperledger/fabric/common/localmsp"
 	"github.com/hyperledger/fabric/common/metrics/disabled"
 	"github.com/hyperledger/fabric/common/mocks/scc"
@@ -39,12 +43,14 @@ import (
 	"github.com/hyperledger/fabric/core/container/inproccontroller"
 	deliverclient "github.com/hyperledger/fabric/core/deliverservice"
 	"github.com/hyperledger/fabric/core/deliverservice/blocksprovider"
+	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/ledgermgmt"
 	ledgermock "github.com/hyperledger/fabric/core/ledger/mock"
 	"github.com/hyperledger/fabric/core/peer"
 	"github.com/hyperledger/fabric/core/policy"
 	policymocks "github.com/hyperledger/fabric/core/policy/mocks"
 	"github.com/hyperledger/fabric/core/scc/cscc/mock"
+	"github.com/hyperledger/fabric/core/scc/querycache"
 	"github.com/hyperledger/fabric/gossip/api"
 	"github.com/hyperledger/fabric/gossip/service"
 	"github.com/hyperledger/fabric/msp/mgmt"
@@ -52,6 +58,7 @@ import (
 	peergossip "github.com/hyperledger/fabric/peer/gossip"
 	"github.com/hyperledger/fabric/peer/gossip/mocks"
 	cb "github.com/hyperledger/fabric/protos/common"
+	mspprotos "github.com/hyperledger/fabric/protos/msp"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/hyperledger/fabric/protoutil"
 	"github.com/pkg/errors"
@@ -208,6 +215,37 @@ func TestConfigerInvokeJoinChainWrongParams(t *testing.T) {
 	}
 }
 
+func TestConfigerInvokeJoinChainBySnapshotWrongParams(t *testing.T) {
+	e := New(nil, mockAclProvider, nil, nil, nil)
+	stub := shim.NewMockStub("PeerConfiger", e)
+
+	if res := stub.MockInit("1", nil); res.Status != shim.OK {
+		fmt.Println("Init failed", string(res.Message))
+		t.FailNow()
+	}
+
+	// Failed path: no snapshot path
+	args := [][]byte{[]byte("JoinChainBySnapshot"), []byte("")}
+	res := stub.MockInvokeWithSignedProposal("2", args, nil)
+	assert.Equal(t, int32(shim.ERROR), res.Status)
+	assert.Equal(t, "Cannot join the channel, no snapshot path provided", res.Message)
+
+	// Failed path: the snapshot is not read unless the join policy is satisfied
+	mockAclProvider.Reset()
+	mockAclProvider.On("CheckACL", resources.Cscc_JoinChainBySnapshot, "", (*pb.SignedProposal)(nil)).Return(errors.New("Failed authorization"))
+	args = [][]byte{[]byte("JoinChainBySnapshot"), []byte("/path/to/snapshot")}
+	res = stub.MockInvokeWithSignedProposal("2", args, nil)
+	assert.Equal(t, int32(shim.ERROR), res.Status)
+	assert.Equal(t, "access denied for [JoinChainBySnapshot][/path/to/snapshot]: [Failed authorization]", res.Message)
+
+	// Failed path: no snapshot at the given path
+	mockAclProvider.Reset()
+	mockAclProvider.On("CheckACL", resources.Cscc_JoinChainBySnapshot, "", (*pb.SignedProposal)(nil)).Return(nil)
+	res = stub.MockInvokeWithSignedProposal("2", args, nil)
+	assert.Equal(t, int32(shim.ERROR), res.Status)
+	assert.Contains(t, res.Message, "Failed to read the snapshot, error reading the metadata of the snapshot in /path/to/snapshot")
+}
+
 func TestConfigerInvokeJoinChainCorrectParams(t *testing.T) {
 	mp := (&scc.MocksccProviderFactory{}).NewSystemChaincodeProvider()
 
@@ -280,7 +318,7 @@ func TestConfigerInvokeJoinChainCorrectParams(t *testing.T) {
 	require.NoError(t, err)
 
 	identity, _ := mgmt.GetLocalSigningIdentityOrPanic().Serialize()
-	messageCryptoService := peergossip.NewMCS(&mocks.ChannelPolicyManagerGetter{}, localmsp.NewSigner(), mgmt.NewDeserializersManager())
+	messageCryptoService := peergossip.NewMCS(&mocks.ChannelPolicyManagerGetter{}, localmsp.NewSigner(), mgmt.NewDeserializersManager(), "")
 	secAdv := peergossip.NewSecurityAdvisor(mgmt.NewDeserializersManager())
 	var defaultSecureDialOpts = func() []grpc.DialOption {
 		var dialOpts []grpc.DialOption
@@ -327,9 +365,18 @@ func TestConfigerInvokeJoinChainCorrectParams(t *testing.T) {
 	assert.Equal(t, res.Status, int32(shim.ERROR))
 
 	// Now, continue with valid execution path
+	auditLog := &bytes.Buffer{}
+	audit.Configure(nil, audit.NewWriterSink(auditLog))
+	defer audit.Configure(nil)
 	if res := stub.MockInvokeWithSignedProposal("2", args, sProp); res.Status != shim.OK {
 		t.Fatalf("cscc invoke JoinChain failed with: %v", res.Message)
 	}
+	record, err := audit.Verify(auditLog)
+	require.NoError(t, err)
+	require.NotNil(t, record)
+	assert.Equal(t, audit.ChannelJoin, record.Type)
+	assert.Equal(t, audit.Success, record.Outcome)
+	assert.Equal(t, "mytestchainid", record.Channel)
 
 	// This call must fail
 	sProp.Signature = nil
@@ -443,6 +490,208 @@ func TestGetConfigTree(t *testing.T) {
 	})
 }
 
+func TestGetConfigTreeResponseCache(t *testing.T) {
+	path, err := ioutil.TempDir("", "cscc-querycache")
+	require.NoError(t, err)
+	defer os.RemoveAll(path)
+	viper.Set("peer.fileSystemPath", path)
+	peer.MockInitialize()
+	defer ledgermgmt.CleanupTestEnv()
+	require.NoError(t, peer.MockCreateChain("testchan"))
+
+	aclProvider := &mock.ACLProvider{}
+	configMgr := &mock.ConfigManager{}
+	ctxv := &mock.ConfigtxValidator{}
+	configMgr.GetChannelConfigReturns(ctxv)
+	ctxv.ConfigProtoReturns(&cb.Config{Sequence: 1})
+	pc := &PeerConfiger{
+		aclProvider:   aclProvider,
+		configMgr:     configMgr,
+		ResponseCache: querycache.New(10),
+	}
+
+	args := [][]byte{[]byte("GetConfigTree"), []byte("testchan")}
+	for i := 0; i < 2; i++ {
+		res := pc.InvokeNoShim(args, nil)
+		assert.Equal(t, int32(shim.OK), res.Status)
+	}
+	assert.Equal(t, 1, configMgr.GetChannelConfigCallCount())
+	assert.Equal(t, 1, pc.ResponseCache.Len())
+
+	// The ACL is checked before a cached response is returned
+	aclProvider.CheckACLReturns(fmt.Errorf("fake-error"))
+	res := pc.InvokeNoShim(args, nil)
+	assert.Equal(t, "access denied for [GetConfigTree][testchan]: fake-error", res.Message)
+	aclProvider.CheckACLReturns(nil)
+
+	// The responses of channels the peer has no ledger for are not cached
+	res = pc.InvokeNoShim([][]byte{[]byte("GetConfigTree"), []byte("otherchan")}, nil)
+	assert.Equal(t, int32(shim.OK), res.Status)
+	assert.Equal(t, 1, pc.ResponseCache.Len())
+	assert.Equal(t, 2, configMgr.GetChannelConfigCallCount())
+
+	// Committing a block invalidates the cached responses
+	l := peer.GetLedger("testchan")
+	bcInfo, err := l.GetBlockchainInfo()
+	require.NoError(t, err)
+	block := testutil.ConstructBlock(t, 1, bcInfo.CurrentBlockHash, [][]byte{}, false)
+	require.NoError(t, l.CommitWithPvtData(&ledger.BlockAndPvtData{Block: block}))
+	ctxv.ConfigProtoReturns(&cb.Config{Sequence: 2})
+	res = pc.InvokeNoShim(args, nil)
+	assert.Equal(t, int32(shim.OK), res.Status)
+	assert.Equal(t, 3, configMgr.GetChannelConfigCallCount())
+	checkConfig := &pb.ConfigTree{}
+	require.NoError(t, proto.Unmarshal(res.Payload, checkConfig))
+	assert.Equal(t, uint64(2), checkConfig.ChannelConfig.Sequence)
+}
+
+func TestGetChannelConfigInfo(t *testing.T) {
+	aclProvider := &mock.ACLProvider{}
+	configMgr := &mock.ConfigManager{}
+	pc := &PeerConfiger{
+		aclProvider: aclProvider,
+		configMgr:   configMgr,
+	}
+
+	args := [][]byte{[]byte("GetChannelConfigInfo"), []byte("testchan")}
+
+	mspValue := func(mspID string) *cb.ConfigValue {
+		return &cb.ConfigValue{
+			Value: protoutil.MarshalOrPanic(&mspprotos.MSPConfig{
+				Config: protoutil.MarshalOrPanic(&mspprotos.FabricMSPConfig{Name: mspID}),
+			}),
+		}
+	}
+	capabilitiesValue := func(names ...string) *cb.ConfigValue {
+		capabilities := &cb.Capabilities{Capabilities: map[string]*cb.Capability{}}
+		for _, name := range names {
+			capabilities.Capabilities[name] = &cb.Capability{}
+		}
+		return &cb.ConfigValue{Value: protoutil.MarshalOrPanic(capabilities)}
+	}
+
+	testConfig := &cb.Config{
+		Sequence: 3,
+		ChannelGroup: &cb.ConfigGroup{
+			Values: map[string]*cb.ConfigValue{
+				"Capabilities": capabilitiesValue("V1_4_2", "V1_3"),
+				"OrdererAddresses": {
+					Value: protoutil.MarshalOrPanic(&cb.OrdererAddresses{Addresses: []string{"orderer0:7050", "orderer1:7050"}}),
+				},
+			},
+			Groups: map[string]*cb.ConfigGroup{
+				"Application": {
+					Values: map[string]*cb.ConfigValue{
+						"Capabilities": capabilitiesValue("V2_0"),
+					},
+					Groups: map[string]*cb.ConfigGroup{
+						"Org2": {
+							Values: map[string]*cb.ConfigValue{
+								"MSP": mspValue("Org2MSP"),
+							},
+						},
+						"Org1": {
+							Values: map[string]*cb.ConfigValue{
+								"MSP": mspValue("Org1MSP"),
+								"AnchorPeers": {
+									Value: protoutil.MarshalOrPanic(&pb.AnchorPeers{
+										AnchorPeers: []*pb.AnchorPeer{{Host: "peer0.org1", Port: 7051}},
+									}),
+								},
+							},
+						},
+					},
+				},
+				"Orderer": {
+					Values: map[string]*cb.ConfigValue{
+						"Capabilities": capabilitiesValue("V1_4_2"),
+					},
+					Groups: map[string]*cb.ConfigGroup{
+						"OrdererOrg": {
+							Values: map[string]*cb.ConfigValue{
+								"MSP": mspValue("OrdererMSP"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		ctxv := &mock.ConfigtxValidator{}
+		configMgr.GetChannelConfigReturns(ctxv)
+		ctxv.ConfigProtoReturns(testConfig)
+		res := pc.InvokeNoShim(args, nil)
+		assert.Equal(t, int32(shim.OK), res.Status)
+		info := &pb.ChannelConfigInfo{}
+		err := proto.Unmarshal(res.Payload, info)
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(&pb.ChannelConfigInfo{
+			ChannelId:               "testchan",
+			Sequence:                3,
+			ChannelCapabilities:     []string{"V1_3", "V1_4_2"},
+			ApplicationCapabilities: []string{"V2_0"},
+			OrdererCapabilities:     []string{"V1_4_2"},
+			ApplicationOrganizations: []*pb.OrganizationInfo{
+				{Name: "Org1", MspId: "Org1MSP", AnchorPeers: []*pb.AnchorPeer{{Host: "peer0.org1", Port: 7051}}},
+				{Name: "Org2", MspId: "Org2MSP"},
+			},
+			OrdererOrganizations: []*pb.OrganizationInfo{
+				{Name: "OrdererOrg", MspId: "OrdererMSP"},
+			},
+			OrdererAddresses: []string{"orderer0:7050", "orderer1:7050"},
+		}, info))
+	})
+
+	t.Run("MissingConfig", func(t *testing.T) {
+		ctxv := &mock.ConfigtxValidator{}
+		configMgr.GetChannelConfigReturns(ctxv)
+		res := pc.InvokeNoShim(args, nil)
+		assert.NotEqual(t, int32(shim.OK), res.Status)
+		assert.Equal(t, "Unknown chain ID, testchan", res.Message)
+	})
+
+	t.Run("NilChannel", func(t *testing.T) {
+		res := pc.InvokeNoShim([][]byte{[]byte("GetChannelConfigInfo"), nil}, nil)
+		assert.NotEqual(t, int32(shim.OK), res.Status)
+		assert.Equal(t, "Chain ID must not be nil", res.Message)
+	})
+
+	t.Run("BadMSPConfig", func(t *testing.T) {
+		ctxv := &mock.ConfigtxValidator{}
+		configMgr.GetChannelConfigReturns(ctxv)
+		ctxv.ConfigProtoReturns(&cb.Config{
+			ChannelGroup: &cb.ConfigGroup{
+				Groups: map[string]*cb.ConfigGroup{
+					"Application": {
+						Groups: map[string]*cb.ConfigGroup{
+							"Org1": {
+								Values: map[string]*cb.ConfigValue{
+									"MSP": {Value: protoutil.MarshalOrPanic(&mspprotos.MSPConfig{Type: 7})},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+		res := pc.InvokeNoShim(args, nil)
+		assert.NotEqual(t, int32(shim.OK), res.Status)
+		assert.Equal(t, "Failed to extract the channel config info for chain ID testchan: invalid application organization: invalid MSP of organization Org1: unknown MSP type 7", res.Message)
+	})
+
+	t.Run("BadACL", func(t *testing.T) {
+		aclProvider.CheckACLReturns(fmt.Errorf("fake-error"))
+		res := pc.InvokeNoShim(args, nil)
+		assert.NotEqual(t, int32(shim.OK), res.Status)
+		assert.Equal(t, "access denied for [GetChannelConfigInfo][testchan]: fake-error", res.Message)
+		resource, channel, _ := aclProvider.CheckACLArgsForCall(aclProvider.CheckACLCallCount() - 1)
+		assert.Equal(t, resources.Cscc_GetChannelConfigInfo, resource)
+		assert.Equal(t, "testchan", channel)
+	})
+}
+
 func TestSimulateConfigTreeUpdate(t *testing.T) {
 	aclProvider := &mock.ACLProvider{}
 	configMgr := &mock.ConfigManager{}
@@ -11,6 +11,7 @@ import (
 	"regexp"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/audit"
 	"github.com/hyperledger/fabric/common/cauthdsl"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/core/aclmgmt"
@@ -860,6 +861,17 @@ func (lscc *LifeCycleSysCC) Invoke(stub shim.ChaincodeStubInterface) pb.Response
 		depSpec := args[1]
 
 		err := lscc.executeInstall(stub, depSpec)
+		event := audit.Event{
+			Type:     audit.ChaincodeInstall,
+			Outcome:  audit.Success,
+			Identity: audit.ProposalIdentity(sp),
+			Details:  map[string]string{"lifecycle": "legacy"},
+		}
+		if err != nil {
+			event.Outcome = audit.Failure
+			event.Details["reason"] = err.Error()
+		}
+		audit.Log(event)
 		if err != nil {
 			return shim.Error(err.Error())
 		}
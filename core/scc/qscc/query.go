@@ -10,11 +10,14 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/core/aclmgmt"
 	"github.com/hyperledger/fabric/core/chaincode/shim"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/peer"
+	"github.com/hyperledger/fabric/core/scc/querycache"
+	"github.com/hyperledger/fabric/protos/common"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/hyperledger/fabric/protoutil"
 )
@@ -40,8 +43,14 @@ func (e *LedgerQuerier) Enabled() bool             { return true }
 // - GetBlockByNumber returns a block
 // - GetBlockByHash returns a block
 // - GetTransactionByID returns a transaction
+// - GetBlocksByRange returns a page of the blocks in a range
+// - GetBlockByNumberHeaderOnly returns the header of a block
 type LedgerQuerier struct {
 	aclProvider aclmgmt.ACLProvider
+
+	// ResponseCache, if set, caches the responses of the queries until a
+	// block is committed to their channel
+	ResponseCache *querycache.Cache
 }
 
 var qscclogger = flogging.MustGetLogger("qscc")
@@ -53,8 +62,20 @@ const (
 	GetBlockByHash     string = "GetBlockByHash"
 	GetTransactionByID string = "GetTransactionByID"
 	GetBlockByTxID     string = "GetBlockByTxID"
+
+	GetBlocksByRange           string = "GetBlocksByRange"
+	GetBlockByNumberHeaderOnly string = "GetBlockByNumberHeaderOnly"
 )
 
+// maxBlocksPerPage is the default and the maximum number of blocks returned
+// by a single GetBlocksByRange call
+const maxBlocksPerPage = 100
+
+// maxBytesPerPage bounds the total size of the blocks returned by a single
+// GetBlocksByRange call, so that its response fits within the default maximum
+// message size of gRPC clients. A page holds at least one block, whatever its size.
+var maxBytesPerPage = 4 * 1024 * 1024
+
 // Init is called once per chain when the chain is created.
 // This allows the chaincode to initialize any variables on the ledger prior
 // to any transaction execution on the chain.
@@ -71,6 +92,11 @@ func (e *LedgerQuerier) Init(stub shim.ChaincodeStubInterface) pb.Response {
 // # GetBlockByNumber: Return the block specified by block number in args[2]
 // # GetBlockByHash: Return the block specified by block hash in args[2]
 // # GetTransactionByID: Return the transaction specified by ID in args[2]
+// # GetBlocksByRange: Return a BlockRangeResponse with the blocks numbered from
+// args[2] to args[3] (inclusive), at most args[4] (optional) blocks, and a few
+// megabytes, at a time
+// # GetBlockByNumberHeaderOnly: Return the header of the block specified by
+// block number in args[2]
 func (e *LedgerQuerier) Invoke(stub shim.ChaincodeStubInterface) pb.Response {
 	args := stub.GetArgs()
 
@@ -104,6 +130,26 @@ func (e *LedgerQuerier) Invoke(stub shim.ChaincodeStubInterface) pb.Response {
 		return shim.Error(fmt.Sprintf("access denied for [%s][%s]: [%s]", fname, cid, err))
 	}
 
+	if e.ResponseCache == nil {
+		return query(targetLedger, fname, args)
+	}
+	bcInfo, err := targetLedger.GetBlockchainInfo()
+	if err != nil {
+		return query(targetLedger, fname, args)
+	}
+	if resp, found := e.ResponseCache.Get(cid, bcInfo.Height, args); found {
+		qscclogger.Debugf("Returning cached response of %s on chain: %s at height %d", fname, cid, bcInfo.Height)
+		return resp
+	}
+	resp := query(targetLedger, fname, args)
+	if resp.Status == shim.OK {
+		e.ResponseCache.Put(cid, bcInfo.Height, args, resp)
+	}
+	return resp
+}
+
+// query executes the query function on the ledger
+func query(targetLedger ledger.PeerLedger, fname string, args [][]byte) pb.Response {
 	switch fname {
 	case GetTransactionByID:
 		return getTransactionByID(targetLedger, args[2])
@@ -115,6 +161,10 @@ func (e *LedgerQuerier) Invoke(stub shim.ChaincodeStubInterface) pb.Response {
 		return getChainInfo(targetLedger)
 	case GetBlockByTxID:
 		return getBlockByTxID(targetLedger, args[2])
+	case GetBlocksByRange:
+		return getBlocksByRange(targetLedger, args[2:])
+	case GetBlockByNumberHeaderOnly:
+		return getBlockByNumberHeaderOnly(targetLedger, args[2])
 	}
 
 	return shim.Error(fmt.Sprintf("Requested function %s not found.", fname))
@@ -214,6 +264,99 @@ func getBlockByTxID(vledger ledger.PeerLedger, rawTxID []byte) pb.Response {
 	return shim.Success(bytes)
 }
 
+func getBlocksByRange(vledger ledger.PeerLedger, args [][]byte) pb.Response {
+	if len(args) < 2 || args[0] == nil || args[1] == nil {
+		return shim.Error("Start and end block numbers must not be nil.")
+	}
+	start, err := strconv.ParseUint(string(args[0]), 10, 64)
+	if err != nil {
+		return shim.Error(fmt.Sprintf("Failed to parse start block number with error %s", err))
+	}
+	end, err := strconv.ParseUint(string(args[1]), 10, 64)
+	if err != nil {
+		return shim.Error(fmt.Sprintf("Failed to parse end block number with error %s", err))
+	}
+	if start > end {
+		return shim.Error(fmt.Sprintf("Start block number %d is greater than end block number %d", start, end))
+	}
+	pageSize := uint64(maxBlocksPerPage)
+	if len(args) > 2 && args[2] != nil {
+		pageSize, err = strconv.ParseUint(string(args[2]), 10, 64)
+		if err != nil {
+			return shim.Error(fmt.Sprintf("Failed to parse page size with error %s", err))
+		}
+		if pageSize == 0 || pageSize > maxBlocksPerPage {
+			return shim.Error(fmt.Sprintf("Page size %d is not between 1 and %d", pageSize, maxBlocksPerPage))
+		}
+	}
+
+	binfo, err := vledger.GetBlockchainInfo()
+	if err != nil {
+		return shim.Error(fmt.Sprintf("Failed to get block info with error %s", err))
+	}
+	resp := &pb.BlockRangeResponse{NextBlockNumber: start}
+	// blocks which have not been committed yet are not waited for
+	if start < binfo.Height {
+		last := end
+		if binfo.Height <= last {
+			last = binfo.Height - 1
+		}
+		if start+pageSize-1 < last {
+			last = start + pageSize - 1
+		}
+
+		itr, err := vledger.GetBlocksIterator(start)
+		if err != nil {
+			return shim.Error(fmt.Sprintf("Failed to get blocks from block number %d, error %s", start, err))
+		}
+		defer itr.Close()
+
+		pageBytes := 0
+		for num := start; num <= last; num++ {
+			result, err := itr.Next()
+			if err != nil {
+				return shim.Error(fmt.Sprintf("Failed to get block number %d, error %s", num, err))
+			}
+			block := result.(*common.Block)
+			pageBytes += proto.Size(block)
+			if len(resp.Blocks) > 0 && pageBytes > maxBytesPerPage {
+				break
+			}
+			resp.Blocks = append(resp.Blocks, block)
+		}
+		resp.NextBlockNumber = start + uint64(len(resp.Blocks))
+	}
+	resp.HasMore = resp.NextBlockNumber <= end && resp.NextBlockNumber < binfo.Height
+
+	bytes, err := protoutil.Marshal(resp)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	return shim.Success(bytes)
+}
+
+func getBlockByNumberHeaderOnly(vledger ledger.PeerLedger, number []byte) pb.Response {
+	if number == nil {
+		return shim.Error("Block number must not be nil.")
+	}
+	bnum, err := strconv.ParseUint(string(number), 10, 64)
+	if err != nil {
+		return shim.Error(fmt.Sprintf("Failed to parse block number with error %s", err))
+	}
+	block, err := vledger.GetBlockByNumber(bnum)
+	if err != nil {
+		return shim.Error(fmt.Sprintf("Failed to get block number %d, error %s", bnum, err))
+	}
+
+	bytes, err := protoutil.Marshal(block.Header)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	return shim.Success(bytes)
+}
+
 func getACLResource(fname string) string {
 	return "qscc/" + fname
 }
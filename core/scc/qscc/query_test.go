@@ -12,6 +12,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/common/ledger/testutil"
 	"github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/core/aclmgmt/mocks"
@@ -19,6 +20,7 @@ import (
 	"github.com/hyperledger/fabric/core/chaincode/shim"
 	ledger2 "github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/peer"
+	"github.com/hyperledger/fabric/core/scc/querycache"
 	"github.com/hyperledger/fabric/protos/common"
 	peer2 "github.com/hyperledger/fabric/protos/peer"
 	"github.com/hyperledger/fabric/protoutil"
@@ -319,6 +321,171 @@ func TestQueryGeneratedBlock(t *testing.T) {
 	}
 }
 
+func TestQueryGetBlocksByRange(t *testing.T) {
+	chainid := "mytestchainid9"
+	path := tempDir(t, "test9")
+	defer os.RemoveAll(path)
+
+	stub, err := setupTestLedger(chainid, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block1 := addBlockForTesting(t, chainid)
+
+	getBlocksByRange := func(args ...string) (peer2.Response, *peer2.BlockRangeResponse) {
+		invokeArgs := [][]byte{[]byte(GetBlocksByRange), []byte(chainid)}
+		for _, arg := range args {
+			invokeArgs = append(invokeArgs, []byte(arg))
+		}
+		prop := resetProvider(resources.Qscc_GetBlocksByRange, chainid, &peer2.SignedProposal{}, nil)
+		res := stub.MockInvokeWithSignedProposal("1", invokeArgs, prop)
+		resp := &peer2.BlockRangeResponse{}
+		if res.Status == shim.OK {
+			require.NoError(t, proto.Unmarshal(res.Payload, resp))
+		}
+		return res, resp
+	}
+
+	// the genesis block and block 1 are in the ledger
+	res, resp := getBlocksByRange("0", "1")
+	require.Equal(t, int32(shim.OK), res.Status, "GetBlocksByRange failed with err: %s", res.Message)
+	require.Len(t, resp.Blocks, 2)
+	assert.Equal(t, uint64(0), resp.Blocks[0].Header.Number)
+	assert.True(t, proto.Equal(block1.Header, resp.Blocks[1].Header))
+	assert.False(t, resp.HasMore)
+	assert.Equal(t, uint64(2), resp.NextBlockNumber)
+
+	// a page of a single block
+	res, resp = getBlocksByRange("0", "1", "1")
+	require.Equal(t, int32(shim.OK), res.Status, "GetBlocksByRange failed with err: %s", res.Message)
+	require.Len(t, resp.Blocks, 1)
+	assert.Equal(t, uint64(0), resp.Blocks[0].Header.Number)
+	assert.True(t, resp.HasMore)
+	assert.Equal(t, uint64(1), resp.NextBlockNumber)
+
+	// blocks which have not been committed are not returned
+	res, resp = getBlocksByRange("1", "10")
+	require.Equal(t, int32(shim.OK), res.Status, "GetBlocksByRange failed with err: %s", res.Message)
+	require.Len(t, resp.Blocks, 1)
+	assert.Equal(t, uint64(1), resp.Blocks[0].Header.Number)
+	assert.False(t, resp.HasMore)
+	assert.Equal(t, uint64(2), resp.NextBlockNumber)
+
+	// pages are cut short once their blocks exceed the maximum size, but hold at least one block
+	defer func(max int) { maxBytesPerPage = max }(maxBytesPerPage)
+	maxBytesPerPage = 1
+	res, resp = getBlocksByRange("0", "1")
+	require.Equal(t, int32(shim.OK), res.Status, "GetBlocksByRange failed with err: %s", res.Message)
+	require.Len(t, resp.Blocks, 1)
+	assert.Equal(t, uint64(0), resp.Blocks[0].Header.Number)
+	assert.True(t, resp.HasMore)
+	assert.Equal(t, uint64(1), resp.NextBlockNumber)
+	maxBytesPerPage = proto.Size(resp.Blocks[0]) + proto.Size(block1)
+	res, resp = getBlocksByRange("0", "1")
+	require.Equal(t, int32(shim.OK), res.Status, "GetBlocksByRange failed with err: %s", res.Message)
+	require.Len(t, resp.Blocks, 2)
+	assert.False(t, resp.HasMore)
+
+	res, resp = getBlocksByRange("5", "10")
+	require.Equal(t, int32(shim.OK), res.Status, "GetBlocksByRange failed with err: %s", res.Message)
+	assert.Empty(t, resp.Blocks)
+	assert.False(t, resp.HasMore)
+	assert.Equal(t, uint64(5), resp.NextBlockNumber)
+
+	res, _ = getBlocksByRange("1", "0")
+	assert.Equal(t, int32(shim.ERROR), res.Status)
+	assert.Equal(t, "Start block number 1 is greater than end block number 0", res.Message)
+
+	res, _ = getBlocksByRange("0", "1", "0")
+	assert.Equal(t, int32(shim.ERROR), res.Status)
+	assert.Equal(t, "Page size 0 is not between 1 and 100", res.Message)
+
+	res, _ = getBlocksByRange("zero", "1")
+	assert.Equal(t, int32(shim.ERROR), res.Status)
+	assert.Contains(t, res.Message, "Failed to parse start block number")
+
+	res, _ = getBlocksByRange("0")
+	assert.Equal(t, int32(shim.ERROR), res.Status)
+	assert.Equal(t, "Start and end block numbers must not be nil.", res.Message)
+}
+
+func TestQueryGetBlockByNumberHeaderOnly(t *testing.T) {
+	chainid := "mytestchainid10"
+	path := tempDir(t, "test10")
+	defer os.RemoveAll(path)
+
+	stub, err := setupTestLedger(chainid, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block1 := addBlockForTesting(t, chainid)
+
+	args := [][]byte{[]byte(GetBlockByNumberHeaderOnly), []byte(chainid), []byte("1")}
+	prop := resetProvider(resources.Qscc_GetBlockByNumberHeaderOnly, chainid, &peer2.SignedProposal{}, nil)
+	res := stub.MockInvokeWithSignedProposal("1", args, prop)
+	require.Equal(t, int32(shim.OK), res.Status, "GetBlockByNumberHeaderOnly failed with err: %s", res.Message)
+	header := &common.BlockHeader{}
+	require.NoError(t, proto.Unmarshal(res.Payload, header))
+	assert.True(t, proto.Equal(block1.Header, header))
+
+	// block number 2 should not be present in the ledger
+	args = [][]byte{[]byte(GetBlockByNumberHeaderOnly), []byte(chainid), []byte("2")}
+	res = stub.MockInvokeWithSignedProposal("2", args, prop)
+	assert.Equal(t, int32(shim.ERROR), res.Status, "GetBlockByNumberHeaderOnly should have failed with invalid number: 2")
+
+	// block number cannot be nil
+	args = [][]byte{[]byte(GetBlockByNumberHeaderOnly), []byte(chainid), []byte(nil)}
+	res = stub.MockInvokeWithSignedProposal("3", args, prop)
+	assert.Equal(t, int32(shim.ERROR), res.Status, "GetBlockByNumberHeaderOnly should have failed with nil block number")
+}
+
+func TestQueryResponseCache(t *testing.T) {
+	chainid := "mytestchainid11"
+	path := tempDir(t, "test11")
+	defer os.RemoveAll(path)
+
+	_, err := setupTestLedger(chainid, path)
+	require.NoError(t, err)
+	lq := &LedgerQuerier{
+		aclProvider:   mockAclProvider,
+		ResponseCache: querycache.New(10),
+	}
+	stub := shim.NewMockStub("LedgerQuerier", lq)
+
+	getHeight := func(prop *peer2.SignedProposal) uint64 {
+		args := [][]byte{[]byte(GetChainInfo), []byte(chainid)}
+		res := stub.MockInvokeWithSignedProposal("1", args, prop)
+		require.Equal(t, int32(shim.OK), res.Status, "GetChainInfo failed with err: %s", res.Message)
+		bcInfo := &common.BlockchainInfo{}
+		require.NoError(t, proto.Unmarshal(res.Payload, bcInfo))
+		return bcInfo.Height
+	}
+
+	prop := resetProvider(resources.Qscc_GetChainInfo, chainid, &peer2.SignedProposal{}, nil)
+	assert.Equal(t, uint64(1), getHeight(prop))
+	assert.Equal(t, 1, lq.ResponseCache.Len())
+	assert.Equal(t, uint64(1), getHeight(prop))
+	assert.Equal(t, 1, lq.ResponseCache.Len())
+
+	// The ACL is checked before a cached response is returned
+	resetProvider(resources.Qscc_GetChainInfo, chainid, prop, errors.New("Failed access control"))
+	res := stub.MockInvokeWithSignedProposal("2", [][]byte{[]byte(GetChainInfo), []byte(chainid)}, prop)
+	assert.Equal(t, int32(shim.ERROR), res.Status)
+	assert.Contains(t, res.Message, "Failed access control")
+
+	// Failed queries are not cached
+	prop = resetProvider(resources.Qscc_GetBlockByNumber, chainid, &peer2.SignedProposal{}, nil)
+	res = stub.MockInvokeWithSignedProposal("3", [][]byte{[]byte(GetBlockByNumber), []byte(chainid), []byte("1")}, prop)
+	assert.Equal(t, int32(shim.ERROR), res.Status)
+	assert.Equal(t, 1, lq.ResponseCache.Len())
+
+	// Committing a block invalidates the cached responses
+	addBlockForTesting(t, chainid)
+	prop = resetProvider(resources.Qscc_GetChainInfo, chainid, &peer2.SignedProposal{}, nil)
+	assert.Equal(t, uint64(2), getHeight(prop))
+	assert.Equal(t, 1, lq.ResponseCache.Len())
+}
+
 func addBlockForTesting(t *testing.T, chainid string) *common.Block {
 	ledger := peer.GetLedger(chainid)
 	defer ledger.Close()
@@ -0,0 +1,126 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package querycache
+
+import (
+	"container/list"
+	"encoding/binary"
+	"sync"
+
+	pb "github.com/hyperledger/fabric/protos/peer"
+)
+
+// Cache caches the responses of the query functions of the system
+// chaincodes, so that clients such as block explorers, which issue the same
+// queries at a high rate, do not have the ledger read and the response
+// marshaled for each of them.
+//
+// A response is bound to the height of the ledger of the channel it was
+// computed at, and is dropped as soon as a block is committed to the
+// channel. Callers are expected to check the ACL of the query before
+// looking up its response, so that cached responses are only returned to
+// clients that are allowed to see them.
+type Cache struct {
+	size int
+
+	mutex   sync.Mutex
+	entries map[cacheKey]*list.Element
+	lru     *list.List
+}
+
+type cacheKey struct {
+	channel string
+	args    string
+}
+
+type cacheEntry struct {
+	key      cacheKey
+	height   uint64
+	response pb.Response
+}
+
+// New creates a Cache that holds at most size responses
+func New(size int) *Cache {
+	return &Cache{
+		size:    size,
+		entries: make(map[cacheKey]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// Get returns the response cached for the arguments of the query on the
+// channel at the given ledger height, if there is one
+func (c *Cache) Get(channel string, height uint64, args [][]byte) (pb.Response, bool) {
+	key := cacheKey{channel: channel, args: encodeArgs(args)}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	elem, exists := c.entries[key]
+	if !exists {
+		return pb.Response{}, false
+	}
+	entry := elem.Value.(*cacheEntry)
+	if entry.height != height {
+		c.lru.Remove(elem)
+		delete(c.entries, key)
+		return pb.Response{}, false
+	}
+	c.lru.MoveToFront(elem)
+	return entry.response, true
+}
+
+// Put caches the response to the arguments of the query on the channel at
+// the given ledger height, and evicts the least recently used response if
+// the cache is full
+func (c *Cache) Put(channel string, height uint64, args [][]byte, response pb.Response) {
+	if c.size <= 0 {
+		return
+	}
+	key := cacheKey{channel: channel, args: encodeArgs(args)}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if elem, exists := c.entries[key]; exists {
+		entry := elem.Value.(*cacheEntry)
+		entry.height = height
+		entry.response = response
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&cacheEntry{key: key, height: height, response: response})
+	if c.lru.Len() > c.size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+// Len returns the number of cached responses
+func (c *Cache) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.lru.Len()
+}
+
+// encodeArgs encodes the arguments of a query into a string, prefixing each
+// argument with its length so that distinct arguments never collide
+func encodeArgs(args [][]byte) string {
+	size := 0
+	for _, arg := range args {
+		size += binary.MaxVarintLen64 + len(arg)
+	}
+	buf := make([]byte, 0, size)
+	lenBuf := make([]byte, binary.MaxVarintLen64)
+	for _, arg := range args {
+		n := binary.PutUvarint(lenBuf, uint64(len(arg)))
+		buf = append(buf, lenBuf[:n]...)
+		buf = append(buf, arg...)
+	}
+	return string(buf)
+}
@@ -0,0 +1,86 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package querycache
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheHeight(t *testing.T) {
+	c := New(10)
+	args := [][]byte{[]byte("GetChainInfo"), []byte("mychannel")}
+
+	_, found := c.Get("mychannel", 5, args)
+	assert.False(t, found)
+
+	c.Put("mychannel", 5, args, shim.Success([]byte("info")))
+	resp, found := c.Get("mychannel", 5, args)
+	assert.True(t, found)
+	assert.Equal(t, []byte("info"), resp.Payload)
+
+	// Another channel does not see the response
+	_, found = c.Get("otherchannel", 5, args)
+	assert.False(t, found)
+
+	// A new block drops the response
+	_, found = c.Get("mychannel", 6, args)
+	assert.False(t, found)
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestCacheArgs(t *testing.T) {
+	c := New(10)
+	c.Put("mychannel", 1, [][]byte{[]byte("ab"), []byte("c")}, shim.Success([]byte("1")))
+
+	_, found := c.Get("mychannel", 1, [][]byte{[]byte("a"), []byte("bc")})
+	assert.False(t, found)
+	_, found = c.Get("mychannel", 1, [][]byte{[]byte("abc")})
+	assert.False(t, found)
+	resp, found := c.Get("mychannel", 1, [][]byte{[]byte("ab"), []byte("c")})
+	assert.True(t, found)
+	assert.Equal(t, []byte("1"), resp.Payload)
+}
+
+func TestCacheEviction(t *testing.T) {
+	c := New(2)
+	a := [][]byte{[]byte("a")}
+	b := [][]byte{[]byte("b")}
+	d := [][]byte{[]byte("d")}
+
+	c.Put("mychannel", 1, a, shim.Success([]byte("a")))
+	c.Put("mychannel", 1, b, shim.Success([]byte("b")))
+	// Looking up a makes b the least recently used response
+	_, found := c.Get("mychannel", 1, a)
+	assert.True(t, found)
+	c.Put("mychannel", 1, d, shim.Success([]byte("d")))
+
+	assert.Equal(t, 2, c.Len())
+	_, found = c.Get("mychannel", 1, b)
+	assert.False(t, found)
+	_, found = c.Get("mychannel", 1, a)
+	assert.True(t, found)
+	_, found = c.Get("mychannel", 1, d)
+	assert.True(t, found)
+
+	// Re-caching a response at a new height replaces it
+	c.Put("mychannel", 2, a, shim.Success([]byte("a2")))
+	resp, found := c.Get("mychannel", 2, a)
+	assert.True(t, found)
+	assert.Equal(t, []byte("a2"), resp.Payload)
+	assert.Equal(t, 2, c.Len())
+}
+
+func TestCacheDisabled(t *testing.T) {
+	c := New(0)
+	args := [][]byte{[]byte("GetChainInfo")}
+	c.Put("mychannel", 1, args, shim.Success(nil))
+	_, found := c.Get("mychannel", 1, args)
+	assert.False(t, found)
+}
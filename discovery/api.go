@@ -35,6 +35,9 @@ type GossipSupport interface {
 	// ChannelExists returns whether a given channel exists or not
 	ChannelExists(channel string) bool
 
+	// Channels returns the channels the peer joined
+	Channels() []common.ChainID
+
 	// PeersOfChannel returns the NetworkMembers considered alive
 	// and also subscribed to the channel given
 	PeersOfChannel(common.ChainID) discovery.Members
@@ -63,6 +66,10 @@ type EndorsementSupport interface {
 type ConfigSupport interface {
 	// Config returns the channel's configuration
 	Config(channel string) (*discprotos.ConfigResult, error)
+
+	// Orderers returns the ordering service endpoints of the channel,
+	// and the consensus type and metadata of its ordering service
+	Orderers(channel string) (*discprotos.OrdererResult, error)
 }
 
 // Support defines an interface that allows the discovery service
@@ -39,6 +39,9 @@ type ChannelResponse interface {
 	// Config returns a response for a config query, or error if something went wrong
 	Config() (*discovery.ConfigResult, error)
 
+	// Orderers returns a response for an orderer query, or error if something went wrong
+	Orderers() (*discovery.OrdererResult, error)
+
 	// Peers returns a response for a peer membership query, or error if something went wrong
 	Peers(invocationChain ...*discovery.ChaincodeCall) ([]*Peer, error)
 
@@ -70,4 +73,9 @@ type Peer struct {
 	AliveMessage     *protoext.SignedGossipMessage
 	StateInfoMessage *protoext.SignedGossipMessage
 	Identity         []byte
+	// ChannelStateInfoMessages maps the channels the peer joined to its
+	// StateInfo messages in these channels, which contain the chaincodes
+	// of the peer in each channel. It is only populated by local peer
+	// queries that include chaincodes.
+	ChannelStateInfoMessages map[string]*protoext.SignedGossipMessage
 }
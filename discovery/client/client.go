@@ -17,6 +17,7 @@ import (
 	"github.com/hyperledger/fabric/discovery/protoext"
 	gprotoext "github.com/hyperledger/fabric/gossip/protoext"
 	"github.com/hyperledger/fabric/protos/discovery"
+	"github.com/hyperledger/fabric/protos/gossip"
 	"github.com/hyperledger/fabric/protos/msp"
 	"github.com/pkg/errors"
 )
@@ -26,6 +27,7 @@ var configTypes = []protoext.QueryType{
 	protoext.PeerMembershipQueryType,
 	protoext.ChaincodeQueryType,
 	protoext.LocalMembershipQueryType,
+	protoext.OrdererQueryType,
 }
 
 // Client interacts with the discovery server
@@ -73,6 +75,21 @@ func (req *Request) AddConfigQuery() *Request {
 	return req
 }
 
+// AddOrderersQuery adds to the request a query for the ordering service
+// endpoints and consensus type of the channel
+func (req *Request) AddOrderersQuery() *Request {
+	ch := req.lastChannel
+	q := &discovery.Query_OrdererQuery{
+		OrdererQuery: &discovery.OrdererQuery{},
+	}
+	req.Queries = append(req.Queries, &discovery.Query{
+		Channel: ch,
+		Query:   q,
+	})
+	req.addQueryMapping(protoext.OrdererQueryType, ch)
+	return req
+}
+
 // AddEndorsersQuery adds to the request a query for given chaincodes
 // interests are the chaincode interests that the client wants to query for.
 // All interests for a given channel should be supplied in an aggregated slice
@@ -101,8 +118,22 @@ func (req *Request) AddEndorsersQuery(interests ...*discovery.ChaincodeInterest)
 
 // AddLocalPeersQuery adds to the request a local peer query
 func (req *Request) AddLocalPeersQuery() *Request {
+	return req.addLocalPeersQuery(false)
+}
+
+// AddLocalPeersWithChaincodesQuery adds to the request a local peer query
+// that also returns the StateInfo messages of the peers for the channels
+// the client is eligible for, which contain the chaincodes of the peers in
+// each channel. It replaces a local peer query added to the request.
+func (req *Request) AddLocalPeersWithChaincodesQuery() *Request {
+	return req.addLocalPeersQuery(true)
+}
+
+func (req *Request) addLocalPeersQuery(includeChaincodes bool) *Request {
 	q := &discovery.Query_LocalPeers{
-		LocalPeers: &discovery.LocalPeerQuery{},
+		LocalPeers: &discovery.LocalPeerQuery{
+			IncludeChaincodes: includeChaincodes,
+		},
 	}
 	req.Queries = append(req.Queries, &discovery.Query{
 		Query: q,
@@ -222,6 +253,23 @@ func (cr *channelResponse) Config() (*discovery.ConfigResult, error) {
 	return nil, res.(error)
 }
 
+func (cr *channelResponse) Orderers() (*discovery.OrdererResult, error) {
+	res, exists := cr.response[key{
+		queryType: protoext.OrdererQueryType,
+		k:         cr.channel,
+	}]
+
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	if orderers, isOrderers := res.(*discovery.OrdererResult); isOrderers {
+		return orderers, nil
+	}
+
+	return nil, res.(error)
+}
+
 func parsePeers(queryType protoext.QueryType, r response, channel string, invocationChain ...*discovery.ChaincodeCall) ([]*Peer, error) {
 	peerKeys := key{
 		queryType: queryType,
@@ -350,6 +398,8 @@ func (req *Request) computeResponse(r *discovery.Response) (response, error) {
 			err = resp.mapPeerMembership(channel2index, r, protoext.PeerMembershipQueryType)
 		case protoext.LocalMembershipQueryType:
 			err = resp.mapPeerMembership(channel2index, r, protoext.LocalMembershipQueryType)
+		case protoext.OrdererQueryType:
+			err = resp.mapOrderers(channel2index, r)
 		}
 		if err != nil {
 			return nil, err
@@ -380,6 +430,27 @@ func (resp response) mapConfig(channel2index map[string]int, r *discovery.Respon
 	return nil
 }
 
+func (resp response) mapOrderers(channel2index map[string]int, r *discovery.Response) error {
+	for ch, index := range channel2index {
+		orderers, err := protoext.ResponseOrderersAt(r, index)
+		if orderers == nil && err == nil {
+			return errors.Errorf("expected QueryResult of either OrdererResult or Error but got %v instead", r.Results[index])
+		}
+		key := key{
+			queryType: protoext.OrdererQueryType,
+			k:         ch,
+		}
+
+		if err != nil {
+			resp[key] = errors.New(err.Content)
+			continue
+		}
+
+		resp[key] = orderers
+	}
+	return nil
+}
+
 func (resp response) mapPeerMembership(key2Index map[string]int, r *discovery.Response, qt protoext.QueryType) error {
 	for k, index := range key2Index {
 		membersRes, err := protoext.ResponseMembershipAt(r, index)
@@ -428,17 +499,40 @@ func peersForChannel(membersRes *discovery.PeerMembershipResult, qt protoext.Que
 			if err := validateAliveMessage(aliveMsg); err != nil {
 				return nil, errors.Wrap(err, "failed validating alive message")
 			}
+			channelStateInfoMsgs, err := channelStateInfoMessages(peer.ChannelStateInfos)
+			if err != nil {
+				return nil, err
+			}
 			peers = append(peers, &Peer{
-				MSPID:            org,
-				Identity:         peer.Identity,
-				AliveMessage:     aliveMsg,
-				StateInfoMessage: stateInfoMsg,
+				MSPID:                    org,
+				Identity:                 peer.Identity,
+				AliveMessage:             aliveMsg,
+				StateInfoMessage:         stateInfoMsg,
+				ChannelStateInfoMessages: channelStateInfoMsgs,
 			})
 		}
 	}
 	return peers, nil
 }
 
+func channelStateInfoMessages(envelopes map[string]*gossip.Envelope) (map[string]*gprotoext.SignedGossipMessage, error) {
+	if len(envelopes) == 0 {
+		return nil, nil
+	}
+	res := make(map[string]*gprotoext.SignedGossipMessage, len(envelopes))
+	for channel, envelope := range envelopes {
+		stateInfoMsg, err := gprotoext.EnvelopeToGossipMessage(envelope)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed unmarshaling stateInfo message of channel %s", channel)
+		}
+		if err := validateStateInfoMessage(stateInfoMsg); err != nil {
+			return nil, errors.Wrapf(err, "failed validating stateInfo message of channel %s", channel)
+		}
+		res[channel] = stateInfoMsg
+	}
+	return res, nil
+}
+
 func isStateInfoExpected(qt protoext.QueryType) bool {
 	return qt != protoext.LocalMembershipQueryType
 }
@@ -111,6 +111,14 @@ var (
 		},
 	}
 
+	expectedOrderers = &discovery.OrdererResult{
+		Orderers: map[string]*discovery.Endpoints{
+			"A": {Endpoint: []*discovery.Endpoint{{Host: "orderer.a", Port: 7050}}},
+		},
+		ConsensusType:     "etcdraft",
+		ConsensusMetadata: []byte{1, 2, 3},
+	}
+
 	channelPeersWithChaincodes = gdisc.Members{
 		newPeer(0, stateInfoMessage(cc, cc2), propertiesWithChaincodes).NetworkMember,
 		newPeer(1, stateInfoMessage(cc, cc2), propertiesWithChaincodes).NetworkMember,
@@ -359,6 +367,7 @@ func createDiscoveryService(sup *mockSupport) discovery.DiscoveryServer {
 	})
 
 	sup.On("Config", "mychannel").Return(expectedConf)
+	sup.On("Orderers", "mychannel").Return(expectedOrderers)
 	sup.On("Peers").Return(membershipPeers)
 	sup.endorsementAnalyzer = endorsement.NewEndorsementAnalyzer(sup, pf, pe, mdf)
 	sup.On("IdentityInfo").Return(peerIdentities)
@@ -391,7 +400,7 @@ func TestClient(t *testing.T) {
 
 	sup.On("PeersOfChannel").Return(channelPeersWithoutChaincodes).Times(2)
 	req := NewRequest()
-	req.OfChannel("mychannel").AddPeersQuery().AddConfigQuery().AddLocalPeersQuery().AddEndorsersQuery(interest("mycc"))
+	req.OfChannel("mychannel").AddPeersQuery().AddConfigQuery().AddOrderersQuery().AddLocalPeersQuery().AddEndorsersQuery(interest("mycc"))
 	r, err := cl.Send(ctx, req, authInfo)
 	assert.NoError(t, err)
 
@@ -409,6 +418,16 @@ func TestClient(t *testing.T) {
 		conf, err := fakeChannel.Config()
 		assert.Equal(t, ErrNotFound, err)
 		assert.Nil(t, conf)
+
+		orderers, err := fakeChannel.Orderers()
+		assert.Equal(t, ErrNotFound, err)
+		assert.Nil(t, orderers)
+	})
+
+	t.Run("Orderer query", func(t *testing.T) {
+		orderers, err := r.ForChannel("mychannel").Orderers()
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(expectedOrderers, orderers))
 	})
 
 	t.Run("Peer membership query", func(t *testing.T) {
@@ -498,6 +517,29 @@ func TestClient(t *testing.T) {
 		assert.Len(t, peers, 6)
 	})
 
+	t.Run("Local peer query with chaincodes", func(t *testing.T) {
+		sup.On("Channels").Return([]gossipcommon.ChainID{gossipcommon.ChainID("mychannel")}).Once()
+		sup.On("PeersOfChannel").Return(channelPeersWithChaincodes).Once()
+		req = NewRequest().AddLocalPeersWithChaincodesQuery()
+		r, err = cl.Send(ctx, req, authInfo)
+		assert.NoError(t, err)
+		peers, err := r.ForLocal().Peers()
+		assert.NoError(t, err)
+		assert.Len(t, peers, len(peerIdentities))
+		// Only the peers in the channel view have StateInfo messages
+		var peersWithChaincodes int
+		for _, p := range peers {
+			if p.ChannelStateInfoMessages == nil {
+				continue
+			}
+			peersWithChaincodes++
+			stateInfo := p.ChannelStateInfoMessages["mychannel"].GetStateInfo()
+			assert.True(t, proto.Equal(cc, stateInfo.Properties.Chaincodes[0]))
+			assert.True(t, proto.Equal(cc2, stateInfo.Properties.Chaincodes[1]))
+		}
+		assert.Equal(t, len(channelPeersWithChaincodes), peersWithChaincodes)
+	})
+
 	t.Run("Endorser query with PrioritiesByHeight selector", func(t *testing.T) {
 		sup.On("PeersOfChannel").Return(channelPeersWithDifferentLedgerHeights).Twice()
 		req = NewRequest()
@@ -948,6 +990,10 @@ func (*mockSupport) ChannelExists(channel string) bool {
 	return true
 }
 
+func (ms *mockSupport) Channels() []gossipcommon.ChainID {
+	return ms.Called().Get(0).([]gossipcommon.ChainID)
+}
+
 func (ms *mockSupport) PeersOfChannel(gossipcommon.ChainID) gdisc.Members {
 	return ms.Called().Get(0).(gdisc.Members)
 }
@@ -972,6 +1018,10 @@ func (ms *mockSupport) Config(channel string) (*discovery.ConfigResult, error) {
 	return ms.Called(channel).Get(0).(*discovery.ConfigResult), nil
 }
 
+func (ms *mockSupport) Orderers(channel string) (*discovery.OrdererResult, error) {
+	return ms.Called(channel).Get(0).(*discovery.OrdererResult), nil
+}
+
 type mockDiscoveryServer struct {
 	mock.Mock
 	*grpc.Server
@@ -60,8 +60,12 @@ func AddCommands(cli CommandRegistrar) {
 	peers := cli.Command(PeersCommand, "Discover peers", peerCmd.Execute)
 	server := peers.Flag("server", "Sets the endpoint of the server to connect").String()
 	channel := peers.Flag("channel", "Sets the channel the query is intended to").String()
+	chaincodes := peers.Flag("chaincode", "Filters the peers by the chaincode name(s) they have installed").Strings()
+	collections := peers.Flag("collection", "Filters the peers by the collection name(s) they are members of, as a mapping from chaincode to a comma separated list of collections").PlaceHolder("CC:C1,C2").StringMap()
 	peerCmd.SetServer(server)
 	peerCmd.SetChannel(channel)
+	peerCmd.SetChaincodes(chaincodes)
+	peerCmd.SetCollections(collections)
 
 	configCmd := NewConfigCmd(&ClientStub{}, &ConfigResponseParser{Writer: responseParserWriter})
 	config := cli.Command(ConfigCommand, "Discover channel config", configCmd.Execute)
@@ -72,8 +76,8 @@ func AddCommands(cli CommandRegistrar) {
 
 	endorserCmd := NewEndorsersCmd(&RawStub{}, &EndorserResponseParser{Writer: responseParserWriter})
 	endorsers := cli.Command(EndorsersCommand, "Discover chaincode endorsers", endorserCmd.Execute)
-	chaincodes := endorsers.Flag("chaincode", "Specifies the chaincode name(s)").Strings()
-	collections := endorsers.Flag("collection", "Specifies the collection name(s) as a mapping from chaincode to a comma separated list of collections").PlaceHolder("CC:C1,C2").StringMap()
+	chaincodes = endorsers.Flag("chaincode", "Specifies the chaincode name(s)").Strings()
+	collections = endorsers.Flag("collection", "Specifies the collection name(s) as a mapping from chaincode to a comma separated list of collections").PlaceHolder("CC:C1,C2").StringMap()
 	server = endorsers.Flag("server", "Sets the endpoint of the server to connect").String()
 	channel = endorsers.Flag("channel", "Sets the channel the query is intended to").String()
 	endorserCmd.SetChannel(channel)
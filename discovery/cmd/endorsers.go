@@ -78,20 +78,11 @@ func (pc *EndorsersCmd) Execute(conf common.Config) error {
 		Chaincodes:  pc.chaincodes,
 		Collections: pc.collections,
 	}
-	cc2collections, err := ccAndCol.parseInput()
+	ccCalls, err := ccAndCol.chaincodeCalls()
 	if err != nil {
 		return err
 	}
 
-	var ccCalls []*ChaincodeCall
-
-	for _, cc := range *ccAndCol.Chaincodes {
-		ccCalls = append(ccCalls, &ChaincodeCall{
-			Name:            cc,
-			CollectionNames: cc2collections[cc],
-		})
-	}
-
 	req, err := discovery.NewRequest().OfChannel(channel).AddEndorsersQuery(&ChaincodeInterest{Chaincodes: ccCalls})
 	if err != nil {
 		return errors.Wrap(err, "failed creating request")
@@ -170,6 +161,25 @@ func (ec *chaincodesAndCollections) parseInput() (map[string][]string, error) {
 	return res, nil
 }
 
+// chaincodeCalls returns the chaincode invocation chain made of the chaincodes
+// and their collections
+func (ec *chaincodesAndCollections) chaincodeCalls() ([]*ChaincodeCall, error) {
+	cc2collections, err := ec.parseInput()
+	if err != nil {
+		return nil, err
+	}
+
+	var ccCalls []*ChaincodeCall
+
+	for _, cc := range *ec.Chaincodes {
+		ccCalls = append(ccCalls, &ChaincodeCall{
+			Name:            cc,
+			CollectionNames: cc2collections[cc],
+		})
+	}
+	return ccCalls, nil
+}
+
 func parseEndorsementDescriptors(descriptors []*EndorsementDescriptor) []endorsermentDescriptor {
 	var res []endorsermentDescriptor
 	for _, desc := range descriptors {
@@ -56,6 +56,29 @@ func (_m *ChannelResponse) Endorsers(invocationChain client.InvocationChain, f c
 	return r0, r1
 }
 
+// Orderers provides a mock function with given fields:
+func (_m *ChannelResponse) Orderers() (*discovery.OrdererResult, error) {
+	ret := _m.Called()
+
+	var r0 *discovery.OrdererResult
+	if rf, ok := ret.Get(0).(func() *discovery.OrdererResult); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*discovery.OrdererResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Peers provides a mock function with given fields: invocationChain
 func (_m *ChannelResponse) Peers(invocationChain ...*discovery.ChaincodeCall) ([]*client.Peer, error) {
 	_va := make([]interface{}, len(invocationChain))
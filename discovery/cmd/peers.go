@@ -14,6 +14,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/cmd/common"
 	"github.com/hyperledger/fabric/discovery/client"
+	discprotos "github.com/hyperledger/fabric/protos/discovery"
 	"github.com/hyperledger/fabric/protos/msp"
 	"github.com/pkg/errors"
 )
@@ -28,10 +29,12 @@ func NewPeerCmd(stub Stub, parser ResponseParser) *PeerCmd {
 
 // PeerCmd executes channelPeer listing command
 type PeerCmd struct {
-	stub    Stub
-	server  *string
-	channel *string
-	parser  ResponseParser
+	stub        Stub
+	server      *string
+	channel     *string
+	chaincodes  *[]string
+	collections *map[string]string
+	parser      ResponseParser
 }
 
 // SetServer sets the server of the PeerCmd
@@ -44,6 +47,16 @@ func (pc *PeerCmd) SetChannel(channel *string) {
 	pc.channel = channel
 }
 
+// SetChaincodes sets the chaincodes that the peers are filtered by
+func (pc *PeerCmd) SetChaincodes(chaincodes *[]string) {
+	pc.chaincodes = chaincodes
+}
+
+// SetCollections sets the collections that the peers are filtered by
+func (pc *PeerCmd) SetCollections(collections *map[string]string) {
+	pc.collections = collections
+}
+
 // Execute executes the command
 func (pc *PeerCmd) Execute(conf common.Config) error {
 	channel := ""
@@ -58,10 +71,22 @@ func (pc *PeerCmd) Execute(conf common.Config) error {
 
 	server := *pc.server
 
+	ccAndCol := &chaincodesAndCollections{
+		Chaincodes:  pc.chaincodes,
+		Collections: pc.collections,
+	}
+	ccCalls, err := ccAndCol.chaincodeCalls()
+	if err != nil {
+		return err
+	}
+	if channel == "" && len(ccCalls) > 0 {
+		return errors.New("chaincodes can only be specified for a channel peer query")
+	}
+
 	req := discovery.NewRequest()
 	if channel != "" {
 		req = req.OfChannel(channel)
-		req = req.AddPeersQuery()
+		req = req.AddPeersQuery(ccCalls...)
 	} else {
 		req = req.AddLocalPeersQuery()
 	}
@@ -69,6 +94,9 @@ func (pc *PeerCmd) Execute(conf common.Config) error {
 	if err != nil {
 		return err
 	}
+	if len(ccCalls) > 0 {
+		res = &invocationChainResponse{ServiceResponse: res, invocationChain: ccCalls}
+	}
 	return pc.parser.ParseResponse(channel, res)
 }
 
@@ -125,6 +153,29 @@ type localPeer struct {
 	Identity string
 }
 
+// invocationChainResponse returns the peers of the channel that are
+// eligible for the chaincode invocation chain the peers were queried for
+type invocationChainResponse struct {
+	ServiceResponse
+	invocationChain []*discprotos.ChaincodeCall
+}
+
+func (icr *invocationChainResponse) ForChannel(channel string) discovery.ChannelResponse {
+	return &invocationChainChannelResponse{
+		ChannelResponse: icr.ServiceResponse.ForChannel(channel),
+		invocationChain: icr.invocationChain,
+	}
+}
+
+type invocationChainChannelResponse struct {
+	discovery.ChannelResponse
+	invocationChain []*discprotos.ChaincodeCall
+}
+
+func (iccr *invocationChainChannelResponse) Peers(...*discprotos.ChaincodeCall) ([]*discovery.Peer, error) {
+	return iccr.ChannelResponse.Peers(iccr.invocationChain...)
+}
+
 type peerLister interface {
 	Peers() ([]*discovery.Peer, error)
 }
@@ -17,6 +17,7 @@ import (
 	discovery "github.com/hyperledger/fabric/discovery/cmd"
 	"github.com/hyperledger/fabric/discovery/cmd/mocks"
 	"github.com/hyperledger/fabric/gossip/protoext"
+	discprotos "github.com/hyperledger/fabric/protos/discovery"
 	"github.com/hyperledger/fabric/protos/gossip"
 	"github.com/hyperledger/fabric/protos/msp"
 	"github.com/hyperledger/fabric/protoutil"
@@ -60,6 +61,48 @@ func TestPeerCmd(t *testing.T) {
 		err = cmd.Execute(common.Config{})
 		assert.NoError(t, err)
 	})
+
+	t.Run("Channel peer query with chaincodes and collections", func(t *testing.T) {
+		channel := "mychannel"
+		chaincodes := []string{"mycc"}
+		collections := map[string]string{
+			"mycc": "col1,col2",
+		}
+		invocationChain := []*discprotos.ChaincodeCall{{Name: "mycc", CollectionNames: []string{"col1", "col2"}}}
+
+		stub := &mocks.Stub{}
+		parser := &mocks.ResponseParser{}
+		cmd := discovery.NewPeerCmd(stub, parser)
+		cmd.SetServer(&server)
+		cmd.SetChannel(&channel)
+		cmd.SetChaincodes(&chaincodes)
+		cmd.SetCollections(&collections)
+
+		chanRes := &mocks.ChannelResponse{}
+		chanRes.On("Peers", invocationChain[0]).Return([]*Peer{{MSPID: "Org1MSP"}}, nil)
+		res := &mocks.ServiceResponse{}
+		res.On("ForChannel", channel).Return(chanRes)
+		stub.On("Send", server, mock.Anything, mock.Anything).Return(res, nil).Once().Run(func(arg mock.Arguments) {
+			// Ensure the peers are filtered by the invocation chain passed in the CLI
+			req := arg.Get(2).(*Request)
+			assert.Equal(t, invocationChain, req.Queries[0].GetPeerQuery().Filter.Chaincodes)
+		})
+		parser.On("ParseResponse", channel, mock.Anything).Return(nil).Once().Run(func(arg mock.Arguments) {
+			// Ensure the parser gets the peers of the invocation chain
+			peers, err := arg.Get(1).(discovery.ServiceResponse).ForChannel(channel).Peers()
+			assert.NoError(t, err)
+			assert.Equal(t, []*Peer{{MSPID: "Org1MSP"}}, peers)
+		})
+
+		err := cmd.Execute(common.Config{})
+		assert.NoError(t, err)
+		parser.AssertNumberOfCalls(t, "ParseResponse", 1)
+
+		// Chaincodes cannot be specified for a channel-less peer query
+		cmd.SetChannel(nil)
+		err = cmd.Execute(common.Config{})
+		assert.EqualError(t, err, "chaincodes can only be specified for a channel peer query")
+	})
 }
 
 func TestParsePeers(t *testing.T) {
@@ -61,6 +61,10 @@ type endorsementAnalyzer struct {
 	principalEvaluator
 	policyFetcher
 	chaincodeMetadataFetcher
+	// MaxBlocksBehind is the maximum number of blocks the ledger of a peer
+	// may lag behind the highest ledger of the channel for the peer to be
+	// selected as an endorser, or 0 to select peers regardless of the lag
+	MaxBlocksBehind uint64
 }
 
 // NewEndorsementAnalyzer constructs an NewEndorsementAnalyzer out of the given support
@@ -81,6 +85,10 @@ func (ea *endorsementAnalyzer) PeersForEndorsement(chainID common.ChainID, inter
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
+	if ea.MaxBlocksBehind > 0 {
+		// Filter out peers that are still catching up with the channel
+		chanMembership = chanMembership.Filter(readyForEndorsement(ea.PeersOfChannel(chainID), ea.MaxBlocksBehind))
+	}
 	channelMembersById := chanMembership.ByID()
 	// Choose only the alive messages of those that have joined the channel
 	aliveMembership := ea.Peers().Intersect(chanMembership)
@@ -127,6 +135,16 @@ func (ea *endorsementAnalyzer) PeersAuthorizedByCriteria(chainID common.ChainID,
 	return chanMembership.Filter(metadataAndCollectionFilters.isMemberAuthorized), nil
 }
 
+// readyForEndorsement returns a filter that selects the peers whose ledger
+// height is at most maxBlocksBehind blocks behind the highest ledger height
+// reached by a majority of the given peers of the channel
+func readyForEndorsement(peersOfChannel Members, maxBlocksBehind uint64) func(NetworkMember) bool {
+	tip := peersOfChannel.QuorumLedgerHeight()
+	return func(member NetworkMember) bool {
+		return member.Properties.GetLedgerHeight()+maxBlocksBehind >= tip
+	}
+}
+
 type context struct {
 	chaincode           string
 	channel             string
@@ -163,6 +163,40 @@ func TestPeersForEndorsement(t *testing.T) {
 		}, extractPeers(desc))
 	})
 
+	t.Run("PeersBehind", func(t *testing.T) {
+		// Scenario: Policy is found and there are enough peers to satisfy
+		// 2 principal combinations: p0 and p6, or p12 alone.
+		// However, p12 is still catching up with the channel, so only
+		// the combination of p0 and p6 can be satisfied. p1 advertises a
+		// height far ahead of the channel, which is ignored
+		pb := principalBuilder{}
+		policy := pb.newSet().addPrincipal(peerRole("p0")).addPrincipal(peerRole("p6")).
+			newSet().addPrincipal(peerRole("p12")).buildPolicy()
+		chanPeers := peerSet{
+			newPeer(0).withChaincode(cc, "1.0").withLedgerHeight(100),
+			newPeer(3).withChaincode(cc, "1.0").withLedgerHeight(100),
+			newPeer(6).withChaincode(cc, "1.0").withLedgerHeight(95),
+			newPeer(9).withChaincode(cc, "1.0").withLedgerHeight(100),
+			newPeer(11).withChaincode(cc, "1.0").withLedgerHeight(100),
+			newPeer(12).withChaincode(cc, "1.0").withLedgerHeight(80),
+			newPeer(1).withChaincode(cc, "1.0").withLedgerHeight(100000),
+		}
+		g.On("PeersOfChannel").Return(chanPeers.toMembers()).Twice()
+		mf.On("Metadata").Return(&chaincode.Metadata{Name: cc, Version: "1.0"}).Once()
+		analyzer := NewEndorsementAnalyzer(g, pf, &principalEvaluatorMock{}, mf)
+		analyzer.MaxBlocksBehind = 5
+		pf.On("PolicyByChaincode", cc).Return(policy).Once()
+		desc, err := analyzer.PeersForEndorsement(channel, &discoveryprotos.ChaincodeInterest{Chaincodes: []*discoveryprotos.ChaincodeCall{{Name: cc}}})
+		assert.NoError(t, err)
+		assert.NotNil(t, desc)
+		assert.Len(t, desc.Layouts, 1)
+		assert.Len(t, desc.Layouts[0].QuantitiesByGroup, 2)
+		assert.Equal(t, map[string]struct{}{
+			peerIdentityString("p0"): {},
+			peerIdentityString("p6"): {},
+		}, extractPeers(desc))
+	})
+
 	t.Run("WrongVersionInstalled", func(t *testing.T) {
 		// Scenario V: Policy is found, and there are enough peers to satisfy policy combinations,
 		// but all peers have the wrong version installed on them.
@@ -601,6 +635,14 @@ func (pi *peerInfo) withChaincode(name, version string) *peerInfo {
 	return pi
 }
 
+func (pi *peerInfo) withLedgerHeight(height uint64) *peerInfo {
+	if pi.Properties == nil {
+		pi.Properties = &gossip.Properties{}
+	}
+	pi.Properties.LedgerHeight = height
+	return pi
+}
+
 type gossipMock struct {
 	mock.Mock
 }
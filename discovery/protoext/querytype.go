@@ -17,6 +17,7 @@ const (
 	PeerMembershipQueryType
 	ChaincodeQueryType
 	LocalMembershipQueryType
+	OrdererQueryType
 )
 
 // GetType returns the type of the request
@@ -30,6 +31,8 @@ func GetQueryType(q *discovery.Query) QueryType {
 		return PeerMembershipQueryType
 	case q.GetLocalPeers() != nil:
 		return LocalMembershipQueryType
+	case q.GetOrdererQuery() != nil:
+		return OrdererQueryType
 	default:
 		return InvalidQueryType
 	}
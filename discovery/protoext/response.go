@@ -15,6 +15,13 @@ func ResponseConfigAt(m *discovery.Response, i int) (*discovery.ConfigResult, *d
 	return r.GetConfigResult(), r.GetError()
 }
 
+// ResponseOrderersAt returns the OrdererResult at a given index in the Response,
+// or an Error if present.
+func ResponseOrderersAt(m *discovery.Response, i int) (*discovery.OrdererResult, *discovery.Error) {
+	r := m.Results[i]
+	return r.GetOrdererResult(), r.GetError()
+}
+
 // ResponseMembershipAt returns the PeerMembershipResult at a given index in the Response,
 // or an Error if present.
 func ResponseMembershipAt(m *discovery.Response, i int) (*discovery.PeerMembershipResult, *discovery.Error) {
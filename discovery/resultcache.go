@@ -0,0 +1,222 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package discovery
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/util"
+	common2 "github.com/hyperledger/fabric/gossip/common"
+	discovery2 "github.com/hyperledger/fabric/gossip/discovery"
+	"github.com/hyperledger/fabric/protos/discovery"
+)
+
+const defaultResultCacheTTL = 10 * time.Second
+
+type resultCacheSupport interface {
+	// ConfigSequence returns the configuration sequence of the given channel
+	ConfigSequence(channel string) uint64
+
+	// PeersOfChannel returns the NetworkMembers considered alive
+	// and also subscribed to the channel given
+	PeersOfChannel(common2.ChainID) discovery2.Members
+
+	// Peers returns the NetworkMembers considered alive
+	Peers() discovery2.Members
+}
+
+type resultCacheConfig struct {
+	enabled bool
+	// maxCacheSize is the maximum number of results cached for a channel,
+	// after which a purge takes place
+	maxCacheSize int
+	// purgeRetentionRatio is the % of entries that remain in the cache
+	// after the cache is purged due to overpopulation
+	purgeRetentionRatio float64
+	// ttl is the time after which a result is computed again even if
+	// neither the config nor the membership changed, as the results contain
+	// the ledger heights of the peers
+	ttl time.Duration
+}
+
+// resultCache memoizes the results of channel scoped queries. The results of
+// a channel are invalidated when the config sequence of the channel changes,
+// or when the membership view changes, that is, when peers join, leave or
+// die, or when the chaincodes installed on the peers of the channel change.
+type resultCache struct {
+	resultCacheSupport
+	conf resultCacheConfig
+	now  func() time.Time
+
+	sync.RWMutex
+	channels map[string]*channelResults
+}
+
+type channelResults struct {
+	sync.RWMutex
+	version string
+	entries map[string]cachedResult
+}
+
+type cachedResult struct {
+	result    *discovery.QueryResult
+	expiresAt time.Time
+}
+
+func newResultCache(s resultCacheSupport, conf resultCacheConfig) *resultCache {
+	if conf.maxCacheSize <= 0 {
+		conf.maxCacheSize = defaultMaxCacheSize
+	}
+	if conf.ttl <= 0 {
+		conf.ttl = defaultResultCacheTTL
+	}
+	return &resultCache{
+		resultCacheSupport: s,
+		conf:               conf,
+		now:                time.Now,
+		channels:           make(map[string]*channelResults),
+	}
+}
+
+// QueryResult returns the cached result of the given channel scoped query,
+// or computes it with the given dispatcher and caches it
+func (rc *resultCache) QueryResult(q *discovery.Query, dispatchQuery dispatcher) *discovery.QueryResult {
+	if !rc.conf.enabled || q.Channel == "" {
+		return dispatchQuery(q)
+	}
+	key, err := queryToKey(q)
+	if err != nil {
+		logger.Warningf("Failed computing key of query: %+v", err)
+		return dispatchQuery(q)
+	}
+
+	cache := rc.channelResults(q.Channel)
+	version := rc.version(q.Channel)
+	if result, found := cache.lookup(version, key, rc.now()); found {
+		return result
+	}
+
+	result := dispatchQuery(q)
+	if _, isErr := result.Result.(*discovery.QueryResult_Error); isErr {
+		return result
+	}
+	// The config or the membership might have changed while computing the
+	// result, in which case it is not cached as a fresher result might
+	// already be cached
+	if rc.version(q.Channel) != version {
+		return result
+	}
+	cache.store(version, key, cachedResult{result: result, expiresAt: rc.now().Add(rc.conf.ttl)}, rc.conf)
+	return result
+}
+
+func (rc *resultCache) channelResults(channel string) *channelResults {
+	rc.RLock()
+	cache := rc.channels[channel]
+	rc.RUnlock()
+	if cache != nil {
+		return cache
+	}
+
+	rc.Lock()
+	defer rc.Unlock()
+	if cache = rc.channels[channel]; cache == nil {
+		cache = &channelResults{entries: make(map[string]cachedResult)}
+		rc.channels[channel] = cache
+	}
+	return cache
+}
+
+// version returns the version of the config and the membership view of the
+// channel that the results are computed from
+func (rc *resultCache) version(channel string) string {
+	buf := &bytes.Buffer{}
+	binary.Write(buf, binary.BigEndian, rc.ConfigSequence(channel))
+	writeMembers(buf, rc.Peers(), false)
+	writeMembers(buf, rc.PeersOfChannel(common2.ChainID(channel)), true)
+	return hex.EncodeToString(util.ComputeSHA256(buf.Bytes()))
+}
+
+// writeMembers writes the PKI-IDs and the endpoints of the members, and the
+// chaincodes installed on them if withProperties is set, without their
+// ledger heights which change with every block
+func writeMembers(buf *bytes.Buffer, members discovery2.Members, withProperties bool) {
+	sorted := make(discovery2.Members, len(members))
+	copy(sorted, members)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].PKIid, sorted[j].PKIid) < 0
+	})
+	binary.Write(buf, binary.BigEndian, uint32(len(sorted)))
+	for _, member := range sorted {
+		writeBytes(buf, member.PKIid)
+		writeBytes(buf, []byte(member.Endpoint))
+		if !withProperties || member.Properties == nil {
+			continue
+		}
+		if member.Properties.LeftChannel {
+			buf.WriteByte(1)
+		}
+		for _, cc := range member.Properties.Chaincodes {
+			writeBytes(buf, []byte(cc.Name))
+			writeBytes(buf, []byte(cc.Version))
+			writeBytes(buf, cc.Metadata)
+		}
+	}
+}
+
+func writeBytes(buf *bytes.Buffer, b []byte) {
+	binary.Write(buf, binary.BigEndian, uint32(len(b)))
+	buf.Write(b)
+}
+
+func (cache *channelResults) lookup(version, key string, now time.Time) (*discovery.QueryResult, bool) {
+	cache.RLock()
+	defer cache.RUnlock()
+	if cache.version != version {
+		return nil, false
+	}
+	entry, exists := cache.entries[key]
+	if !exists || now.After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.result, true
+}
+
+func (cache *channelResults) store(version, key string, entry cachedResult, conf resultCacheConfig) {
+	cache.Lock()
+	defer cache.Unlock()
+	if cache.version != version {
+		// Invalidate the results computed from another config or membership
+		cache.version = version
+		cache.entries = make(map[string]cachedResult)
+	}
+	if len(cache.entries)+1 > conf.maxCacheSize {
+		entries2evict := conf.maxCacheSize - int(conf.purgeRetentionRatio*float64(conf.maxCacheSize))
+		for key := range cache.entries {
+			if entries2evict == 0 {
+				break
+			}
+			entries2evict--
+			delete(cache.entries, key)
+		}
+	}
+	cache.entries[key] = entry
+}
+
+func queryToKey(q *discovery.Query) (string, error) {
+	b, err := proto.Marshal(q)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(util.ComputeSHA256(b)), nil
+}
@@ -0,0 +1,167 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package discovery
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	common2 "github.com/hyperledger/fabric/gossip/common"
+	discovery2 "github.com/hyperledger/fabric/gossip/discovery"
+	"github.com/hyperledger/fabric/protos/discovery"
+	"github.com/hyperledger/fabric/protos/gossip"
+	"github.com/stretchr/testify/assert"
+)
+
+type resultCacheSupportMock struct {
+	sequence       uint64
+	peers          discovery2.Members
+	peersOfChannel discovery2.Members
+}
+
+func (s *resultCacheSupportMock) ConfigSequence(channel string) uint64 {
+	return s.sequence
+}
+
+func (s *resultCacheSupportMock) PeersOfChannel(common2.ChainID) discovery2.Members {
+	return s.peersOfChannel
+}
+
+func (s *resultCacheSupportMock) Peers() discovery2.Members {
+	return s.peers
+}
+
+type countingDispatcher struct {
+	calls  int
+	result *discovery.QueryResult
+}
+
+func (d *countingDispatcher) dispatch(q *discovery.Query) *discovery.QueryResult {
+	d.calls++
+	return d.result
+}
+
+func configQuery(channel string) *discovery.Query {
+	return &discovery.Query{
+		Channel: channel,
+		Query:   &discovery.Query_ConfigQuery{ConfigQuery: &discovery.ConfigQuery{}},
+	}
+}
+
+func newMember(pkiID, endpoint string, height uint64, chaincodes ...string) discovery2.NetworkMember {
+	props := &gossip.Properties{LedgerHeight: height}
+	for _, cc := range chaincodes {
+		props.Chaincodes = append(props.Chaincodes, &gossip.Chaincode{Name: cc, Version: "1.0"})
+	}
+	return discovery2.NetworkMember{PKIid: common2.PKIidType(pkiID), Endpoint: endpoint, Properties: props}
+}
+
+func TestResultCacheDisabled(t *testing.T) {
+	d := &countingDispatcher{result: &discovery.QueryResult{}}
+	cache := newResultCache(&resultCacheSupportMock{}, resultCacheConfig{})
+
+	cache.QueryResult(configQuery("mychannel"), d.dispatch)
+	cache.QueryResult(configQuery("mychannel"), d.dispatch)
+	assert.Equal(t, 2, d.calls)
+}
+
+func TestResultCacheUsage(t *testing.T) {
+	d := &countingDispatcher{result: &discovery.QueryResult{}}
+	cache := newResultCache(&resultCacheSupportMock{}, resultCacheConfig{enabled: true})
+
+	// The same query is computed once
+	assert.Equal(t, d.result, cache.QueryResult(configQuery("mychannel"), d.dispatch))
+	assert.Equal(t, d.result, cache.QueryResult(configQuery("mychannel"), d.dispatch))
+	assert.Equal(t, 1, d.calls)
+
+	// The results of other channels and queries are cached separately
+	cache.QueryResult(configQuery("yourchannel"), d.dispatch)
+	cache.QueryResult(&discovery.Query{
+		Channel: "mychannel",
+		Query:   &discovery.Query_PeerQuery{PeerQuery: &discovery.PeerMembershipQuery{}},
+	}, d.dispatch)
+	assert.Equal(t, 3, d.calls)
+
+	// Local queries are not cached
+	cache.QueryResult(configQuery(""), d.dispatch)
+	cache.QueryResult(configQuery(""), d.dispatch)
+	assert.Equal(t, 5, d.calls)
+
+	// Errors are not cached
+	d.result = wrapError(errors.New("failed"))
+	cache.QueryResult(configQuery("errchannel"), d.dispatch)
+	cache.QueryResult(configQuery("errchannel"), d.dispatch)
+	assert.Equal(t, 7, d.calls)
+}
+
+func TestResultCacheInvalidation(t *testing.T) {
+	support := &resultCacheSupportMock{
+		peers:          discovery2.Members{newMember("p1", "p1:7051", 0)},
+		peersOfChannel: discovery2.Members{newMember("p1", "p1:7051", 5, "mycc")},
+	}
+	d := &countingDispatcher{result: &discovery.QueryResult{}}
+	cache := newResultCache(support, resultCacheConfig{enabled: true, ttl: time.Minute})
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	query := func(expectedCalls int) {
+		cache.QueryResult(configQuery("mychannel"), d.dispatch)
+		assert.Equal(t, expectedCalls, d.calls)
+	}
+	query(1)
+	query(1)
+
+	// A new ledger height doesn't invalidate the results
+	support.peersOfChannel = discovery2.Members{newMember("p1", "p1:7051", 6, "mycc")}
+	query(1)
+
+	// A config update invalidates the results
+	support.sequence = 1
+	query(2)
+	query(2)
+
+	// A peer joining invalidates the results
+	support.peers = discovery2.Members{newMember("p2", "p2:7051", 0), newMember("p1", "p1:7051", 0)}
+	query(3)
+	query(3)
+
+	// A chaincode installed on a peer of the channel invalidates the results
+	support.peersOfChannel = discovery2.Members{newMember("p1", "p1:7051", 6, "mycc", "yourcc")}
+	query(4)
+	query(4)
+
+	// The results expire after the TTL
+	now = now.Add(time.Minute + time.Second)
+	query(5)
+	query(5)
+}
+
+func TestResultCachePurge(t *testing.T) {
+	d := &countingDispatcher{result: &discovery.QueryResult{}}
+	cache := newResultCache(&resultCacheSupportMock{}, resultCacheConfig{enabled: true, maxCacheSize: 4, purgeRetentionRatio: 0.5})
+
+	for _, cc := range []string{"a", "b", "c"} {
+		cache.QueryResult(configQuery("mychannel"), d.dispatch)
+		cache.QueryResult(&discovery.Query{
+			Channel: "mychannel",
+			Query: &discovery.Query_CcQuery{CcQuery: &discovery.ChaincodeQuery{
+				Interests: []*discovery.ChaincodeInterest{{Chaincodes: []*discovery.ChaincodeCall{{Name: cc}}}},
+			}},
+		}, d.dispatch)
+	}
+	assert.Equal(t, 4, d.calls)
+	assert.Len(t, cache.channels["mychannel"].entries, 4)
+
+	// Caching a fifth result purges half of the cache
+	cache.QueryResult(configQuery("mychannel"), d.dispatch)
+	cache.QueryResult(&discovery.Query{
+		Channel: "mychannel",
+		Query:   &discovery.Query_PeerQuery{PeerQuery: &discovery.PeerMembershipQuery{}},
+	}, d.dispatch)
+	assert.Len(t, cache.channels["mychannel"].entries, 3)
+}
@@ -11,6 +11,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"time"
 
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/util"
@@ -19,6 +20,7 @@ import (
 	common2 "github.com/hyperledger/fabric/gossip/common"
 	discovery2 "github.com/hyperledger/fabric/gossip/discovery"
 	"github.com/hyperledger/fabric/protos/discovery"
+	"github.com/hyperledger/fabric/protos/gossip"
 	"github.com/hyperledger/fabric/protoutil"
 	"github.com/pkg/errors"
 )
@@ -41,6 +43,7 @@ type service struct {
 	channelDispatchers map[protoext.QueryType]dispatcher
 	localDispatchers   map[protoext.QueryType]dispatcher
 	auth               *authCache
+	results            *resultCache
 	Support
 }
 
@@ -50,14 +53,25 @@ type Config struct {
 	AuthCacheEnabled             bool
 	AuthCacheMaxSize             int
 	AuthCachePurgeRetentionRatio float64
+	// ResultCacheEnabled enables the caching of the results of channel
+	// scoped queries, until the config or the membership view of the
+	// channel changes, or ResultCacheTTL elapses
+	ResultCacheEnabled             bool
+	ResultCacheMaxSize             int
+	ResultCachePurgeRetentionRatio float64
+	ResultCacheTTL                 time.Duration
 }
 
 // String returns a string representation of this Config
 func (c Config) String() string {
+	s := fmt.Sprintf("TLS: %t, auth cache disabled", c.TLS)
 	if c.AuthCacheEnabled {
-		return fmt.Sprintf("TLS: %t, authCacheMaxSize: %d, authCachePurgeRatio: %f", c.TLS, c.AuthCacheMaxSize, c.AuthCachePurgeRetentionRatio)
+		s = fmt.Sprintf("TLS: %t, authCacheMaxSize: %d, authCachePurgeRatio: %f", c.TLS, c.AuthCacheMaxSize, c.AuthCachePurgeRetentionRatio)
 	}
-	return fmt.Sprintf("TLS: %t, auth cache disabled", c.TLS)
+	if c.ResultCacheEnabled {
+		s += fmt.Sprintf(", resultCacheMaxSize: %d, resultCachePurgeRatio: %f, resultCacheTTL: %s", c.ResultCacheMaxSize, c.ResultCachePurgeRetentionRatio, c.ResultCacheTTL)
+	}
+	return s
 }
 
 // peerMapping maps PKI-IDs to Peers
@@ -71,12 +85,19 @@ func NewService(config Config, sup Support) *service {
 			maxCacheSize:        config.AuthCacheMaxSize,
 			purgeRetentionRatio: config.AuthCachePurgeRetentionRatio,
 		}),
+		results: newResultCache(sup, resultCacheConfig{
+			enabled:             config.ResultCacheEnabled,
+			maxCacheSize:        config.ResultCacheMaxSize,
+			purgeRetentionRatio: config.ResultCachePurgeRetentionRatio,
+			ttl:                 config.ResultCacheTTL,
+		}),
 		Support: sup,
 	}
 	s.channelDispatchers = map[protoext.QueryType]dispatcher{
 		protoext.ConfigQueryType:         s.configQuery,
 		protoext.ChaincodeQueryType:      s.chaincodeQuery,
 		protoext.PeerMembershipQueryType: s.channelMembershipResponse,
+		protoext.OrdererQueryType:        s.ordererQuery,
 	}
 	s.localDispatchers = map[protoext.QueryType]dispatcher{
 		protoext.LocalMembershipQueryType: s.localMembershipResponse,
@@ -108,14 +129,22 @@ func (s *service) processQuery(query *discovery.Query, request *discovery.Signed
 		logger.Warning("got query for channel", query.Channel, "from", addr, "but it doesn't exist")
 		return accessDenied
 	}
-	if err := s.auth.EligibleForService(query.Channel, protoutil.SignedData{
+	signedData := protoutil.SignedData{
 		Data:      request.Payload,
 		Signature: request.Signature,
 		Identity:  identity,
-	}); err != nil {
+	}
+	if err := s.auth.EligibleForService(query.Channel, signedData); err != nil {
 		logger.Warning("got query for channel", query.Channel, "from", addr, "but it isn't eligible:", err)
 		return accessDenied
 	}
+	if query.Channel == "" && query.GetLocalPeers().GetIncludeChaincodes() {
+		// The chaincodes of the peers in a channel are only returned
+		// to clients that are eligible for service in that channel
+		return s.localMembershipWithChaincodesResponse(query, func(channel string) bool {
+			return s.auth.EligibleForService(channel, signedData) == nil
+		})
+	}
 	return s.dispatch(query)
 }
 
@@ -129,7 +158,7 @@ func (s *service) dispatch(q *discovery.Query) *discovery.QueryResult {
 	if !exists {
 		return wrapError(errors.New("unknown or missing request type"))
 	}
-	return dispatchQuery(q)
+	return s.results.QueryResult(q, dispatchQuery)
 }
 
 func (s *service) chaincodeQuery(q *discovery.Query) *discovery.QueryResult {
@@ -168,6 +197,19 @@ func (s *service) configQuery(q *discovery.Query) *discovery.QueryResult {
 	}
 }
 
+func (s *service) ordererQuery(q *discovery.Query) *discovery.QueryResult {
+	orderers, err := s.Orderers(q.Channel)
+	if err != nil {
+		logger.Errorf("Failed fetching orderers for channel %s: %v", q.Channel, err)
+		return wrapError(errors.Errorf("failed fetching orderers for channel %s", q.Channel))
+	}
+	return &discovery.QueryResult{
+		Result: &discovery.QueryResult_OrdererResult{
+			OrdererResult: orderers,
+		},
+	}
+}
+
 func wrapPeerResponse(peersByOrg map[string]*discovery.Peers) *discovery.QueryResult {
 	return &discovery.QueryResult{
 		Result: &discovery.QueryResult_Members{
@@ -202,8 +244,41 @@ func (s *service) channelMembershipResponse(q *discovery.Query) *discovery.Query
 }
 
 func (s *service) localMembershipResponse(q *discovery.Query) *discovery.QueryResult {
+	return wrapLocalMembership(s.computeMembership(q))
+}
+
+// localMembershipWithChaincodesResponse returns the local membership along with
+// the StateInfo messages of the peers for the channels the client is eligible for,
+// which contain the chaincodes of the peers for each channel
+func (s *service) localMembershipWithChaincodesResponse(q *discovery.Query, eligible func(channel string) bool) *discovery.QueryResult {
+	membership := s.computeMembership(q)
+	peersByID := make(peerMapping)
+	for _, ids2Peers := range membership {
+		for id, peer := range ids2Peers {
+			peersByID[id] = peer
+		}
+	}
+	for _, channel := range s.Channels() {
+		if !eligible(string(channel)) {
+			continue
+		}
+		for _, member := range s.PeersOfChannel(channel) {
+			peer, exists := peersByID[string(member.PKIid)]
+			if !exists || member.Envelope == nil {
+				continue
+			}
+			if peer.ChannelStateInfos == nil {
+				peer.ChannelStateInfos = make(map[string]*gossip.Envelope)
+			}
+			peer.ChannelStateInfos[string(channel)] = member.Envelope
+		}
+	}
+	return wrapLocalMembership(membership)
+}
+
+func wrapLocalMembership(membership map[string]peerMapping) *discovery.QueryResult {
 	membersByOrgs := make(map[string]*discovery.Peers)
-	for org, ids2Peers := range s.computeMembership(q) {
+	for org, ids2Peers := range membership {
 		membersByOrgs[org] = &discovery.Peers{}
 		for _, peer := range ids2Peers {
 			membersByOrgs[org].Peers = append(membersByOrgs[org].Peers, peer)
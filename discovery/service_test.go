@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package discovery
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -321,6 +322,55 @@ func TestService(t *testing.T) {
 	resp, err = service.Discover(ctx, toSignedRequest(req))
 	assert.NoError(t, err)
 	assert.Contains(t, resp.Results[0].GetError().Content, "unknown or missing request type")
+
+	// Scenario XIV: Request with an orderer query
+	mockSup.On("Orderers", "channelWithAccessGranted").Return(nil, errors.New("failed fetching orderers")).Once()
+	req.Queries[0].Query = &discovery.Query_OrdererQuery{
+		OrdererQuery: &discovery.OrdererQuery{},
+	}
+	resp, err = service.Discover(ctx, toSignedRequest(req))
+	assert.NoError(t, err)
+	assert.Contains(t, resp.Results[0].GetError().Content, "failed fetching orderers for channel channelWithAccessGranted")
+
+	// Scenario XV: Request with an orderer query
+	ordererResult := &discovery.OrdererResult{
+		Orderers: map[string]*discovery.Endpoints{
+			"OrdererMSP": {Endpoint: []*discovery.Endpoint{{Host: "orderer.example.com", Port: 7050}}},
+		},
+		ConsensusType: "etcdraft",
+	}
+	mockSup.On("Orderers", "channelWithAccessGranted").Return(ordererResult, nil).Once()
+	resp, err = service.Discover(ctx, toSignedRequest(req))
+	assert.NoError(t, err)
+	assert.Equal(t, ordererResult, resp.Results[0].GetOrdererResult())
+
+	// Scenario XVI: Request with a local membership query that includes the chaincodes of the peers.
+	// The client is eligible for service in channelWithAccessGranted but not in channelWithAccessDenied,
+	// so only the StateInfo messages of channelWithAccessGranted are returned.
+	mockSup.On("EligibleForService", "", mock.Anything).Return(nil).Once()
+	mockSup.On("Channels").Return([]gcommon.ChainID{gcommon.ChainID("channelWithAccessGranted"), gcommon.ChainID("channelWithAccessDenied")}).Once()
+	mockSup.On("PeersOfChannel", gcommon.ChainID("channelWithAccessGranted")).Return(gdisc.Members{stateInfoMsg(1), stateInfoMsg(4)}).Once()
+	mockSup.On("Peers").Return(gdisc.Members{aliveMsg(0), aliveMsg(1)}).Once()
+	mockSup.On("IdentityInfo").Return(api.PeerIdentitySet{idInfo(0, "O2"), idInfo(1, "O2")}).Once()
+	req.Queries = []*discovery.Query{
+		{
+			Query: &discovery.Query_LocalPeers{
+				LocalPeers: &discovery.LocalPeerQuery{IncludeChaincodes: true},
+			},
+		},
+	}
+	resp, err = service.Discover(ctx, toSignedRequest(req))
+	assert.NoError(t, err)
+	localPeers := resp.Results[0].GetMembers().PeersByOrg["O2"].Peers
+	assert.Len(t, localPeers, 2)
+	for _, p := range localPeers {
+		if bytes.Equal(p.Identity, idInfo(1, "O2").Identity) {
+			assert.Equal(t, map[string]*gossip.Envelope{"channelWithAccessGranted": stateInfoMsg(1).Envelope}, p.ChannelStateInfos)
+			continue
+		}
+		assert.Nil(t, p.ChannelStateInfos)
+	}
+	mockSup.AssertNotCalled(t, "PeersOfChannel", gcommon.ChainID("channelWithAccessDenied"))
 }
 
 func TestValidateStructure(t *testing.T) {
@@ -491,8 +541,12 @@ func (ms *mockSupport) ChannelExists(channel string) bool {
 	return ms.Called(channel).Get(0).(bool)
 }
 
+func (ms *mockSupport) Channels() []gcommon.ChainID {
+	return ms.Called().Get(0).([]gcommon.ChainID)
+}
+
 func (ms *mockSupport) PeersOfChannel(channel gcommon.ChainID) gdisc.Members {
-	panic("not implemented")
+	return ms.Called(channel).Get(0).(gdisc.Members)
 }
 
 func (ms *mockSupport) Peers() gdisc.Members {
@@ -532,6 +586,14 @@ func (ms *mockSupport) Config(channel string) (*discovery.ConfigResult, error) {
 	return args.Get(0).(*discovery.ConfigResult), args.Error(1)
 }
 
+func (ms *mockSupport) Orderers(channel string) (*discovery.OrdererResult, error) {
+	args := ms.Called(channel)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*discovery.OrdererResult), args.Error(1)
+}
+
 func idInfo(id int, org string) api.PeerIdentityInfo {
 	endpoint := fmt.Sprintf("p%d", id)
 	return api.PeerIdentityInfo{
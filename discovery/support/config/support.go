@@ -18,6 +18,7 @@ import (
 	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/discovery"
 	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/hyperledger/fabric/protos/orderer"
 	"github.com/pkg/errors"
 )
 
@@ -52,6 +53,62 @@ func NewDiscoverySupport(getLastConfigBlock CurrentConfigBlockGetter) *Discovery
 
 // Config returns the channel's configuration
 func (s *DiscoverySupport) Config(channel string) (*discovery.ConfigResult, error) {
+	ce, err := s.configEnvelope(channel)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &discovery.ConfigResult{
+		Msps:     make(map[string]*msp.FabricMSPConfig),
+		Orderers: make(map[string]*discovery.Endpoints),
+	}
+	ordererGrp := ce.Config.ChannelGroup.Groups[channelconfig.OrdererGroupKey].Groups
+	appGrp := ce.Config.ChannelGroup.Groups[channelconfig.ApplicationGroupKey].Groups
+
+	ordererEndpoints, err := ordererEndpoints(ce)
+	if err != nil {
+		return nil, err
+	}
+	res.Orderers = ordererEndpoints
+
+	if err := appendMSPConfigs(ordererGrp, appGrp, res.Msps); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return res, nil
+
+}
+
+// Orderers returns the ordering service endpoints of the channel,
+// and the consensus type and metadata of its ordering service
+func (s *DiscoverySupport) Orderers(channel string) (*discovery.OrdererResult, error) {
+	ce, err := s.configEnvelope(channel)
+	if err != nil {
+		return nil, err
+	}
+
+	ordererEndpoints, err := ordererEndpoints(ce)
+	if err != nil {
+		return nil, err
+	}
+
+	consensusTypeValue, exists := ce.Config.ChannelGroup.Groups[channelconfig.OrdererGroupKey].Values[channelconfig.ConsensusTypeKey]
+	if !exists {
+		return nil, errors.New("consensus type is missing from the orderer group")
+	}
+	consensusType := &orderer.ConsensusType{}
+	if err := proto.Unmarshal(consensusTypeValue.Value, consensusType); err != nil {
+		return nil, errors.Wrap(err, "failed unmarshaling consensus type")
+	}
+
+	return &discovery.OrdererResult{
+		Orderers:          ordererEndpoints,
+		ConsensusType:     consensusType.Type,
+		ConsensusMetadata: consensusType.Metadata,
+	}, nil
+}
+
+// configEnvelope returns the validated config envelope of the last config block of the channel
+func (s *DiscoverySupport) configEnvelope(channel string) (*common.ConfigEnvelope, error) {
 	block := s.GetCurrConfigBlock(channel)
 	if block == nil {
 		return nil, errors.Errorf("could not get last config block for channel %s", channel)
@@ -75,30 +132,21 @@ func (s *DiscoverySupport) Config(channel string) (*discovery.ConfigResult, erro
 	if err := ValidateConfigEnvelope(ce); err != nil {
 		return nil, errors.Wrap(err, "config envelope is invalid")
 	}
+	return ce, nil
+}
 
-	res := &discovery.ConfigResult{
-		Msps:     make(map[string]*msp.FabricMSPConfig),
-		Orderers: make(map[string]*discovery.Endpoints),
-	}
-	ordererGrp := ce.Config.ChannelGroup.Groups[channelconfig.OrdererGroupKey].Groups
-	appGrp := ce.Config.ChannelGroup.Groups[channelconfig.ApplicationGroupKey].Groups
-
+func ordererEndpoints(ce *common.ConfigEnvelope) (map[string]*discovery.Endpoints, error) {
 	ordererAddresses := &common.OrdererAddresses{}
 	if err := proto.Unmarshal(ce.Config.ChannelGroup.Values[channelconfig.OrdererAddressesKey].Value, ordererAddresses); err != nil {
 		return nil, errors.Wrap(err, "failed unmarshaling orderer addresses")
 	}
 
+	ordererGrp := ce.Config.ChannelGroup.Groups[channelconfig.OrdererGroupKey].Groups
 	ordererEndpoints, err := computeOrdererEndpoints(ordererGrp, ordererAddresses)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed computing orderer addresses")
 	}
-	res.Orderers = ordererEndpoints
-
-	if err := appendMSPConfigs(ordererGrp, appGrp, res.Msps); err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return res, nil
-
+	return ordererEndpoints, nil
 }
 
 func computeOrdererEndpoints(ordererGrp map[string]*common.ConfigGroup, ordererAddresses *common.OrdererAddresses) (map[string]*discovery.Endpoints, error) {
@@ -23,6 +23,7 @@ import (
 	"github.com/hyperledger/fabric/discovery/support/config"
 	"github.com/hyperledger/fabric/discovery/support/mocks"
 	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/discovery"
 	"github.com/onsi/gomega/gexec"
 	"github.com/stretchr/testify/assert"
 )
@@ -182,6 +183,46 @@ func TestSupportBadConfig(t *testing.T) {
 	assert.Nil(t, res)
 }
 
+func TestSupportOrderers(t *testing.T) {
+	fakeBlockGetter := &mocks.ConfigBlockGetter{}
+	cs := config.NewDiscoverySupport(fakeBlockGetter)
+
+	fakeBlockGetter.GetCurrConfigBlockReturnsOnCall(0, nil)
+	res, err := cs.Orderers("test")
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "could not get last config block for channel test")
+
+	block, err := test.MakeGenesisBlock("test")
+	assert.NoError(t, err)
+	fakeBlockGetter.GetCurrConfigBlockReturnsOnCall(1, block)
+	res, err = cs.Orderers("test")
+	assert.NoError(t, err)
+	assert.Equal(t, "solo", res.ConsensusType)
+	assert.Equal(t, map[string]*discovery.Endpoints{
+		"SampleOrg": {Endpoint: []*discovery.Endpoint{{Host: "127.0.0.1", Port: 7050}}},
+	}, res.Orderers)
+
+	// Remove the consensus type from the orderer group
+	env := &common.Envelope{}
+	assert.NoError(t, proto.Unmarshal(block.Data.Data[0], env))
+	pl := &common.Payload{}
+	assert.NoError(t, proto.Unmarshal(env.Payload, pl))
+	ce := &common.ConfigEnvelope{}
+	assert.NoError(t, proto.Unmarshal(pl.Data, ce))
+	delete(ce.Config.ChannelGroup.Groups[channelconfig.OrdererGroupKey].Values, channelconfig.ConsensusTypeKey)
+	pl.Data, _ = proto.Marshal(ce)
+	env.Payload, _ = proto.Marshal(pl)
+	envBytes, _ := proto.Marshal(env)
+	fakeBlockGetter.GetCurrConfigBlockReturnsOnCall(2, &common.Block{
+		Data: &common.BlockData{
+			Data: [][]byte{envBytes},
+		},
+	})
+	res, err = cs.Orderers("test")
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "consensus type is missing from the orderer group")
+}
+
 func TestValidateConfigEnvelope(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -29,6 +29,15 @@ func (s *DiscoverySupport) ChannelExists(channel string) bool {
 	return s.SelfChannelInfo(common.ChainID(channel)) != nil
 }
 
+// Channels returns the channels the peer joined
+func (s *DiscoverySupport) Channels() []common.ChainID {
+	var channels []common.ChainID
+	for channel := range s.MembershipSnapshot().Channels {
+		channels = append(channels, common.ChainID(channel))
+	}
+	return channels
+}
+
 // PeersOfChannel returns the NetworkMembers considered alive
 // and also subscribed to the channel given
 func (s *DiscoverySupport) PeersOfChannel(chain common.ChainID) discovery.Members {
@@ -13,6 +13,7 @@ import (
 	"github.com/hyperledger/fabric/discovery/support/mocks"
 	"github.com/hyperledger/fabric/gossip/common"
 	"github.com/hyperledger/fabric/gossip/discovery"
+	gossip2 "github.com/hyperledger/fabric/gossip/gossip"
 	"github.com/hyperledger/fabric/gossip/protoext"
 	"github.com/hyperledger/fabric/protos/gossip"
 	"github.com/stretchr/testify/assert"
@@ -24,6 +25,18 @@ func TestChannelExists(t *testing.T) {
 	assert.False(t, sup.ChannelExists(""))
 }
 
+func TestChannels(t *testing.T) {
+	g := &mocks.Gossip{}
+	g.MembershipSnapshotReturns(gossip2.MembershipSnapshot{
+		Channels: map[string][]discovery.NetworkMember{
+			"mychannel":   nil,
+			"yourchannel": {{PKIid: common.PKIidType("p1")}},
+		},
+	})
+	sup := gossipSupport.NewDiscoverySupport(g)
+	assert.ElementsMatch(t, []common.ChainID{common.ChainID("mychannel"), common.ChainID("yourchannel")}, sup.Channels())
+}
+
 func TestPeers(t *testing.T) {
 	g := &mocks.Gossip{}
 	sup := gossipSupport.NewDiscoverySupport(g)
@@ -148,6 +148,28 @@ type Gossip struct {
 	identityInfoReturnsOnCall map[int]struct {
 		result1 api.PeerIdentitySet
 	}
+	MembershipSnapshotStub        func() gossip.MembershipSnapshot
+	membershipSnapshotMutex       sync.RWMutex
+	membershipSnapshotArgsForCall []struct{}
+	membershipSnapshotReturns     struct {
+		result1 gossip.MembershipSnapshot
+	}
+	membershipSnapshotReturnsOnCall map[int]struct {
+		result1 gossip.MembershipSnapshot
+	}
+	OpenStateTransferStreamStub        func(peer *comm.RemotePeer) (comm.StateTransferStream, error)
+	openStateTransferStreamMutex       sync.RWMutex
+	openStateTransferStreamArgsForCall []struct {
+		peer *comm.RemotePeer
+	}
+	openStateTransferStreamReturns struct {
+		result1 comm.StateTransferStream
+		result2 error
+	}
+	openStateTransferStreamReturnsOnCall map[int]struct {
+		result1 comm.StateTransferStream
+		result2 error
+	}
 	StopStub         func()
 	stopMutex        sync.RWMutex
 	stopArgsForCall  []struct{}
@@ -730,6 +752,99 @@ func (fake *Gossip) IdentityInfoReturnsOnCall(i int, result1 api.PeerIdentitySet
 	}{result1}
 }
 
+func (fake *Gossip) MembershipSnapshot() gossip.MembershipSnapshot {
+	fake.membershipSnapshotMutex.Lock()
+	ret, specificReturn := fake.membershipSnapshotReturnsOnCall[len(fake.membershipSnapshotArgsForCall)]
+	fake.membershipSnapshotArgsForCall = append(fake.membershipSnapshotArgsForCall, struct{}{})
+	fake.recordInvocation("MembershipSnapshot", []interface{}{})
+	fake.membershipSnapshotMutex.Unlock()
+	if fake.MembershipSnapshotStub != nil {
+		return fake.MembershipSnapshotStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.membershipSnapshotReturns.result1
+}
+
+func (fake *Gossip) MembershipSnapshotCallCount() int {
+	fake.membershipSnapshotMutex.RLock()
+	defer fake.membershipSnapshotMutex.RUnlock()
+	fake.openStateTransferStreamMutex.RLock()
+	defer fake.openStateTransferStreamMutex.RUnlock()
+	return len(fake.membershipSnapshotArgsForCall)
+}
+
+func (fake *Gossip) MembershipSnapshotReturns(result1 gossip.MembershipSnapshot) {
+	fake.MembershipSnapshotStub = nil
+	fake.membershipSnapshotReturns = struct {
+		result1 gossip.MembershipSnapshot
+	}{result1}
+}
+
+func (fake *Gossip) MembershipSnapshotReturnsOnCall(i int, result1 gossip.MembershipSnapshot) {
+	fake.MembershipSnapshotStub = nil
+	if fake.membershipSnapshotReturnsOnCall == nil {
+		fake.membershipSnapshotReturnsOnCall = make(map[int]struct {
+			result1 gossip.MembershipSnapshot
+		})
+	}
+	fake.membershipSnapshotReturnsOnCall[i] = struct {
+		result1 gossip.MembershipSnapshot
+	}{result1}
+}
+
+func (fake *Gossip) OpenStateTransferStream(peer *comm.RemotePeer) (comm.StateTransferStream, error) {
+	fake.openStateTransferStreamMutex.Lock()
+	ret, specificReturn := fake.openStateTransferStreamReturnsOnCall[len(fake.openStateTransferStreamArgsForCall)]
+	fake.openStateTransferStreamArgsForCall = append(fake.openStateTransferStreamArgsForCall, struct {
+		peer *comm.RemotePeer
+	}{peer})
+	fake.recordInvocation("OpenStateTransferStream", []interface{}{peer})
+	fake.openStateTransferStreamMutex.Unlock()
+	if fake.OpenStateTransferStreamStub != nil {
+		return fake.OpenStateTransferStreamStub(peer)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.openStateTransferStreamReturns.result1, fake.openStateTransferStreamReturns.result2
+}
+
+func (fake *Gossip) OpenStateTransferStreamCallCount() int {
+	fake.openStateTransferStreamMutex.RLock()
+	defer fake.openStateTransferStreamMutex.RUnlock()
+	return len(fake.openStateTransferStreamArgsForCall)
+}
+
+func (fake *Gossip) OpenStateTransferStreamArgsForCall(i int) *comm.RemotePeer {
+	fake.openStateTransferStreamMutex.RLock()
+	defer fake.openStateTransferStreamMutex.RUnlock()
+	return fake.openStateTransferStreamArgsForCall[i].peer
+}
+
+func (fake *Gossip) OpenStateTransferStreamReturns(result1 comm.StateTransferStream, result2 error) {
+	fake.OpenStateTransferStreamStub = nil
+	fake.openStateTransferStreamReturns = struct {
+		result1 comm.StateTransferStream
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Gossip) OpenStateTransferStreamReturnsOnCall(i int, result1 comm.StateTransferStream, result2 error) {
+	fake.OpenStateTransferStreamStub = nil
+	if fake.openStateTransferStreamReturnsOnCall == nil {
+		fake.openStateTransferStreamReturnsOnCall = make(map[int]struct {
+			result1 comm.StateTransferStream
+			result2 error
+		})
+	}
+	fake.openStateTransferStreamReturnsOnCall[i] = struct {
+		result1 comm.StateTransferStream
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Gossip) Stop() {
 	fake.stopMutex.Lock()
 	fake.stopArgsForCall = append(fake.stopArgsForCall, struct{}{})
@@ -781,6 +896,8 @@ func (fake *Gossip) Invocations() map[string][][]interface{} {
 	defer fake.suspectPeersMutex.RUnlock()
 	fake.identityInfoMutex.RLock()
 	defer fake.identityInfoMutex.RUnlock()
+	fake.membershipSnapshotMutex.RLock()
+	defer fake.membershipSnapshotMutex.RUnlock()
 	fake.stopMutex.RLock()
 	defer fake.stopMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -26,6 +26,22 @@ func (_m *GossipSupport) ChannelExists(channel string) bool {
 	return r0
 }
 
+// Channels provides a mock function with given fields:
+func (_m *GossipSupport) Channels() []common.ChainID {
+	ret := _m.Called()
+
+	var r0 []common.ChainID
+	if rf, ok := ret.Get(0).(func() []common.ChainID); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]common.ChainID)
+		}
+	}
+
+	return r0
+}
+
 // IdentityInfo provides a mock function with given fields:
 func (_m *GossipSupport) IdentityInfo() api.PeerIdentitySet {
 	ret := _m.Called()
@@ -555,7 +555,7 @@ func createMSPManager(t *testing.T, dir string, configs map[string]*msprotos.Fab
 		mspsOfChannel = append(mspsOfChannel, mspInstance)
 	}
 
-	mspMgr := msp.NewMSPManager()
+	mspMgr := msp.NewMSPManager(nil)
 	mspMgr.Setup(mspsOfChannel)
 	return mspMgr
 }
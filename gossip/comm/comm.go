@@ -47,6 +47,10 @@ type Comm interface {
 	// CloseConn closes a connection to a certain endpoint
 	CloseConn(peer *RemotePeer)
 
+	// OpenStateTransferStream opens a dedicated stream to a remote peer,
+	// over which blocks are requested in bulk
+	OpenStateTransferStream(peer *RemotePeer) (StateTransferStream, error)
+
 	// Stop stops the module
 	Stop()
 }
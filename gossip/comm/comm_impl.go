@@ -26,6 +26,7 @@ import (
 	proto "github.com/hyperledger/fabric/protos/gossip"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/peer"
 )
 
@@ -78,14 +79,18 @@ func NewCommInstance(s *grpc.Server, certs *common.TLSCertificates, idStore iden
 		connTimeout:    config.ConnTimeout,
 		recvBuffSize:   config.RecvBuffSize,
 		sendBuffSize:   config.SendBuffSize,
+		overrides:      config.EndpointOverrides,
+		verifyOrgCert:  config.OrgTLSCertVerifier,
 	}
 
 	connConfig := ConnConfig{
-		RecvBuffSize: config.RecvBuffSize,
-		SendBuffSize: config.SendBuffSize,
+		RecvBuffSize:          config.RecvBuffSize,
+		SendBuffSize:          config.SendBuffSize,
+		ChannelBandwidthLimit: config.ChannelBandwidthLimit,
+		IdleTimeout:           config.IdleConnTimeout,
 	}
 
-	commInst.connStore = newConnStore(commInst, commInst.logger, connConfig)
+	commInst.connStore = newConnStore(commInst, commInst.logger, commMetrics, connConfig)
 
 	proto.RegisterGossipServer(s, commInst)
 
@@ -98,6 +103,22 @@ type CommConfig struct {
 	ConnTimeout  time.Duration // Connection timeout
 	RecvBuffSize int           // Buffer size of received messages
 	SendBuffSize int           // Buffer size of sending messages
+	// ChannelBandwidthLimit is the maximum number of bytes per second
+	// sent for each channel, excluding blocks, private data, leadership
+	// messages and acknowledgements. Zero means unlimited.
+	ChannelBandwidthLimit int
+	// EndpointOverrides are the endpoints dialed instead of the
+	// endpoints advertised by peers of foreign organizations
+	EndpointOverrides EndpointOverrides
+	// IdleConnTimeout is the time after which connections dialed by the
+	// peer, through which no messages were sent or received, are closed.
+	// Zero means connections are never closed for being idle.
+	IdleConnTimeout time.Duration
+	// OrgTLSCertVerifier verifies that the TLS certificate chain presented
+	// by a remote peer is issued by the TLS CAs of the organization of the
+	// peer. If nil, the TLS certificates of remote peers are not pinned to
+	// the TLS CAs of their organizations.
+	OrgTLSCertVerifier func(org api.OrgIdentityType, rawCerts [][]byte) error
 }
 
 type commImpl struct {
@@ -123,6 +144,8 @@ type commImpl struct {
 	connTimeout    time.Duration
 	recvBuffSize   int
 	sendBuffSize   int
+	overrides      EndpointOverrides
+	verifyOrgCert  func(org api.OrgIdentityType, rawCerts [][]byte) error
 }
 
 func (c *commImpl) createConnection(endpoint string, expectedPKIID common.PKIidType) (*connection, error) {
@@ -131,7 +154,6 @@ func (c *commImpl) createConnection(endpoint string, expectedPKIID common.PKIidT
 	var stream proto.Gossip_GossipStreamClient
 	var pkiID common.PKIidType
 	var connInfo *protoext.ConnectionInfo
-	var dialOpts []grpc.DialOption
 
 	c.logger.Debug("Entering", endpoint, expectedPKIID)
 	defer c.logger.Debug("Exiting")
@@ -139,27 +161,12 @@ func (c *commImpl) createConnection(endpoint string, expectedPKIID common.PKIidT
 	if c.isStopping() {
 		return nil, errors.New("Stopping")
 	}
-	dialOpts = append(dialOpts, c.secureDialOpts()...)
-	dialOpts = append(dialOpts, grpc.WithBlock())
-	dialOpts = append(dialOpts, c.opts...)
-	ctx := context.Background()
-	ctx, cancel := context.WithTimeout(ctx, c.dialTimeout)
-	defer cancel()
-	cc, err = grpc.DialContext(ctx, endpoint, dialOpts...)
+	cc, cl, err := c.dial(endpoint, expectedPKIID)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
-	cl := proto.NewGossipClient(cc)
-
-	ctx, cancel = context.WithTimeout(context.Background(), DefConnTimeout)
-	defer cancel()
-	if _, err = cl.Ping(ctx, &proto.Empty{}); err != nil {
-		cc.Close()
-		return nil, errors.WithStack(err)
-	}
-
-	ctx, cancel = context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(context.Background())
 	if stream, err = cl.GossipStream(ctx); err == nil {
 		connInfo, err = c.authenticateRemotePeer(stream, true)
 		if err == nil {
@@ -181,8 +188,10 @@ func (c *commImpl) createConnection(endpoint string, expectedPKIID common.PKIidT
 			connConfig := ConnConfig{
 				RecvBuffSize: c.recvBuffSize,
 				SendBuffSize: c.sendBuffSize,
+				IdleTimeout:  c.connStore.config.IdleTimeout,
 			}
 			conn := newConnection(cl, cc, stream, nil, c.metrics, connConfig)
+			conn.budgets = c.connStore.budgets
 			conn.pkiID = pkiID
 			conn.info = connInfo
 			conn.logger = c.logger
@@ -246,55 +255,79 @@ func (c *commImpl) isStopping() bool {
 }
 
 func (c *commImpl) Probe(remotePeer *RemotePeer) error {
-	var dialOpts []grpc.DialOption
 	endpoint := remotePeer.Endpoint
 	pkiID := remotePeer.PKIID
 	if c.isStopping() {
 		return fmt.Errorf("Stopping")
 	}
 	c.logger.Debug("Entering, endpoint:", endpoint, "PKIID:", pkiID)
-	dialOpts = append(dialOpts, c.secureDialOpts()...)
-	dialOpts = append(dialOpts, grpc.WithBlock())
-	dialOpts = append(dialOpts, c.opts...)
-	ctx := context.Background()
-	ctx, cancel := context.WithTimeout(ctx, c.dialTimeout)
-	defer cancel()
-	cc, err := grpc.DialContext(ctx, remotePeer.Endpoint, dialOpts...)
-	if err != nil {
-		c.logger.Debugf("Returning %v", err)
-		return err
+	cc, _, err := c.dial(endpoint, pkiID)
+	if err == nil {
+		cc.Close()
 	}
-	defer cc.Close()
-	cl := proto.NewGossipClient(cc)
-	ctx, cancel = context.WithTimeout(context.Background(), DefConnTimeout)
-	defer cancel()
-	_, err = cl.Ping(ctx, &proto.Empty{})
 	c.logger.Debugf("Returning %v", err)
 	return err
 }
 
-func (c *commImpl) Handshake(remotePeer *RemotePeer) (api.PeerIdentityType, error) {
+// dial connects to the peer with the given PKI-ID, which advertises the given endpoint, and pings it
+func (c *commImpl) dial(endpoint string, pkiID common.PKIidType) (*grpc.ClientConn, proto.GossipClient, error) {
+	c.metrics.Dials.Add(1)
+	defer func(start time.Time) {
+		c.metrics.DialDuration.Observe(time.Since(start).Seconds())
+	}(time.Now())
+
 	var dialOpts []grpc.DialOption
 	dialOpts = append(dialOpts, c.secureDialOpts()...)
 	dialOpts = append(dialOpts, grpc.WithBlock())
 	dialOpts = append(dialOpts, c.opts...)
-	ctx := context.Background()
-	ctx, cancel := context.WithTimeout(ctx, c.dialTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), c.dialTimeout)
 	defer cancel()
-	cc, err := grpc.DialContext(ctx, remotePeer.Endpoint, dialOpts...)
+	cc, err := grpc.DialContext(ctx, c.dialEndpoint(endpoint, pkiID), dialOpts...)
 	if err != nil {
-		return nil, err
+		c.metrics.DialFailures.Add(1)
+		return nil, nil, err
 	}
-	defer cc.Close()
 
 	cl := proto.NewGossipClient(cc)
 	ctx, cancel = context.WithTimeout(context.Background(), DefConnTimeout)
 	defer cancel()
-	if _, err = cl.Ping(ctx, &proto.Empty{}); err != nil {
+	var p peer.Peer
+	if _, err = cl.Ping(ctx, &proto.Empty{}, grpc.Peer(&p)); err != nil {
+		c.metrics.DialFailures.Add(1)
+		cc.Close()
+		return nil, nil, err
+	}
+	if tlsInfo, isTLS := p.AuthInfo.(credentials.TLSInfo); isTLS && tlsInfo.State.DidResume {
+		c.metrics.ResumedTLSSessions.Add(1)
+	}
+	return cc, cl, nil
+}
+
+// dialEndpoint returns the endpoint to dial in order to reach the peer with
+// the given PKI-ID, which advertises the given endpoint.
+func (c *commImpl) dialEndpoint(endpoint string, pkiID common.PKIidType) string {
+	if len(c.overrides) == 0 || len(pkiID) == 0 {
+		return endpoint
+	}
+	identity, err := c.idMapper.Get(pkiID)
+	if err != nil {
+		return endpoint
+	}
+	dialEndpoint := c.overrides.Resolve(c.sa.OrgByPeerIdentity(identity), endpoint)
+	if dialEndpoint != endpoint {
+		c.logger.Debugf("Dialing %s instead of %s", dialEndpoint, endpoint)
+	}
+	return dialEndpoint
+}
+
+func (c *commImpl) Handshake(remotePeer *RemotePeer) (api.PeerIdentityType, error) {
+	cc, cl, err := c.dial(remotePeer.Endpoint, remotePeer.PKIID)
+	if err != nil {
 		return nil, err
 	}
+	defer cc.Close()
 
-	ctx, cancel = context.WithTimeout(context.Background(), handshakeTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), handshakeTimeout)
 	defer cancel()
 	stream, err := cl.GossipStream(ctx)
 	if err != nil {
@@ -337,7 +370,7 @@ func (c *commImpl) Accept(acceptor common.MessageAcceptor) <-chan protoext.Recei
 					return
 				}
 				select {
-				case specificChan <- msg.(*ReceivedMessageImpl):
+				case specificChan <- msg.(protoext.ReceivedMessage):
 				case <-c.exitChan:
 					return
 				}
@@ -469,6 +502,15 @@ func (c *commImpl) authenticateRemotePeer(stream stream, initiator bool) (*proto
 		if !bytes.Equal(remoteCertHash, receivedMsg.TlsCertHash) {
 			return nil, errors.Errorf("Expected %v in remote hash of TLS cert, but got %v", remoteCertHash, receivedMsg.TlsCertHash)
 		}
+		// If the TLS certificates are pinned to the TLS CAs of organizations,
+		// make sure the remote peer's one is issued by those of its organization.
+		if c.verifyOrgCert != nil {
+			org := c.sa.OrgByPeerIdentity(receivedMsg.Identity)
+			if err := c.verifyOrgCert(org, extractCertificatesFromContext(ctx)); err != nil {
+				c.logger.Warningf("TLS certificate of %s doesn't match the TLS CAs of organization %s: %v", remoteAddress, org, err)
+				return nil, errors.WithMessage(err, fmt.Sprintf("TLS certificate of organization %s", org))
+			}
+		}
 	}
 	// Final step - verify the signature on the connection message itself
 	verifier := func(peerIdentity []byte, signature, message []byte) error {
@@ -588,30 +630,17 @@ func (c *commImpl) disconnect(pkiID common.PKIidType) {
 	c.connStore.closeByPKIid(pkiID)
 }
 
-func readWithTimeout(stream interface{}, timeout time.Duration, address string) (*protoext.SignedGossipMessage, error) {
+func readWithTimeout(stream stream, timeout time.Duration, address string) (*protoext.SignedGossipMessage, error) {
 	incChan := make(chan *protoext.SignedGossipMessage, 1)
 	errChan := make(chan error, 1)
 	go func() {
-		if srvStr, isServerStr := stream.(proto.Gossip_GossipStreamServer); isServerStr {
-			if m, err := srvStr.Recv(); err == nil {
-				msg, err := protoext.EnvelopeToGossipMessage(m)
-				if err != nil {
-					errChan <- err
-					return
-				}
-				incChan <- msg
-			}
-		} else if clStr, isClientStr := stream.(proto.Gossip_GossipStreamClient); isClientStr {
-			if m, err := clStr.Recv(); err == nil {
-				msg, err := protoext.EnvelopeToGossipMessage(m)
-				if err != nil {
-					errChan <- err
-					return
-				}
-				incChan <- msg
+		if m, err := stream.Recv(); err == nil {
+			msg, err := protoext.EnvelopeToGossipMessage(m)
+			if err != nil {
+				errChan <- err
+				return
 			}
-		} else {
-			panic(errors.Errorf("Stream isn't a GossipStreamServer or a GossipStreamClient, but %v. Aborting", reflect.TypeOf(stream)))
+			incChan <- msg
 		}
 	}()
 	select {
@@ -28,10 +28,12 @@ import (
 	"github.com/hyperledger/fabric/gossip/common"
 	"github.com/hyperledger/fabric/gossip/identity"
 	"github.com/hyperledger/fabric/gossip/metrics"
+	metricsmocks "github.com/hyperledger/fabric/gossip/metrics/mocks"
 	"github.com/hyperledger/fabric/gossip/mocks"
 	"github.com/hyperledger/fabric/gossip/protoext"
 	"github.com/hyperledger/fabric/gossip/util"
 	proto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc"
@@ -687,6 +689,10 @@ func (bp *nonResponsivePeer) GossipStream(stream proto.Gossip_GossipStreamServer
 	return nil
 }
 
+func (bp *nonResponsivePeer) StateTransferStream(stream proto.Gossip_StateTransferStreamServer) error {
+	return nil
+}
+
 func (bp *nonResponsivePeer) stop() {
 	bp.Server.Stop()
 }
@@ -930,6 +936,64 @@ func TestPresumedDead(t *testing.T) {
 	}
 }
 
+func TestIdleConnections(t *testing.T) {
+	t.Parallel()
+	testMetricProvider := metricsmocks.TestUtilConstructMetricProvider()
+	commMetrics := metrics.NewGossipMetrics(testMetricProvider.FakeProvider).CommMetrics
+	comm1, _ := newCommInstanceWithMetrics(t, naiveSec, commMetrics)
+	comm2, port2 := newCommInstanceWithMetrics(t, naiveSec, commMetrics)
+	defer comm1.Stop()
+	defer comm2.Stop()
+	connStore1 := comm1.(*commGRPC).connStore
+	connStore2 := comm2.(*commGRPC).connStore
+	connStore1.config.IdleTimeout = time.Minute
+
+	acceptCh := comm2.Accept(acceptAll)
+	sendAndReceive := func() {
+		comm1.Send(createGossipMsg(), remotePeer(port2))
+		select {
+		case <-acceptCh:
+		case <-time.After(time.Second * 5):
+			assert.Fail(t, "Didn't receive a message within a timely period")
+		}
+	}
+	sendAndReceive()
+	assert.Equal(t, 1, connStore1.connNum())
+	assert.Equal(t, 1, testMetricProvider.FakeDials.AddCallCount())
+
+	// The connection isn't closed before the idle timeout expires
+	connStore1.closeIdleConnections(time.Now())
+	assert.Equal(t, 1, connStore1.connNum())
+
+	// Once it expires, the connection is closed by the peer that dialed it,
+	// and the remote peer sees the stream end
+	connStore1.closeIdleConnections(time.Now().Add(time.Minute))
+	assert.Equal(t, 0, connStore1.connNum())
+	assert.Equal(t, 1, testMetricProvider.FakeIdleConnectionsClosed.AddCallCount())
+	waitForConnClose := func() bool {
+		return connStore2.connNum() == 0
+	}
+	for i := 0; i < 50 && !waitForConnClose(); i++ {
+		time.Sleep(time.Millisecond * 100)
+	}
+	assert.True(t, waitForConnClose())
+
+	// Neither peer presumes the other dead
+	select {
+	case <-comm1.PresumedDead():
+		assert.Fail(t, "Idle connection closure shouldn't make the remote peer presumed dead")
+	case <-comm2.PresumedDead():
+		assert.Fail(t, "Idle connection closure shouldn't make the remote peer presumed dead")
+	case <-time.After(time.Second):
+	}
+
+	// The connection is dialed again once it is needed
+	sendAndReceive()
+	assert.Equal(t, 1, connStore1.connNum())
+	assert.Equal(t, 2, testMetricProvider.FakeDials.AddCallCount())
+	assert.Equal(t, 0, testMetricProvider.FakeDialFailures.AddCallCount())
+}
+
 func createGossipMsg() *protoext.SignedGossipMessage {
 	msg, _ := protoext.NoopSign(&proto.GossipMessage{
 		Tag:   proto.GossipMessage_EMPTY,
@@ -941,6 +1005,34 @@ func createGossipMsg() *protoext.SignedGossipMessage {
 	return msg
 }
 
+func TestOrgTLSCertPinning(t *testing.T) {
+	t.Parallel()
+	comm1, _ := newCommInstance(t, naiveSec)
+	comm2, port2 := newCommInstance(t, naiveSec)
+	defer comm1.Stop()
+	defer comm2.Stop()
+
+	var verifiedCerts [][]byte
+	var verificationErr error
+	comm1.(*commGRPC).verifyOrgCert = func(org api.OrgIdentityType, rawCerts [][]byte) error {
+		verifiedCerts = rawCerts
+		return verificationErr
+	}
+
+	// The TLS certificate of comm2 is issued by the TLS CAs of its organization
+	_, err := comm1.Handshake(remotePeer(port2))
+	assert.NoError(t, err)
+	assert.Len(t, verifiedCerts, 1)
+	tlsCert := comm2.(*commGRPC).tlsCerts.TLSServerCert.Load().(*tls.Certificate)
+	assert.Equal(t, tlsCert.Certificate[0], verifiedCerts[0])
+
+	// The TLS certificate of comm2 isn't issued by the TLS CAs of its organization
+	verificationErr = errors.New("certificate is not issued by the TLS CAs of the organization")
+	_, err = comm1.Handshake(remotePeer(port2))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "certificate is not issued by the TLS CAs of the organization")
+}
+
 func remotePeer(port int) *RemotePeer {
 	endpoint := fmt.Sprintf("127.0.0.1:%d", port)
 	return &RemotePeer{Endpoint: endpoint, PKIID: []byte(endpoint)}
@@ -990,3 +1082,54 @@ func TestConcurrentCloseSend(t *testing.T) {
 	atomic.StoreInt32(&stopping, int32(1))
 	<-done
 }
+
+func TestEndpointOverrides(t *testing.T) {
+	t.Parallel()
+	sec := &naiveSecProvider{}
+	sec.On("OrgByPeerIdentity", mock.Anything).Return(api.OrgIdentityType("A"))
+
+	comm1, port1 := newCommInstance(t, sec)
+	comm2, port2 := newCommInstance(t, naiveSec)
+	defer comm1.Stop()
+	defer comm2.Stop()
+
+	// Have comm2 send a message to comm1 in order for comm1 to know comm2's identity
+	messagesForComm1 := comm1.Accept(acceptAll)
+	comm2.Send(createGossipMsg(), remotePeer(port1))
+	<-messagesForComm1
+	comm1.CloseConn(remotePeer(port2))
+
+	const advertisedEndpoint = "peer0.a.example.com:7051"
+	comm1.(*commGRPC).overrides = EndpointOverrides{}
+	comm1.(*commGRPC).overrides.Add("A", advertisedEndpoint, fmt.Sprintf("127.0.0.1:%d", port2))
+	messagesForComm2 := comm2.Accept(acceptAll)
+
+	// The advertised endpoint isn't resolvable, so comm2 can only be reached through the override
+	advertisedPeer := &RemotePeer{Endpoint: advertisedEndpoint, PKIID: remotePeer(port2).PKIID}
+	comm1.Send(createGossipMsg(), advertisedPeer)
+	select {
+	case <-messagesForComm2:
+	case <-time.After(time.Second * 5):
+		assert.Fail(t, "Didn't receive a message within a timely manner")
+	}
+	assert.NoError(t, comm1.Probe(advertisedPeer))
+	_, err := comm1.Handshake(advertisedPeer)
+	assert.NoError(t, err)
+
+	// Without a PKI-ID, the organization of the peer is unknown and the endpoint isn't overridden
+	_, err = comm1.Handshake(&RemotePeer{Endpoint: advertisedEndpoint})
+	assert.Error(t, err)
+}
+
+func TestEndpointOverridesResolve(t *testing.T) {
+	overrides := EndpointOverrides{}
+	overrides.Add("A", "p1:7051", "10.0.0.1:7051")
+	overrides.Add("A", "p2:7051", "10.0.0.2:7051")
+	overrides.Add("B", "p3:7051", "10.0.0.3:7051")
+
+	assert.Equal(t, "10.0.0.1:7051", overrides.Resolve(api.OrgIdentityType("A"), "p1:7051"))
+	assert.Equal(t, "10.0.0.2:7051", overrides.Resolve(api.OrgIdentityType("A"), "p2:7051"))
+	assert.Equal(t, "p3:7051", overrides.Resolve(api.OrgIdentityType("A"), "p3:7051"))
+	assert.Equal(t, "p1:7051", overrides.Resolve(api.OrgIdentityType("B"), "p1:7051"))
+	assert.Equal(t, "p1:7051", EndpointOverrides(nil).Resolve(api.OrgIdentityType("A"), "p1:7051"))
+}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/hyperledger/fabric/gossip/common"
 	"github.com/hyperledger/fabric/gossip/metrics"
@@ -44,17 +45,27 @@ type connectionStore struct {
 	pki2Conn         map[string]*connection // mapping between pkiID to connections
 	destinationLocks map[string]*sync.Mutex //mapping between pkiIDs and locks,
 	// used to prevent concurrent connection establishment to the same remote endpoint
+	budgets  *channelBudgets      // bandwidth budgets of channels, shared by all connections
+	metrics  *metrics.CommMetrics // metrics of the comm layer
+	stopChan chan struct{}        // closed upon shutdown, to stop closing idle connections
 }
 
-func newConnStore(connFactory connFactory, logger util.Logger, config ConnConfig) *connectionStore {
-	return &connectionStore{
+func newConnStore(connFactory connFactory, logger util.Logger, metrics *metrics.CommMetrics, config ConnConfig) *connectionStore {
+	cs := &connectionStore{
 		connFactory:      connFactory,
 		isClosing:        false,
 		pki2Conn:         make(map[string]*connection),
 		destinationLocks: make(map[string]*sync.Mutex),
 		logger:           logger,
 		config:           config,
+		budgets:          newChannelBudgets(config.ChannelBandwidthLimit),
+		metrics:          metrics,
+		stopChan:         make(chan struct{}),
 	}
+	if config.IdleTimeout > 0 {
+		go cs.periodicallyCloseIdleConnections()
+	}
+	return cs
 }
 
 func (cs *connectionStore) getConnection(peer *RemotePeer) (*connection, error) {
@@ -145,6 +156,9 @@ func (cs *connectionStore) closeConn(peer *RemotePeer) {
 
 func (cs *connectionStore) shutdown() {
 	cs.Lock()
+	if !cs.isClosing {
+		close(cs.stopChan)
+	}
 	cs.isClosing = true
 	pkiIds2conn := cs.pki2Conn
 
@@ -165,6 +179,41 @@ func (cs *connectionStore) shutdown() {
 	wg.Wait()
 }
 
+func (cs *connectionStore) periodicallyCloseIdleConnections() {
+	ticker := time.NewTicker(cs.config.IdleTimeout / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-cs.stopChan:
+			return
+		case <-ticker.C:
+			cs.closeIdleConnections(time.Now())
+		}
+	}
+}
+
+// closeIdleConnections closes the connections this peer dialed, that neither sent nor received
+// messages during the idle timeout. The remote peers see the streams end gracefully instead
+// of presuming this peer dead, and connections are dialed again once they are needed.
+func (cs *connectionStore) closeIdleConnections(now time.Time) {
+	var idleConns []*connection
+	cs.Lock()
+	for pkiID, conn := range cs.pki2Conn {
+		if conn.conn == nil || now.Sub(conn.lastActive()) < cs.config.IdleTimeout {
+			continue
+		}
+		idleConns = append(idleConns, conn)
+		delete(cs.pki2Conn, pkiID)
+	}
+	cs.Unlock()
+
+	for _, conn := range idleConns {
+		cs.logger.Debug("Closing idle connection to", conn.info.Endpoint)
+		conn.close()
+		cs.metrics.IdleConnectionsClosed.Add(1)
+	}
+}
+
 func (cs *connectionStore) onConnected(serverStream proto.Gossip_GossipStreamServer,
 	connInfo *protoext.ConnectionInfo, metrics *metrics.CommMetrics) *connection {
 	cs.Lock()
@@ -180,6 +229,7 @@ func (cs *connectionStore) onConnected(serverStream proto.Gossip_GossipStreamSer
 func (cs *connectionStore) registerConn(connInfo *protoext.ConnectionInfo,
 	serverStream proto.Gossip_GossipStreamServer, metrics *metrics.CommMetrics) *connection {
 	conn := newConnection(nil, nil, nil, serverStream, metrics, cs.config)
+	conn.budgets = cs.budgets
 	conn.pkiID = connInfo.ID
 	conn.info = connInfo
 	conn.logger = cs.logger
@@ -201,6 +251,7 @@ func newConnection(cl proto.GossipClient, c *grpc.ClientConn, cs proto.Gossip_Go
 	connection := &connection{
 		metrics:      metrics,
 		outBuff:      make(chan *msgSending, config.SendBuffSize),
+		priorityBuff: make(chan *msgSending, config.SendBuffSize),
 		cl:           cl,
 		conn:         c,
 		clientStream: cs,
@@ -209,21 +260,27 @@ func newConnection(cl proto.GossipClient, c *grpc.ClientConn, cs proto.Gossip_Go
 		stopChan:     make(chan struct{}, 1),
 		recvBuffSize: config.RecvBuffSize,
 	}
+	connection.touch()
 	return connection
 }
 
 // ConnConfig is the configuration required to initialize a new conn
 type ConnConfig struct {
-	RecvBuffSize int
-	SendBuffSize int
+	RecvBuffSize          int
+	SendBuffSize          int
+	ChannelBandwidthLimit int
+	IdleTimeout           time.Duration
 }
 
 type connection struct {
+	lastActivity int64 // unix time in nanoseconds of the last message sent or received, accessed atomically
 	recvBuffSize int
 	metrics      *metrics.CommMetrics
 	cancel       context.CancelFunc
 	info         *protoext.ConnectionInfo
 	outBuff      chan *msgSending
+	priorityBuff chan *msgSending                // messages sent ahead of the ones in outBuff
+	budgets      *channelBudgets                 // bandwidth budgets of channels, nil if unlimited
 	logger       util.Logger                     // logger
 	pkiID        common.PKIidType                // pkiID of the remote endpoint
 	handler      handler                         // function to invoke upon a message reception
@@ -268,18 +325,43 @@ func (conn *connection) toDie() bool {
 	return atomic.LoadInt32(&(conn.stopFlag)) == int32(1)
 }
 
+// touch records that a message is sent or received through the connection
+func (conn *connection) touch() {
+	atomic.StoreInt64(&conn.lastActivity, time.Now().UnixNano())
+}
+
+// lastActive returns the time a message was last sent or received through the connection
+func (conn *connection) lastActive() time.Time {
+	return time.Unix(0, atomic.LoadInt64(&conn.lastActivity))
+}
+
 func (conn *connection) send(msg *protoext.SignedGossipMessage, onErr func(error), shouldBlock blockingBehavior) {
 	if conn.toDie() {
 		conn.logger.Debug("Aborting send() to ", conn.info.Endpoint, "because connection is closing")
 		return
 	}
 
+	conn.touch()
 	m := &msgSending{
 		envelope: msg.Envelope,
 		onErr:    onErr,
 	}
 
-	if len(conn.outBuff) == cap(conn.outBuff) {
+	buff := conn.outBuff
+	if isHighPriority(msg.GossipMessage) {
+		buff = conn.priorityBuff
+	} else if conn.budgets != nil && len(msg.Channel) > 0 {
+		size := len(msg.Envelope.Payload) + len(msg.Envelope.Signature)
+		if isStateTransfer(msg.GossipMessage) {
+			conn.budgets.take(string(msg.Channel), size)
+		} else if !conn.budgets.tryTake(string(msg.Channel), size) {
+			conn.logger.Debug("Bandwidth budget of channel", string(msg.Channel), "exhausted, dropping message to", conn.info.Endpoint)
+			conn.metrics.ThrottledMessages.Add(1)
+			return
+		}
+	}
+
+	if len(buff) == cap(buff) {
 		if conn.logger.IsEnabledFor(zapcore.DebugLevel) {
 			conn.logger.Debug("Buffer to", conn.info.Endpoint, "overflowed, dropping message", msg.String())
 			conn.metrics.BufferOverflow.Add(1)
@@ -289,7 +371,7 @@ func (conn *connection) send(msg *protoext.SignedGossipMessage, onErr func(error
 		}
 	}
 
-	conn.outBuff <- m
+	buff <- m
 }
 
 func (conn *connection) serviceConnection() error {
@@ -327,27 +409,37 @@ func (conn *connection) writeToStream() {
 			conn.logger.Error(conn.pkiID, "Stream is nil, aborting!")
 			return
 		}
+		var m *msgSending
+		// Messages in the priority buffer are sent first
 		select {
-		case m := <-conn.outBuff:
-			err := stream.Send(m.envelope)
-			if err != nil {
-				go m.onErr(err)
+		case m = <-conn.priorityBuff:
+		default:
+			select {
+			case m = <-conn.priorityBuff:
+			case m = <-conn.outBuff:
+			case stop := <-conn.stopChan:
+				conn.logger.Debug("Closing writing to stream")
+				conn.stopChan <- stop
 				return
 			}
-			conn.metrics.SentMessages.Add(1)
-		case stop := <-conn.stopChan:
-			conn.logger.Debug("Closing writing to stream")
-			conn.stopChan <- stop
+		}
+		err := stream.Send(m.envelope)
+		if err != nil {
+			go m.onErr(err)
 			return
 		}
+		conn.metrics.SentMessages.Add(1)
 	}
 }
 
 func (conn *connection) drainOutputBuffer() {
-	// Drain the output buffer
+	// Drain the output buffers
 	for len(conn.outBuff) > 0 {
 		<-conn.outBuff
 	}
+	for len(conn.priorityBuff) > 0 {
+		<-conn.priorityBuff
+	}
 }
 
 func (conn *connection) readFromStream(errChan chan error, quit chan struct{}, msgChan chan *protoext.SignedGossipMessage) {
@@ -369,6 +461,7 @@ func (conn *connection) readFromStream(errChan chan error, quit chan struct{}, m
 			return
 		}
 		conn.metrics.ReceivedMessages.Add(1)
+		conn.touch()
 		msg, err := protoext.EnvelopeToGossipMessage(envelope)
 		if err != nil {
 			errChan <- err
@@ -66,6 +66,16 @@ func certHashFromRawCert(rawCert []byte) []byte {
 
 // ExtractCertificateHash extracts the hash of the certificate from the stream
 func extractCertificateHashFromContext(ctx context.Context) []byte {
+	certs := extractCertificatesFromContext(ctx)
+	if len(certs) == 0 {
+		return nil
+	}
+	return certHashFromRawCert(certs[0])
+}
+
+// extractCertificatesFromContext extracts the DER encoded certificate
+// chain the remote peer presented in the TLS handshake of the stream
+func extractCertificatesFromContext(ctx context.Context) [][]byte {
 	pr, extracted := peer.FromContext(ctx)
 	if !extracted {
 		return nil
@@ -80,10 +90,9 @@ func extractCertificateHashFromContext(ctx context.Context) []byte {
 	if !isTLSConn {
 		return nil
 	}
-	certs := tlsInfo.State.PeerCertificates
-	if len(certs) == 0 {
-		return nil
+	var rawCerts [][]byte
+	for _, cert := range tlsInfo.State.PeerCertificates {
+		rawCerts = append(rawCerts, cert.Raw)
 	}
-	raw := certs[0].Raw
-	return certHashFromRawCert(raw)
+	return rawCerts
 }
@@ -72,6 +72,10 @@ func (s *gossipTestServer) Ping(context.Context, *proto.Empty) (*proto.Empty, er
 	return &proto.Empty{}, nil
 }
 
+func (s *gossipTestServer) StateTransferStream(stream proto.Gossip_StateTransferStreamServer) error {
+	return nil
+}
+
 func TestCertificateExtraction(t *testing.T) {
 	cert := GenerateCertificatesOrPanic()
 	srv, ll := createTestServer(t, &cert)
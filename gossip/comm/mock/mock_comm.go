@@ -15,6 +15,7 @@ import (
 	"github.com/hyperledger/fabric/gossip/protoext"
 	"github.com/hyperledger/fabric/gossip/util"
 	proto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/pkg/errors"
 )
 
 // Mock which aims to simulate socket
@@ -186,6 +187,12 @@ func (mock *commMock) CloseConn(peer *comm.RemotePeer) {
 	// NOOP
 }
 
+// OpenStateTransferStream opens a dedicated stream to a remote peer,
+// over which blocks are requested in bulk
+func (mock *commMock) OpenStateTransferStream(peer *comm.RemotePeer) (comm.StateTransferStream, error) {
+	return nil, errors.New("state transfer streams are not supported")
+}
+
 // Stop stops the module
 func (mock *commMock) Stop() {
 	logger.Debug("Stopping communication module, closing all accepting channels.")
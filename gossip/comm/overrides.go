@@ -0,0 +1,34 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package comm
+
+import (
+	"github.com/hyperledger/fabric/gossip/api"
+)
+
+// EndpointOverrides maps the MSP IDs of organizations to the endpoints advertised
+// by their peers, and these to the endpoints the peers are dialed through instead.
+// It is used when the endpoints a foreign organization advertises are not
+// reachable as is from the network of this peer, e.g. behind a NAT.
+type EndpointOverrides map[string]map[string]string
+
+// Add overrides the given endpoint advertised by peers of the given organization
+func (eo EndpointOverrides) Add(org string, from string, to string) {
+	if _, exists := eo[org]; !exists {
+		eo[org] = make(map[string]string)
+	}
+	eo[org][from] = to
+}
+
+// Resolve returns the endpoint to dial in order to reach a peer
+// of the given organization advertising the given endpoint
+func (eo EndpointOverrides) Resolve(org api.OrgIdentityType, endpoint string) string {
+	if override, exists := eo[string(org)][endpoint]; exists {
+		return override
+	}
+	return endpoint
+}
@@ -0,0 +1,101 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package comm
+
+import (
+	"sync"
+	"time"
+
+	proto "github.com/hyperledger/fabric/protos/gossip"
+)
+
+// isHighPriority returns whether the given message is sent ahead of other
+// messages, and regardless of the bandwidth budget of its channel.
+// These are the messages disseminating blocks and private data, the leadership
+// messages and the acknowledgements, which are latency sensitive, as opposed to
+// membership and pull messages and state transfer.
+func isHighPriority(msg *proto.GossipMessage) bool {
+	return msg.GetDataMsg() != nil || msg.GetLeadershipMsg() != nil ||
+		msg.GetPrivateData() != nil || msg.GetAck() != nil
+}
+
+// channelBudgets limits the bandwidth used to send messages of each channel.
+type channelBudgets struct {
+	limit   int // bytes per second, per channel
+	now     func() time.Time
+	lock    sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+func newChannelBudgets(limit int) *channelBudgets {
+	if limit <= 0 {
+		return nil
+	}
+	return &channelBudgets{
+		limit:   limit,
+		now:     time.Now,
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// isStateTransfer returns whether the given message is a state transfer
+// request or response. These are never dropped for exceeding the bandwidth
+// budget of their channel, as a peer whose request or response is dropped
+// stalls until its state request times out; they are charged to the budget
+// nonetheless, so that the other messages of the channel yield to them.
+func isStateTransfer(msg *proto.GossipMessage) bool {
+	return msg.GetStateRequest() != nil || msg.GetStateResponse() != nil
+}
+
+// tryTake takes size bytes from the budget of the given channel,
+// unless it has been exhausted.
+func (cb *channelBudgets) tryTake(channel string, size int) bool {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+
+	bucket := cb.bucket(channel)
+	if bucket.tokens < 0 {
+		return false
+	}
+	bucket.tokens -= float64(size)
+	return true
+}
+
+// take takes size bytes from the budget of the given channel,
+// even if it has been exhausted.
+func (cb *channelBudgets) take(channel string, size int) {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+
+	cb.bucket(channel).tokens -= float64(size)
+}
+
+// bucket returns the refilled token bucket of the given channel.
+// It must be called with the lock held.
+func (cb *channelBudgets) bucket(channel string) *tokenBucket {
+	now := cb.now()
+	bucket, exists := cb.buckets[channel]
+	if !exists {
+		// A budget accumulates up to one second worth of bandwidth
+		bucket = &tokenBucket{tokens: float64(cb.limit), lastRefill: now}
+		cb.buckets[channel] = bucket
+	}
+
+	bucket.tokens += now.Sub(bucket.lastRefill).Seconds() * float64(cb.limit)
+	if bucket.tokens > float64(cb.limit) {
+		bucket.tokens = float64(cb.limit)
+	}
+	bucket.lastRefill = now
+	return bucket
+}
+
+type tokenBucket struct {
+	// tokens may become negative, when a message larger than
+	// the remaining budget is sent
+	tokens     float64
+	lastRefill time.Time
+}
@@ -0,0 +1,159 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package comm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/common/metrics/disabled"
+	"github.com/hyperledger/fabric/gossip/metrics"
+	"github.com/hyperledger/fabric/gossip/protoext"
+	"github.com/hyperledger/fabric/gossip/util"
+	proto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsHighPriority(t *testing.T) {
+	for _, msg := range []*proto.GossipMessage{
+		{Content: &proto.GossipMessage_DataMsg{DataMsg: &proto.DataMessage{}}},
+		{Content: &proto.GossipMessage_LeadershipMsg{LeadershipMsg: &proto.LeadershipMessage{}}},
+		{Content: &proto.GossipMessage_PrivateData{PrivateData: &proto.PrivateDataMessage{}}},
+		{Content: &proto.GossipMessage_Ack{Ack: &proto.Acknowledgement{}}},
+	} {
+		assert.True(t, isHighPriority(msg))
+	}
+
+	for _, msg := range []*proto.GossipMessage{
+		{Content: &proto.GossipMessage_AliveMsg{AliveMsg: &proto.AliveMessage{}}},
+		{Content: &proto.GossipMessage_StateResponse{StateResponse: &proto.RemoteStateResponse{}}},
+		{Content: &proto.GossipMessage_DataUpdate{DataUpdate: &proto.DataUpdate{}}},
+		{Content: &proto.GossipMessage_StateInfo{StateInfo: &proto.StateInfo{}}},
+	} {
+		assert.False(t, isHighPriority(msg))
+	}
+}
+
+func TestIsStateTransfer(t *testing.T) {
+	assert.True(t, isStateTransfer(&proto.GossipMessage{Content: &proto.GossipMessage_StateRequest{StateRequest: &proto.RemoteStateRequest{}}}))
+	assert.True(t, isStateTransfer(&proto.GossipMessage{Content: &proto.GossipMessage_StateResponse{StateResponse: &proto.RemoteStateResponse{}}}))
+	assert.False(t, isStateTransfer(&proto.GossipMessage{Content: &proto.GossipMessage_StateInfo{StateInfo: &proto.StateInfo{}}}))
+	assert.False(t, isStateTransfer(&proto.GossipMessage{Content: &proto.GossipMessage_DataMsg{DataMsg: &proto.DataMessage{}}}))
+}
+
+func TestChannelBudgets(t *testing.T) {
+	assert.Nil(t, newChannelBudgets(0))
+
+	now := time.Unix(0, 0)
+	budgets := newChannelBudgets(100)
+	budgets.now = func() time.Time { return now }
+
+	// A full budget admits a message larger than itself
+	assert.True(t, budgets.tryTake("A", 150))
+	assert.False(t, budgets.tryTake("A", 1))
+	// Other channels have their own budget
+	assert.True(t, budgets.tryTake("B", 100))
+
+	// A quarter of a second later, the budget of channel A is still exhausted
+	now = now.Add(250 * time.Millisecond)
+	assert.False(t, budgets.tryTake("A", 1))
+	assert.True(t, budgets.tryTake("B", 10))
+
+	// Once replenished, the budget admits messages again
+	now = now.Add(time.Second)
+	assert.True(t, budgets.tryTake("A", 200))
+	assert.False(t, budgets.tryTake("A", 10))
+
+	// The budget never accumulates more than a second of bandwidth
+	now = now.Add(time.Hour)
+	assert.True(t, budgets.tryTake("A", 101))
+	assert.False(t, budgets.tryTake("A", 1))
+
+	// Taking regardless of the budget delays the replenishment
+	now = now.Add(time.Hour)
+	budgets.take("A", 300)
+	now = now.Add(time.Second)
+	assert.False(t, budgets.tryTake("A", 1))
+	now = now.Add(time.Second)
+	assert.True(t, budgets.tryTake("A", 1))
+}
+
+type recordingStream struct {
+	proto.Gossip_GossipStreamServer
+	sent chan *proto.Envelope
+}
+
+func (rs *recordingStream) Send(envelope *proto.Envelope) error {
+	rs.sent <- envelope
+	return nil
+}
+
+func TestConnectionPriority(t *testing.T) {
+	commMetrics := metrics.NewGossipMetrics(&disabled.Provider{}).CommMetrics
+	stream := &recordingStream{sent: make(chan *proto.Envelope, 10)}
+	conn := newConnection(nil, nil, nil, stream, commMetrics, ConnConfig{SendBuffSize: 10})
+	conn.logger = util.GetLogger(util.CommLogger, "")
+	conn.info = &protoext.ConnectionInfo{Endpoint: "peer0"}
+
+	newMsg := func(nonce uint64, msg *proto.GossipMessage) *protoext.SignedGossipMessage {
+		msg.Nonce = nonce
+		sMsg, _ := protoext.NoopSign(msg)
+		return sMsg
+	}
+	stateInfo := &proto.GossipMessage{Content: &proto.GossipMessage_StateInfo{StateInfo: &proto.StateInfo{}}}
+	dataMsg := &proto.GossipMessage{Content: &proto.GossipMessage_DataMsg{DataMsg: &proto.DataMessage{}}}
+
+	conn.send(newMsg(1, stateInfo), func(error) {}, nonBlockingSend)
+	conn.send(newMsg(2, stateInfo), func(error) {}, nonBlockingSend)
+	conn.send(newMsg(3, dataMsg), func(error) {}, nonBlockingSend)
+
+	go conn.writeToStream()
+	defer conn.close()
+
+	var nonces []uint64
+	for i := 0; i < 3; i++ {
+		msg, err := protoext.EnvelopeToGossipMessage(<-stream.sent)
+		assert.NoError(t, err)
+		nonces = append(nonces, msg.Nonce)
+	}
+	assert.Equal(t, []uint64{3, 1, 2}, nonces)
+}
+
+func TestConnectionBandwidthBudget(t *testing.T) {
+	commMetrics := metrics.NewGossipMetrics(&disabled.Provider{}).CommMetrics
+	conn := newConnection(nil, nil, nil, nil, commMetrics, ConnConfig{SendBuffSize: 10})
+	conn.logger = util.GetLogger(util.CommLogger, "")
+	conn.info = &protoext.ConnectionInfo{Endpoint: "peer0"}
+	conn.budgets = newChannelBudgets(1)
+
+	newMsg := func(msg *proto.GossipMessage) *protoext.SignedGossipMessage {
+		msg.Channel = []byte("A")
+		sMsg, _ := protoext.NoopSign(msg)
+		return sMsg
+	}
+	stateInfo := newMsg(&proto.GossipMessage{Content: &proto.GossipMessage_StateInfo{StateInfo: &proto.StateInfo{}}})
+	dataMsg := newMsg(&proto.GossipMessage{Content: &proto.GossipMessage_DataMsg{DataMsg: &proto.DataMessage{}}})
+	stateResponse := newMsg(&proto.GossipMessage{Content: &proto.GossipMessage_StateResponse{StateResponse: &proto.RemoteStateResponse{}}})
+
+	// The first message exhausts the budget, and the next one is dropped
+	conn.send(stateInfo, func(error) {}, nonBlockingSend)
+	conn.send(stateInfo, func(error) {}, nonBlockingSend)
+	assert.Len(t, conn.outBuff, 1)
+
+	// High priority messages are sent regardless of the budget
+	// Blocking sends don't wait for the budget to be replenished either
+	conn.send(stateInfo, func(error) {}, blockingSend)
+	assert.Len(t, conn.outBuff, 1)
+
+	conn.send(dataMsg, func(error) {}, nonBlockingSend)
+	conn.send(dataMsg, func(error) {}, blockingSend)
+	assert.Len(t, conn.priorityBuff, 2)
+
+	// State transfer messages are sent regardless of the budget
+	conn.send(stateResponse, func(error) {}, nonBlockingSend)
+	assert.Len(t, conn.outBuff, 2)
+}
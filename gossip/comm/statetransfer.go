@@ -0,0 +1,180 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package comm
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric/gossip/protoext"
+	proto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+// StateTransferStream is a dedicated stream to a remote peer, over which
+// state requests are sent and blocks are received in bulk
+type StateTransferStream interface {
+	// Send sends a state request to the remote peer
+	Send(msg *proto.GossipMessage) error
+
+	// Recv waits up to the given timeout for the next message of the remote peer
+	Recv(timeout time.Duration) (*protoext.SignedGossipMessage, error)
+
+	// Close closes the stream
+	Close()
+}
+
+// OpenStateTransferStream opens a dedicated stream to the given remote peer,
+// and authenticates it the same way gossip streams are
+func (c *commImpl) OpenStateTransferStream(remotePeer *RemotePeer) (StateTransferStream, error) {
+	if c.isStopping() {
+		return nil, errors.New("comm is stopping")
+	}
+
+	cc, cl, err := c.dial(remotePeer.Endpoint, remotePeer.PKIID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := cl.StateTransferStream(ctx)
+	if err != nil {
+		cancel()
+		cc.Close()
+		return nil, err
+	}
+
+	connInfo, err := c.authenticateRemotePeer(stream, true)
+	if err == nil && len(remotePeer.PKIID) > 0 && !bytes.Equal(connInfo.ID, remotePeer.PKIID) {
+		err = errors.New("PKI-ID of remote peer doesn't match expected PKI-ID")
+	}
+	if err != nil {
+		cancel()
+		cc.Close()
+		return nil, err
+	}
+
+	return &stateTransferStream{
+		stream:   stream,
+		cc:       cc,
+		cancel:   cancel,
+		endpoint: remotePeer.Endpoint,
+	}, nil
+}
+
+// StateTransferStream serves the state requests a remote peer sends over a dedicated stream.
+// The requests are dispatched to the subscribers of the comm module, and their responses
+// are sent back over the same stream.
+func (c *commImpl) StateTransferStream(stream proto.Gossip_StateTransferStreamServer) error {
+	if c.isStopping() {
+		return errors.New("Shutting down")
+	}
+	connInfo, err := c.authenticateRemotePeer(stream, false)
+	if err != nil {
+		c.logger.Errorf("Authentication failed: %v", err)
+		return err
+	}
+	c.logger.Debug("Servicing state transfer stream of", extractRemoteAddress(stream))
+
+	lock := &sync.Mutex{}
+	for {
+		envelope, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		m, err := protoext.EnvelopeToGossipMessage(envelope)
+		if err != nil {
+			return err
+		}
+		if m.GetStateRequest() == nil {
+			return errors.Errorf("expected a state request from %s, got %s", connInfo.Endpoint, m)
+		}
+		c.msgPublisher.DeMultiplex(&stateTransferMessage{
+			SignedGossipMessage: m,
+			stream:              stream,
+			lock:                lock,
+			connInfo:            connInfo,
+		})
+	}
+}
+
+// ReceivedOverStateTransferStream returns whether the given message
+// was received over a dedicated state transfer stream
+func ReceivedOverStateTransferStream(msg protoext.ReceivedMessage) bool {
+	_, isStateTransferMsg := msg.(*stateTransferMessage)
+	return isStateTransferMsg
+}
+
+type stateTransferStream struct {
+	stream   proto.Gossip_StateTransferStreamClient
+	cc       *grpc.ClientConn
+	cancel   context.CancelFunc
+	endpoint string
+}
+
+func (s *stateTransferStream) Send(msg *proto.GossipMessage) error {
+	sMsg, err := protoext.NoopSign(msg)
+	if err != nil {
+		return err
+	}
+	return s.stream.Send(sMsg.Envelope)
+}
+
+func (s *stateTransferStream) Recv(timeout time.Duration) (*protoext.SignedGossipMessage, error) {
+	return readWithTimeout(s.stream, timeout, s.endpoint)
+}
+
+func (s *stateTransferStream) Close() {
+	s.cancel()
+	s.cc.Close()
+}
+
+// stateTransferMessage is a state request received over a state transfer stream
+type stateTransferMessage struct {
+	*protoext.SignedGossipMessage
+	stream   proto.Gossip_StateTransferStreamServer
+	lock     sync.Locker
+	connInfo *protoext.ConnectionInfo
+}
+
+// GetSourceEnvelope Returns the Envelope the stateTransferMessage was
+// constructed with
+func (m *stateTransferMessage) GetSourceEnvelope() *proto.Envelope {
+	return m.Envelope
+}
+
+// Respond sends a msg back over the stream the stateTransferMessage was received on
+func (m *stateTransferMessage) Respond(msg *proto.GossipMessage) {
+	sMsg, err := protoext.NoopSign(msg)
+	if err != nil {
+		return
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.stream.Send(sMsg.Envelope)
+}
+
+// GetGossipMessage returns the inner GossipMessage
+func (m *stateTransferMessage) GetGossipMessage() *protoext.SignedGossipMessage {
+	return m.SignedGossipMessage
+}
+
+// GetConnectionInfo returns information about the remote peer
+// that send the message
+func (m *stateTransferMessage) GetConnectionInfo() *protoext.ConnectionInfo {
+	return m.connInfo
+}
+
+// Ack is a no-op, as state requests are answered with state responses
+func (m *stateTransferMessage) Ack(err error) {}
@@ -8,6 +8,8 @@ package discovery
 
 import (
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/hyperledger/fabric/gossip/common"
 	"github.com/hyperledger/fabric/gossip/protoext"
@@ -94,6 +96,14 @@ func (n NetworkMember) PreferredEndpoint() string {
 	return n.Endpoint
 }
 
+// MemberState describes whether a member of the view is alive,
+// and when it was last seen
+type MemberState struct {
+	NetworkMember
+	Alive    bool
+	LastSeen time.Time
+}
+
 // PeerIdentification encompasses a remote peer's
 // PKI-ID and whether its in the same org as the current
 // peer or not
@@ -124,6 +134,10 @@ type Discovery interface {
 	// GetMembership returns the alive members in the view
 	GetMembership() []NetworkMember
 
+	// MembershipSnapshot returns the alive and the dead members in the view,
+	// along with the last time each of them was seen
+	MembershipSnapshot() []MemberState
+
 	// InitiateSync makes the instance ask a given number of peers
 	// for their membership information
 	InitiateSync(peerNum int)
@@ -180,6 +194,24 @@ func (members Members) Map(f func(member NetworkMember) NetworkMember) Members {
 	return res
 }
 
+// QuorumLedgerHeight returns the highest ledger height reached by a majority
+// of the members that advertise their ledger height, or 0 if none does.
+// Unlike the highest advertised height, it cannot be inflated by a minority
+// of members advertising heights the channel has not reached.
+func (members Members) QuorumLedgerHeight() uint64 {
+	var heights []uint64
+	for _, member := range members {
+		if height := member.Properties.GetLedgerHeight(); height > 0 {
+			heights = append(heights, height)
+		}
+	}
+	if len(heights) == 0 {
+		return 0
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] > heights[j] })
+	return heights[len(heights)/2]
+}
+
 // HaveExternalEndpoints selects network members that have external endpoints
 func HasExternalEndpoint(member NetworkMember) bool {
 	return member.Endpoint != ""
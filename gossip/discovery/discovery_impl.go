@@ -939,6 +939,34 @@ func (d *gossipDiscoveryImpl) GetMembership() []NetworkMember {
 
 }
 
+// MembershipSnapshot returns the alive and the dead members in the view,
+// along with the last time each of them was seen
+func (d *gossipDiscoveryImpl) MembershipSnapshot() []MemberState {
+	if d.toDie() {
+		return []MemberState{}
+	}
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	response := []MemberState{}
+	addMembers := func(lastTS map[string]*timestamp, alive bool) {
+		for id, ts := range lastTS {
+			member, exists := d.id2Member[id]
+			if !exists {
+				continue
+			}
+			response = append(response, MemberState{
+				NetworkMember: *member,
+				Alive:         alive,
+				LastSeen:      ts.lastSeen,
+			})
+		}
+	}
+	addMembers(d.aliveLastTS, true)
+	addMembers(d.deadLastTS, false)
+	return response
+}
+
 func tsToTime(ts uint64) time.Time {
 	return time.Unix(int64(0), int64(ts))
 }
@@ -333,6 +333,10 @@ func (g *gossipInstance) Ping(context.Context, *proto.Empty) (*proto.Empty, erro
 	return &proto.Empty{}, nil
 }
 
+func (g *gossipInstance) StateTransferStream(stream proto.Gossip_StateTransferStreamServer) error {
+	return nil
+}
+
 var noopPolicy = func(remotePeer *NetworkMember) (Sieve, EnvelopeFilter) {
 	return func(msg *protoext.SignedGossipMessage) bool {
 			return true
@@ -842,6 +846,34 @@ func TestExpiration(t *testing.T) {
 	waitUntilOrFailBlocking(t, stopAction.Wait)
 }
 
+func TestMembershipSnapshot(t *testing.T) {
+	t.Parallel()
+	bootPeers := []string{bootPeer(14611)}
+	instances := []*gossipInstance{
+		createDiscoveryInstance(14611, "d1", bootPeers),
+		createDiscoveryInstance(14612, "d2", bootPeers),
+		createDiscoveryInstance(14613, "d3", bootPeers),
+	}
+	defer instances[0].Stop()
+	defer instances[1].Stop()
+	assertMembership(t, instances, 2)
+
+	start := time.Now()
+	waitUntilOrFailBlocking(t, instances[2].Stop)
+	assertMembership(t, instances[:2], 1)
+
+	snapshot := instances[0].MembershipSnapshot()
+	assert.Len(t, snapshot, 2)
+	states := make(map[string]MemberState)
+	for _, state := range snapshot {
+		states[state.Endpoint] = state
+	}
+	assert.True(t, states[instances[1].Self().Endpoint].Alive)
+	assert.False(t, states[instances[2].Self().Endpoint].Alive)
+	assert.True(t, states[instances[1].Self().Endpoint].LastSeen.After(start))
+	assert.True(t, states[instances[2].Self().Endpoint].LastSeen.Before(time.Now()))
+}
+
 func TestGetFullMembership(t *testing.T) {
 	t.Parallel()
 	nodeNum := 15
@@ -1514,6 +1546,20 @@ func TestMembersIntersect(t *testing.T) {
 	assert.Equal(t, Members{{PKIid: common.PKIidType("p1"), Endpoint: "p1"}}, members1.Intersect(members2))
 }
 
+func TestMembersQuorumLedgerHeight(t *testing.T) {
+	withHeight := func(height uint64) NetworkMember {
+		return NetworkMember{Properties: &proto.Properties{LedgerHeight: height}}
+	}
+
+	assert.Equal(t, uint64(0), Members{}.QuorumLedgerHeight())
+	assert.Equal(t, uint64(0), Members{{Endpoint: "p0"}}.QuorumLedgerHeight())
+	assert.Equal(t, uint64(10), Members{withHeight(10)}.QuorumLedgerHeight())
+	assert.Equal(t, uint64(10), Members{withHeight(12), withHeight(10)}.QuorumLedgerHeight())
+	assert.Equal(t, uint64(11), Members{withHeight(12), {Endpoint: "p1"}, withHeight(10), withHeight(11)}.QuorumLedgerHeight())
+	// A member advertising a height far ahead of the others is ignored
+	assert.Equal(t, uint64(100), Members{withHeight(100), withHeight(1000000), withHeight(100), withHeight(99)}.QuorumLedgerHeight())
+}
+
 func waitUntilOrFail(t *testing.T, pred func() bool) {
 	waitUntilTimeoutOrFail(t, pred, timeout)
 }
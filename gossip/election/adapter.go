@@ -35,6 +35,10 @@ func (mi *msgImpl) IsDeclaration() bool {
 	return mi.msg.GetLeadershipMsg().IsDeclaration
 }
 
+func (mi *msgImpl) Priority() uint32 {
+	return mi.msg.GetLeadershipMsg().Priority
+}
+
 type peerImpl struct {
 	member discovery.NetworkMember
 }
@@ -124,13 +128,14 @@ func (ai *adapterImpl) Accept() <-chan Msg {
 	return msgCh
 }
 
-func (ai *adapterImpl) CreateMessage(isDeclaration bool) Msg {
+func (ai *adapterImpl) CreateMessage(isDeclaration bool, priority uint32) Msg {
 	ai.seqNum++
 	seqNum := ai.seqNum
 
 	leadershipMsg := &proto.LeadershipMessage{
 		PkiId:         ai.selfPKIid,
 		IsDeclaration: isDeclaration,
+		Priority:      priority,
 		Timestamp: &proto.PeerTime{
 			IncNum: ai.incTime,
 			SeqNum: seqNum,
@@ -54,7 +54,7 @@ func TestAdapterImpl_CreateMessage(t *testing.T) {
 
 	adapter := NewAdapter(mockGossip, selfNetworkMember.PKIid, []byte("channel0"),
 		metrics.NewGossipMetrics(&disabled.Provider{}).ElectionMetrics)
-	msg := adapter.CreateMessage(true)
+	msg := adapter.CreateMessage(true, 5)
 
 	if !protoext.IsLeadershipMsg(msg.(*msgImpl).msg) {
 		t.Error("Newly created message should be LeadershipMsg")
@@ -64,7 +64,11 @@ func TestAdapterImpl_CreateMessage(t *testing.T) {
 		t.Error("Newly created msg should be Declaration msg")
 	}
 
-	msg = adapter.CreateMessage(false)
+	if msg.Priority() != 5 {
+		t.Error("Newly created msg should carry the given priority")
+	}
+
+	msg = adapter.CreateMessage(false, 0)
 
 	if !protoext.IsLeadershipMsg(msg.(*msgImpl).msg) {
 		t.Error("Newly created message should be LeadershipMsg")
@@ -124,7 +128,7 @@ func TestAdapterImpl_Gossip(t *testing.T) {
 
 	sender := adapters[fmt.Sprintf("Peer%d", 0)]
 
-	sender.Gossip(sender.CreateMessage(true))
+	sender.Gossip(sender.CreateMessage(true, 0))
 
 	totalMsg := 0
 
@@ -9,6 +9,7 @@ package election
 import (
 	"bytes"
 	"encoding/hex"
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -18,7 +19,8 @@ import (
 
 // Gossip leader election module
 // Algorithm properties:
-// - Peers break symmetry by comparing IDs
+// - Peers with higher priorities are preferred, and peers
+//   of equal priorities break symmetry by comparing IDs
 // - Each peer is either a leader or a follower,
 //   and the aim is to have exactly 1 leader if the membership view
 //   is the same for all peers
@@ -50,12 +52,15 @@ import (
 //		If you are the leader:
 //			Broadcast leadership declaration
 //			If a leadership declaration was received from
-// 			a peer with a lower ID,
+// 			a better candidate,
 //			become a follower
 //		Else, you're a follower:
 //			If haven't received a leadership declaration within
 // 			a time threshold:
 //				set leaderKnown to false
+//			If only received leadership declarations from peers
+//			with a lower priority:
+//				become a leader
 //
 // LeaderElection():
 // 	Gossip leadership proposal message
@@ -63,9 +68,12 @@ import (
 //	If received a leadership declaration:
 //		return
 //	Iterate over all proposal messages collected.
-// 	If a proposal message from a peer with an ID lower
+// 	If a proposal message from a better candidate
 // 	than yourself was received, return.
 //	Else, declare yourself a leader
+//
+// A peer is a better candidate than another if it has a higher priority,
+// or if it has the same priority and a lower ID.
 
 // LeaderElectionAdapter is used by the leader election module
 // to send and receive messages and to get membership information
@@ -76,8 +84,9 @@ type LeaderElectionAdapter interface {
 	// Accept returns a channel that emits messages
 	Accept() <-chan Msg
 
-	// CreateProposalMessage
-	CreateMessage(isDeclaration bool) Msg
+	// CreateMessage creates a leadership proposal or declaration
+	// message carrying the given priority
+	CreateMessage(isDeclaration bool, priority uint32) Msg
 
 	// Peers returns a list of peers considered alive
 	Peers() []Peer
@@ -99,6 +108,16 @@ type LeaderElectionService interface {
 	// Yield relinquishes the leadership until a new leader is elected,
 	// or a timeout expires
 	Yield()
+
+	// Priority returns the priority of this peer in leader elections
+	Priority() uint32
+
+	// ForceLeadership makes this peer take over the leadership
+	// from other peers, or revokes it when forced is false
+	ForceLeadership(forced bool)
+
+	// IsForcedLeader returns whether this peer was forced to be a leader
+	IsForcedLeader() bool
 }
 
 type peerID []byte
@@ -124,6 +143,8 @@ type Msg interface {
 	IsProposal() bool
 	// IsDeclaration returns whether this message is a leadership declaration
 	IsDeclaration() bool
+	// Priority returns the priority of the peer sent the message
+	Priority() uint32
 }
 
 func noopCallback(_ bool) {
@@ -141,6 +162,8 @@ type ElectionConfig struct {
 	MembershipSampleInterval time.Duration
 	LeaderAliveThreshold     time.Duration
 	LeaderElectionDuration   time.Duration
+	// Priority of the peer, peers with higher priorities are preferred as leaders
+	Priority uint32
 }
 
 // NewLeaderElectionService returns a new LeaderElectionService
@@ -150,7 +173,7 @@ func NewLeaderElectionService(adapter LeaderElectionAdapter, id string, callback
 	}
 	le := &leaderElectionSvcImpl{
 		id:            peerID(id),
-		proposals:     util.NewSet(),
+		proposals:     make(map[string]uint32),
 		adapter:       adapter,
 		stopChan:      make(chan struct{}, 1),
 		interruptChan: make(chan struct{}, 1),
@@ -170,7 +193,7 @@ func NewLeaderElectionService(adapter LeaderElectionAdapter, id string, callback
 // leaderElectionSvcImpl is an implementation of a LeaderElectionService
 type leaderElectionSvcImpl struct {
 	id        peerID
-	proposals *util.Set
+	proposals map[string]uint32
 	sync.Mutex
 	stopChan      chan struct{}
 	interruptChan chan struct{}
@@ -179,12 +202,18 @@ type leaderElectionSvcImpl struct {
 	toDie         int32
 	leaderExists  int32
 	yield         int32
+	forced        int32
 	sleeping      bool
 	adapter       LeaderElectionAdapter
 	logger        util.Logger
 	callback      leadershipCallback
 	yieldTimer    *time.Timer
 	config        ElectionConfig
+
+	// weakerLeader and strongerLeader record whether a leadership declaration
+	// from a worse or from a better candidate was received while following
+	weakerLeader   bool
+	strongerLeader bool
 }
 
 func (le *leaderElectionSvcImpl) start() {
@@ -224,14 +253,19 @@ func (le *leaderElectionSvcImpl) handleMessage(msg Msg) {
 	defer le.Unlock()
 
 	if msg.IsProposal() {
-		le.proposals.Add(string(msg.SenderID()))
+		le.proposals[string(msg.SenderID())] = msg.Priority()
 	} else if msg.IsDeclaration() {
 		atomic.StoreInt32(&le.leaderExists, int32(1))
 		if le.sleeping && len(le.interruptChan) == 0 {
 			le.interruptChan <- struct{}{}
 		}
-		if bytes.Compare(msg.SenderID(), le.id) < 0 && le.IsLeader() {
-			le.stopBeingLeader()
+		if le.isBetterCandidate(msg.SenderID(), msg.Priority()) {
+			le.strongerLeader = true
+			if le.IsLeader() {
+				le.stopBeingLeader()
+			}
+		} else if msg.Priority() < le.Priority() {
+			le.weakerLeader = true
 		}
 	} else {
 		// We shouldn't get here
@@ -278,6 +312,11 @@ func (le *leaderElectionSvcImpl) run() {
 		if le.shouldStop() {
 			return
 		}
+		// Take over the leadership from peers with a lower priority
+		if !le.IsLeader() && le.shouldPreempt() {
+			le.logger.Info(le.id, ": Taking over the leadership from a peer with a lower priority")
+			le.beLeader()
+		}
 		if le.IsLeader() {
 			le.leader()
 		} else {
@@ -311,12 +350,14 @@ func (le *leaderElectionSvcImpl) leaderElection() {
 	}
 	// Leader doesn't exist, let's see if there is a better candidate than us
 	// for being a leader
-	for _, o := range le.proposals.ToArray() {
-		id := o.(string)
-		if bytes.Compare(peerID(id), le.id) < 0 {
+	le.Lock()
+	for id, priority := range le.proposals {
+		if le.isBetterCandidate(peerID(id), priority) {
+			le.Unlock()
 			return
 		}
 	}
+	le.Unlock()
 	// If we got here, there is no one that proposed being a leader
 	// that's a better candidate than us.
 	le.beLeader()
@@ -327,7 +368,7 @@ func (le *leaderElectionSvcImpl) leaderElection() {
 func (le *leaderElectionSvcImpl) propose() {
 	le.logger.Debug(le.id, ": Entering")
 	le.logger.Debug(le.id, ": Exiting")
-	leadershipProposal := le.adapter.CreateMessage(false)
+	leadershipProposal := le.adapter.CreateMessage(false, le.Priority())
 	le.adapter.Gossip(leadershipProposal)
 }
 
@@ -335,7 +376,11 @@ func (le *leaderElectionSvcImpl) follower() {
 	le.logger.Debug(le.id, ": Entering")
 	defer le.logger.Debug(le.id, ": Exiting")
 
-	le.proposals.Clear()
+	le.Lock()
+	le.proposals = make(map[string]uint32)
+	le.weakerLeader = false
+	le.strongerLeader = false
+	le.Unlock()
 	atomic.StoreInt32(&le.leaderExists, int32(0))
 	le.adapter.ReportMetrics(false)
 	select {
@@ -346,7 +391,7 @@ func (le *leaderElectionSvcImpl) follower() {
 }
 
 func (le *leaderElectionSvcImpl) leader() {
-	leaderDeclaration := le.adapter.CreateMessage(true)
+	leaderDeclaration := le.adapter.CreateMessage(true, le.Priority())
 	le.adapter.Gossip(leaderDeclaration)
 	le.adapter.ReportMetrics(true)
 	le.waitForInterrupt(le.config.LeaderAliveThreshold / 2)
@@ -387,6 +432,23 @@ func (le *leaderElectionSvcImpl) isAlive(id peerID) bool {
 	return false
 }
 
+// isBetterCandidate returns whether the peer of the given id and priority
+// is a better candidate than us for being a leader
+func (le *leaderElectionSvcImpl) isBetterCandidate(id peerID, priority uint32) bool {
+	if priority != le.Priority() {
+		return priority > le.Priority()
+	}
+	return bytes.Compare(id, le.id) < 0
+}
+
+// shouldPreempt returns whether we should take over the leadership, which is
+// the case if only leaders with a lower priority than us declared themselves
+func (le *leaderElectionSvcImpl) shouldPreempt() bool {
+	le.Lock()
+	defer le.Unlock()
+	return le.weakerLeader && !le.strongerLeader && !le.isYielding()
+}
+
 func (le *leaderElectionSvcImpl) isLeaderExists() bool {
 	return atomic.LoadInt32(&le.leaderExists) == int32(1)
 }
@@ -446,6 +508,32 @@ func (le *leaderElectionSvcImpl) Yield() {
 	})
 }
 
+// Priority returns the priority of this peer in leader elections,
+// which is the highest possible priority if it was forced to be a leader
+func (le *leaderElectionSvcImpl) Priority() uint32 {
+	if le.IsForcedLeader() {
+		return math.MaxUint32
+	}
+	return le.config.Priority
+}
+
+// ForceLeadership makes this peer take over the leadership
+// from other peers, or revokes it when forced is false
+func (le *leaderElectionSvcImpl) ForceLeadership(forced bool) {
+	if forced {
+		le.logger.Info(le.id, ": Forced to be a leader")
+		atomic.StoreInt32(&le.forced, int32(1))
+		return
+	}
+	le.logger.Info(le.id, ": No longer forced to be a leader")
+	atomic.StoreInt32(&le.forced, int32(0))
+}
+
+// IsForcedLeader returns whether this peer was forced to be a leader
+func (le *leaderElectionSvcImpl) IsForcedLeader() bool {
+	return atomic.LoadInt32(&le.forced) == int32(1)
+}
+
 // Stop stops the LeaderElectionService
 func (le *leaderElectionSvcImpl) Stop() {
 	le.logger.Debug(le.id, ": Entering")
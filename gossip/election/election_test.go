@@ -8,6 +8,7 @@ package election
 
 import (
 	"fmt"
+	"math"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -35,6 +36,7 @@ func init() {
 type msg struct {
 	sender   string
 	proposal bool
+	priority uint32
 }
 
 func (m *msg) SenderID() peerID {
@@ -49,6 +51,10 @@ func (m *msg) IsDeclaration() bool {
 	return !m.proposal
 }
 
+func (m *msg) Priority() uint32 {
+	return m.priority
+}
+
 type peer struct {
 	mockedMethods map[string]struct{}
 	mock.Mock
@@ -101,8 +107,8 @@ func (p *peer) Accept() <-chan Msg {
 	return (<-chan Msg)(p.msgChan)
 }
 
-func (p *peer) CreateMessage(isDeclaration bool) Msg {
-	return &msg{proposal: !isDeclaration, sender: p.id}
+func (p *peer) CreateMessage(isDeclaration bool, priority uint32) Msg {
+	return &msg{proposal: !isDeclaration, sender: p.id, priority: priority}
 }
 
 func (p *peer) Peers() []Peer {
@@ -159,6 +165,10 @@ func createPeers(spawnInterval time.Duration, ids ...int) []*peer {
 }
 
 func createPeerWithCostumeMetrics(id int, peerMap map[string]*peer, l *sync.RWMutex, f func(mock.Arguments)) *peer {
+	return createPeerWithPriority(id, 0, peerMap, l, f)
+}
+
+func createPeerWithPriority(id int, priority uint32, peerMap map[string]*peer, l *sync.RWMutex, f func(mock.Arguments)) *peer {
 	idStr := fmt.Sprintf("p%d", id)
 	c := make(chan Msg, 100)
 	p := &peer{id: idStr, peers: peerMap, sharedLock: l, msgChan: c, mockedMethods: make(map[string]struct{}), leaderFromCallback: false, callbackInvoked: false}
@@ -168,6 +178,7 @@ func createPeerWithCostumeMetrics(id int, peerMap map[string]*peer, l *sync.RWMu
 		MembershipSampleInterval: testMembershipSampleInterval,
 		LeaderAliveThreshold:     testLeaderAliveThreshold,
 		LeaderElectionDuration:   testLeaderElectionDuration,
+		Priority:                 priority,
 	}
 	p.LeaderElectionService = NewLeaderElectionService(p, idStr, p.leaderCallback, config)
 	l.Lock()
@@ -468,6 +479,66 @@ func TestPartition(t *testing.T) {
 	}
 }
 
+func TestPriority(t *testing.T) {
+	t.Parallel()
+	// Scenario: Peers are spawned at the same time, and the peer with the highest ID
+	// has a higher priority than the others
+	// expected outcome: the peer with the highest priority is the leader
+	peerMap := make(map[string]*peer)
+	l := &sync.RWMutex{}
+	peers := []*peer{
+		createPeer(0, peerMap, l),
+		createPeer(1, peerMap, l),
+		createPeerWithPriority(2, 1, peerMap, l, func(mock.Arguments) {}),
+	}
+	leaders := waitForLeaderElection(t, peers)
+	assert.Len(t, leaders, 1, "Only 1 leader should have been elected")
+	assert.Equal(t, "p2", leaders[0])
+}
+
+func TestPriorityTakeover(t *testing.T) {
+	t.Parallel()
+	// Scenario: A peer is elected as a leader, and then a peer
+	// with a higher priority is spawned
+	// expected outcome: the peer with the higher priority takes over the leadership
+	peerMap := make(map[string]*peer)
+	l := &sync.RWMutex{}
+	peers := []*peer{createPeer(0, peerMap, l)}
+	leaders := waitForLeaderElection(t, peers)
+	assert.Equal(t, "p0", leaders[0])
+
+	peers = append(peers, createPeerWithPriority(1, 1, peerMap, l, func(mock.Arguments) {}))
+	waitForBoolFunc(t, peers[1].IsLeader, true, "p1 didn't take over the leadership")
+	waitForBoolFunc(t, peers[0].IsLeader, false, "p0 didn't relinquish the leadership")
+	waitForBoolFunc(t, peers[0].isLeaderFromCallback, false, "Leadership callback result is wrong for p0")
+}
+
+func TestForceLeadership(t *testing.T) {
+	t.Parallel()
+	// Scenario: Peers spawn and a leader is elected.
+	// After a while, another peer is forced to be a leader
+	// expected outcome: the forced peer takes over the leadership and keeps it,
+	// even after it isn't forced anymore
+	peers := createPeers(0, 0, 1, 2)
+	leaders := waitForLeaderElection(t, peers)
+	assert.Len(t, leaders, 1, "Only 1 leader should have been elected")
+	assert.Equal(t, "p0", leaders[0])
+
+	peers[2].ForceLeadership(true)
+	assert.True(t, peers[2].IsForcedLeader())
+	assert.Equal(t, uint32(math.MaxUint32), peers[2].Priority())
+	waitForBoolFunc(t, peers[2].IsLeader, true, "p2 didn't take over the leadership")
+	waitForBoolFunc(t, peers[0].IsLeader, false, "p0 didn't relinquish the leadership")
+
+	peers[2].ForceLeadership(false)
+	assert.False(t, peers[2].IsForcedLeader())
+	assert.Equal(t, uint32(0), peers[2].Priority())
+	time.Sleep(testLeaderAliveThreshold * 2)
+	leaders = waitForLeaderElection(t, peers)
+	assert.Len(t, leaders, 1, "Only 1 leader should have been elected")
+	assert.Equal(t, "p2", leaders[0])
+}
+
 func Test_peerIDString(t *testing.T) {
 	tests := []struct {
 		input    peerID
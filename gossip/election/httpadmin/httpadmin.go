@@ -0,0 +1,128 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package httpadmin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/core/middleware"
+	"github.com/hyperledger/fabric/gossip/election"
+)
+
+// URLBase is the path under which the handler serves leader election requests.
+const URLBase = "/election/"
+
+//go:generate counterfeiter -o fakes/elections.go -fake-name Elections . Elections
+
+// Elections provides the leader election services of the channels of the peer.
+type Elections interface {
+	// LeaderElection returns the leader election service of the given channel,
+	// or nil if the peer doesn't take part in leader elections for it
+	LeaderElection(channelID string) election.LeaderElectionService
+}
+
+//go:generate counterfeiter -o fakes/leader_election_service.go -fake-name LeaderElectionService ../ LeaderElectionService
+
+type LeadershipStatus struct {
+	Leader   bool   `json:"leader"`
+	Forced   bool   `json:"forced"`
+	Priority uint32 `json:"priority"`
+}
+
+type ForcedLeadership struct {
+	Forced bool `json:"forced"`
+}
+
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+func NewHandler(e Elections, checkAdmin middleware.AdminChecker) *Handler {
+	return &Handler{
+		Elections:  e,
+		CheckAdmin: checkAdmin,
+		Logger:     flogging.MustGetLogger("gossip.election.httpadmin"),
+	}
+}
+
+// Handler serves the leader election endpoint of the operations system.
+//
+// GET /election/<channel> returns the leadership status of the peer in the channel.
+//
+// PUT /election/<channel> with {"forced": true} forces the peer to take over the
+// leadership in the channel, and with {"forced": false} revokes it. Only
+// clients whose TLS certificate passes CheckAdmin may change the leadership.
+type Handler struct {
+	Elections  Elections
+	CheckAdmin middleware.AdminChecker
+	Logger     *flogging.FabricLogger
+}
+
+func (h *Handler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	channelID := strings.TrimPrefix(req.URL.Path, URLBase)
+	if channelID == "" || strings.Contains(channelID, "/") {
+		h.sendResponse(resp, http.StatusNotFound, fmt.Errorf("invalid channel path: %s", req.URL.Path))
+		return
+	}
+
+	le := h.Elections.LeaderElection(channelID)
+	if le == nil {
+		h.sendResponse(resp, http.StatusNotFound, fmt.Errorf("no leader election for channel %s", channelID))
+		return
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		h.sendResponse(resp, http.StatusOK, &LeadershipStatus{
+			Leader:   le.IsLeader(),
+			Forced:   le.IsForcedLeader(),
+			Priority: le.Priority(),
+		})
+
+	case http.MethodPut:
+		forceLeadership := http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			h.forceLeadership(resp, req, channelID, le)
+		})
+		middleware.RequireAdmin(h.CheckAdmin)(forceLeadership).ServeHTTP(resp, req)
+
+	default:
+		err := fmt.Errorf("invalid request method: %s", req.Method)
+		h.sendResponse(resp, http.StatusBadRequest, err)
+	}
+}
+
+func (h *Handler) forceLeadership(resp http.ResponseWriter, req *http.Request, channelID string, le election.LeaderElectionService) {
+	var forced ForcedLeadership
+	decoder := json.NewDecoder(req.Body)
+	if err := decoder.Decode(&forced); err != nil {
+		h.sendResponse(resp, http.StatusBadRequest, err)
+		return
+	}
+	req.Body.Close()
+
+	h.Logger.Infof("Setting forced leadership of channel %s to %t", channelID, forced.Forced)
+	le.ForceLeadership(forced.Forced)
+	resp.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) sendResponse(resp http.ResponseWriter, code int, payload interface{}) {
+	encoder := json.NewEncoder(resp)
+	if err, ok := payload.(error); ok {
+		payload = &ErrorResponse{Error: err.Error()}
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(code)
+
+	if err := encoder.Encode(payload); err != nil {
+		h.Logger.Errorw("failed to encode payload", "error", err)
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package httpadmin_test
+
+import (
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/gossip/election/httpadmin"
+	"github.com/hyperledger/fabric/gossip/election/httpadmin/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		fakeElections             *fakes.Elections
+		fakeLeaderElectionService *fakes.LeaderElectionService
+		handler                   *httpadmin.Handler
+		adminCert                 *x509.Certificate
+	)
+
+	BeforeEach(func() {
+		fakeLeaderElectionService = &fakes.LeaderElectionService{}
+		fakeLeaderElectionService.IsLeaderReturns(true)
+		fakeLeaderElectionService.PriorityReturns(5)
+		fakeElections = &fakes.Elections{}
+		fakeElections.LeaderElectionReturns(fakeLeaderElectionService)
+		adminCert = &x509.Certificate{Raw: []byte("admin")}
+		handler = &httpadmin.Handler{
+			Elections: fakeElections,
+			CheckAdmin: func(cert *x509.Certificate) error {
+				if cert != adminCert {
+					return errors.New("not an admin")
+				}
+				return nil
+			},
+			Logger: flogging.NewFabricLogger(flogging.NewZapLogger(nil)),
+		}
+	})
+
+	// newPutRequest returns a PUT request authenticated with the certificate
+	// of an admin
+	newPutRequest := func(body string) *http.Request {
+		req := httptest.NewRequest("PUT", "https://localhost/election/mychannel", strings.NewReader(body))
+		req.TLS.VerifiedChains = [][]*x509.Certificate{{adminCert}}
+		return req
+	}
+
+	Describe("GET", func() {
+		It("returns the leadership status of the peer in the channel", func() {
+			req := httptest.NewRequest("GET", "/election/mychannel", nil)
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			Expect(fakeElections.LeaderElectionCallCount()).To(Equal(1))
+			Expect(fakeElections.LeaderElectionArgsForCall(0)).To(Equal("mychannel"))
+			Expect(resp.Header().Get("Content-Type")).To(Equal("application/json"))
+			Expect(resp.Body).To(MatchJSON(`{"leader": true, "forced": false, "priority": 5}`))
+		})
+
+		Context("when the peer doesn't take part in leader elections for the channel", func() {
+			BeforeEach(func() {
+				fakeElections.LeaderElectionReturns(nil)
+			})
+
+			It("responds with not found", func() {
+				req := httptest.NewRequest("GET", "/election/mychannel", nil)
+				resp := httptest.NewRecorder()
+				handler.ServeHTTP(resp, req)
+
+				Expect(resp.Code).To(Equal(http.StatusNotFound))
+				Expect(resp.Body).To(MatchJSON(`{"error": "no leader election for channel mychannel"}`))
+			})
+		})
+	})
+
+	Describe("PUT", func() {
+		It("forces the leadership of the peer in the channel", func() {
+			req := newPutRequest(`{"forced": true}`)
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusNoContent))
+			Expect(fakeLeaderElectionService.ForceLeadershipCallCount()).To(Equal(1))
+			Expect(fakeLeaderElectionService.ForceLeadershipArgsForCall(0)).To(BeTrue())
+		})
+
+		It("revokes the forced leadership of the peer in the channel", func() {
+			req := newPutRequest(`{"forced": false}`)
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusNoContent))
+			Expect(fakeLeaderElectionService.ForceLeadershipCallCount()).To(Equal(1))
+			Expect(fakeLeaderElectionService.ForceLeadershipArgsForCall(0)).To(BeFalse())
+		})
+
+		Context("when the request body is malformed", func() {
+			It("responds with bad request", func() {
+				req := newPutRequest(`goo`)
+				resp := httptest.NewRecorder()
+				handler.ServeHTTP(resp, req)
+
+				Expect(resp.Code).To(Equal(http.StatusBadRequest))
+				Expect(resp.Body).To(MatchJSON(`{"error": "invalid character 'g' looking for beginning of value"}`))
+				Expect(fakeLeaderElectionService.ForceLeadershipCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the client does not present a certificate", func() {
+			It("responds with unauthorized", func() {
+				req := httptest.NewRequest("PUT", "/election/mychannel", strings.NewReader(`{"forced": true}`))
+				resp := httptest.NewRecorder()
+				handler.ServeHTTP(resp, req)
+
+				Expect(resp.Code).To(Equal(http.StatusUnauthorized))
+				Expect(fakeLeaderElectionService.ForceLeadershipCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the client is not an admin", func() {
+			It("responds with forbidden", func() {
+				req := newPutRequest(`{"forced": true}`)
+				req.TLS.VerifiedChains = [][]*x509.Certificate{{{Raw: []byte("client")}}}
+				resp := httptest.NewRecorder()
+				handler.ServeHTTP(resp, req)
+
+				Expect(resp.Code).To(Equal(http.StatusForbidden))
+				Expect(fakeLeaderElectionService.ForceLeadershipCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when the channel path is invalid", func() {
+		It("responds with not found", func() {
+			req := httptest.NewRequest("GET", "/election/mychannel/foo", nil)
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusNotFound))
+			Expect(resp.Body).To(MatchJSON(`{"error": "invalid channel path: /election/mychannel/foo"}`))
+			Expect(fakeElections.LeaderElectionCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the request method is unsupported", func() {
+		It("responds with bad request", func() {
+			req := httptest.NewRequest("POST", "/election/mychannel", nil)
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+			Expect(resp.Body).To(MatchJSON(`{"error": "invalid request method: POST"}`))
+		})
+	})
+})
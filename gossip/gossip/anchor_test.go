@@ -72,6 +72,10 @@ func (p *peerMock) Ping(context.Context, *proto.Empty) (*proto.Empty, error) {
 	return &proto.Empty{}, nil
 }
 
+func (p *peerMock) StateTransferStream(stream proto.Gossip_StateTransferStreamServer) error {
+	return nil
+}
+
 func newPeerMockWithGRPC(port int, gRPCServer *comm.GRPCServer, certs *common.TLSCertificates,
 	expectedMsgs2Receive int, t *testing.T, msgAssertions ...msgInspection) *peerMock {
 	p := &peerMock{
@@ -886,6 +886,8 @@ func (gc *gossipChannel) updateProperties(ledgerHeight uint64, chaincodes []*pro
 			LeftChannel:  leftChannel,
 			LedgerHeight: ledgerHeight,
 			Chaincodes:   chaincodes,
+			// The state transfer of this peer serves state transfer streams
+			BulkStateTransfer: true,
 		},
 	}
 	m := &proto.GossipMessage{
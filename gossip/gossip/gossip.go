@@ -82,6 +82,14 @@ type Gossip interface {
 	// IdentityInfo returns information known peer identities
 	IdentityInfo() api.PeerIdentitySet
 
+	// MembershipSnapshot returns a point in time view of the membership,
+	// along with the rates of messages received from each member
+	MembershipSnapshot() MembershipSnapshot
+
+	// OpenStateTransferStream opens a dedicated stream to a remote peer,
+	// over which blocks are requested in bulk
+	OpenStateTransferStream(peer *comm.RemotePeer) (comm.StateTransferStream, error)
+
 	// Stop stops the gossip component
 	Stop()
 }
@@ -145,6 +153,13 @@ type Config struct {
 	RecvBuffSize int           // Buffer size of received messages
 	SendBuffSize int           // Buffer size of sending messages
 
+	ChannelBandwidthLimit int // Maximum bytes per second sent for each channel, apart from blocks and leadership messages
+
+	EndpointOverrides comm.EndpointOverrides // Endpoints dialed instead of the endpoints advertised by peers of foreign organizations
+	IdleConnTimeout   time.Duration          // Time after which unused connections dialed by the peer are closed, zero means never
+
+	OrgTLSCertVerifier func(org api.OrgIdentityType, rawCerts [][]byte) error // Verifies TLS certificates of peers against the TLS CAs of their organization, nil means not pinned
+
 	MsgExpirationTimeout time.Duration // Leadership message expiration timeout
 
 	AliveTimeInterval            time.Duration // Alive check interval
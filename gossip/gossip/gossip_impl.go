@@ -65,6 +65,7 @@ type gossipServiceImpl struct {
 	stateInfoMsgStore msgstore.MessageStore
 	certPuller        pull.Mediator
 	gossipMetrics     *metrics.GossipMetrics
+	msgCounter        *msgCounter
 }
 
 // NewGossipService creates a gossip instance attached to a gRPC server
@@ -90,6 +91,7 @@ func NewGossipService(conf *Config, s *grpc.Server, sa api.SecurityAdvisor,
 		stopSignal:            &sync.WaitGroup{},
 		includeIdentityPeriod: time.Now().Add(conf.PublishCertPeriod),
 		gossipMetrics:         gossipMetrics,
+		msgCounter:            newMsgCounter(),
 	}
 	g.stateInfoMsgStore = g.newStateInfoMsgStore()
 
@@ -99,10 +101,14 @@ func NewGossipService(conf *Config, s *grpc.Server, sa api.SecurityAdvisor,
 	}, sa)
 
 	commConfig := comm.CommConfig{
-		DialTimeout:  conf.DialTimeout,
-		ConnTimeout:  conf.ConnTimeout,
-		RecvBuffSize: conf.RecvBuffSize,
-		SendBuffSize: conf.SendBuffSize,
+		DialTimeout:           conf.DialTimeout,
+		ConnTimeout:           conf.ConnTimeout,
+		RecvBuffSize:          conf.RecvBuffSize,
+		SendBuffSize:          conf.SendBuffSize,
+		ChannelBandwidthLimit: conf.ChannelBandwidthLimit,
+		EndpointOverrides:     conf.EndpointOverrides,
+		IdleConnTimeout:       conf.IdleConnTimeout,
+		OrgTLSCertVerifier:    conf.OrgTLSCertVerifier,
 	}
 	g.comm, err = comm.NewCommInstance(s, conf.TLSCerts, g.idMapper, selfIdentity, secureDialOpts, sa,
 		gossipMetrics.CommMetrics, commConfig)
@@ -232,6 +238,10 @@ func (g *gossipServiceImpl) learnAnchorPeers(channel string, orgOfAnchorPeers ap
 			g.logger.Infof("Anchor peer %s:%d isn't in our org(%v) and we have no external endpoint, skipping", ap.Host, ap.Port, string(orgOfAnchorPeers))
 			continue
 		}
+		if override := g.conf.EndpointOverrides.Resolve(orgOfAnchorPeers, endpoint); override != endpoint {
+			g.logger.Infof("Anchor peer %s of %s is overridden by %s", endpoint, string(orgOfAnchorPeers), override)
+			endpoint = override
+		}
 		identifier := func() (*discovery.PeerIdentification, error) {
 			remotePeerIdentity, err := g.comm.Handshake(&comm.RemotePeer{Endpoint: endpoint})
 			if err != nil {
@@ -330,6 +340,7 @@ func (g *gossipServiceImpl) handleMessage(m protoext.ReceivedMessage) {
 	}
 
 	msg := m.GetGossipMessage()
+	g.msgCounter.add(m.GetConnectionInfo().ID)
 
 	g.logger.Debug("Entering,", m.GetConnectionInfo(), "sent us", msg)
 	defer g.logger.Debug("Exiting")
@@ -783,6 +794,12 @@ func (g *gossipServiceImpl) UpdateChaincodes(chaincodes []*proto.Chaincode, chai
 	gc.UpdateChaincodes(chaincodes)
 }
 
+// OpenStateTransferStream opens a dedicated stream to a remote peer,
+// over which blocks are requested in bulk
+func (g *gossipServiceImpl) OpenStateTransferStream(peer *comm.RemotePeer) (comm.StateTransferStream, error) {
+	return g.comm.OpenStateTransferStream(peer)
+}
+
 // Accept returns a dedicated read-only channel for messages sent by other nodes that match a certain predicate.
 // If passThrough is false, the messages are processed by the gossip layer beforehand.
 // If passThrough is true, the gossip layer doesn't intervene and the messages
@@ -50,6 +50,7 @@ var tests = []func(t *testing.T){
 	TestMembershipRequestSpoofing,
 	TestDataLeakage,
 	TestLeaveChannel,
+	TestMembershipSnapshot,
 	// TestDisseminateAll2All: {},
 	TestIdentityExpiration,
 	TestSendByCriteria,
@@ -399,6 +400,58 @@ func TestLeaveChannel(t *testing.T) {
 
 }
 
+func TestMembershipSnapshot(t *testing.T) {
+	t.Parallel()
+	defer testWG.Done()
+	// Scenario: Have 2 peers in a channel, and a third peer that isn't in it.
+	// Ensure the membership snapshot of the first peer reflects the channel membership,
+	// and that the third peer is reported dead after it stops.
+
+	port0, grpc0, certs0, secDialOpts0, _ := util.CreateGRPCLayer()
+	port1, grpc1, certs1, secDialOpts1, _ := util.CreateGRPCLayer()
+	port2, grpc2, certs2, secDialOpts2, _ := util.CreateGRPCLayer()
+
+	p0 := newGossipInstanceWithGRPC(0, port0, grpc0, certs0, secDialOpts0, 100, port1)
+	p0.JoinChan(&joinChanMsg{}, common.ChainID("A"))
+	p0.UpdateLedgerHeight(1, common.ChainID("A"))
+	defer p0.Stop()
+
+	p1 := newGossipInstanceWithGRPC(1, port1, grpc1, certs1, secDialOpts1, 100, port0)
+	p1.JoinChan(&joinChanMsg{}, common.ChainID("A"))
+	p1.UpdateLedgerHeight(5, common.ChainID("A"))
+	defer p1.Stop()
+
+	p2 := newGossipInstanceWithGRPC(2, port2, grpc2, certs2, secDialOpts2, 100, port0)
+
+	waitUntilOrFail(t, func() bool {
+		return len(p0.PeersOfChannel(common.ChainID("A"))) == 1 && len(p0.Peers()) == 2
+	}, "waiting for p0 to form membership")
+
+	snapshot := p0.MembershipSnapshot()
+	assert.Equal(t, p0.SelfMembershipInfo().PKIid, snapshot.Self.PKIid)
+	assert.Len(t, snapshot.Members, 2)
+	for _, member := range snapshot.Members {
+		assert.True(t, member.Alive)
+		assert.False(t, member.LastSeen.IsZero())
+		assert.NotZero(t, member.MessagesReceived)
+	}
+	assert.Len(t, snapshot.Channels, 1)
+	assert.Len(t, snapshot.Channels["A"], 1)
+	assert.Equal(t, p1.SelfMembershipInfo().PKIid, snapshot.Channels["A"][0].PKIid)
+	assert.Equal(t, uint64(5), snapshot.Channels["A"][0].Properties.LedgerHeight)
+
+	p2ID := p2.SelfMembershipInfo().PKIid
+	p2.Stop()
+	waitUntilOrFail(t, func() bool {
+		for _, member := range p0.MembershipSnapshot().Members {
+			if bytes.Equal(member.PKIid, p2ID) {
+				return !member.Alive
+			}
+		}
+		return false
+	}, "waiting for p0 to consider p2 dead")
+}
+
 func TestPull(t *testing.T) {
 	t.Parallel()
 	defer testWG.Done()
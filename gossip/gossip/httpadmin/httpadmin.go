@@ -0,0 +1,136 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package httpadmin
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/gossip/discovery"
+	"github.com/hyperledger/fabric/gossip/gossip"
+)
+
+// URLBase is the path under which the handler serves gossip membership requests.
+const URLBase = "/gossip/membership"
+
+//go:generate counterfeiter -o fakes/membership.go -fake-name Membership . Membership
+
+// Membership provides the membership view of the gossip component of the peer.
+type Membership interface {
+	MembershipSnapshot() gossip.MembershipSnapshot
+}
+
+type MembershipView struct {
+	Self     Peer              `json:"self"`
+	Members  []Member          `json:"members"`
+	Channels map[string][]Peer `json:"channels"`
+}
+
+type Peer struct {
+	PKIID            string `json:"pki_id"`
+	Endpoint         string `json:"endpoint"`
+	InternalEndpoint string `json:"internal_endpoint,omitempty"`
+	LedgerHeight     uint64 `json:"ledger_height,omitempty"`
+}
+
+type Member struct {
+	Peer
+	Alive            bool      `json:"alive"`
+	LastSeen         time.Time `json:"last_seen"`
+	MessagesReceived uint64    `json:"messages_received"`
+	MessageRate      float64   `json:"message_rate"`
+}
+
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+func NewHandler(m Membership) *Handler {
+	return &Handler{
+		Membership: m,
+		Logger:     flogging.MustGetLogger("gossip.httpadmin"),
+	}
+}
+
+// Handler serves the gossip membership endpoint of the operations system.
+//
+// GET /gossip/membership returns the alive and dead members known to the peer,
+// the rates of messages received from them, and the members of each channel.
+type Handler struct {
+	Membership Membership
+	Logger     *flogging.FabricLogger
+}
+
+func (h *Handler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		err := fmt.Errorf("invalid request method: %s", req.Method)
+		h.sendResponse(resp, http.StatusBadRequest, err)
+		return
+	}
+
+	snapshot := h.Membership.MembershipSnapshot()
+	view := &MembershipView{
+		Self:     newPeer(snapshot.Self),
+		Members:  []Member{},
+		Channels: make(map[string][]Peer),
+	}
+	for _, m := range snapshot.Members {
+		view.Members = append(view.Members, Member{
+			Peer:             newPeer(m.NetworkMember),
+			Alive:            m.Alive,
+			LastSeen:         m.LastSeen,
+			MessagesReceived: m.MessagesReceived,
+			MessageRate:      m.MessageRate,
+		})
+	}
+	sort.Slice(view.Members, func(i, j int) bool {
+		return view.Members[i].PKIID < view.Members[j].PKIID
+	})
+	for channelID, members := range snapshot.Channels {
+		peers := []Peer{}
+		for _, m := range members {
+			peers = append(peers, newPeer(m))
+		}
+		sort.Slice(peers, func(i, j int) bool {
+			return peers[i].PKIID < peers[j].PKIID
+		})
+		view.Channels[channelID] = peers
+	}
+
+	h.sendResponse(resp, http.StatusOK, view)
+}
+
+func newPeer(member discovery.NetworkMember) Peer {
+	peer := Peer{
+		PKIID:            hex.EncodeToString(member.PKIid),
+		Endpoint:         member.Endpoint,
+		InternalEndpoint: member.InternalEndpoint,
+	}
+	if member.Properties != nil {
+		peer.LedgerHeight = member.Properties.LedgerHeight
+	}
+	return peer
+}
+
+func (h *Handler) sendResponse(resp http.ResponseWriter, code int, payload interface{}) {
+	encoder := json.NewEncoder(resp)
+	if err, ok := payload.(error); ok {
+		payload = &ErrorResponse{Error: err.Error()}
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(code)
+
+	if err := encoder.Encode(payload); err != nil {
+		h.Logger.Errorw("failed to encode payload", "error", err)
+	}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package httpadmin_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/gossip/discovery"
+	"github.com/hyperledger/fabric/gossip/gossip"
+	"github.com/hyperledger/fabric/gossip/gossip/httpadmin"
+	"github.com/hyperledger/fabric/gossip/gossip/httpadmin/fakes"
+	proto "github.com/hyperledger/fabric/protos/gossip"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		fakeMembership *fakes.Membership
+		handler        *httpadmin.Handler
+	)
+
+	BeforeEach(func() {
+		p1 := discovery.NetworkMember{PKIid: []byte{1}, Endpoint: "p1:7051", InternalEndpoint: "p1.internal:7051"}
+		p2 := discovery.NetworkMember{PKIid: []byte{2}, Endpoint: "p2:7051"}
+		fakeMembership = &fakes.Membership{}
+		fakeMembership.MembershipSnapshotReturns(gossip.MembershipSnapshot{
+			Self: discovery.NetworkMember{PKIid: []byte{0}, Endpoint: "p0:7051"},
+			Members: []gossip.MemberSnapshot{
+				{
+					MemberState:      discovery.MemberState{NetworkMember: p2, LastSeen: time.Unix(0, 0).UTC()},
+					MessagesReceived: 3,
+				},
+				{
+					MemberState:      discovery.MemberState{NetworkMember: p1, Alive: true, LastSeen: time.Unix(60, 0).UTC()},
+					MessagesReceived: 100,
+					MessageRate:      2.5,
+				},
+			},
+			Channels: map[string][]discovery.NetworkMember{
+				"mychannel": {
+					{PKIid: []byte{1}, Endpoint: "p1:7051", Properties: &proto.Properties{LedgerHeight: 10}},
+				},
+			},
+		})
+		handler = &httpadmin.Handler{
+			Membership: fakeMembership,
+			Logger:     flogging.NewFabricLogger(flogging.NewZapLogger(nil)),
+		}
+	})
+
+	It("returns the membership view of the peer", func() {
+		req := httptest.NewRequest("GET", "/gossip/membership", nil)
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		Expect(fakeMembership.MembershipSnapshotCallCount()).To(Equal(1))
+		Expect(resp.Header().Get("Content-Type")).To(Equal("application/json"))
+		Expect(resp.Body).To(MatchJSON(`{
+			"self": {"pki_id": "00", "endpoint": "p0:7051"},
+			"members": [
+				{
+					"pki_id": "01", "endpoint": "p1:7051", "internal_endpoint": "p1.internal:7051",
+					"alive": true, "last_seen": "1970-01-01T00:01:00Z", "messages_received": 100, "message_rate": 2.5
+				},
+				{
+					"pki_id": "02", "endpoint": "p2:7051",
+					"alive": false, "last_seen": "1970-01-01T00:00:00Z", "messages_received": 3, "message_rate": 0
+				}
+			],
+			"channels": {
+				"mychannel": [{"pki_id": "01", "endpoint": "p1:7051", "ledger_height": 10}]
+			}
+		}`))
+	})
+
+	Context("when the peer knows no members", func() {
+		BeforeEach(func() {
+			fakeMembership.MembershipSnapshotReturns(gossip.MembershipSnapshot{
+				Self: discovery.NetworkMember{PKIid: []byte{0}, Endpoint: "p0:7051"},
+			})
+		})
+
+		It("returns an empty membership view", func() {
+			req := httptest.NewRequest("GET", "/gossip/membership", nil)
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			Expect(resp.Body).To(MatchJSON(`{"self": {"pki_id": "00", "endpoint": "p0:7051"}, "members": [], "channels": {}}`))
+		})
+	})
+
+	Context("when the request method is unsupported", func() {
+		It("responds with bad request", func() {
+			req := httptest.NewRequest("PUT", "/gossip/membership", nil)
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+			Expect(resp.Body).To(MatchJSON(`{"error": "invalid request method: PUT"}`))
+			Expect(fakeMembership.MembershipSnapshotCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,136 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package gossip
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric/gossip/common"
+	"github.com/hyperledger/fabric/gossip/discovery"
+)
+
+// msgRateWindow is the period over which the message rates of peers are computed
+const msgRateWindow = 10 * time.Second
+
+// MembershipSnapshot is a point in time view of the membership of the gossip instance
+type MembershipSnapshot struct {
+	Self     discovery.NetworkMember
+	Members  []MemberSnapshot
+	Channels map[string][]discovery.NetworkMember
+}
+
+// MemberSnapshot describes a member of the view, and the messages received from it
+type MemberSnapshot struct {
+	discovery.MemberState
+	MessagesReceived uint64  // Total count of messages received from the member
+	MessageRate      float64 // Messages received per second from the member, during the last rate window
+}
+
+// MembershipSnapshot returns a point in time view of the alive and dead members,
+// and of the members of each channel the peer joined
+func (g *gossipServiceImpl) MembershipSnapshot() MembershipSnapshot {
+	snapshot := MembershipSnapshot{
+		Self:     g.selfNetworkMember(),
+		Channels: make(map[string][]discovery.NetworkMember),
+	}
+
+	known := make(map[string]struct{})
+	for _, member := range g.disc.MembershipSnapshot() {
+		known[string(member.PKIid)] = struct{}{}
+		total, rate := g.msgCounter.get(member.PKIid)
+		snapshot.Members = append(snapshot.Members, MemberSnapshot{
+			MemberState:      member,
+			MessagesReceived: total,
+			MessageRate:      rate,
+		})
+	}
+	// Forget about peers that were purged from the membership
+	g.msgCounter.retain(known)
+
+	g.chanState.RLock()
+	defer g.chanState.RUnlock()
+	for chainID, gc := range g.chanState.channels {
+		snapshot.Channels[chainID] = gc.GetPeers()
+	}
+	return snapshot
+}
+
+// msgCounter counts the messages received from each peer
+type msgCounter struct {
+	now    func() time.Time
+	lock   sync.Mutex
+	counts map[string]*peerMsgCount
+}
+
+type peerMsgCount struct {
+	total       uint64
+	windowStart time.Time
+	windowCount uint64
+	rate        float64
+}
+
+func newMsgCounter() *msgCounter {
+	return &msgCounter{
+		now:    time.Now,
+		counts: make(map[string]*peerMsgCount),
+	}
+}
+
+// add counts a message received from the given peer
+func (mc *msgCounter) add(pkiID common.PKIidType) {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
+	count, exists := mc.counts[string(pkiID)]
+	if !exists {
+		count = &peerMsgCount{windowStart: mc.now()}
+		mc.counts[string(pkiID)] = count
+	}
+	mc.roll(count)
+	count.total++
+	count.windowCount++
+}
+
+// get returns the total count of messages received from the given peer,
+// and the rate of messages received from it during the last window
+func (mc *msgCounter) get(pkiID common.PKIidType) (uint64, float64) {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
+	count, exists := mc.counts[string(pkiID)]
+	if !exists {
+		return 0, 0
+	}
+	mc.roll(count)
+	return count.total, count.rate
+}
+
+// retain forgets about all peers but the given ones
+func (mc *msgCounter) retain(pkiIDs map[string]struct{}) {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
+	for pkiID := range mc.counts {
+		if _, exists := pkiIDs[pkiID]; !exists {
+			delete(mc.counts, pkiID)
+		}
+	}
+}
+
+// roll computes the rate of the current window once it has elapsed, and starts a new one.
+// It must be called with the lock held.
+func (mc *msgCounter) roll(count *peerMsgCount) {
+	now := mc.now()
+	elapsed := now.Sub(count.windowStart)
+	if elapsed < msgRateWindow {
+		return
+	}
+	count.rate = float64(count.windowCount) / elapsed.Seconds()
+	count.windowCount = 0
+	count.windowStart = now
+}
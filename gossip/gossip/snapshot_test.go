@@ -0,0 +1,54 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package gossip
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/gossip/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMsgCounter(t *testing.T) {
+	now := time.Unix(0, 0)
+	mc := newMsgCounter()
+	mc.now = func() time.Time { return now }
+
+	p1, p2 := common.PKIidType("p1"), common.PKIidType("p2")
+	for i := 0; i < 20; i++ {
+		mc.add(p1)
+	}
+	mc.add(p2)
+
+	// The rate isn't known until the first window elapses
+	total, rate := mc.get(p1)
+	assert.Equal(t, uint64(20), total)
+	assert.Equal(t, float64(0), rate)
+
+	now = now.Add(msgRateWindow)
+	total, rate = mc.get(p1)
+	assert.Equal(t, uint64(20), total)
+	assert.Equal(t, float64(2), rate)
+
+	// The rate decreases once no messages are received during a window
+	now = now.Add(msgRateWindow)
+	total, rate = mc.get(p1)
+	assert.Equal(t, uint64(20), total)
+	assert.Equal(t, float64(0), rate)
+
+	// Unknown and forgotten peers have no messages
+	total, rate = mc.get(common.PKIidType("p3"))
+	assert.Equal(t, uint64(0), total)
+	assert.Equal(t, float64(0), rate)
+
+	mc.retain(map[string]struct{}{string(p1): {}})
+	total, _ = mc.get(p2)
+	assert.Equal(t, uint64(0), total)
+	total, _ = mc.get(p1)
+	assert.Equal(t, uint64(20), total)
+}
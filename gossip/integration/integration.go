@@ -7,10 +7,12 @@ SPDX-License-Identifier: Apache-2.0
 package integration
 
 import (
+	"bytes"
 	"net"
 	"strconv"
 	"time"
 
+	corecomm "github.com/hyperledger/fabric/core/comm"
 	"github.com/hyperledger/fabric/gossip/api"
 	"github.com/hyperledger/fabric/gossip/comm"
 	"github.com/hyperledger/fabric/gossip/common"
@@ -39,6 +41,11 @@ func newConfig(selfEndpoint string, externalEndpoint string, certs *common.TLSCe
 		return nil, errors.Wrapf(err, "misconfigured endpoint %s, failed to parse port number", selfEndpoint)
 	}
 
+	overrides, err := endpointOverrides()
+	if err != nil {
+		return nil, err
+	}
+
 	conf := &gossip.Config{
 		BindPort:                   int(port),
 		BootstrapPeers:             bootPeers,
@@ -65,6 +72,9 @@ func newConfig(selfEndpoint string, externalEndpoint string, certs *common.TLSCe
 		ConnTimeout:                util.GetDurationOrDefault("peer.gossip.connTimeout", comm.DefConnTimeout),
 		RecvBuffSize:               util.GetIntOrDefault("peer.gossip.recvBuffSize", comm.DefRecvBuffSize),
 		SendBuffSize:               util.GetIntOrDefault("peer.gossip.sendBuffSize", comm.DefSendBuffSize),
+		ChannelBandwidthLimit:      viper.GetInt("peer.gossip.channelBandwidthLimit"),
+		EndpointOverrides:          overrides,
+		IdleConnTimeout:            viper.GetDuration("peer.gossip.idleConnTimeout"),
 		MsgExpirationTimeout:       util.GetDurationOrDefault("peer.gossip.election.leaderAliveThreshold", election.DefLeaderAliveThreshold) * 10,
 		AliveTimeInterval:          util.GetDurationOrDefault("peer.gossip.aliveTimeInterval", discovery.DefAliveTimeInterval),
 	}
@@ -76,6 +86,27 @@ func newConfig(selfEndpoint string, externalEndpoint string, certs *common.TLSCe
 	return conf, nil
 }
 
+// endpointOverrides loads the overrides of endpoints advertised by peers of other organizations
+func endpointOverrides() (comm.EndpointOverrides, error) {
+	var entries []struct {
+		Org  string
+		From string
+		To   string
+	}
+	if err := viper.UnmarshalKey("peer.gossip.endpointOverrides", &entries); err != nil {
+		return nil, errors.Wrap(err, "failed parsing peer.gossip.endpointOverrides")
+	}
+
+	overrides := comm.EndpointOverrides{}
+	for _, entry := range entries {
+		if entry.Org == "" || entry.From == "" || entry.To == "" {
+			return nil, errors.Errorf("misconfigured endpoint override %+v, org, from and to must be set", entry)
+		}
+		overrides.Add(entry.Org, entry.From, entry.To)
+	}
+	return overrides, nil
+}
+
 // NewGossipComponent creates a gossip component that attaches itself to the given gRPC server
 func NewGossipComponent(peerIdentity []byte, endpoint string, s *grpc.Server,
 	secAdv api.SecurityAdvisor, cryptSvc api.MessageCryptoService,
@@ -88,8 +119,29 @@ func NewGossipComponent(peerIdentity []byte, endpoint string, s *grpc.Server,
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
+	if certs != nil && corecomm.GetCredentialSupport().OrgCAPinning() {
+		conf.OrgTLSCertVerifier = orgTLSCertVerifier(secAdv.OrgByPeerIdentity(peerIdentity))
+	}
 	gossipInstance := gossip.NewGossipService(conf, s, secAdv, cryptSvc,
 		peerIdentity, secureDialOpts, gossipMetrics)
 
 	return gossipInstance, nil
 }
+
+// orgTLSCertVerifier returns a function that verifies the TLS certificates of
+// peers against the TLS CAs of their organization in the channels the peer
+// joined. Those of peers of its own organization may also be issued by its
+// statically configured TLS root CAs, so that it can reach them before
+// joining channels.
+func orgTLSCertVerifier(selfOrg api.OrgIdentityType) func(api.OrgIdentityType, [][]byte) error {
+	return func(org api.OrgIdentityType, rawCerts [][]byte) error {
+		cs := corecomm.GetCredentialSupport()
+		rootCAs := cs.AppOrgRootCAs(string(org))
+		if bytes.Equal(org, selfOrg) {
+			cs.RLock()
+			rootCAs = append(rootCAs, cs.ServerRootCAs...)
+			cs.RUnlock()
+		}
+		return corecomm.VerifyCertificateOfOrg(rawCerts, rootCAs)
+	}
+}
@@ -72,6 +72,26 @@ func TestNewGossipCryptoService(t *testing.T) {
 	go s3.Serve(ll3)
 }
 
+func TestEndpointOverrides(t *testing.T) {
+	defer viper.Reset()
+
+	viper.Set("peer.gossip.endpointOverrides", []map[string]interface{}{
+		{"org": "Org2MSP", "from": "peer0.org2.example.com:7051", "to": "10.0.2.15:7051"},
+		{"org": "Org2MSP", "from": "peer1.org2.example.com:7051", "to": "10.0.2.16:7051"},
+	})
+	conf, err := newConfig("localhost:7051", "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.2.15:7051", conf.EndpointOverrides.Resolve(api.OrgIdentityType("Org2MSP"), "peer0.org2.example.com:7051"))
+	assert.Equal(t, "10.0.2.16:7051", conf.EndpointOverrides.Resolve(api.OrgIdentityType("Org2MSP"), "peer1.org2.example.com:7051"))
+	assert.Equal(t, "peer0.org2.example.com:7051", conf.EndpointOverrides.Resolve(api.OrgIdentityType("Org3MSP"), "peer0.org2.example.com:7051"))
+
+	viper.Set("peer.gossip.endpointOverrides", []map[string]interface{}{
+		{"org": "Org2MSP", "from": "peer0.org2.example.com:7051"},
+	})
+	_, err = newConfig("localhost:7051", "", nil)
+	assert.Contains(t, err.Error(), "misconfigured endpoint override")
+}
+
 func setupTestEnv() {
 	viper.SetConfigName("core")
 	viper.SetEnvPrefix("CORE")
@@ -95,16 +95,28 @@ var (
 
 // CommMetrics encapsulates gossip communication related metrics
 type CommMetrics struct {
-	SentMessages     metrics.Counter
-	BufferOverflow   metrics.Counter
-	ReceivedMessages metrics.Counter
+	SentMessages          metrics.Counter
+	BufferOverflow        metrics.Counter
+	ReceivedMessages      metrics.Counter
+	ThrottledMessages     metrics.Counter
+	Dials                 metrics.Counter
+	DialFailures          metrics.Counter
+	DialDuration          metrics.Histogram
+	ResumedTLSSessions    metrics.Counter
+	IdleConnectionsClosed metrics.Counter
 }
 
 func newCommMetrics(p metrics.Provider) *CommMetrics {
 	return &CommMetrics{
-		SentMessages:     p.NewCounter(SentMessagesOpts),
-		BufferOverflow:   p.NewCounter(BufferOverflowOpts),
-		ReceivedMessages: p.NewCounter(ReceivedMessagesOpts),
+		SentMessages:          p.NewCounter(SentMessagesOpts),
+		BufferOverflow:        p.NewCounter(BufferOverflowOpts),
+		ReceivedMessages:      p.NewCounter(ReceivedMessagesOpts),
+		ThrottledMessages:     p.NewCounter(ThrottledMessagesOpts),
+		Dials:                 p.NewCounter(DialsOpts),
+		DialFailures:          p.NewCounter(DialFailuresOpts),
+		DialDuration:          p.NewHistogram(DialDurationOpts),
+		ResumedTLSSessions:    p.NewCounter(ResumedTLSSessionsOpts),
+		IdleConnectionsClosed: p.NewCounter(IdleConnectionsClosedOpts),
 	}
 }
 
@@ -132,6 +144,54 @@ var (
 		Help:         "Number of messages received",
 		StatsdFormat: "%{#fqname}",
 	}
+
+	ThrottledMessagesOpts = metrics.CounterOpts{
+		Namespace:    "gossip",
+		Subsystem:    "comm",
+		Name:         "messages_throttled",
+		Help:         "Number of outgoing messages dropped due to the bandwidth budget of their channel",
+		StatsdFormat: "%{#fqname}",
+	}
+
+	DialsOpts = metrics.CounterOpts{
+		Namespace:    "gossip",
+		Subsystem:    "comm",
+		Name:         "dials",
+		Help:         "Number of connections dialed to remote peers",
+		StatsdFormat: "%{#fqname}",
+	}
+
+	DialFailuresOpts = metrics.CounterOpts{
+		Namespace:    "gossip",
+		Subsystem:    "comm",
+		Name:         "dial_failures",
+		Help:         "Number of connections to remote peers that failed to be dialed",
+		StatsdFormat: "%{#fqname}",
+	}
+
+	DialDurationOpts = metrics.HistogramOpts{
+		Namespace:    "gossip",
+		Subsystem:    "comm",
+		Name:         "dial_duration",
+		Help:         "Time it takes to dial and ping a remote peer in seconds",
+		StatsdFormat: "%{#fqname}",
+	}
+
+	ResumedTLSSessionsOpts = metrics.CounterOpts{
+		Namespace:    "gossip",
+		Subsystem:    "comm",
+		Name:         "tls_sessions_resumed",
+		Help:         "Number of connections dialed to remote peers that resumed a previous TLS session",
+		StatsdFormat: "%{#fqname}",
+	}
+
+	IdleConnectionsClosedOpts = metrics.CounterOpts{
+		Namespace:    "gossip",
+		Subsystem:    "comm",
+		Name:         "idle_connections_closed",
+		Help:         "Number of connections closed because no messages were sent or received through them for the idle timeout",
+		StatsdFormat: "%{#fqname}",
+	}
 )
 
 // MembershipMetrics encapsulates gossip channel membership related metrics
@@ -167,6 +227,8 @@ type PrivdataMetrics struct {
 	ReconciliationDuration         metrics.Histogram
 	PullDuration                   metrics.Histogram
 	RetrieveDuration               metrics.Histogram
+	PushRetries                    metrics.Counter
+	PushFailures                   metrics.Counter
 }
 
 func newPrivdataMetrics(p metrics.Provider) *PrivdataMetrics {
@@ -180,6 +242,8 @@ func newPrivdataMetrics(p metrics.Provider) *PrivdataMetrics {
 		ReconciliationDuration:         p.NewHistogram(ReconciliationDurationOpts),
 		PullDuration:                   p.NewHistogram(PullDurationOpts),
 		RetrieveDuration:               p.NewHistogram(RetrieveDurationOpts),
+		PushRetries:                    p.NewCounter(PushRetriesOpts),
+		PushFailures:                   p.NewCounter(PushFailuresOpts),
 	}
 }
 
@@ -264,4 +328,22 @@ var (
 		LabelNames:   []string{"channel"},
 		StatsdFormat: "%{#fqname}.%{channel}",
 	}
+
+	PushRetriesOpts = metrics.CounterOpts{
+		Namespace:    "gossip",
+		Subsystem:    "privdata",
+		Name:         "push_retries",
+		Help:         "Number of times private data was pushed again after not being acknowledged by the required peers",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
+
+	PushFailuresOpts = metrics.CounterOpts{
+		Namespace:    "gossip",
+		Subsystem:    "privdata",
+		Name:         "push_failures",
+		Help:         "Number of private data pushes which were not acknowledged by the required peers",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
 )
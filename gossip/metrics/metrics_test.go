@@ -42,6 +42,12 @@ func TestMetrics(t *testing.T) {
 	assert.NotNil(t, gossipMetrics.CommMetrics.SentMessages)
 	assert.NotNil(t, gossipMetrics.CommMetrics.ReceivedMessages)
 	assert.NotNil(t, gossipMetrics.CommMetrics.BufferOverflow)
+	assert.NotNil(t, gossipMetrics.CommMetrics.ThrottledMessages)
+	assert.NotNil(t, gossipMetrics.CommMetrics.Dials)
+	assert.NotNil(t, gossipMetrics.CommMetrics.DialFailures)
+	assert.NotNil(t, gossipMetrics.CommMetrics.DialDuration)
+	assert.NotNil(t, gossipMetrics.CommMetrics.ResumedTLSSessions)
+	assert.NotNil(t, gossipMetrics.CommMetrics.IdleConnectionsClosed)
 
 	assert.NotNil(t, gossipMetrics.MembershipMetrics)
 	assert.NotNil(t, gossipMetrics.MembershipMetrics.Total)
@@ -56,4 +62,6 @@ func TestMetrics(t *testing.T) {
 	assert.NotNil(t, gossipMetrics.PrivdataMetrics.ReconciliationDuration)
 	assert.NotNil(t, gossipMetrics.PrivdataMetrics.PullDuration)
 	assert.NotNil(t, gossipMetrics.PrivdataMetrics.RetrieveDuration)
+	assert.NotNil(t, gossipMetrics.PrivdataMetrics.PushRetries)
+	assert.NotNil(t, gossipMetrics.PrivdataMetrics.PushFailures)
 }
@@ -21,9 +21,16 @@ type TestMetricProvider struct {
 
 	FakeDeclarationGauge *metricsfakes.Gauge
 
-	FakeSentMessages     *metricsfakes.Counter
-	FakeBufferOverflow   *metricsfakes.Counter
-	FakeReceivedMessages *metricsfakes.Counter
+	FakeSentMessages      *metricsfakes.Counter
+	FakeBufferOverflow    *metricsfakes.Counter
+	FakeReceivedMessages  *metricsfakes.Counter
+	FakeThrottledMessages *metricsfakes.Counter
+
+	FakeDials                 *metricsfakes.Counter
+	FakeDialFailures          *metricsfakes.Counter
+	FakeDialDuration          *metricsfakes.Histogram
+	FakeResumedTLSSessions    *metricsfakes.Counter
+	FakeIdleConnectionsClosed *metricsfakes.Counter
 
 	FakeTotalGauge *metricsfakes.Gauge
 
@@ -36,6 +43,8 @@ type TestMetricProvider struct {
 	FakeReconciliationDuration         *metricsfakes.Histogram
 	FakePullDuration                   *metricsfakes.Histogram
 	FakeRetrieveDuration               *metricsfakes.Histogram
+	FakePushRetries                    *metricsfakes.Counter
+	FakePushFailures                   *metricsfakes.Counter
 }
 
 func TestUtilConstructMetricProvider() *TestMetricProvider {
@@ -50,6 +59,13 @@ func TestUtilConstructMetricProvider() *TestMetricProvider {
 	fakeSentMessages := testUtilConstructCounter()
 	fakeBufferOverflow := testUtilConstructCounter()
 	fakeReceivedMessages := testUtilConstructCounter()
+	fakeThrottledMessages := testUtilConstructCounter()
+
+	fakeDials := testUtilConstructCounter()
+	fakeDialFailures := testUtilConstructCounter()
+	fakeDialDuration := testUtilConstructHist()
+	fakeResumedTLSSessions := testUtilConstructCounter()
+	fakeIdleConnectionsClosed := testUtilConstructCounter()
 
 	fakeTotalGauge := testUtilConstructGauge()
 
@@ -62,6 +78,8 @@ func TestUtilConstructMetricProvider() *TestMetricProvider {
 	fakeReconciliationDuration := testUtilConstructHist()
 	fakePullDuration := testUtilConstructHist()
 	fakeRetrieveDuration := testUtilConstructHist()
+	fakePushRetries := testUtilConstructCounter()
+	fakePushFailures := testUtilConstructCounter()
 
 	fakeProvider.NewCounterStub = func(opts metrics.CounterOpts) metrics.Counter {
 		switch opts.Name {
@@ -71,6 +89,20 @@ func TestUtilConstructMetricProvider() *TestMetricProvider {
 			return fakeSentMessages
 		case gmetrics.ReceivedMessagesOpts.Name:
 			return fakeReceivedMessages
+		case gmetrics.ThrottledMessagesOpts.Name:
+			return fakeThrottledMessages
+		case gmetrics.DialsOpts.Name:
+			return fakeDials
+		case gmetrics.DialFailuresOpts.Name:
+			return fakeDialFailures
+		case gmetrics.ResumedTLSSessionsOpts.Name:
+			return fakeResumedTLSSessions
+		case gmetrics.IdleConnectionsClosedOpts.Name:
+			return fakeIdleConnectionsClosed
+		case gmetrics.PushRetriesOpts.Name:
+			return fakePushRetries
+		case gmetrics.PushFailuresOpts.Name:
+			return fakePushFailures
 		}
 		return nil
 	}
@@ -78,6 +110,8 @@ func TestUtilConstructMetricProvider() *TestMetricProvider {
 		switch opts.Name {
 		case gmetrics.CommitDurationOpts.Name:
 			return fakeCommitDurationHist
+		case gmetrics.DialDurationOpts.Name:
+			return fakeDialDuration
 		case gmetrics.ValidationDurationOpts.Name:
 			return fakeValidationDuration
 		case gmetrics.ListMissingPrivateDataDurationOpts.Name:
@@ -122,6 +156,12 @@ func TestUtilConstructMetricProvider() *TestMetricProvider {
 		fakeSentMessages,
 		fakeBufferOverflow,
 		fakeReceivedMessages,
+		fakeThrottledMessages,
+		fakeDials,
+		fakeDialFailures,
+		fakeDialDuration,
+		fakeResumedTLSSessions,
+		fakeIdleConnectionsClosed,
 		fakeTotalGauge,
 		fakeValidationDuration,
 		fakeListMissingPrivateDataDuration,
@@ -132,6 +172,8 @@ func TestUtilConstructMetricProvider() *TestMetricProvider {
 		fakeReconciliationDuration,
 		fakePullDuration,
 		fakeRetrieveDuration,
+		fakePushRetries,
+		fakePushFailures,
 	}
 }
 
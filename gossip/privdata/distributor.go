@@ -69,8 +69,29 @@ type distributorImpl struct {
 	chainID string
 	gossipAdapter
 	CollectionAccessFactory
-	pushAckTimeout time.Duration
-	metrics        *metrics.PrivdataMetrics
+	config  DistributorConfig
+	metrics *metrics.PrivdataMetrics
+}
+
+// DistributorConfig defines how the private data of the transactions of a
+// channel is pushed to other peers at endorsement time
+type DistributorConfig struct {
+	// PushAckTimeout is the time to wait for the acknowledgement of each
+	// push of private data
+	PushAckTimeout time.Duration
+	// PushAckRetries is the number of times private data is pushed to other
+	// eligible peers, when the peers required by the collection didn't
+	// acknowledge it within PushAckTimeout
+	PushAckRetries int
+	// ClampRequiredPeerCount lowers the requiredPeerCount of the collections
+	// to the number of eligible peers of the channel that are alive, so that
+	// endorsements do not fail while too few of them are up
+	ClampRequiredPeerCount bool
+	// DisablePush makes the peers that did not endorse a transaction pull
+	// its private data at commit time, instead of having it pushed to their
+	// transient store at endorsement time. The requiredPeerCount of the
+	// collections is then not enforced.
+	DisablePush bool
 }
 
 // CollectionAccessFactory an interface to generate collection access policy
@@ -106,20 +127,27 @@ func NewCollectionAccessFactory(factory IdentityDeserializerFactory) CollectionA
 }
 
 // NewDistributor a constructor for private data distributor capable to send
-// private read write sets for underlying collection
+// private read write sets for underlying collection.
+// Private data which isn't acknowledged by the peers required by the collection
+// within config.PushAckTimeout is pushed to other eligible peers, up to
+// config.PushAckRetries times.
 func NewDistributor(chainID string, gossip gossipAdapter, factory CollectionAccessFactory,
-	metrics *metrics.PrivdataMetrics, pushAckTimeout time.Duration) PvtDataDistributor {
+	metrics *metrics.PrivdataMetrics, config DistributorConfig) PvtDataDistributor {
 	return &distributorImpl{
 		chainID:                 chainID,
 		gossipAdapter:           gossip,
 		CollectionAccessFactory: factory,
-		pushAckTimeout:          pushAckTimeout,
+		config:                  config,
 		metrics:                 metrics,
 	}
 }
 
 // Distribute broadcast reliably private data read write set based on policies
 func (d *distributorImpl) Distribute(txID string, privData *transientstore.TxPvtReadWriteSetWithConfigInfo, blkHt uint64) error {
+	if d.config.DisablePush {
+		logger.Debugf("[%s] Not pushing the private data of transaction %s, as pushes are disabled", d.chainID, txID)
+		return nil
+	}
 	disseminationPlan, err := d.computeDisseminationPlan(txID, privData, blkHt)
 	if err != nil {
 		return errors.WithStack(err)
@@ -130,6 +158,9 @@ func (d *distributorImpl) Distribute(txID string, privData *transientstore.TxPvt
 type dissemination struct {
 	msg      *protoext.SignedGossipMessage
 	criteria gossip2.SendCriteria
+	// fallbacks are the criteria to send the message by, in turn,
+	// as long as the required acknowledgements weren't collected
+	fallbacks []gossip2.SendCriteria
 }
 
 func (d *distributorImpl) computeDisseminationPlan(txID string,
@@ -213,6 +244,10 @@ func (d *distributorImpl) disseminationPlanForMsg(colAP privdata.CollectionAcces
 	// Select one representative from each org
 	maximumPeerCount := colAP.MaximumPeerCount()
 	requiredPeerCount := colAP.RequiredPeerCount()
+	if d.config.ClampRequiredPeerCount && requiredPeerCount > len(eligiblePeers) {
+		logger.Debugf("[%s] Clamping the required peer count %d to the %d eligible peers alive", d.chainID, requiredPeerCount, len(eligiblePeers))
+		requiredPeerCount = len(eligiblePeers)
+	}
 
 	if maximumPeerCount > 0 {
 		for _, selectionPeers := range identitySets {
@@ -220,18 +255,27 @@ func (d *distributorImpl) disseminationPlanForMsg(colAP privdata.CollectionAcces
 			if requiredPeerCount == 0 {
 				required = 0
 			}
-			peer2SendPerOrg := selectionPeers[rand.Intn(len(selectionPeers))]
-			sc := gossip2.SendCriteria{
-				Timeout:  d.pushAckTimeout,
-				Channel:  gossipCommon.ChainID(d.chainID),
-				MaxPeers: 1,
-				MinAck:   required,
-				IsEligible: func(member discovery.NetworkMember) bool {
-					return bytes.Equal(member.PKIid, peer2SendPerOrg.PKIId)
-				},
+			// Select a random peer of the org, followed by other peers of
+			// the org to push to if it doesn't acknowledge the private data
+			var criteria []gossip2.SendCriteria
+			for _, i := range rand.Perm(len(selectionPeers)) {
+				peer2SendPerOrg := selectionPeers[i]
+				criteria = append(criteria, gossip2.SendCriteria{
+					Timeout:  d.config.PushAckTimeout,
+					Channel:  gossipCommon.ChainID(d.chainID),
+					MaxPeers: 1,
+					MinAck:   required,
+					IsEligible: func(member discovery.NetworkMember) bool {
+						return bytes.Equal(member.PKIid, peer2SendPerOrg.PKIId)
+					},
+				})
+				if required == 0 || len(criteria) > d.config.PushAckRetries {
+					break
+				}
 			}
 			disseminationPlan = append(disseminationPlan, &dissemination{
-				criteria: sc,
+				criteria:  criteria[0],
+				fallbacks: criteria[1:],
 				msg: &protoext.SignedGossipMessage{
 					Envelope:      proto2.Clone(pvtDataMsg.Envelope).(*proto.Envelope),
 					GossipMessage: proto2.Clone(pvtDataMsg.GossipMessage).(*proto.GossipMessage),
@@ -252,7 +296,7 @@ func (d *distributorImpl) disseminationPlanForMsg(colAP privdata.CollectionAcces
 	// criteria to select remaining peers to satisfy colAP.MaximumPeerCount()
 	// collection policy parameters
 	sc := gossip2.SendCriteria{
-		Timeout:  d.pushAckTimeout,
+		Timeout:  d.config.PushAckTimeout,
 		Channel:  gossipCommon.ChainID(d.chainID),
 		MaxPeers: maximumPeerCount,
 		MinAck:   requiredPeerCount,
@@ -261,9 +305,18 @@ func (d *distributorImpl) disseminationPlanForMsg(colAP privdata.CollectionAcces
 		},
 	}
 
+	// Retrying selects the peers to push to anew
+	var fallbacks []gossip2.SendCriteria
+	if requiredPeerCount > 0 {
+		for i := 0; i < d.config.PushAckRetries; i++ {
+			fallbacks = append(fallbacks, sc)
+		}
+	}
+
 	disseminationPlan = append(disseminationPlan, &dissemination{
-		criteria: sc,
-		msg:      pvtDataMsg,
+		criteria:  sc,
+		fallbacks: fallbacks,
+		msg:       pvtDataMsg,
 	})
 
 	return disseminationPlan, nil
@@ -310,7 +363,17 @@ func (d *distributorImpl) disseminate(disseminationPlan []*dissemination) error
 			defer wg.Done()
 			defer d.reportSendDuration(start)
 			err := d.SendByCriteria(dis.msg, dis.criteria)
+			for _, criteria := range dis.fallbacks {
+				if err == nil {
+					break
+				}
+				m := dis.msg.GetPrivateData().Payload
+				logger.Warning("Private RWSet for TxID", m.TxId, ", namespace", m.Namespace, "collection", m.CollectionName, "wasn't acknowledged, retrying:", err)
+				d.metrics.PushRetries.With("channel", d.chainID).Add(1)
+				err = d.SendByCriteria(dis.msg, criteria)
+			}
 			if err != nil {
+				d.metrics.PushFailures.With("channel", d.chainID).Add(1)
 				atomic.AddUint32(&failures, 1)
 				m := dis.msg.GetPrivateData().Payload
 				logger.Error("Failed disseminating private RWSet for TxID", m.TxId, ", namespace", m.Namespace, "collection", m.CollectionName, ":", err)
@@ -9,7 +9,10 @@ package privdata
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/hyperledger/fabric/core/common/privdata"
 	"github.com/hyperledger/fabric/gossip/api"
@@ -24,6 +27,7 @@ import (
 	proto "github.com/hyperledger/fabric/protos/gossip"
 	"github.com/hyperledger/fabric/protos/transientstore"
 	"github.com/hyperledger/fabric/protoutil"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -186,7 +190,7 @@ func TestDistributor(t *testing.T) {
 	testMetricProvider := mocks.TestUtilConstructMetricProvider()
 	metrics := metrics.NewGossipMetrics(testMetricProvider.FakeProvider).PrivdataMetrics
 
-	d := NewDistributor(channelID, g, accessFactoryMock, metrics, 0)
+	d := NewDistributor(channelID, g, accessFactoryMock, metrics, DistributorConfig{})
 	pdFactory := &pvtDataFactory{}
 	pvtData := pdFactory.addRWSet().addNSRWSet("ns1", "c1", "c2").addRWSet().addNSRWSet("ns2", "c1", "c2").create()
 	err := d.Distribute("tx1", &transientstore.TxPvtReadWriteSetWithConfigInfo{
@@ -278,3 +282,230 @@ func TestDistributor(t *testing.T) {
 	)
 	assert.True(t, testMetricProvider.FakeSendDuration.ObserveArgsForCall(0) > 0)
 }
+
+func TestDistributorRetries(t *testing.T) {
+	channelID := "test"
+
+	g := &gossipMock{
+		Mock: mock.Mock{},
+		PeerSignature: api.PeerSignature{
+			Signature:    []byte{3, 4, 5},
+			Message:      []byte{6, 7, 8},
+			PeerIdentity: []byte{0, 1, 2},
+		},
+	}
+	members := []discovery.NetworkMember{
+		{PKIid: gcommon.PKIidType{1}},
+		{PKIid: gcommon.PKIidType{2}},
+		{PKIid: gcommon.PKIidType{3}},
+	}
+	g.On("PeersOfChannel", gcommon.ChainID(channelID)).Return(members)
+	g.On("IdentityInfo").Return(api.PeerIdentitySet{
+		{
+			PKIId:        gcommon.PKIidType{1},
+			Organization: api.OrgIdentityType("org1"),
+		},
+		{
+			PKIId:        gcommon.PKIidType{2},
+			Organization: api.OrgIdentityType("org1"),
+		},
+		{
+			PKIId:        gcommon.PKIidType{3},
+			Organization: api.OrgIdentityType("org1"),
+		},
+	})
+
+	colConfig := &common.CollectionConfig{
+		Payload: &common.CollectionConfig_StaticCollectionConfig{
+			StaticCollectionConfig: &common.StaticCollectionConfig{
+				Name:              "c1",
+				RequiredPeerCount: 1,
+				MaximumPeerCount:  1,
+			},
+		},
+	}
+	policyMock := &collectionAccessPolicyMock{}
+	policyMock.Setup(1, 1, func(_ protoutil.SignedData) bool {
+		return true
+	}, []string{"org1"}, false)
+	accessFactoryMock := &collectionAccessFactoryMock{}
+	accessFactoryMock.On("AccessPolicy", colConfig, channelID).Return(policyMock, nil)
+
+	pdFactory := &pvtDataFactory{}
+	pvtData := pdFactory.addRWSet().addNSRWSet("ns1", "c1").create()
+	privData := &transientstore.TxPvtReadWriteSetWithConfigInfo{
+		PvtRwset: pvtData[0].WriteSet,
+		CollectionConfigs: map[string]*common.CollectionConfigPackage{
+			"ns1": {
+				Config: []*common.CollectionConfig{colConfig},
+			},
+		},
+	}
+
+	// Only the third peer the private data is pushed to acknowledges it
+	var pushedTo []gcommon.PKIidType
+	recordPush := func(args mock.Arguments) {
+		criteria := args.Get(1).(gossip2.SendCriteria)
+		for _, member := range members {
+			if criteria.IsEligible(member) {
+				pushedTo = append(pushedTo, member.PKIid)
+			}
+		}
+	}
+	g.On("SendByCriteria", mock.Anything, mock.Anything).Run(recordPush).Return(errors.New("timed out waiting for acknowledgement")).Twice()
+	g.On("SendByCriteria", mock.Anything, mock.Anything).Run(recordPush).Return(nil).Once()
+
+	testMetricProvider := mocks.TestUtilConstructMetricProvider()
+	metrics := metrics.NewGossipMetrics(testMetricProvider.FakeProvider).PrivdataMetrics
+
+	d := NewDistributor(channelID, g, accessFactoryMock, metrics, DistributorConfig{PushAckTimeout: time.Second, PushAckRetries: 2})
+	err := d.Distribute("tx1", privData, 0)
+	assert.NoError(t, err)
+	// Every peer of the org was pushed to in turn
+	assert.Len(t, pushedTo, 3)
+	assert.ElementsMatch(t, []gcommon.PKIidType{{1}, {2}, {3}}, pushedTo)
+	assert.Equal(t, 2, testMetricProvider.FakePushRetries.AddCallCount())
+	assert.Equal(t, 0, testMetricProvider.FakePushFailures.AddCallCount())
+
+	// With fewer retries, the private data isn't acknowledged
+	pushedTo = nil
+	g.On("SendByCriteria", mock.Anything, mock.Anything).Run(recordPush).Return(errors.New("timed out waiting for acknowledgement"))
+	d = NewDistributor(channelID, g, accessFactoryMock, metrics, DistributorConfig{PushAckTimeout: time.Second, PushAckRetries: 1})
+	err = d.Distribute("tx1", privData, 0)
+	assert.EqualError(t, err, "Failed disseminating 1 out of 1 private dissemination plans")
+	assert.Len(t, pushedTo, 2)
+	assert.Equal(t, 1, testMetricProvider.FakePushFailures.AddCallCount())
+	assert.Equal(t, []string{"channel", channelID}, testMetricProvider.FakePushFailures.WithArgsForCall(0))
+}
+
+func TestDistributorConfig(t *testing.T) {
+	channelID := "test"
+
+	g := &gossipMock{
+		Mock: mock.Mock{},
+		PeerSignature: api.PeerSignature{
+			Signature:    []byte{3, 4, 5},
+			Message:      []byte{6, 7, 8},
+			PeerIdentity: []byte{0, 1, 2},
+		},
+	}
+	g.On("PeersOfChannel", gcommon.ChainID(channelID)).Return([]discovery.NetworkMember{
+		{PKIid: gcommon.PKIidType{1}},
+	})
+	g.On("IdentityInfo").Return(api.PeerIdentitySet{
+		{
+			PKIId:        gcommon.PKIidType{1},
+			Organization: api.OrgIdentityType("org1"),
+		},
+	})
+	// The plans of a transaction are disseminated concurrently
+	var lock sync.Mutex
+	var minAcks []int
+	g.On("SendByCriteria", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		lock.Lock()
+		defer lock.Unlock()
+		minAcks = append(minAcks, args.Get(1).(gossip2.SendCriteria).MinAck)
+	}).Return(nil)
+
+	colConfig := &common.CollectionConfig{
+		Payload: &common.CollectionConfig_StaticCollectionConfig{
+			StaticCollectionConfig: &common.StaticCollectionConfig{
+				Name:              "c1",
+				RequiredPeerCount: 3,
+				MaximumPeerCount:  3,
+			},
+		},
+	}
+	policyMock := &collectionAccessPolicyMock{}
+	policyMock.Setup(3, 3, func(_ protoutil.SignedData) bool {
+		return true
+	}, []string{"org1"}, false)
+	accessFactoryMock := &collectionAccessFactoryMock{}
+	accessFactoryMock.On("AccessPolicy", colConfig, channelID).Return(policyMock, nil)
+
+	pdFactory := &pvtDataFactory{}
+	pvtData := pdFactory.addRWSet().addNSRWSet("ns1", "c1").create()
+	privData := &transientstore.TxPvtReadWriteSetWithConfigInfo{
+		PvtRwset: pvtData[0].WriteSet,
+		CollectionConfigs: map[string]*common.CollectionConfigPackage{
+			"ns1": {
+				Config: []*common.CollectionConfig{colConfig},
+			},
+		},
+	}
+
+	testMetricProvider := mocks.TestUtilConstructMetricProvider()
+	metrics := metrics.NewGossipMetrics(testMetricProvider.FakeProvider).PrivdataMetrics
+
+	t.Run("RequiredPeerCount", func(t *testing.T) {
+		minAcks = nil
+		d := NewDistributor(channelID, g, accessFactoryMock, metrics, DistributorConfig{})
+		err := d.Distribute("tx1", privData, 0)
+		assert.NoError(t, err)
+		// The peer of the org must acknowledge the private data, and so must
+		// the two other required peers, which are not alive
+		assert.ElementsMatch(t, []int{1, 2}, minAcks)
+	})
+
+	t.Run("ClampRequiredPeerCount", func(t *testing.T) {
+		minAcks = nil
+		d := NewDistributor(channelID, g, accessFactoryMock, metrics, DistributorConfig{ClampRequiredPeerCount: true})
+		err := d.Distribute("tx1", privData, 0)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []int{1, 0}, minAcks)
+	})
+
+	t.Run("DisablePush", func(t *testing.T) {
+		minAcks = nil
+		d := NewDistributor(channelID, g, accessFactoryMock, metrics, DistributorConfig{DisablePush: true})
+		err := d.Distribute("tx1", privData, 0)
+		assert.NoError(t, err)
+		assert.Empty(t, minAcks)
+	})
+}
+
+func TestGetDisseminationConfig(t *testing.T) {
+	defer viper.Reset()
+
+	config := GetDisseminationConfig("mychannel")
+	assert.Equal(t, DisseminationConfig{}.PullRetryThreshold, config.PullRetryThreshold)
+	assert.False(t, config.DisablePush)
+
+	viper.SetConfigType("yaml")
+	err := viper.ReadConfig(strings.NewReader(`---
+peer:
+  gossip:
+    pvtData:
+      pullRetryThreshold: 60s
+      pushAckTimeout: 3s
+      pushAckRetries: 2
+      channels:
+        my.channel:
+          pullRetryThreshold: 5s
+          pushAckRetries: 0
+          clampRequiredPeerCount: true
+          pushToNonEndorsers: false
+        badchannel:
+          pushAckRetries: many
+`))
+	assert.NoError(t, err)
+
+	global := DisseminationConfig{
+		DistributorConfig: DistributorConfig{
+			PushAckTimeout: 3 * time.Second,
+			PushAckRetries: 2,
+		},
+		PullRetryThreshold: time.Minute,
+	}
+	assert.Equal(t, global, GetDisseminationConfig("mychannel"))
+	assert.Equal(t, DisseminationConfig{
+		DistributorConfig: DistributorConfig{
+			PushAckTimeout:         3 * time.Second,
+			ClampRequiredPeerCount: true,
+			DisablePush:            true,
+		},
+		PullRetryThreshold: 5 * time.Second,
+	}, GetDisseminationConfig("my.channel"))
+	// Invalid overrides are ignored
+	assert.Equal(t, global, GetDisseminationConfig("badchannel"))
+}
@@ -40,6 +40,9 @@ type PvtDataReconciler interface {
 	Start()
 	// Stop function stops reconciler
 	Stop()
+	// CollsEligibilityEnabled notifies the reconciler that the peer became eligible for existing collections,
+	// which schedules a reconciliation of the private data the peer missed for them, unless configured otherwise
+	CollsEligibilityEnabled()
 }
 
 type Reconciler struct {
@@ -48,9 +51,10 @@ type Reconciler struct {
 	config  *ReconcilerConfig
 	ReconciliationFetcher
 	committer.Committer
-	stopChan  chan struct{}
-	startOnce sync.Once
-	stopOnce  sync.Once
+	stopChan    chan struct{}
+	collElgChan chan struct{}
+	startOnce   sync.Once
+	stopOnce    sync.Once
 }
 
 // NoOpReconciler non functional reconciler to be used
@@ -67,11 +71,18 @@ func (*NoOpReconciler) Stop() {
 	// do nothing
 }
 
+func (*NoOpReconciler) CollsEligibilityEnabled() {
+	// do nothing
+}
+
 // ReconcilerConfig holds config flags that are read from core.yaml
 type ReconcilerConfig struct {
 	SleepInterval time.Duration
 	BatchSize     int
 	IsEnabled     bool
+	// ReconcileOnEligibility indicates whether a reconciliation is scheduled as soon as the peer
+	// becomes eligible for existing collections, even if the periodic reconciliation is disabled
+	ReconcileOnEligibility bool
 }
 
 // NewReconciler creates a new instance of reconciler
@@ -85,6 +96,7 @@ func NewReconciler(channel string, metrics *metrics.PrivdataMetrics, c committer
 		Committer:             c,
 		ReconciliationFetcher: fetcher,
 		stopChan:              make(chan struct{}),
+		collElgChan:           make(chan struct{}, 1),
 	}
 }
 
@@ -100,17 +112,38 @@ func (r *Reconciler) Start() {
 	})
 }
 
+// CollsEligibilityEnabled schedules a reconciliation, if the reconciler is configured to
+// reconcile the private data of the collections the peer becomes eligible for
+func (r *Reconciler) CollsEligibilityEnabled() {
+	if !r.config.ReconcileOnEligibility {
+		return
+	}
+	select {
+	case r.collElgChan <- struct{}{}:
+		logger.Debugf("[%s] Scheduled a reconciliation, as the peer became eligible for existing collections", r.channel)
+	default:
+		logger.Debugf("[%s] A reconciliation is already scheduled", r.channel)
+	}
+}
+
 func (r *Reconciler) run() {
 	for {
+		// If the periodic reconciliation is disabled, the reconciler
+		// only reconciles when the peer becomes eligible for collections
+		var sleep <-chan time.Time
+		if r.config.IsEnabled {
+			sleep = time.After(r.config.SleepInterval)
+		}
 		select {
 		case <-r.stopChan:
 			return
-		case <-time.After(r.config.SleepInterval):
+		case <-sleep:
 			logger.Debug("Start reconcile missing private info")
-			if err := r.reconcile(); err != nil {
-				logger.Error("Failed to reconcile missing private info, error: ", err.Error())
-				break
-			}
+		case <-r.collElgChan:
+			logger.Debug("Start reconcile missing private info of collections the peer became eligible for")
+		}
+		if err := r.reconcile(); err != nil {
+			logger.Error("Failed to reconcile missing private info, error: ", err.Error())
 		}
 	}
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/hyperledger/fabric/gossip/privdata/mocks"
 	"github.com/hyperledger/fabric/protos/common"
 	gossip2 "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -256,6 +257,53 @@ func TestReconciliationHappyPathWithScheduler(t *testing.T) {
 	assert.True(t, commitPvtDataOfOldBlocksHappened)
 }
 
+func TestReconciliationOnEligibility(t *testing.T) {
+	// Scenario: the periodic reconciliation is disabled, and the reconciler
+	// only reconciles once the peer becomes eligible for existing collections.
+	committer := &mocks.Committer{}
+	fetcher := &mocks.ReconciliationFetcher{}
+	missingPvtDataTracker := &mocks.MissingPvtDataTracker{}
+	missingPvtDataTracker.On("GetMissingPvtDataInfoForMostRecentBlocks", mock.Anything).Return(nil, nil)
+
+	reconciled := make(chan struct{}, 10)
+	committer.On("GetMissingPvtDataTracker").Return(missingPvtDataTracker, nil).Run(func(_ mock.Arguments) {
+		reconciled <- struct{}{}
+	})
+
+	newReconciler := func(reconcileOnEligibility bool) *Reconciler {
+		return NewReconciler("", metrics.NewGossipMetrics(&disabled.Provider{}).PrivdataMetrics, committer, fetcher,
+			&ReconcilerConfig{SleepInterval: time.Millisecond * 10, BatchSize: 1, ReconcileOnEligibility: reconcileOnEligibility})
+	}
+
+	r := newReconciler(true)
+	r.Start()
+	defer r.Stop()
+
+	select {
+	case <-reconciled:
+		assert.Fail(t, "reconciled although the periodic reconciliation is disabled")
+	case <-time.After(time.Millisecond * 100):
+	}
+
+	r.CollsEligibilityEnabled()
+	select {
+	case <-reconciled:
+	case <-time.After(time.Second * 5):
+		assert.Fail(t, "didn't reconcile after the peer became eligible for collections")
+	}
+
+	// A reconciler that isn't configured to reconcile on eligibility ignores the notification
+	r = newReconciler(false)
+	r.Start()
+	defer r.Stop()
+	r.CollsEligibilityEnabled()
+	select {
+	case <-reconciled:
+		assert.Fail(t, "reconciled although not configured to reconcile on eligibility")
+	case <-time.After(time.Millisecond * 100):
+	}
+}
+
 func TestReconciliationPullingMissingPrivateDataAtOnePass(t *testing.T) {
 	// Scenario: define batch size to retrieve missing private data to 1
 	// and make sure that even though there are missing data for two blocks
@@ -477,3 +525,16 @@ func TestFailuresWhileReconcilingMissingPvtData(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, "failed get missing pvt data for recent blocks", err.Error())
 }
+
+func TestGetReconcilerConfigReconcileOnEligibility(t *testing.T) {
+	defer viper.Reset()
+
+	// Unless set, reconciling on eligibility follows whether reconciliation is enabled
+	viper.Set("peer.gossip.pvtData.reconciliationEnabled", true)
+	assert.True(t, GetReconcilerConfig().ReconcileOnEligibility)
+	viper.Set("peer.gossip.pvtData.reconciliationEnabled", false)
+	assert.False(t, GetReconcilerConfig().ReconcileOnEligibility)
+
+	viper.Set("peer.gossip.pvtData.reconcileOnEligibility", true)
+	assert.True(t, GetReconcilerConfig().ReconcileOnEligibility)
+}
@@ -8,6 +8,7 @@ package privdata
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/golang/protobuf/proto"
@@ -19,6 +20,7 @@ import (
 	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
 	"github.com/hyperledger/fabric/protos/msp"
 	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 )
 
@@ -327,6 +329,7 @@ const (
 	reconcileBatchSizeConfigKey      = "peer.gossip.pvtData.reconcileBatchSize"
 	reconcileBatchSizeDefault        = 10
 	reconciliationEnabledConfigKey   = "peer.gossip.pvtData.reconciliationEnabled"
+	reconcileOnEligibilityConfigKey  = "peer.gossip.pvtData.reconcileOnEligibility"
 )
 
 // this func reads reconciler configuration values from core.yaml and returns ReconcilerConfig
@@ -342,7 +345,16 @@ func GetReconcilerConfig() *ReconcilerConfig {
 		reconcileBatchSize = reconcileBatchSizeDefault
 	}
 	isEnabled := viper.GetBool(reconciliationEnabledConfigKey)
-	return &ReconcilerConfig{SleepInterval: reconcileSleepInterval, BatchSize: reconcileBatchSize, IsEnabled: isEnabled}
+	reconcileOnEligibility := isEnabled
+	if viper.IsSet(reconcileOnEligibilityConfigKey) {
+		reconcileOnEligibility = viper.GetBool(reconcileOnEligibilityConfigKey)
+	}
+	return &ReconcilerConfig{
+		SleepInterval:          reconcileSleepInterval,
+		BatchSize:              reconcileBatchSize,
+		IsEnabled:              isEnabled,
+		ReconcileOnEligibility: reconcileOnEligibility,
+	}
 }
 
 const (
@@ -358,3 +370,88 @@ func GetTransientBlockRetention() uint64 {
 	}
 	return transientBlockRetention
 }
+
+const (
+	pullRetryThresholdConfigKey     = "peer.gossip.pvtData.pullRetryThreshold"
+	pushAckTimeoutConfigKey         = "peer.gossip.pvtData.pushAckTimeout"
+	pushAckRetriesConfigKey         = "peer.gossip.pvtData.pushAckRetries"
+	clampRequiredPeerCountConfigKey = "peer.gossip.pvtData.clampRequiredPeerCount"
+	pushToNonEndorsersConfigKey     = "peer.gossip.pvtData.pushToNonEndorsers"
+	channelOverridesConfigKey       = "peer.gossip.pvtData.channels"
+)
+
+// DisseminationConfig defines how the private data of the transactions of a
+// channel is pushed at endorsement time and pulled at commit time
+type DisseminationConfig struct {
+	DistributorConfig
+	// PullRetryThreshold is the maximum time spent pulling the missing
+	// private data of a block before committing it without the data
+	PullRetryThreshold time.Duration
+}
+
+// disseminationOverrides are the settings of the dissemination of private
+// data that are overridden for a channel
+type disseminationOverrides struct {
+	PullRetryThreshold     *time.Duration `mapstructure:"pullRetryThreshold"`
+	PushAckTimeout         *time.Duration `mapstructure:"pushAckTimeout"`
+	PushAckRetries         *int           `mapstructure:"pushAckRetries"`
+	ClampRequiredPeerCount *bool          `mapstructure:"clampRequiredPeerCount"`
+	PushToNonEndorsers     *bool          `mapstructure:"pushToNonEndorsers"`
+}
+
+// GetDisseminationConfig reads the configuration of the dissemination of the
+// private data of the channel from core.yaml: the settings of
+// peer.gossip.pvtData, overridden by the ones set for the channel in
+// peer.gossip.pvtData.channels
+func GetDisseminationConfig(channel string) DisseminationConfig {
+	pushToNonEndorsers := true
+	if viper.IsSet(pushToNonEndorsersConfigKey) {
+		pushToNonEndorsers = viper.GetBool(pushToNonEndorsersConfigKey)
+	}
+	config := DisseminationConfig{
+		DistributorConfig: DistributorConfig{
+			PushAckTimeout:         viper.GetDuration(pushAckTimeoutConfigKey),
+			PushAckRetries:         viper.GetInt(pushAckRetriesConfigKey),
+			ClampRequiredPeerCount: viper.GetBool(clampRequiredPeerCountConfigKey),
+			DisablePush:            !pushToNonEndorsers,
+		},
+		PullRetryThreshold: viper.GetDuration(pullRetryThresholdConfigKey),
+	}
+
+	// Channel names may contain dots, so the overrides of a channel are
+	// looked up in the map of the channels rather than by key
+	overrides, exists := viper.GetStringMap(channelOverridesConfigKey)[strings.ToLower(channel)]
+	if !exists || overrides == nil {
+		return config
+	}
+	o := &disseminationOverrides{}
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+		WeaklyTypedInput: true,
+		Result:           o,
+	})
+	if err == nil {
+		err = decoder.Decode(overrides)
+	}
+	if err != nil {
+		logger.Errorf("Invalid configuration key %s of channel %s, ignoring it: %s", channelOverridesConfigKey, channel, err)
+		return config
+	}
+
+	if o.PullRetryThreshold != nil {
+		config.PullRetryThreshold = *o.PullRetryThreshold
+	}
+	if o.PushAckTimeout != nil {
+		config.PushAckTimeout = *o.PushAckTimeout
+	}
+	if o.PushAckRetries != nil {
+		config.PushAckRetries = *o.PushAckRetries
+	}
+	if o.ClampRequiredPeerCount != nil {
+		config.ClampRequiredPeerCount = *o.ClampRequiredPeerCount
+	}
+	if o.PushToNonEndorsers != nil {
+		config.DisablePush = !*o.PushToNonEndorsers
+	}
+	return config
+}
@@ -166,7 +166,9 @@ func (m *SignedGossipMessage) String() string {
 	gMsg := "No gossipMessage"
 	if m.GossipMessage != nil {
 		var isSimpleMsg bool
-		if m.GetStateResponse() != nil {
+		if m.GetStateResponse() != nil && len(m.GetStateResponse().CompressedPayloads) > 0 {
+			gMsg = fmt.Sprintf("StateResponse with %d compressed bytes", len(m.GetStateResponse().CompressedPayloads))
+		} else if m.GetStateResponse() != nil {
 			gMsg = fmt.Sprintf("StateResponse with %d items", len(m.GetStateResponse().Payloads))
 		} else if IsDataMsg(m.GossipMessage) && m.GetDataMsg().Payload != nil {
 			gMsg = PayloadToString(m.GetDataMsg().Payload)
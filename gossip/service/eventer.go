@@ -87,18 +87,20 @@ func cloneOrgConfig(src map[string]channelconfig.ApplicationOrg) map[string]chan
 	clone := make(map[string]channelconfig.ApplicationOrg)
 	for k, v := range src {
 		clone[k] = &appGrp{
-			name:        v.Name(),
-			mspID:       v.MSPID(),
-			anchorPeers: v.AnchorPeers(),
+			name:          v.Name(),
+			mspID:         v.MSPID(),
+			anchorPeers:   v.AnchorPeers(),
+			peerEndpoints: v.PeerEndpoints(),
 		}
 	}
 	return clone
 }
 
 type appGrp struct {
-	name        string
-	mspID       string
-	anchorPeers []*peer.AnchorPeer
+	name          string
+	mspID         string
+	anchorPeers   []*peer.AnchorPeer
+	peerEndpoints []string
 }
 
 func (ag *appGrp) Name() string {
@@ -112,3 +114,7 @@ func (ag *appGrp) MSPID() string {
 func (ag *appGrp) AnchorPeers() []*peer.AnchorPeer {
 	return ag.anchorPeers
 }
+
+func (ag *appGrp) PeerEndpoints() []string {
+	return ag.peerEndpoints
+}
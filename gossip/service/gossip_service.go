@@ -7,12 +7,18 @@ SPDX-License-Identifier: Apache-2.0
 package service
 
 import (
+	"net"
+	"path/filepath"
+	"strconv"
 	"sync"
 
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/common/localmsp"
 	"github.com/hyperledger/fabric/common/metrics"
 	"github.com/hyperledger/fabric/core/committer"
 	"github.com/hyperledger/fabric/core/committer/txvalidator"
 	"github.com/hyperledger/fabric/core/common/privdata"
+	coreconfig "github.com/hyperledger/fabric/core/config"
 	deliverclient "github.com/hyperledger/fabric/core/deliverservice"
 	"github.com/hyperledger/fabric/core/deliverservice/blocksprovider"
 	"github.com/hyperledger/fabric/gossip/api"
@@ -52,6 +58,12 @@ type GossipService interface {
 	InitializeChannel(chainID string, endpoints []string, support Support)
 	// AddPayload appends message payload to for given chain
 	AddPayload(chainID string, payload *gproto.Payload) error
+	// LeaderElection returns the leader election service of the given channel,
+	// or nil if the peer doesn't take part in leader elections for it
+	LeaderElection(chainID string) election.LeaderElectionService
+	// CollsEligibilityEnabled schedules a reconciliation of the private data of the given channel,
+	// as the peer became eligible for existing collections of it
+	CollsEligibilityEnabled(chainID string)
 }
 
 // DeliveryServiceFactory factory to create and initialize delivery service instance
@@ -61,16 +73,22 @@ type DeliveryServiceFactory interface {
 }
 
 type deliveryFactoryImpl struct {
+	metrics *deliverclient.Metrics
 }
 
 // Returns an instance of delivery client
-func (*deliveryFactoryImpl) Service(g GossipService, endpoints []string, mcs api.MessageCryptoService) (deliverclient.DeliverService, error) {
+func (df *deliveryFactoryImpl) Service(g GossipService, endpoints []string, mcs api.MessageCryptoService) (deliverclient.DeliverService, error) {
 	return deliverclient.NewDeliverService(&deliverclient.Config{
 		CryptoSvc:   mcs,
 		Gossip:      g,
 		Endpoints:   endpoints,
 		ConnFactory: deliverclient.DefaultConnectionFactory,
 		ABCFactory:  deliverclient.DefaultABCFactory,
+		// Peers elected by their organization yield to another peer
+		// rather than waiting for the ordering service to recover
+		GiveUpOnCircuitOpen: viper.GetBool("peer.gossip.useLeaderElection"),
+		Metrics:             df.metrics,
+		Signer:              localmsp.NewSignerForIdentity(viper.GetString("peer.signingIdentities.deliver")),
 	})
 }
 
@@ -89,6 +107,9 @@ func (p privateHandler) close() {
 type gossipServiceImpl struct {
 	gossipSvc
 	privateHandlers map[string]privateHandler
+	// collElgPending holds the channels for which the peer became eligible
+	// for existing collections before they were initialized
+	collElgPending  map[string]struct{}
 	chains          map[string]state.GossipStateProvider
 	leaderElection  map[string]election.LeaderElectionService
 	deliveryService map[string]deliverclient.DeliverService
@@ -98,6 +119,7 @@ type gossipServiceImpl struct {
 	peerIdentity    []byte
 	secAdv          api.SecurityAdvisor
 	metrics         *gossipMetrics.GossipMetrics
+	payloadStores   *state.PayloadStoreProvider
 }
 
 // This is an implementation of api.JoinChannelMessage.
@@ -136,7 +158,7 @@ func InitGossipService(peerIdentity []byte, metricsProvider metrics.Provider, en
 	// TODO: This is a temporary work-around to make the gossip leader election module load its logger at startup
 	// TODO: in order for the flogging package to register this logger in time so it can set the log levels as requested in the config
 	util.GetLogger(util.ElectionLogger, "")
-	return InitGossipServiceCustomDeliveryFactory(peerIdentity, metricsProvider, endpoint, s, certs, &deliveryFactoryImpl{},
+	return InitGossipServiceCustomDeliveryFactory(peerIdentity, metricsProvider, endpoint, s, certs, &deliveryFactoryImpl{metrics: deliverclient.NewMetrics(metricsProvider)},
 		mcs, secAdv, secureDialOpts, bootPeers...)
 }
 
@@ -162,6 +184,7 @@ func InitGossipServiceCustomDeliveryFactory(peerIdentity []byte, metricsProvider
 			mcs:             mcs,
 			gossipSvc:       gossip,
 			privateHandlers: make(map[string]privateHandler),
+			collElgPending:  make(map[string]struct{}),
 			chains:          make(map[string]state.GossipStateProvider),
 			leaderElection:  make(map[string]election.LeaderElectionService),
 			deliveryService: make(map[string]deliverclient.DeliverService),
@@ -170,6 +193,11 @@ func InitGossipServiceCustomDeliveryFactory(peerIdentity []byte, metricsProvider
 			secAdv:          secAdv,
 			metrics:         gossipMetrics,
 		}
+		if viper.GetBool("peer.gossip.payloadStore.enabled") {
+			gossipServiceInstance.payloadStores = state.NewPayloadStoreProvider(
+				filepath.Join(coreconfig.GetPath("peer.fileSystemPath"), "gossipPayloads"),
+				viper.GetInt("peer.gossip.payloadStore.maxSize"))
+		}
 	})
 	return errors.WithStack(err)
 }
@@ -214,6 +242,8 @@ type Support struct {
 	Store                privdata2.TransientStore
 	Cs                   privdata.CollectionStore
 	IdDeserializeFactory privdata2.IdentityDeserializerFactory
+	// MaxBlockBytes returns the maximum size of the blocks of the channel
+	MaxBlockBytes func() uint32
 }
 
 // DataStoreSupport aggregates interfaces capable
@@ -244,9 +274,10 @@ func (g *gossipServiceImpl) InitializeChannel(chainID string, endpoints []string
 	fetcher := privdata2.NewPuller(g.metrics.PrivdataMetrics, support.Cs, g.gossipSvc, dataRetriever,
 		collectionAccessFactory, chainID, privdata2.GetBtlPullMargin())
 
+	disseminationConfig := privdata2.GetDisseminationConfig(chainID)
 	coordinatorConfig := privdata2.CoordinatorConfig{
 		TransientBlockRetention: privdata2.GetTransientBlockRetention(),
-		PullRetryThreshold:      viper.GetDuration("peer.gossip.pvtData.pullRetryThreshold"),
+		PullRetryThreshold:      disseminationConfig.PullRetryThreshold,
 	}
 	coordinator := privdata2.NewCoordinator(privdata2.Support{
 		ChainID:         chainID,
@@ -260,25 +291,32 @@ func (g *gossipServiceImpl) InitializeChannel(chainID string, endpoints []string
 	reconcilerConfig := privdata2.GetReconcilerConfig()
 	var reconciler privdata2.PvtDataReconciler
 
-	if reconcilerConfig.IsEnabled {
+	if reconcilerConfig.IsEnabled || reconcilerConfig.ReconcileOnEligibility {
 		reconciler = privdata2.NewReconciler(chainID, g.metrics.PrivdataMetrics,
 			support.Committer, fetcher, reconcilerConfig)
 	} else {
 		reconciler = &privdata2.NoOpReconciler{}
 	}
 
-	pushAckTimeout := viper.GetDuration("peer.gossip.pvtData.pushAckTimeout")
 	g.privateHandlers[chainID] = privateHandler{
 		support:     support,
 		coordinator: coordinator,
-		distributor: privdata2.NewDistributor(chainID, g, collectionAccessFactory, g.metrics.PrivdataMetrics, pushAckTimeout),
+		distributor: privdata2.NewDistributor(chainID, g, collectionAccessFactory, g.metrics.PrivdataMetrics, disseminationConfig.DistributorConfig),
 		reconciler:  reconciler,
 	}
 	g.privateHandlers[chainID].reconciler.Start()
+	if _, pending := g.collElgPending[chainID]; pending {
+		delete(g.collElgPending, chainID)
+		reconciler.CollsEligibilityEnabled()
+	}
 
 	blockingMode := !viper.GetBool("peer.gossip.nonBlockingCommitMode")
+	var payloadStore state.PayloadStore
+	if g.payloadStores != nil {
+		payloadStore = g.payloadStores.OpenStore(chainID)
+	}
 	g.chains[chainID] = state.NewGossipStateProvider(chainID, servicesAdapter, coordinator,
-		g.metrics.StateMetrics, blockingMode)
+		g.metrics.StateMetrics, blockingMode, payloadStore, support.MaxBlockBytes)
 	if g.deliveryService[chainID] == nil {
 		var err error
 		g.deliveryService[chainID], err = g.deliveryFactory.Service(g, endpoints, g.mcs)
@@ -340,6 +378,7 @@ func (g *gossipServiceImpl) updateAnchors(config Config) {
 		return
 	}
 	jcm := &joinChannelMessage{seqNum: config.Sequence(), members2AnchorPeers: map[string][]api.AnchorPeer{}}
+	autoAnchorPeers := viper.GetBool("peer.gossip.autoAnchorPeers")
 	for _, appOrg := range config.Organizations() {
 		logger.Debug(appOrg.MSPID(), "anchor peers:", appOrg.AnchorPeers())
 		jcm.members2AnchorPeers[appOrg.MSPID()] = []api.AnchorPeer{}
@@ -350,6 +389,10 @@ func (g *gossipServiceImpl) updateAnchors(config Config) {
 			}
 			jcm.members2AnchorPeers[appOrg.MSPID()] = append(jcm.members2AnchorPeers[appOrg.MSPID()], anchorPeer)
 		}
+		// Absent anchor peers, bootstrap the membership of the org from the endpoints of its peers
+		if autoAnchorPeers && len(appOrg.AnchorPeers()) == 0 {
+			jcm.members2AnchorPeers[appOrg.MSPID()] = anchorPeersFromEndpoints(config.ChainID(), appOrg)
+		}
 	}
 
 	// Initialize new state provider for given committer
@@ -357,6 +400,29 @@ func (g *gossipServiceImpl) updateAnchors(config Config) {
 	g.JoinChan(jcm, gossipCommon.ChainID(config.ChainID()))
 }
 
+// anchorPeersFromEndpoints derives anchor peers from the peer endpoints
+// the given org publishes in the channel config
+func anchorPeersFromEndpoints(chainID string, appOrg channelconfig.ApplicationOrg) []api.AnchorPeer {
+	anchorPeers := []api.AnchorPeer{}
+	for _, endpoint := range appOrg.PeerEndpoints() {
+		host, portStr, err := net.SplitHostPort(endpoint)
+		if err != nil {
+			logger.Warningf("Skipping malformed peer endpoint %s of %s: %s", endpoint, appOrg.MSPID(), err)
+			continue
+		}
+		port, err := strconv.Atoi(portStr)
+		if err != nil {
+			logger.Warningf("Skipping peer endpoint %s of %s with invalid port: %s", endpoint, appOrg.MSPID(), err)
+			continue
+		}
+		anchorPeers = append(anchorPeers, api.AnchorPeer{Host: host, Port: port})
+	}
+	if len(anchorPeers) > 0 {
+		logger.Infof("Using the peer endpoints of %s as its anchor peers for channel %s: %v", appOrg.MSPID(), chainID, anchorPeers)
+	}
+	return anchorPeers
+}
+
 func (g *gossipServiceImpl) updateEndpoints(chainID string, endpoints []string) {
 	if ds, ok := g.deliveryService[chainID]; ok {
 		logger.Debugf("Updating endpoints for chainID", chainID)
@@ -375,6 +441,68 @@ func (g *gossipServiceImpl) AddPayload(chainID string, payload *gproto.Payload)
 	return g.chains[chainID].AddPayload(payload)
 }
 
+// CollsEligibilityEnabled schedules a reconciliation of the private data of the given channel,
+// as the peer became eligible for existing collections of it. If the channel isn't initialized
+// yet, the reconciliation is scheduled once it is.
+func (g *gossipServiceImpl) CollsEligibilityEnabled(chainID string) {
+	g.lock.Lock()
+	handler, exists := g.privateHandlers[chainID]
+	if !exists {
+		logger.Debugf("Channel %s isn't initialized yet, deferring the reconciliation of its private data", chainID)
+		g.collElgPending[chainID] = struct{}{}
+	}
+	g.lock.Unlock()
+	if exists {
+		handler.reconciler.CollsEligibilityEnabled()
+	}
+}
+
+// CollElgListener notifies the gossip service when the peer becomes eligible for existing collections,
+// so that the private data the peer missed for them is reconciled. It is handed to the ledger, which
+// is initialized before the gossip service, hence the notifications are queued until the gossip
+// service is set.
+type CollElgListener struct {
+	lock          sync.Mutex
+	gossipService GossipService
+	pending       []string
+}
+
+// NewCollElgListener creates a CollElgListener without a gossip service
+func NewCollElgListener() *CollElgListener {
+	return &CollElgListener{}
+}
+
+// SetGossipService sets the gossip service notified by the listener,
+// and notifies it of the notifications queued until then
+func (l *CollElgListener) SetGossipService(gossipService GossipService) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.gossipService = gossipService
+	for _, ledgerID := range l.pending {
+		gossipService.CollsEligibilityEnabled(ledgerID)
+	}
+	l.pending = nil
+}
+
+// CollsEligibilityEnabled implements the ledger.CollElgListener interface
+func (l *CollElgListener) CollsEligibilityEnabled(ledgerID string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.gossipService == nil {
+		l.pending = append(l.pending, ledgerID)
+		return
+	}
+	l.gossipService.CollsEligibilityEnabled(ledgerID)
+}
+
+// LeaderElection returns the leader election service of the given channel,
+// or nil if the peer doesn't take part in leader elections for it
+func (g *gossipServiceImpl) LeaderElection(chainID string) election.LeaderElectionService {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	return g.leaderElection[chainID]
+}
+
 // Stop stops the gossip component
 func (g *gossipServiceImpl) Stop() {
 	g.lock.Lock()
@@ -393,6 +521,9 @@ func (g *gossipServiceImpl) Stop() {
 			g.deliveryService[chainID].Stop()
 		}
 	}
+	if g.payloadStores != nil {
+		g.payloadStores.Close()
+	}
 	g.gossipSvc.Stop()
 }
 
@@ -405,6 +536,7 @@ func (g *gossipServiceImpl) newLeaderElectionComponent(chainID string, callback
 		MembershipSampleInterval: util.GetDurationOrDefault("peer.gossip.election.membershipSampleInterval", election.DefMembershipSampleInterval),
 		LeaderAliveThreshold:     util.GetDurationOrDefault("peer.gossip.election.leaderAliveThreshold", election.DefLeaderAliveThreshold),
 		LeaderElectionDuration:   util.GetDurationOrDefault("peer.gossip.election.leaderElectionDuration", election.DefLeaderElectionDuration),
+		Priority:                 uint32(viper.GetInt("peer.gossip.election.priority")),
 	}
 	return election.NewLeaderElectionService(adapter, string(PKIid), callback, config)
 }
@@ -85,7 +85,7 @@ func TestInitGossipService(t *testing.T) {
 	for i := 0; i < 10; i++ {
 		go func() {
 			defer wg.Done()
-			messageCryptoService := peergossip.NewMCS(&mocks.ChannelPolicyManagerGetter{}, localmsp.NewSigner(), mgmt.NewDeserializersManager())
+			messageCryptoService := peergossip.NewMCS(&mocks.ChannelPolicyManagerGetter{}, localmsp.NewSigner(), mgmt.NewDeserializersManager(), "")
 			secAdv := peergossip.NewSecurityAdvisor(mgmt.NewDeserializersManager())
 			err := InitGossipService(identity, &disabled.Provider{}, endpoint, grpcServer, nil,
 				messageCryptoService, secAdv, nil)
@@ -721,6 +721,7 @@ func newGossipInstance(port int, id int, gRPCServer *comm.GRPCServer, certs *gos
 		chains:          make(map[string]state.GossipStateProvider),
 		leaderElection:  make(map[string]election.LeaderElectionService),
 		privateHandlers: make(map[string]privateHandler),
+		collElgPending:  make(map[string]struct{}),
 		deliveryService: make(map[string]deliverclient.DeliverService),
 		deliveryFactory: &deliveryFactoryImpl{},
 		peerIdentity:    api.PeerIdentityType(conf.InternalEndpoint),
@@ -878,3 +879,36 @@ func TestChannelConfig(t *testing.T) {
 	gService.updateAnchors(mc)
 	assert.True(t, gService.amIinChannel(string(orgInChannelA), mc))
 }
+
+type collElgGossipService struct {
+	GossipService
+	ledgerIDs []string
+}
+
+func (gs *collElgGossipService) CollsEligibilityEnabled(chainID string) {
+	gs.ledgerIDs = append(gs.ledgerIDs, chainID)
+}
+
+func TestCollElgListener(t *testing.T) {
+	listener := NewCollElgListener()
+	// Notifications are queued until the gossip service is set
+	listener.CollsEligibilityEnabled("A")
+	listener.CollsEligibilityEnabled("B")
+
+	gs := &collElgGossipService{}
+	listener.SetGossipService(gs)
+	assert.Equal(t, []string{"A", "B"}, gs.ledgerIDs)
+
+	listener.CollsEligibilityEnabled("C")
+	assert.Equal(t, []string{"A", "B", "C"}, gs.ledgerIDs)
+}
+
+func TestCollsEligibilityEnabledBeforeChannelInitialized(t *testing.T) {
+	g := &gossipServiceImpl{
+		privateHandlers: make(map[string]privateHandler),
+		collElgPending:  make(map[string]struct{}),
+	}
+	// Notifications for channels which aren't initialized yet are deferred
+	g.CollsEligibilityEnabled("A")
+	assert.Contains(t, g.collElgPending, "A")
+}
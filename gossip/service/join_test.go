@@ -22,6 +22,7 @@ import (
 	"github.com/hyperledger/fabric/gossip/util"
 	proto "github.com/hyperledger/fabric/protos/gossip"
 	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -105,6 +106,14 @@ func (g *gossipMock) IdentityInfo() api.PeerIdentitySet {
 	panic("implement me")
 }
 
+func (g *gossipMock) MembershipSnapshot() gossip.MembershipSnapshot {
+	panic("implement me")
+}
+
+func (g *gossipMock) OpenStateTransferStream(peer *comm.RemotePeer) (comm.StateTransferStream, error) {
+	panic("implement me")
+}
+
 func (*gossipMock) Stop() {
 	panic("implement me")
 }
@@ -114,7 +123,8 @@ func (*gossipMock) SendByCriteria(*protoext.SignedGossipMessage, gossip.SendCrit
 }
 
 type appOrgMock struct {
-	id string
+	id            string
+	peerEndpoints []string
 }
 
 func (*appOrgMock) Name() string {
@@ -129,6 +139,10 @@ func (ao *appOrgMock) AnchorPeers() []*peer.AnchorPeer {
 	return []*peer.AnchorPeer{}
 }
 
+func (ao *appOrgMock) PeerEndpoints() []string {
+	return ao.peerEndpoints
+}
+
 type configMock struct {
 	orgs2AppOrgs map[string]channelconfig.ApplicationOrg
 }
@@ -225,3 +239,41 @@ func TestJoinChannelNoAnchorPeers(t *testing.T) {
 	})
 	joinChanCalled.Wait()
 }
+
+func TestJoinChannelAutoAnchorPeers(t *testing.T) {
+	// Scenario: The channel we're joining has 2 orgs without anchor peers,
+	// but which publish the endpoints of their peers.
+	// The test ensures that the peer endpoints are used as anchor peers
+	// only when anchor peers are derived automatically.
+
+	appOrg0 := &appOrgMock{id: "Org0", peerEndpoints: []string{"p0:7051"}}
+	appOrg1 := &appOrgMock{id: "Org1", peerEndpoints: []string{"p1:7051", "p2", "p3:port"}}
+	config := &configMock{
+		orgs2AppOrgs: map[string]channelconfig.ApplicationOrg{
+			"Org0": appOrg0,
+			"Org1": appOrg1,
+		},
+	}
+
+	joinedChannel := func(autoAnchorPeers bool) api.JoinChannelMessage {
+		viper.Set("peer.gossip.autoAnchorPeers", autoAnchorPeers)
+		defer viper.Set("peer.gossip.autoAnchorPeers", false)
+
+		jcmChan := make(chan api.JoinChannelMessage, 1)
+		gMock := &gossipMock{}
+		gMock.On("JoinChan", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			jcmChan <- args.Get(0).(api.JoinChannelMessage)
+		})
+		g := &gossipServiceImpl{secAdv: &secAdvMock{}, peerIdentity: api.PeerIdentityType("Org0"), gossipSvc: gMock}
+		g.updateAnchors(config)
+		return <-jcmChan
+	}
+
+	jcm := joinedChannel(false)
+	assert.Empty(t, jcm.AnchorPeersOf(api.OrgIdentityType("Org0")))
+	assert.Empty(t, jcm.AnchorPeersOf(api.OrgIdentityType("Org1")))
+
+	jcm = joinedChannel(true)
+	assert.Equal(t, []api.AnchorPeer{{Host: "p0", Port: 7051}}, jcm.AnchorPeersOf(api.OrgIdentityType("Org0")))
+	assert.Equal(t, []api.AnchorPeer{{Host: "p1", Port: 7051}}, jcm.AnchorPeersOf(api.OrgIdentityType("Org1")))
+}
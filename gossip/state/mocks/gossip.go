@@ -91,6 +91,18 @@ func (g *GossipMock) IdentityInfo() api.PeerIdentitySet {
 	panic("not implemented")
 }
 
+func (g *GossipMock) MembershipSnapshot() gossip.MembershipSnapshot {
+	panic("not implemented")
+}
+
+func (g *GossipMock) OpenStateTransferStream(peer *comm.RemotePeer) (comm.StateTransferStream, error) {
+	args := g.Called(peer)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(comm.StateTransferStream), args.Error(1)
+}
+
 func (g *GossipMock) Stop() {
 
 }
@@ -0,0 +1,175 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package state
+
+import (
+	"encoding/binary"
+	"sync"
+
+	pb "github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
+	proto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/pkg/errors"
+)
+
+// PayloadStore persists the payloads buffered by the state provider of a channel,
+// so that a peer doesn't fetch them again after it restarts
+type PayloadStore interface {
+	// Put persists the given payload, unless the store is full
+	Put(payload *proto.Payload) error
+
+	// Delete removes the payload with the given sequence number
+	Delete(seqNum uint64) error
+
+	// Load returns the persisted payloads with a sequence number of at least next,
+	// ordered by sequence number. Payloads with lower sequence numbers and payloads
+	// that can't be read are removed from the store.
+	Load(next uint64) ([]*proto.Payload, error)
+}
+
+// PayloadStoreProvider provides the payload stores of the channels of the peer,
+// backed by a single leveldb
+type PayloadStoreProvider struct {
+	dbProvider *leveldbhelper.Provider
+	maxSize    int
+}
+
+// NewPayloadStoreProvider creates a PayloadStoreProvider that persists payloads under
+// the given path. The payloads persisted for each channel amount to at most maxSize bytes.
+func NewPayloadStoreProvider(dbPath string, maxSize int) *PayloadStoreProvider {
+	return &PayloadStoreProvider{
+		dbProvider: leveldbhelper.NewProvider(&leveldbhelper.Conf{DBPath: dbPath}),
+		maxSize:    maxSize,
+	}
+}
+
+// OpenStore returns the payload store of the given channel
+func (p *PayloadStoreProvider) OpenStore(chainID string) PayloadStore {
+	return &payloadStore{
+		db:      p.dbProvider.GetDBHandle(chainID),
+		maxSize: p.maxSize,
+		sizes:   make(map[uint64]int),
+	}
+}
+
+// Close closes the PayloadStoreProvider
+func (p *PayloadStoreProvider) Close() {
+	p.dbProvider.Close()
+}
+
+type payloadStore struct {
+	db      *leveldbhelper.DBHandle
+	maxSize int
+
+	lock  sync.Mutex
+	size  int
+	sizes map[uint64]int
+}
+
+func (ps *payloadStore) Put(payload *proto.Payload) error {
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+
+	if _, exists := ps.sizes[payload.SeqNum]; exists {
+		return nil
+	}
+	bytes, err := pb.Marshal(payload)
+	if err != nil {
+		return errors.Wrapf(err, "failed marshaling payload %d", payload.SeqNum)
+	}
+	if ps.size+len(bytes) > ps.maxSize {
+		return errors.Errorf("payload store is full, cannot persist payload %d of %d bytes", payload.SeqNum, len(bytes))
+	}
+	if err := ps.db.Put(seqNumKey(payload.SeqNum), bytes, false); err != nil {
+		return errors.Wrapf(err, "failed persisting payload %d", payload.SeqNum)
+	}
+	ps.sizes[payload.SeqNum] = len(bytes)
+	ps.size += len(bytes)
+	return nil
+}
+
+func (ps *payloadStore) Delete(seqNum uint64) error {
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+
+	size, exists := ps.sizes[seqNum]
+	if !exists {
+		return nil
+	}
+	if err := ps.db.Delete(seqNumKey(seqNum), false); err != nil {
+		return errors.Wrapf(err, "failed removing payload %d", seqNum)
+	}
+	delete(ps.sizes, seqNum)
+	ps.size -= size
+	return nil
+}
+
+func (ps *payloadStore) Load(next uint64) ([]*proto.Payload, error) {
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+
+	var payloads []*proto.Payload
+	discarded := leveldbhelper.NewUpdateBatch()
+	itr := ps.db.GetIterator(nil, nil)
+	for itr.Next() {
+		key := append([]byte(nil), itr.Key()...)
+		payload := &proto.Payload{}
+		if len(key) != 8 || pb.Unmarshal(itr.Value(), payload) != nil || payload.SeqNum != binary.BigEndian.Uint64(key) {
+			logger.Warningf("Discarding unreadable payload persisted under key %x", key)
+			discarded.Delete(key)
+			continue
+		}
+		if payload.SeqNum < next {
+			discarded.Delete(key)
+			continue
+		}
+		payloads = append(payloads, payload)
+		ps.sizes[payload.SeqNum] = len(itr.Value())
+		ps.size += len(itr.Value())
+	}
+	itr.Release()
+	if err := itr.Error(); err != nil {
+		return nil, errors.Wrap(err, "failed reading persisted payloads")
+	}
+
+	if err := ps.db.WriteBatch(discarded, true); err != nil {
+		return nil, errors.Wrap(err, "failed discarding persisted payloads")
+	}
+	return payloads, nil
+}
+
+func seqNumKey(seqNum uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seqNum)
+	return key
+}
+
+// persistentBuffer is a PayloadsBuffer that persists the payloads it holds
+type persistentBuffer struct {
+	PayloadsBuffer
+	store   PayloadStore
+	chainID string
+}
+
+func (b *persistentBuffer) Push(payload *proto.Payload) {
+	if payload.SeqNum >= b.Next() {
+		if err := b.store.Put(payload); err != nil {
+			logger.Debugf("[%s] Not persisting payload: %s", b.chainID, err)
+		}
+	}
+	b.PayloadsBuffer.Push(payload)
+}
+
+func (b *persistentBuffer) Pop() *proto.Payload {
+	payload := b.PayloadsBuffer.Pop()
+	if payload != nil {
+		if err := b.store.Delete(payload.SeqNum); err != nil {
+			logger.Warningf("[%s] %s", b.chainID, err)
+		}
+	}
+	return payload
+}
@@ -0,0 +1,206 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package state
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	pb "github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/gossip/common"
+	"github.com/hyperledger/fabric/gossip/discovery"
+	"github.com/hyperledger/fabric/gossip/metrics"
+	"github.com/hyperledger/fabric/gossip/protoext"
+	"github.com/hyperledger/fabric/gossip/state/mocks"
+	pcomm "github.com/hyperledger/fabric/protos/common"
+	proto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func blockPayload(seqNum uint64) *proto.Payload {
+	return &proto.Payload{
+		SeqNum: seqNum,
+		Data:   protoutil.MarshalOrPanic(protoutil.NewBlock(seqNum, []byte{})),
+	}
+}
+
+func payloadSize(seqNum uint64) int {
+	return len(protoutil.MarshalOrPanic(blockPayload(seqNum)))
+}
+
+func TestPayloadStore(t *testing.T) {
+	dbPath, err := ioutil.TempDir("", "payloadstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbPath)
+
+	provider := NewPayloadStoreProvider(dbPath, 3*payloadSize(1))
+	store := provider.OpenStore("A")
+	for seqNum := uint64(1); seqNum <= 3; seqNum++ {
+		assert.NoError(t, store.Put(blockPayload(seqNum)))
+	}
+	// Persisting a payload twice doesn't account for it twice
+	assert.NoError(t, store.Put(blockPayload(3)))
+	// The store is full
+	assert.EqualError(t, store.Put(blockPayload(4)), "payload store is full, cannot persist payload 4 of 20 bytes")
+	assert.NoError(t, store.Delete(1))
+	assert.NoError(t, store.Put(blockPayload(4)))
+	// Deleting a payload that isn't persisted is a no-op
+	assert.NoError(t, store.Delete(10))
+
+	// Stores of other channels are separate
+	assert.NoError(t, provider.OpenStore("B").Put(blockPayload(5)))
+	provider.Close()
+
+	provider = NewPayloadStoreProvider(dbPath, 3*payloadSize(1))
+	defer provider.Close()
+	store = provider.OpenStore("A")
+	payloads, err := store.Load(3)
+	assert.NoError(t, err)
+	assert.Len(t, payloads, 2)
+	assert.True(t, pb.Equal(blockPayload(3), payloads[0]))
+	assert.True(t, pb.Equal(blockPayload(4), payloads[1]))
+
+	// Payloads lower than the next sequence number were discarded
+	store = provider.OpenStore("A")
+	payloads, err = store.Load(0)
+	assert.NoError(t, err)
+	assert.Len(t, payloads, 2)
+
+	payloads, err = provider.OpenStore("B").Load(0)
+	assert.NoError(t, err)
+	assert.Len(t, payloads, 1)
+	assert.Equal(t, uint64(5), payloads[0].SeqNum)
+}
+
+func TestPayloadStoreCorruption(t *testing.T) {
+	dbPath, err := ioutil.TempDir("", "payloadstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbPath)
+
+	provider := NewPayloadStoreProvider(dbPath, 1024)
+	defer provider.Close()
+	store := provider.OpenStore("A")
+	assert.NoError(t, store.Put(blockPayload(1)))
+	assert.NoError(t, store.Put(blockPayload(2)))
+
+	db := provider.dbProvider.GetDBHandle("A")
+	// A payload that can't be unmarshaled
+	assert.NoError(t, db.Put(seqNumKey(3), []byte{0xff, 0xff}, true))
+	// A payload persisted under the key of another sequence number
+	assert.NoError(t, db.Put(seqNumKey(4), protoutil.MarshalOrPanic(blockPayload(5)), true))
+	// A malformed key
+	assert.NoError(t, db.Put([]byte("foo"), protoutil.MarshalOrPanic(blockPayload(6)), true))
+
+	payloads, err := provider.OpenStore("A").Load(1)
+	assert.NoError(t, err)
+	assert.Len(t, payloads, 2)
+	assert.Equal(t, uint64(1), payloads[0].SeqNum)
+	assert.Equal(t, uint64(2), payloads[1].SeqNum)
+
+	for _, key := range [][]byte{seqNumKey(3), seqNumKey(4), []byte("foo")} {
+		value, err := db.Get(key)
+		assert.NoError(t, err)
+		assert.Nil(t, value)
+	}
+}
+
+type payloadStoreMock struct {
+	mock.Mock
+}
+
+func (ps *payloadStoreMock) Put(payload *proto.Payload) error {
+	return ps.Called(payload.SeqNum).Error(0)
+}
+
+func (ps *payloadStoreMock) Delete(seqNum uint64) error {
+	return ps.Called(seqNum).Error(0)
+}
+
+func (ps *payloadStoreMock) Load(next uint64) ([]*proto.Payload, error) {
+	args := ps.Called(next)
+	return args.Get(0).([]*proto.Payload), args.Error(1)
+}
+
+func TestPersistentBuffer(t *testing.T) {
+	store := &payloadStoreMock{}
+	store.On("Put", uint64(1)).Return(nil)
+	store.On("Put", uint64(2)).Return(errors.New("payload store is full"))
+	store.On("Delete", uint64(1)).Return(nil)
+	store.On("Delete", uint64(2)).Return(nil)
+	buffer := newPayloadsBuffer("A", 1, store)
+
+	buffer.Push(blockPayload(1))
+	buffer.Push(blockPayload(2))
+	assert.Equal(t, 2, buffer.Size())
+
+	assert.Equal(t, uint64(1), buffer.Pop().SeqNum)
+	assert.Equal(t, uint64(2), buffer.Pop().SeqNum)
+	assert.Nil(t, buffer.Pop())
+	store.AssertNumberOfCalls(t, "Delete", 2)
+
+	// Payloads that were already popped aren't persisted
+	buffer.Push(blockPayload(1))
+	store.AssertNumberOfCalls(t, "Put", 2)
+}
+
+type rejectingCryptoService struct {
+	cryptoServiceMock
+	rejected uint64
+}
+
+func (cs *rejectingCryptoService) VerifyBlock(chainID common.ChainID, seqNum uint64, signedBlock []byte) error {
+	if seqNum == cs.rejected {
+		return errors.New("bad signature")
+	}
+	return nil
+}
+
+func TestLoadPersistedPayloads(t *testing.T) {
+	// Scenario: the state provider of a peer restarting commits the payloads
+	// it persisted before, apart from payloads failing verification
+	dbPath, err := ioutil.TempDir("", "payloadstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbPath)
+
+	provider := NewPayloadStoreProvider(dbPath, 1024)
+	defer provider.Close()
+	store := provider.OpenStore(util.GetTestChainID())
+	for seqNum := uint64(1); seqNum <= 4; seqNum++ {
+		assert.NoError(t, store.Put(blockPayload(seqNum)))
+	}
+
+	g := &mocks.GossipMock{}
+	g.On("Accept", mock.Anything, false).Return(make(<-chan *proto.GossipMessage), nil)
+	g.On("Accept", mock.Anything, true).Return(nil, make(chan protoext.ReceivedMessage))
+	g.On("PeersOfChannel", mock.Anything).Return([]discovery.NetworkMember{})
+
+	committed := make(chan uint64, 4)
+	coord := new(coordinatorMock)
+	coord.On("LedgerHeight", mock.Anything).Return(uint64(1), nil)
+	coord.On("Close")
+	coord.On("StoreBlock", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		committed <- args.Get(0).(*pcomm.Block).Header.Number
+	}).Return(nil, nil)
+
+	mcs := &rejectingCryptoService{cryptoServiceMock: cryptoServiceMock{acceptor: noopPeerIdentityAcceptor}, rejected: 3}
+	servicesAdapater := &ServicesMediator{GossipAdapter: g, MCSAdapter: mcs}
+	stateMetrics := metrics.NewGossipMetrics(&disabled.Provider{}).StateMetrics
+	st := NewGossipStateProvider(util.GetTestChainID(), servicesAdapater, coord, stateMetrics, blocking,
+		provider.OpenStore(util.GetTestChainID()), nil)
+	defer st.Stop()
+
+	assert.Equal(t, uint64(1), <-committed)
+	assert.Equal(t, uint64(2), <-committed)
+	assert.Equal(t, 1, st.(*GossipStateProviderImpl).payloads.Size())
+}
@@ -8,6 +8,9 @@ package state
 
 import (
 	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -49,6 +52,20 @@ const (
 
 	defMaxBlockDistance = 100
 
+	// Peers lagging behind by more than defBulkTransferThreshold blocks fetch
+	// them over a state transfer stream to a single peer, in compressed batches of
+	// defBulkTransferBatchSize blocks which span at most defBulkTransferMaxBytes,
+	// unless a single block is larger
+	defBulkTransferThreshold       = 1000
+	defBulkTransferBatchSize       = 100
+	defBulkTransferMaxBytes        = 10 * 1024 * 1024
+	defBulkTransferResponseTimeout = 10 * time.Second
+
+	// defMaxBlockBytes is the maximum size of a block when the
+	// channel's maximum block size is not known, which is the
+	// maximum size of a gRPC message
+	defMaxBlockBytes = 100 * 1024 * 1024
+
 	blocking    = true
 	nonBlocking = false
 
@@ -73,6 +90,10 @@ type GossipAdapter interface {
 	// PeersOfChannel returns the NetworkMembers considered alive
 	// and also subscribed to the channel given
 	PeersOfChannel(common2.ChainID) []discovery.NetworkMember
+
+	// OpenStateTransferStream opens a dedicated stream to the given peer,
+	// over which blocks are requested in bulk
+	OpenStateTransferStream(peer *comm.RemotePeer) (comm.StateTransferStream, error)
 }
 
 // MCSAdapter adapter of message crypto service interface to bound
@@ -155,6 +176,8 @@ type GossipStateProviderImpl struct {
 	requestValidator *stateRequestValidator
 
 	blockingMode bool
+
+	maxBlockBytes func() uint32
 }
 
 var logger = util.GetLogger(util.StateLogger, "")
@@ -163,23 +186,34 @@ var logger = util.GetLogger(util.StateLogger, "")
 type stateRequestValidator struct {
 }
 
-// validate checks for RemoteStateRequest message validity
-func (v *stateRequestValidator) validate(request *proto.RemoteStateRequest) error {
+// validate checks for RemoteStateRequest message validity, allowing
+// larger batches for requests sent over a state transfer stream
+func (v *stateRequestValidator) validate(request *proto.RemoteStateRequest, bulk bool) error {
 	if request.StartSeqNum > request.EndSeqNum {
 		return errors.Errorf("Invalid sequence interval [%d...%d).", request.StartSeqNum, request.EndSeqNum)
 	}
 
-	if request.EndSeqNum > defAntiEntropyBatchSize+request.StartSeqNum {
+	batchSize := uint64(defAntiEntropyBatchSize)
+	if bulk {
+		batchSize = defBulkTransferBatchSize
+	}
+
+	if request.EndSeqNum > batchSize+request.StartSeqNum {
 		return errors.Errorf("Requesting blocks range [%d-%d) greater than configured allowed"+
-			" (%d) batching size for anti-entropy.", request.StartSeqNum, request.EndSeqNum, defAntiEntropyBatchSize)
+			" (%d) batching size for anti-entropy.", request.StartSeqNum, request.EndSeqNum, batchSize)
 	}
 	return nil
 }
 
 // NewGossipStateProvider creates state provider with coordinator instance
 // to orchestrate arrival of private rwsets and blocks before committing them into the ledger.
+// If a payload store is given, the payloads buffered but not yet committed are persisted
+// into it, and the payloads persisted before the peer restarted are buffered again.
+// maxBlockBytes returns the maximum size of the blocks of the channel, which bounds
+// the size of the blocks transferred in bulk; if it is nil, defMaxBlockBytes is assumed.
 func NewGossipStateProvider(chainID string, services *ServicesMediator, ledger ledgerResources,
-	stateMetrics *metrics.StateMetrics, blockingMode bool) GossipStateProvider {
+	stateMetrics *metrics.StateMetrics, blockingMode bool, payloadStore PayloadStore,
+	maxBlockBytes func() uint32) GossipStateProvider {
 
 	gossipChan, _ := services.Accept(func(message interface{}) bool {
 		// Get only data messages
@@ -238,7 +272,7 @@ func NewGossipStateProvider(chainID string, services *ServicesMediator, ledger l
 
 		// Create a queue for payloads, wrapped in a metrics buffer
 		payloads: &metricsBuffer{
-			PayloadsBuffer: NewPayloadsBuffer(height),
+			PayloadsBuffer: newPayloadsBuffer(chainID, height, payloadStore),
 			sizeMetrics:    stateMetrics.PayloadBufferSize,
 			chainID:        chainID,
 		},
@@ -260,6 +294,12 @@ func NewGossipStateProvider(chainID string, services *ServicesMediator, ledger l
 		requestValidator: &stateRequestValidator{},
 
 		blockingMode: blockingMode,
+
+		maxBlockBytes: maxBlockBytes,
+	}
+
+	if payloadStore != nil {
+		s.loadPersistedPayloads(payloadStore, height)
 	}
 
 	logger.Infof("Updating metadata information, "+
@@ -281,6 +321,42 @@ func NewGossipStateProvider(chainID string, services *ServicesMediator, ledger l
 	return s
 }
 
+// newPayloadsBuffer creates the payloads buffer of the channel,
+// which persists the payloads into the given payload store, if any
+func newPayloadsBuffer(chainID string, next uint64, payloadStore PayloadStore) PayloadsBuffer {
+	if payloadStore == nil {
+		return NewPayloadsBuffer(next)
+	}
+	return &persistentBuffer{
+		PayloadsBuffer: NewPayloadsBuffer(next),
+		store:          payloadStore,
+		chainID:        chainID,
+	}
+}
+
+// loadPersistedPayloads buffers the payloads persisted before the peer restarted,
+// discarding the ones that fail verification
+func (s *GossipStateProviderImpl) loadPersistedPayloads(payloadStore PayloadStore, height uint64) {
+	payloads, err := payloadStore.Load(height)
+	if err != nil {
+		logger.Warningf("[%s] Failed loading persisted payloads: %+v", s.chainID, err)
+		return
+	}
+	for _, payload := range payloads {
+		if err := s.mediator.VerifyBlock(common2.ChainID(s.chainID), payload.SeqNum, payload.Data); err != nil {
+			logger.Warningf("[%s] Discarding persisted payload %d which failed verification: %s", s.chainID, payload.SeqNum, err)
+			if err := payloadStore.Delete(payload.SeqNum); err != nil {
+				logger.Warningf("[%s] %s", s.chainID, err)
+			}
+			continue
+		}
+		s.payloads.Push(payload)
+	}
+	if len(payloads) > 0 {
+		logger.Infof("[%s] Loaded %d persisted payloads", s.chainID, s.payloads.Size())
+	}
+}
+
 func (s *GossipStateProviderImpl) listen() {
 	defer s.done.Done()
 
@@ -417,8 +493,9 @@ func (s *GossipStateProviderImpl) handleStateRequest(msg protoext.ReceivedMessag
 		return
 	}
 	request := msg.GetGossipMessage().GetStateRequest()
+	bulk := comm.ReceivedOverStateTransferStream(msg)
 
-	if err := s.requestValidator.validate(request); err != nil {
+	if err := s.requestValidator.validate(request, bulk); err != nil {
 		logger.Errorf("State request validation failed, %s. Ignoring request...", err)
 		return
 	}
@@ -436,7 +513,13 @@ func (s *GossipStateProviderImpl) handleStateRequest(msg protoext.ReceivedMessag
 	endSeqNum := min(currentHeight, request.EndSeqNum)
 
 	response := &proto.RemoteStateResponse{Payloads: make([]*proto.Payload, 0)}
+	responseSize := 0
 	for seqNum := request.StartSeqNum; seqNum <= endSeqNum; seqNum++ {
+		if bulk && len(response.Payloads) > 0 && responseSize >= defBulkTransferMaxBytes {
+			logger.Debugf("Response to bulk state request reached %d bytes, truncating it at block %d", responseSize, seqNum)
+			break
+		}
+
 		logger.Debug("Reading block ", seqNum, " with private data from the coordinator service")
 		connInfo := msg.GetConnectionInfo()
 		peerAuthInfo := protoutil.SignedData{
@@ -479,6 +562,19 @@ func (s *GossipStateProviderImpl) handleStateRequest(msg protoext.ReceivedMessag
 			Data:        blockBytes,
 			PrivateData: pvtBytes,
 		})
+		responseSize += len(blockBytes)
+		for _, pvt := range pvtBytes {
+			responseSize += len(pvt)
+		}
+	}
+
+	if bulk {
+		compressed, err := compressPayloads(response)
+		if err != nil {
+			logger.Errorf("Failed compressing response to bulk state request: %+v", err)
+			return
+		}
+		response = &proto.RemoteStateResponse{CompressedPayloads: compressed}
 	}
 	// Sending back response with missing blocks
 	msg.Respond(&proto.GossipMessage{
@@ -491,14 +587,26 @@ func (s *GossipStateProviderImpl) handleStateRequest(msg protoext.ReceivedMessag
 }
 
 func (s *GossipStateProviderImpl) handleStateResponse(msg protoext.ReceivedMessage) (uint64, error) {
-	max := uint64(0)
 	// Send signal that response for given nonce has been received
-	response := msg.GetGossipMessage().GetStateResponse()
+	return s.processStateResponse(msg.GetGossipMessage().GetStateResponse())
+}
+
+// processStateResponse verifies the payloads of the given state response and pushes
+// them into the payloads buffer, returning the highest sequence number among them
+func (s *GossipStateProviderImpl) processStateResponse(response *proto.RemoteStateResponse) (uint64, error) {
+	max := uint64(0)
+	payloads := response.GetPayloads()
+	if len(response.GetCompressedPayloads()) > 0 {
+		var err error
+		if payloads, err = decompressPayloads(response.CompressedPayloads, s.maxBulkStateResponseBytes()); err != nil {
+			return uint64(0), err
+		}
+	}
 	// Extract payloads, verify and push into buffer
-	if len(response.GetPayloads()) == 0 {
+	if len(payloads) == 0 {
 		return uint64(0), errors.New("Received state transfer response without payload")
 	}
-	for _, payload := range response.GetPayloads() {
+	for _, payload := range payloads {
 		logger.Debugf("Received payload with sequence number %d.", payload.SeqNum)
 		if err := s.mediator.VerifyBlock(common2.ChainID(s.chainID), payload.SeqNum, payload.Data); err != nil {
 			err = errors.WithStack(err)
@@ -649,10 +757,20 @@ func (s *GossipStateProviderImpl) maxAvailableLedgerHeight() uint64 {
 
 // requestBlocksInRange capable to acquire blocks with sequence
 // numbers in the range [start...end).
+// Large ranges are first transferred in bulk over a state transfer stream;
+// the blocks which could not be transferred in bulk are requested in
+// batches of the regular size.
 func (s *GossipStateProviderImpl) requestBlocksInRange(start uint64, end uint64) {
 	atomic.StoreInt32(&s.stateTransferActive, 1)
 	defer atomic.StoreInt32(&s.stateTransferActive, 0)
 
+	if end-start >= defBulkTransferThreshold {
+		var stopped bool
+		if start, stopped = s.transferBlocksInBulk(start, end); stopped {
+			return
+		}
+	}
+
 	for prev := start; prev <= end; {
 		next := min(end, prev+defAntiEntropyBatchSize)
 
@@ -668,7 +786,7 @@ func (s *GossipStateProviderImpl) requestBlocksInRange(start uint64, end uint64)
 				return
 			}
 			// Select peers to ask for blocks
-			peer, err := s.selectPeerToRequestFrom(next)
+			peer, err := s.selectPeerToRequestFrom(s.hasRequiredHeight(next))
 			if err != nil {
 				logger.Warningf("Cannot send state request for blocks in range [%d...%d), due to %+v",
 					prev, next, errors.WithStack(err))
@@ -705,6 +823,74 @@ func (s *GossipStateProviderImpl) requestBlocksInRange(start uint64, end uint64)
 	}
 }
 
+// transferBlocksInBulk transfers the blocks in the range [start...end] over a state
+// transfer stream to a peer which serves them, switching to another peer whenever
+// the stream fails, up to defAntiEntropyMaxRetries times. It returns the sequence
+// number of the first block not transferred, and whether the state provider was stopped.
+func (s *GossipStateProviderImpl) transferBlocksInBulk(start uint64, end uint64) (uint64, bool) {
+	for tryCounts := 0; tryCounts <= defAntiEntropyMaxRetries && start <= end; tryCounts++ {
+		peer, err := s.selectPeerToRequestFrom(s.servesBulkStateTransfer(end))
+		if err != nil {
+			logger.Infof("[%s] Ledger is %d blocks behind, but no peer serves bulk state transfer", s.chainID, end-start+1)
+			return start, false
+		}
+
+		logger.Infof("[%s] Ledger is %d blocks behind, starting bulk state transfer with peer %s",
+			s.chainID, end-start+1, peer.Endpoint)
+		next, stopped, err := s.transferBlocksFrom(peer, start, end)
+		if stopped {
+			return next, true
+		}
+		if err != nil {
+			logger.Warningf("[%s] Bulk state transfer with peer %s stopped at block %d, due to %+v",
+				s.chainID, peer.Endpoint, next, err)
+		}
+		start = next
+	}
+	return start, false
+}
+
+// transferBlocksFrom transfers the blocks in the range [start...end] over a state transfer
+// stream to the given peer, until the stream fails. It returns the sequence number of the
+// first block not transferred, and whether the state provider was stopped.
+func (s *GossipStateProviderImpl) transferBlocksFrom(peer *comm.RemotePeer, start uint64, end uint64) (uint64, bool, error) {
+	stream, err := s.mediator.OpenStateTransferStream(peer)
+	if err != nil {
+		return start, false, err
+	}
+	defer stream.Close()
+
+	for start <= end {
+		select {
+		case <-s.stopCh:
+			s.stopCh <- struct{}{}
+			return start, true, nil
+		default:
+		}
+
+		next := min(end, start+defBulkTransferBatchSize)
+		request := s.stateRequestMessage(start, next)
+		if err := stream.Send(request); err != nil {
+			return start, false, err
+		}
+
+		msg, err := stream.Recv(defBulkTransferResponseTimeout)
+		if err != nil {
+			return start, false, err
+		}
+		if msg.Nonce != request.Nonce || msg.GetStateResponse() == nil {
+			return start, false, errors.Errorf("expected a state response with nonce %d, got %s", request.Nonce, msg)
+		}
+
+		index, err := s.processStateResponse(msg.GetStateResponse())
+		if err != nil {
+			return start, false, err
+		}
+		start = index + 1
+	}
+	return start, false, nil
+}
+
 // stateRequestMessage generates state request message for given blocks in range [beginSeq...endSeq]
 func (s *GossipStateProviderImpl) stateRequestMessage(beginSeq uint64, endSeq uint64) *proto.GossipMessage {
 	return &proto.GossipMessage{
@@ -721,9 +907,9 @@ func (s *GossipStateProviderImpl) stateRequestMessage(beginSeq uint64, endSeq ui
 }
 
 // selectPeerToRequestFrom selects peer which has required blocks to ask missing blocks from
-func (s *GossipStateProviderImpl) selectPeerToRequestFrom(height uint64) (*comm.RemotePeer, error) {
+func (s *GossipStateProviderImpl) selectPeerToRequestFrom(predicate func(peer discovery.NetworkMember) bool) (*comm.RemotePeer, error) {
 	// Filter peers which posses required range of missing blocks
-	peers := s.filterPeers(s.hasRequiredHeight(height))
+	peers := s.filterPeers(predicate)
 
 	n := len(peers)
 	if n == 0 {
@@ -759,6 +945,15 @@ func (s *GossipStateProviderImpl) hasRequiredHeight(height uint64) func(peer dis
 	}
 }
 
+// servesBulkStateTransfer returns predicate which is capable to filter peers with ledger height above than
+// indicated by provided input parameter, and which advertise that they serve state transfer streams
+func (s *GossipStateProviderImpl) servesBulkStateTransfer(height uint64) func(peer discovery.NetworkMember) bool {
+	hasRequiredHeight := s.hasRequiredHeight(height)
+	return func(peer discovery.NetworkMember) bool {
+		return hasRequiredHeight(peer) && peer.Properties.BulkStateTransfer
+	}
+}
+
 // AddPayload adds new payload into state.
 func (s *GossipStateProviderImpl) AddPayload(payload *proto.Payload) error {
 	return s.addPayload(payload, s.blockingMode)
@@ -813,6 +1008,61 @@ func (s *GossipStateProviderImpl) commitBlock(block *common.Block, pvtData util.
 	return nil
 }
 
+// compressPayloads returns the gzipped serialization of the given state response
+func compressPayloads(response *proto.RemoteStateResponse) ([]byte, error) {
+	responseBytes, err := pb.Marshal(response)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed marshaling state response")
+	}
+
+	buf := &bytes.Buffer{}
+	gw := gzip.NewWriter(buf)
+	if _, err := gw.Write(responseBytes); err != nil {
+		return nil, errors.Wrap(err, "failed compressing state response")
+	}
+	if err := gw.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed compressing state response")
+	}
+	return buf.Bytes(), nil
+}
+
+// maxBulkStateResponseBytes returns the maximum size of a decompressed state response.
+// Responses are truncated once they reach defBulkTransferMaxBytes, so they may exceed it
+// by one block and its private data, which is assumed not to be larger than the block.
+func (s *GossipStateProviderImpl) maxBulkStateResponseBytes() int {
+	maxBlockBytes := uint32(defMaxBlockBytes)
+	if s.maxBlockBytes != nil {
+		if n := s.maxBlockBytes(); n > 0 {
+			maxBlockBytes = n
+		}
+	}
+	return defBulkTransferMaxBytes + 2*int(maxBlockBytes)
+}
+
+// decompressPayloads returns the payloads of a state response compressed by compressPayloads,
+// failing if the decompressed response exceeds maxBytes
+func decompressPayloads(compressed []byte, maxBytes int) ([]*proto.Payload, error) {
+	gr, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed decompressing state response")
+	}
+	defer gr.Close()
+
+	responseBytes, err := ioutil.ReadAll(io.LimitReader(gr, int64(maxBytes)+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed decompressing state response")
+	}
+	if len(responseBytes) > maxBytes {
+		return nil, errors.Errorf("decompressed state response exceeds %d bytes", maxBytes)
+	}
+
+	response := &proto.RemoteStateResponse{}
+	if err := pb.Unmarshal(responseBytes, response); err != nil {
+		return nil, errors.Wrap(err, "failed unmarshaling decompressed state response")
+	}
+	return response.Payloads, nil
+}
+
 func min(a uint64, b uint64) uint64 {
 	return b ^ ((a ^ b) & (-(uint64(a-b) >> 63)))
 }
@@ -409,7 +409,7 @@ func newPeerNodeWithGossipWithValidatorWithMetrics(id int, committer committer.C
 		TransientStore: &mockTransientStore{},
 		Committer:      committer,
 	}, protoutil.SignedData{}, gossipMetrics.PrivdataMetrics, coordConfig)
-	sp := NewGossipStateProvider(util.GetTestChainID(), servicesAdapater, coord, gossipMetrics.StateMetrics, blocking)
+	sp := NewGossipStateProvider(util.GetTestChainID(), servicesAdapater, coord, gossipMetrics.StateMetrics, blocking, nil, nil)
 	if sp == nil {
 		gRPCServer.Stop()
 		return nil, port
@@ -584,6 +584,248 @@ func TestLargeBlockGap(t *testing.T) {
 	}
 }
 
+func TestBulkStateTransfer(t *testing.T) {
+	// Scenario: the peer knows of two peers with a ledger height
+	// far higher than its own. It fetches the missing blocks in bulk,
+	// in compressed batches, over a state transfer stream to a single peer.
+	t.Parallel()
+	g := &mocks.GossipMock{}
+	stream := &stateTransferStreamMock{responses: make(chan *protoext.SignedGossipMessage, 1)}
+	var lock sync.Mutex
+	streamPeers := map[string]struct{}{}
+	g.On("OpenStateTransferStream", mock.Anything).Return(stream, nil).Run(func(arguments mock.Arguments) {
+		lock.Lock()
+		defer lock.Unlock()
+		streamPeers[arguments.Get(0).(*comm.RemotePeer).Endpoint] = struct{}{}
+	})
+
+	requests := transferManyBlocks(t, g, true)
+	assert.Empty(t, requests)
+	lock.Lock()
+	assert.Len(t, streamPeers, 1)
+	lock.Unlock()
+	stream.lock.Lock()
+	defer stream.lock.Unlock()
+	assert.NotEmpty(t, stream.requests)
+	for _, req := range stream.requests {
+		assert.True(t, req.EndSeqNum-req.StartSeqNum <= defBulkTransferBatchSize)
+	}
+}
+
+func TestBulkStateTransferFallback(t *testing.T) {
+	// Scenario: the peer knows of two peers with a ledger height far
+	// higher than its own, but they are of an older version and do not
+	// serve state transfer streams. It fetches the missing blocks in
+	// batches of the regular size.
+	t.Parallel()
+	g := &mocks.GossipMock{}
+	requests := transferManyBlocks(t, g, false)
+	g.AssertNotCalled(t, "OpenStateTransferStream", mock.Anything)
+	for _, req := range requests {
+		assert.True(t, req.EndSeqNum-req.StartSeqNum <= defAntiEntropyBatchSize)
+	}
+}
+
+func TestBulkStateTransferStreamFailure(t *testing.T) {
+	// Scenario: the peer knows of two peers with a ledger height far
+	// higher than its own, which serve state transfer streams, but the
+	// streams cannot be opened. It fetches the missing blocks in batches
+	// of the regular size.
+	t.Parallel()
+	g := &mocks.GossipMock{}
+	g.On("OpenStateTransferStream", mock.Anything).Return(nil, errors.New("connection refused"))
+	requests := transferManyBlocks(t, g, true)
+	g.AssertNumberOfCalls(t, "OpenStateTransferStream", defAntiEntropyMaxRetries+1)
+	assert.NotEmpty(t, requests)
+	for _, req := range requests {
+		assert.True(t, req.EndSeqNum-req.StartSeqNum <= defAntiEntropyBatchSize)
+	}
+}
+
+// transferManyBlocks makes a peer using the given gossip mock fetch 1499 blocks from
+// two peers, which advertise that they serve state transfer streams if bulk is set,
+// and returns the state requests it sent over gossip
+func transferManyBlocks(t *testing.T, g *mocks.GossipMock, bulk bool) []*proto.RemoteStateRequest {
+	mc := &mockCommitter{Mock: &mock.Mock{}}
+	blocksPassedToLedger := make(chan uint64, 2000)
+	mc.On("CommitWithPvtData", mock.Anything).Run(func(arg mock.Arguments) {
+		blocksPassedToLedger <- arg.Get(0).(*pcomm.Block).Header.Number
+	})
+	msgsFromPeer := make(chan protoext.ReceivedMessage)
+	mc.On("LedgerHeight", mock.Anything).Return(uint64(1), nil)
+	membership := []discovery.NetworkMember{
+		{
+			PKIid:      common.PKIidType("a"),
+			Endpoint:   "a",
+			Properties: &proto.Properties{LedgerHeight: 1500, BulkStateTransfer: bulk},
+		},
+		{
+			PKIid:      common.PKIidType("b"),
+			Endpoint:   "b",
+			Properties: &proto.Properties{LedgerHeight: 1500, BulkStateTransfer: bulk},
+		},
+	}
+	g.On("PeersOfChannel", mock.Anything).Return(membership)
+	g.On("Accept", mock.Anything, false).Return(make(<-chan *proto.GossipMessage), nil)
+	g.On("Accept", mock.Anything, true).Return(nil, msgsFromPeer)
+
+	var lock sync.Mutex
+	var requests []*proto.RemoteStateRequest
+	g.On("Send", mock.Anything, mock.Anything).Run(func(arguments mock.Arguments) {
+		msg := arguments.Get(0).(*proto.GossipMessage)
+		req := msg.GetStateRequest()
+		lock.Lock()
+		requests = append(requests, req)
+		lock.Unlock()
+
+		sMsg, _ := protoext.NoopSign(&proto.GossipMessage{
+			Nonce:   msg.Nonce,
+			Channel: []byte(util.GetTestChainID()),
+			Content: &proto.GossipMessage_StateResponse{
+				StateResponse: &proto.RemoteStateResponse{Payloads: blockPayloads(req.StartSeqNum, req.EndSeqNum)},
+			},
+		})
+		msgsFromPeer <- &comm.ReceivedMessageImpl{
+			SignedGossipMessage: sMsg,
+		}
+	})
+	p := newPeerNodeWithGossip(0, mc, noopPeerIdentityAcceptor, g)
+	defer p.shutdown()
+
+	for expectedSequence := 1; expectedSequence < 1500; expectedSequence++ {
+		assert.Equal(t, expectedSequence, int(<-blocksPassedToLedger))
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	return requests
+}
+
+// blockPayloads returns the payloads of empty blocks with sequence numbers in the range [start...end]
+func blockPayloads(start uint64, end uint64) []*proto.Payload {
+	var payloads []*proto.Payload
+	for seq := start; seq <= end; seq++ {
+		b, _ := pb.Marshal(protoutil.NewBlock(seq, []byte{}))
+		payloads = append(payloads, &proto.Payload{SeqNum: seq, Data: b})
+	}
+	return payloads
+}
+
+// stateTransferStreamMock answers the state requests sent over it
+// with the compressed payloads of the requested blocks
+type stateTransferStreamMock struct {
+	lock      sync.Mutex
+	requests  []*proto.RemoteStateRequest
+	responses chan *protoext.SignedGossipMessage
+}
+
+func (s *stateTransferStreamMock) Send(msg *proto.GossipMessage) error {
+	req := msg.GetStateRequest()
+	s.lock.Lock()
+	s.requests = append(s.requests, req)
+	s.lock.Unlock()
+
+	compressed, err := compressPayloads(&proto.RemoteStateResponse{Payloads: blockPayloads(req.StartSeqNum, req.EndSeqNum)})
+	if err != nil {
+		return err
+	}
+	sMsg, err := protoext.NoopSign(&proto.GossipMessage{
+		Nonce:   msg.Nonce,
+		Channel: []byte(util.GetTestChainID()),
+		Content: &proto.GossipMessage_StateResponse{
+			StateResponse: &proto.RemoteStateResponse{CompressedPayloads: compressed},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	s.responses <- sMsg
+	return nil
+}
+
+func (s *stateTransferStreamMock) Recv(timeout time.Duration) (*protoext.SignedGossipMessage, error) {
+	select {
+	case msg := <-s.responses:
+		return msg, nil
+	case <-time.After(timeout):
+		return nil, errors.New("timed out")
+	}
+}
+
+func (s *stateTransferStreamMock) Close() {}
+
+func TestStateTransferStream(t *testing.T) {
+	// Scenario: a peer requests blocks over a state transfer stream,
+	// and gets them in a response with compressed payloads
+	t.Parallel()
+	bootPeer, bootPort := newBootNode(0, newCommitter(), noopPeerIdentityAcceptor)
+	defer bootPeer.shutdown()
+
+	for i := 1; i <= 4; i++ {
+		b, err := pb.Marshal(protoutil.NewBlock(uint64(i), []byte{}))
+		assert.NoError(t, err)
+		bootPeer.s.AddPayload(&proto.Payload{SeqNum: uint64(i), Data: b})
+	}
+	waitUntilTrueOrTimeout(t, func() bool {
+		height, _ := bootPeer.commit.LedgerHeight()
+		return height == 5
+	}, 30*time.Second)
+
+	peer := newPeerNode(1, newCommitter(), noopPeerIdentityAcceptor, bootPort)
+	defer peer.shutdown()
+
+	chainID := common.ChainID(util.GetTestChainID())
+	waitUntilTrueOrTimeout(t, func() bool {
+		return len(peer.g.PeersOfChannel(chainID)) == 1
+	}, 30*time.Second)
+	member := peer.g.PeersOfChannel(chainID)[0]
+	assert.True(t, member.Properties.BulkStateTransfer)
+
+	stream, err := peer.g.OpenStateTransferStream(&comm.RemotePeer{Endpoint: member.PreferredEndpoint(), PKIID: member.PKIid})
+	assert.NoError(t, err)
+	defer stream.Close()
+
+	request := peer.s.stateRequestMessage(1, 4)
+	assert.NoError(t, stream.Send(request))
+	msg, err := stream.Recv(10 * time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, request.Nonce, msg.Nonce)
+
+	response := msg.GetStateResponse()
+	assert.Empty(t, response.Payloads)
+	payloads, err := decompressPayloads(response.CompressedPayloads, peer.s.maxBulkStateResponseBytes())
+	assert.NoError(t, err)
+	assert.Len(t, payloads, 4)
+	for i, payload := range payloads {
+		assert.Equal(t, uint64(i+1), payload.SeqNum)
+	}
+
+	// Over gossip, the same request is answered with uncompressed payloads
+	_, peerCh := peer.g.Accept(func(message interface{}) bool {
+		return message.(protoext.ReceivedMessage).GetGossipMessage().GetStateResponse() != nil
+	}, true)
+	peer.g.Send(request, &comm.RemotePeer{Endpoint: member.PreferredEndpoint(), PKIID: member.PKIid})
+	select {
+	case msg := <-peerCh:
+		response := msg.GetGossipMessage().GetStateResponse()
+		assert.Empty(t, response.CompressedPayloads)
+		assert.Len(t, response.Payloads, 4)
+	case <-time.After(10 * time.Second):
+		t.Fatal("Didn't get a state response over gossip")
+	}
+}
+
+func TestMaxBulkStateResponseBytes(t *testing.T) {
+	st := &GossipStateProviderImpl{}
+	assert.Equal(t, defBulkTransferMaxBytes+2*defMaxBlockBytes, st.maxBulkStateResponseBytes())
+
+	st.maxBlockBytes = func() uint32 { return 0 }
+	assert.Equal(t, defBulkTransferMaxBytes+2*defMaxBlockBytes, st.maxBulkStateResponseBytes())
+
+	st.maxBlockBytes = func() uint32 { return 1024 * 1024 }
+	assert.Equal(t, defBulkTransferMaxBytes+2*1024*1024, st.maxBulkStateResponseBytes())
+}
+
 func TestOverPopulation(t *testing.T) {
 	// Scenario: Add to the state provider blocks
 	// with a gap in between, and ensure that the payload buffer
@@ -1427,7 +1669,7 @@ func TestTransferOfPrivateRWSet(t *testing.T) {
 
 	servicesAdapater := &ServicesMediator{GossipAdapter: g, MCSAdapter: &cryptoServiceMock{acceptor: noopPeerIdentityAcceptor}}
 	stateMetrics := metrics.NewGossipMetrics(&disabled.Provider{}).StateMetrics
-	st := NewGossipStateProvider(chainID, servicesAdapater, coord1, stateMetrics, blocking)
+	st := NewGossipStateProvider(chainID, servicesAdapater, coord1, stateMetrics, blocking, nil, nil)
 	defer st.Stop()
 
 	// Mocked state request message
@@ -1661,11 +1903,11 @@ func TestTransferOfPvtDataBetweenPeers(t *testing.T) {
 	stateMetrics := metrics.NewGossipMetrics(&disabled.Provider{}).StateMetrics
 
 	mediator := &ServicesMediator{GossipAdapter: peers["peer1"], MCSAdapter: cryptoService}
-	peer1State := NewGossipStateProvider(chainID, mediator, peers["peer1"].coord, stateMetrics, blocking)
+	peer1State := NewGossipStateProvider(chainID, mediator, peers["peer1"].coord, stateMetrics, blocking, nil, nil)
 	defer peer1State.Stop()
 
 	mediator = &ServicesMediator{GossipAdapter: peers["peer2"], MCSAdapter: cryptoService}
-	peer2State := NewGossipStateProvider(chainID, mediator, peers["peer2"].coord, stateMetrics, blocking)
+	peer2State := NewGossipStateProvider(chainID, mediator, peers["peer2"].coord, stateMetrics, blocking, nil, nil)
 	defer peer2State.Stop()
 
 	// Make sure state was replicated
@@ -1688,22 +1930,56 @@ func TestStateRequestValidator(t *testing.T) {
 	err := validator.validate(&proto.RemoteStateRequest{
 		StartSeqNum: 10,
 		EndSeqNum:   5,
-	})
+	}, false)
 	assert.Contains(t, err.Error(), "Invalid sequence interval [10...5).")
 	assert.Error(t, err)
 
 	err = validator.validate(&proto.RemoteStateRequest{
 		StartSeqNum: 10,
 		EndSeqNum:   30,
-	})
+	}, false)
 	assert.Contains(t, err.Error(), "Requesting blocks range [10-30) greater than configured")
 	assert.Error(t, err)
 
 	err = validator.validate(&proto.RemoteStateRequest{
 		StartSeqNum: 10,
 		EndSeqNum:   20,
-	})
+	}, false)
+	assert.NoError(t, err)
+
+	err = validator.validate(&proto.RemoteStateRequest{
+		StartSeqNum: 10,
+		EndSeqNum:   110,
+	}, true)
 	assert.NoError(t, err)
+
+	err = validator.validate(&proto.RemoteStateRequest{
+		StartSeqNum: 10,
+		EndSeqNum:   111,
+	}, true)
+	assert.Contains(t, err.Error(), "Requesting blocks range [10-111) greater than configured allowed (100)")
+}
+
+func TestCompressPayloads(t *testing.T) {
+	payloads := []*proto.Payload{
+		{SeqNum: 1, Data: []byte("block 1")},
+		{SeqNum: 2, Data: []byte("block 2"), PrivateData: [][]byte{[]byte("private data")}},
+	}
+	compressed, err := compressPayloads(&proto.RemoteStateResponse{Payloads: payloads})
+	assert.NoError(t, err)
+
+	decompressed, err := decompressPayloads(compressed, 1024)
+	assert.NoError(t, err)
+	assert.Len(t, decompressed, 2)
+	for i := range payloads {
+		assert.True(t, pb.Equal(payloads[i], decompressed[i]))
+	}
+
+	_, err = decompressPayloads(compressed, 10)
+	assert.EqualError(t, err, "decompressed state response exceeds 10 bytes")
+
+	_, err = decompressPayloads([]byte("not gzipped"), 1024)
+	assert.EqualError(t, err, "failed decompressing state response: gzip: invalid header")
 }
 
 func waitUntilTrueOrTimeout(t *testing.T, predicate func() bool, timeout time.Duration) {
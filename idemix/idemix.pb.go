@@ -799,6 +799,151 @@ func (m *CredentialRevocationInformation) GetRevocationData() []byte {
 	return nil
 }
 
+// PlainSigNonRevocationData contains the revocation data of a CredentialRevocationInformation
+// created with the plain signature revocation algorithm, namely a signature on each
+// unrevoked revocation handle, valid under the epoch public key
+type PlainSigNonRevocationData struct {
+	Signatures           []*MessageSignature `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *PlainSigNonRevocationData) Reset()         { *m = PlainSigNonRevocationData{} }
+func (m *PlainSigNonRevocationData) String() string { return proto.CompactTextString(m) }
+func (*PlainSigNonRevocationData) ProtoMessage()    {}
+func (*PlainSigNonRevocationData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_idemix_9eaaac972d0b8c7e, []int{10}
+}
+func (m *PlainSigNonRevocationData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlainSigNonRevocationData.Unmarshal(m, b)
+}
+func (m *PlainSigNonRevocationData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlainSigNonRevocationData.Marshal(b, m, deterministic)
+}
+func (dst *PlainSigNonRevocationData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlainSigNonRevocationData.Merge(dst, src)
+}
+func (m *PlainSigNonRevocationData) XXX_Size() int {
+	return xxx_messageInfo_PlainSigNonRevocationData.Size(m)
+}
+func (m *PlainSigNonRevocationData) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlainSigNonRevocationData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlainSigNonRevocationData proto.InternalMessageInfo
+
+func (m *PlainSigNonRevocationData) GetSignatures() []*MessageSignature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+// MessageSignature is a weak Boneh-Boyen signature on a revocation handle
+type MessageSignature struct {
+	RevocationHandle     []byte   `protobuf:"bytes,1,opt,name=revocation_handle,json=revocationHandle,proto3" json:"revocation_handle,omitempty"`
+	RhSig                *ECP     `protobuf:"bytes,2,opt,name=rh_sig,json=rhSig,proto3" json:"rh_sig,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MessageSignature) Reset()         { *m = MessageSignature{} }
+func (m *MessageSignature) String() string { return proto.CompactTextString(m) }
+func (*MessageSignature) ProtoMessage()    {}
+func (*MessageSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_idemix_9eaaac972d0b8c7e, []int{11}
+}
+func (m *MessageSignature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MessageSignature.Unmarshal(m, b)
+}
+func (m *MessageSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MessageSignature.Marshal(b, m, deterministic)
+}
+func (dst *MessageSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageSignature.Merge(dst, src)
+}
+func (m *MessageSignature) XXX_Size() int {
+	return xxx_messageInfo_MessageSignature.Size(m)
+}
+func (m *MessageSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageSignature proto.InternalMessageInfo
+
+func (m *MessageSignature) GetRevocationHandle() []byte {
+	if m != nil {
+		return m.RevocationHandle
+	}
+	return nil
+}
+
+func (m *MessageSignature) GetRhSig() *ECP {
+	if m != nil {
+		return m.RhSig
+	}
+	return nil
+}
+
+// PlainSigNonRevocationProof proves knowledge of a signature on the revocation handle
+// of the credential, valid under the epoch public key, and thus that the credential
+// is not revoked
+type PlainSigNonRevocationProof struct {
+	SigmaPrime           *ECP     `protobuf:"bytes,1,opt,name=sigma_prime,json=sigmaPrime,proto3" json:"sigma_prime,omitempty"`
+	SigmaBar             *ECP     `protobuf:"bytes,2,opt,name=sigma_bar,json=sigmaBar,proto3" json:"sigma_bar,omitempty"`
+	ProofSR              []byte   `protobuf:"bytes,3,opt,name=proof_s_r,json=proofSR,proto3" json:"proof_s_r,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlainSigNonRevocationProof) Reset()         { *m = PlainSigNonRevocationProof{} }
+func (m *PlainSigNonRevocationProof) String() string { return proto.CompactTextString(m) }
+func (*PlainSigNonRevocationProof) ProtoMessage()    {}
+func (*PlainSigNonRevocationProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_idemix_9eaaac972d0b8c7e, []int{12}
+}
+func (m *PlainSigNonRevocationProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlainSigNonRevocationProof.Unmarshal(m, b)
+}
+func (m *PlainSigNonRevocationProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlainSigNonRevocationProof.Marshal(b, m, deterministic)
+}
+func (dst *PlainSigNonRevocationProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlainSigNonRevocationProof.Merge(dst, src)
+}
+func (m *PlainSigNonRevocationProof) XXX_Size() int {
+	return xxx_messageInfo_PlainSigNonRevocationProof.Size(m)
+}
+func (m *PlainSigNonRevocationProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlainSigNonRevocationProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlainSigNonRevocationProof proto.InternalMessageInfo
+
+func (m *PlainSigNonRevocationProof) GetSigmaPrime() *ECP {
+	if m != nil {
+		return m.SigmaPrime
+	}
+	return nil
+}
+
+func (m *PlainSigNonRevocationProof) GetSigmaBar() *ECP {
+	if m != nil {
+		return m.SigmaBar
+	}
+	return nil
+}
+
+func (m *PlainSigNonRevocationProof) GetProofSR() []byte {
+	if m != nil {
+		return m.ProofSR
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ECP)(nil), "ECP")
 	proto.RegisterType((*ECP2)(nil), "ECP2")
@@ -810,61 +955,71 @@ func init() {
 	proto.RegisterType((*NonRevocationProof)(nil), "NonRevocationProof")
 	proto.RegisterType((*NymSignature)(nil), "NymSignature")
 	proto.RegisterType((*CredentialRevocationInformation)(nil), "CredentialRevocationInformation")
+	proto.RegisterType((*PlainSigNonRevocationData)(nil), "PlainSigNonRevocationData")
+	proto.RegisterType((*MessageSignature)(nil), "MessageSignature")
+	proto.RegisterType((*PlainSigNonRevocationProof)(nil), "PlainSigNonRevocationProof")
 }
 
 func init() { proto.RegisterFile("idemix/idemix.proto", fileDescriptor_idemix_9eaaac972d0b8c7e) }
 
 var fileDescriptor_idemix_9eaaac972d0b8c7e = []byte{
-	// 816 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0xdd, 0x6e, 0xe2, 0x46,
-	0x14, 0xd6, 0x60, 0x9b, 0x84, 0x83, 0x13, 0xb2, 0x93, 0xa8, 0x3b, 0xfd, 0x53, 0x59, 0xab, 0xdb,
-	0x8d, 0x7a, 0x41, 0xba, 0x44, 0x7d, 0x80, 0x2c, 0xa5, 0xd5, 0xaa, 0x12, 0x42, 0xe6, 0xae, 0x37,
-	0xa3, 0x19, 0x18, 0xb0, 0x05, 0xb6, 0xe9, 0xd8, 0x74, 0x71, 0x2f, 0xfa, 0x34, 0x7d, 0x9b, 0x5e,
-	0xf4, 0x95, 0xaa, 0xf9, 0x01, 0x0f, 0x61, 0xb7, 0x57, 0xf1, 0x39, 0xdf, 0xf9, 0xe3, 0xfb, 0x3e,
-	0xc7, 0x70, 0x9b, 0x2e, 0x44, 0x96, 0xee, 0x1f, 0xcc, 0x9f, 0xc1, 0x56, 0x16, 0x55, 0x11, 0xbd,
-	0x02, 0x6f, 0x3c, 0x9a, 0xe2, 0x10, 0xd0, 0x9e, 0xa0, 0x3e, 0xba, 0x0f, 0x63, 0xb4, 0x57, 0x51,
-	0x4d, 0x5a, 0x26, 0xaa, 0xa3, 0x9f, 0xc1, 0x1f, 0x8f, 0xa6, 0x43, 0x7c, 0x0d, 0xad, 0x3d, 0xb3,
-	0x45, 0xad, 0x3d, 0xd3, 0x31, 0xb7, 0x65, 0xad, 0x3d, 0x57, 0x71, 0xcd, 0x88, 0x67, 0xe2, 0x5a,
-	0xe3, 0x35, 0x27, 0xbe, 0x8d, 0x79, 0xf4, 0x77, 0x0b, 0x7a, 0xef, 0xcb, 0x72, 0x27, 0xe4, 0x74,
-	0xc7, 0x37, 0xe9, 0xfc, 0x57, 0x51, 0xe3, 0x37, 0xd0, 0x63, 0x55, 0x25, 0x53, 0xbe, 0xab, 0x04,
-	0xcd, 0x59, 0x26, 0x4a, 0x82, 0xfa, 0xde, 0x7d, 0x27, 0xbe, 0x3e, 0xa6, 0x27, 0x2a, 0x8b, 0x5f,
-	0x82, 0x9f, 0xd0, 0x72, 0xad, 0xd7, 0x75, 0x87, 0xfe, 0x60, 0x3c, 0x9a, 0xc6, 0x5e, 0x32, 0x5b,
-	0xe3, 0x2f, 0xa1, 0x9d, 0x50, 0xc9, 0xf2, 0x85, 0xde, 0x7c, 0x80, 0x82, 0x24, 0x66, 0xf9, 0x02,
-	0x7f, 0x0d, 0x17, 0x09, 0x55, 0x93, 0x4a, 0xe2, 0xf7, 0xbd, 0x23, 0xda, 0x4e, 0x9e, 0x54, 0x0e,
-	0xdf, 0x02, 0xfa, 0x40, 0x02, 0xdd, 0x16, 0x28, 0x60, 0x18, 0xa3, 0x0f, 0x6a, 0x20, 0x67, 0x92,
-	0xae, 0xde, 0x92, 0xb6, 0x3b, 0x90, 0x33, 0xf9, 0xcb, 0xdb, 0x23, 0x38, 0x24, 0x17, 0xcf, 0xc1,
-	0x21, 0x7e, 0x09, 0x17, 0x5b, 0x59, 0x14, 0x4b, 0x3a, 0x27, 0x97, 0xfa, 0x57, 0xb7, 0x75, 0x38,
-	0x6a, 0x80, 0x92, 0x74, 0x1c, 0x60, 0x86, 0x31, 0xf8, 0x09, 0x2b, 0x13, 0x02, 0x3a, 0xab, 0x9f,
-	0xa3, 0x27, 0xe8, 0x18, 0x96, 0x14, 0x3f, 0x37, 0xe0, 0xa5, 0xe5, 0xda, 0x92, 0xae, 0x1e, 0x71,
-	0x04, 0x5e, 0xba, 0x3d, 0xf0, 0x70, 0x33, 0x78, 0x46, 0x68, 0xac, 0xc0, 0x68, 0x09, 0x30, 0x92,
-	0x62, 0x21, 0xf2, 0x2a, 0x65, 0x1b, 0x8c, 0x01, 0x19, 0xd9, 0x0e, 0xe7, 0x22, 0xa6, 0x72, 0xfc,
-	0x84, 0x4b, 0xc4, 0x95, 0xea, 0xc2, 0xca, 0x87, 0x84, 0x8a, 0x4a, 0x2b, 0x1e, 0x2a, 0xf1, 0x1d,
-	0x04, 0x86, 0xc6, 0xa0, 0xef, 0xdd, 0x87, 0xb1, 0x09, 0xa2, 0x3f, 0xa1, 0xab, 0xf6, 0xc4, 0xe2,
-	0xf7, 0x9d, 0x28, 0x2b, 0xfc, 0x19, 0x78, 0x79, 0x9d, 0x9d, 0xac, 0x52, 0x09, 0xfc, 0x0a, 0xc2,
-	0x54, 0x9f, 0x49, 0xf3, 0x22, 0x9f, 0x0b, 0x6b, 0x99, 0xae, 0xc9, 0x4d, 0x54, 0xca, 0xa5, 0xce,
-	0xfb, 0x14, 0x75, 0xbe, 0x4b, 0x5d, 0xf4, 0xaf, 0x0f, 0x9d, 0x59, 0xba, 0xca, 0x59, 0xb5, 0x93,
-	0x42, 0x09, 0xcd, 0xe8, 0x56, 0xa6, 0x99, 0x38, 0x59, 0xdf, 0x66, 0x53, 0x95, 0xc3, 0x9f, 0x43,
-	0xc0, 0x28, 0x67, 0xf2, 0xe4, 0x27, 0xfb, 0xec, 0x1d, 0x93, 0xaa, 0x93, 0xdb, 0x4e, 0xd7, 0x40,
-	0x6d, 0x6e, 0x3a, 0x9d, 0xc3, 0xfc, 0x93, 0xc3, 0xbe, 0x02, 0xb0, 0x87, 0x29, 0x5b, 0x06, 0x1a,
-	0xbb, 0x34, 0xb7, 0xcd, 0xd6, 0xf8, 0x0b, 0xe8, 0x1c, 0x50, 0xa1, 0x7d, 0x14, 0xc6, 0x66, 0xce,
-	0x6c, 0xec, 0x76, 0x4a, 0xe3, 0xa3, 0x63, 0x67, 0x3c, 0x3c, 0x41, 0x1f, 0xad, 0x8f, 0x0e, 0xe8,
-	0x23, 0x7e, 0x0d, 0xbd, 0xe3, 0x56, 0x7b, 0xb5, 0x71, 0x54, 0x68, 0x57, 0x9b, 0xab, 0x23, 0xb8,
-	0x3a, 0x94, 0x19, 0xd9, 0x40, 0xcb, 0xd6, 0x35, 0x45, 0xc6, 0xfc, 0x77, 0x10, 0x18, 0x39, 0xba,
-	0x7a, 0x80, 0x09, 0x0e, 0x1a, 0x86, 0xe7, 0x1a, 0x1e, 0x27, 0x4a, 0xaa, 0x2a, 0xae, 0x74, 0x17,
-	0xd8, 0xcb, 0x26, 0x75, 0x86, 0x7f, 0x84, 0x5b, 0x29, 0xfe, 0x28, 0xe6, 0xac, 0x4a, 0x8b, 0x9c,
-	0x8a, 0x6d, 0x31, 0x4f, 0xe8, 0x76, 0x4d, 0xae, 0xdd, 0xf7, 0xeb, 0x45, 0x53, 0x31, 0x56, 0x05,
-	0xd3, 0x35, 0xfe, 0x1e, 0x9c, 0x24, 0xdd, 0xae, 0x69, 0x99, 0xae, 0x48, 0x4f, 0x4f, 0xef, 0x35,
-	0xc0, 0x74, 0x3d, 0x4b, 0x57, 0xea, 0x66, 0x3d, 0x97, 0xdc, 0xf4, 0xd1, 0xbd, 0x17, 0x9b, 0x00,
-	0x8f, 0xe1, 0x2e, 0x2f, 0x72, 0xea, 0x4e, 0x51, 0x57, 0x91, 0x17, 0x7a, 0xf3, 0xed, 0x60, 0x52,
-	0xe4, 0x71, 0x33, 0x48, 0x41, 0x31, 0xce, 0xcf, 0x72, 0x51, 0x06, 0xf8, 0xbc, 0x12, 0xbf, 0x86,
-	0x6b, 0x67, 0x30, 0xdb, 0xac, 0xb4, 0xc1, 0x82, 0xf8, 0xaa, 0xc9, 0x3e, 0x6d, 0x56, 0xf8, 0x87,
-	0x4f, 0xdc, 0x60, 0xbc, 0xfe, 0xb1, 0x75, 0x7f, 0x41, 0x38, 0xa9, 0xb3, 0xc6, 0xc2, 0x8e, 0xd3,
-	0xd0, 0xff, 0x38, 0xad, 0xf5, 0xcc, 0x69, 0x67, 0xc2, 0x78, 0x67, 0xc2, 0x1c, 0x95, 0xf6, 0x1d,
-	0xa5, 0xa3, 0x7f, 0x10, 0x7c, 0xd3, 0xfc, 0x97, 0x68, 0xae, 0x7b, 0x9f, 0x2f, 0x0b, 0x99, 0xe9,
-	0xc7, 0x86, 0x6f, 0xe4, 0xf2, 0xdd, 0x87, 0xcb, 0xa3, 0xba, 0x2d, 0x57, 0xdd, 0x0b, 0x61, 0x35,
-	0xed, 0x43, 0x78, 0xa8, 0xd0, 0x72, 0xda, 0x9b, 0x2c, 0xac, 0x94, 0x3c, 0xa7, 0xd5, 0xff, 0x18,
-	0xad, 0x6f, 0xc0, 0xf1, 0x00, 0x5d, 0xb0, 0x8a, 0xd9, 0x57, 0xcd, 0xe9, 0xfe, 0x89, 0x55, 0xec,
-	0xdd, 0x77, 0xbf, 0x7d, 0xbb, 0x4a, 0xab, 0x64, 0xc7, 0x07, 0xf3, 0x22, 0x7b, 0x48, 0xea, 0xad,
-	0x90, 0x1b, 0xb1, 0x58, 0x09, 0xf9, 0xb0, 0x64, 0x5c, 0xa6, 0x73, 0xfb, 0xd5, 0xe3, 0x6d, 0xfd,
-	0xd9, 0x7b, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xaa, 0xcc, 0x9c, 0x10, 0x0d, 0x07, 0x00, 0x00,
+	// 925 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x56, 0xdd, 0x6e, 0xe2, 0x46,
+	0x14, 0x96, 0xb1, 0x4d, 0xe0, 0x40, 0x02, 0x99, 0x44, 0xdd, 0xd9, 0x6d, 0xab, 0x12, 0xab, 0xdb,
+	0x8d, 0x5a, 0x89, 0x34, 0x44, 0x7d, 0x80, 0x2c, 0xa5, 0xed, 0xaa, 0x2a, 0x42, 0xe6, 0xae, 0xaa,
+	0x64, 0x8d, 0x61, 0x62, 0x8f, 0xc0, 0x36, 0x1d, 0x9b, 0x2e, 0xee, 0x45, 0x2f, 0xfa, 0x2c, 0x7d,
+	0x9b, 0x5e, 0xf4, 0x95, 0xaa, 0xf9, 0x01, 0x8f, 0x21, 0xbb, 0x57, 0xf8, 0x9c, 0xef, 0xfc, 0xf9,
+	0x7c, 0xdf, 0x0c, 0x86, 0x2b, 0xb6, 0xa4, 0x09, 0xdb, 0xdd, 0xa9, 0x9f, 0xe1, 0x86, 0x67, 0x45,
+	0xe6, 0xdd, 0x80, 0x3d, 0x19, 0xcf, 0x50, 0x17, 0xac, 0x1d, 0xb6, 0x06, 0xd6, 0x6d, 0xd7, 0xb7,
+	0x76, 0xc2, 0x2a, 0x71, 0x43, 0x59, 0xa5, 0xf7, 0x03, 0x38, 0x93, 0xf1, 0x6c, 0x84, 0x2e, 0xa0,
+	0xb1, 0x23, 0x3a, 0xa8, 0xb1, 0x23, 0xd2, 0x0e, 0x75, 0x58, 0x63, 0x17, 0x0a, 0xbb, 0x24, 0xd8,
+	0x56, 0x76, 0x29, 0xf1, 0x32, 0xc4, 0x8e, 0xb6, 0x43, 0xef, 0x9f, 0x06, 0xf4, 0xde, 0xe5, 0xf9,
+	0x96, 0xf2, 0xd9, 0x36, 0x5c, 0xb3, 0xc5, 0xcf, 0xb4, 0x44, 0x6f, 0xa0, 0x47, 0x8a, 0x82, 0xb3,
+	0x70, 0x5b, 0xd0, 0x20, 0x25, 0x09, 0xcd, 0xb1, 0x35, 0xb0, 0x6f, 0xdb, 0xfe, 0xc5, 0xc1, 0x3d,
+	0x15, 0x5e, 0xf4, 0x02, 0x9c, 0x38, 0xc8, 0x57, 0xb2, 0x5d, 0x67, 0xe4, 0x0c, 0x27, 0xe3, 0x99,
+	0x6f, 0xc7, 0xf3, 0x15, 0xfa, 0x14, 0x9a, 0x71, 0xc0, 0x49, 0xba, 0xc4, 0xb6, 0x01, 0xb9, 0xb1,
+	0x4f, 0xd2, 0x25, 0xfa, 0x1c, 0xce, 0xe2, 0x40, 0x54, 0xca, 0xb1, 0x33, 0xb0, 0x0f, 0x68, 0x33,
+	0x7e, 0x14, 0x3e, 0x74, 0x05, 0xd6, 0x7b, 0xec, 0xca, 0x34, 0x57, 0x00, 0x23, 0xdf, 0x7a, 0x2f,
+	0x0a, 0x86, 0x84, 0x07, 0xd1, 0x3d, 0x6e, 0x9a, 0x05, 0x43, 0xc2, 0x7f, 0xbc, 0x3f, 0x80, 0x23,
+	0x7c, 0x76, 0x0c, 0x8e, 0xd0, 0x0b, 0x38, 0xdb, 0xf0, 0x2c, 0x7b, 0x0a, 0x16, 0xb8, 0x25, 0xdf,
+	0xba, 0x29, 0xcd, 0x71, 0x05, 0xe4, 0xb8, 0x6d, 0x00, 0x73, 0x84, 0xc0, 0x89, 0x49, 0x1e, 0x63,
+	0x90, 0x5e, 0xf9, 0xec, 0x3d, 0x42, 0x5b, 0x6d, 0x49, 0xec, 0xa7, 0x0f, 0x36, 0xcb, 0x57, 0x7a,
+	0xe9, 0xe2, 0x11, 0x79, 0x60, 0xb3, 0xcd, 0x7e, 0x0f, 0xfd, 0xe1, 0xd1, 0x42, 0x7d, 0x01, 0x7a,
+	0x4f, 0x00, 0x63, 0x4e, 0x97, 0x34, 0x2d, 0x18, 0x59, 0x23, 0x04, 0x96, 0xa2, 0x6d, 0x3f, 0xae,
+	0x45, 0x84, 0x2f, 0xac, 0xed, 0xd2, 0x0a, 0x05, 0xeb, 0x54, 0xd3, 0x67, 0x51, 0x61, 0xe5, 0x9a,
+	0x3c, 0x2b, 0x47, 0xd7, 0xe0, 0xaa, 0x35, 0xba, 0x03, 0xfb, 0xb6, 0xeb, 0x2b, 0xc3, 0xfb, 0x13,
+	0x3a, 0xa2, 0x8f, 0x4f, 0x7f, 0xdf, 0xd2, 0xbc, 0x40, 0x9f, 0x80, 0x9d, 0x96, 0x49, 0xad, 0x95,
+	0x70, 0xa0, 0x1b, 0xe8, 0x32, 0x39, 0x66, 0x90, 0x66, 0xe9, 0x82, 0x6a, 0xc9, 0x74, 0x94, 0x6f,
+	0x2a, 0x5c, 0xe6, 0xea, 0xec, 0x0f, 0xad, 0xce, 0x31, 0x57, 0xe7, 0xfd, 0xe7, 0x40, 0x7b, 0xce,
+	0xa2, 0x94, 0x14, 0x5b, 0x4e, 0x05, 0xd1, 0x24, 0xd8, 0x70, 0x96, 0xd0, 0x5a, 0xfb, 0x26, 0x99,
+	0x09, 0x1f, 0x7a, 0x09, 0x2e, 0x09, 0x42, 0xc2, 0x6b, 0xaf, 0xec, 0x90, 0xb7, 0x84, 0x8b, 0xcc,
+	0x50, 0x67, 0x9a, 0x02, 0x6a, 0x86, 0x2a, 0xd3, 0x18, 0xcc, 0xa9, 0x0d, 0xf6, 0x19, 0x80, 0x1e,
+	0x4c, 0xc8, 0xd2, 0x95, 0x58, 0x4b, 0xcd, 0x36, 0x5f, 0xa1, 0x57, 0xd0, 0xde, 0xa3, 0x54, 0xea,
+	0xa8, 0xeb, 0xab, 0x3a, 0xf3, 0x89, 0x99, 0xc9, 0x95, 0x8e, 0x0e, 0x99, 0xfe, 0xa8, 0x86, 0x3e,
+	0xe0, 0x56, 0x0d, 0x7d, 0x40, 0xaf, 0xa1, 0x77, 0xe8, 0xaa, 0xa7, 0x56, 0x8a, 0xea, 0xea, 0xd6,
+	0x6a, 0x6a, 0x0f, 0xce, 0xf7, 0x61, 0x8a, 0x36, 0x90, 0xb4, 0x75, 0x54, 0x90, 0x12, 0xff, 0x35,
+	0xb8, 0x8a, 0x8e, 0x8e, 0x2c, 0xa0, 0x8c, 0x3d, 0x87, 0xdd, 0x53, 0x0e, 0x0f, 0x15, 0x79, 0x20,
+	0x22, 0xce, 0x65, 0x16, 0xe8, 0xc9, 0xa6, 0x65, 0x82, 0xbe, 0x83, 0x2b, 0x4e, 0xff, 0xc8, 0x16,
+	0xa4, 0x60, 0x59, 0x1a, 0xd0, 0x4d, 0xb6, 0x88, 0x83, 0xcd, 0x0a, 0x5f, 0x98, 0xe7, 0xeb, 0xb2,
+	0x8a, 0x98, 0x88, 0x80, 0xd9, 0x0a, 0x7d, 0x0d, 0x86, 0x33, 0xd8, 0xac, 0x82, 0x9c, 0x45, 0xb8,
+	0x27, 0xab, 0xf7, 0x2a, 0x60, 0xb6, 0x9a, 0xb3, 0x48, 0xcc, 0x2c, 0xeb, 0xe2, 0xfe, 0xc0, 0xba,
+	0xb5, 0x7d, 0x65, 0xa0, 0x09, 0x5c, 0xa7, 0x59, 0x1a, 0x98, 0x55, 0xc4, 0x54, 0xf8, 0x52, 0x76,
+	0xbe, 0x1a, 0x4e, 0xb3, 0xd4, 0xaf, 0x0a, 0x09, 0xc8, 0x47, 0xe9, 0x89, 0xcf, 0x4b, 0x00, 0x9d,
+	0x46, 0xa2, 0xd7, 0x70, 0x61, 0x14, 0x26, 0xeb, 0x48, 0x0a, 0xcc, 0xf5, 0xcf, 0x2b, 0xef, 0xe3,
+	0x3a, 0x42, 0xdf, 0x7e, 0x60, 0x06, 0xa5, 0xf5, 0xe7, 0xda, 0xfd, 0x05, 0xdd, 0x69, 0x99, 0x54,
+	0x12, 0x36, 0x94, 0x66, 0x7d, 0x44, 0x69, 0x8d, 0x23, 0xa5, 0x9d, 0x10, 0x63, 0x9f, 0x10, 0x73,
+	0x60, 0xda, 0x31, 0x98, 0xf6, 0xfe, 0xb5, 0xe0, 0x8b, 0xea, 0x96, 0xa8, 0xa6, 0x7b, 0x97, 0x3e,
+	0x65, 0x3c, 0x91, 0x8f, 0xd5, 0xbe, 0x2d, 0x73, 0xdf, 0x03, 0x68, 0x1d, 0xd8, 0x6d, 0x98, 0xec,
+	0x9e, 0x51, 0xcd, 0xe9, 0x00, 0xba, 0xfb, 0x08, 0x49, 0xa7, 0x9e, 0x49, 0xc3, 0x82, 0xc9, 0xd3,
+	0xb5, 0x3a, 0xcf, 0xad, 0xf5, 0x0d, 0x18, 0x1a, 0x08, 0x96, 0xa4, 0x20, 0xfa, 0xa8, 0x19, 0xd9,
+	0xdf, 0x93, 0x82, 0x78, 0x53, 0x78, 0x39, 0x5b, 0x13, 0x96, 0xce, 0x59, 0x54, 0x23, 0x51, 0x80,
+	0xe8, 0x1e, 0x20, 0xdf, 0xef, 0x59, 0xfd, 0xc1, 0x74, 0x46, 0x97, 0xc3, 0x5f, 0x68, 0x9e, 0x93,
+	0x88, 0x1e, 0x18, 0xf0, 0x8d, 0x20, 0xef, 0x37, 0xe8, 0x1f, 0xe3, 0xe8, 0x9b, 0x9a, 0x52, 0x63,
+	0x92, 0x2e, 0xd7, 0x54, 0x73, 0xd5, 0xaf, 0x80, 0x9f, 0xa4, 0x5f, 0xfc, 0x53, 0xf0, 0x58, 0xbe,
+	0xbc, 0x79, 0xe7, 0xb8, 0x3c, 0x9e, 0xb3, 0xc8, 0xfb, 0xdb, 0x82, 0x57, 0xcf, 0x8e, 0xbb, 0xd7,
+	0x5c, 0x27, 0x67, 0x51, 0xf2, 0xdc, 0x8d, 0x06, 0x12, 0x50, 0xa7, 0xfc, 0x06, 0xda, 0x2a, 0xec,
+	0xf8, 0x66, 0x6b, 0x49, 0xb7, 0xb8, 0xdd, 0x8c, 0x7b, 0x88, 0x6b, 0x16, 0xf4, 0x3d, 0xe4, 0xbf,
+	0xfd, 0xea, 0xd7, 0x2f, 0x23, 0x56, 0xc4, 0xdb, 0x70, 0xb8, 0xc8, 0x92, 0xbb, 0xb8, 0xdc, 0x50,
+	0xbe, 0xa6, 0xcb, 0x88, 0xf2, 0xbb, 0x27, 0x12, 0x72, 0xb6, 0xd0, 0x1f, 0x0a, 0x61, 0x53, 0x7e,
+	0x29, 0x3c, 0xfc, 0x3f, 0x00, 0xca, 0x48, 0xa2, 0x0d, 0x40, 0x08, 0x00, 0x00,
 }
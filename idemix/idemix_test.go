@@ -10,6 +10,7 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric-amcl/amcl/FP256BN"
 	"github.com/stretchr/testify/assert"
 )
@@ -162,3 +163,87 @@ func TestIdemix(t *testing.T) {
 		return
 	}
 }
+
+func TestPlainSignatureRevocation(t *testing.T) {
+	rng, err := GetRand()
+	assert.NoError(t, err)
+
+	AttributeNames := []string{"Attr1", "Attr2", "Attr3", "Attr4", "RevocationHandle"}
+	key, err := NewIssuerKey(AttributeNames, rng)
+	assert.NoError(t, err)
+	revocationKey, err := GenerateLongTermRevocationKey()
+	assert.NoError(t, err)
+
+	// Issue two credentials, with revocation handles 1 and 2
+	issue := func(rh int) (*Credential, *FP256BN.BIG) {
+		attrs := []*FP256BN.BIG{FP256BN.NewBIGint(0), FP256BN.NewBIGint(1), FP256BN.NewBIGint(2), FP256BN.NewBIGint(3), FP256BN.NewBIGint(rh)}
+		sk := RandModOrder(rng)
+		m := NewCredRequest(sk, BigToBytes(RandModOrder(rng)), key.Ipk, rng)
+		cred, err := NewCredential(key, m, attrs, rng)
+		assert.NoError(t, err)
+		return cred, sk
+	}
+	cred1, sk1 := issue(1)
+	cred2, sk2 := issue(2)
+
+	// Revoke the second credential in epoch 1
+	epoch := 1
+	cri, err := CreateCRI(revocationKey, []*FP256BN.BIG{FP256BN.NewBIGint(1)}, epoch, ALG_PLAIN_SIGNATURE, rng)
+	assert.NoError(t, err)
+	err = VerifyEpochPK(&revocationKey.PublicKey, cri.EpochPk, cri.EpochPkSig, int(cri.Epoch), RevocationAlgorithm(cri.RevocationAlg))
+	assert.NoError(t, err)
+
+	disclosure := []byte{0, 1, 0, 0, 0}
+	msg := []byte{1, 2, 3, 4, 5}
+	rhIndex := 4
+	attrValues := []*FP256BN.BIG{nil, FP256BN.NewBIGint(1), nil, nil, nil}
+
+	// The unrevoked credential signs, and its signature is verified
+	nym1, randNym1 := MakeNym(sk1, key.Ipk, rng)
+	sig, err := NewSignature(cred1, sk1, nym1, randNym1, key.Ipk, disclosure, msg, rhIndex, cri, rng)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(ALG_PLAIN_SIGNATURE), sig.NonRevocationProof.RevocationAlg)
+	assert.NoError(t, sig.Ver(disclosure, key.Ipk, msg, attrValues, rhIndex, &revocationKey.PublicKey, epoch))
+
+	// The signature is not valid in another epoch, nor for another message
+	assert.Error(t, sig.Ver(disclosure, key.Ipk, msg, attrValues, rhIndex, &revocationKey.PublicKey, epoch+1))
+	assert.Error(t, sig.Ver(disclosure, key.Ipk, []byte{5}, attrValues, rhIndex, &revocationKey.PublicKey, epoch))
+
+	// Tampering with the non-revocation proof invalidates the signature
+	proof := &PlainSigNonRevocationProof{}
+	assert.NoError(t, proto.Unmarshal(sig.NonRevocationProof.NonRevocationProof, proof))
+	proof.ProofSR = BigToBytes(RandModOrder(rng))
+	sig.NonRevocationProof.NonRevocationProof, err = proto.Marshal(proof)
+	assert.NoError(t, err)
+	assert.Error(t, sig.Ver(disclosure, key.Ipk, msg, attrValues, rhIndex, &revocationKey.PublicKey, epoch))
+
+	// The revoked credential can't sign anymore
+	nym2, randNym2 := MakeNym(sk2, key.Ipk, rng)
+	_, err = NewSignature(cred2, sk2, nym2, randNym2, key.Ipk, disclosure, msg, rhIndex, cri, rng)
+	assert.EqualError(t, err, "failed to compute non-revoked proof: the credential is revoked in epoch 1")
+
+	// Once the CRI rolls over to the next epoch, the signatures produced against
+	// the CRI of the previous epoch are rejected, even those of credentials that
+	// are still not revoked, as they can't prove they weren't revoked since
+	nextCRI, err := CreateCRI(revocationKey, []*FP256BN.BIG{FP256BN.NewBIGint(1)}, epoch+1, ALG_PLAIN_SIGNATURE, rng)
+	assert.NoError(t, err)
+	sig, err = NewSignature(cred1, sk1, nym1, randNym1, key.Ipk, disclosure, msg, rhIndex, cri, rng)
+	assert.NoError(t, err)
+	err = sig.Ver(disclosure, key.Ipk, msg, attrValues, rhIndex, &revocationKey.PublicKey, epoch+1)
+	assert.EqualError(t, err, "signature invalid: signature epoch 1 doesn't match current epoch 2")
+	sig, err = NewSignature(cred1, sk1, nym1, randNym1, key.Ipk, disclosure, msg, rhIndex, nextCRI, rng)
+	assert.NoError(t, err)
+	assert.NoError(t, sig.Ver(disclosure, key.Ipk, msg, attrValues, rhIndex, &revocationKey.PublicKey, epoch+1))
+
+	// Nor can it downgrade to a CRI of the same epoch without revocation, as the revocation authority never signed one
+	noRevocationCRI, err := CreateCRI(revocationKey, nil, epoch, ALG_NO_REVOCATION, rng)
+	assert.NoError(t, err)
+	noRevocationCRI.EpochPkSig = cri.EpochPkSig
+	sig, err = NewSignature(cred2, sk2, nym2, randNym2, key.Ipk, disclosure, msg, rhIndex, noRevocationCRI, rng)
+	assert.NoError(t, err)
+	assert.Error(t, sig.Ver(disclosure, key.Ipk, msg, attrValues, rhIndex, &revocationKey.PublicKey, epoch))
+
+	// The revocation handle can't be disclosed
+	_, err = NewSignature(cred1, sk1, nym1, randNym1, key.Ipk, []byte{0, 0, 0, 0, 1}, msg, rhIndex, cri, rng)
+	assert.Error(t, err)
+}
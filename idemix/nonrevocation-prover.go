@@ -7,6 +7,9 @@ SPDX-License-Identifier: Apache-2.0
 package idemix
 
 import (
+	"bytes"
+
+	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric-amcl/amcl"
 	"github.com/hyperledger/fabric-amcl/amcl/FP256BN"
 	"github.com/pkg/errors"
@@ -35,10 +38,81 @@ func (prover *nopNonRevokedProver) getNonRevokedProof(chal *FP256BN.BIG) (*NonRe
 }
 
 // getNonRevocationProver returns the nonRevokedProver bound to the passed revocation algorithm
+// plainSigNonRevokedProver proves knowledge of the weak Boneh-Boyen signature sigma
+// of the revocation authority on the revocation handle rh of the credential.
+// sigma is randomized into sigma' = sigma^r, and sigma bar = sigma'^{-rh} \cdot g_1^r
+// is computed, so that e(sigma', epochPK) = e(sigma bar, g_2). The prover then shows
+// knowledge of rh and r, with rh being the same value as in the credential.
+type plainSigNonRevokedProver struct {
+	sigmaPrime *FP256BN.ECP
+	sigmaBar   *FP256BN.ECP
+	r          *FP256BN.BIG
+	rR         *FP256BN.BIG
+}
+
+func (prover *plainSigNonRevokedProver) getFSContribution(rh *FP256BN.BIG, rRh *FP256BN.BIG, cri *CredentialRevocationInformation, rng *amcl.RAND) ([]byte, error) {
+	revocationData := &PlainSigNonRevocationData{}
+	err := proto.Unmarshal(cri.RevocationData, revocationData)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal revocation data")
+	}
+
+	var sigma *FP256BN.ECP
+	rhBytes := BigToBytes(rh)
+	for _, sig := range revocationData.Signatures {
+		if bytes.Equal(sig.RevocationHandle, rhBytes) {
+			sigma = EcpFromProto(sig.RhSig)
+			break
+		}
+	}
+	if sigma == nil {
+		return nil, errors.Errorf("the credential is revoked in epoch %d", cri.Epoch)
+	}
+
+	prover.r = RandModOrder(rng)
+	prover.rR = RandModOrder(rng)
+
+	// sigma' = sigma^r
+	prover.sigmaPrime = sigma.Mul(prover.r)
+	// sigma bar = sigma'^{-rh} \cdot g_1^r
+	prover.sigmaBar = FP256BN.G1mul(GenG1, prover.r)
+	prover.sigmaBar.Sub(prover.sigmaPrime.Mul(rh))
+
+	// t = sigma'^{-r_rh} \cdot g_1^{r_r}
+	t := FP256BN.G1mul(GenG1, prover.rR)
+	t.Sub(prover.sigmaPrime.Mul(rRh))
+
+	proofData := make([]byte, ProofBytes[ALG_PLAIN_SIGNATURE])
+	index := 0
+	index = appendBytesG1(proofData, index, t)
+	index = appendBytesG1(proofData, index, prover.sigmaPrime)
+	appendBytesG1(proofData, index, prover.sigmaBar)
+	return proofData, nil
+}
+
+func (prover *plainSigNonRevokedProver) getNonRevokedProof(chal *FP256BN.BIG) (*NonRevocationProof, error) {
+	// s_r = r_r + C \cdot r
+	proofSR := Modadd(prover.rR, FP256BN.Modmul(chal, prover.r, GroupOrder), GroupOrder)
+	proofBytes, err := proto.Marshal(&PlainSigNonRevocationProof{
+		SigmaPrime: EcpToProto(prover.sigmaPrime),
+		SigmaBar:   EcpToProto(prover.sigmaBar),
+		ProofSR:    BigToBytes(proofSR),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal non-revocation proof")
+	}
+	return &NonRevocationProof{
+		RevocationAlg:      int32(ALG_PLAIN_SIGNATURE),
+		NonRevocationProof: proofBytes,
+	}, nil
+}
+
 func getNonRevocationProver(algorithm RevocationAlgorithm) (nonRevokedProver, error) {
 	switch algorithm {
 	case ALG_NO_REVOCATION:
 		return &nopNonRevokedProver{}, nil
+	case ALG_PLAIN_SIGNATURE:
+		return &plainSigNonRevokedProver{}, nil
 	default:
 		// unknown revocation algorithm
 		return nil, errors.Errorf("unknown revocation algorithm %d", algorithm)
@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package idemix
 
 import (
+	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric-amcl/amcl/FP256BN"
 	"github.com/pkg/errors"
 )
@@ -25,10 +26,55 @@ func (verifier *nopNonRevocationVerifier) recomputeFSContribution(proof *NonRevo
 }
 
 // getNonRevocationVerifier returns the nonRevocationVerifier bound to the passed revocation algorithm
+type plainSigNonRevocationVerifier struct{}
+
+func (verifier *plainSigNonRevocationVerifier) recomputeFSContribution(proof *NonRevocationProof, chal *FP256BN.BIG, epochPK *FP256BN.ECP2, proofSRh *FP256BN.BIG) ([]byte, error) {
+	if epochPK == nil {
+		return nil, errors.Errorf("non-revocation proof invalid: received nil epoch public key")
+	}
+	plainSigProof := &PlainSigNonRevocationProof{}
+	err := proto.Unmarshal(proof.NonRevocationProof, plainSigProof)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal non-revocation proof")
+	}
+	if plainSigProof.SigmaPrime == nil || plainSigProof.SigmaBar == nil {
+		return nil, errors.Errorf("non-revocation proof invalid: missing group elements")
+	}
+	sigmaPrime := EcpFromProto(plainSigProof.SigmaPrime)
+	sigmaBar := EcpFromProto(plainSigProof.SigmaBar)
+	proofSR := FP256BN.FromBytes(plainSigProof.ProofSR)
+
+	// check that e(sigma', epochPK) = e(sigma bar, g_2)
+	if sigmaPrime.Is_infinity() {
+		return nil, errors.Errorf("non-revocation proof invalid: sigma' = 1")
+	}
+	temp1 := FP256BN.Ate(epochPK, sigmaPrime)
+	temp2 := FP256BN.Ate(GenG2, sigmaBar)
+	temp2.Inverse()
+	temp1.Mul(temp2)
+	if !FP256BN.Fexp(temp1).Isunity() {
+		return nil, errors.Errorf("non-revocation proof invalid: sigma' and sigma bar don't have the expected structure")
+	}
+
+	// recompute t = sigma'^{-s_rh} \cdot g_1^{s_r} \cdot sigma bar^{-C}
+	t := FP256BN.G1mul(GenG1, proofSR)
+	t.Sub(sigmaPrime.Mul(proofSRh))
+	t.Sub(sigmaBar.Mul(chal))
+
+	proofData := make([]byte, ProofBytes[ALG_PLAIN_SIGNATURE])
+	index := 0
+	index = appendBytesG1(proofData, index, t)
+	index = appendBytesG1(proofData, index, sigmaPrime)
+	appendBytesG1(proofData, index, sigmaBar)
+	return proofData, nil
+}
+
 func getNonRevocationVerifier(algorithm RevocationAlgorithm) (nonRevocationVerifier, error) {
 	switch algorithm {
 	case ALG_NO_REVOCATION:
 		return &nopNonRevocationVerifier{}, nil
+	case ALG_PLAIN_SIGNATURE:
+		return &plainSigNonRevocationVerifier{}, nil
 	default:
 		// unknown revocation algorithm
 		return nil, errors.Errorf("unknown revocation algorithm %d", algorithm)
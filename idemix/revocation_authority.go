@@ -24,10 +24,18 @@ type RevocationAlgorithm int32
 
 const (
 	ALG_NO_REVOCATION RevocationAlgorithm = iota
+	// ALG_PLAIN_SIGNATURE has the revocation authority sign each unrevoked revocation
+	// handle with the epoch key, and signers prove knowledge of the signature on the
+	// revocation handle of their credential
+	ALG_PLAIN_SIGNATURE
 )
 
+// ProofBytes is the amount of bytes the non-revocation proof of each algorithm
+// contributes to the Fiat-Shamir hash
 var ProofBytes = map[RevocationAlgorithm]int{
 	ALG_NO_REVOCATION: 0,
+	// t-value, sigma' and sigma bar
+	ALG_PLAIN_SIGNATURE: 3 * (2*FieldBytes + 1),
 }
 
 // GenerateLongTermRevocationKey generates a long term signing key that will be used for revocation
@@ -47,13 +55,18 @@ func CreateCRI(key *ecdsa.PrivateKey, unrevokedHandles []*FP256BN.BIG, epoch int
 	cri.RevocationAlg = int32(alg)
 	cri.Epoch = int64(epoch)
 
-	if alg == ALG_NO_REVOCATION {
+	var epochSk *FP256BN.BIG
+	switch alg {
+	case ALG_NO_REVOCATION:
 		// put a dummy PK in the proto
 		cri.EpochPk = Ecp2ToProto(GenG2)
-	} else {
+	case ALG_PLAIN_SIGNATURE:
 		// create epoch key
-		_, epochPk := WBBKeyGen(rng)
+		var epochPk *FP256BN.ECP2
+		epochSk, epochPk = WBBKeyGen(rng)
 		cri.EpochPk = Ecp2ToProto(epochPk)
+	default:
+		return nil, errors.Errorf("the specified revocation algorithm is not supported.")
 	}
 
 	// sign epoch + epoch key with long term key
@@ -69,11 +82,22 @@ func CreateCRI(key *ecdsa.PrivateKey, unrevokedHandles []*FP256BN.BIG, epoch int
 		return nil, err
 	}
 
-	if alg == ALG_NO_REVOCATION {
-		return cri, nil
-	} else {
-		return nil, errors.Errorf("the specified revocation algorithm is not supported.")
+	if alg == ALG_PLAIN_SIGNATURE {
+		// sign each unrevoked handle with the epoch key
+		revocationData := &PlainSigNonRevocationData{}
+		for _, rh := range unrevokedHandles {
+			revocationData.Signatures = append(revocationData.Signatures, &MessageSignature{
+				RevocationHandle: BigToBytes(rh),
+				RhSig:            EcpToProto(WBBSign(epochSk, rh)),
+			})
+		}
+		cri.RevocationData, err = proto.Marshal(revocationData)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal revocation data")
+		}
 	}
+
+	return cri, nil
 }
 
 // VerifyEpochPK verifies that the revocation PK for a certain epoch is valid,
@@ -254,6 +254,18 @@ func (sig *Signature) Ver(Disclosure []byte, ipk *IssuerPublicKey, msg []byte, a
 		return errors.Errorf("Attribute %d is disclosed but is also used as revocation handle, which should remain hidden.", rhIndex)
 	}
 
+	// Check that the signature was produced against the CRI of the current epoch,
+	// whose epoch public key was signed by the revocation authority. Signatures
+	// produced against the CRI of a previous epoch are rejected on purpose, even
+	// right after a rollover, as the credential may have been revoked since.
+	if sig.Epoch != int64(epoch) {
+		return errors.Errorf("signature invalid: signature epoch %d doesn't match current epoch %d", sig.Epoch, epoch)
+	}
+	err := VerifyEpochPK(revPk, sig.RevocationEpochPk, sig.RevocationPkSig, int(sig.Epoch), RevocationAlgorithm(sig.NonRevocationProof.RevocationAlg))
+	if err != nil {
+		return errors.WithMessage(err, "signature invalid")
+	}
+
 	HiddenIndices := hiddenIndices(Disclosure)
 
 	// Parse signature
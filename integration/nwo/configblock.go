@@ -81,14 +81,7 @@ func UpdateConfig(n *Network, orderer *Orderer, channel string, current, updated
 	Expect(err).NotTo(HaveOccurred())
 	configUpdate.ChannelId = channel
 
-	signedEnvelope, err := protoutil.CreateSignedEnvelope(
-		common.HeaderType_CONFIG_UPDATE,
-		channel,
-		nil, // local signer
-		&common.ConfigUpdateEnvelope{ConfigUpdate: protoutil.MarshalOrPanic(configUpdate)},
-		0, // message version
-		0, // epoch
-	)
+	signedEnvelope, err := protoutil.CreateConfigUpdateEnvelope(channel, configUpdate)
 	Expect(err).NotTo(HaveOccurred())
 	Expect(signedEnvelope).NotTo(BeNil())
 
@@ -210,14 +203,7 @@ func computeUpdateOrdererConfig(updateFile string, n *Network, channel string, c
 	Expect(err).NotTo(HaveOccurred())
 	configUpdate.ChannelId = channel
 
-	signedEnvelope, err := protoutil.CreateSignedEnvelope(
-		common.HeaderType_CONFIG_UPDATE,
-		channel,
-		nil, // local signer
-		&common.ConfigUpdateEnvelope{ConfigUpdate: protoutil.MarshalOrPanic(configUpdate)},
-		0, // message version
-		0, // epoch
-	)
+	signedEnvelope, err := protoutil.CreateConfigUpdateEnvelope(channel, configUpdate)
 	Expect(err).NotTo(HaveOccurred())
 	Expect(signedEnvelope).NotTo(BeNil())
 
@@ -112,6 +112,9 @@ Profiles:{{ range .Profiles }}
         BlockValidation:
           Type: ImplicitMeta
           Rule: ANY Writers
+        ClusterMessageValidation:
+          Type: ImplicitMeta
+          Rule: ANY Writers
     {{- end }}
     {{- if .Consortium }}
     Consortium: {{ .Consortium }}
@@ -62,6 +62,7 @@ peer:
       pullRetryThreshold: 60s
       transientstoreMaxBlockRetention: 1000
       pushAckTimeout: 3s
+      pushAckRetries: 2
       reconcileBatchSize: 10
       reconcileSleepInterval: 10s
       reconciliationEnabled: true
@@ -96,6 +97,10 @@ peer:
         KeyStore:
   mspConfigPath: {{ .PeerLocalMSPDir Peer }}
   localMspId: {{ (.Organization Peer.Organization).MSPID }}
+  deliverService:
+    sendQueueSize: 10
+    slowConsumerTimeout: 30s
+    slowConsumerPolicy: disconnect
   deliveryclient:
     reconnectTotalTimeThreshold: 3600s
   localMspType: bccsp
@@ -115,6 +120,8 @@ peer:
     validators:
       vscc:
         name: DefaultValidation
+      tvscc:
+        name: TokenValidation
   validatorPoolSize:
   discovery:
     enabled: true
@@ -44,6 +44,7 @@ type Peer struct {
 	BCCSP                  *BCCSP          `yaml:"BCCSP,omitempty"`
 	MSPConfigPath          string          `yaml:"mspConfigPath,omitempty"`
 	LocalMSPID             string          `yaml:"localMspId,omitempty"`
+	DeliverService         *DeliverService `yaml:"deliverService,omitempty"`
 	Deliveryclient         *DeliveryClient `yaml:"deliveryclient,omitempty"`
 	LocalMspType           string          `yaml:"localMspType,omitempty"`
 	AdminService           *Service        `yaml:"adminService,omitempty"`
@@ -55,9 +56,13 @@ type Peer struct {
 }
 
 type Keepalive struct {
-	MinInterval    time.Duration    `yaml:"minInterval,omitempty"`
-	Client         *ClientKeepalive `yaml:"client,omitempty"`
-	DeliveryClient *ClientKeepalive `yaml:"deliveryClient,omitempty"`
+	MinInterval           time.Duration    `yaml:"minInterval,omitempty"`
+	MaxConnectionIdle     time.Duration    `yaml:"maxConnectionIdle,omitempty"`
+	MaxConnectionAge      time.Duration    `yaml:"maxConnectionAge,omitempty"`
+	MaxConnectionAgeGrace time.Duration    `yaml:"maxConnectionAgeGrace,omitempty"`
+	MaxConcurrentStreams  uint32           `yaml:"maxConcurrentStreams,omitempty"`
+	Client                *ClientKeepalive `yaml:"client,omitempty"`
+	DeliveryClient        *ClientKeepalive `yaml:"deliveryClient,omitempty"`
 }
 
 type ClientKeepalive struct {
@@ -107,6 +112,7 @@ type GossipPvtData struct {
 	PullRetryThreshold              time.Duration `yaml:"pullRetryThreshold,omitempty"`
 	TransientstoreMaxBlockRetention int           `yaml:"transientstoreMaxBlockRetention,omitempty"`
 	PushAckTimeout                  time.Duration `yaml:"pushAckTimeout,omitempty"`
+	PushAckRetries                  int           `yaml:"pushAckRetries,omitempty"`
 }
 
 type Events struct {
@@ -151,6 +157,12 @@ type SoftwareProvider struct {
 	Security int    `yaml:"Security,omitempty"`
 }
 
+type DeliverService struct {
+	SendQueueSize       int           `yaml:"sendQueueSize,omitempty"`
+	SlowConsumerTimeout time.Duration `yaml:"slowConsumerTimeout,omitempty"`
+	SlowConsumerPolicy  string        `yaml:"slowConsumerPolicy,omitempty"`
+}
+
 type DeliveryClient struct {
 	ReconnectTotalTimeThreshold time.Duration `yaml:"reconnectTotalTimeThreshold,omitempty"`
 }
@@ -168,8 +180,10 @@ type Handlers struct {
 }
 
 type Handler struct {
-	Name    string `yaml:"name,omitempty"`
-	Library string `yaml:"library,omitempty"`
+	Name    string            `yaml:"name,omitempty"`
+	Library string            `yaml:"library,omitempty"`
+	Order   int               `yaml:"order,omitempty"`
+	Config  map[string]string `yaml:"config,omitempty"`
 }
 
 type HandlerMap map[string]Handler
@@ -52,9 +52,13 @@ type OrdererSASLPlain struct {
 }
 
 type OrdererKeepalive struct {
-	ServerMinInterval time.Duration `yaml:"ServerMinInterval,omitempty"`
-	ServerInterval    time.Duration `yaml:"ServerInterval,omitempty"`
-	ServerTimeout     time.Duration `yaml:"ServerTimeout,omitempty"`
+	ServerMinInterval     time.Duration `yaml:"ServerMinInterval,omitempty"`
+	ServerInterval        time.Duration `yaml:"ServerInterval,omitempty"`
+	ServerTimeout         time.Duration `yaml:"ServerTimeout,omitempty"`
+	MaxConnectionIdle     time.Duration `yaml:"MaxConnectionIdle,omitempty"`
+	MaxConnectionAge      time.Duration `yaml:"MaxConnectionAge,omitempty"`
+	MaxConnectionAgeGrace time.Duration `yaml:"MaxConnectionAgeGrace,omitempty"`
+	MaxConcurrentStreams  uint32        `yaml:"MaxConcurrentStreams,omitempty"`
 }
 
 type OrdererProfile struct {
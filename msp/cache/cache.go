@@ -7,6 +7,8 @@ SPDX-License-Identifier: Apache-2.0
 package cache
 
 import (
+	"crypto/sha256"
+
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/msp"
 	pmsp "github.com/hyperledger/fabric/protos/msp"
@@ -17,6 +19,7 @@ const (
 	deserializeIdentityCacheSize = 100
 	validateIdentityCacheSize    = 100
 	satisfiesPrincipalCacheSize  = 100
+	verifySignatureCacheSize     = 1000
 )
 
 var mspLogger = flogging.MustGetLogger("msp")
@@ -31,6 +34,7 @@ func New(o msp.MSP) (msp.MSP, error) {
 	theMsp.deserializeIdentityCache = newSecondChanceCache(deserializeIdentityCacheSize)
 	theMsp.satisfiesPrincipalCache = newSecondChanceCache(satisfiesPrincipalCacheSize)
 	theMsp.validateIdentityCache = newSecondChanceCache(validateIdentityCacheSize)
+	theMsp.verifySignatureCache = newSecondChanceCache(verifySignatureCacheSize)
 
 	return theMsp, nil
 }
@@ -47,6 +51,10 @@ type cachedMSP struct {
 	// basically a map of principals=>identities=>stringified to booleans
 	// specifying whether this identity satisfies this principal
 	satisfiesPrincipalCache *secondChanceCache
+
+	// cache of the signatures successfully verified by identities,
+	// keyed by identity, message digest and signature
+	verifySignatureCache *secondChanceCache
 }
 
 type cachedIdentity struct {
@@ -62,6 +70,10 @@ func (id *cachedIdentity) Validate() error {
 	return id.cache.Validate(id.Identity)
 }
 
+func (id *cachedIdentity) Verify(msg []byte, sig []byte) error {
+	return id.cache.verify(id.Identity, msg, sig)
+}
+
 func (c *cachedMSP) DeserializeIdentity(serializedIdentity []byte) (msp.Identity, error) {
 	id, ok := c.deserializeIdentityCache.get(string(serializedIdentity))
 	if ok {
@@ -127,10 +139,30 @@ func (c *cachedMSP) SatisfiesPrincipal(id msp.Identity, principal *pmsp.MSPPrinc
 	return err
 }
 
+// verify verifies sig against msg with the given identity. Only successful
+// verifications are cached, so that an invalid signature is always checked.
+func (c *cachedMSP) verify(id msp.Identity, msg []byte, sig []byte) error {
+	identifier := id.GetIdentifier()
+	digest := sha256.Sum256(msg)
+	key := string(identifier.Mspid+":"+identifier.Id) + string(digest[:]) + string(sig)
+
+	if _, ok := c.verifySignatureCache.get(key); ok {
+		return nil
+	}
+
+	err := id.Verify(msg, sig)
+	if err == nil {
+		c.verifySignatureCache.add(key, true)
+	}
+
+	return err
+}
+
 func (c *cachedMSP) cleanCash() error {
 	c.deserializeIdentityCache = newSecondChanceCache(deserializeIdentityCacheSize)
 	c.satisfiesPrincipalCache = newSecondChanceCache(satisfiesPrincipalCacheSize)
 	c.validateIdentityCache = newSecondChanceCache(validateIdentityCacheSize)
+	c.verifySignatureCache = newSecondChanceCache(verifySignatureCacheSize)
 
 	return nil
 }
@@ -41,6 +41,7 @@ func TestSetup(t *testing.T) {
 	assert.Equal(t, 0, i.(*cachedMSP).deserializeIdentityCache.len())
 	assert.Equal(t, 0, i.(*cachedMSP).satisfiesPrincipalCache.len())
 	assert.Equal(t, 0, i.(*cachedMSP).validateIdentityCache.len())
+	assert.Equal(t, 0, i.(*cachedMSP).verifySignatureCache.len())
 }
 
 func TestGetType(t *testing.T) {
@@ -213,6 +214,46 @@ func TestValidate(t *testing.T) {
 	assert.False(t, ok)
 }
 
+func TestVerify(t *testing.T) {
+	mockMSP := &mocks.MockMSP{}
+	i, err := New(mockMSP)
+	assert.NoError(t, err)
+
+	mockIdentity := &mocks.MockIdentity{ID: "Alice"}
+	mockIdentity.On("GetIdentifier").Return(&msp.IdentityIdentifier{Mspid: "MSP", Id: "Alice"})
+	mockMSP.On("DeserializeIdentity", mock.Anything).Return(mockIdentity, nil)
+	id, err := i.DeserializeIdentity([]byte{1, 2, 3})
+	assert.NoError(t, err)
+
+	// Check successful verifications are cached
+	msg, sig := []byte("msg"), []byte("sig")
+	mockIdentity.On("Verify", msg, sig).Return(nil).Once()
+	assert.NoError(t, id.Verify(msg, sig))
+	assert.NoError(t, id.Verify(msg, sig))
+	mockIdentity.AssertNumberOfCalls(t, "Verify", 1)
+	assert.Equal(t, 1, i.(*cachedMSP).verifySignatureCache.len())
+
+	// Check failed verifications are not cached
+	badSig := []byte("bad sig")
+	mockIdentity.On("Verify", msg, badSig).Return(errors.New("Invalid signature"))
+	assert.EqualError(t, id.Verify(msg, badSig), "Invalid signature")
+	assert.EqualError(t, id.Verify(msg, badSig), "Invalid signature")
+	mockIdentity.AssertNumberOfCalls(t, "Verify", 3)
+	assert.Equal(t, 1, i.(*cachedMSP).verifySignatureCache.len())
+
+	// Check the signature of another message is verified
+	otherMsg := []byte("other msg")
+	mockIdentity.On("Verify", otherMsg, sig).Return(errors.New("Invalid signature"))
+	assert.EqualError(t, id.Verify(otherMsg, sig), "Invalid signature")
+
+	// Check the same signature is verified again for another identity
+	mockIdentity2 := &mocks.MockIdentity{ID: "Bob"}
+	mockIdentity2.On("GetIdentifier").Return(&msp.IdentityIdentifier{Mspid: "MSP", Id: "Bob"})
+	mockIdentity2.On("Verify", msg, sig).Return(errors.New("Invalid signature"))
+	assert.EqualError(t, i.(*cachedMSP).verify(mockIdentity2, msg, sig), "Invalid signature")
+	mockIdentity2.AssertExpectations(t)
+}
+
 func TestSatisfiesValidateIndirectCall(t *testing.T) {
 	mockMSP := &mocks.MockMSP{}
 
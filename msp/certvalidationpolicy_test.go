@@ -0,0 +1,134 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msp
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/bccsp/sw"
+	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCertValidationPolicy(t *testing.T) {
+	// testdata/intermediate has a signing identity and an admin, both signed
+	// by an intermediate CA with ECDSA-SHA256, and with digitalSignature and
+	// keyEncipherment key usages
+	t.Run("Satisfied", func(t *testing.T) {
+		thisMSP, err := getMSPWithCertValidationPolicy(t, "testdata/intermediate", &msp.FabricCertValidationPolicy{
+			MaxIntermediateDepth:       1,
+			RequiredKeyUsages:          []string{"digitalSignature", "keyEncipherment"},
+			AllowedSignatureAlgorithms: []string{"ECDSA-SHA256", "ECDSA-SHA384"},
+		})
+		assert.NoError(t, err)
+
+		id, err := thisMSP.GetDefaultSigningIdentity()
+		assert.NoError(t, err)
+		assert.NoError(t, thisMSP.Validate(id.GetPublicVersion()))
+	})
+
+	t.Run("MissingKeyUsage", func(t *testing.T) {
+		_, err := getMSPWithCertValidationPolicy(t, "testdata/intermediate", &msp.FabricCertValidationPolicy{
+			RequiredKeyUsages: []string{"digitalSignature", "keyAgreement"},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "the certificate is missing required key usages [digitalSignature keyAgreement]")
+	})
+
+	t.Run("DisallowedSignatureAlgorithm", func(t *testing.T) {
+		_, err := getMSPWithCertValidationPolicy(t, "testdata/intermediate", &msp.FabricCertValidationPolicy{
+			AllowedSignatureAlgorithms: []string{"ECDSA-SHA384"},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "is signed with ECDSA-SHA256, which is not allowed")
+	})
+
+	t.Run("UnknownKeyUsage", func(t *testing.T) {
+		_, err := getMSPWithCertValidationPolicy(t, "testdata/intermediate", &msp.FabricCertValidationPolicy{
+			RequiredKeyUsages: []string{"signEverything"},
+		})
+		assert.EqualError(t, err, "invalid certificate validation policy: unknown key usage [signEverything]")
+	})
+
+	t.Run("UnknownSignatureAlgorithm", func(t *testing.T) {
+		_, err := getMSPWithCertValidationPolicy(t, "testdata/intermediate", &msp.FabricCertValidationPolicy{
+			AllowedSignatureAlgorithms: []string{"MD5-RSA"},
+		})
+		assert.EqualError(t, err, "invalid certificate validation policy: unknown signature algorithm [MD5-RSA]")
+	})
+}
+
+func TestValidateChainAgainstPolicy(t *testing.T) {
+	leaf := &x509.Certificate{
+		Subject:            pkix.Name{CommonName: "leaf"},
+		KeyUsage:           x509.KeyUsageDigitalSignature,
+		SignatureAlgorithm: x509.ECDSAWithSHA256,
+	}
+	intermediate := &x509.Certificate{
+		Subject:            pkix.Name{CommonName: "intermediate"},
+		SignatureAlgorithm: x509.ECDSAWithSHA384,
+	}
+	root := &x509.Certificate{
+		Subject:            pkix.Name{CommonName: "root"},
+		SignatureAlgorithm: x509.SHA256WithRSA,
+	}
+
+	thisMSP := &bccspmsp{}
+
+	// Without a policy, any chain is fine
+	assert.NoError(t, thisMSP.validateChainAgainstPolicy([]*x509.Certificate{leaf, intermediate, intermediate, root}))
+
+	err := thisMSP.setupCertValidationPolicy(&msp.FabricMSPConfig{
+		CertValidationPolicy: &msp.FabricCertValidationPolicy{
+			MaxIntermediateDepth:       1,
+			RequiredKeyUsages:          []string{"digitalSignature"},
+			AllowedSignatureAlgorithms: []string{"ECDSA-SHA256", "ECDSA-SHA384"},
+		},
+	})
+	assert.NoError(t, err)
+
+	// The root certificate is not subject to the allowed signature algorithms
+	assert.NoError(t, thisMSP.validateChainAgainstPolicy([]*x509.Certificate{leaf, root}))
+	assert.NoError(t, thisMSP.validateChainAgainstPolicy([]*x509.Certificate{leaf, intermediate, root}))
+
+	err = thisMSP.validateChainAgainstPolicy([]*x509.Certificate{leaf, intermediate, intermediate, root})
+	assert.EqualError(t, err, "the certification chain has 2 intermediate certificates, at most 1 are allowed")
+
+	err = thisMSP.validateChainAgainstPolicy([]*x509.Certificate{intermediate, root})
+	assert.EqualError(t, err, "the certificate is missing required key usages [digitalSignature]")
+
+	err = thisMSP.validateChainAgainstPolicy([]*x509.Certificate{leaf, root, root})
+	assert.EqualError(t, err, "certificate [CN=root] is signed with SHA256-RSA, which is not allowed")
+
+	// Setting up without a policy removes the constraints
+	err = thisMSP.setupCertValidationPolicy(&msp.FabricMSPConfig{})
+	assert.NoError(t, err)
+	assert.NoError(t, thisMSP.validateChainAgainstPolicy([]*x509.Certificate{intermediate, root, root, root}))
+}
+
+func getMSPWithCertValidationPolicy(t *testing.T, dir string, policy *msp.FabricCertValidationPolicy) (MSP, error) {
+	conf, err := GetLocalMspConfig(dir, nil, "SampleOrg")
+	assert.NoError(t, err)
+
+	fabricConf := &msp.FabricMSPConfig{}
+	err = proto.Unmarshal(conf.Config, fabricConf)
+	assert.NoError(t, err)
+	fabricConf.CertValidationPolicy = policy
+	conf.Config, err = proto.Marshal(fabricConf)
+	assert.NoError(t, err)
+
+	ks, err := sw.NewFileBasedKeyStore(nil, filepath.Join(dir, "keystore"), true)
+	assert.NoError(t, err)
+	thisMSP, err := NewBccspMspWithKeyStore(MSPv2_0, ks)
+	assert.NoError(t, err)
+
+	return thisMSP, thisMSP.Setup(conf)
+}
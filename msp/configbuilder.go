@@ -16,6 +16,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/bccsp"
 	"github.com/hyperledger/fabric/bccsp/factory"
+	"github.com/hyperledger/fabric/idemix"
 	"github.com/hyperledger/fabric/protos/msp"
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v2"
@@ -41,6 +42,23 @@ type NodeOUs struct {
 	ClientOUIdentifier *OrganizationalUnitIdentifiersConfiguration `yaml:"ClientOUIdentifier,omitempty"`
 	// PeerOUIdentifier specifies how to recognize peers by OU
 	PeerOUIdentifier *OrganizationalUnitIdentifiersConfiguration `yaml:"PeerOUIdentifier,omitempty"`
+	// AdminOUIdentifier specifies how to recognize admins by OU
+	AdminOUIdentifier *OrganizationalUnitIdentifiersConfiguration `yaml:"AdminOUIdentifier,omitempty"`
+	// OrdererOUIdentifier specifies how to recognize orderers by OU
+	OrdererOUIdentifier *OrganizationalUnitIdentifiersConfiguration `yaml:"OrdererOUIdentifier,omitempty"`
+}
+
+// CertValidationPolicy contains constraints the certificate chains of the
+// identities of an MSP must satisfy, in addition to the ones always enforced.
+type CertValidationPolicy struct {
+	// MaxIntermediateDepth is the maximum number of intermediate certificates
+	// between an identity and its root certificate; zero means no limit
+	MaxIntermediateDepth uint32 `yaml:"MaxIntermediateDepth,omitempty"`
+	// RequiredKeyUsages lists the key usages identities' certificates must have
+	RequiredKeyUsages []string `yaml:"RequiredKeyUsages,omitempty"`
+	// AllowedSignatureAlgorithms lists the signature algorithms that may be
+	// used to sign the certificates of identities' chains
+	AllowedSignatureAlgorithms []string `yaml:"AllowedSignatureAlgorithms,omitempty"`
 }
 
 // Configuration represents the accessory configuration an MSP can be equipped with.
@@ -52,6 +70,9 @@ type Configuration struct {
 	// NodeOUs enables the MSP to tell apart clients, peers and orderers based
 	// on the identity's OU.
 	NodeOUs *NodeOUs `yaml:"NodeOUs,omitempty"`
+	// CertValidationPolicy enables the MSP to enforce stricter constraints
+	// on the certificate chains of its identities.
+	CertValidationPolicy *CertValidationPolicy `yaml:"CertValidationPolicy,omitempty"`
 }
 
 func readFile(file string) ([]byte, error) {
@@ -139,9 +160,12 @@ func SetupBCCSPKeystoreConfig(bccspConfig *factory.FactoryOpts, keystoreDir stri
 			bccspConfig.SwOpts = factory.GetDefaultOpts().SwOpts
 		}
 
-		// Only override the KeyStorePath if it was left empty
-		if bccspConfig.SwOpts.FileKeystore == nil ||
+		if bccspConfig.SwOpts.SecretStoreKeystore != nil {
+			// The keys are kept in a secret store, not in the keystore directory
+			bccspConfig.SwOpts.Ephemeral = false
+		} else if bccspConfig.SwOpts.FileKeystore == nil ||
 			bccspConfig.SwOpts.FileKeystore.KeyStorePath == "" {
+			// Only override the KeyStorePath if it was left empty
 			bccspConfig.SwOpts.Ephemeral = false
 			bccspConfig.SwOpts.FileKeystore = &factory.FileKeystoreOpts{KeyStorePath: keystoreDir}
 		}
@@ -216,8 +240,10 @@ func getMspConfig(dir string, ID string, sigid *msp.SigningIdentityInfo) (*msp.M
 		return nil, errors.WithMessage(err, fmt.Sprintf("could not load a valid ca certificate from directory %s", cacertDir))
 	}
 
+	// Admin certificates may be omitted if admins are recognized by OU,
+	// which is checked once the configuration file is loaded
 	admincert, err := getPemMaterialFromDir(admincertDir)
-	if err != nil || len(admincert) == 0 {
+	if err != nil && !os.IsNotExist(err) {
 		return nil, errors.WithMessage(err, fmt.Sprintf("could not load a valid admin certificate from directory %s", admincertDir))
 	}
 
@@ -257,6 +283,7 @@ func getMspConfig(dir string, ID string, sigid *msp.SigningIdentityInfo) (*msp.M
 	// otherwise skip it
 	var ouis []*msp.FabricOUIdentifier
 	var nodeOUs *msp.FabricNodeOUs
+	var certValidationPolicy *msp.FabricCertValidationPolicy
 	_, err = os.Stat(configFile)
 	if err == nil {
 		// load the file, if there is a failure in loading it then
@@ -324,11 +351,28 @@ func getMspConfig(dir string, ID string, sigid *msp.SigningIdentityInfo) (*msp.M
 			} else {
 				nodeOUs.PeerOuIdentifier.Certificate = raw
 			}
+
+			// AdminOU and OrdererOU are optional
+			nodeOUs.AdminOuIdentifier = loadNodeOUIdentifier(dir, configuration.NodeOUs.AdminOUIdentifier)
+			nodeOUs.OrdererOuIdentifier = loadNodeOUIdentifier(dir, configuration.NodeOUs.OrdererOUIdentifier)
+		}
+
+		// Prepare CertValidationPolicy
+		if configuration.CertValidationPolicy != nil {
+			certValidationPolicy = &msp.FabricCertValidationPolicy{
+				MaxIntermediateDepth:       configuration.CertValidationPolicy.MaxIntermediateDepth,
+				RequiredKeyUsages:          configuration.CertValidationPolicy.RequiredKeyUsages,
+				AllowedSignatureAlgorithms: configuration.CertValidationPolicy.AllowedSignatureAlgorithms,
+			}
 		}
 	} else {
 		mspLogger.Debugf("MSP configuration file not found at [%s]: [%s]", configFile, err)
 	}
 
+	if len(admincert) == 0 && (nodeOUs == nil || nodeOUs.AdminOuIdentifier == nil) {
+		return nil, errors.Errorf("could not load a valid admin certificate from directory %s", admincertDir)
+	}
+
 	// Set FabricCryptoConfig
 	cryptoConfig := &msp.FabricCryptoConfig{
 		SignatureHashFamily:            bccsp.SHA2,
@@ -348,6 +392,7 @@ func getMspConfig(dir string, ID string, sigid *msp.SigningIdentityInfo) (*msp.M
 		TlsRootCerts:                  tlsCACerts,
 		TlsIntermediateCerts:          tlsIntermediateCerts,
 		FabricNodeOus:                 nodeOUs,
+		CertValidationPolicy:          certValidationPolicy,
 	}
 
 	fmpsjs, _ := proto.Marshal(fmspconf)
@@ -363,6 +408,9 @@ const (
 	IdemixConfigFileIssuerPublicKey     = "IssuerPublicKey"
 	IdemixConfigFileRevocationPublicKey = "RevocationPublicKey"
 	IdemixConfigFileSigner              = "SignerConfig"
+	IdemixConfigFileCRI                 = "CRI"
+
+	IdemixConfigDirPreviousIssuerPublicKeys = "PreviousIssuerPublicKeys"
 )
 
 // GetIdemixMspConfig returns the configuration for the Idemix MSP
@@ -383,6 +431,25 @@ func GetIdemixMspConfig(dir string, ID string) (*msp.MSPConfig, error) {
 		RevocationPk: revocationPkBytes,
 	}
 
+	// The credential revocation information of the current epoch is optional
+	criBytes, err := readFile(filepath.Join(dir, IdemixConfigDirMsp, IdemixConfigFileCRI))
+	if err == nil {
+		cri := &idemix.CredentialRevocationInformation{}
+		err = proto.Unmarshal(criBytes, cri)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal credential revocation information")
+		}
+		idemixConfig.Epoch = cri.Epoch
+		idemixConfig.CredentialRevocationInformation = criBytes
+	}
+
+	// The issuer public keys which were rotated out are optional
+	previousIpks, err := getPreviousIssuerPublicKeys(filepath.Join(dir, IdemixConfigDirMsp, IdemixConfigDirPreviousIssuerPublicKeys))
+	if err != nil {
+		return nil, err
+	}
+	idemixConfig.PreviousIpks = previousIpks
+
 	signerBytes, err := readFile(filepath.Join(dir, IdemixConfigDirUser, IdemixConfigFileSigner))
 	if err == nil {
 		signerConfig := &msp.IdemixMSPSignerConfig{}
@@ -400,3 +467,52 @@ func GetIdemixMspConfig(dir string, ID string) (*msp.MSPConfig, error) {
 
 	return &msp.MSPConfig{Config: confBytes, Type: int32(IDEMIX)}, nil
 }
+
+// getPreviousIssuerPublicKeys reads the issuer public keys which were rotated
+// out from the given directory, one per file, if the directory exists
+func getPreviousIssuerPublicKeys(dir string) ([]*msp.IdemixPreviousIssuerPublicKey, error) {
+	files, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read previous issuer public keys directory")
+	}
+
+	var previousIpks []*msp.IdemixPreviousIssuerPublicKey
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		raw, err := readFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read previous issuer public key file")
+		}
+		previous := &msp.IdemixPreviousIssuerPublicKey{}
+		err = proto.Unmarshal(raw, previous)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal previous issuer public key %s", f.Name())
+		}
+		previousIpks = append(previousIpks, previous)
+	}
+	return previousIpks, nil
+}
+
+// loadNodeOUIdentifier returns the FabricOUIdentifier for the given NodeOU
+// configuration, or nil if it does not specify an OU. The certificate,
+// if any, is read relative to the MSP directory.
+func loadNodeOUIdentifier(dir string, conf *OrganizationalUnitIdentifiersConfiguration) *msp.FabricOUIdentifier {
+	if conf == nil || len(conf.OrganizationalUnitIdentifier) == 0 {
+		return nil
+	}
+
+	ou := &msp.FabricOUIdentifier{OrganizationalUnitIdentifier: conf.OrganizationalUnitIdentifier}
+	f := filepath.Join(dir, conf.Certificate)
+	raw, err := readFile(f)
+	if err != nil {
+		mspLogger.Debugf("Failed loading %s certificate at [%s]: [%s]", conf.OrganizationalUnitIdentifier, f, err)
+	} else {
+		ou.Certificate = raw
+	}
+	return ou
+}
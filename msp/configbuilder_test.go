@@ -12,7 +12,10 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/bccsp/factory"
 	"github.com/hyperledger/fabric/core/config/configtest"
+	"github.com/hyperledger/fabric/protos/msp"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -72,3 +75,53 @@ func TestReadFileUtils(t *testing.T) {
 	_, err = readPemFile("/dev/null")
 	assert.Error(t, err)
 }
+
+func TestGetLocalMspConfigWithCertValidationPolicy(t *testing.T) {
+	mspDir, err := configtest.GetDevMspDir()
+	assert.NoError(t, err)
+
+	tempDir, err := ioutil.TempDir("", "fabric-msp-test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	for _, dir := range []string{signcerts, cacerts, admincerts, keystore} {
+		err = os.Symlink(filepath.Join(mspDir, dir), filepath.Join(tempDir, dir))
+		assert.NoError(t, err)
+	}
+	err = ioutil.WriteFile(filepath.Join(tempDir, configfilename), []byte(`
+CertValidationPolicy:
+  MaxIntermediateDepth: 2
+  RequiredKeyUsages:
+    - digitalSignature
+  AllowedSignatureAlgorithms:
+    - ECDSA-SHA256
+`), 0644)
+	assert.NoError(t, err)
+
+	conf, err := GetLocalMspConfig(tempDir, nil, "SampleOrg")
+	assert.NoError(t, err)
+
+	fabricConf := &msp.FabricMSPConfig{}
+	err = proto.Unmarshal(conf.Config, fabricConf)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(&msp.FabricCertValidationPolicy{
+		MaxIntermediateDepth:       2,
+		RequiredKeyUsages:          []string{"digitalSignature"},
+		AllowedSignatureAlgorithms: []string{"ECDSA-SHA256"},
+	}, fabricConf.CertValidationPolicy))
+}
+
+func TestSetupBCCSPKeystoreConfig(t *testing.T) {
+	keystoreDir := "/tmp/keystore"
+
+	bccspConfig := SetupBCCSPKeystoreConfig(nil, keystoreDir)
+	assert.Equal(t, keystoreDir, bccspConfig.SwOpts.FileKeystore.KeyStorePath)
+
+	// The keystore directory is ignored when the keys are kept in a secret store
+	bccspConfig = factory.GetDefaultOpts()
+	bccspConfig.SwOpts.SecretStoreKeystore = &factory.SecretStoreKeystoreOpts{Type: "file", Path: "/tmp/secrets"}
+	bccspConfig = SetupBCCSPKeystoreConfig(bccspConfig, keystoreDir)
+	assert.Nil(t, bccspConfig.SwOpts.FileKeystore)
+	assert.False(t, bccspConfig.SwOpts.Ephemeral)
+	assert.Equal(t, "/tmp/secrets", bccspConfig.SwOpts.SecretStoreKeystore.Path)
+}
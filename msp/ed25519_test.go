@@ -0,0 +1,43 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msp
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEd25519Identities(t *testing.T) {
+	// testdata/ed25519 contains a CA and a signing identity with Ed25519 keys
+	thisMSP := getLocalMSPWithVersion(t, "testdata/ed25519", MSPv2_0)
+
+	id, err := thisMSP.GetDefaultSigningIdentity()
+	assert.NoError(t, err)
+	assert.NoError(t, thisMSP.Validate(id.GetPublicVersion()))
+
+	serializedID, err := id.Serialize()
+	assert.NoError(t, err)
+	idBack, err := thisMSP.DeserializeIdentity(serializedID)
+	assert.NoError(t, err)
+	assert.NoError(t, thisMSP.Validate(idBack))
+
+	msg := []byte("foo")
+	sig, err := id.Sign(msg)
+	assert.NoError(t, err)
+	assert.NoError(t, id.Verify(msg, sig))
+	assert.NoError(t, idBack.Verify(msg, sig))
+	assert.Error(t, idBack.Verify(msg[1:], sig))
+	assert.Error(t, idBack.Verify(msg, sig[1:]))
+
+	// Ed25519 signs the message itself, rather than its digest
+	cert := idBack.(*identity).cert
+	assert.Equal(t, x509.Ed25519, cert.PublicKeyAlgorithm)
+	assert.True(t, ed25519.Verify(cert.PublicKey.(ed25519.PublicKey), msg, sig))
+}
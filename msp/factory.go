@@ -16,6 +16,7 @@ const (
 	MSPv1_0 = iota
 	MSPv1_1
 	MSPv1_3
+	MSPv2_0
 )
 
 // NewOpts represent
@@ -27,6 +28,8 @@ type NewOpts interface {
 // NewBaseOpts is the default base type for all MSP instantiation Opts
 type NewBaseOpts struct {
 	Version MSPVersion
+	// Metrics are the metrics reported by the MSP, none if nil
+	Metrics *Metrics
 }
 
 func (o *NewBaseOpts) GetVersion() MSPVersion {
@@ -45,20 +48,23 @@ type IdemixNewOpts struct {
 
 // New create a new MSP instance depending on the passed Opts
 func New(opts NewOpts) (MSP, error) {
-	switch opts.(type) {
+	switch o := opts.(type) {
 	case *BCCSPNewOpts:
 		switch opts.GetVersion() {
-		case MSPv1_0:
-			return newBccspMsp(MSPv1_0)
-		case MSPv1_1:
-			return newBccspMsp(MSPv1_1)
-		case MSPv1_3:
-			return newBccspMsp(MSPv1_3)
+		case MSPv1_0, MSPv1_1, MSPv1_3, MSPv2_0:
+			theMsp, err := newBccspMsp(opts.GetVersion())
+			if err != nil {
+				return nil, err
+			}
+			theMsp.(*bccspmsp).metrics = metricsOrDisabled(o.Metrics)
+			return theMsp, nil
 		default:
 			return nil, errors.Errorf("Invalid *BCCSPNewOpts. Version not recognized [%v]", opts.GetVersion())
 		}
 	case *IdemixNewOpts:
 		switch opts.GetVersion() {
+		case MSPv2_0:
+			return newIdemixMsp(MSPv2_0)
 		case MSPv1_3:
 			return newIdemixMsp(MSPv1_3)
 		case MSPv1_1:
@@ -16,6 +16,7 @@ import (
 	"github.com/hyperledger/fabric/bccsp"
 	idemixbccsp "github.com/hyperledger/fabric/bccsp/idemix"
 	"github.com/hyperledger/fabric/bccsp/sw"
+	"github.com/hyperledger/fabric/idemix"
 	m "github.com/hyperledger/fabric/protos/msp"
 	"github.com/pkg/errors"
 	"go.uber.org/zap/zapcore"
@@ -56,12 +57,20 @@ type idemixmsp struct {
 	csp          bccsp.BCCSP
 	version      MSPVersion
 	ipk          bccsp.Key
+	previousIpks []*previousIssuerKey
 	signer       *idemixSigningIdentity
 	name         string
 	revocationPK bccsp.Key
 	epoch        int
 }
 
+// previousIssuerKey is an issuer public key which was rotated out,
+// and which is accepted until notAfter
+type previousIssuerKey struct {
+	ipk      bccsp.Key
+	notAfter time.Time
+}
+
 // newIdemixMsp creates a new instance of idemixmsp
 func newIdemixMsp(version MSPVersion) (MSP, error) {
 	mspLogger.Debugf("Creating Idemix-based MSP instance")
@@ -97,38 +106,28 @@ func (msp *idemixmsp) Setup(conf1 *m.MSPConfig) error {
 	mspLogger.Debugf("Setting up Idemix MSP instance %s", msp.name)
 
 	// Import Issuer Public Key
-	IssuerPublicKey, err := msp.csp.KeyImport(
-		conf.Ipk,
-		&bccsp.IdemixIssuerPublicKeyImportOpts{
-			Temporary: true,
-			AttributeNames: []string{
-				AttributeNameOU,
-				AttributeNameRole,
-				AttributeNameEnrollmentId,
-				AttributeNameRevocationHandle,
-			},
-		})
+	IssuerPublicKey, err := msp.importIssuerPublicKey(conf.Ipk)
 	if err != nil {
-		importErr, ok := errors.Cause(err).(*bccsp.IdemixIssuerPublicKeyImporterError)
-		if !ok {
-			panic("unexpected condition, BCCSP did not return the expected *bccsp.IdemixIssuerPublicKeyImporterError")
+		return err
+	}
+	msp.ipk = IssuerPublicKey
+
+	// Import the issuer public keys which were rotated out, so that
+	// the credentials they issued are accepted until they expire
+	msp.previousIpks = nil
+	for i, previous := range conf.PreviousIpks {
+		ipk, err := msp.importIssuerPublicKey(previous.Ipk)
+		if err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("failed importing previous issuer public key %d", i))
 		}
-		switch importErr.Type {
-		case bccsp.IdemixIssuerPublicKeyImporterUnmarshallingError:
-			return errors.WithMessage(err, "failed to unmarshal ipk from idemix msp config")
-		case bccsp.IdemixIssuerPublicKeyImporterHashError:
-			return errors.WithMessage(err, "setting the hash of the issuer public key failed")
-		case bccsp.IdemixIssuerPublicKeyImporterValidationError:
-			return errors.WithMessage(err, "cannot setup idemix msp with invalid public key")
-		case bccsp.IdemixIssuerPublicKeyImporterNumAttributesError:
-			fallthrough
-		case bccsp.IdemixIssuerPublicKeyImporterAttributeNameError:
-			return errors.Errorf("issuer public key must have have attributes OU, Role, EnrollmentId, and RevocationHandle")
-		default:
-			panic(fmt.Sprintf("unexpected condtion, issuer public key import error not valid, got [%d]", importErr.Type))
+		if bytes.Equal(ipk.SKI(), IssuerPublicKey.SKI()) {
+			return errors.Errorf("previous issuer public key %d is the current issuer public key", i)
 		}
+		msp.previousIpks = append(msp.previousIpks, &previousIssuerKey{
+			ipk:      ipk,
+			notAfter: time.Unix(previous.NotAfter, 0),
+		})
 	}
-	msp.ipk = IssuerPublicKey
 
 	// Import revocation public key
 	RevocationPublicKey, err := msp.csp.KeyImport(
@@ -140,6 +139,16 @@ func (msp *idemixmsp) Setup(conf1 *m.MSPConfig) error {
 	}
 	msp.revocationPK = RevocationPublicKey
 
+	// Check that the credential revocation information of the current epoch,
+	// if any, was signed by the revocation authority
+	msp.epoch = int(conf.Epoch)
+	if len(conf.CredentialRevocationInformation) != 0 {
+		err = msp.verifyCRI(conf.CredentialRevocationInformation)
+		if err != nil {
+			return err
+		}
+	}
+
 	if conf.Signer == nil {
 		// No credential in config, so we don't setup a default signer
 		mspLogger.Debug("idemix msp setup as verification only msp (no key material found)")
@@ -154,6 +163,23 @@ func (msp *idemixmsp) Setup(conf1 *m.MSPConfig) error {
 		return errors.WithMessage(err, "failed importing signer secret key")
 	}
 
+	role := &m.MSPRole{
+		MspIdentifier: msp.name,
+		Role:          m.MSPRole_MEMBER,
+	}
+	if checkRole(int(conf.Signer.Role), ADMIN) {
+		role.Role = m.MSPRole_ADMIN
+	}
+
+	enrollmentId := conf.Signer.EnrollmentId
+
+	// Verify credential, against the current issuer public key or,
+	// if it was issued before the last rotations, a previous one
+	IssuerPublicKey, err = msp.credentialIssuer(UserKey, conf.Signer, role)
+	if err != nil {
+		return err
+	}
+
 	// Derive NymPublicKey
 	NymKey, err := msp.csp.KeyDeriv(UserKey, &bccsp.IdemixNymKeyDerivationOpts{Temporary: true, IssuerPK: IssuerPublicKey})
 	if err != nil {
@@ -164,39 +190,17 @@ func (msp *idemixmsp) Setup(conf1 *m.MSPConfig) error {
 		return errors.Wrapf(err, "failed getting public nym key")
 	}
 
-	role := &m.MSPRole{
-		MspIdentifier: msp.name,
-		Role:          m.MSPRole_MEMBER,
-	}
-	if checkRole(int(conf.Signer.Role), ADMIN) {
-		role.Role = m.MSPRole_ADMIN
-	}
-
 	ou := &m.OrganizationUnit{
 		MspIdentifier:                msp.name,
 		OrganizationalUnitIdentifier: conf.Signer.OrganizationalUnitIdentifier,
 		CertifiersIdentifier:         IssuerPublicKey.SKI(),
 	}
 
-	enrollmentId := conf.Signer.EnrollmentId
-
-	// Verify credential
-	valid, err := msp.csp.Verify(
-		UserKey,
-		conf.Signer.Cred,
-		nil,
-		&bccsp.IdemixCredentialSignerOpts{
-			IssuerPK: IssuerPublicKey,
-			Attributes: []bccsp.IdemixAttribute{
-				{Type: bccsp.IdemixBytesAttribute, Value: []byte(conf.Signer.OrganizationalUnitIdentifier)},
-				{Type: bccsp.IdemixIntAttribute, Value: getIdemixRoleFromMSPRole(role)},
-				{Type: bccsp.IdemixBytesAttribute, Value: []byte(enrollmentId)},
-				{Type: bccsp.IdemixHiddenAttribute},
-			},
-		},
-	)
-	if err != nil || !valid {
-		return errors.WithMessage(err, "Credential is not cryptographically valid")
+	// Prove non-revocation against the credential revocation information of the
+	// current epoch, when the MSP config carries it
+	cri := conf.Signer.CredentialRevocationInformation
+	if len(conf.CredentialRevocationInformation) != 0 {
+		cri = conf.CredentialRevocationInformation
 	}
 
 	// Create the cryptographic evidence that this identity is valid
@@ -214,7 +218,7 @@ func (msp *idemixmsp) Setup(conf1 *m.MSPConfig) error {
 				{Type: bccsp.IdemixHiddenAttribute},
 			},
 			RhIndex: rhIndex,
-			CRI:     conf.Signer.CredentialRevocationInformation,
+			CRI:     cri,
 		},
 	)
 	if err != nil {
@@ -232,6 +236,119 @@ func (msp *idemixmsp) Setup(conf1 *m.MSPConfig) error {
 	return nil
 }
 
+// importIssuerPublicKey imports the given serialized issuer public key,
+// which must have the attributes of the credentials of this MSP
+func (msp *idemixmsp) importIssuerPublicKey(raw []byte) (bccsp.Key, error) {
+	ipk, err := msp.csp.KeyImport(
+		raw,
+		&bccsp.IdemixIssuerPublicKeyImportOpts{
+			Temporary: true,
+			AttributeNames: []string{
+				AttributeNameOU,
+				AttributeNameRole,
+				AttributeNameEnrollmentId,
+				AttributeNameRevocationHandle,
+			},
+		})
+	if err != nil {
+		importErr, ok := errors.Cause(err).(*bccsp.IdemixIssuerPublicKeyImporterError)
+		if !ok {
+			panic("unexpected condition, BCCSP did not return the expected *bccsp.IdemixIssuerPublicKeyImporterError")
+		}
+		switch importErr.Type {
+		case bccsp.IdemixIssuerPublicKeyImporterUnmarshallingError:
+			return nil, errors.WithMessage(err, "failed to unmarshal ipk from idemix msp config")
+		case bccsp.IdemixIssuerPublicKeyImporterHashError:
+			return nil, errors.WithMessage(err, "setting the hash of the issuer public key failed")
+		case bccsp.IdemixIssuerPublicKeyImporterValidationError:
+			return nil, errors.WithMessage(err, "cannot setup idemix msp with invalid public key")
+		case bccsp.IdemixIssuerPublicKeyImporterNumAttributesError:
+			fallthrough
+		case bccsp.IdemixIssuerPublicKeyImporterAttributeNameError:
+			return nil, errors.Errorf("issuer public key must have have attributes OU, Role, EnrollmentId, and RevocationHandle")
+		default:
+			panic(fmt.Sprintf("unexpected condtion, issuer public key import error not valid, got [%d]", importErr.Type))
+		}
+	}
+	return ipk, nil
+}
+
+// credentialIssuer returns the issuer public key, either the current one or a
+// previous one, with respect to which the credential of the signer is valid
+func (msp *idemixmsp) credentialIssuer(userKey bccsp.Key, signer *m.IdemixMSPSignerConfig, role *m.MSPRole) (bccsp.Key, error) {
+	candidates := []bccsp.Key{msp.ipk}
+	for _, previous := range msp.previousIpks {
+		candidates = append(candidates, previous.ipk)
+	}
+
+	var err error
+	for _, ipk := range candidates {
+		var valid bool
+		valid, err = msp.csp.Verify(
+			userKey,
+			signer.Cred,
+			nil,
+			&bccsp.IdemixCredentialSignerOpts{
+				IssuerPK: ipk,
+				Attributes: []bccsp.IdemixAttribute{
+					{Type: bccsp.IdemixBytesAttribute, Value: []byte(signer.OrganizationalUnitIdentifier)},
+					{Type: bccsp.IdemixIntAttribute, Value: getIdemixRoleFromMSPRole(role)},
+					{Type: bccsp.IdemixBytesAttribute, Value: []byte(signer.EnrollmentId)},
+					{Type: bccsp.IdemixHiddenAttribute},
+				},
+			},
+		)
+		if err == nil && valid {
+			return ipk, nil
+		}
+	}
+	return nil, errors.WithMessage(err, "Credential is not cryptographically valid")
+}
+
+// issuerOf returns the issuer public key of the identities whose OU has the
+// given certifiers identifier, which is the SKI of the issuer public key, and
+// the time until which this key is accepted, which is zero for the current key.
+// Unknown certifiers identifiers are resolved to the current issuer public key,
+// so that the identities with a wrong one fail validation.
+func (msp *idemixmsp) issuerOf(certifiersIdentifier []byte) (bccsp.Key, time.Time) {
+	for _, previous := range msp.previousIpks {
+		if bytes.Equal(previous.ipk.SKI(), certifiersIdentifier) {
+			return previous.ipk, previous.notAfter
+		}
+	}
+	return msp.ipk, time.Time{}
+}
+
+// verifyCRI checks that the given credential revocation information is the one
+// of the current epoch, and that it was signed by the revocation authority
+func (msp *idemixmsp) verifyCRI(criBytes []byte) error {
+	cri := &idemix.CredentialRevocationInformation{}
+	err := proto.Unmarshal(criBytes, cri)
+	if err != nil {
+		return errors.Wrap(err, "failed to unmarshal credential revocation information")
+	}
+	if int(cri.Epoch) != msp.epoch {
+		return errors.Errorf("credential revocation information is for epoch %d, but the current epoch is %d", cri.Epoch, msp.epoch)
+	}
+
+	valid, err := msp.csp.Verify(
+		msp.revocationPK,
+		criBytes,
+		nil,
+		&bccsp.IdemixCRISignerOpts{
+			Epoch:               msp.epoch,
+			RevocationAlgorithm: bccsp.RevocationAlgorithm(cri.RevocationAlg),
+		},
+	)
+	if err != nil {
+		return errors.WithMessage(err, "credential revocation information is not valid")
+	}
+	if !valid {
+		return errors.New("credential revocation information is not valid")
+	}
+	return nil
+}
+
 // GetVersion returns the version of this MSP
 func (msp *idemixmsp) GetVersion() MSPVersion {
 	return msp.version
@@ -327,13 +444,16 @@ func (msp *idemixmsp) Validate(id Identity) error {
 	if identity.GetMSPIdentifier() != msp.name {
 		return errors.Errorf("the supplied identity does not belong to this msp")
 	}
+	if !identity.ipkNotAfter.IsZero() && time.Now().After(identity.ipkNotAfter) {
+		return errors.Errorf("the issuer public key of the identity was rotated out and expired on %s", identity.ipkNotAfter.UTC().Format(time.RFC3339))
+	}
 	return identity.verifyProof()
 }
 
 func (id *idemixidentity) verifyProof() error {
 	// Verify signature
 	valid, err := id.msp.csp.Verify(
-		id.msp.ipk,
+		id.ipk,
 		id.associationProof,
 		nil,
 		&bccsp.IdemixSignerOpts{
@@ -521,6 +641,10 @@ type idemixidentity struct {
 	id           *IdentityIdentifier
 	Role         *m.MSPRole
 	OU           *m.OrganizationUnit
+	// ipk is the public key of the issuer of the credential of this identity,
+	// and ipkNotAfter the time it expires, which is zero for the current key
+	ipk         bccsp.Key
+	ipkNotAfter time.Time
 	// associationProof contains cryptographic proof that this identity
 	// belongs to the MSP id.msp, i.e., it proves that the pseudonym
 	// is constructed from a secret key on which the CA issued a credential.
@@ -538,6 +662,7 @@ func newIdemixIdentity(msp *idemixmsp, NymPublicKey bccsp.Key, role *m.MSPRole,
 	id.Role = role
 	id.OU = ou
 	id.associationProof = proof
+	id.ipk, id.ipkNotAfter = msp.issuerOf(ou.CertifiersIdentifier)
 
 	raw, err := NymPublicKey.Bytes()
 	if err != nil {
@@ -568,7 +693,7 @@ func (id *idemixidentity) GetMSPIdentifier() string {
 
 func (id *idemixidentity) GetOrganizationalUnits() []*OUIdentifier {
 	// we use the (serialized) public key of this MSP as the CertifiersIdentifier
-	certifiersIdentifier, err := id.msp.ipk.Bytes()
+	certifiersIdentifier, err := id.ipk.Bytes()
 	if err != nil {
 		mspIdentityLogger.Errorf("Failed to marshal ipk in GetOrganizationalUnits: %s", err)
 		return nil
@@ -592,7 +717,7 @@ func (id *idemixidentity) Verify(msg []byte, sig []byte) error {
 		sig,
 		msg,
 		&bccsp.IdemixNymSignerOpts{
-			IssuerPK: id.msp.ipk,
+			IssuerPK: id.ipk,
 		},
 	)
 	return err
@@ -657,7 +782,7 @@ func (id *idemixSigningIdentity) Sign(msg []byte) ([]byte, error) {
 		msg,
 		&bccsp.IdemixNymSignerOpts{
 			Nym:      id.NymKey,
-			IssuerPK: id.msp.ipk,
+			IssuerPK: id.ipk,
 		},
 	)
 	if err != nil {
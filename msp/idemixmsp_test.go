@@ -8,6 +8,7 @@ package msp
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/idemix"
@@ -124,6 +125,119 @@ func TestSetupBad(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to unmarshal ipk from idemix msp config")
 }
 
+func TestSetupCRI(t *testing.T) {
+	conf, err := GetIdemixMspConfig("testdata/idemix/MSP1OU1", "MSP1OU1")
+	assert.NoError(t, err)
+	idemixConfig := &msp.IdemixMSPConfig{}
+	err = proto.Unmarshal(conf.Config, idemixConfig)
+	assert.NoError(t, err)
+
+	setupWithCRI := func(cri []byte, epoch int64) error {
+		idemixConfig.CredentialRevocationInformation = cri
+		idemixConfig.Epoch = epoch
+		conf.Config, err = proto.Marshal(idemixConfig)
+		assert.NoError(t, err)
+		msp, err := newIdemixMsp(MSPv1_3)
+		assert.NoError(t, err)
+		return msp.Setup(conf)
+	}
+
+	// The CRI of the signer config was signed by the revocation authority for epoch 0
+	signerCRI := idemixConfig.Signer.CredentialRevocationInformation
+	assert.NoError(t, setupWithCRI(signerCRI, 0))
+
+	err = setupWithCRI(signerCRI, 1)
+	assert.EqualError(t, err, "credential revocation information is for epoch 0, but the current epoch is 1")
+
+	// A CRI whose epoch was tampered with is rejected
+	cri := &idemix.CredentialRevocationInformation{}
+	assert.NoError(t, proto.Unmarshal(signerCRI, cri))
+	cri.Epoch = 1
+	criBytes, err := proto.Marshal(cri)
+	assert.NoError(t, err)
+	err = setupWithCRI(criBytes, 1)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "credential revocation information is not valid")
+
+	err = setupWithCRI([]byte("barf"), 0)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to unmarshal credential revocation information")
+}
+
+func TestIssuerKeyRotation(t *testing.T) {
+	rotatedIpk, err := readFile("testdata/idemix/MSP1OU1/msp/IssuerPublicKey")
+	assert.NoError(t, err)
+	currentIpk, err := readFile("testdata/idemix/MSP2OU1/msp/IssuerPublicKey")
+	assert.NoError(t, err)
+
+	// setupRotated sets up the MSP of the given directory, with the issuer key
+	// of MSP2OU1 as the current one, in place of the MSP1 issuer key, which
+	// was used to issue the credential of the signer, if any
+	setupRotated := func(dir string, previousIpks ...*msp.IdemixPreviousIssuerPublicKey) (MSP, error) {
+		conf, err := GetIdemixMspConfig(dir, "MSP1OU1")
+		assert.NoError(t, err)
+		idemixConfig := &msp.IdemixMSPConfig{}
+		err = proto.Unmarshal(conf.Config, idemixConfig)
+		assert.NoError(t, err)
+		idemixConfig.Ipk = currentIpk
+		idemixConfig.PreviousIpks = previousIpks
+		conf.Config, err = proto.Marshal(idemixConfig)
+		assert.NoError(t, err)
+
+		thisMSP, err := newIdemixMsp(MSPv2_0)
+		assert.NoError(t, err)
+		return thisMSP, thisMSP.Setup(conf)
+	}
+
+	// Without the rotated key, the credential of the signer is not valid
+	_, err = setupRotated("testdata/idemix/MSP1OU1")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Credential is not cryptographically valid")
+
+	// Before the rotated key expires, the credentials it issued are accepted
+	notAfter := time.Now().Add(time.Hour).Unix()
+	signerMSP, err := setupRotated("testdata/idemix/MSP1OU1", &msp.IdemixPreviousIssuerPublicKey{Ipk: rotatedIpk, NotAfter: notAfter})
+	assert.NoError(t, err)
+	id, err := getDefaultSigner(signerMSP)
+	assert.NoError(t, err)
+	serializedID, err := id.Serialize()
+	assert.NoError(t, err)
+
+	verifierMSP, err := setupRotated("testdata/idemix/MSP1Verifier", &msp.IdemixPreviousIssuerPublicKey{Ipk: rotatedIpk, NotAfter: notAfter})
+	assert.NoError(t, err)
+	verID, err := verifierMSP.DeserializeIdentity(serializedID)
+	assert.NoError(t, err)
+	assert.NoError(t, verID.Validate())
+
+	msg := []byte("hello world")
+	sig, err := id.Sign(msg)
+	assert.NoError(t, err)
+	assert.NoError(t, verID.Verify(msg, sig))
+
+	// A verifier which does not know the rotated key rejects the identity
+	verifierMSP, err = setupRotated("testdata/idemix/MSP1Verifier")
+	assert.NoError(t, err)
+	verID, err = verifierMSP.DeserializeIdentity(serializedID)
+	assert.NoError(t, err)
+	assert.Error(t, verID.Validate())
+
+	// Once the rotated key expires, the credentials it issued are rejected
+	expired := time.Unix(time.Now().Add(-time.Hour).Unix(), 0)
+	verifierMSP, err = setupRotated("testdata/idemix/MSP1Verifier", &msp.IdemixPreviousIssuerPublicKey{Ipk: rotatedIpk, NotAfter: expired.Unix()})
+	assert.NoError(t, err)
+	verID, err = verifierMSP.DeserializeIdentity(serializedID)
+	assert.NoError(t, err)
+	err = verID.Validate()
+	assert.EqualError(t, err, "the issuer public key of the identity was rotated out and expired on "+expired.UTC().Format(time.RFC3339))
+
+	_, err = setupRotated("testdata/idemix/MSP1Verifier", &msp.IdemixPreviousIssuerPublicKey{Ipk: currentIpk})
+	assert.EqualError(t, err, "previous issuer public key 0 is the current issuer public key")
+
+	_, err = setupRotated("testdata/idemix/MSP1Verifier", &msp.IdemixPreviousIssuerPublicKey{Ipk: []byte("barf")})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed importing previous issuer public key 0")
+}
+
 func TestSigning(t *testing.T) {
 	msp, err := setup("testdata/idemix/MSP1OU1", "MSP1")
 	assert.NoError(t, err)
@@ -16,6 +16,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/gm"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/protos/msp"
 	"github.com/pkg/errors"
@@ -145,14 +146,9 @@ func (id *identity) Verify(msg []byte, sig []byte) error {
 	// mspIdentityLogger.Infof("Verifying signature")
 
 	// Compute Hash
-	hashOpt, err := id.getHashOpt(id.msp.cryptoConfig.SignatureHashFamily)
+	digest, err := id.digest(msg)
 	if err != nil {
-		return errors.WithMessage(err, "failed getting hash function options")
-	}
-
-	digest, err := id.msp.bccsp.Hash(msg, hashOpt)
-	if err != nil {
-		return errors.WithMessage(err, "failed computing digest")
+		return err
 	}
 
 	if mspIdentityLogger.IsEnabledFor(zapcore.DebugLevel) {
@@ -190,12 +186,39 @@ func (id *identity) Serialize() ([]byte, error) {
 	return idBytes, nil
 }
 
+// digest returns the digest of msg to be signed by this identity.
+// Ed25519 signs messages rather than digests, hence msg is returned
+// as is for identities with an Ed25519 key. SM2 signs the SM3 hash of
+// the Z value of the key followed by the message, whatever the
+// signature hash family of the MSP.
+func (id *identity) digest(msg []byte) ([]byte, error) {
+	if id.cert.PublicKeyAlgorithm == x509.Ed25519 {
+		return msg, nil
+	}
+	if pub, ok := id.cert.PublicKey.(*gm.PublicKey); ok {
+		return id.msp.sm2Digest(pub, msg)
+	}
+
+	hashOpt, err := id.getHashOpt(id.msp.cryptoConfig.SignatureHashFamily)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed getting hash function options")
+	}
+
+	digest, err := id.msp.bccsp.Hash(msg, hashOpt)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed computing digest")
+	}
+	return digest, nil
+}
+
 func (id *identity) getHashOpt(hashFamily string) (bccsp.HashOpts, error) {
 	switch hashFamily {
 	case bccsp.SHA2:
 		return bccsp.GetHashOpt(bccsp.SHA256)
 	case bccsp.SHA3:
 		return bccsp.GetHashOpt(bccsp.SHA3_256)
+	case bccsp.SM3:
+		return bccsp.GetHashOpt(bccsp.SM3)
 	}
 	return nil, errors.Errorf("hash familiy not recognized [%s]", hashFamily)
 }
@@ -222,14 +245,9 @@ func (id *signingidentity) Sign(msg []byte) ([]byte, error) {
 	//mspIdentityLogger.Infof("Signing message")
 
 	// Compute Hash
-	hashOpt, err := id.getHashOpt(id.msp.cryptoConfig.SignatureHashFamily)
+	digest, err := id.digest(msg)
 	if err != nil {
-		return nil, errors.WithMessage(err, "failed getting hash function options")
-	}
-
-	digest, err := id.msp.bccsp.Hash(msg, hashOpt)
-	if err != nil {
-		return nil, errors.WithMessage(err, "failed computing digest")
+		return nil, err
 	}
 
 	if len(msg) < 32 {
@@ -0,0 +1,49 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msp
+
+import (
+	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
+)
+
+var (
+	deserializeIdentityFailures = metrics.CounterOpts{
+		Namespace:    "msp",
+		Name:         "deserialize_identity_failures",
+		Help:         "The number of serialized identities which could not be deserialized.",
+		LabelNames:   []string{"msp_id"},
+		StatsdFormat: "%{#fqname}.%{msp_id}",
+	}
+	crlRejections = metrics.CounterOpts{
+		Namespace:    "msp",
+		Name:         "crl_rejections",
+		Help:         "The number of identities rejected because their certificate was revoked.",
+		LabelNames:   []string{"msp_id"},
+		StatsdFormat: "%{#fqname}.%{msp_id}",
+	}
+)
+
+type Metrics struct {
+	DeserializeIdentityFailures metrics.Counter
+	CRLRejections               metrics.Counter
+}
+
+func NewMetrics(p metrics.Provider) *Metrics {
+	return &Metrics{
+		DeserializeIdentityFailures: p.NewCounter(deserializeIdentityFailures),
+		CRLRejections:               p.NewCounter(crlRejections),
+	}
+}
+
+// metricsOrDisabled returns the given metrics, or disabled metrics if nil
+func metricsOrDisabled(m *Metrics) *Metrics {
+	if m == nil {
+		return NewMetrics(&disabled.Provider{})
+	}
+	return m
+}
@@ -0,0 +1,96 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msp
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/metrics/metricsfakes"
+	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFakeMetrics() (*Metrics, *metricsfakes.Counter, *metricsfakes.Counter) {
+	deserializeFailures := &metricsfakes.Counter{}
+	deserializeFailures.WithReturns(deserializeFailures)
+	crlRejections := &metricsfakes.Counter{}
+	crlRejections.WithReturns(crlRejections)
+	m := &Metrics{
+		DeserializeIdentityFailures: deserializeFailures,
+		CRLRejections:               crlRejections,
+	}
+	return m, deserializeFailures, crlRejections
+}
+
+func TestDeserializeIdentityFailuresMetric(t *testing.T) {
+	m, deserializeFailures, _ := newFakeMetrics()
+
+	mspMgr := NewMSPManager(m)
+	require.NoError(t, mspMgr.Setup([]MSP{localMsp}))
+
+	_, err := mspMgr.DeserializeIdentity([]byte("barf"))
+	assert.Error(t, err)
+	assert.Equal(t, 1, deserializeFailures.AddCallCount())
+	assert.Equal(t, []string{"msp_id", ""}, deserializeFailures.WithArgsForCall(0))
+
+	sID, err := proto.Marshal(&msp.SerializedIdentity{Mspid: "UnknownMSP", IdBytes: []byte("barf")})
+	require.NoError(t, err)
+	_, err = mspMgr.DeserializeIdentity(sID)
+	assert.Error(t, err)
+	assert.Equal(t, 2, deserializeFailures.AddCallCount())
+	assert.Equal(t, []string{"msp_id", ""}, deserializeFailures.WithArgsForCall(1))
+
+	sID, err = proto.Marshal(&msp.SerializedIdentity{Mspid: "SampleOrg", IdBytes: []byte("barf")})
+	require.NoError(t, err)
+	_, err = mspMgr.DeserializeIdentity(sID)
+	assert.Error(t, err)
+	assert.Equal(t, 3, deserializeFailures.AddCallCount())
+	assert.Equal(t, []string{"msp_id", "SampleOrg"}, deserializeFailures.WithArgsForCall(2))
+	assert.Equal(t, float64(1), deserializeFailures.AddArgsForCall(2))
+
+	id, err := localMsp.GetDefaultSigningIdentity()
+	require.NoError(t, err)
+	sID, err = id.Serialize()
+	require.NoError(t, err)
+	_, err = mspMgr.DeserializeIdentity(sID)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, deserializeFailures.AddCallCount())
+}
+
+func TestCRLRejectionsMetric(t *testing.T) {
+	m, _, crlRejections := newFakeMetrics()
+
+	// testdata/revocation has a CRL which revokes the signing certificate
+	thisMSP := getLocalMSP(t, "testdata/revocation")
+	thisMSP.(*bccspmsp).metrics = m
+	id, err := thisMSP.GetDefaultSigningIdentity()
+	require.NoError(t, err)
+	assert.Error(t, id.Validate())
+	assert.Equal(t, 1, crlRejections.AddCallCount())
+	assert.Equal(t, []string{"msp_id", "SampleOrg"}, crlRejections.WithArgsForCall(0))
+
+	// the signature over the CRL of testdata/revocation2 is invalid
+	thisMSP = getLocalMSP(t, "testdata/revocation2")
+	thisMSP.(*bccspmsp).metrics = m
+	id, err = thisMSP.GetDefaultSigningIdentity()
+	require.NoError(t, err)
+	assert.NoError(t, id.Validate())
+	assert.Equal(t, 1, crlRejections.AddCallCount())
+}
+
+func TestNewReportsMetrics(t *testing.T) {
+	m, _, _ := newFakeMetrics()
+	thisMSP, err := New(&BCCSPNewOpts{NewBaseOpts: NewBaseOpts{Version: MSPv1_0, Metrics: m}})
+	require.NoError(t, err)
+	assert.Equal(t, m, thisMSP.(*bccspmsp).metrics)
+
+	thisMSP, err = New(&BCCSPNewOpts{NewBaseOpts: NewBaseOpts{Version: MSPv1_0}})
+	require.NoError(t, err)
+	assert.NotNil(t, thisMSP.(*bccspmsp).metrics)
+}
@@ -0,0 +1,50 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package mgmt
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+
+	"github.com/golang/protobuf/proto"
+	mspproto "github.com/hyperledger/fabric/protos/msp"
+	"github.com/pkg/errors"
+)
+
+// CheckLocalAdmin returns an error unless the given certificate, such as the
+// TLS client certificate of an administrative request, is the certificate of
+// an admin of the local MSP. The certificate is validated as an identity of the
+// local MSP, so it must be issued by a CA of the MSP: certificates issued by a
+// separate TLS CA are rejected, even if the TLS CA is trusted by the MSP.
+func CheckLocalAdmin(cert *x509.Certificate) error {
+	localMSP := GetLocalMSP()
+	mspID, err := localMSP.GetIdentifier()
+	if err != nil {
+		return errors.WithMessage(err, "could not extract local msp identifier")
+	}
+
+	serializedIdentity, err := proto.Marshal(&mspproto.SerializedIdentity{
+		Mspid:   mspID,
+		IdBytes: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}),
+	})
+	if err != nil {
+		return errors.Wrap(err, "marshalling failed")
+	}
+	id, err := localMSP.DeserializeIdentity(serializedIdentity)
+	if err != nil {
+		return errors.WithMessage(err, "the certificate is not an identity of the local MSP")
+	}
+
+	principal, err := NewLocalMSPPrincipalGetter().Get(Admins)
+	if err != nil {
+		return err
+	}
+	if err := id.SatisfiesPrincipal(principal); err != nil {
+		return errors.WithMessage(err, "the certificate is not the certificate of an admin of the local MSP")
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package mgmt
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/common/crypto/tlsgen"
+	"github.com/hyperledger/fabric/core/config/configtest"
+	"github.com/hyperledger/fabric/msp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckLocalAdmin(t *testing.T) {
+	dir, err := configtest.GetDevMspDir()
+	require.NoError(t, err)
+	require.NoError(t, LoadLocalMsp(dir, nil, "SampleOrg"))
+
+	adminPEM, err := ioutil.ReadFile(filepath.Join(dir, "admincerts", "admincert.pem"))
+	require.NoError(t, err)
+	block, _ := pem.Decode(adminPEM)
+	require.NotNil(t, block)
+	admin, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	assert.NoError(t, CheckLocalAdmin(admin))
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "stranger"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	stranger, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	err = CheckLocalAdmin(stranger)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "certificate signed by unknown authority")
+}
+
+func TestCheckLocalAdminTLSCA(t *testing.T) {
+	dir, err := configtest.GetDevMspDir()
+	require.NoError(t, err)
+	require.NoError(t, LoadLocalMsp(dir, nil, "SampleOrg"))
+
+	// Admin certificates must be issued by the CA of the local MSP,
+	// a client certificate of a separate TLS CA is not an identity of the MSP
+	tlsCA, err := tlsgen.NewCA()
+	require.NoError(t, err)
+	clientKeyPair, err := tlsCA.NewClientCertKeyPair()
+	require.NoError(t, err)
+	err = CheckLocalAdmin(clientKeyPair.TLSCert)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "the certificate is not an identity of the local MSP")
+}
+
+func TestCheckLocalAdminByNodeOU(t *testing.T) {
+	// The local MSP has no admin certificates, its admins are classified by the admin OU
+	dir := filepath.Join("..", "testdata", "nodeous9")
+	conf, err := msp.GetLocalMspConfig(dir, nil, "SampleOrg")
+	require.NoError(t, err)
+	mspInst, err := newLocalIdentityMSP(dir, nil, msp.ProviderTypeToString(msp.FABRIC))
+	require.NoError(t, err)
+	require.NoError(t, mspInst.Setup(conf))
+
+	m.Lock()
+	previous := localMsp
+	localMsp = mspInst
+	m.Unlock()
+	defer func() {
+		m.Lock()
+		localMsp = previous
+		m.Unlock()
+	}()
+
+	readCert := func(name string) *x509.Certificate {
+		certPEM, err := ioutil.ReadFile(filepath.Join(dir, "users", name))
+		require.NoError(t, err)
+		block, _ := pem.Decode(certPEM)
+		require.NotNil(t, block)
+		cert, err := x509.ParseCertificate(block.Bytes)
+		require.NoError(t, err)
+		return cert
+	}
+
+	assert.NoError(t, CheckLocalAdmin(readCert("admin.pem")))
+
+	err = CheckLocalAdmin(readCert("client.pem"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "the certificate is not the certificate of an admin of the local MSP")
+}
@@ -76,7 +76,7 @@ func TestMain(m *testing.M) {
 		os.Exit(-1)
 	}
 
-	XXXSetMSPManager("foo", msp.NewMSPManager())
+	XXXSetMSPManager("foo", msp.NewMSPManager(nil))
 	retVal := m.Run()
 	os.Exit(retVal)
 }
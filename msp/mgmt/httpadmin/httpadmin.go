@@ -0,0 +1,127 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package httpadmin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/core/middleware"
+)
+
+// URL is the path under which the handler serves local MSP reload requests.
+const URL = "/localmsp/reload"
+
+// ChannelsURL is the path under which the handler serves channel MSP reload requests.
+const ChannelsURL = "/channelmsps/reload"
+
+//go:generate counterfeiter -o fakes/reloader.go -fake-name Reloader . Reloader
+
+// Reloader reloads the MSPs of the node.
+type Reloader interface {
+	// ReloadLocalMsp loads the local MSP again from its directory, and
+	// replaces the current one with it if it is valid
+	ReloadLocalMsp() error
+
+	// ReloadChannelMSPs sets up the MSPs of every channel again from
+	// the current channel configuration
+	ReloadChannelMSPs() error
+}
+
+// Reloaders adapts functions to Reloader.
+type Reloaders struct {
+	LocalMsp    func() error
+	ChannelMSPs func() error
+}
+
+// ReloadLocalMsp calls r.LocalMsp().
+func (r Reloaders) ReloadLocalMsp() error {
+	return r.LocalMsp()
+}
+
+// ReloadChannelMSPs calls r.ChannelMSPs().
+func (r Reloaders) ReloadChannelMSPs() error {
+	return r.ChannelMSPs()
+}
+
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+func NewHandler(r Reloader, checkAdmin middleware.AdminChecker) *Handler {
+	return &Handler{
+		Reloader:   r,
+		CheckAdmin: checkAdmin,
+		Logger:     flogging.MustGetLogger("msp.httpadmin"),
+	}
+}
+
+// Handler serves the MSP reload endpoints of the operations system.
+//
+// POST /localmsp/reload loads the local MSP again from its directory, so that
+// updated CRLs and CA certificates take effect. If the reloaded MSP is not
+// valid, the current one remains in use and the error is returned.
+//
+// POST /channelmsps/reload sets up the MSPs of every channel again from the
+// current channel configuration. Channels whose MSPs fail to reload keep their
+// current ones, and are listed in the error returned.
+//
+// Only clients whose TLS certificate passes CheckAdmin may reload MSPs.
+type Handler struct {
+	Reloader   Reloader
+	CheckAdmin middleware.AdminChecker
+	Logger     *flogging.FabricLogger
+}
+
+func (h *Handler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	middleware.RequireAdmin(h.CheckAdmin)(http.HandlerFunc(h.serveReload)).ServeHTTP(resp, req)
+}
+
+func (h *Handler) serveReload(resp http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		err := fmt.Errorf("invalid request method: %s", req.Method)
+		h.sendResponse(resp, http.StatusBadRequest, err)
+		return
+	}
+
+	var what string
+	var reload func() error
+	switch req.URL.Path {
+	case URL:
+		what, reload = "the local MSP", h.Reloader.ReloadLocalMsp
+	case ChannelsURL:
+		what, reload = "the channel MSPs", h.Reloader.ReloadChannelMSPs
+	default:
+		h.sendResponse(resp, http.StatusNotFound, fmt.Errorf("invalid path: %s", req.URL.Path))
+		return
+	}
+
+	h.Logger.Infof("Reloading %s", what)
+	if err := reload(); err != nil {
+		h.Logger.Errorf("Failed reloading %s: %s", what, err)
+		h.sendResponse(resp, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) sendResponse(resp http.ResponseWriter, code int, payload interface{}) {
+	encoder := json.NewEncoder(resp)
+	if err, ok := payload.(error); ok {
+		payload = &ErrorResponse{Error: err.Error()}
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(code)
+
+	if err := encoder.Encode(payload); err != nil {
+		h.Logger.Errorw("failed to encode payload", "error", err)
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package httpadmin_test
+
+import (
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/msp/mgmt/httpadmin"
+	"github.com/hyperledger/fabric/msp/mgmt/httpadmin/fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		fakeReloader *fakes.Reloader
+		handler      *httpadmin.Handler
+		adminCert    *x509.Certificate
+	)
+
+	BeforeEach(func() {
+		fakeReloader = &fakes.Reloader{}
+		adminCert = &x509.Certificate{Raw: []byte("admin")}
+		handler = &httpadmin.Handler{
+			Reloader: fakeReloader,
+			CheckAdmin: func(cert *x509.Certificate) error {
+				if cert != adminCert {
+					return errors.New("not an admin")
+				}
+				return nil
+			},
+			Logger: flogging.NewFabricLogger(flogging.NewZapLogger(nil)),
+		}
+	})
+
+	// newRequest returns a request authenticated with the certificate of an admin
+	newRequest := func(method, target string) *http.Request {
+		req := httptest.NewRequest(method, "https://localhost"+target, nil)
+		req.TLS.VerifiedChains = [][]*x509.Certificate{{adminCert}}
+		return req
+	}
+
+	It("reloads the local MSP", func() {
+		req := newRequest("POST", httpadmin.URL)
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+
+		Expect(resp.Code).To(Equal(http.StatusNoContent))
+		Expect(fakeReloader.ReloadLocalMspCallCount()).To(Equal(1))
+		Expect(fakeReloader.ReloadChannelMSPsCallCount()).To(Equal(0))
+	})
+
+	It("reloads the channel MSPs", func() {
+		req := newRequest("POST", httpadmin.ChannelsURL)
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+
+		Expect(resp.Code).To(Equal(http.StatusNoContent))
+		Expect(fakeReloader.ReloadChannelMSPsCallCount()).To(Equal(1))
+		Expect(fakeReloader.ReloadLocalMspCallCount()).To(Equal(0))
+	})
+
+	Context("when reloading the local MSP fails", func() {
+		BeforeEach(func() {
+			fakeReloader.ReloadLocalMspReturns(errors.New("the CRL is not signed by a CA"))
+		})
+
+		It("returns the error", func() {
+			req := newRequest("POST", httpadmin.URL)
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusInternalServerError))
+			Expect(resp.Header().Get("Content-Type")).To(Equal("application/json"))
+			Expect(resp.Body).To(MatchJSON(`{"error": "the CRL is not signed by a CA"}`))
+		})
+	})
+
+	Context("when reloading the channel MSPs fails", func() {
+		BeforeEach(func() {
+			fakeReloader.ReloadChannelMSPsReturns(errors.New("failed reloading the MSPs of channels mychannel"))
+		})
+
+		It("returns the error", func() {
+			req := newRequest("POST", httpadmin.ChannelsURL)
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusInternalServerError))
+			Expect(resp.Body).To(MatchJSON(`{"error": "failed reloading the MSPs of channels mychannel"}`))
+		})
+	})
+
+	Context("when the request method is not POST", func() {
+		It("responds with bad request", func() {
+			req := newRequest("GET", httpadmin.URL)
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+			Expect(resp.Body).To(MatchJSON(`{"error": "invalid request method: GET"}`))
+			Expect(fakeReloader.ReloadLocalMspCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the path is unknown", func() {
+		It("responds with not found", func() {
+			req := newRequest("POST", "/localmsp/reload/foo")
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusNotFound))
+			Expect(resp.Body).To(MatchJSON(`{"error": "invalid path: /localmsp/reload/foo"}`))
+			Expect(fakeReloader.ReloadLocalMspCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the client does not present a certificate", func() {
+		It("responds with unauthorized", func() {
+			req := httptest.NewRequest("POST", httpadmin.URL, nil)
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusUnauthorized))
+			Expect(fakeReloader.ReloadLocalMspCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the client is not an admin", func() {
+		It("responds with forbidden", func() {
+			req := newRequest("POST", httpadmin.ChannelsURL)
+			req.TLS.VerifiedChains = [][]*x509.Certificate{{{Raw: []byte("client")}}}
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusForbidden))
+			Expect(fakeReloader.ReloadChannelMSPsCallCount()).To(Equal(0))
+		})
+	})
+
+	It("adapts functions to reloaders", func() {
+		var localCalled, channelsCalled bool
+		handler.Reloader = httpadmin.Reloaders{
+			LocalMsp: func() error {
+				localCalled = true
+				return nil
+			},
+			ChannelMSPs: func() error {
+				channelsCalled = true
+				return nil
+			},
+		}
+
+		for _, target := range []string{httpadmin.URL, httpadmin.ChannelsURL} {
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, newRequest("POST", target))
+			Expect(resp.Code).To(Equal(http.StatusNoContent))
+		}
+		Expect(localCalled).To(BeTrue())
+		Expect(channelsCalled).To(BeTrue())
+	})
+})
@@ -0,0 +1,118 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package mgmt
+
+import (
+	"path/filepath"
+	"sort"
+
+	"github.com/hyperledger/fabric/bccsp/factory"
+	"github.com/hyperledger/fabric/bccsp/sw"
+	"github.com/hyperledger/fabric/msp"
+	"github.com/hyperledger/fabric/msp/cache"
+	"github.com/pkg/errors"
+)
+
+// localIdentities are the local MSPs loaded in addition to the local MSP,
+// by name. They are guarded by m
+var localIdentities = make(map[string]msp.MSP)
+
+// LoadLocalIdentity loads a local MSP with the specified type from the
+// specified directory in addition to the local MSP, and registers it under
+// the given name, so that the node can sign with several identities, e.g.
+// during the overlap of the rotation of its identity.
+//
+// When the BCCSP is the software one, the private key of the identity is
+// read from the keystore folder of the directory. Otherwise, it is looked up
+// in the BCCSP, as the key of the local MSP is.
+func LoadLocalIdentity(name, dir string, bccspConfig *factory.FactoryOpts, mspID, mspType string) error {
+	if name == "" {
+		return errors.New("the local identity must have a name")
+	}
+	if mspID == "" {
+		return errors.Errorf("the local identity %s must have an MSP ID", name)
+	}
+
+	conf, err := msp.GetLocalMspConfigWithType(dir, bccspConfig, mspID, mspType)
+	if err != nil {
+		return err
+	}
+
+	mspInst, err := newLocalIdentityMSP(dir, bccspConfig, mspType)
+	if err != nil {
+		return err
+	}
+	if err := mspInst.Setup(conf); err != nil {
+		return err
+	}
+	if _, err := mspInst.GetDefaultSigningIdentity(); err != nil {
+		return errors.WithMessage(err, "the local identity has no signing identity")
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	localIdentities[name] = mspInst
+	mspLogger.Infof("Loaded local identity %s of MSP %s from %s", name, mspID, dir)
+	return nil
+}
+
+func newLocalIdentityMSP(dir string, bccspConfig *factory.FactoryOpts, mspType string) (msp.MSP, error) {
+	if mspType != msp.ProviderTypeToString(msp.FABRIC) || (bccspConfig != nil && bccspConfig.ProviderName != "SW") {
+		return newLocalMSP(mspType)
+	}
+
+	ks, err := sw.NewFileBasedKeyStore(nil, filepath.Join(dir, "keystore"), true)
+	if err != nil {
+		return nil, errors.WithMessage(err, "could not open the keystore of the local identity")
+	}
+	mspInst, err := msp.NewBccspMspWithKeyStore(localMSPVersion, ks)
+	if err != nil {
+		return nil, err
+	}
+	return cache.New(mspInst)
+}
+
+// GetLocalIdentity returns the local MSP registered under the given name,
+// or the local MSP if the name is empty
+func GetLocalIdentity(name string) (msp.MSP, error) {
+	if name == "" {
+		return GetLocalMSP(), nil
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	mspInst, exists := localIdentities[name]
+	if !exists {
+		return nil, errors.Errorf("local identity %s is not loaded", name)
+	}
+	return mspInst, nil
+}
+
+// GetLocalSigningIdentity returns the default signing identity of the local
+// MSP registered under the given name, or of the local MSP if the name is
+// empty
+func GetLocalSigningIdentity(name string) (msp.SigningIdentity, error) {
+	mspInst, err := GetLocalIdentity(name)
+	if err != nil {
+		return nil, err
+	}
+	return mspInst.GetDefaultSigningIdentity()
+}
+
+// LocalIdentityNames returns the sorted names of the local MSPs loaded in
+// addition to the local MSP
+func LocalIdentityNames() []string {
+	m.Lock()
+	defer m.Unlock()
+
+	var names []string
+	for name := range localIdentities {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,65 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package mgmt
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/core/config/configtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadLocalIdentity(t *testing.T) {
+	dir, err := configtest.GetDevMspDir()
+	require.NoError(t, err)
+
+	err = LoadLocalIdentity("", dir, nil, "SampleOrg", "bccsp")
+	assert.EqualError(t, err, "the local identity must have a name")
+	err = LoadLocalIdentity("next", dir, nil, "", "bccsp")
+	assert.EqualError(t, err, "the local identity next must have an MSP ID")
+	err = LoadLocalIdentity("next", "/nonexistent", nil, "SampleOrg", "bccsp")
+	assert.Error(t, err)
+
+	_, err = GetLocalIdentity("next")
+	assert.EqualError(t, err, "local identity next is not loaded")
+	_, err = GetLocalSigningIdentity("next")
+	assert.EqualError(t, err, "local identity next is not loaded")
+
+	err = LoadLocalIdentity("next", dir, nil, "SampleOrg", "bccsp")
+	require.NoError(t, err)
+	defer func() {
+		m.Lock()
+		delete(localIdentities, "next")
+		m.Unlock()
+	}()
+	assert.Equal(t, []string{"next"}, LocalIdentityNames())
+
+	mspInst, err := GetLocalIdentity("next")
+	require.NoError(t, err)
+	mspID, err := mspInst.GetIdentifier()
+	require.NoError(t, err)
+	assert.Equal(t, "SampleOrg", mspID)
+
+	signer, err := GetLocalSigningIdentity("next")
+	require.NoError(t, err)
+	signature, err := signer.Sign([]byte("msg"))
+	require.NoError(t, err)
+	assert.NoError(t, signer.Verify([]byte("msg"), signature))
+
+	// The signatures are verifiable with the identity known to the local MSP
+	serialized, err := signer.Serialize()
+	require.NoError(t, err)
+	id, err := GetLocalMSP().DeserializeIdentity(serialized)
+	require.NoError(t, err)
+	assert.NoError(t, id.Verify([]byte("msg"), signature))
+
+	// The empty name refers to the local MSP
+	mspInst, err = GetLocalIdentity("")
+	require.NoError(t, err)
+	assert.Equal(t, GetLocalMSP(), mspInst)
+}
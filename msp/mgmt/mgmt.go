@@ -29,7 +29,12 @@ func LoadLocalMspWithType(dir string, bccspConfig *factory.FactoryOpts, mspID, m
 		return err
 	}
 
-	return GetLocalMSP().Setup(conf)
+	if err := GetLocalMSP().Setup(conf); err != nil {
+		return err
+	}
+
+	setLocalMspSource(dir, bccspConfig, mspID, mspType)
+	return nil
 }
 
 // LoadLocalMsp loads the local MSP from the specified directory
@@ -43,7 +48,24 @@ func LoadLocalMsp(dir string, bccspConfig *factory.FactoryOpts, mspID string) er
 		return err
 	}
 
-	return GetLocalMSP().Setup(conf)
+	if err := GetLocalMSP().Setup(conf); err != nil {
+		return err
+	}
+
+	setLocalMspSource(dir, bccspConfig, mspID, msp.ProviderTypeToString(msp.FABRIC))
+	return nil
+}
+
+func setLocalMspSource(dir string, bccspConfig *factory.FactoryOpts, mspID, mspType string) {
+	m.Lock()
+	defer m.Unlock()
+
+	localMspSrc = &localMspSource{
+		dir:         dir,
+		bccspConfig: bccspConfig,
+		mspID:       mspID,
+		mspType:     mspType,
+	}
 }
 
 // FIXME: AS SOON AS THE CHAIN MANAGEMENT CODE IS COMPLETE,
@@ -89,7 +111,7 @@ func GetManagerForChain(chainID string) msp.MSPManager {
 	mspMgr, ok := mspMap[chainID]
 	if !ok {
 		mspLogger.Debugf("Created new msp manager for channel `%s`", chainID)
-		mspMgmtMgr := &mspMgmtMgr{msp.NewMSPManager(), false}
+		mspMgmtMgr := &mspMgmtMgr{msp.NewMSPManager(nil), false}
 		mspMap[chainID] = mspMgmtMgr
 		mspMgr = mspMgmtMgr
 	} else {
@@ -150,8 +172,23 @@ func loadLocaMSP() msp.MSP {
 		mspType = msp.ProviderTypeToString(msp.FABRIC)
 	}
 
+	mspInst, err := newLocalMSP(mspType)
+	if err != nil {
+		mspLogger.Fatalf("Failed to initialize local MSP, received err %+v", err)
+	}
+
+	mspLogger.Debugf("Created new local MSP")
+
+	return &reloadableMSP{instance: mspInst}
+}
+
+// localMSPVersion is the version of the local BCCSP-based MSPs. It is the latest one,
+// so that the admins of the local MSP may be classified by their NodeOU.
+const localMSPVersion = msp.MSPv2_0
+
+func newLocalMSP(mspType string) (msp.MSP, error) {
 	var mspOpts = map[string]msp.NewOpts{
-		msp.ProviderTypeToString(msp.FABRIC): &msp.BCCSPNewOpts{NewBaseOpts: msp.NewBaseOpts{Version: msp.MSPv1_0}},
+		msp.ProviderTypeToString(msp.FABRIC): &msp.BCCSPNewOpts{NewBaseOpts: msp.NewBaseOpts{Version: localMSPVersion}},
 		msp.ProviderTypeToString(msp.IDEMIX): &msp.IdemixNewOpts{NewBaseOpts: msp.NewBaseOpts{Version: msp.MSPv1_1}},
 	}
 	newOpts, found := mspOpts[mspType]
@@ -161,23 +198,16 @@ func loadLocaMSP() msp.MSP {
 
 	mspInst, err := msp.New(newOpts)
 	if err != nil {
-		mspLogger.Fatalf("Failed to initialize local MSP, received err %+v", err)
+		return nil, err
 	}
 	switch mspType {
 	case msp.ProviderTypeToString(msp.FABRIC):
-		mspInst, err = cache.New(mspInst)
-		if err != nil {
-			mspLogger.Fatalf("Failed to initialize local MSP, received err %+v", err)
-		}
+		return cache.New(mspInst)
 	case msp.ProviderTypeToString(msp.IDEMIX):
-		// Do nothing
+		return mspInst, nil
 	default:
 		panic("msp type " + mspType + " unknown")
 	}
-
-	mspLogger.Debugf("Created new local MSP")
-
-	return mspInst
 }
 
 // GetIdentityDeserializer returns the IdentityDeserializer for the given chain
@@ -32,7 +32,7 @@ func TestGetManagerForChains(t *testing.T) {
 }
 
 func TestGetManagerForChains_usingMSPConfigHandlers(t *testing.T) {
-	XXXSetMSPManager("foo", msp.NewMSPManager())
+	XXXSetMSPManager("foo", msp.NewMSPManager(nil))
 	msp2 := GetManagerForChain("foo")
 	// return value should be set because the MSPManager was initialized
 	if msp2 == nil {
@@ -41,7 +41,7 @@ func TestGetManagerForChains_usingMSPConfigHandlers(t *testing.T) {
 }
 
 func TestGetIdentityDeserializer(t *testing.T) {
-	XXXSetMSPManager("baz", msp.NewMSPManager())
+	XXXSetMSPManager("baz", msp.NewMSPManager(nil))
 	ids := GetIdentityDeserializer("baz")
 	assert.NotNil(t, ids)
 	ids = GetIdentityDeserializer("")
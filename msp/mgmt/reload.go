@@ -0,0 +1,264 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package mgmt
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric/bccsp/factory"
+	"github.com/hyperledger/fabric/msp"
+	pmsp "github.com/hyperledger/fabric/protos/msp"
+	"github.com/pkg/errors"
+)
+
+// localMspSource records where the local MSP was loaded from,
+// so that it can be loaded again from the same place
+type localMspSource struct {
+	dir         string
+	bccspConfig *factory.FactoryOpts
+	mspID       string
+	mspType     string
+}
+
+// localMspSrc is guarded by m
+var localMspSrc *localMspSource
+
+// reloadLock serializes reloads of the local MSP
+var reloadLock sync.Mutex
+
+// reloadableMSP is the local MSP handed out by GetLocalMSP.
+// It delegates to an MSP instance that is replaced atomically
+// when the local MSP is reloaded, so that holders of the local
+// MSP pick up the new instance without having to fetch it again.
+type reloadableMSP struct {
+	lock     sync.RWMutex
+	instance msp.MSP
+}
+
+func (r *reloadableMSP) get() msp.MSP {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.instance
+}
+
+func (r *reloadableMSP) swap(instance msp.MSP) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.instance = instance
+}
+
+func (r *reloadableMSP) DeserializeIdentity(serializedIdentity []byte) (msp.Identity, error) {
+	return r.get().DeserializeIdentity(serializedIdentity)
+}
+
+func (r *reloadableMSP) IsWellFormed(identity *pmsp.SerializedIdentity) error {
+	return r.get().IsWellFormed(identity)
+}
+
+func (r *reloadableMSP) Setup(config *pmsp.MSPConfig) error {
+	return r.get().Setup(config)
+}
+
+func (r *reloadableMSP) GetVersion() msp.MSPVersion {
+	return r.get().GetVersion()
+}
+
+func (r *reloadableMSP) GetType() msp.ProviderType {
+	return r.get().GetType()
+}
+
+func (r *reloadableMSP) GetIdentifier() (string, error) {
+	return r.get().GetIdentifier()
+}
+
+func (r *reloadableMSP) GetSigningIdentity(identifier *msp.IdentityIdentifier) (msp.SigningIdentity, error) {
+	return r.get().GetSigningIdentity(identifier)
+}
+
+func (r *reloadableMSP) GetDefaultSigningIdentity() (msp.SigningIdentity, error) {
+	return r.get().GetDefaultSigningIdentity()
+}
+
+func (r *reloadableMSP) GetTLSRootCerts() [][]byte {
+	return r.get().GetTLSRootCerts()
+}
+
+func (r *reloadableMSP) GetTLSIntermediateCerts() [][]byte {
+	return r.get().GetTLSIntermediateCerts()
+}
+
+func (r *reloadableMSP) Validate(id msp.Identity) error {
+	return r.get().Validate(id)
+}
+
+func (r *reloadableMSP) SatisfiesPrincipal(id msp.Identity, principal *pmsp.MSPPrincipal) error {
+	return r.get().SatisfiesPrincipal(id, principal)
+}
+
+// ReloadLocalMsp loads the local MSP again from the directory it was
+// loaded from, so that updated CRLs and CA certificates take effect
+// without restarting the node. The new MSP instance is set up and
+// validated before it atomically replaces the current one; if any of
+// this fails, the current instance remains in use.
+//
+// Channel MSPs are built from the channel configuration, and are
+// replaced whenever a config update for the channel is committed.
+func ReloadLocalMsp() error {
+	reloadLock.Lock()
+	defer reloadLock.Unlock()
+
+	m.Lock()
+	src := localMspSrc
+	current, _ := localMsp.(*reloadableMSP)
+	m.Unlock()
+
+	if src == nil || current == nil {
+		return errors.New("the local MSP has not been loaded")
+	}
+
+	conf, err := msp.GetLocalMspConfigWithType(src.dir, src.bccspConfig, src.mspID, src.mspType)
+	if err != nil {
+		return errors.WithMessage(err, "failed loading the local MSP configuration")
+	}
+
+	newMsp, err := newLocalMSP(msp.ProviderTypeToString(current.GetType()))
+	if err != nil {
+		return errors.WithMessage(err, "failed creating the local MSP")
+	}
+
+	if err := newMsp.Setup(conf); err != nil {
+		return errors.WithMessage(err, "failed setting up the local MSP")
+	}
+
+	if err := validateReloadedMSP(current, newMsp); err != nil {
+		return err
+	}
+
+	current.swap(newMsp)
+	mspLogger.Infof("Reloaded local MSP from %s", src.dir)
+
+	return nil
+}
+
+// GetLocalMspConfig returns the configuration of the local MSP
+// as currently found in the directory it was loaded from.
+func GetLocalMspConfig() (*pmsp.MSPConfig, error) {
+	m.Lock()
+	src := localMspSrc
+	m.Unlock()
+
+	if src == nil {
+		return nil, errors.New("the local MSP has not been loaded")
+	}
+
+	return msp.GetLocalMspConfigWithType(src.dir, src.bccspConfig, src.mspID, src.mspType)
+}
+
+// validateReloadedMSP checks that the reloaded MSP can stand in for the current one:
+// it must have the same identifier, and the local signing identity must still be valid.
+func validateReloadedMSP(current, reloaded msp.MSP) error {
+	currentID, err := current.GetIdentifier()
+	if err != nil {
+		return errors.WithMessage(err, "failed getting the identifier of the local MSP")
+	}
+	reloadedID, err := reloaded.GetIdentifier()
+	if err != nil {
+		return errors.WithMessage(err, "failed getting the identifier of the reloaded local MSP")
+	}
+	if currentID != reloadedID {
+		return errors.Errorf("the reloaded local MSP has identifier %s, expected %s", reloadedID, currentID)
+	}
+
+	signer, err := reloaded.GetDefaultSigningIdentity()
+	if err != nil {
+		return errors.WithMessage(err, "the reloaded local MSP has no signing identity")
+	}
+	if err := signer.Validate(); err != nil {
+		return errors.WithMessage(err, "the local signing identity is not valid for the reloaded local MSP")
+	}
+
+	return nil
+}
+
+// WatchLocalMsp checks the directory the local MSP was loaded from every
+// interval, and reloads the local MSP whenever its contents change.
+// It returns once done is closed.
+func WatchLocalMsp(interval time.Duration, done <-chan struct{}) {
+	m.Lock()
+	src := localMspSrc
+	m.Unlock()
+
+	if src == nil {
+		mspLogger.Warning("Not watching the local MSP directory as the local MSP has not been loaded")
+		return
+	}
+
+	mspLogger.Infof("Watching %s for changes to the local MSP every %s", src.dir, interval)
+
+	lastFingerprint, err := dirFingerprint(src.dir)
+	if err != nil {
+		mspLogger.Warningf("Failed reading local MSP directory %s: %s", src.dir, err)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+
+		fingerprint, err := dirFingerprint(src.dir)
+		if err != nil {
+			mspLogger.Warningf("Failed reading local MSP directory %s: %s", src.dir, err)
+			continue
+		}
+		if fingerprint == lastFingerprint {
+			continue
+		}
+
+		mspLogger.Infof("Local MSP directory %s changed, reloading the local MSP", src.dir)
+		if err := ReloadLocalMsp(); err != nil {
+			mspLogger.Errorf("Failed reloading the local MSP, keeping the current one: %s", err)
+		}
+		// Either way, wait for the next change before trying again
+		lastFingerprint = fingerprint
+	}
+}
+
+// dirFingerprint summarizes the names, sizes and modification times
+// of the files in the given MSP directory, except for the keystore.
+func dirFingerprint(dir string) ([32]byte, error) {
+	h := sha256.New()
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if info.Name() == "keystore" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		fmt.Fprintf(h, "%s:%d:%d\n", path, info.Size(), info.ModTime().UnixNano())
+		return nil
+	})
+
+	var fingerprint [32]byte
+	if err != nil {
+		return fingerprint, err
+	}
+	copy(fingerprint[:], h.Sum(nil))
+	return fingerprint, nil
+}
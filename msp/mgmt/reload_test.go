@@ -0,0 +1,207 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package mgmt
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/core/config/configtest"
+	"github.com/hyperledger/fabric/msp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReloadLocalMsp(t *testing.T) {
+	mspDir := copyDevMspDir(t)
+	defer os.RemoveAll(mspDir)
+	defer restoreLocalMsp(t)
+
+	err := LoadLocalMsp(mspDir, nil, "SampleOrg")
+	require.NoError(t, err)
+
+	localMSP := GetLocalMSP()
+	before := localMSP.(*reloadableMSP).get()
+
+	err = ReloadLocalMsp()
+	assert.NoError(t, err)
+
+	after := localMSP.(*reloadableMSP).get()
+	assert.False(t, before == after, "the local MSP instance should have been replaced")
+	assert.True(t, GetLocalMSP() == localMSP, "GetLocalMSP should keep returning the same MSP")
+
+	id, err := localMSP.GetDefaultSigningIdentity()
+	assert.NoError(t, err)
+	assert.NoError(t, id.Validate())
+}
+
+func TestReloadLocalMspInvalid(t *testing.T) {
+	mspDir := copyDevMspDir(t)
+	defer os.RemoveAll(mspDir)
+	defer restoreLocalMsp(t)
+
+	err := LoadLocalMsp(mspDir, nil, "SampleOrg")
+	require.NoError(t, err)
+
+	localMSP := GetLocalMSP()
+	before := localMSP.(*reloadableMSP).get()
+
+	t.Run("BadCACert", func(t *testing.T) {
+		caCerts, err := ioutil.ReadDir(filepath.Join(mspDir, "cacerts"))
+		require.NoError(t, err)
+		require.NotEmpty(t, caCerts)
+		caCert := filepath.Join(mspDir, "cacerts", caCerts[0].Name())
+		original, err := ioutil.ReadFile(caCert)
+		require.NoError(t, err)
+		defer ioutil.WriteFile(caCert, original, 0644)
+
+		err = ioutil.WriteFile(caCert, []byte("not a certificate"), 0644)
+		require.NoError(t, err)
+
+		err = ReloadLocalMsp()
+		assert.Error(t, err)
+		assert.True(t, localMSP.(*reloadableMSP).get() == before, "the local MSP instance should not have been replaced")
+	})
+
+	t.Run("UntrustedSigner", func(t *testing.T) {
+		caCerts, err := ioutil.ReadDir(filepath.Join(mspDir, "cacerts"))
+		require.NoError(t, err)
+		caCert := filepath.Join(mspDir, "cacerts", caCerts[0].Name())
+		original, err := ioutil.ReadFile(caCert)
+		require.NoError(t, err)
+		defer ioutil.WriteFile(caCert, original, 0644)
+
+		otherCACert, err := ioutil.ReadFile(filepath.Join("..", "testdata", "revocation", "cacerts", "cacert.pem"))
+		require.NoError(t, err)
+		err = ioutil.WriteFile(caCert, otherCACert, 0644)
+		require.NoError(t, err)
+
+		err = ReloadLocalMsp()
+		assert.Error(t, err)
+		assert.True(t, localMSP.(*reloadableMSP).get() == before, "the local MSP instance should not have been replaced")
+	})
+
+	t.Run("NotLoaded", func(t *testing.T) {
+		m.Lock()
+		src := localMspSrc
+		localMspSrc = nil
+		m.Unlock()
+		defer func() {
+			m.Lock()
+			localMspSrc = src
+			m.Unlock()
+		}()
+
+		err := ReloadLocalMsp()
+		assert.EqualError(t, err, "the local MSP has not been loaded")
+	})
+}
+
+func TestGetLocalMspConfig(t *testing.T) {
+	mspDir := copyDevMspDir(t)
+	defer os.RemoveAll(mspDir)
+	defer restoreLocalMsp(t)
+
+	err := LoadLocalMsp(mspDir, nil, "SampleOrg")
+	require.NoError(t, err)
+
+	conf, err := GetLocalMspConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(msp.FABRIC), conf.Type)
+
+	m.Lock()
+	localMspSrc = nil
+	m.Unlock()
+
+	_, err = GetLocalMspConfig()
+	assert.EqualError(t, err, "the local MSP has not been loaded")
+}
+
+func TestWatchLocalMsp(t *testing.T) {
+	mspDir := copyDevMspDir(t)
+	defer os.RemoveAll(mspDir)
+	defer restoreLocalMsp(t)
+
+	err := LoadLocalMsp(mspDir, nil, "SampleOrg")
+	require.NoError(t, err)
+
+	localMSP := GetLocalMSP().(*reloadableMSP)
+	before := localMSP.get()
+
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		WatchLocalMsp(10*time.Millisecond, done)
+		close(stopped)
+	}()
+
+	// Nothing changed, so the local MSP must not be reloaded
+	time.Sleep(50 * time.Millisecond)
+	assert.True(t, localMSP.get() == before, "the local MSP instance should not have been replaced")
+
+	caCerts, err := ioutil.ReadDir(filepath.Join(mspDir, "cacerts"))
+	require.NoError(t, err)
+	modTime := time.Now().Add(time.Minute)
+	err = os.Chtimes(filepath.Join(mspDir, "cacerts", caCerts[0].Name()), modTime, modTime)
+	require.NoError(t, err)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for localMSP.get() == before && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.False(t, localMSP.get() == before, "the local MSP instance should have been replaced")
+
+	close(done)
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("WatchLocalMsp should have returned")
+	}
+}
+
+func copyDevMspDir(t *testing.T) string {
+	devMspDir, err := configtest.GetDevMspDir()
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "reload-msp")
+	require.NoError(t, err)
+
+	err = filepath.Walk(devMspDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(devMspDir, path)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return os.MkdirAll(filepath.Join(dir, relPath), 0755)
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(filepath.Join(dir, relPath), content, 0644)
+	})
+	require.NoError(t, err)
+
+	return dir
+}
+
+func restoreLocalMsp(t *testing.T) {
+	devMspDir, err := configtest.GetDevMspDir()
+	require.NoError(t, err)
+
+	conf, err := msp.GetLocalMspConfig(devMspDir, nil, "SampleOrg")
+	require.NoError(t, err)
+	err = GetLocalMSP().Setup(conf)
+	require.NoError(t, err)
+	setLocalMspSource(devMspDir, nil, "SampleOrg", msp.ProviderTypeToString(msp.FABRIC))
+}
@@ -155,7 +155,7 @@ func TestMSPSetupBad(t *testing.T) {
 		return
 	}
 
-	mgr := NewMSPManager()
+	mgr := NewMSPManager(nil)
 	err = mgr.Setup(nil)
 	assert.NoError(t, err)
 	err = mgr.Setup([]MSP{})
@@ -285,7 +285,7 @@ func TestSerializeIdentities(t *testing.T) {
 }
 
 func TestIsWellFormed(t *testing.T) {
-	mspMgr := NewMSPManager()
+	mspMgr := NewMSPManager(nil)
 
 	id, err := localMsp.GetDefaultSigningIdentity()
 	if err != nil {
@@ -1151,7 +1151,7 @@ func TestMain(m *testing.M) {
 		os.Exit(-1)
 	}
 
-	mspMgr = NewMSPManager()
+	mspMgr = NewMSPManager(nil)
 	err = mspMgr.Setup([]MSP{localMsp})
 	if err != nil {
 		fmt.Printf("Setup for msp manager should have succeeded, got err %s instead", err)
@@ -17,6 +17,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/bccsp"
 	"github.com/hyperledger/fabric/bccsp/factory"
+	"github.com/hyperledger/fabric/bccsp/gm"
 	"github.com/hyperledger/fabric/bccsp/signer"
 	"github.com/hyperledger/fabric/bccsp/sw"
 	m "github.com/hyperledger/fabric/protos/msp"
@@ -90,11 +91,22 @@ type bccspmsp struct {
 	// cryptoConfig contains
 	cryptoConfig *m.FabricCryptoConfig
 
+	// certValidationPolicy contains the additional constraints
+	// on the certificate chains of identities, if any
+	certValidationPolicy *m.FabricCertValidationPolicy
+	// requiredKeyUsage is the key usage identities' certificates must have
+	requiredKeyUsage x509.KeyUsage
+	// allowedSignatureAlgorithms is the set of signature algorithms that may
+	// be used to sign the certificates of identities' chains; nil means any
+	allowedSignatureAlgorithms map[x509.SignatureAlgorithm]bool
+
 	// NodeOUs configuration
 	ouEnforcement bool
-	// These are the OUIdentifiers of the clients, peers and orderers.
+	// These are the OUIdentifiers of the clients, peers, admins and orderers.
 	// They are used to tell apart these entities
-	clientOU, peerOU *OUIdentifier
+	clientOU, peerOU, adminOU, ordererOU *OUIdentifier
+
+	metrics *Metrics
 }
 
 // newBccspMsp returns an MSP instance backed up by a BCCSP
@@ -108,6 +120,7 @@ func newBccspMsp(version MSPVersion) (MSP, error) {
 	theMsp := &bccspmsp{}
 	theMsp.version = version
 	theMsp.bccsp = bccsp
+	theMsp.metrics = metricsOrDisabled(nil)
 	switch version {
 	case MSPv1_0:
 		theMsp.internalSetupFunc = theMsp.setupV1
@@ -121,6 +134,10 @@ func newBccspMsp(version MSPVersion) (MSP, error) {
 		theMsp.internalSetupFunc = theMsp.setupV11
 		theMsp.internalValidateIdentityOusFunc = theMsp.validateIdentityOUsV11
 		theMsp.internalSatisfiesPrincipalInternalFunc = theMsp.satisfiesPrincipalInternalV13
+	case MSPv2_0:
+		theMsp.internalSetupFunc = theMsp.setupV2
+		theMsp.internalValidateIdentityOusFunc = theMsp.validateIdentityOUsV11
+		theMsp.internalSatisfiesPrincipalInternalFunc = theMsp.satisfiesPrincipalInternalV2
 	default:
 		return nil, errors.Errorf("Invalid MSP version [%v]", version)
 	}
@@ -161,7 +178,7 @@ func (msp *bccspmsp) getCertFromPem(idBytes []byte) (*x509.Certificate, error) {
 
 	// get a cert
 	var cert *x509.Certificate
-	cert, err := x509.ParseCertificate(pemCert.Bytes)
+	cert, err := msp.parseCertificate(pemCert.Bytes)
 	if err != nil {
 		return nil, errors.Wrap(err, "getCertFromPem error: failed to parse x509 cert")
 	}
@@ -211,9 +228,15 @@ func (msp *bccspmsp) getSigningIdentityFromConf(sidInfo *m.SigningIdentityInfo)
 		if pemKey == nil {
 			return nil, errors.Errorf("%s: wrong PEM encoding", sidInfo.PrivateSigner.KeyIdentifier)
 		}
-		privKey, err = msp.bccsp.KeyImport(pemKey.Bytes, &bccsp.ECDSAPrivateKeyImportOpts{Temporary: true})
+		var importOpts bccsp.KeyImportOpts = &bccsp.ECDSAPrivateKeyImportOpts{Temporary: true}
+		if idPub.(*identity).cert.PublicKeyAlgorithm == x509.Ed25519 {
+			importOpts = &bccsp.ED25519PrivateKeyImportOpts{Temporary: true}
+		} else if _, ok := idPub.(*identity).cert.PublicKey.(*gm.PublicKey); ok {
+			importOpts = &bccsp.SM2PrivateKeyImportOpts{Temporary: true}
+		}
+		privKey, err = msp.bccsp.KeyImport(pemKey.Bytes, importOpts)
 		if err != nil {
-			return nil, errors.WithMessage(err, "getIdentityFromBytes error: Failed to import EC private key")
+			return nil, errors.WithMessage(err, "getIdentityFromBytes error: Failed to import private key")
 		}
 	}
 
@@ -336,18 +359,25 @@ func (msp *bccspmsp) hasOURole(id Identity, mspRole m.MSPRole_MSPRoleType) error
 }
 
 func (msp *bccspmsp) hasOURoleInternal(id *identity, mspRole m.MSPRole_MSPRoleType) error {
-	var nodeOUValue string
+	var nodeOU *OUIdentifier
 	switch mspRole {
 	case m.MSPRole_CLIENT:
-		nodeOUValue = msp.clientOU.OrganizationalUnitIdentifier
+		nodeOU = msp.clientOU
 	case m.MSPRole_PEER:
-		nodeOUValue = msp.peerOU.OrganizationalUnitIdentifier
+		nodeOU = msp.peerOU
+	case m.MSPRole_ADMIN:
+		nodeOU = msp.adminOU
+	case m.MSPRole_ORDERER:
+		nodeOU = msp.ordererOU
 	default:
-		return fmt.Errorf("Invalid MSPRoleType. It must be CLIENT, PEER or ORDERER")
+		return fmt.Errorf("Invalid MSPRoleType. It must be CLIENT, PEER, ADMIN or ORDERER")
+	}
+	if nodeOU == nil {
+		return fmt.Errorf("No OU is defined for role [%s], MSP: [%s]", mspRole, msp.name)
 	}
 
 	for _, OU := range id.GetOrganizationalUnits() {
-		if OU.OrganizationalUnitIdentifier == nodeOUValue {
+		if OU.OrganizationalUnitIdentifier == nodeOU.OrganizationalUnitIdentifier {
 			return nil
 		}
 	}
@@ -381,7 +411,7 @@ func (msp *bccspmsp) deserializeIdentityInternal(serializedIdentity []byte) (Ide
 	if bl == nil {
 		return nil, errors.New("could not decode the PEM structure")
 	}
-	cert, err := x509.ParseCertificate(bl.Bytes)
+	cert, err := msp.parseCertificate(bl.Bytes)
 	if err != nil {
 		return nil, errors.Wrap(err, "parseCertificate failed")
 	}
@@ -585,6 +615,49 @@ func (msp *bccspmsp) satisfiesPrincipalInternalV13(id Identity, principal *m.MSP
 	}
 }
 
+// satisfiesPrincipalInternalV2 takes as arguments the identity and the principal.
+// The function returns an error if one occurred.
+// The function implements the additional behavior expected of an MSP starting from v2.0:
+// when NodeOUs are enabled, an identity carrying the admin OU satisfies the ADMIN role,
+// and an identity carrying the orderer OU satisfies the ORDERER role.
+// For pre-v2.0 functionality, the function calls the satisfiesPrincipalInternalV13.
+func (msp *bccspmsp) satisfiesPrincipalInternalV2(id Identity, principal *m.MSPPrincipal) error {
+	if principal.PrincipalClassification != m.MSPPrincipal_ROLE {
+		return msp.satisfiesPrincipalInternalV13(id, principal)
+	}
+
+	mspRole := &m.MSPRole{}
+	err := proto.Unmarshal(principal.Principal, mspRole)
+	if err != nil {
+		return errors.Wrap(err, "could not unmarshal MSPRole from principal")
+	}
+
+	switch mspRole.Role {
+	case m.MSPRole_ADMIN:
+		// Identities listed as admins are admins regardless of their OUs
+		if !msp.ouEnforcement || msp.adminOU == nil || msp.hasOURole(id, m.MSPRole_ADMIN) != nil {
+			return msp.satisfiesPrincipalInternalV13(id, principal)
+		}
+		fallthrough
+	case m.MSPRole_ORDERER:
+		if mspRole.MspIdentifier != msp.name {
+			return errors.Errorf("the identity is a member of a different MSP (expected %s, got %s)", mspRole.MspIdentifier, id.GetMSPIdentifier())
+		}
+
+		mspLogger.Debugf("Checking if identity satisfies role [%s] for %s", m.MSPRole_MSPRoleType_name[int32(mspRole.Role)], msp.name)
+		if err := msp.Validate(id); err != nil {
+			return errors.Wrapf(err, "The identity is not valid under this MSP [%s]", msp.name)
+		}
+
+		if err := msp.hasOURole(id, mspRole.Role); err != nil {
+			return errors.Wrapf(err, "The identity is not a [%s] under this MSP [%s]", m.MSPRole_MSPRoleType_name[int32(mspRole.Role)], msp.name)
+		}
+		return nil
+	default:
+		return msp.satisfiesPrincipalInternalV13(id, principal)
+	}
+}
+
 // getCertificationChain returns the certification chain of the passed identity within this msp
 func (msp *bccspmsp) getCertificationChain(id Identity) ([]*x509.Certificate, error) {
 	mspLogger.Debugf("MSP %s getting certification chain", msp.name)
@@ -625,7 +698,13 @@ func (msp *bccspmsp) getUniqueValidationChain(cert *x509.Certificate, opts x509.
 	if msp.opts == nil {
 		return nil, errors.New("the supplied identity has no verify options")
 	}
-	validationChains, err := cert.Verify(opts)
+	var validationChains [][]*x509.Certificate
+	var err error
+	if gm.IsSM2SignedCert(cert) {
+		validationChains, err = msp.getSM2ValidationChains(cert, opts)
+	} else {
+		validationChains, err = cert.Verify(opts)
+	}
 	if err != nil {
 		return nil, errors.WithMessage(err, "the supplied identity is not valid")
 	}
@@ -740,6 +819,6 @@ func (msp *bccspmsp) IsWellFormed(identity *m.SerializedIdentity) error {
 	if bl.Type != "CERTIFICATE" && bl.Type != "" {
 		return errors.Errorf("pem type is %s, should be 'CERTIFICATE' or missing", bl.Type)
 	}
-	_, err := x509.ParseCertificate(bl.Bytes)
+	_, err := msp.parseCertificate(bl.Bytes)
 	return err
 }
@@ -100,6 +100,65 @@ func (msp *bccspmsp) setupCrypto(conf *m.FabricMSPConfig) error {
 	return nil
 }
 
+// keyUsages maps the names of key usages, as used in
+// certificate validation policies, to their x509 value
+var keyUsages = map[string]x509.KeyUsage{
+	"digitalSignature":  x509.KeyUsageDigitalSignature,
+	"contentCommitment": x509.KeyUsageContentCommitment,
+	"keyEncipherment":   x509.KeyUsageKeyEncipherment,
+	"dataEncipherment":  x509.KeyUsageDataEncipherment,
+	"keyAgreement":      x509.KeyUsageKeyAgreement,
+	"keyCertSign":       x509.KeyUsageCertSign,
+	"cRLSign":           x509.KeyUsageCRLSign,
+	"encipherOnly":      x509.KeyUsageEncipherOnly,
+	"decipherOnly":      x509.KeyUsageDecipherOnly,
+}
+
+// signatureAlgorithms are the signature algorithms that may be
+// allowed by certificate validation policies, by their name
+var signatureAlgorithms = map[string]x509.SignatureAlgorithm{}
+
+func init() {
+	for _, alg := range []x509.SignatureAlgorithm{
+		x509.SHA1WithRSA, x509.SHA256WithRSA, x509.SHA384WithRSA, x509.SHA512WithRSA,
+		x509.SHA256WithRSAPSS, x509.SHA384WithRSAPSS, x509.SHA512WithRSAPSS,
+		x509.ECDSAWithSHA1, x509.ECDSAWithSHA256, x509.ECDSAWithSHA384, x509.ECDSAWithSHA512,
+		x509.PureEd25519,
+	} {
+		signatureAlgorithms[alg.String()] = alg
+	}
+}
+
+func (msp *bccspmsp) setupCertValidationPolicy(conf *m.FabricMSPConfig) error {
+	msp.certValidationPolicy = conf.CertValidationPolicy
+	msp.requiredKeyUsage = 0
+	msp.allowedSignatureAlgorithms = nil
+	if msp.certValidationPolicy == nil {
+		return nil
+	}
+
+	for _, name := range msp.certValidationPolicy.RequiredKeyUsages {
+		usage, ok := keyUsages[name]
+		if !ok {
+			return errors.Errorf("invalid certificate validation policy: unknown key usage [%s]", name)
+		}
+		msp.requiredKeyUsage |= usage
+	}
+
+	for _, name := range msp.certValidationPolicy.AllowedSignatureAlgorithms {
+		alg, ok := signatureAlgorithms[name]
+		if !ok {
+			return errors.Errorf("invalid certificate validation policy: unknown signature algorithm [%s]", name)
+		}
+		if msp.allowedSignatureAlgorithms == nil {
+			msp.allowedSignatureAlgorithms = map[x509.SignatureAlgorithm]bool{}
+		}
+		msp.allowedSignatureAlgorithms[alg] = true
+	}
+
+	return nil
+}
+
 func (msp *bccspmsp) setupCAs(conf *m.FabricMSPConfig) error {
 	// make and fill the set of CA certs - we expect them to be there
 	if len(conf.RootCerts) == 0 {
@@ -262,6 +321,50 @@ func (msp *bccspmsp) setupNodeOUs(config *m.FabricMSPConfig) error {
 	return nil
 }
 
+func (msp *bccspmsp) setupNodeOUsV2(config *m.FabricMSPConfig) error {
+	if config.FabricNodeOus == nil {
+		msp.ouEnforcement = false
+		return nil
+	}
+
+	msp.ouEnforcement = config.FabricNodeOus.Enable
+
+	var err error
+	if msp.clientOU, err = msp.getNodeOUIdentifier(config.FabricNodeOus.ClientOuIdentifier); err != nil {
+		return err
+	}
+	if msp.peerOU, err = msp.getNodeOUIdentifier(config.FabricNodeOus.PeerOuIdentifier); err != nil {
+		return err
+	}
+	if msp.adminOU, err = msp.getNodeOUIdentifier(config.FabricNodeOus.AdminOuIdentifier); err != nil {
+		return err
+	}
+	if msp.ordererOU, err = msp.getNodeOUIdentifier(config.FabricNodeOus.OrdererOuIdentifier); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// getNodeOUIdentifier returns the OUIdentifier for the given
+// NodeOU configuration, or nil if the NodeOU is not defined
+func (msp *bccspmsp) getNodeOUIdentifier(conf *m.FabricOUIdentifier) (*OUIdentifier, error) {
+	if conf == nil || conf.OrganizationalUnitIdentifier == "" {
+		return nil, nil
+	}
+
+	ou := &OUIdentifier{OrganizationalUnitIdentifier: conf.OrganizationalUnitIdentifier}
+	if len(conf.Certificate) != 0 {
+		certifiersIdentifier, err := msp.getCertifiersIdentifier(conf.Certificate)
+		if err != nil {
+			return nil, err
+		}
+		ou.CertifiersIdentifier = certifiersIdentifier
+	}
+
+	return ou, nil
+}
+
 func (msp *bccspmsp) setupSigningIdentity(conf *m.FabricMSPConfig) error {
 	if conf.SigningIdentity != nil {
 		sid, err := msp.getSigningIdentityFromConf(conf.SigningIdentity)
@@ -389,6 +492,11 @@ func (msp *bccspmsp) preSetupV1(conf *m.FabricMSPConfig) error {
 		return err
 	}
 
+	// setup the certificate validation policy
+	if err := msp.setupCertValidationPolicy(conf); err != nil {
+		return err
+	}
+
 	// Setup CAs
 	if err := msp.setupCAs(conf); err != nil {
 		return err
@@ -484,3 +592,49 @@ func (msp *bccspmsp) postSetupV11(conf *m.FabricMSPConfig) error {
 
 	return nil
 }
+
+func (msp *bccspmsp) setupV2(conf *m.FabricMSPConfig) error {
+	err := msp.preSetupV1(conf)
+	if err != nil {
+		return err
+	}
+
+	// setup NodeOUs, including the admin and orderer ones
+	if err := msp.setupNodeOUsV2(conf); err != nil {
+		return err
+	}
+
+	err = msp.postSetupV2(conf)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (msp *bccspmsp) postSetupV2(conf *m.FabricMSPConfig) error {
+	// Check for OU enforcement
+	if !msp.ouEnforcement {
+		// No enforcement required. Call post setup as per V1
+		return msp.postSetupV1(conf)
+	}
+
+	if len(msp.admins) == 0 && msp.adminOU == nil {
+		return errors.New("administrators must be declared either as admin certificates or through the admin OU")
+	}
+
+	// Check that admins are valid clients or admins
+	for i, admin := range msp.admins {
+		if err := admin.Validate(); err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("admin %d is invalid", i))
+		}
+
+		clientErr := msp.hasOURole(admin, m.MSPRole_CLIENT)
+		adminErr := msp.hasOURole(admin, m.MSPRole_ADMIN)
+		if clientErr != nil && adminErr != nil {
+			return errors.Errorf("admin %d is invalid: it is neither a client [%s] nor an admin [%s]", i, clientErr, adminErr)
+		}
+	}
+
+	return nil
+}
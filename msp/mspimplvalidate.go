@@ -15,6 +15,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/hyperledger/fabric/bccsp/gm"
 	"github.com/pkg/errors"
 )
 
@@ -29,6 +30,11 @@ func (msp *bccspmsp) validateIdentity(id *identity) error {
 		return errors.WithMessage(err, "could not validate identity against certification chain")
 	}
 
+	err = msp.validateChainAgainstPolicy(validationChain)
+	if err != nil {
+		return errors.WithMessage(err, "could not validate identity against certificate validation policy")
+	}
+
 	err = msp.internalValidateIdentityOusFunc(id)
 	if err != nil {
 		return errors.WithMessage(err, "could not validate identity's OUs")
@@ -58,6 +64,9 @@ func (msp *bccspmsp) validateTLSCAIdentity(cert *x509.Certificate, opts *x509.Ve
 	if !cert.IsCA {
 		return errors.New("Only CA identities can be validated")
 	}
+	if gm.IsSM2SignedCert(cert) {
+		return errors.New("TLS CA certificates signed with SM2 are not supported")
+	}
 
 	validationChain, err := msp.getUniqueValidationChain(cert, *opts)
 	if err != nil {
@@ -102,7 +111,7 @@ func (msp *bccspmsp) validateCertAgainstChain(cert *x509.Certificate, validation
 					// certificate that is under validation. As a
 					// precaution, we verify that said CA is also the
 					// signer of this CRL.
-					err = validationChain[1].CheckCRLSignature(crl)
+					err = checkCRLSignature(validationChain[1], crl)
 					if err != nil {
 						// the CA cert that signed the certificate
 						// that is under validation did not sign the
@@ -117,6 +126,7 @@ func (msp *bccspmsp) validateCertAgainstChain(cert *x509.Certificate, validation
 					// revocation applies instantaneously from the time
 					// the MSP config is committed and used so we will not
 					// make use of that field
+					msp.metrics.CRLRejections.With("msp_id", msp.name).Add(1)
 					return errors.New("The certificate has been revoked")
 				}
 			}
@@ -126,6 +136,36 @@ func (msp *bccspmsp) validateCertAgainstChain(cert *x509.Certificate, validation
 	return nil
 }
 
+// validateChainAgainstPolicy checks the given certification chain, which starts
+// with the certificate of an identity and ends with a root certificate, against
+// the certificate validation policy of this MSP, if any.
+func (msp *bccspmsp) validateChainAgainstPolicy(validationChain []*x509.Certificate) error {
+	if msp.certValidationPolicy == nil {
+		return nil
+	}
+
+	maxDepth := msp.certValidationPolicy.MaxIntermediateDepth
+	if depth := len(validationChain) - 2; maxDepth > 0 && depth > int(maxDepth) {
+		return errors.Errorf("the certification chain has %d intermediate certificates, at most %d are allowed", depth, maxDepth)
+	}
+
+	cert := validationChain[0]
+	if cert.KeyUsage&msp.requiredKeyUsage != msp.requiredKeyUsage {
+		return errors.Errorf("the certificate is missing required key usages %v", msp.certValidationPolicy.RequiredKeyUsages)
+	}
+
+	if msp.allowedSignatureAlgorithms != nil {
+		// The root certificate is trusted as is, whatever it is signed with
+		for _, c := range validationChain[:len(validationChain)-1] {
+			if !msp.allowedSignatureAlgorithms[c.SignatureAlgorithm] {
+				return errors.Errorf("certificate [%s] is signed with %s, which is not allowed", c.Subject, c.SignatureAlgorithm)
+			}
+		}
+	}
+
+	return nil
+}
+
 func (msp *bccspmsp) validateIdentityOUsV1(id *identity) error {
 	// Check that the identity's OUs are compatible with those recognized by this MSP,
 	// meaning that the intersection is not empty.
@@ -172,17 +212,12 @@ func (msp *bccspmsp) validateIdentityOUsV11(id *identity) error {
 	}
 
 	// Make sure that the identity has only one of the special OUs
-	// used to tell apart clients, peers and orderers.
+	// used to tell apart clients, peers, admins and orderers.
 	counter := 0
 	for _, OU := range id.GetOrganizationalUnits() {
 		// Is OU.OrganizationalUnitIdentifier one of the special OUs?
-		var nodeOU *OUIdentifier
-		switch OU.OrganizationalUnitIdentifier {
-		case msp.clientOU.OrganizationalUnitIdentifier:
-			nodeOU = msp.clientOU
-		case msp.peerOU.OrganizationalUnitIdentifier:
-			nodeOU = msp.peerOU
-		default:
+		nodeOU := msp.getNodeOU(OU.OrganizationalUnitIdentifier)
+		if nodeOU == nil {
 			continue
 		}
 
@@ -197,12 +232,23 @@ func (msp *bccspmsp) validateIdentityOUsV11(id *identity) error {
 		}
 	}
 	if counter != 1 {
-		return errors.Errorf("the identity must be a client, a peer or an orderer identity to be valid, not a combination of them. OUs: [%v], MSP: [%s]", id.GetOrganizationalUnits(), msp.name)
+		return errors.Errorf("the identity must be a client, a peer, an admin or an orderer identity to be valid, not a combination of them. OUs: [%v], MSP: [%s]", id.GetOrganizationalUnits(), msp.name)
 	}
 
 	return nil
 }
 
+// getNodeOU returns the OUIdentifier of the clients, peers, admins or
+// orderers with the given OU, or nil if there is none
+func (msp *bccspmsp) getNodeOU(ou string) *OUIdentifier {
+	for _, nodeOU := range []*OUIdentifier{msp.clientOU, msp.peerOU, msp.adminOU, msp.ordererOU} {
+		if nodeOU != nil && nodeOU.OrganizationalUnitIdentifier == ou {
+			return nodeOU
+		}
+	}
+	return nil
+}
+
 func (msp *bccspmsp) getValidityOptsForCert(cert *x509.Certificate) x509.VerifyOptions {
 	// First copy the opts to override the CurrentTime field
 	// in order to make the certificate passing the expiration test
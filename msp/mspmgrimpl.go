@@ -34,13 +34,16 @@ type mspManagerImpl struct {
 
 	// error that might have occurred at startup
 	up bool
+
+	metrics *Metrics
 }
 
-// NewMSPManager returns a new MSP manager instance;
+// NewMSPManager returns a new MSP manager instance, which reports
+// the given metrics, or none if nil;
 // note that this instance is not initialized until
 // the Setup method is called
-func NewMSPManager() MSPManager {
-	return &mspManagerImpl{}
+func NewMSPManager(metrics *Metrics) MSPManager {
+	return &mspManagerImpl{metrics: metricsOrDisabled(metrics)}
 }
 
 // Setup initializes the internal data structures of this manager and creates MSPs
@@ -87,23 +90,31 @@ func (mgr *mspManagerImpl) DeserializeIdentity(serializedID []byte) (Identity, e
 	sId := &msp.SerializedIdentity{}
 	err := proto.Unmarshal(serializedID, sId)
 	if err != nil {
+		mgr.metrics.DeserializeIdentityFailures.With("msp_id", "").Add(1)
 		return nil, errors.Wrap(err, "could not deserialize a SerializedIdentity")
 	}
 
 	// we can now attempt to obtain the MSP
 	msp := mgr.mspsMap[sId.Mspid]
 	if msp == nil {
+		// the MSP ID is not used as a label, as it is not one of ours
+		mgr.metrics.DeserializeIdentityFailures.With("msp_id", "").Add(1)
 		return nil, errors.Errorf("MSP %s is unknown", sId.Mspid)
 	}
 
+	var id Identity
 	switch t := msp.(type) {
 	case *bccspmsp:
-		return t.deserializeIdentityInternal(sId.IdBytes)
+		id, err = t.deserializeIdentityInternal(sId.IdBytes)
 	case *idemixmsp:
-		return t.deserializeIdentityInternal(sId.IdBytes)
+		id, err = t.deserializeIdentityInternal(sId.IdBytes)
 	default:
-		return t.DeserializeIdentity(serializedID)
+		id, err = t.DeserializeIdentity(serializedID)
+	}
+	if err != nil {
+		mgr.metrics.DeserializeIdentityFailures.With("msp_id", sId.Mspid).Add(1)
 	}
+	return id, err
 }
 
 func (mgr *mspManagerImpl) IsWellFormed(identity *msp.SerializedIdentity) error {
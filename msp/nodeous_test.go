@@ -17,6 +17,8 @@ limitations under the License.
 package msp
 
 import (
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
@@ -237,3 +239,115 @@ func TestSatisfiesPrincipalClient(t *testing.T) {
 		assert.Contains(t, err.Error(), "The identity is not a [PEER] under this MSP [SampleOrg]")
 	}))
 }
+
+func TestAdminAndOrdererNodeOUs(t *testing.T) {
+	// testdata/nodeous9:
+	// the configuration enables NodeOUs for clients, peers, admins and orderers,
+	// and there are no admin certificates: admins are recognized by their OU
+	thisMSP := getLocalMSPWithVersion(t, "testdata/nodeous9", MSPv2_0)
+	assert.True(t, thisMSP.(*bccspmsp).ouEnforcement)
+	assert.Empty(t, thisMSP.(*bccspmsp).admins)
+
+	mspID, err := thisMSP.GetIdentifier()
+	assert.NoError(t, err)
+	rolePrincipal := func(role msp.MSPRole_MSPRoleType) *msp.MSPPrincipal {
+		principalBytes, err := proto.Marshal(&msp.MSPRole{Role: role, MspIdentifier: mspID})
+		assert.NoError(t, err)
+		return &msp.MSPPrincipal{
+			PrincipalClassification: msp.MSPPrincipal_ROLE,
+			Principal:               principalBytes}
+	}
+
+	admin := getIdentityFromFile(t, thisMSP, "testdata/nodeous9/users/admin.pem")
+	orderer := getIdentityFromFile(t, thisMSP, "testdata/nodeous9/users/orderer.pem")
+	client := getIdentityFromFile(t, thisMSP, "testdata/nodeous9/users/client.pem")
+	peer, err := thisMSP.GetDefaultSigningIdentity()
+	assert.NoError(t, err)
+
+	for _, id := range []Identity{admin, orderer, client, peer} {
+		assert.NoError(t, id.Validate())
+		assert.NoError(t, id.SatisfiesPrincipal(rolePrincipal(msp.MSPRole_MEMBER)))
+	}
+
+	assert.NoError(t, admin.SatisfiesPrincipal(rolePrincipal(msp.MSPRole_ADMIN)))
+	assert.NoError(t, orderer.SatisfiesPrincipal(rolePrincipal(msp.MSPRole_ORDERER)))
+
+	err = admin.SatisfiesPrincipal(rolePrincipal(msp.MSPRole_CLIENT))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "The identity is not a [CLIENT] under this MSP [SampleOrg]")
+
+	err = admin.SatisfiesPrincipal(rolePrincipal(msp.MSPRole_ORDERER))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "The identity is not a [ORDERER] under this MSP [SampleOrg]")
+
+	for _, id := range []Identity{orderer, client, peer} {
+		err = id.SatisfiesPrincipal(rolePrincipal(msp.MSPRole_ADMIN))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "This identity is not an admin")
+	}
+
+	// An MSP before v2.0 does not know about admin and orderer OUs
+	thisMSP = getLocalMSPWithVersion(t, "testdata/nodeous9", MSPv1_3)
+	mspID, err = thisMSP.GetIdentifier()
+	assert.NoError(t, err)
+	admin = getIdentityFromFile(t, thisMSP, "testdata/nodeous9/users/admin.pem")
+	err = admin.SatisfiesPrincipal(rolePrincipal(msp.MSPRole_ADMIN))
+	assert.EqualError(t, err, "This identity is not an admin")
+}
+
+func TestAdminNodeOUsWithAdminCerts(t *testing.T) {
+	// Admins listed in the admin certificates must be clients or admins
+	conf, err := GetLocalMspConfig("testdata/nodeous9", nil, "SampleOrg")
+	assert.NoError(t, err)
+	fabricConf := &msp.FabricMSPConfig{}
+	err = proto.Unmarshal(conf.Config, fabricConf)
+	assert.NoError(t, err)
+	fabricConf.SigningIdentity = nil
+
+	for _, test := range []struct {
+		cert        string
+		expectedErr string
+	}{
+		{cert: "admin.pem"},
+		{cert: "client.pem"},
+		{cert: "orderer.pem", expectedErr: "admin 0 is invalid: it is neither a client"},
+	} {
+		t.Run(test.cert, func(t *testing.T) {
+			adminCert, err := ioutil.ReadFile(filepath.Join("testdata/nodeous9/users", test.cert))
+			assert.NoError(t, err)
+			fabricConf.Admins = [][]byte{adminCert}
+			conf.Config, err = proto.Marshal(fabricConf)
+			assert.NoError(t, err)
+
+			thisMSP, err := newBccspMsp(MSPv2_0)
+			assert.NoError(t, err)
+			err = thisMSP.Setup(conf)
+			if test.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+
+	// Without admin certificates, the admin OU must be defined
+	fabricConf.Admins = nil
+	fabricConf.FabricNodeOus.AdminOuIdentifier = nil
+	conf.Config, err = proto.Marshal(fabricConf)
+	assert.NoError(t, err)
+	thisMSP, err := newBccspMsp(MSPv2_0)
+	assert.NoError(t, err)
+	err = thisMSP.Setup(conf)
+	assert.EqualError(t, err, "administrators must be declared either as admin certificates or through the admin OU")
+}
+
+func getIdentityFromFile(t *testing.T, thisMSP MSP, path string) Identity {
+	pem, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+
+	id, _, err := thisMSP.(*bccspmsp).getIdentityFromConf(pem)
+	assert.NoError(t, err)
+
+	return id
+}
@@ -0,0 +1,164 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msp
+
+import (
+	"bytes"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"time"
+
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/gm"
+	"github.com/pkg/errors"
+)
+
+// parseCertificate parses a DER encoded x509 certificate. Certificates
+// with SM2 keys are only understood by MSPs at v2.0 or later.
+func (msp *bccspmsp) parseCertificate(der []byte) (*x509.Certificate, error) {
+	if msp.version < MSPv2_0 {
+		return x509.ParseCertificate(der)
+	}
+	return gm.ParseCertificate(der)
+}
+
+// sm2Digest returns the digest of msg to be signed with the private key
+// of pub, that is the SM3 hash of the Z value of pub followed by msg.
+func (msp *bccspmsp) sm2Digest(pub *gm.PublicKey, msg []byte) ([]byte, error) {
+	za, err := gm.ZA(pub, gm.DefaultUID)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed computing the Z value of the SM2 public key")
+	}
+
+	h, err := msp.bccsp.GetHash(&bccsp.SM3Opts{})
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed getting SM3 hash function")
+	}
+	h.Write(za)
+	h.Write(msg)
+	return h.Sum(nil), nil
+}
+
+// getSM2ValidationChains returns the validation chains of cert, which is
+// signed with SM2, up to the root CAs of this MSP. The standard library
+// does not verify SM2 signatures, hence the chains are built here with
+// the same rules as x509.Certificate.Verify, except for name constraints.
+func (msp *bccspmsp) getSM2ValidationChains(cert *x509.Certificate, opts x509.VerifyOptions) ([][]*x509.Certificate, error) {
+	var roots, intermediates []*x509.Certificate
+	for _, id := range msp.rootCerts {
+		roots = append(roots, id.(*identity).cert)
+	}
+	for _, id := range msp.intermediateCerts {
+		intermediates = append(intermediates, id.(*identity).cert)
+	}
+
+	now := opts.CurrentTime
+	if now.IsZero() {
+		now = time.Now()
+	}
+	keyUsages := opts.KeyUsages
+	if len(keyUsages) == 0 {
+		keyUsages = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	}
+
+	var chains [][]*x509.Certificate
+	var build func(chain []*x509.Certificate) error
+	build = func(chain []*x509.Certificate) error {
+		c := chain[len(chain)-1]
+		if now.Before(c.NotBefore) || now.After(c.NotAfter) {
+			return x509.CertificateInvalidError{Cert: c, Reason: x509.Expired}
+		}
+		for _, root := range roots {
+			if bytes.Equal(c.Raw, root.Raw) {
+				chains = append(chains, chain)
+				return nil
+			}
+		}
+
+		var err error = x509.UnknownAuthorityError{Cert: c}
+		for _, parent := range append(append([]*x509.Certificate{}, roots...), intermediates...) {
+			if !bytes.Equal(parent.RawSubject, c.RawIssuer) || inChain(parent, chain) {
+				continue
+			}
+			if sigErr := gm.CheckSignatureFrom(c, parent); sigErr != nil {
+				err = sigErr
+				continue
+			}
+			if buildErr := build(append(append([]*x509.Certificate{}, chain...), parent)); buildErr != nil {
+				err = buildErr
+			} else {
+				err = nil
+			}
+		}
+		if len(chains) > 0 {
+			return nil
+		}
+		return err
+	}
+
+	if err := build([]*x509.Certificate{cert}); err != nil {
+		return nil, err
+	}
+
+	var validChains [][]*x509.Certificate
+	for _, chain := range chains {
+		if hasExtKeyUsage(chain, keyUsages) {
+			validChains = append(validChains, chain)
+		}
+	}
+	if len(validChains) == 0 {
+		return nil, x509.CertificateInvalidError{Cert: cert, Reason: x509.IncompatibleUsage}
+	}
+	return validChains, nil
+}
+
+// inChain returns whether cert is part of chain
+func inChain(cert *x509.Certificate, chain []*x509.Certificate) bool {
+	for _, c := range chain {
+		if bytes.Equal(c.Raw, cert.Raw) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasExtKeyUsage returns whether each certificate of chain allows one of
+// the given extended key usages, or does not restrict them
+func hasExtKeyUsage(chain []*x509.Certificate, keyUsages []x509.ExtKeyUsage) bool {
+	for _, usage := range keyUsages {
+		if usage == x509.ExtKeyUsageAny {
+			return true
+		}
+	}
+
+	for _, c := range chain {
+		if len(c.ExtKeyUsage) == 0 && len(c.UnknownExtKeyUsage) == 0 {
+			continue
+		}
+
+		allowed := false
+		for _, certUsage := range c.ExtKeyUsage {
+			for _, usage := range keyUsages {
+				if certUsage == x509.ExtKeyUsageAny || certUsage == usage {
+					allowed = true
+				}
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+	return true
+}
+
+// checkCRLSignature checks that crl is signed by ca
+func checkCRLSignature(ca *x509.Certificate, crl *pkix.CertificateList) error {
+	if _, ok := ca.PublicKey.(*gm.PublicKey); ok {
+		return gm.CheckCRLSignature(crl, ca)
+	}
+	return ca.CheckCRLSignature(crl)
+}
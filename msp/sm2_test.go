@@ -0,0 +1,113 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msp
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/gm"
+	"github.com/hyperledger/fabric/bccsp/sw"
+	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSM2Identities(t *testing.T) {
+	// testdata/sm2 contains a root CA, an intermediate CA and a signing
+	// identity with SM2 keys, whose certificates are signed with SM2
+	thisMSP := getLocalMSPWithVersion(t, "testdata/sm2", MSPv2_0)
+
+	id, err := thisMSP.GetDefaultSigningIdentity()
+	assert.NoError(t, err)
+	assert.NoError(t, thisMSP.Validate(id.GetPublicVersion()))
+
+	serializedID, err := id.Serialize()
+	assert.NoError(t, err)
+	idBack, err := thisMSP.DeserializeIdentity(serializedID)
+	assert.NoError(t, err)
+	assert.NoError(t, thisMSP.Validate(idBack))
+
+	msg := []byte("foo")
+	sig, err := id.Sign(msg)
+	assert.NoError(t, err)
+	assert.NoError(t, id.Verify(msg, sig))
+	assert.NoError(t, idBack.Verify(msg, sig))
+	assert.Error(t, idBack.Verify(msg[1:], sig))
+	assert.Error(t, idBack.Verify(msg, sig[1:]))
+
+	// SM2 signs the SM3 hash of the Z value of the key followed by the message
+	pub := idBack.(*identity).cert.PublicKey.(*gm.PublicKey)
+	digest, err := gm.Digest(pub, gm.DefaultUID, msg)
+	assert.NoError(t, err)
+	assert.True(t, gm.VerifyASN1(pub, digest, sig))
+
+	// The identity is issued by the intermediate CA
+	chain, err := thisMSP.(*bccspmsp).getCertificationChain(idBack)
+	assert.NoError(t, err)
+	assert.Len(t, chain, 3)
+	assert.Equal(t, "ica.org1.example.com", chain[1].Subject.CommonName)
+	assert.Equal(t, "ca.org1.example.com", chain[2].Subject.CommonName)
+}
+
+func TestSM2RevokedIdentity(t *testing.T) {
+	// testdata/sm2/revoked contains a certificate revoked by the CRL of the intermediate CA
+	thisMSP := getLocalMSPWithVersion(t, "testdata/sm2", MSPv2_0)
+
+	certPEM, err := ioutil.ReadFile(filepath.Join("testdata", "sm2", "revoked", "cert.pem"))
+	require.NoError(t, err)
+	serializedID, err := NewSerializedIdentity("SampleOrg", certPEM)
+	require.NoError(t, err)
+	id, err := thisMSP.DeserializeIdentity(serializedID)
+	assert.NoError(t, err)
+	err = thisMSP.Validate(id)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "The certificate has been revoked")
+}
+
+func TestSM2RequiresV2_0(t *testing.T) {
+	// MSPs before v2.0 do not understand certificates with SM2 keys
+	_, err := getLocalMSPWithVersionAndError(t, "testdata/sm2", MSPv1_3)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported elliptic curve")
+}
+
+func TestSM3CryptoConfig(t *testing.T) {
+	conf, err := GetLocalMspConfig("testdata/sm2", nil, "SampleOrg")
+	require.NoError(t, err)
+	fabricConf := &msp.FabricMSPConfig{}
+	require.NoError(t, proto.Unmarshal(conf.Config, fabricConf))
+	fabricConf.CryptoConfig = &msp.FabricCryptoConfig{
+		SignatureHashFamily:            bccsp.SM3,
+		IdentityIdentifierHashFunction: bccsp.SM3,
+	}
+	conf.Config, err = proto.Marshal(fabricConf)
+	require.NoError(t, err)
+
+	ks, err := sw.NewFileBasedKeyStore(nil, filepath.Join("testdata", "sm2", "keystore"), true)
+	require.NoError(t, err)
+	thisMSP, err := NewBccspMspWithKeyStore(MSPv2_0, ks)
+	require.NoError(t, err)
+	require.NoError(t, thisMSP.Setup(conf))
+
+	id, err := thisMSP.GetDefaultSigningIdentity()
+	require.NoError(t, err)
+	digest := gm.SumSM3(id.(*signingidentity).cert.Raw)
+	assert.Equal(t, hex.EncodeToString(digest[:]), id.GetIdentifier().Id)
+
+	// The SM3 signature hash family also applies to ECDSA identities
+	ecdsaID := &identity{msp: thisMSP.(*bccspmsp), cert: getIdentity(t, signcerts).(*identity).cert}
+	msg := []byte("foo")
+	digestBytes, err := ecdsaID.digest(msg)
+	assert.NoError(t, err)
+	expected := gm.SumSM3(msg)
+	assert.Equal(t, expected[:], digestBytes)
+}
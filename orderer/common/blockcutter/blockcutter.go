@@ -9,6 +9,7 @@ package blockcutter
 import (
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/common/flogging"
 	cb "github.com/hyperledger/fabric/protos/common"
@@ -79,7 +80,7 @@ func (r *receiver) Ordered(msg *cb.Envelope) (messageBatches [][]*cb.Envelope, p
 
 	batchSize := ordererConfig.BatchSize()
 
-	messageSizeBytes := messageSizeBytes(msg)
+	messageSizeBytes := MessageSizeBytes(ordererConfig.Capabilities(), msg)
 	if messageSizeBytes > batchSize.PreferredMaxBytes {
 		logger.Debugf("The current message, with %v bytes, is larger than the preferred batch size of %v bytes and will be isolated.", messageSizeBytes, batchSize.PreferredMaxBytes)
 
@@ -135,6 +136,13 @@ func (r *receiver) Cut() []*cb.Envelope {
 	return batch
 }
 
-func messageSizeBytes(message *cb.Envelope) uint32 {
+// MessageSizeBytes returns the size of the message as counted against the batch size.
+// With the ExactMessageSize capability, this is the marshaled size of the message, which
+// is how the size filter measures messages against the absolute max bytes. Otherwise
+// only the payload and signature of the message are counted.
+func MessageSizeBytes(capabilities channelconfig.OrdererCapabilities, message *cb.Envelope) uint32 {
+	if capabilities.ExactMessageSize() {
+		return uint32(proto.Size(message))
+	}
 	return uint32(len(message.Payload) + len(message.Signature))
 }
@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	mockconfig "github.com/hyperledger/fabric/common/mocks/config"
 	"github.com/hyperledger/fabric/orderer/common/blockcutter"
 	"github.com/hyperledger/fabric/orderer/common/blockcutter/mock"
 	cb "github.com/hyperledger/fabric/protos/common"
@@ -21,13 +22,16 @@ var _ = Describe("Blockcutter", func() {
 		bc                blockcutter.Receiver
 		fakeConfig        *mock.OrdererConfig
 		fakeConfigFetcher *mock.OrdererConfigFetcher
+		fakeCapabilities  *mockconfig.OrdererCapabilities
 
 		metrics               *blockcutter.Metrics
 		fakeBlockFillDuration *mock.MetricsHistogram
 	)
 
 	BeforeEach(func() {
+		fakeCapabilities = &mockconfig.OrdererCapabilities{}
 		fakeConfig = &mock.OrdererConfig{}
+		fakeConfig.CapabilitiesReturns(fakeCapabilities)
 		fakeConfigFetcher = &mock.OrdererConfigFetcher{}
 		fakeConfigFetcher.OrdererConfigReturns(fakeConfig, true)
 
@@ -173,6 +177,33 @@ var _ = Describe("Blockcutter", func() {
 			})
 		})
 
+		Context("when only the payload and signature of the message fit in the preferred max bytes", func() {
+			BeforeEach(func() {
+				fakeConfig.BatchSizeReturns(&ab.BatchSize{
+					MaxMessageCount:   3,
+					PreferredMaxBytes: 42,
+				})
+			})
+
+			It("adds the message to the pending batches", func() {
+				batches, pending := bc.Ordered(message)
+				Expect(batches).To(BeEmpty())
+				Expect(pending).To(BeTrue())
+			})
+
+			Context("when the exact message size capability is enabled", func() {
+				BeforeEach(func() {
+					fakeCapabilities.ExactMessageSizeVal = true
+				})
+
+				It("cuts the batch immediately", func() {
+					batches, pending := bc.Ordered(message)
+					Expect(len(batches)).To(Equal(1))
+					Expect(pending).To(BeFalse())
+				})
+			})
+		})
+
 		Context("when the orderer config cannot be retrieved", func() {
 			BeforeEach(func() {
 				fakeConfigFetcher.OrdererConfigReturns(nil, false)
@@ -184,6 +215,28 @@ var _ = Describe("Blockcutter", func() {
 		})
 	})
 
+	Describe("MessageSizeBytes", func() {
+		var message *cb.Envelope
+
+		BeforeEach(func() {
+			message = &cb.Envelope{Payload: []byte("Twenty Bytes of Data"), Signature: []byte("Twenty Bytes of Data")}
+		})
+
+		It("counts the payload and signature of the message", func() {
+			Expect(blockcutter.MessageSizeBytes(fakeCapabilities, message)).To(Equal(uint32(40)))
+		})
+
+		Context("when the exact message size capability is enabled", func() {
+			BeforeEach(func() {
+				fakeCapabilities.ExactMessageSizeVal = true
+			})
+
+			It("returns the marshaled size of the message", func() {
+				Expect(blockcutter.MessageSizeBytes(fakeCapabilities, message)).To(Equal(uint32(44)))
+			})
+		})
+	})
+
 	Describe("Cut", func() {
 		It("cuts an empty batch", func() {
 			batch := bc.Cut()
@@ -80,6 +80,16 @@ type OrdererConfig struct {
 	consensusTypeReturnsOnCall map[int]struct {
 		result1 string
 	}
+	DecommissionedStub        func() bool
+	decommissionedMutex       sync.RWMutex
+	decommissionedArgsForCall []struct {
+	}
+	decommissionedReturns struct {
+		result1 bool
+	}
+	decommissionedReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	KafkaBrokersStub        func() []string
 	kafkaBrokersMutex       sync.RWMutex
 	kafkaBrokersArgsForCall []struct {
@@ -100,15 +110,15 @@ type OrdererConfig struct {
 	maxChannelsCountReturnsOnCall map[int]struct {
 		result1 uint64
 	}
-	OrganizationsStub        func() map[string]channelconfig.Org
+	OrganizationsStub        func() map[string]channelconfig.OrdererOrg
 	organizationsMutex       sync.RWMutex
 	organizationsArgsForCall []struct {
 	}
 	organizationsReturns struct {
-		result1 map[string]channelconfig.Org
+		result1 map[string]channelconfig.OrdererOrg
 	}
 	organizationsReturnsOnCall map[int]struct {
-		result1 map[string]channelconfig.Org
+		result1 map[string]channelconfig.OrdererOrg
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
@@ -478,6 +488,58 @@ func (fake *OrdererConfig) ConsensusTypeReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *OrdererConfig) Decommissioned() bool {
+	fake.decommissionedMutex.Lock()
+	ret, specificReturn := fake.decommissionedReturnsOnCall[len(fake.decommissionedArgsForCall)]
+	fake.decommissionedArgsForCall = append(fake.decommissionedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Decommissioned", []interface{}{})
+	fake.decommissionedMutex.Unlock()
+	if fake.DecommissionedStub != nil {
+		return fake.DecommissionedStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.decommissionedReturns
+	return fakeReturns.result1
+}
+
+func (fake *OrdererConfig) DecommissionedCallCount() int {
+	fake.decommissionedMutex.RLock()
+	defer fake.decommissionedMutex.RUnlock()
+	return len(fake.decommissionedArgsForCall)
+}
+
+func (fake *OrdererConfig) DecommissionedCalls(stub func() bool) {
+	fake.decommissionedMutex.Lock()
+	defer fake.decommissionedMutex.Unlock()
+	fake.DecommissionedStub = stub
+}
+
+func (fake *OrdererConfig) DecommissionedReturns(result1 bool) {
+	fake.decommissionedMutex.Lock()
+	defer fake.decommissionedMutex.Unlock()
+	fake.DecommissionedStub = nil
+	fake.decommissionedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *OrdererConfig) DecommissionedReturnsOnCall(i int, result1 bool) {
+	fake.decommissionedMutex.Lock()
+	defer fake.decommissionedMutex.Unlock()
+	fake.DecommissionedStub = nil
+	if fake.decommissionedReturnsOnCall == nil {
+		fake.decommissionedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.decommissionedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *OrdererConfig) KafkaBrokers() []string {
 	fake.kafkaBrokersMutex.Lock()
 	ret, specificReturn := fake.kafkaBrokersReturnsOnCall[len(fake.kafkaBrokersArgsForCall)]
@@ -496,6 +558,8 @@ func (fake *OrdererConfig) KafkaBrokers() []string {
 }
 
 func (fake *OrdererConfig) KafkaBrokersCallCount() int {
+	fake.decommissionedMutex.RLock()
+	defer fake.decommissionedMutex.RUnlock()
 	fake.kafkaBrokersMutex.RLock()
 	defer fake.kafkaBrokersMutex.RUnlock()
 	return len(fake.kafkaBrokersArgsForCall)
@@ -582,7 +646,7 @@ func (fake *OrdererConfig) MaxChannelsCountReturnsOnCall(i int, result1 uint64)
 	}{result1}
 }
 
-func (fake *OrdererConfig) Organizations() map[string]channelconfig.Org {
+func (fake *OrdererConfig) Organizations() map[string]channelconfig.OrdererOrg {
 	fake.organizationsMutex.Lock()
 	ret, specificReturn := fake.organizationsReturnsOnCall[len(fake.organizationsArgsForCall)]
 	fake.organizationsArgsForCall = append(fake.organizationsArgsForCall, struct {
@@ -605,32 +669,32 @@ func (fake *OrdererConfig) OrganizationsCallCount() int {
 	return len(fake.organizationsArgsForCall)
 }
 
-func (fake *OrdererConfig) OrganizationsCalls(stub func() map[string]channelconfig.Org) {
+func (fake *OrdererConfig) OrganizationsCalls(stub func() map[string]channelconfig.OrdererOrg) {
 	fake.organizationsMutex.Lock()
 	defer fake.organizationsMutex.Unlock()
 	fake.OrganizationsStub = stub
 }
 
-func (fake *OrdererConfig) OrganizationsReturns(result1 map[string]channelconfig.Org) {
+func (fake *OrdererConfig) OrganizationsReturns(result1 map[string]channelconfig.OrdererOrg) {
 	fake.organizationsMutex.Lock()
 	defer fake.organizationsMutex.Unlock()
 	fake.OrganizationsStub = nil
 	fake.organizationsReturns = struct {
-		result1 map[string]channelconfig.Org
+		result1 map[string]channelconfig.OrdererOrg
 	}{result1}
 }
 
-func (fake *OrdererConfig) OrganizationsReturnsOnCall(i int, result1 map[string]channelconfig.Org) {
+func (fake *OrdererConfig) OrganizationsReturnsOnCall(i int, result1 map[string]channelconfig.OrdererOrg) {
 	fake.organizationsMutex.Lock()
 	defer fake.organizationsMutex.Unlock()
 	fake.OrganizationsStub = nil
 	if fake.organizationsReturnsOnCall == nil {
 		fake.organizationsReturnsOnCall = make(map[int]struct {
-			result1 map[string]channelconfig.Org
+			result1 map[string]channelconfig.OrdererOrg
 		})
 	}
 	fake.organizationsReturnsOnCall[i] = struct {
-		result1 map[string]channelconfig.Org
+		result1 map[string]channelconfig.OrdererOrg
 	}{result1}
 }
 
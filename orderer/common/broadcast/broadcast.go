@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package broadcast
 
 import (
+	"fmt"
 	"io"
 	"time"
 
@@ -120,6 +121,12 @@ func (mt *MetricsTracker) Record(resp *ab.BroadcastResponse) {
 	mt.Metrics.ProcessedCount.With(labels...).Add(1)
 }
 
+// RecordTooLarge records that the message was rejected because it exceeds
+// the absolute maximum size allowed on the channel.
+func (mt *MetricsTracker) RecordTooLarge() {
+	mt.Metrics.RejectedTooLargeCount.With("channel", mt.ChannelID, "type", mt.TxType).Add(1)
+}
+
 func (mt *MetricsTracker) BeginValidate() {
 	mt.ValidateStartTime = time.Now()
 }
@@ -154,7 +161,7 @@ func (bh *Handler) ProcessMessage(msg *cb.Envelope, addr string) (resp *ab.Broad
 	}
 	if err != nil {
 		logger.Warningf("[channel: %s] Could not get message processor for serving %s: %s", tracker.ChannelID, addr, err)
-		return &ab.BroadcastResponse{Status: cb.Status_BAD_REQUEST, Info: err.Error()}
+		return &ab.BroadcastResponse{Status: ClassifyError(err), Info: err.Error()}
 	}
 
 	if !isConfig {
@@ -163,6 +170,9 @@ func (bh *Handler) ProcessMessage(msg *cb.Envelope, addr string) (resp *ab.Broad
 		configSeq, err := processor.ProcessNormalMsg(msg)
 		if err != nil {
 			logger.Warningf("[channel: %s] Rejecting broadcast of normal message from %s because of error: %s", chdr.ChannelId, addr, err)
+			if errors.Cause(err) == msgprocessor.ErrMaxBytesExceeded {
+				tracker.RecordTooLarge()
+			}
 			return &ab.BroadcastResponse{Status: ClassifyError(err), Info: err.Error()}
 		}
 		tracker.EndValidate()
@@ -184,6 +194,9 @@ func (bh *Handler) ProcessMessage(msg *cb.Envelope, addr string) (resp *ab.Broad
 		config, configSeq, err := processor.ProcessConfigUpdateMsg(msg)
 		if err != nil {
 			logger.Warningf("[channel: %s] Rejecting broadcast of config message from %s because of error: %s", chdr.ChannelId, addr, err)
+			if errors.Cause(err) == msgprocessor.ErrMaxBytesExceeded {
+				tracker.RecordTooLarge()
+			}
 			return &ab.BroadcastResponse{Status: ClassifyError(err), Info: err.Error()}
 		}
 		tracker.EndValidate()
@@ -206,6 +219,34 @@ func (bh *Handler) ProcessMessage(msg *cb.Envelope, addr string) (resp *ab.Broad
 	return &ab.BroadcastResponse{Status: cb.Status_SUCCESS}
 }
 
+// ValidateConfigUpdate runs a config update message through the same processing
+// as ProcessMessage, including policy evaluation, but does not order it.
+func (bh *Handler) ValidateConfigUpdate(msg *cb.Envelope, addr string) *ab.ConfigUpdateValidationResponse {
+	chdr, isConfig, processor, err := bh.SupportRegistrar.BroadcastChannelSupport(msg)
+	if err != nil {
+		logger.Warningf("Could not get message processor for validating config update from %s: %s", addr, err)
+		return &ab.ConfigUpdateValidationResponse{Status: cb.Status_BAD_REQUEST, Info: err.Error()}
+	}
+
+	if !isConfig {
+		logger.Warningf("[channel: %s] Rejecting validation of message from %s because it is not a config update", chdr.ChannelId, addr)
+		return &ab.ConfigUpdateValidationResponse{
+			Status: cb.Status_BAD_REQUEST,
+			Info:   fmt.Sprintf("message of type %s is not a config update", cb.HeaderType(chdr.Type)),
+		}
+	}
+
+	logger.Debugf("[channel: %s] Validating config update message from %s", chdr.ChannelId, addr)
+
+	_, configSeq, err := processor.ProcessConfigUpdateMsg(msg)
+	if err != nil {
+		logger.Infof("[channel: %s] Config update from %s failed validation: %s", chdr.ChannelId, addr, err)
+		return &ab.ConfigUpdateValidationResponse{Status: ClassifyError(err), Info: err.Error(), ConfigSequence: configSeq}
+	}
+
+	return &ab.ConfigUpdateValidationResponse{Status: cb.Status_SUCCESS, ConfigSequence: configSeq}
+}
+
 // ClassifyError converts an error type into a status code.
 func ClassifyError(err error) cb.Status {
 	switch errors.Cause(err) {
@@ -213,6 +254,10 @@ func ClassifyError(err error) cb.Status {
 		return cb.Status_NOT_FOUND
 	case msgprocessor.ErrPermissionDenied:
 		return cb.Status_FORBIDDEN
+	case msgprocessor.ErrChannelDecommissioned:
+		return cb.Status_GONE
+	case msgprocessor.ErrQuotaExceeded:
+		return cb.Status_SERVICE_UNAVAILABLE
 	default:
 		return cb.Status_BAD_REQUEST
 	}
@@ -20,6 +20,7 @@ import (
 	"github.com/hyperledger/fabric/orderer/common/msgprocessor"
 	cb "github.com/hyperledger/fabric/protos/common"
 	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/pkg/errors"
 )
 
 var _ = Describe("Broadcast", func() {
@@ -29,6 +30,7 @@ var _ = Describe("Broadcast", func() {
 		fakeValidateHistogram *mock.MetricsHistogram
 		fakeEnqueueHistogram  *mock.MetricsHistogram
 		fakeProcessedCounter  *mock.MetricsCounter
+		fakeTooLargeCounter   *mock.MetricsCounter
 	)
 
 	BeforeEach(func() {
@@ -43,12 +45,16 @@ var _ = Describe("Broadcast", func() {
 		fakeProcessedCounter = &mock.MetricsCounter{}
 		fakeProcessedCounter.WithReturns(fakeProcessedCounter)
 
+		fakeTooLargeCounter = &mock.MetricsCounter{}
+		fakeTooLargeCounter.WithReturns(fakeTooLargeCounter)
+
 		handler = &broadcast.Handler{
 			SupportRegistrar: fakeSupportRegistrar,
 			Metrics: &broadcast.Metrics{
-				ValidateDuration: fakeValidateHistogram,
-				EnqueueDuration:  fakeEnqueueHistogram,
-				ProcessedCount:   fakeProcessedCounter,
+				ValidateDuration:      fakeValidateHistogram,
+				EnqueueDuration:       fakeEnqueueHistogram,
+				ProcessedCount:        fakeProcessedCounter,
+				RejectedTooLargeCount: fakeTooLargeCounter,
 			},
 		}
 	})
@@ -167,6 +173,45 @@ var _ = Describe("Broadcast", func() {
 				})
 			})
 
+			Context("when the channel has been decommissioned", func() {
+				BeforeEach(func() {
+					fakeSupportRegistrar.BroadcastChannelSupportReturns(&cb.ChannelHeader{
+						Type:      2,
+						ChannelId: "fake-channel",
+					}, false, nil, errors.Wrap(msgprocessor.ErrChannelDecommissioned, "fake-channel"))
+				})
+
+				It("returns the error to the client with a gone status", func() {
+					err := handler.Handle(fakeABServer)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeABServer.SendCallCount()).To(Equal(1))
+					Expect(proto.Equal(
+						fakeABServer.SendArgsForCall(0),
+						&ab.BroadcastResponse{Status: cb.Status_GONE, Info: "fake-channel: channel has been decommissioned"}),
+					).To(BeTrue())
+				})
+			})
+
+			Context("when the channel exceeded its quota", func() {
+				BeforeEach(func() {
+					fakeSupportRegistrar.BroadcastChannelSupportReturns(&cb.ChannelHeader{
+						Type:      3,
+						ChannelId: "fake-channel",
+					}, false, nil, errors.Wrap(msgprocessor.ErrQuotaExceeded, "channel fake-channel exceeded its bandwidth quota"))
+				})
+
+				It("returns the error to the client with a service unavailable status", func() {
+					err := handler.Handle(fakeABServer)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeABServer.SendCallCount()).To(Equal(1))
+					Expect(proto.Equal(
+						fakeABServer.SendArgsForCall(0),
+						&ab.BroadcastResponse{Status: cb.Status_SERVICE_UNAVAILABLE, Info: "channel fake-channel exceeded its bandwidth quota: channel quota exceeded"}),
+					).To(BeTrue())
+				})
+			})
 		})
 
 		Context("when the receive from the client fails", func() {
@@ -272,6 +317,37 @@ var _ = Describe("Broadcast", func() {
 						fakeABServer.SendArgsForCall(0),
 						&ab.BroadcastResponse{Status: cb.Status_FORBIDDEN, Info: msgprocessor.ErrPermissionDenied.Error()},
 					)).To(BeTrue())
+					Expect(fakeTooLargeCounter.AddCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the error cause is msgprocessor.ErrMaxBytesExceeded", func() {
+				BeforeEach(func() {
+					fakeSupport.ProcessNormalMsgReturns(0, errors.Wrap(msgprocessor.ErrMaxBytesExceeded, "message payload is 11 bytes and exceeds maximum allowed 10 bytes"))
+				})
+
+				It("returns the error and a bad request status", func() {
+					err := handler.Handle(fakeABServer)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeSupport.OrderCallCount()).To(Equal(0))
+					Expect(fakeABServer.SendCallCount()).To(Equal(1))
+					Expect(proto.Equal(
+						fakeABServer.SendArgsForCall(0),
+						&ab.BroadcastResponse{Status: cb.Status_BAD_REQUEST, Info: "message payload is 11 bytes and exceeds maximum allowed 10 bytes: message too large"},
+					)).To(BeTrue())
+				})
+
+				It("records the rejected message", func() {
+					handler.Handle(fakeABServer)
+
+					Expect(fakeTooLargeCounter.WithCallCount()).To(Equal(1))
+					Expect(fakeTooLargeCounter.WithArgsForCall(0)).To(Equal([]string{
+						"channel", "fake-channel",
+						"type", "ENDORSER_TRANSACTION",
+					}))
+					Expect(fakeTooLargeCounter.AddCallCount()).To(Equal(1))
+					Expect(fakeTooLargeCounter.AddArgsForCall(0)).To(Equal(float64(1)))
 				})
 			})
 		})
@@ -395,6 +471,96 @@ var _ = Describe("Broadcast", func() {
 						)).To(BeTrue())
 					})
 				})
+
+				Context("when the error cause is msgprocessor.ErrMaxBytesExceeded", func() {
+					BeforeEach(func() {
+						fakeSupport.ProcessConfigUpdateMsgReturns(nil, 0, msgprocessor.ErrMaxBytesExceeded)
+					})
+
+					It("returns the error, a bad request status, and records the rejection", func() {
+						err := handler.Handle(fakeABServer)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakeSupport.ConfigureCallCount()).To(Equal(0))
+						Expect(fakeABServer.SendCallCount()).To(Equal(1))
+						Expect(proto.Equal(
+							fakeABServer.SendArgsForCall(0),
+							&ab.BroadcastResponse{Status: cb.Status_BAD_REQUEST, Info: msgprocessor.ErrMaxBytesExceeded.Error()},
+						)).To(BeTrue())
+						Expect(fakeTooLargeCounter.AddCallCount()).To(Equal(1))
+					})
+				})
+			})
+		})
+	})
+
+	Describe("ValidateConfigUpdate", func() {
+		var (
+			fakeSupport *mock.ChannelSupport
+			fakeMsg     *cb.Envelope
+		)
+
+		BeforeEach(func() {
+			fakeMsg = &cb.Envelope{}
+
+			fakeSupport = &mock.ChannelSupport{}
+			fakeSupport.ProcessConfigUpdateMsgReturns(&cb.Envelope{}, 3, nil)
+
+			fakeSupportRegistrar.BroadcastChannelSupportReturns(&cb.ChannelHeader{
+				Type:      int32(cb.HeaderType_CONFIG_UPDATE),
+				ChannelId: "fake-channel",
+			}, true, fakeSupport, nil)
+		})
+
+		It("validates the config update without ordering it", func() {
+			resp := handler.ValidateConfigUpdate(fakeMsg, "address")
+			Expect(proto.Equal(resp, &ab.ConfigUpdateValidationResponse{Status: cb.Status_SUCCESS, ConfigSequence: 3})).To(BeTrue())
+
+			Expect(fakeSupport.ProcessConfigUpdateMsgCallCount()).To(Equal(1))
+			Expect(fakeSupport.ProcessConfigUpdateMsgArgsForCall(0)).To(Equal(fakeMsg))
+			Expect(fakeSupport.WaitReadyCallCount()).To(Equal(0))
+			Expect(fakeSupport.ConfigureCallCount()).To(Equal(0))
+			Expect(fakeProcessedCounter.AddCallCount()).To(Equal(0))
+		})
+
+		Context("when the channel support cannot be retrieved", func() {
+			BeforeEach(func() {
+				fakeSupportRegistrar.BroadcastChannelSupportReturns(nil, false, nil, fmt.Errorf("support-error"))
+			})
+
+			It("returns the error with a bad request status", func() {
+				resp := handler.ValidateConfigUpdate(fakeMsg, "address")
+				Expect(proto.Equal(resp, &ab.ConfigUpdateValidationResponse{Status: cb.Status_BAD_REQUEST, Info: "support-error"})).To(BeTrue())
+			})
+		})
+
+		Context("when the message is not a config update", func() {
+			BeforeEach(func() {
+				fakeSupportRegistrar.BroadcastChannelSupportReturns(&cb.ChannelHeader{
+					Type:      int32(cb.HeaderType_ENDORSER_TRANSACTION),
+					ChannelId: "fake-channel",
+				}, false, fakeSupport, nil)
+			})
+
+			It("rejects it with a bad request status", func() {
+				resp := handler.ValidateConfigUpdate(fakeMsg, "address")
+				Expect(proto.Equal(resp, &ab.ConfigUpdateValidationResponse{
+					Status: cb.Status_BAD_REQUEST,
+					Info:   "message of type ENDORSER_TRANSACTION is not a config update",
+				})).To(BeTrue())
+				Expect(fakeSupport.ProcessConfigUpdateMsgCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the config update fails validation", func() {
+			BeforeEach(func() {
+				fakeSupport.ProcessConfigUpdateMsgReturns(nil, 0, errors.Wrap(msgprocessor.ErrPermissionDenied, "policy not satisfied"))
+			})
+
+			It("returns the detailed error and the classified status", func() {
+				resp := handler.ValidateConfigUpdate(fakeMsg, "address")
+				Expect(resp.Status).To(Equal(cb.Status_FORBIDDEN))
+				Expect(resp.Info).To(Equal("policy not satisfied: permission denied"))
 			})
 		})
 	})
@@ -30,18 +30,27 @@ var (
 		LabelNames:   []string{"channel", "type", "status"},
 		StatsdFormat: "%{#fqname}.%{channel}.%{type}.%{status}",
 	}
+	rejectedTooLargeCount = metrics.CounterOpts{
+		Namespace:    "broadcast",
+		Name:         "rejected_too_large_count",
+		Help:         "The number of transactions rejected for exceeding the absolute max bytes.",
+		LabelNames:   []string{"channel", "type"},
+		StatsdFormat: "%{#fqname}.%{channel}.%{type}",
+	}
 )
 
 type Metrics struct {
-	ValidateDuration metrics.Histogram
-	EnqueueDuration  metrics.Histogram
-	ProcessedCount   metrics.Counter
+	ValidateDuration      metrics.Histogram
+	EnqueueDuration       metrics.Histogram
+	ProcessedCount        metrics.Counter
+	RejectedTooLargeCount metrics.Counter
 }
 
 func NewMetrics(p metrics.Provider) *Metrics {
 	return &Metrics{
-		ValidateDuration: p.NewHistogram(validateDuration),
-		EnqueueDuration:  p.NewHistogram(enqueueDuration),
-		ProcessedCount:   p.NewCounter(processedCount),
+		ValidateDuration:      p.NewHistogram(validateDuration),
+		EnqueueDuration:       p.NewHistogram(enqueueDuration),
+		ProcessedCount:        p.NewCounter(processedCount),
+		RejectedTooLargeCount: p.NewCounter(rejectedTooLargeCount),
 	}
 }
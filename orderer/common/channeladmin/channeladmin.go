@@ -0,0 +1,148 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package channeladmin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/core/middleware"
+	"github.com/hyperledger/fabric/orderer/common/msgprocessor"
+	"github.com/hyperledger/fabric/orderer/common/multichannel"
+	"github.com/pkg/errors"
+)
+
+// URLBase is the path under which the handler serves channel administration requests.
+const URLBase = "/channels/"
+
+//go:generate counterfeiter -o fakes/registrar.go -fake-name Registrar . Registrar
+
+// Registrar manages the channels served by the orderer.
+type Registrar interface {
+	ChannelStatus(channelID string) (*multichannel.ChannelStatus, error)
+	DecommissionChannel(channelID string, removeLedger bool, retention time.Duration) error
+}
+
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+func NewHandler(r Registrar, decommissionEnabled bool, checkAdmin middleware.AdminChecker) *Handler {
+	return &Handler{
+		Registrar:           r,
+		DecommissionEnabled: decommissionEnabled,
+		CheckAdmin:          checkAdmin,
+		Logger:              flogging.MustGetLogger("orderer.common.channeladmin"),
+	}
+}
+
+// Handler serves the channel administration endpoint of the operations system.
+//
+// GET /channels/<channel> returns the ordering status of the channel.
+//
+// DELETE /channels/<channel>?removeLedger=true&retention=24h decommissions the
+// channel and, if requested, removes its ledger once the retention has elapsed.
+// Channels may only be decommissioned when DecommissionEnabled is set, by
+// clients whose TLS certificate passes CheckAdmin.
+type Handler struct {
+	Registrar           Registrar
+	DecommissionEnabled bool
+	CheckAdmin          middleware.AdminChecker
+	Logger              *flogging.FabricLogger
+}
+
+func (h *Handler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	channelID := strings.TrimPrefix(req.URL.Path, URLBase)
+	if channelID == "" || strings.Contains(channelID, "/") {
+		h.sendResponse(resp, http.StatusNotFound, fmt.Errorf("invalid channel path: %s", req.URL.Path))
+		return
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		h.status(resp, channelID)
+
+	case http.MethodDelete:
+		if !h.DecommissionEnabled {
+			h.sendResponse(resp, http.StatusForbidden, errors.New("decommissioning channels is disabled"))
+			return
+		}
+		decommission := http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			h.decommission(resp, req, channelID)
+		})
+		middleware.RequireAdmin(h.CheckAdmin)(decommission).ServeHTTP(resp, req)
+
+	default:
+		err := fmt.Errorf("invalid request method: %s", req.Method)
+		h.sendResponse(resp, http.StatusBadRequest, err)
+	}
+}
+
+func (h *Handler) status(resp http.ResponseWriter, channelID string) {
+	status, err := h.Registrar.ChannelStatus(channelID)
+	if err != nil {
+		h.sendResponse(resp, errorCode(err), err)
+		return
+	}
+
+	h.sendResponse(resp, http.StatusOK, status)
+}
+
+func (h *Handler) decommission(resp http.ResponseWriter, req *http.Request, channelID string) {
+	query := req.URL.Query()
+
+	var removeLedger bool
+	if v := query.Get("removeLedger"); v != "" {
+		var err error
+		if removeLedger, err = strconv.ParseBool(v); err != nil {
+			h.sendResponse(resp, http.StatusBadRequest, errors.Wrap(err, "invalid removeLedger"))
+			return
+		}
+	}
+
+	var retention time.Duration
+	if v := query.Get("retention"); v != "" {
+		var err error
+		if retention, err = time.ParseDuration(v); err != nil || retention < 0 {
+			h.sendResponse(resp, http.StatusBadRequest, errors.Errorf("invalid retention: %s", v))
+			return
+		}
+	}
+
+	if err := h.Registrar.DecommissionChannel(channelID, removeLedger, retention); err != nil {
+		h.sendResponse(resp, errorCode(err), err)
+		return
+	}
+
+	resp.WriteHeader(http.StatusNoContent)
+}
+
+func errorCode(err error) int {
+	if errors.Cause(err) == msgprocessor.ErrChannelDoesNotExist {
+		return http.StatusNotFound
+	}
+	return http.StatusBadRequest
+}
+
+func (h *Handler) sendResponse(resp http.ResponseWriter, code int, payload interface{}) {
+	encoder := json.NewEncoder(resp)
+	if err, ok := payload.(error); ok {
+		payload = &ErrorResponse{Error: err.Error()}
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(code)
+
+	if err := encoder.Encode(payload); err != nil {
+		h.Logger.Errorw("failed to encode payload", "error", err)
+	}
+}
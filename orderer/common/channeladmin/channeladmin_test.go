@@ -0,0 +1,243 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package channeladmin_test
+
+import (
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/hyperledger/fabric/orderer/common/channeladmin"
+	"github.com/hyperledger/fabric/orderer/common/channeladmin/fakes"
+	"github.com/hyperledger/fabric/orderer/common/msgprocessor"
+	"github.com/hyperledger/fabric/orderer/common/multichannel"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		fakeRegistrar *fakes.Registrar
+		handler       *channeladmin.Handler
+		adminCert     *x509.Certificate
+	)
+
+	BeforeEach(func() {
+		fakeRegistrar = &fakes.Registrar{}
+		adminCert = &x509.Certificate{Raw: []byte("admin")}
+		handler = &channeladmin.Handler{
+			Registrar:           fakeRegistrar,
+			DecommissionEnabled: true,
+			CheckAdmin: func(cert *x509.Certificate) error {
+				if cert != adminCert {
+					return errors.New("not an admin")
+				}
+				return nil
+			},
+		}
+	})
+
+	// newDeleteRequest returns a DELETE request authenticated with the
+	// certificate of an admin
+	newDeleteRequest := func(target string) *http.Request {
+		req := httptest.NewRequest("DELETE", "https://localhost"+target, nil)
+		req.TLS.VerifiedChains = [][]*x509.Certificate{{adminCert}}
+		return req
+	}
+
+	Describe("GET", func() {
+		BeforeEach(func() {
+			fakeRegistrar.ChannelStatusReturns(&multichannel.ChannelStatus{
+				ChannelID:             "mychannel",
+				Height:                12,
+				LastConfigBlockNumber: 9,
+				LastConfigSequence:    3,
+				ConsensusType:         "etcdraft",
+				Leader:                2,
+			}, nil)
+		})
+
+		It("returns the status of the channel", func() {
+			req := httptest.NewRequest("GET", "/channels/mychannel", nil)
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			Expect(fakeRegistrar.ChannelStatusCallCount()).To(Equal(1))
+			Expect(fakeRegistrar.ChannelStatusArgsForCall(0)).To(Equal("mychannel"))
+			Expect(resp.Header().Get("Content-Type")).To(Equal("application/json"))
+			Expect(resp.Body).To(MatchJSON(`{
+				"channelID": "mychannel",
+				"height": 12,
+				"lastConfigBlockNumber": 9,
+				"lastConfigSequence": 3,
+				"consensusType": "etcdraft",
+				"leader": 2
+			}`))
+		})
+
+		Context("when the channel does not exist", func() {
+			BeforeEach(func() {
+				fakeRegistrar.ChannelStatusReturns(nil, errors.Wrap(msgprocessor.ErrChannelDoesNotExist, "cannot get status of channel mychannel"))
+			})
+
+			It("responds with not found", func() {
+				req := httptest.NewRequest("GET", "/channels/mychannel", nil)
+				resp := httptest.NewRecorder()
+				handler.ServeHTTP(resp, req)
+
+				Expect(resp.Code).To(Equal(http.StatusNotFound))
+				Expect(resp.Body).To(MatchJSON(`{"error": "cannot get status of channel mychannel: channel does not exist"}`))
+			})
+		})
+	})
+
+	It("decommissions the channel", func() {
+		req := newDeleteRequest("/channels/mychannel")
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+
+		Expect(resp.Code).To(Equal(http.StatusNoContent))
+		Expect(fakeRegistrar.DecommissionChannelCallCount()).To(Equal(1))
+		channelID, removeLedger, retention := fakeRegistrar.DecommissionChannelArgsForCall(0)
+		Expect(channelID).To(Equal("mychannel"))
+		Expect(removeLedger).To(BeFalse())
+		Expect(retention).To(BeZero())
+	})
+
+	It("passes the ledger removal and retention", func() {
+		req := newDeleteRequest("/channels/mychannel?removeLedger=true&retention=36h")
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+
+		Expect(resp.Code).To(Equal(http.StatusNoContent))
+		Expect(fakeRegistrar.DecommissionChannelCallCount()).To(Equal(1))
+		channelID, removeLedger, retention := fakeRegistrar.DecommissionChannelArgsForCall(0)
+		Expect(channelID).To(Equal("mychannel"))
+		Expect(removeLedger).To(BeTrue())
+		Expect(retention).To(Equal(36 * time.Hour))
+	})
+
+	Context("when decommissioning channels is disabled", func() {
+		BeforeEach(func() {
+			handler.DecommissionEnabled = false
+		})
+
+		It("responds with forbidden", func() {
+			req := newDeleteRequest("/channels/mychannel")
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(fakeRegistrar.DecommissionChannelCallCount()).To(Equal(0))
+			Expect(resp.Code).To(Equal(http.StatusForbidden))
+			Expect(resp.Body).To(MatchJSON(`{"error": "decommissioning channels is disabled"}`))
+		})
+	})
+
+	Context("when the client does not present a certificate", func() {
+		It("responds with unauthorized", func() {
+			req := httptest.NewRequest("DELETE", "/channels/mychannel", nil)
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(fakeRegistrar.DecommissionChannelCallCount()).To(Equal(0))
+			Expect(resp.Code).To(Equal(http.StatusUnauthorized))
+		})
+	})
+
+	Context("when the client is not an admin", func() {
+		It("responds with forbidden", func() {
+			req := newDeleteRequest("/channels/mychannel")
+			req.TLS.VerifiedChains = [][]*x509.Certificate{{{Raw: []byte("client")}}}
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(fakeRegistrar.DecommissionChannelCallCount()).To(Equal(0))
+			Expect(resp.Code).To(Equal(http.StatusForbidden))
+		})
+	})
+
+	Context("when removeLedger is malformed", func() {
+		It("responds with an error payload", func() {
+			req := newDeleteRequest("/channels/mychannel?removeLedger=maybe")
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(fakeRegistrar.DecommissionChannelCallCount()).To(Equal(0))
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+			Expect(resp.Body).To(MatchJSON(`{"error": "invalid removeLedger: strconv.ParseBool: parsing \"maybe\": invalid syntax"}`))
+		})
+	})
+
+	Context("when the retention is malformed", func() {
+		It("responds with an error payload", func() {
+			req := newDeleteRequest("/channels/mychannel?removeLedger=true&retention=-1h")
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(fakeRegistrar.DecommissionChannelCallCount()).To(Equal(0))
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+			Expect(resp.Body).To(MatchJSON(`{"error": "invalid retention: -1h"}`))
+		})
+	})
+
+	Context("when the channel does not exist", func() {
+		BeforeEach(func() {
+			fakeRegistrar.DecommissionChannelReturns(errors.Wrap(msgprocessor.ErrChannelDoesNotExist, "cannot decommission channel mychannel"))
+		})
+
+		It("responds with not found", func() {
+			req := newDeleteRequest("/channels/mychannel")
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusNotFound))
+			Expect(resp.Body).To(MatchJSON(`{"error": "cannot decommission channel mychannel: channel does not exist"}`))
+		})
+	})
+
+	Context("when decommissioning fails", func() {
+		BeforeEach(func() {
+			fakeRegistrar.DecommissionChannelReturns(errors.New("cannot decommission system channel"))
+		})
+
+		It("responds with an error payload", func() {
+			req := newDeleteRequest("/channels/mychannel")
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+			Expect(resp.Body).To(MatchJSON(`{"error": "cannot decommission system channel"}`))
+			Expect(resp.Header().Get("Content-Type")).To(Equal("application/json"))
+		})
+	})
+
+	Context("when the path does not name a channel", func() {
+		It("responds with not found", func() {
+			req := newDeleteRequest("/channels/")
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(fakeRegistrar.DecommissionChannelCallCount()).To(Equal(0))
+			Expect(resp.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Context("when an unsupported method is used", func() {
+		It("responds with an error payload", func() {
+			req := httptest.NewRequest("POST", "/channels/mychannel", nil)
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+
+			Expect(fakeRegistrar.DecommissionChannelCallCount()).To(Equal(0))
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+			Expect(resp.Body).To(MatchJSON(`{"error": "invalid request method: POST"}`))
+		})
+	})
+})
@@ -40,21 +40,33 @@ type TopLevel struct {
 
 // General contains config which should be common among all orderer types.
 type General struct {
-	LedgerType     string
-	ListenAddress  string
-	ListenPort     uint16
-	TLS            TLS
-	Cluster        Cluster
-	Keepalive      Keepalive
-	GenesisMethod  string
-	GenesisProfile string
-	SystemChannel  string
-	GenesisFile    string
-	Profile        Profile
-	LocalMSPDir    string
-	LocalMSPID     string
-	BCCSP          *bccsp.FactoryOpts
-	Authentication Authentication
+	LedgerType             string
+	ListenAddress          string
+	ListenPort             uint16
+	TLS                    TLS
+	Cluster                Cluster
+	Keepalive              Keepalive
+	GenesisMethod          string
+	GenesisProfile         string
+	SystemChannel          string
+	GenesisFile            string
+	Profile                Profile
+	LocalMSPDir            string
+	LocalMSPID             string
+	LocalMSPReloadInterval time.Duration
+	TLSReloadInterval      time.Duration
+	BCCSP                  *bccsp.FactoryOpts
+	Authentication         Authentication
+	ChannelQuotas          ChannelQuotas
+	CertExpiration         CertExpiration
+}
+
+// CertExpiration contains configuration for monitoring the expiration of the
+// certificates of the local MSP, the TLS certificates and the certificates of
+// the MSPs of the channels.
+type CertExpiration struct {
+	ScanInterval      time.Duration
+	WarningThresholds []time.Duration
 }
 
 type Cluster struct {
@@ -77,9 +89,13 @@ type Cluster struct {
 
 // Keepalive contains configuration for gRPC servers.
 type Keepalive struct {
-	ServerMinInterval time.Duration
-	ServerInterval    time.Duration
-	ServerTimeout     time.Duration
+	ServerMinInterval     time.Duration
+	ServerInterval        time.Duration
+	ServerTimeout         time.Duration
+	MaxConnectionIdle     time.Duration
+	MaxConnectionAge      time.Duration
+	MaxConnectionAgeGrace time.Duration
+	MaxConcurrentStreams  uint32
 }
 
 // TLS contains configuration for TLS connections.
@@ -105,6 +121,13 @@ type Authentication struct {
 	TimeWindow time.Duration
 }
 
+// ChannelQuotas contains configuration for the resources each channel
+// may consume on the ordering service. A value of 0 disables a limit.
+type ChannelQuotas struct {
+	MaxPendingMessages int
+	MaxBytesPerSecond  int
+}
+
 // Profile contains configuration for Go pprof profiling.
 type Profile struct {
 	Enabled bool
@@ -113,8 +136,16 @@ type Profile struct {
 
 // FileLedger contains configuration for the file-based ledger.
 type FileLedger struct {
-	Location string
-	Prefix   string
+	Location     string
+	Prefix       string
+	StartupCheck StartupCheck
+}
+
+// StartupCheck contains configuration for the consistency check
+// of the file ledgers performed when the orderer starts.
+type StartupCheck struct {
+	Enabled bool
+	DryRun  bool
 }
 
 // RAMLedger contains configuration for the RAM ledger.
@@ -188,14 +219,49 @@ type Debug struct {
 
 // Operations configures the operations endpont for the orderer.
 type Operations struct {
-	ListenAddress string
-	TLS           TLS
+	ListenAddress       string
+	TLS                 TLS
+	Profile             OperationsProfile
+	Tracing             Tracing
+	ChannelDecommission ChannelDecommission
+}
+
+// ChannelDecommission configures the decommission of channels through the
+// operations service.
+type ChannelDecommission struct {
+	Enabled bool
+}
+
+// Tracing configures the export of a span for each gRPC request served by
+// the orderer to an OpenTelemetry collector.
+type Tracing struct {
+	Enabled        bool
+	Endpoint       string
+	WriteInterval  time.Duration
+	Headers        map[string]string
+	MaxQueuedSpans int
+}
+
+// OperationsProfile configures the profiling endpoints of the operations
+// service.
+type OperationsProfile struct {
+	Enabled     bool
+	MaxDuration time.Duration
 }
 
 // Operations confiures the metrics provider for the orderer.
 type Metrics struct {
 	Provider string
 	Statsd   Statsd
+	OTLP     OTLP
+}
+
+// OTLP provides the configuration required to export the metrics of the
+// orderer to an OpenTelemetry collector.
+type OTLP struct {
+	Endpoint      string
+	WriteInterval time.Duration
+	Headers       map[string]string
 }
 
 // Statsd provides the configuration required to emit statsd metrics from the orderer.
@@ -236,6 +302,10 @@ var Defaults = TopLevel{
 		Authentication: Authentication{
 			TimeWindow: time.Duration(15 * time.Minute),
 		},
+		CertExpiration: CertExpiration{
+			ScanInterval:      24 * time.Hour,
+			WarningThresholds: []time.Duration{30 * 24 * time.Hour, 7 * 24 * time.Hour, 24 * time.Hour},
+		},
 	},
 	RAMLedger: RAMLedger{
 		HistorySize: 10000,
@@ -394,6 +464,13 @@ func (c *TopLevel) completeInitialization(configDir string) {
 			logger.Infof("General.Authentication.TimeWindow unset, setting to %s", Defaults.General.Authentication.TimeWindow)
 			c.General.Authentication.TimeWindow = Defaults.General.Authentication.TimeWindow
 
+		case c.General.CertExpiration.ScanInterval <= 0:
+			logger.Infof("General.CertExpiration.ScanInterval unset, setting to %s", Defaults.General.CertExpiration.ScanInterval)
+			c.General.CertExpiration.ScanInterval = Defaults.General.CertExpiration.ScanInterval
+		case c.General.CertExpiration.WarningThresholds == nil:
+			logger.Infof("General.CertExpiration.WarningThresholds unset, setting to %v", Defaults.General.CertExpiration.WarningThresholds)
+			c.General.CertExpiration.WarningThresholds = Defaults.General.CertExpiration.WarningThresholds
+
 		case c.FileLedger.Prefix == "":
 			logger.Infof("FileLedger.Prefix unset, setting to %s", Defaults.FileLedger.Prefix)
 			c.FileLedger.Prefix = Defaults.FileLedger.Prefix
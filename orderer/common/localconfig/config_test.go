@@ -141,6 +141,20 @@ func TestClusterDefaults(t *testing.T) {
 	assert.Equal(t, cfg.General.Cluster.ReplicationMaxRetries, Defaults.General.Cluster.ReplicationMaxRetries)
 }
 
+func TestCertExpiration(t *testing.T) {
+	cleanup := configtest.SetDevFabricConfigPath(t)
+	defer cleanup()
+	cfg, err := Load()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, cfg.General.CertExpiration.ScanInterval)
+	assert.Equal(t, []time.Duration{720 * time.Hour, 168 * time.Hour, 24 * time.Hour}, cfg.General.CertExpiration.WarningThresholds)
+
+	cfg.General.CertExpiration = CertExpiration{}
+	cfg.completeInitialization("/dummy/path")
+	assert.Equal(t, Defaults.General.CertExpiration, cfg.General.CertExpiration)
+}
+
 func TestSystemChannel(t *testing.T) {
 	cleanup := configtest.SetDevFabricConfigPath(t)
 	defer cleanup()
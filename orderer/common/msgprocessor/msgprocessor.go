@@ -31,6 +31,18 @@ var ErrChannelDoesNotExist = errors.New("channel does not exist")
 // which are not permitted due to an authorization failure.
 var ErrPermissionDenied = errors.New("permission denied")
 
+// ErrMaxBytesExceeded is returned by errors which are caused by transactions
+// which are larger than the absolute maximum batch size of the channel.
+var ErrMaxBytesExceeded = errors.New("message too large")
+
+// ErrChannelDecommissioned is returned for transactions which target a channel
+// that has been decommissioned on this orderer.
+var ErrChannelDecommissioned = errors.New("channel has been decommissioned")
+
+// ErrQuotaExceeded is returned for transactions which would exceed the
+// resource quotas of their channel on this orderer.
+var ErrQuotaExceeded = errors.New("channel quota exceeded")
+
 // Classification represents the possible message types for the system.
 type Classification int
 
@@ -7,10 +7,10 @@ SPDX-License-Identifier: Apache-2.0
 package msgprocessor
 
 import (
-	"fmt"
-
+	"github.com/golang/protobuf/proto"
 	cb "github.com/hyperledger/fabric/protos/common"
 	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/pkg/errors"
 )
 
 // Support defines the subset of the channel support required to create this filter
@@ -29,16 +29,17 @@ type MaxBytesRule struct {
 }
 
 // Apply returns an error if the message exceeds the configured absolute max batch size.
+// The returned error has ErrMaxBytesExceeded as its cause.
 func (r *MaxBytesRule) Apply(message *cb.Envelope) error {
 	maxBytes := r.support.BatchSize().AbsoluteMaxBytes
 	if size := messageByteSize(message); size > maxBytes {
-		return fmt.Errorf("message payload is %d bytes and exceeds maximum allowed %d bytes", size, maxBytes)
+		return errors.Wrapf(errors.WithStack(ErrMaxBytesExceeded), "message payload is %d bytes and exceeds maximum allowed %d bytes", size, maxBytes)
 	}
 	return nil
 }
 
+// messageByteSize returns the exact marshaled size of the message, so that
+// a message which is accepted here can never push a batch over the limit.
 func messageByteSize(message *cb.Envelope) uint32 {
-	// XXX this is good approximation, but is going to be a few bytes short, because of the field specifiers in the proto marshaling
-	// this should probably be padded to determine the true exact marshaled size
-	return uint32(len(message.Payload) + len(message.Signature))
+	return uint32(proto.Size(message))
 }
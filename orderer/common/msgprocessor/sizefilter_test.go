@@ -7,12 +7,14 @@ SPDX-License-Identifier: Apache-2.0
 package msgprocessor
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
 	mockconfig "github.com/hyperledger/fabric/common/mocks/config"
 	cb "github.com/hyperledger/fabric/protos/common"
 	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -28,7 +30,9 @@ func TestMaxBytesRule(t *testing.T) {
 		assert.Nil(t, msf.Apply(makeMessage(make([]byte, dataSize))))
 	})
 	t.Run("TooBig", func(t *testing.T) {
-		assert.NotNil(t, msf.Apply(makeMessage(make([]byte, dataSize+1))))
+		err := msf.Apply(makeMessage(make([]byte, dataSize+1)))
+		assert.EqualError(t, err, fmt.Sprintf("message payload is %d bytes and exceeds maximum allowed %d bytes: message too large", maxBytes+1, maxBytes))
+		assert.Equal(t, ErrMaxBytesExceeded, errors.Cause(err))
 	})
 }
 
@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package multichannel
 
 import (
+	"bytes"
 	"sync"
 
 	"github.com/golang/protobuf/proto"
@@ -16,9 +17,15 @@ import (
 	"github.com/hyperledger/fabric/common/ledger/blockledger"
 	"github.com/hyperledger/fabric/common/util"
 	cb "github.com/hyperledger/fabric/protos/common"
+	ab "github.com/hyperledger/fabric/protos/orderer"
 	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
 )
 
+// Note, the block signature value is intentionally nil, as this metadata is only about the signature, there is no
+// additional metadata information required beyond the fact that the metadata item is signed.
+var blockSignatureValue = []byte(nil)
+
 type blockWriterSupport interface {
 	crypto.LocalSigner
 	blockledger.ReadWriter
@@ -85,6 +92,14 @@ func (bw *BlockWriter) CreateNextBlock(messages []*cb.Envelope) *cb.Block {
 	return block
 }
 
+// LastConfig returns the number of the last config block written by the block writer,
+// and the config sequence it carries. It waits for the block being committed, if any.
+func (bw *BlockWriter) LastConfig() (blockNumber uint64, sequence uint64) {
+	bw.committingBlock.Lock()
+	defer bw.committingBlock.Unlock()
+	return bw.lastConfigBlockNum, bw.lastConfigSeq
+}
+
 // WriteConfigBlock should be invoked for blocks which contain a config transaction.
 // This call will block until the new config has taken effect, then will return
 // while the block is written asynchronously to disk.
@@ -137,6 +152,20 @@ func (bw *BlockWriter) WriteConfigBlock(block *cb.Block, encodedMetadataValue []
 		bw.committingBlock.Lock()
 		bw.committingBlock.Unlock()
 		bw.support.Update(bundle)
+
+		if decommissions(configEnvelope.Config) {
+			bw.WriteBlock(block, encodedMetadataValue)
+			// The chain is halted by another goroutine, as halting it waits for the
+			// goroutine of the chain, which writes this block, to exit
+			go func() {
+				bw.committingBlock.Lock()
+				bw.committingBlock.Unlock()
+				if err := bw.registrar.DecommissionChannel(chdr.ChannelId, false, 0); err != nil {
+					logger.Errorf("Failed decommissioning channel %s: %s", chdr.ChannelId, err)
+				}
+			}()
+			return
+		}
 	default:
 		logger.Panicf("Told to write a config block with unknown header type: %v", chdr.Type)
 	}
@@ -177,25 +206,38 @@ func (bw *BlockWriter) commitBlock(encodedMetadataValue []byte) {
 	logger.Debugf("[channel: %s] Wrote block %d", bw.support.ChainID(), bw.lastBlock.GetHeader().Number)
 }
 
-func (bw *BlockWriter) addBlockSignature(block *cb.Block) {
-	blockSignature := &cb.MetadataSignature{
-		SignatureHeader: protoutil.MarshalOrPanic(protoutil.NewSignatureHeaderOrPanic(bw.support)),
+// SignBlock returns the signature of this orderer over the header of the given block.
+// The signature is identical in form to the one attached to the block when it is written,
+// so it can be verified by other orderers before the block is committed.
+func (bw *BlockWriter) SignBlock(block *cb.Block) (*cb.MetadataSignature, error) {
+	signatureHeader, err := bw.support.NewSignatureHeader()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed creating signature header")
 	}
 
-	// Note, this value is intentionally nil, as this metadata is only about the signature, there is no additional metadata
-	// information required beyond the fact that the metadata item is signed.
-	blockSignatureValue := []byte(nil)
+	blockSignature := &cb.MetadataSignature{
+		SignatureHeader: protoutil.MarshalOrPanic(signatureHeader),
+	}
 
-	blockSignature.Signature = protoutil.SignOrPanic(
-		bw.support,
+	blockSignature.Signature, err = bw.support.Sign(
 		util.ConcatenateBytes(blockSignatureValue, blockSignature.SignatureHeader, protoutil.BlockHeaderBytes(block.Header)),
 	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed signing block %d", block.Header.Number)
+	}
+
+	return blockSignature, nil
+}
+
+func (bw *BlockWriter) addBlockSignature(block *cb.Block) {
+	blockSignature, err := bw.SignBlock(block)
+	if err != nil {
+		logger.Panicf("[channel: %s] %s", bw.support.ChainID(), err)
+	}
 
 	block.Metadata.Metadata[cb.BlockMetadataIndex_SIGNATURES] = protoutil.MarshalOrPanic(&cb.Metadata{
-		Value: blockSignatureValue,
-		Signatures: []*cb.MetadataSignature{
-			blockSignature,
-		},
+		Value:      blockSignatureValue,
+		Signatures: bw.aggregateSignatures(block, cb.BlockMetadataIndex_SIGNATURES, blockSignatureValue, blockSignature),
 	})
 }
 
@@ -220,9 +262,57 @@ func (bw *BlockWriter) addLastConfigSignature(block *cb.Block) {
 	)
 
 	block.Metadata.Metadata[cb.BlockMetadataIndex_LAST_CONFIG] = protoutil.MarshalOrPanic(&cb.Metadata{
-		Value: lastConfigValue,
-		Signatures: []*cb.MetadataSignature{
-			lastConfigSignature,
-		},
+		Value:      lastConfigValue,
+		Signatures: bw.aggregateSignatures(block, cb.BlockMetadataIndex_LAST_CONFIG, lastConfigValue, lastConfigSignature),
 	})
 }
+
+// aggregateSignatures returns the signatures of other orderers found in the given metadata
+// of the block, followed by the signature of this orderer. This allows consenters to gather
+// the signatures of several orderers over a block before it is written and disseminated.
+// Signatures over a different metadata value than the one being written, as well as previous
+// signatures of this orderer, are discarded.
+func (bw *BlockWriter) aggregateSignatures(block *cb.Block, index cb.BlockMetadataIndex, value []byte, signature *cb.MetadataSignature) []*cb.MetadataSignature {
+	if len(block.Metadata.Metadata) <= int(index) || len(block.Metadata.Metadata[index]) == 0 {
+		return []*cb.MetadataSignature{signature}
+	}
+
+	metadata, err := protoutil.GetMetadataFromBlock(block, index)
+	if err != nil {
+		logger.Warningf("[channel: %s] Discarding signatures of block %d: %s", bw.support.ChainID(), block.Header.Number, err)
+		return []*cb.MetadataSignature{signature}
+	}
+	if !bytes.Equal(metadata.Value, value) {
+		logger.Warningf("[channel: %s] Discarding %d signatures of block %d over a different metadata value", bw.support.ChainID(), len(metadata.Signatures), block.Header.Number)
+		return []*cb.MetadataSignature{signature}
+	}
+
+	own, err := protoutil.GetSignatureHeader(signature.SignatureHeader)
+	if err != nil {
+		logger.Panicf("[channel: %s] %s", bw.support.ChainID(), err)
+	}
+
+	var signatures []*cb.MetadataSignature
+	for _, collected := range metadata.Signatures {
+		shdr, err := protoutil.GetSignatureHeader(collected.SignatureHeader)
+		if err != nil {
+			logger.Warningf("[channel: %s] Discarding a malformed signature of block %d: %s", bw.support.ChainID(), block.Header.Number, err)
+			continue
+		}
+		if bytes.Equal(shdr.Creator, own.Creator) {
+			continue
+		}
+		signatures = append(signatures, collected)
+	}
+	return append(signatures, signature)
+}
+
+// decommissions returns whether the given config decommissions the channel
+func decommissions(config *cb.Config) bool {
+	value, ok := config.GetChannelGroup().GetGroups()[newchannelconfig.OrdererGroupKey].GetValues()[newchannelconfig.DecommissionKey]
+	if !ok {
+		return false
+	}
+	d := &ab.Decommission{}
+	return proto.Unmarshal(value.Value, d) == nil && d.Decommissioned
+}
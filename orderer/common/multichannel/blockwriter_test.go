@@ -8,17 +8,24 @@ package multichannel
 
 import (
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/proto"
 	newchannelconfig "github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/common/crypto"
 	"github.com/hyperledger/fabric/common/ledger/blockledger"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
 	mockconfigtx "github.com/hyperledger/fabric/common/mocks/configtx"
 	"github.com/hyperledger/fabric/common/tools/configtxgen/configtxgentest"
 	"github.com/hyperledger/fabric/common/tools/configtxgen/encoder"
 	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/orderer/consensus"
 	cb "github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protoutil"
+	"github.com/onsi/gomega"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type mockBlockWriterSupport struct {
@@ -64,6 +71,81 @@ func TestBlockSignature(t *testing.T) {
 	assert.NotNil(t, md.Signatures, "Should have signature")
 }
 
+func TestSignBlock(t *testing.T) {
+	bw := &BlockWriter{
+		support: &mockBlockWriterSupport{
+			LocalSigner: mockCrypto(),
+		},
+	}
+
+	block := protoutil.NewBlock(7, []byte("foo"))
+	signature, err := bw.SignBlock(block)
+	assert.NoError(t, err)
+
+	shdr, err := protoutil.GetSignatureHeader(signature.SignatureHeader)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("IdentityBytes"), shdr.Creator)
+	// The mock signer returns the signed bytes as the signature
+	assert.Equal(t, util.ConcatenateBytes(signature.SignatureHeader, protoutil.BlockHeaderBytes(block.Header)), signature.Signature)
+
+	// The signature written into the block is the one SignBlock produces
+	bw.addBlockSignature(block)
+	md := protoutil.GetMetadataFromBlockOrPanic(block, cb.BlockMetadataIndex_SIGNATURES)
+	assert.Equal(t, []*cb.MetadataSignature{signature}, md.Signatures)
+}
+
+func TestAggregateBlockSignatures(t *testing.T) {
+	bw := &BlockWriter{
+		support: &mockBlockWriterSupport{
+			LocalSigner: mockCrypto(),
+			Validator:   &mockconfigtx.Validator{},
+		},
+	}
+
+	remoteSignature := func(creator string) *cb.MetadataSignature {
+		return &cb.MetadataSignature{
+			SignatureHeader: protoutil.MarshalOrPanic(&cb.SignatureHeader{Creator: []byte(creator)}),
+			Signature:       []byte(creator + "-signature"),
+		}
+	}
+
+	block := protoutil.NewBlock(7, []byte("foo"))
+	ownSignature, err := bw.SignBlock(block)
+	assert.NoError(t, err)
+
+	// Signatures gathered from other orderers are kept, and a stale
+	// signature of this orderer is replaced by a fresh one
+	collected := []*cb.MetadataSignature{remoteSignature("orderer2"), remoteSignature("IdentityBytes"), remoteSignature("orderer3")}
+	block.Metadata.Metadata[cb.BlockMetadataIndex_SIGNATURES] = protoutil.MarshalOrPanic(&cb.Metadata{
+		Signatures: collected,
+	})
+	bw.addBlockSignature(block)
+	md := protoutil.GetMetadataFromBlockOrPanic(block, cb.BlockMetadataIndex_SIGNATURES)
+	assert.Len(t, md.Signatures, 3)
+	for i, expected := range []*cb.MetadataSignature{collected[0], collected[2], ownSignature} {
+		assert.True(t, proto.Equal(expected, md.Signatures[i]))
+	}
+
+	// Signatures over another last config index are discarded
+	block.Metadata.Metadata[cb.BlockMetadataIndex_LAST_CONFIG] = protoutil.MarshalOrPanic(&cb.Metadata{
+		Value:      protoutil.MarshalOrPanic(&cb.LastConfig{Index: 3}),
+		Signatures: []*cb.MetadataSignature{remoteSignature("orderer2")},
+	})
+	bw.addLastConfigSignature(block)
+	md = protoutil.GetMetadataFromBlockOrPanic(block, cb.BlockMetadataIndex_LAST_CONFIG)
+	assert.Len(t, md.Signatures, 1)
+
+	// Signatures over the same last config index are kept
+	block.Metadata.Metadata[cb.BlockMetadataIndex_LAST_CONFIG] = protoutil.MarshalOrPanic(&cb.Metadata{
+		Value:      protoutil.MarshalOrPanic(&cb.LastConfig{Index: 0}),
+		Signatures: []*cb.MetadataSignature{remoteSignature("orderer2")},
+	})
+	bw.addLastConfigSignature(block)
+	md = protoutil.GetMetadataFromBlockOrPanic(block, cb.BlockMetadataIndex_LAST_CONFIG)
+	assert.Len(t, md.Signatures, 2)
+	assert.True(t, proto.Equal(remoteSignature("orderer2"), md.Signatures[0]))
+}
+
 func TestBlockLastConfig(t *testing.T) {
 	lastConfigSeq := uint64(6)
 	newConfigSeq := lastConfigSeq + 1
@@ -85,6 +167,10 @@ func TestBlockLastConfig(t *testing.T) {
 	assert.Equal(t, newBlockNum, bw.lastConfigBlockNum)
 	assert.Equal(t, newConfigSeq, bw.lastConfigSeq)
 
+	blockNum, seq := bw.LastConfig()
+	assert.Equal(t, newBlockNum, blockNum)
+	assert.Equal(t, newConfigSeq, seq)
+
 	md := protoutil.GetMetadataFromBlockOrPanic(block, cb.BlockMetadataIndex_LAST_CONFIG)
 	assert.NotNil(t, md.Value, "Value not be empty in this case")
 	assert.NotNil(t, md.Signatures, "Should have signature")
@@ -189,6 +275,50 @@ func TestGoodWriteConfig(t *testing.T) {
 	assert.Equal(t, consenterMetadata, omd.Value)
 }
 
+func TestWriteDecommissionConfig(t *testing.T) {
+	confSys := configtxgentest.Load(genesisconfig.SampleInsecureSoloProfile)
+	genesisBlockSys := encoder.New(confSys).GenesisBlock()
+	confStd := configtxgentest.Load(genesisconfig.SampleInsecureSoloProfile)
+	confStd.Consortiums = nil
+	genesisBlockStd := encoder.New(confStd).GenesisBlockForChannel("mychannel")
+
+	lf, _ := newRAMLedgerAndFactory(10, genesisconfig.TestChainID, genesisBlockSys)
+	l, err := lf.GetOrCreate("mychannel")
+	require.NoError(t, err)
+	require.NoError(t, l.Append(genesisBlockStd))
+	manager := NewRegistrar(lf, mockCrypto(), &disabled.Provider{})
+	manager.Initialize(map[string]consensus.Consenter{confSys.Orderer.OrdererType: &mockConsenter{}})
+	require.NotNil(t, manager.GetChain("mychannel"))
+
+	bw := &BlockWriter{
+		support: &mockBlockWriterSupport{
+			LocalSigner: mockCrypto(),
+			ReadWriter:  l,
+			Validator:   &mockconfigtx.Validator{},
+		},
+		registrar: manager,
+	}
+
+	group := protoutil.NewConfigGroup()
+	group.Groups[newchannelconfig.OrdererGroupKey] = protoutil.NewConfigGroup()
+	group.Groups[newchannelconfig.OrdererGroupKey].Values[newchannelconfig.DecommissionKey] = &cb.ConfigValue{
+		Value: protoutil.MarshalOrPanic(newchannelconfig.DecommissionValue().Value()),
+	}
+	ctx := makeConfigTxFromConfigUpdateEnvelope("mychannel", &cb.ConfigUpdateEnvelope{
+		ConfigUpdate: protoutil.MarshalOrPanic(&cb.ConfigUpdate{WriteSet: group}),
+	})
+	block := protoutil.NewBlock(1, protoutil.BlockHeaderHash(genesisBlockStd.Header))
+	block.Data.Data = [][]byte{protoutil.MarshalOrPanic(ctx)}
+	bw.WriteConfigBlock(block, nil)
+
+	// The channel is decommissioned once the config block is written
+	gt := gomega.NewGomegaWithT(t)
+	gt.Eventually(func() bool { return manager.IsDecommissioned("mychannel") }, time.Minute).Should(gomega.BeTrue())
+	assert.Nil(t, manager.GetChain("mychannel"))
+	cBlock := blockledger.GetBlock(l, block.Header.Number)
+	assert.Equal(t, block.Header, cBlock.Header)
+}
+
 func TestRaceWriteConfig(t *testing.T) {
 	confSys := configtxgentest.Load(genesisconfig.SampleInsecureSoloProfile)
 	genesisBlockSys := encoder.New(confSys).GenesisBlock()
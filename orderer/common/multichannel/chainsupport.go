@@ -27,6 +27,7 @@ type ChainSupport struct {
 	*BlockWriter
 	consensus.Chain
 	cutter blockcutter.Receiver
+	quota  *channelQuota
 	crypto.LocalSigner
 	// Needed for consensus-type migration: to execute the migration state machine correctly,
 	// chains need to know if they are system or standard channel.
@@ -53,6 +54,7 @@ func newChainSupport(
 	cs := &ChainSupport{
 		ledgerResources: ledgerResources,
 		LocalSigner:     signer,
+		quota:           newChannelQuota(registrar.quotas),
 		cutter: blockcutter.NewReceiverImpl(
 			ledgerResources.ConfigtxValidator().ChainID(),
 			ledgerResources,
@@ -163,6 +165,26 @@ func (cs *ChainSupport) start() {
 	cs.Chain.Start()
 }
 
+// Order passes the message through to the underlying consensus.Chain,
+// unless the channel has reached its quota of pending messages.
+func (cs *ChainSupport) Order(env *cb.Envelope, configSeq uint64) error {
+	if !cs.quota.acquirePending() {
+		return errors.Wrapf(msgprocessor.ErrQuotaExceeded, "channel %s has too many pending messages", cs.ChainID())
+	}
+	defer cs.quota.releasePending()
+	return cs.Chain.Order(env, configSeq)
+}
+
+// Configure passes the config message through to the underlying consensus.Chain,
+// unless the channel has reached its quota of pending messages.
+func (cs *ChainSupport) Configure(config *cb.Envelope, configSeq uint64) error {
+	if !cs.quota.acquirePending() {
+		return errors.Wrapf(msgprocessor.ErrQuotaExceeded, "channel %s has too many pending messages", cs.ChainID())
+	}
+	defer cs.quota.releasePending()
+	return cs.Chain.Configure(config, configSeq)
+}
+
 // BlockCutter returns the blockcutter.Receiver instance for this channel.
 func (cs *ChainSupport) BlockCutter() blockcutter.Receiver {
 	return cs.cutter
@@ -189,6 +211,10 @@ func (cs *ChainSupport) ProposeConfigUpdate(configtx *cb.Envelope) (*cb.ConfigEn
 		return nil, errors.Wrap(err, "config update is not compatible")
 	}
 
+	if errs := channelconfig.ValidateConfigUpdate(cs.ConfigtxValidator().ConfigProto(), env.Config); errs != nil {
+		return nil, errors.Wrap(errs, "config update is not valid")
+	}
+
 	return env, cs.ValidateNew(bundle)
 }
 
@@ -234,6 +260,39 @@ func (cs *ChainSupport) VerifyBlockSignature(sd []*protoutil.SignedData, envelop
 	return nil
 }
 
+// SignMessage signs the given intra-cluster message with the local signer of the orderer.
+func (cs *ChainSupport) SignMessage(msg []byte) (*protoutil.SignedData, error) {
+	signatureHeader, err := cs.NewSignatureHeader()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed creating signature header")
+	}
+	signature, err := cs.Sign(msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed signing message")
+	}
+	return &protoutil.SignedData{
+		Data:      msg,
+		Identity:  signatureHeader.Creator,
+		Signature: signature,
+	}, nil
+}
+
+// VerifyMessage verifies that an intra-cluster message was signed by an identity
+// which satisfies the cluster message validation policy of the channel.
+// Unlike the orderer writers policy, which is satisfied by any client of the
+// orderer organizations, this policy is meant to be satisfied only by the ordering
+// service nodes, hence channels lacking it cannot authenticate intra-cluster messages.
+func (cs *ChainSupport) VerifyMessage(sd *protoutil.SignedData) error {
+	policy, exists := cs.PolicyManager().GetPolicy(policies.ClusterMessageValidation)
+	if !exists {
+		return errors.Errorf("policy %s wasn't found", policies.ClusterMessageValidation)
+	}
+	if err := policy.Evaluate([]*protoutil.SignedData{sd}); err != nil {
+		return errors.Wrap(err, "message verification failed")
+	}
+	return nil
+}
+
 // IsSystemChannel returns true if this is the system channel.
 func (cs *ChainSupport) IsSystemChannel() bool {
 	return cs.systemChannel
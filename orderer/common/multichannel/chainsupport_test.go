@@ -96,6 +96,79 @@ func TestVerifyBlockSignature(t *testing.T) {
 
 }
 
+func TestSignAndVerifyMessage(t *testing.T) {
+	policyMgr := &mockpolicies.Manager{
+		PolicyMap: make(map[string]policies.Policy),
+	}
+	cs := &ChainSupport{
+		LocalSigner: mockCrypto(),
+		ledgerResources: &ledgerResources{
+			configResources: &configResources{
+				mutableResources: &mutableResourcesMock{
+					Resources: config.Resources{
+						ConfigtxValidatorVal: &configtx.Validator{ChainIDVal: "mychannel"},
+						PolicyManagerVal:     policyMgr,
+					},
+				},
+			},
+		},
+	}
+
+	sd, err := cs.SignMessage([]byte("message"))
+	assert.NoError(t, err)
+	assert.Equal(t, &protoutil.SignedData{
+		Data:      []byte("message"),
+		Identity:  []byte("IdentityBytes"),
+		Signature: []byte("message"),
+	}, sd)
+
+	// Scenario I: The cluster message validation policy cannot be found
+	err = cs.VerifyMessage(sd)
+	assert.EqualError(t, err, "policy /Channel/Orderer/ClusterMessageValidation wasn't found")
+
+	// Scenario II: The message isn't signed by an orderer
+	policyMgr.PolicyMap["/Channel/Orderer/ClusterMessageValidation"] = &mockpolicies.Policy{
+		Err: errors.New("identity is not an orderer"),
+	}
+	err = cs.VerifyMessage(sd)
+	assert.EqualError(t, err, "message verification failed: identity is not an orderer")
+
+	// Scenario III: The message is signed by an orderer
+	policyMgr.PolicyMap["/Channel/Orderer/ClusterMessageValidation"] = &mockpolicies.Policy{}
+	assert.NoError(t, cs.VerifyMessage(sd))
+}
+
+func TestProposeConfigUpdateInvalid(t *testing.T) {
+	current := testConfigEnvelope(t).Config
+	proposed := testConfigEnvelope(t).Config
+	proposed.ChannelGroup.Groups[channelconfig.OrdererGroupKey].Values[channelconfig.BatchSizeKey].ModPolicy = "Missing"
+
+	validator := &configtx.Validator{
+		ChainIDVal:             "mychannel",
+		ConfigProtoVal:         current,
+		ProposeConfigUpdateVal: &common.ConfigEnvelope{Config: proposed},
+	}
+	cs := &ChainSupport{
+		ledgerResources: &ledgerResources{
+			configResources: &configResources{
+				mutableResources: &mutableResourcesMock{
+					Resources: config.Resources{
+						ConfigtxValidatorVal: validator,
+					},
+				},
+			},
+		},
+	}
+
+	_, err := cs.ProposeConfigUpdate(&common.Envelope{})
+	assert.EqualError(t, err, "config update is not valid: /Channel/Orderer/BatchSize: mod_policy Missing refers to policy /Channel/Orderer/Missing which does not exist")
+
+	// The violations of the current config do not prevent updates
+	validator.ConfigProtoVal = proposed
+	_, err = cs.ProposeConfigUpdate(&common.Envelope{})
+	assert.NoError(t, err)
+}
+
 func testConfigEnvelope(t *testing.T) *common.ConfigEnvelope {
 	config := configtxgentest.Load(localconfig.SampleInsecureSoloProfile)
 	group, err := encoder.NewChannelGroup(config)
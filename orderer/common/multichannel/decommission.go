@@ -0,0 +1,81 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package multichannel
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// decommission records that a channel has been decommissioned.
+type decommission struct {
+	// RemoveLedgerAt is the time at which the ledger of the channel is
+	// removed, or nil if the ledger is retained.
+	RemoveLedgerAt *time.Time `json:"removeLedgerAt,omitempty"`
+}
+
+// decommissionStore persists the decommissioned channels in a file, so that
+// they are neither served again nor kept forever when the orderer restarts.
+// A store without a path keeps nothing, as befits ledgers held in memory.
+type decommissionStore struct {
+	path string
+}
+
+func (s *decommissionStore) load() (map[string]decommission, error) {
+	decommissioned := make(map[string]decommission)
+	if s.path == "" {
+		return decommissioned, nil
+	}
+
+	raw, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return decommissioned, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed reading decommissioned channels from %s", s.path)
+	}
+	if err := json.Unmarshal(raw, &decommissioned); err != nil {
+		return nil, errors.Wrapf(err, "failed parsing decommissioned channels from %s", s.path)
+	}
+	return decommissioned, nil
+}
+
+// save replaces the content of the file with the given channels. The file
+// is replaced by renaming a temporary one, hence it is never left partially
+// written.
+func (s *decommissionStore) save(decommissioned map[string]decommission) error {
+	if s.path == "" {
+		return nil
+	}
+
+	raw, err := json.Marshal(decommissioned)
+	if err != nil {
+		return errors.Wrap(err, "failed marshaling decommissioned channels")
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path))
+	if err != nil {
+		return errors.Wrap(err, "failed creating decommissioned channels file")
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(raw); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "failed writing decommissioned channels")
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "failed writing decommissioned channels")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "failed writing decommissioned channels")
+	}
+	return errors.Wrap(os.Rename(tmp.Name(), s.path), "failed replacing decommissioned channels file")
+}
@@ -0,0 +1,114 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package multichannel
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ChannelQuotas limits the resources each channel may consume on the ordering
+// service, so that a single busy channel cannot degrade the others.
+// A value of 0 disables the respective limit.
+type ChannelQuotas struct {
+	// MaxPendingMessages is the maximum number of messages of a channel
+	// which may be waiting to be accepted by its consenter at the same time.
+	MaxPendingMessages int
+	// MaxBytesPerSecond is the maximum rate at which broadcast messages
+	// are accepted for a channel.
+	MaxBytesPerSecond int
+}
+
+// channelQuota enforces the ChannelQuotas of a single channel.
+// A nil *channelQuota admits everything.
+type channelQuota struct {
+	maxPending int64
+	pending    int64
+
+	bandwidth *bandwidthLimiter
+}
+
+func newChannelQuota(quotas ChannelQuotas) *channelQuota {
+	if quotas.MaxPendingMessages <= 0 && quotas.MaxBytesPerSecond <= 0 {
+		return nil
+	}
+
+	q := &channelQuota{maxPending: int64(quotas.MaxPendingMessages)}
+	if quotas.MaxBytesPerSecond > 0 {
+		q.bandwidth = newBandwidthLimiter(float64(quotas.MaxBytesPerSecond), time.Now)
+	}
+	return q
+}
+
+// admitBytes reports whether a message of the given size fits in the bandwidth quota.
+func (q *channelQuota) admitBytes(size int) bool {
+	if q == nil || q.bandwidth == nil {
+		return true
+	}
+	return q.bandwidth.admit(size)
+}
+
+// acquirePending reserves a pending message slot, and reports whether one was available.
+// Every successful call must be followed by a call to releasePending.
+func (q *channelQuota) acquirePending() bool {
+	if q == nil || q.maxPending <= 0 {
+		return true
+	}
+	if atomic.AddInt64(&q.pending, 1) > q.maxPending {
+		atomic.AddInt64(&q.pending, -1)
+		return false
+	}
+	return true
+}
+
+// releasePending frees a slot reserved by acquirePending.
+func (q *channelQuota) releasePending() {
+	if q == nil || q.maxPending <= 0 {
+		return
+	}
+	atomic.AddInt64(&q.pending, -1)
+}
+
+// bandwidthLimiter is a token bucket holding up to one second worth of bytes.
+// A message is admitted as long as the bucket is not empty, and may put it in
+// debt, so messages larger than the rate are throttled rather than starved.
+type bandwidthLimiter struct {
+	now  func() time.Time
+	rate float64
+
+	mutex  sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newBandwidthLimiter(bytesPerSecond float64, now func() time.Time) *bandwidthLimiter {
+	return &bandwidthLimiter{
+		now:    now,
+		rate:   bytesPerSecond,
+		tokens: bytesPerSecond,
+		last:   now(),
+	}
+}
+
+func (l *bandwidthLimiter) admit(size int) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.rate {
+		l.tokens = l.rate
+	}
+	l.last = now
+
+	if l.tokens <= 0 {
+		return false
+	}
+	l.tokens -= float64(size)
+	return true
+}
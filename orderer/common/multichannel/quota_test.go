@@ -0,0 +1,67 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package multichannel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewChannelQuota(t *testing.T) {
+	assert.Nil(t, newChannelQuota(ChannelQuotas{}))
+
+	q := newChannelQuota(ChannelQuotas{MaxPendingMessages: 1})
+	assert.NotNil(t, q)
+	assert.Nil(t, q.bandwidth)
+
+	q = newChannelQuota(ChannelQuotas{MaxBytesPerSecond: 1})
+	assert.NotNil(t, q)
+	assert.NotNil(t, q.bandwidth)
+}
+
+func TestChannelQuotaUnlimited(t *testing.T) {
+	var q *channelQuota
+	assert.True(t, q.admitBytes(1<<30))
+	for i := 0; i < 10; i++ {
+		assert.True(t, q.acquirePending())
+	}
+	q.releasePending()
+}
+
+func TestChannelQuotaPending(t *testing.T) {
+	q := newChannelQuota(ChannelQuotas{MaxPendingMessages: 2})
+
+	assert.True(t, q.acquirePending())
+	assert.True(t, q.acquirePending())
+	assert.False(t, q.acquirePending())
+
+	q.releasePending()
+	assert.True(t, q.acquirePending())
+	assert.False(t, q.acquirePending())
+}
+
+func TestBandwidthLimiter(t *testing.T) {
+	now := time.Now()
+	l := newBandwidthLimiter(100, func() time.Time { return now })
+
+	// The bucket starts full
+	assert.True(t, l.admit(60))
+	assert.True(t, l.admit(60))
+	// and is now in debt
+	assert.False(t, l.admit(1))
+
+	// Half a second refills 50 bytes, paying the debt
+	now = now.Add(500 * time.Millisecond)
+	assert.True(t, l.admit(1))
+
+	// The bucket never holds more than one second worth of bytes
+	now = now.Add(time.Hour)
+	assert.True(t, l.admit(100))
+	assert.False(t, l.admit(1))
+}
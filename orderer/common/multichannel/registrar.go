@@ -11,7 +11,10 @@ package multichannel
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/common/configtx"
@@ -65,17 +68,47 @@ type mutableResources interface {
 
 type configResources struct {
 	mutableResources
+	bundleMetrics *channelconfig.Metrics
+
+	// updateLock serializes config updates with reloads of the channel MSPs
+	updateLock sync.Mutex
 }
 
 func (cr *configResources) CreateBundle(channelID string, config *cb.Config) (*channelconfig.Bundle, error) {
-	return channelconfig.NewBundle(channelID, config)
+	return channelconfig.NewBundleWithMetrics(channelID, config, cr.bundleMetrics)
 }
 
 func (cr *configResources) Update(bndl *channelconfig.Bundle) {
+	cr.updateLock.Lock()
+	defer cr.updateLock.Unlock()
+
 	checkResourcesOrPanic(bndl)
 	cr.mutableResources.Update(bndl)
 }
 
+// reloadMSPs sets up the channel configuration again from the current config,
+// which replaces the MSPs of the channel with new instances.
+func (cr *configResources) reloadMSPs() error {
+	cr.updateLock.Lock()
+	defer cr.updateLock.Unlock()
+
+	current := cr.ConfigtxValidator()
+	bndl, err := cr.CreateBundle(current.ChainID(), current.ConfigProto())
+	if err != nil {
+		return err
+	}
+
+	if err := cr.ValidateNew(bndl); err != nil {
+		return err
+	}
+	if err := checkResources(bndl); err != nil {
+		return err
+	}
+
+	cr.mutableResources.Update(bndl)
+	return nil
+}
+
 func (cr *configResources) SharedConfig() channelconfig.Orderer {
 	oc, ok := cr.OrdererConfig()
 	if !ok {
@@ -98,10 +131,14 @@ type Registrar struct {
 	ledgerFactory      blockledger.Factory
 	signer             crypto.LocalSigner
 	blockcutterMetrics *blockcutter.Metrics
+	bundleMetrics      *channelconfig.Metrics
 	systemChannelID    string
 	systemChannel      *ChainSupport
 	templator          msgprocessor.ChannelConfigTemplator
 	callbacks          []channelconfig.BundleActor
+	decommissioned     map[string]decommission
+	decommissionStore  decommissionStore
+	quotas             ChannelQuotas
 }
 
 // ConfigBlock retrieves the last configuration block from the given ledger.
@@ -129,24 +166,54 @@ func NewRegistrar(ledgerFactory blockledger.Factory,
 	signer crypto.LocalSigner, metricsProvider metrics.Provider, callbacks ...channelconfig.BundleActor) *Registrar {
 	r := &Registrar{
 		chains:             make(map[string]*ChainSupport),
+		decommissioned:     make(map[string]decommission),
 		ledgerFactory:      ledgerFactory,
 		signer:             signer,
 		blockcutterMetrics: blockcutter.NewMetrics(metricsProvider),
+		bundleMetrics:      channelconfig.NewMetrics(metricsProvider),
 		callbacks:          callbacks,
 	}
 
 	return r
 }
 
+// SetChannelQuotas sets the quotas enforced on each channel.
+// It must be called before Initialize.
+func (r *Registrar) SetChannelQuotas(quotas ChannelQuotas) {
+	r.quotas = quotas
+}
+
+// SetDecommissionFile sets the file in which the decommissioned channels are
+// recorded, so that they remain decommissioned across restarts.
+// It must be called before Initialize.
+func (r *Registrar) SetDecommissionFile(path string) {
+	r.decommissionStore = decommissionStore{path: path}
+}
+
 func (r *Registrar) Initialize(consenters map[string]consensus.Consenter) {
 	r.consenters = consenters
 	existingChains := r.ledgerFactory.ChainIDs()
 
+	decommissioned, err := r.decommissionStore.load()
+	if err != nil {
+		logger.Panicf("Failed loading decommissioned channels: %s", err)
+	}
+	r.decommissioned = decommissioned
+	for channelID, d := range decommissioned {
+		logger.Infof("Not starting decommissioned channel %s", channelID)
+		if d.RemoveLedgerAt != nil {
+			r.scheduleLedgerRemoval(channelID, *d.RemoveLedgerAt)
+		}
+	}
+
 	//TODO To initialize after consensus-type migration, it is necessary to identify the system channel and create it first,
 	// determining the correct consensus-type and the state of the migration. This is needed for recovery, in case the
 	// migration process crashes before it is committed.
 
 	for _, chainID := range existingChains {
+		if _, ok := decommissioned[chainID]; ok {
+			continue
+		}
 		rl, err := r.ledgerFactory.GetOrCreate(chainID)
 		if err != nil {
 			logger.Panicf("Ledger factory reported chainID %s but could not retrieve it: %s", chainID, err)
@@ -191,6 +258,13 @@ func (r *Registrar) Initialize(consenters map[string]consensus.Consenter) {
 			r.systemChannel = chain
 			// We delay starting this chain, as it might try to copy and replace the chains map via newChain before the map is fully built
 			defer chain.start()
+		} else if oc, ok := ledgerResources.OrdererConfig(); ok && oc.Decommissioned() {
+			// The orderer stopped after writing the config block which decommissions
+			// the channel, but before recording the decommission
+			logger.Infof("Not starting channel %s, decommissioned by its config", chainID)
+			if err := r.recordDecommission(chainID, decommission{}); err != nil {
+				logger.Panicf("Failed recording the decommission of channel %s: %s", chainID, err)
+			}
 		} else {
 			logger.Debugf("Starting chain: %s", chainID)
 			chain := newChainSupport(
@@ -228,6 +302,10 @@ func (r *Registrar) BroadcastChannelSupport(msg *cb.Envelope) (*cb.ChannelHeader
 	cs := r.GetChain(chdr.ChannelId)
 	// New channel creation
 	if cs == nil {
+		// Prevent re-creating a channel whose ledger is still retained
+		if r.IsDecommissioned(chdr.ChannelId) {
+			return chdr, false, nil, errors.Wrapf(msgprocessor.ErrChannelDecommissioned, "channel %s", chdr.ChannelId)
+		}
 		// Prevent channel creation during consensus-type migration
 		if r.ConsensusMigrationPending() {
 			return chdr, true, nil, errors.New("cannot create channel because consensus-type migration is pending")
@@ -244,6 +322,10 @@ func (r *Registrar) BroadcastChannelSupport(msg *cb.Envelope) (*cb.ChannelHeader
 	default:
 	}
 
+	if !cs.quota.admitBytes(int(blockcutter.MessageSizeBytes(cs.SharedConfig().Capabilities(), msg))) {
+		return chdr, isConfig, nil, errors.Wrapf(msgprocessor.ErrQuotaExceeded, "channel %s exceeded its bandwidth quota", cs.ChainID())
+	}
+
 	return chdr, isConfig, cs, nil
 }
 
@@ -253,6 +335,12 @@ func (r *Registrar) ConsensusMigrationPending() bool {
 	return r.systemChannel.MigrationStatus().IsPending()
 }
 
+// ConsensusMigrationAborted checks whether consensus-type migration is aborted on the system channel.
+func (r *Registrar) ConsensusMigrationAborted() bool {
+	state, _ := r.systemChannel.MigrationStatus().StateContext()
+	return state == ab.ConsensusType_MIG_STATE_ABORT
+}
+
 // ConsensusMigrationStart checks whether consensus-type migration had started,
 // and then marks all standard channels as started.
 func (r *Registrar) ConsensusMigrationStart(context uint64) error {
@@ -304,9 +392,44 @@ func (r *Registrar) ConsensusMigrationCommit() error {
 }
 
 // ConsensusMigrationAbort checks pre-conditions and aborts the consensus-type migration.
-func (r *Registrar) ConsensusMigrationAbort() (err error) {
-	//TODO implement the consensus-type migration abort path
-	return fmt.Errorf("Not implemented yet")
+// Standard channels that did not yet receive a context are released immediately, whereas
+// standard channels at CONTEXT stay frozen until a config update reverts their type.
+func (r *Registrar) ConsensusMigrationAbort() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	sysState, sysContext := r.systemChannel.MigrationStatus().StateContext()
+	if !(sysState == ab.ConsensusType_MIG_STATE_START && sysContext > 0) {
+		return errors.Errorf("cannot abort consensus-type migration because system channel (%s): state=%s, context=%d (expect: state=%s, context>0)",
+			r.systemChannel.ChainID(), sysState, sysContext, ab.ConsensusType_MIG_STATE_START)
+	}
+
+	for id, chain := range r.chains {
+		if id == r.systemChannel.ChainID() {
+			continue
+		}
+		st, ctx := chain.MigrationStatus().StateContext()
+		if st != ab.ConsensusType_MIG_STATE_START && st != ab.ConsensusType_MIG_STATE_CONTEXT {
+			return errors.Errorf("cannot abort consensus-type migration because standard channel %s, unexpected state=%s", id, st)
+		}
+		if ctx != sysContext {
+			return errors.Errorf("cannot abort consensus-type migration because standard channel %s, bad context=%d, expected=%d", id, ctx, sysContext)
+		}
+	}
+
+	for id, chain := range r.chains {
+		if id == r.systemChannel.ChainID() {
+			continue
+		}
+		if st, _ := chain.MigrationStatus().StateContext(); st == ab.ConsensusType_MIG_STATE_START {
+			chain.MigrationStatus().SetStateContext(ab.ConsensusType_MIG_STATE_ABORT, sysContext)
+		}
+	}
+
+	r.systemChannel.MigrationStatus().SetStateContext(ab.ConsensusType_MIG_STATE_ABORT, sysContext)
+	logger.Debugf("Consensus-type migration: system channel marked as aborted, context=%d", sysContext)
+
+	return nil
 }
 
 // GetChain retrieves the chain support for a chain if it exists.
@@ -337,7 +460,7 @@ func (r *Registrar) newLedgerResources(configTx *cb.Envelope) *ledgerResources {
 		logger.Panicf("Error umarshaling config envelope from payload data: %s", err)
 	}
 
-	bundle, err := channelconfig.NewBundle(chdr.ChannelId, configEnvelope.Config)
+	bundle, err := channelconfig.NewBundleWithMetrics(chdr.ChannelId, configEnvelope.Config, r.bundleMetrics)
 	if err != nil {
 		logger.Panicf("Error creating channelconfig bundle: %s", err)
 	}
@@ -352,6 +475,7 @@ func (r *Registrar) newLedgerResources(configTx *cb.Envelope) *ledgerResources {
 	return &ledgerResources{
 		configResources: &configResources{
 			mutableResources: channelconfig.NewBundleSource(bundle, r.callbacks...),
+			bundleMetrics:    r.bundleMetrics,
 		},
 		ReadWriter: ledger,
 	}
@@ -399,6 +523,149 @@ func (r *Registrar) newChain(configtx *cb.Envelope) {
 	r.chains = newChains
 }
 
+// DecommissionChannel halts the chain of a standard channel and stops serving it.
+// It decommissions the channel on this orderer only. A channel is decommissioned
+// on all the orderers serving it by a config update which sets its Decommission
+// value, once the config block is written.
+// Subsequent broadcasts to the channel are rejected with ErrChannelDecommissioned.
+// If removeLedger is set, the ledger of the channel is removed once the retention
+// period has elapsed, after which a channel of the same name may be created again.
+// The decommission is recorded before the chain is halted, hence it survives
+// restarts of the orderer, and so does a pending removal of the ledger.
+func (r *Registrar) DecommissionChannel(channelID string, removeLedger bool, retention time.Duration) error {
+	r.lock.Lock()
+	if channelID == r.systemChannelID {
+		r.lock.Unlock()
+		return errors.Errorf("cannot decommission system channel %s", channelID)
+	}
+	cs, ok := r.chains[channelID]
+	if !ok {
+		r.lock.Unlock()
+		return errors.Wrapf(msgprocessor.ErrChannelDoesNotExist, "cannot decommission channel %s", channelID)
+	}
+
+	d := decommission{}
+	if removeLedger {
+		removeLedgerAt := time.Now().Add(retention)
+		d.RemoveLedgerAt = &removeLedgerAt
+	}
+	if err := r.recordDecommission(channelID, d); err != nil {
+		r.lock.Unlock()
+		return errors.WithMessage(err, fmt.Sprintf("cannot decommission channel %s", channelID))
+	}
+
+	// Copy the map to allow concurrent reads from broadcast/deliver
+	newChains := make(map[string]*ChainSupport)
+	for key, value := range r.chains {
+		if key != channelID {
+			newChains[key] = value
+		}
+	}
+	r.chains = newChains
+	r.lock.Unlock()
+
+	cs.Halt()
+	logger.Infof("Decommissioned channel %s", channelID)
+
+	if d.RemoveLedgerAt != nil {
+		r.scheduleLedgerRemoval(channelID, *d.RemoveLedgerAt)
+	}
+
+	return nil
+}
+
+// recordDecommission records that the given channel has been decommissioned.
+// It must be called with the lock held.
+func (r *Registrar) recordDecommission(channelID string, d decommission) error {
+	decommissioned := make(map[string]decommission)
+	for key, value := range r.decommissioned {
+		decommissioned[key] = value
+	}
+	decommissioned[channelID] = d
+	if err := r.decommissionStore.save(decommissioned); err != nil {
+		return err
+	}
+	r.decommissioned = decommissioned
+	return nil
+}
+
+// IsDecommissioned returns whether the given channel has been decommissioned
+// and its ledger is still retained.
+func (r *Registrar) IsDecommissioned(channelID string) bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	_, ok := r.decommissioned[channelID]
+	return ok
+}
+
+func (r *Registrar) scheduleLedgerRemoval(channelID string, at time.Time) {
+	retention := time.Until(at)
+	if retention < 0 {
+		retention = 0
+	}
+	logger.Infof("Ledger of decommissioned channel %s will be removed in %s", channelID, retention)
+	time.AfterFunc(retention, func() { r.removeLedger(channelID) })
+}
+
+func (r *Registrar) removeLedger(channelID string) {
+	if err := r.ledgerFactory.Remove(channelID); err != nil {
+		logger.Errorf("Failed removing ledger of decommissioned channel %s: %s", channelID, err)
+		return
+	}
+
+	r.lock.Lock()
+	decommissioned := make(map[string]decommission)
+	for key, value := range r.decommissioned {
+		if key != channelID {
+			decommissioned[key] = value
+		}
+	}
+	// The channel stays decommissioned until the record is gone, so that no
+	// channel of the same name is created only to be skipped after a restart,
+	// when the removal is attempted again
+	if err := r.decommissionStore.save(decommissioned); err != nil {
+		r.lock.Unlock()
+		logger.Errorf("Failed recording the removal of the ledger of decommissioned channel %s: %s", channelID, err)
+		return
+	}
+	r.decommissioned = decommissioned
+	r.lock.Unlock()
+
+	logger.Infof("Removed ledger of decommissioned channel %s", channelID)
+}
+
+// ChannelStatus summarizes the ordering state of a channel.
+type ChannelStatus struct {
+	ChannelID             string `json:"channelID"`
+	Height                uint64 `json:"height"`
+	LastConfigBlockNumber uint64 `json:"lastConfigBlockNumber"`
+	LastConfigSequence    uint64 `json:"lastConfigSequence"`
+	ConsensusType         string `json:"consensusType"`
+	// Leader is the ID of the leader of the chain, if its consensus protocol elects one.
+	Leader uint64 `json:"leader,omitempty"`
+}
+
+// ChannelStatus returns the ordering state of the given channel.
+func (r *Registrar) ChannelStatus(channelID string) (*ChannelStatus, error) {
+	cs := r.GetChain(channelID)
+	if cs == nil {
+		return nil, errors.Wrapf(msgprocessor.ErrChannelDoesNotExist, "cannot get status of channel %s", channelID)
+	}
+
+	status := &ChannelStatus{
+		ChannelID:     channelID,
+		Height:        cs.Height(),
+		ConsensusType: cs.SharedConfig().ConsensusType(),
+	}
+	status.LastConfigBlockNumber, status.LastConfigSequence = cs.LastConfig()
+	if lr, ok := cs.Chain.(consensus.LeaderReporter); ok {
+		status.Leader = lr.Leader()
+	}
+
+	return status, nil
+}
+
 // ChannelsCount returns the count of the current total number of channels.
 func (r *Registrar) ChannelsCount() int {
 	r.lock.RLock()
@@ -407,6 +674,50 @@ func (r *Registrar) ChannelsCount() int {
 	return len(r.chains)
 }
 
+// ChannelIDs returns the IDs of the channels served by the orderer.
+func (r *Registrar) ChannelIDs() []string {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	channelIDs := make([]string, 0, len(r.chains))
+	for channelID := range r.chains {
+		channelIDs = append(channelIDs, channelID)
+	}
+	return channelIDs
+}
+
+// ReloadChannelMSPs sets up the MSPs of every channel of the orderer again
+// from the current channel configuration. Channel MSPs take their CA
+// certificates and CRLs from the channel configuration, so changes to them
+// take effect with the config update that makes them; reloading discards what
+// the current MSP instances have cached, such as identities validated before a
+// CA certificate expired. A channel whose MSPs fail to reload keeps its
+// current ones.
+func (r *Registrar) ReloadChannelMSPs() error {
+	r.lock.RLock()
+	chains := make(map[string]*ChainSupport, len(r.chains))
+	for channelID, cs := range r.chains {
+		chains[channelID] = cs
+	}
+	r.lock.RUnlock()
+
+	var failed []string
+	for channelID, cs := range chains {
+		if err := cs.reloadMSPs(); err != nil {
+			logger.Errorf("[channel %s] Failed reloading the channel MSPs: %s", channelID, err)
+			failed = append(failed, channelID)
+			continue
+		}
+		logger.Infof("[channel %s] Reloaded the channel MSPs", channelID)
+	}
+
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		return errors.Errorf("failed reloading the MSPs of channels %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
 // NewChannelConfig produces a new template channel configuration based on the system channel's current config.
 func (r *Registrar) NewChannelConfig(envConfigUpdate *cb.Envelope) (channelconfig.Resources, error) {
 	return r.templator.NewChannelConfig(envConfigUpdate)
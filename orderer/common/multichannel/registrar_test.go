@@ -7,10 +7,16 @@ SPDX-License-Identifier: Apache-2.0
 package multichannel
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/common/crypto"
+	"github.com/hyperledger/fabric/common/genesis"
 	"github.com/hyperledger/fabric/common/ledger/blockledger"
 	ramledger "github.com/hyperledger/fabric/common/ledger/blockledger/ram"
 	"github.com/hyperledger/fabric/common/metrics/disabled"
@@ -21,10 +27,12 @@ import (
 	"github.com/hyperledger/fabric/common/tools/configtxgen/encoder"
 	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
 	"github.com/hyperledger/fabric/orderer/common/blockcutter"
+	"github.com/hyperledger/fabric/orderer/common/msgprocessor"
 	"github.com/hyperledger/fabric/orderer/consensus"
 	cb "github.com/hyperledger/fabric/protos/common"
 	ab "github.com/hyperledger/fabric/protos/orderer"
 	"github.com/hyperledger/fabric/protoutil"
+	"github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -258,6 +266,7 @@ func Test3ChanConsensusMigrationController(t *testing.T) {
 		testMessageOrderAndRetrieval(confSys.Orderer.BatchSize.MaxMessageCount, testChainID2, chainSupport2, rls[2], t)
 
 		assert.Equal(t, 3, manager.ChannelsCount(), "Three channels")
+		assert.ElementsMatch(t, []string{genesisconfig.TestChainID, testChainID1, testChainID2}, manager.ChannelIDs())
 
 		// Test MigrationController methods
 		assert.True(t, !manager.ConsensusMigrationPending())
@@ -342,10 +351,30 @@ func Test3ChanConsensusMigrationController(t *testing.T) {
 		chainSupport1.MigrationStatus().SetStateContext(ab.ConsensusType_MIG_STATE_CONTEXT, ctx)
 		assert.True(t, chainSupport1.MigrationStatus().IsPending())
 
+		chainSupport2.MigrationStatus().SetStateContext(ab.ConsensusType_MIG_STATE_CONTEXT, ctx+1)
 		err = manager.ConsensusMigrationAbort()
-		assert.EqualError(t, err, "Not implemented yet")
+		assert.EqualError(t, err, "cannot abort consensus-type migration because standard channel testchainid2, bad context=6, expected=5")
+		assert.True(t, manager.ConsensusMigrationPending())
+		assert.True(t, !manager.ConsensusMigrationAborted())
 
-		//TODO test abort path here, when implemented
+		chainSupport2.MigrationStatus().SetStateContext(ab.ConsensusType_MIG_STATE_START, ctx)
+		err = manager.ConsensusMigrationAbort()
+		assert.NoError(t, err, "Migration can abort")
+		assert.True(t, !manager.ConsensusMigrationPending())
+		assert.True(t, manager.ConsensusMigrationAborted())
+		assert.True(t, !chainSupport.MigrationStatus().IsPending())
+		// A channel that received a context stays pending until its type is reverted
+		assert.True(t, chainSupport1.MigrationStatus().IsPending())
+		assert.True(t, !chainSupport2.MigrationStatus().IsPending())
+		st, _ := chainSupport2.MigrationStatus().StateContext()
+		assert.Equal(t, ab.ConsensusType_MIG_STATE_ABORT, st)
+
+		err = manager.ConsensusMigrationAbort()
+		assert.EqualError(t, err,
+			"cannot abort consensus-type migration because system channel (testchainid): state=MIG_STATE_ABORT, context=5 (expect: state=MIG_STATE_START, context>0)")
+
+		err = manager.ConsensusMigrationStart(ctx + 1)
+		assert.EqualError(t, err, "cannot start new consensus-type migration because standard channel testchainid1, still pending")
 	})
 }
 
@@ -571,3 +600,249 @@ func TestBroadcastChannelSupportRejection(t *testing.T) {
 		assert.Error(t, err, "Messages of type HeaderType_CONFIG should return an error.")
 	})
 }
+
+func TestDecommissionChannel(t *testing.T) {
+	//system channel
+	confSys := configtxgentest.Load(genesisconfig.SampleInsecureSoloProfile)
+	genesisBlockSys := encoder.New(confSys).GenesisBlock()
+	//standard channel, no Consortiums
+	confStd := configtxgentest.Load(genesisconfig.SampleInsecureSoloProfile)
+	confStd.Consortiums = nil
+	genesisBlockStd := encoder.New(confStd).GenesisBlockForChannel("mychannel")
+
+	consenters := map[string]consensus.Consenter{confSys.Orderer.OrdererType: &mockConsenter{}}
+	restart := func(lf blockledger.Factory, decommissionFile string) *Registrar {
+		manager := NewRegistrar(lf, mockCrypto(), &disabled.Provider{})
+		manager.SetDecommissionFile(decommissionFile)
+		manager.Initialize(consenters)
+		return manager
+	}
+
+	setupWithFile := func(t *testing.T, decommissionFile string) (blockledger.Factory, *Registrar) {
+		lf, _ := newRAMLedgerAndFactory(10, genesisconfig.TestChainID, genesisBlockSys)
+		ledger, err := lf.GetOrCreate("mychannel")
+		require.NoError(t, err)
+		require.NoError(t, ledger.Append(genesisBlockStd))
+
+		manager := restart(lf, decommissionFile)
+		require.NotNil(t, manager.GetChain("mychannel"))
+		return lf, manager
+	}
+
+	setup := func(t *testing.T) (blockledger.Factory, *Registrar) {
+		return setupWithFile(t, "")
+	}
+
+	t.Run("Keep ledger", func(t *testing.T) {
+		lf, manager := setup(t)
+		chain := manager.GetChain("mychannel")
+
+		err := manager.DecommissionChannel("mychannel", false, 0)
+		assert.NoError(t, err)
+		assert.Nil(t, manager.GetChain("mychannel"))
+		assert.True(t, manager.IsDecommissioned("mychannel"))
+		assert.Equal(t, 1, manager.ChannelsCount())
+		assert.Contains(t, lf.ChainIDs(), "mychannel")
+
+		// The chain is halted
+		_, ok := <-chain.Chain.(*mockChain).queue
+		assert.False(t, ok)
+
+		_, _, _, err = manager.BroadcastChannelSupport(makeNormalTx("mychannel", 1))
+		assert.EqualError(t, err, "channel mychannel: channel has been decommissioned")
+		assert.Equal(t, msgprocessor.ErrChannelDecommissioned, errors.Cause(err))
+	})
+
+	t.Run("Remove ledger", func(t *testing.T) {
+		lf, manager := setup(t)
+
+		err := manager.DecommissionChannel("mychannel", true, 0)
+		assert.NoError(t, err)
+		gt := gomega.NewGomegaWithT(t)
+		gt.Eventually(func() bool { return manager.IsDecommissioned("mychannel") }, time.Minute).Should(gomega.BeFalse())
+		assert.NotContains(t, lf.ChainIDs(), "mychannel")
+	})
+
+	t.Run("System channel", func(t *testing.T) {
+		_, manager := setup(t)
+
+		err := manager.DecommissionChannel(genesisconfig.TestChainID, false, 0)
+		assert.EqualError(t, err, "cannot decommission system channel "+genesisconfig.TestChainID)
+		assert.NotNil(t, manager.GetChain(genesisconfig.TestChainID))
+	})
+
+	t.Run("Unknown channel", func(t *testing.T) {
+		_, manager := setup(t)
+
+		err := manager.DecommissionChannel("foo", false, 0)
+		assert.EqualError(t, err, "cannot decommission channel foo: channel does not exist")
+		assert.False(t, manager.IsDecommissioned("foo"))
+	})
+
+	t.Run("Restart", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "decommission")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+		decommissionFile := filepath.Join(dir, "decommissioned.json")
+
+		lf, manager := setupWithFile(t, decommissionFile)
+		err = manager.DecommissionChannel("mychannel", true, time.Hour)
+		assert.NoError(t, err)
+
+		// The channel remains decommissioned
+		manager = restart(lf, decommissionFile)
+		assert.Nil(t, manager.GetChain("mychannel"))
+		assert.True(t, manager.IsDecommissioned("mychannel"))
+		assert.Contains(t, lf.ChainIDs(), "mychannel")
+
+		// and its ledger is removed once due, even if the orderer was down then
+		store := &decommissionStore{path: decommissionFile}
+		decommissioned, err := store.load()
+		require.NoError(t, err)
+		require.NotNil(t, decommissioned["mychannel"].RemoveLedgerAt)
+		past := time.Now().Add(-time.Minute)
+		require.NoError(t, store.save(map[string]decommission{"mychannel": {RemoveLedgerAt: &past}}))
+
+		manager = restart(lf, decommissionFile)
+		gt := gomega.NewGomegaWithT(t)
+		gt.Eventually(func() bool { return manager.IsDecommissioned("mychannel") }, time.Minute).Should(gomega.BeFalse())
+		assert.NotContains(t, lf.ChainIDs(), "mychannel")
+		decommissioned, err = store.load()
+		require.NoError(t, err)
+		assert.Empty(t, decommissioned)
+	})
+
+	t.Run("Decommissioned by config", func(t *testing.T) {
+		confDecommissioned := configtxgentest.Load(genesisconfig.SampleInsecureSoloProfile)
+		confDecommissioned.Consortiums = nil
+		confDecommissioned.Orderer.Capabilities = map[string]bool{"V2_0": true}
+		channelGroup, err := encoder.NewChannelGroup(confDecommissioned)
+		require.NoError(t, err)
+		channelGroup.Groups[channelconfig.OrdererGroupKey].Values[channelconfig.DecommissionKey] = &cb.ConfigValue{
+			Value:     protoutil.MarshalOrPanic(channelconfig.DecommissionValue().Value()),
+			ModPolicy: channelconfig.AdminsPolicyKey,
+		}
+
+		lf, _ := newRAMLedgerAndFactory(10, genesisconfig.TestChainID, genesisBlockSys)
+		ledger, err := lf.GetOrCreate("decommissioned")
+		require.NoError(t, err)
+		require.NoError(t, ledger.Append(genesis.NewFactoryImpl(channelGroup).Block("decommissioned")))
+
+		manager := restart(lf, "")
+		assert.Nil(t, manager.GetChain("decommissioned"))
+		assert.True(t, manager.IsDecommissioned("decommissioned"))
+		assert.Contains(t, lf.ChainIDs(), "decommissioned")
+	})
+
+	t.Run("Failure to record", func(t *testing.T) {
+		_, manager := setupWithFile(t, "/nonexistent/decommissioned.json")
+
+		err := manager.DecommissionChannel("mychannel", false, 0)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot decommission channel mychannel: failed creating decommissioned channels file")
+		assert.NotNil(t, manager.GetChain("mychannel"))
+		assert.False(t, manager.IsDecommissioned("mychannel"))
+	})
+}
+
+type mockLeaderChain struct {
+	consensus.Chain
+	leader uint64
+}
+
+func (c *mockLeaderChain) Leader() uint64 {
+	return c.leader
+}
+
+func TestChannelStatus(t *testing.T) {
+	confSys := configtxgentest.Load(genesisconfig.SampleInsecureSoloProfile)
+	genesisBlockSys := encoder.New(confSys).GenesisBlock()
+
+	lf, rl := newRAMLedgerAndFactory(10, genesisconfig.TestChainID, genesisBlockSys)
+	consenters := map[string]consensus.Consenter{confSys.Orderer.OrdererType: &mockConsenter{}}
+	manager := NewRegistrar(lf, mockCrypto(), &disabled.Provider{})
+	manager.Initialize(consenters)
+
+	status, err := manager.ChannelStatus(genesisconfig.TestChainID)
+	assert.NoError(t, err)
+	assert.Equal(t, &ChannelStatus{
+		ChannelID:     genesisconfig.TestChainID,
+		Height:        rl.Height(),
+		ConsensusType: confSys.Orderer.OrdererType,
+	}, status)
+
+	t.Run("With leader", func(t *testing.T) {
+		cs := manager.GetChain(genesisconfig.TestChainID)
+		cs.Chain = &mockLeaderChain{Chain: cs.Chain, leader: 3}
+
+		status, err := manager.ChannelStatus(genesisconfig.TestChainID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(3), status.Leader)
+	})
+
+	t.Run("Unknown channel", func(t *testing.T) {
+		_, err := manager.ChannelStatus("foo")
+		assert.EqualError(t, err, "cannot get status of channel foo: channel does not exist")
+		assert.Equal(t, msgprocessor.ErrChannelDoesNotExist, errors.Cause(err))
+	})
+}
+
+func TestReloadChannelMSPs(t *testing.T) {
+	stableBundle := func(cs *ChainSupport) *channelconfig.Bundle {
+		return cs.mutableResources.(*channelconfig.BundleSource).StableBundle()
+	}
+
+	confSys := configtxgentest.Load(genesisconfig.SampleInsecureSoloProfile)
+	genesisBlockSys := encoder.New(confSys).GenesisBlock()
+
+	lf, _ := newRAMLedgerAndFactory(10, genesisconfig.TestChainID, genesisBlockSys)
+	consenters := map[string]consensus.Consenter{confSys.Orderer.OrdererType: &mockConsenter{}}
+	manager := NewRegistrar(lf, mockCrypto(), &disabled.Provider{})
+	manager.Initialize(consenters)
+
+	cs := manager.GetChain(genesisconfig.TestChainID)
+	mspManager := stableBundle(cs).MSPManager()
+	sequence := cs.Sequence()
+
+	err := manager.ReloadChannelMSPs()
+	assert.NoError(t, err)
+	assert.False(t, mspManager == stableBundle(cs).MSPManager(), "the channel MSPs should have been replaced")
+	assert.Equal(t, sequence, cs.Sequence())
+}
+
+func TestChannelQuotas(t *testing.T) {
+	confSys := configtxgentest.Load(genesisconfig.SampleInsecureSoloProfile)
+	genesisBlockSys := encoder.New(confSys).GenesisBlock()
+
+	lf, _ := newRAMLedgerAndFactory(10, genesisconfig.TestChainID, genesisBlockSys)
+	consenters := map[string]consensus.Consenter{confSys.Orderer.OrdererType: &mockConsenter{}}
+	manager := NewRegistrar(lf, mockCrypto(), &disabled.Provider{})
+	manager.SetChannelQuotas(ChannelQuotas{MaxPendingMessages: 1, MaxBytesPerSecond: 1})
+	manager.Initialize(consenters)
+
+	t.Run("Bandwidth", func(t *testing.T) {
+		_, _, cs, err := manager.BroadcastChannelSupport(makeNormalTx(genesisconfig.TestChainID, 1))
+		assert.NoError(t, err)
+		assert.NotNil(t, cs)
+
+		// The first message put the channel in debt for much longer than the test takes
+		_, _, cs, err = manager.BroadcastChannelSupport(makeNormalTx(genesisconfig.TestChainID, 2))
+		assert.EqualError(t, err, "channel "+genesisconfig.TestChainID+" exceeded its bandwidth quota: channel quota exceeded")
+		assert.Equal(t, msgprocessor.ErrQuotaExceeded, errors.Cause(err))
+		assert.Nil(t, cs)
+	})
+
+	t.Run("Pending messages", func(t *testing.T) {
+		cs := manager.GetChain(genesisconfig.TestChainID)
+		require.True(t, cs.quota.acquirePending())
+
+		err := cs.Order(makeNormalTx(genesisconfig.TestChainID, 3), 0)
+		assert.EqualError(t, err, "channel "+genesisconfig.TestChainID+" has too many pending messages: channel quota exceeded")
+		err = cs.Configure(makeConfigTx(genesisconfig.TestChainID, 4), 0)
+		assert.EqualError(t, err, "channel "+genesisconfig.TestChainID+" has too many pending messages: channel quota exceeded")
+
+		cs.quota.releasePending()
+		assert.NoError(t, cs.Order(makeNormalTx(genesisconfig.TestChainID, 3), 0))
+		assert.True(t, cs.quota.acquirePending(), "the pending slot is released once the message is ordered")
+	})
+}
@@ -0,0 +1,109 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hyperledger/fabric/common/ledger/blkstorage/fsblkstorage"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+)
+
+// checkFileLedgers verifies the integrity of the block files of every channel ledger
+// under the given file ledger directory. Partially written blocks left at the end of
+// a ledger by a crash are truncated, unless dryRun is set, in which case the ledgers
+// are only inspected.
+func checkFileLedgers(ledgerDir string, dryRun bool) error {
+	chainsDir := filepath.Join(ledgerDir, fsblkstorage.ChainsDir)
+	channels, err := ioutil.ReadDir(chainsDir)
+	if os.IsNotExist(err) {
+		logger.Infof("No ledgers found in %s, skipping ledger check", chainsDir)
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed listing ledgers in %s", chainsDir)
+	}
+
+	var inconsistent []string
+	for _, channel := range channels {
+		if !channel.IsDir() {
+			continue
+		}
+		if err := checkFileLedger(filepath.Join(chainsDir, channel.Name()), channel.Name(), dryRun); err != nil {
+			logger.Errorf("[channel: %s] Ledger is inconsistent: %s", channel.Name(), err)
+			inconsistent = append(inconsistent, channel.Name())
+		}
+	}
+
+	if len(inconsistent) > 0 {
+		return errors.Errorf("inconsistent ledgers found for channels: %s", strings.Join(inconsistent, ", "))
+	}
+	return nil
+}
+
+func checkFileLedger(blockDir string, channelID string, dryRun bool) error {
+	logger.Infof("[channel: %s] Checking ledger in %s", channelID, blockDir)
+	result, err := fsblkstorage.CheckBlockfiles(blockDir, !dryRun, lastConfigVerifier())
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case result.Truncated:
+		logger.Warningf("[channel: %s] Truncated a partially written block of %d bytes at the end of %s", channelID, result.TornBytes, result.TornBlockfile)
+	case result.TornBlockfile != "":
+		logger.Warningf("[channel: %s] Found a partially written block of %d bytes at the end of %s, which would be truncated", channelID, result.TornBytes, result.TornBlockfile)
+	}
+
+	logger.Infof("[channel: %s] Ledger is consistent, height is %d", channelID, result.Height)
+	return nil
+}
+
+// lastConfigVerifier returns a function which verifies that the last config index in the
+// metadata of consecutive blocks points to the latest config block of the ledger.
+func lastConfigVerifier() func(*cb.Block) error {
+	var lastConfig uint64
+	return func(block *cb.Block) error {
+		// The last config of the genesis block may be empty, and it is necessarily block 0
+		if block.Header.Number == 0 {
+			return nil
+		}
+
+		index, err := protoutil.GetLastConfigIndexFromBlock(block)
+		if err != nil {
+			return err
+		}
+
+		if isConfigBlock(block) {
+			if index != block.Header.Number {
+				return errors.Errorf("config block has last config index %d", index)
+			}
+		} else if index != lastConfig {
+			return errors.Errorf("last config index is %d, but the last config block is %d", index, lastConfig)
+		}
+
+		lastConfig = index
+		return nil
+	}
+}
+
+func isConfigBlock(block *cb.Block) bool {
+	env, err := protoutil.ExtractEnvelope(block, 0)
+	if err != nil {
+		return false
+	}
+	chdr, err := protoutil.ChannelHeader(env)
+	if err != nil {
+		return false
+	}
+	return chdr.Type == int32(cb.HeaderType_CONFIG)
+}
@@ -0,0 +1,129 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/ledger/blkstorage/fsblkstorage"
+	"github.com/hyperledger/fabric/common/ledger/blockledger/file"
+	"github.com/hyperledger/fabric/common/tools/configtxgen/configtxgentest"
+	"github.com/hyperledger/fabric/common/tools/configtxgen/encoder"
+	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckFileLedgers(t *testing.T) {
+	conf := configtxgentest.Load(genesisconfig.SampleInsecureSoloProfile)
+	conf.Consortiums = nil
+	genesisBlock := encoder.New(conf).GenesisBlockForChannel("mychannel")
+	configEnv := protoutil.ExtractEnvelopeOrPanic(genesisBlock, 0)
+	normalEnv := &cb.Envelope{
+		Payload: protoutil.MarshalOrPanic(&cb.Payload{
+			Header: &cb.Header{
+				ChannelHeader: protoutil.MarshalOrPanic(&cb.ChannelHeader{
+					Type:      int32(cb.HeaderType_ENDORSER_TRANSACTION),
+					ChannelId: "mychannel",
+				}),
+			},
+		}),
+	}
+
+	nextBlock := func(previous *cb.Block, env *cb.Envelope, lastConfig uint64) *cb.Block {
+		block := protoutil.NewBlock(previous.Header.Number+1, protoutil.BlockHeaderHash(previous.Header))
+		block.Data.Data = [][]byte{protoutil.MarshalOrPanic(env)}
+		block.Header.DataHash = protoutil.BlockDataHash(block.Data)
+		block.Metadata.Metadata[cb.BlockMetadataIndex_LAST_CONFIG] = protoutil.MarshalOrPanic(&cb.Metadata{
+			Value: protoutil.MarshalOrPanic(&cb.LastConfig{Index: lastConfig}),
+		})
+		return block
+	}
+
+	// setup creates a ledger with the genesis block, followed by a block
+	// per given envelope carrying the corresponding last config index.
+	setup := func(t *testing.T, envs []*cb.Envelope, lastConfigs []uint64) string {
+		ledgerDir, err := ioutil.TempDir("", "ledgercheck")
+		require.NoError(t, err)
+
+		lf := fileledger.New(ledgerDir)
+		defer lf.Close()
+		ledger, err := lf.GetOrCreate("mychannel")
+		require.NoError(t, err)
+		require.NoError(t, ledger.Append(genesisBlock))
+
+		block := genesisBlock
+		for i, env := range envs {
+			block = nextBlock(block, env, lastConfigs[i])
+			require.NoError(t, ledger.Append(block))
+		}
+		return ledgerDir
+	}
+
+	t.Run("Consistent ledger", func(t *testing.T) {
+		ledgerDir := setup(t, []*cb.Envelope{normalEnv, configEnv, normalEnv}, []uint64{0, 2, 2})
+		defer os.RemoveAll(ledgerDir)
+
+		assert.NoError(t, checkFileLedgers(ledgerDir, false))
+	})
+
+	t.Run("No ledgers", func(t *testing.T) {
+		assert.NoError(t, checkFileLedgers(filepath.Join(os.TempDir(), "missing-ledger-dir"), false))
+	})
+
+	t.Run("Inconsistent last config", func(t *testing.T) {
+		ledgerDir := setup(t, []*cb.Envelope{normalEnv, normalEnv}, []uint64{0, 1})
+		defer os.RemoveAll(ledgerDir)
+
+		err := checkFileLedgers(ledgerDir, false)
+		assert.EqualError(t, err, "inconsistent ledgers found for channels: mychannel")
+
+		blockDir := filepath.Join(ledgerDir, fsblkstorage.ChainsDir, "mychannel")
+		_, err = fsblkstorage.CheckBlockfiles(blockDir, false, lastConfigVerifier())
+		assert.EqualError(t, err, "block 2 failed verification: last config index is 1, but the last config block is 0")
+	})
+
+	t.Run("Config block not pointing to itself", func(t *testing.T) {
+		ledgerDir := setup(t, []*cb.Envelope{normalEnv, configEnv, configEnv}, []uint64{0, 2, 2})
+		defer os.RemoveAll(ledgerDir)
+
+		blockDir := filepath.Join(ledgerDir, fsblkstorage.ChainsDir, "mychannel")
+		_, err := fsblkstorage.CheckBlockfiles(blockDir, false, lastConfigVerifier())
+		assert.EqualError(t, err, "block 3 failed verification: config block has last config index 2")
+	})
+
+	t.Run("Torn block", func(t *testing.T) {
+		ledgerDir := setup(t, []*cb.Envelope{normalEnv}, []uint64{0})
+		defer os.RemoveAll(ledgerDir)
+
+		blockfile := filepath.Join(ledgerDir, fsblkstorage.ChainsDir, "mychannel", "blockfile_000000")
+		info, err := os.Stat(blockfile)
+		require.NoError(t, err)
+		f, err := os.OpenFile(blockfile, os.O_APPEND|os.O_WRONLY, 0600)
+		require.NoError(t, err)
+		_, err = f.Write(append(proto.EncodeVarint(1000), []byte("partial")...))
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		// A dry run leaves the torn block in place
+		assert.NoError(t, checkFileLedgers(ledgerDir, true))
+		tornInfo, err := os.Stat(blockfile)
+		require.NoError(t, err)
+		assert.True(t, tornInfo.Size() > info.Size())
+
+		assert.NoError(t, checkFileLedgers(ledgerDir, false))
+		repairedInfo, err := os.Stat(blockfile)
+		require.NoError(t, err)
+		assert.Equal(t, info.Size(), repairedInfo.Size())
+	})
+}
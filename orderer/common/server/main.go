@@ -16,18 +16,21 @@ import (
 	_ "net/http/pprof" // This is essentially the main package for the orderer
 	"os"
 	"os/signal"
+	"path/filepath"
 	"sync"
 	"syscall"
 	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric-lib-go/healthz"
+	"github.com/hyperledger/fabric/common/certmonitor"
 	"github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/common/crypto"
 	"github.com/hyperledger/fabric/common/flogging"
 	floggingmetrics "github.com/hyperledger/fabric/common/flogging/metrics"
 	"github.com/hyperledger/fabric/common/grpclogging"
 	"github.com/hyperledger/fabric/common/grpcmetrics"
+	"github.com/hyperledger/fabric/common/grpctracing"
 	"github.com/hyperledger/fabric/common/ledger/blockledger"
 	"github.com/hyperledger/fabric/common/localmsp"
 	"github.com/hyperledger/fabric/common/metrics"
@@ -40,7 +43,9 @@ import (
 	"github.com/hyperledger/fabric/core/operations"
 	"github.com/hyperledger/fabric/msp"
 	mspmgmt "github.com/hyperledger/fabric/msp/mgmt"
+	msphttpadmin "github.com/hyperledger/fabric/msp/mgmt/httpadmin"
 	"github.com/hyperledger/fabric/orderer/common/bootstrap/file"
+	"github.com/hyperledger/fabric/orderer/common/channeladmin"
 	"github.com/hyperledger/fabric/orderer/common/cluster"
 	"github.com/hyperledger/fabric/orderer/common/localconfig"
 	"github.com/hyperledger/fabric/orderer/common/metadata"
@@ -52,6 +57,7 @@ import (
 	cb "github.com/hyperledger/fabric/protos/common"
 	ab "github.com/hyperledger/fabric/protos/orderer"
 	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
 	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
 	kingpin "gopkg.in/alecthomas/kingpin.v2"
@@ -102,7 +108,22 @@ func Start(cmd string, conf *localconfig.TopLevel) {
 	clusterType := isClusterType(bootstrapBlock)
 	signer := localmsp.NewSigner()
 
-	lf, _ := createLedgerFactory(conf)
+	// The ledgers must be checked before the ledger factory opens them
+	if conf.General.LedgerType == "file" && conf.FileLedger.Location != "" && conf.FileLedger.StartupCheck.Enabled {
+		err := checkFileLedgers(conf.FileLedger.Location, conf.FileLedger.StartupCheck.DryRun)
+		if conf.FileLedger.StartupCheck.DryRun {
+			if err != nil {
+				logger.Errorf("Ledger check failed: %s", err)
+			}
+			logger.Info("Ledger check dry run completed, exiting")
+			return
+		}
+		if err != nil {
+			logger.Panicf("Ledger check failed: %s", err)
+		}
+	}
+
+	lf, ld := createLedgerFactory(conf)
 
 	clusterDialer := &cluster.PredicateDialer{}
 	clusterClientConfig := initializeClusterClientConfig(conf)
@@ -120,11 +141,22 @@ func Start(cmd string, conf *localconfig.TopLevel) {
 		logger.Panicf("failed to initialize operations subsystem: %s", err)
 	}
 	defer opsSystem.Stop()
+
+	if interval := conf.General.LocalMSPReloadInterval; interval > 0 {
+		mspWatchDone := make(chan struct{})
+		defer close(mspWatchDone)
+		go mspmgmt.WatchLocalMsp(interval, mspWatchDone)
+	}
+
 	metricsProvider := opsSystem.Provider
 	logObserver := floggingmetrics.NewObserver(metricsProvider)
 	flogging.Global.SetObserver(logObserver)
 
 	serverConfig := initializeServerConfig(conf, metricsProvider)
+	if tracer := opsSystem.Tracer(); tracer != nil {
+		serverConfig.UnaryInterceptors = append(serverConfig.UnaryInterceptors, grpctracing.UnaryServerInterceptor(tracer))
+		serverConfig.StreamInterceptors = append(serverConfig.StreamInterceptors, grpctracing.StreamServerInterceptor(tracer))
+	}
 	grpcServer := initializeGrpcServer(conf, serverConfig)
 	caMgr := &caManager{
 		appRootCAsByChain:     make(map[string][][]byte),
@@ -145,6 +177,17 @@ func Start(cmd string, conf *localconfig.TopLevel) {
 		servers = append(servers, clusterGRPCServer)
 	}
 
+	// rotate the TLS key pairs and client root CAs of the servers without a restart
+	if interval := conf.General.TLSReloadInterval; interval > 0 && serverConfig.SecOpts.UseTLS {
+		tlsWatchDone := make(chan struct{})
+		defer close(tlsWatchDone)
+		go grpcServer.WatchTLS(caMgr.tlsLoader(conf.General.TLS.Certificate, conf.General.TLS.PrivateKey, conf.General.TLS), interval, tlsWatchDone)
+		if clusterGRPCServer != grpcServer {
+			clusterConf := conf.General.Cluster
+			go clusterGRPCServer.WatchTLS(caMgr.tlsLoader(clusterConf.ServerCertificate, clusterConf.ServerPrivateKey, conf.General.TLS), interval, tlsWatchDone)
+		}
+	}
+
 	tlsCallback := func(bundle *channelconfig.Bundle) {
 		// only need to do this if mutual TLS is required or if the orderer node is part of a cluster
 		if grpcServer.MutualTLSRequired() || clusterType {
@@ -159,9 +202,24 @@ func Start(cmd string, conf *localconfig.TopLevel) {
 		}
 	}
 
-	manager := initializeMultichannelRegistrar(bootstrapBlock, r, clusterDialer, clusterServerConfig, clusterGRPCServer, conf, signer, metricsProvider, opsSystem, lf, tlsCallback)
+	manager := initializeMultichannelRegistrar(bootstrapBlock, r, clusterDialer, clusterServerConfig, clusterGRPCServer, conf, signer, metricsProvider, opsSystem, lf, ld, tlsCallback)
 	mutualTLS := serverConfig.SecOpts.UseTLS && serverConfig.SecOpts.RequireClientCert
 	server := NewServer(manager, metricsProvider, &conf.Debug, conf.General.Authentication.TimeWindow, mutualTLS)
+	if conf.Operations.ChannelDecommission.Enabled {
+		logger.Warning("Channels may be decommissioned by admins of the local MSP through the operations service")
+	}
+	opsSystem.RegisterHandler(channeladmin.URLBase, channeladmin.NewHandler(manager, conf.Operations.ChannelDecommission.Enabled, mspmgmt.CheckLocalAdmin))
+	mspReloadHandler := msphttpadmin.NewHandler(msphttpadmin.Reloaders{
+		LocalMsp:    mspmgmt.ReloadLocalMsp,
+		ChannelMSPs: manager.ReloadChannelMSPs,
+	}, mspmgmt.CheckLocalAdmin)
+	opsSystem.RegisterHandler(msphttpadmin.URL, mspReloadHandler)
+	opsSystem.RegisterHandler(msphttpadmin.ChannelsURL, mspReloadHandler)
+
+	certMonitor := certmonitor.NewMonitor(conf.General.CertExpiration.WarningThresholds, certmonitor.NewMetrics(metricsProvider), certMonitorSources(conf, manager)...)
+	certMonitorDone := make(chan struct{})
+	defer close(certMonitorDone)
+	go certMonitor.Run(conf.General.CertExpiration.ScanInterval, certMonitorDone)
 
 	logger.Infof("Starting %s", metadata.GetVersionInfo())
 	go handleSignals(addPlatformSignals(map[os.Signal]func(){
@@ -180,7 +238,9 @@ func Start(cmd string, conf *localconfig.TopLevel) {
 
 	initializeProfilingService(conf)
 	ab.RegisterAtomicBroadcastServer(grpcServer.Server(), server)
+	ab.RegisterConfigUpdateValidatorServer(grpcServer.Server(), server.(ab.ConfigUpdateValidatorServer))
 	logger.Info("Beginning to serve requests")
+	opsSystem.SetReady()
 	grpcServer.Start()
 }
 
@@ -427,7 +487,7 @@ func initializeServerConfig(conf *localconfig.TopLevel, metricsProvider metrics.
 		secureOpts.ClientRootCAs = clientRootCAs
 		logger.Infof("Starting orderer with %s enabled", msg)
 	}
-	kaOpts := comm.DefaultKeepaliveOptions
+	kaOpts := comm.DefaultKeepaliveOptions.Clone()
 	// keepalive settings
 	// ServerMinInterval must be greater than 0
 	if conf.General.Keepalive.ServerMinInterval > time.Duration(0) {
@@ -435,6 +495,10 @@ func initializeServerConfig(conf *localconfig.TopLevel, metricsProvider metrics.
 	}
 	kaOpts.ServerInterval = conf.General.Keepalive.ServerInterval
 	kaOpts.ServerTimeout = conf.General.Keepalive.ServerTimeout
+	kaOpts.MaxConnectionIdle = conf.General.Keepalive.MaxConnectionIdle
+	kaOpts.MaxConnectionAge = conf.General.Keepalive.MaxConnectionAge
+	kaOpts.MaxConnectionAgeGrace = conf.General.Keepalive.MaxConnectionAgeGrace
+	kaOpts.MaxConcurrentStreams = conf.General.Keepalive.MaxConcurrentStreams
 
 	commLogger := flogging.MustGetLogger("core.comm").With("server", "Orderer")
 	if metricsProvider == nil {
@@ -543,11 +607,64 @@ func initializeLocalMsp(conf *localconfig.TopLevel) {
 	}
 }
 
+// certMonitorSources returns the certificates the orderer monitors for expiration:
+// those of its local MSP, its TLS certificates and those of its channels' MSPs.
+func certMonitorSources(conf *localconfig.TopLevel, r *multichannel.Registrar) []certmonitor.Source {
+	localMSP := func() ([]certmonitor.Certificate, error) {
+		mspConf, err := mspmgmt.GetLocalMspConfig()
+		if err != nil {
+			return nil, err
+		}
+		return certmonitor.MSPCertificates(certmonitor.KindLocalMSP, "", mspConf)
+	}
+
+	tls := func() ([]certmonitor.Certificate, error) {
+		var paths []string
+		if conf.General.TLS.Enabled {
+			paths = append(paths, conf.General.TLS.Certificate)
+			paths = append(paths, conf.General.TLS.RootCAs...)
+		}
+		if conf.General.Cluster.ClientCertificate != "" {
+			paths = append(paths, conf.General.Cluster.ClientCertificate)
+			paths = append(paths, conf.General.Cluster.RootCAs...)
+		}
+
+		var pemCerts [][]byte
+		for _, path := range paths {
+			pemCert, err := ioutil.ReadFile(path)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed reading %s", path)
+			}
+			pemCerts = append(pemCerts, pemCert)
+		}
+		return certmonitor.PEMCertificates(certmonitor.KindTLS, "", conf.General.LocalMSPID, pemCerts...)
+	}
+
+	channels := func() ([]certmonitor.Certificate, error) {
+		var certs []certmonitor.Certificate
+		for _, channelID := range r.ChannelIDs() {
+			cs := r.GetChain(channelID)
+			if cs == nil {
+				continue
+			}
+			channelCerts, err := certmonitor.ChannelCertificates(channelID, cs.ConfigtxValidator().ConfigProto())
+			if err != nil {
+				return nil, errors.WithMessage(err, fmt.Sprintf("failed getting certificates of channel %s", channelID))
+			}
+			certs = append(certs, channelCerts...)
+		}
+		return certs, nil
+	}
+
+	return []certmonitor.Source{localMSP, tls, channels}
+}
+
 //go:generate counterfeiter -o mocks/health_checker.go -fake-name HealthChecker . healthChecker
 
 // HealthChecker defines the contract for health checker
 type healthChecker interface {
 	RegisterChecker(component string, checker healthz.HealthChecker) error
+	DeregisterChecker(component string)
 }
 
 func initializeMultichannelRegistrar(
@@ -561,6 +678,7 @@ func initializeMultichannelRegistrar(
 	metricsProvider metrics.Provider,
 	healthChecker healthChecker,
 	lf blockledger.Factory,
+	ld string,
 	callbacks ...channelconfig.BundleActor,
 ) *multichannel.Registrar {
 	genesisBlock := extractBootstrapBlock(conf)
@@ -574,6 +692,13 @@ func initializeMultichannelRegistrar(
 	consenters := make(map[string]consensus.Consenter)
 
 	registrar := multichannel.NewRegistrar(lf, signer, metricsProvider, callbacks...)
+	registrar.SetChannelQuotas(multichannel.ChannelQuotas{
+		MaxPendingMessages: conf.General.ChannelQuotas.MaxPendingMessages,
+		MaxBytesPerSecond:  conf.General.ChannelQuotas.MaxBytesPerSecond,
+	})
+	if ld != "" {
+		registrar.SetDecommissionFile(filepath.Join(ld, "decommissioned.json"))
+	}
 
 	consenters["solo"] = solo.New()
 	var kafkaMetrics *kafka.Metrics
@@ -582,7 +707,7 @@ func initializeMultichannelRegistrar(
 	// closes if we wished to cleanup this routine on exit.
 	go kafkaMetrics.PollGoMetricsUntilStop(time.Minute, nil)
 	if isClusterType(bootstrapBlock) {
-		initializeEtcdraftConsenter(consenters, conf, lf, clusterDialer, bootstrapBlock, ri, srvConf, srv, registrar, metricsProvider)
+		initializeEtcdraftConsenter(consenters, conf, lf, clusterDialer, bootstrapBlock, ri, srvConf, srv, registrar, metricsProvider, healthChecker)
 	}
 	registrar.Initialize(consenters)
 	return registrar
@@ -599,6 +724,7 @@ func initializeEtcdraftConsenter(
 	srv *comm.GRPCServer,
 	registrar *multichannel.Registrar,
 	metricsProvider metrics.Provider,
+	healthChecker healthChecker,
 ) {
 	replicationRefreshInterval := conf.General.Cluster.ReplicationBackgroundRefreshInterval
 	if replicationRefreshInterval == 0 {
@@ -636,7 +762,7 @@ func initializeEtcdraftConsenter(
 	ri.channelLister = icr
 
 	go icr.run()
-	raftConsenter := etcdraft.New(clusterDialer, conf, srvConf, srv, registrar, icr, metricsProvider)
+	raftConsenter := etcdraft.New(clusterDialer, conf, srvConf, srv, registrar, icr, metricsProvider, healthChecker)
 	consenters["etcdraft"] = raftConsenter
 }
 
@@ -652,6 +778,18 @@ func newOperationsSystem(ops localconfig.Operations, metrics localconfig.Metrics
 				WriteInterval: metrics.Statsd.WriteInterval,
 				Prefix:        metrics.Statsd.Prefix,
 			},
+			OTLP: &operations.OTLP{
+				Endpoint:      metrics.OTLP.Endpoint,
+				WriteInterval: metrics.OTLP.WriteInterval,
+				Headers:       metrics.OTLP.Headers,
+			},
+		},
+		Tracing: operations.TracingOptions{
+			Enabled:        ops.Tracing.Enabled,
+			Endpoint:       ops.Tracing.Endpoint,
+			WriteInterval:  ops.Tracing.WriteInterval,
+			Headers:        ops.Tracing.Headers,
+			MaxQueuedSpans: ops.Tracing.MaxQueuedSpans,
 		},
 		TLS: operations.TLS{
 			Enabled:            ops.TLS.Enabled,
@@ -660,7 +798,12 @@ func newOperationsSystem(ops localconfig.Operations, metrics localconfig.Metrics
 			ClientCertRequired: ops.TLS.ClientAuthRequired,
 			ClientCACertFiles:  ops.TLS.ClientRootCAs,
 		},
-		Version: metadata.Version,
+		Profile: operations.ProfileOptions{
+			Enabled:     ops.Profile.Enabled,
+			MaxDuration: ops.Profile.MaxDuration,
+		},
+		Version:     metadata.Version,
+		ServiceName: "orderer",
 	})
 }
 
@@ -677,7 +820,6 @@ func (mgr *caManager) updateTrustedRoots(
 	servers ...*comm.GRPCServer,
 ) {
 	mgr.Lock()
-	defer mgr.Unlock()
 
 	appRootCAs := [][]byte{}
 	ordererRootCAs := [][]byte{}
@@ -712,6 +854,7 @@ func (mgr *caManager) updateTrustedRoots(
 	msps, err := cm.MSPManager().GetMSPs()
 	if err != nil {
 		logger.Errorf("Error getting root CAs for channel %s (%s)", cid, err)
+		mgr.Unlock()
 		return
 	}
 	for k, v := range msps {
@@ -745,8 +888,32 @@ func (mgr *caManager) updateTrustedRoots(
 	}
 	mgr.appRootCAsByChain[cid] = appRootCAs
 	mgr.ordererRootCAsByChain[cid] = ordererRootCAs
+	mgr.Unlock()
 
-	// now iterate over all roots for all app and orderer chains
+	// now update the client roots for the gRPC server. The roots are taken
+	// under the lock of the server, as when its TLS material is reloaded.
+	for _, srv := range servers {
+		err = srv.UpdateClientRootCAs(mgr.lockedTrustedRoots)
+		if err != nil {
+			msg := "Failed to update trusted roots for orderer from latest config " +
+				"block.  This orderer may not be able to communicate " +
+				"with members of channel %s (%s)"
+			logger.Warningf(msg, cm.ConfigtxValidator().ChainID(), err)
+		}
+	}
+}
+
+// lockedTrustedRoots returns the trusted roots, taking the lock.
+func (mgr *caManager) lockedTrustedRoots() [][]byte {
+	mgr.Lock()
+	defer mgr.Unlock()
+	return mgr.trustedRoots()
+}
+
+// trustedRoots returns the roots of all app and orderer chains along with
+// the statically configured root certs. The caller must hold the lock.
+func (mgr *caManager) trustedRoots() [][]byte {
+	// iterate over all roots for all app and orderer chains
 	trustedRoots := [][]byte{}
 	for _, roots := range mgr.appRootCAsByChain {
 		trustedRoots = append(trustedRoots, roots...)
@@ -758,16 +925,34 @@ func (mgr *caManager) updateTrustedRoots(
 	if len(mgr.clientRootCAs) > 0 {
 		trustedRoots = append(trustedRoots, mgr.clientRootCAs...)
 	}
+	return trustedRoots
+}
 
-	// now update the client roots for the gRPC server
-	for _, srv := range servers {
-		err = srv.SetClientRootCAs(trustedRoots)
+// tlsLoader returns a comm.TLSLoader which reads the TLS key pair of a
+// server and the statically configured client root certs from disk again.
+// The latter replace the statically configured root certs of the manager
+// when client authentication is required, and are combined with the roots
+// of the chains, so that reloading the TLS material does not drop the roots
+// of any chain.
+func (mgr *caManager) tlsLoader(certFile, keyFile string, tlsConf localconfig.TLS) comm.TLSLoader {
+	var clientRootCAFiles []string
+	if tlsConf.ClientAuthRequired {
+		clientRootCAFiles = tlsConf.ClientRootCAs
+	}
+	load := comm.FileTLSLoader(certFile, keyFile, clientRootCAFiles...)
+	return func() (*comm.SecureOptions, error) {
+		secOpts, err := load()
 		if err != nil {
-			msg := "Failed to update trusted roots for orderer from latest config " +
-				"block.  This orderer may not be able to communicate " +
-				"with members of channel %s (%s)"
-			logger.Warningf(msg, cm.ConfigtxValidator().ChainID(), err)
+			return nil, err
+		}
+
+		mgr.Lock()
+		defer mgr.Unlock()
+		if tlsConf.ClientAuthRequired {
+			mgr.clientRootCAs = secOpts.ClientRootCAs
 		}
+		secOpts.ClientRootCAs = mgr.trustedRoots()
+		return secOpts, nil
 	}
 }
 
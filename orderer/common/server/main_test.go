@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/hyperledger/fabric/bccsp/factory"
+	"github.com/hyperledger/fabric/common/certmonitor"
 	"github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/common/crypto/tlsgen"
 	deliver_mocks "github.com/hyperledger/fabric/common/deliver/mock"
@@ -41,6 +42,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -277,10 +279,55 @@ func TestInitializeMultiChainManager(t *testing.T) {
 		initializeLocalMsp(conf)
 		lf, _ := createLedgerFactory(conf)
 		bootBlock := encoder.New(genesisconfig.Load(genesisconfig.SampleDevModeSoloProfile)).GenesisBlockForChannel("system")
-		initializeMultichannelRegistrar(bootBlock, &replicationInitiator{}, &cluster.PredicateDialer{}, comm.ServerConfig{}, nil, conf, localmsp.NewSigner(), &disabled.Provider{}, &server_mocks.HealthChecker{}, lf)
+		initializeMultichannelRegistrar(bootBlock, &replicationInitiator{}, &cluster.PredicateDialer{}, comm.ServerConfig{}, nil, conf, localmsp.NewSigner(), &disabled.Provider{}, &server_mocks.HealthChecker{}, lf, "")
 	})
 }
 
+func TestCertMonitorSources(t *testing.T) {
+	cleanup := configtest.SetDevFabricConfigPath(t)
+	defer cleanup()
+	conf := genesisConfig(t)
+	conf.General.TLS = localconfig.TLS{
+		Enabled:     true,
+		Certificate: filepath.Join("testdata", "tls", "server.crt"),
+		RootCAs:     []string{filepath.Join("testdata", "tls", "ca.crt")},
+	}
+	initializeLocalMsp(conf)
+	lf, _ := createLedgerFactory(conf)
+	bootBlock := encoder.New(genesisconfig.Load(genesisconfig.SampleDevModeSoloProfile)).GenesisBlockForChannel("system")
+	registrar := initializeMultichannelRegistrar(bootBlock, &replicationInitiator{}, &cluster.PredicateDialer{}, comm.ServerConfig{}, nil, conf, localmsp.NewSigner(), &disabled.Provider{}, &server_mocks.HealthChecker{}, lf, "")
+
+	sources := certMonitorSources(conf, registrar)
+	require.Len(t, sources, 3)
+
+	localMSPCerts, err := sources[0]()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, localMSPCerts)
+	for _, c := range localMSPCerts {
+		assert.Equal(t, certmonitor.KindLocalMSP, c.Kind)
+		assert.Equal(t, "SampleOrg", c.MSPID)
+	}
+
+	tlsCerts, err := sources[1]()
+	assert.NoError(t, err)
+	assert.Len(t, tlsCerts, 2)
+	for _, c := range tlsCerts {
+		assert.Equal(t, certmonitor.KindTLS, c.Kind)
+	}
+
+	channelCerts, err := sources[2]()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, channelCerts)
+	for _, c := range channelCerts {
+		assert.Equal(t, certmonitor.KindChannelMSP, c.Kind)
+		assert.Equal(t, genesisconfig.TestChainID, c.Channel)
+	}
+
+	conf.General.TLS.Certificate = "nonexistent"
+	_, err = sources[1]()
+	assert.EqualError(t, err, "failed reading nonexistent: open nonexistent: no such file or directory")
+}
+
 func TestInitializeGrpcServer(t *testing.T) {
 	// get a free random port
 	listenAddr := func() string {
@@ -340,7 +387,7 @@ func TestUpdateTrustedRoots(t *testing.T) {
 	}
 	lf, _ := createLedgerFactory(conf)
 	bootBlock := encoder.New(genesisconfig.Load(genesisconfig.SampleDevModeSoloProfile)).GenesisBlockForChannel("system")
-	initializeMultichannelRegistrar(bootBlock, &replicationInitiator{}, &cluster.PredicateDialer{}, comm.ServerConfig{}, nil, genesisConfig(t), localmsp.NewSigner(), &disabled.Provider{}, &server_mocks.HealthChecker{}, lf, callback)
+	initializeMultichannelRegistrar(bootBlock, &replicationInitiator{}, &cluster.PredicateDialer{}, comm.ServerConfig{}, nil, genesisConfig(t), localmsp.NewSigner(), &disabled.Provider{}, &server_mocks.HealthChecker{}, lf, "", callback)
 	t.Logf("# app CAs: %d", len(caMgr.appRootCAsByChain[genesisconfig.TestChainID]))
 	t.Logf("# orderer CAs: %d", len(caMgr.ordererRootCAsByChain[genesisconfig.TestChainID]))
 	// mutual TLS not required so no updates should have occurred
@@ -377,7 +424,7 @@ func TestUpdateTrustedRoots(t *testing.T) {
 			caMgr.updateClusterDialer(predDialer, clusterConf.SecOpts.ServerRootCAs)
 		}
 	}
-	initializeMultichannelRegistrar(bootBlock, &replicationInitiator{}, &cluster.PredicateDialer{}, comm.ServerConfig{}, nil, genesisConfig(t), localmsp.NewSigner(), &disabled.Provider{}, &server_mocks.HealthChecker{}, lf, callback)
+	initializeMultichannelRegistrar(bootBlock, &replicationInitiator{}, &cluster.PredicateDialer{}, comm.ServerConfig{}, nil, genesisConfig(t), localmsp.NewSigner(), &disabled.Provider{}, &server_mocks.HealthChecker{}, lf, "", callback)
 	t.Logf("# app CAs: %d", len(caMgr.appRootCAsByChain[genesisconfig.TestChainID]))
 	t.Logf("# orderer CAs: %d", len(caMgr.ordererRootCAsByChain[genesisconfig.TestChainID]))
 	// mutual TLS is required so updates should have occurred
@@ -388,6 +435,41 @@ func TestUpdateTrustedRoots(t *testing.T) {
 	grpcServer.Listener().Close()
 }
 
+func TestCAManagerTLSLoader(t *testing.T) {
+	certFile := filepath.Join("testdata", "tls", "server.crt")
+	keyFile := filepath.Join("testdata", "tls", "server.key")
+	caFile := filepath.Join("testdata", "tls", "ca.crt")
+	caPEM, err := ioutil.ReadFile(caFile)
+	assert.NoError(t, err)
+
+	caMgr := &caManager{
+		appRootCAsByChain:     map[string][][]byte{"mychannel": {[]byte("app root")}},
+		ordererRootCAsByChain: map[string][][]byte{"mychannel": {[]byte("orderer root")}},
+		clientRootCAs:         [][]byte{[]byte("stale root")},
+	}
+
+	tlsConf := localconfig.TLS{
+		Enabled:            true,
+		ClientAuthRequired: true,
+		ClientRootCAs:      []string{caFile},
+	}
+	secOpts, err := caMgr.tlsLoader(certFile, keyFile, tlsConf)()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, secOpts.Certificate)
+	assert.NotEmpty(t, secOpts.Key)
+	assert.ElementsMatch(t, [][]byte{[]byte("app root"), []byte("orderer root"), caPEM}, secOpts.ClientRootCAs)
+	assert.Equal(t, [][]byte{caPEM}, caMgr.clientRootCAs)
+
+	// the static roots are only reloaded when client authentication is required
+	tlsConf.ClientAuthRequired = false
+	secOpts, err = caMgr.tlsLoader(certFile, keyFile, tlsConf)()
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, [][]byte{[]byte("app root"), []byte("orderer root"), caPEM}, secOpts.ClientRootCAs)
+
+	_, err = caMgr.tlsLoader("missing.crt", keyFile, tlsConf)()
+	assert.Error(t, err)
+}
+
 func TestConfigureClusterListener(t *testing.T) {
 	logEntries := make(chan string, 100)
 
@@ -605,7 +687,7 @@ func TestInitializeEtcdraftConsenter(t *testing.T) {
 				Key:         crt.Key,
 				UseTLS:      true,
 			},
-		}, srv, &multichannel.Registrar{}, &disabled.Provider{})
+		}, srv, &multichannel.Registrar{}, &disabled.Provider{}, &server_mocks.HealthChecker{})
 	assert.NotNil(t, consenters["etcdraft"])
 }
 
@@ -8,6 +8,11 @@ import (
 )
 
 type HealthChecker struct {
+	DeregisterCheckerStub        func(string)
+	deregisterCheckerMutex       sync.RWMutex
+	deregisterCheckerArgsForCall []struct {
+		arg1 string
+	}
 	RegisterCheckerStub        func(string, healthz.HealthChecker) error
 	registerCheckerMutex       sync.RWMutex
 	registerCheckerArgsForCall []struct {
@@ -24,6 +29,37 @@ type HealthChecker struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *HealthChecker) DeregisterChecker(arg1 string) {
+	fake.deregisterCheckerMutex.Lock()
+	fake.deregisterCheckerArgsForCall = append(fake.deregisterCheckerArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeregisterChecker", []interface{}{arg1})
+	fake.deregisterCheckerMutex.Unlock()
+	if fake.DeregisterCheckerStub != nil {
+		fake.DeregisterCheckerStub(arg1)
+	}
+}
+
+func (fake *HealthChecker) DeregisterCheckerCallCount() int {
+	fake.deregisterCheckerMutex.RLock()
+	defer fake.deregisterCheckerMutex.RUnlock()
+	return len(fake.deregisterCheckerArgsForCall)
+}
+
+func (fake *HealthChecker) DeregisterCheckerCalls(stub func(string)) {
+	fake.deregisterCheckerMutex.Lock()
+	defer fake.deregisterCheckerMutex.Unlock()
+	fake.DeregisterCheckerStub = stub
+}
+
+func (fake *HealthChecker) DeregisterCheckerArgsForCall(i int) string {
+	fake.deregisterCheckerMutex.RLock()
+	defer fake.deregisterCheckerMutex.RUnlock()
+	argsForCall := fake.deregisterCheckerArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *HealthChecker) RegisterChecker(arg1 string, arg2 healthz.HealthChecker) error {
 	fake.registerCheckerMutex.Lock()
 	ret, specificReturn := fake.registerCheckerReturnsOnCall[len(fake.registerCheckerArgsForCall)]
@@ -88,6 +124,8 @@ func (fake *HealthChecker) RegisterCheckerReturnsOnCall(i int, result1 error) {
 func (fake *HealthChecker) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.deregisterCheckerMutex.RLock()
+	defer fake.deregisterCheckerMutex.RUnlock()
 	fake.registerCheckerMutex.RLock()
 	defer fake.registerCheckerMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -248,6 +248,9 @@ type Factory interface {
 	// ChainIDs returns the chain IDs the Factory is aware of
 	ChainIDs() []string
 
+	// Remove removes the ledger of the given chain and all of its blocks
+	Remove(chainID string) error
+
 	// Close releases all resources acquired by the factory
 	Close()
 }
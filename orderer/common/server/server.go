@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package server
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -17,12 +18,14 @@ import (
 	"github.com/hyperledger/fabric/common/deliver"
 	"github.com/hyperledger/fabric/common/metrics"
 	"github.com/hyperledger/fabric/common/policies"
+	"github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/orderer/common/broadcast"
 	localconfig "github.com/hyperledger/fabric/orderer/common/localconfig"
 	"github.com/hyperledger/fabric/orderer/common/msgprocessor"
 	"github.com/hyperledger/fabric/orderer/common/multichannel"
 	cb "github.com/hyperledger/fabric/protos/common"
 	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protoutil"
 	"github.com/pkg/errors"
 )
 
@@ -64,7 +67,7 @@ func (rs *responseSender) SendStatusResponse(status cb.Status) error {
 	return rs.Send(reply)
 }
 
-func (rs *responseSender) SendBlockResponse(block *cb.Block) error {
+func (rs *responseSender) SendBlockResponse(block *cb.Block, channelID string, chain deliver.Chain, signedData *protoutil.SignedData) error {
 	response := &ab.DeliverResponse{
 		Type: &ab.DeliverResponse_Block{Block: block},
 	}
@@ -188,3 +191,8 @@ func (s *server) Deliver(srv ab.AtomicBroadcast_DeliverServer) error {
 	}
 	return s.dh.Handle(srv.Context(), deliverServer)
 }
+
+// Validate checks a config update against the current channel config without ordering it
+func (s *server) Validate(ctx context.Context, env *cb.Envelope) (*ab.ConfigUpdateValidationResponse, error) {
+	return s.bh.ValidateConfigUpdate(env, util.ExtractRemoteAddress(ctx)), nil
+}
@@ -15,11 +15,14 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/orderer/common/broadcast"
+	broadcastmock "github.com/hyperledger/fabric/orderer/common/broadcast/mock"
 	localconfig "github.com/hyperledger/fabric/orderer/common/localconfig"
 	"github.com/hyperledger/fabric/orderer/common/multichannel"
 	cb "github.com/hyperledger/fabric/protos/common"
 	ab "github.com/hyperledger/fabric/protos/orderer"
 	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
@@ -35,6 +38,20 @@ func TestDeliverNoPanic(t *testing.T) {
 	_ = (&server{}).Deliver(nil)
 }
 
+func TestValidateConfigUpdate(t *testing.T) {
+	registrar := &broadcastmock.ChannelSupportRegistrar{}
+	registrar.BroadcastChannelSupportReturns(nil, false, nil, errors.New("channel not found"))
+
+	var s ab.ConfigUpdateValidatorServer = &server{
+		bh: &broadcast.Handler{SupportRegistrar: registrar},
+	}
+	resp, err := s.Validate(context.Background(), &cb.Envelope{})
+	assert.NoError(t, err)
+	assert.Equal(t, cb.Status_BAD_REQUEST, resp.Status)
+	assert.Equal(t, "channel not found", resp.Info)
+	assert.Equal(t, 1, registrar.BroadcastChannelSupportCallCount())
+}
+
 type recvr interface {
 	Recv() (*cb.Envelope, error)
 }
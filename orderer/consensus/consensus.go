@@ -72,17 +72,44 @@ type Chain interface {
 	MigrationStatus() migration.Status
 }
 
+// LeaderReporter is implemented by chains whose consensus protocol elects a leader.
+type LeaderReporter interface {
+	// Leader returns the ID of the current leader of the chain,
+	// or 0 if no leader is known.
+	Leader() uint64
+}
+
+// MessageAuthenticator signs and authenticates messages exchanged between
+// the ordering service nodes of a channel. Consenters which cannot trust
+// their peers (such as BFT consenters) use it to bind every intra-cluster
+// message to the identity of the orderer which sent it.
+type MessageAuthenticator interface {
+	// SignMessage signs the given message with the identity of this orderer.
+	SignMessage(msg []byte) (*protoutil.SignedData, error)
+
+	// VerifyMessage verifies that the given message was signed by
+	// an ordering service node of the channel.
+	VerifyMessage(*protoutil.SignedData) error
+}
+
 //go:generate counterfeiter -o mocks/mock_consenter_support.go . ConsenterSupport
 
 // ConsenterSupport provides the resources available to a Consenter implementation.
 type ConsenterSupport interface {
 	crypto.LocalSigner
 	msgprocessor.Processor
+	MessageAuthenticator
 
 	// VerifyBlockSignature verifies a signature of a block with a given optional
 	// configuration (can be nil).
 	VerifyBlockSignature([]*protoutil.SignedData, *cb.ConfigEnvelope) error
 
+	// SignBlock returns the signature of this orderer over the header of the given block,
+	// as it is placed in the block's signatures metadata when the block is written.
+	// Consenters which require blocks to be validated by several orderers exchange
+	// these signatures and check the ones they receive with VerifyBlockSignature.
+	SignBlock(block *cb.Block) (*cb.MetadataSignature, error)
+
 	// BlockCutter returns the block cutting helper for this channel.
 	BlockCutter() blockcutter.Receiver
 
@@ -98,6 +125,8 @@ type ConsenterSupport interface {
 	Block(number uint64) *cb.Block
 
 	// WriteBlock commits a block to the ledger.
+	// Signatures of other orderers over the block, placed in its signatures
+	// metadata beforehand, are kept alongside the signature of this orderer.
 	WriteBlock(block *cb.Block, encodedMetadataValue []byte)
 
 	// WriteConfigBlock commits a block to the ledger, and applies the config update inside.
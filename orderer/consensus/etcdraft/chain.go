@@ -149,6 +149,10 @@ type Chain struct {
 	configInflight       bool // this is true when there is config block or ConfChange in flight
 	blockInflight        int  // number of in flight blocks
 
+	blockCutTime map[uint64]time.Time // cut time of each in flight block, for commit latency metrics
+
+	signatures *signatureCollector // signatures of other consenters over the blocks proposed by this node
+
 	clock clock.Clock // Tests can inject a fake clock
 
 	support consensus.ConsenterSupport
@@ -241,6 +245,7 @@ func NewChain(
 		confState:        cc,
 		createPuller:     f,
 		clock:            opts.Clock,
+		signatures:       newSignatureCollector(),
 		Metrics: &Metrics{
 			ClusterSize:          opts.Metrics.ClusterSize.With("channel", support.ChainID()),
 			IsLeader:             opts.Metrics.IsLeader.With("channel", support.ChainID()),
@@ -248,6 +253,10 @@ func NewChain(
 			SnapshotBlockNumber:  opts.Metrics.SnapshotBlockNumber.With("channel", support.ChainID()),
 			LeaderChanges:        opts.Metrics.LeaderChanges.With("channel", support.ChainID()),
 			ProposalFailures:     opts.Metrics.ProposalFailures.With("channel", support.ChainID()),
+			LeaderID:             opts.Metrics.LeaderID.With("channel", support.ChainID()),
+			InflightBlocks:       opts.Metrics.InflightBlocks.With("channel", support.ChainID()),
+			ConfigSequenceLag:    opts.Metrics.ConfigSequenceLag.With("channel", support.ChainID()),
+			BlockCommitLatency:   opts.Metrics.BlockCommitLatency.With("channel", support.ChainID()),
 		},
 		logger:          lg,
 		opts:            opts,
@@ -456,6 +465,32 @@ func (c *Chain) Halt() {
 	<-c.doneC
 }
 
+// Leader returns the ID of the current Raft leader, or 0 if there is none.
+func (c *Chain) Leader() uint64 {
+	return atomic.LoadUint64(&c.lastKnownLeader)
+}
+
+// HealthCheck reports whether the chain has a Raft leader, without which it
+// cannot order transactions. A chain which was halted is not checked.
+func (c *Chain) HealthCheck(ctx context.Context) error {
+	select {
+	case <-c.startC:
+	default:
+		return errors.Errorf("chain is not started")
+	}
+
+	select {
+	case <-c.doneC:
+		return nil
+	default:
+	}
+
+	if c.Leader() == raft.None {
+		return errors.Errorf("chain has no Raft leader")
+	}
+	return nil
+}
+
 func (c *Chain) isRunning() error {
 	select {
 	case <-c.startC:
@@ -472,12 +507,17 @@ func (c *Chain) isRunning() error {
 	return nil
 }
 
-// Consensus passes the given ConsensusRequest message to the raft.Node instance
+// Consensus passes the given ConsensusRequest message to the raft.Node instance,
+// unless it carries a block signature in its metadata.
 func (c *Chain) Consensus(req *orderer.ConsensusRequest, sender uint64) error {
 	if err := c.isRunning(); err != nil {
 		return err
 	}
 
+	if len(req.Metadata) > 0 {
+		return c.onBlockSignature(req.Metadata, sender)
+	}
+
 	stepMsg := &raftpb.Message{}
 	if err := proto.Unmarshal(req.Payload, stepMsg); err != nil {
 		return fmt.Errorf("failed to unmarshal StepRequest payload to Raft Message: %s", err)
@@ -570,6 +610,8 @@ func (c *Chain) serveRequest() {
 		c.Metrics.IsLeader.Set(1)
 
 		c.blockInflight = 0
+		c.blockCutTime = map[uint64]time.Time{}
+		c.Metrics.InflightBlocks.Set(0)
 		c.justElected = true
 		submitC = nil
 		ch := make(chan *common.Block, c.opts.MaxInflightMsgs)
@@ -596,6 +638,11 @@ func (c *Chain) serveRequest() {
 			for {
 				select {
 				case b := <-ch:
+					if err := c.collectSignatures(ctx, b); err != nil {
+						c.logger.Errorf("Failed to collect signatures of block %d and discard %d blocks in queue: %s", b.Header.Number, len(ch), err)
+						return
+					}
+
 					data := protoutil.MarshalOrPanic(b)
 					if err := c.Node.Propose(ctx, data); err != nil {
 						c.logger.Errorf("Failed to propose block %d to raft and discard %d blocks in queue: %s", b.Header.Number, len(ch), err)
@@ -616,6 +663,8 @@ func (c *Chain) serveRequest() {
 	becomeFollower := func() {
 		cancelProp()
 		c.blockInflight = 0
+		c.blockCutTime = nil
+		c.Metrics.InflightBlocks.Set(0)
 		_ = c.support.BlockCutter().Cut()
 		stop()
 		submitC = c.submitC
@@ -668,6 +717,7 @@ func (c *Chain) serveRequest() {
 				if newLeader != soft.Lead {
 					c.logger.Infof("Raft leader changed: %d -> %d", soft.Lead, newLeader)
 					c.Metrics.LeaderChanges.Add(1)
+					c.Metrics.LeaderID.Set(float64(newLeader))
 
 					atomic.StoreUint64(&c.lastKnownLeader, newLeader)
 
@@ -789,6 +839,11 @@ func (c *Chain) serveRequest() {
 func (c *Chain) writeBlock(block *common.Block, index uint64) {
 	if c.blockInflight > 0 {
 		c.blockInflight-- // only reduce on leader
+		c.Metrics.InflightBlocks.Set(float64(c.blockInflight))
+	}
+	if cutTime, ok := c.blockCutTime[block.Header.Number]; ok {
+		c.Metrics.BlockCommitLatency.Observe(c.clock.Since(cutTime).Seconds())
+		delete(c.blockCutTime, block.Header.Number)
 	}
 	c.lastBlock = block
 
@@ -816,6 +871,12 @@ func (c *Chain) writeBlock(block *common.Block, index uint64) {
 func (c *Chain) ordered(msg *orderer.SubmitRequest) (batches [][]*common.Envelope, pending bool, err error) {
 	seq := c.support.Sequence()
 
+	if msg.LastValidationSeq < seq {
+		c.Metrics.ConfigSequenceLag.Set(float64(seq - msg.LastValidationSeq))
+	} else {
+		c.Metrics.ConfigSequenceLag.Set(0)
+	}
+
 	if c.isConfig(msg.Payload) {
 		// ConfigMsg
 		if msg.LastValidationSeq < seq {
@@ -860,6 +921,8 @@ func (c *Chain) propose(ch chan<- *common.Block, bc *blockCreator, batches ...[]
 		}
 
 		c.blockInflight++
+		c.blockCutTime[b.Header.Number] = c.clock.Now()
+		c.Metrics.InflightBlocks.Set(float64(c.blockInflight))
 	}
 
 	return
@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package etcdraft_test
 
 import (
+	"context"
 	"encoding/pem"
 	"fmt"
 	"io/ioutil"
@@ -218,6 +219,10 @@ var _ = Describe("Chain", func() {
 					fakeFields.fakeSnapshotBlockNumber,
 					fakeFields.fakeLeaderChanges,
 					fakeFields.fakeProposalFailures,
+					fakeFields.fakeLeaderID,
+					fakeFields.fakeInflightBlocks,
+					fakeFields.fakeConfigSequenceLag,
+					fakeFields.fakeBlockCommitLatency,
 				}
 				for _, m := range metricsList {
 					Expect(m.WithCallCount()).To(Equal(1))
@@ -240,6 +245,10 @@ var _ = Describe("Chain", func() {
 				Expect(fakeFields.fakeProposalFailures.AddCallCount()).To(Equal(1))
 				Expect(fakeFields.fakeProposalFailures.AddArgsForCall(0)).To(Equal(float64(1)))
 			})
+
+			It("fails the health check", func() {
+				Expect(chain.HealthCheck(context.Background())).To(MatchError("chain has no Raft leader"))
+			})
 		})
 
 		Context("when Raft leader is elected", func() {
@@ -252,6 +261,16 @@ var _ = Describe("Chain", func() {
 				Expect(fakeFields.fakeIsLeader.SetArgsForCall(1)).To(Equal(float64(1)))
 				Expect(fakeFields.fakeLeaderChanges.AddCallCount()).To(Equal(1))
 				Expect(fakeFields.fakeLeaderChanges.AddArgsForCall(0)).To(Equal(float64(1)))
+				Expect(fakeFields.fakeLeaderID.SetCallCount()).To(Equal(1))
+				Expect(fakeFields.fakeLeaderID.SetArgsForCall(0)).To(Equal(float64(1)))
+			})
+
+			It("reports itself as the leader", func() {
+				Eventually(chain.Leader, LongEventualTimeout).Should(Equal(uint64(1)))
+			})
+
+			It("passes the health check", func() {
+				Eventually(func() error { return chain.HealthCheck(context.Background()) }, LongEventualTimeout).Should(Succeed())
 			})
 
 			It("fails to order envelope if chain is halted", func() {
@@ -272,6 +291,10 @@ var _ = Describe("Chain", func() {
 				Eventually(support.WriteBlockCallCount, LongEventualTimeout).Should(Equal(1))
 				Expect(fakeFields.fakeCommittedBlockNumber.SetCallCount()).Should(Equal(1))
 				Expect(fakeFields.fakeCommittedBlockNumber.SetArgsForCall(0)).Should(Equal(float64(1)))
+				Expect(fakeFields.fakeBlockCommitLatency.ObserveCallCount()).Should(Equal(1))
+				Expect(fakeFields.fakeInflightBlocks.SetCallCount()).Should(Equal(3))
+				Expect(fakeFields.fakeInflightBlocks.SetArgsForCall(1)).Should(Equal(float64(1)))
+				Expect(fakeFields.fakeInflightBlocks.SetArgsForCall(2)).Should(Equal(float64(0)))
 
 				By("respecting batch timeout")
 				cutter.CutNext = false
@@ -394,6 +417,8 @@ var _ = Describe("Chain", func() {
 					err := chain.Order(env, 0)
 					Expect(err).NotTo(HaveOccurred())
 					Eventually(cutter.CurBatch, LongEventualTimeout).Should(HaveLen(1))
+					Expect(fakeFields.fakeConfigSequenceLag.SetCallCount()).To(Equal(1))
+					Expect(fakeFields.fakeConfigSequenceLag.SetArgsForCall(0)).To(Equal(float64(1)))
 				})
 
 				It("does not enqueue if envelope is not valid", func() {
@@ -3250,6 +3275,10 @@ func newFakeMetrics(fakeFields *fakeMetricsFields) *etcdraft.Metrics {
 		SnapshotBlockNumber:  fakeFields.fakeSnapshotBlockNumber,
 		LeaderChanges:        fakeFields.fakeLeaderChanges,
 		ProposalFailures:     fakeFields.fakeProposalFailures,
+		LeaderID:             fakeFields.fakeLeaderID,
+		InflightBlocks:       fakeFields.fakeInflightBlocks,
+		ConfigSequenceLag:    fakeFields.fakeConfigSequenceLag,
+		BlockCommitLatency:   fakeFields.fakeBlockCommitLatency,
 	}
 }
 
@@ -3260,6 +3289,10 @@ type fakeMetricsFields struct {
 	fakeSnapshotBlockNumber  *metricsfakes.Gauge
 	fakeLeaderChanges        *metricsfakes.Counter
 	fakeProposalFailures     *metricsfakes.Counter
+	fakeLeaderID             *metricsfakes.Gauge
+	fakeInflightBlocks       *metricsfakes.Gauge
+	fakeConfigSequenceLag    *metricsfakes.Gauge
+	fakeBlockCommitLatency   *metricsfakes.Histogram
 }
 
 func newFakeMetricsFields() *fakeMetricsFields {
@@ -3270,6 +3303,10 @@ func newFakeMetricsFields() *fakeMetricsFields {
 		fakeSnapshotBlockNumber:  newFakeGauge(),
 		fakeLeaderChanges:        newFakeCounter(),
 		fakeProposalFailures:     newFakeCounter(),
+		fakeLeaderID:             newFakeGauge(),
+		fakeInflightBlocks:       newFakeGauge(),
+		fakeConfigSequenceLag:    newFakeGauge(),
+		fakeBlockCommitLatency:   newFakeHistogram(),
 	}
 }
 
@@ -3279,6 +3316,12 @@ func newFakeGauge() *metricsfakes.Gauge {
 	return fakeGauge
 }
 
+func newFakeHistogram() *metricsfakes.Histogram {
+	fakeHistogram := &metricsfakes.Histogram{}
+	fakeHistogram.WithReturns(fakeHistogram)
+	return fakeHistogram
+}
+
 func newFakeCounter() *metricsfakes.Counter {
 	fakeCounter := &metricsfakes.Counter{}
 	fakeCounter.WithReturns(fakeCounter)
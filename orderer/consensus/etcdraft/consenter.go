@@ -15,6 +15,7 @@ import (
 
 	"code.cloudfoundry.org/clock"
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-lib-go/healthz"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/metrics"
 	"github.com/hyperledger/fabric/common/viperutil"
@@ -47,6 +48,17 @@ type InactiveChainRegistry interface {
 	TrackChain(chainName string, genesisBlock *common.Block, createChain CreateChainCallback)
 }
 
+//go:generate mockery -dir . -name HealthCheckRegistry -case underscore -output mocks
+
+// HealthCheckRegistry registers the health checkers of the chains, which
+// report whether the chains have a Raft leader
+type HealthCheckRegistry interface {
+	// RegisterChecker registers the health checker of the given component.
+	RegisterChecker(component string, checker healthz.HealthChecker) error
+	// DeregisterChecker deregisters the health checker of the given component.
+	DeregisterChecker(component string)
+}
+
 //go:generate mockery -dir . -name ChainGetter -case underscore -output mocks
 
 // ChainGetter obtains instances of ChainSupport for the given channel
@@ -68,6 +80,7 @@ type Config struct {
 type Consenter struct {
 	CreateChain           func(chainName string)
 	InactiveChainRegistry InactiveChainRegistry
+	HealthCheckRegistry   HealthCheckRegistry
 	Dialer                *cluster.PredicateDialer
 	Communication         cluster.Communicator
 	*Dispatcher
@@ -162,6 +175,7 @@ func (c *Consenter) HandleChain(support consensus.ConsenterSupport, metadata *co
 
 	id, err := c.detectSelfID(raftMetadata.Consenters)
 	if err != nil {
+		c.HealthCheckRegistry.DeregisterChecker(support.ChainID())
 		c.InactiveChainRegistry.TrackChain(support.ChainID(), support.Block(0), func() {
 			c.CreateChain(support.ChainID())
 		})
@@ -213,7 +227,7 @@ func (c *Consenter) HandleChain(support consensus.ConsenterSupport, metadata *co
 		Comm:          c.Communication,
 		StreamsByType: cluster.NewStreamsByType(),
 	}
-	return NewChain(
+	chain, err := NewChain(
 		support,
 		opts,
 		c.Communication,
@@ -221,6 +235,15 @@ func (c *Consenter) HandleChain(support consensus.ConsenterSupport, metadata *co
 		func() (BlockPuller, error) { return newBlockPuller(support, c.Dialer, c.OrdererConfig.General.Cluster) },
 		nil,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	// the chain replaces the checker of a chain previously created for the
+	// channel, such as when the channel is activated after its onboarding
+	c.HealthCheckRegistry.DeregisterChecker(support.ChainID())
+	c.HealthCheckRegistry.RegisterChecker(support.ChainID(), chain)
+	return chain, nil
 }
 
 // ReadRaftMetadata attempts to read raft metadata from block metadata, if available.
@@ -255,6 +278,7 @@ func New(
 	r *multichannel.Registrar,
 	icr InactiveChainRegistry,
 	metricsProvider metrics.Provider,
+	hcr HealthCheckRegistry,
 ) *Consenter {
 	logger := flogging.MustGetLogger("orderer.consensus.etcdraft")
 
@@ -273,6 +297,7 @@ func New(
 		Dialer:                clusterDialer,
 		Metrics:               NewMetrics(metricsProvider),
 		InactiveChainRegistry: icr,
+		HealthCheckRegistry:   hcr,
 	}
 	consenter.Dispatcher = &Dispatcher{
 		Logger:        logger,
@@ -181,6 +181,7 @@ var _ = Describe("Consenter", func() {
 
 		Expect(chain.Start).NotTo(Panic())
 		Expect(defaultSuspicionFallback).To(BeTrue())
+		consenter.hcr.AssertCalled(testingInstance, "RegisterChecker", support.ChainID(), chain)
 	})
 
 	It("fails to handle chain if no matching cert found", func() {
@@ -213,6 +214,8 @@ var _ = Describe("Consenter", func() {
 		Expect(err).To(Not(HaveOccurred()))
 		Expect(chain.Order(nil, 0).Error()).To(Equal("channel foo is not serviced by me"))
 		consenter.icr.AssertNumberOfCalls(testingInstance, "TrackChain", 1)
+		consenter.hcr.AssertCalled(testingInstance, "DeregisterChecker", "foo")
+		consenter.hcr.AssertNotCalled(testingInstance, "RegisterChecker", mock.Anything, mock.Anything)
 	})
 
 	It("fails to handle chain if etcdraft options have not been provided", func() {
@@ -269,6 +272,7 @@ var _ = Describe("Consenter", func() {
 type consenter struct {
 	*etcdraft.Consenter
 	icr *mocks.InactiveChainRegistry
+	hcr *mocks.HealthCheckRegistry
 }
 
 func newConsenter(chainGetter *mocks.ChainGetter) *consenter {
@@ -278,8 +282,12 @@ func newConsenter(chainGetter *mocks.ChainGetter) *consenter {
 	communicator.On("Configure", mock.Anything, mock.Anything)
 	icr := &mocks.InactiveChainRegistry{}
 	icr.On("TrackChain", "foo", mock.Anything, mock.Anything)
+	hcr := &mocks.HealthCheckRegistry{}
+	hcr.On("RegisterChecker", mock.Anything, mock.Anything).Return(nil)
+	hcr.On("DeregisterChecker", mock.Anything)
 	c := &etcdraft.Consenter{
 		InactiveChainRegistry: icr,
+		HealthCheckRegistry:   hcr,
 		Communication:         communicator,
 		Cert:                  []byte("cert.orderer0.org0"),
 		Logger:                flogging.MustGetLogger("test"),
@@ -297,5 +305,6 @@ func newConsenter(chainGetter *mocks.ChainGetter) *consenter {
 	return &consenter{
 		Consenter: c,
 		icr:       icr,
+		hcr:       hcr,
 	}
 }
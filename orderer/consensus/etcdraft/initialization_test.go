@@ -31,7 +31,7 @@ func TestNewEtcdRaftConsenter(t *testing.T) {
 				Certificate: []byte{1, 2, 3},
 			},
 		}, srv, &multichannel.Registrar{},
-		&mocks.InactiveChainRegistry{}, &disabled.Provider{})
+		&mocks.InactiveChainRegistry{}, &disabled.Provider{}, &mocks.HealthCheckRegistry{})
 
 	// Assert that the certificate from the gRPC server was passed to the consenter
 	assert.Equal(t, []byte{1, 2, 3}, consenter.Cert)
@@ -41,4 +41,5 @@ func TestNewEtcdRaftConsenter(t *testing.T) {
 	assert.NotNil(t, consenter.ChainSelector)
 	assert.NotNil(t, consenter.Dispatcher)
 	assert.NotNil(t, consenter.Logger)
+	assert.NotNil(t, consenter.HealthCheckRegistry)
 }
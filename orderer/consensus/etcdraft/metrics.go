@@ -49,6 +49,38 @@ var (
 		LabelNames:   []string{"channel"},
 		StatsdFormat: "%{#fqname}.%{channel}",
 	}
+	leaderIDOpts = metrics.GaugeOpts{
+		Namespace:    "consensus",
+		Subsystem:    "etcdraft",
+		Name:         "leader_id",
+		Help:         "The raft id of the current leader, 0 if there is no leader.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
+	inflightBlocksOpts = metrics.GaugeOpts{
+		Namespace:    "consensus",
+		Subsystem:    "etcdraft",
+		Name:         "inflight_blocks",
+		Help:         "The number of blocks proposed by the leader but not yet written to the ledger.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
+	configSequenceLagOpts = metrics.GaugeOpts{
+		Namespace:    "consensus",
+		Subsystem:    "etcdraft",
+		Name:         "config_sequence_lag",
+		Help:         "The number of config updates between the validation of the last ordered message and the current config sequence.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
+	blockCommitLatencyOpts = metrics.HistogramOpts{
+		Namespace:    "consensus",
+		Subsystem:    "etcdraft",
+		Name:         "block_commit_latency",
+		Help:         "The time in seconds from a block being cut by the leader until it is written to the ledger.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
 	proposalFailuresOpts = metrics.CounterOpts{
 		Namespace:    "consensus",
 		Subsystem:    "etcdraft",
@@ -66,6 +98,10 @@ type Metrics struct {
 	SnapshotBlockNumber  metrics.Gauge
 	LeaderChanges        metrics.Counter
 	ProposalFailures     metrics.Counter
+	LeaderID             metrics.Gauge
+	InflightBlocks       metrics.Gauge
+	ConfigSequenceLag    metrics.Gauge
+	BlockCommitLatency   metrics.Histogram
 }
 
 func NewMetrics(p metrics.Provider) *Metrics {
@@ -76,5 +112,9 @@ func NewMetrics(p metrics.Provider) *Metrics {
 		SnapshotBlockNumber:  p.NewGauge(snapshotBlockNumberOpts),
 		LeaderChanges:        p.NewCounter(leaderChangesOpts),
 		ProposalFailures:     p.NewCounter(proposalFailuresOpts),
+		LeaderID:             p.NewGauge(leaderIDOpts),
+		InflightBlocks:       p.NewGauge(inflightBlocksOpts),
+		ConfigSequenceLag:    p.NewGauge(configSequenceLagOpts),
+		BlockCommitLatency:   p.NewHistogram(blockCommitLatencyOpts),
 	}
 }
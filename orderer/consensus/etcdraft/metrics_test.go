@@ -16,26 +16,30 @@ import (
 var _ = Describe("Metrics", func() {
 	Context("NewMetrics", func() {
 		var (
-			fakeProvider *metricsfakes.Provider
-			fakeGauge    *metricsfakes.Gauge
-			fakeCounter  *metricsfakes.Counter
+			fakeProvider  *metricsfakes.Provider
+			fakeGauge     *metricsfakes.Gauge
+			fakeCounter   *metricsfakes.Counter
+			fakeHistogram *metricsfakes.Histogram
 		)
 
 		BeforeEach(func() {
 			fakeProvider = &metricsfakes.Provider{}
 			fakeGauge = &metricsfakes.Gauge{}
 			fakeCounter = &metricsfakes.Counter{}
+			fakeHistogram = &metricsfakes.Histogram{}
 
 			fakeProvider.NewGaugeReturns(fakeGauge)
 			fakeProvider.NewCounterReturns(fakeCounter)
+			fakeProvider.NewHistogramReturns(fakeHistogram)
 		})
 
 		It("uses the provider to initialize a new Metrics object", func() {
 			metrics := etcdraft.NewMetrics(fakeProvider)
 
 			Expect(metrics).NotTo(BeNil())
-			Expect(fakeProvider.NewGaugeCallCount()).To(Equal(4))
+			Expect(fakeProvider.NewGaugeCallCount()).To(Equal(7))
 			Expect(fakeProvider.NewCounterCallCount()).To(Equal(2))
+			Expect(fakeProvider.NewHistogramCallCount()).To(Equal(1))
 
 			Expect(metrics.ClusterSize).To(Equal(fakeGauge))
 			Expect(metrics.IsLeader).To(Equal(fakeGauge))
@@ -43,6 +47,10 @@ var _ = Describe("Metrics", func() {
 			Expect(metrics.SnapshotBlockNumber).To(Equal(fakeGauge))
 			Expect(metrics.LeaderChanges).To(Equal(fakeCounter))
 			Expect(metrics.ProposalFailures).To(Equal(fakeCounter))
+			Expect(metrics.LeaderID).To(Equal(fakeGauge))
+			Expect(metrics.InflightBlocks).To(Equal(fakeGauge))
+			Expect(metrics.ConfigSequenceLag).To(Equal(fakeGauge))
+			Expect(metrics.BlockCommitLatency).To(Equal(fakeHistogram))
 		})
 	})
 })
@@ -0,0 +1,30 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package mocks
+
+import healthz "github.com/hyperledger/fabric-lib-go/healthz"
+import mock "github.com/stretchr/testify/mock"
+
+// HealthCheckRegistry is an autogenerated mock type for the HealthCheckRegistry type
+type HealthCheckRegistry struct {
+	mock.Mock
+}
+
+// DeregisterChecker provides a mock function with given fields: component
+func (_m *HealthCheckRegistry) DeregisterChecker(component string) {
+	_m.Called(component)
+}
+
+// RegisterChecker provides a mock function with given fields: component, checker
+func (_m *HealthCheckRegistry) RegisterChecker(component string, checker healthz.HealthChecker) error {
+	ret := _m.Called(component, checker)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, healthz.HealthChecker) error); ok {
+		r0 = rf(component, checker)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,254 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package etcdraft
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+)
+
+// signatureCollector gathers the signatures that other consenters send
+// over the headers of the blocks this node is about to propose.
+type signatureCollector struct {
+	lock    sync.Mutex
+	pending map[uint64]*pendingBlock
+}
+
+type pendingBlock struct {
+	header     []byte
+	signatures map[uint64]*common.MetadataSignature
+	updated    chan struct{}
+}
+
+func newSignatureCollector() *signatureCollector {
+	return &signatureCollector{pending: map[uint64]*pendingBlock{}}
+}
+
+// track starts collecting signatures over the given header. The returned
+// channel is signaled whenever a new signature is collected.
+func (sc *signatureCollector) track(header *common.BlockHeader) <-chan struct{} {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	pb := &pendingBlock{
+		header:     protoutil.BlockHeaderBytes(header),
+		signatures: map[uint64]*common.MetadataSignature{},
+		updated:    make(chan struct{}, 1),
+	}
+	sc.pending[header.Number] = pb
+	return pb.updated
+}
+
+// untrack stops collecting signatures over the header of the given block.
+func (sc *signatureCollector) untrack(number uint64) {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	delete(sc.pending, number)
+}
+
+// add records the signature of the given sender, and returns false if
+// no signatures are being collected over the signed header.
+func (sc *signatureCollector) add(sender uint64, bs *orderer.BlockSignature) bool {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	pb, exists := sc.pending[bs.Header.Number]
+	if !exists || !bytes.Equal(pb.header, protoutil.BlockHeaderBytes(bs.Header)) {
+		return false
+	}
+
+	pb.signatures[sender] = bs.Signature
+	select {
+	case pb.updated <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// collected returns the signatures collected over the header of the given
+// block, ordered by the ID of the consenters which sent them.
+func (sc *signatureCollector) collected(number uint64) []*common.MetadataSignature {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	pb, exists := sc.pending[number]
+	if !exists {
+		return nil
+	}
+
+	var senders []uint64
+	for sender := range pb.signatures {
+		senders = append(senders, sender)
+	}
+	sort.Slice(senders, func(i, j int) bool { return senders[i] < senders[j] })
+
+	var signatures []*common.MetadataSignature
+	for _, sender := range senders {
+		signatures = append(signatures, pb.signatures[sender])
+	}
+	return signatures
+}
+
+// collectSignatures adds to the signatures metadata of the given block the signatures
+// of other consenters, until the block validation policy of the channel is satisfied.
+// If the signature of this node alone satisfies the policy, the block is left untouched.
+// Signature requests are sent again every election timeout, until ctx is done.
+func (c *Chain) collectSignatures(ctx context.Context, block *common.Block) error {
+	own, err := c.support.SignBlock(block)
+	if err != nil {
+		return err
+	}
+
+	signatureSet, err := blockSignatureSet(block.Header, own)
+	if err != nil {
+		return err
+	}
+	if err := c.support.VerifyBlockSignature(signatureSet, nil); err == nil {
+		return nil
+	}
+
+	updated := c.signatures.track(block.Header)
+	defer c.signatures.untrack(block.Header.Number)
+
+	request := &orderer.ConsensusRequest{
+		Channel:  c.channelID,
+		Metadata: protoutil.MarshalOrPanic(&orderer.BlockSignature{Header: block.Header, Signature: own}),
+	}
+	c.requestSignatures(request)
+
+	ticker := c.clock.NewTicker(c.opts.TickInterval * time.Duration(c.opts.ElectionTick))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-updated:
+			signatures := append([]*common.MetadataSignature{own}, c.signatures.collected(block.Header.Number)...)
+			signatureSet, err := blockSignatureSet(block.Header, signatures...)
+			if err != nil {
+				return err
+			}
+			if err := c.support.VerifyBlockSignature(signatureSet, nil); err != nil {
+				c.logger.Debugf("Collected %d signatures of block %d, which do not satisfy the block validation policy yet: %s",
+					len(signatures), block.Header.Number, err)
+				continue
+			}
+
+			block.Metadata.Metadata[common.BlockMetadataIndex_SIGNATURES] = protoutil.MarshalOrPanic(&common.Metadata{
+				Signatures: signatures,
+			})
+			c.logger.Debugf("Collected %d signatures of block %d", len(signatures), block.Header.Number)
+			return nil
+
+		case <-ticker.C():
+			c.logger.Warnf("Block %d is not signed by enough consenters yet, requesting their signatures again", block.Header.Number)
+			c.requestSignatures(request)
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// requestSignatures sends the given signature request to all other consenters of the channel.
+func (c *Chain) requestSignatures(request *orderer.ConsensusRequest) {
+	c.raftMetadataLock.RLock()
+	var nodes []uint64
+	for id := range c.opts.RaftMetadata.Consenters {
+		if id != c.raftID {
+			nodes = append(nodes, id)
+		}
+	}
+	c.raftMetadataLock.RUnlock()
+
+	for _, id := range nodes {
+		if err := c.rpc.SendConsensus(id, request); err != nil {
+			c.logger.Warnf("Failed requesting the signature of node %d: %s", id, err)
+		}
+	}
+}
+
+// onBlockSignature handles a block signature sent by another consenter. It is either
+// the answer to a signature request of this node, or a request of the leader to sign
+// the header of the block it is about to propose, in which case the signature of this
+// node is sent back to it.
+func (c *Chain) onBlockSignature(metadata []byte, sender uint64) error {
+	bs := &orderer.BlockSignature{}
+	if err := proto.Unmarshal(metadata, bs); err != nil {
+		return errors.Errorf("failed to unmarshal BlockSignature: %s", err)
+	}
+	if bs.Header == nil || bs.Signature == nil {
+		return errors.Errorf("malformed BlockSignature from node %d", sender)
+	}
+
+	signatureSet, err := blockSignatureSet(bs.Header, bs.Signature)
+	if err != nil {
+		return err
+	}
+	if err := c.support.VerifyMessage(signatureSet[0]); err != nil {
+		c.logger.Warnf("Discarding signature of block %d from node %d: %s", bs.Header.Number, sender, err)
+		return nil
+	}
+
+	if c.signatures.add(sender, bs) {
+		c.logger.Debugf("Collected signature of block %d from node %d", bs.Header.Number, sender)
+		return nil
+	}
+
+	if lead := atomic.LoadUint64(&c.lastKnownLeader); sender != lead {
+		c.logger.Debugf("Discarding signature of block %d from node %d, which is not the leader", bs.Header.Number, sender)
+		return nil
+	}
+
+	own, err := c.support.SignBlock(&common.Block{Header: bs.Header})
+	if err != nil {
+		c.logger.Errorf("Failed signing block %d for node %d: %s", bs.Header.Number, sender, err)
+		return nil
+	}
+
+	response := &orderer.ConsensusRequest{
+		Channel:  c.channelID,
+		Metadata: protoutil.MarshalOrPanic(&orderer.BlockSignature{Header: bs.Header, Signature: own}),
+	}
+	// The response is sent asynchronously in order not to block the stream the request was received on.
+	go func() {
+		if err := c.rpc.SendConsensus(sender, response); err != nil {
+			c.logger.Warnf("Failed sending signature of block %d to node %d: %s", bs.Header.Number, sender, err)
+		}
+	}()
+
+	return nil
+}
+
+// blockSignatureSet returns the signed data of the given signatures over a block header,
+// the same way it is constructed from the signatures metadata of a written block.
+func blockSignatureSet(header *common.BlockHeader, signatures ...*common.MetadataSignature) ([]*protoutil.SignedData, error) {
+	var signatureSet []*protoutil.SignedData
+	for _, signature := range signatures {
+		sigHdr, err := protoutil.GetSignatureHeader(signature.GetSignatureHeader())
+		if err != nil {
+			return nil, errors.Errorf("failed unmarshaling signature header of block %d: %s", header.Number, err)
+		}
+		signatureSet = append(signatureSet, &protoutil.SignedData{
+			Identity:  sigHdr.Creator,
+			Data:      util.ConcatenateBytes(signature.GetSignatureHeader(), protoutil.BlockHeaderBytes(header)),
+			Signature: signature.GetSignature(),
+		})
+	}
+	return signatureSet, nil
+}
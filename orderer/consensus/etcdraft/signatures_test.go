@@ -0,0 +1,313 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package etcdraft
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/orderer/consensus/mocks"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/orderer/etcdraft"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type sentRequest struct {
+	dest    uint64
+	request *orderer.ConsensusRequest
+}
+
+type signatureRPC struct {
+	sent chan sentRequest
+}
+
+func (rpc *signatureRPC) SendConsensus(dest uint64, request *orderer.ConsensusRequest) error {
+	rpc.sent <- sentRequest{dest: dest, request: request}
+	return nil
+}
+
+func (rpc *signatureRPC) SendSubmit(dest uint64, request *orderer.SubmitRequest) error {
+	panic("should not be called")
+}
+
+// signatureOf returns a block signature made by the given node.
+func signatureOf(node string) *common.MetadataSignature {
+	return &common.MetadataSignature{
+		SignatureHeader: protoutil.MarshalOrPanic(&common.SignatureHeader{Creator: []byte(node)}),
+		Signature:       []byte(fmt.Sprintf("signature of %s", node)),
+	}
+}
+
+// requireSigners makes VerifyBlockSignature accept only signature sets made by at least n nodes.
+func requireSigners(support *mocks.FakeConsenterSupport, n int) {
+	support.VerifyBlockSignatureStub = func(signatureSet []*protoutil.SignedData, _ *common.ConfigEnvelope) error {
+		if len(signatureSet) < n {
+			return errors.Errorf("expected %d signatures, got %d", n, len(signatureSet))
+		}
+		return nil
+	}
+}
+
+func newSigningChain(support *mocks.FakeConsenterSupport, rpc RPC) (*Chain, *fakeclock.FakeClock) {
+	clock := fakeclock.NewFakeClock(time.Now())
+	startC := make(chan struct{})
+	close(startC)
+	return &Chain{
+		startC:     startC,
+		doneC:      make(chan struct{}),
+		rpc:        rpc,
+		raftID:     1,
+		channelID:  "mychannel",
+		support:    support,
+		clock:      clock,
+		signatures: newSignatureCollector(),
+		logger:     flogging.MustGetLogger("test"),
+		opts: Options{
+			TickInterval: time.Second,
+			ElectionTick: 10,
+			RaftMetadata: &etcdraft.RaftMetadata{
+				Consenters: map[uint64]*etcdraft.Consenter{1: {}, 2: {}, 3: {}},
+			},
+		},
+	}, clock
+}
+
+func TestCollectSignaturesOwnSignatureSuffices(t *testing.T) {
+	support := &mocks.FakeConsenterSupport{}
+	support.SignBlockReturns(signatureOf("node1"), nil)
+	rpc := &signatureRPC{sent: make(chan sentRequest, 10)}
+	chain, _ := newSigningChain(support, rpc)
+
+	block := protoutil.NewBlock(5, []byte("previous"))
+	err := chain.collectSignatures(context.Background(), block)
+	assert.NoError(t, err)
+	assert.Empty(t, block.Metadata.Metadata[common.BlockMetadataIndex_SIGNATURES])
+	assert.Len(t, rpc.sent, 0)
+}
+
+func TestCollectSignatures(t *testing.T) {
+	support := &mocks.FakeConsenterSupport{}
+	support.SignBlockReturns(signatureOf("node1"), nil)
+	requireSigners(support, 2)
+	rpc := &signatureRPC{sent: make(chan sentRequest, 10)}
+	chain, clock := newSigningChain(support, rpc)
+
+	block := protoutil.NewBlock(5, []byte("previous"))
+	errC := make(chan error, 1)
+	go func() {
+		errC <- chain.collectSignatures(context.Background(), block)
+	}()
+
+	requests := map[uint64]*orderer.ConsensusRequest{}
+	for i := 0; i < 2; i++ {
+		sent := <-rpc.sent
+		requests[sent.dest] = sent.request
+	}
+	assert.Len(t, requests, 2)
+	assert.Contains(t, requests, uint64(2))
+	assert.Contains(t, requests, uint64(3))
+
+	request := &orderer.BlockSignature{}
+	assert.NoError(t, proto.Unmarshal(requests[2].Metadata, request))
+	assert.True(t, proto.Equal(block.Header, request.Header))
+	assert.True(t, proto.Equal(signatureOf("node1"), request.Signature))
+	assert.Empty(t, requests[2].Payload)
+
+	// signature requests are sent again on election timeout
+	clock.WaitForWatcherAndIncrement(10 * time.Second)
+	for i := 0; i < 2; i++ {
+		<-rpc.sent
+	}
+
+	// a signature over another header is not collected
+	otherHeader := proto.Clone(block.Header).(*common.BlockHeader)
+	otherHeader.DataHash = []byte("other")
+	err := chain.onBlockSignature(protoutil.MarshalOrPanic(&orderer.BlockSignature{
+		Header:    otherHeader,
+		Signature: signatureOf("node2"),
+	}), 2)
+	assert.NoError(t, err)
+
+	err = chain.onBlockSignature(protoutil.MarshalOrPanic(&orderer.BlockSignature{
+		Header:    block.Header,
+		Signature: signatureOf("node3"),
+	}), 3)
+	assert.NoError(t, err)
+
+	assert.NoError(t, <-errC)
+	metadata, err := protoutil.GetMetadataFromBlock(block, common.BlockMetadataIndex_SIGNATURES)
+	assert.NoError(t, err)
+	assert.Len(t, metadata.Signatures, 2)
+	assert.True(t, proto.Equal(signatureOf("node1"), metadata.Signatures[0]))
+	assert.True(t, proto.Equal(signatureOf("node3"), metadata.Signatures[1]))
+
+	// the signature set of the block is the one verified by the policy
+	signatureSet, err := blockSignatureSet(block.Header, metadata.Signatures...)
+	assert.NoError(t, err)
+	verified, _ := support.VerifyBlockSignatureArgsForCall(support.VerifyBlockSignatureCallCount() - 1)
+	assert.Equal(t, signatureSet, verified)
+}
+
+func TestCollectSignaturesAborted(t *testing.T) {
+	support := &mocks.FakeConsenterSupport{}
+	support.SignBlockReturns(signatureOf("node1"), nil)
+	requireSigners(support, 3)
+	rpc := &signatureRPC{sent: make(chan sentRequest, 10)}
+	chain, _ := newSigningChain(support, rpc)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	block := protoutil.NewBlock(5, []byte("previous"))
+	errC := make(chan error, 1)
+	go func() {
+		errC <- chain.collectSignatures(ctx, block)
+	}()
+
+	<-rpc.sent
+	<-rpc.sent
+	err := chain.onBlockSignature(protoutil.MarshalOrPanic(&orderer.BlockSignature{
+		Header:    block.Header,
+		Signature: signatureOf("node2"),
+	}), 2)
+	assert.NoError(t, err)
+
+	cancel()
+	assert.Equal(t, context.Canceled, <-errC)
+	assert.Empty(t, block.Metadata.Metadata[common.BlockMetadataIndex_SIGNATURES])
+	assert.Empty(t, chain.signatures.pending)
+}
+
+func TestCollectSignaturesSigningFailure(t *testing.T) {
+	support := &mocks.FakeConsenterSupport{}
+	support.SignBlockReturns(nil, errors.New("HSM is down"))
+	chain, _ := newSigningChain(support, &signatureRPC{})
+
+	err := chain.collectSignatures(context.Background(), protoutil.NewBlock(5, nil))
+	assert.EqualError(t, err, "HSM is down")
+}
+
+func TestOnBlockSignature(t *testing.T) {
+	header := protoutil.NewBlock(5, []byte("previous")).Header
+	request := protoutil.MarshalOrPanic(&orderer.BlockSignature{
+		Header:    header,
+		Signature: signatureOf("node2"),
+	})
+
+	for _, testCase := range []struct {
+		name          string
+		metadata      []byte
+		sender        uint64
+		verifyErr     error
+		expectedErr   string
+		expectedReply bool
+	}{
+		{
+			name:          "request from the leader",
+			metadata:      request,
+			sender:        2,
+			expectedReply: true,
+		},
+		{
+			name:     "request from a follower",
+			metadata: request,
+			sender:   3,
+		},
+		{
+			name:      "request not signed by an orderer",
+			metadata:  request,
+			sender:    2,
+			verifyErr: errors.New("message verification failed"),
+		},
+		{
+			name:        "garbage",
+			metadata:    []byte{1, 2, 3},
+			sender:      2,
+			expectedErr: "failed to unmarshal BlockSignature: proto: orderer.BlockSignature: illegal tag 0 (wire type 1)",
+		},
+		{
+			name:        "missing signature",
+			metadata:    protoutil.MarshalOrPanic(&orderer.BlockSignature{Header: header}),
+			sender:      2,
+			expectedErr: "malformed BlockSignature from node 2",
+		},
+	} {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			support := &mocks.FakeConsenterSupport{}
+			support.SignBlockReturns(signatureOf("node1"), nil)
+			support.VerifyMessageReturns(testCase.verifyErr)
+			rpc := &signatureRPC{sent: make(chan sentRequest, 1)}
+			chain, _ := newSigningChain(support, rpc)
+			chain.lastKnownLeader = 2
+
+			err := chain.Consensus(&orderer.ConsensusRequest{Metadata: testCase.metadata}, testCase.sender)
+			if testCase.expectedErr != "" {
+				assert.EqualError(t, err, testCase.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			if !testCase.expectedReply {
+				assert.Equal(t, 0, support.SignBlockCallCount())
+				return
+			}
+
+			signed := support.SignBlockArgsForCall(0)
+			assert.True(t, proto.Equal(header, signed.Header))
+
+			verified := support.VerifyMessageArgsForCall(0)
+			assert.Equal(t, []byte("node2"), verified.Identity)
+			assert.True(t, bytes.HasSuffix(verified.Data, protoutil.BlockHeaderBytes(header)))
+
+			sent := <-rpc.sent
+			assert.Equal(t, uint64(2), sent.dest)
+			reply := &orderer.BlockSignature{}
+			assert.NoError(t, proto.Unmarshal(sent.request.Metadata, reply))
+			assert.True(t, proto.Equal(header, reply.Header))
+			assert.True(t, proto.Equal(signatureOf("node1"), reply.Signature))
+		})
+	}
+}
+
+func TestSignatureCollectorConcurrency(t *testing.T) {
+	collector := newSignatureCollector()
+	header := protoutil.NewBlock(5, []byte("previous")).Header
+	updated := collector.track(header)
+
+	var wg sync.WaitGroup
+	for sender := uint64(2); sender < 10; sender++ {
+		wg.Add(1)
+		go func(sender uint64) {
+			defer wg.Done()
+			assert.True(t, collector.add(sender, &orderer.BlockSignature{
+				Header:    header,
+				Signature: signatureOf(fmt.Sprintf("node%d", sender)),
+			}))
+		}(sender)
+	}
+	wg.Wait()
+
+	<-updated
+	signatures := collector.collected(5)
+	assert.Len(t, signatures, 8)
+	for i, signature := range signatures {
+		assert.True(t, proto.Equal(signatureOf(fmt.Sprintf("node%d", i+2)), signature))
+	}
+
+	collector.untrack(5)
+	assert.Nil(t, collector.collected(5))
+	assert.False(t, collector.add(2, &orderer.BlockSignature{Header: header, Signature: signatureOf("node2")}))
+}
@@ -3713,6 +3713,20 @@ func (c *mockConsenterSupport) VerifyBlockSignature([]*protoutil.SignedData, *cb
 	return nil
 }
 
+func (c *mockConsenterSupport) SignBlock(block *cb.Block) (*cb.MetadataSignature, error) {
+	args := c.Called(block)
+	return args.Get(0).(*cb.MetadataSignature), args.Error(1)
+}
+
+func (c *mockConsenterSupport) SignMessage(msg []byte) (*protoutil.SignedData, error) {
+	args := c.Called(msg)
+	return args.Get(0).(*protoutil.SignedData), args.Error(1)
+}
+
+func (c *mockConsenterSupport) VerifyMessage(sd *protoutil.SignedData) error {
+	return nil
+}
+
 func (c *mockConsenterSupport) NewSignatureHeader() (*cb.SignatureHeader, error) {
 	args := c.Called()
 	return args.Get(0).(*cb.SignatureHeader), args.Error(1)
@@ -63,6 +63,10 @@ type Controller interface {
 	// by inspecting the status of the system channel.
 	ConsensusMigrationPending() bool
 
+	// ConsensusMigrationAborted checks whether consensus-type migration had been aborted,
+	// by inspecting the status of the system channel.
+	ConsensusMigrationAborted() bool
+
 	// ConsensusMigrationStart marks every standard channel as "START" with the given context.
 	// It should first check that consensus-type migration is not pending on any of the standard channels.
 	// This call is always triggered by a MigrationState="START" config update on the system channel.
@@ -229,8 +233,18 @@ func (ms *StatusImpl) stepSystem(
 					currState, nextMigState, nextMigContext, currContext)
 			}
 		case orderer.ConsensusType_MIG_STATE_ABORT:
-			ms.logger.Panicf("Consensus-type migration: Not implemented yet, transition %s to %s", currState, nextMigState)
-			//TODO implement abort path
+			if currContext == nextMigContext {
+				err := migrationController.ConsensusMigrationAbort()
+				if err != nil {
+					ms.logger.Warningf("Consensus-type migration: Reject Config tx on system channel, migrationAbort failed; error=%s", err)
+				} else {
+					ms.logger.Infof("Consensus-type migration: aborted; Status: %s", ms)
+					commitBlock = true
+				}
+			} else {
+				ms.logger.Warningf("Consensus-type migration: Reject Config tx on system channel; %s to %s, because of bad context:(tx=%d/exp=%d)",
+					currState, nextMigState, nextMigContext, currContext)
+			}
 		default:
 			unexpectedTransitionResponse(currState, nextMigState)
 		}
@@ -292,9 +306,6 @@ func (ms *StatusImpl) stepStandard(
 				ms.logger.Warningf("Consensus-type migration: context rejected; migrationPending=%v, context:(tx=%d/exp=%d)",
 					migrationController.ConsensusMigrationPending(), nextMigContext, currContext)
 			}
-		case orderer.ConsensusType_MIG_STATE_ABORT:
-			ms.logger.Panicf("Consensus-type migration: Not implemented yet, transition %s to %s", currState, nextMigState)
-			//TODO implement abort path
 		default:
 			unexpectedTransitionResponse(currState, nextMigState)
 		}
@@ -309,11 +320,16 @@ func (ms *StatusImpl) stepStandard(
 		}
 
 	case orderer.ConsensusType_MIG_STATE_CONTEXT:
-		//=== Migration pending, expect ABORT, or nothing else to do (restart to Raft)
+		//=== Migration pending, expect NONE after the system channel aborted (revert type back), or nothing else to do (restart to Raft)
 		switch nextMigState {
-		case orderer.ConsensusType_MIG_STATE_ABORT:
-			ms.logger.Panicf("Consensus-type migration: Not implemented yet, transition %s to %s", currState, nextMigState)
-			//TODO implement abort path
+		case orderer.ConsensusType_MIG_STATE_NONE:
+			if migrationController.ConsensusMigrationAborted() {
+				ms.SetStateContext(orderer.ConsensusType_MIG_STATE_ABORT, currContext)
+				ms.logger.Infof("Consensus-type migration: context reverted after abort; Status: %s", ms)
+				commitBlock = true
+			} else {
+				ms.logger.Warningf("Consensus-type migration: context revert rejected, migration not aborted on system channel; Status: %s", ms)
+			}
 		default:
 			unexpectedTransitionResponse(currState, nextMigState)
 		}
@@ -130,7 +130,13 @@ func TestStepSysFromNone(t *testing.T) {
 	})
 
 	t.Run("None-Abort", func(t *testing.T) {
-		//TODO test abort when implemented
+		t.Logf("status before: %s", status.String())
+
+		migController.ConsensusMigrationAbortReturns(nil)
+		commitBlock, commitMig := status.Step("Foo", "kafka", orderer.ConsensusType_MIG_STATE_ABORT, 7, 7, &migController)
+		assert.True(t, !commitBlock)
+		assert.True(t, !commitMig)
+		assert.Equal(t, 0, migController.ConsensusMigrationAbortCallCount())
 	})
 
 	t.Run("None-Context", func(t *testing.T) {
@@ -191,7 +197,23 @@ func TestStepSysFromStart(t *testing.T) {
 	})
 
 	t.Run("Start-Abort", func(t *testing.T) {
-		//TODO test abort when implemented
+		t.Logf("status before: %s", status.String())
+
+		migController.ConsensusMigrationAbortReturns(nil)
+		commitBlock, commitMig := status.Step("Foo", "kafka", orderer.ConsensusType_MIG_STATE_ABORT, context+1, 0, &migController)
+		assert.True(t, !commitBlock)
+		assert.True(t, !commitMig)
+
+		migController.ConsensusMigrationAbortReturns(fmt.Errorf("Cannot abort"))
+		commitBlock, commitMig = status.Step("Foo", "kafka", orderer.ConsensusType_MIG_STATE_ABORT, context, 0, &migController)
+		assert.True(t, !commitBlock)
+		assert.True(t, !commitMig)
+
+		migController.ConsensusMigrationAbortReturns(nil)
+		commitBlock, commitMig = status.Step("Foo", "kafka", orderer.ConsensusType_MIG_STATE_ABORT, context, 0, &migController)
+		assert.True(t, commitBlock)
+		assert.True(t, !commitMig)
+		assert.Equal(t, 2, migController.ConsensusMigrationAbortCallCount())
 	})
 
 	t.Run("Start-Context", func(t *testing.T) {
@@ -247,7 +269,13 @@ func TestStepSysFromCommit(t *testing.T) {
 	})
 
 	t.Run("Commit-Abort", func(t *testing.T) {
-		//TODO test abort when implemented
+		t.Logf("status before: %s", status.String())
+
+		migController.ConsensusMigrationAbortReturns(nil)
+		commitBlock, commitMig := status.Step("Foo", "kafka", orderer.ConsensusType_MIG_STATE_ABORT, context, 0, &migController)
+		assert.True(t, !commitBlock)
+		assert.True(t, !commitMig)
+		assert.Equal(t, 0, migController.ConsensusMigrationAbortCallCount())
 	})
 
 	t.Run("Commit-Context", func(t *testing.T) {
@@ -260,7 +288,51 @@ func TestStepSysFromCommit(t *testing.T) {
 }
 
 func TestStepSysFromAbort(t *testing.T) {
-	//TODO test abort when implemented
+	sysChan := true
+	migController := mocks.FakeMigrationController{}
+	status := migration.NewStatusStepper(sysChan, "test")
+	lastBlockCut := uint64(6)
+	context := lastBlockCut + 1
+	status.SetStateContext(orderer.ConsensusType_MIG_STATE_ABORT, context)
+
+	t.Run("Abort-None", func(t *testing.T) {
+		t.Logf("status before: %s", status.String())
+
+		commitBlock, commitMig := status.Step("Foo", "kafka", orderer.ConsensusType_MIG_STATE_NONE, 0, context, &migController)
+		assert.True(t, commitBlock)
+		assert.True(t, !commitMig)
+	})
+
+	t.Run("Abort-Bad", func(t *testing.T) {
+		t.Logf("status before: %s", status.String())
+
+		states := [...]orderer.ConsensusType_MigrationState{
+			orderer.ConsensusType_MIG_STATE_COMMIT,
+			orderer.ConsensusType_MIG_STATE_ABORT,
+			orderer.ConsensusType_MIG_STATE_CONTEXT,
+		}
+
+		for _, st := range states {
+			commitBlock, commitMig := status.Step("Foo", "kafka", st, context, context, &migController)
+			assert.True(t, !commitBlock)
+			assert.True(t, !commitMig)
+		}
+	})
+
+	t.Run("Abort-Start", func(t *testing.T) {
+		t.Logf("status before: %s", status.String())
+
+		migController.ConsensusMigrationStartReturns(fmt.Errorf("Cannot start"))
+		commitBlock, commitMig := status.Step("Foo", "kafka", orderer.ConsensusType_MIG_STATE_START, 0, context+1, &migController)
+		assert.True(t, !commitBlock)
+		assert.True(t, !commitMig)
+
+		migController.ConsensusMigrationStartReturns(nil)
+		commitBlock, commitMig = status.Step("Foo", "kafka", orderer.ConsensusType_MIG_STATE_START, 0, context+1, &migController)
+		assert.True(t, commitBlock)
+		assert.True(t, !commitMig)
+		assert.Equal(t, context+2, migController.ConsensusMigrationStartArgsForCall(1))
+	})
 }
 
 func TestStepSysFromContext(t *testing.T) {
@@ -328,7 +400,11 @@ func TestStepStdFromNone(t *testing.T) {
 	})
 
 	t.Run("None-Abort", func(t *testing.T) {
-		//TODO test abort when implemented
+		t.Logf("status before: %s", status.String())
+
+		commitBlock, commitMig := status.Step("Foo", "kafka", orderer.ConsensusType_MIG_STATE_ABORT, 7, 0, &migController)
+		assert.True(t, !commitBlock)
+		assert.True(t, !commitMig)
 	})
 
 	t.Run("None-Context", func(t *testing.T) {
@@ -353,8 +429,7 @@ func TestStepStdFromStart(t *testing.T) {
 
 		states := [...]orderer.ConsensusType_MigrationState{
 			orderer.ConsensusType_MIG_STATE_NONE, orderer.ConsensusType_MIG_STATE_START,
-			orderer.ConsensusType_MIG_STATE_COMMIT,
-			// TODO when implemented orderer.ConsensusType_MIG_STATE_ABORT,
+			orderer.ConsensusType_MIG_STATE_COMMIT, orderer.ConsensusType_MIG_STATE_ABORT,
 		}
 
 		for _, st := range states {
@@ -409,9 +484,9 @@ func TestStepStdFromContext(t *testing.T) {
 		t.Logf("status before: %s", status.String())
 
 		var states1 = [4]orderer.ConsensusType_MigrationState{
-			orderer.ConsensusType_MIG_STATE_NONE,
 			orderer.ConsensusType_MIG_STATE_START,
 			orderer.ConsensusType_MIG_STATE_COMMIT,
+			orderer.ConsensusType_MIG_STATE_ABORT,
 			orderer.ConsensusType_MIG_STATE_CONTEXT,
 		}
 
@@ -427,7 +502,15 @@ func TestStepStdFromContext(t *testing.T) {
 
 	t.Run("Context-Abort", func(t *testing.T) {
 		t.Logf("status before: %s", status.String())
-		// TODO when implemented orderer.ConsensusType_MIG_STATE_ABORT,
+
+		migController.ConsensusMigrationAbortedReturns(true)
+		commitBlock, commitMig := status.Step("Foo", "kafka", orderer.ConsensusType_MIG_STATE_NONE, 0, 0, &migController)
+		assert.True(t, commitBlock)
+		assert.True(t, !commitMig)
+		assert.True(t, !status.IsPending())
+		state, ctx := status.StateContext()
+		assert.Equal(t, orderer.ConsensusType_MIG_STATE_ABORT, state)
+		assert.Equal(t, context, ctx)
 	})
 }
 
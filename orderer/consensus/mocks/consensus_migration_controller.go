@@ -18,6 +18,16 @@ type FakeMigrationController struct {
 	consensusMigrationAbortReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ConsensusMigrationAbortedStub        func() bool
+	consensusMigrationAbortedMutex       sync.RWMutex
+	consensusMigrationAbortedArgsForCall []struct {
+	}
+	consensusMigrationAbortedReturns struct {
+		result1 bool
+	}
+	consensusMigrationAbortedReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	ConsensusMigrationCommitStub        func() error
 	consensusMigrationCommitMutex       sync.RWMutex
 	consensusMigrationCommitArgsForCall []struct {
@@ -105,6 +115,58 @@ func (fake *FakeMigrationController) ConsensusMigrationAbortReturnsOnCall(i int,
 	}{result1}
 }
 
+func (fake *FakeMigrationController) ConsensusMigrationAborted() bool {
+	fake.consensusMigrationAbortedMutex.Lock()
+	ret, specificReturn := fake.consensusMigrationAbortedReturnsOnCall[len(fake.consensusMigrationAbortedArgsForCall)]
+	fake.consensusMigrationAbortedArgsForCall = append(fake.consensusMigrationAbortedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ConsensusMigrationAborted", []interface{}{})
+	fake.consensusMigrationAbortedMutex.Unlock()
+	if fake.ConsensusMigrationAbortedStub != nil {
+		return fake.ConsensusMigrationAbortedStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.consensusMigrationAbortedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeMigrationController) ConsensusMigrationAbortedCallCount() int {
+	fake.consensusMigrationAbortedMutex.RLock()
+	defer fake.consensusMigrationAbortedMutex.RUnlock()
+	return len(fake.consensusMigrationAbortedArgsForCall)
+}
+
+func (fake *FakeMigrationController) ConsensusMigrationAbortedCalls(stub func() bool) {
+	fake.consensusMigrationAbortedMutex.Lock()
+	defer fake.consensusMigrationAbortedMutex.Unlock()
+	fake.ConsensusMigrationAbortedStub = stub
+}
+
+func (fake *FakeMigrationController) ConsensusMigrationAbortedReturns(result1 bool) {
+	fake.consensusMigrationAbortedMutex.Lock()
+	defer fake.consensusMigrationAbortedMutex.Unlock()
+	fake.ConsensusMigrationAbortedStub = nil
+	fake.consensusMigrationAbortedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeMigrationController) ConsensusMigrationAbortedReturnsOnCall(i int, result1 bool) {
+	fake.consensusMigrationAbortedMutex.Lock()
+	defer fake.consensusMigrationAbortedMutex.Unlock()
+	fake.ConsensusMigrationAbortedStub = nil
+	if fake.consensusMigrationAbortedReturnsOnCall == nil {
+		fake.consensusMigrationAbortedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.consensusMigrationAbortedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeMigrationController) ConsensusMigrationCommit() error {
 	fake.consensusMigrationCommitMutex.Lock()
 	ret, specificReturn := fake.consensusMigrationCommitReturnsOnCall[len(fake.consensusMigrationCommitArgsForCall)]
@@ -274,6 +336,8 @@ func (fake *FakeMigrationController) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.consensusMigrationAbortMutex.RLock()
 	defer fake.consensusMigrationAbortMutex.RUnlock()
+	fake.consensusMigrationAbortedMutex.RLock()
+	defer fake.consensusMigrationAbortedMutex.RUnlock()
 	fake.consensusMigrationCommitMutex.RLock()
 	defer fake.consensusMigrationCommitMutex.RUnlock()
 	fake.consensusMigrationPendingMutex.RLock()
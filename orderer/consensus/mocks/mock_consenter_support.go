@@ -174,6 +174,32 @@ type FakeConsenterSupport struct {
 		result1 []byte
 		result2 error
 	}
+	SignBlockStub        func(*common.Block) (*common.MetadataSignature, error)
+	signBlockMutex       sync.RWMutex
+	signBlockArgsForCall []struct {
+		arg1 *common.Block
+	}
+	signBlockReturns struct {
+		result1 *common.MetadataSignature
+		result2 error
+	}
+	signBlockReturnsOnCall map[int]struct {
+		result1 *common.MetadataSignature
+		result2 error
+	}
+	SignMessageStub        func([]byte) (*protoutil.SignedData, error)
+	signMessageMutex       sync.RWMutex
+	signMessageArgsForCall []struct {
+		arg1 []byte
+	}
+	signMessageReturns struct {
+		result1 *protoutil.SignedData
+		result2 error
+	}
+	signMessageReturnsOnCall map[int]struct {
+		result1 *protoutil.SignedData
+		result2 error
+	}
 	VerifyBlockSignatureStub        func([]*protoutil.SignedData, *common.ConfigEnvelope) error
 	verifyBlockSignatureMutex       sync.RWMutex
 	verifyBlockSignatureArgsForCall []struct {
@@ -186,6 +212,17 @@ type FakeConsenterSupport struct {
 	verifyBlockSignatureReturnsOnCall map[int]struct {
 		result1 error
 	}
+	VerifyMessageStub        func(*protoutil.SignedData) error
+	verifyMessageMutex       sync.RWMutex
+	verifyMessageArgsForCall []struct {
+		arg1 *protoutil.SignedData
+	}
+	verifyMessageReturns struct {
+		result1 error
+	}
+	verifyMessageReturnsOnCall map[int]struct {
+		result1 error
+	}
 	WriteBlockStub        func(*common.Block, []byte)
 	writeBlockMutex       sync.RWMutex
 	writeBlockArgsForCall []struct {
@@ -1017,6 +1054,137 @@ func (fake *FakeConsenterSupport) SignReturnsOnCall(i int, result1 []byte, resul
 	}{result1, result2}
 }
 
+func (fake *FakeConsenterSupport) SignBlock(arg1 *common.Block) (*common.MetadataSignature, error) {
+	fake.signBlockMutex.Lock()
+	ret, specificReturn := fake.signBlockReturnsOnCall[len(fake.signBlockArgsForCall)]
+	fake.signBlockArgsForCall = append(fake.signBlockArgsForCall, struct {
+		arg1 *common.Block
+	}{arg1})
+	fake.recordInvocation("SignBlock", []interface{}{arg1})
+	fake.signBlockMutex.Unlock()
+	if fake.SignBlockStub != nil {
+		return fake.SignBlockStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.signBlockReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeConsenterSupport) SignBlockCallCount() int {
+	fake.signBlockMutex.RLock()
+	defer fake.signBlockMutex.RUnlock()
+	return len(fake.signBlockArgsForCall)
+}
+
+func (fake *FakeConsenterSupport) SignBlockCalls(stub func(*common.Block) (*common.MetadataSignature, error)) {
+	fake.signBlockMutex.Lock()
+	defer fake.signBlockMutex.Unlock()
+	fake.SignBlockStub = stub
+}
+
+func (fake *FakeConsenterSupport) SignBlockArgsForCall(i int) *common.Block {
+	fake.signBlockMutex.RLock()
+	defer fake.signBlockMutex.RUnlock()
+	argsForCall := fake.signBlockArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConsenterSupport) SignBlockReturns(result1 *common.MetadataSignature, result2 error) {
+	fake.signBlockMutex.Lock()
+	defer fake.signBlockMutex.Unlock()
+	fake.SignBlockStub = nil
+	fake.signBlockReturns = struct {
+		result1 *common.MetadataSignature
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConsenterSupport) SignBlockReturnsOnCall(i int, result1 *common.MetadataSignature, result2 error) {
+	fake.signBlockMutex.Lock()
+	defer fake.signBlockMutex.Unlock()
+	fake.SignBlockStub = nil
+	if fake.signBlockReturnsOnCall == nil {
+		fake.signBlockReturnsOnCall = make(map[int]struct {
+			result1 *common.MetadataSignature
+			result2 error
+		})
+	}
+	fake.signBlockReturnsOnCall[i] = struct {
+		result1 *common.MetadataSignature
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConsenterSupport) SignMessage(arg1 []byte) (*protoutil.SignedData, error) {
+	var arg1Copy []byte
+	if arg1 != nil {
+		arg1Copy = make([]byte, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.signMessageMutex.Lock()
+	ret, specificReturn := fake.signMessageReturnsOnCall[len(fake.signMessageArgsForCall)]
+	fake.signMessageArgsForCall = append(fake.signMessageArgsForCall, struct {
+		arg1 []byte
+	}{arg1Copy})
+	fake.recordInvocation("SignMessage", []interface{}{arg1Copy})
+	fake.signMessageMutex.Unlock()
+	if fake.SignMessageStub != nil {
+		return fake.SignMessageStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.signMessageReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeConsenterSupport) SignMessageCallCount() int {
+	fake.signMessageMutex.RLock()
+	defer fake.signMessageMutex.RUnlock()
+	return len(fake.signMessageArgsForCall)
+}
+
+func (fake *FakeConsenterSupport) SignMessageCalls(stub func([]byte) (*protoutil.SignedData, error)) {
+	fake.signMessageMutex.Lock()
+	defer fake.signMessageMutex.Unlock()
+	fake.SignMessageStub = stub
+}
+
+func (fake *FakeConsenterSupport) SignMessageArgsForCall(i int) []byte {
+	fake.signMessageMutex.RLock()
+	defer fake.signMessageMutex.RUnlock()
+	argsForCall := fake.signMessageArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConsenterSupport) SignMessageReturns(result1 *protoutil.SignedData, result2 error) {
+	fake.signMessageMutex.Lock()
+	defer fake.signMessageMutex.Unlock()
+	fake.SignMessageStub = nil
+	fake.signMessageReturns = struct {
+		result1 *protoutil.SignedData
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConsenterSupport) SignMessageReturnsOnCall(i int, result1 *protoutil.SignedData, result2 error) {
+	fake.signMessageMutex.Lock()
+	defer fake.signMessageMutex.Unlock()
+	fake.SignMessageStub = nil
+	if fake.signMessageReturnsOnCall == nil {
+		fake.signMessageReturnsOnCall = make(map[int]struct {
+			result1 *protoutil.SignedData
+			result2 error
+		})
+	}
+	fake.signMessageReturnsOnCall[i] = struct {
+		result1 *protoutil.SignedData
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeConsenterSupport) VerifyBlockSignature(arg1 []*protoutil.SignedData, arg2 *common.ConfigEnvelope) error {
 	var arg1Copy []*protoutil.SignedData
 	if arg1 != nil {
@@ -1083,6 +1251,66 @@ func (fake *FakeConsenterSupport) VerifyBlockSignatureReturnsOnCall(i int, resul
 	}{result1}
 }
 
+func (fake *FakeConsenterSupport) VerifyMessage(arg1 *protoutil.SignedData) error {
+	fake.verifyMessageMutex.Lock()
+	ret, specificReturn := fake.verifyMessageReturnsOnCall[len(fake.verifyMessageArgsForCall)]
+	fake.verifyMessageArgsForCall = append(fake.verifyMessageArgsForCall, struct {
+		arg1 *protoutil.SignedData
+	}{arg1})
+	fake.recordInvocation("VerifyMessage", []interface{}{arg1})
+	fake.verifyMessageMutex.Unlock()
+	if fake.VerifyMessageStub != nil {
+		return fake.VerifyMessageStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.verifyMessageReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConsenterSupport) VerifyMessageCallCount() int {
+	fake.verifyMessageMutex.RLock()
+	defer fake.verifyMessageMutex.RUnlock()
+	return len(fake.verifyMessageArgsForCall)
+}
+
+func (fake *FakeConsenterSupport) VerifyMessageCalls(stub func(*protoutil.SignedData) error) {
+	fake.verifyMessageMutex.Lock()
+	defer fake.verifyMessageMutex.Unlock()
+	fake.VerifyMessageStub = stub
+}
+
+func (fake *FakeConsenterSupport) VerifyMessageArgsForCall(i int) *protoutil.SignedData {
+	fake.verifyMessageMutex.RLock()
+	defer fake.verifyMessageMutex.RUnlock()
+	argsForCall := fake.verifyMessageArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConsenterSupport) VerifyMessageReturns(result1 error) {
+	fake.verifyMessageMutex.Lock()
+	defer fake.verifyMessageMutex.Unlock()
+	fake.VerifyMessageStub = nil
+	fake.verifyMessageReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConsenterSupport) VerifyMessageReturnsOnCall(i int, result1 error) {
+	fake.verifyMessageMutex.Lock()
+	defer fake.verifyMessageMutex.Unlock()
+	fake.VerifyMessageStub = nil
+	if fake.verifyMessageReturnsOnCall == nil {
+		fake.verifyMessageReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.verifyMessageReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConsenterSupport) WriteBlock(arg1 *common.Block, arg2 []byte) {
 	var arg2Copy []byte
 	if arg2 != nil {
@@ -1188,8 +1416,14 @@ func (fake *FakeConsenterSupport) Invocations() map[string][][]interface{} {
 	defer fake.sharedConfigMutex.RUnlock()
 	fake.signMutex.RLock()
 	defer fake.signMutex.RUnlock()
+	fake.signBlockMutex.RLock()
+	defer fake.signBlockMutex.RUnlock()
+	fake.signMessageMutex.RLock()
+	defer fake.signMessageMutex.RUnlock()
 	fake.verifyBlockSignatureMutex.RLock()
 	defer fake.verifyBlockSignatureMutex.RUnlock()
+	fake.verifyMessageMutex.RLock()
+	defer fake.verifyMessageMutex.RUnlock()
 	fake.writeBlockMutex.RLock()
 	defer fake.writeBlockMutex.RUnlock()
 	fake.writeConfigBlockMutex.RLock()
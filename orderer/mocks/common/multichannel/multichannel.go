@@ -67,6 +67,9 @@ type ConsenterSupport struct {
 	// BlockVerificationErr is returned by VerifyBlockSignature
 	BlockVerificationErr error
 
+	// MessageVerificationErr is returned by VerifyMessage
+	MessageVerificationErr error
+
 	SystemChannelVal bool
 }
 
@@ -126,6 +129,23 @@ func (mcs *ConsenterSupport) Sign(message []byte) ([]byte, error) {
 	return message, nil
 }
 
+// SignMessage returns the message passed in, signed with Sign
+func (mcs *ConsenterSupport) SignMessage(message []byte) (*protoutil.SignedData, error) {
+	signature, _ := mcs.Sign(message)
+	return &protoutil.SignedData{Data: message, Signature: signature}, nil
+}
+
+// VerifyMessage returns MessageVerificationErr
+func (mcs *ConsenterSupport) VerifyMessage(_ *protoutil.SignedData) error {
+	return mcs.MessageVerificationErr
+}
+
+// SignBlock returns a signature over the header of the given block, signed with Sign
+func (mcs *ConsenterSupport) SignBlock(block *cb.Block) (*cb.MetadataSignature, error) {
+	signature, _ := mcs.Sign(protoutil.BlockHeaderBytes(block.Header))
+	return &cb.MetadataSignature{Signature: signature}, nil
+}
+
 // NewSignatureHeader returns an empty signature header
 func (mcs *ConsenterSupport) NewSignatureHeader() (*cb.SignatureHeader, error) {
 	return &cb.SignatureHeader{}, nil
@@ -52,6 +52,7 @@ type ApproveForMyOrgInput struct {
 	ValidationParameterBytes []byte
 	CollectionConfigPackage  *cb.CollectionConfigPackage
 	InitRequired             bool
+	ReadYourWrites           bool
 	PeerAddresses            []string
 	WaitForEvent             bool
 	WaitForEventTimeout      time.Duration
@@ -132,6 +133,7 @@ func approveForMyOrgCmd(cf *ChaincodeCmdFactory, a *ApproverForMyOrg) *cobra.Com
 		"vscc",
 		"policy",
 		"init-required",
+		"read-your-writes",
 		"collections-config",
 		"peerAddresses",
 		"tlsRootCertFiles",
@@ -268,6 +270,7 @@ func (a *ApproverForMyOrg) setInput() error {
 		ValidationPlugin:         vscc,
 		ValidationParameterBytes: policyBytes,
 		InitRequired:             initRequired,
+		ReadYourWrites:           readYourWrites,
 		CollectionConfigPackage:  ccp,
 		PeerAddresses:            peerAddresses,
 		WaitForEvent:             waitForEvent,
@@ -291,6 +294,7 @@ func (a *ApproverForMyOrg) createProposals(inputTxID string) (proposal *pb.Propo
 		ValidationPlugin:    a.Input.ValidationPlugin,
 		ValidationParameter: a.Input.ValidationParameterBytes,
 		InitRequired:        a.Input.InitRequired,
+		ReadYourWrites:      a.Input.ReadYourWrites,
 		Collections:         a.Input.CollectionConfigPackage,
 	}
 
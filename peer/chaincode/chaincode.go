@@ -19,7 +19,7 @@ import (
 
 const (
 	chainFuncName    = "chaincode"
-	chainCmdDes      = "Operate a chaincode: approveformyorg|commit|install|instantiate|invoke|package|query|signpackage|upgrade|list."
+	chainCmdDes      = "Operate a chaincode: approveformyorg|commit|install|instantiate|invoke|package|query|signpackage|upgrade|list|createproposal|signproposal|sendproposal|signtx|sendtx|devrun."
 	newLifecycleName = "_lifecycle"
 )
 
@@ -51,6 +51,12 @@ func Cmd(cf *ChaincodeCmdFactory) *cobra.Command {
 	chaincodeCmd.AddCommand(listCmd(cf))
 	chaincodeCmd.AddCommand(approveForMyOrgCmd(cf, nil))
 	chaincodeCmd.AddCommand(commitCmd(cf, nil))
+	chaincodeCmd.AddCommand(createProposalCmd(cf))
+	chaincodeCmd.AddCommand(signProposalCmd(cf))
+	chaincodeCmd.AddCommand(sendProposalCmd(cf))
+	chaincodeCmd.AddCommand(signTxCmd(cf))
+	chaincodeCmd.AddCommand(sendTxCmd(cf))
+	chaincodeCmd.AddCommand(devRunCmd(cf))
 
 	return chaincodeCmd
 }
@@ -78,10 +84,16 @@ var (
 	connectionProfile     string
 	waitForEvent          bool
 	waitForEventTimeout   time.Duration
+	waitForEventQuorum    int
 	newLifecycle          bool
 	hash                  []byte
 	sequence              int
 	initRequired          bool
+	readYourWrites        bool
+	invocationsFile       string
+	startPeer             bool
+	pollInterval          time.Duration
+	chaincodeAddress      string
 )
 
 var chaincodeCmd = &cobra.Command{
@@ -89,7 +101,7 @@ var chaincodeCmd = &cobra.Command{
 	Short: fmt.Sprint(chainCmdDes),
 	Long:  fmt.Sprint(chainCmdDes),
 	PersistentPreRun: func(cmd *cobra.Command, args []string) {
-		common.InitCmd(cmd, args)
+		common.InitClientCmd(cmd, args)
 		common.SetOrdererEnv(cmd, args)
 	},
 }
@@ -140,6 +152,8 @@ func resetFlags() {
 		fmt.Sprint("Whether to wait for the event from each peer's deliver filtered service signifying that the 'invoke' transaction has been committed successfully"))
 	flags.DurationVar(&waitForEventTimeout, "waitForEventTimeout", 30*time.Second,
 		fmt.Sprint("Time to wait for the event from each peer's deliver filtered service signifying that the 'invoke' transaction has been committed successfully"))
+	flags.IntVar(&waitForEventQuorum, "waitForEventQuorum", 0,
+		fmt.Sprint("The number of peers that must commit the 'invoke' transaction as valid for the command to succeed when waiting for the event, or 0 for all peers"))
 	flags.BoolVarP(&newLifecycle, "newLifecycle", "N", false, "Run command using _lifecycle")
 	flags.BoolVarP(&createSignedCCDepSpec, "cc-package", "s", false, "create CC deployment spec for owner endorsements instead of raw CC deployment spec")
 	flags.BoolVarP(&signCCDepSpec, "sign", "S", false, "if creating CC deployment spec package for owner endorsements, also sign it with local MSP")
@@ -147,6 +161,11 @@ func resetFlags() {
 	flags.BytesHexVarP(&hash, "hash", "", nil, "The hash of the chaincode install package")
 	flags.IntVarP(&sequence, "sequence", "", 1, "The sequence number of the chaincode definition for the channel")
 	flags.BoolVarP(&initRequired, "init-required", "", false, "Whether the chaincode requires invoking 'init'")
+	flags.BoolVarP(&readYourWrites, "read-your-writes", "", false, "Whether reads of the chaincode return the writes made earlier in the same transaction")
+	flags.StringVarP(&invocationsFile, "invocations", "", "", "Path to a JSON file holding the invocations of the chaincode to replay after each restart of the chaincode")
+	flags.BoolVarP(&startPeer, "startPeer", "", false, "Whether to start the peer in chaincode development mode")
+	flags.DurationVarP(&pollInterval, "pollInterval", "", time.Second, "The interval at which the chaincode source directory is checked for changes")
+	flags.StringVarP(&chaincodeAddress, "chaincodeAddress", "", "127.0.0.1:7052", "The address at which the peer listens for chaincode connections")
 }
 
 func attachFlags(cmd *cobra.Command, names []string) {
@@ -52,6 +52,7 @@ type CommitInput struct {
 	ValidationParameterBytes []byte
 	CollectionConfigPackage  *cb.CollectionConfigPackage
 	InitRequired             bool
+	ReadYourWrites           bool
 	PeerAddresses            []string
 	WaitForEvent             bool
 	WaitForEventTimeout      time.Duration
@@ -132,6 +133,7 @@ func commitCmd(cf *ChaincodeCmdFactory, c *Committer) *cobra.Command {
 		"vscc",
 		"policy",
 		"init-required",
+		"read-your-writes",
 		"collections-config",
 		"peerAddresses",
 		"tlsRootCertFiles",
@@ -270,6 +272,7 @@ func (c *Committer) setInput() error {
 		ValidationPlugin:         vscc,
 		ValidationParameterBytes: policyBytes,
 		InitRequired:             initRequired,
+		ReadYourWrites:           readYourWrites,
 		CollectionConfigPackage:  ccp,
 		PeerAddresses:            peerAddresses,
 		WaitForEvent:             waitForEvent,
@@ -293,6 +296,7 @@ func (c *Committer) createProposals(inputTxID string) (proposal *pb.Proposal, si
 		ValidationPlugin:    c.Input.ValidationPlugin,
 		ValidationParameter: c.Input.ValidationParameterBytes,
 		InitRequired:        c.Input.InitRequired,
+		ReadYourWrites:      c.Input.ReadYourWrites,
 		Collections:         c.Input.CollectionConfigPackage,
 	}
 
@@ -7,8 +7,10 @@ SPDX-License-Identifier: Apache-2.0
 package chaincode
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -126,7 +128,7 @@ func chaincodeInvokeOrQuery(cmd *cobra.Command, invoke bool, cf *ChaincodeCmdFac
 	// otherwise, tests can explicitly set their own txid
 	txID := ""
 
-	proposalResp, err := ChaincodeInvokeOrQuery(
+	proposalResp, peerStatuses, err := invokeOrQuery(
 		spec,
 		channelID,
 		txID,
@@ -156,6 +158,14 @@ func chaincodeInvokeOrQuery(cmd *cobra.Command, invoke bool, cf *ChaincodeCmdFac
 			return errors.Errorf("endorsement failure during invoke. response: %v", proposalResp.Response)
 		}
 		logger.Infof("Chaincode invoke successful. result: %v", ca.Response)
+		if common.OutputJSON() {
+			return common.PrintJSON(cmd.OutOrStdout(), invokeResult{
+				Status:  ca.Response.Status,
+				Message: ca.Response.Message,
+				Payload: string(ca.Response.Payload),
+				Peers:   peerStatuses,
+			})
+		}
 	} else {
 		if proposalResp == nil {
 			return errors.New("error during query: received nil proposal response")
@@ -167,6 +177,16 @@ func chaincodeInvokeOrQuery(cmd *cobra.Command, invoke bool, cf *ChaincodeCmdFac
 		if chaincodeQueryRaw && chaincodeQueryHex {
 			return fmt.Errorf("options --raw (-r) and --hex (-x) are not compatible")
 		}
+		if common.OutputJSON() {
+			if chaincodeQueryRaw {
+				return errors.New("option --raw (-r) is not compatible with the json output format")
+			}
+			payload := string(proposalResp.Response.Payload)
+			if chaincodeQueryHex {
+				payload = hex.EncodeToString(proposalResp.Response.Payload)
+			}
+			return common.PrintJSON(cmd.OutOrStdout(), queryResult{Payload: payload})
+		}
 		if chaincodeQueryRaw {
 			fmt.Println(proposalResp.Response.Payload)
 			return nil
@@ -180,6 +200,31 @@ func chaincodeInvokeOrQuery(cmd *cobra.Command, invoke bool, cf *ChaincodeCmdFac
 	return nil
 }
 
+// invokeResult is the JSON output of the invoke command. The commit status
+// of the transaction on each peer is included with --waitForEvent.
+type invokeResult struct {
+	Status  int32          `json:"status"`
+	Message string         `json:"message"`
+	Payload string         `json:"payload"`
+	Peers   []peerTxStatus `json:"peers,omitempty"`
+}
+
+// peerTxStatus is the commit status of the transaction on a peer, which is
+// the validation code of the transaction, ERROR if the deliver service of the
+// peer failed, or UNKNOWN if the peer had not reported the transaction when
+// the quorum was reached
+type peerTxStatus struct {
+	Address string `json:"address"`
+	Status  string `json:"status"`
+	Error   string `json:"error,omitempty"`
+}
+
+// queryResult is the JSON output of the query command. The payload is
+// hex encoded when --hex is set.
+type queryResult struct {
+	Payload string `json:"payload"`
+}
+
 type collectionConfigJson struct {
 	Name            string `json:"name"`
 	Policy          string `json:"policy"`
@@ -343,9 +388,10 @@ func validatePeerConnectionParameters(cmdName string) error {
 	}
 
 	// currently only support multiple peer addresses for invoke,
-	// approveformyorg, and commit
+	// sendproposal, approveformyorg, and commit
 	multiplePeersAllowed := map[string]bool{
 		"invoke":          true,
+		"sendproposal":    true,
 		"approveformyorg": true,
 		"commit":          true,
 	}
@@ -472,12 +518,30 @@ func ChaincodeInvokeOrQuery(
 	deliverClients []api.PeerDeliverClient,
 	bc common.BroadcastClient,
 ) (*pb.ProposalResponse, error) {
+	proposalResp, _, err := invokeOrQuery(spec, cID, txID, invoke, signer, certificate, endorserClients, deliverClients, bc)
+	return proposalResp, err
+}
+
+// invokeOrQuery implements ChaincodeInvokeOrQuery, and also returns the
+// commit status of the transaction on each peer when waiting for the
+// transaction to be committed
+func invokeOrQuery(
+	spec *pb.ChaincodeSpec,
+	cID string,
+	txID string,
+	invoke bool,
+	signer msp.SigningIdentity,
+	certificate tls.Certificate,
+	endorserClients []pb.EndorserClient,
+	deliverClients []api.PeerDeliverClient,
+	bc common.BroadcastClient,
+) (*pb.ProposalResponse, []peerTxStatus, error) {
 	// Build the ChaincodeInvocationSpec message
 	invocation := &pb.ChaincodeInvocationSpec{ChaincodeSpec: spec}
 
 	creator, err := signer.Serialize()
 	if err != nil {
-		return nil, errors.WithMessage(err, fmt.Sprintf("error serializing identity for %s", signer.GetIdentifier()))
+		return nil, nil, errors.WithMessage(err, fmt.Sprintf("error serializing identity for %s", signer.GetIdentifier()))
 	}
 
 	funcName := "invoke"
@@ -489,31 +553,31 @@ func ChaincodeInvokeOrQuery(
 	var tMap map[string][]byte
 	if transient != "" {
 		if err := json.Unmarshal([]byte(transient), &tMap); err != nil {
-			return nil, errors.Wrap(err, "error parsing transient string")
+			return nil, nil, errors.Wrap(err, "error parsing transient string")
 		}
 	}
 
 	prop, txid, err := protoutil.CreateChaincodeProposalWithTxIDAndTransient(pcommon.HeaderType_ENDORSER_TRANSACTION, cID, invocation, creator, txID, tMap)
 	if err != nil {
-		return nil, errors.WithMessage(err, fmt.Sprintf("error creating proposal for %s", funcName))
+		return nil, nil, errors.WithMessage(err, fmt.Sprintf("error creating proposal for %s", funcName))
 	}
 
 	signedProp, err := protoutil.GetSignedProposal(prop, signer)
 	if err != nil {
-		return nil, errors.WithMessage(err, fmt.Sprintf("error creating signed proposal for %s", funcName))
+		return nil, nil, errors.WithMessage(err, fmt.Sprintf("error creating signed proposal for %s", funcName))
 	}
 	var responses []*pb.ProposalResponse
 	for _, endorser := range endorserClients {
 		proposalResp, err := endorser.ProcessProposal(context.Background(), signedProp)
 		if err != nil {
-			return nil, errors.WithMessage(err, fmt.Sprintf("error endorsing %s", funcName))
+			return nil, nil, errors.WithMessage(err, fmt.Sprintf("error endorsing %s", funcName))
 		}
 		responses = append(responses, proposalResp)
 	}
 
 	if len(responses) == 0 {
 		// this should only happen if some new code has introduced a bug
-		return nil, errors.New("no proposal responses received - this might indicate a bug")
+		return nil, nil, errors.New("no proposal responses received - this might indicate a bug")
 	}
 	// all responses will be checked when the signed transaction is created.
 	// for now, just set this so we check the first response's status
@@ -522,61 +586,69 @@ func ChaincodeInvokeOrQuery(
 	if invoke {
 		if proposalResp != nil {
 			if proposalResp.Response.Status >= shim.ERRORTHRESHOLD {
-				return proposalResp, nil
+				return proposalResp, nil, nil
 			}
 			// assemble a signed transaction (it's an Envelope message)
 			env, err := protoutil.CreateSignedTx(prop, signer, responses...)
 			if err != nil {
-				return proposalResp, errors.WithMessage(err, "could not assemble transaction")
+				return proposalResp, nil, errors.WithMessage(err, "could not assemble transaction")
 			}
 			var dg *deliverGroup
 			var ctx context.Context
 			if waitForEvent {
+				if waitForEventQuorum < 0 || waitForEventQuorum > len(deliverClients) {
+					return proposalResp, nil, errors.Errorf("invalid quorum %d to wait for, must be between 1 and the number of peers (%d), or 0 for all peers", waitForEventQuorum, len(deliverClients))
+				}
 				var cancelFunc context.CancelFunc
 				ctx, cancelFunc = context.WithTimeout(context.Background(), waitForEventTimeout)
 				defer cancelFunc()
 
 				dg = newDeliverGroup(deliverClients, peerAddresses, certificate, channelID, txid)
+				dg.Quorum = waitForEventQuorum
 				// connect to deliver service on all peers
 				err := dg.Connect(ctx)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 			}
 
 			// send the envelope for ordering
 			if err = bc.Send(env); err != nil {
-				return proposalResp, errors.WithMessage(err, fmt.Sprintf("error sending transaction for %s", funcName))
+				return proposalResp, nil, errors.WithMessage(err, fmt.Sprintf("error sending transaction for %s", funcName))
 			}
 
 			if dg != nil && ctx != nil {
-				// wait for event that contains the txid from all peers
+				// wait for event that contains the txid from the quorum of peers
 				err = dg.Wait(ctx)
 				if err != nil {
-					return nil, err
+					return nil, dg.PeerStatuses(), err
 				}
+				return proposalResp, dg.PeerStatuses(), nil
 			}
 		}
 	}
 
-	return proposalResp, nil
+	return proposalResp, nil, nil
 }
 
 // deliverGroup holds all of the information needed to connect
 // to a set of peers to wait for the interested txid to be
-// committed to the ledgers of all peers. This functionality
-// is currently implemented via the peer's DeliverFiltered service.
-// An error from any of the peers/deliver clients will result in
-// the invoke command returning an error. Only the first error that
-// occurs will be set
+// committed as valid to the ledgers of a quorum of the peers,
+// which defaults to all peers. This functionality is currently
+// implemented via the peer's DeliverFiltered service.
+// An error from more peers/deliver clients than the quorum allows
+// will result in the invoke command returning an error. Only the
+// first error that occurs will be set
 type deliverGroup struct {
 	Clients     []*deliverClient
 	Certificate tls.Certificate
 	ChannelID   string
 	TxID        string
+	Quorum      int
 	mutex       sync.Mutex
 	Error       error
 	wg          sync.WaitGroup
+	statuses    map[*deliverClient]peerTxStatus
 }
 
 // deliverClient holds the client/connection related to a specific
@@ -585,6 +657,14 @@ type deliverClient struct {
 	Client     api.PeerDeliverClient
 	Connection ccapi.Deliver
 	Address    string
+	connErr    error // guarded by the mutex of the deliver group
+}
+
+// clientResult is the outcome of waiting for the txid on a deliver client
+type clientResult struct {
+	client *deliverClient
+	status pb.TxValidationCode
+	err    error
 }
 
 func newDeliverGroup(deliverClients []api.PeerDeliverClient, peerAddresses []string, certificate tls.Certificate, channelID string, txid string) *deliverGroup {
@@ -609,8 +689,8 @@ func newDeliverGroup(deliverClients []api.PeerDeliverClient, peerAddresses []str
 
 // Connect waits for all deliver clients in the group to connect to
 // the peer's deliver service, receive an error, or for the context
-// to timeout. An error will be returned whenever even a single
-// deliver client fails to connect to its peer
+// to timeout. An error will be returned whenever more deliver clients
+// fail to connect to their peers than the quorum allows
 func (dg *deliverGroup) Connect(ctx context.Context) error {
 	dg.wg.Add(len(dg.Clients))
 	for _, client := range dg.Clients {
@@ -621,8 +701,14 @@ func (dg *deliverGroup) Connect(ctx context.Context) error {
 
 	select {
 	case <-readyCh:
-		if dg.Error != nil {
-			err := errors.WithMessage(dg.Error, "failed to connect to deliver on all peers")
+		failed := 0
+		for _, client := range dg.Clients {
+			if dg.connError(client) != nil {
+				failed++
+			}
+		}
+		if failed > len(dg.Clients)-dg.quorum() {
+			err := errors.WithMessage(dg.error(), fmt.Sprintf("failed to connect to deliver on %s", dg.quorumDescription()))
 			return err
 		}
 	case <-ctx.Done():
@@ -641,7 +727,7 @@ func (dg *deliverGroup) ClientConnect(ctx context.Context, dc *deliverClient) {
 	df, err := dc.Client.DeliverFiltered(ctx)
 	if err != nil {
 		err = errors.WithMessage(err, fmt.Sprintf("error connecting to deliver filtered at %s", dc.Address))
-		dg.setError(err)
+		dg.setConnError(dc, err)
 		return
 	}
 	defer df.CloseSend()
@@ -651,49 +737,72 @@ func (dg *deliverGroup) ClientConnect(ctx context.Context, dc *deliverClient) {
 	err = df.Send(envelope)
 	if err != nil {
 		err = errors.WithMessage(err, fmt.Sprintf("error sending deliver seek info envelope to %s", dc.Address))
-		dg.setError(err)
+		dg.setConnError(dc, err)
 		return
 	}
 }
 
-// Wait waits for all deliver client connections in the group to
-// either receive a block with the txid, an error, or for the
-// context to timeout
+// Wait waits for the deliver client connections in the group to
+// receive a block with the txid, until the quorum of peers has
+// committed the transaction as valid, the quorum can no longer be
+// reached because of errors or invalid transactions, or the context
+// times out
 func (dg *deliverGroup) Wait(ctx context.Context) error {
 	if len(dg.Clients) == 0 {
 		return nil
 	}
 
-	dg.wg.Add(len(dg.Clients))
+	resultCh := make(chan clientResult, len(dg.Clients))
 	for _, client := range dg.Clients {
-		go dg.ClientWait(client)
-	}
-	readyCh := make(chan struct{})
-	go dg.WaitForWG(readyCh)
-
-	select {
-	case <-readyCh:
-		if dg.Error != nil {
-			err := errors.WithMessage(dg.Error, "failed to receive txid on all peers")
-			return err
+		if connErr := dg.connError(client); connErr != nil {
+			// the client failed to connect to its peer
+			resultCh <- clientResult{client: client, err: connErr}
+			continue
+		}
+		go dg.ClientWait(client, resultCh)
+	}
+
+	quorum := dg.quorum()
+	dg.statuses = make(map[*deliverClient]peerTxStatus)
+	valid, failed := 0, 0
+	for valid < quorum {
+		select {
+		case r := <-resultCh:
+			status := peerTxStatus{Address: r.client.Address, Status: r.status.String()}
+			switch {
+			case r.err != nil:
+				status.Status = "ERROR"
+				status.Error = r.err.Error()
+				dg.setError(r.err)
+				failed++
+			case r.status != pb.TxValidationCode_VALID:
+				dg.setError(errors.Errorf("txid [%s] committed with status (%s) at %s", dg.TxID, r.status, r.client.Address))
+				failed++
+			default:
+				valid++
+			}
+			dg.statuses[r.client] = status
+			if failed > len(dg.Clients)-quorum {
+				return errors.WithMessage(dg.error(), fmt.Sprintf("failed to receive txid on %s", dg.quorumDescription()))
+			}
+		case <-ctx.Done():
+			return errors.Errorf("timed out waiting for txid on %s", dg.quorumDescription())
 		}
-	case <-ctx.Done():
-		err := errors.New("timed out waiting for txid on all peers")
-		return err
 	}
 
+	logger.Infof("txid [%s] committed with status (VALID) at %d of %d peers", dg.TxID, valid, len(dg.Clients))
 	return nil
 }
 
 // ClientWait waits for the specified deliver client to receive
-// a block event with the requested txid
-func (dg *deliverGroup) ClientWait(dc *deliverClient) {
-	defer dg.wg.Done()
+// a block event with the requested txid, and sends the outcome
+// to the result channel
+func (dg *deliverGroup) ClientWait(dc *deliverClient, resultCh chan<- clientResult) {
 	for {
 		resp, err := dc.Connection.Recv()
 		if err != nil {
 			err = errors.WithMessage(err, fmt.Sprintf("error receiving from deliver filtered at %s", dc.Address))
-			dg.setError(err)
+			resultCh <- clientResult{client: dc, err: err}
 			return
 		}
 		switch r := resp.Type.(type) {
@@ -702,21 +811,51 @@ func (dg *deliverGroup) ClientWait(dc *deliverClient) {
 			for _, tx := range filteredTransactions {
 				if tx.Txid == dg.TxID {
 					logger.Infof("txid [%s] committed with status (%s) at %s", dg.TxID, tx.TxValidationCode, dc.Address)
+					resultCh <- clientResult{client: dc, status: tx.TxValidationCode}
 					return
 				}
 			}
 		case *pb.DeliverResponse_Status:
 			err = errors.Errorf("deliver completed with status (%s) before txid received", r.Status)
-			dg.setError(err)
+			resultCh <- clientResult{client: dc, err: err}
 			return
 		default:
 			err = errors.Errorf("received unexpected response type (%T) from %s", r, dc.Address)
-			dg.setError(err)
+			resultCh <- clientResult{client: dc, err: err}
 			return
 		}
 	}
 }
 
+// PeerStatuses returns the commit status of the txid on each peer of the
+// group, in the order of the peers. It must be called after Wait returns.
+func (dg *deliverGroup) PeerStatuses() []peerTxStatus {
+	statuses := make([]peerTxStatus, len(dg.Clients))
+	for i, client := range dg.Clients {
+		status, ok := dg.statuses[client]
+		if !ok {
+			status = peerTxStatus{Address: client.Address, Status: "UNKNOWN"}
+		}
+		statuses[i] = status
+	}
+	return statuses
+}
+
+// quorum returns the number of peers that must commit the txid as valid
+func (dg *deliverGroup) quorum() int {
+	if dg.Quorum <= 0 || dg.Quorum > len(dg.Clients) {
+		return len(dg.Clients)
+	}
+	return dg.Quorum
+}
+
+func (dg *deliverGroup) quorumDescription() string {
+	if dg.quorum() == len(dg.Clients) {
+		return "all peers"
+	}
+	return fmt.Sprintf("a quorum of %d of %d peers", dg.quorum(), len(dg.Clients))
+}
+
 // WaitForWG waits for the deliverGroup's wait group and closes
 // the channel when ready
 func (dg *deliverGroup) WaitForWG(readyCh chan struct{}) {
@@ -731,6 +870,26 @@ func (dg *deliverGroup) setError(err error) {
 	dg.mutex.Unlock()
 }
 
+func (dg *deliverGroup) error() error {
+	dg.mutex.Lock()
+	defer dg.mutex.Unlock()
+	return dg.Error
+}
+
+// setConnError records that the deliver client failed to connect to its peer
+func (dg *deliverGroup) setConnError(dc *deliverClient, err error) {
+	dg.mutex.Lock()
+	dc.connErr = err
+	dg.Error = err
+	dg.mutex.Unlock()
+}
+
+func (dg *deliverGroup) connError(dc *deliverClient) error {
+	dg.mutex.Lock()
+	defer dg.mutex.Unlock()
+	return dc.connErr
+}
+
 func createDeliverEnvelope(channelID string, certificate tls.Certificate) *pcommon.Envelope {
 	var tlsCertHash []byte
 	// check for client certificate and create hash if present
@@ -768,3 +927,44 @@ func createDeliverEnvelope(channelID string, certificate tls.Certificate) *pcomm
 
 	return env
 }
+
+// readProtoFile unmarshals the content of the given file into msg
+func readProtoFile(file string, msg proto.Message) error {
+	raw, err := ioutil.ReadFile(file)
+	if err != nil {
+		return errors.Wrapf(err, "error reading %s", file)
+	}
+	if err := proto.Unmarshal(raw, msg); err != nil {
+		return errors.Wrapf(err, "error unmarshaling %s", file)
+	}
+	return nil
+}
+
+// writeProtoFile writes msg to the given file
+func writeProtoFile(file string, msg proto.Message) error {
+	raw, err := proto.Marshal(msg)
+	if err != nil {
+		return errors.Wrap(err, "error marshaling output")
+	}
+	if err := ioutil.WriteFile(file, raw, 0600); err != nil {
+		return errors.Wrapf(err, "error writing %s", file)
+	}
+	return nil
+}
+
+// checkCreator checks that the creator referenced in the signature header
+// of a proposal or transaction is the identity of the signer
+func checkCreator(signatureHeader []byte, signer msp.SigningIdentity) error {
+	shdr, err := protoutil.GetSignatureHeader(signatureHeader)
+	if err != nil {
+		return err
+	}
+	signerBytes, err := signer.Serialize()
+	if err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("error serializing identity for %s", signer.GetIdentifier()))
+	}
+	if !bytes.Equal(signerBytes, shdr.Creator) {
+		return errors.New("the creator referenced in the header is not the identity of the local signer")
+	}
+	return nil
+}
@@ -533,6 +533,100 @@ func TestDeliverGroupWait(t *testing.T) {
 		ContainSubstring("tofu")))
 }
 
+func TestDeliverGroupWaitQuorum(t *testing.T) {
+	defer resetFlags()
+	g := NewGomegaWithT(t)
+
+	validConn := func() *mock.Deliver {
+		mockConn := &mock.Deliver{}
+		mockConn.RecvReturns(&pb.DeliverResponse{
+			Type: &pb.DeliverResponse_FilteredBlock{FilteredBlock: createFilteredBlock("txid0")},
+		}, nil)
+		return mockConn
+	}
+	invalidConn := func() *mock.Deliver {
+		fb := createFilteredBlock("txid0")
+		fb.FilteredTransactions[0].TxValidationCode = pb.TxValidationCode_MVCC_READ_CONFLICT
+		mockConn := &mock.Deliver{}
+		mockConn.RecvReturns(&pb.DeliverResponse{
+			Type: &pb.DeliverResponse_FilteredBlock{FilteredBlock: fb},
+		}, nil)
+		return mockConn
+	}
+	errConn := func() *mock.Deliver {
+		mockConn := &mock.Deliver{}
+		mockConn.RecvReturns(nil, errors.New("avocado"))
+		return mockConn
+	}
+	// a connection that never receives the txid
+	blockedCh := make(chan struct{})
+	defer close(blockedCh)
+	blockedConn := func() *mock.Deliver {
+		mockConn := &mock.Deliver{}
+		mockConn.RecvStub = func() (*pb.DeliverResponse, error) {
+			<-blockedCh
+			return nil, errors.New("closed")
+		}
+		return mockConn
+	}
+	newGroup := func(quorum int, conns ...*mock.Deliver) *deliverGroup {
+		dg := &deliverGroup{
+			ChannelID: "testchannel",
+			TxID:      "txid0",
+			Quorum:    quorum,
+		}
+		for i, conn := range conns {
+			dg.Clients = append(dg.Clients, &deliverClient{
+				Connection: conn,
+				Address:    fmt.Sprintf("peer%d", i),
+			})
+		}
+		return dg
+	}
+
+	// success - the quorum commits the txid as valid, despite an error from one peer
+	dg := newGroup(2, validConn(), errConn(), validConn())
+	err := dg.Wait(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// success - the quorum is reached before the last peer reports
+	dg = newGroup(2, validConn(), blockedConn(), validConn())
+	err = dg.Wait(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(dg.PeerStatuses()).To(Equal([]peerTxStatus{
+		{Address: "peer0", Status: "VALID"},
+		{Address: "peer1", Status: "UNKNOWN"},
+		{Address: "peer2", Status: "VALID"},
+	}))
+
+	// failure - the txid is committed as invalid by too many peers
+	dg = newGroup(2, invalidConn(), validConn(), invalidConn())
+	err = dg.Wait(context.Background())
+	g.Expect(err.Error()).To(HavePrefix("failed to receive txid on a quorum of 2 of 3 peers: txid [txid0] committed with status (MVCC_READ_CONFLICT)"))
+	g.Expect(dg.PeerStatuses()).To(ContainElement(peerTxStatus{Address: "peer0", Status: "MVCC_READ_CONFLICT"}))
+	g.Expect(dg.PeerStatuses()).To(ContainElement(peerTxStatus{Address: "peer2", Status: "MVCC_READ_CONFLICT"}))
+
+	// failure - an invalid txid fails the default quorum of all peers
+	dg = newGroup(0, validConn(), invalidConn())
+	err = dg.Wait(context.Background())
+	g.Expect(err).To(MatchError("failed to receive txid on all peers: txid [txid0] committed with status (MVCC_READ_CONFLICT) at peer1"))
+
+	// failure - errors are reported per peer
+	dg = newGroup(1, errConn())
+	err = dg.Wait(context.Background())
+	g.Expect(err).To(MatchError("failed to receive txid on all peers: error receiving from deliver filtered at peer0: avocado"))
+	g.Expect(dg.PeerStatuses()).To(Equal([]peerTxStatus{
+		{Address: "peer0", Status: "ERROR", Error: "error receiving from deliver filtered at peer0: avocado"},
+	}))
+
+	// failure - timeout before the quorum is reached
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	dg = newGroup(2, validConn(), blockedConn(), blockedConn())
+	err = dg.Wait(ctx)
+	g.Expect(err).To(MatchError("timed out waiting for txid on a quorum of 2 of 3 peers"))
+}
+
 func TestChaincodeInvokeOrQuery_waitForEvent(t *testing.T) {
 	defer resetFlags()
 
@@ -601,6 +695,47 @@ func TestChaincodeInvokeOrQuery_waitForEvent(t *testing.T) {
 		assert.Contains(t, err.Error(), "moist")
 	})
 
+	t.Run("success - a quorum of the deliver clients returns event with expected txid", func(t *testing.T) {
+		defer func() { waitForEventQuorum = 0 }()
+		waitForEventQuorum = 1
+		mockDCErr := getMockDeliverClientWithErr("moist")
+		mockDC := getMockDeliverClientResponseWithTxID("txid0")
+		mockDeliverClients := []api.PeerDeliverClient{mockDCErr, mockDC}
+
+		_, peerStatuses, err := invokeOrQuery(
+			&pb.ChaincodeSpec{},
+			channelID,
+			txID,
+			true,
+			mockCF.Signer,
+			mockCF.Certificate,
+			mockCF.EndorserClients,
+			mockDeliverClients,
+			mockCF.BroadcastClient,
+		)
+		assert.NoError(t, err)
+		assert.Len(t, peerStatuses, 2)
+		assert.Equal(t, peerTxStatus{Address: "peer1", Status: "VALID"}, peerStatuses[1])
+	})
+
+	t.Run("failure - invalid quorum", func(t *testing.T) {
+		defer func() { waitForEventQuorum = 0 }()
+		waitForEventQuorum = 3
+
+		_, err = ChaincodeInvokeOrQuery(
+			&pb.ChaincodeSpec{},
+			channelID,
+			txID,
+			true,
+			mockCF.Signer,
+			mockCF.Certificate,
+			mockCF.EndorserClients,
+			mockCF.DeliverClients,
+			mockCF.BroadcastClient,
+		)
+		assert.EqualError(t, err, "invalid quorum 3 to wait for, must be between 1 and the number of peers (2), or 0 for all peers")
+	})
+
 	t.Run(" failure - timeout occurs - both deliver clients don't return an event with the expected txid before timeout", func(t *testing.T) {
 		delayChan := make(chan struct{})
 		mockDCDelay := getMockDeliverClientRespondAfterDelay(delayChan, "txid0")
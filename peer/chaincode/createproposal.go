@@ -0,0 +1,129 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package chaincode
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/config"
+	"github.com/hyperledger/fabric/peer/common"
+	pcommon "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/msp"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// createProposalCmd returns the cobra command for creating an unsigned
+// invoke proposal
+func createProposalCmd(cf *ChaincodeCmdFactory) *cobra.Command {
+	cpCmd := &cobra.Command{
+		Use:   "createproposal <outputfile>",
+		Short: fmt.Sprintf("Create an unsigned proposal to invoke the specified %s.", chainFuncName),
+		Long: fmt.Sprintf("Create an unsigned proposal to invoke the specified %s, and write it to the output file. "+
+			"The proposal is created for the identity of the signing certificate of the local MSP, whose private key is not required. "+
+			"Sign the proposal with signproposal, and send it to the peers with sendproposal.", chainFuncName),
+		Annotations: map[string]string{common.SkipLocalMSPAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("peer chaincode createproposal <outputfile>")
+			}
+			return createProposal(cmd, args[0], cf)
+		},
+	}
+	flagList := []string{
+		"name",
+		"ctor",
+		"channelID",
+	}
+	attachFlags(cpCmd, flagList)
+
+	return cpCmd
+}
+
+func createProposal(cmd *cobra.Command, outputFile string, cf *ChaincodeCmdFactory) error {
+	if channelID == "" {
+		return errors.New("The required parameter 'channelID' is empty. Rerun the command with -C flag")
+	}
+	spec, err := getChaincodeSpec(cmd)
+	if err != nil {
+		return err
+	}
+	// Parsing of the command line is done so silence cmd usage
+	cmd.SilenceUsage = true
+
+	var creator []byte
+	if cf != nil && cf.Signer != nil {
+		creator, err = cf.Signer.Serialize()
+	} else {
+		creator, err = localCreator()
+	}
+	if err != nil {
+		return err
+	}
+
+	var tMap map[string][]byte
+	if transient != "" {
+		if err := json.Unmarshal([]byte(transient), &tMap); err != nil {
+			return errors.Wrap(err, "error parsing transient string")
+		}
+	}
+
+	invocation := &pb.ChaincodeInvocationSpec{ChaincodeSpec: spec}
+	prop, txID, err := protoutil.CreateChaincodeProposalWithTxIDAndTransient(pcommon.HeaderType_ENDORSER_TRANSACTION, channelID, invocation, creator, "", tMap)
+	if err != nil {
+		return errors.WithMessage(err, "error creating proposal")
+	}
+	propBytes, err := protoutil.GetBytesProposal(prop)
+	if err != nil {
+		return err
+	}
+
+	if err := writeProtoFile(outputFile, &pb.SignedProposal{ProposalBytes: propBytes}); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Wrote unsigned proposal for transaction %s to %s successfully\n", txID, outputFile)
+	return nil
+}
+
+// localCreator returns the serialized identity of the signing certificate
+// of the local MSP, without loading the private key of the MSP
+func localCreator() ([]byte, error) {
+	mspID := viper.GetString("peer.localMspId")
+	if mspID == "" {
+		return nil, errors.New("the local MSP must have an ID")
+	}
+	signcertsDir := filepath.Join(config.GetPath("peer.mspConfigPath"), "signcerts")
+	files, err := ioutil.ReadDir(signcertsDir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading the signing certificate of the local MSP")
+	}
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		raw, err := ioutil.ReadFile(filepath.Join(signcertsDir, file.Name()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading the signing certificate of the local MSP")
+		}
+		block, _ := pem.Decode(raw)
+		if block == nil {
+			continue
+		}
+		// serialize the identity the same way as the MSP does
+		idBytes := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: block.Bytes})
+		return proto.Marshal(&msp.SerializedIdentity{Mspid: mspID, IdBytes: idBytes})
+	}
+	return nil, errors.Errorf("no signing certificate found in %s", signcertsDir)
+}
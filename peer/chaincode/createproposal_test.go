@@ -0,0 +1,99 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package chaincode
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/fabric/core/config/configtest"
+	"github.com/hyperledger/fabric/peer/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createUnsignedProposal writes an unsigned invoke proposal of the default
+// signer to the given file
+func createUnsignedProposal(t *testing.T, file string) {
+	signer, err := common.GetDefaultSigner()
+	require.NoError(t, err)
+
+	cmd := createProposalCmd(&ChaincodeCmdFactory{Signer: signer})
+	addFlags(cmd)
+	cmd.SetArgs([]string{"-n", "example02", "-c", "{\"Args\": [\"invoke\",\"a\",\"b\",\"10\"]}", "-C", "mychannel", file})
+	cmd.SetOutput(&bytes.Buffer{})
+	require.NoError(t, cmd.Execute())
+}
+
+func TestCreateProposalCmd(t *testing.T) {
+	defer resetFlags()
+	resetFlags()
+	dir := newTempDir()
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "unsigned.proposal")
+
+	createUnsignedProposal(t, file)
+
+	signedProp := &pb.SignedProposal{}
+	require.NoError(t, readProtoFile(file, signedProp))
+	assert.Empty(t, signedProp.Signature)
+	prop, err := protoutil.GetProposal(signedProp.ProposalBytes)
+	require.NoError(t, err)
+	hdr, err := protoutil.GetHeader(prop.Header)
+	require.NoError(t, err)
+	chdr, err := protoutil.UnmarshalChannelHeader(hdr.ChannelHeader)
+	require.NoError(t, err)
+	assert.Equal(t, "mychannel", chdr.ChannelId)
+	assert.NotEmpty(t, chdr.TxId)
+
+	// Error case: no channelID specified
+	resetFlags()
+	cmd := createProposalCmd(&ChaincodeCmdFactory{})
+	addFlags(cmd)
+	cmd.SetArgs([]string{"-n", "example02", "-c", "{\"Args\": [\"invoke\"]}", file})
+	cmd.SetOutput(&bytes.Buffer{})
+	assert.EqualError(t, cmd.Execute(), "The required parameter 'channelID' is empty. Rerun the command with -C flag")
+
+	// Error case: no output file
+	cmd = createProposalCmd(&ChaincodeCmdFactory{})
+	addFlags(cmd)
+	cmd.SetArgs([]string{"-n", "example02", "-c", "{\"Args\": [\"invoke\"]}", "-C", "mychannel"})
+	cmd.SetOutput(&bytes.Buffer{})
+	assert.EqualError(t, cmd.Execute(), "peer chaincode createproposal <outputfile>")
+}
+
+func TestLocalCreator(t *testing.T) {
+	defer viper.Reset()
+
+	mspDir, err := configtest.GetDevMspDir()
+	require.NoError(t, err)
+	viper.Set("peer.mspConfigPath", mspDir)
+
+	_, err = localCreator()
+	assert.EqualError(t, err, "the local MSP must have an ID")
+
+	// the creator is serialized the same way as by the local MSP
+	viper.Set("peer.localMspId", "SampleOrg")
+	creator, err := localCreator()
+	require.NoError(t, err)
+	signer, err := common.GetDefaultSigner()
+	require.NoError(t, err)
+	expected, err := signer.Serialize()
+	require.NoError(t, err)
+	assert.Equal(t, expected, creator)
+
+	viper.Set("peer.mspConfigPath", newTempDir())
+	defer os.RemoveAll(viper.GetString("peer.mspConfigPath"))
+	_, err = localCreator()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "error reading the signing certificate of the local MSP")
+}
@@ -0,0 +1,374 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package chaincode
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/hyperledger/fabric/peer/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// devRunCmd returns the cobra command for running a chaincode against a peer
+// in development mode
+func devRunCmd(cf *ChaincodeCmdFactory) *cobra.Command {
+	drCmd := &cobra.Command{
+		Use:   "devrun",
+		Short: fmt.Sprintf("Build and run the specified %s against a peer in development mode.", chainFuncName),
+		Long: fmt.Sprintf("Build and run the %s in the source directory given with --path against a peer in development mode, "+
+			"optionally starting the peer with --startPeer. The source directory is watched for changes, upon which the %s is rebuilt "+
+			"and restarted, and the invocations of the --invocations file, if any, are replayed on the channel given with --channelID.", chainFuncName, chainFuncName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return errors.New("trailing args detected")
+			}
+			return chaincodeDevRun(cmd, cf)
+		},
+	}
+	flagList := []string{
+		"name",
+		"version",
+		"path",
+		"channelID",
+		"peerAddresses",
+		"tlsRootCertFiles",
+		"connectionProfile",
+		"invocations",
+		"startPeer",
+		"pollInterval",
+		"chaincodeAddress",
+	}
+	attachFlags(drCmd, flagList)
+
+	return drCmd
+}
+
+// devInvocation is an invocation of the chaincode that devrun replays after
+// each restart of the chaincode
+type devInvocation struct {
+	// Args are the arguments of the invocation, starting with the function
+	Args []string `json:"args"`
+	// Query is whether the invocation is a query, whose transaction is not
+	// submitted for ordering
+	Query bool `json:"query,omitempty"`
+}
+
+func chaincodeDevRun(cmd *cobra.Command, cf *ChaincodeCmdFactory) error {
+	if chaincodeName == "" || chaincodeName == common.UndefinedParamValue {
+		return errors.New("must supply the chaincode name with -n")
+	}
+	if chaincodeVersion == "" || chaincodeVersion == common.UndefinedParamValue {
+		return errors.New("must supply the chaincode version with -v")
+	}
+	if chaincodePath == "" || chaincodePath == common.UndefinedParamValue {
+		return errors.New("must supply the chaincode source directory with -p")
+	}
+	if pollInterval <= 0 {
+		return errors.Errorf("invalid poll interval %s, must be positive", pollInterval)
+	}
+	var invocations []devInvocation
+	var isOrdererRequired bool
+	if invocationsFile != "" {
+		if channelID == "" {
+			return errors.New("The required parameter 'channelID' is empty. Rerun the command with -C flag")
+		}
+		var err error
+		invocations, err = readDevInvocations(invocationsFile)
+		if err != nil {
+			return err
+		}
+		for _, inv := range invocations {
+			isOrdererRequired = isOrdererRequired || !inv.Query
+		}
+	}
+	// Parsing of the command line is done so silence cmd usage
+	cmd.SilenceUsage = true
+
+	sourceDir, err := filepath.Abs(chaincodePath)
+	if err != nil {
+		return errors.Wrapf(err, "invalid chaincode source directory %s", chaincodePath)
+	}
+	binDir, err := ioutil.TempDir("", "devrun")
+	if err != nil {
+		return errors.Wrap(err, "failed creating the directory of the chaincode binary")
+	}
+	defer os.RemoveAll(binDir)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(signals)
+	go func() {
+		select {
+		case <-signals:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	if startPeer {
+		stopPeer, err := startDevModePeer(cmd.OutOrStdout(), cmd.OutOrStderr())
+		if err != nil {
+			return err
+		}
+		defer stopPeer()
+	}
+
+	r := &devRunner{
+		name:         chaincodeName,
+		version:      chaincodeVersion,
+		sourceDir:    sourceDir,
+		binary:       filepath.Join(binDir, chaincodeName),
+		pollInterval: pollInterval,
+		invocations:  invocations,
+		out:          cmd.OutOrStdout(),
+		build:        buildChaincode,
+		start: func(binary string) (func(), error) {
+			return startChaincode(binary, chaincodeName+":"+chaincodeVersion, chaincodeAddress, cmd.OutOrStdout(), cmd.OutOrStderr())
+		},
+		invoke: func(inv devInvocation) (*pb.ProposalResponse, error) {
+			// the peer may still be starting, so connect on first use
+			if cf == nil {
+				var err error
+				cf, err = InitCmdFactory(cmd.Name(), true, isOrdererRequired)
+				if err != nil {
+					return nil, err
+				}
+			}
+			return devInvoke(cf, inv)
+		},
+	}
+	return r.run(ctx)
+}
+
+// devRunner runs a chaincode against a peer in development mode, and
+// rebuilds and restarts it when its source changes
+type devRunner struct {
+	name         string
+	version      string
+	sourceDir    string
+	binary       string
+	pollInterval time.Duration
+	invocations  []devInvocation
+	out          io.Writer
+
+	build  func(sourceDir, binary string) error
+	start  func(binary string) (stop func(), err error)
+	invoke func(inv devInvocation) (*pb.ProposalResponse, error)
+}
+
+// run builds, starts and replays the invocations on the chaincode each time
+// its source directory changes, until the context is done
+func (r *devRunner) run(ctx context.Context) error {
+	var state string
+	stop := func() {}
+	defer func() { stop() }()
+
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+	for {
+		newState, err := sourceState(r.sourceDir)
+		if err != nil {
+			return err
+		}
+		if newState != state {
+			state = newState
+			stop()
+			stop = r.restart()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// restart builds and starts the chaincode, and replays the invocations on
+// it. It returns the function stopping the chaincode.
+func (r *devRunner) restart() func() {
+	logger.Infof("Building chaincode %s:%s from %s", r.name, r.version, r.sourceDir)
+	if err := r.build(r.sourceDir, r.binary); err != nil {
+		logger.Errorf("Failed building chaincode %s:%s, waiting for changes: %s", r.name, r.version, err)
+		return func() {}
+	}
+	stop, err := r.start(r.binary)
+	if err != nil {
+		logger.Errorf("Failed starting chaincode %s:%s, waiting for changes: %s", r.name, r.version, err)
+		return func() {}
+	}
+	logger.Infof("Started chaincode %s:%s", r.name, r.version)
+
+	for _, inv := range r.invocations {
+		resp, err := r.invoke(inv)
+		if err != nil {
+			fmt.Fprintf(r.out, "%s %v failed: %s\n", invocationType(inv), inv.Args, err)
+			continue
+		}
+		if resp.Response == nil {
+			fmt.Fprintf(r.out, "%s %v failed: received nil response\n", invocationType(inv), inv.Args)
+			continue
+		}
+		fmt.Fprintf(r.out, "%s %v: status %d, message %q, payload %q\n",
+			invocationType(inv), inv.Args, resp.Response.Status, resp.Response.Message, resp.Response.Payload)
+	}
+	return stop
+}
+
+func invocationType(inv devInvocation) string {
+	if inv.Query {
+		return "query"
+	}
+	return "invoke"
+}
+
+// devInvoke performs the given invocation of the chaincode
+func devInvoke(cf *ChaincodeCmdFactory, inv devInvocation) (*pb.ProposalResponse, error) {
+	input := &pb.ChaincodeInput{}
+	for _, arg := range inv.Args {
+		input.Args = append(input.Args, []byte(arg))
+	}
+	spec := &pb.ChaincodeSpec{
+		Type:        pb.ChaincodeSpec_GOLANG,
+		ChaincodeId: &pb.ChaincodeID{Name: chaincodeName},
+		Input:       input,
+	}
+	return ChaincodeInvokeOrQuery(
+		spec,
+		channelID,
+		"",
+		!inv.Query,
+		cf.Signer,
+		cf.Certificate,
+		cf.EndorserClients,
+		cf.DeliverClients,
+		cf.BroadcastClient,
+	)
+}
+
+// readDevInvocations reads the invocations to replay from the given JSON file
+func readDevInvocations(file string) ([]devInvocation, error) {
+	raw, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed reading invocations file %s", file)
+	}
+	var invocations []devInvocation
+	if err := json.Unmarshal(raw, &invocations); err != nil {
+		return nil, errors.Wrapf(err, "failed parsing invocations file %s", file)
+	}
+	for i, inv := range invocations {
+		if len(inv.Args) == 0 {
+			return nil, errors.Errorf("invocation %d of invocations file %s has no args", i, file)
+		}
+	}
+	return invocations, nil
+}
+
+// sourceState returns a digest of the names, sizes and modification times of
+// the files in the given directory, which changes when any file changes.
+// Hidden files and directories are skipped.
+func sourceState(dir string) (string, error) {
+	h := sha256.New()
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != dir && strings.HasPrefix(info.Name(), ".") {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.IsDir() {
+			return nil
+		}
+		fmt.Fprintf(h, "%s %d %d\n", path, info.Size(), info.ModTime().UnixNano())
+		return nil
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed reading chaincode source directory %s", dir)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// buildChaincode builds the Go chaincode in sourceDir into binary
+func buildChaincode(sourceDir, binary string) error {
+	build := exec.Command("go", "build", "-o", binary, ".")
+	build.Dir = sourceDir
+	output, err := build.CombinedOutput()
+	if err != nil {
+		return errors.Errorf("go build failed: %s\n%s", err, output)
+	}
+	return nil
+}
+
+// startChaincode starts the chaincode binary with the given chaincode id,
+// connecting to the peer at peerAddress
+func startChaincode(binary, ccID, peerAddress string, stdout, stderr io.Writer) (func(), error) {
+	cc := exec.Command(binary)
+	cc.Env = append(os.Environ(),
+		"CORE_CHAINCODE_ID_NAME="+ccID,
+		"CORE_PEER_ADDRESS="+peerAddress,
+		"CORE_PEER_TLS_ENABLED=false",
+	)
+	cc.Stdout = stdout
+	cc.Stderr = stderr
+	if err := cc.Start(); err != nil {
+		return nil, errors.Wrapf(err, "failed starting %s", binary)
+	}
+	return func() { stopProcess(cc) }, nil
+}
+
+// startDevModePeer starts this peer binary in chaincode development mode
+func startDevModePeer(stdout, stderr io.Writer) (func(), error) {
+	peer := exec.Command(os.Args[0], "node", "start", "--peer-chaincodedev")
+	peer.Env = os.Environ()
+	if os.Getenv("CORE_PEER_CHAINCODELISTENADDRESS") == "" {
+		peer.Env = append(peer.Env, "CORE_PEER_CHAINCODELISTENADDRESS="+chaincodeAddress)
+	}
+	peer.Stdout = stdout
+	peer.Stderr = stderr
+	if err := peer.Start(); err != nil {
+		return nil, errors.Wrap(err, "failed starting the peer in chaincode development mode")
+	}
+	logger.Infof("Started the peer in chaincode development mode")
+	return func() { stopProcess(peer) }, nil
+}
+
+// stopProcess interrupts the given process, and kills it if it does not exit
+// in time
+func stopProcess(p *exec.Cmd) {
+	done := make(chan struct{})
+	go func() {
+		p.Wait()
+		close(done)
+	}()
+	p.Process.Signal(os.Interrupt)
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		p.Process.Kill()
+		<-done
+	}
+}
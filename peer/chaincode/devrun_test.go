@@ -0,0 +1,199 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package chaincode
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/hyperledger/fabric/protos/peer"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDevRunCmdValidation(t *testing.T) {
+	defer resetFlags()
+
+	tests := []struct {
+		args        []string
+		expectedErr string
+	}{
+		{[]string{"-v", "0", "-p", "."}, "must supply the chaincode name with -n"},
+		{[]string{"-n", "mycc", "-p", "."}, "must supply the chaincode version with -v"},
+		{[]string{"-n", "mycc", "-v", "0"}, "must supply the chaincode source directory with -p"},
+		{[]string{"-n", "mycc", "-v", "0", "-p", ".", "--pollInterval", "0s"}, "invalid poll interval 0s, must be positive"},
+		{[]string{"-n", "mycc", "-v", "0", "-p", ".", "--invocations", "invocations.json"}, "The required parameter 'channelID' is empty. Rerun the command with -C flag"},
+		{[]string{"-n", "mycc", "-v", "0", "-p", ".", "extra"}, "trailing args detected"},
+	}
+	for _, test := range tests {
+		resetFlags()
+		cmd := devRunCmd(&ChaincodeCmdFactory{})
+		addFlags(cmd)
+		cmd.SetArgs(test.args)
+		cmd.SetOutput(&bytes.Buffer{})
+		assert.EqualError(t, cmd.Execute(), test.expectedErr)
+	}
+}
+
+func TestReadDevInvocations(t *testing.T) {
+	dir := newTempDir()
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "invocations.json")
+
+	require.NoError(t, ioutil.WriteFile(file, []byte(`[{"args":["invoke","a","b","10"]},{"args":["query","a"],"query":true}]`), 0600))
+	invocations, err := readDevInvocations(file)
+	assert.NoError(t, err)
+	assert.Equal(t, []devInvocation{
+		{Args: []string{"invoke", "a", "b", "10"}},
+		{Args: []string{"query", "a"}, Query: true},
+	}, invocations)
+
+	require.NoError(t, ioutil.WriteFile(file, []byte(`[{"args":[]}]`), 0600))
+	_, err = readDevInvocations(file)
+	assert.EqualError(t, err, "invocation 0 of invocations file "+file+" has no args")
+
+	require.NoError(t, ioutil.WriteFile(file, []byte(`{`), 0600))
+	_, err = readDevInvocations(file)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed parsing invocations file")
+
+	_, err = readDevInvocations(filepath.Join(dir, "missing.json"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed reading invocations file")
+}
+
+func TestSourceState(t *testing.T) {
+	dir := newTempDir()
+	defer os.RemoveAll(dir)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "cc.go"), []byte("package main"), 0600))
+
+	state, err := sourceState(dir)
+	require.NoError(t, err)
+	unchanged, err := sourceState(dir)
+	require.NoError(t, err)
+	assert.Equal(t, state, unchanged)
+
+	// hidden files and directories are skipped
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".git"), 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, ".git", "HEAD"), []byte("ref"), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, ".cc.go.swp"), []byte("swap"), 0600))
+	unchanged, err = sourceState(dir)
+	require.NoError(t, err)
+	assert.Equal(t, state, unchanged)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "lib"), 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "lib", "lib.go"), []byte("package lib"), 0600))
+	changed, err := sourceState(dir)
+	require.NoError(t, err)
+	assert.NotEqual(t, state, changed)
+
+	_, err = sourceState(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
+
+type syncBuffer struct {
+	mutex sync.Mutex
+	buf   bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.buf.String()
+}
+
+func TestDevRunnerRun(t *testing.T) {
+	dir := newTempDir()
+	defer os.RemoveAll(dir)
+	source := filepath.Join(dir, "cc.go")
+	require.NoError(t, ioutil.WriteFile(source, []byte("package main"), 0600))
+
+	var mutex sync.Mutex
+	var builds, starts, stops int
+	buildErr := errors.New("syntax error")
+	out := &syncBuffer{}
+	r := &devRunner{
+		name:         "mycc",
+		version:      "0",
+		sourceDir:    dir,
+		binary:       filepath.Join(dir, "mycc"),
+		pollInterval: 10 * time.Millisecond,
+		invocations: []devInvocation{
+			{Args: []string{"invoke", "a", "b", "10"}},
+			{Args: []string{"query", "a"}, Query: true},
+		},
+		out: out,
+		build: func(sourceDir, binary string) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			builds++
+			content, _ := ioutil.ReadFile(source)
+			if string(content) == "package main with error" {
+				return buildErr
+			}
+			return nil
+		},
+		start: func(binary string) (func(), error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			starts++
+			return func() {
+				mutex.Lock()
+				defer mutex.Unlock()
+				stops++
+			}, nil
+		},
+		invoke: func(inv devInvocation) (*pb.ProposalResponse, error) {
+			if inv.Query {
+				return nil, errors.New("chaincode not found")
+			}
+			return &pb.ProposalResponse{Response: &pb.Response{Status: 200, Payload: []byte("ok")}}, nil
+		},
+	}
+	counts := func() []int {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return []int{builds, starts, stops}
+	}
+	gt := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- r.run(ctx) }()
+
+	// the chaincode is built and started, and the invocations are replayed
+	gt.Eventually(counts).Should(Equal([]int{1, 1, 0}))
+	gt.Eventually(out.String).Should(Equal("invoke [invoke a b 10]: status 200, message \"\", payload \"ok\"\n" +
+		"query [query a] failed: chaincode not found\n"))
+
+	// a change that fails to build stops the chaincode without restarting it
+	require.NoError(t, ioutil.WriteFile(source, []byte("package main with error"), 0600))
+	gt.Eventually(counts).Should(Equal([]int{2, 1, 1}))
+
+	// a fixed change restarts the chaincode
+	require.NoError(t, ioutil.WriteFile(source, []byte("package main // fixed"), 0600))
+	gt.Eventually(counts).Should(Equal([]int{3, 2, 1}))
+
+	// the chaincode is stopped when the runner is done
+	cancel()
+	assert.NoError(t, <-done)
+	assert.Equal(t, []int{3, 2, 2}, counts())
+}
@@ -8,8 +8,11 @@ package chaincode
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"os"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/core/chaincode/persistence"
@@ -201,6 +204,10 @@ func (i *Installer) submitInstallProposal(signedProposal *pb.SignedProposal) err
 	}
 	logger.Infof("Installed remotely: %v", proposalResponse)
 
+	result := installResult{
+		Status:  proposalResponse.Response.Status,
+		Message: proposalResponse.Response.Message,
+	}
 	if i.Input.NewLifecycle {
 		icr := &lb.InstallChaincodeResult{}
 		err := proto.Unmarshal(proposalResponse.Response.Payload, icr)
@@ -208,11 +215,28 @@ func (i *Installer) submitInstallProposal(signedProposal *pb.SignedProposal) err
 			return errors.Wrap(err, "error unmarshaling proposal response's response payload")
 		}
 		logger.Infof("Chaincode code package hash: %x", icr.Hash)
+		result.Hash = hex.EncodeToString(icr.Hash)
+	}
+
+	if common.OutputJSON() {
+		var w io.Writer = os.Stdout
+		if i.Command != nil {
+			w = i.Command.OutOrStdout()
+		}
+		return common.PrintJSON(w, result)
 	}
 
 	return nil
 }
 
+// installResult is the JSON output of the install command. The hash of
+// the chaincode package is only known for the _lifecycle install.
+type installResult struct {
+	Status  int32  `json:"status"`
+	Message string `json:"message"`
+	Hash    string `json:"hash,omitempty"`
+}
+
 func (i *Installer) validateInput() error {
 	if i.Input.PackageFile == "" {
 		return errors.New("chaincode install package must be provided")
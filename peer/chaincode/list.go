@@ -11,10 +11,12 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"io"
 	"reflect"
 	"strings"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/peer/common"
 	cb "github.com/hyperledger/fabric/protos/common"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	lb "github.com/hyperledger/fabric/protos/peer/lifecycle"
@@ -111,14 +113,15 @@ func getChaincodes(cmd *cobra.Command, cf *ChaincodeCmdFactory) error {
 		return errors.Errorf("bad response: %d - %s", proposalResponse.Response.Status, proposalResponse.Response.Message)
 	}
 
-	return printResponse(getInstalledChaincodes, proposalResponse)
+	return printResponse(cmd.OutOrStdout(), getInstalledChaincodes, proposalResponse)
 }
 
 // printResponse prints the information included in the response
 // from the server. If getInstalledChaincodes is set to false, the
 // proposal response will be interpreted as containing instantiated
-// chaincode information.
-func printResponse(getInstalledChaincodes bool, proposalResponse *pb.ProposalResponse) error {
+// chaincode information. When the JSON output format is selected, the
+// response is written to w as a chaincodeList.
+func printResponse(w io.Writer, getInstalledChaincodes bool, proposalResponse *pb.ProposalResponse) error {
 	var qicr *lb.QueryInstalledChaincodesResult
 	var cqr *pb.ChaincodeQueryResponse
 
@@ -136,6 +139,10 @@ func printResponse(getInstalledChaincodes bool, proposalResponse *pb.ProposalRes
 		}
 	}
 
+	if common.OutputJSON() {
+		return common.PrintJSON(w, newChaincodeList(qicr, cqr))
+	}
+
 	if getInstalledChaincodes {
 		fmt.Println("Get installed chaincodes on peer:")
 	} else {
@@ -156,6 +163,51 @@ func printResponse(getInstalledChaincodes bool, proposalResponse *pb.ProposalRes
 	return nil
 }
 
+// chaincodeList is the JSON output of the list command
+type chaincodeList struct {
+	Chaincodes []chaincodeListEntry `json:"chaincodes"`
+}
+
+// chaincodeListEntry describes an installed or instantiated chaincode.
+// Byte fields are hex encoded, as in the text output.
+type chaincodeListEntry struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Path    string `json:"path,omitempty"`
+	Input   string `json:"input,omitempty"`
+	Escc    string `json:"escc,omitempty"`
+	Vscc    string `json:"vscc,omitempty"`
+	ID      string `json:"id,omitempty"`
+	Hash    string `json:"hash,omitempty"`
+}
+
+func newChaincodeList(qicr *lb.QueryInstalledChaincodesResult, cqr *pb.ChaincodeQueryResponse) chaincodeList {
+	list := chaincodeList{Chaincodes: []chaincodeListEntry{}}
+	if qicr != nil {
+		for _, chaincode := range qicr.InstalledChaincodes {
+			list.Chaincodes = append(list.Chaincodes, chaincodeListEntry{
+				Name:    chaincode.Name,
+				Version: chaincode.Version,
+				Hash:    hex.EncodeToString(chaincode.Hash),
+			})
+		}
+		return list
+	}
+
+	for _, chaincode := range cqr.Chaincodes {
+		list.Chaincodes = append(list.Chaincodes, chaincodeListEntry{
+			Name:    chaincode.Name,
+			Version: chaincode.Version,
+			Path:    chaincode.Path,
+			Input:   chaincode.Input,
+			Escc:    chaincode.Escc,
+			Vscc:    chaincode.Vscc,
+			ID:      hex.EncodeToString(chaincode.Id),
+		})
+	}
+	return list
+}
+
 type ccInfo struct {
 	*pb.ChaincodeInfo
 }
@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package chaincode
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"testing"
@@ -190,3 +191,74 @@ func TestString(t *testing.T) {
 	}
 	assert.Equal(t, "Name: ccName, Version: 1.0, Input: input, Escc: escc, Vscc: vscc, Id: 0102030405", ccInf.String())
 }
+
+func TestChaincodeListCmdJSON(t *testing.T) {
+	signer, err := common.GetDefaultSigner()
+	assert.NoError(t, err)
+
+	installedCqr := &pb.ChaincodeQueryResponse{
+		Chaincodes: []*pb.ChaincodeInfo{
+			{Name: "mycc1", Version: "1.0", Path: "codePath1", Input: "input", Escc: "escc", Vscc: "vscc", Id: []byte{1, 2, 3}},
+		},
+	}
+	installedCqrBytes, err := proto.Marshal(installedCqr)
+	assert.NoError(t, err)
+
+	mockResponse := &pb.ProposalResponse{
+		Response:    &pb.Response{Status: 200, Payload: installedCqrBytes},
+		Endorsement: &pb.Endorsement{},
+	}
+	mockCF := &ChaincodeCmdFactory{
+		EndorserClients: []pb.EndorserClient{common.GetMockEndorserClient(mockResponse, nil)},
+		Signer:          signer,
+		BroadcastClient: common.GetMockBroadcastClient(nil),
+	}
+
+	t.Run("legacy lscc", func(t *testing.T) {
+		resetFlags()
+		cmd := listCmd(mockCF)
+		common.AddOutputFlag(cmd.Flags())
+		defer cmd.Flags().Set("output", common.OutputFormatText)
+		buffer := &bytes.Buffer{}
+		cmd.SetOutput(buffer)
+		cmd.SetArgs([]string{"--installed", "--output", "json"})
+		assert.NoError(t, cmd.Execute())
+		assert.Equal(t, `{"chaincodes":[{"name":"mycc1","version":"1.0","path":"codePath1","input":"input","escc":"escc","vscc":"vscc","id":"010203"}]}`+"\n", buffer.String())
+	})
+
+	t.Run("_lifecycle", func(t *testing.T) {
+		resetFlags()
+		qicrBytes, err := proto.Marshal(&lb.QueryInstalledChaincodesResult{
+			InstalledChaincodes: []*lb.QueryInstalledChaincodesResult_InstalledChaincode{
+				{Name: "test1", Version: "v1.0", Hash: []byte("hash1")},
+			},
+		})
+		assert.NoError(t, err)
+		mockResponse.Response = &pb.Response{Status: 200, Payload: qicrBytes}
+
+		cmd := listCmd(mockCF)
+		common.AddOutputFlag(cmd.Flags())
+		defer cmd.Flags().Set("output", common.OutputFormatText)
+		buffer := &bytes.Buffer{}
+		cmd.SetOutput(buffer)
+		cmd.SetArgs([]string{"--installed", "--newLifecycle", "--output", "json"})
+		assert.NoError(t, cmd.Execute())
+		assert.Equal(t, `{"chaincodes":[{"name":"test1","version":"v1.0","hash":"`+hex.EncodeToString([]byte("hash1"))+`"}]}`+"\n", buffer.String())
+	})
+
+	t.Run("no chaincodes", func(t *testing.T) {
+		resetFlags()
+		cqrBytes, err := proto.Marshal(&pb.ChaincodeQueryResponse{})
+		assert.NoError(t, err)
+		mockResponse.Response = &pb.Response{Status: 200, Payload: cqrBytes}
+
+		cmd := listCmd(mockCF)
+		common.AddOutputFlag(cmd.Flags())
+		defer cmd.Flags().Set("output", common.OutputFormatText)
+		buffer := &bytes.Buffer{}
+		cmd.SetOutput(buffer)
+		cmd.SetArgs([]string{"--instantiated", "-C", "mychannel", "--output", "json"})
+		assert.NoError(t, cmd.Execute())
+		assert.Equal(t, `{"chaincodes":[]}`+"\n", buffer.String())
+	})
+}
@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package chaincode
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 
@@ -61,6 +62,31 @@ func TestQueryCmd(t *testing.T) {
 	assert.Error(t, err, "Expected error executing query command")
 }
 
+func TestQueryCmdJSON(t *testing.T) {
+	mockCF, err := getMockChaincodeCmdFactory()
+	assert.NoError(t, err, "Error getting mock chaincode command factory")
+	defer func() {
+		chaincodeQueryRaw = false
+		chaincodeQueryHex = false
+	}()
+
+	args := []string{"-C", "mychannel", "-n", "example02", "-c", "{\"Args\": [\"query\",\"a\"]}", "--output", "json"}
+	cmd := newQueryCmdForTest(mockCF, args)
+	common.AddOutputFlag(cmd.Flags())
+	defer cmd.Flags().Set("output", common.OutputFormatText)
+	buffer := &bytes.Buffer{}
+	cmd.SetOutput(buffer)
+	assert.NoError(t, cmd.Execute())
+	assert.Equal(t, `{"payload":""}`+"\n", buffer.String())
+
+	// The raw payload can't be represented in the JSON output
+	cmd = newQueryCmdForTest(mockCF, append([]string{"-r"}, args...))
+	common.AddOutputFlag(cmd.Flags())
+	cmd.SetOutput(&bytes.Buffer{})
+	err = cmd.Execute()
+	assert.EqualError(t, err, "option --raw (-r) is not compatible with the json output format")
+}
+
 func TestQueryCmdEndorsementFailure(t *testing.T) {
 	args := []string{"-C", "mychannel", "-n", "example02", "-c", "{\"Args\": [\"queryinvalid\",\"a\"]}"}
 	ccRespStatus := [2]int32{502, 400}
@@ -0,0 +1,131 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package chaincode
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/hyperledger/fabric/peer/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// sendProposalCmd returns the cobra command for sending a signed proposal
+// to the peers for endorsement
+func sendProposalCmd(cf *ChaincodeCmdFactory) *cobra.Command {
+	spCmd := &cobra.Command{
+		Use:   "sendproposal <signedproposal> <outputtx>",
+		Short: "Send the specified signed proposal to the peers for endorsement.",
+		Long: "Send the specified signed proposal, created with createproposal and signed with signproposal, to the peers for endorsement, " +
+			"and write the unsigned transaction assembled from the endorsements to the output file. " +
+			"Sign the transaction with signtx, and submit it to the ordering service with sendtx.",
+		Annotations: map[string]string{common.SkipLocalMSPAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("peer chaincode sendproposal <signedproposal> <outputtx>")
+			}
+			return sendProposal(cmd, args[0], args[1], cf)
+		},
+	}
+	flagList := []string{
+		"peerAddresses",
+		"tlsRootCertFiles",
+		"connectionProfile",
+	}
+	attachFlags(spCmd, flagList)
+
+	return spCmd
+}
+
+func sendProposal(cmd *cobra.Command, inputFile, outputFile string, cf *ChaincodeCmdFactory) error {
+	// Parsing of the command line is done so silence cmd usage
+	cmd.SilenceUsage = true
+
+	signedProp := &pb.SignedProposal{}
+	if err := readProtoFile(inputFile, signedProp); err != nil {
+		return err
+	}
+	if len(signedProp.Signature) == 0 {
+		return errors.New("the proposal is not signed")
+	}
+	prop, err := protoutil.GetProposal(signedProp.ProposalBytes)
+	if err != nil {
+		return err
+	}
+	hdr, err := protoutil.GetHeader(prop.Header)
+	if err != nil {
+		return err
+	}
+	chdr, err := protoutil.UnmarshalChannelHeader(hdr.ChannelHeader)
+	if err != nil {
+		return err
+	}
+
+	if cf == nil {
+		// the channel of the proposal selects the peers of a connection profile
+		channelID = chdr.ChannelId
+		endorserClients, err := getEndorserClients(cmd.Name())
+		if err != nil {
+			return err
+		}
+		cf = &ChaincodeCmdFactory{EndorserClients: endorserClients}
+	}
+
+	var responses []*pb.ProposalResponse
+	for _, endorser := range cf.EndorserClients {
+		proposalResp, err := endorser.ProcessProposal(context.Background(), signedProp)
+		if err != nil {
+			return errors.WithMessage(err, "error endorsing proposal")
+		}
+		if proposalResp.Response == nil || proposalResp.Response.Status >= shim.ERRORTHRESHOLD || proposalResp.Endorsement == nil {
+			return errors.Errorf("endorsement failure during sendproposal. response: %v", proposalResp.Response)
+		}
+		responses = append(responses, proposalResp)
+	}
+	if len(responses) == 0 {
+		// this should only happen if some new code has introduced a bug
+		return errors.New("no proposal responses received - this might indicate a bug")
+	}
+
+	env, err := protoutil.CreateUnsignedTx(prop, responses...)
+	if err != nil {
+		return errors.WithMessage(err, "could not assemble transaction")
+	}
+	if err := writeProtoFile(outputFile, env); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Wrote unsigned transaction %s to %s successfully\n", chdr.TxId, outputFile)
+	return nil
+}
+
+// getEndorserClients returns the endorser clients of the peers given on the
+// command line, without requiring the local MSP
+func getEndorserClients(cmdName string) ([]pb.EndorserClient, error) {
+	if err := validatePeerConnectionParameters(cmdName); err != nil {
+		return nil, errors.WithMessage(err, "error validating peer connection parameters")
+	}
+	var endorserClients []pb.EndorserClient
+	for i, address := range peerAddresses {
+		var tlsRootCertFile string
+		if tlsRootCertFiles != nil {
+			tlsRootCertFile = tlsRootCertFiles[i]
+		}
+		endorserClient, err := common.GetEndorserClientFnc(address, tlsRootCertFile)
+		if err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("error getting endorser client for %s", cmdName))
+		}
+		endorserClients = append(endorserClients, endorserClient)
+	}
+	if len(endorserClients) == 0 {
+		return nil, errors.New("no endorser clients retrieved - this might indicate a bug")
+	}
+	return endorserClients, nil
+}
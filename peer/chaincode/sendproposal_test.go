@@ -0,0 +1,104 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package chaincode
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/fabric/peer/common"
+	pcommon "github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createSignedProposal writes a signed invoke proposal of the default signer
+// to the given file
+func createSignedProposal(t *testing.T, file string) {
+	unsignedFile := file + ".unsigned"
+	createUnsignedProposal(t, unsignedFile)
+	defer os.Remove(unsignedFile)
+
+	signer, err := common.GetDefaultSigner()
+	require.NoError(t, err)
+	cmd := signProposalCmd(&ChaincodeCmdFactory{Signer: signer})
+	cmd.SetArgs([]string{unsignedFile, file})
+	cmd.SetOutput(&bytes.Buffer{})
+	require.NoError(t, cmd.Execute())
+}
+
+func TestSendProposalCmd(t *testing.T) {
+	defer resetFlags()
+	resetFlags()
+	dir := newTempDir()
+	defer os.RemoveAll(dir)
+	unsignedPropFile := filepath.Join(dir, "unsigned.proposal")
+	signedPropFile := filepath.Join(dir, "signed.proposal")
+	txFile := filepath.Join(dir, "unsigned.tx")
+	createUnsignedProposal(t, unsignedPropFile)
+	createSignedProposal(t, signedPropFile)
+
+	run := func(cf *ChaincodeCmdFactory, args ...string) (string, error) {
+		cmd := sendProposalCmd(cf)
+		cmd.SetArgs(args)
+		buf := &bytes.Buffer{}
+		cmd.SetOutput(buf)
+		err := cmd.Execute()
+		return buf.String(), err
+	}
+
+	mockResponse := &pb.ProposalResponse{
+		Response:    &pb.Response{Status: 200},
+		Endorsement: &pb.Endorsement{},
+	}
+	mockCF := &ChaincodeCmdFactory{
+		EndorserClients: []pb.EndorserClient{
+			common.GetMockEndorserClient(mockResponse, nil),
+			common.GetMockEndorserClient(mockResponse, nil),
+		},
+	}
+	out, err := run(mockCF, signedPropFile, txFile)
+	require.NoError(t, err)
+
+	env := &pcommon.Envelope{}
+	require.NoError(t, readProtoFile(txFile, env))
+	assert.Empty(t, env.Signature)
+	chdr, err := protoutil.ChannelHeader(env)
+	require.NoError(t, err)
+	assert.Equal(t, "mychannel", chdr.ChannelId)
+	assert.Equal(t, "Wrote unsigned transaction "+chdr.TxId+" to "+txFile+" successfully\n", out)
+
+	// Error case: the proposal is not signed
+	_, err = run(mockCF, unsignedPropFile, txFile)
+	assert.EqualError(t, err, "the proposal is not signed")
+
+	// Error case: endorsement failure
+	failureCF := &ChaincodeCmdFactory{
+		EndorserClients: []pb.EndorserClient{
+			common.GetMockEndorserClient(&pb.ProposalResponse{Response: &pb.Response{Status: 500, Message: "bad"}}, nil),
+		},
+	}
+	_, err = run(failureCF, signedPropFile, txFile)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "endorsement failure during sendproposal")
+
+	// Error case: endorsement error
+	errorCF := &ChaincodeCmdFactory{
+		EndorserClients: []pb.EndorserClient{common.GetMockEndorserClient(nil, errors.New("barf"))},
+	}
+	_, err = run(errorCF, signedPropFile, txFile)
+	assert.EqualError(t, err, "error endorsing proposal: barf")
+
+	// Error case: wrong number of arguments
+	_, err = run(mockCF, signedPropFile)
+	assert.EqualError(t, err, "peer chaincode sendproposal <signedproposal> <outputtx>")
+}
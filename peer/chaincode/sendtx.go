@@ -0,0 +1,75 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package chaincode
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/fabric/peer/common"
+	pcommon "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// sendTxCmd returns the cobra command for submitting a signed transaction
+// to the ordering service
+func sendTxCmd(cf *ChaincodeCmdFactory) *cobra.Command {
+	stCmd := &cobra.Command{
+		Use:   "sendtx <signedtx>",
+		Short: "Submit the specified signed transaction to the ordering service.",
+		Long: "Submit the specified signed transaction, assembled with sendproposal and signed with signtx, " +
+			"to the ordering service given with the --orderer flag.",
+		Annotations: map[string]string{common.SkipLocalMSPAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("peer chaincode sendtx <signedtx>")
+			}
+			return sendTx(cmd, args[0], cf)
+		},
+	}
+
+	return stCmd
+}
+
+func sendTx(cmd *cobra.Command, inputFile string, cf *ChaincodeCmdFactory) error {
+	// Parsing of the command line is done so silence cmd usage
+	cmd.SilenceUsage = true
+
+	env := &pcommon.Envelope{}
+	if err := readProtoFile(inputFile, env); err != nil {
+		return err
+	}
+	if len(env.Signature) == 0 {
+		return errors.New("the transaction is not signed")
+	}
+	chdr, err := protoutil.ChannelHeader(env)
+	if err != nil {
+		return err
+	}
+
+	if cf == nil {
+		// the orderer endpoint cannot be retrieved from the peer without
+		// signing with the local MSP, so it must be given
+		if viper.GetString("orderer.address") == "" {
+			return errors.New("the ordering service endpoint must be provided with the --orderer flag")
+		}
+		broadcastClient, err := common.GetBroadcastClientFnc()
+		if err != nil {
+			return errors.WithMessage(err, "error getting broadcast client")
+		}
+		cf = &ChaincodeCmdFactory{BroadcastClient: broadcastClient}
+	}
+	defer cf.BroadcastClient.Close()
+
+	if err := cf.BroadcastClient.Send(env); err != nil {
+		return errors.WithMessage(err, "error sending transaction")
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Submitted transaction %s to the ordering service\n", chdr.TxId)
+	return nil
+}
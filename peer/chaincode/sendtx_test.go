@@ -0,0 +1,70 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package chaincode
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/fabric/peer/common"
+	pcommon "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendTxCmd(t *testing.T) {
+	defer viper.Reset()
+	defer resetFlags()
+	resetFlags()
+	dir := newTempDir()
+	defer os.RemoveAll(dir)
+	unsignedFile := filepath.Join(dir, "unsigned.tx")
+	signedFile := filepath.Join(dir, "signed.tx")
+	createUnsignedTx(t, unsignedFile)
+
+	signer, err := common.GetDefaultSigner()
+	require.NoError(t, err)
+	cmd := signTxCmd(&ChaincodeCmdFactory{Signer: signer})
+	cmd.SetArgs([]string{unsignedFile, signedFile})
+	cmd.SetOutput(&bytes.Buffer{})
+	require.NoError(t, cmd.Execute())
+
+	run := func(cf *ChaincodeCmdFactory, args ...string) (string, error) {
+		cmd := sendTxCmd(cf)
+		cmd.SetArgs(args)
+		buf := &bytes.Buffer{}
+		cmd.SetOutput(buf)
+		err := cmd.Execute()
+		return buf.String(), err
+	}
+
+	out, err := run(&ChaincodeCmdFactory{BroadcastClient: common.GetMockBroadcastClient(nil)}, signedFile)
+	require.NoError(t, err)
+	env := &pcommon.Envelope{}
+	require.NoError(t, readProtoFile(signedFile, env))
+	chdr, err := protoutil.ChannelHeader(env)
+	require.NoError(t, err)
+	assert.Equal(t, "Submitted transaction "+chdr.TxId+" to the ordering service\n", out)
+
+	// Error case: the transaction is not signed
+	_, err = run(&ChaincodeCmdFactory{BroadcastClient: common.GetMockBroadcastClient(nil)}, unsignedFile)
+	assert.EqualError(t, err, "the transaction is not signed")
+
+	// Error case: the orderer rejects the transaction
+	_, err = run(&ChaincodeCmdFactory{BroadcastClient: common.GetMockBroadcastClient(errors.New("barf"))}, signedFile)
+	assert.EqualError(t, err, "error sending transaction: barf")
+
+	// Error case: no ordering service endpoint
+	viper.Set("orderer.address", "")
+	_, err = run(nil, signedFile)
+	assert.EqualError(t, err, "the ordering service endpoint must be provided with the --orderer flag")
+}
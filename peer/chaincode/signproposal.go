@@ -0,0 +1,73 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package chaincode
+
+import (
+	"fmt"
+
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// signProposalCmd returns the cobra command for signing a proposal
+func signProposalCmd(cf *ChaincodeCmdFactory) *cobra.Command {
+	spCmd := &cobra.Command{
+		Use:   "signproposal <inputproposal> <outputproposal>",
+		Short: "Sign the specified proposal",
+		Long: "Sign the specified proposal, created with createproposal, with the local MSP. " +
+			"No connection to the network is required, so the proposal may be signed on another machine.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("peer chaincode signproposal <inputproposal> <outputproposal>")
+			}
+			return signProposal(cmd, args[0], args[1], cf)
+		},
+	}
+
+	return spCmd
+}
+
+func signProposal(cmd *cobra.Command, inputFile, outputFile string, cf *ChaincodeCmdFactory) error {
+	// Parsing of the command line is done so silence cmd usage
+	cmd.SilenceUsage = true
+
+	var err error
+	if cf == nil {
+		cf, err = InitCmdFactory(cmd.Name(), false, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	signedProp := &pb.SignedProposal{}
+	if err := readProtoFile(inputFile, signedProp); err != nil {
+		return err
+	}
+	prop, err := protoutil.GetProposal(signedProp.ProposalBytes)
+	if err != nil {
+		return err
+	}
+	hdr, err := protoutil.GetHeader(prop.Header)
+	if err != nil {
+		return err
+	}
+	if err := checkCreator(hdr.SignatureHeader, cf.Signer); err != nil {
+		return err
+	}
+
+	signedProp.Signature, err = cf.Signer.Sign(signedProp.ProposalBytes)
+	if err != nil {
+		return errors.WithMessage(err, "error signing proposal")
+	}
+	if err := writeProtoFile(outputFile, signedProp); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Wrote signed proposal to %s successfully\n", outputFile)
+	return nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package chaincode
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	mockmsp "github.com/hyperledger/fabric/common/mocks/msp"
+	"github.com/hyperledger/fabric/peer/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignProposalCmd(t *testing.T) {
+	defer resetFlags()
+	resetFlags()
+	dir := newTempDir()
+	defer os.RemoveAll(dir)
+	unsignedFile := filepath.Join(dir, "unsigned.proposal")
+	signedFile := filepath.Join(dir, "signed.proposal")
+	createUnsignedProposal(t, unsignedFile)
+
+	signer, err := common.GetDefaultSigner()
+	require.NoError(t, err)
+	cmd := signProposalCmd(&ChaincodeCmdFactory{Signer: signer})
+	cmd.SetArgs([]string{unsignedFile, signedFile})
+	buf := &bytes.Buffer{}
+	cmd.SetOutput(buf)
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "Wrote signed proposal to "+signedFile+" successfully\n", buf.String())
+
+	signedProp := &pb.SignedProposal{}
+	require.NoError(t, readProtoFile(signedFile, signedProp))
+	assert.NoError(t, signer.Verify(signedProp.ProposalBytes, signedProp.Signature))
+
+	// Error case: the proposal was created for another identity
+	otherSigner, err := mockmsp.NewNoopMsp().GetDefaultSigningIdentity()
+	require.NoError(t, err)
+	cmd = signProposalCmd(&ChaincodeCmdFactory{Signer: otherSigner})
+	cmd.SetArgs([]string{unsignedFile, signedFile})
+	cmd.SetOutput(&bytes.Buffer{})
+	assert.EqualError(t, cmd.Execute(), "the creator referenced in the header is not the identity of the local signer")
+
+	// Error case: missing input file
+	cmd = signProposalCmd(&ChaincodeCmdFactory{Signer: signer})
+	cmd.SetArgs([]string{filepath.Join(dir, "missing"), signedFile})
+	cmd.SetOutput(&bytes.Buffer{})
+	err = cmd.Execute()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "error reading")
+
+	// Error case: wrong number of arguments
+	cmd = signProposalCmd(&ChaincodeCmdFactory{Signer: signer})
+	cmd.SetArgs([]string{unsignedFile})
+	cmd.SetOutput(&bytes.Buffer{})
+	assert.EqualError(t, cmd.Execute(), "peer chaincode signproposal <inputproposal> <outputproposal>")
+}
@@ -0,0 +1,72 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package chaincode
+
+import (
+	"fmt"
+
+	pcommon "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// signTxCmd returns the cobra command for signing a transaction
+func signTxCmd(cf *ChaincodeCmdFactory) *cobra.Command {
+	stCmd := &cobra.Command{
+		Use:   "signtx <inputtx> <outputtx>",
+		Short: "Sign the specified transaction",
+		Long: "Sign the specified transaction, assembled with sendproposal, with the local MSP. " +
+			"No connection to the network is required, so the transaction may be signed on another machine.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("peer chaincode signtx <inputtx> <outputtx>")
+			}
+			return signTx(cmd, args[0], args[1], cf)
+		},
+	}
+
+	return stCmd
+}
+
+func signTx(cmd *cobra.Command, inputFile, outputFile string, cf *ChaincodeCmdFactory) error {
+	// Parsing of the command line is done so silence cmd usage
+	cmd.SilenceUsage = true
+
+	var err error
+	if cf == nil {
+		cf, err = InitCmdFactory(cmd.Name(), false, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	env := &pcommon.Envelope{}
+	if err := readProtoFile(inputFile, env); err != nil {
+		return err
+	}
+	payload, err := protoutil.UnmarshalPayload(env.Payload)
+	if err != nil {
+		return err
+	}
+	if payload.Header == nil {
+		return errors.New("the transaction has no header")
+	}
+	if err := checkCreator(payload.Header.SignatureHeader, cf.Signer); err != nil {
+		return err
+	}
+
+	env.Signature, err = cf.Signer.Sign(env.Payload)
+	if err != nil {
+		return errors.WithMessage(err, "error signing transaction")
+	}
+	if err := writeProtoFile(outputFile, env); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Wrote signed transaction to %s successfully\n", outputFile)
+	return nil
+}
@@ -0,0 +1,77 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package chaincode
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	mockmsp "github.com/hyperledger/fabric/common/mocks/msp"
+	"github.com/hyperledger/fabric/peer/common"
+	pcommon "github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createUnsignedTx writes an unsigned invoke transaction of the default
+// signer to the given file
+func createUnsignedTx(t *testing.T, file string) {
+	propFile := file + ".proposal"
+	createSignedProposal(t, propFile)
+	defer os.Remove(propFile)
+
+	mockResponse := &pb.ProposalResponse{
+		Response:    &pb.Response{Status: 200},
+		Endorsement: &pb.Endorsement{},
+	}
+	cmd := sendProposalCmd(&ChaincodeCmdFactory{
+		EndorserClients: []pb.EndorserClient{common.GetMockEndorserClient(mockResponse, nil)},
+	})
+	cmd.SetArgs([]string{propFile, file})
+	cmd.SetOutput(&bytes.Buffer{})
+	require.NoError(t, cmd.Execute())
+}
+
+func TestSignTxCmd(t *testing.T) {
+	defer resetFlags()
+	resetFlags()
+	dir := newTempDir()
+	defer os.RemoveAll(dir)
+	unsignedFile := filepath.Join(dir, "unsigned.tx")
+	signedFile := filepath.Join(dir, "signed.tx")
+	createUnsignedTx(t, unsignedFile)
+
+	signer, err := common.GetDefaultSigner()
+	require.NoError(t, err)
+	cmd := signTxCmd(&ChaincodeCmdFactory{Signer: signer})
+	cmd.SetArgs([]string{unsignedFile, signedFile})
+	buf := &bytes.Buffer{}
+	cmd.SetOutput(buf)
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "Wrote signed transaction to "+signedFile+" successfully\n", buf.String())
+
+	env := &pcommon.Envelope{}
+	require.NoError(t, readProtoFile(signedFile, env))
+	assert.NoError(t, signer.Verify(env.Payload, env.Signature))
+
+	// Error case: the transaction was created for another identity
+	otherSigner, err := mockmsp.NewNoopMsp().GetDefaultSigningIdentity()
+	require.NoError(t, err)
+	cmd = signTxCmd(&ChaincodeCmdFactory{Signer: otherSigner})
+	cmd.SetArgs([]string{unsignedFile, signedFile})
+	cmd.SetOutput(&bytes.Buffer{})
+	assert.EqualError(t, cmd.Execute(), "the creator referenced in the header is not the identity of the local signer")
+
+	// Error case: wrong number of arguments
+	cmd = signTxCmd(&ChaincodeCmdFactory{Signer: signer})
+	cmd.SetArgs([]string{unsignedFile})
+	cmd.SetOutput(&bytes.Buffer{})
+	assert.EqualError(t, cmd.Execute(), "peer chaincode signtx <inputtx> <outputtx>")
+}
@@ -34,12 +34,21 @@ const (
 var (
 	// join related variables.
 	genesisBlockPath string
+	snapshotPath     string
 
 	// create related variables
 	channelID     string
 	channelTxFile string
 	outputBlock   string
 	timeout       time.Duration
+
+	// fetch related variables
+	blockFormat string
+)
+
+const (
+	blockFormatProto = "proto"
+	blockFormatJSON  = "json"
 )
 
 // Cmd returns the cobra command for Node
@@ -49,10 +58,12 @@ func Cmd(cf *ChannelCmdFactory) *cobra.Command {
 	channelCmd.AddCommand(createCmd(cf))
 	channelCmd.AddCommand(fetchCmd(cf))
 	channelCmd.AddCommand(joinCmd(cf))
+	channelCmd.AddCommand(joinBySnapshotCmd(cf))
 	channelCmd.AddCommand(listCmd(cf))
 	channelCmd.AddCommand(updateCmd(cf))
 	channelCmd.AddCommand(signconfigtxCmd(cf))
 	channelCmd.AddCommand(getinfoCmd(cf))
+	channelCmd.AddCommand(fetchByTxIDCmd(cf))
 
 	return channelCmd
 }
@@ -73,10 +84,12 @@ func resetFlags() {
 	flags = &pflag.FlagSet{}
 
 	flags.StringVarP(&genesisBlockPath, "blockpath", "b", common.UndefinedParamValue, "Path to file containing genesis block")
+	flags.StringVarP(&snapshotPath, "snapshotpath", "", common.UndefinedParamValue, "Path to the directory on the peer containing a snapshot generated by 'peer node snapshot'")
 	flags.StringVarP(&channelID, "channelID", "c", common.UndefinedParamValue, "In case of a newChain command, the channel ID to create. It must be all lower case, less than 250 characters long and match the regular expression: [a-z][a-z0-9.-]*")
 	flags.StringVarP(&channelTxFile, "file", "f", "", "Configuration transaction file generated by a tool such as configtxgen for submitting to orderer")
 	flags.StringVarP(&outputBlock, "outputBlock", "", common.UndefinedParamValue, `The path to write the genesis block for the channel. (default ./<channelID>.block)`)
 	flags.DurationVarP(&timeout, "timeout", "t", 10*time.Second, "Channel creation timeout")
+	flags.StringVarP(&blockFormat, "blockFormat", "", blockFormatProto, "The format of the fetched blocks, either proto, or json to decode them")
 }
 
 func attachFlags(cmd *cobra.Command, names []string) {
@@ -92,10 +105,10 @@ func attachFlags(cmd *cobra.Command, names []string) {
 
 var channelCmd = &cobra.Command{
 	Use:   "channel",
-	Short: "Operate a channel: create|fetch|join|list|update|signconfigtx|getinfo.",
-	Long:  "Operate a channel: create|fetch|join|list|update|signconfigtx|getinfo.",
+	Short: "Operate a channel: create|fetch|join|joinbysnapshot|list|update|signconfigtx|getinfo|fetch-by-txid.",
+	Long:  "Operate a channel: create|fetch|join|joinbysnapshot|list|update|signconfigtx|getinfo|fetch-by-txid.",
 	PersistentPreRun: func(cmd *cobra.Command, args []string) {
-		common.InitCmd(cmd, args)
+		common.InitClientCmd(cmd, args)
 		common.SetOrdererEnv(cmd, args)
 	},
 }
@@ -106,6 +119,7 @@ type deliverClientIntf interface {
 	GetSpecifiedBlock(num uint64) (*cb.Block, error)
 	GetOldestBlock() (*cb.Block, error)
 	GetNewestBlock() (*cb.Block, error)
+	GetBlocksInRange(start, end uint64, f func(*cb.Block) error) error
 	Close() error
 }
 
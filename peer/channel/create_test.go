@@ -119,6 +119,19 @@ func (m *mockDeliverClient) GetNewestBlock() (*cb.Block, error) {
 	return m.readBlock()
 }
 
+func (m *mockDeliverClient) GetBlocksInRange(start, end uint64, f func(*cb.Block) error) error {
+	for num := start; num <= end; num++ {
+		block, err := m.readBlock()
+		if err != nil {
+			return err
+		}
+		if err := f(block); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (m *mockDeliverClient) Close() error {
 	return nil
 }
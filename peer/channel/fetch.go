@@ -9,10 +9,13 @@ package channel
 import (
 	"fmt"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/tools/protolator"
 	"github.com/hyperledger/fabric/peer/common"
 	cb "github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protoutil"
@@ -21,15 +24,17 @@ import (
 
 func fetchCmd(cf *ChannelCmdFactory) *cobra.Command {
 	fetchCmd := &cobra.Command{
-		Use:   "fetch <newest|oldest|config|(number)> [outputfile]",
+		Use:   "fetch <newest|oldest|config|(number)|(start):(end)> [outputfile|outputdir]",
 		Short: "Fetch a block",
-		Long:  "Fetch a specified block, writing it to a file.",
+		Long: "Fetch a specified block, writing it to a file, or a range of blocks, both inclusive, " +
+			"writing each of them to a file in a directory.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return fetch(cmd, args, cf)
 		},
 	}
 	flagList := []string{
 		"channelID",
+		"blockFormat",
 	}
 	attachFlags(fetchCmd, flagList)
 
@@ -43,6 +48,9 @@ func fetch(cmd *cobra.Command, args []string, cf *ChannelCmdFactory) error {
 	if len(args) > 2 {
 		return fmt.Errorf("trailing args detected")
 	}
+	if blockFormat != blockFormatProto && blockFormat != blockFormatJSON {
+		return fmt.Errorf("unsupported block format %s, must be one of %s or %s", blockFormat, blockFormatProto, blockFormatJSON)
+	}
 	// Parsing of the command line is done so silence cmd usage
 	cmd.SilenceUsage = true
 
@@ -62,6 +70,10 @@ func fetch(cmd *cobra.Command, args []string, cf *ChannelCmdFactory) error {
 		}
 	}
 
+	if strings.Contains(args[0], ":") {
+		return fetchRange(args, cf)
+	}
+
 	var block *cb.Block
 
 	switch args[0] {
@@ -91,21 +103,72 @@ func fetch(cmd *cobra.Command, args []string, cf *ChannelCmdFactory) error {
 		return err
 	}
 
-	b, err := proto.Marshal(block)
-	if err != nil {
-		return err
-	}
-
 	var file string
 	if len(args) == 1 {
-		file = channelID + "_" + args[0] + ".block"
+		file = channelID + "_" + args[0] + blockFileExtension()
 	} else {
 		file = args[1]
 	}
 
-	if err = ioutil.WriteFile(file, b, 0644); err != nil {
+	return writeBlock(file, block)
+}
+
+// fetchRange fetches the blocks in the range given by the first argument,
+// in the form <start>:<end>, to the directory given by the second argument
+func fetchRange(args []string, cf *ChannelCmdFactory) error {
+	bounds := strings.Split(args[0], ":")
+	if len(bounds) != 2 {
+		return fmt.Errorf("fetch target illegal: %s", args[0])
+	}
+	start, err := strconv.ParseUint(bounds[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("fetch target illegal: %s", args[0])
+	}
+	end, err := strconv.ParseUint(bounds[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("fetch target illegal: %s", args[0])
+	}
+	if start > end {
+		return fmt.Errorf("fetch target illegal: %s, the start of the range must not be greater than its end", args[0])
+	}
+
+	var dir string
+	if len(args) == 1 {
+		dir = fmt.Sprintf("%s_%d_%d", channelID, start, end)
+	} else {
+		dir = args[1]
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
 		return err
 	}
 
-	return nil
+	return cf.DeliverClient.GetBlocksInRange(start, end, func(block *cb.Block) error {
+		file := filepath.Join(dir, fmt.Sprintf("%s_%d%s", channelID, block.Header.Number, blockFileExtension()))
+		return writeBlock(file, block)
+	})
+}
+
+func blockFileExtension() string {
+	if blockFormat == blockFormatJSON {
+		return ".json"
+	}
+	return ".block"
+}
+
+// writeBlock writes the block to the file, either as a marshaled proto or,
+// with the json block format, as the canonical JSON of the block
+func writeBlock(file string, block *cb.Block) error {
+	if blockFormat == blockFormatJSON {
+		blockJSON, err := protolator.MarshalCanonicalJSON(block)
+		if err != nil {
+			return fmt.Errorf("failed decoding block [%d] to JSON: %s", block.Header.Number, err)
+		}
+		return ioutil.WriteFile(file, blockJSON, 0644)
+	}
+
+	b, err := proto.Marshal(block)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, b, 0644)
 }
@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package channel
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -89,6 +90,77 @@ func TestFetch(t *testing.T) {
 	}
 }
 
+func TestFetchRange(t *testing.T) {
+	defer resetFlags()
+	InitMSP()
+	resetFlags()
+	cleanup := configtest.SetDevFabricConfigPath(t)
+	defer cleanup()
+
+	mockchain := "mockchain"
+	mockD := &mock.DeliverService{}
+	mockD.RecvStub = func() (*ab.DeliverResponse, error) {
+		// return blocks 2 to 4, followed by the status
+		num := uint64(mockD.RecvCallCount()) + 1
+		if num > 4 {
+			return &ab.DeliverResponse{Type: &ab.DeliverResponse_Status{Status: cb.Status_SUCCESS}}, nil
+		}
+		block := createTestBlock()
+		block.Header.Number = num
+		return &ab.DeliverResponse{Type: &ab.DeliverResponse_Block{Block: block}}, nil
+	}
+	mockCF := &ChannelCmdFactory{
+		BroadcastFactory: mockBroadcastClientFactory,
+		DeliverClient: &common.DeliverClient{
+			Service:   mockD,
+			ChannelID: mockchain,
+		},
+	}
+
+	tempDir, err := ioutil.TempDir("", "fetch-output")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory")
+	}
+	defer os.RemoveAll(tempDir)
+
+	cmd := fetchCmd(mockCF)
+	AddFlags(cmd)
+
+	outputDir := filepath.Join(tempDir, "blocks")
+	cmd.SetArgs([]string{"-c", mockchain, "--blockFormat", "json", "2:4", outputDir})
+	assert.NoError(t, cmd.Execute(), "fetch command expected to succeed")
+
+	seekInfo := &ab.SeekInfo{}
+	_, err = protoutil.UnmarshalEnvelopeOfType(mockD.SendArgsForCall(0), cb.HeaderType_DELIVER_SEEK_INFO, seekInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), seekInfo.Start.GetSpecified().Number)
+	assert.Equal(t, uint64(4), seekInfo.Stop.GetSpecified().Number)
+
+	files, err := ioutil.ReadDir(outputDir)
+	assert.NoError(t, err)
+	assert.Len(t, files, 3)
+	for i, file := range files {
+		assert.Equal(t, fmt.Sprintf("%s_%d.json", mockchain, i+2), file.Name())
+	}
+	blockJSON, err := ioutil.ReadFile(filepath.Join(outputDir, mockchain+"_3.json"))
+	assert.NoError(t, err)
+	decoded := map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal(blockJSON, &decoded))
+	assert.Equal(t, "3", decoded["header"].(map[string]interface{})["number"])
+
+	// failure cases
+	for _, target := range []string{"2:", ":4", "4:2", "1:2:3", "a:b"} {
+		cmd.SetArgs([]string{"-c", mockchain, target, outputDir})
+		err = cmd.Execute()
+		assert.Error(t, err, "fetch command expected to fail")
+		assert.Contains(t, err.Error(), fmt.Sprintf("fetch target illegal: %s", target))
+	}
+
+	cmd.SetArgs([]string{"-c", mockchain, "--blockFormat", "xml", "2:4", outputDir})
+	err = cmd.Execute()
+	assert.EqualError(t, err, "unsupported block format xml, must be one of proto or json")
+}
+
 func TestFetchArgs(t *testing.T) {
 	// failure - no args
 	cmd := fetchCmd(nil)
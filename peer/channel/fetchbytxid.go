@@ -0,0 +1,164 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package channel
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/tools/protolator"
+	"github.com/hyperledger/fabric/core/scc/qscc"
+	"github.com/hyperledger/fabric/peer/common"
+	cb "github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func fetchByTxIDCmd(cf *ChannelCmdFactory) *cobra.Command {
+	fetchByTxIDCmd := &cobra.Command{
+		Use:   "fetch-by-txid <txid>",
+		Short: "Fetch the transaction with the given ID and print it as JSON.",
+		Long: "Fetch the block containing the transaction with the given ID from the peer, check the position " +
+			"of the block in the hash chain, and print the transaction decoded to JSON. Requires '-c'.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("fetch-by-txid requires exactly one argument, the transaction ID")
+			}
+			return fetchByTxID(cmd, args[0], cf)
+		},
+	}
+	flagList := []string{
+		"channelID",
+	}
+	attachFlags(fetchByTxIDCmd, flagList)
+
+	return fetchByTxIDCmd
+}
+
+// txInfo is the output of the fetch-by-txid command
+type txInfo struct {
+	TxID           string          `json:"tx_id"`
+	BlockNumber    uint64          `json:"block_number"`
+	TxIndex        int             `json:"tx_index"`
+	ValidationCode string          `json:"validation_code"`
+	Transaction    json.RawMessage `json:"transaction"`
+}
+
+func fetchByTxID(cmd *cobra.Command, txID string, cf *ChannelCmdFactory) error {
+	//the global chainID filled by the "-c" command
+	if channelID == common.UndefinedParamValue {
+		return errors.New("Must supply channel ID")
+	}
+	// Parsing of the command line is done so silence cmd usage
+	cmd.SilenceUsage = true
+
+	var err error
+	if cf == nil {
+		cf, err = InitCmdFactory(EndorserRequired, PeerDeliverNotRequired, OrdererNotRequired)
+		if err != nil {
+			return err
+		}
+	}
+	client := &endorserClient{cf}
+
+	block, err := client.getBlock(qscc.GetBlockByTxID, txID)
+	if err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("failed fetching the block of transaction %s", txID))
+	}
+	txIndex, env, err := findTransaction(block, txID)
+	if err != nil {
+		return err
+	}
+	if err := verifyBlockPosition(client, block); err != nil {
+		return err
+	}
+
+	txJSON, err := protolator.MarshalCanonicalJSON(env)
+	if err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("failed decoding transaction %s to JSON", txID))
+	}
+	return common.PrintJSON(cmd.OutOrStdout(), txInfo{
+		TxID:           txID,
+		BlockNumber:    block.Header.Number,
+		TxIndex:        txIndex,
+		ValidationCode: validationCode(block, txIndex).String(),
+		Transaction:    json.RawMessage(txJSON),
+	})
+}
+
+// getBlock retrieves a block from the peer with the given qscc function,
+// which is either GetBlockByTxID or GetBlockByNumber
+func (cc *endorserClient) getBlock(function, arg string) (*cb.Block, error) {
+	payload, err := cc.queryQSCC(function, channelID, arg)
+	if err != nil {
+		return nil, err
+	}
+	block := &cb.Block{}
+	if err := proto.Unmarshal(payload, block); err != nil {
+		return nil, errors.Wrap(err, "cannot read qscc response")
+	}
+	if block.Header == nil || block.Data == nil {
+		return nil, errors.New("received a block without header or data")
+	}
+	return block, nil
+}
+
+// findTransaction returns the index and the envelope of the transaction with
+// the given ID in the block
+func findTransaction(block *cb.Block, txID string) (int, *cb.Envelope, error) {
+	for i, envBytes := range block.Data.Data {
+		env, err := protoutil.GetEnvelopeFromBlock(envBytes)
+		if err != nil {
+			return 0, nil, errors.WithMessage(err, fmt.Sprintf("failed reading transaction %d of block [%d]", i, block.Header.Number))
+		}
+		chdr, err := protoutil.ChannelHeader(env)
+		if err != nil {
+			return 0, nil, errors.WithMessage(err, fmt.Sprintf("failed reading transaction %d of block [%d]", i, block.Header.Number))
+		}
+		if chdr.TxId == txID {
+			return i, env, nil
+		}
+	}
+	return 0, nil, errors.Errorf("transaction %s is not found in block [%d]", txID, block.Header.Number)
+}
+
+// verifyBlockPosition checks that the data of the block matches its header,
+// and that the block is chained to the previous block
+func verifyBlockPosition(client *endorserClient, block *cb.Block) error {
+	if !bytes.Equal(protoutil.BlockDataHash(block.Data), block.Header.DataHash) {
+		return errors.Errorf("the data hash of block [%d] does not match its data", block.Header.Number)
+	}
+	if block.Header.Number == 0 {
+		return nil
+	}
+	prevBlock, err := client.getBlock(qscc.GetBlockByNumber, strconv.FormatUint(block.Header.Number-1, 10))
+	if err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("failed fetching block [%d]", block.Header.Number-1))
+	}
+	if !bytes.Equal(protoutil.BlockHeaderHash(prevBlock.Header), block.Header.PreviousHash) {
+		return errors.Errorf("the previous hash of block [%d] does not match the hash of block [%d]", block.Header.Number, prevBlock.Header.Number)
+	}
+	return nil
+}
+
+// validationCode returns the validation code of the transaction at the given
+// index of the block
+func validationCode(block *cb.Block, txIndex int) pb.TxValidationCode {
+	if block.Metadata == nil || len(block.Metadata.Metadata) <= int(cb.BlockMetadataIndex_TRANSACTIONS_FILTER) {
+		return pb.TxValidationCode_NOT_VALIDATED
+	}
+	filter := block.Metadata.Metadata[cb.BlockMetadataIndex_TRANSACTIONS_FILTER]
+	if txIndex >= len(filter) {
+		return pb.TxValidationCode_NOT_VALIDATED
+	}
+	return pb.TxValidationCode(filter[txIndex])
+}
@@ -0,0 +1,175 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package channel
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/hyperledger/fabric/core/scc/qscc"
+	"github.com/hyperledger/fabric/peer/common"
+	cb "github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// qsccEndorserClient answers the qscc block queries from a set of blocks
+type qsccEndorserClient struct {
+	blocks []*cb.Block
+	txIDs  map[string]uint64
+}
+
+func (q *qsccEndorserClient) ProcessProposal(ctx context.Context, in *pb.SignedProposal, opts ...grpc.CallOption) (*pb.ProposalResponse, error) {
+	prop, err := protoutil.GetProposal(in.ProposalBytes)
+	if err != nil {
+		return nil, err
+	}
+	cis, err := protoutil.GetChaincodeInvocationSpec(prop)
+	if err != nil {
+		return nil, err
+	}
+	args := cis.ChaincodeSpec.Input.Args
+	var block *cb.Block
+	switch string(args[0]) {
+	case qscc.GetBlockByTxID:
+		if num, ok := q.txIDs[string(args[2])]; ok {
+			block = q.blocks[num]
+		}
+	case qscc.GetBlockByNumber:
+		num, _ := strconv.ParseUint(string(args[2]), 10, 64)
+		if num < uint64(len(q.blocks)) {
+			block = q.blocks[num]
+		}
+	}
+	if block == nil {
+		return &pb.ProposalResponse{Response: &pb.Response{Status: 500, Message: "not found"}}, nil
+	}
+	return &pb.ProposalResponse{Response: &pb.Response{Status: 200, Payload: protoutil.MarshalOrPanic(block)}}, nil
+}
+
+func (q *qsccEndorserClient) ProcessProposals(ctx context.Context, in *pb.SignedProposals, opts ...grpc.CallOption) (*pb.ProposalResponses, error) {
+	return nil, errors.New("not implemented")
+}
+
+func newTxBlock(num uint64, prevHash []byte, txIDs ...string) *cb.Block {
+	block := protoutil.NewBlock(num, prevHash)
+	for _, txID := range txIDs {
+		env := &cb.Envelope{
+			Payload: protoutil.MarshalOrPanic(&cb.Payload{
+				Header: &cb.Header{
+					ChannelHeader: protoutil.MarshalOrPanic(&cb.ChannelHeader{
+						Type:      int32(cb.HeaderType_ENDORSER_TRANSACTION),
+						ChannelId: mockChannel,
+						TxId:      txID,
+					}),
+					SignatureHeader: protoutil.MarshalOrPanic(&cb.SignatureHeader{}),
+				},
+			}),
+		}
+		block.Data.Data = append(block.Data.Data, protoutil.MarshalOrPanic(env))
+	}
+	block.Header.DataHash = protoutil.BlockDataHash(block.Data)
+	block.Metadata.Metadata[cb.BlockMetadataIndex_TRANSACTIONS_FILTER] = make([]byte, len(txIDs))
+	return block
+}
+
+func TestFetchByTxID(t *testing.T) {
+	InitMSP()
+	resetFlags()
+
+	block0 := newTxBlock(0, nil, "tx0")
+	block1 := newTxBlock(1, protoutil.BlockHeaderHash(block0.Header), "tx1", "tx2")
+	block1.Metadata.Metadata[cb.BlockMetadataIndex_TRANSACTIONS_FILTER][1] = byte(pb.TxValidationCode_MVCC_READ_CONFLICT)
+	endorser := &qsccEndorserClient{
+		blocks: []*cb.Block{block0, block1},
+		txIDs:  map[string]uint64{"tx0": 0, "tx1": 1, "tx2": 1},
+	}
+
+	signer, err := common.GetDefaultSigner()
+	require.NoError(t, err)
+	mockCF := &ChannelCmdFactory{
+		EndorserClient: endorser,
+		Signer:         signer,
+	}
+
+	run := func(args ...string) (string, error) {
+		cmd := fetchByTxIDCmd(mockCF)
+		AddFlags(cmd)
+		buffer := &bytes.Buffer{}
+		cmd.SetOutput(buffer)
+		cmd.SetArgs(args)
+		err := cmd.Execute()
+		return buffer.String(), err
+	}
+
+	out, err := run("-c", mockChannel, "tx2")
+	require.NoError(t, err)
+	info := &struct {
+		TxID           string `json:"tx_id"`
+		BlockNumber    uint64 `json:"block_number"`
+		TxIndex        int    `json:"tx_index"`
+		ValidationCode string `json:"validation_code"`
+		Transaction    struct {
+			Payload struct {
+				Header struct {
+					ChannelHeader struct {
+						TxID string `json:"tx_id"`
+					} `json:"channel_header"`
+				} `json:"header"`
+			} `json:"payload"`
+		} `json:"transaction"`
+	}{}
+	require.NoError(t, json.Unmarshal([]byte(out), info))
+	assert.Equal(t, "tx2", info.TxID)
+	assert.Equal(t, uint64(1), info.BlockNumber)
+	assert.Equal(t, 1, info.TxIndex)
+	assert.Equal(t, "MVCC_READ_CONFLICT", info.ValidationCode)
+	assert.Equal(t, "tx2", info.Transaction.Payload.Header.ChannelHeader.TxID)
+
+	_, err = run("-c", mockChannel, "tx0")
+	assert.NoError(t, err)
+
+	// Error case: unknown transaction
+	_, err = run("-c", mockChannel, "tx3")
+	assert.EqualError(t, err, "failed fetching the block of transaction tx3: received bad response, status 500: not found")
+
+	// Error case: the block is not chained to the previous block
+	block1.Header.PreviousHash = []byte("bad hash")
+	_, err = run("-c", mockChannel, "tx1")
+	assert.EqualError(t, err, "the previous hash of block [1] does not match the hash of block [0]")
+
+	// Error case: the data of the block does not match its header
+	block1.Data.Data = block1.Data.Data[:1]
+	_, err = run("-c", mockChannel, "tx1")
+	assert.EqualError(t, err, "the data hash of block [1] does not match its data")
+
+	// Error case: the transaction is not in the returned block
+	endorser.txIDs["tx4"] = 0
+	_, err = run("-c", mockChannel, "tx4")
+	assert.EqualError(t, err, "transaction tx4 is not found in block [0]")
+
+	// Error case: missing arguments
+	_, err = run("-c", mockChannel)
+	assert.EqualError(t, err, "fetch-by-txid requires exactly one argument, the transaction ID")
+	resetFlags()
+	_, err = run("tx0")
+	assert.EqualError(t, err, "Must supply channel ID")
+}
+
+func TestValidationCode(t *testing.T) {
+	block := protoutil.NewBlock(0, nil)
+	assert.Equal(t, pb.TxValidationCode_NOT_VALIDATED, validationCode(block, 0))
+	block.Metadata = nil
+	assert.Equal(t, pb.TxValidationCode_NOT_VALIDATED, validationCode(block, 0))
+}
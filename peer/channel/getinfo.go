@@ -38,13 +38,35 @@ func getinfoCmd(cf *ChannelCmdFactory) *cobra.Command {
 	return getinfoCmd
 }
 func (cc *endorserClient) getBlockChainInfo() (*cb.BlockchainInfo, error) {
+	payload, err := cc.queryQSCC(qscc.GetChainInfo, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	blockChainInfo := &cb.BlockchainInfo{}
+	err = proto.Unmarshal(payload, blockChainInfo)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read qscc response")
+	}
+
+	return blockChainInfo, nil
+
+}
+
+// queryQSCC invokes the given function of qscc with the given arguments on
+// the peer, and returns the payload of the response
+func (cc *endorserClient) queryQSCC(function string, args ...string) ([]byte, error) {
 	var err error
 
+	input := &pb.ChaincodeInput{Args: [][]byte{[]byte(function)}}
+	for _, arg := range args {
+		input.Args = append(input.Args, []byte(arg))
+	}
 	invocation := &pb.ChaincodeInvocationSpec{
 		ChaincodeSpec: &pb.ChaincodeSpec{
 			Type:        pb.ChaincodeSpec_Type(pb.ChaincodeSpec_Type_value["GOLANG"]),
 			ChaincodeId: &pb.ChaincodeID{Name: "qscc"},
-			Input:       &pb.ChaincodeInput{Args: [][]byte{[]byte(qscc.GetChainInfo), []byte(channelID)}},
+			Input:       input,
 		},
 	}
 
@@ -70,14 +92,7 @@ func (cc *endorserClient) getBlockChainInfo() (*cb.BlockchainInfo, error) {
 		return nil, errors.Errorf("received bad response, status %d: %s", proposalResp.Response.Status, proposalResp.Response.Message)
 	}
 
-	blockChainInfo := &cb.BlockchainInfo{}
-	err = proto.Unmarshal(proposalResp.Response.Payload, blockChainInfo)
-	if err != nil {
-		return nil, errors.Wrap(err, "cannot read qscc response")
-	}
-
-	return blockChainInfo, nil
-
+	return proposalResp.Response.Payload, nil
 }
 
 func getinfo(cmd *cobra.Command, cf *ChannelCmdFactory) error {
@@ -107,6 +122,10 @@ func getinfo(cmd *cobra.Command, cf *ChannelCmdFactory) error {
 		return err
 	}
 
+	if common.OutputJSON() {
+		fmt.Fprintln(cmd.OutOrStdout(), string(jsonBytes))
+		return nil
+	}
 	fmt.Printf("Blockchain info: %s\n", string(jsonBytes))
 
 	return nil
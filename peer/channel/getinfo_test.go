@@ -7,6 +7,8 @@ SPDX-License-Identifier: Apache-2.0
 package channel
 
 import (
+	"bytes"
+	"encoding/json"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
@@ -52,6 +54,19 @@ func TestGetChannelInfo(t *testing.T) {
 	cmd.SetArgs(args)
 
 	assert.NoError(t, cmd.Execute())
+
+	// With the JSON output format, only the blockchain info is written
+	cmd = getinfoCmd(mockCF)
+	AddFlags(cmd)
+	common.AddOutputFlag(cmd.Flags())
+	defer cmd.Flags().Set("output", common.OutputFormatText)
+	buffer := &bytes.Buffer{}
+	cmd.SetOutput(buffer)
+	cmd.SetArgs([]string{"-c", mockChannel, "--output", "json"})
+	assert.NoError(t, cmd.Execute())
+	blockchainInfo := &cb.BlockchainInfo{}
+	assert.NoError(t, json.Unmarshal(buffer.Bytes(), blockchainInfo))
+	assert.True(t, proto.Equal(mockBlockchainInfo, blockchainInfo))
 }
 
 func TestGetChannelInfoMissingChannelID(t *testing.T) {
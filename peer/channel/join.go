@@ -80,7 +80,11 @@ func executeJoin(cf *ChannelCmdFactory) (err error) {
 	if err != nil {
 		return err
 	}
+	return submitJoinProposal(cf, spec)
+}
 
+// submitJoinProposal sends the proposal invoking the given cscc spec to the peer
+func submitJoinProposal(cf *ChannelCmdFactory, spec *pb.ChaincodeSpec) (err error) {
 	// Build the ChaincodeInvocationSpec message
 	invocation := &pb.ChaincodeInvocationSpec{ChaincodeSpec: spec}
 
@@ -0,0 +1,62 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package channel
+
+import (
+	"errors"
+
+	"github.com/hyperledger/fabric/core/scc/cscc"
+	"github.com/hyperledger/fabric/peer/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/spf13/cobra"
+)
+
+const joinBySnapshotDescription = "Joins the peer to a channel from a snapshot, instead of replaying the blocks from the genesis block."
+
+func joinBySnapshotCmd(cf *ChannelCmdFactory) *cobra.Command {
+	joinBySnapshotCmd := &cobra.Command{
+		Use:   "joinbysnapshot",
+		Short: joinBySnapshotDescription,
+		Long:  joinBySnapshotDescription + " The snapshot is read from the file system of the peer. Requires '--snapshotpath'.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return joinBySnapshot(cmd, args, cf)
+		},
+	}
+	flagList := []string{
+		"snapshotpath",
+	}
+	attachFlags(joinBySnapshotCmd, flagList)
+
+	return joinBySnapshotCmd
+}
+
+func getJoinBySnapshotCCSpec() *pb.ChaincodeSpec {
+	input := &pb.ChaincodeInput{Args: [][]byte{[]byte(cscc.JoinChainBySnapshot), []byte(snapshotPath)}}
+
+	return &pb.ChaincodeSpec{
+		Type:        pb.ChaincodeSpec_Type(pb.ChaincodeSpec_Type_value["GOLANG"]),
+		ChaincodeId: &pb.ChaincodeID{Name: "cscc"},
+		Input:       input,
+	}
+}
+
+func joinBySnapshot(cmd *cobra.Command, args []string, cf *ChannelCmdFactory) error {
+	if snapshotPath == common.UndefinedParamValue {
+		return errors.New("Must supply snapshot path")
+	}
+	// Parsing of the command line is done so silence cmd usage
+	cmd.SilenceUsage = true
+
+	var err error
+	if cf == nil {
+		cf, err = InitCmdFactory(EndorserRequired, PeerDeliverNotRequired, OrdererNotRequired)
+		if err != nil {
+			return err
+		}
+	}
+	return submitJoinProposal(cf, getJoinBySnapshotCCSpec())
+}
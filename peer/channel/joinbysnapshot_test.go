@@ -0,0 +1,104 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package channel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/peer/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type capturingEndorserClient struct {
+	pb.EndorserClient
+	signedProp *pb.SignedProposal
+}
+
+func (c *capturingEndorserClient) ProcessProposal(ctx context.Context, in *pb.SignedProposal, opts ...grpc.CallOption) (*pb.ProposalResponse, error) {
+	c.signedProp = in
+	return c.EndorserClient.ProcessProposal(ctx, in, opts...)
+}
+
+func TestMissingSnapshotPath(t *testing.T) {
+	defer resetFlags()
+
+	resetFlags()
+
+	cmd := joinBySnapshotCmd(nil)
+	AddFlags(cmd)
+	cmd.SetArgs([]string{})
+
+	assert.EqualError(t, cmd.Execute(), "Must supply snapshot path")
+}
+
+func TestJoinBySnapshot(t *testing.T) {
+	defer resetFlags()
+
+	InitMSP()
+	resetFlags()
+
+	signer, err := common.GetDefaultSigner()
+	require.NoError(t, err)
+
+	mockEndorserClient := &capturingEndorserClient{
+		EndorserClient: common.GetMockEndorserClient(&pb.ProposalResponse{
+			Response:    &pb.Response{Status: 200},
+			Endorsement: &pb.Endorsement{},
+		}, nil),
+	}
+	mockCF := &ChannelCmdFactory{
+		EndorserClient:   mockEndorserClient,
+		BroadcastFactory: mockBroadcastClientFactory,
+		Signer:           signer,
+	}
+
+	cmd := joinBySnapshotCmd(mockCF)
+	AddFlags(cmd)
+	cmd.SetArgs([]string{"--snapshotpath", "/path/to/snapshot"})
+	assert.NoError(t, cmd.Execute())
+
+	// the snapshot path is passed to cscc, which reads the snapshot on the peer
+	prop, err := protoutil.GetProposal(mockEndorserClient.signedProp.ProposalBytes)
+	require.NoError(t, err)
+	cis, err := protoutil.GetChaincodeInvocationSpec(prop)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(&pb.ChaincodeInput{
+		Args: [][]byte{[]byte("JoinChainBySnapshot"), []byte("/path/to/snapshot")},
+	}, cis.ChaincodeSpec.Input))
+}
+
+func TestJoinBySnapshotBadProposalResponse(t *testing.T) {
+	defer resetFlags()
+
+	InitMSP()
+	resetFlags()
+
+	signer, err := common.GetDefaultSigner()
+	require.NoError(t, err)
+
+	mockResponse := &pb.ProposalResponse{
+		Response:    &pb.Response{Status: 500, Message: "cannot create ledger from snapshot"},
+		Endorsement: &pb.Endorsement{},
+	}
+	mockCF := &ChannelCmdFactory{
+		EndorserClient:   common.GetMockEndorserClient(mockResponse, nil),
+		BroadcastFactory: mockBroadcastClientFactory,
+		Signer:           signer,
+	}
+
+	cmd := joinBySnapshotCmd(mockCF)
+	AddFlags(cmd)
+	cmd.SetArgs([]string{"--snapshotpath", "/path/to/snapshot"})
+	err = cmd.Execute()
+	assert.Equal(t, ProposalFailedErr("bad proposal response 500: cannot create ledger from snapshot"), err)
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/core/scc/cscc"
+	"github.com/hyperledger/fabric/peer/common"
 	common2 "github.com/hyperledger/fabric/protos/common"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/hyperledger/fabric/protoutil"
@@ -35,7 +36,7 @@ func listCmd(cf *ChannelCmdFactory) *cobra.Command {
 			}
 			// Parsing of the command line is done so silence cmd usage
 			cmd.SilenceUsage = true
-			return list(cf)
+			return list(cmd, cf)
 		},
 	}
 }
@@ -82,7 +83,12 @@ func (cc *endorserClient) getChannels() ([]*pb.ChannelInfo, error) {
 	return channelQueryResponse.Channels, nil
 }
 
-func list(cf *ChannelCmdFactory) error {
+// channelList is the JSON output of the list command
+type channelList struct {
+	Channels []string `json:"channels"`
+}
+
+func list(cmd *cobra.Command, cf *ChannelCmdFactory) error {
 	var err error
 	if cf == nil {
 		cf, err = InitCmdFactory(EndorserRequired, PeerDeliverNotRequired, OrdererNotRequired)
@@ -95,6 +101,12 @@ func list(cf *ChannelCmdFactory) error {
 
 	if channels, err := client.getChannels(); err != nil {
 		return err
+	} else if common.OutputJSON() {
+		output := channelList{Channels: []string{}}
+		for _, channel := range channels {
+			output.Channels = append(output.Channels, channel.ChannelId)
+		}
+		return common.PrintJSON(cmd.OutOrStdout(), output)
 	} else {
 		fmt.Println("Channels peers has joined: ")
 
@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package channel
 
 import (
+	"bytes"
 	"errors"
 	"testing"
 
@@ -56,6 +57,45 @@ func TestListChannels(t *testing.T) {
 	testListChannelsEmptyCF(t, mockCF)
 }
 
+func TestListChannelsJSON(t *testing.T) {
+	InitMSP()
+
+	mockPayload, err := proto.Marshal(&pb.ChannelQueryResponse{
+		Channels: []*pb.ChannelInfo{{ChannelId: "channel1"}, {ChannelId: "channel2"}},
+	})
+	assert.NoError(t, err)
+	mockResponse := &pb.ProposalResponse{
+		Response:    &pb.Response{Status: 200, Payload: mockPayload},
+		Endorsement: &pb.Endorsement{},
+	}
+	signer, err := common.GetDefaultSigner()
+	assert.NoError(t, err)
+	mockCF := &ChannelCmdFactory{
+		EndorserClient:   common.GetMockEndorserClient(mockResponse, nil),
+		BroadcastFactory: mockBroadcastClientFactory,
+		Signer:           signer,
+	}
+
+	cmd := listCmd(mockCF)
+	AddFlags(cmd)
+	common.AddOutputFlag(cmd.Flags())
+	defer cmd.Flags().Set("output", common.OutputFormatText)
+	buffer := &bytes.Buffer{}
+	cmd.SetOutput(buffer)
+	cmd.SetArgs([]string{"--output", "json"})
+	assert.NoError(t, cmd.Execute())
+	assert.Equal(t, `{"channels":["channel1","channel2"]}`+"\n", buffer.String())
+
+	cmd = listCmd(mockCF)
+	AddFlags(cmd)
+	common.AddOutputFlag(cmd.Flags())
+	cmd.SetOutput(&bytes.Buffer{})
+	cmd.SetArgs([]string{"--output", "yaml"})
+	err = cmd.Execute()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported output format yaml, must be one of text or json")
+}
+
 func testListChannelsEmptyCF(t *testing.T, mockCF *ChannelCmdFactory) {
 	cmd := listCmd(nil)
 	AddFlags(cmd)
@@ -9,6 +9,7 @@ package clilogging
 import (
 	"context"
 
+	"github.com/hyperledger/fabric/peer/common"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/spf13/cobra"
 )
@@ -50,6 +51,15 @@ func getLevel(cf *LoggingCmdFactory, cmd *cobra.Command, args []string) (err err
 			return err
 		}
 		logger.Infof("Current log level for logger '%s': %s", logResponse.LogModule, logResponse.LogLevel)
+		if common.OutputJSON() {
+			return common.PrintJSON(cmd.OutOrStdout(), logLevel{Logger: logResponse.LogModule, Level: logResponse.LogLevel})
+		}
 	}
 	return err
 }
+
+// logLevel is the JSON output of the getlevel command
+type logLevel struct {
+	Logger string `json:"logger"`
+	Level  string `json:"level"`
+}
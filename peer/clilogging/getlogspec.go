@@ -9,6 +9,7 @@ package clilogging
 import (
 	"context"
 
+	"github.com/hyperledger/fabric/peer/common"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/spf13/cobra"
 )
@@ -44,6 +45,14 @@ func getLogSpec(cf *LoggingCmdFactory, cmd *cobra.Command, args []string) (err e
 			return err
 		}
 		logger.Infof("Current logging spec: %s", logResponse.LogSpec)
+		if common.OutputJSON() {
+			return common.PrintJSON(cmd.OutOrStdout(), logSpec{Spec: logResponse.LogSpec})
+		}
 	}
 	return err
 }
+
+// logSpec is the JSON output of the getlogspec command
+type logSpec struct {
+	Spec string `json:"spec"`
+}
@@ -36,5 +36,5 @@ var loggingCmd = &cobra.Command{
 	Use:              loggingFuncName,
 	Short:            fmt.Sprint(loggingCmdDes),
 	Long:             fmt.Sprint(loggingCmdDes),
-	PersistentPreRun: common.InitCmd,
+	PersistentPreRun: common.InitClientCmd,
 }
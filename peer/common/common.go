@@ -271,13 +271,40 @@ func configFromEnv(prefix string) (address, override string, clientConfig comm.C
 	return
 }
 
+// SkipLocalMSPAnnotation is the annotation of the commands that do not sign
+// with the local MSP. InitCmd does not load the local MSP for them, so that
+// they can run on a machine that does not hold the signing key of the MSP.
+const SkipLocalMSPAnnotation = "skipLocalMSP"
+
+// InitCmd initializes the config, the logging and the local MSP of the peer
+// commands. It does not apply the profile in use, as the settings of the
+// profiles are meant for the client commands and not for the peer node.
 func InitCmd(cmd *cobra.Command, args []string) {
+	initCmd(cmd, false)
+}
+
+// InitClientCmd initializes the client commands of the peer CLI as InitCmd
+// does, after applying the connection settings of the profile in use, if any
+func InitClientCmd(cmd *cobra.Command, args []string) {
+	initCmd(cmd, true)
+}
+
+func initCmd(cmd *cobra.Command, useProfile bool) {
 	err := InitConfig(CmdRoot)
 	if err != nil { // Handle errors reading the config file
 		mainLogger.Errorf("Fatal error when initializing %s config : %s", CmdRoot, err)
 		os.Exit(1)
 	}
 
+	activeProfile = nil
+	if useProfile {
+		err = applyProfile()
+		if err != nil {
+			mainLogger.Errorf("Fatal error when applying the peer profile : %s", err)
+			os.Exit(1)
+		}
+	}
+
 	// read in the legacy logging level settings and, if set,
 	// notify users of the FABRIC_LOGGING_SPEC env variable
 	var loggingLevel string
@@ -299,17 +326,19 @@ func InitCmd(cmd *cobra.Command, args []string) {
 		LogSpec: loggingSpec,
 	})
 
-	// Init the MSP
-	var mspMgrConfigDir = config.GetPath("peer.mspConfigPath")
-	var mspID = viper.GetString("peer.localMspId")
-	var mspType = viper.GetString("peer.localMspType")
-	if mspType == "" {
-		mspType = msp.ProviderTypeToString(msp.FABRIC)
-	}
-	err = InitCrypto(mspMgrConfigDir, mspID, mspType)
-	if err != nil { // Handle errors reading the config file
-		mainLogger.Errorf("Cannot run peer because %s", err.Error())
-		os.Exit(1)
+	// Init the MSP, unless the command does not sign with it
+	if cmd == nil || cmd.Annotations[SkipLocalMSPAnnotation] == "" {
+		var mspMgrConfigDir = config.GetPath("peer.mspConfigPath")
+		var mspID = viper.GetString("peer.localMspId")
+		var mspType = viper.GetString("peer.localMspType")
+		if mspType == "" {
+			mspType = msp.ProviderTypeToString(msp.FABRIC)
+		}
+		err = InitCrypto(mspMgrConfigDir, mspID, mspType)
+		if err != nil { // Handle errors reading the config file
+			mainLogger.Errorf("Cannot run peer because %s", err.Error())
+			os.Exit(1)
+		}
 	}
 
 	runtime.GOMAXPROCS(viper.GetInt("peer.gomaxprocs"))
@@ -8,6 +8,7 @@ package common_test
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
@@ -19,6 +20,7 @@ import (
 	"github.com/hyperledger/fabric/peer/common"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestInitConfig(t *testing.T) {
@@ -198,3 +200,30 @@ func TestInitCmd(t *testing.T) {
 	assert.Equal(t, "error", flogging.Global.Level("abc").String())
 	os.Setenv("FABRIC_LOGGING_SPEC", origEnvValue)
 }
+
+func TestInitCmdProfile(t *testing.T) {
+	cleanup := configtest.SetDevFabricConfigPath(t)
+	defer cleanup()
+	defer viper.Reset()
+	defer os.Setenv(common.ProfilesPathEnv, os.Getenv(common.ProfilesPathEnv))
+
+	dir, err := ioutil.TempDir("", "profiles")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "peer_profiles.yaml")
+	os.Setenv(common.ProfilesPathEnv, path)
+	profiles := &common.Profiles{
+		Current: "org1",
+		Profiles: map[string]*common.Profile{
+			"org1": {PeerAddress: "peer0.org1.example.com:7051"},
+		},
+	}
+	require.NoError(t, profiles.Save(path))
+
+	// The peer node does not use the profiles of the client commands
+	common.InitCmd(nil, nil)
+	assert.Equal(t, "0.0.0.0:7051", viper.GetString("peer.address"))
+
+	common.InitClientCmd(nil, nil)
+	assert.Equal(t, "peer0.org1.example.com:7051", viper.GetString("peer.address"))
+}
@@ -41,15 +41,23 @@ type DeliverClient struct {
 	TLSCertHash []byte
 }
 
-func (d *DeliverClient) seekSpecified(blockNumber uint64) error {
-	seekPosition := &ab.SeekPosition{
+func specifiedPosition(blockNumber uint64) *ab.SeekPosition {
+	return &ab.SeekPosition{
 		Type: &ab.SeekPosition_Specified{
 			Specified: &ab.SeekSpecified{
 				Number: blockNumber,
 			},
 		},
 	}
-	env := seekHelper(d.ChannelID, seekPosition, d.TLSCertHash)
+}
+
+func (d *DeliverClient) seekSpecified(blockNumber uint64) error {
+	env := seekHelper(d.ChannelID, specifiedPosition(blockNumber), d.TLSCertHash)
+	return d.Service.Send(env)
+}
+
+func (d *DeliverClient) seekRange(start, end uint64) error {
+	env := seekRangeHelper(d.ChannelID, specifiedPosition(start), specifiedPosition(end), d.TLSCertHash)
 	return d.Service.Send(env)
 }
 
@@ -92,6 +100,46 @@ func (d *DeliverClient) GetSpecifiedBlock(num uint64) (*cb.Block, error) {
 	return d.readBlock()
 }
 
+// GetBlocksInRange gets the blocks from start to end, both inclusive, from a
+// peer/orderer's deliver service, and passes each of them in order to the
+// given function. The blocks are received over a single seek request.
+func (d *DeliverClient) GetBlocksInRange(start, end uint64, f func(*cb.Block) error) error {
+	if start > end {
+		return errors.Errorf("invalid block range: start [%d] is greater than end [%d]", start, end)
+	}
+	err := d.seekRange(start, end)
+	if err != nil {
+		return errors.WithMessage(err, "error getting blocks in range")
+	}
+
+	next := start
+	for {
+		msg, err := d.Service.Recv()
+		if err != nil {
+			return errors.Wrap(err, "error receiving")
+		}
+		switch t := msg.Type.(type) {
+		case *ab.DeliverResponse_Status:
+			logger.Infof("Got status: %v", t)
+			if t.Status != cb.Status_SUCCESS || next <= end {
+				return errors.Errorf("can't read the block: %v", t)
+			}
+			return nil
+		case *ab.DeliverResponse_Block:
+			if t.Block.Header.Number != next {
+				return errors.Errorf("received block [%d] out of order, expected block [%d]", t.Block.Header.Number, next)
+			}
+			logger.Infof("Received block: %v", t.Block.Header.Number)
+			if err := f(t.Block); err != nil {
+				return err
+			}
+			next++
+		default:
+			return errors.Errorf("response error: unknown type %T", t)
+		}
+	}
+}
+
 // GetOldestBlock gets the oldest block from a peer/orderer's deliver service
 func (d *DeliverClient) GetOldestBlock() (*cb.Block, error) {
 	err := d.seekOldest()
@@ -118,9 +166,13 @@ func (d *DeliverClient) Close() error {
 }
 
 func seekHelper(channelID string, position *ab.SeekPosition, tlsCertHash []byte) *cb.Envelope {
+	return seekRangeHelper(channelID, position, position, tlsCertHash)
+}
+
+func seekRangeHelper(channelID string, start, stop *ab.SeekPosition, tlsCertHash []byte) *cb.Envelope {
 	seekInfo := &ab.SeekInfo{
-		Start:    position,
-		Stop:     position,
+		Start:    start,
+		Stop:     stop,
 		Behavior: ab.SeekInfo_BLOCK_UNTIL_READY,
 	}
 
@@ -88,6 +88,73 @@ func TestDeliverClientErrors(t *testing.T) {
 	assert.Contains(t, err.Error(), "error getting newest block: gorilla")
 }
 
+func TestGetBlocksInRange(t *testing.T) {
+	InitMSP()
+
+	mockClient := &mock.DeliverService{}
+	o := &DeliverClient{
+		Service: mockClient,
+	}
+	blockResponse := func(num uint64) *ab.DeliverResponse {
+		return &ab.DeliverResponse{
+			Type: &ab.DeliverResponse_Block{Block: &cb.Block{Header: &cb.BlockHeader{Number: num}}},
+		}
+	}
+	statusResponse := func(status cb.Status) *ab.DeliverResponse {
+		return &ab.DeliverResponse{
+			Type: &ab.DeliverResponse_Status{Status: status},
+		}
+	}
+
+	// success
+	mockClient.RecvReturnsOnCall(0, blockResponse(5), nil)
+	mockClient.RecvReturnsOnCall(1, blockResponse(6), nil)
+	mockClient.RecvReturnsOnCall(2, statusResponse(cb.Status_SUCCESS), nil)
+	var received []uint64
+	err := o.GetBlocksInRange(5, 6, func(block *cb.Block) error {
+		received = append(received, block.Header.Number)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{5, 6}, received)
+	assert.Equal(t, 1, mockClient.SendCallCount())
+
+	// failure - invalid range
+	err = o.GetBlocksInRange(6, 5, nil)
+	assert.EqualError(t, err, "invalid block range: start [6] is greater than end [5]")
+
+	// failure - status before all the blocks are received
+	mockClient = &mock.DeliverService{}
+	o.Service = mockClient
+	mockClient.RecvReturnsOnCall(0, blockResponse(5), nil)
+	mockClient.RecvReturnsOnCall(1, statusResponse(cb.Status_NOT_FOUND), nil)
+	err = o.GetBlocksInRange(5, 6, func(*cb.Block) error { return nil })
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "can't read the block")
+
+	// failure - block out of order
+	mockClient = &mock.DeliverService{}
+	o.Service = mockClient
+	mockClient.RecvReturns(blockResponse(7), nil)
+	err = o.GetBlocksInRange(5, 6, func(*cb.Block) error { return nil })
+	assert.EqualError(t, err, "received block [7] out of order, expected block [5]")
+
+	// failure - the function returns an error
+	mockClient.RecvReturns(blockResponse(5), nil)
+	err = o.GetBlocksInRange(5, 6, func(*cb.Block) error { return errors.New("banana") })
+	assert.EqualError(t, err, "banana")
+
+	// failure - recv returns error
+	mockClient.RecvReturns(nil, errors.New("monkey"))
+	err = o.GetBlocksInRange(5, 6, func(*cb.Block) error { return nil })
+	assert.EqualError(t, err, "error receiving: monkey")
+
+	// failure - send returns error
+	mockClient.SendReturns(errors.New("gorilla"))
+	err = o.GetBlocksInRange(5, 6, func(*cb.Block) error { return nil })
+	assert.EqualError(t, err, "error getting blocks in range: gorilla")
+}
+
 func TestNewOrdererDeliverClient(t *testing.T) {
 	defer viper.Reset()
 	cleanup := configtest.SetDevFabricConfigPath(t)
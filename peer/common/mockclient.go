@@ -43,6 +43,17 @@ func (m *mockEndorserClient) ProcessProposal(ctx context.Context, in *pb.SignedP
 	return m.response, m.err
 }
 
+func (m *mockEndorserClient) ProcessProposals(ctx context.Context, in *pb.SignedProposals, opts ...grpc.CallOption) (*pb.ProposalResponses, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	responses := &pb.ProposalResponses{}
+	for range in.Proposals {
+		responses.Responses = append(responses.Responses, m.response)
+	}
+	return responses, nil
+}
+
 func GetMockBroadcastClient(err error) BroadcastClient {
 	return &mockBroadcastClient{err: err}
 }
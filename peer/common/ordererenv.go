@@ -42,6 +42,8 @@ func SetOrdererEnv(cmd *cobra.Command, args []string) {
 	// chaining PersistentPreRun functions
 	loggingSpec := os.Getenv("FABRIC_LOGGING_SPEC")
 	flogging.InitFromSpec(loggingSpec)
+	// fill in the orderer flags not given from the profile in use
+	applyProfileToOrdererFlags()
 	// set the orderer environment from flags
 	viper.Set("orderer.tls.rootcert.file", caFile)
 	viper.Set("orderer.tls.clientKey.file", keyFile)
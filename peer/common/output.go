@@ -0,0 +1,71 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+const (
+	// OutputFormatText is the default, human oriented output format
+	OutputFormatText = "text"
+	// OutputFormatJSON is the machine readable output format, in which
+	// commands write a single JSON document to standard output
+	OutputFormatJSON = "json"
+)
+
+// outputFormatValue is a pflag.Value that only accepts the supported
+// output formats
+type outputFormatValue string
+
+func (o *outputFormatValue) String() string {
+	return string(*o)
+}
+
+func (o *outputFormatValue) Set(format string) error {
+	switch format {
+	case OutputFormatText, OutputFormatJSON:
+		*o = outputFormatValue(format)
+		return nil
+	default:
+		return errors.Errorf("unsupported output format %s, must be one of %s or %s", format, OutputFormatText, OutputFormatJSON)
+	}
+}
+
+func (o *outputFormatValue) Type() string {
+	return "string"
+}
+
+var outputFormat = outputFormatValue(OutputFormatText)
+
+// AddOutputFlag adds the --output flag, which selects the output format
+// of the commands, to the given flag set. The output format is reset to
+// the default text format.
+func AddOutputFlag(flags *pflag.FlagSet) {
+	outputFormat = OutputFormatText
+	flags.Var(&outputFormat, "output", fmt.Sprintf("The output format of the command, either %s or %s", OutputFormatText, OutputFormatJSON))
+}
+
+// OutputJSON returns whether the commands should write their results as JSON
+func OutputJSON() bool {
+	return outputFormat == OutputFormatJSON
+}
+
+// PrintJSON writes v to w as a single line JSON document
+func PrintJSON(w io.Writer, v interface{}) error {
+	jsonBytes, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrap(err, "failed marshaling output to JSON")
+	}
+	_, err = fmt.Fprintln(w, string(jsonBytes))
+	return err
+}
@@ -0,0 +1,47 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package common
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutputFlag(t *testing.T) {
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	AddOutputFlag(flags)
+	assert.False(t, OutputJSON())
+	assert.Equal(t, OutputFormatText, flags.Lookup("output").DefValue)
+
+	assert.NoError(t, flags.Parse([]string{"--output", "json"}))
+	assert.True(t, OutputJSON())
+
+	err := flags.Parse([]string{"--output", "yaml"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported output format yaml, must be one of text or json")
+	assert.True(t, OutputJSON())
+
+	// Adding the flag again resets the output format
+	AddOutputFlag(pflag.NewFlagSet("test", pflag.ContinueOnError))
+	assert.False(t, OutputJSON())
+}
+
+func TestPrintJSON(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	err := PrintJSON(buffer, struct {
+		Channels []string `json:"channels"`
+	}{Channels: []string{"mychannel"}})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"channels":["mychannel"]}`+"\n", buffer.String())
+
+	err = PrintJSON(buffer, make(chan struct{}))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed marshaling output to JSON")
+}
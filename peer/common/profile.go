@@ -0,0 +1,193 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package common
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hyperledger/fabric/core/config"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// ProfilesPathEnv is the environment variable that overrides the location of
+// the file holding the connection profiles of the peer CLI
+const ProfilesPathEnv = "FABRIC_PEER_PROFILES_PATH"
+
+// Profile is a named set of connection settings of the peer CLI. The settings
+// of the profile in use are applied unless they are overridden by the
+// corresponding CORE_* environment variable or command line flag. Relative
+// paths are relative to the directory of the profiles file.
+type Profile struct {
+	PeerAddress            string `yaml:"peerAddress,omitempty"`
+	PeerTLSEnabled         bool   `yaml:"peerTLSEnabled,omitempty"`
+	PeerTLSRootCertFile    string `yaml:"peerTLSRootCertFile,omitempty"`
+	MSPConfigPath          string `yaml:"mspConfigPath,omitempty"`
+	LocalMSPID             string `yaml:"localMspId,omitempty"`
+	OrdererAddress         string `yaml:"ordererAddress,omitempty"`
+	OrdererTLSEnabled      bool   `yaml:"ordererTLSEnabled,omitempty"`
+	OrdererTLSRootCertFile string `yaml:"ordererTLSRootCertFile,omitempty"`
+}
+
+// Profiles is the content of the profiles file
+type Profiles struct {
+	// Current is the name of the profile in use, if any
+	Current  string              `yaml:"current,omitempty"`
+	Profiles map[string]*Profile `yaml:"profiles,omitempty"`
+}
+
+// activeProfile is the profile applied by InitClientCmd, whose orderer settings
+// are applied by SetOrdererEnv
+var activeProfile *Profile
+
+// ProfilesPath returns the location of the profiles file, which is
+// $HOME/.fabric/peer_profiles.yaml unless overridden by the
+// FABRIC_PEER_PROFILES_PATH environment variable
+func ProfilesPath() string {
+	if path := os.Getenv(ProfilesPathEnv); path != "" {
+		return path
+	}
+	return filepath.Join(os.Getenv("HOME"), ".fabric", "peer_profiles.yaml")
+}
+
+// LoadProfiles reads the profiles file at the given path. A missing file
+// yields no profiles.
+func LoadProfiles(path string) (*Profiles, error) {
+	profiles := &Profiles{}
+	raw, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return profiles, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed reading profiles file %s", path)
+	}
+	if err := yaml.Unmarshal(raw, profiles); err != nil {
+		return nil, errors.Wrapf(err, "failed parsing profiles file %s", path)
+	}
+	return profiles, nil
+}
+
+// Save writes the profiles to the profiles file at the given path
+func (p *Profiles) Save(path string) error {
+	raw, err := yaml.Marshal(p)
+	if err != nil {
+		return errors.Wrap(err, "failed marshaling profiles")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.Wrapf(err, "failed creating the directory of profiles file %s", path)
+	}
+	if err := ioutil.WriteFile(path, raw, 0600); err != nil {
+		return errors.Wrapf(err, "failed writing profiles file %s", path)
+	}
+	return nil
+}
+
+// Names returns the sorted names of the profiles
+func (p *Profiles) Names() []string {
+	var names []string
+	for name := range p.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Use makes the profile with the given name the profile in use
+func (p *Profiles) Use(name string) error {
+	if _, exists := p.Profiles[name]; !exists {
+		return errors.Errorf("profile %s does not exist", name)
+	}
+	p.Current = name
+	return nil
+}
+
+// CurrentProfile returns the profile in use, or nil if no profile is in use
+func (p *Profiles) CurrentProfile() (*Profile, error) {
+	if p.Current == "" {
+		return nil, nil
+	}
+	profile, exists := p.Profiles[p.Current]
+	if !exists || profile == nil {
+		return nil, errors.Errorf("profile %s in use does not exist", p.Current)
+	}
+	return profile, nil
+}
+
+// applyProfile applies the peer settings of the profile in use to the
+// global Viper environment, and records the profile so that its orderer
+// settings are applied by SetOrdererEnv
+func applyProfile() error {
+	activeProfile = nil
+	path := ProfilesPath()
+	profiles, err := LoadProfiles(path)
+	if err != nil {
+		return err
+	}
+	profile, err := profiles.CurrentProfile()
+	if err != nil || profile == nil {
+		return err
+	}
+
+	base := filepath.Dir(path)
+	setIfNotInEnv("peer.address", profile.PeerAddress)
+	if profile.PeerTLSEnabled {
+		setIfNotInEnv("peer.tls.enabled", true)
+	}
+	setIfNotInEnv("peer.tls.rootcert.file", translateProfilePath(base, profile.PeerTLSRootCertFile))
+	setIfNotInEnv("peer.mspConfigPath", translateProfilePath(base, profile.MSPConfigPath))
+	setIfNotInEnv("peer.localMspId", profile.LocalMSPID)
+
+	activeProfile = &Profile{
+		OrdererAddress:         profile.OrdererAddress,
+		OrdererTLSEnabled:      profile.OrdererTLSEnabled,
+		OrdererTLSRootCertFile: translateProfilePath(base, profile.OrdererTLSRootCertFile),
+	}
+	mainLogger.Debugf("Using profile %s from %s", profiles.Current, path)
+	return nil
+}
+
+// applyProfileToOrdererFlags sets the orderer flags that were not given on
+// the command line from the profile in use
+func applyProfileToOrdererFlags() {
+	if activeProfile == nil {
+		return
+	}
+	if OrderingEndpoint == "" {
+		OrderingEndpoint = activeProfile.OrdererAddress
+	}
+	if !tlsEnabled {
+		tlsEnabled = activeProfile.OrdererTLSEnabled
+	}
+	if caFile == "" {
+		caFile = activeProfile.OrdererTLSRootCertFile
+	}
+}
+
+// setIfNotInEnv sets the given key in the global Viper environment, unless
+// the value is empty or the key is set by its CORE_* environment variable
+func setIfNotInEnv(key string, value interface{}) {
+	if value == "" {
+		return
+	}
+	envVar := strings.ToUpper(CmdRoot + "_" + strings.Replace(key, ".", "_", -1))
+	if _, set := os.LookupEnv(envVar); set {
+		return
+	}
+	viper.Set(key, value)
+}
+
+func translateProfilePath(base, path string) string {
+	if path == "" {
+		return ""
+	}
+	return config.TranslatePath(base, path)
+}
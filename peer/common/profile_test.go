@@ -0,0 +1,128 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package common
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "profiles")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "fabric", "peer_profiles.yaml")
+
+	// a missing profiles file yields no profiles
+	profiles, err := LoadProfiles(path)
+	require.NoError(t, err)
+	assert.Empty(t, profiles.Names())
+	profile, err := profiles.CurrentProfile()
+	assert.NoError(t, err)
+	assert.Nil(t, profile)
+
+	profiles.Profiles = map[string]*Profile{
+		"org2": {PeerAddress: "peer0.org2.example.com:9051"},
+		"org1": {PeerAddress: "peer0.org1.example.com:7051", LocalMSPID: "Org1MSP"},
+	}
+	assert.Equal(t, []string{"org1", "org2"}, profiles.Names())
+	assert.EqualError(t, profiles.Use("org3"), "profile org3 does not exist")
+	require.NoError(t, profiles.Use("org1"))
+	require.NoError(t, profiles.Save(path))
+
+	profiles, err = LoadProfiles(path)
+	require.NoError(t, err)
+	assert.Equal(t, "org1", profiles.Current)
+	profile, err = profiles.CurrentProfile()
+	assert.NoError(t, err)
+	assert.Equal(t, &Profile{PeerAddress: "peer0.org1.example.com:7051", LocalMSPID: "Org1MSP"}, profile)
+
+	profiles.Current = "org3"
+	_, err = profiles.CurrentProfile()
+	assert.EqualError(t, err, "profile org3 in use does not exist")
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("profiles: [barf"), 0600))
+	_, err = LoadProfiles(path)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed parsing profiles file")
+}
+
+func TestProfilesPath(t *testing.T) {
+	defer os.Setenv(ProfilesPathEnv, os.Getenv(ProfilesPathEnv))
+
+	os.Setenv(ProfilesPathEnv, "/tmp/profiles.yaml")
+	assert.Equal(t, "/tmp/profiles.yaml", ProfilesPath())
+	os.Unsetenv(ProfilesPathEnv)
+	assert.Equal(t, filepath.Join(os.Getenv("HOME"), ".fabric", "peer_profiles.yaml"), ProfilesPath())
+}
+
+func TestApplyProfile(t *testing.T) {
+	defer viper.Reset()
+	defer os.Setenv(ProfilesPathEnv, os.Getenv(ProfilesPathEnv))
+	defer func() {
+		activeProfile = nil
+		OrderingEndpoint, tlsEnabled, caFile = "", false, ""
+	}()
+
+	dir, err := ioutil.TempDir("", "profiles")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "peer_profiles.yaml")
+	os.Setenv(ProfilesPathEnv, path)
+
+	// no profile in use
+	require.NoError(t, applyProfile())
+	assert.Nil(t, activeProfile)
+	assert.Equal(t, "", viper.GetString("peer.address"))
+
+	profiles := &Profiles{
+		Current: "org1",
+		Profiles: map[string]*Profile{
+			"org1": {
+				PeerAddress:            "peer0.org1.example.com:7051",
+				PeerTLSEnabled:         true,
+				PeerTLSRootCertFile:    "org1/tls/ca.crt",
+				MSPConfigPath:          "/etc/org1/msp",
+				LocalMSPID:             "Org1MSP",
+				OrdererAddress:         "orderer.example.com:7050",
+				OrdererTLSEnabled:      true,
+				OrdererTLSRootCertFile: "orderer/tls/ca.crt",
+			},
+		},
+	}
+	require.NoError(t, profiles.Save(path))
+
+	// the CORE_* environment variables take precedence over the profile
+	defer os.Unsetenv("CORE_PEER_LOCALMSPID")
+	os.Setenv("CORE_PEER_LOCALMSPID", "Org2MSP")
+	viper.Set("peer.localMspId", "Org2MSP")
+
+	require.NoError(t, applyProfile())
+	assert.Equal(t, "peer0.org1.example.com:7051", viper.GetString("peer.address"))
+	assert.True(t, viper.GetBool("peer.tls.enabled"))
+	assert.Equal(t, filepath.Join(dir, "org1/tls/ca.crt"), viper.GetString("peer.tls.rootcert.file"))
+	assert.Equal(t, "/etc/org1/msp", viper.GetString("peer.mspConfigPath"))
+	assert.Equal(t, "Org2MSP", viper.GetString("peer.localMspId"))
+
+	// the orderer flags given on the command line take precedence over the profile
+	OrderingEndpoint, tlsEnabled, caFile = "", false, "ca.crt"
+	applyProfileToOrdererFlags()
+	assert.Equal(t, "orderer.example.com:7050", OrderingEndpoint)
+	assert.True(t, tlsEnabled)
+	assert.Equal(t, "ca.crt", caFile)
+
+	profiles.Current = "org3"
+	require.NoError(t, profiles.Save(path))
+	assert.EqualError(t, applyProfile(), "profile org3 in use does not exist")
+	assert.Nil(t, activeProfile)
+}
@@ -41,6 +41,7 @@ type MSPMessageCryptoService struct {
 	channelPolicyManagerGetter policies.ChannelPolicyManagerGetter
 	localSigner                crypto.LocalSigner
 	deserializer               mgmt.DeserializersManager
+	blockVerificationPolicy    string
 }
 
 // NewMCS creates a new instance of MSPMessageCryptoService
@@ -49,8 +50,19 @@ type MSPMessageCryptoService struct {
 // 1. a policies.ChannelPolicyManagerGetter that gives access to the policy manager of a given channel via the Manager method.
 // 2. an instance of crypto.LocalSigner
 // 3. an identity deserializer manager
-func NewMCS(channelPolicyManagerGetter policies.ChannelPolicyManagerGetter, localSigner crypto.LocalSigner, deserializer mgmt.DeserializersManager) *MSPMessageCryptoService {
-	return &MSPMessageCryptoService{channelPolicyManagerGetter: channelPolicyManagerGetter, localSigner: localSigner, deserializer: deserializer}
+// 4. the path of the channel policy the signatures of blocks must satisfy.
+// If empty, the block validation policy of the channel (policies.BlockValidation)
+// is used. Blocks of channels which do not define any other policy are rejected.
+func NewMCS(channelPolicyManagerGetter policies.ChannelPolicyManagerGetter, localSigner crypto.LocalSigner, deserializer mgmt.DeserializersManager, blockVerificationPolicy string) *MSPMessageCryptoService {
+	if blockVerificationPolicy == "" {
+		blockVerificationPolicy = policies.BlockValidation
+	}
+	return &MSPMessageCryptoService{
+		channelPolicyManagerGetter: channelPolicyManagerGetter,
+		localSigner:                localSigner,
+		deserializer:               deserializer,
+		blockVerificationPolicy:    blockVerificationPolicy,
+	}
 }
 
 // ValidateIdentity validates the identity of a remote peer.
@@ -160,7 +172,12 @@ func (s *MSPMessageCryptoService) VerifyBlock(chainID common.ChainID, seqNum uin
 	mcsLogger.Debugf("Got policy manager for channel [%s] with flag [%t]", channelID, ok)
 
 	// Get block validation policy
-	policy, ok := cpm.GetPolicy(policies.BlockValidation)
+	policy, ok := cpm.GetPolicy(s.blockVerificationPolicy)
+	if !ok && s.blockVerificationPolicy != policies.BlockValidation {
+		// The operator configured a policy other than the block validation one,
+		// falling back to a possibly weaker policy would defeat its purpose
+		return fmt.Errorf("Channel [%s] does not define the block verification policy [%s]", channelID, s.blockVerificationPolicy)
+	}
 	// ok is true if it was the policy requested, or false if it is the default policy
 	mcsLogger.Debugf("Got block validation policy for channel [%s] with flag [%t]", channelID, ok)
 
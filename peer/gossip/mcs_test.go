@@ -40,6 +40,7 @@ func TestPKIidOfCert(t *testing.T) {
 	msgCryptoService := NewMCS(&mocks.ChannelPolicyManagerGetterWithManager{},
 		&mockscrypto.LocalSigner{Identity: []byte("Alice")},
 		deserializersManager,
+		"",
 	)
 
 	peerIdentity := []byte("Alice")
@@ -69,7 +70,7 @@ func TestPKIidOfCert(t *testing.T) {
 }
 
 func TestPKIidOfNil(t *testing.T) {
-	msgCryptoService := NewMCS(&mocks.ChannelPolicyManagerGetter{}, localmsp.NewSigner(), mgmt.NewDeserializersManager())
+	msgCryptoService := NewMCS(&mocks.ChannelPolicyManagerGetter{}, localmsp.NewSigner(), mgmt.NewDeserializersManager(), "")
 
 	pkid := msgCryptoService.GetPKIidOfCert(nil)
 	// Check pkid is not nil
@@ -87,6 +88,7 @@ func TestValidateIdentity(t *testing.T) {
 		&mocks.ChannelPolicyManagerGetterWithManager{},
 		&mockscrypto.LocalSigner{Identity: []byte("Charlie")},
 		deserializersManager,
+		"",
 	)
 
 	err := msgCryptoService.ValidateIdentity([]byte("Alice"))
@@ -118,6 +120,7 @@ func TestSign(t *testing.T) {
 		&mocks.ChannelPolicyManagerGetter{},
 		&mockscrypto.LocalSigner{Identity: []byte("Alice")},
 		mgmt.NewDeserializersManager(),
+		"",
 	)
 
 	msg := []byte("Hello World!!!")
@@ -148,6 +151,7 @@ func TestVerify(t *testing.T) {
 				"C": &mocks.IdentityDeserializer{Identity: []byte("Dave"), Msg: []byte("msg4"), Mock: mock.Mock{}},
 			},
 		},
+		"",
 	)
 
 	msg := []byte("msg1")
@@ -202,6 +206,7 @@ func TestVerifyBlock(t *testing.T) {
 				"B": &mocks.IdentityDeserializer{Identity: []byte("Charlie"), Msg: []byte("msg3"), Mock: mock.Mock{}},
 			},
 		},
+		"",
 	)
 
 	// - Prepare testing valid block, Alice signs it.
@@ -235,6 +240,68 @@ func TestVerifyBlock(t *testing.T) {
 	assert.Error(t, msgCryptoService.VerifyBlock([]byte("C"), 42, nil))
 }
 
+type evaluatedPolicy struct {
+	err       error
+	evaluated int
+}
+
+func (p *evaluatedPolicy) Evaluate(signatureSet []*protoutil.SignedData) error {
+	p.evaluated++
+	return p.err
+}
+
+type namedPolicyManager map[string]policies.Policy
+
+func (m namedPolicyManager) GetPolicy(id string) (policies.Policy, bool) {
+	if policy, ok := m[id]; ok {
+		return policy, true
+	}
+	return &evaluatedPolicy{err: errors.New("no such policy")}, false
+}
+
+func (m namedPolicyManager) Manager(path []string) (policies.Manager, bool) {
+	panic("Not implemented")
+}
+
+func TestVerifyBlockVerificationPolicy(t *testing.T) {
+	aliceSigner := &mockscrypto.LocalSigner{Identity: []byte("Alice")}
+	defaultPolicy := &evaluatedPolicy{}
+	bftPolicy := &evaluatedPolicy{err: errors.New("signature set did not satisfy policy")}
+	policyManagerGetter := &mocks.ChannelPolicyManagerGetterWithManager{
+		Managers: map[string]policies.Manager{
+			"A": namedPolicyManager{
+				policies.BlockValidation:         defaultPolicy,
+				"/Channel/Orderer/BFTValidation": bftPolicy,
+			},
+			"B": namedPolicyManager{
+				policies.BlockValidation: defaultPolicy,
+			},
+		},
+	}
+
+	// By default, the block validation policy of the channel is evaluated
+	msgCryptoService := NewMCS(policyManagerGetter, aliceSigner, mgmt.NewDeserializersManager(), "")
+	blockRaw, _ := mockBlock(t, "A", 42, aliceSigner, nil)
+	assert.NoError(t, msgCryptoService.VerifyBlock([]byte("A"), 42, blockRaw))
+	assert.Equal(t, 1, defaultPolicy.evaluated)
+	assert.Equal(t, 0, bftPolicy.evaluated)
+
+	// The configured policy is evaluated instead, when the channel defines it
+	msgCryptoService = NewMCS(policyManagerGetter, aliceSigner, mgmt.NewDeserializersManager(), "/Channel/Orderer/BFTValidation")
+	err := msgCryptoService.VerifyBlock([]byte("A"), 42, blockRaw)
+	assert.EqualError(t, err, "signature set did not satisfy policy")
+	assert.Equal(t, 1, defaultPolicy.evaluated)
+	assert.Equal(t, 1, bftPolicy.evaluated)
+
+	// Blocks of channels which do not define the configured policy are rejected,
+	// instead of being verified against the block validation policy
+	blockRaw, _ = mockBlock(t, "B", 42, aliceSigner, nil)
+	err = msgCryptoService.VerifyBlock([]byte("B"), 42, blockRaw)
+	assert.EqualError(t, err, "Channel [B] does not define the block verification policy [/Channel/Orderer/BFTValidation]")
+	assert.Equal(t, 1, defaultPolicy.evaluated)
+	assert.Equal(t, 1, bftPolicy.evaluated)
+}
+
 func mockBlock(t *testing.T, channel string, seqNum uint64, localSigner crypto.LocalSigner, dataHash []byte) ([]byte, []byte) {
 	block := protoutil.NewBlock(seqNum, nil)
 
@@ -319,6 +386,7 @@ func TestExpiration(t *testing.T) {
 		&mocks.ChannelPolicyManagerGetterWithManager{},
 		&mockscrypto.LocalSigner{Identity: []byte("Yacov")},
 		deserializersManager,
+		"",
 	)
 
 	// Green path I check the expiration date is as expected
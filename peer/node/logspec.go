@@ -0,0 +1,221 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hyperledger/fabric/common/flogging/httpadmin"
+	"github.com/hyperledger/fabric/peer/common"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const logSpecURL = "/logspec"
+
+var (
+	logModule            string
+	logLevel             string
+	logSpec              string
+	shimLogLevel         bool
+	operationsAddress    string
+	operationsTLSRootCA  string
+	operationsClientCert string
+	operationsClientKey  string
+)
+
+func logSpecCmd() *cobra.Command {
+	// Set the flags on the node logspec command.
+	flags := nodeLogSpecCmd.Flags()
+	flags.StringVarP(&logModule, "module", "m", "", "The module, that is a logger name or a prefix of logger names, whose logging level to get or set")
+	flags.StringVarP(&logLevel, "level", "l", "", "The logging level to set for the module or the chaincode shim")
+	flags.StringVarP(&logSpec, "spec", "s", "", "The logging spec to activate, replacing the active one")
+	flags.BoolVar(&shimLogLevel, "shim", false, "Get or set the logging level of the shim of the chaincodes instead of the peer")
+	flags.StringVar(&operationsAddress, "operationsAddress", "", "The address of the operations endpoint of the peer (default operations.listenAddress)")
+	flags.StringVar(&operationsTLSRootCA, "operationsTLSRootCertFile", "", "Path to file containing PEM-encoded trusted certificate(s) for the operations endpoint")
+	flags.StringVar(&operationsClientCert, "operationsClientCert", "", "Path to file containing PEM-encoded X509 certificate to use for mutual TLS communication with the operations endpoint")
+	flags.StringVar(&operationsClientKey, "operationsClientKey", "", "Path to file containing PEM-encoded private key to use for mutual TLS communication with the operations endpoint")
+
+	return nodeLogSpecCmd
+}
+
+var nodeLogSpecCmd = &cobra.Command{
+	Use:   "logspec",
+	Short: "Gets or sets the logging spec of a running node.",
+	Long: `Gets or sets the logging spec of the running node through its operations endpoint. ` +
+		`Without flags, the active logging spec is printed. With --module, the logging level of the ` +
+		`module is printed, or set with --level, leaving the levels of the other modules unchanged. ` +
+		`With --spec, the active logging spec is replaced. With --shim, the logging level of the shim ` +
+		`of the chaincodes is printed, or set with --level, which is propagated to the running chaincodes.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			return fmt.Errorf("trailing args detected: %s", args)
+		}
+		if logSpec != "" && (logModule != "" || logLevel != "" || shimLogLevel) {
+			return errors.New("--spec cannot be combined with --module, --level or --shim")
+		}
+		if shimLogLevel && logModule != "" {
+			return errors.New("--shim cannot be combined with --module")
+		}
+		if logLevel != "" && logModule == "" && !shimLogLevel {
+			return errors.New("--level requires --module or --shim")
+		}
+		// Parsing of the command line is done so silence cmd usage
+		cmd.SilenceUsage = true
+
+		client, err := newOperationsClient()
+		if err != nil {
+			return err
+		}
+		return logSpecCommand(client, cmd.OutOrStdout())
+	},
+}
+
+func logSpecCommand(client *operationsClient, out io.Writer) error {
+	switch {
+	case logSpec != "":
+		return client.do(http.MethodPut, logSpecURL, &httpadmin.LogSpec{Spec: logSpec}, nil)
+
+	case shimLogLevel && logLevel != "":
+		return client.do(http.MethodPut, httpadmin.ShimURL, &httpadmin.ShimLevel{Level: logLevel}, nil)
+
+	case shimLogLevel:
+		level := &httpadmin.ShimLevel{}
+		if err := client.do(http.MethodGet, httpadmin.ShimURL, nil, level); err != nil {
+			return err
+		}
+		if common.OutputJSON() {
+			return common.PrintJSON(out, level)
+		}
+		fmt.Fprintln(out, level.Level)
+		return nil
+
+	case logModule != "" && logLevel != "":
+		return client.do(http.MethodPut, logSpecURL, &httpadmin.ModuleLevel{Module: logModule, Level: logLevel}, nil)
+
+	case logModule != "":
+		level := &httpadmin.ModuleLevel{}
+		if err := client.do(http.MethodGet, logSpecURL+"?module="+url.QueryEscape(logModule), nil, level); err != nil {
+			return err
+		}
+		if common.OutputJSON() {
+			return common.PrintJSON(out, level)
+		}
+		fmt.Fprintln(out, level.Level)
+		return nil
+
+	default:
+		spec := &httpadmin.LogSpec{}
+		if err := client.do(http.MethodGet, logSpecURL, nil, spec); err != nil {
+			return err
+		}
+		if common.OutputJSON() {
+			return common.PrintJSON(out, spec)
+		}
+		fmt.Fprintln(out, spec.Spec)
+		return nil
+	}
+}
+
+// operationsClient sends requests to the operations endpoint of the node
+type operationsClient struct {
+	baseURL string
+	client  *http.Client
+}
+
+// newOperationsClient creates a client of the operations endpoint of the
+// node, which uses TLS if operations.tls.enabled is set
+func newOperationsClient() (*operationsClient, error) {
+	address := operationsAddress
+	if address == "" {
+		address = viper.GetString("operations.listenAddress")
+	}
+	if address == "" {
+		return nil, errors.New("the address of the operations endpoint must be set with --operationsAddress or operations.listenAddress")
+	}
+
+	if !viper.GetBool("operations.tls.enabled") {
+		return &operationsClient{
+			baseURL: "http://" + address,
+			client:  &http.Client{Timeout: 10 * time.Second},
+		}, nil
+	}
+
+	tlsConfig := &tls.Config{}
+	if operationsTLSRootCA != "" {
+		caPEM, err := ioutil.ReadFile(operationsTLSRootCA)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed reading the TLS root certificate of the operations endpoint")
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caPEM) {
+			return nil, errors.Errorf("no certificates found in %s", operationsTLSRootCA)
+		}
+	}
+	if operationsClientCert != "" || operationsClientKey != "" {
+		cert, err := tls.LoadX509KeyPair(operationsClientCert, operationsClientKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed loading the client certificate for the operations endpoint")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return &operationsClient{
+		baseURL: "https://" + address,
+		client: &http.Client{
+			Timeout:   10 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+	}, nil
+}
+
+// do sends the request with the given JSON body, if any, and decodes the JSON
+// response into out, if any
+func (c *operationsClient) do(method, path string, body, out interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		bodyBytes, err := json.Marshal(body)
+		if err != nil {
+			return errors.Wrap(err, "failed marshaling request")
+		}
+		reqBody = bytes.NewReader(bodyBytes)
+	}
+	req, err := http.NewRequest(method, c.baseURL+path, reqBody)
+	if err != nil {
+		return errors.Wrap(err, "failed creating request")
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed contacting the operations endpoint")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		errResp := &httpadmin.ErrorResponse{}
+		if err := json.NewDecoder(resp.Body).Decode(errResp); err != nil || errResp.Error == "" {
+			return errors.Errorf("operations endpoint returned %s", resp.Status)
+		}
+		return errors.Errorf("operations endpoint returned %s: %s", resp.Status, errResp.Error)
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return errors.Wrap(err, "failed decoding response")
+	}
+	return nil
+}
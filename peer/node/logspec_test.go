@@ -0,0 +1,110 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/common/flogging/httpadmin"
+	"github.com/hyperledger/fabric/common/flogging/httpadmin/fakes"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogSpecCmd(t *testing.T) {
+	logging, err := flogging.New(flogging.Config{LogSpec: "gossip=warning:info"})
+	require.NoError(t, err)
+	fakeShimLogging := &fakes.ShimLogging{}
+	fakeShimLogging.ShimLogLevelReturns("INFO")
+
+	mux := http.NewServeMux()
+	mux.Handle(logSpecURL, &httpadmin.SpecHandler{Logging: logging, Logger: flogging.MustGetLogger("test")})
+	mux.Handle(httpadmin.ShimURL, httpadmin.NewShimLevelHandler(fakeShimLogging))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	address := strings.TrimPrefix(server.URL, "http://")
+
+	cmd := logSpecCmd()
+	cmd.SilenceErrors = true
+	run := func(args ...string) (string, error) {
+		logModule, logLevel, logSpec, shimLogLevel, operationsAddress = "", "", "", false, ""
+		cmd.Flags().VisitAll(func(f *pflag.Flag) { f.Changed = false })
+		buffer := &bytes.Buffer{}
+		cmd.SetOutput(buffer)
+		cmd.SetArgs(append(args, "--operationsAddress", address))
+		err := cmd.Execute()
+		return buffer.String(), err
+	}
+
+	out, err := run()
+	require.NoError(t, err)
+	assert.Equal(t, "gossip=warn:info\n", out)
+
+	out, err = run("--module", "gossip.privdata")
+	require.NoError(t, err)
+	assert.Equal(t, "warn\n", out)
+
+	_, err = run("--module", "gossip.privdata", "--level", "debug")
+	require.NoError(t, err)
+	assert.Equal(t, "debug", logging.Level("gossip.privdata").String())
+	assert.Equal(t, "warn", logging.Level("gossip.election").String())
+
+	_, err = run("--spec", "error")
+	require.NoError(t, err)
+	assert.Equal(t, "error", logging.Spec())
+
+	out, err = run("--shim")
+	require.NoError(t, err)
+	assert.Equal(t, "INFO\n", out)
+
+	_, err = run("--shim", "--level", "debug")
+	require.NoError(t, err)
+	require.Equal(t, 1, fakeShimLogging.SetShimLogLevelCallCount())
+	assert.Equal(t, "debug", fakeShimLogging.SetShimLogLevelArgsForCall(0))
+
+	_, err = run("--module", "gossip", "--level", "loud")
+	assert.EqualError(t, err, "operations endpoint returned 400 Bad Request: invalid logging specification 'error:gossip=loud': bad segment 'gossip=loud'")
+
+	tests := []struct {
+		name        string
+		args        []string
+		expectedErr string
+	}{
+		{
+			name:        "trailing args",
+			args:        []string{"foo"},
+			expectedErr: "trailing args detected: [foo]",
+		},
+		{
+			name:        "spec and module",
+			args:        []string{"--spec", "debug", "--module", "gossip"},
+			expectedErr: "--spec cannot be combined with --module, --level or --shim",
+		},
+		{
+			name:        "shim and module",
+			args:        []string{"--shim", "--module", "gossip"},
+			expectedErr: "--shim cannot be combined with --module",
+		},
+		{
+			name:        "level without module",
+			args:        []string{"--level", "debug"},
+			expectedErr: "--level requires --module or --shim",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := run(tt.args...)
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
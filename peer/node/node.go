@@ -26,7 +26,7 @@ import (
 
 const (
 	nodeFuncName = "node"
-	nodeCmdDes   = "Operate a peer node: start|status."
+	nodeCmdDes   = "Operate a peer node: start|status|reset|rollback|snapshot|logspec."
 )
 
 var logger = flogging.MustGetLogger("nodeCmd")
@@ -35,6 +35,10 @@ var logger = flogging.MustGetLogger("nodeCmd")
 func Cmd() *cobra.Command {
 	nodeCmd.AddCommand(startCmd())
 	nodeCmd.AddCommand(statusCmd())
+	nodeCmd.AddCommand(resetCmd())
+	nodeCmd.AddCommand(rollbackCmd())
+	nodeCmd.AddCommand(snapshotCmd())
+	nodeCmd.AddCommand(logSpecCmd())
 
 	return nodeCmd
 }
@@ -0,0 +1,47 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"github.com/hyperledger/fabric/core/committer/txvalidator/plugin"
+	"github.com/hyperledger/fabric/core/endorser"
+	"github.com/hyperledger/fabric/core/handlers/decoration"
+	endorsement "github.com/hyperledger/fabric/core/handlers/endorsement/api"
+	"github.com/hyperledger/fabric/core/handlers/library"
+)
+
+// endorsementPlugins maps the names of the endorsement plugins to the
+// factories of their versions in use
+type endorsementPlugins struct {
+	*library.VersionedPlugins
+}
+
+// PluginFactoryByName returns the factory of the version in use of the
+// endorsement plugin with the given name, or nil if not found
+func (ep endorsementPlugins) PluginFactoryByName(name endorser.PluginName) endorsement.PluginFactory {
+	_, factory := ep.PluginVersionByName(name)
+	return factory
+}
+
+// PluginVersionByName returns the version in use and its factory of the
+// endorsement plugin with the given name, or nil if not found
+func (ep endorsementPlugins) PluginVersionByName(name endorser.PluginName) (string, endorsement.PluginFactory) {
+	return ep.Factory(string(name))
+}
+
+// validationPlugins maps the names of the validation plugins to their
+// factories, and provides the decorators whose decorations are made
+// available to the validation plugins
+type validationPlugins struct {
+	plugin.MapBasedMapper
+	decorators []decoration.Decorator
+}
+
+// Decorators returns the decorators of the chaincode input
+func (vp validationPlugins) Decorators() []decoration.Decorator {
+	return vp.decorators
+}
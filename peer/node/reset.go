@@ -0,0 +1,34 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/fabric/core/ledger/kvledger"
+	"github.com/spf13/cobra"
+)
+
+func resetCmd() *cobra.Command {
+	return nodeResetCmd
+}
+
+var nodeResetCmd = &cobra.Command{
+	Use:   "reset",
+	Short: "Resets the node.",
+	Long: `Resets all channels on the peer by dropping the state and history databases, while ` +
+		`preserving the block store. The blocks of all channels are replayed to rebuild the databases ` +
+		`when the peer is next started. When the command is executed, the peer must be offline.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			return fmt.Errorf("trailing args detected: %s", args)
+		}
+		// Parsing of the command line is done so silence cmd usage
+		cmd.SilenceUsage = true
+		return kvledger.ResetAllKVLedgers()
+	},
+}
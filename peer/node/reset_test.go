@@ -0,0 +1,43 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResetCmd(t *testing.T) {
+	testPath, err := ioutil.TempDir("", "resetcmd")
+	require.NoError(t, err)
+	defer os.RemoveAll(testPath)
+	viper.Set("peer.fileSystemPath", testPath)
+	defer viper.Reset()
+
+	stateDBPath := ledgerconfig.GetStateLevelDBPath()
+	require.NoError(t, os.MkdirAll(stateDBPath, 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(stateDBPath, "CURRENT"), []byte("MANIFEST-000001"), 0644))
+
+	cmd := resetCmd()
+	cmd.SilenceErrors = true
+
+	cmd.SetArgs([]string{"foo"})
+	assert.EqualError(t, cmd.Execute(), "trailing args detected: [foo]")
+	assert.DirExists(t, stateDBPath)
+
+	cmd.SetArgs([]string{})
+	assert.NoError(t, cmd.Execute())
+	_, err = os.Stat(stateDBPath)
+	assert.True(t, os.IsNotExist(err))
+}
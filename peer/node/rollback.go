@@ -0,0 +1,51 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/fabric/core/ledger/kvledger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	channelID   string
+	blockNumber uint64
+)
+
+func rollbackCmd() *cobra.Command {
+	// Set the flags on the node rollback command.
+	flags := nodeRollbackCmd.Flags()
+	flags.StringVarP(&channelID, "channelID", "c", "", "Channel to rollback")
+	flags.Uint64VarP(&blockNumber, "blockNumber", "b", 0, "Block number to which the channel needs to be rolled back to")
+
+	return nodeRollbackCmd
+}
+
+var nodeRollbackCmd = &cobra.Command{
+	Use:   "rollback",
+	Short: "Rolls back a channel.",
+	Long: `Rolls back a channel to a specified block number. The blocks after the specified block ` +
+		`are removed, and the databases are rebuilt from the remaining blocks when the peer is ` +
+		`next started. When the command is executed, the peer must be offline.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			return fmt.Errorf("trailing args detected: %s", args)
+		}
+		if channelID == "" {
+			return errors.New("Must supply channel ID")
+		}
+		if !cmd.Flags().Changed("blockNumber") {
+			return errors.New("Must supply the block number to which the channel needs to be rolled back to")
+		}
+		// Parsing of the command line is done so silence cmd usage
+		cmd.SilenceUsage = true
+		return kvledger.RollbackKVLedger(channelID, blockNumber)
+	},
+}
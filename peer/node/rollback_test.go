@@ -0,0 +1,67 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollbackCmd(t *testing.T) {
+	testPath, err := ioutil.TempDir("", "rollbackcmd")
+	require.NoError(t, err)
+	defer os.RemoveAll(testPath)
+	viper.Set("peer.fileSystemPath", testPath)
+	defer viper.Reset()
+
+	cmd := rollbackCmd()
+	cmd.SilenceErrors = true
+
+	tests := []struct {
+		name        string
+		args        []string
+		expectedErr string
+	}{
+		{
+			name:        "missing channel ID",
+			args:        []string{"-b", "5"},
+			expectedErr: "Must supply channel ID",
+		},
+		{
+			name:        "missing block number",
+			args:        []string{"-c", "mychannel"},
+			expectedErr: "Must supply the block number to which the channel needs to be rolled back to",
+		},
+		{
+			name:        "trailing args",
+			args:        []string{"-c", "mychannel", "-b", "5", "foo"},
+			expectedErr: "trailing args detected: [foo]",
+		},
+		{
+			name:        "non-existent channel",
+			args:        []string{"-c", "mychannel", "-b", "5"},
+			expectedErr: "ledger [mychannel] does not exist",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			channelID = ""
+			blockNumber = 0
+			cmd.Flags().VisitAll(func(f *pflag.Flag) { f.Changed = false })
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/fabric/core/ledger/kvledger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var snapshotPath string
+
+func snapshotCmd() *cobra.Command {
+	// Set the flags on the node snapshot command.
+	flags := nodeSnapshotCmd.Flags()
+	flags.StringVarP(&channelID, "channelID", "c", "", "Channel to snapshot")
+	flags.StringVarP(&snapshotPath, "snapshotpath", "", "", "Path to the directory to write the snapshot to, which must not exist or be empty")
+
+	return nodeSnapshotCmd
+}
+
+var nodeSnapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Generates a snapshot of a channel.",
+	Long: `Generates a snapshot of the public state and the hashes of the private data of a channel ` +
+		`as of its last block, along with its latest config block. Another peer can join the channel ` +
+		`from the snapshot with 'peer channel joinbysnapshot', instead of replaying the blocks from ` +
+		`the genesis block. The snapshot does not contain the blocks, the private data or the history ` +
+		`of the keys. When the command is executed, the peer must be offline.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			return fmt.Errorf("trailing args detected: %s", args)
+		}
+		if channelID == "" {
+			return errors.New("Must supply channel ID")
+		}
+		if snapshotPath == "" {
+			return errors.New("Must supply the path to the directory to write the snapshot to")
+		}
+		// Parsing of the command line is done so silence cmd usage
+		cmd.SilenceUsage = true
+		return kvledger.GenerateSnapshot(channelID, snapshotPath)
+	},
+}
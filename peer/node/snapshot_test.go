@@ -0,0 +1,69 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotCmd(t *testing.T) {
+	testPath, err := ioutil.TempDir("", "snapshotcmd")
+	require.NoError(t, err)
+	defer os.RemoveAll(testPath)
+	viper.Set("peer.fileSystemPath", testPath)
+	defer viper.Reset()
+	snapshotDir := filepath.Join(testPath, "snapshot")
+
+	cmd := snapshotCmd()
+	cmd.SilenceErrors = true
+
+	tests := []struct {
+		name        string
+		args        []string
+		expectedErr string
+	}{
+		{
+			name:        "missing channel ID",
+			args:        []string{"--snapshotpath", snapshotDir},
+			expectedErr: "Must supply channel ID",
+		},
+		{
+			name:        "missing snapshot path",
+			args:        []string{"-c", "mychannel"},
+			expectedErr: "Must supply the path to the directory to write the snapshot to",
+		},
+		{
+			name:        "trailing args",
+			args:        []string{"-c", "mychannel", "--snapshotpath", snapshotDir, "foo"},
+			expectedErr: "trailing args detected: [foo]",
+		},
+		{
+			name:        "non-existent channel",
+			args:        []string{"-c", "mychannel", "--snapshotpath", snapshotDir},
+			expectedErr: "ledger [mychannel] does not exist",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			channelID = ""
+			snapshotPath = ""
+			cmd.Flags().VisitAll(func(f *pflag.Flag) { f.Changed = false })
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
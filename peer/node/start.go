@@ -7,27 +7,36 @@ SPDX-License-Identifier: Apache-2.0
 package node
 
 import (
+	"crypto/tls"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/bccsp/factory"
+	"github.com/hyperledger/fabric/common/audit"
 	"github.com/hyperledger/fabric/common/cauthdsl"
+	"github.com/hyperledger/fabric/common/certmonitor"
 	ccdef "github.com/hyperledger/fabric/common/chaincode"
 	"github.com/hyperledger/fabric/common/crypto/tlsgen"
 	"github.com/hyperledger/fabric/common/deliver"
 	"github.com/hyperledger/fabric/common/flogging"
+	logginghttpadmin "github.com/hyperledger/fabric/common/flogging/httpadmin"
 	floggingmetrics "github.com/hyperledger/fabric/common/flogging/metrics"
 	"github.com/hyperledger/fabric/common/grpclogging"
 	"github.com/hyperledger/fabric/common/grpcmetrics"
+	"github.com/hyperledger/fabric/common/grpctracing"
 	"github.com/hyperledger/fabric/common/localmsp"
 	"github.com/hyperledger/fabric/common/metadata"
 	"github.com/hyperledger/fabric/common/metrics"
 	"github.com/hyperledger/fabric/common/policies"
+	"github.com/hyperledger/fabric/common/redaction"
 	"github.com/hyperledger/fabric/common/viperutil"
 	"github.com/hyperledger/fabric/core/aclmgmt"
 	"github.com/hyperledger/fabric/core/aclmgmt/resources"
@@ -42,24 +51,33 @@ import (
 	"github.com/hyperledger/fabric/core/committer/txvalidator/plugin"
 	"github.com/hyperledger/fabric/core/common/ccprovider"
 	"github.com/hyperledger/fabric/core/common/privdata"
+	coreconfig "github.com/hyperledger/fabric/core/config"
 	"github.com/hyperledger/fabric/core/container"
 	"github.com/hyperledger/fabric/core/container/dockercontroller"
 	"github.com/hyperledger/fabric/core/container/inproccontroller"
+	"github.com/hyperledger/fabric/core/container/kubernetescontroller"
 	"github.com/hyperledger/fabric/core/dispatcher"
 	"github.com/hyperledger/fabric/core/endorser"
+	"github.com/hyperledger/fabric/core/endorser/recorder"
+	recorderadmin "github.com/hyperledger/fabric/core/endorser/recorder/httpadmin"
 	authHandler "github.com/hyperledger/fabric/core/handlers/auth"
-	endorsement2 "github.com/hyperledger/fabric/core/handlers/endorsement/api"
+	"github.com/hyperledger/fabric/core/handlers/decoration"
 	endorsement3 "github.com/hyperledger/fabric/core/handlers/endorsement/api/identities"
 	"github.com/hyperledger/fabric/core/handlers/library"
-	"github.com/hyperledger/fabric/core/handlers/validation/api"
+	pluginadmin "github.com/hyperledger/fabric/core/handlers/library/httpadmin"
+	"github.com/hyperledger/fabric/core/handlers/statelistener"
+	statedispatcher "github.com/hyperledger/fabric/core/handlers/statelistener/dispatcher"
+	validation "github.com/hyperledger/fabric/core/handlers/validation/api"
 	"github.com/hyperledger/fabric/core/ledger/cceventmgmt"
 	"github.com/hyperledger/fabric/core/ledger/ledgermgmt"
 	"github.com/hyperledger/fabric/core/operations"
 	"github.com/hyperledger/fabric/core/peer"
+	peeradmin "github.com/hyperledger/fabric/core/peer/httpadmin"
 	"github.com/hyperledger/fabric/core/scc"
 	"github.com/hyperledger/fabric/core/scc/cscc"
 	"github.com/hyperledger/fabric/core/scc/lscc"
 	"github.com/hyperledger/fabric/core/scc/qscc"
+	"github.com/hyperledger/fabric/core/scc/querycache"
 	"github.com/hyperledger/fabric/discovery"
 	"github.com/hyperledger/fabric/discovery/endorsement"
 	discsupport "github.com/hyperledger/fabric/discovery/support"
@@ -68,9 +86,12 @@ import (
 	"github.com/hyperledger/fabric/discovery/support/config"
 	"github.com/hyperledger/fabric/discovery/support/gossip"
 	gossipcommon "github.com/hyperledger/fabric/gossip/common"
+	electionadmin "github.com/hyperledger/fabric/gossip/election/httpadmin"
+	gossipadmin "github.com/hyperledger/fabric/gossip/gossip/httpadmin"
 	"github.com/hyperledger/fabric/gossip/service"
 	"github.com/hyperledger/fabric/msp"
 	"github.com/hyperledger/fabric/msp/mgmt"
+	msphttpadmin "github.com/hyperledger/fabric/msp/mgmt/httpadmin"
 	peergossip "github.com/hyperledger/fabric/peer/gossip"
 	"github.com/hyperledger/fabric/peer/version"
 	cb "github.com/hyperledger/fabric/protos/common"
@@ -156,6 +177,32 @@ func serve(args []string) error {
 		return errors.WithMessage(err, "failed to initialize operations subystems")
 	}
 	defer opsSystem.Stop()
+	mspReloadHandler := msphttpadmin.NewHandler(msphttpadmin.Reloaders{
+		LocalMsp:    mgmt.ReloadLocalMsp,
+		ChannelMSPs: peer.ReloadChannelMSPs,
+	}, mgmt.CheckLocalAdmin)
+	opsSystem.RegisterHandler(msphttpadmin.URL, mspReloadHandler)
+	opsSystem.RegisterHandler(msphttpadmin.ChannelsURL, mspReloadHandler)
+
+	if viper.GetBool("peer.audit.enabled") {
+		auditSink, err := initializeAuditLog(coreconfig.GetPath("peer.audit.file"))
+		if err != nil {
+			return err
+		}
+		defer auditSink.Close()
+	}
+
+	argsRedaction, err := redaction.ParseMode(viper.GetString("peer.argsRedaction"))
+	if err != nil {
+		return err
+	}
+	redaction.Configure(argsRedaction)
+
+	if interval := viper.GetDuration("peer.mspReloadInterval"); interval > 0 {
+		mspWatchDone := make(chan struct{})
+		defer close(mspWatchDone)
+		go mgmt.WatchLocalMsp(interval, mspWatchDone)
+	}
 
 	metricsProvider := opsSystem.Provider
 	logObserver := floggingmetrics.NewObserver(metricsProvider)
@@ -176,6 +223,8 @@ func serve(args []string) error {
 		ChannelConfigSource:          peer.Default,
 	}
 
+	collElgListener := service.NewCollElgListener()
+
 	//initialize resource management exit
 	ledgermgmt.Initialize(
 		&ledgermgmt.Initializer{
@@ -183,6 +232,7 @@ func serve(args []string) error {
 			PlatformRegistry:              pr,
 			DeployedChaincodeInfoProvider: lifecycleImpl,
 			MembershipInfoProvider:        membershipInfoProvider,
+			CollElgListener:               collElgListener,
 			MetricsProvider:               metricsProvider,
 			HealthCheckRegistry:           opsSystem,
 		},
@@ -252,6 +302,10 @@ func serve(args []string) error {
 		grpclogging.StreamServerInterceptor(flogging.MustGetLogger("comm.grpc.server").Zap()),
 		throttle.StreamServerInterceptor,
 	)
+	if tracer := opsSystem.Tracer(); tracer != nil {
+		serverConfig.UnaryInterceptors = append(serverConfig.UnaryInterceptors, grpctracing.UnaryServerInterceptor(tracer))
+		serverConfig.StreamInterceptors = append(serverConfig.StreamInterceptors, grpctracing.StreamServerInterceptor(tracer))
+	}
 
 	peerServer, err := peer.NewPeerServer(listenAddr, serverConfig)
 	if err != nil {
@@ -270,6 +324,22 @@ func serve(args []string) error {
 			logger.Fatalf("Failed to set TLS client certificate (%s)", err)
 		}
 		comm.GetCredentialSupport().SetClientCertificate(clientCert)
+
+		// resume the TLS sessions of connections to other peers, if they allow it
+		if serverConfig.SecOpts.SessionResumption {
+			cacheSize := viper.GetInt("peer.tls.sessionResumption.cacheSize")
+			comm.GetCredentialSupport().SetPeerSessionCache(tls.NewLRUClientSessionCache(cacheSize))
+		}
+
+		// pin the TLS certificates of remote peers and orderers to the TLS CAs of their organizations
+		cs.SetOrgCAPinning(viper.GetBool("peer.tls.orgCAPinning.enabled"))
+
+		// rotate the TLS key pair and client root CAs of the peer server without a restart
+		if interval := viper.GetDuration("peer.tls.reloadInterval"); interval > 0 {
+			tlsWatchDone := make(chan struct{})
+			defer close(tlsWatchDone)
+			go peerServer.WatchTLS(peer.TLSLoader(), interval, tlsWatchDone)
+		}
 	}
 
 	mutualTLS := serverConfig.SecOpts.UseTLS && serverConfig.SecOpts.RequireClientCert
@@ -279,9 +349,20 @@ func serve(args []string) error {
 		}
 	}
 
-	abServer := peer.NewDeliverEventsServer(mutualTLS, policyCheckerProvider, &peer.DeliverChainManager{}, metricsProvider)
+	// the private data delivered to clients is filtered by the member orgs
+	// policies of the collections, which only rely on the channel MSPs
+	collectionFilter := privdata.NewSimpleCollectionStore(&peer.CollectionSupport{})
+	abServer := peer.NewDeliverEventsServer(mutualTLS, policyCheckerProvider, &peer.DeliverChainManager{}, metricsProvider, collectionFilter)
 	pb.RegisterDeliverServer(peerServer.Server(), abServer)
 
+	txStatusServer := peer.NewTransactionStatusServer(mutualTLS, policyCheckerProvider, func(channelID string) peer.TransactionStatusLedger {
+		if l := peer.GetLedger(channelID); l != nil {
+			return l
+		}
+		return nil
+	})
+	pb.RegisterTransactionStatusServer(peerServer.Server(), txStatusServer)
+
 	// Create a self-signed CA for chaincode service
 	ca, err := tlsgen.NewCA()
 	if err != nil {
@@ -316,20 +397,50 @@ func serve(args []string) error {
 		ChannelConfigSource: peer.Default,
 	}
 
-	dockerProvider := dockercontroller.NewProvider(
-		viper.GetString("peer.id"),
-		viper.GetString("peer.networkId"),
-		opsSystem.Provider,
-	)
-	dockerVM := dockercontroller.NewDockerVM(
-		dockerProvider.PeerID,
-		dockerProvider.NetworkID,
-		dockerProvider.BuildMetrics,
-	)
+	// User chaincodes are launched with the DOCKER container type, which
+	// is provided by the configured chaincode runtime
+	var chaincodeVMProvider container.VMProvider
+	switch runtime := viper.GetString("vm.runtime"); runtime {
+	case "", "docker":
+		dockerProvider := dockercontroller.NewProvider(
+			viper.GetString("peer.id"),
+			viper.GetString("peer.networkId"),
+			opsSystem.Provider,
+		)
+		dockerVM := dockercontroller.NewDockerVM(
+			dockerProvider.PeerID,
+			dockerProvider.NetworkID,
+			dockerProvider.BuildMetrics,
+		)
 
-	err = opsSystem.RegisterChecker("docker", dockerVM)
-	if err != nil {
-		logger.Panicf("failed to register docker health check: %s", err)
+		err = opsSystem.RegisterChecker("docker", dockerVM)
+		if err != nil {
+			logger.Panicf("failed to register docker health check: %s", err)
+		}
+
+		if interval := viper.GetDuration("vm.docker.statsInterval"); interval > 0 {
+			containerStatsDone := make(chan struct{})
+			defer close(containerStatsDone)
+			go dockerProvider.StatsCollector.Run(interval, containerStatsDone)
+		}
+		chaincodeVMProvider = dockerProvider
+	case "kubernetes":
+		kubernetesProvider, err := kubernetescontroller.NewProvider(
+			viper.GetString("peer.id"),
+			viper.GetString("peer.networkId"),
+			kubernetesConfig(),
+		)
+		if err != nil {
+			return errors.WithMessage(err, "failed to create the kubernetes chaincode runtime")
+		}
+
+		err = opsSystem.RegisterChecker("kubernetes", kubernetesProvider.NewVM())
+		if err != nil {
+			logger.Panicf("failed to register kubernetes health check: %s", err)
+		}
+		chaincodeVMProvider = kubernetesProvider
+	default:
+		return errors.Errorf("unknown chaincode runtime: %s", runtime)
 	}
 
 	chaincodeSupport := chaincode.NewChaincodeSupport(
@@ -343,7 +454,7 @@ func serve(args []string) error {
 		aclProvider,
 		container.NewVMController(
 			map[string]container.VMProvider{
-				dockercontroller.ContainerType: dockerProvider,
+				dockercontroller.ContainerType: chaincodeVMProvider,
 				inproccontroller.ContainerType: ipRegistry,
 			},
 		),
@@ -354,6 +465,7 @@ func serve(args []string) error {
 		lifecycleImpl,
 	)
 	ipRegistry.ChaincodeSupport = chaincodeSupport
+	opsSystem.RegisterHandler(logginghttpadmin.ShimURL, logginghttpadmin.NewShimLevelHandler(chaincodeSupport))
 
 	ccSupSrv := pb.ChaincodeSupportServer(chaincodeSupport)
 	if tlsEnabled {
@@ -362,6 +474,11 @@ func serve(args []string) error {
 
 	csccInst := cscc.New(sccp, aclProvider, lifecycleImpl, lsccInst, lifecycleImpl)
 	qsccInst := qscc.New(aclProvider)
+	if queryCacheSize := viper.GetInt("peer.queryCacheSize"); queryCacheSize > 0 {
+		queryCache := querycache.New(queryCacheSize)
+		csccInst.ResponseCache = queryCache
+		qsccInst.ResponseCache = queryCache
+	}
 
 	//Now that chaincode is initialized, register all system chaincodes.
 	sccs := scc.CreatePluginSysCCs(sccp)
@@ -373,6 +490,11 @@ func serve(args []string) error {
 	// start the chaincode specific gRPC listening service
 	go ccSrv.Start()
 
+	err = opsSystem.RegisterChecker("chaincode", &chaincode.LauncherHealthChecker{Address: ccSrv.Address()})
+	if err != nil {
+		logger.Panicf("failed to register chaincode launcher health check: %s", err)
+	}
+
 	logger.Debugf("Running peer")
 
 	// Start the Admin server
@@ -382,11 +504,18 @@ func serve(args []string) error {
 		return service.GetGossipService().DistributePrivateData(channel, txID, privateData, blkHt)
 	}
 
+	if err := loadLocalIdentities(); err != nil {
+		logger.Panicf("Failed loading local identities: %s", err)
+	}
 	signingIdentity := mgmt.GetLocalSigningIdentityOrPanic()
 	serializedIdentity, err := signingIdentity.Serialize()
 	if err != nil {
 		logger.Panicf("Failed serializing self identity: %v", err)
 	}
+	endorsementSigningIdentity, err := mgmt.GetLocalSigningIdentity(viper.GetString("peer.signingIdentities.endorsement"))
+	if err != nil {
+		logger.Panicf("Failed getting the endorsement signing identity: %s", err)
+	}
 
 	libConf := library.Config{}
 	if err = viperutil.EnhancedExactUnmarshalKey("peer.handlers", &libConf); err != nil {
@@ -395,19 +524,43 @@ func serve(args []string) error {
 	reg := library.InitRegistry(libConf)
 
 	authFilters := reg.Lookup(library.Auth).([]authHandler.Filter)
+	var stateListenerDispatcher *statedispatcher.Dispatcher
+	if stateListeners := reg.Lookup(library.StateListener).([]statelistener.Listener); len(stateListeners) > 0 {
+		stateListenerDispatcher = statedispatcher.New(stateListeners)
+		defer stateListenerDispatcher.Stop()
+	}
 	endorserSupport := &endorser.SupportImpl{
-		SignerSupport:    signingIdentity,
+		SignerSupport:    endorsementSigningIdentity,
 		Peer:             peer.Default,
 		PeerSupport:      peer.DefaultSupport,
 		ChaincodeSupport: chaincodeSupport,
 		SysCCProvider:    sccp,
 		ACLProvider:      aclProvider,
 	}
-	endorsementPluginsByName := reg.Lookup(library.Endorsement).(map[string]endorsement2.PluginFactory)
-	validationPluginsByName := reg.Lookup(library.Validation).(map[string]validation.PluginFactory)
+	if aclCacheSize := viper.GetInt("peer.aclCacheSize"); aclCacheSize > 0 {
+		aclCacheTTL := viper.GetDuration("peer.aclCacheTTL")
+		if aclCacheTTL <= 0 {
+			aclCacheTTL = endorser.DefaultACLCacheTTL
+		}
+		endorserSupport.ACLCache = endorser.NewACLCache(aclCacheSize, aclCacheTTL, aclProvider, peer.GetStableChannelConfig)
+	}
+	endorsementPluginVersions := reg.EndorserVersions()
+	if viper.GetBool("operations.pluginUpdates.enabled") {
+		if libraryDir := viper.GetString("operations.pluginUpdates.libraryDir"); libraryDir != "" {
+			if err := endorsementPluginVersions.SetLibraryDir(libraryDir); err != nil {
+				return err
+			}
+		}
+		opsSystem.RegisterHandler(pluginadmin.URLBase, pluginadmin.NewHandler(endorsementPluginVersions, mgmt.CheckLocalAdmin))
+		logger.Warning("Endorsement plugins can be updated through the operations service")
+	}
 	signingIdentityFetcher := (endorsement3.SigningIdentityFetcher)(endorserSupport)
 	channelStateRetriever := endorser.ChannelStateRetriever(endorserSupport)
-	pluginMapper := endorser.MapBasedPluginMapper(endorsementPluginsByName)
+	pluginMapper := endorsementPlugins{VersionedPlugins: endorsementPluginVersions}
+	validationPluginMapper := validationPlugins{
+		MapBasedMapper: plugin.MapBasedMapper(reg.Lookup(library.Validation).(map[string]validation.PluginFactory)),
+		decorators:     reg.Lookup(library.Decoration).([]decoration.Decorator),
+	}
 	pluginEndorser := endorser.NewPluginEndorser(&endorser.PluginSupport{
 		ChannelStateRetriever:   channelStateRetriever,
 		TransientStoreRetriever: peer.TransientStoreFactory,
@@ -416,6 +569,25 @@ func serve(args []string) error {
 	})
 	endorserSupport.PluginEndorser = pluginEndorser
 	serverEndorser := endorser.NewEndorserServer(privDataDist, endorserSupport, pr, metricsProvider)
+	if serverEndorser.WriteSetPolicy, err = writeSetPolicyConfig(); err != nil {
+		return err
+	}
+	serverEndorser.MaxBatchSize = viper.GetInt("peer.maxProposalBatchSize")
+	serverEndorser.SimulationHeightTimeout = viper.GetDuration("peer.simulationHeightTimeout")
+	serverEndorser.MaxBlocksBehind = maxBlocksBehindConfig()
+	if serverEndorser.ConcurrencyLimiter, err = concurrencyLimiterConfig(); err != nil {
+		return err
+	}
+	if viper.GetBool("peer.simulationRecording.enabled") {
+		maxRecords := viper.GetInt("peer.simulationRecording.maxRecords")
+		if maxRecords <= 0 {
+			maxRecords = 1000
+		}
+		simulationRecorder := recorder.NewRecorder(aclProvider, maxRecords)
+		serverEndorser.SimulationRecorder = simulationRecorder
+		opsSystem.RegisterHandler(recorderadmin.URLBase, recorderadmin.NewHandler(simulationRecorder, mgmt.CheckLocalAdmin))
+		logger.Infof("Recording up to %d simulations of proposals", maxRecords)
+	}
 	auth := authHandler.ChainFilters(serverEndorser, authFilters...)
 	// Register the Endorser server
 	pb.RegisterEndorserServer(peerServer.Server(), auth)
@@ -428,6 +600,15 @@ func serve(args []string) error {
 		return err
 	}
 	defer service.GetGossipService().Stop()
+	collElgListener.SetGossipService(service.GetGossipService())
+	endorserSupport.ChannelMembership = service.GetGossipService()
+	opsSystem.RegisterHandler(electionadmin.URLBase, electionadmin.NewHandler(service.GetGossipService(), mgmt.CheckLocalAdmin))
+	opsSystem.RegisterHandler(gossipadmin.URLBase, gossipadmin.NewHandler(service.GetGossipService()))
+	opsSystem.RegisterHandler(peeradmin.URLBase, peeradmin.NewHandler(&peeradmin.PeerStatusSource{
+		Lifecycle:    lifecycleImpl,
+		Elections:    service.GetGossipService(),
+		StaticLeader: viper.GetBool("peer.gossip.orgLeader"),
+	}))
 
 	// register prover grpc service
 	err = registerProverService(peerServer, aclProvider, signingIdentity)
@@ -465,13 +646,24 @@ func serve(args []string) error {
 			logger.Panicf("Failed subscribing to chaincode lifecycle updates")
 		}
 		cceventmgmt.GetMgr().Register(cid, sub)
-	}, sccp, plugin.MapBasedMapper(validationPluginsByName),
-		pr, lifecycleImpl, membershipInfoProvider, metricsProvider, lsccInst, lifecycleImpl)
+		if stateListenerDispatcher != nil {
+			stateListenerDispatcher.Start(cid, peer.GetLedger(cid))
+		}
+	}, sccp, validationPluginMapper, pr, lifecycleImpl, membershipInfoProvider, metricsProvider, lsccInst, lifecycleImpl)
 
 	if viper.GetBool("peer.discovery.enabled") {
 		registerDiscoveryService(peerServer, policyMgr, lifecycle)
 	}
 
+	certScanInterval, certWarningThresholds, err := certExpirationConfig()
+	if err != nil {
+		return err
+	}
+	certMonitor := certmonitor.NewMonitor(certWarningThresholds, certmonitor.NewMetrics(metricsProvider), certMonitorSources()...)
+	certMonitorDone := make(chan struct{})
+	defer close(certMonitorDone)
+	go certMonitor.Run(certScanInterval, certMonitorDone)
+
 	networkID := viper.GetString("peer.networkId")
 
 	logger.Infof("Starting peer with ID=[%s], network ID=[%s], address=[%s]", peerEndpoint.Id, networkID, peerEndpoint.Address)
@@ -511,6 +703,7 @@ func serve(args []string) error {
 	}))
 
 	logger.Infof("Started peer with ID=[%s], network ID=[%s], address=[%s]", peerEndpoint.Id, networkID, peerEndpoint.Address)
+	opsSystem.SetReady()
 
 	// Block until grpc server exits
 	return <-serve
@@ -570,18 +763,91 @@ func registerDiscoveryService(peerServer *comm.GRPCServer, polMgr policies.Chann
 	gSup := gossip.NewDiscoverySupport(service.GetGossipService())
 	ccSup := ccsupport.NewDiscoverySupport(lc)
 	ea := endorsement.NewEndorsementAnalyzer(gSup, ccSup, acl, lc)
+	ea.MaxBlocksBehind = maxBlocksBehindConfig()
 	confSup := config.NewDiscoverySupport(config.CurrentConfigBlockGetterFunc(peer.GetCurrConfigBlock))
 	support := discsupport.NewDiscoverySupport(acl, gSup, ea, confSup, acl)
 	svc := discovery.NewService(discovery.Config{
-		TLS:                          peerServer.TLSEnabled(),
-		AuthCacheEnabled:             viper.GetBool("peer.discovery.authCacheEnabled"),
-		AuthCacheMaxSize:             viper.GetInt("peer.discovery.authCacheMaxSize"),
-		AuthCachePurgeRetentionRatio: viper.GetFloat64("peer.discovery.authCachePurgeRetentionRatio"),
+		TLS:                            peerServer.TLSEnabled(),
+		AuthCacheEnabled:               viper.GetBool("peer.discovery.authCacheEnabled"),
+		AuthCacheMaxSize:               viper.GetInt("peer.discovery.authCacheMaxSize"),
+		AuthCachePurgeRetentionRatio:   viper.GetFloat64("peer.discovery.authCachePurgeRetentionRatio"),
+		ResultCacheEnabled:             viper.GetBool("peer.discovery.resultCacheEnabled"),
+		ResultCacheMaxSize:             viper.GetInt("peer.discovery.resultCacheMaxSize"),
+		ResultCachePurgeRetentionRatio: viper.GetFloat64("peer.discovery.resultCachePurgeRetentionRatio"),
+		ResultCacheTTL:                 viper.GetDuration("peer.discovery.resultCacheTTL"),
 	}, support)
 	logger.Info("Discovery service activated")
 	discprotos.RegisterDiscoveryServer(peerServer.Server(), svc)
 }
 
+// kubernetesConfig returns the configuration of the kubernetes chaincode runtime
+func kubernetesConfig() kubernetescontroller.Config {
+	return kubernetescontroller.Config{
+		Endpoint:         viper.GetString("vm.kubernetes.endpoint"),
+		TokenFile:        coreconfig.GetPath("vm.kubernetes.tokenFile"),
+		CAFile:           coreconfig.GetPath("vm.kubernetes.caFile"),
+		Namespace:        viper.GetString("vm.kubernetes.namespace"),
+		ImageRegistry:    viper.GetString("vm.kubernetes.imageRegistry"),
+		ImagePullSecrets: viper.GetStringSlice("vm.kubernetes.imagePullSecrets"),
+		Limits:           viper.GetStringMapString("vm.kubernetes.resources.limits"),
+		Requests:         viper.GetStringMapString("vm.kubernetes.resources.requests"),
+		PollInterval:     viper.GetDuration("vm.kubernetes.pollInterval"),
+	}
+}
+
+// localIdentityConfig is the configuration of a local identity that the peer
+// loads in addition to its local MSP
+type localIdentityConfig struct {
+	Name          string
+	MSPConfigPath string `mapstructure:"mspConfigPath"`
+	LocalMSPID    string `mapstructure:"localMspId"`
+	LocalMSPType  string `mapstructure:"localMspType"`
+}
+
+// loadLocalIdentities loads the local identities defined in
+// peer.localIdentities, and checks that the identities that
+// peer.signingIdentities selects to sign the outbound operations exist
+func loadLocalIdentities() error {
+	var identities []localIdentityConfig
+	if viper.IsSet("peer.localIdentities") {
+		if err := viperutil.EnhancedExactUnmarshalKey("peer.localIdentities", &identities); err != nil {
+			return errors.WithMessage(err, "could not load peer.localIdentities")
+		}
+	}
+	if len(identities) > 0 {
+		var bccspConfig *factory.FactoryOpts
+		if err := viperutil.EnhancedExactUnmarshalKey("peer.BCCSP", &bccspConfig); err != nil {
+			return errors.WithMessage(err, "could not parse peer.BCCSP")
+		}
+		for _, identity := range identities {
+			mspType := identity.LocalMSPType
+			if mspType == "" {
+				mspType = msp.ProviderTypeToString(msp.FABRIC)
+			}
+			dir := coreconfig.TranslatePath(filepath.Dir(viper.ConfigFileUsed()), identity.MSPConfigPath)
+			if err := mgmt.LoadLocalIdentity(identity.Name, dir, bccspConfig, identity.LocalMSPID, mspType); err != nil {
+				return errors.WithMessage(err, fmt.Sprintf("could not load local identity %s from %s", identity.Name, dir))
+			}
+		}
+	}
+
+	for _, operation := range []string{"endorsement", "deliver"} {
+		if _, err := mgmt.GetLocalSigningIdentity(viper.GetString("peer.signingIdentities." + operation)); err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("invalid peer.signingIdentities.%s", operation))
+		}
+	}
+	return nil
+}
+
+// maxBlocksBehindConfig returns the maximum number of blocks the ledger of a
+// channel may lag behind the channel for the peer to be ready for endorsement
+func maxBlocksBehindConfig() uint64 {
+	if maxBlocksBehind := viper.GetInt("peer.endorsementReadiness.maxBlocksBehind"); maxBlocksBehind > 0 {
+		return uint64(maxBlocksBehind)
+	}
+	return 0
+}
+
 //create a CC listener using peer.chaincodeListenAddress (and if that's not set use peer.peerAddress)
 func createChaincodeServer(ca tlsgen.CA, peerHostname string) (srv *comm.GRPCServer, ccEndpoint string, err error) {
 	// before potentially setting chaincodeListenAddress, compute chaincode endpoint at first
@@ -641,13 +907,9 @@ func createChaincodeServer(ca tlsgen.CA, peerHostname string) (srv *comm.GRPCSer
 		}
 	}
 
-	// Chaincode keepalive options - static for now
-	chaincodeKeepaliveOptions := &comm.KeepaliveOptions{
-		ServerInterval:    time.Duration(2) * time.Hour,    // 2 hours - gRPC default
-		ServerTimeout:     time.Duration(20) * time.Second, // 20 sec - gRPC default
-		ServerMinInterval: time.Duration(1) * time.Minute,  // match ClientInterval
-	}
-	config.KaOpts = chaincodeKeepaliveOptions
+	// Chaincode keepalive options - static for now, matching those of the
+	// shim, without the connection limits of the peer server
+	config.KaOpts = comm.DefaultKeepaliveOptions.Clone()
 	config.HealthCheckEnabled = true
 
 	srv, err = comm.NewGRPCServer(cclistenAddress, config)
@@ -799,7 +1061,7 @@ func secureDialOpts() []grpc.DialOption {
 			grpc.MaxCallRecvMsgSize(comm.MaxRecvMsgSize),
 			grpc.MaxCallSendMsgSize(comm.MaxSendMsgSize)))
 	// set the keepalive options
-	kaOpts := comm.DefaultKeepaliveOptions
+	kaOpts := comm.DefaultKeepaliveOptions.Clone()
 	if viper.IsSet("peer.keepalive.client.interval") {
 		kaOpts.ClientInterval = viper.GetDuration("peer.keepalive.client.interval")
 	}
@@ -839,6 +1101,7 @@ func initGossipService(policyMgr policies.ChannelPolicyManagerGetter, metricsPro
 		policyMgr,
 		localmsp.NewSigner(),
 		mgmt.NewDeserializersManager(),
+		viper.GetString("peer.blockVerificationPolicy"),
 	)
 	secAdv := peergossip.NewSecurityAdvisor(mgmt.NewDeserializersManager())
 	bootstrap := viper.GetStringSlice("peer.gossip.bootstrap")
@@ -868,6 +1131,18 @@ func newOperationsSystem() *operations.System {
 				WriteInterval: viper.GetDuration("metrics.statsd.writeInterval"),
 				Prefix:        viper.GetString("metrics.statsd.prefix"),
 			},
+			OTLP: &operations.OTLP{
+				Endpoint:      viper.GetString("metrics.otlp.endpoint"),
+				WriteInterval: viper.GetDuration("metrics.otlp.writeInterval"),
+				Headers:       viper.GetStringMapString("metrics.otlp.headers"),
+			},
+		},
+		Tracing: operations.TracingOptions{
+			Enabled:        viper.GetBool("operations.tracing.enabled"),
+			Endpoint:       viper.GetString("operations.tracing.endpoint"),
+			WriteInterval:  viper.GetDuration("operations.tracing.writeInterval"),
+			Headers:        viper.GetStringMapString("operations.tracing.headers"),
+			MaxQueuedSpans: viper.GetInt("operations.tracing.maxQueuedSpans"),
 		},
 		TLS: operations.TLS{
 			Enabled:            viper.GetBool("operations.tls.enabled"),
@@ -876,7 +1151,12 @@ func newOperationsSystem() *operations.System {
 			ClientCertRequired: viper.GetBool("operations.tls.clientAuthRequired"),
 			ClientCACertFiles:  viper.GetStringSlice("operations.tls.clientRootCAs.files"),
 		},
-		Version: metadata.Version,
+		Profile: operations.ProfileOptions{
+			Enabled:     viper.GetBool("operations.profile.enabled"),
+			MaxDuration: viper.GetDuration("operations.profile.maxDuration"),
+		},
+		Version:     metadata.Version,
+		ServiceName: "peer",
 	})
 }
 
@@ -912,3 +1192,144 @@ func registerProverService(peerServer *comm.GRPCServer, aclProvider aclmgmt.ACLP
 	token.RegisterProverServer(peerServer.Server(), prover)
 	return nil
 }
+
+// certExpirationConfig returns how often the expiration of certificates is
+// checked, and the thresholds at which warnings about it are logged.
+func certExpirationConfig() (time.Duration, []time.Duration, error) {
+	scanInterval := viper.GetDuration("peer.certExpiration.scanInterval")
+	if scanInterval <= 0 {
+		scanInterval = certmonitor.DefaultScanInterval
+	}
+
+	if !viper.IsSet("peer.certExpiration.warningThresholds") {
+		return scanInterval, []time.Duration{30 * 24 * time.Hour, 7 * 24 * time.Hour, 24 * time.Hour}, nil
+	}
+
+	var thresholds []time.Duration
+	for _, t := range viper.GetStringSlice("peer.certExpiration.warningThresholds") {
+		threshold, err := time.ParseDuration(t)
+		if err != nil {
+			return 0, nil, errors.Wrapf(err, "invalid peer.certExpiration.warningThresholds")
+		}
+		thresholds = append(thresholds, threshold)
+	}
+	return scanInterval, thresholds, nil
+}
+
+// initializeAuditLog configures the audit log to be appended to the file
+// at the given path, resuming the chain of hashes of the records already in
+// the file.
+func initializeAuditLog(path string) (*audit.FileSink, error) {
+	if path == "" {
+		return nil, errors.New("peer.audit.file must be set when the audit log is enabled")
+	}
+	last, err := audit.VerifyFile(path)
+	if err != nil {
+		logger.Warningf("Audit log %s failed verification, it may have been tampered with: %s", path, err)
+	}
+	sink, err := audit.NewFileSink(path)
+	if err != nil {
+		return nil, err
+	}
+	audit.Configure(last, sink)
+	logger.Infof("Recording audit events to %s", path)
+	return sink, nil
+}
+
+// writeSetPolicyConfig returns the limits on the write-sets of the endorsed
+// transactions defined in peer.writeSetLimits, or nil if there are none
+func writeSetPolicyConfig() (*endorser.WriteSetPolicy, error) {
+	if !viper.IsSet("peer.writeSetLimits") {
+		return nil, nil
+	}
+	policy := &endorser.WriteSetPolicy{}
+	if err := viperutil.EnhancedExactUnmarshalKey("peer.writeSetLimits", policy); err != nil {
+		return nil, errors.WithMessage(err, "could not load peer.writeSetLimits")
+	}
+	limits := map[string]endorser.WriteSetLimits{"": policy.WriteSetLimits}
+	for channel, channelLimits := range policy.Channels {
+		limits[channel] = channelLimits
+	}
+	for channel, l := range limits {
+		if l.MaxBytes < 0 || l.MaxWrites < 0 {
+			if channel == "" {
+				return nil, errors.New("invalid peer.writeSetLimits: limits must not be negative")
+			}
+			return nil, errors.Errorf("invalid peer.writeSetLimits of channel %s: limits must not be negative", channel)
+		}
+	}
+	return policy, nil
+}
+
+// concurrencyLimiterConfig returns the limiter of the concurrent proposals
+// of the endorser defined by peer.concurrencyLimits, or nil if no limit is set
+func concurrencyLimiterConfig() (*endorser.ConcurrencyLimiter, error) {
+	if !viper.IsSet("peer.concurrencyLimits") {
+		return nil, nil
+	}
+	limits := endorser.ConcurrencyLimits{
+		Global:       viper.GetInt("peer.concurrencyLimits.global"),
+		PerChaincode: viper.GetInt("peer.concurrencyLimits.perChaincode"),
+		MaxQueued:    viper.GetInt("peer.concurrencyLimits.maxQueued"),
+		QueueTimeout: viper.GetDuration("peer.concurrencyLimits.queueTimeout"),
+		RetryAfter:   viper.GetDuration("peer.concurrencyLimits.retryAfter"),
+	}
+	if limits.Global < 0 || limits.PerChaincode < 0 || limits.MaxQueued < 0 || limits.QueueTimeout < 0 || limits.RetryAfter < 0 {
+		return nil, errors.New("invalid peer.concurrencyLimits: limits must not be negative")
+	}
+	if limits.Global == 0 && limits.PerChaincode == 0 {
+		return nil, nil
+	}
+	logger.Infof("Limiting concurrent proposals to %d globally and %d per chaincode, with up to %d queued proposals",
+		limits.Global, limits.PerChaincode, limits.MaxQueued)
+	return endorser.NewConcurrencyLimiter(limits), nil
+}
+
+// certMonitorSources returns the certificates the peer monitors for expiration:
+// those of its local MSP, its TLS certificates and those of its channels' MSPs.
+func certMonitorSources() []certmonitor.Source {
+	localMSP := func() ([]certmonitor.Certificate, error) {
+		conf, err := mgmt.GetLocalMspConfig()
+		if err != nil {
+			return nil, err
+		}
+		return certmonitor.MSPCertificates(certmonitor.KindLocalMSP, "", conf)
+	}
+
+	tls := func() ([]certmonitor.Certificate, error) {
+		if !viper.GetBool("peer.tls.enabled") {
+			return nil, nil
+		}
+		var pemCerts [][]byte
+		for _, key := range []string{"peer.tls.cert.file", "peer.tls.clientCert.file", "peer.tls.rootcert.file"} {
+			path := coreconfig.GetPath(key)
+			if path == "" {
+				continue
+			}
+			pemCert, err := ioutil.ReadFile(path)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed reading %s", key)
+			}
+			pemCerts = append(pemCerts, pemCert)
+		}
+		return certmonitor.PEMCertificates(certmonitor.KindTLS, "", viper.GetString("peer.localMspId"), pemCerts...)
+	}
+
+	channels := func() ([]certmonitor.Certificate, error) {
+		var certs []certmonitor.Certificate
+		for _, ci := range peer.GetChannelsInfo() {
+			bundle := peer.GetStableChannelConfig(ci.ChannelId)
+			if bundle == nil {
+				continue
+			}
+			channelCerts, err := certmonitor.ChannelCertificates(ci.ChannelId, bundle.ConfigtxValidator().ConfigProto())
+			if err != nil {
+				return nil, errors.WithMessage(err, fmt.Sprintf("failed getting certificates of channel %s", ci.ChannelId))
+			}
+			certs = append(certs, channelCerts...)
+		}
+		return certs, nil
+	}
+
+	return []certmonitor.Source{localMSP, tls, channels}
+}
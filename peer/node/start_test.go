@@ -8,16 +8,23 @@ package node
 
 import (
 	"bytes"
+	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hyperledger/fabric/common/viperutil"
+	"github.com/hyperledger/fabric/core/config/configtest"
+	"github.com/hyperledger/fabric/core/endorser"
 	"github.com/hyperledger/fabric/core/handlers/library"
+	"github.com/hyperledger/fabric/msp/mgmt"
 	"github.com/hyperledger/fabric/msp/mgmt/testtools"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 )
 
@@ -173,3 +180,150 @@ func TestComputeChaincodeEndpoint(t *testing.T) {
 	/*** Scenario 4: set up both chaincodeAddress and chaincodeListenAddress ***/
 	// This scenario will be the same to scenarios 3: set up chaincodeAddress only.
 }
+
+func TestCertExpirationConfig(t *testing.T) {
+	defer viper.Reset()
+
+	scanInterval, thresholds, err := certExpirationConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, scanInterval)
+	assert.Equal(t, []time.Duration{720 * time.Hour, 168 * time.Hour, 24 * time.Hour}, thresholds)
+
+	viper.Set("peer.certExpiration.scanInterval", "1h")
+	viper.Set("peer.certExpiration.warningThresholds", []string{"48h", "1h"})
+	scanInterval, thresholds, err = certExpirationConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, scanInterval)
+	assert.Equal(t, []time.Duration{48 * time.Hour, time.Hour}, thresholds)
+
+	viper.Set("peer.certExpiration.warningThresholds", []string{"a while"})
+	_, _, err = certExpirationConfig()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid peer.certExpiration.warningThresholds")
+}
+
+func TestWriteSetPolicyConfig(t *testing.T) {
+	defer viper.Reset()
+
+	policy, err := writeSetPolicyConfig()
+	assert.NoError(t, err)
+	assert.Nil(t, policy)
+
+	viper.SetConfigType("yaml")
+	err = viper.ReadConfig(strings.NewReader(`---
+peer:
+  writeSetLimits:
+    maxBytes: 1048576
+    maxWrites: 100
+    forbiddenNamespaces:
+      - lscc
+    channels:
+      mychannel:
+        maxWrites: 10
+`))
+	require.NoError(t, err)
+	policy, err = writeSetPolicyConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, &endorser.WriteSetPolicy{
+		WriteSetLimits: endorser.WriteSetLimits{MaxBytes: 1048576, MaxWrites: 100, ForbiddenNamespaces: []string{"lscc"}},
+		Channels: map[string]endorser.WriteSetLimits{
+			"mychannel": {MaxWrites: 10},
+		},
+	}, policy)
+
+	err = viper.ReadConfig(strings.NewReader(`---
+peer:
+  writeSetLimits:
+    channels:
+      mychannel:
+        maxBytes: -1
+`))
+	require.NoError(t, err)
+	_, err = writeSetPolicyConfig()
+	assert.EqualError(t, err, "invalid peer.writeSetLimits of channel mychannel: limits must not be negative")
+}
+
+func TestConcurrencyLimiterConfig(t *testing.T) {
+	defer viper.Reset()
+
+	limiter, err := concurrencyLimiterConfig()
+	assert.NoError(t, err)
+	assert.Nil(t, limiter)
+
+	viper.SetConfigType("yaml")
+	err = viper.ReadConfig(strings.NewReader(`---
+peer:
+  concurrencyLimits:
+    global: 0
+    perChaincode: 0
+    maxQueued: 1000
+`))
+	require.NoError(t, err)
+	limiter, err = concurrencyLimiterConfig()
+	assert.NoError(t, err)
+	assert.Nil(t, limiter)
+
+	err = viper.ReadConfig(strings.NewReader(`---
+peer:
+  concurrencyLimits:
+    global: 100
+    perChaincode: 10
+    maxQueued: 1000
+    queueTimeout: 10s
+    retryAfter: 2s
+`))
+	require.NoError(t, err)
+	limiter, err = concurrencyLimiterConfig()
+	assert.NoError(t, err)
+	assert.NotNil(t, limiter)
+
+	err = viper.ReadConfig(strings.NewReader(`---
+peer:
+  concurrencyLimits:
+    global: 100
+    maxQueued: -1
+`))
+	require.NoError(t, err)
+	_, err = concurrencyLimiterConfig()
+	assert.EqualError(t, err, "invalid peer.concurrencyLimits: limits must not be negative")
+}
+
+func TestLoadLocalIdentities(t *testing.T) {
+	defer viper.Reset()
+	require.NoError(t, msptesttools.LoadMSPSetupForTesting())
+	mspDir, err := configtest.GetDevMspDir()
+	require.NoError(t, err)
+
+	assert.NoError(t, loadLocalIdentities())
+
+	viper.SetConfigType("yaml")
+	err = viper.ReadConfig(strings.NewReader(fmt.Sprintf(`---
+peer:
+  localIdentities:
+    - name: next
+      mspConfigPath: %s
+      localMspId: SampleOrg
+  signingIdentities:
+    endorsement: next
+    deliver: missing
+`, mspDir)))
+	require.NoError(t, err)
+	err = loadLocalIdentities()
+	assert.EqualError(t, err, "invalid peer.signingIdentities.deliver: local identity missing is not loaded")
+
+	viper.Set("peer.signingIdentities.deliver", "")
+	assert.NoError(t, loadLocalIdentities())
+	assert.Contains(t, mgmt.LocalIdentityNames(), "next")
+
+	err = viper.ReadConfig(strings.NewReader(`---
+peer:
+  localIdentities:
+    - name: other
+      mspConfigPath: /nonexistent
+      localMspId: SampleOrg
+`))
+	require.NoError(t, err)
+	err = loadLocalIdentities()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "could not load local identity other from /nonexistent")
+}
@@ -0,0 +1,105 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/fabric/peer/common"
+	"github.com/spf13/cobra"
+)
+
+const (
+	profileFuncName = "profile"
+	profileCmdDes   = "Manage the connection profiles of the peer CLI: list|use."
+)
+
+// Cmd returns the cobra command for Profile
+func Cmd() *cobra.Command {
+	profileCmd := &cobra.Command{
+		Use:   profileFuncName,
+		Short: fmt.Sprint(profileCmdDes),
+		Long: fmt.Sprint(profileCmdDes) + " Connection profiles are read from " +
+			"$HOME/.fabric/peer_profiles.yaml, or from the file given by the " +
+			common.ProfilesPathEnv + " environment variable.",
+	}
+	profileCmd.AddCommand(listCmd())
+	profileCmd.AddCommand(useCmd())
+
+	return profileCmd
+}
+
+// profileList is the JSON output of the list command
+type profileList struct {
+	Current  string   `json:"current,omitempty"`
+	Profiles []string `json:"profiles"`
+}
+
+func listCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the connection profiles.",
+		Long:  "List the connection profiles. The profile in use is marked with an asterisk.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return fmt.Errorf("trailing args detected")
+			}
+			// Parsing of the command line is done so silence cmd usage
+			cmd.SilenceUsage = true
+
+			profiles, err := common.LoadProfiles(common.ProfilesPath())
+			if err != nil {
+				return err
+			}
+			names := profiles.Names()
+			if common.OutputJSON() {
+				if names == nil {
+					names = []string{}
+				}
+				return common.PrintJSON(cmd.OutOrStdout(), profileList{Current: profiles.Current, Profiles: names})
+			}
+			for _, name := range names {
+				marker := " "
+				if name == profiles.Current {
+					marker = "*"
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "%s %s\n", marker, name)
+			}
+			return nil
+		},
+	}
+}
+
+func useCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "use <name>",
+		Short: "Use the connection profile with the given name.",
+		Long: "Use the connection profile with the given name for the subsequent peer commands. " +
+			"The settings of the profile are overridden by the corresponding CORE_* environment variables and command line flags.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("the name of the profile to use must be provided")
+			}
+			// Parsing of the command line is done so silence cmd usage
+			cmd.SilenceUsage = true
+
+			path := common.ProfilesPath()
+			profiles, err := common.LoadProfiles(path)
+			if err != nil {
+				return err
+			}
+			if err := profiles.Use(args[0]); err != nil {
+				return err
+			}
+			if err := profiles.Save(path); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Using profile %s\n", args[0])
+			return nil
+		},
+	}
+}
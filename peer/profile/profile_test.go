@@ -0,0 +1,74 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profile
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/fabric/peer/common"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileCmd(t *testing.T) {
+	defer os.Setenv(common.ProfilesPathEnv, os.Getenv(common.ProfilesPathEnv))
+	dir, err := ioutil.TempDir("", "profiles")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "peer_profiles.yaml")
+	os.Setenv(common.ProfilesPathEnv, path)
+
+	profiles := &common.Profiles{
+		Profiles: map[string]*common.Profile{
+			"org1": {PeerAddress: "peer0.org1.example.com:7051"},
+			"org2": {PeerAddress: "peer0.org2.example.com:9051"},
+		},
+	}
+	require.NoError(t, profiles.Save(path))
+
+	run := func(args ...string) (string, error) {
+		cmd := Cmd()
+		common.AddOutputFlag(cmd.PersistentFlags())
+		buf := &bytes.Buffer{}
+		cmd.SetOutput(buf)
+		cmd.SetArgs(args)
+		err := cmd.Execute()
+		return buf.String(), err
+	}
+
+	out, err := run("list")
+	assert.NoError(t, err)
+	assert.Equal(t, "  org1\n  org2\n", out)
+
+	out, err = run("use", "org2")
+	assert.NoError(t, err)
+	assert.Equal(t, "Using profile org2\n", out)
+	profiles, err = common.LoadProfiles(path)
+	require.NoError(t, err)
+	assert.Equal(t, "org2", profiles.Current)
+
+	out, err = run("list")
+	assert.NoError(t, err)
+	assert.Equal(t, "  org1\n* org2\n", out)
+
+	out, err = run("list", "--output", "json")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"current":"org2","profiles":["org1","org2"]}`, out)
+	common.AddOutputFlag(pflag.NewFlagSet("reset", pflag.ContinueOnError))
+
+	_, err = run("use", "org3")
+	assert.EqualError(t, err, "profile org3 does not exist")
+	_, err = run("use")
+	assert.EqualError(t, err, "the name of the profile to use must be provided")
+	_, err = run("list", "org1")
+	assert.EqualError(t, err, "trailing args detected")
+}
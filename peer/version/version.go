@@ -11,6 +11,7 @@ import (
 	"runtime"
 
 	"github.com/hyperledger/fabric/common/metadata"
+	"github.com/hyperledger/fabric/peer/common"
 	"github.com/spf13/cobra"
 )
 
@@ -32,11 +33,45 @@ var cobraCommand = &cobra.Command{
 		}
 		// Parsing of the command line is done so silence cmd usage
 		cmd.SilenceUsage = true
+		if common.OutputJSON() {
+			return common.PrintJSON(cmd.OutOrStdout(), getVersionInfo())
+		}
 		fmt.Print(GetInfo())
 		return nil
 	},
 }
 
+// versionInfo is the JSON output of the version command
+type versionInfo struct {
+	Name      string        `json:"name"`
+	Version   string        `json:"version"`
+	CommitSHA string        `json:"commit_sha"`
+	GoVersion string        `json:"go_version"`
+	OSArch    string        `json:"os_arch"`
+	Chaincode chaincodeInfo `json:"chaincode"`
+}
+
+type chaincodeInfo struct {
+	BaseDockerNamespace string `json:"base_docker_namespace"`
+	BaseDockerLabel     string `json:"base_docker_label"`
+	DockerNamespace     string `json:"docker_namespace"`
+}
+
+func getVersionInfo() versionInfo {
+	return versionInfo{
+		Name:      ProgramName,
+		Version:   metadata.Version,
+		CommitSHA: metadata.CommitSHA,
+		GoVersion: runtime.Version(),
+		OSArch:    fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH),
+		Chaincode: chaincodeInfo{
+			BaseDockerNamespace: metadata.BaseDockerNamespace,
+			BaseDockerLabel:     metadata.BaseDockerLabel,
+			DockerNamespace:     metadata.DockerNamespace,
+		},
+	}
+}
+
 // GetInfo returns version information for the peer
 func GetInfo() string {
 	ccinfo := fmt.Sprintf("  Base Docker Namespace: %s\n"+
@@ -17,8 +17,12 @@
 package version
 
 import (
+	"bytes"
+	"encoding/json"
+	"runtime"
 	"testing"
 
+	"github.com/hyperledger/fabric/peer/common"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -33,3 +37,20 @@ func TestCmdWithTrailingArgs(t *testing.T) {
 	cmd.SetArgs(args)
 	assert.EqualError(t, cmd.Execute(), "trailing args detected")
 }
+
+func TestCmdJSON(t *testing.T) {
+	cmd := Cmd()
+	common.AddOutputFlag(cmd.Flags())
+	defer cmd.Flags().Set("output", common.OutputFormatText)
+	buffer := &bytes.Buffer{}
+	cmd.SetOutput(buffer)
+	cmd.SetArgs([]string{"--output", "json"})
+	defer cmd.SetOutput(nil)
+	assert.NoError(t, cmd.Execute())
+
+	info := versionInfo{}
+	assert.NoError(t, json.Unmarshal(buffer.Bytes(), &info))
+	assert.Equal(t, getVersionInfo(), info)
+	assert.Equal(t, ProgramName, info.Name)
+	assert.Equal(t, runtime.Version(), info.GoVersion)
+}
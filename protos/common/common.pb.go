@@ -28,6 +28,7 @@ const (
 	Status_BAD_REQUEST              Status = 400
 	Status_FORBIDDEN                Status = 403
 	Status_NOT_FOUND                Status = 404
+	Status_GONE                     Status = 410
 	Status_REQUEST_ENTITY_TOO_LARGE Status = 413
 	Status_INTERNAL_SERVER_ERROR    Status = 500
 	Status_NOT_IMPLEMENTED          Status = 501
@@ -40,6 +41,7 @@ var Status_name = map[int32]string{
 	400: "BAD_REQUEST",
 	403: "FORBIDDEN",
 	404: "NOT_FOUND",
+	410: "GONE",
 	413: "REQUEST_ENTITY_TOO_LARGE",
 	500: "INTERNAL_SERVER_ERROR",
 	501: "NOT_IMPLEMENTED",
@@ -51,6 +53,7 @@ var Status_value = map[string]int32{
 	"BAD_REQUEST":              400,
 	"FORBIDDEN":                403,
 	"NOT_FOUND":                404,
+	"GONE":                     410,
 	"REQUEST_ENTITY_TOO_LARGE": 413,
 	"INTERNAL_SERVER_ERROR":    500,
 	"NOT_IMPLEMENTED":          501,
@@ -789,65 +792,66 @@ func init() {
 func init() { proto.RegisterFile("common/common.proto", fileDescriptor_common_b374fafc5e1c956e) }
 
 var fileDescriptor_common_b374fafc5e1c956e = []byte{
-	// 959 bytes of a gzipped FileDescriptorProto
+	// 965 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
-	0x18, 0xdd, 0xc4, 0xf9, 0xf9, 0xa5, 0x69, 0xdd, 0x49, 0xcb, 0x9a, 0xc2, 0x6a, 0x2b, 0xc3, 0xa2,
-	0xd2, 0x4a, 0xa9, 0x28, 0x17, 0x38, 0x3a, 0xf6, 0xb4, 0xb5, 0x9a, 0x8e, 0xc3, 0xd8, 0x59, 0xc4,
-	0x2e, 0x92, 0xe5, 0x24, 0xd3, 0x24, 0x22, 0xb1, 0x23, 0x7b, 0x52, 0xb5, 0x67, 0xee, 0x08, 0x09,
-	0xae, 0xfc, 0x2f, 0x1c, 0x11, 0x7f, 0x0f, 0x88, 0x2b, 0x1a, 0x8f, 0xed, 0x4d, 0xca, 0x4a, 0x9c,
-	0xe2, 0xf7, 0xe6, 0xcd, 0xf7, 0xbd, 0xf9, 0xde, 0xc4, 0x86, 0xce, 0x38, 0x5a, 0x2e, 0xa3, 0xf0,
-	0x5c, 0xfe, 0x74, 0x57, 0x71, 0xc4, 0x23, 0x54, 0x93, 0xe8, 0xe8, 0xe5, 0x34, 0x8a, 0xa6, 0x0b,
-	0x76, 0x9e, 0xb2, 0xa3, 0xf5, 0xdd, 0x39, 0x9f, 0x2f, 0x59, 0xc2, 0x83, 0xe5, 0x4a, 0x0a, 0x75,
-	0x1d, 0xa0, 0x1f, 0x24, 0xdc, 0x8c, 0xc2, 0xbb, 0xf9, 0x14, 0x1d, 0x40, 0x75, 0x1e, 0x4e, 0xd8,
-	0x83, 0x56, 0x3a, 0x2e, 0x9d, 0x54, 0xa8, 0x04, 0xfa, 0x5b, 0x68, 0xdc, 0x32, 0x1e, 0x4c, 0x02,
-	0x1e, 0x08, 0xc5, 0x7d, 0xb0, 0x58, 0xb3, 0x54, 0xb1, 0x43, 0x25, 0x40, 0x5f, 0x03, 0x24, 0xf3,
-	0x69, 0x18, 0xf0, 0x75, 0xcc, 0x12, 0xad, 0x7c, 0xac, 0x9c, 0xb4, 0x2e, 0x3e, 0xec, 0x66, 0x8e,
-	0xf2, 0xbd, 0x6e, 0xae, 0xa0, 0x1b, 0x62, 0xfd, 0x7b, 0xd8, 0xff, 0x8f, 0x00, 0x7d, 0x0e, 0x6a,
-	0x21, 0xf1, 0x67, 0x2c, 0x98, 0xb0, 0x38, 0x6b, 0xb8, 0x57, 0xf0, 0xd7, 0x29, 0x8d, 0x3e, 0x86,
-	0x66, 0x41, 0x69, 0xe5, 0x54, 0xf3, 0x8e, 0xd0, 0xdf, 0x40, 0x2d, 0xd3, 0xbd, 0x82, 0xdd, 0xf1,
-	0x2c, 0x08, 0x43, 0xb6, 0xd8, 0x2e, 0xd8, 0xce, 0xd8, 0x4c, 0xf6, 0xbe, 0xce, 0xe5, 0xf7, 0x76,
-	0xd6, 0x7f, 0x2c, 0x43, 0xdb, 0xdc, 0xda, 0x8c, 0xa0, 0xc2, 0x1f, 0x57, 0x72, 0x36, 0x55, 0x9a,
-	0x3e, 0x23, 0x0d, 0xea, 0xf7, 0x2c, 0x4e, 0xe6, 0x51, 0x98, 0xd6, 0xa9, 0xd2, 0x1c, 0xa2, 0xaf,
-	0xa0, 0x59, 0xa4, 0xa1, 0x29, 0xc7, 0xa5, 0x93, 0xd6, 0xc5, 0x51, 0x57, 0xe6, 0xd5, 0xcd, 0xf3,
-	0xea, 0x7a, 0xb9, 0x82, 0xbe, 0x13, 0xa3, 0x17, 0x00, 0xf9, 0x59, 0xe6, 0x13, 0xad, 0x72, 0x5c,
-	0x3a, 0x69, 0xd2, 0x66, 0xc6, 0xd8, 0x13, 0xd4, 0x81, 0x2a, 0x7f, 0x10, 0x2b, 0xd5, 0x74, 0xa5,
-	0xc2, 0x1f, 0xec, 0x89, 0x08, 0x8e, 0xad, 0xa2, 0xf1, 0x4c, 0xab, 0xc9, 0x68, 0x53, 0x20, 0xa6,
-	0xc7, 0x1e, 0x38, 0x0b, 0x53, 0x7f, 0x75, 0x39, 0xbd, 0x82, 0x40, 0x3a, 0xb4, 0xf9, 0x22, 0xf1,
-	0xc7, 0x2c, 0xe6, 0xfe, 0x2c, 0x48, 0x66, 0x5a, 0x23, 0x55, 0xb4, 0xf8, 0x22, 0x31, 0x59, 0xcc,
-	0xaf, 0x83, 0x64, 0xa6, 0x1b, 0xb0, 0xe7, 0x3e, 0x89, 0x44, 0x83, 0xfa, 0x38, 0x66, 0x01, 0x8f,
-	0xf2, 0x19, 0xe7, 0x50, 0x98, 0x08, 0xa3, 0x70, 0x9c, 0x07, 0x25, 0x81, 0x8e, 0xa1, 0x3e, 0x08,
-	0x1e, 0x17, 0x51, 0x30, 0x41, 0x9f, 0x41, 0x6d, 0x23, 0x9d, 0xd6, 0xc5, 0x6e, 0x7e, 0x89, 0x64,
-	0x69, 0x9a, 0xad, 0x8a, 0x49, 0x8b, 0x1b, 0x93, 0xd5, 0x49, 0x9f, 0xf5, 0x1e, 0x34, 0x70, 0x78,
-	0xcf, 0x16, 0x91, 0x9c, 0xfa, 0x4a, 0x96, 0xcc, 0x2d, 0x64, 0xf0, 0x7f, 0xee, 0xcb, 0x4f, 0x25,
-	0xa8, 0xf6, 0x16, 0xd1, 0xf8, 0x07, 0x74, 0xf6, 0xc4, 0x49, 0x27, 0x77, 0x92, 0x2e, 0x3f, 0xb1,
-	0xf3, 0x6a, 0xc3, 0x4e, 0xeb, 0x62, 0x7f, 0x4b, 0x6a, 0x05, 0x3c, 0x90, 0x0e, 0xd1, 0x17, 0xd0,
-	0x58, 0x66, 0x77, 0x3d, 0x0b, 0xfc, 0x70, 0x4b, 0x9a, 0xff, 0x11, 0x68, 0x21, 0xd3, 0xa7, 0xd0,
-	0xda, 0x68, 0x88, 0x3e, 0x80, 0x5a, 0xb8, 0x5e, 0x8e, 0x32, 0x57, 0x15, 0x9a, 0x21, 0xf4, 0x09,
-	0xb4, 0x57, 0x31, 0xbb, 0x9f, 0x47, 0xeb, 0x44, 0x26, 0x25, 0x4f, 0xb6, 0x93, 0x93, 0x22, 0x2a,
-	0xf4, 0x11, 0x34, 0x45, 0x4d, 0x29, 0x50, 0x52, 0x41, 0x43, 0x10, 0x69, 0x8e, 0x2f, 0xa1, 0x59,
-	0xd8, 0x2d, 0xc6, 0x5b, 0x3a, 0x56, 0x8a, 0xf1, 0x9e, 0x41, 0x7b, 0xcb, 0x24, 0x3a, 0xda, 0x38,
-	0x8d, 0x14, 0x16, 0xf8, 0xf4, 0xf7, 0x12, 0xd4, 0x5c, 0x1e, 0xf0, 0x75, 0x82, 0x5a, 0x50, 0x1f,
-	0x92, 0x1b, 0xe2, 0x7c, 0x4b, 0xd4, 0x67, 0x68, 0x07, 0xea, 0xee, 0xd0, 0x34, 0xb1, 0xeb, 0xaa,
-	0x7f, 0x94, 0x90, 0x0a, 0xad, 0x9e, 0x61, 0xf9, 0x14, 0x7f, 0x33, 0xc4, 0xae, 0xa7, 0xfe, 0xac,
-	0xa0, 0x5d, 0x68, 0x5e, 0x3a, 0xb4, 0x67, 0x5b, 0x16, 0x26, 0xea, 0x2f, 0x29, 0x26, 0x8e, 0xe7,
-	0x5f, 0x3a, 0x43, 0x62, 0xa9, 0xbf, 0x2a, 0xe8, 0x05, 0x68, 0x99, 0xda, 0xc7, 0xc4, 0xb3, 0xbd,
-	0xef, 0x7c, 0xcf, 0x71, 0xfc, 0xbe, 0x41, 0xaf, 0xb0, 0xfa, 0x9b, 0x82, 0x8e, 0xe0, 0xd0, 0x26,
-	0x1e, 0xa6, 0xc4, 0xe8, 0xfb, 0x2e, 0xa6, 0xaf, 0x31, 0xf5, 0x31, 0xa5, 0x0e, 0x55, 0xff, 0x52,
-	0xd0, 0x01, 0xec, 0x89, 0x52, 0xf6, 0xed, 0xa0, 0x8f, 0x6f, 0x31, 0xf1, 0xb0, 0xa5, 0xfe, 0xad,
-	0x20, 0x0d, 0x3a, 0x42, 0x68, 0x9b, 0xd8, 0x1f, 0x12, 0xe3, 0xb5, 0x61, 0xf7, 0x8d, 0x5e, 0x1f,
-	0xab, 0xff, 0x28, 0xa7, 0x7f, 0x96, 0x00, 0xe4, 0xd4, 0x3d, 0xf1, 0x3f, 0x6e, 0x41, 0xfd, 0x16,
-	0xbb, 0xae, 0x71, 0x85, 0xd5, 0x67, 0x08, 0xa0, 0x66, 0x3a, 0xe4, 0xd2, 0xbe, 0x52, 0x4b, 0x68,
-	0x1f, 0xda, 0xf2, 0xd9, 0x1f, 0x0e, 0x2c, 0xc3, 0xc3, 0x6a, 0x19, 0x69, 0x70, 0x80, 0x89, 0xe5,
-	0x50, 0x17, 0x53, 0xdf, 0xa3, 0x06, 0x71, 0x0d, 0xd3, 0xb3, 0x1d, 0xa2, 0x2a, 0xe8, 0x39, 0x74,
-	0x1c, 0x6a, 0x61, 0xfa, 0x64, 0xa1, 0x82, 0x0e, 0x61, 0xdf, 0xc2, 0x7d, 0x5b, 0x38, 0x76, 0x31,
-	0xbe, 0xf1, 0x6d, 0x72, 0xe9, 0xa8, 0x55, 0x41, 0x9b, 0xd7, 0x86, 0x4d, 0x4c, 0xc7, 0xc2, 0xfe,
-	0xc0, 0x30, 0x6f, 0x44, 0xff, 0x9a, 0x68, 0x30, 0xc0, 0x98, 0xfa, 0x86, 0x75, 0x6b, 0x13, 0xdf,
-	0x19, 0x60, 0x6a, 0xa4, 0x75, 0x1a, 0x62, 0x83, 0xe7, 0xdc, 0x60, 0xb2, 0x55, 0xbe, 0x79, 0xfa,
-	0x16, 0xd0, 0x56, 0x78, 0xb6, 0x78, 0xb1, 0xa3, 0x5d, 0x00, 0xd7, 0xbe, 0x22, 0x86, 0x37, 0xa4,
-	0xd8, 0x55, 0x9f, 0xa1, 0x3d, 0x68, 0xf5, 0x0d, 0xd7, 0xf3, 0x8b, 0xb3, 0x3d, 0x87, 0xce, 0x46,
-	0x1d, 0xd7, 0xbf, 0xb4, 0xfb, 0x1e, 0xa6, 0x6a, 0x59, 0x4c, 0x23, 0x3b, 0x87, 0xaa, 0xf4, 0x5c,
-	0xf8, 0x34, 0x8a, 0xa7, 0xdd, 0xd9, 0xe3, 0x8a, 0xc5, 0x0b, 0x36, 0x99, 0xb2, 0xb8, 0x7b, 0x17,
-	0x8c, 0xe2, 0xf9, 0x58, 0xbe, 0xc6, 0x92, 0xec, 0x8e, 0xbf, 0x39, 0x9b, 0xce, 0xf9, 0x6c, 0x3d,
-	0x12, 0xf0, 0x7c, 0x43, 0x7c, 0x2e, 0xc5, 0xf2, 0x1b, 0x95, 0x64, 0xdf, 0xb1, 0x51, 0x2d, 0x85,
-	0x5f, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x63, 0x9b, 0x9f, 0x8b, 0xdf, 0x06, 0x00, 0x00,
+	0x18, 0x6d, 0xe2, 0xfc, 0xfc, 0xd2, 0xb4, 0xee, 0xa4, 0x65, 0x4d, 0x61, 0xb5, 0x95, 0x61, 0x51,
+	0x69, 0xa5, 0x54, 0x94, 0x0b, 0x1c, 0x1d, 0x7b, 0xda, 0x5a, 0x4d, 0xc7, 0x61, 0xec, 0x2c, 0x62,
+	0x17, 0xc9, 0x72, 0x93, 0x69, 0x12, 0x91, 0xd8, 0x91, 0x3d, 0xa9, 0xda, 0x33, 0x77, 0x84, 0x04,
+	0x27, 0x24, 0xfe, 0x1f, 0x04, 0xff, 0x0e, 0x88, 0x2b, 0x1a, 0x8f, 0xed, 0x4d, 0xca, 0x4a, 0x7b,
+	0x8a, 0xdf, 0x9b, 0x37, 0xdf, 0xf7, 0xe6, 0x7b, 0x13, 0x1b, 0x3a, 0xa3, 0x68, 0xb1, 0x88, 0xc2,
+	0x33, 0xf9, 0xd3, 0x5d, 0xc6, 0x11, 0x8f, 0x50, 0x4d, 0xa2, 0xc3, 0x17, 0x93, 0x28, 0x9a, 0xcc,
+	0xd9, 0x59, 0xca, 0xde, 0xae, 0xee, 0xce, 0xf8, 0x6c, 0xc1, 0x12, 0x1e, 0x2c, 0x96, 0x52, 0xa8,
+	0xeb, 0x00, 0xfd, 0x20, 0xe1, 0x66, 0x14, 0xde, 0xcd, 0x26, 0x68, 0x1f, 0xaa, 0xb3, 0x70, 0xcc,
+	0x1e, 0xb4, 0xd2, 0x51, 0xe9, 0xb8, 0x42, 0x25, 0xd0, 0xdf, 0x40, 0xe3, 0x86, 0xf1, 0x60, 0x1c,
+	0xf0, 0x40, 0x28, 0xee, 0x83, 0xf9, 0x8a, 0xa5, 0x8a, 0x6d, 0x2a, 0x01, 0xfa, 0x1a, 0x20, 0x99,
+	0x4d, 0xc2, 0x80, 0xaf, 0x62, 0x96, 0x68, 0xe5, 0x23, 0xe5, 0xb8, 0x75, 0xfe, 0x61, 0x37, 0x73,
+	0x94, 0xef, 0x75, 0x73, 0x05, 0x5d, 0x13, 0xeb, 0xdf, 0xc3, 0xde, 0xff, 0x04, 0xe8, 0x73, 0x50,
+	0x0b, 0x89, 0x3f, 0x65, 0xc1, 0x98, 0xc5, 0x59, 0xc3, 0xdd, 0x82, 0xbf, 0x4a, 0x69, 0xf4, 0x31,
+	0x34, 0x0b, 0x4a, 0x2b, 0xa7, 0x9a, 0xb7, 0x84, 0xfe, 0x1a, 0x6a, 0x99, 0xee, 0x25, 0xec, 0x8c,
+	0xa6, 0x41, 0x18, 0xb2, 0xf9, 0x66, 0xc1, 0x76, 0xc6, 0x66, 0xb2, 0x77, 0x75, 0x2e, 0xbf, 0xb3,
+	0xb3, 0xfe, 0x63, 0x19, 0xda, 0xe6, 0xc6, 0x66, 0x04, 0x15, 0xfe, 0xb8, 0x94, 0xb3, 0xa9, 0xd2,
+	0xf4, 0x19, 0x69, 0x50, 0xbf, 0x67, 0x71, 0x32, 0x8b, 0xc2, 0xb4, 0x4e, 0x95, 0xe6, 0x10, 0x7d,
+	0x05, 0xcd, 0x22, 0x0d, 0x4d, 0x39, 0x2a, 0x1d, 0xb7, 0xce, 0x0f, 0xbb, 0x32, 0xaf, 0x6e, 0x9e,
+	0x57, 0xd7, 0xcb, 0x15, 0xf4, 0xad, 0x18, 0x3d, 0x07, 0xc8, 0xcf, 0x32, 0x1b, 0x6b, 0x95, 0xa3,
+	0xd2, 0x71, 0x93, 0x36, 0x33, 0xc6, 0x1e, 0xa3, 0x0e, 0x54, 0xf9, 0x83, 0x58, 0xa9, 0xa6, 0x2b,
+	0x15, 0xfe, 0x60, 0x8f, 0x45, 0x70, 0x6c, 0x19, 0x8d, 0xa6, 0x5a, 0x4d, 0x46, 0x9b, 0x02, 0x31,
+	0x3d, 0xf6, 0xc0, 0x59, 0x98, 0xfa, 0xab, 0xcb, 0xe9, 0x15, 0x04, 0xd2, 0xa1, 0xcd, 0xe7, 0x89,
+	0x3f, 0x62, 0x31, 0xf7, 0xa7, 0x41, 0x32, 0xd5, 0x1a, 0xa9, 0xa2, 0xc5, 0xe7, 0x89, 0xc9, 0x62,
+	0x7e, 0x15, 0x24, 0x53, 0xdd, 0x80, 0x5d, 0xf7, 0x49, 0x24, 0x1a, 0xd4, 0x47, 0x31, 0x0b, 0x78,
+	0x94, 0xcf, 0x38, 0x87, 0xc2, 0x44, 0x18, 0x85, 0xa3, 0x3c, 0x28, 0x09, 0x74, 0x0c, 0xf5, 0x41,
+	0xf0, 0x38, 0x8f, 0x82, 0x31, 0xfa, 0x0c, 0x6a, 0x6b, 0xe9, 0xb4, 0xce, 0x77, 0xf2, 0x4b, 0x24,
+	0x4b, 0xd3, 0xda, 0xb4, 0x98, 0xb4, 0xb8, 0x31, 0x59, 0x9d, 0xf4, 0x59, 0xef, 0x41, 0x03, 0x87,
+	0xf7, 0x6c, 0x1e, 0xc9, 0xa9, 0x2f, 0x65, 0xc9, 0xdc, 0x42, 0x06, 0xdf, 0x73, 0x5f, 0x7e, 0x2a,
+	0x41, 0xb5, 0x37, 0x8f, 0x46, 0x3f, 0xa0, 0xd3, 0x27, 0x4e, 0x3a, 0xb9, 0x93, 0x74, 0xf9, 0x89,
+	0x9d, 0x97, 0x6b, 0x76, 0x5a, 0xe7, 0x7b, 0x1b, 0x52, 0x2b, 0xe0, 0x81, 0x74, 0x88, 0xbe, 0x80,
+	0xc6, 0x22, 0xbb, 0xeb, 0x59, 0xe0, 0x07, 0x1b, 0xd2, 0xfc, 0x8f, 0x40, 0x0b, 0x99, 0x3e, 0x81,
+	0xd6, 0x5a, 0x43, 0xf4, 0x01, 0xd4, 0xc2, 0xd5, 0xe2, 0x36, 0x73, 0x55, 0xa1, 0x19, 0x42, 0x9f,
+	0x40, 0x7b, 0x19, 0xb3, 0xfb, 0x59, 0xb4, 0x4a, 0x64, 0x52, 0xf2, 0x64, 0xdb, 0x39, 0x29, 0xa2,
+	0x42, 0x1f, 0x41, 0x53, 0xd4, 0x94, 0x02, 0x25, 0x15, 0x34, 0x04, 0x91, 0xe6, 0xf8, 0x02, 0x9a,
+	0x85, 0xdd, 0x62, 0xbc, 0xa5, 0x23, 0xa5, 0x18, 0xef, 0x29, 0xb4, 0x37, 0x4c, 0xa2, 0xc3, 0xb5,
+	0xd3, 0x48, 0x61, 0x81, 0x4f, 0xfe, 0x2a, 0x41, 0xcd, 0xe5, 0x01, 0x5f, 0x25, 0xa8, 0x05, 0xf5,
+	0x21, 0xb9, 0x26, 0xce, 0xb7, 0x44, 0xdd, 0x42, 0xdb, 0x50, 0x77, 0x87, 0xa6, 0x89, 0x5d, 0x57,
+	0xfd, 0xa3, 0x84, 0x54, 0x68, 0xf5, 0x0c, 0xcb, 0xa7, 0xf8, 0x9b, 0x21, 0x76, 0x3d, 0xf5, 0x67,
+	0x05, 0xed, 0x40, 0xf3, 0xc2, 0xa1, 0x3d, 0xdb, 0xb2, 0x30, 0x51, 0x7f, 0x49, 0x31, 0x71, 0x3c,
+	0xff, 0xc2, 0x19, 0x12, 0x4b, 0xfd, 0x55, 0x41, 0x4d, 0xa8, 0x5c, 0x3a, 0x04, 0xab, 0xbf, 0x29,
+	0xe8, 0x39, 0x68, 0xd9, 0x46, 0x1f, 0x13, 0xcf, 0xf6, 0xbe, 0xf3, 0x3d, 0xc7, 0xf1, 0xfb, 0x06,
+	0xbd, 0xc4, 0xea, 0xef, 0x0a, 0x3a, 0x84, 0x03, 0x9b, 0x78, 0x98, 0x12, 0xa3, 0xef, 0xbb, 0x98,
+	0xbe, 0xc2, 0xd4, 0xc7, 0x94, 0x3a, 0x54, 0xfd, 0x5b, 0x41, 0xfb, 0xb0, 0x2b, 0xaa, 0xda, 0x37,
+	0x83, 0x3e, 0xbe, 0xc1, 0xc4, 0xc3, 0x96, 0xfa, 0x8f, 0x82, 0x34, 0xe8, 0x08, 0xa1, 0x6d, 0x62,
+	0x7f, 0x48, 0x8c, 0x57, 0x86, 0xdd, 0x37, 0x7a, 0x7d, 0xac, 0xfe, 0xab, 0x9c, 0xfc, 0x59, 0x02,
+	0x90, 0x01, 0x78, 0xe2, 0x2f, 0xdd, 0x82, 0xfa, 0x0d, 0x76, 0x5d, 0xe3, 0x12, 0xab, 0x5b, 0x08,
+	0xa0, 0x66, 0x3a, 0xe4, 0xc2, 0xbe, 0x54, 0x4b, 0x68, 0x0f, 0xda, 0xf2, 0xd9, 0x1f, 0x0e, 0x2c,
+	0xc3, 0xc3, 0x6a, 0x19, 0x69, 0xb0, 0x8f, 0x89, 0xe5, 0x50, 0x17, 0x53, 0xdf, 0xa3, 0x06, 0x71,
+	0x0d, 0xd3, 0xb3, 0x1d, 0xa2, 0x2a, 0xe8, 0x19, 0x74, 0x1c, 0x6a, 0x61, 0xfa, 0x64, 0xa1, 0x82,
+	0x0e, 0x60, 0xcf, 0xc2, 0x7d, 0x5b, 0x38, 0x76, 0x31, 0xbe, 0xf6, 0x6d, 0x72, 0xe1, 0xa8, 0x55,
+	0x41, 0x9b, 0x57, 0x86, 0x4d, 0x4c, 0xc7, 0xc2, 0xfe, 0xc0, 0x30, 0xaf, 0x45, 0xff, 0x9a, 0x68,
+	0x30, 0xc0, 0x98, 0xfa, 0x86, 0x75, 0x63, 0x13, 0xdf, 0x19, 0x60, 0x6a, 0xa4, 0x75, 0x1a, 0x62,
+	0x83, 0xe7, 0x5c, 0x63, 0xb2, 0x51, 0xbe, 0x79, 0xf2, 0x06, 0xd0, 0x46, 0x8e, 0xb6, 0x78, 0xc7,
+	0xa3, 0x1d, 0x00, 0xd7, 0xbe, 0x24, 0x86, 0x37, 0xa4, 0xd8, 0x55, 0xb7, 0xd0, 0x2e, 0xb4, 0xfa,
+	0x86, 0xeb, 0xf9, 0xc5, 0xd9, 0x9e, 0x41, 0x67, 0xad, 0x8e, 0xeb, 0x5f, 0xd8, 0x7d, 0x0f, 0x53,
+	0xb5, 0x2c, 0xa6, 0x91, 0x9d, 0x43, 0x55, 0x7a, 0x2e, 0x7c, 0x1a, 0xc5, 0x93, 0xee, 0xf4, 0x71,
+	0xc9, 0xe2, 0x39, 0x1b, 0x4f, 0x58, 0xdc, 0xbd, 0x0b, 0x6e, 0xe3, 0xd9, 0x48, 0xbe, 0xd1, 0x92,
+	0xec, 0xba, 0xbf, 0x3e, 0x9d, 0xcc, 0xf8, 0x74, 0x75, 0x2b, 0xe0, 0xd9, 0x9a, 0xf8, 0x4c, 0x8a,
+	0xe5, 0xe7, 0x2a, 0xc9, 0x3e, 0x69, 0xb7, 0xb5, 0x14, 0x7e, 0xf9, 0xdf, 0x00, 0xfa, 0xef, 0x04,
+	0x90, 0xea, 0x06, 0x00, 0x00,
 }
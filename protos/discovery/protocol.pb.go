@@ -232,6 +232,7 @@ type Query struct {
 	//	*Query_PeerQuery
 	//	*Query_CcQuery
 	//	*Query_LocalPeers
+	//	*Query_OrdererQuery
 	Query                isQuery_Query `protobuf_oneof:"query"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
@@ -289,6 +290,10 @@ type Query_LocalPeers struct {
 	LocalPeers *LocalPeerQuery `protobuf:"bytes,5,opt,name=local_peers,json=localPeers,proto3,oneof"`
 }
 
+type Query_OrdererQuery struct {
+	OrdererQuery *OrdererQuery `protobuf:"bytes,6,opt,name=orderer_query,json=ordererQuery,proto3,oneof"`
+}
+
 func (*Query_ConfigQuery) isQuery_Query() {}
 
 func (*Query_PeerQuery) isQuery_Query() {}
@@ -297,6 +302,8 @@ func (*Query_CcQuery) isQuery_Query() {}
 
 func (*Query_LocalPeers) isQuery_Query() {}
 
+func (*Query_OrdererQuery) isQuery_Query() {}
+
 func (m *Query) GetQuery() isQuery_Query {
 	if m != nil {
 		return m.Query
@@ -332,6 +339,13 @@ func (m *Query) GetLocalPeers() *LocalPeerQuery {
 	return nil
 }
 
+func (m *Query) GetOrdererQuery() *OrdererQuery {
+	if x, ok := m.GetQuery().(*Query_OrdererQuery); ok {
+		return x.OrdererQuery
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Query) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Query_OneofMarshaler, _Query_OneofUnmarshaler, _Query_OneofSizer, []interface{}{
@@ -339,6 +353,7 @@ func (*Query) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error,
 		(*Query_PeerQuery)(nil),
 		(*Query_CcQuery)(nil),
 		(*Query_LocalPeers)(nil),
+		(*Query_OrdererQuery)(nil),
 	}
 }
 
@@ -366,6 +381,11 @@ func _Query_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.LocalPeers); err != nil {
 			return err
 		}
+	case *Query_OrdererQuery:
+		b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.OrdererQuery); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Query.Query has unexpected type %T", x)
@@ -408,6 +428,14 @@ func _Query_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer)
 		err := b.DecodeMessage(msg)
 		m.Query = &Query_LocalPeers{msg}
 		return true, err
+	case 6: // query.orderer_query
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(OrdererQuery)
+		err := b.DecodeMessage(msg)
+		m.Query = &Query_OrdererQuery{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -437,6 +465,11 @@ func _Query_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Query_OrdererQuery:
+		s := proto.Size(x.OrdererQuery)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -454,6 +487,7 @@ type QueryResult struct {
 	//	*QueryResult_ConfigResult
 	//	*QueryResult_CcQueryRes
 	//	*QueryResult_Members
+	//	*QueryResult_OrdererResult
 	Result               isQueryResult_Result `protobuf_oneof:"result"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
@@ -504,6 +538,10 @@ type QueryResult_Members struct {
 	Members *PeerMembershipResult `protobuf:"bytes,4,opt,name=members,proto3,oneof"`
 }
 
+type QueryResult_OrdererResult struct {
+	OrdererResult *OrdererResult `protobuf:"bytes,5,opt,name=orderer_result,json=ordererResult,proto3,oneof"`
+}
+
 func (*QueryResult_Error) isQueryResult_Result() {}
 
 func (*QueryResult_ConfigResult) isQueryResult_Result() {}
@@ -512,6 +550,8 @@ func (*QueryResult_CcQueryRes) isQueryResult_Result() {}
 
 func (*QueryResult_Members) isQueryResult_Result() {}
 
+func (*QueryResult_OrdererResult) isQueryResult_Result() {}
+
 func (m *QueryResult) GetResult() isQueryResult_Result {
 	if m != nil {
 		return m.Result
@@ -547,6 +587,13 @@ func (m *QueryResult) GetMembers() *PeerMembershipResult {
 	return nil
 }
 
+func (m *QueryResult) GetOrdererResult() *OrdererResult {
+	if x, ok := m.GetResult().(*QueryResult_OrdererResult); ok {
+		return x.OrdererResult
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*QueryResult) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _QueryResult_OneofMarshaler, _QueryResult_OneofUnmarshaler, _QueryResult_OneofSizer, []interface{}{
@@ -554,6 +601,7 @@ func (*QueryResult) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) e
 		(*QueryResult_ConfigResult)(nil),
 		(*QueryResult_CcQueryRes)(nil),
 		(*QueryResult_Members)(nil),
+		(*QueryResult_OrdererResult)(nil),
 	}
 }
 
@@ -581,6 +629,11 @@ func _QueryResult_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Members); err != nil {
 			return err
 		}
+	case *QueryResult_OrdererResult:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.OrdererResult); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("QueryResult.Result has unexpected type %T", x)
@@ -623,6 +676,14 @@ func _QueryResult_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Bu
 		err := b.DecodeMessage(msg)
 		m.Result = &QueryResult_Members{msg}
 		return true, err
+	case 5: // result.orderer_result
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(OrdererResult)
+		err := b.DecodeMessage(msg)
+		m.Result = &QueryResult_OrdererResult{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -652,6 +713,11 @@ func _QueryResult_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *QueryResult_OrdererResult:
+		s := proto.Size(x.OrdererResult)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -993,6 +1059,10 @@ func (m *ChaincodeQueryResult) GetContent() []*EndorsementDescriptor {
 
 // LocalPeerQuery queries for peers in a non channel context
 type LocalPeerQuery struct {
+	// include_chaincodes requests the StateInfo messages of the peers for
+	// the channels they joined, which contain the chaincodes of the peers
+	// for each channel
+	IncludeChaincodes    bool     `protobuf:"varint,1,opt,name=include_chaincodes,json=includeChaincodes,proto3" json:"include_chaincodes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1022,6 +1092,13 @@ func (m *LocalPeerQuery) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LocalPeerQuery proto.InternalMessageInfo
 
+func (m *LocalPeerQuery) GetIncludeChaincodes() bool {
+	if m != nil {
+		return m.IncludeChaincodes
+	}
+	return false
+}
+
 // EndorsementDescriptor contains information about which peers can be used
 // to request endorsement from, such that the endorsement policy would be fulfilled.
 // Here is how to compute a set of peers to ask an endorsement from, given an EndorsementDescriptor:
@@ -1181,10 +1258,15 @@ type Peer struct {
 	// This is an Envelope of a GossipMessage with a gossip.AliveMessage message
 	MembershipInfo *gossip.Envelope `protobuf:"bytes,2,opt,name=membership_info,json=membershipInfo,proto3" json:"membership_info,omitempty"`
 	// This is the msp.SerializedIdentity of the peer, represented in bytes.
-	Identity             []byte   `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Identity []byte `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	// channel_state_infos maps the channels the peer joined to Envelopes of
+	// GossipMessages with its gossip.StateInfo message for the channel.
+	// It is only populated in the response of a LocalPeerQuery that includes
+	// chaincodes, for the channels the client is eligible for.
+	ChannelStateInfos    map[string]*gossip.Envelope `protobuf:"bytes,4,rep,name=channel_state_infos,json=channelStateInfos,proto3" json:"channel_state_infos,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
 func (m *Peer) Reset()         { *m = Peer{} }
@@ -1232,6 +1314,13 @@ func (m *Peer) GetIdentity() []byte {
 	return nil
 }
 
+func (m *Peer) GetChannelStateInfos() map[string]*gossip.Envelope {
+	if m != nil {
+		return m.ChannelStateInfos
+	}
+	return nil
+}
+
 // Error denotes that something went wrong and contains the error message
 type Error struct {
 	Content              string   `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
@@ -1357,6 +1446,98 @@ func (m *Endpoint) GetPort() uint32 {
 	return 0
 }
 
+// OrdererQuery requests an OrdererResult
+type OrdererQuery struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrdererQuery) Reset()         { *m = OrdererQuery{} }
+func (m *OrdererQuery) String() string { return proto.CompactTextString(m) }
+func (*OrdererQuery) ProtoMessage()    {}
+func (*OrdererQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_protocol_b2f93a2b7b5bdad4, []int{22}
+}
+func (m *OrdererQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrdererQuery.Unmarshal(m, b)
+}
+func (m *OrdererQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrdererQuery.Marshal(b, m, deterministic)
+}
+func (dst *OrdererQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrdererQuery.Merge(dst, src)
+}
+func (m *OrdererQuery) XXX_Size() int {
+	return xxx_messageInfo_OrdererQuery.Size(m)
+}
+func (m *OrdererQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrdererQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrdererQuery proto.InternalMessageInfo
+
+// OrdererResult contains the ordering service endpoints of a channel,
+// and the consensus type and metadata of its ordering service
+type OrdererResult struct {
+	// orderers is a map from MSP_ID to endpoint lists of orderers
+	Orderers map[string]*Endpoints `protobuf:"bytes,1,rep,name=orderers,proto3" json:"orderers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// consensus_type is the type of the consensus of the ordering service,
+	// such as solo, kafka or etcdraft
+	ConsensusType string `protobuf:"bytes,2,opt,name=consensus_type,json=consensusType,proto3" json:"consensus_type,omitempty"`
+	// consensus_metadata is the consensus type specific metadata,
+	// such as the consenters of an etcdraft ordering service
+	ConsensusMetadata    []byte   `protobuf:"bytes,3,opt,name=consensus_metadata,json=consensusMetadata,proto3" json:"consensus_metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrdererResult) Reset()         { *m = OrdererResult{} }
+func (m *OrdererResult) String() string { return proto.CompactTextString(m) }
+func (*OrdererResult) ProtoMessage()    {}
+func (*OrdererResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_protocol_b2f93a2b7b5bdad4, []int{23}
+}
+func (m *OrdererResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrdererResult.Unmarshal(m, b)
+}
+func (m *OrdererResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrdererResult.Marshal(b, m, deterministic)
+}
+func (dst *OrdererResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrdererResult.Merge(dst, src)
+}
+func (m *OrdererResult) XXX_Size() int {
+	return xxx_messageInfo_OrdererResult.Size(m)
+}
+func (m *OrdererResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrdererResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrdererResult proto.InternalMessageInfo
+
+func (m *OrdererResult) GetOrderers() map[string]*Endpoints {
+	if m != nil {
+		return m.Orderers
+	}
+	return nil
+}
+
+func (m *OrdererResult) GetConsensusType() string {
+	if m != nil {
+		return m.ConsensusType
+	}
+	return ""
+}
+
+func (m *OrdererResult) GetConsensusMetadata() []byte {
+	if m != nil {
+		return m.ConsensusMetadata
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SignedRequest)(nil), "discovery.SignedRequest")
 	proto.RegisterType((*Request)(nil), "discovery.Request")
@@ -1382,9 +1563,13 @@ func init() {
 	proto.RegisterMapType((map[string]uint32)(nil), "discovery.Layout.QuantitiesByGroupEntry")
 	proto.RegisterType((*Peers)(nil), "discovery.Peers")
 	proto.RegisterType((*Peer)(nil), "discovery.Peer")
+	proto.RegisterMapType((map[string]*gossip.Envelope)(nil), "discovery.Peer.ChannelStateInfosEntry")
 	proto.RegisterType((*Error)(nil), "discovery.Error")
 	proto.RegisterType((*Endpoints)(nil), "discovery.Endpoints")
 	proto.RegisterType((*Endpoint)(nil), "discovery.Endpoint")
+	proto.RegisterType((*OrdererQuery)(nil), "discovery.OrdererQuery")
+	proto.RegisterType((*OrdererResult)(nil), "discovery.OrdererResult")
+	proto.RegisterMapType((map[string]*Endpoints)(nil), "discovery.OrdererResult.OrderersEntry")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1464,77 +1649,87 @@ var _Discovery_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("discovery/protocol.proto", fileDescriptor_protocol_b2f93a2b7b5bdad4) }
 
 var fileDescriptor_protocol_b2f93a2b7b5bdad4 = []byte{
-	// 1145 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x5b, 0x6f, 0xe3, 0x44,
-	0x14, 0x6e, 0xd2, 0xa6, 0x49, 0x4e, 0x9a, 0x5e, 0xa6, 0x61, 0x09, 0xd1, 0x8a, 0xdd, 0xb5, 0xb4,
-	0x50, 0x16, 0xc9, 0x59, 0x95, 0xdb, 0xd2, 0x56, 0xa0, 0xed, 0x85, 0x4d, 0xc5, 0x76, 0xdb, 0xce,
-	0x22, 0x84, 0x78, 0x89, 0x5c, 0xe7, 0x34, 0xb1, 0x70, 0x3c, 0xee, 0xcc, 0xb8, 0x92, 0x9f, 0x79,
-	0xe7, 0x27, 0xf0, 0xc2, 0x0b, 0xe2, 0x27, 0xf0, 0xeb, 0x90, 0xe7, 0xe2, 0x38, 0xa9, 0xcb, 0x22,
-	0xf1, 0xe6, 0x39, 0xe7, 0x7c, 0xe7, 0xf2, 0x9d, 0xe3, 0x99, 0x03, 0xdd, 0x51, 0x20, 0x7c, 0x76,
-	0x8b, 0x3c, 0xed, 0xc7, 0x9c, 0x49, 0xe6, 0xb3, 0xd0, 0x55, 0x1f, 0xa4, 0x99, 0x6b, 0x7a, 0x9d,
-	0x31, 0x13, 0x22, 0x88, 0xfb, 0x53, 0x14, 0xc2, 0x1b, 0xa3, 0x36, 0xe8, 0x75, 0xa6, 0x22, 0xee,
-	0x4f, 0x45, 0x3c, 0xf4, 0x59, 0x74, 0x1d, 0x8c, 0x8b, 0xd2, 0x60, 0x84, 0x91, 0x0c, 0x64, 0x80,
-	0x42, 0x4b, 0x9d, 0x57, 0xd0, 0x7e, 0x1b, 0x8c, 0x23, 0x1c, 0x51, 0xbc, 0x49, 0x50, 0x48, 0xd2,
-	0x85, 0x7a, 0xec, 0xa5, 0x21, 0xf3, 0x46, 0xdd, 0xca, 0xe3, 0xca, 0xce, 0x1a, 0xb5, 0x47, 0xf2,
-	0x10, 0x9a, 0x22, 0x18, 0x47, 0x9e, 0x4c, 0x38, 0x76, 0xab, 0x4a, 0x37, 0x13, 0x38, 0x1c, 0xea,
-	0xd6, 0xc5, 0x3e, 0xac, 0x7b, 0x89, 0x9c, 0x64, 0x91, 0x7c, 0x4f, 0x06, 0x2c, 0x52, 0x9e, 0x5a,
-	0xbb, 0xdb, 0x6e, 0x9e, 0xb9, 0xfb, 0x32, 0x91, 0x93, 0xd3, 0xe8, 0x9a, 0xd1, 0x05, 0x53, 0xf2,
-	0x0c, 0xea, 0x37, 0x09, 0xf2, 0x00, 0x45, 0xb7, 0xfa, 0x78, 0x79, 0xa7, 0xb5, 0xbb, 0x59, 0x40,
-	0x5d, 0x26, 0xc8, 0x53, 0x6a, 0x0d, 0x9c, 0x03, 0x68, 0x50, 0x14, 0x31, 0x8b, 0x04, 0x92, 0xe7,
-	0x50, 0xe7, 0x28, 0x92, 0x50, 0x8a, 0x6e, 0x45, 0xe1, 0x1e, 0xdc, 0xc1, 0x29, 0x35, 0xb5, 0x66,
-	0xce, 0x08, 0x1a, 0x36, 0x0b, 0xf2, 0x31, 0x6c, 0xf8, 0x61, 0x80, 0x91, 0x1c, 0x1a, 0x86, 0x52,
-	0x53, 0xfd, 0xba, 0x16, 0x9f, 0x1a, 0x29, 0xe9, 0x43, 0xc7, 0x18, 0xca, 0x50, 0x0c, 0x7d, 0xe4,
-	0x72, 0x38, 0xf1, 0xc4, 0xc4, 0xf0, 0xb1, 0xa5, 0x75, 0x3f, 0x84, 0xe2, 0x08, 0xb9, 0x1c, 0x78,
-	0x62, 0xe2, 0xfc, 0x5e, 0x85, 0x9a, 0x0a, 0x9f, 0x31, 0xeb, 0x4f, 0xbc, 0x28, 0xc2, 0x50, 0xf9,
-	0x6e, 0x52, 0x7b, 0x24, 0xfb, 0xb0, 0xa6, 0x5b, 0x35, 0xcc, 0x2a, 0x4b, 0x95, 0xb3, 0xf9, 0x02,
-	0x8e, 0x94, 0x5a, 0xf9, 0x19, 0x2c, 0xd1, 0x96, 0x3f, 0x3b, 0x92, 0x6f, 0x01, 0x62, 0x44, 0x6e,
-	0xa0, 0xcb, 0x0a, 0xfa, 0x61, 0x01, 0x7a, 0x81, 0xc8, 0xcf, 0x70, 0x7a, 0x85, 0x5c, 0x4c, 0x82,
-	0xd8, 0xba, 0x68, 0x66, 0x18, 0xed, 0xe0, 0x4b, 0x68, 0xf8, 0xbe, 0x81, 0xaf, 0x28, 0xf8, 0x07,
-	0xc5, 0xc8, 0x13, 0x2f, 0x88, 0x7c, 0x36, 0x42, 0x8b, 0xac, 0xfb, 0xbe, 0xc6, 0x1d, 0x40, 0x2b,
-	0x64, 0xbe, 0x17, 0x0e, 0x33, 0x57, 0xa2, 0x5b, 0xbb, 0x03, 0x7d, 0x9d, 0x69, 0x2f, 0x6c, 0x9c,
-	0xc1, 0x12, 0x85, 0xd0, 0x4a, 0xc4, 0x61, 0x1d, 0x6a, 0x2a, 0xa4, 0xf3, 0x6b, 0x15, 0x5a, 0x85,
-	0xfe, 0x90, 0x1d, 0xa8, 0x21, 0xe7, 0x8c, 0x9b, 0xa1, 0x29, 0xb6, 0xff, 0x24, 0x93, 0x0f, 0x96,
-	0xa8, 0x36, 0x20, 0xdf, 0x40, 0xdb, 0xd0, 0xa6, 0x5b, 0x6a, 0x78, 0x7b, 0xff, 0x0e, 0x6f, 0xda,
-	0xf3, 0x60, 0x89, 0x1a, 0x9a, 0x4d, 0xa4, 0x23, 0x58, 0xb3, 0x85, 0x67, 0x1e, 0x0c, 0x77, 0x8f,
-	0xee, 0x2d, 0x3e, 0x77, 0x03, 0x86, 0x02, 0x8a, 0x82, 0xec, 0x43, 0x7d, 0xaa, 0xd9, 0x35, 0xe4,
-	0x3d, 0xba, 0x97, 0xfb, 0x1c, 0x6f, 0x11, 0x87, 0x0d, 0x58, 0xd5, 0xa9, 0x3b, 0x6d, 0x68, 0x15,
-	0x7a, 0xec, 0xfc, 0x55, 0x85, 0xb5, 0x62, 0xee, 0xe4, 0x0b, 0x58, 0x99, 0x8a, 0xd8, 0xce, 0xf6,
-	0x93, 0x7b, 0x4a, 0x74, 0xcf, 0x44, 0x2c, 0x4e, 0x22, 0xc9, 0x53, 0xaa, 0xcc, 0xc9, 0x4b, 0x68,
-	0x30, 0x3e, 0x42, 0x9e, 0xa5, 0xa7, 0x7f, 0xa7, 0xa7, 0xf7, 0x41, 0xcf, 0x8d, 0x9d, 0x86, 0xe7,
-	0xb0, 0xde, 0x19, 0x34, 0x73, 0xaf, 0x64, 0x13, 0x96, 0x7f, 0xc1, 0xd4, 0xcc, 0x6f, 0xf6, 0x49,
-	0x9e, 0x41, 0xed, 0xd6, 0x0b, 0x13, 0x34, 0xe4, 0x77, 0xdc, 0xa9, 0x88, 0xdd, 0xef, 0xbc, 0x2b,
-	0x1e, 0xf8, 0x67, 0x6f, 0x2f, 0x4c, 0x04, 0x6d, 0xb2, 0x57, 0x7d, 0x51, 0xe9, 0x5d, 0x42, 0x7b,
-	0x2e, 0xd2, 0x7f, 0x71, 0x59, 0x98, 0x80, 0x68, 0x14, 0xb3, 0x20, 0x92, 0xa2, 0xe0, 0xd2, 0xf9,
-	0x1e, 0xb6, 0x4b, 0x86, 0x9c, 0x7c, 0x0e, 0xab, 0xd7, 0x41, 0x28, 0xd1, 0x4e, 0xd2, 0xc3, 0xb2,
-	0xc6, 0x9e, 0x46, 0x12, 0x39, 0x0a, 0x49, 0x8d, 0xad, 0xf3, 0x77, 0x05, 0x3a, 0x65, 0x6d, 0x23,
-	0x97, 0xb0, 0xa6, 0x06, 0x7d, 0x78, 0x95, 0x0e, 0x19, 0x1f, 0x9b, 0x4e, 0xf4, 0xdf, 0xd1, 0x6d,
-	0x57, 0x4f, 0x7b, 0x7a, 0xce, 0xc7, 0x9a, 0x58, 0xf5, 0xb3, 0x6a, 0x41, 0xef, 0x1c, 0x36, 0x16,
-	0xd4, 0x25, 0x6c, 0x7c, 0x34, 0xcf, 0xc6, 0xe6, 0x42, 0xc0, 0x39, 0x26, 0x5e, 0xc3, 0xfa, 0xfc,
-	0xc8, 0x92, 0x3d, 0x68, 0x06, 0xa6, 0x44, 0x3b, 0x3c, 0xff, 0xce, 0xc3, 0xcc, 0xdc, 0x39, 0x83,
-	0xad, 0x3b, 0x7a, 0xf2, 0x02, 0xc0, 0xb7, 0x42, 0xeb, 0xb1, 0x5b, 0xe6, 0xf1, 0xc8, 0x0b, 0x43,
-	0x5a, 0xb0, 0x75, 0xde, 0x40, 0x7b, 0x4e, 0x49, 0x08, 0xac, 0x44, 0xde, 0x14, 0x4d, 0xb1, 0xea,
-	0x9b, 0x7c, 0x02, 0x9b, 0x3e, 0x0b, 0x43, 0xf4, 0xb3, 0xc7, 0x60, 0x98, 0x89, 0xf4, 0xe0, 0x36,
-	0xe9, 0xc6, 0x4c, 0xfe, 0x26, 0x13, 0x3b, 0x14, 0x3a, 0x65, 0xff, 0x27, 0xd9, 0x83, 0xba, 0xcf,
-	0x22, 0x89, 0x91, 0x34, 0xe9, 0x3d, 0x9e, 0x1f, 0x20, 0xc6, 0x05, 0x4e, 0x31, 0x92, 0xc7, 0x28,
-	0x7c, 0x1e, 0xc4, 0x92, 0x71, 0x6a, 0x01, 0xce, 0x26, 0xac, 0xcf, 0xdf, 0x5a, 0xce, 0x1f, 0x55,
-	0x78, 0xaf, 0x14, 0x94, 0xbd, 0x87, 0x79, 0x75, 0xa6, 0x86, 0x99, 0x80, 0x8c, 0x61, 0x1b, 0x35,
-	0x4c, 0x8f, 0xcc, 0x98, 0xb3, 0x24, 0xb6, 0x3f, 0xe1, 0x57, 0xef, 0xca, 0xc8, 0x4a, 0xb3, 0xd9,
-	0x78, 0xa5, 0x90, 0x7a, 0x7a, 0xb6, 0x70, 0x51, 0x4e, 0x3e, 0x85, 0x7a, 0xe8, 0xa5, 0x2c, 0x91,
-	0xd9, 0x05, 0x96, 0x39, 0xdf, 0x2a, 0x5e, 0xc1, 0x4a, 0x43, 0xad, 0x45, 0xef, 0x47, 0x78, 0x50,
-	0xee, 0xf9, 0x7f, 0x0e, 0xde, 0x9f, 0x15, 0x58, 0xd5, 0xb1, 0xc8, 0x4f, 0xb0, 0x7d, 0x93, 0x78,
-	0x66, 0xcb, 0xc8, 0x2b, 0x37, 0xad, 0xd8, 0xb9, 0x93, 0x9b, 0x7b, 0x99, 0x1b, 0x9b, 0x84, 0x4c,
-	0xa5, 0x37, 0x8b, 0xf2, 0xde, 0x31, 0x3c, 0x28, 0x37, 0x2e, 0x49, 0xbe, 0x53, 0x4c, 0xbe, 0x5d,
-	0x4c, 0xd5, 0x85, 0x9a, 0x4a, 0x9f, 0x3c, 0x85, 0x9a, 0x7e, 0xb9, 0x74, 0x6a, 0x1b, 0x0b, 0xf5,
-	0x51, 0xad, 0x75, 0x7e, 0xab, 0xc0, 0x4a, 0x76, 0x26, 0x7d, 0x00, 0x21, 0x3d, 0x89, 0xc3, 0x20,
-	0xba, 0x66, 0xf9, 0xeb, 0xa4, 0x37, 0x30, 0xf7, 0x24, 0xba, 0xc5, 0x90, 0xc5, 0x48, 0x9b, 0xca,
-	0x46, 0x2d, 0x15, 0x5f, 0xc3, 0xc6, 0x34, 0xbf, 0x0e, 0x34, 0xaa, 0x7a, 0x0f, 0x6a, 0x7d, 0x66,
-	0xa8, 0xa0, 0x3d, 0x68, 0xe4, 0x8b, 0xc8, 0xb2, 0x5a, 0x2d, 0xf2, 0xb3, 0xf3, 0x04, 0x6a, 0xea,
-	0x21, 0x54, 0x0b, 0x45, 0x3e, 0xe8, 0x7a, 0xa1, 0x30, 0x63, 0x7c, 0x00, 0xcd, 0xfc, 0xa6, 0x24,
-	0x7d, 0x68, 0xa0, 0x39, 0x98, 0x52, 0xb7, 0x4b, 0x6e, 0x54, 0x9a, 0x1b, 0x39, 0xbb, 0xd0, 0xb0,
-	0xd2, 0xec, 0x1f, 0x9d, 0x30, 0x61, 0x03, 0xa8, 0xef, 0x4c, 0x16, 0x33, 0x2e, 0x0d, 0xb5, 0xea,
-	0x7b, 0x77, 0x00, 0xcd, 0x63, 0xeb, 0x93, 0xec, 0x43, 0xc3, 0x1e, 0x48, 0xf1, 0x6e, 0x98, 0xdb,
-	0x34, 0x7b, 0xc5, 0x2c, 0xec, 0x1a, 0xe7, 0x2c, 0x1d, 0x3e, 0xff, 0xd9, 0x1d, 0x07, 0x72, 0x92,
-	0x5c, 0xb9, 0x3e, 0x9b, 0xf6, 0x27, 0x69, 0x8c, 0x3c, 0xc4, 0xd1, 0x18, 0x79, 0xff, 0x5a, 0xbd,
-	0x2a, 0x7a, 0x1d, 0x16, 0xfd, 0x1c, 0x7c, 0xb5, 0xaa, 0x24, 0x9f, 0xfd, 0x13, 0x00, 0x00, 0xff,
-	0xff, 0x32, 0x94, 0x32, 0xb7, 0x33, 0x0b, 0x00, 0x00,
+	// 1307 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x6e, 0xdb, 0xc6,
+	0x12, 0xb6, 0x64, 0xcb, 0x92, 0xc6, 0x92, 0x6c, 0xaf, 0x75, 0x72, 0x74, 0x84, 0xe0, 0x9c, 0x84,
+	0x40, 0x72, 0xdc, 0x14, 0x95, 0x02, 0xf7, 0x2f, 0x8d, 0x83, 0x06, 0xb1, 0x93, 0xc6, 0x41, 0xe3,
+	0x24, 0x66, 0x82, 0xa0, 0xe8, 0x0d, 0x41, 0xaf, 0xc6, 0x12, 0x51, 0x8a, 0x4b, 0xef, 0x2e, 0x03,
+	0xf0, 0x79, 0x7a, 0x53, 0xf4, 0x11, 0x7a, 0xd1, 0xcb, 0x3e, 0x46, 0x9f, 0xa4, 0x17, 0xc5, 0xfe,
+	0x51, 0xd4, 0x8f, 0x9b, 0x00, 0x45, 0xef, 0xb8, 0xdf, 0xcc, 0x37, 0x3b, 0x7f, 0xbb, 0xb3, 0x84,
+	0xde, 0x28, 0x12, 0x94, 0xbd, 0x43, 0x9e, 0x0f, 0x53, 0xce, 0x24, 0xa3, 0x2c, 0x1e, 0xe8, 0x0f,
+	0xd2, 0x2c, 0x24, 0xfd, 0xee, 0x98, 0x09, 0x11, 0xa5, 0xc3, 0x29, 0x0a, 0x11, 0x8e, 0xd1, 0x28,
+	0xf4, 0xbb, 0x53, 0x91, 0x0e, 0xa7, 0x22, 0x0d, 0x28, 0x4b, 0x2e, 0xa2, 0x71, 0x19, 0x8d, 0x46,
+	0x98, 0xc8, 0x48, 0x46, 0x28, 0x0c, 0xea, 0x3d, 0x85, 0xf6, 0xeb, 0x68, 0x9c, 0xe0, 0xc8, 0xc7,
+	0xcb, 0x0c, 0x85, 0x24, 0x3d, 0xa8, 0xa7, 0x61, 0x1e, 0xb3, 0x70, 0xd4, 0xab, 0xdc, 0xa8, 0xec,
+	0xb7, 0x7c, 0xb7, 0x24, 0xd7, 0xa1, 0x29, 0xa2, 0x71, 0x12, 0xca, 0x8c, 0x63, 0xaf, 0xaa, 0x65,
+	0x33, 0xc0, 0xe3, 0x50, 0x77, 0x26, 0x0e, 0xa1, 0x13, 0x66, 0x72, 0xa2, 0x76, 0xa2, 0xa1, 0x8c,
+	0x58, 0xa2, 0x2d, 0x6d, 0x1d, 0xec, 0x0d, 0x0a, 0xcf, 0x07, 0x8f, 0x32, 0x39, 0x79, 0x96, 0x5c,
+	0x30, 0x7f, 0x41, 0x95, 0xdc, 0x81, 0xfa, 0x65, 0x86, 0x3c, 0x42, 0xd1, 0xab, 0xde, 0x58, 0xdf,
+	0xdf, 0x3a, 0xd8, 0x29, 0xb1, 0xce, 0x32, 0xe4, 0xb9, 0xef, 0x14, 0xbc, 0x07, 0xd0, 0xf0, 0x51,
+	0xa4, 0x2c, 0x11, 0x48, 0xee, 0x42, 0x9d, 0xa3, 0xc8, 0x62, 0x29, 0x7a, 0x15, 0xcd, 0xbb, 0xb6,
+	0xc4, 0xd3, 0x62, 0xdf, 0xa9, 0x79, 0x23, 0x68, 0x38, 0x2f, 0xc8, 0xff, 0x61, 0x9b, 0xc6, 0x11,
+	0x26, 0x32, 0xb0, 0x19, 0xca, 0x6d, 0xf4, 0x1d, 0x03, 0x3f, 0xb3, 0x28, 0x19, 0x42, 0xd7, 0x2a,
+	0xca, 0x58, 0x04, 0x14, 0xb9, 0x0c, 0x26, 0xa1, 0x98, 0xd8, 0x7c, 0xec, 0x1a, 0xd9, 0x9b, 0x58,
+	0x1c, 0x23, 0x97, 0x27, 0xa1, 0x98, 0x78, 0xbf, 0x57, 0xa1, 0xa6, 0xb7, 0x57, 0x99, 0xa5, 0x93,
+	0x30, 0x49, 0x30, 0xd6, 0xb6, 0x9b, 0xbe, 0x5b, 0x92, 0x43, 0x68, 0x99, 0x52, 0x05, 0x2a, 0xb2,
+	0x5c, 0x1b, 0x9b, 0x0f, 0xe0, 0x58, 0x8b, 0xb5, 0x9d, 0x93, 0x35, 0x7f, 0x8b, 0xce, 0x96, 0xe4,
+	0x21, 0x40, 0x8a, 0xc8, 0x2d, 0x75, 0x5d, 0x53, 0xff, 0x5b, 0xa2, 0xbe, 0x42, 0xe4, 0xa7, 0x38,
+	0x3d, 0x47, 0x2e, 0x26, 0x51, 0xea, 0x4c, 0x34, 0x15, 0xc7, 0x18, 0xf8, 0x02, 0x1a, 0x94, 0x5a,
+	0xfa, 0x86, 0xa6, 0xff, 0xa7, 0xbc, 0xf3, 0x24, 0x8c, 0x12, 0xca, 0x46, 0xe8, 0x98, 0x75, 0x4a,
+	0x0d, 0xef, 0x01, 0x6c, 0xc5, 0x8c, 0x86, 0x71, 0xa0, 0x4c, 0x89, 0x5e, 0x6d, 0x89, 0xfa, 0x5c,
+	0x49, 0x5f, 0xb9, 0x7d, 0x4e, 0xd6, 0x7c, 0x88, 0x1d, 0x22, 0xc8, 0xd7, 0xd0, 0x66, 0x7c, 0x84,
+	0xbc, 0xf0, 0x7c, 0x53, 0xf3, 0xff, 0x5d, 0xe2, 0xbf, 0x34, 0x72, 0xc7, 0x6e, 0xb1, 0xd2, 0xfa,
+	0xa8, 0x0e, 0x35, 0xcd, 0xf3, 0x7e, 0xab, 0xc2, 0x56, 0xa9, 0xbe, 0x64, 0x1f, 0x6a, 0xc8, 0x39,
+	0xe3, 0xb6, 0xe9, 0xca, 0xed, 0xf3, 0x44, 0xe1, 0x27, 0x6b, 0xbe, 0x51, 0x50, 0x2e, 0xd8, 0xb4,
+	0x9b, 0x96, 0xe8, 0x55, 0x97, 0x5c, 0x30, 0x79, 0x37, 0x96, 0x95, 0x0b, 0xb4, 0xb4, 0x26, 0xc7,
+	0xd0, 0x72, 0x89, 0x53, 0x16, 0x6c, 0xee, 0xff, 0x77, 0x65, 0xf2, 0x0a, 0x33, 0x60, 0x53, 0xe8,
+	0xa3, 0x20, 0x87, 0x50, 0x9f, 0x9a, 0xea, 0xf4, 0x36, 0x96, 0xf8, 0xf3, 0xb5, 0x2b, 0xf8, 0x8e,
+	0x41, 0x1e, 0x41, 0xc7, 0x25, 0xd1, 0x86, 0x60, 0xaa, 0xd0, 0x5b, 0xce, 0x62, 0x41, 0x6e, 0xb3,
+	0x32, 0x70, 0xd4, 0x80, 0x4d, 0x43, 0xf5, 0xda, 0xb0, 0x55, 0x6a, 0x33, 0xef, 0xe7, 0x2a, 0xb4,
+	0xca, 0xe1, 0x93, 0xcf, 0x61, 0x63, 0x2a, 0x52, 0x77, 0xbc, 0x6e, 0x5e, 0x91, 0xa5, 0xc1, 0xa9,
+	0x48, 0xc5, 0x93, 0x44, 0xf2, 0xdc, 0xd7, 0xea, 0xe4, 0x11, 0x34, 0xec, 0x8e, 0xee, 0x44, 0xdf,
+	0xba, 0x8a, 0x6a, 0x5d, 0xb5, 0xf4, 0x82, 0xd6, 0x3f, 0x85, 0x66, 0x61, 0x95, 0xec, 0xc0, 0xfa,
+	0x0f, 0x98, 0xdb, 0x23, 0xa4, 0x3e, 0xc9, 0x1d, 0xa8, 0xbd, 0x0b, 0xe3, 0x0c, 0x6d, 0xfd, 0xba,
+	0x83, 0xa9, 0x48, 0x07, 0xdf, 0x84, 0xe7, 0x3c, 0xa2, 0xa7, 0xaf, 0x5f, 0xd9, 0x1d, 0x8c, 0xca,
+	0xfd, 0xea, 0xbd, 0x4a, 0xff, 0x0c, 0xda, 0x73, 0x3b, 0x7d, 0x88, 0xc9, 0x52, 0x13, 0x25, 0xa3,
+	0x94, 0x45, 0x89, 0x14, 0x25, 0x93, 0xde, 0xb7, 0xb0, 0xb7, 0xe2, 0x9c, 0x91, 0xcf, 0x60, 0xf3,
+	0x22, 0x8a, 0x25, 0xba, 0x66, 0xbc, 0xbe, 0xaa, 0x37, 0x9e, 0x25, 0x12, 0x39, 0x0a, 0xe9, 0x5b,
+	0x5d, 0xef, 0x97, 0x0a, 0x74, 0x57, 0x55, 0x9e, 0x9c, 0x41, 0x4b, 0x9f, 0xb5, 0xe0, 0x3c, 0x0f,
+	0x18, 0x1f, 0xdb, 0x4a, 0x0c, 0xdf, 0xd3, 0x30, 0x1a, 0x14, 0x47, 0xf9, 0x4b, 0x3e, 0x36, 0x89,
+	0x85, 0xb4, 0x00, 0xfa, 0x2f, 0x61, 0x7b, 0x41, 0xbc, 0x22, 0x1b, 0xb7, 0xe7, 0xb3, 0xb1, 0xb3,
+	0xb0, 0xe1, 0x5c, 0x26, 0x9e, 0x43, 0x67, 0xbe, 0xeb, 0xc9, 0x7d, 0x68, 0x46, 0x36, 0x44, 0xd7,
+	0x3c, 0x7f, 0x9d, 0x87, 0x99, 0xba, 0x77, 0x0a, 0xbb, 0x4b, 0x72, 0x72, 0x0f, 0x80, 0x3a, 0xd0,
+	0x59, 0xec, 0xad, 0xb2, 0x78, 0x1c, 0xc6, 0xb1, 0x5f, 0xd2, 0xf5, 0x5e, 0x40, 0x7b, 0x4e, 0x48,
+	0x08, 0x6c, 0x24, 0xe1, 0x14, 0x6d, 0xb0, 0xfa, 0x9b, 0x7c, 0x04, 0x3b, 0x94, 0xc5, 0x31, 0x52,
+	0x35, 0x8f, 0x02, 0x05, 0x99, 0xc6, 0x6d, 0xfa, 0xdb, 0x33, 0xfc, 0x85, 0x82, 0x3d, 0x1f, 0xba,
+	0xab, 0x8e, 0x38, 0xb9, 0x0f, 0x75, 0xca, 0x12, 0x89, 0x89, 0xb4, 0xee, 0xdd, 0x98, 0x6f, 0x20,
+	0xc6, 0x05, 0x4e, 0x31, 0x91, 0x8f, 0x51, 0x50, 0x1e, 0xa5, 0x92, 0x71, 0xdf, 0x11, 0xbc, 0x87,
+	0xd0, 0x99, 0xbf, 0x38, 0xc9, 0x27, 0x40, 0xa2, 0x84, 0xc6, 0xd9, 0x08, 0x83, 0xb9, 0xb8, 0x2b,
+	0xfb, 0x0d, 0x7f, 0xd7, 0x4a, 0x8e, 0x67, 0x41, 0xfe, 0x58, 0x85, 0x7f, 0xad, 0xdc, 0x43, 0x4d,
+	0xf0, 0xc2, 0x80, 0x0d, 0x79, 0x06, 0x90, 0x31, 0xec, 0xa1, 0xa1, 0x99, 0x0e, 0x1b, 0x73, 0x96,
+	0xa5, 0xee, 0xcc, 0x7e, 0xf9, 0xbe, 0x00, 0x1c, 0xaa, 0x5a, 0xe9, 0xa9, 0x66, 0x9a, 0x66, 0xdb,
+	0xc5, 0x45, 0x9c, 0x7c, 0x0c, 0xf5, 0x38, 0xcc, 0x59, 0x26, 0xd5, 0x95, 0xa9, 0x8c, 0xef, 0x96,
+	0x87, 0x86, 0x96, 0xf8, 0x4e, 0xa3, 0xff, 0x16, 0xae, 0xad, 0xb6, 0xfc, 0x37, 0xfb, 0xf4, 0xa7,
+	0x0a, 0x6c, 0x9a, 0xbd, 0xc8, 0x77, 0xb0, 0x77, 0x99, 0x85, 0xf6, 0x5d, 0x54, 0x44, 0x6e, 0x2b,
+	0xb7, 0xbf, 0xe4, 0xdb, 0xe0, 0xac, 0x50, 0xb6, 0x0e, 0xd9, 0x48, 0x2f, 0x17, 0xf1, 0xfe, 0x63,
+	0xb8, 0xb6, 0x5a, 0x79, 0x85, 0xf3, 0xdd, 0xb2, 0xf3, 0xed, 0xb2, 0xab, 0x03, 0xa8, 0x99, 0x99,
+	0x79, 0x0b, 0x6a, 0x66, 0xd6, 0x1a, 0xd7, 0xb6, 0x17, 0xe2, 0xf3, 0x8d, 0xd4, 0xfb, 0xb5, 0x0a,
+	0x1b, 0x6a, 0x4d, 0x86, 0x00, 0x42, 0x86, 0x12, 0x83, 0x28, 0xb9, 0x60, 0xc5, 0x3c, 0x34, 0x6f,
+	0xc6, 0xc1, 0x93, 0xe4, 0x1d, 0xc6, 0x2c, 0x45, 0xbf, 0xa9, 0x75, 0xf4, 0x33, 0xe8, 0x2b, 0xd8,
+	0x9e, 0x16, 0xb7, 0x87, 0x61, 0x55, 0xaf, 0x60, 0x75, 0x66, 0x8a, 0x9a, 0xda, 0x87, 0x46, 0xf1,
+	0x74, 0x5a, 0xd7, 0x8f, 0xa1, 0x62, 0x4d, 0xde, 0xc2, 0x9e, 0x7d, 0xea, 0x04, 0x33, 0x7f, 0xd4,
+	0xbc, 0x53, 0x51, 0xdc, 0x5e, 0x88, 0x62, 0x70, 0x6c, 0x54, 0x5f, 0x3b, 0xaf, 0x5c, 0x23, 0xd1,
+	0x45, 0x5c, 0xf5, 0xc6, 0x6a, 0xe5, 0x0f, 0xe9, 0x8d, 0xc5, 0x80, 0x4a, 0x09, 0xbf, 0x09, 0x35,
+	0xfd, 0x54, 0xd0, 0x4f, 0xb6, 0xe2, 0x1c, 0x9b, 0x27, 0x9b, 0x3d, 0xa5, 0x0f, 0xa0, 0x59, 0x0c,
+	0x02, 0x32, 0x84, 0x06, 0xda, 0x85, 0x2d, 0xcd, 0xde, 0x8a, 0x81, 0xe1, 0x17, 0x4a, 0xde, 0x01,
+	0x34, 0x1c, 0xaa, 0xae, 0xa0, 0x09, 0x13, 0x6e, 0x03, 0xfd, 0xad, 0xb0, 0x94, 0x71, 0x69, 0x5b,
+	0x41, 0x7f, 0x7b, 0x1d, 0x68, 0x95, 0x1f, 0x44, 0xde, 0x1f, 0x95, 0x62, 0x8c, 0xd9, 0x5b, 0xe7,
+	0xa8, 0x34, 0x69, 0x2b, 0x4b, 0xb9, 0x9d, 0xd3, 0xbd, 0x6a, 0xd4, 0x92, 0x5b, 0xd0, 0xa1, 0x2c,
+	0x11, 0x98, 0x88, 0x4c, 0x04, 0x32, 0x4f, 0x4d, 0xbe, 0x9a, 0x7e, 0xbb, 0x40, 0xdf, 0xe4, 0x29,
+	0xaa, 0x2b, 0x69, 0xa6, 0x36, 0x45, 0x19, 0x8e, 0x42, 0x19, 0xda, 0xba, 0xef, 0x16, 0x92, 0x53,
+	0x2b, 0xf8, 0x07, 0x26, 0xee, 0xc1, 0x09, 0x34, 0x1f, 0x3b, 0x0d, 0x72, 0x08, 0x0d, 0xb7, 0x20,
+	0xe5, 0x49, 0x30, 0xf7, 0x6b, 0xd3, 0x2f, 0x17, 0xc5, 0xfd, 0x37, 0x78, 0x6b, 0x47, 0x77, 0xbf,
+	0x1f, 0x8c, 0x23, 0x39, 0xc9, 0xce, 0x07, 0x94, 0x4d, 0x87, 0x93, 0x3c, 0x45, 0x1e, 0xe3, 0x68,
+	0x8c, 0x7c, 0x78, 0xa1, 0xdf, 0x10, 0xe6, 0xff, 0x4b, 0x0c, 0x0b, 0xf2, 0xf9, 0xa6, 0x46, 0x3e,
+	0xfd, 0x73, 0x00, 0x3e, 0x31, 0xa9, 0x6b, 0xa4, 0x0d, 0x00, 0x00,
 }
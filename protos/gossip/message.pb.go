@@ -47,7 +47,7 @@ func (x PullMsgType) String() string {
 	return proto.EnumName(PullMsgType_name, int32(x))
 }
 func (PullMsgType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{0}
+	return fileDescriptor_message_6bace4d0c8546752, []int{0}
 }
 
 type GossipMessage_Tag int32
@@ -82,7 +82,7 @@ func (x GossipMessage_Tag) String() string {
 	return proto.EnumName(GossipMessage_Tag_name, int32(x))
 }
 func (GossipMessage_Tag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{3, 0}
+	return fileDescriptor_message_6bace4d0c8546752, []int{3, 0}
 }
 
 // Envelope contains a marshalled
@@ -102,7 +102,7 @@ func (m *Envelope) Reset()         { *m = Envelope{} }
 func (m *Envelope) String() string { return proto.CompactTextString(m) }
 func (*Envelope) ProtoMessage()    {}
 func (*Envelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{0}
+	return fileDescriptor_message_6bace4d0c8546752, []int{0}
 }
 func (m *Envelope) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Envelope.Unmarshal(m, b)
@@ -160,7 +160,7 @@ func (m *SecretEnvelope) Reset()         { *m = SecretEnvelope{} }
 func (m *SecretEnvelope) String() string { return proto.CompactTextString(m) }
 func (*SecretEnvelope) ProtoMessage()    {}
 func (*SecretEnvelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{1}
+	return fileDescriptor_message_6bace4d0c8546752, []int{1}
 }
 func (m *SecretEnvelope) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SecretEnvelope.Unmarshal(m, b)
@@ -210,7 +210,7 @@ func (m *Secret) Reset()         { *m = Secret{} }
 func (m *Secret) String() string { return proto.CompactTextString(m) }
 func (*Secret) ProtoMessage()    {}
 func (*Secret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{2}
+	return fileDescriptor_message_6bace4d0c8546752, []int{2}
 }
 func (m *Secret) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Secret.Unmarshal(m, b)
@@ -349,7 +349,7 @@ func (m *GossipMessage) Reset()         { *m = GossipMessage{} }
 func (m *GossipMessage) String() string { return proto.CompactTextString(m) }
 func (*GossipMessage) ProtoMessage()    {}
 func (*GossipMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{3}
+	return fileDescriptor_message_6bace4d0c8546752, []int{3}
 }
 func (m *GossipMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GossipMessage.Unmarshal(m, b)
@@ -1128,7 +1128,7 @@ func (m *StateInfo) Reset()         { *m = StateInfo{} }
 func (m *StateInfo) String() string { return proto.CompactTextString(m) }
 func (*StateInfo) ProtoMessage()    {}
 func (*StateInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{4}
+	return fileDescriptor_message_6bace4d0c8546752, []int{4}
 }
 func (m *StateInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateInfo.Unmarshal(m, b)
@@ -1177,19 +1177,21 @@ func (m *StateInfo) GetProperties() *Properties {
 }
 
 type Properties struct {
-	LedgerHeight         uint64       `protobuf:"varint,1,opt,name=ledger_height,json=ledgerHeight,proto3" json:"ledger_height,omitempty"`
-	LeftChannel          bool         `protobuf:"varint,2,opt,name=left_channel,json=leftChannel,proto3" json:"left_channel,omitempty"`
-	Chaincodes           []*Chaincode `protobuf:"bytes,3,rep,name=chaincodes,proto3" json:"chaincodes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	LedgerHeight uint64       `protobuf:"varint,1,opt,name=ledger_height,json=ledgerHeight,proto3" json:"ledger_height,omitempty"`
+	LeftChannel  bool         `protobuf:"varint,2,opt,name=left_channel,json=leftChannel,proto3" json:"left_channel,omitempty"`
+	Chaincodes   []*Chaincode `protobuf:"bytes,3,rep,name=chaincodes,proto3" json:"chaincodes,omitempty"`
+	// bulk_state_transfer is set by peers which serve state transfer streams
+	BulkStateTransfer    bool     `protobuf:"varint,4,opt,name=bulk_state_transfer,json=bulkStateTransfer,proto3" json:"bulk_state_transfer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Properties) Reset()         { *m = Properties{} }
 func (m *Properties) String() string { return proto.CompactTextString(m) }
 func (*Properties) ProtoMessage()    {}
 func (*Properties) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{5}
+	return fileDescriptor_message_6bace4d0c8546752, []int{5}
 }
 func (m *Properties) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Properties.Unmarshal(m, b)
@@ -1230,6 +1232,13 @@ func (m *Properties) GetChaincodes() []*Chaincode {
 	return nil
 }
 
+func (m *Properties) GetBulkStateTransfer() bool {
+	if m != nil {
+		return m.BulkStateTransfer
+	}
+	return false
+}
+
 // StateInfoSnapshot is an aggregation of StateInfo messages
 type StateInfoSnapshot struct {
 	Elements             []*Envelope `protobuf:"bytes,1,rep,name=elements,proto3" json:"elements,omitempty"`
@@ -1242,7 +1251,7 @@ func (m *StateInfoSnapshot) Reset()         { *m = StateInfoSnapshot{} }
 func (m *StateInfoSnapshot) String() string { return proto.CompactTextString(m) }
 func (*StateInfoSnapshot) ProtoMessage()    {}
 func (*StateInfoSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{6}
+	return fileDescriptor_message_6bace4d0c8546752, []int{6}
 }
 func (m *StateInfoSnapshot) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateInfoSnapshot.Unmarshal(m, b)
@@ -1285,7 +1294,7 @@ func (m *StateInfoPullRequest) Reset()         { *m = StateInfoPullRequest{} }
 func (m *StateInfoPullRequest) String() string { return proto.CompactTextString(m) }
 func (*StateInfoPullRequest) ProtoMessage()    {}
 func (*StateInfoPullRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{7}
+	return fileDescriptor_message_6bace4d0c8546752, []int{7}
 }
 func (m *StateInfoPullRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateInfoPullRequest.Unmarshal(m, b)
@@ -1328,7 +1337,7 @@ func (m *ConnEstablish) Reset()         { *m = ConnEstablish{} }
 func (m *ConnEstablish) String() string { return proto.CompactTextString(m) }
 func (*ConnEstablish) ProtoMessage()    {}
 func (*ConnEstablish) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{8}
+	return fileDescriptor_message_6bace4d0c8546752, []int{8}
 }
 func (m *ConnEstablish) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConnEstablish.Unmarshal(m, b)
@@ -1385,7 +1394,7 @@ func (m *PeerIdentity) Reset()         { *m = PeerIdentity{} }
 func (m *PeerIdentity) String() string { return proto.CompactTextString(m) }
 func (*PeerIdentity) ProtoMessage()    {}
 func (*PeerIdentity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{9}
+	return fileDescriptor_message_6bace4d0c8546752, []int{9}
 }
 func (m *PeerIdentity) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeerIdentity.Unmarshal(m, b)
@@ -1441,7 +1450,7 @@ func (m *DataRequest) Reset()         { *m = DataRequest{} }
 func (m *DataRequest) String() string { return proto.CompactTextString(m) }
 func (*DataRequest) ProtoMessage()    {}
 func (*DataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{10}
+	return fileDescriptor_message_6bace4d0c8546752, []int{10}
 }
 func (m *DataRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataRequest.Unmarshal(m, b)
@@ -1497,7 +1506,7 @@ func (m *GossipHello) Reset()         { *m = GossipHello{} }
 func (m *GossipHello) String() string { return proto.CompactTextString(m) }
 func (*GossipHello) ProtoMessage()    {}
 func (*GossipHello) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{11}
+	return fileDescriptor_message_6bace4d0c8546752, []int{11}
 }
 func (m *GossipHello) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GossipHello.Unmarshal(m, b)
@@ -1553,7 +1562,7 @@ func (m *DataUpdate) Reset()         { *m = DataUpdate{} }
 func (m *DataUpdate) String() string { return proto.CompactTextString(m) }
 func (*DataUpdate) ProtoMessage()    {}
 func (*DataUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{12}
+	return fileDescriptor_message_6bace4d0c8546752, []int{12}
 }
 func (m *DataUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataUpdate.Unmarshal(m, b)
@@ -1609,7 +1618,7 @@ func (m *DataDigest) Reset()         { *m = DataDigest{} }
 func (m *DataDigest) String() string { return proto.CompactTextString(m) }
 func (*DataDigest) ProtoMessage()    {}
 func (*DataDigest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{13}
+	return fileDescriptor_message_6bace4d0c8546752, []int{13}
 }
 func (m *DataDigest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataDigest.Unmarshal(m, b)
@@ -1662,7 +1671,7 @@ func (m *DataMessage) Reset()         { *m = DataMessage{} }
 func (m *DataMessage) String() string { return proto.CompactTextString(m) }
 func (*DataMessage) ProtoMessage()    {}
 func (*DataMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{14}
+	return fileDescriptor_message_6bace4d0c8546752, []int{14}
 }
 func (m *DataMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataMessage.Unmarshal(m, b)
@@ -1703,7 +1712,7 @@ func (m *PrivateDataMessage) Reset()         { *m = PrivateDataMessage{} }
 func (m *PrivateDataMessage) String() string { return proto.CompactTextString(m) }
 func (*PrivateDataMessage) ProtoMessage()    {}
 func (*PrivateDataMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{15}
+	return fileDescriptor_message_6bace4d0c8546752, []int{15}
 }
 func (m *PrivateDataMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PrivateDataMessage.Unmarshal(m, b)
@@ -1744,7 +1753,7 @@ func (m *Payload) Reset()         { *m = Payload{} }
 func (m *Payload) String() string { return proto.CompactTextString(m) }
 func (*Payload) ProtoMessage()    {}
 func (*Payload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{16}
+	return fileDescriptor_message_6bace4d0c8546752, []int{16}
 }
 func (m *Payload) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Payload.Unmarshal(m, b)
@@ -1804,7 +1813,7 @@ func (m *PrivatePayload) Reset()         { *m = PrivatePayload{} }
 func (m *PrivatePayload) String() string { return proto.CompactTextString(m) }
 func (*PrivatePayload) ProtoMessage()    {}
 func (*PrivatePayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{17}
+	return fileDescriptor_message_6bace4d0c8546752, []int{17}
 }
 func (m *PrivatePayload) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PrivatePayload.Unmarshal(m, b)
@@ -1881,7 +1890,7 @@ func (m *AliveMessage) Reset()         { *m = AliveMessage{} }
 func (m *AliveMessage) String() string { return proto.CompactTextString(m) }
 func (*AliveMessage) ProtoMessage()    {}
 func (*AliveMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{18}
+	return fileDescriptor_message_6bace4d0c8546752, []int{18}
 }
 func (m *AliveMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AliveMessage.Unmarshal(m, b)
@@ -1928,6 +1937,7 @@ type LeadershipMessage struct {
 	PkiId                []byte    `protobuf:"bytes,1,opt,name=pki_id,json=pkiId,proto3" json:"pki_id,omitempty"`
 	Timestamp            *PeerTime `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	IsDeclaration        bool      `protobuf:"varint,3,opt,name=is_declaration,json=isDeclaration,proto3" json:"is_declaration,omitempty"`
+	Priority             uint32    `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
 	XXX_unrecognized     []byte    `json:"-"`
 	XXX_sizecache        int32     `json:"-"`
@@ -1937,7 +1947,7 @@ func (m *LeadershipMessage) Reset()         { *m = LeadershipMessage{} }
 func (m *LeadershipMessage) String() string { return proto.CompactTextString(m) }
 func (*LeadershipMessage) ProtoMessage()    {}
 func (*LeadershipMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{19}
+	return fileDescriptor_message_6bace4d0c8546752, []int{19}
 }
 func (m *LeadershipMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LeadershipMessage.Unmarshal(m, b)
@@ -1978,6 +1988,13 @@ func (m *LeadershipMessage) GetIsDeclaration() bool {
 	return false
 }
 
+func (m *LeadershipMessage) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 // PeerTime defines the logical time of a peer's life
 type PeerTime struct {
 	IncNum               uint64   `protobuf:"varint,1,opt,name=inc_num,json=incNum,proto3" json:"inc_num,omitempty"`
@@ -1991,7 +2008,7 @@ func (m *PeerTime) Reset()         { *m = PeerTime{} }
 func (m *PeerTime) String() string { return proto.CompactTextString(m) }
 func (*PeerTime) ProtoMessage()    {}
 func (*PeerTime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{20}
+	return fileDescriptor_message_6bace4d0c8546752, []int{20}
 }
 func (m *PeerTime) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeerTime.Unmarshal(m, b)
@@ -2039,7 +2056,7 @@ func (m *MembershipRequest) Reset()         { *m = MembershipRequest{} }
 func (m *MembershipRequest) String() string { return proto.CompactTextString(m) }
 func (*MembershipRequest) ProtoMessage()    {}
 func (*MembershipRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{21}
+	return fileDescriptor_message_6bace4d0c8546752, []int{21}
 }
 func (m *MembershipRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MembershipRequest.Unmarshal(m, b)
@@ -2086,7 +2103,7 @@ func (m *MembershipResponse) Reset()         { *m = MembershipResponse{} }
 func (m *MembershipResponse) String() string { return proto.CompactTextString(m) }
 func (*MembershipResponse) ProtoMessage()    {}
 func (*MembershipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{22}
+	return fileDescriptor_message_6bace4d0c8546752, []int{22}
 }
 func (m *MembershipResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MembershipResponse.Unmarshal(m, b)
@@ -2135,7 +2152,7 @@ func (m *Member) Reset()         { *m = Member{} }
 func (m *Member) String() string { return proto.CompactTextString(m) }
 func (*Member) ProtoMessage()    {}
 func (*Member) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{23}
+	return fileDescriptor_message_6bace4d0c8546752, []int{23}
 }
 func (m *Member) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Member.Unmarshal(m, b)
@@ -2187,7 +2204,7 @@ func (m *Empty) Reset()         { *m = Empty{} }
 func (m *Empty) String() string { return proto.CompactTextString(m) }
 func (*Empty) ProtoMessage()    {}
 func (*Empty) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{24}
+	return fileDescriptor_message_6bace4d0c8546752, []int{24}
 }
 func (m *Empty) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Empty.Unmarshal(m, b)
@@ -2221,7 +2238,7 @@ func (m *RemoteStateRequest) Reset()         { *m = RemoteStateRequest{} }
 func (m *RemoteStateRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoteStateRequest) ProtoMessage()    {}
 func (*RemoteStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{25}
+	return fileDescriptor_message_6bace4d0c8546752, []int{25}
 }
 func (m *RemoteStateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoteStateRequest.Unmarshal(m, b)
@@ -2258,17 +2275,20 @@ func (m *RemoteStateRequest) GetEndSeqNum() uint64 {
 // RemoteStateResponse is used to send a set of blocks
 // to a remote peer
 type RemoteStateResponse struct {
-	Payloads             []*Payload `protobuf:"bytes,1,rep,name=payloads,proto3" json:"payloads,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Payloads []*Payload `protobuf:"bytes,1,rep,name=payloads,proto3" json:"payloads,omitempty"`
+	// compressed_payloads holds a gzipped RemoteStateResponse carrying
+	// the payloads, in response to requests sent over a StateTransferStream
+	CompressedPayloads   []byte   `protobuf:"bytes,2,opt,name=compressed_payloads,json=compressedPayloads,proto3" json:"compressed_payloads,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RemoteStateResponse) Reset()         { *m = RemoteStateResponse{} }
 func (m *RemoteStateResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoteStateResponse) ProtoMessage()    {}
 func (*RemoteStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{26}
+	return fileDescriptor_message_6bace4d0c8546752, []int{26}
 }
 func (m *RemoteStateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoteStateResponse.Unmarshal(m, b)
@@ -2295,6 +2315,13 @@ func (m *RemoteStateResponse) GetPayloads() []*Payload {
 	return nil
 }
 
+func (m *RemoteStateResponse) GetCompressedPayloads() []byte {
+	if m != nil {
+		return m.CompressedPayloads
+	}
+	return nil
+}
+
 // RemotePrivateDataRequest message used to request
 // missing private rwset
 type RemotePvtDataRequest struct {
@@ -2308,7 +2335,7 @@ func (m *RemotePvtDataRequest) Reset()         { *m = RemotePvtDataRequest{} }
 func (m *RemotePvtDataRequest) String() string { return proto.CompactTextString(m) }
 func (*RemotePvtDataRequest) ProtoMessage()    {}
 func (*RemotePvtDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{27}
+	return fileDescriptor_message_6bace4d0c8546752, []int{27}
 }
 func (m *RemotePvtDataRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemotePvtDataRequest.Unmarshal(m, b)
@@ -2351,7 +2378,7 @@ func (m *PvtDataDigest) Reset()         { *m = PvtDataDigest{} }
 func (m *PvtDataDigest) String() string { return proto.CompactTextString(m) }
 func (*PvtDataDigest) ProtoMessage()    {}
 func (*PvtDataDigest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{28}
+	return fileDescriptor_message_6bace4d0c8546752, []int{28}
 }
 func (m *PvtDataDigest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PvtDataDigest.Unmarshal(m, b)
@@ -2419,7 +2446,7 @@ func (m *RemotePvtDataResponse) Reset()         { *m = RemotePvtDataResponse{} }
 func (m *RemotePvtDataResponse) String() string { return proto.CompactTextString(m) }
 func (*RemotePvtDataResponse) ProtoMessage()    {}
 func (*RemotePvtDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{29}
+	return fileDescriptor_message_6bace4d0c8546752, []int{29}
 }
 func (m *RemotePvtDataResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemotePvtDataResponse.Unmarshal(m, b)
@@ -2459,7 +2486,7 @@ func (m *PvtDataElement) Reset()         { *m = PvtDataElement{} }
 func (m *PvtDataElement) String() string { return proto.CompactTextString(m) }
 func (*PvtDataElement) ProtoMessage()    {}
 func (*PvtDataElement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{30}
+	return fileDescriptor_message_6bace4d0c8546752, []int{30}
 }
 func (m *PvtDataElement) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PvtDataElement.Unmarshal(m, b)
@@ -2509,7 +2536,7 @@ func (m *PvtDataPayload) Reset()         { *m = PvtDataPayload{} }
 func (m *PvtDataPayload) String() string { return proto.CompactTextString(m) }
 func (*PvtDataPayload) ProtoMessage()    {}
 func (*PvtDataPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{31}
+	return fileDescriptor_message_6bace4d0c8546752, []int{31}
 }
 func (m *PvtDataPayload) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PvtDataPayload.Unmarshal(m, b)
@@ -2554,7 +2581,7 @@ func (m *Acknowledgement) Reset()         { *m = Acknowledgement{} }
 func (m *Acknowledgement) String() string { return proto.CompactTextString(m) }
 func (*Acknowledgement) ProtoMessage()    {}
 func (*Acknowledgement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{32}
+	return fileDescriptor_message_6bace4d0c8546752, []int{32}
 }
 func (m *Acknowledgement) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Acknowledgement.Unmarshal(m, b)
@@ -2596,7 +2623,7 @@ func (m *Chaincode) Reset()         { *m = Chaincode{} }
 func (m *Chaincode) String() string { return proto.CompactTextString(m) }
 func (*Chaincode) ProtoMessage()    {}
 func (*Chaincode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{33}
+	return fileDescriptor_message_6bace4d0c8546752, []int{33}
 }
 func (m *Chaincode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Chaincode.Unmarshal(m, b)
@@ -2690,6 +2717,9 @@ const _ = grpc.SupportPackageIsVersion4
 type GossipClient interface {
 	// GossipStream is the gRPC stream used for sending and receiving messages
 	GossipStream(ctx context.Context, opts ...grpc.CallOption) (Gossip_GossipStreamClient, error)
+	// StateTransferStream is the gRPC stream used by peers far behind
+	// to request blocks in bulk from a single remote peer
+	StateTransferStream(ctx context.Context, opts ...grpc.CallOption) (Gossip_StateTransferStreamClient, error)
 	// Ping is used to probe a remote peer's aliveness
 	Ping(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
 }
@@ -2733,6 +2763,37 @@ func (x *gossipGossipStreamClient) Recv() (*Envelope, error) {
 	return m, nil
 }
 
+func (c *gossipClient) StateTransferStream(ctx context.Context, opts ...grpc.CallOption) (Gossip_StateTransferStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Gossip_serviceDesc.Streams[1], "/gossip.Gossip/StateTransferStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gossipStateTransferStreamClient{stream}
+	return x, nil
+}
+
+type Gossip_StateTransferStreamClient interface {
+	Send(*Envelope) error
+	Recv() (*Envelope, error)
+	grpc.ClientStream
+}
+
+type gossipStateTransferStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *gossipStateTransferStreamClient) Send(m *Envelope) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *gossipStateTransferStreamClient) Recv() (*Envelope, error) {
+	m := new(Envelope)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *gossipClient) Ping(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := c.cc.Invoke(ctx, "/gossip.Gossip/Ping", in, out, opts...)
@@ -2746,6 +2807,9 @@ func (c *gossipClient) Ping(ctx context.Context, in *Empty, opts ...grpc.CallOpt
 type GossipServer interface {
 	// GossipStream is the gRPC stream used for sending and receiving messages
 	GossipStream(Gossip_GossipStreamServer) error
+	// StateTransferStream is the gRPC stream used by peers far behind
+	// to request blocks in bulk from a single remote peer
+	StateTransferStream(Gossip_StateTransferStreamServer) error
 	// Ping is used to probe a remote peer's aliveness
 	Ping(context.Context, *Empty) (*Empty, error)
 }
@@ -2780,6 +2844,32 @@ func (x *gossipGossipStreamServer) Recv() (*Envelope, error) {
 	return m, nil
 }
 
+func _Gossip_StateTransferStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GossipServer).StateTransferStream(&gossipStateTransferStreamServer{stream})
+}
+
+type Gossip_StateTransferStreamServer interface {
+	Send(*Envelope) error
+	Recv() (*Envelope, error)
+	grpc.ServerStream
+}
+
+type gossipStateTransferStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *gossipStateTransferStreamServer) Send(m *Envelope) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *gossipStateTransferStreamServer) Recv() (*Envelope, error) {
+	m := new(Envelope)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Gossip_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -2814,130 +2904,140 @@ var _Gossip_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "StateTransferStream",
+			Handler:       _Gossip_StateTransferStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "gossip/message.proto",
 }
 
-func init() { proto.RegisterFile("gossip/message.proto", fileDescriptor_message_7c42328ef5ef9997) }
-
-var fileDescriptor_message_7c42328ef5ef9997 = []byte{
-	// 1874 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x5b, 0x6f, 0xe3, 0xc6,
-	0x15, 0x16, 0x6d, 0x5d, 0x8f, 0x2e, 0x96, 0xc7, 0xde, 0x5d, 0xc6, 0x49, 0x13, 0x87, 0xed, 0x26,
-	0xdb, 0x7a, 0x23, 0x6f, 0x9d, 0x16, 0x0d, 0x90, 0xb6, 0x0b, 0x5b, 0x52, 0x2c, 0x21, 0x2b, 0xad,
-	0x4b, 0x7b, 0xd1, 0xba, 0x2f, 0xc4, 0x98, 0x1c, 0x53, 0xac, 0xc9, 0x21, 0xcd, 0x19, 0x3b, 0xf6,
-	0x63, 0xd1, 0x87, 0x00, 0x7d, 0xe9, 0x6f, 0xe8, 0x53, 0xff, 0x66, 0x31, 0x33, 0xbc, 0x4a, 0xf6,
-	0x02, 0x1b, 0x20, 0x6f, 0x3c, 0xf7, 0x99, 0x33, 0x67, 0xbe, 0x73, 0x86, 0xb0, 0xed, 0x86, 0x8c,
-	0x79, 0xd1, 0x7e, 0x40, 0x18, 0xc3, 0x2e, 0x19, 0x44, 0x71, 0xc8, 0x43, 0x54, 0x57, 0xdc, 0x9d,
-	0x67, 0x76, 0x18, 0x04, 0x21, 0xdd, 0xb7, 0x43, 0xdf, 0x27, 0x36, 0xf7, 0x42, 0xaa, 0x14, 0x8c,
-	0x7f, 0x69, 0xd0, 0x1c, 0xd3, 0x5b, 0xe2, 0x87, 0x11, 0x41, 0x3a, 0x34, 0x22, 0x7c, 0xef, 0x87,
-	0xd8, 0xd1, 0xb5, 0x5d, 0xed, 0x45, 0xc7, 0x4c, 0x49, 0xf4, 0x09, 0xb4, 0x98, 0xe7, 0x52, 0xcc,
-	0x6f, 0x62, 0xa2, 0xaf, 0x49, 0x59, 0xce, 0x40, 0xaf, 0x61, 0x83, 0x11, 0x3b, 0x26, 0xdc, 0x22,
-	0x89, 0x2b, 0x7d, 0x7d, 0x57, 0x7b, 0xd1, 0x3e, 0x78, 0x3a, 0x50, 0xf1, 0x07, 0xa7, 0x52, 0x9c,
-	0x06, 0x32, 0x7b, 0xac, 0x44, 0x1b, 0x13, 0xe8, 0x95, 0x35, 0x7e, 0xea, 0x52, 0x8c, 0x43, 0xa8,
-	0x2b, 0x4f, 0xe8, 0x25, 0xf4, 0x3d, 0xca, 0x49, 0x4c, 0xb1, 0x3f, 0xa6, 0x4e, 0x14, 0x7a, 0x94,
-	0x4b, 0x57, 0xad, 0x49, 0xc5, 0x5c, 0x91, 0x1c, 0xb5, 0xa0, 0x61, 0x87, 0x94, 0x13, 0xca, 0x8d,
-	0x1f, 0xdb, 0xd0, 0x3d, 0x96, 0xcb, 0x9e, 0xa9, 0x5c, 0xa2, 0x6d, 0xa8, 0xd1, 0x90, 0xda, 0x44,
-	0xda, 0x57, 0x4d, 0x45, 0x88, 0x25, 0xda, 0x0b, 0x4c, 0x29, 0xf1, 0x93, 0x65, 0xa4, 0x24, 0xda,
-	0x83, 0x75, 0x8e, 0x5d, 0x99, 0x83, 0xde, 0xc1, 0x47, 0x69, 0x0e, 0x4a, 0x3e, 0x07, 0x67, 0xd8,
-	0x35, 0x85, 0x16, 0xfa, 0x1a, 0x5a, 0xd8, 0xf7, 0x6e, 0x89, 0x15, 0x30, 0x57, 0xaf, 0xc9, 0xb4,
-	0x6d, 0xa7, 0x26, 0x87, 0x42, 0x90, 0x58, 0x4c, 0x2a, 0x66, 0x53, 0x2a, 0xce, 0x98, 0x8b, 0x7e,
-	0x07, 0x8d, 0x80, 0x04, 0x56, 0x4c, 0xae, 0xf5, 0xba, 0x34, 0xc9, 0xa2, 0xcc, 0x48, 0x70, 0x41,
-	0x62, 0xb6, 0xf0, 0x22, 0x93, 0x5c, 0xdf, 0x10, 0xc6, 0x27, 0x15, 0xb3, 0x1e, 0x90, 0xc0, 0x24,
-	0xd7, 0xe8, 0xf7, 0xa9, 0x15, 0xd3, 0x1b, 0xd2, 0x6a, 0xe7, 0x21, 0x2b, 0x16, 0x85, 0x94, 0x91,
-	0xcc, 0x8c, 0xa1, 0x57, 0xd0, 0x74, 0x30, 0xc7, 0x72, 0x81, 0x4d, 0x69, 0xb7, 0x95, 0xda, 0x8d,
-	0x30, 0xc7, 0xf9, 0xfa, 0x1a, 0x42, 0x4d, 0x2c, 0x6f, 0x0f, 0x6a, 0x0b, 0xe2, 0xfb, 0xa1, 0xde,
-	0x2a, 0xab, 0xab, 0x14, 0x4c, 0x84, 0x68, 0x52, 0x31, 0x95, 0x0e, 0xda, 0x4f, 0xdc, 0x3b, 0x9e,
-	0xab, 0x83, 0xd4, 0x47, 0x45, 0xf7, 0x23, 0xcf, 0x55, 0xbb, 0x90, 0xde, 0x47, 0x9e, 0x9b, 0xad,
-	0x47, 0xec, 0xbe, 0xbd, 0xba, 0x9e, 0x7c, 0xdf, 0xd2, 0x42, 0x6d, 0xbc, 0x2d, 0x2d, 0x6e, 0x22,
-	0x07, 0x73, 0xa2, 0x77, 0x56, 0xa3, 0xbc, 0x93, 0x92, 0x49, 0xc5, 0x04, 0x27, 0xa3, 0xd0, 0x73,
-	0xa8, 0x91, 0x20, 0xe2, 0xf7, 0x7a, 0x57, 0x1a, 0x74, 0x53, 0x83, 0xb1, 0x60, 0x8a, 0x0d, 0x48,
-	0x29, 0xda, 0x83, 0xaa, 0x1d, 0x52, 0xaa, 0xf7, 0xa4, 0xd6, 0x93, 0x54, 0x6b, 0x18, 0x52, 0x3a,
-	0x66, 0x1c, 0x5f, 0xf8, 0x1e, 0x5b, 0x4c, 0x2a, 0xa6, 0x54, 0x42, 0x07, 0x00, 0x8c, 0x63, 0x4e,
-	0x2c, 0x8f, 0x5e, 0x86, 0xfa, 0x86, 0x34, 0xd9, 0xcc, 0xae, 0x89, 0x90, 0x4c, 0xe9, 0xa5, 0xc8,
-	0x4e, 0x8b, 0xa5, 0x04, 0x3a, 0x82, 0x9e, 0xb2, 0x61, 0x14, 0x47, 0x6c, 0x11, 0x72, 0xbd, 0x5f,
-	0x3e, 0xf4, 0xcc, 0xee, 0x34, 0x51, 0x98, 0x54, 0xcc, 0xae, 0x34, 0x49, 0x19, 0x68, 0x06, 0x5b,
-	0x79, 0x5c, 0x2b, 0xba, 0xf1, 0x7d, 0x99, 0xbf, 0x4d, 0xe9, 0xe8, 0x93, 0x15, 0x47, 0x27, 0x37,
-	0xbe, 0x9f, 0x27, 0xb2, 0xcf, 0x96, 0xf8, 0xe8, 0x10, 0x94, 0x7f, 0xe1, 0x44, 0x28, 0xe9, 0xa8,
-	0x5c, 0x50, 0x26, 0x09, 0x42, 0x4e, 0xa4, 0xbb, 0xdc, 0x4d, 0x87, 0x15, 0x68, 0x34, 0x4a, 0x77,
-	0x15, 0x27, 0x25, 0xa7, 0x6f, 0x49, 0x1f, 0x1f, 0x3f, 0xe8, 0x23, 0xab, 0xca, 0x2e, 0x2b, 0x32,
-	0x44, 0x6e, 0x7c, 0x82, 0x1d, 0x55, 0xbc, 0xb2, 0x44, 0xb7, 0xcb, 0xb9, 0x79, 0x93, 0x49, 0xf3,
-	0x42, 0xed, 0xe6, 0x26, 0xa2, 0x5c, 0xbf, 0x85, 0x6e, 0x44, 0x48, 0x6c, 0x79, 0x0e, 0xa1, 0xdc,
-	0xe3, 0xf7, 0xfa, 0x93, 0xf2, 0x35, 0x3c, 0x21, 0x24, 0x9e, 0x26, 0x32, 0xb1, 0x8d, 0xa8, 0x40,
-	0x8b, 0xcb, 0x8e, 0xed, 0x2b, 0xfd, 0xa9, 0x34, 0x79, 0x96, 0xdd, 0x5c, 0xfb, 0x8a, 0x86, 0x3f,
-	0xf8, 0xc4, 0x71, 0x49, 0x40, 0xa8, 0xd8, 0xbc, 0xd0, 0x42, 0x7f, 0x06, 0x88, 0x62, 0xef, 0x56,
-	0x65, 0x41, 0x7f, 0x56, 0x4e, 0xbe, 0xda, 0xef, 0xc9, 0x2d, 0x2f, 0x57, 0x71, 0xc1, 0x02, 0xbd,
-	0x2e, 0xd8, 0x33, 0x5d, 0x97, 0xf6, 0xbf, 0x78, 0xc4, 0x3e, 0xcb, 0x58, 0xc1, 0x04, 0xbd, 0x86,
-	0x4e, 0x42, 0x59, 0xa2, 0xd0, 0xf5, 0x8f, 0xca, 0xc7, 0x76, 0xa2, 0x64, 0xe5, 0x6b, 0xdd, 0x8e,
-	0x72, 0xae, 0x61, 0xc1, 0xfa, 0x19, 0x76, 0x51, 0x17, 0x5a, 0xef, 0xe6, 0xa3, 0xf1, 0x77, 0xd3,
-	0xf9, 0x78, 0xd4, 0xaf, 0xa0, 0x16, 0xd4, 0xc6, 0xb3, 0x93, 0xb3, 0xf3, 0xbe, 0x86, 0x3a, 0xd0,
-	0x7c, 0x6b, 0x1e, 0x5b, 0x6f, 0xe7, 0x6f, 0xce, 0xfb, 0x6b, 0x42, 0x6f, 0x38, 0x39, 0x9c, 0x2b,
-	0x72, 0x1d, 0xf5, 0xa1, 0x23, 0xc9, 0xc3, 0xf9, 0xc8, 0x7a, 0x6b, 0x1e, 0xf7, 0xab, 0x68, 0x03,
-	0xda, 0x4a, 0xc1, 0x94, 0x8c, 0x5a, 0x11, 0x89, 0xff, 0xa7, 0x41, 0x2b, 0xab, 0x48, 0x34, 0x80,
-	0x16, 0xf7, 0x02, 0xc2, 0x38, 0x0e, 0x22, 0x89, 0xb8, 0xed, 0x83, 0x7e, 0xf1, 0x84, 0xce, 0xbc,
-	0x80, 0x98, 0xb9, 0x0a, 0x7a, 0x02, 0xf5, 0xe8, 0xca, 0xb3, 0x3c, 0x47, 0x02, 0x71, 0xc7, 0xac,
-	0x45, 0x57, 0xde, 0xd4, 0x41, 0x9f, 0x41, 0x3b, 0xc1, 0x69, 0x6b, 0x76, 0x38, 0xd4, 0xab, 0x52,
-	0x06, 0x09, 0x6b, 0x76, 0x38, 0x14, 0x37, 0x34, 0x8a, 0xc3, 0x88, 0xc4, 0xdc, 0x23, 0x2c, 0x41,
-	0x64, 0x94, 0x27, 0x28, 0x95, 0x98, 0x05, 0x2d, 0xe3, 0x47, 0x0d, 0x20, 0x17, 0xa1, 0x5f, 0x42,
-	0x57, 0x1e, 0x7d, 0x6c, 0x2d, 0x88, 0xe7, 0x2e, 0x78, 0xd2, 0x38, 0x3a, 0x8a, 0x39, 0x91, 0x3c,
-	0xf4, 0x39, 0x74, 0x7c, 0x72, 0xc9, 0xad, 0x62, 0x13, 0x69, 0x9a, 0x6d, 0xc1, 0x1b, 0x26, 0x8d,
-	0xe4, 0xb7, 0x20, 0x16, 0xe6, 0x51, 0x3b, 0x74, 0x08, 0xd3, 0xd7, 0x77, 0xd7, 0x8b, 0x60, 0x31,
-	0x4c, 0x25, 0x66, 0x41, 0xc9, 0x38, 0x84, 0xcd, 0x15, 0x34, 0x40, 0x2f, 0xa1, 0x49, 0x7c, 0x59,
-	0x88, 0x4c, 0xd7, 0xa4, 0x97, 0x2c, 0x73, 0x59, 0x4f, 0xce, 0x34, 0x8c, 0x3f, 0xc0, 0xf6, 0x43,
-	0x38, 0xb0, 0x9c, 0x39, 0x6d, 0x39, 0x73, 0xc6, 0x25, 0x74, 0x4b, 0xa0, 0x57, 0x38, 0x02, 0xad,
-	0x78, 0x04, 0x3b, 0xd0, 0xcc, 0xae, 0x9a, 0x6a, 0x9d, 0x19, 0x8d, 0x0c, 0xe8, 0x72, 0x9f, 0x59,
-	0x36, 0x89, 0xb9, 0xb5, 0xc0, 0x6c, 0x91, 0x1c, 0x5e, 0x9b, 0xfb, 0x6c, 0x48, 0x62, 0x3e, 0xc1,
-	0x6c, 0x61, 0xbc, 0x83, 0x4e, 0xf1, 0x4a, 0x3e, 0x16, 0x06, 0x41, 0x55, 0xb8, 0x49, 0x42, 0xc8,
-	0x6f, 0x11, 0x3a, 0x20, 0x1c, 0xcb, 0xda, 0x57, 0x9e, 0x33, 0xda, 0x08, 0xa0, 0x5d, 0xb8, 0x79,
-	0x8f, 0x77, 0x7d, 0x47, 0x76, 0x24, 0xa6, 0xaf, 0xed, 0xae, 0x8b, 0xae, 0x9f, 0x90, 0x68, 0x00,
-	0xcd, 0x80, 0xb9, 0x16, 0xbf, 0x4f, 0xc6, 0x9f, 0x5e, 0xde, 0x96, 0x44, 0x16, 0x67, 0xcc, 0x3d,
-	0xbb, 0x8f, 0x88, 0xd9, 0x08, 0xd4, 0x87, 0x11, 0x42, 0xbb, 0xd0, 0x0f, 0x1f, 0x09, 0x57, 0x5c,
-	0xef, 0x5a, 0x79, 0xbd, 0x1f, 0x1c, 0xf0, 0x0e, 0x20, 0x6f, 0x75, 0x8f, 0xc4, 0xfb, 0x15, 0x54,
-	0x93, 0x58, 0x0f, 0x57, 0x49, 0xf5, 0x27, 0x45, 0xf6, 0x55, 0x64, 0xd5, 0xca, 0x7f, 0xf6, 0xc4,
-	0x7e, 0xa3, 0xce, 0x31, 0x9d, 0xde, 0x7e, 0x5d, 0x1e, 0x25, 0xdb, 0x07, 0x1b, 0x99, 0xb5, 0x62,
-	0x67, 0xb3, 0xa5, 0xf1, 0x1d, 0xa0, 0x55, 0x04, 0x44, 0xaf, 0x96, 0x1d, 0x3c, 0x5d, 0x82, 0xcb,
-	0x15, 0x3f, 0xe7, 0xd0, 0x48, 0x78, 0xe8, 0x19, 0x34, 0x18, 0xb9, 0xb6, 0xe8, 0x4d, 0x90, 0x6c,
-	0xb7, 0xce, 0xc8, 0xf5, 0xfc, 0x26, 0x10, 0xd5, 0x59, 0x38, 0x55, 0x95, 0xd7, 0xcf, 0x97, 0xd0,
-	0x79, 0x5d, 0x26, 0xa2, 0x84, 0xbf, 0xff, 0x59, 0x83, 0x5e, 0x39, 0x2c, 0xfa, 0x12, 0x36, 0xf2,
-	0xb9, 0xde, 0xa2, 0x38, 0x50, 0x99, 0x6d, 0x99, 0xbd, 0x9c, 0x3d, 0xc7, 0x01, 0x11, 0xa3, 0xb3,
-	0x90, 0xb2, 0x08, 0xdb, 0x6a, 0x74, 0x6e, 0x99, 0x39, 0x03, 0x6d, 0x41, 0x8d, 0xdf, 0xa5, 0x70,
-	0xd9, 0x32, 0xab, 0xfc, 0x6e, 0xea, 0x08, 0x24, 0x4b, 0x57, 0x14, 0xff, 0xc0, 0x08, 0x4f, 0xf0,
-	0x32, 0x5d, 0xa6, 0x29, 0x78, 0xe8, 0x25, 0xa0, 0x54, 0x89, 0x79, 0x41, 0x8a, 0x79, 0x35, 0xb9,
-	0xdd, 0x7e, 0x22, 0x39, 0xf5, 0x82, 0x04, 0xf7, 0xe6, 0x80, 0x0a, 0xcb, 0xb5, 0x43, 0x7a, 0xe9,
-	0xb9, 0x2c, 0x19, 0x63, 0x3f, 0x1b, 0xa8, 0x87, 0xca, 0x60, 0x98, 0x69, 0x0c, 0xa5, 0xc2, 0x09,
-	0xb6, 0xaf, 0xb0, 0x4b, 0xcc, 0x4d, 0x7b, 0x49, 0xc0, 0x8c, 0x7f, 0x6b, 0xd0, 0x29, 0x0e, 0xca,
-	0x68, 0x00, 0x10, 0x64, 0xf3, 0x6c, 0x72, 0x64, 0xbd, 0xf2, 0xa4, 0x6b, 0x16, 0x34, 0x3e, 0xb8,
-	0xb1, 0x14, 0xe1, 0xab, 0x5a, 0x86, 0x2f, 0xe3, 0x9f, 0x1a, 0x6c, 0xae, 0x4c, 0x1c, 0x8f, 0x01,
-	0xd4, 0x87, 0x06, 0x7e, 0x0e, 0x3d, 0x8f, 0x59, 0x0e, 0xb1, 0x7d, 0x1c, 0x63, 0x91, 0x02, 0x79,
-	0x54, 0x4d, 0xb3, 0xeb, 0xb1, 0x51, 0xce, 0x34, 0xfe, 0x08, 0xcd, 0xd4, 0x5a, 0x94, 0x9f, 0x47,
-	0xed, 0x62, 0xf9, 0x79, 0xd4, 0x16, 0xe5, 0x57, 0xa8, 0xcb, 0xb5, 0x62, 0x5d, 0x1a, 0x97, 0xb0,
-	0xb9, 0xf2, 0x86, 0x40, 0xdf, 0x42, 0x9f, 0x11, 0xff, 0x52, 0x0e, 0x8f, 0x71, 0xa0, 0x62, 0x6b,
-	0xe5, 0x05, 0x67, 0x10, 0xb1, 0x21, 0x34, 0xa7, 0xb9, 0xa2, 0xb8, 0xef, 0x62, 0x18, 0xa2, 0xc9,
-	0xbd, 0x56, 0x84, 0x71, 0x01, 0x68, 0xf5, 0xd5, 0x81, 0xbe, 0x80, 0x9a, 0x7c, 0xe4, 0x3c, 0xda,
-	0xa6, 0x94, 0x58, 0xe2, 0x14, 0xc1, 0xce, 0x7b, 0x70, 0x8a, 0x60, 0xc7, 0xf8, 0x2b, 0xd4, 0x55,
-	0x0c, 0x71, 0x66, 0xa4, 0xf4, 0x0a, 0x34, 0x33, 0xfa, 0xbd, 0x18, 0xfb, 0xf0, 0x10, 0x61, 0x34,
-	0xa0, 0x26, 0x1f, 0x01, 0xc6, 0xdf, 0x00, 0xad, 0x8e, 0xba, 0xa2, 0x89, 0x31, 0x8e, 0x63, 0x6e,
-	0x95, 0xaf, 0x7e, 0x5b, 0x32, 0x4f, 0xd5, 0xfd, 0xff, 0x14, 0xda, 0x84, 0x3a, 0x56, 0xf9, 0x10,
-	0x5a, 0x84, 0x3a, 0x4a, 0x6e, 0x1c, 0xc1, 0xd6, 0x03, 0x03, 0x30, 0xda, 0x83, 0x66, 0x82, 0x32,
-	0x69, 0x2b, 0x5f, 0x81, 0xb3, 0x4c, 0xc1, 0x38, 0x86, 0xed, 0x87, 0x86, 0x4a, 0xb4, 0x9f, 0x63,
-	0xad, 0xf2, 0x91, 0x3d, 0x5a, 0x12, 0x45, 0x85, 0xd4, 0x19, 0x04, 0x1b, 0xff, 0xd5, 0xa0, 0x5b,
-	0x12, 0xe5, 0x68, 0xa1, 0x15, 0xd0, 0xe2, 0xfd, 0x00, 0xf3, 0x29, 0x40, 0x7e, 0x7b, 0x13, 0x94,
-	0x29, 0x70, 0xd0, 0xc7, 0xd0, 0xba, 0xf0, 0x43, 0xfb, 0x4a, 0xe4, 0x44, 0x5e, 0xac, 0xaa, 0xd9,
-	0x94, 0x8c, 0x53, 0x72, 0x8d, 0x76, 0xa1, 0x23, 0x52, 0xe5, 0x51, 0x4b, 0xb2, 0x12, 0x74, 0x01,
-	0x46, 0xae, 0xa7, 0xf4, 0x48, 0x70, 0x8c, 0xef, 0xe1, 0xc9, 0x83, 0x13, 0x30, 0x3a, 0x58, 0x99,
-	0x7e, 0x9e, 0x2e, 0x6d, 0x77, 0xac, 0xc4, 0x85, 0x19, 0xe8, 0x1c, 0x7a, 0x65, 0x19, 0xfa, 0x0a,
-	0xea, 0x2a, 0x1b, 0x49, 0xe1, 0x3f, 0x92, 0xb2, 0x44, 0xa9, 0xf8, 0x03, 0x23, 0x69, 0x67, 0x69,
-	0x73, 0xf8, 0x4b, 0xe6, 0x3a, 0x05, 0xf0, 0xe7, 0xb0, 0xc1, 0xef, 0xac, 0xd2, 0xf6, 0x92, 0x81,
-	0x91, 0xdf, 0x9d, 0x66, 0x1b, 0x2c, 0xbb, 0x2c, 0xfe, 0x13, 0x31, 0xbe, 0x84, 0x8d, 0xa5, 0x07,
-	0x87, 0xb8, 0x74, 0x24, 0x8e, 0xc3, 0x38, 0x39, 0x1f, 0x45, 0x18, 0xef, 0xa0, 0x95, 0x8d, 0x8d,
-	0xa2, 0x03, 0x15, 0x9a, 0x85, 0xfc, 0x16, 0x31, 0x6e, 0x49, 0xcc, 0xc4, 0x01, 0xa9, 0xf3, 0x4b,
-	0xc9, 0xf7, 0x4d, 0x4e, 0xbf, 0xf9, 0x13, 0xb4, 0x0b, 0x9d, 0x78, 0xf9, 0x71, 0xd0, 0x85, 0xd6,
-	0xd1, 0x9b, 0xb7, 0xc3, 0xef, 0xad, 0xd9, 0xe9, 0x71, 0x5f, 0x13, 0x6f, 0x80, 0xe9, 0x68, 0x3c,
-	0x3f, 0x9b, 0x9e, 0x9d, 0x4b, 0xce, 0xda, 0xc1, 0x3f, 0xa0, 0xae, 0x26, 0x21, 0xf4, 0x0d, 0x74,
-	0xd4, 0xd7, 0x29, 0x8f, 0x09, 0x0e, 0xd0, 0xca, 0xc5, 0xde, 0x59, 0xe1, 0x18, 0x95, 0x17, 0xda,
-	0x2b, 0x0d, 0x7d, 0x01, 0xd5, 0x13, 0x8f, 0xba, 0xa8, 0xfc, 0x48, 0xdf, 0x29, 0x93, 0x46, 0xe5,
-	0xe8, 0xab, 0xbf, 0xef, 0xb9, 0x1e, 0x5f, 0xdc, 0x5c, 0x88, 0x4e, 0xb3, 0xbf, 0xb8, 0x8f, 0x48,
-	0xac, 0xa6, 0xf2, 0xfd, 0x4b, 0x7c, 0x11, 0x7b, 0xf6, 0xbe, 0xfc, 0x2f, 0xc6, 0xf6, 0x95, 0xd9,
-	0x45, 0x5d, 0x92, 0x5f, 0xff, 0x3f, 0x00, 0x00, 0xff, 0xff, 0xdd, 0x1d, 0xb3, 0x7e, 0x5f, 0x13,
-	0x00, 0x00,
+func init() { proto.RegisterFile("gossip/message.proto", fileDescriptor_message_6bace4d0c8546752) }
+
+var fileDescriptor_message_6bace4d0c8546752 = []byte{
+	// 1942 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x53, 0xe3, 0xc8,
+	0x15, 0xb7, 0xc0, 0x36, 0xf6, 0xf3, 0x1f, 0x4c, 0xc3, 0xcc, 0x68, 0xd9, 0xcd, 0x2e, 0x51, 0x32,
+	0xbb, 0x93, 0x30, 0x6b, 0x26, 0x6c, 0x52, 0xd9, 0xaa, 0x4d, 0x32, 0x05, 0x86, 0xc5, 0xd4, 0x0e,
+	0x0c, 0x11, 0x4c, 0x25, 0xe4, 0xa2, 0x6a, 0xa4, 0x46, 0x56, 0x21, 0xb5, 0x84, 0xba, 0xcd, 0xc2,
+	0x39, 0x87, 0x54, 0xe5, 0x92, 0x4f, 0x90, 0x43, 0x4e, 0x39, 0xe4, 0x03, 0xe4, 0xeb, 0xa5, 0xba,
+	0x5b, 0x7f, 0x5a, 0xb6, 0x99, 0xaa, 0x99, 0xaa, 0xdc, 0xf4, 0xfe, 0xf6, 0xeb, 0xd7, 0xaf, 0x7f,
+	0xef, 0xb5, 0x60, 0xc3, 0x8f, 0x19, 0x0b, 0x92, 0x9d, 0x88, 0x30, 0x86, 0x7d, 0x32, 0x4c, 0xd2,
+	0x98, 0xc7, 0xa8, 0xa9, 0xb8, 0x9b, 0xcf, 0xdc, 0x38, 0x8a, 0x62, 0xba, 0xe3, 0xc6, 0x61, 0x48,
+	0x5c, 0x1e, 0xc4, 0x54, 0x29, 0x58, 0x7f, 0x35, 0xa0, 0x75, 0x48, 0xef, 0x48, 0x18, 0x27, 0x04,
+	0x99, 0xb0, 0x92, 0xe0, 0x87, 0x30, 0xc6, 0x9e, 0x69, 0x6c, 0x19, 0x2f, 0xba, 0x76, 0x4e, 0xa2,
+	0xcf, 0xa0, 0xcd, 0x02, 0x9f, 0x62, 0x3e, 0x4d, 0x89, 0xb9, 0x24, 0x65, 0x25, 0x03, 0xbd, 0x86,
+	0x55, 0x46, 0xdc, 0x94, 0x70, 0x87, 0x64, 0xae, 0xcc, 0xe5, 0x2d, 0xe3, 0x45, 0x67, 0xf7, 0xe9,
+	0x50, 0xad, 0x3f, 0x3c, 0x97, 0xe2, 0x7c, 0x21, 0xbb, 0xcf, 0x2a, 0xb4, 0x35, 0x86, 0x7e, 0x55,
+	0xe3, 0x63, 0x43, 0xb1, 0xf6, 0xa0, 0xa9, 0x3c, 0xa1, 0x97, 0x30, 0x08, 0x28, 0x27, 0x29, 0xc5,
+	0xe1, 0x21, 0xf5, 0x92, 0x38, 0xa0, 0x5c, 0xba, 0x6a, 0x8f, 0x6b, 0xf6, 0x9c, 0x64, 0xbf, 0x0d,
+	0x2b, 0x6e, 0x4c, 0x39, 0xa1, 0xdc, 0xfa, 0x5b, 0x07, 0x7a, 0x47, 0x32, 0xec, 0x13, 0x95, 0x4b,
+	0xb4, 0x01, 0x0d, 0x1a, 0x53, 0x97, 0x48, 0xfb, 0xba, 0xad, 0x08, 0x11, 0xa2, 0x3b, 0xc1, 0x94,
+	0x92, 0x30, 0x0b, 0x23, 0x27, 0xd1, 0x36, 0x2c, 0x73, 0xec, 0xcb, 0x1c, 0xf4, 0x77, 0x3f, 0xc9,
+	0x73, 0x50, 0xf1, 0x39, 0xbc, 0xc0, 0xbe, 0x2d, 0xb4, 0xd0, 0x37, 0xd0, 0xc6, 0x61, 0x70, 0x47,
+	0x9c, 0x88, 0xf9, 0x66, 0x43, 0xa6, 0x6d, 0x23, 0x37, 0xd9, 0x13, 0x82, 0xcc, 0x62, 0x5c, 0xb3,
+	0x5b, 0x52, 0xf1, 0x84, 0xf9, 0xe8, 0xd7, 0xb0, 0x12, 0x91, 0xc8, 0x49, 0xc9, 0xad, 0xd9, 0x94,
+	0x26, 0xc5, 0x2a, 0x27, 0x24, 0xba, 0x22, 0x29, 0x9b, 0x04, 0x89, 0x4d, 0x6e, 0xa7, 0x84, 0xf1,
+	0x71, 0xcd, 0x6e, 0x46, 0x24, 0xb2, 0xc9, 0x2d, 0xfa, 0x4d, 0x6e, 0xc5, 0xcc, 0x15, 0x69, 0xb5,
+	0xb9, 0xc8, 0x8a, 0x25, 0x31, 0x65, 0xa4, 0x30, 0x63, 0xe8, 0x15, 0xb4, 0x3c, 0xcc, 0xb1, 0x0c,
+	0xb0, 0x25, 0xed, 0xd6, 0x73, 0xbb, 0x03, 0xcc, 0x71, 0x19, 0xdf, 0x8a, 0x50, 0x13, 0xe1, 0x6d,
+	0x43, 0x63, 0x42, 0xc2, 0x30, 0x36, 0xdb, 0x55, 0x75, 0x95, 0x82, 0xb1, 0x10, 0x8d, 0x6b, 0xb6,
+	0xd2, 0x41, 0x3b, 0x99, 0x7b, 0x2f, 0xf0, 0x4d, 0x90, 0xfa, 0x48, 0x77, 0x7f, 0x10, 0xf8, 0x6a,
+	0x17, 0xd2, 0xfb, 0x41, 0xe0, 0x17, 0xf1, 0x88, 0xdd, 0x77, 0xe6, 0xe3, 0x29, 0xf7, 0x2d, 0x2d,
+	0xd4, 0xc6, 0x3b, 0xd2, 0x62, 0x9a, 0x78, 0x98, 0x13, 0xb3, 0x3b, 0xbf, 0xca, 0x3b, 0x29, 0x19,
+	0xd7, 0x6c, 0xf0, 0x0a, 0x0a, 0x3d, 0x87, 0x06, 0x89, 0x12, 0xfe, 0x60, 0xf6, 0xa4, 0x41, 0x2f,
+	0x37, 0x38, 0x14, 0x4c, 0xb1, 0x01, 0x29, 0x45, 0xdb, 0x50, 0x77, 0x63, 0x4a, 0xcd, 0xbe, 0xd4,
+	0x7a, 0x92, 0x6b, 0x8d, 0x62, 0x4a, 0x0f, 0x19, 0xc7, 0x57, 0x61, 0xc0, 0x26, 0xe3, 0x9a, 0x2d,
+	0x95, 0xd0, 0x2e, 0x00, 0xe3, 0x98, 0x13, 0x27, 0xa0, 0xd7, 0xb1, 0xb9, 0x2a, 0x4d, 0xd6, 0x8a,
+	0x6b, 0x22, 0x24, 0xc7, 0xf4, 0x5a, 0x64, 0xa7, 0xcd, 0x72, 0x02, 0xed, 0x43, 0x5f, 0xd9, 0x30,
+	0x8a, 0x13, 0x36, 0x89, 0xb9, 0x39, 0xa8, 0x1e, 0x7a, 0x61, 0x77, 0x9e, 0x29, 0x8c, 0x6b, 0x76,
+	0x4f, 0x9a, 0xe4, 0x0c, 0x74, 0x02, 0xeb, 0xe5, 0xba, 0x4e, 0x32, 0x0d, 0x43, 0x99, 0xbf, 0x35,
+	0xe9, 0xe8, 0xb3, 0x39, 0x47, 0x67, 0xd3, 0x30, 0x2c, 0x13, 0x39, 0x60, 0x33, 0x7c, 0xb4, 0x07,
+	0xca, 0xbf, 0x93, 0x2a, 0x25, 0x13, 0x55, 0x0b, 0xca, 0x26, 0x51, 0xcc, 0x89, 0x74, 0x57, 0xba,
+	0xe9, 0x32, 0x8d, 0x46, 0x07, 0xf9, 0xae, 0xd2, 0xac, 0xe4, 0xcc, 0x75, 0xe9, 0xe3, 0xd3, 0x85,
+	0x3e, 0x8a, 0xaa, 0xec, 0x31, 0x9d, 0x21, 0x72, 0x13, 0x12, 0xec, 0xa9, 0xe2, 0x95, 0x25, 0xba,
+	0x51, 0xcd, 0xcd, 0x9b, 0x42, 0x5a, 0x16, 0x6a, 0xaf, 0x34, 0x11, 0xe5, 0xfa, 0x1d, 0xf4, 0x12,
+	0x42, 0x52, 0x27, 0xf0, 0x08, 0xe5, 0x01, 0x7f, 0x30, 0x9f, 0x54, 0xaf, 0xe1, 0x19, 0x21, 0xe9,
+	0x71, 0x26, 0x13, 0xdb, 0x48, 0x34, 0x5a, 0x5c, 0x76, 0xec, 0xde, 0x98, 0x4f, 0xa5, 0xc9, 0xb3,
+	0xe2, 0xe6, 0xba, 0x37, 0x34, 0xfe, 0x31, 0x24, 0x9e, 0x4f, 0x22, 0x42, 0xc5, 0xe6, 0x85, 0x16,
+	0xfa, 0x03, 0x40, 0x92, 0x06, 0x77, 0x2a, 0x0b, 0xe6, 0xb3, 0x6a, 0xf2, 0xd5, 0x7e, 0xcf, 0xee,
+	0x78, 0xb5, 0x8a, 0x35, 0x0b, 0xf4, 0x5a, 0xb3, 0x67, 0xa6, 0x29, 0xed, 0x7f, 0xf2, 0x88, 0x7d,
+	0x91, 0x31, 0xcd, 0x04, 0xbd, 0x86, 0x6e, 0x46, 0x39, 0xa2, 0xd0, 0xcd, 0x4f, 0xaa, 0xc7, 0x76,
+	0xa6, 0x64, 0xd5, 0x6b, 0xdd, 0x49, 0x4a, 0xae, 0xe5, 0xc0, 0xf2, 0x05, 0xf6, 0x51, 0x0f, 0xda,
+	0xef, 0x4e, 0x0f, 0x0e, 0xbf, 0x3f, 0x3e, 0x3d, 0x3c, 0x18, 0xd4, 0x50, 0x1b, 0x1a, 0x87, 0x27,
+	0x67, 0x17, 0x97, 0x03, 0x03, 0x75, 0xa1, 0xf5, 0xd6, 0x3e, 0x72, 0xde, 0x9e, 0xbe, 0xb9, 0x1c,
+	0x2c, 0x09, 0xbd, 0xd1, 0x78, 0xef, 0x54, 0x91, 0xcb, 0x68, 0x00, 0x5d, 0x49, 0xee, 0x9d, 0x1e,
+	0x38, 0x6f, 0xed, 0xa3, 0x41, 0x1d, 0xad, 0x42, 0x47, 0x29, 0xd8, 0x92, 0xd1, 0xd0, 0x91, 0xf8,
+	0xdf, 0x06, 0xb4, 0x8b, 0x8a, 0x44, 0x43, 0x68, 0xf3, 0x20, 0x22, 0x8c, 0xe3, 0x28, 0x91, 0x88,
+	0xdb, 0xd9, 0x1d, 0xe8, 0x27, 0x74, 0x11, 0x44, 0xc4, 0x2e, 0x55, 0xd0, 0x13, 0x68, 0x26, 0x37,
+	0x81, 0x13, 0x78, 0x12, 0x88, 0xbb, 0x76, 0x23, 0xb9, 0x09, 0x8e, 0x3d, 0xf4, 0x05, 0x74, 0x32,
+	0x9c, 0x76, 0x4e, 0xf6, 0x46, 0x66, 0x5d, 0xca, 0x20, 0x63, 0x9d, 0xec, 0x8d, 0xc4, 0x0d, 0x4d,
+	0xd2, 0x38, 0x21, 0x29, 0x0f, 0x08, 0x33, 0x1b, 0x55, 0xac, 0x38, 0x2b, 0x24, 0xb6, 0xa6, 0x65,
+	0xfd, 0xd7, 0x00, 0x28, 0x45, 0xe8, 0x67, 0xd0, 0x93, 0x47, 0x9f, 0x3a, 0x13, 0x12, 0xf8, 0x13,
+	0x9e, 0x35, 0x8e, 0xae, 0x62, 0x8e, 0x25, 0x0f, 0xfd, 0x14, 0xba, 0x21, 0xb9, 0xe6, 0x8e, 0xde,
+	0x44, 0x5a, 0x76, 0x47, 0xf0, 0x46, 0x8a, 0x85, 0x7e, 0x05, 0x22, 0xb0, 0x80, 0xba, 0xb1, 0x47,
+	0x98, 0xb9, 0xbc, 0xb5, 0xac, 0x83, 0xc5, 0x28, 0x97, 0xd8, 0x9a, 0x12, 0x1a, 0xc2, 0xfa, 0xd5,
+	0x34, 0xbc, 0x71, 0xd4, 0xd5, 0xe2, 0x29, 0xa6, 0xec, 0x9a, 0xa4, 0x72, 0x9b, 0x2d, 0x7b, 0x4d,
+	0x88, 0x64, 0x46, 0x2f, 0x32, 0x81, 0xb5, 0x07, 0x6b, 0x73, 0xe8, 0x81, 0x5e, 0x42, 0x8b, 0x84,
+	0xb2, 0x70, 0x99, 0x69, 0x6c, 0x2d, 0xeb, 0x99, 0x2e, 0x7a, 0x78, 0xa1, 0x61, 0xfd, 0x16, 0x36,
+	0x16, 0xe1, 0xc6, 0x6c, 0xa6, 0x8d, 0xd9, 0x4c, 0x5b, 0xd7, 0xd0, 0xab, 0x80, 0xa4, 0x76, 0x64,
+	0x86, 0x7e, 0x64, 0x9b, 0xd0, 0x2a, 0xae, 0xa6, 0x6a, 0xb5, 0x05, 0x8d, 0x2c, 0xe8, 0xf1, 0x90,
+	0x39, 0x2e, 0x49, 0xb9, 0x33, 0xc1, 0x6c, 0x92, 0x1d, 0x76, 0x87, 0x87, 0x6c, 0x44, 0x52, 0x3e,
+	0xc6, 0x6c, 0x62, 0xbd, 0x83, 0xae, 0x7e, 0x85, 0x1f, 0x5b, 0x06, 0x41, 0x5d, 0xb8, 0xc9, 0x96,
+	0x90, 0xdf, 0x62, 0xe9, 0x88, 0x70, 0x2c, 0xef, 0x8a, 0xf2, 0x5c, 0xd0, 0x56, 0x04, 0x1d, 0xed,
+	0xa6, 0x3e, 0x3e, 0x25, 0x78, 0xb2, 0x83, 0x31, 0x73, 0x69, 0x6b, 0x59, 0x4c, 0x09, 0x19, 0x89,
+	0x86, 0xd0, 0x8a, 0x98, 0xef, 0xf0, 0x87, 0x6c, 0x5c, 0xea, 0x97, 0x6d, 0x4c, 0x64, 0xf1, 0x84,
+	0xf9, 0x17, 0x0f, 0x09, 0xb1, 0x57, 0x22, 0xf5, 0x61, 0xc5, 0xd0, 0xd1, 0xfa, 0xe7, 0x23, 0xcb,
+	0xe9, 0xf1, 0x2e, 0x55, 0xe3, 0xfd, 0xe0, 0x05, 0xef, 0x01, 0xca, 0xd6, 0xf8, 0xc8, 0x7a, 0x3f,
+	0x87, 0x7a, 0xb6, 0xd6, 0xe2, 0x2a, 0xa9, 0x7f, 0xd4, 0xca, 0x21, 0x40, 0xd9, 0xfa, 0xff, 0xef,
+	0x89, 0xfd, 0x16, 0x3a, 0x1a, 0xe0, 0xa1, 0x5f, 0x54, 0x47, 0xcf, 0xce, 0xee, 0x6a, 0x61, 0xad,
+	0xd8, 0xc5, 0x2c, 0x6a, 0x7d, 0x0f, 0x68, 0x1e, 0x31, 0xd1, 0xab, 0x59, 0x07, 0x4f, 0x67, 0xe0,
+	0x75, 0xce, 0xcf, 0x25, 0xac, 0x64, 0x3c, 0xf4, 0x0c, 0x56, 0x18, 0xb9, 0x75, 0xe8, 0x34, 0xca,
+	0xb6, 0xdb, 0x64, 0xe4, 0xf6, 0x74, 0x1a, 0x89, 0xea, 0xd4, 0x4e, 0x55, 0x7e, 0x0b, 0x08, 0xa9,
+	0xa0, 0xf9, 0xb2, 0x4c, 0x44, 0x05, 0xaf, 0xff, 0xb1, 0x04, 0xfd, 0xea, 0xb2, 0xe8, 0x2b, 0x58,
+	0x2d, 0xdf, 0x01, 0x0e, 0xc5, 0x91, 0xca, 0x6c, 0xdb, 0xee, 0x97, 0xec, 0x53, 0x1c, 0x11, 0x31,
+	0x6a, 0x0b, 0x29, 0x4b, 0xb0, 0xab, 0x46, 0xed, 0xb6, 0x5d, 0x32, 0xd0, 0x3a, 0x34, 0xf8, 0x7d,
+	0x0e, 0xaf, 0x6d, 0xbb, 0xce, 0xef, 0x8f, 0x3d, 0x81, 0x7c, 0x79, 0x44, 0xe9, 0x8f, 0x8c, 0xf0,
+	0x0c, 0x5f, 0xf3, 0x30, 0x6d, 0xc1, 0x43, 0x2f, 0x01, 0xe5, 0x4a, 0x2c, 0x88, 0x72, 0x8c, 0x6c,
+	0xc8, 0xed, 0x0e, 0x32, 0xc9, 0x79, 0x10, 0x65, 0x38, 0x79, 0x0a, 0x48, 0x0b, 0xd7, 0x8d, 0xe9,
+	0x75, 0xe0, 0xb3, 0x6c, 0xec, 0xfd, 0x62, 0xa8, 0x1e, 0x36, 0xc3, 0x51, 0xa1, 0x31, 0x92, 0x0a,
+	0x67, 0xd8, 0xbd, 0xc1, 0x3e, 0xb1, 0xd7, 0xdc, 0x19, 0x01, 0xb3, 0xfe, 0x6e, 0x40, 0x57, 0x1f,
+	0xac, 0xd1, 0x10, 0x20, 0x2a, 0xe6, 0xdf, 0xec, 0xc8, 0xfa, 0xd5, 0xc9, 0xd8, 0xd6, 0x34, 0x3e,
+	0xb8, 0x11, 0xe9, 0xf0, 0x55, 0xaf, 0xc2, 0x97, 0xf5, 0x4f, 0x03, 0xd6, 0xe6, 0x26, 0x94, 0xc7,
+	0x00, 0xea, 0x43, 0x17, 0x7e, 0x0e, 0xfd, 0x80, 0x39, 0x1e, 0x71, 0x43, 0x9c, 0x62, 0x91, 0x02,
+	0x79, 0x54, 0x2d, 0xbb, 0x17, 0xb0, 0x83, 0x92, 0x29, 0xe2, 0x4b, 0xd2, 0x20, 0x4e, 0xf3, 0xf8,
+	0x7a, 0x76, 0x41, 0x5b, 0xbf, 0x83, 0x56, 0xee, 0x59, 0x94, 0x66, 0x40, 0x5d, 0xbd, 0x34, 0x03,
+	0xea, 0x8a, 0xd2, 0xd4, 0x6a, 0x76, 0x49, 0xaf, 0x59, 0xeb, 0x1a, 0xd6, 0xe6, 0xde, 0x23, 0xe8,
+	0x3b, 0x18, 0x30, 0x12, 0x5e, 0xcb, 0x41, 0x34, 0x8d, 0x54, 0x5c, 0xc6, 0x96, 0xb1, 0x10, 0x3e,
+	0x56, 0x85, 0xe6, 0x71, 0xa9, 0x28, 0xb0, 0x40, 0x0c, 0x56, 0x34, 0xbb, 0xf3, 0x8a, 0xb0, 0xae,
+	0x00, 0xcd, 0xbf, 0x60, 0xd0, 0x97, 0xd0, 0x90, 0x0f, 0xa6, 0x47, 0x5b, 0x98, 0x12, 0x4b, 0x0c,
+	0x23, 0xd8, 0x7b, 0x0f, 0x86, 0x11, 0xec, 0x59, 0x7f, 0x82, 0xa6, 0x5a, 0x43, 0xe4, 0x8b, 0x54,
+	0x5e, 0x94, 0x76, 0x41, 0xbf, 0x17, 0x7f, 0x17, 0x0f, 0x24, 0xd6, 0x0a, 0x34, 0xe4, 0x83, 0xc2,
+	0xfa, 0x33, 0xa0, 0xf9, 0xb1, 0x59, 0x34, 0x38, 0xc6, 0x71, 0xca, 0x9d, 0x2a, 0x2c, 0x74, 0x24,
+	0xf3, 0x5c, 0x61, 0xc3, 0xe7, 0xd0, 0x21, 0xd4, 0x73, 0xaa, 0x87, 0xd0, 0x26, 0xd4, 0x53, 0x72,
+	0x8b, 0xc1, 0xfa, 0x82, 0x61, 0x1a, 0x6d, 0x43, 0x2b, 0x43, 0xa0, 0xbc, 0xcd, 0xcf, 0x41, 0x5d,
+	0xa1, 0x80, 0x76, 0x60, 0xdd, 0x8d, 0xa3, 0x24, 0x25, 0x8c, 0x11, 0xcf, 0x29, 0xec, 0xd4, 0x26,
+	0x51, 0x29, 0xca, 0x2c, 0x99, 0x75, 0x04, 0x1b, 0x8b, 0x26, 0x5a, 0xb4, 0x53, 0x02, 0xb7, 0x5a,
+	0xb4, 0x78, 0x31, 0x65, 0x8a, 0x0a, 0xf6, 0x0b, 0x3c, 0xb7, 0xfe, 0x65, 0x40, 0xaf, 0x22, 0x2a,
+	0xa1, 0xc7, 0xd0, 0xa0, 0xe7, 0xfd, 0x68, 0xf5, 0x39, 0x40, 0x09, 0x05, 0x19, 0x64, 0x69, 0x1c,
+	0xf4, 0x29, 0xb4, 0xaf, 0xc2, 0xd8, 0xbd, 0x11, 0x49, 0x94, 0xb7, 0xa0, 0x6e, 0xb7, 0x24, 0xe3,
+	0x9c, 0xdc, 0xa2, 0x2d, 0xe8, 0x8a, 0xdc, 0x06, 0xd4, 0x91, 0xac, 0x0c, 0xaa, 0x80, 0x91, 0xdb,
+	0x63, 0xba, 0x2f, 0x38, 0xd6, 0x0f, 0xf0, 0x64, 0xe1, 0xf8, 0x8d, 0x76, 0xe7, 0x46, 0xa9, 0xa7,
+	0x33, 0xdb, 0x3d, 0x54, 0x62, 0x6d, 0xa0, 0xba, 0x84, 0x7e, 0x55, 0x86, 0xbe, 0x86, 0xa6, 0xca,
+	0x46, 0x76, 0x53, 0x1e, 0x49, 0x59, 0xa6, 0xa4, 0xff, 0x3d, 0xc9, 0x7a, 0x63, 0x46, 0x5a, 0x7f,
+	0x2c, 0x5c, 0xe7, 0xdd, 0xe0, 0x39, 0xac, 0xf2, 0x7b, 0xa7, 0xb2, 0xbd, 0x6c, 0x5a, 0xe5, 0xf7,
+	0xe7, 0xc5, 0x06, 0xab, 0x2e, 0xf5, 0x1f, 0x32, 0xd6, 0x57, 0xb0, 0x3a, 0xf3, 0xda, 0x11, 0xb7,
+	0x94, 0xa4, 0x69, 0x9c, 0x66, 0xe7, 0xa3, 0x08, 0xeb, 0x1d, 0xb4, 0x8b, 0x99, 0x55, 0xb4, 0x33,
+	0xad, 0xf3, 0xc8, 0x6f, 0xb1, 0xc6, 0x1d, 0x49, 0x99, 0x38, 0x20, 0x75, 0x7e, 0x39, 0xf9, 0xbe,
+	0x31, 0xec, 0x97, 0xbf, 0x87, 0x8e, 0xd6, 0xd6, 0x67, 0x5f, 0x26, 0x3d, 0x68, 0xef, 0xbf, 0x79,
+	0x3b, 0xfa, 0xc1, 0x39, 0x39, 0x3f, 0x1a, 0x18, 0xe2, 0x01, 0x72, 0x7c, 0x70, 0x78, 0x7a, 0x71,
+	0x7c, 0x71, 0x29, 0x39, 0x4b, 0xbb, 0xff, 0x31, 0xa0, 0xa9, 0xe6, 0x2a, 0xf4, 0x2d, 0x74, 0xd5,
+	0xd7, 0x39, 0x4f, 0x09, 0x8e, 0xd0, 0x1c, 0x14, 0x6c, 0xce, 0x71, 0xac, 0xda, 0x0b, 0xe3, 0x95,
+	0x81, 0x5e, 0xc3, 0x7a, 0x65, 0xac, 0xfe, 0x60, 0x07, 0x5f, 0x42, 0xfd, 0x2c, 0xa0, 0x3e, 0xaa,
+	0xfe, 0x63, 0xd8, 0xac, 0x92, 0x56, 0x6d, 0xff, 0xeb, 0xbf, 0x6c, 0xfb, 0x01, 0x9f, 0x4c, 0xaf,
+	0x44, 0xe3, 0xdb, 0x99, 0x3c, 0x24, 0x24, 0x55, 0x8f, 0x8a, 0x9d, 0x6b, 0x7c, 0x95, 0x06, 0xee,
+	0x8e, 0xfc, 0xad, 0xc7, 0x76, 0x94, 0xd9, 0x55, 0x53, 0x92, 0xdf, 0xfc, 0x6f, 0x00, 0xa7, 0xca,
+	0xf9, 0xeb, 0x1e, 0x14, 0x00, 0x00,
 }
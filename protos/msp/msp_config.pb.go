@@ -121,10 +121,13 @@ type FabricMSPConfig struct {
 	TlsIntermediateCerts [][]byte `protobuf:"bytes,10,rep,name=tls_intermediate_certs,json=tlsIntermediateCerts,proto3" json:"tls_intermediate_certs,omitempty"`
 	// fabric_node_ous contains the configuration to distinguish clients from peers from orderers
 	// based on the OUs.
-	FabricNodeOus        *FabricNodeOUs `protobuf:"bytes,11,opt,name=fabric_node_ous,json=fabricNodeOus,proto3" json:"fabric_node_ous,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	FabricNodeOus *FabricNodeOUs `protobuf:"bytes,11,opt,name=fabric_node_ous,json=fabricNodeOus,proto3" json:"fabric_node_ous,omitempty"`
+	// cert_validation_policy contains additional constraints that
+	// the certificate chains of the identities of this MSP must satisfy.
+	CertValidationPolicy *FabricCertValidationPolicy `protobuf:"bytes,12,opt,name=cert_validation_policy,json=certValidationPolicy,proto3" json:"cert_validation_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
 func (m *FabricMSPConfig) Reset()         { *m = FabricMSPConfig{} }
@@ -228,6 +231,13 @@ func (m *FabricMSPConfig) GetFabricNodeOus() *FabricNodeOUs {
 	return nil
 }
 
+func (m *FabricMSPConfig) GetCertValidationPolicy() *FabricCertValidationPolicy {
+	if m != nil {
+		return m.CertValidationPolicy
+	}
+	return nil
+}
+
 // FabricCryptoConfig contains configuration parameters
 // for the cryptographic algorithms used by the MSP
 // this configuration refers to
@@ -283,6 +293,71 @@ func (m *FabricCryptoConfig) GetIdentityIdentifierHashFunction() string {
 	return ""
 }
 
+// FabricCertValidationPolicy contains constraints on the certificate
+// chains of the identities of an MSP, in addition to the ones always
+// enforced during identity validation
+type FabricCertValidationPolicy struct {
+	// MaxIntermediateDepth is the maximum number of intermediate
+	// certificates between an identity and its root certificate.
+	// Zero means that the number of intermediate certificates is not limited.
+	MaxIntermediateDepth uint32 `protobuf:"varint,1,opt,name=max_intermediate_depth,json=maxIntermediateDepth,proto3" json:"max_intermediate_depth,omitempty"`
+	// RequiredKeyUsages lists the key usages an identity's certificate must have,
+	// e.g. "digitalSignature".
+	RequiredKeyUsages []string `protobuf:"bytes,2,rep,name=required_key_usages,json=requiredKeyUsages,proto3" json:"required_key_usages,omitempty"`
+	// AllowedSignatureAlgorithms lists the signature algorithms that may be used
+	// to sign the certificates of an identity's chain, e.g. "ECDSA-SHA256".
+	// If empty, any signature algorithm is allowed.
+	AllowedSignatureAlgorithms []string `protobuf:"bytes,3,rep,name=allowed_signature_algorithms,json=allowedSignatureAlgorithms,proto3" json:"allowed_signature_algorithms,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
+	XXX_unrecognized           []byte   `json:"-"`
+	XXX_sizecache              int32    `json:"-"`
+}
+
+func (m *FabricCertValidationPolicy) Reset()         { *m = FabricCertValidationPolicy{} }
+func (m *FabricCertValidationPolicy) String() string { return proto.CompactTextString(m) }
+func (*FabricCertValidationPolicy) ProtoMessage()    {}
+func (*FabricCertValidationPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{3}
+}
+func (m *FabricCertValidationPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FabricCertValidationPolicy.Unmarshal(m, b)
+}
+func (m *FabricCertValidationPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FabricCertValidationPolicy.Marshal(b, m, deterministic)
+}
+func (dst *FabricCertValidationPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FabricCertValidationPolicy.Merge(dst, src)
+}
+func (m *FabricCertValidationPolicy) XXX_Size() int {
+	return xxx_messageInfo_FabricCertValidationPolicy.Size(m)
+}
+func (m *FabricCertValidationPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_FabricCertValidationPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FabricCertValidationPolicy proto.InternalMessageInfo
+
+func (m *FabricCertValidationPolicy) GetMaxIntermediateDepth() uint32 {
+	if m != nil {
+		return m.MaxIntermediateDepth
+	}
+	return 0
+}
+
+func (m *FabricCertValidationPolicy) GetRequiredKeyUsages() []string {
+	if m != nil {
+		return m.RequiredKeyUsages
+	}
+	return nil
+}
+
+func (m *FabricCertValidationPolicy) GetAllowedSignatureAlgorithms() []string {
+	if m != nil {
+		return m.AllowedSignatureAlgorithms
+	}
+	return nil
+}
+
 // IdemixMSPConfig collects all the configuration information for
 // an Idemix MSP.
 type IdemixMSPConfig struct {
@@ -295,17 +370,24 @@ type IdemixMSPConfig struct {
 	// revocation_pk is the public key used for revocation of credentials
 	RevocationPk []byte `protobuf:"bytes,4,opt,name=revocation_pk,json=revocationPk,proto3" json:"revocation_pk,omitempty"`
 	// epoch represents the current epoch (time interval) used for revocation
-	Epoch                int64    `protobuf:"varint,5,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Epoch int64 `protobuf:"varint,5,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// credential_revocation_information contains the serialized CredentialRevocationInformation
+	// of the current epoch, which signers use to prove that their credential is not revoked
+	CredentialRevocationInformation []byte `protobuf:"bytes,6,opt,name=credential_revocation_information,json=credentialRevocationInformation,proto3" json:"credential_revocation_information,omitempty"`
+	// previous_ipks are issuer public keys which were rotated out, and which
+	// are still accepted until they expire, so that the credentials issued
+	// with them remain valid while their holders get new ones
+	PreviousIpks         []*IdemixPreviousIssuerPublicKey `protobuf:"bytes,7,rep,name=previous_ipks,json=previousIpks,proto3" json:"previous_ipks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
 }
 
 func (m *IdemixMSPConfig) Reset()         { *m = IdemixMSPConfig{} }
 func (m *IdemixMSPConfig) String() string { return proto.CompactTextString(m) }
 func (*IdemixMSPConfig) ProtoMessage()    {}
 func (*IdemixMSPConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{3}
+	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{4}
 }
 func (m *IdemixMSPConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IdemixMSPConfig.Unmarshal(m, b)
@@ -360,6 +442,70 @@ func (m *IdemixMSPConfig) GetEpoch() int64 {
 	return 0
 }
 
+func (m *IdemixMSPConfig) GetCredentialRevocationInformation() []byte {
+	if m != nil {
+		return m.CredentialRevocationInformation
+	}
+	return nil
+}
+
+func (m *IdemixMSPConfig) GetPreviousIpks() []*IdemixPreviousIssuerPublicKey {
+	if m != nil {
+		return m.PreviousIpks
+	}
+	return nil
+}
+
+// IdemixPreviousIssuerPublicKey is an issuer public key which was rotated out
+type IdemixPreviousIssuerPublicKey struct {
+	// ipk represents the (serialized) issuer public key
+	Ipk []byte `protobuf:"bytes,1,opt,name=ipk,proto3" json:"ipk,omitempty"`
+	// not_after is the time, in seconds since the Unix epoch, after which
+	// the credentials issued with this key are no longer accepted
+	NotAfter             int64    `protobuf:"varint,2,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IdemixPreviousIssuerPublicKey) Reset()         { *m = IdemixPreviousIssuerPublicKey{} }
+func (m *IdemixPreviousIssuerPublicKey) String() string { return proto.CompactTextString(m) }
+func (*IdemixPreviousIssuerPublicKey) ProtoMessage()    {}
+func (*IdemixPreviousIssuerPublicKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{5}
+}
+func (m *IdemixPreviousIssuerPublicKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IdemixPreviousIssuerPublicKey.Unmarshal(m, b)
+}
+func (m *IdemixPreviousIssuerPublicKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IdemixPreviousIssuerPublicKey.Marshal(b, m, deterministic)
+}
+func (dst *IdemixPreviousIssuerPublicKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdemixPreviousIssuerPublicKey.Merge(dst, src)
+}
+func (m *IdemixPreviousIssuerPublicKey) XXX_Size() int {
+	return xxx_messageInfo_IdemixPreviousIssuerPublicKey.Size(m)
+}
+func (m *IdemixPreviousIssuerPublicKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdemixPreviousIssuerPublicKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdemixPreviousIssuerPublicKey proto.InternalMessageInfo
+
+func (m *IdemixPreviousIssuerPublicKey) GetIpk() []byte {
+	if m != nil {
+		return m.Ipk
+	}
+	return nil
+}
+
+func (m *IdemixPreviousIssuerPublicKey) GetNotAfter() int64 {
+	if m != nil {
+		return m.NotAfter
+	}
+	return 0
+}
+
 // IdemixMSPSIgnerConfig contains the crypto material to set up an idemix signing identity
 type IdemixMSPSignerConfig struct {
 	// cred represents the serialized idemix credential of the default signer
@@ -383,7 +529,7 @@ func (m *IdemixMSPSignerConfig) Reset()         { *m = IdemixMSPSignerConfig{} }
 func (m *IdemixMSPSignerConfig) String() string { return proto.CompactTextString(m) }
 func (*IdemixMSPSignerConfig) ProtoMessage()    {}
 func (*IdemixMSPSignerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{4}
+	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{6}
 }
 func (m *IdemixMSPSignerConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IdemixMSPSignerConfig.Unmarshal(m, b)
@@ -465,7 +611,7 @@ func (m *SigningIdentityInfo) Reset()         { *m = SigningIdentityInfo{} }
 func (m *SigningIdentityInfo) String() string { return proto.CompactTextString(m) }
 func (*SigningIdentityInfo) ProtoMessage()    {}
 func (*SigningIdentityInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{5}
+	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{7}
 }
 func (m *SigningIdentityInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SigningIdentityInfo.Unmarshal(m, b)
@@ -520,7 +666,7 @@ func (m *KeyInfo) Reset()         { *m = KeyInfo{} }
 func (m *KeyInfo) String() string { return proto.CompactTextString(m) }
 func (*KeyInfo) ProtoMessage()    {}
 func (*KeyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{6}
+	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{8}
 }
 func (m *KeyInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KeyInfo.Unmarshal(m, b)
@@ -577,7 +723,7 @@ func (m *FabricOUIdentifier) Reset()         { *m = FabricOUIdentifier{} }
 func (m *FabricOUIdentifier) String() string { return proto.CompactTextString(m) }
 func (*FabricOUIdentifier) ProtoMessage()    {}
 func (*FabricOUIdentifier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{7}
+	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{9}
 }
 func (m *FabricOUIdentifier) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FabricOUIdentifier.Unmarshal(m, b)
@@ -620,7 +766,11 @@ type FabricNodeOUs struct {
 	// OU Identifier of the clients
 	ClientOuIdentifier *FabricOUIdentifier `protobuf:"bytes,2,opt,name=client_ou_identifier,json=clientOuIdentifier,proto3" json:"client_ou_identifier,omitempty"`
 	// OU Identifier of the peers
-	PeerOuIdentifier     *FabricOUIdentifier `protobuf:"bytes,3,opt,name=peer_ou_identifier,json=peerOuIdentifier,proto3" json:"peer_ou_identifier,omitempty"`
+	PeerOuIdentifier *FabricOUIdentifier `protobuf:"bytes,3,opt,name=peer_ou_identifier,json=peerOuIdentifier,proto3" json:"peer_ou_identifier,omitempty"`
+	// OU Identifier of the admins
+	AdminOuIdentifier *FabricOUIdentifier `protobuf:"bytes,4,opt,name=admin_ou_identifier,json=adminOuIdentifier,proto3" json:"admin_ou_identifier,omitempty"`
+	// OU Identifier of the orderers
+	OrdererOuIdentifier  *FabricOUIdentifier `protobuf:"bytes,5,opt,name=orderer_ou_identifier,json=ordererOuIdentifier,proto3" json:"orderer_ou_identifier,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -630,7 +780,7 @@ func (m *FabricNodeOUs) Reset()         { *m = FabricNodeOUs{} }
 func (m *FabricNodeOUs) String() string { return proto.CompactTextString(m) }
 func (*FabricNodeOUs) ProtoMessage()    {}
 func (*FabricNodeOUs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{8}
+	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{10}
 }
 func (m *FabricNodeOUs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FabricNodeOUs.Unmarshal(m, b)
@@ -671,11 +821,27 @@ func (m *FabricNodeOUs) GetPeerOuIdentifier() *FabricOUIdentifier {
 	return nil
 }
 
+func (m *FabricNodeOUs) GetAdminOuIdentifier() *FabricOUIdentifier {
+	if m != nil {
+		return m.AdminOuIdentifier
+	}
+	return nil
+}
+
+func (m *FabricNodeOUs) GetOrdererOuIdentifier() *FabricOUIdentifier {
+	if m != nil {
+		return m.OrdererOuIdentifier
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MSPConfig)(nil), "msp.MSPConfig")
 	proto.RegisterType((*FabricMSPConfig)(nil), "msp.FabricMSPConfig")
 	proto.RegisterType((*FabricCryptoConfig)(nil), "msp.FabricCryptoConfig")
+	proto.RegisterType((*FabricCertValidationPolicy)(nil), "msp.FabricCertValidationPolicy")
 	proto.RegisterType((*IdemixMSPConfig)(nil), "msp.IdemixMSPConfig")
+	proto.RegisterType((*IdemixPreviousIssuerPublicKey)(nil), "msp.IdemixPreviousIssuerPublicKey")
 	proto.RegisterType((*IdemixMSPSignerConfig)(nil), "msp.IdemixMSPSignerConfig")
 	proto.RegisterType((*SigningIdentityInfo)(nil), "msp.SigningIdentityInfo")
 	proto.RegisterType((*KeyInfo)(nil), "msp.KeyInfo")
@@ -686,58 +852,72 @@ func init() {
 func init() { proto.RegisterFile("msp/msp_config.proto", fileDescriptor_msp_config_e749e5bd1d6d997b) }
 
 var fileDescriptor_msp_config_e749e5bd1d6d997b = []byte{
-	// 847 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x5f, 0x6f, 0xe3, 0x44,
-	0x10, 0x57, 0x92, 0x26, 0x77, 0x99, 0x38, 0x49, 0xd9, 0xeb, 0x15, 0x0b, 0x71, 0x77, 0xa9, 0x01,
-	0x91, 0x17, 0x52, 0xa9, 0x87, 0x84, 0x84, 0x78, 0xba, 0xc2, 0x09, 0x03, 0xa5, 0xd5, 0x56, 0x7d,
-	0xe1, 0xc5, 0xda, 0xd8, 0x9b, 0x64, 0x65, 0x7b, 0xd7, 0xda, 0x5d, 0x9f, 0x08, 0xe2, 0x99, 0x2f,
-	0xc0, 0x77, 0xe0, 0x99, 0x57, 0xbe, 0x1d, 0xda, 0x3f, 0x8d, 0x9d, 0x6b, 0x15, 0x78, 0x9b, 0x9d,
-	0xf9, 0xcd, 0xcf, 0xb3, 0xbf, 0x99, 0x59, 0xc3, 0x49, 0xa9, 0xaa, 0xf3, 0x52, 0x55, 0x49, 0x2a,
-	0xf8, 0x8a, 0xad, 0x17, 0x95, 0x14, 0x5a, 0xa0, 0x5e, 0xa9, 0xaa, 0xe8, 0x2b, 0x18, 0x5e, 0xdd,
-	0xde, 0x5c, 0x5a, 0x3f, 0x42, 0x70, 0xa4, 0xb7, 0x15, 0x0d, 0x3b, 0xb3, 0xce, 0xbc, 0x8f, 0xad,
-	0x8d, 0x4e, 0x61, 0xe0, 0xb2, 0xc2, 0xee, 0xac, 0x33, 0x0f, 0xb0, 0x3f, 0x45, 0x7f, 0x1f, 0xc1,
-	0xf4, 0x2d, 0x59, 0x4a, 0x96, 0xee, 0xe5, 0x73, 0x52, 0xba, 0xfc, 0x21, 0xb6, 0x36, 0x7a, 0x01,
-	0x20, 0x85, 0xd0, 0x49, 0x4a, 0xa5, 0x56, 0x61, 0x77, 0xd6, 0x9b, 0x07, 0x78, 0x68, 0x3c, 0x97,
-	0xc6, 0x81, 0xbe, 0x00, 0xc4, 0xb8, 0xa6, 0xb2, 0xa4, 0x19, 0x23, 0x9a, 0x7a, 0x58, 0xcf, 0xc2,
-	0x3e, 0x68, 0x47, 0x1c, 0xfc, 0x14, 0x06, 0x24, 0x2b, 0x19, 0x57, 0xe1, 0x91, 0x85, 0xf8, 0x13,
-	0xfa, 0x1c, 0xa6, 0x92, 0xbe, 0x13, 0x29, 0xd1, 0x4c, 0xf0, 0xa4, 0x60, 0x4a, 0x87, 0x7d, 0x0b,
-	0x98, 0x34, 0xee, 0x9f, 0x98, 0xd2, 0xe8, 0x12, 0x8e, 0x15, 0x5b, 0x73, 0xc6, 0xd7, 0x09, 0xcb,
-	0x28, 0xd7, 0x4c, 0x6f, 0xc3, 0xc1, 0xac, 0x33, 0x1f, 0x5d, 0x84, 0x8b, 0x52, 0x55, 0x8b, 0x5b,
-	0x17, 0x8c, 0x7d, 0x2c, 0xe6, 0x2b, 0x81, 0xa7, 0x6a, 0xdf, 0x89, 0x12, 0x78, 0x25, 0xe4, 0x9a,
-	0x70, 0xf6, 0x9b, 0x25, 0x26, 0x45, 0x52, 0x73, 0xa6, 0x3d, 0xe1, 0x8a, 0x51, 0xa9, 0xc2, 0x27,
-	0xb3, 0xde, 0x7c, 0x74, 0xf1, 0xa1, 0xe5, 0x74, 0x32, 0x5d, 0xdf, 0xc5, 0xbb, 0x38, 0x7e, 0xb1,
-	0x9f, 0x7f, 0xc7, 0x99, 0x6e, 0xa2, 0x0a, 0x7d, 0x03, 0xe3, 0x54, 0x6e, 0x2b, 0x2d, 0x7c, 0xc7,
-	0xc2, 0xa7, 0xb6, 0xc4, 0x36, 0xdd, 0xa5, 0x8d, 0x3b, 0xe1, 0x71, 0x90, 0xb6, 0x4e, 0xe8, 0x53,
-	0x98, 0xe8, 0x42, 0x25, 0x2d, 0xd9, 0x87, 0x56, 0x8b, 0x40, 0x17, 0x0a, 0xef, 0x94, 0xff, 0x12,
-	0x4e, 0x0d, 0xea, 0x11, 0xf5, 0xc1, 0xa2, 0x4f, 0x74, 0xa1, 0xe2, 0x07, 0x0d, 0xf8, 0x1a, 0xa6,
-	0x2b, 0xfb, 0xfd, 0x84, 0x8b, 0x8c, 0x26, 0xa2, 0x56, 0xe1, 0xc8, 0xd6, 0x86, 0x5a, 0xb5, 0xfd,
-	0x2c, 0x32, 0x7a, 0x7d, 0xa7, 0xf0, 0x78, 0xd5, 0x1c, 0x6b, 0x15, 0xfd, 0xd9, 0x01, 0xf4, 0xb0,
-	0x78, 0x74, 0x01, 0xcf, 0x8d, 0xc0, 0x44, 0xd7, 0x92, 0x26, 0x1b, 0xa2, 0x36, 0xc9, 0x8a, 0x94,
-	0xac, 0xd8, 0xfa, 0x31, 0x7a, 0xb6, 0x0b, 0x7e, 0x4f, 0xd4, 0xe6, 0xad, 0x0d, 0xa1, 0x18, 0xce,
-	0xee, 0xdb, 0xd7, 0x92, 0xdd, 0x67, 0xd7, 0x3c, 0x35, 0xb2, 0xda, 0x81, 0x1d, 0xe2, 0x97, 0xf7,
-	0xc0, 0x46, 0x60, 0x4b, 0xe4, 0x51, 0xd1, 0x5f, 0x1d, 0x98, 0xc6, 0x19, 0x2d, 0xd9, 0xaf, 0x87,
-	0x07, 0xf9, 0x18, 0x7a, 0xac, 0xca, 0xfd, 0x16, 0x18, 0x13, 0x5d, 0xc0, 0xc0, 0xd4, 0x46, 0x65,
-	0xd8, 0xb3, 0x12, 0x7c, 0x64, 0x25, 0xd8, 0x71, 0xdd, 0xda, 0x98, 0xef, 0x90, 0x47, 0xa2, 0x4f,
-	0x60, 0xdc, 0x1a, 0xd4, 0x2a, 0x0f, 0x8f, 0x2c, 0x5f, 0xd0, 0x38, 0x6f, 0x72, 0x74, 0x02, 0x7d,
-	0x5a, 0x89, 0x74, 0x13, 0xf6, 0x67, 0x9d, 0x79, 0x0f, 0xbb, 0x43, 0xf4, 0x47, 0x17, 0x9e, 0x3f,
-	0x4a, 0x6e, 0xca, 0x4d, 0x25, 0xcd, 0x6c, 0xb9, 0x01, 0xb6, 0x36, 0x9a, 0x40, 0x57, 0xdd, 0x57,
-	0xdb, 0x55, 0x39, 0xfa, 0x16, 0x5e, 0x1e, 0x9e, 0x59, 0x7b, 0x89, 0x21, 0xfe, 0xf8, 0xd0, 0x64,
-	0x9a, 0x2f, 0x49, 0x51, 0x50, 0x5b, 0x75, 0x1f, 0x5b, 0xdb, 0x5c, 0x89, 0x72, 0x29, 0x8a, 0xa2,
-	0xa4, 0xdc, 0x10, 0xda, 0xaa, 0x87, 0x38, 0x68, 0x9c, 0x71, 0x86, 0x7e, 0x80, 0x33, 0x53, 0x96,
-	0x21, 0x22, 0x45, 0xd2, 0x92, 0x80, 0xf1, 0x95, 0x90, 0xa5, 0xb5, 0xed, 0x22, 0x06, 0xf8, 0x55,
-	0x03, 0xc4, 0x3b, 0x5c, 0xdc, 0xc0, 0x22, 0x01, 0xcf, 0x1e, 0x59, 0x53, 0x53, 0x47, 0x55, 0x2f,
-	0x0b, 0x96, 0x26, 0xbe, 0x2b, 0x4e, 0x8e, 0xc0, 0x39, 0x9d, 0x60, 0xe8, 0x35, 0x4c, 0x2a, 0xc9,
-	0xde, 0x99, 0x61, 0xf7, 0xa8, 0xae, 0xed, 0x5d, 0x60, 0x7b, 0xf7, 0x23, 0x75, 0x1b, 0x3f, 0xf6,
-	0x18, 0x97, 0x14, 0xdd, 0xc2, 0x13, 0x1f, 0x41, 0x9f, 0xc1, 0x24, 0xa7, 0xed, 0x99, 0xf3, 0x33,
-	0x32, 0xce, 0x69, 0x6b, 0xc0, 0xd0, 0x19, 0x04, 0x06, 0x56, 0x12, 0x4d, 0x25, 0x23, 0x85, 0xef,
-	0xc3, 0x28, 0xa7, 0xdb, 0x2b, 0xef, 0x8a, 0x7e, 0xbf, 0x5f, 0x86, 0xf6, 0xc3, 0x80, 0x66, 0x30,
-	0x32, 0x4b, 0xc8, 0x56, 0x2c, 0x25, 0x9a, 0xfa, 0x2b, 0xb4, 0x5d, 0xff, 0xa3, 0x91, 0xdd, 0xff,
-	0x6e, 0x64, 0xf4, 0x4f, 0x07, 0xc6, 0x7b, 0xcb, 0x6a, 0x9e, 0x56, 0xca, 0xc9, 0xb2, 0x70, 0x1f,
-	0x7d, 0x8a, 0xfd, 0x09, 0xc5, 0x70, 0x92, 0x16, 0xcc, 0xb4, 0x56, 0xd4, 0xef, 0x7f, 0xe5, 0xc0,
-	0x0b, 0x87, 0x5c, 0xd2, 0x75, 0xdd, 0xba, 0xdc, 0x77, 0x80, 0x2a, 0x4a, 0xe5, 0x7b, 0x44, 0xbd,
-	0xc3, 0x44, 0xc7, 0x26, 0xa5, 0x4d, 0xf3, 0x26, 0x81, 0x33, 0x21, 0xd7, 0x8b, 0xcd, 0xb6, 0xa2,
-	0xb2, 0xa0, 0xd9, 0x9a, 0xca, 0x85, 0x7b, 0x68, 0xdc, 0x8f, 0x4d, 0x19, 0xa6, 0x37, 0xc7, 0x57,
-	0xaa, 0x72, 0xeb, 0x71, 0x43, 0xd2, 0x9c, 0xac, 0xe9, 0x2f, 0xf3, 0x35, 0xd3, 0x9b, 0x7a, 0xb9,
-	0x48, 0x45, 0x79, 0xde, 0xca, 0x3d, 0x77, 0xb9, 0xe7, 0x2e, 0xd7, 0xfc, 0x26, 0x97, 0x03, 0x6b,
-	0xbf, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0x54, 0x67, 0x46, 0xdb, 0x38, 0x07, 0x00, 0x00,
+	// 1069 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x23, 0xb5,
+	0x1b, 0x56, 0x92, 0xa6, 0xdb, 0xbc, 0x9d, 0xf4, 0x8f, 0x9b, 0xf6, 0x37, 0xea, 0x6f, 0xbb, 0x4d,
+	0x07, 0x10, 0xb9, 0x90, 0x4a, 0x5d, 0x24, 0x24, 0xc4, 0x81, 0xdd, 0x2e, 0xbb, 0x0c, 0xa5, 0xdb,
+	0xc8, 0x55, 0x39, 0x70, 0x19, 0xb9, 0x33, 0x4e, 0x62, 0x65, 0x66, 0x3c, 0xd8, 0x9e, 0xd2, 0x20,
+	0xce, 0xf0, 0x01, 0xf8, 0x38, 0x5c, 0xb9, 0xf3, 0x95, 0x90, 0xff, 0x24, 0x99, 0xb4, 0x25, 0x70,
+	0xe0, 0x66, 0xbf, 0xef, 0xf3, 0x3e, 0x1e, 0x3f, 0x8f, 0x5f, 0x7b, 0xa0, 0x93, 0xc9, 0xe2, 0x34,
+	0x93, 0x45, 0x14, 0xf3, 0x7c, 0xc8, 0x46, 0xfd, 0x42, 0x70, 0xc5, 0x51, 0x23, 0x93, 0x45, 0xf0,
+	0x19, 0xb4, 0x2e, 0xaf, 0x07, 0xe7, 0x26, 0x8e, 0x10, 0xac, 0xa9, 0x69, 0x41, 0xfd, 0x5a, 0xb7,
+	0xd6, 0x6b, 0x62, 0x33, 0x46, 0x07, 0xb0, 0x6e, 0xab, 0xfc, 0x7a, 0xb7, 0xd6, 0xf3, 0xb0, 0x9b,
+	0x05, 0xbf, 0x36, 0x61, 0xfb, 0x2d, 0xb9, 0x15, 0x2c, 0x5e, 0xaa, 0xcf, 0x49, 0x66, 0xeb, 0x5b,
+	0xd8, 0x8c, 0xd1, 0x11, 0x80, 0xe0, 0x5c, 0x45, 0x31, 0x15, 0x4a, 0xfa, 0xf5, 0x6e, 0xa3, 0xe7,
+	0xe1, 0x96, 0x8e, 0x9c, 0xeb, 0x00, 0xfa, 0x04, 0x10, 0xcb, 0x15, 0x15, 0x19, 0x4d, 0x18, 0x51,
+	0xd4, 0xc1, 0x1a, 0x06, 0xb6, 0x5b, 0xcd, 0x58, 0xf8, 0x01, 0xac, 0x93, 0x24, 0x63, 0xb9, 0xf4,
+	0xd7, 0x0c, 0xc4, 0xcd, 0xd0, 0xc7, 0xb0, 0x2d, 0xe8, 0x1d, 0x8f, 0x89, 0x62, 0x3c, 0x8f, 0x52,
+	0x26, 0x95, 0xdf, 0x34, 0x80, 0xad, 0x45, 0xf8, 0x5b, 0x26, 0x15, 0x3a, 0x87, 0x1d, 0xc9, 0x46,
+	0x39, 0xcb, 0x47, 0x11, 0x4b, 0x68, 0xae, 0x98, 0x9a, 0xfa, 0xeb, 0xdd, 0x5a, 0x6f, 0xf3, 0xcc,
+	0xef, 0x67, 0xb2, 0xe8, 0x5f, 0xdb, 0x64, 0xe8, 0x72, 0x61, 0x3e, 0xe4, 0x78, 0x5b, 0x2e, 0x07,
+	0x51, 0x04, 0xc7, 0x5c, 0x8c, 0x48, 0xce, 0x7e, 0x32, 0xc4, 0x24, 0x8d, 0xca, 0x9c, 0x29, 0x47,
+	0x38, 0x64, 0x54, 0x48, 0xff, 0x59, 0xb7, 0xd1, 0xdb, 0x3c, 0xfb, 0x9f, 0xe1, 0xb4, 0x32, 0x5d,
+	0xdd, 0x84, 0xf3, 0x3c, 0x3e, 0x5a, 0xae, 0xbf, 0xc9, 0x99, 0x5a, 0x64, 0x25, 0xfa, 0x02, 0xda,
+	0xb1, 0x98, 0x16, 0x8a, 0x3b, 0xc7, 0xfc, 0x8d, 0x6e, 0xed, 0x01, 0xdd, 0xb9, 0xc9, 0x5b, 0xe1,
+	0xb1, 0x17, 0x57, 0x66, 0xe8, 0x43, 0xd8, 0x52, 0xa9, 0x8c, 0x2a, 0xb2, 0xb7, 0x8c, 0x16, 0x9e,
+	0x4a, 0x25, 0x9e, 0x2b, 0xff, 0x29, 0x1c, 0x68, 0xd4, 0x13, 0xea, 0x83, 0x41, 0x77, 0x54, 0x2a,
+	0xc3, 0x47, 0x06, 0x7c, 0x0e, 0xdb, 0x43, 0xb3, 0x7e, 0x94, 0xf3, 0x84, 0x46, 0xbc, 0x94, 0xfe,
+	0xa6, 0xf9, 0x36, 0x54, 0xf9, 0xb6, 0xf7, 0x3c, 0xa1, 0x57, 0x37, 0x12, 0xb7, 0x87, 0x8b, 0x69,
+	0x29, 0xd1, 0x0d, 0x1c, 0xe8, 0x05, 0xa2, 0x3b, 0x92, 0xb2, 0xc4, 0x3a, 0x55, 0xf0, 0x94, 0xc5,
+	0x53, 0xdf, 0x33, 0x14, 0xc7, 0xd5, 0xed, 0x51, 0xa1, 0xbe, 0x9b, 0xe3, 0x06, 0x06, 0x86, 0x3b,
+	0xf1, 0x13, 0xd1, 0xe0, 0xb7, 0x1a, 0xa0, 0xc7, 0x9a, 0xa0, 0x33, 0xd8, 0xd7, 0xbe, 0x11, 0x55,
+	0x0a, 0x1a, 0x8d, 0x89, 0x1c, 0x47, 0x43, 0x92, 0xb1, 0x74, 0xea, 0x4e, 0xe7, 0xde, 0x3c, 0xf9,
+	0x35, 0x91, 0xe3, 0xb7, 0x26, 0x85, 0x42, 0x38, 0x99, 0x9d, 0x8a, 0x8a, 0x9b, 0xae, 0xba, 0xcc,
+	0x63, 0xbd, 0xaa, 0xe9, 0x83, 0x16, 0x7e, 0x31, 0x03, 0x2e, 0x7c, 0x33, 0x44, 0x0e, 0x15, 0xfc,
+	0x51, 0x83, 0xc3, 0xbf, 0xdf, 0x8a, 0x56, 0x3f, 0x23, 0xf7, 0xcb, 0xea, 0x27, 0xb4, 0x50, 0x63,
+	0xf3, 0x79, 0x6d, 0xdc, 0xc9, 0xc8, 0x7d, 0x55, 0xfd, 0x37, 0x3a, 0x87, 0xfa, 0xb0, 0x27, 0xe8,
+	0x0f, 0x25, 0x13, 0x34, 0x89, 0x26, 0x74, 0x1a, 0x95, 0x92, 0x8c, 0xa8, 0xed, 0xaa, 0x16, 0xde,
+	0x9d, 0xa5, 0x2e, 0xe8, 0xf4, 0xc6, 0x24, 0xd0, 0x97, 0xf0, 0x9c, 0xa4, 0x29, 0xff, 0x91, 0x26,
+	0xd1, 0x42, 0x0b, 0x92, 0x8e, 0xb8, 0x60, 0x6a, 0x9c, 0xd9, 0x3e, 0x6b, 0xe1, 0x43, 0x87, 0xb9,
+	0x9e, 0x41, 0x5e, 0xcd, 0x11, 0xc1, 0xef, 0x75, 0xd8, 0x0e, 0x13, 0x9a, 0xb1, 0xfb, 0xd5, 0x6d,
+	0xbe, 0x03, 0x0d, 0x56, 0x4c, 0xdc, 0x1d, 0xa1, 0x87, 0xe8, 0x0c, 0xd6, 0xf5, 0x9a, 0x54, 0xf8,
+	0x0d, 0xe3, 0xee, 0xa1, 0x71, 0x77, 0xce, 0x75, 0x6d, 0x72, 0xee, 0xfc, 0x3a, 0x24, 0xfa, 0x00,
+	0xda, 0x95, 0x36, 0x2e, 0x26, 0xfe, 0x9a, 0xe1, 0xf3, 0x16, 0xc1, 0xc1, 0x04, 0x75, 0xa0, 0x49,
+	0x0b, 0x1e, 0x8f, 0xfd, 0x66, 0xb7, 0xd6, 0x6b, 0x60, 0x3b, 0x41, 0xdf, 0xc0, 0x49, 0x2c, 0xa8,
+	0xf1, 0x82, 0xa4, 0x51, 0x85, 0x85, 0xe5, 0x43, 0x2e, 0x32, 0x33, 0x36, 0x9d, 0xee, 0xe1, 0xe3,
+	0x05, 0x10, 0xcf, 0x71, 0xe1, 0x02, 0x86, 0xde, 0x41, 0xbb, 0x10, 0xf4, 0x8e, 0xf1, 0x52, 0x46,
+	0xac, 0x98, 0xcc, 0xba, 0x39, 0xa8, 0xec, 0x60, 0xe0, 0xf2, 0xa1, 0x94, 0x25, 0x15, 0x83, 0xf2,
+	0x36, 0x65, 0xf1, 0x05, 0x9d, 0x62, 0x6f, 0x56, 0x18, 0x16, 0x13, 0x19, 0xbc, 0x87, 0xa3, 0x95,
+	0xf0, 0x99, 0x6c, 0xb5, 0x85, 0x6c, 0xff, 0x87, 0x56, 0xce, 0x55, 0x44, 0x86, 0x8a, 0x0a, 0x23,
+	0x67, 0x03, 0x6f, 0xe4, 0x5c, 0xbd, 0xd2, 0xf3, 0xe0, 0x97, 0x3a, 0xec, 0x3f, 0xa9, 0xa0, 0xf6,
+	0x44, 0xef, 0xca, 0x31, 0x99, 0x31, 0xda, 0x82, 0xba, 0x9c, 0x59, 0x52, 0x97, 0x13, 0xf4, 0x06,
+	0x5e, 0xac, 0xbe, 0xb6, 0x8c, 0x53, 0x2d, 0xfc, 0x7c, 0xd5, 0xe5, 0xa4, 0x57, 0x12, 0x3c, 0xa5,
+	0xc6, 0x9a, 0x26, 0x36, 0x63, 0xed, 0x1b, 0xcd, 0x05, 0x4f, 0xd3, 0x8c, 0xe6, 0x9a, 0xd0, 0x58,
+	0xd3, 0xc2, 0xde, 0x22, 0x18, 0x26, 0xff, 0xa5, 0x43, 0x01, 0x87, 0xbd, 0x27, 0x6e, 0x6a, 0xfd,
+	0x1d, 0x85, 0xd1, 0x36, 0x72, 0x47, 0xcf, 0xca, 0xe1, 0xd9, 0xa0, 0x15, 0x0c, 0xbd, 0x84, 0xad,
+	0x42, 0xb0, 0x3b, 0xdd, 0x71, 0x0e, 0x55, 0x37, 0x07, 0xd4, 0x33, 0xf6, 0x5e, 0x50, 0x7b, 0xe9,
+	0xb7, 0x1d, 0xc6, 0x16, 0x05, 0xd7, 0xf0, 0xcc, 0x65, 0xd0, 0x47, 0xb0, 0xa5, 0x7b, 0xaf, 0x22,
+	0x9b, 0x6d, 0x84, 0xf6, 0x84, 0x56, 0x2e, 0x03, 0x74, 0x02, 0x9e, 0x86, 0x65, 0x44, 0x51, 0xc1,
+	0x48, 0xea, 0x7c, 0xd8, 0x9c, 0xd0, 0xe9, 0xa5, 0x0b, 0x05, 0x3f, 0x03, 0x7a, 0xfc, 0x36, 0xa0,
+	0x2e, 0x6c, 0xea, 0x7b, 0x8e, 0x0d, 0x59, 0x4c, 0x14, 0x75, 0x5b, 0xa8, 0x86, 0xfe, 0x85, 0x91,
+	0xf5, 0x7f, 0x36, 0x32, 0xf8, 0xb3, 0x0e, 0xed, 0xa5, 0xfb, 0x5a, 0xbf, 0xae, 0x34, 0x27, 0xb7,
+	0xa9, 0x5d, 0x74, 0x03, 0xbb, 0x19, 0x0a, 0xa1, 0x13, 0xa7, 0x4c, 0x5b, 0xcb, 0xcb, 0x87, 0xab,
+	0xac, 0x78, 0xe4, 0x90, 0x2d, 0xba, 0x2a, 0x2b, 0x9b, 0xfb, 0x0a, 0x50, 0x41, 0xa9, 0x78, 0x40,
+	0xd4, 0x58, 0x4d, 0xb4, 0xa3, 0x4b, 0x96, 0x68, 0xde, 0xc1, 0x9e, 0x79, 0xf9, 0x1f, 0xf0, 0xac,
+	0xad, 0xe6, 0xd9, 0x35, 0x35, 0x4b, 0x44, 0x17, 0xb0, 0xcf, 0x45, 0x42, 0xc5, 0xa3, 0x4f, 0x6a,
+	0xae, 0xa6, 0xda, 0x73, 0x55, 0x55, 0xb2, 0xd7, 0x11, 0x9c, 0x70, 0x31, 0xea, 0x8f, 0xa7, 0x05,
+	0x15, 0x29, 0x4d, 0x46, 0x54, 0xf4, 0xed, 0x0b, 0x68, 0xff, 0xb8, 0xa4, 0x26, 0x7b, 0xbd, 0x73,
+	0x29, 0x0b, 0xdb, 0xb4, 0x03, 0x12, 0x4f, 0xc8, 0x88, 0x7e, 0xdf, 0x1b, 0x31, 0x35, 0x2e, 0x6f,
+	0xfb, 0x31, 0xcf, 0x4e, 0x2b, 0xb5, 0xa7, 0xb6, 0xf6, 0xd4, 0xd6, 0xea, 0xff, 0xb7, 0xdb, 0x75,
+	0x33, 0x7e, 0xf9, 0xd7, 0x00, 0x53, 0x95, 0x49, 0x85, 0xd1, 0x09, 0x00, 0x00,
 }
@@ -60,10 +60,11 @@ func (MSPPrincipal_Classification) EnumDescriptor() ([]byte, []int) {
 type MSPRole_MSPRoleType int32
 
 const (
-	MSPRole_MEMBER MSPRole_MSPRoleType = 0
-	MSPRole_ADMIN  MSPRole_MSPRoleType = 1
-	MSPRole_CLIENT MSPRole_MSPRoleType = 2
-	MSPRole_PEER   MSPRole_MSPRoleType = 3
+	MSPRole_MEMBER  MSPRole_MSPRoleType = 0
+	MSPRole_ADMIN   MSPRole_MSPRoleType = 1
+	MSPRole_CLIENT  MSPRole_MSPRoleType = 2
+	MSPRole_PEER    MSPRole_MSPRoleType = 3
+	MSPRole_ORDERER MSPRole_MSPRoleType = 4
 )
 
 var MSPRole_MSPRoleType_name = map[int32]string{
@@ -71,12 +72,14 @@ var MSPRole_MSPRoleType_name = map[int32]string{
 	1: "ADMIN",
 	2: "CLIENT",
 	3: "PEER",
+	4: "ORDERER",
 }
 var MSPRole_MSPRoleType_value = map[string]int32{
-	"MEMBER": 0,
-	"ADMIN":  1,
-	"CLIENT": 2,
-	"PEER":   3,
+	"MEMBER":  0,
+	"ADMIN":   1,
+	"CLIENT":  2,
+	"PEER":    3,
+	"ORDERER": 4,
 }
 
 func (x MSPRole_MSPRoleType) String() string {
@@ -400,38 +403,38 @@ func init() {
 }
 
 var fileDescriptor_msp_principal_9016cf1a8a7156cd = []byte{
-	// 519 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xdf, 0x6a, 0xdb, 0x30,
-	0x14, 0xc6, 0xeb, 0xa4, 0x6b, 0x9b, 0x93, 0x3f, 0xa8, 0x22, 0xa5, 0x81, 0x95, 0x11, 0xbc, 0x0d,
-	0x72, 0xe5, 0x40, 0xba, 0xed, 0x62, 0x77, 0x4e, 0x62, 0x86, 0x20, 0x96, 0x8d, 0xe3, 0x5c, 0xb4,
-	0x94, 0x05, 0xc7, 0x51, 0x52, 0x81, 0x6d, 0x19, 0xdb, 0xbd, 0xf0, 0xde, 0x65, 0x6f, 0xb0, 0xcb,
-	0x3d, 0xd5, 0x9e, 0x62, 0xd8, 0x6e, 0x12, 0x65, 0xeb, 0x60, 0x57, 0xf6, 0x39, 0xe7, 0xf7, 0x1d,
-	0x1d, 0x49, 0x9f, 0xe0, 0x3a, 0x4c, 0xe3, 0x61, 0x98, 0xc6, 0xcb, 0x38, 0xe1, 0x91, 0xcf, 0x63,
-	0x2f, 0xd0, 0xe2, 0x44, 0x64, 0x02, 0x9f, 0xf9, 0x22, 0x0c, 0x45, 0xa4, 0xfe, 0x52, 0xa0, 0x65,
-	0xce, 0x6d, 0x7b, 0x57, 0xc6, 0x5f, 0xa1, 0xb7, 0x67, 0x97, 0x7e, 0xe0, 0xa5, 0x29, 0xdf, 0x70,
-	0xdf, 0xcb, 0xb8, 0x88, 0x7a, 0x4a, 0x5f, 0x19, 0x74, 0x46, 0x6f, 0xb5, 0x4a, 0xab, 0xc9, 0x3a,
-	0x6d, 0x72, 0x84, 0x3a, 0xd7, 0xfb, 0x26, 0xc7, 0x05, 0x7c, 0x03, 0x8d, 0x7d, 0xa9, 0x57, 0xeb,
-	0x2b, 0x83, 0x96, 0x73, 0x48, 0xa8, 0x0f, 0xd0, 0xf9, 0x83, 0xbf, 0x80, 0x53, 0xc7, 0x9a, 0x19,
-	0xe8, 0x04, 0x5f, 0xc1, 0xa5, 0xe5, 0x7c, 0xd1, 0x29, 0xb9, 0xd7, 0x5d, 0x62, 0xd1, 0xe5, 0x82,
-	0x12, 0x17, 0x29, 0xb8, 0x05, 0x17, 0x64, 0x6a, 0x50, 0x97, 0xb8, 0x77, 0xa8, 0x86, 0xdb, 0xd0,
-	0xd0, 0xa9, 0x45, 0xef, 0xcc, 0x22, 0xac, 0x17, 0xc5, 0x89, 0x65, 0x8e, 0x09, 0x35, 0xa6, 0xe8,
-	0x54, 0xfd, 0xa9, 0x00, 0xb2, 0x92, 0xad, 0x17, 0xf1, 0x6f, 0x65, 0xf3, 0x45, 0xc4, 0x33, 0xfc,
-	0x1e, 0x3a, 0xc5, 0x01, 0xf1, 0x35, 0x8b, 0x32, 0xbe, 0xe1, 0x2c, 0x29, 0xb7, 0xd9, 0x70, 0xda,
-	0x61, 0x1a, 0x93, 0x7d, 0x12, 0x4f, 0xe1, 0x8d, 0x90, 0xa4, 0x5e, 0xb0, 0x7c, 0x8a, 0x78, 0x26,
-	0xcb, 0x6a, 0xa5, 0xec, 0xe6, 0x98, 0x2a, 0x96, 0x90, 0xba, 0xdc, 0xc2, 0x95, 0xcf, 0x92, 0x2a,
-	0x48, 0x65, 0x71, 0xbd, 0x3c, 0x89, 0xee, 0xa1, 0x78, 0x10, 0xa9, 0xdf, 0x15, 0x38, 0x37, 0xe7,
-	0xb6, 0x23, 0x02, 0xf6, 0xbf, 0xd3, 0x0e, 0xe1, 0x34, 0x11, 0x01, 0x2b, 0x67, 0xea, 0x8c, 0x5e,
-	0x4b, 0x37, 0x56, 0x74, 0xd9, 0x7d, 0xdd, 0x3c, 0x66, 0x4e, 0x09, 0xaa, 0x9f, 0xa1, 0x29, 0x25,
-	0x31, 0xc0, 0x99, 0x69, 0x98, 0x63, 0xc3, 0x41, 0x27, 0xb8, 0x01, 0xaf, 0xf4, 0xa9, 0x49, 0x28,
-	0x52, 0x8a, 0xf4, 0x64, 0x46, 0x0c, 0xea, 0xa2, 0x5a, 0x71, 0x31, 0xb6, 0x61, 0x38, 0xa8, 0xae,
-	0xfe, 0x50, 0xa0, 0x6b, 0xce, 0xed, 0x6a, 0xf9, 0x2c, 0xd7, 0x23, 0x11, 0xe5, 0x21, 0xcf, 0x72,
-	0xfc, 0x00, 0x1d, 0x6f, 0x17, 0x2c, 0xb3, 0x3c, 0x66, 0xcf, 0x0e, 0xfa, 0x28, 0xcd, 0xf3, 0x97,
-	0xea, 0xc5, 0x64, 0x39, 0x69, 0xdb, 0x93, 0x43, 0xf5, 0x13, 0xf4, 0xfe, 0x85, 0xe2, 0x26, 0x9c,
-	0x53, 0xcb, 0x24, 0x54, 0x9f, 0xa1, 0x93, 0x83, 0x27, 0xac, 0xc5, 0x1c, 0x29, 0x2a, 0x81, 0xcb,
-	0x89, 0x08, 0x57, 0x3c, 0x62, 0xeb, 0x83, 0xed, 0x3f, 0x00, 0xec, 0x5d, 0x98, 0xf6, 0x94, 0x7e,
-	0x7d, 0xd0, 0x1c, 0x75, 0x5f, 0x32, 0xba, 0x23, 0x71, 0x63, 0x1b, 0xde, 0x89, 0x64, 0xab, 0x3d,
-	0xe6, 0x31, 0x4b, 0x02, 0xb6, 0xde, 0xb2, 0x44, 0xdb, 0x78, 0xab, 0x84, 0xfb, 0xd5, 0x2b, 0x4b,
-	0x9f, 0x1b, 0xdc, 0x0f, 0xb6, 0x3c, 0x7b, 0x7c, 0x5a, 0x15, 0xe1, 0x50, 0x82, 0x87, 0x15, 0x3c,
-	0xac, 0xe0, 0xe2, 0x9d, 0xae, 0xce, 0xca, 0xff, 0xdb, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x40,
-	0x36, 0xd2, 0xf9, 0xb9, 0x03, 0x00, 0x00,
+	// 521 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x6e, 0xda, 0x40,
+	0x10, 0x86, 0x63, 0xa0, 0x49, 0x98, 0x00, 0xda, 0xac, 0x88, 0x82, 0xd4, 0xa8, 0x42, 0x6e, 0x2b,
+	0x71, 0x32, 0x12, 0x69, 0x7b, 0x37, 0x60, 0x45, 0x96, 0xf0, 0xda, 0x5a, 0xcc, 0x21, 0x51, 0x54,
+	0x64, 0xcc, 0x42, 0x56, 0xb2, 0xbd, 0x96, 0xed, 0x1c, 0xdc, 0x47, 0xaa, 0x7a, 0xec, 0x53, 0xf5,
+	0x29, 0x2a, 0xdb, 0x01, 0x96, 0x36, 0x95, 0x7a, 0xb2, 0x67, 0xe6, 0xfb, 0xc7, 0xbf, 0x77, 0x67,
+	0xe0, 0x3a, 0x4c, 0xe3, 0x61, 0x98, 0xc6, 0xcb, 0x38, 0xe1, 0x91, 0xcf, 0x63, 0x2f, 0xd0, 0xe2,
+	0x44, 0x64, 0x02, 0x9f, 0xfa, 0x22, 0x0c, 0x45, 0xa4, 0xfe, 0x52, 0xa0, 0x65, 0xcd, 0x1d, 0x67,
+	0x57, 0xc6, 0x5f, 0xa1, 0xb7, 0x67, 0x97, 0x7e, 0xe0, 0xa5, 0x29, 0xdf, 0x70, 0xdf, 0xcb, 0xb8,
+	0x88, 0x7a, 0x4a, 0x5f, 0x19, 0x74, 0x46, 0xef, 0xb5, 0x4a, 0xab, 0xc9, 0x3a, 0x6d, 0x72, 0x84,
+	0xd2, 0xeb, 0x7d, 0x93, 0xe3, 0x02, 0xbe, 0x81, 0xe6, 0xbe, 0xd4, 0xab, 0xf5, 0x95, 0x41, 0x8b,
+	0x1e, 0x12, 0xea, 0x23, 0x74, 0xfe, 0xe0, 0xcf, 0xa1, 0x41, 0xed, 0x99, 0x81, 0x4e, 0xf0, 0x15,
+	0x5c, 0xda, 0xf4, 0x4e, 0x27, 0xe6, 0x83, 0xee, 0x9a, 0x36, 0x59, 0x2e, 0x88, 0xe9, 0x22, 0x05,
+	0xb7, 0xe0, 0xdc, 0x9c, 0x1a, 0xc4, 0x35, 0xdd, 0x7b, 0x54, 0xc3, 0x6d, 0x68, 0xea, 0xc4, 0x26,
+	0xf7, 0x56, 0x11, 0xd6, 0x8b, 0xe2, 0xc4, 0xb6, 0xc6, 0x26, 0x31, 0xa6, 0xa8, 0xa1, 0xfe, 0x54,
+	0x00, 0xd9, 0xc9, 0xd6, 0x8b, 0xf8, 0xb7, 0xb2, 0xf9, 0x22, 0xe2, 0x19, 0xfe, 0x08, 0x9d, 0xe2,
+	0x80, 0xf8, 0x9a, 0x45, 0x19, 0xdf, 0x70, 0x96, 0x94, 0xbf, 0xd9, 0xa4, 0xed, 0x30, 0x8d, 0xcd,
+	0x7d, 0x12, 0x4f, 0xe1, 0x9d, 0x90, 0xa4, 0x5e, 0xb0, 0x7c, 0x8e, 0x78, 0x26, 0xcb, 0x6a, 0xa5,
+	0xec, 0xe6, 0x98, 0x2a, 0x3e, 0x21, 0x75, 0xb9, 0x85, 0x2b, 0x9f, 0x25, 0x55, 0x90, 0xca, 0xe2,
+	0x7a, 0x79, 0x12, 0xdd, 0x43, 0xf1, 0x20, 0x52, 0xbf, 0x2b, 0x70, 0x66, 0xcd, 0x1d, 0x2a, 0x02,
+	0xf6, 0xbf, 0x6e, 0x87, 0xd0, 0x48, 0x44, 0xc0, 0x4a, 0x4f, 0x9d, 0xd1, 0x5b, 0xe9, 0xc6, 0x8a,
+	0x2e, 0xbb, 0xa7, 0x9b, 0xc7, 0x8c, 0x96, 0xa0, 0x7a, 0x07, 0x17, 0x52, 0x12, 0x03, 0x9c, 0x5a,
+	0x86, 0x35, 0x36, 0x28, 0x3a, 0xc1, 0x4d, 0x78, 0xa3, 0x4f, 0x2d, 0x93, 0x20, 0xa5, 0x48, 0x4f,
+	0x66, 0xa6, 0x41, 0x5c, 0x54, 0x2b, 0x2e, 0xc6, 0x31, 0x0c, 0x8a, 0xea, 0xf8, 0x02, 0xce, 0x6c,
+	0x3a, 0x35, 0xa8, 0x41, 0x51, 0x43, 0xfd, 0xa1, 0x40, 0xd7, 0x9a, 0x3b, 0x95, 0x97, 0x2c, 0xd7,
+	0x23, 0x11, 0xe5, 0x21, 0xcf, 0x72, 0xfc, 0x08, 0x1d, 0x6f, 0x17, 0x2c, 0xb3, 0x3c, 0x66, 0x2f,
+	0xe3, 0xf4, 0x59, 0x32, 0xf7, 0x97, 0xea, 0xd5, 0x64, 0x69, 0xbb, 0xed, 0xc9, 0xa1, 0xfa, 0x05,
+	0x7a, 0xff, 0x42, 0x0b, 0x7f, 0xc4, 0xb6, 0x4c, 0xa2, 0xcf, 0xd0, 0xc9, 0x61, 0x40, 0xec, 0xc5,
+	0x1c, 0x29, 0xaa, 0x09, 0x97, 0x13, 0x11, 0xae, 0x78, 0xc4, 0xd6, 0x87, 0x1d, 0xf8, 0x04, 0xb0,
+	0x1f, 0xc9, 0xb4, 0xa7, 0xf4, 0xeb, 0x83, 0x8b, 0x51, 0xf7, 0xb5, 0xa9, 0xa7, 0x12, 0x37, 0x76,
+	0xe0, 0x83, 0x48, 0xb6, 0xda, 0x53, 0x1e, 0xb3, 0x24, 0x60, 0xeb, 0x2d, 0x4b, 0xb4, 0x8d, 0xb7,
+	0x4a, 0xb8, 0x5f, 0xad, 0x5c, 0xfa, 0xd2, 0xe0, 0x61, 0xb0, 0xe5, 0xd9, 0xd3, 0xf3, 0xaa, 0x08,
+	0x87, 0x12, 0x3c, 0xac, 0xe0, 0x61, 0x05, 0x17, 0x4b, 0xbb, 0x3a, 0x2d, 0xdf, 0x6f, 0x7f, 0x0f,
+	0x00, 0x30, 0x91, 0xbc, 0x0d, 0xc6, 0x03, 0x00, 0x00,
 }
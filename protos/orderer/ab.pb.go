@@ -6,6 +6,7 @@ package orderer // import "github.com/hyperledger/fabric/protos/orderer"
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 import common "github.com/hyperledger/fabric/protos/common"
 
 import (
@@ -44,7 +45,30 @@ func (x SeekInfo_SeekBehavior) String() string {
 	return proto.EnumName(SeekInfo_SeekBehavior_name, int32(x))
 }
 func (SeekInfo_SeekBehavior) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_ab_86effae0ebc2388c, []int{5, 0}
+	return fileDescriptor_ab_86effae0ebc2388c, []int{6, 0}
+}
+
+type SeekInfo_SeekContentFilter int32
+
+const (
+	SeekInfo_ALL_BLOCKS         SeekInfo_SeekContentFilter = 0
+	SeekInfo_CONFIG_BLOCKS_ONLY SeekInfo_SeekContentFilter = 1
+)
+
+var SeekInfo_SeekContentFilter_name = map[int32]string{
+	0: "ALL_BLOCKS",
+	1: "CONFIG_BLOCKS_ONLY",
+}
+var SeekInfo_SeekContentFilter_value = map[string]int32{
+	"ALL_BLOCKS":         0,
+	"CONFIG_BLOCKS_ONLY": 1,
+}
+
+func (x SeekInfo_SeekContentFilter) String() string {
+	return proto.EnumName(SeekInfo_SeekContentFilter_name, int32(x))
+}
+func (SeekInfo_SeekContentFilter) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ab_86effae0ebc2388c, []int{6, 1}
 }
 
 type BroadcastResponse struct {
@@ -193,11 +217,53 @@ func (m *SeekSpecified) GetNumber() uint64 {
 	return 0
 }
 
+// SeekTimestamp seeks the first block whose timestamp, that is the timestamp
+// of the channel header of its first transaction, is not before the given time
+type SeekTimestamp struct {
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SeekTimestamp) Reset()         { *m = SeekTimestamp{} }
+func (m *SeekTimestamp) String() string { return proto.CompactTextString(m) }
+func (*SeekTimestamp) ProtoMessage()    {}
+func (*SeekTimestamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab_86effae0ebc2388c, []int{4}
+}
+func (m *SeekTimestamp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SeekTimestamp.Unmarshal(m, b)
+}
+func (m *SeekTimestamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SeekTimestamp.Marshal(b, m, deterministic)
+}
+func (dst *SeekTimestamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeekTimestamp.Merge(dst, src)
+}
+func (m *SeekTimestamp) XXX_Size() int {
+	return xxx_messageInfo_SeekTimestamp.Size(m)
+}
+func (m *SeekTimestamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeekTimestamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SeekTimestamp proto.InternalMessageInfo
+
+func (m *SeekTimestamp) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
 type SeekPosition struct {
 	// Types that are valid to be assigned to Type:
 	//	*SeekPosition_Newest
 	//	*SeekPosition_Oldest
 	//	*SeekPosition_Specified
+	//	*SeekPosition_Timestamp
+	//	*SeekPosition_Checkpoint
 	Type                 isSeekPosition_Type `protobuf_oneof:"Type"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
@@ -208,7 +274,7 @@ func (m *SeekPosition) Reset()         { *m = SeekPosition{} }
 func (m *SeekPosition) String() string { return proto.CompactTextString(m) }
 func (*SeekPosition) ProtoMessage()    {}
 func (*SeekPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ab_86effae0ebc2388c, []int{4}
+	return fileDescriptor_ab_86effae0ebc2388c, []int{5}
 }
 func (m *SeekPosition) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SeekPosition.Unmarshal(m, b)
@@ -244,12 +310,24 @@ type SeekPosition_Specified struct {
 	Specified *SeekSpecified `protobuf:"bytes,3,opt,name=specified,proto3,oneof"`
 }
 
+type SeekPosition_Timestamp struct {
+	Timestamp *SeekTimestamp `protobuf:"bytes,4,opt,name=timestamp,proto3,oneof"`
+}
+
+type SeekPosition_Checkpoint struct {
+	Checkpoint *SeekCheckpoint `protobuf:"bytes,5,opt,name=checkpoint,proto3,oneof"`
+}
+
 func (*SeekPosition_Newest) isSeekPosition_Type() {}
 
 func (*SeekPosition_Oldest) isSeekPosition_Type() {}
 
 func (*SeekPosition_Specified) isSeekPosition_Type() {}
 
+func (*SeekPosition_Timestamp) isSeekPosition_Type() {}
+
+func (*SeekPosition_Checkpoint) isSeekPosition_Type() {}
+
 func (m *SeekPosition) GetType() isSeekPosition_Type {
 	if m != nil {
 		return m.Type
@@ -278,12 +356,28 @@ func (m *SeekPosition) GetSpecified() *SeekSpecified {
 	return nil
 }
 
+func (m *SeekPosition) GetTimestamp() *SeekTimestamp {
+	if x, ok := m.GetType().(*SeekPosition_Timestamp); ok {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (m *SeekPosition) GetCheckpoint() *SeekCheckpoint {
+	if x, ok := m.GetType().(*SeekPosition_Checkpoint); ok {
+		return x.Checkpoint
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*SeekPosition) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _SeekPosition_OneofMarshaler, _SeekPosition_OneofUnmarshaler, _SeekPosition_OneofSizer, []interface{}{
 		(*SeekPosition_Newest)(nil),
 		(*SeekPosition_Oldest)(nil),
 		(*SeekPosition_Specified)(nil),
+		(*SeekPosition_Timestamp)(nil),
+		(*SeekPosition_Checkpoint)(nil),
 	}
 }
 
@@ -306,6 +400,16 @@ func _SeekPosition_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Specified); err != nil {
 			return err
 		}
+	case *SeekPosition_Timestamp:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Timestamp); err != nil {
+			return err
+		}
+	case *SeekPosition_Checkpoint:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Checkpoint); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("SeekPosition.Type has unexpected type %T", x)
@@ -340,6 +444,22 @@ func _SeekPosition_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.B
 		err := b.DecodeMessage(msg)
 		m.Type = &SeekPosition_Specified{msg}
 		return true, err
+	case 4: // Type.timestamp
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(SeekTimestamp)
+		err := b.DecodeMessage(msg)
+		m.Type = &SeekPosition_Timestamp{msg}
+		return true, err
+	case 5: // Type.checkpoint
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(SeekCheckpoint)
+		err := b.DecodeMessage(msg)
+		m.Type = &SeekPosition_Checkpoint{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -364,6 +484,16 @@ func _SeekPosition_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *SeekPosition_Timestamp:
+		s := proto.Size(x.Timestamp)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *SeekPosition_Checkpoint:
+		s := proto.Size(x.Checkpoint)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -380,19 +510,20 @@ func _SeekPosition_OneofSizer(msg proto.Message) (n int) {
 // as they are created, behavior should be set to BLOCK_UNTIL_READY and the stop should be set to
 // specified with a number of MAX_UINT64
 type SeekInfo struct {
-	Start                *SeekPosition         `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
-	Stop                 *SeekPosition         `protobuf:"bytes,2,opt,name=stop,proto3" json:"stop,omitempty"`
-	Behavior             SeekInfo_SeekBehavior `protobuf:"varint,3,opt,name=behavior,proto3,enum=orderer.SeekInfo_SeekBehavior" json:"behavior,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	Start                *SeekPosition              `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Stop                 *SeekPosition              `protobuf:"bytes,2,opt,name=stop,proto3" json:"stop,omitempty"`
+	Behavior             SeekInfo_SeekBehavior      `protobuf:"varint,3,opt,name=behavior,proto3,enum=orderer.SeekInfo_SeekBehavior" json:"behavior,omitempty"`
+	ContentFilter        SeekInfo_SeekContentFilter `protobuf:"varint,4,opt,name=content_filter,json=contentFilter,proto3,enum=orderer.SeekInfo_SeekContentFilter" json:"content_filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
 func (m *SeekInfo) Reset()         { *m = SeekInfo{} }
 func (m *SeekInfo) String() string { return proto.CompactTextString(m) }
 func (*SeekInfo) ProtoMessage()    {}
 func (*SeekInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ab_86effae0ebc2388c, []int{5}
+	return fileDescriptor_ab_86effae0ebc2388c, []int{6}
 }
 func (m *SeekInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SeekInfo.Unmarshal(m, b)
@@ -433,6 +564,13 @@ func (m *SeekInfo) GetBehavior() SeekInfo_SeekBehavior {
 	return SeekInfo_BLOCK_UNTIL_READY
 }
 
+func (m *SeekInfo) GetContentFilter() SeekInfo_SeekContentFilter {
+	if m != nil {
+		return m.ContentFilter
+	}
+	return SeekInfo_ALL_BLOCKS
+}
+
 type DeliverResponse struct {
 	// Types that are valid to be assigned to Type:
 	//	*DeliverResponse_Status
@@ -447,7 +585,7 @@ func (m *DeliverResponse) Reset()         { *m = DeliverResponse{} }
 func (m *DeliverResponse) String() string { return proto.CompactTextString(m) }
 func (*DeliverResponse) ProtoMessage()    {}
 func (*DeliverResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ab_86effae0ebc2388c, []int{6}
+	return fileDescriptor_ab_86effae0ebc2388c, []int{7}
 }
 func (m *DeliverResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeliverResponse.Unmarshal(m, b)
@@ -573,15 +711,109 @@ func _DeliverResponse_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+// SeekCheckpoint resumes a deliver request after the block of a resume token
+// received in a previous deliver response
+type SeekCheckpoint struct {
+	Token                []byte   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SeekCheckpoint) Reset()         { *m = SeekCheckpoint{} }
+func (m *SeekCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*SeekCheckpoint) ProtoMessage()    {}
+func (*SeekCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab_86effae0ebc2388c, []int{8}
+}
+func (m *SeekCheckpoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SeekCheckpoint.Unmarshal(m, b)
+}
+func (m *SeekCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SeekCheckpoint.Marshal(b, m, deterministic)
+}
+func (dst *SeekCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeekCheckpoint.Merge(dst, src)
+}
+func (m *SeekCheckpoint) XXX_Size() int {
+	return xxx_messageInfo_SeekCheckpoint.Size(m)
+}
+func (m *SeekCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeekCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SeekCheckpoint proto.InternalMessageInfo
+
+func (m *SeekCheckpoint) GetToken() []byte {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+// DeliverCheckpoint is the content of the resume tokens issued by the deliver
+// services, which clients treat as opaque. It identifies the block after
+// which the delivery resumes, and is only valid on the ledger the block with
+// the given header hash was committed to.
+type DeliverCheckpoint struct {
+	Number               uint64   `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	HeaderHash           []byte   `protobuf:"bytes,2,opt,name=header_hash,json=headerHash,proto3" json:"header_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeliverCheckpoint) Reset()         { *m = DeliverCheckpoint{} }
+func (m *DeliverCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*DeliverCheckpoint) ProtoMessage()    {}
+func (*DeliverCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab_86effae0ebc2388c, []int{9}
+}
+func (m *DeliverCheckpoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeliverCheckpoint.Unmarshal(m, b)
+}
+func (m *DeliverCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeliverCheckpoint.Marshal(b, m, deterministic)
+}
+func (dst *DeliverCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliverCheckpoint.Merge(dst, src)
+}
+func (m *DeliverCheckpoint) XXX_Size() int {
+	return xxx_messageInfo_DeliverCheckpoint.Size(m)
+}
+func (m *DeliverCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliverCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliverCheckpoint proto.InternalMessageInfo
+
+func (m *DeliverCheckpoint) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *DeliverCheckpoint) GetHeaderHash() []byte {
+	if m != nil {
+		return m.HeaderHash
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BroadcastResponse)(nil), "orderer.BroadcastResponse")
 	proto.RegisterType((*SeekNewest)(nil), "orderer.SeekNewest")
 	proto.RegisterType((*SeekOldest)(nil), "orderer.SeekOldest")
 	proto.RegisterType((*SeekSpecified)(nil), "orderer.SeekSpecified")
+	proto.RegisterType((*SeekTimestamp)(nil), "orderer.SeekTimestamp")
 	proto.RegisterType((*SeekPosition)(nil), "orderer.SeekPosition")
 	proto.RegisterType((*SeekInfo)(nil), "orderer.SeekInfo")
 	proto.RegisterType((*DeliverResponse)(nil), "orderer.DeliverResponse")
+	proto.RegisterType((*SeekCheckpoint)(nil), "orderer.SeekCheckpoint")
+	proto.RegisterType((*DeliverCheckpoint)(nil), "orderer.DeliverCheckpoint")
 	proto.RegisterEnum("orderer.SeekInfo_SeekBehavior", SeekInfo_SeekBehavior_name, SeekInfo_SeekBehavior_value)
+	proto.RegisterEnum("orderer.SeekInfo_SeekContentFilter", SeekInfo_SeekContentFilter_name, SeekInfo_SeekContentFilter_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -760,37 +992,49 @@ var _AtomicBroadcast_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("orderer/ab.proto", fileDescriptor_ab_86effae0ebc2388c) }
 
 var fileDescriptor_ab_86effae0ebc2388c = []byte{
-	// 505 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xdf, 0x6e, 0x12, 0x51,
-	0x10, 0xc6, 0x59, 0xa5, 0xb4, 0x8c, 0x2d, 0xa5, 0xa7, 0x69, 0x43, 0xb8, 0x30, 0xcd, 0x26, 0x55,
-	0x8c, 0xba, 0x6b, 0x30, 0xf1, 0x42, 0x4d, 0x0c, 0x6b, 0xdb, 0x40, 0x24, 0x60, 0x16, 0x7a, 0xa1,
-	0x37, 0x64, 0x77, 0x19, 0x60, 0xed, 0xb2, 0x67, 0x73, 0xce, 0x01, 0xd3, 0xa7, 0xf0, 0x45, 0x7c,
-	0x24, 0x1f, 0xc6, 0x9c, 0x3f, 0xbb, 0x48, 0xd5, 0x5e, 0xb1, 0x33, 0xf3, 0xfb, 0xce, 0x7c, 0x33,
-	0x19, 0xa0, 0x4e, 0xd9, 0x14, 0x19, 0x32, 0x37, 0x08, 0x9d, 0x8c, 0x51, 0x41, 0xc9, 0xae, 0xc9,
-	0x34, 0x8f, 0x23, 0xba, 0x5c, 0xd2, 0xd4, 0xd5, 0x3f, 0xba, 0x6a, 0x0f, 0xe1, 0xc8, 0x63, 0x34,
-	0x98, 0x46, 0x01, 0x17, 0x3e, 0xf2, 0x8c, 0xa6, 0x1c, 0xc9, 0x13, 0xa8, 0x70, 0x11, 0x88, 0x15,
-	0x6f, 0x58, 0x67, 0x56, 0xab, 0xd6, 0xae, 0x39, 0x46, 0x33, 0x52, 0x59, 0xdf, 0x54, 0x09, 0x81,
-	0x72, 0x9c, 0xce, 0x68, 0xe3, 0xc1, 0x99, 0xd5, 0xaa, 0xfa, 0xea, 0xdb, 0xde, 0x07, 0x18, 0x21,
-	0xde, 0x0c, 0xf0, 0x3b, 0x72, 0x91, 0x47, 0xc3, 0x64, 0x2a, 0xa3, 0xa7, 0x70, 0x20, 0xa3, 0x51,
-	0x86, 0x51, 0x3c, 0x8b, 0x71, 0x4a, 0x4e, 0xa1, 0x92, 0xae, 0x96, 0x21, 0x32, 0xd5, 0xa8, 0xec,
-	0x9b, 0xc8, 0xfe, 0x69, 0xc1, 0xbe, 0x24, 0x3f, 0x53, 0x1e, 0x8b, 0x98, 0xa6, 0xe4, 0x25, 0x54,
-	0x52, 0xf5, 0xa2, 0x02, 0x1f, 0xb5, 0x8f, 0x1d, 0x33, 0x95, 0xb3, 0x69, 0xd6, 0x2d, 0xf9, 0x06,
-	0x92, 0x38, 0x55, 0x2d, 0x95, 0xb5, 0xbb, 0xb8, 0x76, 0x23, 0x71, 0x0d, 0x91, 0x37, 0x50, 0xe5,
-	0xb9, 0xa7, 0xc6, 0x43, 0xa5, 0x38, 0xdd, 0x52, 0x14, 0x8e, 0xbb, 0x25, 0x7f, 0x83, 0x7a, 0x15,
-	0x28, 0x8f, 0x6f, 0x33, 0xb4, 0x7f, 0x59, 0xb0, 0x27, 0xb1, 0x5e, 0x3a, 0xa3, 0xe4, 0x39, 0xec,
-	0x70, 0x11, 0xb0, 0xdc, 0xe9, 0xc9, 0xd6, 0x43, 0xf9, 0x40, 0xbe, 0x66, 0xc8, 0x33, 0x28, 0x73,
-	0x41, 0x33, 0x63, 0xf3, 0x3f, 0xac, 0x42, 0xc8, 0x5b, 0xd8, 0x0b, 0x71, 0x11, 0xac, 0x63, 0xca,
-	0x94, 0xc7, 0x5a, 0xfb, 0xf1, 0x16, 0x2e, 0x9b, 0xab, 0x0f, 0xcf, 0x50, 0x7e, 0xc1, 0xdb, 0xef,
-	0xf5, 0x3a, 0xf3, 0x0a, 0x39, 0x81, 0x23, 0xaf, 0x3f, 0xfc, 0xf8, 0x69, 0x72, 0x3d, 0x18, 0xf7,
-	0xfa, 0x13, 0xff, 0xb2, 0x73, 0xf1, 0xa5, 0x5e, 0x92, 0xe9, 0xab, 0x4e, 0xaf, 0x3f, 0xe9, 0x5d,
-	0x4d, 0x06, 0xc3, 0xb1, 0x49, 0x5b, 0xf6, 0x37, 0x38, 0xbc, 0xc0, 0x24, 0x5e, 0x23, 0x2b, 0x2e,
-	0xa4, 0x75, 0xff, 0x85, 0xc8, 0xdd, 0x9a, 0x1b, 0x39, 0x87, 0x9d, 0x30, 0xa1, 0xd1, 0x8d, 0x19,
-	0xf1, 0x20, 0x07, 0x3d, 0x99, 0xec, 0x96, 0x7c, 0x5d, 0xcd, 0x57, 0xd9, 0xfe, 0x61, 0xc1, 0x61,
-	0x47, 0xd0, 0x65, 0x1c, 0x15, 0x67, 0x49, 0x3e, 0x40, 0x75, 0x13, 0xd4, 0xf3, 0x07, 0x2e, 0xd3,
-	0x35, 0x26, 0x34, 0xc3, 0x66, 0xb3, 0x58, 0xc3, 0x5f, 0x97, 0x6c, 0x97, 0x5a, 0xd6, 0x2b, 0x8b,
-	0xbc, 0x83, 0x5d, 0x33, 0xc0, 0x3f, 0xe4, 0x8d, 0x42, 0x7e, 0x67, 0x48, 0x2d, 0xf6, 0xae, 0xe1,
-	0x9c, 0xb2, 0xb9, 0xb3, 0xb8, 0xcd, 0x90, 0x25, 0x38, 0x9d, 0x23, 0x73, 0x66, 0x41, 0xc8, 0xe2,
-	0x48, 0xff, 0x83, 0x78, 0x2e, 0xff, 0xfa, 0x62, 0x1e, 0x8b, 0xc5, 0x2a, 0x94, 0x0d, 0xdc, 0x3f,
-	0x68, 0x57, 0xd3, 0xae, 0xa6, 0x5d, 0x43, 0x87, 0x15, 0x15, 0xbf, 0xfe, 0x1d, 0x00, 0x00, 0xff,
-	0xff, 0x4b, 0x88, 0xa4, 0x39, 0xb1, 0x03, 0x00, 0x00,
+	// 691 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xdd, 0x6e, 0x12, 0x41,
+	0x14, 0xc7, 0x01, 0x81, 0x96, 0x53, 0x4a, 0x61, 0x6a, 0x2b, 0xe1, 0xc2, 0x36, 0x6b, 0x5a, 0x31,
+	0xea, 0x62, 0x30, 0x31, 0x6a, 0x4d, 0x0c, 0xd0, 0x22, 0x28, 0x01, 0xb3, 0xd0, 0x0b, 0xbd, 0xd9,
+	0xec, 0x2e, 0x03, 0xbb, 0x02, 0x3b, 0x9b, 0x99, 0xa1, 0xa6, 0x4f, 0xe1, 0x23, 0xf8, 0x74, 0xbe,
+	0x87, 0xd9, 0x99, 0xd9, 0x05, 0x2c, 0xf6, 0x8a, 0x3d, 0x67, 0x7e, 0xff, 0xf3, 0x35, 0xcc, 0x81,
+	0x22, 0xa1, 0x63, 0x4c, 0x31, 0xad, 0x59, 0xb6, 0x1e, 0x50, 0xc2, 0x09, 0xda, 0x51, 0x9e, 0xca,
+	0xa1, 0x43, 0x16, 0x0b, 0xe2, 0xd7, 0xe4, 0x8f, 0x3c, 0xad, 0x9c, 0x4c, 0x09, 0x99, 0xce, 0x71,
+	0x4d, 0x58, 0xf6, 0x72, 0x52, 0xe3, 0xde, 0x02, 0x33, 0x6e, 0x2d, 0x02, 0x09, 0x68, 0x03, 0x28,
+	0x35, 0x29, 0xb1, 0xc6, 0x8e, 0xc5, 0xb8, 0x81, 0x59, 0x40, 0x7c, 0x86, 0xd1, 0x39, 0x64, 0x19,
+	0xb7, 0xf8, 0x92, 0x95, 0x93, 0xa7, 0xc9, 0x6a, 0xa1, 0x5e, 0xd0, 0x55, 0xd0, 0xa1, 0xf0, 0x1a,
+	0xea, 0x14, 0x21, 0x48, 0x7b, 0xfe, 0x84, 0x94, 0x53, 0xa7, 0xc9, 0x6a, 0xce, 0x10, 0xdf, 0x5a,
+	0x1e, 0x60, 0x88, 0xf1, 0xac, 0x8f, 0x7f, 0x62, 0xc6, 0x23, 0x6b, 0x30, 0x1f, 0x87, 0xd6, 0x53,
+	0xd8, 0x0f, 0xad, 0x61, 0x80, 0x1d, 0x6f, 0xe2, 0xe1, 0x31, 0x3a, 0x86, 0xac, 0xbf, 0x5c, 0xd8,
+	0x98, 0x8a, 0x44, 0x69, 0x43, 0x59, 0x5a, 0x57, 0x82, 0xa3, 0xa8, 0x58, 0xf4, 0x16, 0x72, 0x71,
+	0xe5, 0x82, 0xdd, 0xab, 0x57, 0x74, 0xd9, 0x9b, 0x1e, 0xf5, 0xa6, 0xc7, 0xb8, 0xb1, 0x82, 0xb5,
+	0xdf, 0x29, 0xc8, 0x87, 0xb1, 0xbe, 0x12, 0xe6, 0x71, 0x8f, 0xf8, 0xe8, 0x25, 0x64, 0x7d, 0x51,
+	0x9c, 0x8a, 0x73, 0xa8, 0xab, 0x09, 0xea, 0xab, 0xba, 0x3b, 0x09, 0x43, 0x41, 0x21, 0x4e, 0x44,
+	0xf5, 0xe5, 0xd4, 0x16, 0x5c, 0x36, 0x16, 0xe2, 0x12, 0x42, 0x6f, 0x20, 0xc7, 0xa2, 0xf6, 0xca,
+	0x0f, 0x84, 0xe2, 0x78, 0x43, 0x11, 0x37, 0xdf, 0x49, 0x18, 0x2b, 0x34, 0xd4, 0xad, 0x1a, 0x4c,
+	0x6f, 0xd1, 0xc5, 0xcd, 0x85, 0xba, 0x18, 0x45, 0xef, 0x00, 0x1c, 0x17, 0x3b, 0xb3, 0x80, 0x78,
+	0x3e, 0x2f, 0x67, 0x84, 0xf0, 0xd1, 0x86, 0xb0, 0x15, 0x1f, 0x77, 0x12, 0xc6, 0x1a, 0xdc, 0xcc,
+	0x42, 0x7a, 0x74, 0x1b, 0x60, 0xed, 0x4f, 0x0a, 0x76, 0x43, 0xb0, 0xeb, 0x4f, 0x08, 0x7a, 0x0e,
+	0x19, 0xc6, 0x2d, 0x1a, 0x0d, 0xe7, 0x68, 0x23, 0x54, 0x34, 0x43, 0x43, 0x32, 0xe8, 0x19, 0xa4,
+	0x19, 0x27, 0x41, 0x39, 0x75, 0x1f, 0x2b, 0x10, 0xf4, 0x1e, 0x76, 0x6d, 0xec, 0x5a, 0x37, 0x1e,
+	0xa1, 0x62, 0x2c, 0x85, 0xfa, 0xe3, 0x0d, 0x3c, 0x4c, 0x2e, 0x3e, 0x9a, 0x8a, 0x32, 0x62, 0x1e,
+	0x7d, 0x86, 0x82, 0x43, 0x7c, 0x8e, 0x7d, 0x6e, 0x4e, 0xbc, 0x39, 0xc7, 0x54, 0x0c, 0xa8, 0x50,
+	0x7f, 0xb2, 0x3d, 0x42, 0x4b, 0xb2, 0x6d, 0x81, 0x1a, 0xfb, 0xce, 0xba, 0xa9, 0x7d, 0x80, 0xfc,
+	0x7a, 0x16, 0x74, 0x04, 0xa5, 0x66, 0x6f, 0xd0, 0xfa, 0x62, 0x5e, 0xf7, 0x47, 0xdd, 0x9e, 0x69,
+	0x5c, 0x35, 0x2e, 0xbf, 0x15, 0x13, 0xa1, 0xbb, 0xdd, 0xe8, 0xf6, 0xcc, 0x6e, 0xdb, 0xec, 0x0f,
+	0x46, 0xca, 0x9d, 0xd4, 0x2e, 0xa0, 0x74, 0x27, 0x03, 0x2a, 0x00, 0x34, 0x7a, 0x3d, 0x53, 0x84,
+	0x19, 0x16, 0x13, 0xe8, 0x18, 0x50, 0x6b, 0xd0, 0x6f, 0x77, 0x3f, 0x29, 0x97, 0x39, 0xe8, 0xf7,
+	0x42, 0xf1, 0x0f, 0x38, 0xb8, 0xc4, 0x73, 0xef, 0x06, 0xd3, 0xf8, 0xa1, 0x55, 0xef, 0x7f, 0x68,
+	0xe1, 0xff, 0x4a, 0x3d, 0xb5, 0x33, 0xc8, 0xd8, 0x73, 0xe2, 0xcc, 0xd4, 0xac, 0xf7, 0x23, 0xb0,
+	0x19, 0x3a, 0x3b, 0x09, 0x43, 0x9e, 0xc6, 0x77, 0x7a, 0x0e, 0x85, 0xcd, 0xbb, 0x47, 0x0f, 0x21,
+	0xc3, 0xc9, 0x0c, 0xfb, 0x22, 0x53, 0xde, 0x90, 0x86, 0xd6, 0x83, 0x92, 0xaa, 0x69, 0x0d, 0xfd,
+	0xcf, 0xab, 0x44, 0x27, 0xb0, 0xe7, 0x62, 0x6b, 0x8c, 0xa9, 0xe9, 0x5a, 0xcc, 0x15, 0x95, 0xe4,
+	0x0d, 0x90, 0xae, 0x8e, 0xc5, 0xdc, 0xfa, 0xaf, 0x24, 0x1c, 0x34, 0x38, 0x59, 0x78, 0x4e, 0xbc,
+	0x53, 0xd0, 0x47, 0xc8, 0xad, 0x8c, 0x62, 0x54, 0xf6, 0x95, 0x7f, 0x83, 0xe7, 0x24, 0xc0, 0x95,
+	0x4a, 0x7c, 0x87, 0x77, 0xd6, 0x90, 0x96, 0xa8, 0x26, 0x5f, 0x25, 0xd1, 0x05, 0xec, 0xa8, 0x12,
+	0xb7, 0xc8, 0xcb, 0xb1, 0xfc, 0x9f, 0xd1, 0x4a, 0x71, 0xf3, 0x1a, 0xce, 0x08, 0x9d, 0xea, 0xee,
+	0x6d, 0x80, 0xe9, 0x1c, 0x8f, 0xa7, 0x98, 0xea, 0x13, 0xcb, 0xa6, 0x9e, 0x23, 0xb7, 0x06, 0x8b,
+	0xe4, 0xdf, 0x5f, 0x4c, 0x3d, 0xee, 0x2e, 0xed, 0x30, 0x41, 0x6d, 0x8d, 0xae, 0x49, 0x5a, 0xee,
+	0x4f, 0x56, 0x53, 0xb4, 0x9d, 0x15, 0xf6, 0xeb, 0xbf, 0x03, 0x00, 0x0d, 0x63, 0x9c, 0xcb, 0x8f,
+	0x05, 0x00, 0x00,
 }
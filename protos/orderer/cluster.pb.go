@@ -39,7 +39,7 @@ func (m *StepRequest) Reset()         { *m = StepRequest{} }
 func (m *StepRequest) String() string { return proto.CompactTextString(m) }
 func (*StepRequest) ProtoMessage()    {}
 func (*StepRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_d9833ceca5c79414, []int{0}
+	return fileDescriptor_cluster_68eea9fb646c86e8, []int{0}
 }
 func (m *StepRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StepRequest.Unmarshal(m, b)
@@ -184,7 +184,7 @@ func (m *StepResponse) Reset()         { *m = StepResponse{} }
 func (m *StepResponse) String() string { return proto.CompactTextString(m) }
 func (*StepResponse) ProtoMessage()    {}
 func (*StepResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_d9833ceca5c79414, []int{1}
+	return fileDescriptor_cluster_68eea9fb646c86e8, []int{1}
 }
 func (m *StepResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StepResponse.Unmarshal(m, b)
@@ -285,8 +285,11 @@ func _StepResponse_OneofSizer(msg proto.Message) (n int) {
 
 // ConsensusRequest is a consensus specific message sent to a cluster member.
 type ConsensusRequest struct {
-	Channel              string   `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	Payload              []byte   `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// metadata carries consensus specific messages which are not part
+	// of the payload, such as a BlockSignature.
+	Metadata             []byte   `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -296,7 +299,7 @@ func (m *ConsensusRequest) Reset()         { *m = ConsensusRequest{} }
 func (m *ConsensusRequest) String() string { return proto.CompactTextString(m) }
 func (*ConsensusRequest) ProtoMessage()    {}
 func (*ConsensusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_d9833ceca5c79414, []int{2}
+	return fileDescriptor_cluster_68eea9fb646c86e8, []int{2}
 }
 func (m *ConsensusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConsensusRequest.Unmarshal(m, b)
@@ -330,6 +333,13 @@ func (m *ConsensusRequest) GetPayload() []byte {
 	return nil
 }
 
+func (m *ConsensusRequest) GetMetadata() []byte {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 // SubmitRequest wraps a transaction to be sent for ordering.
 type SubmitRequest struct {
 	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
@@ -349,7 +359,7 @@ func (m *SubmitRequest) Reset()         { *m = SubmitRequest{} }
 func (m *SubmitRequest) String() string { return proto.CompactTextString(m) }
 func (*SubmitRequest) ProtoMessage()    {}
 func (*SubmitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_d9833ceca5c79414, []int{3}
+	return fileDescriptor_cluster_68eea9fb646c86e8, []int{3}
 }
 func (m *SubmitRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SubmitRequest.Unmarshal(m, b)
@@ -407,7 +417,7 @@ func (m *SubmitResponse) Reset()         { *m = SubmitResponse{} }
 func (m *SubmitResponse) String() string { return proto.CompactTextString(m) }
 func (*SubmitResponse) ProtoMessage()    {}
 func (*SubmitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_d9833ceca5c79414, []int{4}
+	return fileDescriptor_cluster_68eea9fb646c86e8, []int{4}
 }
 func (m *SubmitResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SubmitResponse.Unmarshal(m, b)
@@ -448,12 +458,62 @@ func (m *SubmitResponse) GetInfo() string {
 	return ""
 }
 
+// BlockSignature carries the signature of an ordering service node over
+// the header of a block. Consenters which collect the signatures of several
+// nodes on every block exchange it in the metadata of a ConsensusRequest.
+type BlockSignature struct {
+	Header               *common.BlockHeader       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Signature            *common.MetadataSignature `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *BlockSignature) Reset()         { *m = BlockSignature{} }
+func (m *BlockSignature) String() string { return proto.CompactTextString(m) }
+func (*BlockSignature) ProtoMessage()    {}
+func (*BlockSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_68eea9fb646c86e8, []int{5}
+}
+func (m *BlockSignature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockSignature.Unmarshal(m, b)
+}
+func (m *BlockSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockSignature.Marshal(b, m, deterministic)
+}
+func (dst *BlockSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockSignature.Merge(dst, src)
+}
+func (m *BlockSignature) XXX_Size() int {
+	return xxx_messageInfo_BlockSignature.Size(m)
+}
+func (m *BlockSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockSignature proto.InternalMessageInfo
+
+func (m *BlockSignature) GetHeader() *common.BlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BlockSignature) GetSignature() *common.MetadataSignature {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*StepRequest)(nil), "orderer.StepRequest")
 	proto.RegisterType((*StepResponse)(nil), "orderer.StepResponse")
 	proto.RegisterType((*ConsensusRequest)(nil), "orderer.ConsensusRequest")
 	proto.RegisterType((*SubmitRequest)(nil), "orderer.SubmitRequest")
 	proto.RegisterType((*SubmitResponse)(nil), "orderer.SubmitResponse")
+	proto.RegisterType((*BlockSignature)(nil), "orderer.BlockSignature")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -562,33 +622,38 @@ var _Cluster_serviceDesc = grpc.ServiceDesc{
 	Metadata: "orderer/cluster.proto",
 }
 
-func init() { proto.RegisterFile("orderer/cluster.proto", fileDescriptor_cluster_d9833ceca5c79414) }
-
-var fileDescriptor_cluster_d9833ceca5c79414 = []byte{
-	// 399 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xd1, 0xee, 0xd2, 0x30,
-	0x14, 0xc6, 0xff, 0x53, 0xc2, 0xb2, 0x03, 0x2c, 0x50, 0x44, 0x91, 0x2b, 0x43, 0xa2, 0x21, 0xc6,
-	0x6c, 0x06, 0x2f, 0xf4, 0xce, 0x04, 0xa2, 0xe1, 0xba, 0x8b, 0x5e, 0x78, 0x43, 0xba, 0xad, 0xc0,
-	0x92, 0xd1, 0x8e, 0xb6, 0x23, 0xe1, 0x01, 0x7c, 0x12, 0x5f, 0xd4, 0xac, 0xed, 0x36, 0xe0, 0x9f,
-	0x70, 0x05, 0x3d, 0xdf, 0xd7, 0xdf, 0xf9, 0xce, 0x7a, 0x60, 0xc2, 0x45, 0x4a, 0x05, 0x15, 0x61,
-	0x92, 0x97, 0x52, 0x51, 0x11, 0x14, 0x82, 0x2b, 0x8e, 0x5c, 0x5b, 0x9e, 0x8d, 0x13, 0x7e, 0x3c,
-	0x72, 0x16, 0x9a, 0x1f, 0xa3, 0xce, 0xff, 0x39, 0xd0, 0x8b, 0x14, 0x2d, 0x30, 0x3d, 0x95, 0x54,
-	0x2a, 0xb4, 0x81, 0x51, 0xc2, 0x99, 0xa4, 0x4c, 0x96, 0x72, 0x2b, 0x4c, 0x71, 0xea, 0xbc, 0x73,
-	0x16, 0xbd, 0xe5, 0xdb, 0xc0, 0x92, 0x82, 0x75, 0xed, 0xb0, 0xb7, 0x36, 0x4f, 0x78, 0x98, 0xdc,
-	0xd5, 0xd0, 0x77, 0xf0, 0x65, 0x19, 0x1f, 0x33, 0xd5, 0x60, 0x5e, 0x68, 0xcc, 0xeb, 0x06, 0x13,
-	0x69, 0xb9, 0x65, 0x0c, 0xe4, 0x75, 0x61, 0xe5, 0x81, 0x5b, 0x90, 0x4b, 0xce, 0x49, 0x3a, 0x8f,
-	0xa0, 0x6f, 0x42, 0xca, 0xa2, 0x6a, 0x83, 0xbe, 0x01, 0x34, 0x6c, 0x69, 0xe3, 0xbd, 0x79, 0xc6,
-	0x35, 0xe6, 0xcd, 0x13, 0xf6, 0x6a, 0xb0, 0xbc, 0x86, 0xfe, 0x84, 0xe1, 0xfd, 0x20, 0x68, 0x0a,
-	0x6e, 0x72, 0x20, 0x8c, 0xd1, 0x5c, 0x53, 0x3d, 0x5c, 0x1f, 0x2b, 0xc5, 0x5e, 0xd4, 0x73, 0xf4,
-	0x71, 0xc3, 0xf9, 0xeb, 0xc0, 0xe0, 0x66, 0x94, 0x07, 0x94, 0x00, 0xc6, 0x39, 0x91, 0x6a, 0x7b,
-	0x26, 0x79, 0x96, 0x12, 0x95, 0x71, 0xb6, 0x95, 0xf4, 0xa4, 0x89, 0x1d, 0x3c, 0xaa, 0xa4, 0xdf,
-	0x8d, 0x12, 0xd1, 0x13, 0xfa, 0xd8, 0x76, 0x7d, 0xa9, 0xa7, 0x1c, 0x06, 0xf6, 0xf9, 0x7e, 0xb0,
-	0x33, 0xcd, 0x79, 0x41, 0xdb, 0x1c, 0x3b, 0xf0, 0x6f, 0x27, 0x7f, 0x90, 0xe3, 0x03, 0x74, 0xa5,
-	0x22, 0xaa, 0x94, 0xba, 0xb5, 0xbf, 0xf4, 0x6b, 0x6c, 0xa4, 0xab, 0xd8, 0xaa, 0x08, 0x41, 0x27,
-	0x63, 0x3b, 0xae, 0x9b, 0x7b, 0x58, 0xff, 0x5f, 0xae, 0xc0, 0x5d, 0x9b, 0x0d, 0x43, 0x5f, 0xa1,
-	0x53, 0xbd, 0x0b, 0x7a, 0xd5, 0x7e, 0xfb, 0x76, 0x97, 0x66, 0x93, 0xbb, 0xaa, 0x49, 0xb5, 0x70,
-	0x3e, 0x3b, 0xab, 0x5f, 0xf0, 0x9e, 0x8b, 0x7d, 0x70, 0xb8, 0x14, 0x54, 0xe4, 0x34, 0xdd, 0x53,
-	0x11, 0xec, 0x48, 0x2c, 0xb2, 0xc4, 0xac, 0xa5, 0xac, 0x6f, 0xfe, 0xf9, 0xb4, 0xcf, 0xd4, 0xa1,
-	0x8c, 0xab, 0x78, 0xe1, 0x95, 0x3b, 0x34, 0xee, 0xd0, 0xb8, 0x43, 0xeb, 0x8e, 0xbb, 0xfa, 0xfc,
-	0xe5, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcc, 0xe7, 0xa4, 0xe2, 0x0b, 0x03, 0x00, 0x00,
+func init() { proto.RegisterFile("orderer/cluster.proto", fileDescriptor_cluster_68eea9fb646c86e8) }
+
+var fileDescriptor_cluster_68eea9fb646c86e8 = []byte{
+	// 465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x6b, 0x1a, 0x25, 0x78, 0xda, 0x5a, 0xe9, 0x86, 0x42, 0x9a, 0x13, 0xb2, 0x04, 0xaa,
+	0x00, 0xd9, 0x28, 0x1c, 0xca, 0x0d, 0x29, 0x15, 0x52, 0x2e, 0x5c, 0xd6, 0x82, 0x03, 0x97, 0x68,
+	0x6d, 0x4f, 0x12, 0x0b, 0xc7, 0xeb, 0xec, 0xae, 0x23, 0xf5, 0x01, 0x78, 0x12, 0x5e, 0x14, 0x65,
+	0xff, 0xd8, 0x69, 0x2a, 0xe5, 0x94, 0xec, 0x7c, 0xdf, 0xfc, 0x66, 0x76, 0x76, 0x0c, 0x37, 0x5c,
+	0xe4, 0x28, 0x50, 0xc4, 0x59, 0xd9, 0x48, 0x85, 0x22, 0xaa, 0x05, 0x57, 0x9c, 0x0c, 0x6c, 0x78,
+	0x32, 0xca, 0xf8, 0x66, 0xc3, 0xab, 0xd8, 0xfc, 0x18, 0x35, 0xfc, 0xe7, 0xc1, 0x45, 0xa2, 0xb0,
+	0xa6, 0xb8, 0x6d, 0x50, 0x2a, 0x32, 0x87, 0xeb, 0x8c, 0x57, 0x12, 0x2b, 0xd9, 0xc8, 0x85, 0x30,
+	0xc1, 0xb1, 0xf7, 0xd6, 0xbb, 0xbb, 0x98, 0xde, 0x46, 0x96, 0x14, 0x3d, 0x38, 0x87, 0xcd, 0x9a,
+	0x9f, 0xd1, 0x61, 0x76, 0x14, 0x23, 0xdf, 0x20, 0x90, 0x4d, 0xba, 0x29, 0x54, 0x8b, 0x79, 0xa1,
+	0x31, 0xaf, 0x5b, 0x4c, 0xa2, 0xe5, 0x8e, 0x71, 0x25, 0x0f, 0x03, 0x33, 0x1f, 0x06, 0x35, 0x7b,
+	0x2c, 0x39, 0xcb, 0xc3, 0x04, 0x2e, 0x4d, 0x93, 0xb2, 0xde, 0x97, 0x21, 0x5f, 0x01, 0x5a, 0xb6,
+	0xb4, 0xed, 0xbd, 0x79, 0xc6, 0x35, 0xe6, 0xf9, 0x19, 0xf5, 0x1d, 0x58, 0x1e, 0x42, 0x53, 0x18,
+	0x1e, 0x5f, 0x84, 0x8c, 0x61, 0x90, 0xad, 0x59, 0x55, 0x61, 0xa9, 0xa9, 0x3e, 0x75, 0x47, 0x32,
+	0x6e, 0x13, 0xf5, 0x3d, 0x2e, 0xa9, 0x3b, 0x92, 0x09, 0xbc, 0xdc, 0xa0, 0x62, 0x39, 0x53, 0x6c,
+	0x7c, 0xae, 0xa5, 0xf6, 0x1c, 0xfe, 0xf5, 0xe0, 0xea, 0xc9, 0x35, 0x4f, 0x54, 0x88, 0x60, 0x54,
+	0x32, 0xa9, 0x16, 0x3b, 0x56, 0x16, 0x39, 0x53, 0x05, 0xaf, 0x16, 0x12, 0xb7, 0xba, 0x5a, 0x8f,
+	0x5e, 0xef, 0xa5, 0x5f, 0xad, 0x92, 0xe0, 0x96, 0x7c, 0xe8, 0x3a, 0x3a, 0xd7, 0x13, 0x18, 0x46,
+	0xf6, 0x69, 0xbf, 0x57, 0x3b, 0x2c, 0x79, 0x8d, 0x6d, 0x8f, 0xe1, 0x12, 0x82, 0xa7, 0x53, 0x39,
+	0xd1, 0xc7, 0x7b, 0xe8, 0x4b, 0xc5, 0x54, 0x23, 0x75, 0xe9, 0x60, 0x1a, 0x38, 0x6c, 0xa2, 0xa3,
+	0xd4, 0xaa, 0x84, 0x40, 0xaf, 0xa8, 0x96, 0x5c, 0x17, 0xf7, 0xa9, 0xfe, 0x1f, 0xee, 0x20, 0x98,
+	0x95, 0x3c, 0xfb, 0x93, 0x14, 0xab, 0x8a, 0xa9, 0x46, 0x20, 0xf9, 0x08, 0xfd, 0x35, 0xb2, 0x1c,
+	0x85, 0x7d, 0xa6, 0x91, 0xa3, 0x69, 0xdf, 0x5c, 0x4b, 0xd4, 0x5a, 0xc8, 0x3d, 0xf8, 0xd2, 0x65,
+	0xda, 0x75, 0xb9, 0x75, 0xfe, 0x1f, 0x76, 0xa6, 0x2d, 0x9a, 0x76, 0xde, 0xe9, 0x0c, 0x06, 0x0f,
+	0x66, 0xeb, 0xc9, 0x3d, 0xf4, 0xf6, 0xbb, 0x42, 0x5e, 0x75, 0xfb, 0xd0, 0xed, 0xf7, 0xe4, 0xe6,
+	0x28, 0x6a, 0xa6, 0x71, 0xe7, 0x7d, 0xf6, 0x66, 0x3f, 0xe1, 0x1d, 0x17, 0xab, 0x68, 0xfd, 0x58,
+	0xa3, 0x28, 0x31, 0x5f, 0xa1, 0x88, 0x96, 0x2c, 0x15, 0x45, 0x66, 0x3e, 0x15, 0xe9, 0x32, 0x7f,
+	0x7f, 0x5a, 0x15, 0x6a, 0xdd, 0xa4, 0xfb, 0xc6, 0xe2, 0x03, 0x77, 0x6c, 0xdc, 0xb1, 0x71, 0xc7,
+	0xd6, 0x9d, 0xf6, 0xf5, 0xf9, 0xcb, 0xff, 0x01, 0x00, 0x0f, 0xa5, 0x4f, 0x1b, 0x9f, 0x03, 0x00,
+	0x00,
 }
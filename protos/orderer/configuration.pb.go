@@ -49,7 +49,7 @@ func (x ConsensusType_MigrationState) String() string {
 	return proto.EnumName(ConsensusType_MigrationState_name, int32(x))
 }
 func (ConsensusType_MigrationState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_configuration_50e2c0f98937cf5a, []int{0, 0}
+	return fileDescriptor_configuration_3b8450f6cede3448, []int{0, 0}
 }
 
 type ConsensusType struct {
@@ -76,7 +76,7 @@ func (m *ConsensusType) Reset()         { *m = ConsensusType{} }
 func (m *ConsensusType) String() string { return proto.CompactTextString(m) }
 func (*ConsensusType) ProtoMessage()    {}
 func (*ConsensusType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configuration_50e2c0f98937cf5a, []int{0}
+	return fileDescriptor_configuration_3b8450f6cede3448, []int{0}
 }
 func (m *ConsensusType) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConsensusType.Unmarshal(m, b)
@@ -143,7 +143,7 @@ func (m *BatchSize) Reset()         { *m = BatchSize{} }
 func (m *BatchSize) String() string { return proto.CompactTextString(m) }
 func (*BatchSize) ProtoMessage()    {}
 func (*BatchSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configuration_50e2c0f98937cf5a, []int{1}
+	return fileDescriptor_configuration_3b8450f6cede3448, []int{1}
 }
 func (m *BatchSize) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BatchSize.Unmarshal(m, b)
@@ -197,7 +197,7 @@ func (m *BatchTimeout) Reset()         { *m = BatchTimeout{} }
 func (m *BatchTimeout) String() string { return proto.CompactTextString(m) }
 func (*BatchTimeout) ProtoMessage()    {}
 func (*BatchTimeout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configuration_50e2c0f98937cf5a, []int{2}
+	return fileDescriptor_configuration_3b8450f6cede3448, []int{2}
 }
 func (m *BatchTimeout) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BatchTimeout.Unmarshal(m, b)
@@ -239,7 +239,7 @@ func (m *KafkaBrokers) Reset()         { *m = KafkaBrokers{} }
 func (m *KafkaBrokers) String() string { return proto.CompactTextString(m) }
 func (*KafkaBrokers) ProtoMessage()    {}
 func (*KafkaBrokers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configuration_50e2c0f98937cf5a, []int{3}
+	return fileDescriptor_configuration_3b8450f6cede3448, []int{3}
 }
 func (m *KafkaBrokers) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KafkaBrokers.Unmarshal(m, b)
@@ -278,7 +278,7 @@ func (m *ChannelRestrictions) Reset()         { *m = ChannelRestrictions{} }
 func (m *ChannelRestrictions) String() string { return proto.CompactTextString(m) }
 func (*ChannelRestrictions) ProtoMessage()    {}
 func (*ChannelRestrictions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configuration_50e2c0f98937cf5a, []int{4}
+	return fileDescriptor_configuration_3b8450f6cede3448, []int{4}
 }
 func (m *ChannelRestrictions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelRestrictions.Unmarshal(m, b)
@@ -305,48 +305,92 @@ func (m *ChannelRestrictions) GetMaxCount() uint64 {
 	return 0
 }
 
+// Decommission marks a channel as decommissioned by all the orderers serving
+// it, which stop serving the channel once the config block setting it is
+// written. It requires the V2_0 orderer capability.
+type Decommission struct {
+	Decommissioned       bool     `protobuf:"varint,1,opt,name=decommissioned,proto3" json:"decommissioned,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Decommission) Reset()         { *m = Decommission{} }
+func (m *Decommission) String() string { return proto.CompactTextString(m) }
+func (*Decommission) ProtoMessage()    {}
+func (*Decommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_configuration_3b8450f6cede3448, []int{5}
+}
+func (m *Decommission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Decommission.Unmarshal(m, b)
+}
+func (m *Decommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Decommission.Marshal(b, m, deterministic)
+}
+func (dst *Decommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Decommission.Merge(dst, src)
+}
+func (m *Decommission) XXX_Size() int {
+	return xxx_messageInfo_Decommission.Size(m)
+}
+func (m *Decommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_Decommission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Decommission proto.InternalMessageInfo
+
+func (m *Decommission) GetDecommissioned() bool {
+	if m != nil {
+		return m.Decommissioned
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*ConsensusType)(nil), "orderer.ConsensusType")
 	proto.RegisterType((*BatchSize)(nil), "orderer.BatchSize")
 	proto.RegisterType((*BatchTimeout)(nil), "orderer.BatchTimeout")
 	proto.RegisterType((*KafkaBrokers)(nil), "orderer.KafkaBrokers")
 	proto.RegisterType((*ChannelRestrictions)(nil), "orderer.ChannelRestrictions")
+	proto.RegisterType((*Decommission)(nil), "orderer.Decommission")
 	proto.RegisterEnum("orderer.ConsensusType_MigrationState", ConsensusType_MigrationState_name, ConsensusType_MigrationState_value)
 }
 
 func init() {
-	proto.RegisterFile("orderer/configuration.proto", fileDescriptor_configuration_50e2c0f98937cf5a)
-}
-
-var fileDescriptor_configuration_50e2c0f98937cf5a = []byte{
-	// 460 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0xdf, 0x8a, 0xda, 0x40,
-	0x14, 0xc6, 0x1b, 0x95, 0xee, 0x7a, 0x58, 0x35, 0x8e, 0x2d, 0x84, 0xee, 0x8d, 0x08, 0x0b, 0xd2,
-	0x2e, 0x11, 0xb6, 0x4f, 0x60, 0x82, 0x94, 0xa5, 0x44, 0x61, 0x9c, 0x42, 0xe9, 0x4d, 0x98, 0xc4,
-	0x63, 0x0c, 0x6b, 0x32, 0x61, 0x66, 0x02, 0xb1, 0x7d, 0x8e, 0x3e, 0x4c, 0xdf, 0xae, 0xe4, 0x9f,
-	0x7f, 0xee, 0xce, 0xf7, 0x7d, 0xbf, 0x9c, 0x99, 0x39, 0x39, 0xf0, 0x28, 0xe4, 0x0e, 0x25, 0xca,
-	0x45, 0x28, 0xd2, 0x7d, 0x1c, 0xe5, 0x92, 0xeb, 0x58, 0xa4, 0x76, 0x26, 0x85, 0x16, 0xe4, 0xae,
-	0x09, 0x67, 0xff, 0x3a, 0x30, 0x70, 0x45, 0xaa, 0x30, 0x55, 0xb9, 0x62, 0xa7, 0x0c, 0x09, 0x81,
-	0x9e, 0x3e, 0x65, 0x68, 0x19, 0x53, 0x63, 0xde, 0xa7, 0x55, 0x4d, 0x3e, 0xc1, 0x7d, 0x82, 0x9a,
-	0xef, 0xb8, 0xe6, 0x56, 0x67, 0x6a, 0xcc, 0x1f, 0xe8, 0x59, 0x93, 0x35, 0x8c, 0x92, 0x38, 0xaa,
-	0xbb, 0xfb, 0x4a, 0x73, 0x8d, 0x56, 0x77, 0x6a, 0xcc, 0x87, 0x2f, 0x4f, 0x76, 0x73, 0x88, 0x7d,
-	0x73, 0x80, 0xed, 0xb5, 0xf4, 0xb6, 0x84, 0xe9, 0x30, 0xb9, 0xd1, 0xe4, 0x0b, 0x8c, 0x2f, 0xfd,
-	0x42, 0x91, 0x6a, 0x2c, 0xb4, 0xd5, 0x9b, 0x1a, 0xf3, 0x1e, 0x35, 0xcf, 0x81, 0x5b, 0xfb, 0xb3,
-	0x3f, 0x30, 0xbc, 0x6d, 0x47, 0x08, 0x0c, 0xbd, 0xd7, 0x6f, 0xfe, 0x96, 0x2d, 0xd9, 0xca, 0x5f,
-	0x6f, 0xd6, 0x2b, 0xf3, 0x1d, 0x99, 0xc0, 0xe8, 0xe2, 0x6d, 0xd9, 0x92, 0x32, 0xd3, 0x20, 0x1f,
-	0xc0, 0xbc, 0x98, 0xee, 0xc6, 0xf3, 0x5e, 0x99, 0xd9, 0xb9, 0x45, 0x97, 0xce, 0x86, 0x32, 0xb3,
-	0x4b, 0x3e, 0xc2, 0xf8, 0x1a, 0x5d, 0xb3, 0xd5, 0x4f, 0x66, 0xf6, 0x66, 0x7f, 0x0d, 0xe8, 0x3b,
-	0x5c, 0x87, 0x87, 0x6d, 0xfc, 0x1b, 0xc9, 0x67, 0x18, 0x27, 0xbc, 0xf0, 0x13, 0x54, 0x8a, 0x47,
-	0xe8, 0x87, 0x22, 0x4f, 0x75, 0x35, 0xc4, 0x01, 0x1d, 0x25, 0xbc, 0xf0, 0x6a, 0xdf, 0x2d, 0x6d,
-	0xf2, 0x0c, 0x84, 0x07, 0x4a, 0x1c, 0x73, 0x8d, 0x7e, 0xf9, 0x51, 0x70, 0xd2, 0xa8, 0xaa, 0xc9,
-	0x0e, 0xa8, 0xd9, 0x26, 0x1e, 0x2f, 0x9c, 0xd2, 0x27, 0x36, 0x4c, 0x32, 0x89, 0x7b, 0x94, 0x12,
-	0x77, 0x57, 0x78, 0xb7, 0xc2, 0xc7, 0xe7, 0xa8, 0xe5, 0x67, 0x73, 0x78, 0xa8, 0xae, 0xc5, 0xe2,
-	0x04, 0x45, 0xae, 0x89, 0x05, 0x77, 0xba, 0x2e, 0x9b, 0x9f, 0xda, 0xca, 0x92, 0xfc, 0xce, 0xf7,
-	0x6f, 0xdc, 0x91, 0xe2, 0x0d, 0xa5, 0x2a, 0xc9, 0xa0, 0x2e, 0x2d, 0x63, 0xda, 0x2d, 0xc9, 0x46,
-	0xce, 0x5e, 0x60, 0xe2, 0x1e, 0x78, 0x9a, 0xe2, 0x91, 0xa2, 0xd2, 0x32, 0x0e, 0xcb, 0x89, 0x2b,
-	0xf2, 0x08, 0xfd, 0xf2, 0x42, 0x97, 0xc7, 0xf6, 0xe8, 0x7d, 0xc2, 0x8b, 0xea, 0x95, 0xce, 0x0f,
-	0x78, 0x12, 0x32, 0xb2, 0x0f, 0xa7, 0x0c, 0xe5, 0x11, 0x77, 0x11, 0x4a, 0x7b, 0xcf, 0x03, 0x19,
-	0x87, 0xf5, 0x12, 0xaa, 0x76, 0x3f, 0x7e, 0x3d, 0x47, 0xb1, 0x3e, 0xe4, 0x81, 0x1d, 0x8a, 0x64,
-	0x71, 0x45, 0x2f, 0x6a, 0x7a, 0x51, 0xd3, 0x8b, 0x86, 0x0e, 0xde, 0x57, 0xfa, 0xeb, 0xff, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x9f, 0x9f, 0xe4, 0x13, 0xe1, 0x02, 0x00, 0x00,
+	proto.RegisterFile("orderer/configuration.proto", fileDescriptor_configuration_3b8450f6cede3448)
+}
+
+var fileDescriptor_configuration_3b8450f6cede3448 = []byte{
+	// 482 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x6f, 0xab, 0xda, 0x30,
+	0x14, 0xc6, 0x57, 0x95, 0x5d, 0x3d, 0xf8, 0xa7, 0xc6, 0x0d, 0xca, 0xee, 0x1b, 0x29, 0xdc, 0x21,
+	0xdb, 0xa5, 0xc2, 0x1d, 0xec, 0xbd, 0x3a, 0x19, 0x97, 0x51, 0x85, 0xd8, 0xc1, 0xd8, 0x9b, 0x92,
+	0xb6, 0xc7, 0x1a, 0xae, 0x69, 0x24, 0x49, 0x41, 0xb7, 0xcf, 0xb1, 0x0f, 0xb3, 0x6f, 0x37, 0xda,
+	0xfa, 0x77, 0xef, 0xce, 0xf3, 0x3c, 0xbf, 0x9c, 0x24, 0x27, 0x81, 0x7b, 0xa9, 0x12, 0x54, 0xa8,
+	0xc6, 0xb1, 0xcc, 0xd6, 0x3c, 0xcd, 0x15, 0x33, 0x5c, 0x66, 0xde, 0x4e, 0x49, 0x23, 0xc9, 0xdd,
+	0x31, 0x74, 0xff, 0xd6, 0xa0, 0x33, 0x93, 0x99, 0xc6, 0x4c, 0xe7, 0x3a, 0x38, 0xec, 0x90, 0x10,
+	0x68, 0x98, 0xc3, 0x0e, 0x1d, 0x6b, 0x68, 0x8d, 0x5a, 0xb4, 0xac, 0xc9, 0x3b, 0x68, 0x0a, 0x34,
+	0x2c, 0x61, 0x86, 0x39, 0xb5, 0xa1, 0x35, 0x6a, 0xd3, 0xb3, 0x26, 0x0b, 0xe8, 0x09, 0x9e, 0x56,
+	0xdd, 0x43, 0x6d, 0x98, 0x41, 0xa7, 0x3e, 0xb4, 0x46, 0xdd, 0xa7, 0x07, 0xef, 0xb8, 0x89, 0x77,
+	0xb3, 0x81, 0xe7, 0x9f, 0xe8, 0x55, 0x01, 0xd3, 0xae, 0xb8, 0xd1, 0xe4, 0x23, 0xf4, 0x2f, 0xfd,
+	0x62, 0x99, 0x19, 0xdc, 0x1b, 0xa7, 0x31, 0xb4, 0x46, 0x0d, 0x6a, 0x9f, 0x83, 0x59, 0xe5, 0xbb,
+	0xbf, 0xa1, 0x7b, 0xdb, 0x8e, 0x10, 0xe8, 0xfa, 0xcf, 0x5f, 0xc3, 0x55, 0x30, 0x09, 0xe6, 0xe1,
+	0x62, 0xb9, 0x98, 0xdb, 0xaf, 0xc8, 0x00, 0x7a, 0x17, 0x6f, 0x15, 0x4c, 0x68, 0x60, 0x5b, 0xe4,
+	0x0d, 0xd8, 0x17, 0x73, 0xb6, 0xf4, 0xfd, 0xe7, 0xc0, 0xae, 0xdd, 0xa2, 0x93, 0xe9, 0x92, 0x06,
+	0x76, 0x9d, 0xbc, 0x85, 0xfe, 0x35, 0xba, 0x08, 0xe6, 0x3f, 0x02, 0xbb, 0xe1, 0xfe, 0xb1, 0xa0,
+	0x35, 0x65, 0x26, 0xde, 0xac, 0xf8, 0x2f, 0x24, 0x1f, 0xa0, 0x2f, 0xd8, 0x3e, 0x14, 0xa8, 0x35,
+	0x4b, 0x31, 0x8c, 0x65, 0x9e, 0x99, 0x72, 0x88, 0x1d, 0xda, 0x13, 0x6c, 0xef, 0x57, 0xfe, 0xac,
+	0xb0, 0xc9, 0x23, 0x10, 0x16, 0x69, 0xb9, 0xcd, 0x0d, 0x86, 0xc5, 0xa2, 0xe8, 0x60, 0x50, 0x97,
+	0x93, 0xed, 0x50, 0xfb, 0x94, 0xf8, 0x6c, 0x3f, 0x2d, 0x7c, 0xe2, 0xc1, 0x60, 0xa7, 0x70, 0x8d,
+	0x4a, 0x61, 0x72, 0x85, 0xd7, 0x4b, 0xbc, 0x7f, 0x8e, 0x4e, 0xbc, 0x3b, 0x82, 0x76, 0x79, 0xac,
+	0x80, 0x0b, 0x94, 0xb9, 0x21, 0x0e, 0xdc, 0x99, 0xaa, 0x3c, 0x3e, 0xea, 0x49, 0x16, 0xe4, 0x37,
+	0xb6, 0x7e, 0x61, 0x53, 0x25, 0x5f, 0x50, 0xe9, 0x82, 0x8c, 0xaa, 0xd2, 0xb1, 0x86, 0xf5, 0x82,
+	0x3c, 0x4a, 0xf7, 0x09, 0x06, 0xb3, 0x0d, 0xcb, 0x32, 0xdc, 0x52, 0xd4, 0x46, 0xf1, 0xb8, 0x98,
+	0xb8, 0x26, 0xf7, 0xd0, 0x2a, 0x0e, 0x74, 0xb9, 0x6c, 0x83, 0x36, 0x05, 0xdb, 0x97, 0xb7, 0x74,
+	0x3f, 0x43, 0xfb, 0x0b, 0xc6, 0x52, 0x08, 0xae, 0x35, 0x97, 0x19, 0x79, 0x0f, 0xdd, 0xe4, 0x4a,
+	0x63, 0x52, 0xae, 0x68, 0xd2, 0xff, 0xdc, 0xe9, 0x77, 0x78, 0x90, 0x2a, 0xf5, 0x36, 0x87, 0x1d,
+	0xaa, 0x2d, 0x26, 0x29, 0x2a, 0x6f, 0xcd, 0x22, 0xc5, 0xe3, 0xea, 0xf3, 0xea, 0xd3, 0xbf, 0xfa,
+	0xf9, 0x98, 0x72, 0xb3, 0xc9, 0x23, 0x2f, 0x96, 0x62, 0x7c, 0x45, 0x8f, 0x2b, 0x7a, 0x5c, 0xd1,
+	0xe3, 0x23, 0x1d, 0xbd, 0x2e, 0xf5, 0xa7, 0x7f, 0x03, 0x00, 0x0d, 0x37, 0x99, 0xe9, 0x19, 0x03,
+	0x00, 0x00,
 }
@@ -0,0 +1,186 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: orderer/configvalidation.proto
+
+package orderer // import "github.com/hyperledger/fabric/protos/orderer"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import common "github.com/hyperledger/fabric/protos/common"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type ConfigUpdateValidationResponse struct {
+	// Status code, SUCCESS if the config update would be accepted by Broadcast
+	Status common.Status `protobuf:"varint,1,opt,name=status,proto3,enum=common.Status" json:"status,omitempty"`
+	// Info string which describes why the config update was rejected
+	Info string `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	// The config sequence of the channel the config update was validated against
+	ConfigSequence       uint64   `protobuf:"varint,3,opt,name=config_sequence,json=configSequence,proto3" json:"config_sequence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfigUpdateValidationResponse) Reset()         { *m = ConfigUpdateValidationResponse{} }
+func (m *ConfigUpdateValidationResponse) String() string { return proto.CompactTextString(m) }
+func (*ConfigUpdateValidationResponse) ProtoMessage()    {}
+func (*ConfigUpdateValidationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_configvalidation_e5c100ab81a678c7, []int{0}
+}
+func (m *ConfigUpdateValidationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigUpdateValidationResponse.Unmarshal(m, b)
+}
+func (m *ConfigUpdateValidationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigUpdateValidationResponse.Marshal(b, m, deterministic)
+}
+func (dst *ConfigUpdateValidationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigUpdateValidationResponse.Merge(dst, src)
+}
+func (m *ConfigUpdateValidationResponse) XXX_Size() int {
+	return xxx_messageInfo_ConfigUpdateValidationResponse.Size(m)
+}
+func (m *ConfigUpdateValidationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigUpdateValidationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigUpdateValidationResponse proto.InternalMessageInfo
+
+func (m *ConfigUpdateValidationResponse) GetStatus() common.Status {
+	if m != nil {
+		return m.Status
+	}
+	return common.Status_UNKNOWN
+}
+
+func (m *ConfigUpdateValidationResponse) GetInfo() string {
+	if m != nil {
+		return m.Info
+	}
+	return ""
+}
+
+func (m *ConfigUpdateValidationResponse) GetConfigSequence() uint64 {
+	if m != nil {
+		return m.ConfigSequence
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ConfigUpdateValidationResponse)(nil), "orderer.ConfigUpdateValidationResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ConfigUpdateValidatorClient is the client API for ConfigUpdateValidator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ConfigUpdateValidatorClient interface {
+	// Validate runs a CONFIG_UPDATE envelope through the same checks which
+	// Broadcast applies, including policy evaluation, but never orders it.
+	Validate(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*ConfigUpdateValidationResponse, error)
+}
+
+type configUpdateValidatorClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewConfigUpdateValidatorClient(cc *grpc.ClientConn) ConfigUpdateValidatorClient {
+	return &configUpdateValidatorClient{cc}
+}
+
+func (c *configUpdateValidatorClient) Validate(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*ConfigUpdateValidationResponse, error) {
+	out := new(ConfigUpdateValidationResponse)
+	err := c.cc.Invoke(ctx, "/orderer.ConfigUpdateValidator/Validate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConfigUpdateValidatorServer is the server API for ConfigUpdateValidator service.
+type ConfigUpdateValidatorServer interface {
+	// Validate runs a CONFIG_UPDATE envelope through the same checks which
+	// Broadcast applies, including policy evaluation, but never orders it.
+	Validate(context.Context, *common.Envelope) (*ConfigUpdateValidationResponse, error)
+}
+
+func RegisterConfigUpdateValidatorServer(s *grpc.Server, srv ConfigUpdateValidatorServer) {
+	s.RegisterService(&_ConfigUpdateValidator_serviceDesc, srv)
+}
+
+func _ConfigUpdateValidator_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.Envelope)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigUpdateValidatorServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orderer.ConfigUpdateValidator/Validate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigUpdateValidatorServer).Validate(ctx, req.(*common.Envelope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ConfigUpdateValidator_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "orderer.ConfigUpdateValidator",
+	HandlerType: (*ConfigUpdateValidatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Validate",
+			Handler:    _ConfigUpdateValidator_Validate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "orderer/configvalidation.proto",
+}
+
+func init() {
+	proto.RegisterFile("orderer/configvalidation.proto", fileDescriptor_configvalidation_e5c100ab81a678c7)
+}
+
+var fileDescriptor_configvalidation_e5c100ab81a678c7 = []byte{
+	// 254 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xdf, 0x4a, 0xc3, 0x30,
+	0x14, 0xc6, 0xa9, 0x8e, 0xa9, 0xb9, 0xa8, 0x12, 0x11, 0xca, 0x2e, 0x46, 0x11, 0x74, 0xbd, 0x90,
+	0x04, 0xe6, 0x1b, 0x28, 0x7b, 0x81, 0x8e, 0x79, 0xe1, 0x85, 0x92, 0xb6, 0xa7, 0x5d, 0xa0, 0xcb,
+	0x89, 0x27, 0xe9, 0xc0, 0x07, 0xf0, 0xbd, 0xc5, 0x26, 0x13, 0x2f, 0x64, 0x57, 0xc9, 0xf9, 0xbe,
+	0xdf, 0xf9, 0xcb, 0xe6, 0x48, 0x0d, 0x10, 0x90, 0xac, 0xd1, 0xb4, 0xba, 0xdb, 0xab, 0x5e, 0x37,
+	0xca, 0x6b, 0x34, 0xc2, 0x12, 0x7a, 0xe4, 0x67, 0xd1, 0x9f, 0x5d, 0xd7, 0xb8, 0xdb, 0xa1, 0x91,
+	0xe1, 0x09, 0xee, 0xed, 0x57, 0xc2, 0xe6, 0xcf, 0x63, 0xe2, 0xc6, 0x36, 0xca, 0xc3, 0xcb, 0x6f,
+	0x7a, 0x09, 0xce, 0xa2, 0x71, 0xc0, 0xef, 0xd9, 0xd4, 0x79, 0xe5, 0x07, 0x97, 0x25, 0x79, 0x52,
+	0xa4, 0xcb, 0x54, 0xc4, 0x0a, 0xeb, 0x51, 0x2d, 0xa3, 0xcb, 0x39, 0x9b, 0x68, 0xd3, 0x62, 0x76,
+	0x92, 0x27, 0xc5, 0x45, 0x39, 0xfe, 0xf9, 0x82, 0x5d, 0x86, 0xb1, 0xde, 0x1d, 0x7c, 0x0c, 0x60,
+	0x6a, 0xc8, 0x4e, 0xf3, 0xa4, 0x98, 0x94, 0x69, 0x90, 0xd7, 0x51, 0x5d, 0xbe, 0xb1, 0x9b, 0x7f,
+	0xc6, 0x40, 0xe2, 0x2b, 0x76, 0x1e, 0x03, 0xe0, 0x57, 0x87, 0xce, 0x2b, 0xb3, 0x87, 0x1e, 0x2d,
+	0xcc, 0x16, 0x22, 0x6e, 0x27, 0x8e, 0x2f, 0xf1, 0xb4, 0x61, 0x77, 0x48, 0x9d, 0xd8, 0x7e, 0x5a,
+	0xa0, 0x1e, 0x9a, 0x0e, 0x48, 0xb4, 0xaa, 0x22, 0x5d, 0x87, 0x3b, 0xb8, 0x43, 0x9d, 0xd7, 0x87,
+	0x4e, 0xfb, 0xed, 0x50, 0xfd, 0x74, 0x92, 0x7f, 0x68, 0x19, 0x68, 0x19, 0x68, 0x19, 0xe9, 0x6a,
+	0x3a, 0xc6, 0x8f, 0xdf, 0x03, 0x00, 0x77, 0x2f, 0xc7, 0xec, 0x85, 0x01, 0x00, 0x00,
+}
@@ -49,6 +49,12 @@ const (
 	ChaincodeMessage_GET_STATE_METADATA    ChaincodeMessage_Type = 20
 	ChaincodeMessage_PUT_STATE_METADATA    ChaincodeMessage_Type = 21
 	ChaincodeMessage_GET_PRIVATE_DATA_HASH ChaincodeMessage_Type = 22
+	ChaincodeMessage_CREATE_COMPOSITE_KEY  ChaincodeMessage_Type = 23
+	ChaincodeMessage_SPLIT_COMPOSITE_KEY   ChaincodeMessage_Type = 24
+	// SHIM_LOG_LEVEL is sent by the shim with an empty payload before
+	// REGISTER, to announce that it can set its logging level at runtime,
+	// and by the peer to set it to the level in the payload.
+	ChaincodeMessage_SHIM_LOG_LEVEL ChaincodeMessage_Type = 25
 )
 
 var ChaincodeMessage_Type_name = map[int32]string{
@@ -74,6 +80,9 @@ var ChaincodeMessage_Type_name = map[int32]string{
 	20: "GET_STATE_METADATA",
 	21: "PUT_STATE_METADATA",
 	22: "GET_PRIVATE_DATA_HASH",
+	23: "CREATE_COMPOSITE_KEY",
+	24: "SPLIT_COMPOSITE_KEY",
+	25: "SHIM_LOG_LEVEL",
 }
 var ChaincodeMessage_Type_value = map[string]int32{
 	"UNDEFINED":             0,
@@ -98,13 +107,16 @@ var ChaincodeMessage_Type_value = map[string]int32{
 	"GET_STATE_METADATA":    20,
 	"PUT_STATE_METADATA":    21,
 	"GET_PRIVATE_DATA_HASH": 22,
+	"CREATE_COMPOSITE_KEY":  23,
+	"SPLIT_COMPOSITE_KEY":   24,
+	"SHIM_LOG_LEVEL":        25,
 }
 
 func (x ChaincodeMessage_Type) String() string {
 	return proto.EnumName(ChaincodeMessage_Type_name, int32(x))
 }
 func (ChaincodeMessage_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{0, 0}
+	return fileDescriptor_chaincode_shim_6529ff014cbce6ec, []int{0, 0}
 }
 
 type ChaincodeMessage struct {
@@ -128,7 +140,7 @@ func (m *ChaincodeMessage) Reset()         { *m = ChaincodeMessage{} }
 func (m *ChaincodeMessage) String() string { return proto.CompactTextString(m) }
 func (*ChaincodeMessage) ProtoMessage()    {}
 func (*ChaincodeMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{0}
+	return fileDescriptor_chaincode_shim_6529ff014cbce6ec, []int{0}
 }
 func (m *ChaincodeMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChaincodeMessage.Unmarshal(m, b)
@@ -212,7 +224,7 @@ func (m *GetState) Reset()         { *m = GetState{} }
 func (m *GetState) String() string { return proto.CompactTextString(m) }
 func (*GetState) ProtoMessage()    {}
 func (*GetState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{1}
+	return fileDescriptor_chaincode_shim_6529ff014cbce6ec, []int{1}
 }
 func (m *GetState) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetState.Unmarshal(m, b)
@@ -258,7 +270,7 @@ func (m *GetStateMetadata) Reset()         { *m = GetStateMetadata{} }
 func (m *GetStateMetadata) String() string { return proto.CompactTextString(m) }
 func (*GetStateMetadata) ProtoMessage()    {}
 func (*GetStateMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{2}
+	return fileDescriptor_chaincode_shim_6529ff014cbce6ec, []int{2}
 }
 func (m *GetStateMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStateMetadata.Unmarshal(m, b)
@@ -309,7 +321,7 @@ func (m *PutState) Reset()         { *m = PutState{} }
 func (m *PutState) String() string { return proto.CompactTextString(m) }
 func (*PutState) ProtoMessage()    {}
 func (*PutState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{3}
+	return fileDescriptor_chaincode_shim_6529ff014cbce6ec, []int{3}
 }
 func (m *PutState) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PutState.Unmarshal(m, b)
@@ -363,7 +375,7 @@ func (m *PutStateMetadata) Reset()         { *m = PutStateMetadata{} }
 func (m *PutStateMetadata) String() string { return proto.CompactTextString(m) }
 func (*PutStateMetadata) ProtoMessage()    {}
 func (*PutStateMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{4}
+	return fileDescriptor_chaincode_shim_6529ff014cbce6ec, []int{4}
 }
 func (m *PutStateMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PutStateMetadata.Unmarshal(m, b)
@@ -420,7 +432,7 @@ func (m *DelState) Reset()         { *m = DelState{} }
 func (m *DelState) String() string { return proto.CompactTextString(m) }
 func (*DelState) ProtoMessage()    {}
 func (*DelState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{5}
+	return fileDescriptor_chaincode_shim_6529ff014cbce6ec, []int{5}
 }
 func (m *DelState) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DelState.Unmarshal(m, b)
@@ -472,7 +484,7 @@ func (m *GetStateByRange) Reset()         { *m = GetStateByRange{} }
 func (m *GetStateByRange) String() string { return proto.CompactTextString(m) }
 func (*GetStateByRange) ProtoMessage()    {}
 func (*GetStateByRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{6}
+	return fileDescriptor_chaincode_shim_6529ff014cbce6ec, []int{6}
 }
 func (m *GetStateByRange) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStateByRange.Unmarshal(m, b)
@@ -537,7 +549,7 @@ func (m *GetQueryResult) Reset()         { *m = GetQueryResult{} }
 func (m *GetQueryResult) String() string { return proto.CompactTextString(m) }
 func (*GetQueryResult) ProtoMessage()    {}
 func (*GetQueryResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{7}
+	return fileDescriptor_chaincode_shim_6529ff014cbce6ec, []int{7}
 }
 func (m *GetQueryResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetQueryResult.Unmarshal(m, b)
@@ -593,7 +605,7 @@ func (m *QueryMetadata) Reset()         { *m = QueryMetadata{} }
 func (m *QueryMetadata) String() string { return proto.CompactTextString(m) }
 func (*QueryMetadata) ProtoMessage()    {}
 func (*QueryMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{8}
+	return fileDescriptor_chaincode_shim_6529ff014cbce6ec, []int{8}
 }
 func (m *QueryMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryMetadata.Unmarshal(m, b)
@@ -640,7 +652,7 @@ func (m *GetHistoryForKey) Reset()         { *m = GetHistoryForKey{} }
 func (m *GetHistoryForKey) String() string { return proto.CompactTextString(m) }
 func (*GetHistoryForKey) ProtoMessage()    {}
 func (*GetHistoryForKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{9}
+	return fileDescriptor_chaincode_shim_6529ff014cbce6ec, []int{9}
 }
 func (m *GetHistoryForKey) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetHistoryForKey.Unmarshal(m, b)
@@ -678,7 +690,7 @@ func (m *QueryStateNext) Reset()         { *m = QueryStateNext{} }
 func (m *QueryStateNext) String() string { return proto.CompactTextString(m) }
 func (*QueryStateNext) ProtoMessage()    {}
 func (*QueryStateNext) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{10}
+	return fileDescriptor_chaincode_shim_6529ff014cbce6ec, []int{10}
 }
 func (m *QueryStateNext) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryStateNext.Unmarshal(m, b)
@@ -716,7 +728,7 @@ func (m *QueryStateClose) Reset()         { *m = QueryStateClose{} }
 func (m *QueryStateClose) String() string { return proto.CompactTextString(m) }
 func (*QueryStateClose) ProtoMessage()    {}
 func (*QueryStateClose) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{11}
+	return fileDescriptor_chaincode_shim_6529ff014cbce6ec, []int{11}
 }
 func (m *QueryStateClose) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryStateClose.Unmarshal(m, b)
@@ -755,7 +767,7 @@ func (m *QueryResultBytes) Reset()         { *m = QueryResultBytes{} }
 func (m *QueryResultBytes) String() string { return proto.CompactTextString(m) }
 func (*QueryResultBytes) ProtoMessage()    {}
 func (*QueryResultBytes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{12}
+	return fileDescriptor_chaincode_shim_6529ff014cbce6ec, []int{12}
 }
 func (m *QueryResultBytes) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryResultBytes.Unmarshal(m, b)
@@ -801,7 +813,7 @@ func (m *QueryResponse) Reset()         { *m = QueryResponse{} }
 func (m *QueryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryResponse) ProtoMessage()    {}
 func (*QueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{13}
+	return fileDescriptor_chaincode_shim_6529ff014cbce6ec, []int{13}
 }
 func (m *QueryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryResponse.Unmarshal(m, b)
@@ -863,7 +875,7 @@ func (m *QueryResponseMetadata) Reset()         { *m = QueryResponseMetadata{} }
 func (m *QueryResponseMetadata) String() string { return proto.CompactTextString(m) }
 func (*QueryResponseMetadata) ProtoMessage()    {}
 func (*QueryResponseMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{14}
+	return fileDescriptor_chaincode_shim_6529ff014cbce6ec, []int{14}
 }
 func (m *QueryResponseMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryResponseMetadata.Unmarshal(m, b)
@@ -909,7 +921,7 @@ func (m *StateMetadata) Reset()         { *m = StateMetadata{} }
 func (m *StateMetadata) String() string { return proto.CompactTextString(m) }
 func (*StateMetadata) ProtoMessage()    {}
 func (*StateMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{15}
+	return fileDescriptor_chaincode_shim_6529ff014cbce6ec, []int{15}
 }
 func (m *StateMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateMetadata.Unmarshal(m, b)
@@ -954,7 +966,7 @@ func (m *StateMetadataResult) Reset()         { *m = StateMetadataResult{} }
 func (m *StateMetadataResult) String() string { return proto.CompactTextString(m) }
 func (*StateMetadataResult) ProtoMessage()    {}
 func (*StateMetadataResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{16}
+	return fileDescriptor_chaincode_shim_6529ff014cbce6ec, []int{16}
 }
 func (m *StateMetadataResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateMetadataResult.Unmarshal(m, b)
@@ -981,6 +993,192 @@ func (m *StateMetadataResult) GetEntries() []*StateMetadata {
 	return nil
 }
 
+// CreateCompositeKey is the payload of a ChaincodeMessage. It contains the
+// object type and the attributes which are combined into a composite key.
+type CreateCompositeKey struct {
+	ObjectType           string   `protobuf:"bytes,1,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
+	Attributes           []string `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateCompositeKey) Reset()         { *m = CreateCompositeKey{} }
+func (m *CreateCompositeKey) String() string { return proto.CompactTextString(m) }
+func (*CreateCompositeKey) ProtoMessage()    {}
+func (*CreateCompositeKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaincode_shim_6529ff014cbce6ec, []int{17}
+}
+func (m *CreateCompositeKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCompositeKey.Unmarshal(m, b)
+}
+func (m *CreateCompositeKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCompositeKey.Marshal(b, m, deterministic)
+}
+func (dst *CreateCompositeKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCompositeKey.Merge(dst, src)
+}
+func (m *CreateCompositeKey) XXX_Size() int {
+	return xxx_messageInfo_CreateCompositeKey.Size(m)
+}
+func (m *CreateCompositeKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCompositeKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCompositeKey proto.InternalMessageInfo
+
+func (m *CreateCompositeKey) GetObjectType() string {
+	if m != nil {
+		return m.ObjectType
+	}
+	return ""
+}
+
+func (m *CreateCompositeKey) GetAttributes() []string {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+// CompositeKeyResult is the payload of the response to a CREATE_COMPOSITE_KEY
+// message. It contains the composite key, and the exclusive end key of the
+// range of all composite keys which have the composite key as a prefix.
+type CompositeKeyResult struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	RangeEndKey          string   `protobuf:"bytes,2,opt,name=range_end_key,json=rangeEndKey,proto3" json:"range_end_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompositeKeyResult) Reset()         { *m = CompositeKeyResult{} }
+func (m *CompositeKeyResult) String() string { return proto.CompactTextString(m) }
+func (*CompositeKeyResult) ProtoMessage()    {}
+func (*CompositeKeyResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaincode_shim_6529ff014cbce6ec, []int{18}
+}
+func (m *CompositeKeyResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompositeKeyResult.Unmarshal(m, b)
+}
+func (m *CompositeKeyResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompositeKeyResult.Marshal(b, m, deterministic)
+}
+func (dst *CompositeKeyResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompositeKeyResult.Merge(dst, src)
+}
+func (m *CompositeKeyResult) XXX_Size() int {
+	return xxx_messageInfo_CompositeKeyResult.Size(m)
+}
+func (m *CompositeKeyResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompositeKeyResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompositeKeyResult proto.InternalMessageInfo
+
+func (m *CompositeKeyResult) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *CompositeKeyResult) GetRangeEndKey() string {
+	if m != nil {
+		return m.RangeEndKey
+	}
+	return ""
+}
+
+// SplitCompositeKey is the payload of a ChaincodeMessage. It contains the
+// composite key which is to be split into its object type and attributes.
+type SplitCompositeKey struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SplitCompositeKey) Reset()         { *m = SplitCompositeKey{} }
+func (m *SplitCompositeKey) String() string { return proto.CompactTextString(m) }
+func (*SplitCompositeKey) ProtoMessage()    {}
+func (*SplitCompositeKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaincode_shim_6529ff014cbce6ec, []int{19}
+}
+func (m *SplitCompositeKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SplitCompositeKey.Unmarshal(m, b)
+}
+func (m *SplitCompositeKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SplitCompositeKey.Marshal(b, m, deterministic)
+}
+func (dst *SplitCompositeKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplitCompositeKey.Merge(dst, src)
+}
+func (m *SplitCompositeKey) XXX_Size() int {
+	return xxx_messageInfo_SplitCompositeKey.Size(m)
+}
+func (m *SplitCompositeKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplitCompositeKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SplitCompositeKey proto.InternalMessageInfo
+
+func (m *SplitCompositeKey) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+// SplitCompositeKeyResult is the payload of the response to a
+// SPLIT_COMPOSITE_KEY message. It contains the object type and the
+// attributes of the composite key.
+type SplitCompositeKeyResult struct {
+	ObjectType           string   `protobuf:"bytes,1,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
+	Attributes           []string `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SplitCompositeKeyResult) Reset()         { *m = SplitCompositeKeyResult{} }
+func (m *SplitCompositeKeyResult) String() string { return proto.CompactTextString(m) }
+func (*SplitCompositeKeyResult) ProtoMessage()    {}
+func (*SplitCompositeKeyResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaincode_shim_6529ff014cbce6ec, []int{20}
+}
+func (m *SplitCompositeKeyResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SplitCompositeKeyResult.Unmarshal(m, b)
+}
+func (m *SplitCompositeKeyResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SplitCompositeKeyResult.Marshal(b, m, deterministic)
+}
+func (dst *SplitCompositeKeyResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplitCompositeKeyResult.Merge(dst, src)
+}
+func (m *SplitCompositeKeyResult) XXX_Size() int {
+	return xxx_messageInfo_SplitCompositeKeyResult.Size(m)
+}
+func (m *SplitCompositeKeyResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplitCompositeKeyResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SplitCompositeKeyResult proto.InternalMessageInfo
+
+func (m *SplitCompositeKeyResult) GetObjectType() string {
+	if m != nil {
+		return m.ObjectType
+	}
+	return ""
+}
+
+func (m *SplitCompositeKeyResult) GetAttributes() []string {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ChaincodeMessage)(nil), "protos.ChaincodeMessage")
 	proto.RegisterType((*GetState)(nil), "protos.GetState")
@@ -999,6 +1197,10 @@ func init() {
 	proto.RegisterType((*QueryResponseMetadata)(nil), "protos.QueryResponseMetadata")
 	proto.RegisterType((*StateMetadata)(nil), "protos.StateMetadata")
 	proto.RegisterType((*StateMetadataResult)(nil), "protos.StateMetadataResult")
+	proto.RegisterType((*CreateCompositeKey)(nil), "protos.CreateCompositeKey")
+	proto.RegisterType((*CompositeKeyResult)(nil), "protos.CompositeKeyResult")
+	proto.RegisterType((*SplitCompositeKey)(nil), "protos.SplitCompositeKey")
+	proto.RegisterType((*SplitCompositeKeyResult)(nil), "protos.SplitCompositeKeyResult")
 	proto.RegisterEnum("protos.ChaincodeMessage_Type", ChaincodeMessage_Type_name, ChaincodeMessage_Type_value)
 }
 
@@ -1107,74 +1309,82 @@ var _ChaincodeSupport_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("peer/chaincode_shim.proto", fileDescriptor_chaincode_shim_b04d3028f86b65a2)
-}
-
-var fileDescriptor_chaincode_shim_b04d3028f86b65a2 = []byte{
-	// 1027 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x73, 0xda, 0x46,
-	0x14, 0x0e, 0x06, 0x8c, 0x78, 0xd8, 0x78, 0xb3, 0x0e, 0x2e, 0x66, 0x26, 0x2d, 0x65, 0x7a, 0xa0,
-	0x17, 0x68, 0x68, 0x0f, 0x3d, 0x74, 0x26, 0x83, 0x61, 0x8d, 0x19, 0xdb, 0x40, 0x56, 0xb2, 0x27,
-	0xee, 0x45, 0x23, 0xa4, 0xb5, 0xd0, 0x58, 0x68, 0x55, 0x69, 0x49, 0x43, 0x6f, 0xbd, 0xf6, 0xd8,
-	0x3f, 0xae, 0x7f, 0x4f, 0x67, 0xf5, 0xcb, 0x80, 0xeb, 0xa4, 0x93, 0x13, 0xfa, 0xde, 0xfb, 0xf6,
-	0x7b, 0xbf, 0xf6, 0x21, 0xc1, 0xa9, 0xcf, 0x58, 0xd0, 0x35, 0x17, 0x86, 0xe3, 0x99, 0xdc, 0x62,
-	0x7a, 0xb8, 0x70, 0x96, 0x1d, 0x3f, 0xe0, 0x82, 0xe3, 0xfd, 0xe8, 0x27, 0x6c, 0x34, 0x76, 0x28,
-	0xec, 0x03, 0xf3, 0x44, 0xcc, 0x69, 0x1c, 0x47, 0x3e, 0x3f, 0xe0, 0x3e, 0x0f, 0x0d, 0x37, 0x31,
-	0x7e, 0x63, 0x73, 0x6e, 0xbb, 0xac, 0x1b, 0xa1, 0xf9, 0xea, 0xbe, 0x2b, 0x9c, 0x25, 0x0b, 0x85,
-	0xb1, 0xf4, 0x63, 0x42, 0xeb, 0x9f, 0x22, 0xa0, 0x41, 0xaa, 0x77, 0xcd, 0xc2, 0xd0, 0xb0, 0x19,
-	0x7e, 0x03, 0x05, 0xb1, 0xf6, 0x59, 0x3d, 0xd7, 0xcc, 0xb5, 0xab, 0xbd, 0xd7, 0x31, 0x35, 0xec,
-	0xec, 0xf2, 0x3a, 0xda, 0xda, 0x67, 0x34, 0xa2, 0xe2, 0x9f, 0xa1, 0x9c, 0x49, 0xd7, 0xf7, 0x9a,
-	0xb9, 0x76, 0xa5, 0xd7, 0xe8, 0xc4, 0xc1, 0x3b, 0x69, 0xf0, 0x8e, 0x96, 0x32, 0xe8, 0x23, 0x19,
-	0xd7, 0xa1, 0xe4, 0x1b, 0x6b, 0x97, 0x1b, 0x56, 0x3d, 0xdf, 0xcc, 0xb5, 0x0f, 0x68, 0x0a, 0x31,
-	0x86, 0x82, 0xf8, 0xe8, 0x58, 0xf5, 0x42, 0x33, 0xd7, 0x2e, 0xd3, 0xe8, 0x19, 0xf7, 0x40, 0x49,
-	0x4b, 0xac, 0x17, 0xa3, 0x30, 0x27, 0x69, 0x7a, 0xaa, 0x63, 0x7b, 0xcc, 0x9a, 0x25, 0x5e, 0x9a,
-	0xf1, 0xf0, 0x5b, 0x38, 0xda, 0x69, 0x59, 0x7d, 0x7f, 0xfb, 0x68, 0x56, 0x19, 0x91, 0x5e, 0x5a,
-	0x35, 0xb7, 0x30, 0x7e, 0x0d, 0x60, 0x2e, 0x0c, 0xcf, 0x63, 0xae, 0xee, 0x58, 0xf5, 0x52, 0x94,
-	0x4e, 0x39, 0xb1, 0x8c, 0xad, 0xd6, 0xdf, 0x79, 0x28, 0xc8, 0x56, 0xe0, 0x43, 0x28, 0xdf, 0x4c,
-	0x86, 0xe4, 0x7c, 0x3c, 0x21, 0x43, 0xf4, 0x02, 0x1f, 0x80, 0x42, 0xc9, 0x68, 0xac, 0x6a, 0x84,
-	0xa2, 0x1c, 0xae, 0x02, 0xa4, 0x88, 0x0c, 0xd1, 0x1e, 0x56, 0xa0, 0x30, 0x9e, 0x8c, 0x35, 0x94,
-	0xc7, 0x65, 0x28, 0x52, 0xd2, 0x1f, 0xde, 0xa1, 0x02, 0x3e, 0x82, 0x8a, 0x46, 0xfb, 0x13, 0xb5,
-	0x3f, 0xd0, 0xc6, 0xd3, 0x09, 0x2a, 0x4a, 0xc9, 0xc1, 0xf4, 0x7a, 0x76, 0x45, 0x34, 0x32, 0x44,
-	0xfb, 0x92, 0x4a, 0x28, 0x9d, 0x52, 0x54, 0x92, 0x9e, 0x11, 0xd1, 0x74, 0x55, 0xeb, 0x6b, 0x04,
-	0x29, 0x12, 0xce, 0x6e, 0x52, 0x58, 0x96, 0x70, 0x48, 0xae, 0x12, 0x08, 0xf8, 0x15, 0xa0, 0xf1,
-	0xe4, 0x76, 0x7a, 0x49, 0xf4, 0xc1, 0x45, 0x7f, 0x3c, 0x19, 0x4c, 0x87, 0x04, 0x55, 0xe2, 0x04,
-	0xd5, 0xd9, 0x74, 0xa2, 0x12, 0x74, 0x88, 0x4f, 0x00, 0x67, 0x82, 0xfa, 0xd9, 0x9d, 0x4e, 0xfb,
-	0x93, 0x11, 0x41, 0x55, 0x79, 0x56, 0xda, 0xdf, 0xdd, 0x10, 0x7a, 0xa7, 0x53, 0xa2, 0xde, 0x5c,
-	0x69, 0xe8, 0x48, 0x5a, 0x63, 0x4b, 0xcc, 0x9f, 0x90, 0xf7, 0x1a, 0x42, 0xb8, 0x06, 0x2f, 0x37,
-	0xad, 0x83, 0xab, 0xa9, 0x4a, 0xd0, 0x4b, 0x99, 0xcd, 0x25, 0x21, 0xb3, 0xfe, 0xd5, 0xf8, 0x96,
-	0x20, 0x8c, 0xbf, 0x82, 0x63, 0xa9, 0x78, 0x31, 0x56, 0xb5, 0x29, 0xbd, 0xd3, 0xcf, 0xa7, 0x54,
-	0xbf, 0x24, 0x77, 0xe8, 0x78, 0x3b, 0x85, 0x6b, 0xa2, 0xf5, 0x87, 0x7d, 0xad, 0x8f, 0x5e, 0x49,
-	0x7b, 0x56, 0xdc, 0xa3, 0xbd, 0x86, 0x4f, 0xa1, 0x26, 0xf9, 0x33, 0x3a, 0xbe, 0x95, 0x1e, 0x69,
-	0xd5, 0x2f, 0xfa, 0xea, 0x05, 0x3a, 0x69, 0xfd, 0x02, 0xca, 0x88, 0x09, 0x55, 0x18, 0x82, 0x61,
-	0x04, 0xf9, 0x07, 0xb6, 0x8e, 0xae, 0x73, 0x99, 0xca, 0x47, 0xfc, 0x35, 0x80, 0xc9, 0x5d, 0x97,
-	0x99, 0xc2, 0xe1, 0x5e, 0x74, 0x5f, 0xcb, 0x74, 0xc3, 0xd2, 0x1a, 0x02, 0x4a, 0x4f, 0x5f, 0x33,
-	0x61, 0x58, 0x86, 0x30, 0xbe, 0x40, 0x85, 0x82, 0x32, 0x5b, 0x3d, 0x9b, 0xc3, 0x2b, 0x28, 0x7e,
-	0x30, 0xdc, 0x15, 0x8b, 0x0e, 0x1e, 0xd0, 0x18, 0xec, 0x68, 0xe6, 0x9f, 0x68, 0xfe, 0x0e, 0x28,
-	0xd5, 0xfc, 0xdf, 0x99, 0x3d, 0x51, 0xc1, 0x6f, 0x40, 0x59, 0x26, 0xa7, 0xa3, 0xf5, 0xaa, 0xf4,
-	0x6a, 0xd9, 0x1a, 0x6d, 0x4a, 0xd3, 0x8c, 0x26, 0x1b, 0x3a, 0x64, 0xee, 0x97, 0x36, 0xf4, 0xcf,
-	0x1c, 0x1c, 0xa5, 0x1d, 0x3d, 0x5b, 0x53, 0xc3, 0xb3, 0x19, 0x6e, 0x80, 0x12, 0x0a, 0x23, 0x10,
-	0x97, 0x99, 0x54, 0x86, 0xf1, 0x09, 0xec, 0x33, 0xcf, 0x92, 0x9e, 0x58, 0x2b, 0x41, 0x9f, 0x2d,
-	0xac, 0xb1, 0x53, 0xd8, 0xc1, 0x46, 0x05, 0x73, 0xa8, 0x8e, 0x98, 0x78, 0xb7, 0x62, 0xc1, 0x9a,
-	0xb2, 0x70, 0xe5, 0x0a, 0x39, 0x82, 0xdf, 0x24, 0x4c, 0xc2, 0xc7, 0xe0, 0x73, 0xb5, 0x6c, 0xc5,
-	0xc8, 0xef, 0xc4, 0x18, 0xc1, 0x61, 0x14, 0x20, 0x9b, 0x4d, 0x03, 0x14, 0xdf, 0xb0, 0x99, 0xea,
-	0xfc, 0x11, 0xff, 0x9f, 0x16, 0x69, 0x86, 0xa5, 0x6f, 0xce, 0xf9, 0xc3, 0xd2, 0x08, 0x1e, 0x92,
-	0x30, 0x19, 0x6e, 0x7d, 0x17, 0xdd, 0xc0, 0x0b, 0x27, 0x14, 0x3c, 0x58, 0x9f, 0xf3, 0x40, 0x16,
-	0xff, 0xa4, 0xed, 0xad, 0x26, 0x54, 0xa3, 0x70, 0x51, 0x5f, 0x27, 0xec, 0xa3, 0xc0, 0x55, 0xd8,
-	0x73, 0xac, 0x84, 0xb2, 0xe7, 0x58, 0xad, 0x6f, 0xe1, 0xe8, 0x91, 0x31, 0x70, 0x79, 0xc8, 0x9e,
-	0x50, 0x7e, 0x02, 0xb4, 0xd1, 0x94, 0xb3, 0xb5, 0x60, 0x21, 0x6e, 0x42, 0x25, 0x78, 0x84, 0x11,
-	0xf9, 0x80, 0x6e, 0x9a, 0x5a, 0x7f, 0xe5, 0x92, 0x52, 0x29, 0x0b, 0x7d, 0xee, 0x85, 0x0c, 0xf7,
-	0xa0, 0x14, 0x13, 0x24, 0x3f, 0xdf, 0xae, 0xf4, 0xea, 0xe9, 0x9d, 0xda, 0x95, 0xa7, 0x29, 0x11,
-	0x9f, 0x82, 0xb2, 0x30, 0x42, 0x7d, 0xc9, 0x83, 0x78, 0x0f, 0x14, 0x5a, 0x5a, 0x18, 0xe1, 0x35,
-	0x0f, 0xd2, 0x34, 0xf3, 0x69, 0x9a, 0x9f, 0x1c, 0xad, 0x0d, 0xb5, 0xad, 0x5c, 0xb2, 0xf6, 0xf7,
-	0xa0, 0x76, 0xcf, 0x84, 0xb9, 0x60, 0x96, 0x1e, 0x30, 0x93, 0x07, 0x56, 0xa8, 0x9b, 0x7c, 0xe5,
-	0x89, 0x64, 0x16, 0xc7, 0x89, 0x93, 0xc6, 0xbe, 0x81, 0x74, 0x7d, 0x72, 0x2c, 0x6f, 0xe1, 0x70,
-	0x7b, 0xf7, 0xea, 0x50, 0x92, 0x59, 0x3c, 0xce, 0x25, 0x85, 0xff, 0xbd, 0xdf, 0xad, 0x73, 0x38,
-	0xde, 0xde, 0xb0, 0xf8, 0x26, 0x76, 0xa1, 0xc4, 0x3c, 0x11, 0x38, 0x2c, 0xed, 0xdd, 0x33, 0xfb,
-	0x98, 0xb2, 0x7a, 0xef, 0x37, 0xde, 0xdb, 0xea, 0xca, 0xf7, 0x79, 0x20, 0xf0, 0x10, 0x14, 0xca,
-	0x6c, 0x27, 0x14, 0x2c, 0xc0, 0xf5, 0xe7, 0xde, 0xda, 0x8d, 0x67, 0x3d, 0xad, 0x17, 0xed, 0xdc,
-	0x0f, 0xb9, 0xb3, 0x29, 0xb4, 0x78, 0x60, 0x77, 0x16, 0x6b, 0x9f, 0x05, 0x2e, 0xb3, 0x6c, 0x16,
-	0x74, 0xee, 0x8d, 0x79, 0xe0, 0x98, 0xe9, 0x39, 0xf9, 0xa1, 0xf1, 0xeb, 0xf7, 0xb6, 0x23, 0x16,
-	0xab, 0x79, 0xc7, 0xe4, 0xcb, 0xee, 0x06, 0xb5, 0x1b, 0x53, 0xe3, 0x0f, 0x8e, 0xb0, 0x2b, 0xa9,
-	0xf3, 0xf8, 0xeb, 0xe5, 0xc7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x82, 0x3d, 0x52, 0xe1,
-	0x08, 0x00, 0x00,
+	proto.RegisterFile("peer/chaincode_shim.proto", fileDescriptor_chaincode_shim_6529ff014cbce6ec)
+}
+
+var fileDescriptor_chaincode_shim_6529ff014cbce6ec = []byte{
+	// 1165 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x72, 0x1a, 0x47,
+	0x10, 0x36, 0x42, 0x12, 0xd0, 0x48, 0x68, 0x3c, 0xfa, 0xf1, 0x8a, 0x2a, 0xdb, 0x64, 0x2b, 0xa9,
+	0x52, 0x2e, 0x10, 0x93, 0x1c, 0x72, 0x48, 0x95, 0x0b, 0xc1, 0x08, 0x6d, 0x04, 0x2c, 0x9e, 0x5d,
+	0x54, 0x96, 0x2f, 0x5b, 0x0b, 0x3b, 0x86, 0x8d, 0x80, 0xd9, 0xec, 0x0e, 0x8e, 0xc9, 0x2d, 0xd7,
+	0xbc, 0x43, 0x1e, 0x2c, 0x6f, 0x93, 0x9a, 0xfd, 0x13, 0x3f, 0x91, 0x5d, 0x71, 0x4e, 0xda, 0xaf,
+	0xfb, 0x9b, 0xee, 0xaf, 0xbb, 0xa7, 0xc5, 0xc0, 0xb9, 0xc7, 0x98, 0x5f, 0x1b, 0x4d, 0x6c, 0x77,
+	0x3e, 0xe2, 0x0e, 0xb3, 0x82, 0x89, 0x3b, 0xab, 0x7a, 0x3e, 0x17, 0x1c, 0xef, 0x87, 0x7f, 0x82,
+	0x72, 0x79, 0x83, 0xc2, 0x3e, 0xb0, 0xb9, 0x88, 0x38, 0xe5, 0xe3, 0xd0, 0xe7, 0xf9, 0xdc, 0xe3,
+	0x81, 0x3d, 0x8d, 0x8d, 0x2f, 0xc7, 0x9c, 0x8f, 0xa7, 0xac, 0x16, 0xa2, 0xe1, 0xe2, 0x7d, 0x4d,
+	0xb8, 0x33, 0x16, 0x08, 0x7b, 0xe6, 0x45, 0x04, 0xf5, 0xaf, 0x7d, 0x40, 0xcd, 0x24, 0x5e, 0x97,
+	0x05, 0x81, 0x3d, 0x66, 0xf8, 0x15, 0xec, 0x8a, 0xa5, 0xc7, 0x94, 0x4c, 0x25, 0x73, 0x51, 0xaa,
+	0x3f, 0x8f, 0xa8, 0x41, 0x75, 0x93, 0x57, 0x35, 0x97, 0x1e, 0xa3, 0x21, 0x15, 0xff, 0x08, 0x85,
+	0x34, 0xb4, 0xb2, 0x53, 0xc9, 0x5c, 0x14, 0xeb, 0xe5, 0x6a, 0x94, 0xbc, 0x9a, 0x24, 0xaf, 0x9a,
+	0x09, 0x83, 0x3e, 0x90, 0xb1, 0x02, 0x39, 0xcf, 0x5e, 0x4e, 0xb9, 0xed, 0x28, 0xd9, 0x4a, 0xe6,
+	0xe2, 0x80, 0x26, 0x10, 0x63, 0xd8, 0x15, 0x1f, 0x5d, 0x47, 0xd9, 0xad, 0x64, 0x2e, 0x0a, 0x34,
+	0xfc, 0xc6, 0x75, 0xc8, 0x27, 0x25, 0x2a, 0x7b, 0x61, 0x9a, 0xb3, 0x44, 0x9e, 0xe1, 0x8e, 0xe7,
+	0xcc, 0xe9, 0xc7, 0x5e, 0x9a, 0xf2, 0xf0, 0x6b, 0x38, 0xda, 0x68, 0x99, 0xb2, 0xbf, 0x7e, 0x34,
+	0xad, 0x8c, 0x48, 0x2f, 0x2d, 0x8d, 0xd6, 0x30, 0x7e, 0x0e, 0x30, 0x9a, 0xd8, 0xf3, 0x39, 0x9b,
+	0x5a, 0xae, 0xa3, 0xe4, 0x42, 0x39, 0x85, 0xd8, 0xa2, 0x39, 0xea, 0xdf, 0x59, 0xd8, 0x95, 0xad,
+	0xc0, 0x87, 0x50, 0x18, 0xf4, 0x5a, 0xe4, 0x4a, 0xeb, 0x91, 0x16, 0x7a, 0x82, 0x0f, 0x20, 0x4f,
+	0x49, 0x5b, 0x33, 0x4c, 0x42, 0x51, 0x06, 0x97, 0x00, 0x12, 0x44, 0x5a, 0x68, 0x07, 0xe7, 0x61,
+	0x57, 0xeb, 0x69, 0x26, 0xca, 0xe2, 0x02, 0xec, 0x51, 0xd2, 0x68, 0xdd, 0xa1, 0x5d, 0x7c, 0x04,
+	0x45, 0x93, 0x36, 0x7a, 0x46, 0xa3, 0x69, 0x6a, 0x7a, 0x0f, 0xed, 0xc9, 0x90, 0x4d, 0xbd, 0xdb,
+	0xef, 0x10, 0x93, 0xb4, 0xd0, 0xbe, 0xa4, 0x12, 0x4a, 0x75, 0x8a, 0x72, 0xd2, 0xd3, 0x26, 0xa6,
+	0x65, 0x98, 0x0d, 0x93, 0xa0, 0xbc, 0x84, 0xfd, 0x41, 0x02, 0x0b, 0x12, 0xb6, 0x48, 0x27, 0x86,
+	0x80, 0x4f, 0x00, 0x69, 0xbd, 0x5b, 0xfd, 0x86, 0x58, 0xcd, 0xeb, 0x86, 0xd6, 0x6b, 0xea, 0x2d,
+	0x82, 0x8a, 0x91, 0x40, 0xa3, 0xaf, 0xf7, 0x0c, 0x82, 0x0e, 0xf1, 0x19, 0xe0, 0x34, 0xa0, 0x75,
+	0x79, 0x67, 0xd1, 0x46, 0xaf, 0x4d, 0x50, 0x49, 0x9e, 0x95, 0xf6, 0x37, 0x03, 0x42, 0xef, 0x2c,
+	0x4a, 0x8c, 0x41, 0xc7, 0x44, 0x47, 0xd2, 0x1a, 0x59, 0x22, 0x7e, 0x8f, 0xbc, 0x35, 0x11, 0xc2,
+	0xa7, 0xf0, 0x74, 0xd5, 0xda, 0xec, 0xe8, 0x06, 0x41, 0x4f, 0xa5, 0x9a, 0x1b, 0x42, 0xfa, 0x8d,
+	0x8e, 0x76, 0x4b, 0x10, 0xc6, 0xcf, 0xe0, 0x58, 0x46, 0xbc, 0xd6, 0x0c, 0x53, 0xa7, 0x77, 0xd6,
+	0x95, 0x4e, 0xad, 0x1b, 0x72, 0x87, 0x8e, 0xd7, 0x25, 0x74, 0x89, 0xd9, 0x68, 0x35, 0xcc, 0x06,
+	0x3a, 0x91, 0xf6, 0xfe, 0x60, 0xcb, 0x7e, 0x8a, 0xcf, 0xe1, 0x54, 0xf2, 0xfb, 0x54, 0xbb, 0x95,
+	0x1e, 0x69, 0xb5, 0xae, 0x1b, 0xc6, 0x35, 0x3a, 0xc3, 0x0a, 0x9c, 0x34, 0x29, 0x09, 0x45, 0xe8,
+	0xdd, 0xbe, 0x6e, 0x68, 0x26, 0x09, 0x93, 0x3c, 0x93, 0xd9, 0x8d, 0x7e, 0x47, 0x33, 0x37, 0x1c,
+	0x0a, 0xc6, 0x50, 0x32, 0xae, 0xb5, 0xae, 0xd5, 0xd1, 0xdb, 0x56, 0x87, 0xdc, 0x92, 0x0e, 0x3a,
+	0x57, 0x7f, 0x82, 0x7c, 0x9b, 0x09, 0x43, 0xd8, 0x82, 0x61, 0x04, 0xd9, 0x7b, 0xb6, 0x0c, 0xb7,
+	0xa2, 0x40, 0xe5, 0x27, 0x7e, 0x01, 0x30, 0xe2, 0xd3, 0x29, 0x1b, 0x09, 0x97, 0xcf, 0xc3, 0x6b,
+	0x5f, 0xa0, 0x2b, 0x16, 0xb5, 0x05, 0x28, 0x39, 0xdd, 0x65, 0xc2, 0x76, 0x6c, 0x61, 0x7f, 0x41,
+	0x14, 0x0a, 0xf9, 0xfe, 0xe2, 0x51, 0x0d, 0x27, 0xb0, 0xf7, 0xc1, 0x9e, 0x2e, 0x58, 0x78, 0xf0,
+	0x80, 0x46, 0x60, 0x23, 0x66, 0x76, 0x2b, 0xe6, 0x6f, 0x80, 0xfa, 0x8b, 0xff, 0xa8, 0x6c, 0x2b,
+	0x0a, 0x7e, 0x05, 0xf9, 0x59, 0x7c, 0x3a, 0xdc, 0xd2, 0x62, 0xfd, 0x34, 0xdd, 0xc6, 0xd5, 0xd0,
+	0x34, 0xa5, 0xc9, 0x86, 0xb6, 0xd8, 0xf4, 0x4b, 0x1b, 0xfa, 0x47, 0x06, 0x8e, 0x92, 0x8e, 0x5e,
+	0x2e, 0xa9, 0x3d, 0x1f, 0x33, 0x5c, 0x86, 0x7c, 0x20, 0x6c, 0x5f, 0xdc, 0xa4, 0xa1, 0x52, 0x8c,
+	0xcf, 0x60, 0x9f, 0xcd, 0x1d, 0xe9, 0x89, 0x62, 0xc5, 0xe8, 0xb3, 0x85, 0x95, 0x37, 0x0a, 0x3b,
+	0x58, 0xa9, 0x60, 0x08, 0xa5, 0x36, 0x13, 0x6f, 0x16, 0xcc, 0x5f, 0x52, 0x16, 0x2c, 0xa6, 0x42,
+	0x8e, 0xe0, 0x57, 0x09, 0xe3, 0xf4, 0x11, 0xf8, 0x5c, 0x2d, 0x6b, 0x39, 0xb2, 0x1b, 0x39, 0xda,
+	0x70, 0x18, 0x26, 0x48, 0x67, 0x53, 0x86, 0xbc, 0x67, 0x8f, 0x99, 0xe1, 0xfe, 0x1e, 0xfd, 0x5b,
+	0xde, 0xa3, 0x29, 0x96, 0xbe, 0x21, 0xe7, 0xf7, 0x33, 0xdb, 0xbf, 0x8f, 0xd3, 0xa4, 0x58, 0xfd,
+	0x3a, 0xbc, 0x81, 0xd7, 0x6e, 0x20, 0xb8, 0xbf, 0xbc, 0xe2, 0xbe, 0x2c, 0x7e, 0xab, 0xed, 0x6a,
+	0x05, 0x4a, 0x61, 0xba, 0xb0, 0xaf, 0x3d, 0xf6, 0x51, 0xe0, 0x12, 0xec, 0xb8, 0x4e, 0x4c, 0xd9,
+	0x71, 0x1d, 0xf5, 0x2b, 0x38, 0x7a, 0x60, 0x34, 0xa7, 0x3c, 0x60, 0x5b, 0x94, 0x1f, 0x00, 0xad,
+	0x34, 0xe5, 0x72, 0x29, 0x58, 0x80, 0x2b, 0x50, 0xf4, 0x1f, 0x60, 0x48, 0x3e, 0xa0, 0xab, 0x26,
+	0xf5, 0xcf, 0x4c, 0x5c, 0x2a, 0x65, 0x81, 0xc7, 0xe7, 0x01, 0xc3, 0x75, 0xc8, 0x45, 0x04, 0xc9,
+	0xcf, 0x5e, 0x14, 0xeb, 0x4a, 0x72, 0xa7, 0x36, 0xc3, 0xd3, 0x84, 0x88, 0xcf, 0x21, 0x3f, 0xb1,
+	0x03, 0x6b, 0xc6, 0xfd, 0x68, 0x0f, 0xf2, 0x34, 0x37, 0xb1, 0x83, 0x2e, 0xf7, 0x13, 0x99, 0xd9,
+	0x44, 0xe6, 0x27, 0x47, 0x3b, 0x86, 0xd3, 0x35, 0x2d, 0x69, 0xfb, 0xeb, 0x70, 0xfa, 0x9e, 0x89,
+	0xd1, 0x84, 0x39, 0x96, 0xcf, 0x46, 0xdc, 0x77, 0x02, 0x6b, 0xc4, 0x17, 0x73, 0x11, 0xcf, 0xe2,
+	0x38, 0x76, 0xd2, 0xc8, 0xd7, 0x94, 0xae, 0x4f, 0x8e, 0xe5, 0x35, 0x1c, 0xae, 0xef, 0x9e, 0x02,
+	0x39, 0xa9, 0xe2, 0x61, 0x2e, 0x09, 0xfc, 0xf7, 0xfd, 0x56, 0xaf, 0xe0, 0x78, 0x7d, 0xc3, 0xa2,
+	0x9b, 0x58, 0x83, 0x1c, 0x9b, 0x0b, 0xdf, 0x65, 0x49, 0xef, 0x1e, 0xd9, 0xc7, 0x84, 0xa5, 0x0e,
+	0x00, 0x37, 0x7d, 0x26, 0x67, 0xca, 0x67, 0x1e, 0x0f, 0x5c, 0xc1, 0xe4, 0x0d, 0x79, 0x09, 0x45,
+	0x3e, 0xfc, 0x85, 0x8d, 0x84, 0x95, 0xbe, 0x03, 0x0a, 0x14, 0x22, 0x53, 0xf8, 0x4b, 0xf7, 0x02,
+	0xc0, 0x16, 0xc2, 0x77, 0x87, 0x0b, 0x39, 0xd6, 0x9d, 0x4a, 0x56, 0xfa, 0x1f, 0x2c, 0xea, 0xcf,
+	0x80, 0x57, 0x03, 0xc6, 0xea, 0xb6, 0xf7, 0x5d, 0x85, 0x43, 0x5f, 0x2e, 0xb1, 0xc5, 0xe6, 0x8e,
+	0x75, 0x9f, 0xae, 0x69, 0x31, 0x34, 0x92, 0x70, 0x57, 0xd5, 0x6f, 0xe0, 0xa9, 0xe1, 0x4d, 0x5d,
+	0xb1, 0xa6, 0x70, 0xfb, 0x0e, 0xbf, 0x83, 0x67, 0x5b, 0xb4, 0x38, 0xef, 0xff, 0x2d, 0xa7, 0xfe,
+	0x76, 0xe5, 0x91, 0x64, 0x2c, 0x3c, 0x8f, 0xfb, 0x02, 0xb7, 0x20, 0x4f, 0xd9, 0xd8, 0x0d, 0x04,
+	0xf3, 0xb1, 0xf2, 0xd8, 0x13, 0xa9, 0xfc, 0xa8, 0x47, 0x7d, 0x72, 0x91, 0xf9, 0x2e, 0x73, 0xa9,
+	0x83, 0xca, 0xfd, 0x71, 0x75, 0xb2, 0xf4, 0x98, 0x3f, 0x65, 0xce, 0x98, 0xf9, 0xd5, 0xf7, 0xf6,
+	0xd0, 0x77, 0x47, 0xc9, 0x39, 0xf9, 0xaa, 0x7b, 0xf7, 0xed, 0xd8, 0x15, 0x93, 0xc5, 0xb0, 0x3a,
+	0xe2, 0xb3, 0xda, 0x0a, 0xb5, 0x16, 0x51, 0xa3, 0xd7, 0x5d, 0x50, 0x93, 0xd4, 0x61, 0xf4, 0x54,
+	0xfc, 0xfe, 0x9f, 0x01, 0x00, 0x5a, 0x40, 0x59, 0x0c, 0x4e, 0x0a, 0x00, 0x00,
 }
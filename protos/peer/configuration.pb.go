@@ -30,7 +30,7 @@ func (m *AnchorPeers) Reset()         { *m = AnchorPeers{} }
 func (m *AnchorPeers) String() string { return proto.CompactTextString(m) }
 func (*AnchorPeers) ProtoMessage()    {}
 func (*AnchorPeers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configuration_d9ec63ae33c182ef, []int{0}
+	return fileDescriptor_configuration_b0234bb98e807ab7, []int{0}
 }
 func (m *AnchorPeers) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AnchorPeers.Unmarshal(m, b)
@@ -73,7 +73,7 @@ func (m *AnchorPeer) Reset()         { *m = AnchorPeer{} }
 func (m *AnchorPeer) String() string { return proto.CompactTextString(m) }
 func (*AnchorPeer) ProtoMessage()    {}
 func (*AnchorPeer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configuration_d9ec63ae33c182ef, []int{1}
+	return fileDescriptor_configuration_b0234bb98e807ab7, []int{1}
 }
 func (m *AnchorPeer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AnchorPeer.Unmarshal(m, b)
@@ -120,7 +120,7 @@ func (m *APIResource) Reset()         { *m = APIResource{} }
 func (m *APIResource) String() string { return proto.CompactTextString(m) }
 func (*APIResource) ProtoMessage()    {}
 func (*APIResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configuration_d9ec63ae33c182ef, []int{2}
+	return fileDescriptor_configuration_b0234bb98e807ab7, []int{2}
 }
 func (m *APIResource) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_APIResource.Unmarshal(m, b)
@@ -160,7 +160,7 @@ func (m *ACLs) Reset()         { *m = ACLs{} }
 func (m *ACLs) String() string { return proto.CompactTextString(m) }
 func (*ACLs) ProtoMessage()    {}
 func (*ACLs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configuration_d9ec63ae33c182ef, []int{3}
+	return fileDescriptor_configuration_b0234bb98e807ab7, []int{3}
 }
 func (m *ACLs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ACLs.Unmarshal(m, b)
@@ -187,37 +187,135 @@ func (m *ACLs) GetAcls() map[string]*APIResource {
 	return nil
 }
 
+// PeerEndpoints lists the endpoints of the peers of an organization, which are used
+// to bootstrap gossip with the organization when it has no anchor peers
+type PeerEndpoints struct {
+	Endpoints            []string `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerEndpoints) Reset()         { *m = PeerEndpoints{} }
+func (m *PeerEndpoints) String() string { return proto.CompactTextString(m) }
+func (*PeerEndpoints) ProtoMessage()    {}
+func (*PeerEndpoints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_configuration_b0234bb98e807ab7, []int{4}
+}
+func (m *PeerEndpoints) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerEndpoints.Unmarshal(m, b)
+}
+func (m *PeerEndpoints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerEndpoints.Marshal(b, m, deterministic)
+}
+func (dst *PeerEndpoints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerEndpoints.Merge(dst, src)
+}
+func (m *PeerEndpoints) XXX_Size() int {
+	return xxx_messageInfo_PeerEndpoints.Size(m)
+}
+func (m *PeerEndpoints) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerEndpoints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerEndpoints proto.InternalMessageInfo
+
+func (m *PeerEndpoints) GetEndpoints() []string {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
+}
+
+// InvocationQuota limits the number of proposals that each client identity may
+// have endorsed on the channel within an interval of blocks
+type InvocationQuota struct {
+	// The maximum number of proposals endorsed for a client identity per interval,
+	// or 0 for no limit
+	MaxInvocations uint32 `protobuf:"varint,1,opt,name=max_invocations,json=maxInvocations,proto3" json:"max_invocations,omitempty"`
+	// The number of blocks of an interval
+	BlockInterval        uint64   `protobuf:"varint,2,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InvocationQuota) Reset()         { *m = InvocationQuota{} }
+func (m *InvocationQuota) String() string { return proto.CompactTextString(m) }
+func (*InvocationQuota) ProtoMessage()    {}
+func (*InvocationQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_configuration_b0234bb98e807ab7, []int{5}
+}
+func (m *InvocationQuota) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvocationQuota.Unmarshal(m, b)
+}
+func (m *InvocationQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvocationQuota.Marshal(b, m, deterministic)
+}
+func (dst *InvocationQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvocationQuota.Merge(dst, src)
+}
+func (m *InvocationQuota) XXX_Size() int {
+	return xxx_messageInfo_InvocationQuota.Size(m)
+}
+func (m *InvocationQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvocationQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvocationQuota proto.InternalMessageInfo
+
+func (m *InvocationQuota) GetMaxInvocations() uint32 {
+	if m != nil {
+		return m.MaxInvocations
+	}
+	return 0
+}
+
+func (m *InvocationQuota) GetBlockInterval() uint64 {
+	if m != nil {
+		return m.BlockInterval
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*AnchorPeers)(nil), "protos.AnchorPeers")
 	proto.RegisterType((*AnchorPeer)(nil), "protos.AnchorPeer")
 	proto.RegisterType((*APIResource)(nil), "protos.APIResource")
 	proto.RegisterType((*ACLs)(nil), "protos.ACLs")
 	proto.RegisterMapType((map[string]*APIResource)(nil), "protos.ACLs.AclsEntry")
+	proto.RegisterType((*PeerEndpoints)(nil), "protos.PeerEndpoints")
+	proto.RegisterType((*InvocationQuota)(nil), "protos.InvocationQuota")
 }
 
 func init() {
-	proto.RegisterFile("peer/configuration.proto", fileDescriptor_configuration_d9ec63ae33c182ef)
-}
-
-var fileDescriptor_configuration_d9ec63ae33c182ef = []byte{
-	// 295 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xdf, 0x4b, 0xfb, 0x30,
-	0x14, 0xc5, 0xe9, 0x7e, 0x7c, 0x61, 0xb7, 0xdf, 0x07, 0x89, 0x20, 0x45, 0x10, 0x46, 0x9f, 0x36,
-	0x91, 0x14, 0xa6, 0x82, 0xf8, 0x56, 0xa7, 0x0f, 0xc2, 0xc0, 0x91, 0x47, 0x5f, 0x46, 0x16, 0x6f,
-	0x7f, 0x60, 0x6d, 0xca, 0x4d, 0x2a, 0xf4, 0xcd, 0x3f, 0x5d, 0x9a, 0x6c, 0xab, 0x4f, 0x39, 0x39,
-	0xf9, 0x9c, 0xcb, 0x21, 0x17, 0xa2, 0x06, 0x91, 0x12, 0xa5, 0xeb, 0xac, 0xcc, 0x5b, 0x92, 0xb6,
-	0xd4, 0x35, 0x6f, 0x48, 0x5b, 0xcd, 0xfe, 0xb9, 0xc3, 0xc4, 0xcf, 0x10, 0xa6, 0xb5, 0x2a, 0x34,
-	0x6d, 0x11, 0xc9, 0xb0, 0x7b, 0xf8, 0x2f, 0xdd, 0x75, 0xd7, 0x27, 0x4d, 0x14, 0xcc, 0xc7, 0x8b,
-	0x70, 0xc5, 0x7c, 0xc8, 0xf0, 0x01, 0x15, 0xa1, 0x1c, 0x62, 0xf1, 0x1d, 0xc0, 0xf0, 0xc4, 0x18,
-	0x4c, 0x0a, 0x6d, 0x6c, 0x14, 0xcc, 0x83, 0xc5, 0x4c, 0x38, 0xdd, 0x7b, 0x8d, 0x26, 0x1b, 0x8d,
-	0xe6, 0xc1, 0x62, 0x2a, 0x9c, 0x8e, 0x6f, 0x20, 0x4c, 0xb7, 0xaf, 0x02, 0x8d, 0x6e, 0x49, 0x21,
-	0xbb, 0x02, 0x68, 0x74, 0x55, 0xaa, 0x6e, 0x47, 0x98, 0x1d, 0xc2, 0x33, 0xef, 0x08, 0xcc, 0xe2,
-	0x9f, 0x00, 0x26, 0xe9, 0x7a, 0x63, 0xd8, 0x35, 0x4c, 0xa4, 0xaa, 0x8e, 0xdd, 0x2e, 0x4e, 0xdd,
-	0xd6, 0x1b, 0xc3, 0x53, 0x55, 0x99, 0x97, 0xda, 0x52, 0x27, 0x1c, 0x73, 0xb9, 0x81, 0xd9, 0xc9,
-	0x62, 0x67, 0x30, 0xfe, 0xc4, 0xee, 0x30, 0xb9, 0x97, 0x6c, 0x09, 0xd3, 0x6f, 0x59, 0xb5, 0xe8,
-	0x6a, 0x85, 0xab, 0xf3, 0xd3, 0xac, 0xa1, 0x96, 0xf0, 0xc4, 0xe3, 0xe8, 0x21, 0x78, 0x7a, 0x83,
-	0x58, 0x53, 0xce, 0x8b, 0xae, 0x41, 0xaa, 0xf0, 0x23, 0x47, 0xe2, 0x99, 0xdc, 0x53, 0xa9, 0x8e,
-	0xb9, 0xfe, 0xd3, 0xde, 0x97, 0x79, 0x69, 0x8b, 0x76, 0xcf, 0x95, 0xfe, 0x4a, 0xfe, 0xa0, 0x89,
-	0x47, 0x13, 0x8f, 0x26, 0x3d, 0xba, 0xf7, 0x5b, 0xb8, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xae,
-	0x0a, 0x1d, 0x41, 0xa8, 0x01, 0x00, 0x00,
+	proto.RegisterFile("peer/configuration.proto", fileDescriptor_configuration_b0234bb98e807ab7)
+}
+
+var fileDescriptor_configuration_b0234bb98e807ab7 = []byte{
+	// 370 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x92, 0x51, 0xeb, 0xd3, 0x30,
+	0x14, 0xc5, 0xe9, 0x7f, 0x9d, 0xd0, 0x5b, 0xb7, 0x49, 0x04, 0x29, 0xa2, 0x30, 0x0a, 0xe2, 0x26,
+	0xda, 0xc2, 0x54, 0x10, 0xdf, 0xea, 0xdc, 0xc3, 0x60, 0xe0, 0xcc, 0xa3, 0x2f, 0x25, 0xcd, 0xd2,
+	0x36, 0xac, 0x4b, 0x4a, 0x92, 0x8e, 0xf5, 0xcd, 0x8f, 0x2e, 0x4d, 0xb7, 0xd6, 0xa7, 0xde, 0x7b,
+	0xfa, 0x3b, 0x97, 0x73, 0x93, 0x40, 0x50, 0x33, 0xa6, 0x62, 0x2a, 0x45, 0xce, 0x8b, 0x46, 0x11,
+	0xc3, 0xa5, 0x88, 0x6a, 0x25, 0x8d, 0x44, 0xcf, 0xec, 0x47, 0x87, 0x3f, 0xc1, 0x4f, 0x04, 0x2d,
+	0xa5, 0x3a, 0x32, 0xa6, 0x34, 0xfa, 0x0a, 0xcf, 0x89, 0x6d, 0xd3, 0xce, 0xa9, 0x03, 0x67, 0x39,
+	0x59, 0xf9, 0x1b, 0xd4, 0x9b, 0x74, 0x34, 0xa2, 0xd8, 0x27, 0xa3, 0x2d, 0xfc, 0x02, 0x30, 0xfe,
+	0x42, 0x08, 0xdc, 0x52, 0x6a, 0x13, 0x38, 0x4b, 0x67, 0xe5, 0x61, 0x5b, 0x77, 0x5a, 0x2d, 0x95,
+	0x09, 0x9e, 0x96, 0xce, 0x6a, 0x8a, 0x6d, 0x1d, 0x7e, 0x04, 0x3f, 0x39, 0xee, 0x31, 0xd3, 0xb2,
+	0x51, 0x94, 0xa1, 0xb7, 0x00, 0xb5, 0xac, 0x38, 0x6d, 0x53, 0xc5, 0xf2, 0xbb, 0xd9, 0xeb, 0x15,
+	0xcc, 0xf2, 0xf0, 0xaf, 0x03, 0x6e, 0xb2, 0x3d, 0x68, 0xf4, 0x01, 0x5c, 0x42, 0xab, 0x47, 0xb6,
+	0x57, 0x43, 0xb6, 0xed, 0x41, 0x47, 0x09, 0xad, 0xf4, 0x4e, 0x18, 0xd5, 0x62, 0xcb, 0xbc, 0x3e,
+	0x80, 0x37, 0x48, 0xe8, 0x05, 0x4c, 0xce, 0xac, 0xbd, 0x4f, 0xee, 0x4a, 0xb4, 0x86, 0xe9, 0x95,
+	0x54, 0x0d, 0xb3, 0xb1, 0xfc, 0xcd, 0xcb, 0x61, 0xd6, 0x18, 0x0b, 0xf7, 0xc4, 0xf7, 0xa7, 0x6f,
+	0x4e, 0xf8, 0x09, 0x66, 0xdd, 0x82, 0x3b, 0x71, 0xaa, 0x25, 0x17, 0x46, 0xa3, 0x37, 0xe0, 0xb1,
+	0x47, 0x63, 0xf3, 0x78, 0x78, 0x14, 0x42, 0x02, 0x8b, 0xbd, 0xb8, 0x4a, 0x6a, 0xcf, 0xfd, 0x77,
+	0x23, 0x0d, 0x41, 0xef, 0x61, 0x71, 0x21, 0xb7, 0x94, 0x0f, 0xb2, 0xb6, 0x71, 0x66, 0x78, 0x7e,
+	0x21, 0xb7, 0x11, 0xd6, 0xe8, 0x1d, 0xcc, 0xb3, 0x4a, 0xd2, 0x73, 0xca, 0x85, 0x61, 0xea, 0x4a,
+	0x2a, 0x1b, 0xd1, 0xc5, 0x33, 0xab, 0xee, 0xef, 0xe2, 0x8f, 0x5f, 0x10, 0x4a, 0x55, 0x44, 0x65,
+	0x5b, 0x33, 0x55, 0xb1, 0x53, 0xc1, 0x54, 0x94, 0x93, 0x4c, 0x71, 0xfa, 0xd8, 0xa4, 0xbb, 0xc6,
+	0x3f, 0xeb, 0x82, 0x9b, 0xb2, 0xc9, 0x22, 0x2a, 0x2f, 0xf1, 0x7f, 0x68, 0xdc, 0xa3, 0x71, 0x8f,
+	0xc6, 0x1d, 0x9a, 0xf5, 0xef, 0xe2, 0xf3, 0xbf, 0x01, 0x00, 0x66, 0x19, 0xef, 0x0c, 0x3a, 0x02,
+	0x00, 0x00,
 }
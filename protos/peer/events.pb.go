@@ -6,8 +6,10 @@ package peer // import "github.com/hyperledger/fabric/protos/peer"
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import duration "github.com/golang/protobuf/ptypes/duration"
 import _ "github.com/golang/protobuf/ptypes/timestamp"
 import common "github.com/hyperledger/fabric/protos/common"
+import rwset "github.com/hyperledger/fabric/protos/ledger/rwset"
 
 import (
 	context "golang.org/x/net/context"
@@ -259,12 +261,14 @@ func (m *FilteredTransactionActions) GetChaincodeActions() []*FilteredChaincodeA
 }
 
 // FilteredChaincodeAction is a minimal set of information about an action
-// within a transaction
+// within a transaction. The payload of the chaincode event and the collection
+// hashes are only set for the DeliverFilteredWithPayloads service.
 type FilteredChaincodeAction struct {
-	ChaincodeEvent       *ChaincodeEvent `protobuf:"bytes,1,opt,name=chaincode_event,json=chaincodeEvent,proto3" json:"chaincode_event,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	ChaincodeEvent       *ChaincodeEvent           `protobuf:"bytes,1,opt,name=chaincode_event,json=chaincodeEvent,proto3" json:"chaincode_event,omitempty"`
+	CollectionHashes     []*FilteredCollectionHash `protobuf:"bytes,2,rep,name=collection_hashes,json=collectionHashes,proto3" json:"collection_hashes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *FilteredChaincodeAction) Reset()         { *m = FilteredChaincodeAction{} }
@@ -298,23 +302,139 @@ func (m *FilteredChaincodeAction) GetChaincodeEvent() *ChaincodeEvent {
 	return nil
 }
 
+func (m *FilteredChaincodeAction) GetCollectionHashes() []*FilteredCollectionHash {
+	if m != nil {
+		return m.CollectionHashes
+	}
+	return nil
+}
+
+// FilteredCollectionHash is the hash of the private data written by an
+// action to a collection of a chaincode
+type FilteredCollectionHash struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	CollectionName       string   `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PvtRwsetHash         []byte   `protobuf:"bytes,3,opt,name=pvt_rwset_hash,json=pvtRwsetHash,proto3" json:"pvt_rwset_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FilteredCollectionHash) Reset()         { *m = FilteredCollectionHash{} }
+func (m *FilteredCollectionHash) String() string { return proto.CompactTextString(m) }
+func (*FilteredCollectionHash) ProtoMessage()    {}
+func (*FilteredCollectionHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_events_8af932975aef5a3c, []int{4}
+}
+func (m *FilteredCollectionHash) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilteredCollectionHash.Unmarshal(m, b)
+}
+func (m *FilteredCollectionHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilteredCollectionHash.Marshal(b, m, deterministic)
+}
+func (dst *FilteredCollectionHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilteredCollectionHash.Merge(dst, src)
+}
+func (m *FilteredCollectionHash) XXX_Size() int {
+	return xxx_messageInfo_FilteredCollectionHash.Size(m)
+}
+func (m *FilteredCollectionHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilteredCollectionHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilteredCollectionHash proto.InternalMessageInfo
+
+func (m *FilteredCollectionHash) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *FilteredCollectionHash) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *FilteredCollectionHash) GetPvtRwsetHash() []byte {
+	if m != nil {
+		return m.PvtRwsetHash
+	}
+	return nil
+}
+
+// BlockAndPrivateData contains a block along with the private data of its
+// transactions which the client is entitled to read, keyed by the sequence of
+// the transactions in the block
+type BlockAndPrivateData struct {
+	Block                *common.Block                       `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	PrivateDataMap       map[uint64]*rwset.TxPvtReadWriteSet `protobuf:"bytes,2,rep,name=private_data_map,json=privateDataMap,proto3" json:"private_data_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *BlockAndPrivateData) Reset()         { *m = BlockAndPrivateData{} }
+func (m *BlockAndPrivateData) String() string { return proto.CompactTextString(m) }
+func (*BlockAndPrivateData) ProtoMessage()    {}
+func (*BlockAndPrivateData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_events_8af932975aef5a3c, []int{5}
+}
+func (m *BlockAndPrivateData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockAndPrivateData.Unmarshal(m, b)
+}
+func (m *BlockAndPrivateData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockAndPrivateData.Marshal(b, m, deterministic)
+}
+func (dst *BlockAndPrivateData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockAndPrivateData.Merge(dst, src)
+}
+func (m *BlockAndPrivateData) XXX_Size() int {
+	return xxx_messageInfo_BlockAndPrivateData.Size(m)
+}
+func (m *BlockAndPrivateData) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockAndPrivateData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockAndPrivateData proto.InternalMessageInfo
+
+func (m *BlockAndPrivateData) GetBlock() *common.Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *BlockAndPrivateData) GetPrivateDataMap() map[uint64]*rwset.TxPvtReadWriteSet {
+	if m != nil {
+		return m.PrivateDataMap
+	}
+	return nil
+}
+
 // DeliverResponse
 type DeliverResponse struct {
 	// Types that are valid to be assigned to Type:
 	//	*DeliverResponse_Status
 	//	*DeliverResponse_Block
 	//	*DeliverResponse_FilteredBlock
-	Type                 isDeliverResponse_Type `protobuf_oneof:"Type"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+	//	*DeliverResponse_BlockAndPrivateData
+	Type isDeliverResponse_Type `protobuf_oneof:"Type"`
+	// resume_token is set along with a block or a filtered block, and can be
+	// used in a SeekCheckpoint to resume the delivery after the block
+	ResumeToken          []byte   `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *DeliverResponse) Reset()         { *m = DeliverResponse{} }
 func (m *DeliverResponse) String() string { return proto.CompactTextString(m) }
 func (*DeliverResponse) ProtoMessage()    {}
 func (*DeliverResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_events_8af932975aef5a3c, []int{4}
+	return fileDescriptor_events_8af932975aef5a3c, []int{6}
 }
 func (m *DeliverResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeliverResponse.Unmarshal(m, b)
@@ -350,12 +470,18 @@ type DeliverResponse_FilteredBlock struct {
 	FilteredBlock *FilteredBlock `protobuf:"bytes,3,opt,name=filtered_block,json=filteredBlock,proto3,oneof"`
 }
 
+type DeliverResponse_BlockAndPrivateData struct {
+	BlockAndPrivateData *BlockAndPrivateData `protobuf:"bytes,5,opt,name=block_and_private_data,json=blockAndPrivateData,proto3,oneof"`
+}
+
 func (*DeliverResponse_Status) isDeliverResponse_Type() {}
 
 func (*DeliverResponse_Block) isDeliverResponse_Type() {}
 
 func (*DeliverResponse_FilteredBlock) isDeliverResponse_Type() {}
 
+func (*DeliverResponse_BlockAndPrivateData) isDeliverResponse_Type() {}
+
 func (m *DeliverResponse) GetType() isDeliverResponse_Type {
 	if m != nil {
 		return m.Type
@@ -384,12 +510,27 @@ func (m *DeliverResponse) GetFilteredBlock() *FilteredBlock {
 	return nil
 }
 
+func (m *DeliverResponse) GetBlockAndPrivateData() *BlockAndPrivateData {
+	if x, ok := m.GetType().(*DeliverResponse_BlockAndPrivateData); ok {
+		return x.BlockAndPrivateData
+	}
+	return nil
+}
+
+func (m *DeliverResponse) GetResumeToken() []byte {
+	if m != nil {
+		return m.ResumeToken
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*DeliverResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _DeliverResponse_OneofMarshaler, _DeliverResponse_OneofUnmarshaler, _DeliverResponse_OneofSizer, []interface{}{
 		(*DeliverResponse_Status)(nil),
 		(*DeliverResponse_Block)(nil),
 		(*DeliverResponse_FilteredBlock)(nil),
+		(*DeliverResponse_BlockAndPrivateData)(nil),
 	}
 }
 
@@ -410,6 +551,11 @@ func _DeliverResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.FilteredBlock); err != nil {
 			return err
 		}
+	case *DeliverResponse_BlockAndPrivateData:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.BlockAndPrivateData); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("DeliverResponse.Type has unexpected type %T", x)
@@ -443,6 +589,14 @@ func _DeliverResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *prot
 		err := b.DecodeMessage(msg)
 		m.Type = &DeliverResponse_FilteredBlock{msg}
 		return true, err
+	case 5: // Type.block_and_private_data
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(BlockAndPrivateData)
+		err := b.DecodeMessage(msg)
+		m.Type = &DeliverResponse_BlockAndPrivateData{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -465,6 +619,11 @@ func _DeliverResponse_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *DeliverResponse_BlockAndPrivateData:
+		s := proto.Size(x.BlockAndPrivateData)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -472,12 +631,125 @@ func _DeliverResponse_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+// TransactionStatusRequest is sent as the data of the payload of an envelope
+// to get the commit status of a transaction
+type TransactionStatusRequest struct {
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// the time to wait for the transaction to be committed if it is not
+	// committed yet, the status is returned right away if not set
+	WaitTimeout          *duration.Duration `protobuf:"bytes,2,opt,name=wait_timeout,json=waitTimeout,proto3" json:"wait_timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *TransactionStatusRequest) Reset()         { *m = TransactionStatusRequest{} }
+func (m *TransactionStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*TransactionStatusRequest) ProtoMessage()    {}
+func (*TransactionStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_events_8af932975aef5a3c, []int{7}
+}
+func (m *TransactionStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionStatusRequest.Unmarshal(m, b)
+}
+func (m *TransactionStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionStatusRequest.Marshal(b, m, deterministic)
+}
+func (dst *TransactionStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionStatusRequest.Merge(dst, src)
+}
+func (m *TransactionStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_TransactionStatusRequest.Size(m)
+}
+func (m *TransactionStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionStatusRequest proto.InternalMessageInfo
+
+func (m *TransactionStatusRequest) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *TransactionStatusRequest) GetWaitTimeout() *duration.Duration {
+	if m != nil {
+		return m.WaitTimeout
+	}
+	return nil
+}
+
+// TransactionStatusResponse is the commit status of a transaction. The status
+// is NOT_FOUND if the transaction is not committed, and SUCCESS along with
+// the validation code of the transaction and the number of the block it is
+// committed in otherwise.
+type TransactionStatusResponse struct {
+	Status               common.Status    `protobuf:"varint,1,opt,name=status,proto3,enum=common.Status" json:"status,omitempty"`
+	TxValidationCode     TxValidationCode `protobuf:"varint,2,opt,name=tx_validation_code,json=txValidationCode,proto3,enum=protos.TxValidationCode" json:"tx_validation_code,omitempty"`
+	BlockNumber          uint64           `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *TransactionStatusResponse) Reset()         { *m = TransactionStatusResponse{} }
+func (m *TransactionStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*TransactionStatusResponse) ProtoMessage()    {}
+func (*TransactionStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_events_8af932975aef5a3c, []int{8}
+}
+func (m *TransactionStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionStatusResponse.Unmarshal(m, b)
+}
+func (m *TransactionStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionStatusResponse.Marshal(b, m, deterministic)
+}
+func (dst *TransactionStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionStatusResponse.Merge(dst, src)
+}
+func (m *TransactionStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_TransactionStatusResponse.Size(m)
+}
+func (m *TransactionStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionStatusResponse proto.InternalMessageInfo
+
+func (m *TransactionStatusResponse) GetStatus() common.Status {
+	if m != nil {
+		return m.Status
+	}
+	return common.Status_UNKNOWN
+}
+
+func (m *TransactionStatusResponse) GetTxValidationCode() TxValidationCode {
+	if m != nil {
+		return m.TxValidationCode
+	}
+	return TxValidationCode_VALID
+}
+
+func (m *TransactionStatusResponse) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*FilteredBlock)(nil), "protos.FilteredBlock")
 	proto.RegisterType((*FilteredTransaction)(nil), "protos.FilteredTransaction")
 	proto.RegisterType((*FilteredTransactionActions)(nil), "protos.FilteredTransactionActions")
 	proto.RegisterType((*FilteredChaincodeAction)(nil), "protos.FilteredChaincodeAction")
+	proto.RegisterType((*FilteredCollectionHash)(nil), "protos.FilteredCollectionHash")
+	proto.RegisterType((*BlockAndPrivateData)(nil), "protos.BlockAndPrivateData")
+	proto.RegisterMapType((map[uint64]*rwset.TxPvtReadWriteSet)(nil), "protos.BlockAndPrivateData.PrivateDataMapEntry")
 	proto.RegisterType((*DeliverResponse)(nil), "protos.DeliverResponse")
+	proto.RegisterType((*TransactionStatusRequest)(nil), "protos.TransactionStatusRequest")
+	proto.RegisterType((*TransactionStatusResponse)(nil), "protos.TransactionStatusResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -500,6 +772,16 @@ type DeliverClient interface {
 	// Payload data as a marshaled orderer.SeekInfo message,
 	// then a stream of **filtered** block replies is received
 	DeliverFiltered(ctx context.Context, opts ...grpc.CallOption) (Deliver_DeliverFilteredClient, error)
+	// deliver first requires an Envelope of type ab.DELIVER_SEEK_INFO with
+	// Payload data as a marshaled orderer.SeekInfo message,
+	// then a stream of **filtered** block replies including the chaincode
+	// event payloads and the private data collection hashes is received
+	DeliverFilteredWithPayloads(ctx context.Context, opts ...grpc.CallOption) (Deliver_DeliverFilteredWithPayloadsClient, error)
+	// deliver first requires an Envelope of type ab.DELIVER_SEEK_INFO with
+	// Payload data as a marshaled orderer.SeekInfo message,
+	// then a stream of block replies along with the private data the
+	// client is entitled to read is received
+	DeliverWithPrivateData(ctx context.Context, opts ...grpc.CallOption) (Deliver_DeliverWithPrivateDataClient, error)
 }
 
 type deliverClient struct {
@@ -572,6 +854,68 @@ func (x *deliverDeliverFilteredClient) Recv() (*DeliverResponse, error) {
 	return m, nil
 }
 
+func (c *deliverClient) DeliverFilteredWithPayloads(ctx context.Context, opts ...grpc.CallOption) (Deliver_DeliverFilteredWithPayloadsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Deliver_serviceDesc.Streams[2], "/protos.Deliver/DeliverFilteredWithPayloads", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deliverDeliverFilteredWithPayloadsClient{stream}
+	return x, nil
+}
+
+type Deliver_DeliverFilteredWithPayloadsClient interface {
+	Send(*common.Envelope) error
+	Recv() (*DeliverResponse, error)
+	grpc.ClientStream
+}
+
+type deliverDeliverFilteredWithPayloadsClient struct {
+	grpc.ClientStream
+}
+
+func (x *deliverDeliverFilteredWithPayloadsClient) Send(m *common.Envelope) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *deliverDeliverFilteredWithPayloadsClient) Recv() (*DeliverResponse, error) {
+	m := new(DeliverResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *deliverClient) DeliverWithPrivateData(ctx context.Context, opts ...grpc.CallOption) (Deliver_DeliverWithPrivateDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Deliver_serviceDesc.Streams[3], "/protos.Deliver/DeliverWithPrivateData", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deliverDeliverWithPrivateDataClient{stream}
+	return x, nil
+}
+
+type Deliver_DeliverWithPrivateDataClient interface {
+	Send(*common.Envelope) error
+	Recv() (*DeliverResponse, error)
+	grpc.ClientStream
+}
+
+type deliverDeliverWithPrivateDataClient struct {
+	grpc.ClientStream
+}
+
+func (x *deliverDeliverWithPrivateDataClient) Send(m *common.Envelope) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *deliverDeliverWithPrivateDataClient) Recv() (*DeliverResponse, error) {
+	m := new(DeliverResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // DeliverServer is the server API for Deliver service.
 type DeliverServer interface {
 	// deliver first requires an Envelope of type ab.DELIVER_SEEK_INFO with
@@ -582,6 +926,16 @@ type DeliverServer interface {
 	// Payload data as a marshaled orderer.SeekInfo message,
 	// then a stream of **filtered** block replies is received
 	DeliverFiltered(Deliver_DeliverFilteredServer) error
+	// deliver first requires an Envelope of type ab.DELIVER_SEEK_INFO with
+	// Payload data as a marshaled orderer.SeekInfo message,
+	// then a stream of **filtered** block replies including the chaincode
+	// event payloads and the private data collection hashes is received
+	DeliverFilteredWithPayloads(Deliver_DeliverFilteredWithPayloadsServer) error
+	// deliver first requires an Envelope of type ab.DELIVER_SEEK_INFO with
+	// Payload data as a marshaled orderer.SeekInfo message,
+	// then a stream of block replies along with the private data the
+	// client is entitled to read is received
+	DeliverWithPrivateData(Deliver_DeliverWithPrivateDataServer) error
 }
 
 func RegisterDeliverServer(s *grpc.Server, srv DeliverServer) {
@@ -640,6 +994,58 @@ func (x *deliverDeliverFilteredServer) Recv() (*common.Envelope, error) {
 	return m, nil
 }
 
+func _Deliver_DeliverFilteredWithPayloads_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DeliverServer).DeliverFilteredWithPayloads(&deliverDeliverFilteredWithPayloadsServer{stream})
+}
+
+type Deliver_DeliverFilteredWithPayloadsServer interface {
+	Send(*DeliverResponse) error
+	Recv() (*common.Envelope, error)
+	grpc.ServerStream
+}
+
+type deliverDeliverFilteredWithPayloadsServer struct {
+	grpc.ServerStream
+}
+
+func (x *deliverDeliverFilteredWithPayloadsServer) Send(m *DeliverResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *deliverDeliverFilteredWithPayloadsServer) Recv() (*common.Envelope, error) {
+	m := new(common.Envelope)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Deliver_DeliverWithPrivateData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DeliverServer).DeliverWithPrivateData(&deliverDeliverWithPrivateDataServer{stream})
+}
+
+type Deliver_DeliverWithPrivateDataServer interface {
+	Send(*DeliverResponse) error
+	Recv() (*common.Envelope, error)
+	grpc.ServerStream
+}
+
+type deliverDeliverWithPrivateDataServer struct {
+	grpc.ServerStream
+}
+
+func (x *deliverDeliverWithPrivateDataServer) Send(m *DeliverResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *deliverDeliverWithPrivateDataServer) Recv() (*common.Envelope, error) {
+	m := new(common.Envelope)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _Deliver_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "protos.Deliver",
 	HandlerType: (*DeliverServer)(nil),
@@ -657,47 +1063,155 @@ var _Deliver_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "DeliverFilteredWithPayloads",
+			Handler:       _Deliver_DeliverFilteredWithPayloads_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DeliverWithPrivateData",
+			Handler:       _Deliver_DeliverWithPrivateData_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "peer/events.proto",
+}
+
+// TransactionStatusClient is the client API for TransactionStatus service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TransactionStatusClient interface {
+	// GetTransactionStatus requires an Envelope whose payload data is a
+	// marshaled TransactionStatusRequest, and returns the commit status of
+	// the transaction on the channel of the envelope
+	GetTransactionStatus(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*TransactionStatusResponse, error)
+}
+
+type transactionStatusClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewTransactionStatusClient(cc *grpc.ClientConn) TransactionStatusClient {
+	return &transactionStatusClient{cc}
+}
+
+func (c *transactionStatusClient) GetTransactionStatus(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*TransactionStatusResponse, error) {
+	out := new(TransactionStatusResponse)
+	err := c.cc.Invoke(ctx, "/protos.TransactionStatus/GetTransactionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TransactionStatusServer is the server API for TransactionStatus service.
+type TransactionStatusServer interface {
+	// GetTransactionStatus requires an Envelope whose payload data is a
+	// marshaled TransactionStatusRequest, and returns the commit status of
+	// the transaction on the channel of the envelope
+	GetTransactionStatus(context.Context, *common.Envelope) (*TransactionStatusResponse, error)
+}
+
+func RegisterTransactionStatusServer(s *grpc.Server, srv TransactionStatusServer) {
+	s.RegisterService(&_TransactionStatus_serviceDesc, srv)
+}
+
+func _TransactionStatus_GetTransactionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.Envelope)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionStatusServer).GetTransactionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.TransactionStatus/GetTransactionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionStatusServer).GetTransactionStatus(ctx, req.(*common.Envelope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _TransactionStatus_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "protos.TransactionStatus",
+	HandlerType: (*TransactionStatusServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTransactionStatus",
+			Handler:    _TransactionStatus_GetTransactionStatus_Handler,
+		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "peer/events.proto",
 }
 
 func init() { proto.RegisterFile("peer/events.proto", fileDescriptor_events_8af932975aef5a3c) }
 
 var fileDescriptor_events_8af932975aef5a3c = []byte{
-	// 560 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x69, 0x08, 0xea, 0x44, 0x49, 0xdb, 0x2d, 0x4d, 0xa3, 0x20, 0xd4, 0xc8, 0x12, 0xc8,
-	0x5c, 0x62, 0x64, 0x6e, 0x1c, 0x40, 0xa4, 0x3f, 0x0a, 0x12, 0x87, 0x6a, 0x09, 0x1c, 0x7a, 0xc0,
-	0x5a, 0xdb, 0x13, 0xc7, 0xd4, 0xf1, 0x5a, 0xbb, 0x9b, 0x28, 0x79, 0x04, 0xde, 0x80, 0x67, 0xe0,
-	0x09, 0x39, 0x22, 0xaf, 0xbd, 0x49, 0x9a, 0x52, 0x24, 0x4e, 0xf6, 0xce, 0x7c, 0x3f, 0x33, 0xb3,
-	0x63, 0xc3, 0x51, 0x8e, 0x28, 0x5c, 0x5c, 0x60, 0xa6, 0xe4, 0x20, 0x17, 0x5c, 0x71, 0xd2, 0xd0,
-	0x0f, 0xd9, 0x3b, 0x0e, 0xf9, 0x6c, 0xc6, 0x33, 0xb7, 0x7c, 0x94, 0xc9, 0xde, 0x59, 0xcc, 0x79,
-	0x9c, 0xa2, 0xab, 0x4f, 0xc1, 0x7c, 0xe2, 0xaa, 0x64, 0x86, 0x52, 0xb1, 0x59, 0x5e, 0x01, 0x7a,
-	0x5a, 0x30, 0x9c, 0xb2, 0x24, 0x0b, 0x79, 0x84, 0xbe, 0x96, 0xae, 0x72, 0x1d, 0x9d, 0x53, 0x82,
-	0x65, 0x92, 0x85, 0x2a, 0x31, 0xa2, 0xf6, 0x4f, 0x0b, 0x5a, 0x57, 0x49, 0xaa, 0x50, 0x60, 0x34,
-	0x4c, 0x79, 0x78, 0x4b, 0x9e, 0x03, 0x84, 0x53, 0x96, 0x65, 0x98, 0xfa, 0x49, 0xd4, 0xb5, 0xfa,
-	0x96, 0xb3, 0x4f, 0xf7, 0xab, 0xc8, 0xc7, 0x88, 0x74, 0xa0, 0x91, 0xcd, 0x67, 0x01, 0x8a, 0xee,
-	0xa3, 0xbe, 0xe5, 0xd4, 0x69, 0x75, 0x22, 0xd7, 0x70, 0x32, 0xa9, 0x74, 0xfc, 0x2d, 0x1b, 0xd9,
-	0xad, 0xf7, 0xf7, 0x9c, 0xa6, 0xf7, 0xac, 0xf4, 0x93, 0x03, 0x63, 0x36, 0xde, 0x60, 0xe8, 0xd3,
-	0xc9, 0xfd, 0xa0, 0xb4, 0x7f, 0x5b, 0x70, 0xfc, 0x17, 0x34, 0x21, 0x50, 0x57, 0xcb, 0x75, 0x69,
-	0xfa, 0x9d, 0xbc, 0x84, 0xba, 0x5a, 0xe5, 0xa8, 0x6b, 0x6a, 0x7b, 0x64, 0x50, 0x0d, 0x6e, 0x84,
-	0x2c, 0x42, 0x31, 0x5e, 0xe5, 0x48, 0x75, 0x9e, 0x5c, 0x01, 0x51, 0x4b, 0x7f, 0xc1, 0xd2, 0x24,
-	0x62, 0x85, 0x98, 0x5f, 0x0c, 0xaa, 0xbb, 0xa7, 0x59, 0x5d, 0x53, 0xe2, 0x78, 0xf9, 0x75, 0x0d,
-	0x38, 0xe7, 0x11, 0xd2, 0x43, 0xb5, 0x13, 0x21, 0x5f, 0xe0, 0x78, 0xab, 0x49, 0x7f, 0xd3, 0xab,
-	0xe5, 0x34, 0x3d, 0xfb, 0x1f, 0xbd, 0x7e, 0x28, 0x91, 0xa3, 0x1a, 0x25, 0xea, 0x5e, 0x74, 0xd8,
-	0x80, 0xfa, 0x05, 0x53, 0xcc, 0xfe, 0x0e, 0xbd, 0x87, 0xb9, 0xe4, 0x13, 0x1c, 0x6d, 0x2e, 0xd9,
-	0x58, 0x5b, 0x7a, 0xcc, 0x67, 0xbb, 0xd6, 0xe7, 0x06, 0x58, 0x92, 0xe9, 0x61, 0x78, 0x37, 0x20,
-	0xed, 0x1b, 0x38, 0x7d, 0x00, 0x4c, 0xde, 0xc3, 0xc1, 0xce, 0x36, 0xe9, 0xa1, 0x37, 0xbd, 0x8e,
-	0xb1, 0x59, 0x33, 0x2e, 0x8b, 0x2c, 0x6d, 0x87, 0x77, 0xce, 0xf6, 0x2f, 0x0b, 0x0e, 0x2e, 0x30,
-	0x4d, 0x16, 0x28, 0x28, 0xca, 0x9c, 0x67, 0x12, 0x89, 0x03, 0x0d, 0xa9, 0x98, 0x9a, 0x4b, 0xad,
-	0xd5, 0xf6, 0xda, 0xe6, 0xb2, 0x3e, 0xeb, 0xe8, 0xa8, 0x46, 0xab, 0x3c, 0x79, 0x01, 0x8f, 0x83,
-	0x62, 0x25, 0xf5, 0xad, 0x36, 0xbd, 0x96, 0x01, 0xea, 0x3d, 0x1d, 0xd5, 0x68, 0x99, 0x25, 0xef,
-	0xa0, 0xbd, 0xde, 0xbc, 0x12, 0xbf, 0xa7, 0xf1, 0x27, 0xbb, 0xb3, 0x30, 0xbc, 0xd6, 0x64, 0x3b,
-	0x50, 0x0c, 0xbd, 0xd8, 0x10, 0xef, 0x87, 0x05, 0x4f, 0xaa, 0x62, 0xc9, 0xdb, 0xcd, 0xeb, 0xa1,
-	0xb1, 0xbd, 0xcc, 0x16, 0x98, 0xf2, 0x1c, 0x7b, 0xa7, 0x46, 0x78, 0xa7, 0x35, 0xbb, 0xe6, 0x58,
-	0xaf, 0x2d, 0x32, 0x5c, 0xf7, 0x6c, 0x8c, 0xff, 0x5b, 0x63, 0xf8, 0x0d, 0x6c, 0x2e, 0xe2, 0xc1,
-	0x74, 0x95, 0xa3, 0x48, 0x31, 0x8a, 0x51, 0x0c, 0x26, 0x2c, 0x10, 0x49, 0x68, 0x68, 0xc5, 0xe7,
-	0x3c, 0x6c, 0xe9, 0x29, 0xcb, 0x6b, 0x16, 0xde, 0xb2, 0x18, 0x6f, 0x5e, 0xc5, 0x89, 0x9a, 0xce,
-	0x83, 0xc2, 0xcb, 0xdd, 0x62, 0xba, 0x25, 0xb3, 0xfc, 0x6f, 0x48, 0xb7, 0x60, 0x06, 0xe5, 0x8f,
-	0xe6, 0xcd, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x19, 0xd1, 0xa9, 0x84, 0x04, 0x00, 0x00,
+	// 969 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x8e, 0xd3, 0xb4, 0xa8, 0x27, 0x69, 0x9a, 0x4e, 0x76, 0xb3, 0xde, 0x14, 0x76, 0x5b, 0x03,
+	0x4b, 0xb8, 0x71, 0x50, 0xb8, 0x41, 0x2b, 0x04, 0xda, 0x6e, 0xbb, 0xa4, 0x82, 0xad, 0xa2, 0xd9,
+	0xc0, 0x0a, 0x90, 0xb0, 0x26, 0xf6, 0x49, 0x62, 0xea, 0x3f, 0x3c, 0x93, 0x6c, 0xf2, 0x00, 0xbc,
+	0x03, 0xb7, 0x48, 0x48, 0xdc, 0xf1, 0x70, 0x5c, 0x71, 0x89, 0x3c, 0x63, 0x3b, 0x6e, 0x92, 0x56,
+	0xea, 0x4d, 0x62, 0x7f, 0xe7, 0x3b, 0x3f, 0xfe, 0xce, 0x99, 0x63, 0xc3, 0x51, 0x84, 0x18, 0x77,
+	0x71, 0x8e, 0x81, 0xe0, 0x66, 0x14, 0x87, 0x22, 0x24, 0x7b, 0xf2, 0x8f, 0xb7, 0x9b, 0x76, 0xe8,
+	0xfb, 0x61, 0xd0, 0x55, 0x7f, 0xca, 0xd8, 0x7e, 0x32, 0x09, 0xc3, 0x89, 0x87, 0x5d, 0x79, 0x37,
+	0x9a, 0x8d, 0xbb, 0xce, 0x2c, 0x66, 0xc2, 0xcd, 0xed, 0x4f, 0xd7, 0xed, 0xc2, 0xf5, 0x91, 0x0b,
+	0xe6, 0x47, 0x29, 0x41, 0xf7, 0xd0, 0x99, 0x60, 0xdc, 0x8d, 0xdf, 0x71, 0x14, 0xea, 0x37, 0xb5,
+	0xb4, 0x65, 0x29, 0xf6, 0x94, 0xb9, 0x81, 0x1d, 0x3a, 0x68, 0xc9, 0xa2, 0x52, 0x5b, 0x4b, 0xda,
+	0x44, 0xcc, 0x02, 0xce, 0xec, 0x55, 0x3a, 0xe3, 0x0f, 0x0d, 0x0e, 0x5e, 0xb9, 0x9e, 0xc0, 0x18,
+	0x9d, 0x33, 0x2f, 0xb4, 0xaf, 0xc9, 0x07, 0x00, 0xf6, 0x94, 0x05, 0x01, 0x7a, 0x96, 0xeb, 0xe8,
+	0xda, 0x89, 0xd6, 0xd9, 0xa7, 0xfb, 0x29, 0x72, 0xe9, 0x90, 0x16, 0xec, 0x05, 0x33, 0x7f, 0x84,
+	0xb1, 0x5e, 0x3e, 0xd1, 0x3a, 0x15, 0x9a, 0xde, 0x91, 0x01, 0x3c, 0x1c, 0xa7, 0x71, 0xac, 0x42,
+	0x1a, 0xae, 0x57, 0x4e, 0x76, 0x3a, 0xd5, 0xde, 0xb1, 0xca, 0xc7, 0xcd, 0x2c, 0xd9, 0x70, 0xc5,
+	0xa1, 0x0f, 0xc6, 0x9b, 0x20, 0x37, 0xfe, 0xd3, 0xa0, 0xb9, 0x85, 0x4d, 0x08, 0x54, 0xc4, 0x22,
+	0x2f, 0x4d, 0x5e, 0x93, 0x67, 0x50, 0x11, 0xcb, 0x08, 0x65, 0x4d, 0xf5, 0x1e, 0x31, 0x53, 0xc9,
+	0xfb, 0xc8, 0x1c, 0x8c, 0x87, 0xcb, 0x08, 0xa9, 0xb4, 0x93, 0x57, 0x40, 0xc4, 0xc2, 0x9a, 0x33,
+	0xcf, 0x75, 0xa4, 0xe8, 0x56, 0x22, 0x94, 0xbe, 0x23, 0xbd, 0xf4, 0xac, 0xc4, 0xe1, 0xe2, 0x87,
+	0x9c, 0xf0, 0x32, 0x74, 0x90, 0x36, 0xc4, 0x1a, 0x42, 0xbe, 0x87, 0x66, 0xe1, 0x21, 0xad, 0xd5,
+	0xb3, 0x6a, 0x9d, 0x6a, 0xcf, 0xb8, 0xe3, 0x59, 0x5f, 0x28, 0x66, 0xbf, 0x44, 0x89, 0xd8, 0x40,
+	0xcf, 0xf6, 0xa0, 0x72, 0xce, 0x04, 0x33, 0x7e, 0x85, 0xf6, 0xed, 0xbe, 0xe4, 0x3b, 0x38, 0x5a,
+	0x35, 0x39, 0x4b, 0xad, 0x49, 0x99, 0x9f, 0xae, 0xa7, 0x7e, 0x99, 0x11, 0x95, 0x33, 0x6d, 0xd8,
+	0x37, 0x01, 0x6e, 0xfc, 0xad, 0xc1, 0xa3, 0x5b, 0xd8, 0xe4, 0x6b, 0x38, 0x5c, 0x1b, 0x27, 0xa9,
+	0x7a, 0xb5, 0xd7, 0xca, 0xf2, 0xe4, 0x1e, 0x17, 0x89, 0x95, 0xd6, 0xed, 0x1b, 0xf7, 0xe4, 0x5b,
+	0x38, 0xb2, 0x43, 0xcf, 0x43, 0x25, 0xd3, 0x94, 0xf1, 0x29, 0x72, 0xbd, 0x2c, 0x4b, 0x7d, 0xb2,
+	0x51, 0x6a, 0x4e, 0xec, 0x33, 0x3e, 0xa5, 0x0d, 0xfb, 0xc6, 0x3d, 0x72, 0xe3, 0x77, 0x0d, 0x5a,
+	0xdb, 0xc9, 0xe4, 0x7d, 0xd8, 0x0f, 0x98, 0x8f, 0x3c, 0x62, 0x36, 0x66, 0x33, 0x9b, 0x03, 0xe4,
+	0x13, 0x38, 0x2c, 0x54, 0x91, 0xe0, 0x72, 0x50, 0xf6, 0x69, 0x7d, 0x05, 0x5f, 0x31, 0x1f, 0xc9,
+	0x47, 0x50, 0x8f, 0xe6, 0xc2, 0x92, 0x87, 0x4a, 0x56, 0x2b, 0x47, 0xa3, 0x46, 0x6b, 0xd1, 0x5c,
+	0xd0, 0x04, 0x4c, 0x92, 0x19, 0xff, 0x6a, 0xd0, 0x94, 0x67, 0xe5, 0x45, 0xe0, 0x0c, 0x62, 0x77,
+	0xce, 0x04, 0x26, 0x5d, 0x23, 0x1f, 0xc2, 0xee, 0x28, 0x81, 0x53, 0x8d, 0x0e, 0xb2, 0x29, 0x94,
+	0x5c, 0xaa, 0x6c, 0xe4, 0x47, 0x68, 0x44, 0xca, 0xc7, 0x72, 0x98, 0x60, 0x96, 0xcf, 0xa2, 0x54,
+	0x90, 0x6e, 0x26, 0xc8, 0x96, 0xd8, 0x66, 0xe1, 0xfa, 0x35, 0x8b, 0x2e, 0x02, 0x11, 0x2f, 0x69,
+	0x3d, 0xba, 0x01, 0xb6, 0x7f, 0x86, 0xe6, 0x16, 0x1a, 0x69, 0xc0, 0xce, 0x35, 0x2e, 0x65, 0x51,
+	0x15, 0x9a, 0x5c, 0x12, 0x13, 0x76, 0xe7, 0xcc, 0x9b, 0x29, 0x15, 0xaa, 0x3d, 0xdd, 0x54, 0x5b,
+	0x64, 0xb8, 0x18, 0xcc, 0x05, 0x45, 0xe6, 0xbc, 0x8d, 0x5d, 0x81, 0x6f, 0x50, 0x50, 0x45, 0x7b,
+	0x5e, 0xfe, 0x42, 0x33, 0xfe, 0x2c, 0xc3, 0xe1, 0x39, 0x7a, 0xee, 0x1c, 0x63, 0x8a, 0x3c, 0x0a,
+	0x03, 0x8e, 0xa4, 0x03, 0x7b, 0x5c, 0x30, 0x31, 0xe3, 0x32, 0x78, 0xbd, 0x57, 0xcf, 0x9e, 0xf8,
+	0x8d, 0x44, 0xfb, 0x25, 0x9a, 0xda, 0xc9, 0xc7, 0x99, 0x34, 0xe5, 0x2d, 0xd2, 0xf4, 0x4b, 0x99,
+	0x38, 0x5f, 0x41, 0x3d, 0x5f, 0x22, 0x8a, 0xbf, 0x23, 0xf9, 0x0f, 0xd7, 0x67, 0x25, 0xf3, 0x3b,
+	0x18, 0x17, 0x01, 0x42, 0xa1, 0x25, 0xdd, 0x2c, 0x16, 0x38, 0x56, 0x51, 0x66, 0x7d, 0x57, 0xc6,
+	0x39, 0xbe, 0x43, 0xe2, 0x7e, 0x89, 0x36, 0x47, 0x5b, 0xba, 0x7a, 0x0a, 0xb5, 0x18, 0xf9, 0xcc,
+	0x47, 0x4b, 0x84, 0xd7, 0x18, 0xc8, 0x33, 0x5e, 0xa3, 0x55, 0x85, 0x0d, 0x13, 0x28, 0x39, 0xb6,
+	0xc9, 0x8e, 0x31, 0x7c, 0xd0, 0x0b, 0xc7, 0x55, 0x89, 0x40, 0xf1, 0xb7, 0x19, 0x72, 0x41, 0x9a,
+	0xb0, 0x2b, 0x16, 0x56, 0x71, 0x6d, 0x5d, 0x3a, 0xe4, 0x4b, 0xa8, 0xbd, 0x63, 0xae, 0xb0, 0x92,
+	0x1d, 0x1f, 0xce, 0x44, 0xaa, 0xce, 0x63, 0x53, 0xbd, 0x03, 0xcc, 0xec, 0x1d, 0x60, 0x9e, 0xa7,
+	0xef, 0x08, 0x5a, 0x4d, 0xe8, 0x43, 0xc5, 0x36, 0xfe, 0xd1, 0xe0, 0xf1, 0x96, 0x7c, 0x69, 0x73,
+	0x9e, 0xdd, 0xdd, 0x9c, 0xbc, 0x35, 0xdb, 0x57, 0x62, 0xf9, 0xde, 0x2b, 0xf1, 0x14, 0x6a, 0x4a,
+	0xfb, 0xf4, 0xf5, 0xb0, 0x23, 0xe7, 0xad, 0x2a, 0xb1, 0x2b, 0x09, 0xf5, 0xfe, 0x2a, 0xc3, 0x7b,
+	0xe9, 0x0c, 0x91, 0xe7, 0xab, 0xcb, 0x46, 0x56, 0xd9, 0x45, 0x30, 0x47, 0x2f, 0x8c, 0xb0, 0xfd,
+	0x28, 0xcb, 0xbb, 0x36, 0x71, 0x46, 0xa9, 0xa3, 0x7d, 0xa6, 0x91, 0xb3, 0x7c, 0x14, 0xb3, 0x79,
+	0xb8, 0x7f, 0x8c, 0x2b, 0x38, 0x5e, 0x8b, 0xf1, 0xd6, 0x15, 0xd3, 0x01, 0x5b, 0x7a, 0x21, 0x73,
+	0xf8, 0xfd, 0xe3, 0x5d, 0x42, 0x2b, 0x35, 0xc8, 0x38, 0x85, 0x01, 0xba, 0x6f, 0xa8, 0xde, 0x08,
+	0x8e, 0x36, 0xda, 0x4a, 0x5e, 0xc3, 0x83, 0x6f, 0x50, 0x6c, 0xe2, 0x9b, 0xd1, 0x4f, 0xf3, 0xa6,
+	0xdd, 0x36, 0x1b, 0x46, 0xe9, 0xec, 0x17, 0x30, 0xc2, 0x78, 0x62, 0x4e, 0x97, 0x11, 0xc6, 0xea,
+	0x83, 0xc2, 0x1c, 0xb3, 0x51, 0xec, 0xda, 0x99, 0x73, 0x84, 0x18, 0x9f, 0x1d, 0xc8, 0x2d, 0xce,
+	0x07, 0xcc, 0xbe, 0x66, 0x13, 0xfc, 0xe9, 0xd3, 0x89, 0x2b, 0xa6, 0xb3, 0x51, 0x92, 0xb1, 0x5b,
+	0xf0, 0xec, 0x2a, 0x4f, 0xf5, 0xc9, 0xc2, 0xbb, 0x89, 0xe7, 0x48, 0x7d, 0x03, 0x7d, 0xfe, 0xff,
+	0x00, 0xaa, 0xaf, 0xbb, 0xcc, 0x1f, 0x09, 0x00, 0x00,
 }
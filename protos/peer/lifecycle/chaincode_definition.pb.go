@@ -25,6 +25,7 @@ type ChaincodeEndorsementInfo struct {
 	Id                   []byte   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	InitRequired         bool     `protobuf:"varint,3,opt,name=init_required,json=initRequired,proto3" json:"init_required,omitempty"`
 	EndorsementPlugin    string   `protobuf:"bytes,4,opt,name=endorsement_plugin,json=endorsementPlugin,proto3" json:"endorsement_plugin,omitempty"`
+	ReadYourWrites       bool     `protobuf:"varint,5,opt,name=read_your_writes,json=readYourWrites,proto3" json:"read_your_writes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -34,7 +35,7 @@ func (m *ChaincodeEndorsementInfo) Reset()         { *m = ChaincodeEndorsementIn
 func (m *ChaincodeEndorsementInfo) String() string { return proto.CompactTextString(m) }
 func (*ChaincodeEndorsementInfo) ProtoMessage()    {}
 func (*ChaincodeEndorsementInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_definition_b42f1671bd9c192b, []int{0}
+	return fileDescriptor_chaincode_definition_68611aa74e51e3cd, []int{0}
 }
 func (m *ChaincodeEndorsementInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChaincodeEndorsementInfo.Unmarshal(m, b)
@@ -82,6 +83,13 @@ func (m *ChaincodeEndorsementInfo) GetEndorsementPlugin() string {
 	return ""
 }
 
+func (m *ChaincodeEndorsementInfo) GetReadYourWrites() bool {
+	if m != nil {
+		return m.ReadYourWrites
+	}
+	return false
+}
+
 // ValidationInfo is (most) everything the peer needs to know in order
 // to validate a transaction
 type ChaincodeValidationInfo struct {
@@ -96,7 +104,7 @@ func (m *ChaincodeValidationInfo) Reset()         { *m = ChaincodeValidationInfo
 func (m *ChaincodeValidationInfo) String() string { return proto.CompactTextString(m) }
 func (*ChaincodeValidationInfo) ProtoMessage()    {}
 func (*ChaincodeValidationInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_definition_b42f1671bd9c192b, []int{1}
+	return fileDescriptor_chaincode_definition_68611aa74e51e3cd, []int{1}
 }
 func (m *ChaincodeValidationInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChaincodeValidationInfo.Unmarshal(m, b)
@@ -136,27 +144,28 @@ func init() {
 }
 
 func init() {
-	proto.RegisterFile("peer/lifecycle/chaincode_definition.proto", fileDescriptor_chaincode_definition_b42f1671bd9c192b)
-}
-
-var fileDescriptor_chaincode_definition_b42f1671bd9c192b = []byte{
-	// 281 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x51, 0x4f, 0x4f, 0x83, 0x30,
-	0x14, 0x0f, 0xd3, 0xa8, 0x6b, 0xa6, 0x71, 0xd5, 0xc4, 0x1e, 0x97, 0x79, 0x99, 0x51, 0xdb, 0x98,
-	0x7d, 0x03, 0x8d, 0x07, 0x6f, 0x86, 0x83, 0x07, 0x2f, 0x4b, 0x69, 0x1f, 0xf0, 0x12, 0x68, 0xf1,
-	0x01, 0x4b, 0xf8, 0x26, 0x7e, 0x5c, 0x03, 0x0c, 0x86, 0x47, 0x7e, 0xff, 0xde, 0x8f, 0x5f, 0xd9,
-	0x43, 0x01, 0x40, 0x2a, 0xc3, 0x18, 0x4c, 0x63, 0x32, 0x50, 0x26, 0xd5, 0xe8, 0x8c, 0xb7, 0xb0,
-	0xb3, 0x10, 0xa3, 0xc3, 0x0a, 0xbd, 0x93, 0x05, 0xf9, 0xca, 0xf3, 0xf9, 0xa8, 0x5a, 0xff, 0x06,
-	0x4c, 0xbc, 0x0d, 0xca, 0x77, 0x67, 0x3d, 0x95, 0x90, 0x83, 0xab, 0x3e, 0x5c, 0xec, 0xb9, 0x60,
-	0xe7, 0x7b, 0xa0, 0x12, 0xbd, 0x13, 0xc1, 0x2a, 0xd8, 0xcc, 0xc3, 0xe1, 0x93, 0x5f, 0xb1, 0x19,
-	0x5a, 0x31, 0x5b, 0x05, 0x9b, 0x45, 0x38, 0x43, 0xcb, 0xef, 0xd9, 0x65, 0x7b, 0x62, 0x47, 0xf0,
-	0x53, 0x23, 0x81, 0x15, 0x27, 0xab, 0x60, 0x73, 0x11, 0x2e, 0x5a, 0x30, 0x3c, 0x60, 0xfc, 0x99,
-	0x71, 0x38, 0x5e, 0xd8, 0x15, 0x59, 0x9d, 0xa0, 0x13, 0xa7, 0x5d, 0xf2, 0x72, 0xc2, 0x7c, 0x76,
-	0xc4, 0xba, 0x61, 0x77, 0x63, 0xb3, 0x2f, 0x9d, 0xa1, 0xd5, 0xed, 0x2f, 0x74, 0xc5, 0x1e, 0xd9,
-	0x72, 0x3f, 0x22, 0x43, 0x50, 0x5f, 0xf1, 0xfa, 0x48, 0xf4, 0x39, 0xfc, 0x85, 0xdd, 0x4e, 0xc5,
-	0x9a, 0x74, 0x0e, 0x15, 0xd0, 0xa1, 0xfd, 0xcd, 0x44, 0x3f, 0x50, 0xaf, 0x86, 0x3d, 0x79, 0x4a,
-	0x64, 0xda, 0x14, 0x40, 0x19, 0xd8, 0x04, 0x48, 0xc6, 0x3a, 0x22, 0x34, 0xfd, 0x80, 0xa5, 0x6c,
-	0xb7, 0x96, 0xe3, 0x8a, 0xdf, 0xdb, 0x04, 0xab, 0xb4, 0x8e, 0xa4, 0xf1, 0xb9, 0x9a, 0x98, 0x54,
-	0x6f, 0x52, 0xbd, 0x49, 0xfd, 0x7f, 0xa0, 0xe8, 0xac, 0x83, 0xb7, 0x7f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x8e, 0x74, 0xa5, 0xe1, 0xb9, 0x01, 0x00, 0x00,
+	proto.RegisterFile("peer/lifecycle/chaincode_definition.proto", fileDescriptor_chaincode_definition_68611aa74e51e3cd)
+}
+
+var fileDescriptor_chaincode_definition_68611aa74e51e3cd = []byte{
+	// 304 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xcd, 0x4e, 0xc3, 0x30,
+	0x10, 0x84, 0x95, 0xf2, 0x5b, 0xab, 0x54, 0xad, 0x41, 0xc2, 0xc7, 0xaa, 0x5c, 0x82, 0x80, 0x44,
+	0xa8, 0x6f, 0x00, 0xe2, 0xc0, 0x0d, 0xe5, 0x00, 0x82, 0x4b, 0xe4, 0xda, 0x9b, 0x74, 0xa5, 0xd4,
+	0x0e, 0x9b, 0xa4, 0x28, 0xaf, 0xc7, 0x93, 0xa1, 0x38, 0x4d, 0x1a, 0x8e, 0xfe, 0x66, 0xd6, 0xa3,
+	0xd9, 0x65, 0xb7, 0x39, 0x00, 0x85, 0x19, 0x26, 0xa0, 0x6a, 0x95, 0x41, 0xa8, 0x36, 0x12, 0x8d,
+	0xb2, 0x1a, 0x62, 0x0d, 0x09, 0x1a, 0x2c, 0xd1, 0x9a, 0x20, 0x27, 0x5b, 0x5a, 0x3e, 0xee, 0x5d,
+	0xcb, 0x5f, 0x8f, 0x89, 0xe7, 0xce, 0xf9, 0x62, 0xb4, 0xa5, 0x02, 0xb6, 0x60, 0xca, 0x57, 0x93,
+	0x58, 0x2e, 0xd8, 0xd9, 0x0e, 0xa8, 0x40, 0x6b, 0x84, 0xb7, 0xf0, 0xfc, 0x71, 0xd4, 0x3d, 0xf9,
+	0x94, 0x8d, 0x50, 0x8b, 0xd1, 0xc2, 0xf3, 0x27, 0xd1, 0x08, 0x35, 0xbf, 0x61, 0x17, 0x4d, 0x44,
+	0x4c, 0xf0, 0x5d, 0x21, 0x81, 0x16, 0x47, 0x0b, 0xcf, 0x3f, 0x8f, 0x26, 0x0d, 0x8c, 0xf6, 0x8c,
+	0x3f, 0x30, 0x0e, 0x87, 0x84, 0x38, 0xcf, 0xaa, 0x14, 0x8d, 0x38, 0x76, 0x3f, 0xcf, 0x07, 0xca,
+	0x9b, 0x13, 0xb8, 0xcf, 0x66, 0x04, 0x52, 0xc7, 0xb5, 0xad, 0x28, 0xfe, 0x21, 0x2c, 0xa1, 0x10,
+	0x27, 0xee, 0xdb, 0x69, 0xc3, 0x3f, 0x6d, 0x45, 0x1f, 0x8e, 0x2e, 0x6b, 0x76, 0xdd, 0x77, 0x78,
+	0x97, 0x19, 0x6a, 0xd9, 0x94, 0x75, 0x15, 0xee, 0xd8, 0x7c, 0xd7, 0x93, 0x2e, 0xb2, 0x2d, 0x33,
+	0x3b, 0x08, 0xfb, 0xc4, 0x47, 0x76, 0x35, 0x34, 0x4b, 0x92, 0x5b, 0x28, 0x81, 0xf6, 0x3d, 0x2f,
+	0x07, 0xfe, 0x4e, 0x7a, 0x52, 0xec, 0xde, 0x52, 0x1a, 0x6c, 0xea, 0x1c, 0x28, 0x03, 0x9d, 0x02,
+	0x05, 0x89, 0x5c, 0x13, 0xaa, 0x76, 0xd5, 0x45, 0xd0, 0x5c, 0x25, 0xe8, 0xf7, 0xfd, 0xb5, 0x4a,
+	0xb1, 0xdc, 0x54, 0xeb, 0x40, 0xd9, 0x6d, 0x38, 0x18, 0x0a, 0xdb, 0xa1, 0xb0, 0x1d, 0x0a, 0xff,
+	0x9f, 0x72, 0x7d, 0xea, 0xf0, 0xea, 0x6f, 0x00, 0xf8, 0x2f, 0xd1, 0x6a, 0xe3, 0x01, 0x00, 0x00,
 }
@@ -34,7 +34,7 @@ func (m *InstallChaincodeArgs) Reset()         { *m = InstallChaincodeArgs{} }
 func (m *InstallChaincodeArgs) String() string { return proto.CompactTextString(m) }
 func (*InstallChaincodeArgs) ProtoMessage()    {}
 func (*InstallChaincodeArgs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lifecycle_4a021658c9949a10, []int{0}
+	return fileDescriptor_lifecycle_5f9bc8b9e3a0127f, []int{0}
 }
 func (m *InstallChaincodeArgs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InstallChaincodeArgs.Unmarshal(m, b)
@@ -88,7 +88,7 @@ func (m *InstallChaincodeResult) Reset()         { *m = InstallChaincodeResult{}
 func (m *InstallChaincodeResult) String() string { return proto.CompactTextString(m) }
 func (*InstallChaincodeResult) ProtoMessage()    {}
 func (*InstallChaincodeResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lifecycle_4a021658c9949a10, []int{1}
+	return fileDescriptor_lifecycle_5f9bc8b9e3a0127f, []int{1}
 }
 func (m *InstallChaincodeResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InstallChaincodeResult.Unmarshal(m, b)
@@ -129,7 +129,7 @@ func (m *QueryInstalledChaincodeArgs) Reset()         { *m = QueryInstalledChain
 func (m *QueryInstalledChaincodeArgs) String() string { return proto.CompactTextString(m) }
 func (*QueryInstalledChaincodeArgs) ProtoMessage()    {}
 func (*QueryInstalledChaincodeArgs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lifecycle_4a021658c9949a10, []int{2}
+	return fileDescriptor_lifecycle_5f9bc8b9e3a0127f, []int{2}
 }
 func (m *QueryInstalledChaincodeArgs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryInstalledChaincodeArgs.Unmarshal(m, b)
@@ -176,7 +176,7 @@ func (m *QueryInstalledChaincodeResult) Reset()         { *m = QueryInstalledCha
 func (m *QueryInstalledChaincodeResult) String() string { return proto.CompactTextString(m) }
 func (*QueryInstalledChaincodeResult) ProtoMessage()    {}
 func (*QueryInstalledChaincodeResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lifecycle_4a021658c9949a10, []int{3}
+	return fileDescriptor_lifecycle_5f9bc8b9e3a0127f, []int{3}
 }
 func (m *QueryInstalledChaincodeResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryInstalledChaincodeResult.Unmarshal(m, b)
@@ -216,7 +216,7 @@ func (m *QueryInstalledChaincodesArgs) Reset()         { *m = QueryInstalledChai
 func (m *QueryInstalledChaincodesArgs) String() string { return proto.CompactTextString(m) }
 func (*QueryInstalledChaincodesArgs) ProtoMessage()    {}
 func (*QueryInstalledChaincodesArgs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lifecycle_4a021658c9949a10, []int{4}
+	return fileDescriptor_lifecycle_5f9bc8b9e3a0127f, []int{4}
 }
 func (m *QueryInstalledChaincodesArgs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryInstalledChaincodesArgs.Unmarshal(m, b)
@@ -250,7 +250,7 @@ func (m *QueryInstalledChaincodesResult) Reset()         { *m = QueryInstalledCh
 func (m *QueryInstalledChaincodesResult) String() string { return proto.CompactTextString(m) }
 func (*QueryInstalledChaincodesResult) ProtoMessage()    {}
 func (*QueryInstalledChaincodesResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lifecycle_4a021658c9949a10, []int{5}
+	return fileDescriptor_lifecycle_5f9bc8b9e3a0127f, []int{5}
 }
 func (m *QueryInstalledChaincodesResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryInstalledChaincodesResult.Unmarshal(m, b)
@@ -294,7 +294,7 @@ func (m *QueryInstalledChaincodesResult_InstalledChaincode) String() string {
 }
 func (*QueryInstalledChaincodesResult_InstalledChaincode) ProtoMessage() {}
 func (*QueryInstalledChaincodesResult_InstalledChaincode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lifecycle_4a021658c9949a10, []int{5, 0}
+	return fileDescriptor_lifecycle_5f9bc8b9e3a0127f, []int{5, 0}
 }
 func (m *QueryInstalledChaincodesResult_InstalledChaincode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryInstalledChaincodesResult_InstalledChaincode.Unmarshal(m, b)
@@ -347,6 +347,7 @@ type ApproveChaincodeDefinitionForMyOrgArgs struct {
 	ValidationParameter  []byte                          `protobuf:"bytes,7,opt,name=validation_parameter,json=validationParameter,proto3" json:"validation_parameter,omitempty"`
 	Collections          *common.CollectionConfigPackage `protobuf:"bytes,8,opt,name=collections,proto3" json:"collections,omitempty"`
 	InitRequired         bool                            `protobuf:"varint,9,opt,name=init_required,json=initRequired,proto3" json:"init_required,omitempty"`
+	ReadYourWrites       bool                            `protobuf:"varint,10,opt,name=read_your_writes,json=readYourWrites,proto3" json:"read_your_writes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
 	XXX_unrecognized     []byte                          `json:"-"`
 	XXX_sizecache        int32                           `json:"-"`
@@ -358,7 +359,7 @@ func (m *ApproveChaincodeDefinitionForMyOrgArgs) Reset() {
 func (m *ApproveChaincodeDefinitionForMyOrgArgs) String() string { return proto.CompactTextString(m) }
 func (*ApproveChaincodeDefinitionForMyOrgArgs) ProtoMessage()    {}
 func (*ApproveChaincodeDefinitionForMyOrgArgs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lifecycle_4a021658c9949a10, []int{6}
+	return fileDescriptor_lifecycle_5f9bc8b9e3a0127f, []int{6}
 }
 func (m *ApproveChaincodeDefinitionForMyOrgArgs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ApproveChaincodeDefinitionForMyOrgArgs.Unmarshal(m, b)
@@ -441,6 +442,13 @@ func (m *ApproveChaincodeDefinitionForMyOrgArgs) GetInitRequired() bool {
 	return false
 }
 
+func (m *ApproveChaincodeDefinitionForMyOrgArgs) GetReadYourWrites() bool {
+	if m != nil {
+		return m.ReadYourWrites
+	}
+	return false
+}
+
 // ApproveChaincodeDefinitionForMyOrgResult is the message returned by
 // `_lifecycle.ApproveChaincodeDefinitionForMyOrg`. Currently it returns
 // nothing, but may be extended in the future.
@@ -456,7 +464,7 @@ func (m *ApproveChaincodeDefinitionForMyOrgResult) Reset() {
 func (m *ApproveChaincodeDefinitionForMyOrgResult) String() string { return proto.CompactTextString(m) }
 func (*ApproveChaincodeDefinitionForMyOrgResult) ProtoMessage()    {}
 func (*ApproveChaincodeDefinitionForMyOrgResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lifecycle_4a021658c9949a10, []int{7}
+	return fileDescriptor_lifecycle_5f9bc8b9e3a0127f, []int{7}
 }
 func (m *ApproveChaincodeDefinitionForMyOrgResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ApproveChaincodeDefinitionForMyOrgResult.Unmarshal(m, b)
@@ -488,6 +496,7 @@ type CommitChaincodeDefinitionArgs struct {
 	ValidationParameter  []byte                          `protobuf:"bytes,7,opt,name=validation_parameter,json=validationParameter,proto3" json:"validation_parameter,omitempty"`
 	Collections          *common.CollectionConfigPackage `protobuf:"bytes,8,opt,name=collections,proto3" json:"collections,omitempty"`
 	InitRequired         bool                            `protobuf:"varint,9,opt,name=init_required,json=initRequired,proto3" json:"init_required,omitempty"`
+	ReadYourWrites       bool                            `protobuf:"varint,10,opt,name=read_your_writes,json=readYourWrites,proto3" json:"read_your_writes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
 	XXX_unrecognized     []byte                          `json:"-"`
 	XXX_sizecache        int32                           `json:"-"`
@@ -497,7 +506,7 @@ func (m *CommitChaincodeDefinitionArgs) Reset()         { *m = CommitChaincodeDe
 func (m *CommitChaincodeDefinitionArgs) String() string { return proto.CompactTextString(m) }
 func (*CommitChaincodeDefinitionArgs) ProtoMessage()    {}
 func (*CommitChaincodeDefinitionArgs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lifecycle_4a021658c9949a10, []int{8}
+	return fileDescriptor_lifecycle_5f9bc8b9e3a0127f, []int{8}
 }
 func (m *CommitChaincodeDefinitionArgs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CommitChaincodeDefinitionArgs.Unmarshal(m, b)
@@ -580,6 +589,13 @@ func (m *CommitChaincodeDefinitionArgs) GetInitRequired() bool {
 	return false
 }
 
+func (m *CommitChaincodeDefinitionArgs) GetReadYourWrites() bool {
+	if m != nil {
+		return m.ReadYourWrites
+	}
+	return false
+}
+
 // CommitChaincodeDefinitionResult is the message returned by
 // `_lifecycle.CommitChaincodeDefinition`. Currently it returns
 // nothing, but may be extended in the future.
@@ -593,7 +609,7 @@ func (m *CommitChaincodeDefinitionResult) Reset()         { *m = CommitChaincode
 func (m *CommitChaincodeDefinitionResult) String() string { return proto.CompactTextString(m) }
 func (*CommitChaincodeDefinitionResult) ProtoMessage()    {}
 func (*CommitChaincodeDefinitionResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lifecycle_4a021658c9949a10, []int{9}
+	return fileDescriptor_lifecycle_5f9bc8b9e3a0127f, []int{9}
 }
 func (m *CommitChaincodeDefinitionResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CommitChaincodeDefinitionResult.Unmarshal(m, b)
@@ -626,7 +642,7 @@ func (m *QueryChaincodeDefinitionArgs) Reset()         { *m = QueryChaincodeDefi
 func (m *QueryChaincodeDefinitionArgs) String() string { return proto.CompactTextString(m) }
 func (*QueryChaincodeDefinitionArgs) ProtoMessage()    {}
 func (*QueryChaincodeDefinitionArgs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lifecycle_4a021658c9949a10, []int{10}
+	return fileDescriptor_lifecycle_5f9bc8b9e3a0127f, []int{10}
 }
 func (m *QueryChaincodeDefinitionArgs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryChaincodeDefinitionArgs.Unmarshal(m, b)
@@ -664,6 +680,7 @@ type QueryChaincodeDefinitionResult struct {
 	ValidationParameter  []byte                          `protobuf:"bytes,6,opt,name=validation_parameter,json=validationParameter,proto3" json:"validation_parameter,omitempty"`
 	Collections          *common.CollectionConfigPackage `protobuf:"bytes,7,opt,name=collections,proto3" json:"collections,omitempty"`
 	InitRequired         bool                            `protobuf:"varint,8,opt,name=init_required,json=initRequired,proto3" json:"init_required,omitempty"`
+	ReadYourWrites       bool                            `protobuf:"varint,9,opt,name=read_your_writes,json=readYourWrites,proto3" json:"read_your_writes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
 	XXX_unrecognized     []byte                          `json:"-"`
 	XXX_sizecache        int32                           `json:"-"`
@@ -673,7 +690,7 @@ func (m *QueryChaincodeDefinitionResult) Reset()         { *m = QueryChaincodeDe
 func (m *QueryChaincodeDefinitionResult) String() string { return proto.CompactTextString(m) }
 func (*QueryChaincodeDefinitionResult) ProtoMessage()    {}
 func (*QueryChaincodeDefinitionResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lifecycle_4a021658c9949a10, []int{11}
+	return fileDescriptor_lifecycle_5f9bc8b9e3a0127f, []int{11}
 }
 func (m *QueryChaincodeDefinitionResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryChaincodeDefinitionResult.Unmarshal(m, b)
@@ -749,6 +766,13 @@ func (m *QueryChaincodeDefinitionResult) GetInitRequired() bool {
 	return false
 }
 
+func (m *QueryChaincodeDefinitionResult) GetReadYourWrites() bool {
+	if m != nil {
+		return m.ReadYourWrites
+	}
+	return false
+}
+
 // QueryNamespaceDefinitions is the message used as arguments to
 // `_lifecycle.QueryNamespaceDefinitions`.
 type QueryNamespaceDefinitionsArgs struct {
@@ -761,7 +785,7 @@ func (m *QueryNamespaceDefinitionsArgs) Reset()         { *m = QueryNamespaceDef
 func (m *QueryNamespaceDefinitionsArgs) String() string { return proto.CompactTextString(m) }
 func (*QueryNamespaceDefinitionsArgs) ProtoMessage()    {}
 func (*QueryNamespaceDefinitionsArgs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lifecycle_4a021658c9949a10, []int{12}
+	return fileDescriptor_lifecycle_5f9bc8b9e3a0127f, []int{12}
 }
 func (m *QueryNamespaceDefinitionsArgs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryNamespaceDefinitionsArgs.Unmarshal(m, b)
@@ -794,7 +818,7 @@ func (m *QueryNamespaceDefinitionsResult) Reset()         { *m = QueryNamespaceD
 func (m *QueryNamespaceDefinitionsResult) String() string { return proto.CompactTextString(m) }
 func (*QueryNamespaceDefinitionsResult) ProtoMessage()    {}
 func (*QueryNamespaceDefinitionsResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lifecycle_4a021658c9949a10, []int{13}
+	return fileDescriptor_lifecycle_5f9bc8b9e3a0127f, []int{13}
 }
 func (m *QueryNamespaceDefinitionsResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryNamespaceDefinitionsResult.Unmarshal(m, b)
@@ -834,7 +858,7 @@ func (m *QueryNamespaceDefinitionsResult_Namespace) Reset() {
 func (m *QueryNamespaceDefinitionsResult_Namespace) String() string { return proto.CompactTextString(m) }
 func (*QueryNamespaceDefinitionsResult_Namespace) ProtoMessage()    {}
 func (*QueryNamespaceDefinitionsResult_Namespace) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lifecycle_4a021658c9949a10, []int{13, 0}
+	return fileDescriptor_lifecycle_5f9bc8b9e3a0127f, []int{13, 0}
 }
 func (m *QueryNamespaceDefinitionsResult_Namespace) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryNamespaceDefinitionsResult_Namespace.Unmarshal(m, b)
@@ -861,6 +885,296 @@ func (m *QueryNamespaceDefinitionsResult_Namespace) GetType() string {
 	return ""
 }
 
+// ApproveTokenDefinitionForMyOrgArgs is the message used as arguments to
+// `_lifecycle.ApproveTokenDefinitionForMyOrg`.
+type ApproveTokenDefinitionForMyOrgArgs struct {
+	Sequence             int64    `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ValidationPlugin     string   `protobuf:"bytes,3,opt,name=validation_plugin,json=validationPlugin,proto3" json:"validation_plugin,omitempty"`
+	ValidationParameter  []byte   `protobuf:"bytes,4,opt,name=validation_parameter,json=validationParameter,proto3" json:"validation_parameter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApproveTokenDefinitionForMyOrgArgs) Reset()         { *m = ApproveTokenDefinitionForMyOrgArgs{} }
+func (m *ApproveTokenDefinitionForMyOrgArgs) String() string { return proto.CompactTextString(m) }
+func (*ApproveTokenDefinitionForMyOrgArgs) ProtoMessage()    {}
+func (*ApproveTokenDefinitionForMyOrgArgs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_lifecycle_5f9bc8b9e3a0127f, []int{14}
+}
+func (m *ApproveTokenDefinitionForMyOrgArgs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApproveTokenDefinitionForMyOrgArgs.Unmarshal(m, b)
+}
+func (m *ApproveTokenDefinitionForMyOrgArgs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApproveTokenDefinitionForMyOrgArgs.Marshal(b, m, deterministic)
+}
+func (dst *ApproveTokenDefinitionForMyOrgArgs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApproveTokenDefinitionForMyOrgArgs.Merge(dst, src)
+}
+func (m *ApproveTokenDefinitionForMyOrgArgs) XXX_Size() int {
+	return xxx_messageInfo_ApproveTokenDefinitionForMyOrgArgs.Size(m)
+}
+func (m *ApproveTokenDefinitionForMyOrgArgs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApproveTokenDefinitionForMyOrgArgs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApproveTokenDefinitionForMyOrgArgs proto.InternalMessageInfo
+
+func (m *ApproveTokenDefinitionForMyOrgArgs) GetSequence() int64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ApproveTokenDefinitionForMyOrgArgs) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApproveTokenDefinitionForMyOrgArgs) GetValidationPlugin() string {
+	if m != nil {
+		return m.ValidationPlugin
+	}
+	return ""
+}
+
+func (m *ApproveTokenDefinitionForMyOrgArgs) GetValidationParameter() []byte {
+	if m != nil {
+		return m.ValidationParameter
+	}
+	return nil
+}
+
+// ApproveTokenDefinitionForMyOrgResult is the message returned by
+// `_lifecycle.ApproveTokenDefinitionForMyOrg`. Currently it returns
+// nothing, but may be extended in the future.
+type ApproveTokenDefinitionForMyOrgResult struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApproveTokenDefinitionForMyOrgResult) Reset()         { *m = ApproveTokenDefinitionForMyOrgResult{} }
+func (m *ApproveTokenDefinitionForMyOrgResult) String() string { return proto.CompactTextString(m) }
+func (*ApproveTokenDefinitionForMyOrgResult) ProtoMessage()    {}
+func (*ApproveTokenDefinitionForMyOrgResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_lifecycle_5f9bc8b9e3a0127f, []int{15}
+}
+func (m *ApproveTokenDefinitionForMyOrgResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApproveTokenDefinitionForMyOrgResult.Unmarshal(m, b)
+}
+func (m *ApproveTokenDefinitionForMyOrgResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApproveTokenDefinitionForMyOrgResult.Marshal(b, m, deterministic)
+}
+func (dst *ApproveTokenDefinitionForMyOrgResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApproveTokenDefinitionForMyOrgResult.Merge(dst, src)
+}
+func (m *ApproveTokenDefinitionForMyOrgResult) XXX_Size() int {
+	return xxx_messageInfo_ApproveTokenDefinitionForMyOrgResult.Size(m)
+}
+func (m *ApproveTokenDefinitionForMyOrgResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApproveTokenDefinitionForMyOrgResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApproveTokenDefinitionForMyOrgResult proto.InternalMessageInfo
+
+// CommitTokenDefinitionArgs is the message used as arguments to
+// `_lifecycle.CommitTokenDefinition`.
+type CommitTokenDefinitionArgs struct {
+	Sequence             int64    `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ValidationPlugin     string   `protobuf:"bytes,3,opt,name=validation_plugin,json=validationPlugin,proto3" json:"validation_plugin,omitempty"`
+	ValidationParameter  []byte   `protobuf:"bytes,4,opt,name=validation_parameter,json=validationParameter,proto3" json:"validation_parameter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommitTokenDefinitionArgs) Reset()         { *m = CommitTokenDefinitionArgs{} }
+func (m *CommitTokenDefinitionArgs) String() string { return proto.CompactTextString(m) }
+func (*CommitTokenDefinitionArgs) ProtoMessage()    {}
+func (*CommitTokenDefinitionArgs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_lifecycle_5f9bc8b9e3a0127f, []int{16}
+}
+func (m *CommitTokenDefinitionArgs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommitTokenDefinitionArgs.Unmarshal(m, b)
+}
+func (m *CommitTokenDefinitionArgs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommitTokenDefinitionArgs.Marshal(b, m, deterministic)
+}
+func (dst *CommitTokenDefinitionArgs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitTokenDefinitionArgs.Merge(dst, src)
+}
+func (m *CommitTokenDefinitionArgs) XXX_Size() int {
+	return xxx_messageInfo_CommitTokenDefinitionArgs.Size(m)
+}
+func (m *CommitTokenDefinitionArgs) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitTokenDefinitionArgs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitTokenDefinitionArgs proto.InternalMessageInfo
+
+func (m *CommitTokenDefinitionArgs) GetSequence() int64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *CommitTokenDefinitionArgs) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CommitTokenDefinitionArgs) GetValidationPlugin() string {
+	if m != nil {
+		return m.ValidationPlugin
+	}
+	return ""
+}
+
+func (m *CommitTokenDefinitionArgs) GetValidationParameter() []byte {
+	if m != nil {
+		return m.ValidationParameter
+	}
+	return nil
+}
+
+// CommitTokenDefinitionResult is the message returned by
+// `_lifecycle.CommitTokenDefinition`. Currently it returns
+// nothing, but may be extended in the future.
+type CommitTokenDefinitionResult struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommitTokenDefinitionResult) Reset()         { *m = CommitTokenDefinitionResult{} }
+func (m *CommitTokenDefinitionResult) String() string { return proto.CompactTextString(m) }
+func (*CommitTokenDefinitionResult) ProtoMessage()    {}
+func (*CommitTokenDefinitionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_lifecycle_5f9bc8b9e3a0127f, []int{17}
+}
+func (m *CommitTokenDefinitionResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommitTokenDefinitionResult.Unmarshal(m, b)
+}
+func (m *CommitTokenDefinitionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommitTokenDefinitionResult.Marshal(b, m, deterministic)
+}
+func (dst *CommitTokenDefinitionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitTokenDefinitionResult.Merge(dst, src)
+}
+func (m *CommitTokenDefinitionResult) XXX_Size() int {
+	return xxx_messageInfo_CommitTokenDefinitionResult.Size(m)
+}
+func (m *CommitTokenDefinitionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitTokenDefinitionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitTokenDefinitionResult proto.InternalMessageInfo
+
+// QueryTokenDefinitionArgs is the message used as arguments to
+// `_lifecycle.QueryTokenDefinition`.
+type QueryTokenDefinitionArgs struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryTokenDefinitionArgs) Reset()         { *m = QueryTokenDefinitionArgs{} }
+func (m *QueryTokenDefinitionArgs) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenDefinitionArgs) ProtoMessage()    {}
+func (*QueryTokenDefinitionArgs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_lifecycle_5f9bc8b9e3a0127f, []int{18}
+}
+func (m *QueryTokenDefinitionArgs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryTokenDefinitionArgs.Unmarshal(m, b)
+}
+func (m *QueryTokenDefinitionArgs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryTokenDefinitionArgs.Marshal(b, m, deterministic)
+}
+func (dst *QueryTokenDefinitionArgs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenDefinitionArgs.Merge(dst, src)
+}
+func (m *QueryTokenDefinitionArgs) XXX_Size() int {
+	return xxx_messageInfo_QueryTokenDefinitionArgs.Size(m)
+}
+func (m *QueryTokenDefinitionArgs) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenDefinitionArgs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenDefinitionArgs proto.InternalMessageInfo
+
+func (m *QueryTokenDefinitionArgs) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// QueryTokenDefinitionResult is the message returned by
+// `_lifecycle.QueryTokenDefinition`.
+type QueryTokenDefinitionResult struct {
+	Sequence             int64    `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	ValidationPlugin     string   `protobuf:"bytes,2,opt,name=validation_plugin,json=validationPlugin,proto3" json:"validation_plugin,omitempty"`
+	ValidationParameter  []byte   `protobuf:"bytes,3,opt,name=validation_parameter,json=validationParameter,proto3" json:"validation_parameter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryTokenDefinitionResult) Reset()         { *m = QueryTokenDefinitionResult{} }
+func (m *QueryTokenDefinitionResult) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenDefinitionResult) ProtoMessage()    {}
+func (*QueryTokenDefinitionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_lifecycle_5f9bc8b9e3a0127f, []int{19}
+}
+func (m *QueryTokenDefinitionResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryTokenDefinitionResult.Unmarshal(m, b)
+}
+func (m *QueryTokenDefinitionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryTokenDefinitionResult.Marshal(b, m, deterministic)
+}
+func (dst *QueryTokenDefinitionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenDefinitionResult.Merge(dst, src)
+}
+func (m *QueryTokenDefinitionResult) XXX_Size() int {
+	return xxx_messageInfo_QueryTokenDefinitionResult.Size(m)
+}
+func (m *QueryTokenDefinitionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenDefinitionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenDefinitionResult proto.InternalMessageInfo
+
+func (m *QueryTokenDefinitionResult) GetSequence() int64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *QueryTokenDefinitionResult) GetValidationPlugin() string {
+	if m != nil {
+		return m.ValidationPlugin
+	}
+	return ""
+}
+
+func (m *QueryTokenDefinitionResult) GetValidationParameter() []byte {
+	if m != nil {
+		return m.ValidationParameter
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*InstallChaincodeArgs)(nil), "lifecycle.InstallChaincodeArgs")
 	proto.RegisterType((*InstallChaincodeResult)(nil), "lifecycle.InstallChaincodeResult")
@@ -879,55 +1193,68 @@ func init() {
 	proto.RegisterType((*QueryNamespaceDefinitionsResult)(nil), "lifecycle.QueryNamespaceDefinitionsResult")
 	proto.RegisterMapType((map[string]*QueryNamespaceDefinitionsResult_Namespace)(nil), "lifecycle.QueryNamespaceDefinitionsResult.NamespacesEntry")
 	proto.RegisterType((*QueryNamespaceDefinitionsResult_Namespace)(nil), "lifecycle.QueryNamespaceDefinitionsResult.Namespace")
+	proto.RegisterType((*ApproveTokenDefinitionForMyOrgArgs)(nil), "lifecycle.ApproveTokenDefinitionForMyOrgArgs")
+	proto.RegisterType((*ApproveTokenDefinitionForMyOrgResult)(nil), "lifecycle.ApproveTokenDefinitionForMyOrgResult")
+	proto.RegisterType((*CommitTokenDefinitionArgs)(nil), "lifecycle.CommitTokenDefinitionArgs")
+	proto.RegisterType((*CommitTokenDefinitionResult)(nil), "lifecycle.CommitTokenDefinitionResult")
+	proto.RegisterType((*QueryTokenDefinitionArgs)(nil), "lifecycle.QueryTokenDefinitionArgs")
+	proto.RegisterType((*QueryTokenDefinitionResult)(nil), "lifecycle.QueryTokenDefinitionResult")
 }
 
 func init() {
-	proto.RegisterFile("peer/lifecycle/lifecycle.proto", fileDescriptor_lifecycle_4a021658c9949a10)
-}
-
-var fileDescriptor_lifecycle_4a021658c9949a10 = []byte{
-	// 678 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x6f, 0xd3, 0x4e,
-	0x10, 0x95, 0x9d, 0x7e, 0x24, 0x93, 0xfe, 0xf4, 0x6b, 0xdd, 0x8a, 0x9a, 0x40, 0x9b, 0x60, 0x24,
-	0x14, 0x41, 0x71, 0x44, 0xca, 0x01, 0x55, 0x5c, 0x4a, 0x00, 0x09, 0x10, 0x50, 0x7c, 0xe0, 0xd0,
-	0x4b, 0xb4, 0x75, 0x26, 0xce, 0xaa, 0xf6, 0xae, 0xbb, 0x6b, 0x47, 0xf2, 0x8d, 0xff, 0x81, 0xbf,
-	0x90, 0x23, 0x77, 0xae, 0x48, 0xc8, 0x9f, 0x31, 0x6d, 0x1c, 0x08, 0x1f, 0x37, 0x6e, 0xeb, 0x9d,
-	0xf7, 0x66, 0x9f, 0xe6, 0xed, 0x8c, 0x17, 0xf6, 0x7d, 0x44, 0xd1, 0x73, 0xe9, 0x18, 0xed, 0xc8,
-	0x76, 0x71, 0xb6, 0x32, 0x7d, 0xc1, 0x03, 0xae, 0x35, 0x8a, 0x8d, 0xd6, 0xae, 0xcd, 0x3d, 0x8f,
-	0xb3, 0x9e, 0xcd, 0x5d, 0x17, 0xed, 0x80, 0x72, 0x96, 0x62, 0x8c, 0x0f, 0x0a, 0xec, 0xbc, 0x60,
-	0x32, 0x20, 0xae, 0x3b, 0x98, 0x10, 0xca, 0x6c, 0x3e, 0xc2, 0x63, 0xe1, 0x48, 0x4d, 0x83, 0x15,
-	0x46, 0x3c, 0xd4, 0x95, 0x8e, 0xd2, 0x6d, 0x58, 0xc9, 0x5a, 0xd3, 0x61, 0x7d, 0x8a, 0x42, 0x52,
-	0xce, 0x74, 0x35, 0xd9, 0xce, 0x3f, 0xb5, 0x23, 0xb8, 0x6e, 0xe7, 0xf4, 0x21, 0x4d, 0xf3, 0x0d,
-	0x7d, 0x62, 0x9f, 0x13, 0x07, 0xf5, 0x5a, 0x47, 0xe9, 0x6e, 0x58, 0xbb, 0x05, 0x20, 0x3b, 0xef,
-	0x24, 0x0d, 0x1b, 0x07, 0x70, 0xed, 0xb2, 0x02, 0x0b, 0x65, 0xe8, 0x06, 0xb1, 0x86, 0x09, 0x91,
-	0x93, 0x44, 0xc3, 0x86, 0x95, 0xac, 0x8d, 0x57, 0x70, 0xe3, 0x5d, 0x88, 0x22, 0xca, 0x28, 0x38,
-	0xfa, 0x0d, 0xd9, 0xc6, 0x21, 0xec, 0x55, 0x24, 0x5b, 0xa0, 0x60, 0x1f, 0x6e, 0x56, 0x90, 0x64,
-	0x2c, 0xc1, 0xf8, 0xac, 0xc0, 0x7e, 0x15, 0x20, 0x4b, 0xcb, 0x61, 0x87, 0xe6, 0xc1, 0x61, 0x51,
-	0x17, 0xa9, 0x2b, 0x9d, 0x5a, 0xb7, 0xd9, 0x7f, 0x6c, 0xce, 0x9c, 0x5c, 0x9c, 0xc8, 0x9c, 0x23,
-	0x7c, 0x9b, 0x5e, 0x45, 0xb7, 0xde, 0x83, 0x76, 0x15, 0xba, 0xa4, 0xc7, 0x79, 0x2d, 0x6a, 0xa5,
-	0x5a, 0x7c, 0x55, 0xe1, 0xce, 0xb1, 0xef, 0x0b, 0x3e, 0xc5, 0x22, 0xed, 0x53, 0x1c, 0x53, 0x46,
-	0xe3, 0x3b, 0xf6, 0x9c, 0x8b, 0xd7, 0xd1, 0x5b, 0xe1, 0x24, 0xce, 0xb4, 0xa0, 0x2e, 0xf1, 0x22,
-	0x44, 0x66, 0xa7, 0x07, 0xd6, 0xac, 0xe2, 0xbb, 0x10, 0xa2, 0xce, 0x17, 0x52, 0x9b, 0x2f, 0x64,
-	0x65, 0x26, 0x44, 0xbb, 0x0f, 0x1a, 0xb2, 0x11, 0x17, 0x12, 0x3d, 0x64, 0xc1, 0xd0, 0x77, 0x43,
-	0x87, 0x32, 0x7d, 0x35, 0x21, 0x6e, 0x95, 0x22, 0x27, 0x49, 0x40, 0xbb, 0x07, 0x5b, 0x53, 0xe2,
-	0xd2, 0x11, 0x89, 0x65, 0xe6, 0xe8, 0xb5, 0x04, 0xbd, 0x39, 0x0b, 0x64, 0xe0, 0x07, 0xb0, 0x53,
-	0x06, 0x13, 0x41, 0x3c, 0x0c, 0x50, 0xe8, 0xeb, 0xc9, 0xf9, 0xdb, 0x25, 0x7c, 0x1e, 0xd2, 0x8e,
-	0xa1, 0x39, 0x6b, 0x35, 0xa9, 0xd7, 0x3b, 0x4a, 0xb7, 0xd9, 0x6f, 0x9b, 0x69, 0x17, 0x9a, 0x83,
-	0x22, 0x34, 0xe0, 0x6c, 0x4c, 0x9d, 0xac, 0x13, 0xac, 0x32, 0x47, 0xbb, 0x0d, 0xff, 0xc5, 0x65,
-	0x1c, 0x0a, 0xbc, 0x08, 0xa9, 0xc0, 0x91, 0xde, 0xe8, 0x28, 0xdd, 0xba, 0xb5, 0x11, 0x6f, 0x5a,
-	0xd9, 0x9e, 0x71, 0x17, 0xba, 0x3f, 0x2e, 0x7f, 0x7a, 0x57, 0x8c, 0x2f, 0x2a, 0xec, 0x0d, 0xb8,
-	0xe7, 0xd1, 0x60, 0x0e, 0xf6, 0x9f, 0x45, 0x7f, 0xcb, 0xa2, 0x5b, 0xd0, 0xae, 0xac, 0x7a, 0xe6,
-	0x4c, 0x3f, 0x9b, 0x28, 0x55, 0xbe, 0xcc, 0xe9, 0x53, 0xe3, 0x93, 0x9a, 0x4d, 0x99, 0xca, 0xb4,
-	0x0b, 0xed, 0x5c, 0xaa, 0xcd, 0x2b, 0xac, 0x5b, 0x59, 0xca, 0xba, 0xd5, 0x25, 0xad, 0x5b, 0xfb,
-	0x69, 0xeb, 0xd6, 0xff, 0x84, 0x75, 0xf5, 0x39, 0xd6, 0xb5, 0xb3, 0xdf, 0xc3, 0x1b, 0xe2, 0xa1,
-	0xf4, 0x89, 0x5d, 0x2a, 0x71, 0x3a, 0xea, 0x3f, 0xaa, 0xd0, 0xae, 0x44, 0x64, 0x2e, 0x9c, 0x02,
-	0xb0, 0x3c, 0x9a, 0x4f, 0xf8, 0xa3, 0xcb, 0x13, 0xbe, 0x9a, 0x6f, 0x16, 0x21, 0xf9, 0x8c, 0x05,
-	0x22, 0xb2, 0x4a, 0xd9, 0x5a, 0x6d, 0x68, 0x14, 0xe1, 0xd8, 0xb8, 0x20, 0xf2, 0x8b, 0x5b, 0x12,
-	0xaf, 0x5b, 0x12, 0xfe, 0xbf, 0xc4, 0xd7, 0x36, 0xa1, 0x76, 0x8e, 0x51, 0x86, 0x8a, 0x97, 0xda,
-	0x4b, 0x58, 0x9d, 0x12, 0x37, 0x4c, 0x7b, 0xbb, 0xd9, 0x7f, 0xf8, 0x2b, 0xe2, 0xac, 0x34, 0xc5,
-	0x91, 0xfa, 0x48, 0x79, 0x62, 0xc3, 0x01, 0x17, 0x8e, 0x39, 0x89, 0x7c, 0x14, 0x2e, 0x8e, 0x1c,
-	0x14, 0xe6, 0x98, 0x9c, 0x09, 0x6a, 0xa7, 0x6f, 0x0e, 0x69, 0xc6, 0xef, 0x96, 0xd9, 0x21, 0xa7,
-	0x87, 0x0e, 0x0d, 0x26, 0xe1, 0x59, 0xec, 0x5f, 0xaf, 0x44, 0xea, 0xa5, 0xa4, 0x5e, 0x4a, 0xea,
-	0x7d, 0xff, 0xd8, 0x39, 0x5b, 0x4b, 0xb6, 0x0f, 0xbf, 0x05, 0x00, 0x00, 0xff, 0xff, 0xd7, 0x63,
-	0x36, 0xed, 0x05, 0x09, 0x00, 0x00,
+	proto.RegisterFile("peer/lifecycle/lifecycle.proto", fileDescriptor_lifecycle_5f9bc8b9e3a0127f)
+}
+
+var fileDescriptor_lifecycle_5f9bc8b9e3a0127f = []byte{
+	// 790 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6f, 0xd3, 0x4a,
+	0x10, 0x96, 0xe3, 0xb4, 0x4d, 0x26, 0x7d, 0xef, 0xb5, 0x6e, 0xf5, 0xea, 0xa6, 0x34, 0x09, 0x06,
+	0x55, 0x11, 0x14, 0x47, 0xa4, 0x1c, 0x50, 0xc5, 0xa5, 0x04, 0x90, 0x00, 0x01, 0xc5, 0x42, 0x20,
+	0x7a, 0x89, 0x5c, 0x67, 0xe2, 0xac, 0x6a, 0x7b, 0xdd, 0xb5, 0x1d, 0xe4, 0x1b, 0x77, 0x8e, 0x5c,
+	0x38, 0x71, 0xe5, 0xc0, 0x95, 0x7f, 0x8a, 0xff, 0x02, 0xf9, 0x67, 0x4c, 0x6a, 0x87, 0x06, 0x2a,
+	0xc1, 0x81, 0xdb, 0x7a, 0xe6, 0x9b, 0xd9, 0x6f, 0xf7, 0x9b, 0x99, 0x35, 0x34, 0x6c, 0x44, 0xd6,
+	0x31, 0xc8, 0x10, 0x35, 0x5f, 0x33, 0x70, 0xb2, 0x92, 0x6d, 0x46, 0x5d, 0x2a, 0x54, 0x53, 0x43,
+	0x7d, 0x43, 0xa3, 0xa6, 0x49, 0xad, 0x8e, 0x46, 0x0d, 0x03, 0x35, 0x97, 0x50, 0x2b, 0xc2, 0x48,
+	0x6f, 0x39, 0x58, 0x7f, 0x68, 0x39, 0xae, 0x6a, 0x18, 0xbd, 0x91, 0x4a, 0x2c, 0x8d, 0x0e, 0xf0,
+	0x80, 0xe9, 0x8e, 0x20, 0x40, 0xd9, 0x52, 0x4d, 0x14, 0xb9, 0x16, 0xd7, 0xae, 0x2a, 0xe1, 0x5a,
+	0x10, 0x61, 0x69, 0x8c, 0xcc, 0x21, 0xd4, 0x12, 0x4b, 0xa1, 0x39, 0xf9, 0x14, 0xf6, 0x61, 0x53,
+	0x4b, 0xc2, 0xfb, 0x24, 0xca, 0xd7, 0xb7, 0x55, 0xed, 0x44, 0xd5, 0x51, 0xe4, 0x5b, 0x5c, 0x7b,
+	0x59, 0xd9, 0x48, 0x01, 0xf1, 0x7e, 0x87, 0x91, 0x5b, 0xda, 0x85, 0xff, 0xa7, 0x19, 0x28, 0xe8,
+	0x78, 0x86, 0x1b, 0x70, 0x18, 0xa9, 0xce, 0x28, 0xe4, 0xb0, 0xac, 0x84, 0x6b, 0xe9, 0x31, 0x6c,
+	0x3d, 0xf7, 0x90, 0xf9, 0x71, 0x08, 0x0e, 0x7e, 0x81, 0xb6, 0xb4, 0x07, 0xdb, 0x05, 0xc9, 0x66,
+	0x30, 0x68, 0xc0, 0xa5, 0x82, 0x20, 0x27, 0xa0, 0x20, 0x7d, 0xe5, 0xa0, 0x51, 0x04, 0x88, 0xd3,
+	0x52, 0x58, 0x27, 0x89, 0xb3, 0x9f, 0xde, 0x8b, 0x23, 0x72, 0x2d, 0xbe, 0x5d, 0xeb, 0xde, 0x91,
+	0x27, 0x4a, 0xce, 0x4e, 0x24, 0xe7, 0x10, 0x5f, 0x23, 0x67, 0xd1, 0xf5, 0x97, 0x20, 0x9c, 0x85,
+	0xce, 0xa9, 0x71, 0x72, 0x17, 0x7c, 0xe6, 0x2e, 0x3e, 0xf1, 0xb0, 0x73, 0x60, 0xdb, 0x8c, 0x8e,
+	0x31, 0x4d, 0x7b, 0x0f, 0x87, 0xc4, 0x22, 0x41, 0x8d, 0x3d, 0xa0, 0xec, 0x89, 0xff, 0x8c, 0xe9,
+	0xa1, 0x32, 0x75, 0xa8, 0x38, 0x78, 0xea, 0xa1, 0xa5, 0x45, 0x1b, 0xf2, 0x4a, 0xfa, 0x9d, 0x12,
+	0x29, 0xe5, 0x13, 0xe1, 0xf3, 0x89, 0x94, 0x27, 0x44, 0x84, 0x1b, 0x20, 0xa0, 0x35, 0xa0, 0xcc,
+	0x41, 0x13, 0x2d, 0xb7, 0x6f, 0x1b, 0x9e, 0x4e, 0x2c, 0x71, 0x21, 0x0c, 0x5c, 0xcd, 0x78, 0x0e,
+	0x43, 0x87, 0x70, 0x1d, 0x56, 0xc7, 0xaa, 0x41, 0x06, 0x6a, 0x40, 0x33, 0x41, 0x2f, 0x86, 0xe8,
+	0x95, 0x89, 0x23, 0x06, 0xdf, 0x84, 0xf5, 0x2c, 0x58, 0x65, 0xaa, 0x89, 0x2e, 0x32, 0x71, 0x29,
+	0xdc, 0x7f, 0x2d, 0x83, 0x4f, 0x5c, 0xc2, 0x01, 0xd4, 0x26, 0xad, 0xe6, 0x88, 0x95, 0x16, 0xd7,
+	0xae, 0x75, 0x9b, 0x72, 0xd4, 0x85, 0x72, 0x2f, 0x75, 0xf5, 0xa8, 0x35, 0x24, 0x7a, 0xdc, 0x09,
+	0x4a, 0x36, 0x46, 0xb8, 0x02, 0xff, 0x04, 0xd7, 0xd8, 0x67, 0x78, 0xea, 0x11, 0x86, 0x03, 0xb1,
+	0xda, 0xe2, 0xda, 0x15, 0x65, 0x39, 0x30, 0x2a, 0xb1, 0x4d, 0x68, 0xc3, 0x0a, 0x43, 0x75, 0xd0,
+	0xf7, 0xa9, 0xc7, 0xfa, 0x6f, 0x18, 0x71, 0xd1, 0x11, 0x21, 0xc4, 0xfd, 0x1b, 0xd8, 0x5f, 0x53,
+	0x8f, 0xbd, 0x0a, 0xad, 0xd2, 0x35, 0x68, 0xff, 0x58, 0xa8, 0xa8, 0xaa, 0xa4, 0x8f, 0x3c, 0x6c,
+	0xf7, 0xa8, 0x69, 0x12, 0x37, 0x07, 0xfb, 0x57, 0xcc, 0xdf, 0x2f, 0xe6, 0x65, 0x68, 0x16, 0xea,
+	0x13, 0x6b, 0xd8, 0x8d, 0xa7, 0x54, 0x91, 0x82, 0x39, 0xbd, 0x2f, 0xbd, 0xe3, 0xa1, 0x51, 0x14,
+	0x14, 0x4f, 0xae, 0x59, 0xc2, 0xcf, 0x35, 0x3a, 0x0a, 0x44, 0x2e, 0xcf, 0x25, 0xf2, 0xc2, 0x9c,
+	0x22, 0x2f, 0x9e, 0x5b, 0xe4, 0xa5, 0x8b, 0x10, 0xb9, 0x72, 0x4e, 0x91, 0xab, 0xb9, 0x22, 0x37,
+	0xe3, 0xc7, 0xe9, 0xa9, 0x6a, 0xa2, 0x63, 0xab, 0x5a, 0x46, 0x8c, 0xe8, 0xa1, 0x79, 0x5f, 0x82,
+	0x66, 0x21, 0x22, 0xd6, 0xeb, 0x08, 0xc0, 0x4a, 0xbc, 0xc9, 0xfb, 0xb2, 0x3f, 0xfd, 0xbe, 0x14,
+	0xc7, 0xcb, 0xa9, 0xcb, 0xb9, 0x6f, 0xb9, 0xcc, 0x57, 0x32, 0xd9, 0xea, 0x4d, 0xa8, 0xa6, 0xee,
+	0x40, 0x62, 0xd7, 0xb7, 0xd3, 0x7a, 0x0a, 0xd6, 0x75, 0x07, 0xfe, 0x9b, 0x8a, 0x17, 0x56, 0x80,
+	0x3f, 0x41, 0x3f, 0x46, 0x05, 0x4b, 0xe1, 0x11, 0x2c, 0x8c, 0x55, 0xc3, 0x8b, 0xe6, 0x45, 0xad,
+	0x7b, 0xeb, 0x67, 0xc8, 0x29, 0x51, 0x8a, 0xfd, 0xd2, 0x6d, 0x4e, 0xfa, 0xc2, 0x81, 0x14, 0x4f,
+	0xba, 0x17, 0xf4, 0x04, 0xad, 0x0b, 0x7a, 0x8e, 0x72, 0xeb, 0x8f, 0x9f, 0xb3, 0xfe, 0xca, 0x85,
+	0xf5, 0x27, 0xed, 0xc0, 0xd5, 0xd9, 0xac, 0xe3, 0xbe, 0xfe, 0xcc, 0xc1, 0x66, 0xd4, 0xfb, 0x53,
+	0xb8, 0x3f, 0xf2, 0x54, 0xdb, 0xb0, 0x95, 0x4b, 0x36, 0x3e, 0x8c, 0x0c, 0x62, 0xa8, 0x71, 0xde,
+	0x51, 0xf2, 0x06, 0xd4, 0x07, 0x0e, 0xea, 0x79, 0x01, 0xe7, 0x18, 0x4e, 0xb9, 0x27, 0x2d, 0xcd,
+	0x79, 0x52, 0xbe, 0xf0, 0xa4, 0x77, 0x35, 0xd8, 0xa5, 0x4c, 0x97, 0x47, 0xbe, 0x8d, 0xcc, 0xc0,
+	0x81, 0x8e, 0x4c, 0x1e, 0xaa, 0xc7, 0x8c, 0x68, 0xd1, 0x8f, 0xb6, 0x23, 0xdb, 0x88, 0x6c, 0x52,
+	0xdb, 0x47, 0x7b, 0x3a, 0x71, 0x47, 0xde, 0x71, 0x30, 0x60, 0x3a, 0x99, 0xa0, 0x4e, 0x14, 0xd4,
+	0x89, 0x82, 0x3a, 0xdf, 0xff, 0xe1, 0x1f, 0x2f, 0x86, 0xe6, 0xbd, 0x6f, 0x03, 0x00, 0x49, 0x54,
+	0xab, 0x7c, 0xfa, 0x0b, 0x00, 0x00,
 }
@@ -34,7 +34,7 @@ func (m *PeerID) Reset()         { *m = PeerID{} }
 func (m *PeerID) String() string { return proto.CompactTextString(m) }
 func (*PeerID) ProtoMessage()    {}
 func (*PeerID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_peer_f225b6e437338532, []int{0}
+	return fileDescriptor_peer_5ef9ed8cc76cf3fe, []int{0}
 }
 func (m *PeerID) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeerID.Unmarshal(m, b)
@@ -73,7 +73,7 @@ func (m *PeerEndpoint) Reset()         { *m = PeerEndpoint{} }
 func (m *PeerEndpoint) String() string { return proto.CompactTextString(m) }
 func (*PeerEndpoint) ProtoMessage()    {}
 func (*PeerEndpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_peer_f225b6e437338532, []int{1}
+	return fileDescriptor_peer_5ef9ed8cc76cf3fe, []int{1}
 }
 func (m *PeerEndpoint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeerEndpoint.Unmarshal(m, b)
@@ -107,9 +107,91 @@ func (m *PeerEndpoint) GetAddress() string {
 	return ""
 }
 
+// SignedProposals is a batch of signed proposals for the same channel and
+// chaincode, which are endorsed with a single ProcessProposals call
+type SignedProposals struct {
+	Proposals            []*SignedProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SignedProposals) Reset()         { *m = SignedProposals{} }
+func (m *SignedProposals) String() string { return proto.CompactTextString(m) }
+func (*SignedProposals) ProtoMessage()    {}
+func (*SignedProposals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_peer_5ef9ed8cc76cf3fe, []int{2}
+}
+func (m *SignedProposals) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignedProposals.Unmarshal(m, b)
+}
+func (m *SignedProposals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignedProposals.Marshal(b, m, deterministic)
+}
+func (dst *SignedProposals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedProposals.Merge(dst, src)
+}
+func (m *SignedProposals) XXX_Size() int {
+	return xxx_messageInfo_SignedProposals.Size(m)
+}
+func (m *SignedProposals) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedProposals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedProposals proto.InternalMessageInfo
+
+func (m *SignedProposals) GetProposals() []*SignedProposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+// ProposalResponses are the responses to a batch of proposals, in the order
+// of the proposals of the batch
+type ProposalResponses struct {
+	Responses            []*ProposalResponse `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ProposalResponses) Reset()         { *m = ProposalResponses{} }
+func (m *ProposalResponses) String() string { return proto.CompactTextString(m) }
+func (*ProposalResponses) ProtoMessage()    {}
+func (*ProposalResponses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_peer_5ef9ed8cc76cf3fe, []int{3}
+}
+func (m *ProposalResponses) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposalResponses.Unmarshal(m, b)
+}
+func (m *ProposalResponses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposalResponses.Marshal(b, m, deterministic)
+}
+func (dst *ProposalResponses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalResponses.Merge(dst, src)
+}
+func (m *ProposalResponses) XXX_Size() int {
+	return xxx_messageInfo_ProposalResponses.Size(m)
+}
+func (m *ProposalResponses) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalResponses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalResponses proto.InternalMessageInfo
+
+func (m *ProposalResponses) GetResponses() []*ProposalResponse {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*PeerID)(nil), "protos.PeerID")
 	proto.RegisterType((*PeerEndpoint)(nil), "protos.PeerEndpoint")
+	proto.RegisterType((*SignedProposals)(nil), "protos.SignedProposals")
+	proto.RegisterType((*ProposalResponses)(nil), "protos.ProposalResponses")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -125,6 +207,9 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type EndorserClient interface {
 	ProcessProposal(ctx context.Context, in *SignedProposal, opts ...grpc.CallOption) (*ProposalResponse, error)
+	// ProcessProposals endorses a batch of proposals for the same channel and
+	// chaincode, and returns a response for each proposal
+	ProcessProposals(ctx context.Context, in *SignedProposals, opts ...grpc.CallOption) (*ProposalResponses, error)
 }
 
 type endorserClient struct {
@@ -144,9 +229,21 @@ func (c *endorserClient) ProcessProposal(ctx context.Context, in *SignedProposal
 	return out, nil
 }
 
+func (c *endorserClient) ProcessProposals(ctx context.Context, in *SignedProposals, opts ...grpc.CallOption) (*ProposalResponses, error) {
+	out := new(ProposalResponses)
+	err := c.cc.Invoke(ctx, "/protos.Endorser/ProcessProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EndorserServer is the server API for Endorser service.
 type EndorserServer interface {
 	ProcessProposal(context.Context, *SignedProposal) (*ProposalResponse, error)
+	// ProcessProposals endorses a batch of proposals for the same channel and
+	// chaincode, and returns a response for each proposal
+	ProcessProposals(context.Context, *SignedProposals) (*ProposalResponses, error)
 }
 
 func RegisterEndorserServer(s *grpc.Server, srv EndorserServer) {
@@ -171,6 +268,24 @@ func _Endorser_ProcessProposal_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Endorser_ProcessProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignedProposals)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndorserServer).ProcessProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.Endorser/ProcessProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndorserServer).ProcessProposals(ctx, req.(*SignedProposals))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Endorser_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "protos.Endorser",
 	HandlerType: (*EndorserServer)(nil),
@@ -179,29 +294,37 @@ var _Endorser_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessProposal",
 			Handler:    _Endorser_ProcessProposal_Handler,
 		},
+		{
+			MethodName: "ProcessProposals",
+			Handler:    _Endorser_ProcessProposals_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "peer/peer.proto",
 }
 
-func init() { proto.RegisterFile("peer/peer.proto", fileDescriptor_peer_f225b6e437338532) }
-
-var fileDescriptor_peer_f225b6e437338532 = []byte{
-	// 246 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x4f, 0x4b, 0xc4, 0x30,
-	0x10, 0xc5, 0xdd, 0x22, 0xab, 0x8e, 0xe2, 0x42, 0x04, 0x29, 0x65, 0x11, 0xe9, 0x49, 0x2f, 0x29,
-	0xac, 0xdf, 0x40, 0x2c, 0xe8, 0xc9, 0x5a, 0x6f, 0x5e, 0xa4, 0x6d, 0xc6, 0x6e, 0x60, 0x37, 0x13,
-	0x66, 0xea, 0xc1, 0x6f, 0x2f, 0x4d, 0x5a, 0x71, 0x2f, 0xf9, 0xf3, 0xde, 0x6f, 0x5e, 0x26, 0x03,
-	0x2b, 0x8f, 0xc8, 0xc5, 0xb8, 0x68, 0xcf, 0x34, 0x90, 0x5a, 0x86, 0x4d, 0xb2, 0xab, 0x68, 0x30,
-	0x79, 0x92, 0x66, 0x17, 0xcd, 0x6c, 0x7d, 0x20, 0x7e, 0x32, 0x8a, 0x27, 0x27, 0x18, 0xdd, 0x7c,
-	0x0d, 0xcb, 0x0a, 0x91, 0x5f, 0x9e, 0x94, 0x82, 0x63, 0xd7, 0xec, 0x31, 0x5d, 0xdc, 0x2e, 0xee,
-	0xce, 0xea, 0x70, 0xce, 0x9f, 0xe1, 0x62, 0x74, 0x4b, 0x67, 0x3c, 0x59, 0x37, 0xa8, 0x1b, 0x48,
-	0xac, 0x09, 0xc4, 0xf9, 0xe6, 0x32, 0x26, 0x88, 0x8e, 0xf5, 0x75, 0x62, 0x8d, 0x4a, 0xe1, 0xa4,
-	0x31, 0x86, 0x51, 0x24, 0x4d, 0x42, 0xcc, 0x7c, 0xdd, 0xbc, 0xc1, 0x69, 0xe9, 0x0c, 0xb1, 0x20,
-	0xab, 0x12, 0x56, 0x15, 0x53, 0x87, 0x22, 0xd5, 0xd4, 0x95, 0xba, 0x9e, 0xc3, 0xde, 0x6d, 0xef,
-	0xd0, 0xcc, 0x7a, 0x96, 0xfe, 0x3d, 0x32, 0x29, 0xf5, 0xd4, 0x7e, 0x7e, 0xf4, 0xf8, 0x0a, 0x39,
-	0x71, 0xaf, 0xb7, 0x3f, 0x1e, 0x79, 0x87, 0xa6, 0x47, 0xd6, 0x5f, 0x4d, 0xcb, 0xb6, 0x9b, 0x6b,
-	0xc6, 0x8f, 0x7f, 0xdc, 0xf7, 0x76, 0xd8, 0x7e, 0xb7, 0xba, 0xa3, 0x7d, 0xf1, 0x0f, 0x2d, 0x22,
-	0x5a, 0x44, 0x34, 0x0c, 0xb3, 0x8d, 0x63, 0x7c, 0xf8, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xef, 0x32,
-	0xf2, 0x1f, 0x60, 0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("peer/peer.proto", fileDescriptor_peer_5ef9ed8cc76cf3fe) }
+
+var fileDescriptor_peer_5ef9ed8cc76cf3fe = []byte{
+	// 307 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcd, 0x4e, 0x32, 0x31,
+	0x14, 0x86, 0x19, 0xbe, 0x2f, 0x28, 0x07, 0x23, 0x5a, 0x13, 0x1d, 0x27, 0xc4, 0x90, 0xae, 0x70,
+	0x33, 0x93, 0xa0, 0xf1, 0x02, 0x8c, 0x44, 0x8c, 0x0b, 0xc9, 0xb8, 0x73, 0x63, 0x06, 0x7a, 0x1c,
+	0x9a, 0x40, 0xdb, 0x9c, 0x83, 0x0b, 0xaf, 0xc5, 0x9b, 0x35, 0x4c, 0x29, 0x08, 0x91, 0xcd, 0xfc,
+	0xf4, 0x7d, 0xce, 0x93, 0xb7, 0x69, 0xa1, 0xed, 0x10, 0x29, 0x5b, 0x3e, 0x52, 0x47, 0x76, 0x61,
+	0x45, 0xa3, 0x7a, 0x71, 0x72, 0xe6, 0x03, 0xb2, 0xce, 0x72, 0x31, 0xf3, 0x61, 0xd2, 0xd9, 0x5a,
+	0x7c, 0x27, 0x64, 0x67, 0x0d, 0xa3, 0x4f, 0x65, 0x07, 0x1a, 0x23, 0x44, 0x7a, 0x7a, 0x10, 0x02,
+	0xfe, 0x9b, 0x62, 0x8e, 0x71, 0xd4, 0x8d, 0x7a, 0xcd, 0xbc, 0xfa, 0x96, 0x43, 0x38, 0x5a, 0xa6,
+	0x03, 0xa3, 0x9c, 0xd5, 0x66, 0x21, 0xae, 0xa0, 0xae, 0x55, 0x45, 0xb4, 0xfa, 0xc7, 0xde, 0xc0,
+	0xa9, 0x9f, 0xcf, 0xeb, 0x5a, 0x89, 0x18, 0x0e, 0x0a, 0xa5, 0x08, 0x99, 0xe3, 0x7a, 0xa5, 0x09,
+	0xbf, 0xf2, 0x11, 0xda, 0xaf, 0xba, 0x34, 0xa8, 0x46, 0xab, 0x22, 0x2c, 0x6e, 0xa1, 0x19, 0x5a,
+	0x71, 0x1c, 0x75, 0xff, 0xf5, 0x5a, 0xfd, 0xf3, 0xe0, 0xdc, 0x66, 0xf3, 0x0d, 0x28, 0x9f, 0xe1,
+	0x74, 0xbd, 0xbc, 0xda, 0x0a, 0x8b, 0x3b, 0x68, 0x86, 0x7d, 0x05, 0x55, 0xbc, 0xae, 0xb7, 0x43,
+	0xe7, 0x1b, 0xb4, 0xff, 0x1d, 0xc1, 0xe1, 0xc0, 0x28, 0x4b, 0x8c, 0x24, 0x06, 0xd0, 0x1e, 0x91,
+	0x9d, 0x20, 0x73, 0x18, 0x11, 0x7b, 0xfa, 0x24, 0x7b, 0xe5, 0xb2, 0x26, 0x86, 0x70, 0xb2, 0xa3,
+	0x61, 0x71, 0xf1, 0xb7, 0x87, 0x93, 0xcb, 0x7d, 0x22, 0x96, 0xb5, 0xfb, 0x17, 0x90, 0x96, 0xca,
+	0x74, 0xfa, 0xe5, 0x90, 0x66, 0xa8, 0x4a, 0xa4, 0xf4, 0xa3, 0x18, 0x93, 0x9e, 0x84, 0xa1, 0xe5,
+	0xc9, 0xbe, 0x5d, 0x97, 0x7a, 0x31, 0xfd, 0x1c, 0xa7, 0x13, 0x3b, 0xcf, 0x7e, 0xa1, 0x99, 0x47,
+	0x33, 0x8f, 0x56, 0xb7, 0x65, 0xec, 0xef, 0xc9, 0xcd, 0xcf, 0x00, 0x2a, 0x5d, 0x10, 0x73, 0x41,
+	0x02, 0x00, 0x00,
 }
@@ -6,6 +6,7 @@ package peer // import "github.com/hyperledger/fabric/protos/peer"
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import common "github.com/hyperledger/fabric/protos/common"
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -33,7 +34,7 @@ func (m *ChaincodeQueryResponse) Reset()         { *m = ChaincodeQueryResponse{}
 func (m *ChaincodeQueryResponse) String() string { return proto.CompactTextString(m) }
 func (*ChaincodeQueryResponse) ProtoMessage()    {}
 func (*ChaincodeQueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_query_37e5d407118e73f5, []int{0}
+	return fileDescriptor_query_f77b9e5ff906d705, []int{0}
 }
 func (m *ChaincodeQueryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChaincodeQueryResponse.Unmarshal(m, b)
@@ -91,7 +92,7 @@ func (m *ChaincodeInfo) Reset()         { *m = ChaincodeInfo{} }
 func (m *ChaincodeInfo) String() string { return proto.CompactTextString(m) }
 func (*ChaincodeInfo) ProtoMessage()    {}
 func (*ChaincodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_query_37e5d407118e73f5, []int{1}
+	return fileDescriptor_query_f77b9e5ff906d705, []int{1}
 }
 func (m *ChaincodeInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChaincodeInfo.Unmarshal(m, b)
@@ -174,7 +175,7 @@ func (m *ChannelQueryResponse) Reset()         { *m = ChannelQueryResponse{} }
 func (m *ChannelQueryResponse) String() string { return proto.CompactTextString(m) }
 func (*ChannelQueryResponse) ProtoMessage()    {}
 func (*ChannelQueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_query_37e5d407118e73f5, []int{2}
+	return fileDescriptor_query_f77b9e5ff906d705, []int{2}
 }
 func (m *ChannelQueryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelQueryResponse.Unmarshal(m, b)
@@ -213,7 +214,7 @@ func (m *ChannelInfo) Reset()         { *m = ChannelInfo{} }
 func (m *ChannelInfo) String() string { return proto.CompactTextString(m) }
 func (*ChannelInfo) ProtoMessage()    {}
 func (*ChannelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_query_37e5d407118e73f5, []int{3}
+	return fileDescriptor_query_f77b9e5ff906d705, []int{3}
 }
 func (m *ChannelInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelInfo.Unmarshal(m, b)
@@ -240,34 +241,268 @@ func (m *ChannelInfo) GetChannelId() string {
 	return ""
 }
 
+// ChannelConfigInfo contains the basic facts about the current configuration
+// of a channel, as returned by GetChannelConfigInfo in cscc.go, so that
+// clients do not have to fetch and parse the config block for them
+type ChannelConfigInfo struct {
+	ChannelId                string              `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence                 uint64              `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	ChannelCapabilities      []string            `protobuf:"bytes,3,rep,name=channel_capabilities,json=channelCapabilities,proto3" json:"channel_capabilities,omitempty"`
+	ApplicationCapabilities  []string            `protobuf:"bytes,4,rep,name=application_capabilities,json=applicationCapabilities,proto3" json:"application_capabilities,omitempty"`
+	OrdererCapabilities      []string            `protobuf:"bytes,5,rep,name=orderer_capabilities,json=ordererCapabilities,proto3" json:"orderer_capabilities,omitempty"`
+	ApplicationOrganizations []*OrganizationInfo `protobuf:"bytes,6,rep,name=application_organizations,json=applicationOrganizations,proto3" json:"application_organizations,omitempty"`
+	OrdererOrganizations     []*OrganizationInfo `protobuf:"bytes,7,rep,name=orderer_organizations,json=ordererOrganizations,proto3" json:"orderer_organizations,omitempty"`
+	OrdererAddresses         []string            `protobuf:"bytes,8,rep,name=orderer_addresses,json=ordererAddresses,proto3" json:"orderer_addresses,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}            `json:"-"`
+	XXX_unrecognized         []byte              `json:"-"`
+	XXX_sizecache            int32               `json:"-"`
+}
+
+func (m *ChannelConfigInfo) Reset()         { *m = ChannelConfigInfo{} }
+func (m *ChannelConfigInfo) String() string { return proto.CompactTextString(m) }
+func (*ChannelConfigInfo) ProtoMessage()    {}
+func (*ChannelConfigInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_query_f77b9e5ff906d705, []int{4}
+}
+func (m *ChannelConfigInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelConfigInfo.Unmarshal(m, b)
+}
+func (m *ChannelConfigInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelConfigInfo.Marshal(b, m, deterministic)
+}
+func (dst *ChannelConfigInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelConfigInfo.Merge(dst, src)
+}
+func (m *ChannelConfigInfo) XXX_Size() int {
+	return xxx_messageInfo_ChannelConfigInfo.Size(m)
+}
+func (m *ChannelConfigInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelConfigInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelConfigInfo proto.InternalMessageInfo
+
+func (m *ChannelConfigInfo) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelConfigInfo) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ChannelConfigInfo) GetChannelCapabilities() []string {
+	if m != nil {
+		return m.ChannelCapabilities
+	}
+	return nil
+}
+
+func (m *ChannelConfigInfo) GetApplicationCapabilities() []string {
+	if m != nil {
+		return m.ApplicationCapabilities
+	}
+	return nil
+}
+
+func (m *ChannelConfigInfo) GetOrdererCapabilities() []string {
+	if m != nil {
+		return m.OrdererCapabilities
+	}
+	return nil
+}
+
+func (m *ChannelConfigInfo) GetApplicationOrganizations() []*OrganizationInfo {
+	if m != nil {
+		return m.ApplicationOrganizations
+	}
+	return nil
+}
+
+func (m *ChannelConfigInfo) GetOrdererOrganizations() []*OrganizationInfo {
+	if m != nil {
+		return m.OrdererOrganizations
+	}
+	return nil
+}
+
+func (m *ChannelConfigInfo) GetOrdererAddresses() []string {
+	if m != nil {
+		return m.OrdererAddresses
+	}
+	return nil
+}
+
+// OrganizationInfo contains the MSP ID and, for application organizations,
+// the anchor peers of an organization in a channel
+type OrganizationInfo struct {
+	Name                 string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MspId                string        `protobuf:"bytes,2,opt,name=msp_id,json=mspId,proto3" json:"msp_id,omitempty"`
+	AnchorPeers          []*AnchorPeer `protobuf:"bytes,3,rep,name=anchor_peers,json=anchorPeers,proto3" json:"anchor_peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *OrganizationInfo) Reset()         { *m = OrganizationInfo{} }
+func (m *OrganizationInfo) String() string { return proto.CompactTextString(m) }
+func (*OrganizationInfo) ProtoMessage()    {}
+func (*OrganizationInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_query_f77b9e5ff906d705, []int{5}
+}
+func (m *OrganizationInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrganizationInfo.Unmarshal(m, b)
+}
+func (m *OrganizationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrganizationInfo.Marshal(b, m, deterministic)
+}
+func (dst *OrganizationInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrganizationInfo.Merge(dst, src)
+}
+func (m *OrganizationInfo) XXX_Size() int {
+	return xxx_messageInfo_OrganizationInfo.Size(m)
+}
+func (m *OrganizationInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrganizationInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrganizationInfo proto.InternalMessageInfo
+
+func (m *OrganizationInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *OrganizationInfo) GetMspId() string {
+	if m != nil {
+		return m.MspId
+	}
+	return ""
+}
+
+func (m *OrganizationInfo) GetAnchorPeers() []*AnchorPeer {
+	if m != nil {
+		return m.AnchorPeers
+	}
+	return nil
+}
+
+// BlockRangeResponse returns a page of the blocks in a range of block numbers,
+// as returned by GetBlocksByRange in qscc.go
+type BlockRangeResponse struct {
+	Blocks []*common.Block `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	// has_more is set when there are blocks in the range after this page
+	HasMore bool `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	// next_block_number is the number of the block following the last block
+	// of this page, from which the next page, if any, starts
+	NextBlockNumber      uint64   `protobuf:"varint,3,opt,name=next_block_number,json=nextBlockNumber,proto3" json:"next_block_number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockRangeResponse) Reset()         { *m = BlockRangeResponse{} }
+func (m *BlockRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*BlockRangeResponse) ProtoMessage()    {}
+func (*BlockRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_query_f77b9e5ff906d705, []int{6}
+}
+func (m *BlockRangeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockRangeResponse.Unmarshal(m, b)
+}
+func (m *BlockRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockRangeResponse.Marshal(b, m, deterministic)
+}
+func (dst *BlockRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockRangeResponse.Merge(dst, src)
+}
+func (m *BlockRangeResponse) XXX_Size() int {
+	return xxx_messageInfo_BlockRangeResponse.Size(m)
+}
+func (m *BlockRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockRangeResponse proto.InternalMessageInfo
+
+func (m *BlockRangeResponse) GetBlocks() []*common.Block {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+func (m *BlockRangeResponse) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
+func (m *BlockRangeResponse) GetNextBlockNumber() uint64 {
+	if m != nil {
+		return m.NextBlockNumber
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ChaincodeQueryResponse)(nil), "protos.ChaincodeQueryResponse")
 	proto.RegisterType((*ChaincodeInfo)(nil), "protos.ChaincodeInfo")
 	proto.RegisterType((*ChannelQueryResponse)(nil), "protos.ChannelQueryResponse")
 	proto.RegisterType((*ChannelInfo)(nil), "protos.ChannelInfo")
+	proto.RegisterType((*ChannelConfigInfo)(nil), "protos.ChannelConfigInfo")
+	proto.RegisterType((*OrganizationInfo)(nil), "protos.OrganizationInfo")
+	proto.RegisterType((*BlockRangeResponse)(nil), "protos.BlockRangeResponse")
 }
 
-func init() { proto.RegisterFile("peer/query.proto", fileDescriptor_query_37e5d407118e73f5) }
-
-var fileDescriptor_query_37e5d407118e73f5 = []byte{
-	// 296 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xdf, 0x4a, 0xc3, 0x30,
-	0x14, 0xc6, 0xe9, 0xfe, 0xba, 0x33, 0x15, 0x89, 0x53, 0x72, 0x23, 0x8c, 0x5e, 0x4d, 0x90, 0x16,
-	0x14, 0x5f, 0xc0, 0x5d, 0xc8, 0xae, 0x86, 0xbd, 0xf4, 0x46, 0xba, 0xe4, 0x6c, 0x0d, 0x6c, 0x49,
-	0x4c, 0xba, 0xc1, 0x9e, 0xc6, 0x57, 0x95, 0x93, 0xac, 0xa3, 0xbb, 0xea, 0x39, 0xbf, 0xef, 0x17,
-	0xca, 0x97, 0xc0, 0x9d, 0x45, 0x74, 0xf9, 0xef, 0x1e, 0xdd, 0x31, 0xb3, 0xce, 0xd4, 0x86, 0x0d,
-	0xc2, 0xc7, 0xa7, 0x4b, 0x78, 0x9c, 0x57, 0xa5, 0xd2, 0xc2, 0x48, 0xfc, 0xa2, 0xbc, 0x40, 0x6f,
-	0x8d, 0xf6, 0xc8, 0xde, 0x01, 0x44, 0x93, 0x78, 0x9e, 0x4c, 0xbb, 0xb3, 0xf1, 0xeb, 0x43, 0x3c,
-	0xed, 0xb3, 0xf3, 0x99, 0x85, 0x5e, 0x9b, 0xa2, 0x25, 0xa6, 0x7f, 0x09, 0xdc, 0x5c, 0xa4, 0x8c,
-	0x41, 0x4f, 0x97, 0x3b, 0xe4, 0xc9, 0x34, 0x99, 0x8d, 0x8a, 0x30, 0x33, 0x0e, 0xc3, 0x03, 0x3a,
-	0xaf, 0x8c, 0xe6, 0x9d, 0x80, 0x9b, 0x95, 0x6c, 0x5b, 0xd6, 0x15, 0xef, 0x46, 0x9b, 0x66, 0x36,
-	0x81, 0xbe, 0xd2, 0x76, 0x5f, 0xf3, 0x5e, 0x80, 0x71, 0x21, 0x13, 0xbd, 0x10, 0xbc, 0x1f, 0x4d,
-	0x9a, 0x89, 0x1d, 0x88, 0x0d, 0x22, 0xa3, 0x99, 0xdd, 0x42, 0x47, 0x49, 0x3e, 0x9c, 0x26, 0xb3,
-	0xeb, 0xa2, 0xa3, 0x64, 0xfa, 0x09, 0x93, 0x79, 0x55, 0x6a, 0x8d, 0xdb, 0xcb, 0xc2, 0x39, 0x5c,
-	0x89, 0xc8, 0x9b, 0xba, 0xf7, 0xad, 0xba, 0xc4, 0x43, 0xd9, 0xb3, 0x94, 0xbe, 0xc0, 0xb8, 0x15,
-	0xb0, 0xa7, 0x70, 0x61, 0xb4, 0xfe, 0x28, 0x79, 0x6a, 0x3b, 0x3a, 0x91, 0x85, 0xfc, 0x58, 0x42,
-	0x6a, 0xdc, 0x26, 0xab, 0x8e, 0x16, 0xdd, 0x16, 0xe5, 0x06, 0x5d, 0xb6, 0x2e, 0x57, 0x4e, 0x89,
-	0xe6, 0x27, 0xf4, 0x46, 0xdf, 0xcf, 0x1b, 0x55, 0x57, 0xfb, 0x55, 0x26, 0xcc, 0x2e, 0x6f, 0xa9,
-	0x79, 0x54, 0xf3, 0xa8, 0xe6, 0xa4, 0xae, 0xe2, 0x13, 0xbe, 0xfd, 0x07, 0x00, 0x00, 0xff, 0xff,
-	0x79, 0x94, 0xb3, 0xbd, 0xdd, 0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("peer/query.proto", fileDescriptor_query_f77b9e5ff906d705) }
+
+var fileDescriptor_query_f77b9e5ff906d705 = []byte{
+	// 595 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x6f, 0xd4, 0x3c,
+	0x10, 0xd6, 0x76, 0x3f, 0x3b, 0xdb, 0xbe, 0x6f, 0xeb, 0x6e, 0xc1, 0xad, 0x84, 0xb4, 0x8a, 0x84,
+	0xb4, 0x7c, 0x68, 0xa3, 0x16, 0xf5, 0xc0, 0xb1, 0xdd, 0x03, 0xea, 0xa1, 0x14, 0x22, 0x71, 0xe1,
+	0xb2, 0xf2, 0x3a, 0xd3, 0x8d, 0xc5, 0xc6, 0x4e, 0xed, 0xa4, 0xa2, 0x1c, 0xf9, 0x23, 0xfc, 0x17,
+	0x7e, 0x19, 0xb2, 0x1d, 0xaf, 0x12, 0x84, 0x04, 0xa7, 0xcc, 0x3c, 0xcf, 0x33, 0xe3, 0x99, 0xf1,
+	0x38, 0x70, 0x50, 0x20, 0xea, 0xf8, 0xbe, 0x42, 0xfd, 0x38, 0x2f, 0xb4, 0x2a, 0x15, 0x19, 0xb8,
+	0x8f, 0x39, 0x3d, 0xe2, 0x2a, 0xcf, 0x95, 0x8c, 0xfd, 0xc7, 0x93, 0xa7, 0xd4, 0xc9, 0xb9, 0x92,
+	0x77, 0x62, 0x5d, 0x69, 0x56, 0x8a, 0xc0, 0x44, 0xb7, 0xf0, 0x64, 0x91, 0x31, 0x21, 0xb9, 0x4a,
+	0xf1, 0xa3, 0x4d, 0x97, 0xa0, 0x29, 0x94, 0x34, 0x48, 0x2e, 0x00, 0x78, 0x60, 0x0c, 0xed, 0x4c,
+	0xbb, 0xb3, 0xf1, 0xf9, 0xb1, 0x8f, 0x32, 0xf3, 0x6d, 0xcc, 0xb5, 0xbc, 0x53, 0x49, 0x43, 0x18,
+	0xfd, 0xe8, 0xc0, 0x7e, 0x8b, 0x25, 0x04, 0x7a, 0x92, 0xe5, 0x48, 0x3b, 0xd3, 0xce, 0x6c, 0x37,
+	0x71, 0x36, 0xa1, 0x30, 0x7c, 0x40, 0x6d, 0x84, 0x92, 0x74, 0xc7, 0xc1, 0xc1, 0xb5, 0xea, 0x82,
+	0x95, 0x19, 0xed, 0x7a, 0xb5, 0xb5, 0xc9, 0x04, 0xfa, 0x42, 0x16, 0x55, 0x49, 0x7b, 0x0e, 0xf4,
+	0x8e, 0x55, 0xa2, 0xe1, 0x9c, 0xf6, 0xbd, 0xd2, 0xda, 0x16, 0x7b, 0xb0, 0xd8, 0xc0, 0x63, 0xd6,
+	0x26, 0xff, 0xc1, 0x8e, 0x48, 0xe9, 0x70, 0xda, 0x99, 0xed, 0x25, 0x3b, 0x22, 0x8d, 0xde, 0xc1,
+	0x64, 0x91, 0x31, 0x29, 0x71, 0xd3, 0x6e, 0x38, 0x86, 0x11, 0xf7, 0x78, 0x68, 0xf7, 0xa8, 0xd1,
+	0xae, 0xc5, 0x5d, 0xb3, 0x5b, 0x51, 0xf4, 0x1a, 0xc6, 0x0d, 0x82, 0x3c, 0x73, 0x03, 0xb3, 0xee,
+	0x52, 0xa4, 0x75, 0xb7, 0xbb, 0x35, 0x72, 0x9d, 0x46, 0x3f, 0xbb, 0x70, 0x58, 0xcb, 0x17, 0xee,
+	0x22, 0xfe, 0x21, 0x88, 0x9c, 0xc2, 0xc8, 0xe0, 0x7d, 0x85, 0x92, 0xa3, 0x1b, 0x54, 0x2f, 0xd9,
+	0xfa, 0xe4, 0x0c, 0x26, 0x21, 0x94, 0xb3, 0x82, 0xad, 0xc4, 0x46, 0x94, 0x02, 0x0d, 0xed, 0x4e,
+	0xbb, 0xb3, 0xdd, 0xe4, 0xa8, 0xe6, 0x16, 0x0d, 0x8a, 0xbc, 0x05, 0xca, 0x8a, 0x62, 0x23, 0xb8,
+	0x5b, 0x81, 0x76, 0x58, 0xcf, 0x85, 0x3d, 0x6d, 0xf0, 0xad, 0xd0, 0x33, 0x98, 0x28, 0x9d, 0xa2,
+	0x46, 0xdd, 0x0e, 0xeb, 0xfb, 0xd3, 0x6a, 0xae, 0x15, 0xf2, 0x09, 0x4e, 0x9a, 0xa7, 0x29, 0xbd,
+	0x66, 0x52, 0x7c, 0x73, 0x8e, 0xa1, 0x03, 0x37, 0x61, 0x1a, 0x26, 0x7c, 0xdb, 0x20, 0xdd, 0x98,
+	0x9b, 0x85, 0x36, 0x49, 0x43, 0x6e, 0xe0, 0x38, 0x54, 0xd2, 0x4e, 0x39, 0xfc, 0x4b, 0xca, 0xd0,
+	0x40, 0x3b, 0xdd, 0x2b, 0x38, 0x0c, 0xe9, 0x58, 0x9a, 0x6a, 0x34, 0x06, 0x0d, 0x1d, 0xb9, 0xae,
+	0x0e, 0x6a, 0xe2, 0x32, 0xe0, 0x51, 0x09, 0x07, 0xbf, 0xa7, 0xfd, 0xe3, 0x7e, 0x1f, 0xc3, 0x20,
+	0x37, 0x85, 0xbd, 0x52, 0xbf, 0xde, 0xfd, 0xdc, 0x14, 0xd7, 0x29, 0xb9, 0x80, 0x3d, 0x26, 0x79,
+	0xa6, 0xf4, 0xd2, 0x3e, 0x48, 0x7f, 0x55, 0xe3, 0x73, 0x12, 0x2a, 0xbe, 0x74, 0xdc, 0x07, 0x44,
+	0x9d, 0x8c, 0xd9, 0xd6, 0x36, 0xd1, 0xf7, 0x0e, 0x90, 0xab, 0x8d, 0xe2, 0x5f, 0x12, 0x26, 0xd7,
+	0xb8, 0x5d, 0xd8, 0xe7, 0x30, 0x58, 0x59, 0x34, 0xac, 0xeb, 0xfe, 0xbc, 0x7e, 0xf4, 0x5e, 0x5b,
+	0x93, 0xe4, 0x04, 0x46, 0x19, 0x33, 0xcb, 0x5c, 0x69, 0xbf, 0x43, 0xa3, 0x64, 0x98, 0x31, 0x73,
+	0xa3, 0x34, 0x92, 0x97, 0x70, 0x28, 0xf1, 0x6b, 0xb9, 0x74, 0xca, 0xa5, 0xac, 0xf2, 0x15, 0x6a,
+	0xf7, 0xf2, 0x7a, 0xc9, 0xff, 0x96, 0x70, 0x89, 0xde, 0x3b, 0xf8, 0xea, 0x16, 0x22, 0xa5, 0xd7,
+	0xf3, 0xec, 0xb1, 0x40, 0xbd, 0xc1, 0x74, 0x8d, 0x7a, 0x7e, 0xc7, 0x56, 0x5a, 0xf0, 0x50, 0xbd,
+	0x6d, 0xe9, 0xf3, 0x8b, 0xb5, 0x28, 0xb3, 0x6a, 0x65, 0x2b, 0x89, 0x1b, 0xd2, 0xd8, 0x4b, 0x63,
+	0x2f, 0x8d, 0xad, 0x74, 0xe5, 0xff, 0x58, 0x6f, 0x7e, 0x0d, 0x00, 0xfe, 0x43, 0x9d, 0x9a, 0xcc,
+	0x04, 0x00, 0x00,
 }
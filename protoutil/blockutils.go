@@ -12,6 +12,7 @@ import (
 	"math"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/hyperledger/fabric/common/util"
 	cb "github.com/hyperledger/fabric/protos/common"
 	"github.com/pkg/errors"
@@ -104,6 +105,33 @@ func GetChainIDFromBlock(block *cb.Block) (string, error) {
 	return chdr.ChannelId, nil
 }
 
+// GetTimestampFromBlock returns the timestamp of the block, which is the
+// timestamp of the channel header of its first transaction
+func GetTimestampFromBlock(block *cb.Block) (*timestamp.Timestamp, error) {
+	if block == nil || block.Data == nil || len(block.Data.Data) == 0 {
+		return nil, errors.Errorf("failed to retrieve timestamp - block is empty")
+	}
+	envelope, err := GetEnvelopeFromBlock(block.Data.Data[0])
+	if err != nil {
+		return nil, err
+	}
+	payload, err := GetPayload(envelope)
+	if err != nil {
+		return nil, err
+	}
+	if payload.Header == nil {
+		return nil, errors.Errorf("failed to retrieve timestamp - payload header is empty")
+	}
+	chdr, err := UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	if err != nil {
+		return nil, err
+	}
+	if chdr.Timestamp == nil {
+		return nil, errors.Errorf("failed to retrieve timestamp - channel header has no timestamp")
+	}
+	return chdr.Timestamp, nil
+}
+
 // GetMetadataFromBlock retrieves metadata at the specified index.
 func GetMetadataFromBlock(block *cb.Block, index cb.BlockMetadataIndex) (*cb.Metadata, error) {
 	md := &cb.Metadata{}
@@ -12,6 +12,7 @@ import (
 	"testing"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	configtxtest "github.com/hyperledger/fabric/common/configtx/test"
 	"github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/protos/common"
@@ -165,6 +166,33 @@ func TestGetChainIDFromBlock(t *testing.T) {
 	assert.Error(t, err, "Expected error when payload header is nil")
 }
 
+func TestGetTimestampFromBlock(t *testing.T) {
+	_, err := protoutil.GetTimestampFromBlock(nil)
+	assert.EqualError(t, err, "failed to retrieve timestamp - block is empty")
+
+	ts := &timestamp.Timestamp{Seconds: 1000, Nanos: 500}
+	makeBlock := func(chdr *cb.ChannelHeader) *cb.Block {
+		payload, _ := proto.Marshal(&cb.Payload{
+			Header: &cb.Header{ChannelHeader: protoutil.MarshalOrPanic(chdr)},
+		})
+		env, _ := proto.Marshal(&cb.Envelope{Payload: payload})
+		return &cb.Block{Data: &cb.BlockData{Data: [][]byte{env}}}
+	}
+
+	actual, err := protoutil.GetTimestampFromBlock(makeBlock(&cb.ChannelHeader{Timestamp: ts}))
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(ts, actual))
+
+	_, err = protoutil.GetTimestampFromBlock(makeBlock(&cb.ChannelHeader{ChannelId: testChainID}))
+	assert.EqualError(t, err, "failed to retrieve timestamp - channel header has no timestamp")
+
+	// nil payload header
+	payload, _ := proto.Marshal(&cb.Payload{})
+	env, _ := proto.Marshal(&cb.Envelope{Payload: payload})
+	_, err = protoutil.GetTimestampFromBlock(&cb.Block{Data: &cb.BlockData{Data: [][]byte{env}}})
+	assert.EqualError(t, err, "failed to retrieve timestamp - payload header is empty")
+}
+
 func TestGetBlockFromBlockBytes(t *testing.T) {
 	testChainID := "myuniquetestchainid"
 	gb, err := configtxtest.MakeGenesisBlock(testChainID)
@@ -0,0 +1,255 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package protoutil
+
+import (
+	"bytes"
+
+	"github.com/hyperledger/fabric/common/crypto"
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+)
+
+// Signer signs messages on behalf of an identity, as msp.SigningIdentity
+// does.
+type Signer interface {
+	// Sign signs the message
+	Sign(msg []byte) ([]byte, error)
+
+	// Serialize returns the serialized identity of the signer
+	Serialize() ([]byte, error)
+}
+
+// ChaincodeProposal describes the invocation of a chaincode to be endorsed.
+type ChaincodeProposal struct {
+	// ChannelID is the channel of the chaincode
+	ChannelID string
+
+	// ChaincodeName and ChaincodeVersion identify the chaincode; the version
+	// may be left empty
+	ChaincodeName    string
+	ChaincodeVersion string
+
+	// ChaincodeType is the language of the chaincode, GOLANG when unset
+	ChaincodeType peer.ChaincodeSpec_Type
+
+	// Args are the arguments of the invocation, the function name first
+	Args [][]byte
+
+	// TransientMap holds the data which is not recorded in the transaction
+	TransientMap map[string][]byte
+
+	// Nonce is the nonce of the proposal, generated when unset
+	Nonce []byte
+
+	// TxID is the ID of the transaction, computed from the nonce and the
+	// creator when unset
+	TxID string
+}
+
+// CreateSignedChaincodeProposal builds the proposal described by cp, created
+// and signed by the signer.  It returns the signed proposal, the proposal,
+// which is needed to assemble the transaction, and the transaction ID.
+func CreateSignedChaincodeProposal(cp *ChaincodeProposal, signer Signer) (*peer.SignedProposal, *peer.Proposal, string, error) {
+	if cp == nil || signer == nil {
+		return nil, nil, "", errors.New("nil arguments")
+	}
+	if cp.ChaincodeName == "" {
+		return nil, nil, "", errors.New("chaincode name must be set")
+	}
+
+	creator, err := signer.Serialize()
+	if err != nil {
+		return nil, nil, "", errors.WithMessage(err, "error serializing signer")
+	}
+
+	nonce := cp.Nonce
+	if len(nonce) == 0 {
+		nonce, err = crypto.GetRandomNonce()
+		if err != nil {
+			return nil, nil, "", errors.WithMessage(err, "error generating nonce")
+		}
+	}
+
+	txid := cp.TxID
+	if txid == "" {
+		txid, err = ComputeTxID(nonce, creator)
+		if err != nil {
+			return nil, nil, "", err
+		}
+	}
+
+	ccType := cp.ChaincodeType
+	if ccType == peer.ChaincodeSpec_UNDEFINED {
+		ccType = peer.ChaincodeSpec_GOLANG
+	}
+	cis := &peer.ChaincodeInvocationSpec{
+		ChaincodeSpec: &peer.ChaincodeSpec{
+			Type:        ccType,
+			ChaincodeId: &peer.ChaincodeID{Name: cp.ChaincodeName, Version: cp.ChaincodeVersion},
+			Input:       &peer.ChaincodeInput{Args: cp.Args},
+		},
+	}
+
+	prop, _, err := CreateChaincodeProposalWithTxIDNonceAndTransient(txid, common.HeaderType_ENDORSER_TRANSACTION, cp.ChannelID, cis, nonce, creator, cp.TransientMap)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	signedProp, err := signProposal(prop, signer)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	return signedProp, prop, txid, nil
+}
+
+func signProposal(prop *peer.Proposal, signer Signer) (*peer.SignedProposal, error) {
+	propBytes, err := GetBytesProposal(prop)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := signer.Sign(propBytes)
+	if err != nil {
+		return nil, errors.WithMessage(err, "error signing proposal")
+	}
+
+	return &peer.SignedProposal{ProposalBytes: propBytes, Signature: signature}, nil
+}
+
+// CreateSignedEndorserTransaction assembles the transaction of the proposal
+// from its endorsements and signs it.  The signer must be the creator of the
+// proposal.
+func CreateSignedEndorserTransaction(proposal *peer.Proposal, signer Signer, resps ...*peer.ProposalResponse) (*common.Envelope, error) {
+	if proposal == nil || signer == nil {
+		return nil, errors.New("nil arguments")
+	}
+
+	hdr, err := GetHeader(proposal.Header)
+	if err != nil {
+		return nil, err
+	}
+	shdr, err := GetSignatureHeader(hdr.SignatureHeader)
+	if err != nil {
+		return nil, err
+	}
+	creator, err := signer.Serialize()
+	if err != nil {
+		return nil, errors.WithMessage(err, "error serializing signer")
+	}
+	if !bytes.Equal(creator, shdr.Creator) {
+		return nil, errors.New("signer must be the same as the one referenced in the header")
+	}
+
+	env, err := CreateUnsignedTx(proposal, resps...)
+	if err != nil {
+		return nil, err
+	}
+
+	env.Signature, err = signer.Sign(env.Payload)
+	if err != nil {
+		return nil, errors.WithMessage(err, "error signing transaction")
+	}
+
+	return env, nil
+}
+
+// SignConfigUpdateEnvelope adds the signature of the signer to the config
+// update envelope, as each of the admins whose signatures are required by the
+// modification policies of the update must do.
+func SignConfigUpdateEnvelope(configUpdateEnv *common.ConfigUpdateEnvelope, signer Signer) error {
+	if configUpdateEnv == nil || signer == nil {
+		return errors.New("nil arguments")
+	}
+
+	sigHeader, err := newSignatureHeader(signer)
+	if err != nil {
+		return err
+	}
+
+	configSig := &common.ConfigSignature{
+		SignatureHeader: MarshalOrPanic(sigHeader),
+	}
+	configSig.Signature, err = signer.Sign(util.ConcatenateBytes(configSig.SignatureHeader, configUpdateEnv.ConfigUpdate))
+	if err != nil {
+		return errors.WithMessage(err, "error signing config update")
+	}
+
+	configUpdateEnv.Signatures = append(configUpdateEnv.Signatures, configSig)
+	return nil
+}
+
+// CreateConfigUpdateEnvelope wraps the config update of the channel into an
+// envelope ready to be submitted to the ordering service.  The config update
+// is signed by each of the signers, and the envelope by the first of them.
+// Without signers, the envelope is left unsigned, so that the signatures can
+// be added later, for example with the peer channel signconfigtx command.
+func CreateConfigUpdateEnvelope(channelID string, configUpdate *common.ConfigUpdate, signers ...Signer) (*common.Envelope, error) {
+	if configUpdate == nil {
+		return nil, errors.New("nil config update")
+	}
+	if configUpdate.ChannelId == "" {
+		configUpdate.ChannelId = channelID
+	}
+	if configUpdate.ChannelId != channelID {
+		return nil, errors.Errorf("config update is for channel %s, not %s", configUpdate.ChannelId, channelID)
+	}
+
+	configUpdateEnv := &common.ConfigUpdateEnvelope{
+		ConfigUpdate: MarshalOrPanic(configUpdate),
+	}
+	for _, signer := range signers {
+		if err := SignConfigUpdateEnvelope(configUpdateEnv, signer); err != nil {
+			return nil, err
+		}
+	}
+
+	chdr := MakeChannelHeader(common.HeaderType_CONFIG_UPDATE, 0, channelID, 0)
+	shdr := &common.SignatureHeader{}
+	if len(signers) > 0 {
+		var err error
+		shdr, err = newSignatureHeader(signers[0])
+		if err != nil {
+			return nil, err
+		}
+		if err := SetTxID(chdr, shdr); err != nil {
+			return nil, err
+		}
+	}
+
+	payloadBytes := MarshalOrPanic(&common.Payload{
+		Header: MakePayloadHeader(chdr, shdr),
+		Data:   MarshalOrPanic(configUpdateEnv),
+	})
+
+	env := &common.Envelope{Payload: payloadBytes}
+	if len(signers) > 0 {
+		var err error
+		env.Signature, err = signers[0].Sign(payloadBytes)
+		if err != nil {
+			return nil, errors.WithMessage(err, "error signing envelope")
+		}
+	}
+
+	return env, nil
+}
+
+func newSignatureHeader(signer Signer) (*common.SignatureHeader, error) {
+	creator, err := signer.Serialize()
+	if err != nil {
+		return nil, errors.WithMessage(err, "error serializing signer")
+	}
+	nonce, err := crypto.GetRandomNonce()
+	if err != nil {
+		return nil, errors.WithMessage(err, "error generating nonce")
+	}
+
+	return MakeSignatureHeader(creator, nonce), nil
+}
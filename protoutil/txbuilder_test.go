@@ -0,0 +1,189 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package protoutil_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/util"
+	cb "github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSigner struct {
+	identity  []byte
+	signErr   error
+	serialErr error
+}
+
+func (m *mockSigner) Sign(msg []byte) ([]byte, error) {
+	if m.signErr != nil {
+		return nil, m.signErr
+	}
+	return util.ConcatenateBytes(m.identity, msg), nil
+}
+
+func (m *mockSigner) Serialize() ([]byte, error) {
+	return m.identity, m.serialErr
+}
+
+func TestCreateSignedChaincodeProposal(t *testing.T) {
+	signer := &mockSigner{identity: []byte("creator")}
+	cp := &protoutil.ChaincodeProposal{
+		ChannelID:     "mychannel",
+		ChaincodeName: "mycc",
+		Args:          [][]byte{[]byte("invoke"), []byte("a")},
+		TransientMap:  map[string][]byte{"key": []byte("value")},
+	}
+
+	signedProp, prop, txid, err := protoutil.CreateSignedChaincodeProposal(cp, signer)
+	require.NoError(t, err)
+	propBytes, err := proto.Marshal(prop)
+	require.NoError(t, err)
+	assert.Equal(t, propBytes, signedProp.ProposalBytes)
+	assert.Equal(t, util.ConcatenateBytes([]byte("creator"), propBytes), signedProp.Signature)
+
+	hdr, err := protoutil.GetHeader(prop.Header)
+	require.NoError(t, err)
+	chdr, err := protoutil.UnmarshalChannelHeader(hdr.ChannelHeader)
+	require.NoError(t, err)
+	shdr, err := protoutil.GetSignatureHeader(hdr.SignatureHeader)
+	require.NoError(t, err)
+	assert.Equal(t, "mychannel", chdr.ChannelId)
+	assert.Equal(t, txid, chdr.TxId)
+	assert.Equal(t, int32(cb.HeaderType_ENDORSER_TRANSACTION), chdr.Type)
+	assert.Equal(t, []byte("creator"), shdr.Creator)
+	assert.NoError(t, protoutil.CheckTxID(txid, shdr.Nonce, shdr.Creator))
+
+	cis, err := protoutil.GetChaincodeInvocationSpec(prop)
+	require.NoError(t, err)
+	assert.Equal(t, "mycc", cis.ChaincodeSpec.ChaincodeId.Name)
+	assert.Equal(t, pb.ChaincodeSpec_GOLANG, cis.ChaincodeSpec.Type)
+	assert.Equal(t, cp.Args, cis.ChaincodeSpec.Input.Args)
+
+	cpp, err := protoutil.GetChaincodeProposalPayload(prop.Payload)
+	require.NoError(t, err)
+	assert.Equal(t, cp.TransientMap, cpp.TransientMap)
+
+	// the nonce and the transaction ID are kept when set
+	cp.Nonce = []byte("nonce")
+	cp.TxID = "txid"
+	_, prop, txid, err = protoutil.CreateSignedChaincodeProposal(cp, signer)
+	require.NoError(t, err)
+	assert.Equal(t, "txid", txid)
+	hdr, err = protoutil.GetHeader(prop.Header)
+	require.NoError(t, err)
+	shdr, err = protoutil.GetSignatureHeader(hdr.SignatureHeader)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("nonce"), shdr.Nonce)
+
+	_, _, _, err = protoutil.CreateSignedChaincodeProposal(nil, signer)
+	assert.EqualError(t, err, "nil arguments")
+	_, _, _, err = protoutil.CreateSignedChaincodeProposal(&protoutil.ChaincodeProposal{}, signer)
+	assert.EqualError(t, err, "chaincode name must be set")
+	_, _, _, err = protoutil.CreateSignedChaincodeProposal(cp, &mockSigner{serialErr: errors.New("boom")})
+	assert.EqualError(t, err, "error serializing signer: boom")
+	_, _, _, err = protoutil.CreateSignedChaincodeProposal(cp, &mockSigner{signErr: errors.New("boom")})
+	assert.EqualError(t, err, "error signing proposal: boom")
+}
+
+func TestCreateSignedEndorserTransaction(t *testing.T) {
+	signer := &mockSigner{identity: []byte("creator")}
+	_, prop, _, err := protoutil.CreateSignedChaincodeProposal(&protoutil.ChaincodeProposal{
+		ChannelID:     "mychannel",
+		ChaincodeName: "mycc",
+	}, signer)
+	require.NoError(t, err)
+
+	resp := &pb.ProposalResponse{
+		Payload:     []byte("payload"),
+		Endorsement: &pb.Endorsement{Endorser: []byte("endorser"), Signature: []byte("signature")},
+		Response:    &pb.Response{Status: int32(200)},
+	}
+
+	env, err := protoutil.CreateSignedEndorserTransaction(prop, signer, resp)
+	require.NoError(t, err)
+	assert.Equal(t, util.ConcatenateBytes([]byte("creator"), env.Payload), env.Signature)
+
+	unsigned, err := protoutil.CreateUnsignedTx(prop, resp)
+	require.NoError(t, err)
+	assert.Equal(t, unsigned.Payload, env.Payload)
+
+	_, err = protoutil.CreateSignedEndorserTransaction(prop, &mockSigner{identity: []byte("other")}, resp)
+	assert.EqualError(t, err, "signer must be the same as the one referenced in the header")
+	_, err = protoutil.CreateSignedEndorserTransaction(prop, &mockSigner{identity: []byte("creator"), signErr: errors.New("boom")}, resp)
+	assert.EqualError(t, err, "error signing transaction: boom")
+	_, err = protoutil.CreateSignedEndorserTransaction(nil, signer, resp)
+	assert.EqualError(t, err, "nil arguments")
+}
+
+func TestCreateConfigUpdateEnvelope(t *testing.T) {
+	submitter := &mockSigner{identity: []byte("submitter")}
+	admin := &mockSigner{identity: []byte("admin")}
+	configUpdate := &cb.ConfigUpdate{WriteSet: &cb.ConfigGroup{Version: 1}}
+
+	env, err := protoutil.CreateConfigUpdateEnvelope("mychannel", configUpdate, submitter, admin)
+	require.NoError(t, err)
+	assert.Equal(t, "mychannel", configUpdate.ChannelId)
+	assert.Equal(t, util.ConcatenateBytes([]byte("submitter"), env.Payload), env.Signature)
+
+	payload, err := protoutil.UnmarshalPayload(env.Payload)
+	require.NoError(t, err)
+	chdr, err := protoutil.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	require.NoError(t, err)
+	shdr, err := protoutil.GetSignatureHeader(payload.Header.SignatureHeader)
+	require.NoError(t, err)
+	assert.Equal(t, int32(cb.HeaderType_CONFIG_UPDATE), chdr.Type)
+	assert.Equal(t, "mychannel", chdr.ChannelId)
+	assert.Equal(t, []byte("submitter"), shdr.Creator)
+	assert.NoError(t, protoutil.CheckTxID(chdr.TxId, shdr.Nonce, shdr.Creator))
+
+	configUpdateEnv := &cb.ConfigUpdateEnvelope{}
+	require.NoError(t, proto.Unmarshal(payload.Data, configUpdateEnv))
+	assert.Equal(t, protoutil.MarshalOrPanic(configUpdate), configUpdateEnv.ConfigUpdate)
+	require.Len(t, configUpdateEnv.Signatures, 2)
+	for i, identity := range [][]byte{[]byte("submitter"), []byte("admin")} {
+		configSig := configUpdateEnv.Signatures[i]
+		sigHeader, err := protoutil.GetSignatureHeader(configSig.SignatureHeader)
+		require.NoError(t, err)
+		assert.Equal(t, identity, sigHeader.Creator)
+		assert.Equal(t, util.ConcatenateBytes(identity, configSig.SignatureHeader, configUpdateEnv.ConfigUpdate), configSig.Signature)
+	}
+
+	_, err = protoutil.CreateConfigUpdateEnvelope("otherchannel", configUpdate, submitter)
+	assert.EqualError(t, err, "config update is for channel mychannel, not otherchannel")
+	_, err = protoutil.CreateConfigUpdateEnvelope("mychannel", nil, submitter)
+	assert.EqualError(t, err, "nil config update")
+	_, err = protoutil.CreateConfigUpdateEnvelope("mychannel", configUpdate, &mockSigner{signErr: errors.New("boom")})
+	assert.EqualError(t, err, "error signing config update: boom")
+}
+
+func TestCreateConfigUpdateEnvelopeUnsigned(t *testing.T) {
+	env, err := protoutil.CreateConfigUpdateEnvelope("mychannel", &cb.ConfigUpdate{})
+	require.NoError(t, err)
+	assert.Nil(t, env.Signature)
+
+	payload, err := protoutil.UnmarshalPayload(env.Payload)
+	require.NoError(t, err)
+	chdr, err := protoutil.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	require.NoError(t, err)
+	assert.Empty(t, chdr.TxId)
+
+	configUpdateEnv := &cb.ConfigUpdateEnvelope{}
+	require.NoError(t, proto.Unmarshal(payload.Data, configUpdateEnv))
+	assert.Empty(t, configUpdateEnv.Signatures)
+
+	err = protoutil.SignConfigUpdateEnvelope(configUpdateEnv, &mockSigner{identity: []byte("admin")})
+	require.NoError(t, err)
+	assert.Len(t, configUpdateEnv.Signatures, 1)
+	assert.EqualError(t, protoutil.SignConfigUpdateEnvelope(nil, &mockSigner{}), "nil arguments")
+}
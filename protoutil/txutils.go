@@ -125,12 +125,6 @@ func CreateSignedTx(proposal *peer.Proposal, signer msp.SigningIdentity, resps .
 		return nil, err
 	}
 
-	// the original payload
-	pPayl, err := GetChaincodeProposalPayload(proposal.Payload)
-	if err != nil {
-		return nil, err
-	}
-
 	// check that the signer is the same that is referenced in the header
 	// TODO: maybe worth removing?
 	signerBytes, err := signer.Serialize()
@@ -147,6 +141,47 @@ func CreateSignedTx(proposal *peer.Proposal, signer msp.SigningIdentity, resps .
 		return nil, errors.New("signer must be the same as the one referenced in the header")
 	}
 
+	env, err := CreateUnsignedTx(proposal, resps...)
+	if err != nil {
+		return nil, err
+	}
+
+	// sign the payload
+	sig, err := signer.Sign(env.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	// here's the envelope
+	env.Signature = sig
+	return env, nil
+}
+
+// CreateUnsignedTx assembles an Envelope message from proposal and
+// endorsements, leaving its signature empty. This function should be called
+// by a client that signs the transaction separately, for example with a
+// key held on another machine, before submitting it to peers for ordering
+func CreateUnsignedTx(proposal *peer.Proposal, resps ...*peer.ProposalResponse) (*common.Envelope, error) {
+	if len(resps) == 0 {
+		return nil, errors.New("at least one proposal response is required")
+	}
+
+	// the original header
+	hdr, err := GetHeader(proposal.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	// the original payload
+	pPayl, err := GetChaincodeProposalPayload(proposal.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := GetSignatureHeader(hdr.SignatureHeader); err != nil {
+		return nil, err
+	}
+
 	// get header extensions so we have the visibility field
 	hdrExt, err := GetChaincodeHeaderExtension(hdr)
 	if err != nil {
@@ -210,14 +245,7 @@ func CreateSignedTx(proposal *peer.Proposal, signer msp.SigningIdentity, resps .
 		return nil, err
 	}
 
-	// sign the payload
-	sig, err := signer.Sign(paylBytes)
-	if err != nil {
-		return nil, err
-	}
-
-	// here's the envelope
-	return &common.Envelope{Payload: paylBytes, Signature: sig}, nil
+	return &common.Envelope{Payload: paylBytes}, nil
 }
 
 // CreateProposalResponse creates a proposal response.
@@ -223,6 +223,48 @@ func TestCreateSignedTx(t *testing.T) {
 
 }
 
+func TestCreateUnsignedTx(t *testing.T) {
+	signID, err := mockmsp.NewNoopMsp().GetDefaultSigningIdentity()
+	assert.NoError(t, err)
+	signerBytes, err := signID.Serialize()
+	assert.NoError(t, err)
+
+	ccHeaderExtensionBytes, _ := proto.Marshal(&pb.ChaincodeHeaderExtension{})
+	chdrBytes, _ := proto.Marshal(&cb.ChannelHeader{
+		Extension: ccHeaderExtensionBytes,
+	})
+	shdrBytes, _ := proto.Marshal(&cb.SignatureHeader{
+		Creator: signerBytes,
+	})
+	headerBytes, _ := proto.Marshal(&cb.Header{
+		ChannelHeader:   chdrBytes,
+		SignatureHeader: shdrBytes,
+	})
+	prop := &pb.Proposal{Header: headerBytes}
+	responses := []*pb.ProposalResponse{{
+		Payload:     []byte("payload"),
+		Endorsement: &pb.Endorsement{},
+		Response: &pb.Response{
+			Status: int32(200),
+		},
+	}}
+
+	env, err := protoutil.CreateUnsignedTx(prop, responses...)
+	assert.NoError(t, err)
+	assert.Nil(t, env.Signature)
+
+	// signing the unsigned transaction yields the signed transaction
+	signedEnv, err := protoutil.CreateSignedTx(prop, signID, responses...)
+	assert.NoError(t, err)
+	assert.Equal(t, signedEnv.Payload, env.Payload)
+
+	_, err = protoutil.CreateUnsignedTx(prop)
+	assert.EqualError(t, err, "at least one proposal response is required")
+	responses[0].Response.Status = 500
+	_, err = protoutil.CreateUnsignedTx(prop, responses...)
+	assert.EqualError(t, err, "proposal response was not successful, error code 500, msg ")
+}
+
 func TestCreateSignedTxStatus(t *testing.T) {
 	serializedExtension, err := proto.Marshal(&pb.ChaincodeHeaderExtension{})
 	assert.NoError(t, err)
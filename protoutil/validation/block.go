@@ -0,0 +1,224 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package validation
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/policies"
+	"github.com/hyperledger/fabric/common/util"
+	ledgerutil "github.com/hyperledger/fabric/core/ledger/util"
+	"github.com/hyperledger/fabric/msp"
+	cb "github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+)
+
+// VerifyBlockHash verifies that the data hash in the header of the block is
+// the hash of its data and, when the header of the previous block is given,
+// that the block follows it.
+func VerifyBlockHash(block *cb.Block, previous *cb.BlockHeader) error {
+	if block == nil || block.Header == nil {
+		return errors.New("missing block header")
+	}
+	if block.Data == nil {
+		return errors.Errorf("block [%d] has no data", block.Header.Number)
+	}
+
+	dataHash := protoutil.BlockDataHash(block.Data)
+	if !bytes.Equal(dataHash, block.Header.DataHash) {
+		return errors.Errorf("computed data hash of block [%d] (%s) doesn't match the hash in its header (%s)",
+			block.Header.Number, hex.EncodeToString(dataHash), hex.EncodeToString(block.Header.DataHash))
+	}
+
+	if previous == nil {
+		return nil
+	}
+	if previous.Number+1 != block.Header.Number {
+		return errors.Errorf("block [%d] does not follow block [%d]", block.Header.Number, previous.Number)
+	}
+	previousHash := protoutil.BlockHeaderHash(previous)
+	if !bytes.Equal(previousHash, block.Header.PreviousHash) {
+		return errors.Errorf("hash of block [%d] (%s) doesn't match the previous hash in block [%d] (%s)",
+			previous.Number, hex.EncodeToString(previousHash), block.Header.Number, hex.EncodeToString(block.Header.PreviousHash))
+	}
+
+	return nil
+}
+
+// VerifyBlockMetadata verifies that the signatures of the block and of its last
+// config metadata satisfy the block validation policy of the channel, and that
+// the last config metadata refers to the given block number.
+func VerifyBlockMetadata(block *cb.Block, policyManager policies.Manager, lastConfig uint64) error {
+	if block == nil || block.Header == nil {
+		return errors.New("missing block header")
+	}
+	policy, ok := policyManager.GetPolicy(policies.BlockValidation)
+	if !ok {
+		return errors.Errorf("policy %s wasn't found", policies.BlockValidation)
+	}
+
+	for _, index := range []cb.BlockMetadataIndex{cb.BlockMetadataIndex_SIGNATURES, cb.BlockMetadataIndex_LAST_CONFIG} {
+		metadata, signedData, err := signedMetadata(block, index)
+		if err != nil {
+			return err
+		}
+		if err := policy.Evaluate(signedData); err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("%s metadata of block [%d] does not satisfy the %s policy", index, block.Header.Number, policies.BlockValidation))
+		}
+
+		if index != cb.BlockMetadataIndex_LAST_CONFIG {
+			continue
+		}
+		lc := &cb.LastConfig{}
+		if err := proto.Unmarshal(metadata.Value, lc); err != nil {
+			return errors.Wrapf(err, "error unmarshaling LastConfig of block [%d]", block.Header.Number)
+		}
+		if lc.Index != lastConfig {
+			return errors.Errorf("last config of block [%d] is block [%d], expected block [%d]", block.Header.Number, lc.Index, lastConfig)
+		}
+	}
+
+	return nil
+}
+
+// signedMetadata returns the metadata of the block at the given index, along
+// with the data signed by each of its signatures.
+func signedMetadata(block *cb.Block, index cb.BlockMetadataIndex) (*cb.Metadata, []*protoutil.SignedData, error) {
+	if block.Metadata == nil || len(block.Metadata.Metadata) <= int(index) {
+		return nil, nil, errors.Errorf("block [%d] has no %s metadata", block.Header.Number, index)
+	}
+	metadata, err := protoutil.GetMetadataFromBlock(block, index)
+	if err != nil {
+		return nil, nil, errors.WithMessage(err, fmt.Sprintf("invalid %s metadata in block [%d]", index, block.Header.Number))
+	}
+
+	var signedData []*protoutil.SignedData
+	for _, signature := range metadata.Signatures {
+		shdr, err := protoutil.GetSignatureHeader(signature.SignatureHeader)
+		if err != nil {
+			return nil, nil, errors.WithMessage(err, fmt.Sprintf("invalid signature header in %s metadata of block [%d]", index, block.Header.Number))
+		}
+		signedData = append(signedData, &protoutil.SignedData{
+			Identity:  shdr.Creator,
+			Data:      util.ConcatenateBytes(metadata.Value, signature.SignatureHeader, protoutil.BlockHeaderBytes(block.Header)),
+			Signature: signature.Signature,
+		})
+	}
+
+	return metadata, signedData, nil
+}
+
+// VerifyTransactions verifies that the transactions of the block belong to
+// the channel and are signed by their creator, whose identity must be valid,
+// and, for endorser transactions, that the ID of the transaction is derived
+// from its nonce and creator and that the endorsements are signed by their
+// endorsers.  The transactions which were marked invalid by the peer which
+// committed the block are not verified.
+func VerifyTransactions(block *cb.Block, channelID string, deserializer msp.IdentityDeserializer) error {
+	if block == nil || block.Header == nil || block.Data == nil {
+		return errors.New("missing block header or data")
+	}
+
+	var txFilter ledgerutil.TxValidationFlags
+	if block.Metadata != nil && len(block.Metadata.Metadata) > int(cb.BlockMetadataIndex_TRANSACTIONS_FILTER) {
+		txFilter = ledgerutil.TxValidationFlags(block.Metadata.Metadata[cb.BlockMetadataIndex_TRANSACTIONS_FILTER])
+	}
+
+	for i, envBytes := range block.Data.Data {
+		if len(txFilter) == len(block.Data.Data) && txFilter.IsInvalid(i) {
+			continue
+		}
+		if err := verifyTransaction(envBytes, channelID, deserializer); err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("transaction %d of block [%d] is not valid", i, block.Header.Number))
+		}
+	}
+
+	return nil
+}
+
+func verifyTransaction(envBytes []byte, channelID string, deserializer msp.IdentityDeserializer) error {
+	env, err := protoutil.GetEnvelopeFromBlock(envBytes)
+	if err != nil {
+		return err
+	}
+	payload, err := protoutil.UnmarshalPayload(env.Payload)
+	if err != nil {
+		return err
+	}
+	if payload.Header == nil {
+		return errors.New("missing payload header")
+	}
+	chdr, err := protoutil.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	if err != nil {
+		return err
+	}
+	shdr, err := protoutil.GetSignatureHeader(payload.Header.SignatureHeader)
+	if err != nil {
+		return err
+	}
+
+	if chdr.ChannelId != channelID {
+		return errors.Errorf("transaction is for channel %s, not %s", chdr.ChannelId, channelID)
+	}
+	if err := verifySignature(deserializer, shdr.Creator, env.Payload, env.Signature); err != nil {
+		return errors.WithMessage(err, "invalid creator signature")
+	}
+
+	if cb.HeaderType(chdr.Type) != cb.HeaderType_ENDORSER_TRANSACTION {
+		return nil
+	}
+	if err := protoutil.CheckTxID(chdr.TxId, shdr.Nonce, shdr.Creator); err != nil {
+		return err
+	}
+	tx, err := protoutil.GetTransaction(payload.Data)
+	if err != nil {
+		return err
+	}
+	for _, action := range tx.Actions {
+		ccActionPayload, err := protoutil.GetChaincodeActionPayload(action.Payload)
+		if err != nil {
+			return err
+		}
+		if ccActionPayload.Action == nil {
+			return errors.New("missing chaincode endorsed action")
+		}
+		for _, endorsement := range ccActionPayload.Action.Endorsements {
+			if err := verifyEndorsement(deserializer, ccActionPayload.Action.ProposalResponsePayload, endorsement); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func verifyEndorsement(deserializer msp.IdentityDeserializer, prpBytes []byte, endorsement *pb.Endorsement) error {
+	err := verifySignature(deserializer, endorsement.Endorser, util.ConcatenateBytes(prpBytes, endorsement.Endorser), endorsement.Signature)
+	if err != nil {
+		return errors.WithMessage(err, "invalid endorsement")
+	}
+	return nil
+}
+
+func verifySignature(deserializer msp.IdentityDeserializer, serializedIdentity, msg, signature []byte) error {
+	identity, err := deserializer.DeserializeIdentity(serializedIdentity)
+	if err != nil {
+		return errors.WithMessage(err, "failed deserializing identity")
+	}
+	if err := identity.Validate(); err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("identity of MSP %s is not valid", identity.GetIdentifier().Mspid))
+	}
+	if err := identity.Verify(msg, signature); err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("signature of identity of MSP %s is not valid", identity.GetIdentifier().Mspid))
+	}
+	return nil
+}
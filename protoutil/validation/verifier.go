@@ -0,0 +1,159 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/common/configtx"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/pkg/errors"
+)
+
+// Verifier verifies the blocks of a channel, in order, with the config of the
+// channel in effect at each of them.  It can be used by clients which do not
+// maintain a ledger, such as light clients and audit tools, to check the
+// blocks they receive from untrusted peers or orderers.
+type Verifier struct {
+	channelID  string
+	resources  channelconfig.Resources
+	lastConfig uint64
+	previous   *cb.BlockHeader
+}
+
+// NewVerifier returns a verifier of the blocks following the given config
+// block, which is trusted, typically the genesis block of the channel.
+func NewVerifier(configBlock *cb.Block) (*Verifier, error) {
+	configEnv, err := configFromBlock(configBlock)
+	if err != nil {
+		return nil, err
+	}
+	if configEnv == nil {
+		return nil, errors.Errorf("block [%d] is not a config block", configBlock.Header.Number)
+	}
+	channelID, err := protoutil.GetChainIDFromBlock(configBlock)
+	if err != nil {
+		return nil, err
+	}
+	bundle, err := channelconfig.NewBundle(channelID, configEnv.Config)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed creating bundle from config block")
+	}
+
+	return NewVerifierFromResources(bundle, configBlock.Header.Number, configBlock.Header), nil
+}
+
+// NewVerifierFromResources returns a verifier of the blocks following the
+// block with the given header, with the config resources of the channel,
+// whose last config block is lastConfig.
+func NewVerifierFromResources(resources channelconfig.Resources, lastConfig uint64, previous *cb.BlockHeader) *Verifier {
+	return &Verifier{
+		channelID:  resources.ConfigtxValidator().ChainID(),
+		resources:  resources,
+		lastConfig: lastConfig,
+		previous:   previous,
+	}
+}
+
+// VerifyBlock verifies that the block follows the last verified block, that
+// it is signed according to the block validation policy, that its last config
+// metadata is correct and that its transactions are properly signed.  The
+// config of a config block is used to verify the following blocks.
+func (v *Verifier) VerifyBlock(block *cb.Block) error {
+	if err := VerifyBlockHash(block, v.previous); err != nil {
+		return err
+	}
+
+	configEnv, err := configFromBlock(block)
+	if err != nil {
+		return err
+	}
+	lastConfig := v.lastConfig
+	if configEnv != nil {
+		lastConfig = block.Header.Number
+	}
+
+	if err := VerifyBlockMetadata(block, v.resources.PolicyManager(), lastConfig); err != nil {
+		return err
+	}
+	if err := VerifyTransactions(block, v.channelID, v.resources.MSPManager()); err != nil {
+		return err
+	}
+
+	if configEnv != nil {
+		sequence := v.resources.ConfigtxValidator().Sequence()
+		if configEnv.Config.Sequence <= sequence {
+			return errors.Errorf("config sequence %d of block [%d] is not greater than the current sequence %d", configEnv.Config.Sequence, block.Header.Number, sequence)
+		}
+		bundle, err := channelconfig.NewBundle(v.channelID, configEnv.Config)
+		if err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("failed creating bundle from config block [%d]", block.Header.Number))
+		}
+		v.resources = bundle
+		v.lastConfig = block.Header.Number
+	}
+	v.previous = block.Header
+
+	return nil
+}
+
+// VerifyBlocks verifies the consecutive blocks in order, stopping at the first
+// block which is not valid.
+func (v *Verifier) VerifyBlocks(blocks ...*cb.Block) error {
+	for _, block := range blocks {
+		if err := v.VerifyBlock(block); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Resources returns the config resources of the channel in effect after the
+// last verified block.
+func (v *Verifier) Resources() channelconfig.Resources {
+	return v.resources
+}
+
+// configFromBlock returns the config envelope of the block, or nil if it is
+// not a config block.
+func configFromBlock(block *cb.Block) (*cb.ConfigEnvelope, error) {
+	if block == nil || block.Header == nil || block.Data == nil || len(block.Data.Data) == 0 {
+		return nil, errors.New("block contains no data")
+	}
+	env, err := protoutil.ExtractEnvelope(block, 0)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := protoutil.UnmarshalPayload(env.Payload)
+	if err != nil {
+		return nil, err
+	}
+	if payload.Header == nil {
+		return nil, errors.Errorf("transaction 0 of block [%d] has no header", block.Header.Number)
+	}
+	chdr, err := protoutil.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	if err != nil {
+		return nil, err
+	}
+	if cb.HeaderType(chdr.Type) != cb.HeaderType_CONFIG {
+		return nil, nil
+	}
+	if len(block.Data.Data) != 1 {
+		return nil, errors.Errorf("config block [%d] contains %d transactions", block.Header.Number, len(block.Data.Data))
+	}
+
+	configEnv, err := configtx.UnmarshalConfigEnvelope(payload.Data)
+	if err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("invalid config envelope in block [%d]", block.Header.Number))
+	}
+	if configEnv.Config == nil {
+		return nil, errors.Errorf("config envelope of block [%d] has no config", block.Header.Number)
+	}
+	return configEnv, nil
+}
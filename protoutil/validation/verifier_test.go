@@ -0,0 +1,264 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/crypto"
+	"github.com/hyperledger/fabric/common/tools/configtxgen/configtxgentest"
+	"github.com/hyperledger/fabric/common/tools/configtxgen/encoder"
+	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
+	"github.com/hyperledger/fabric/common/util"
+	ledgerutil "github.com/hyperledger/fabric/core/ledger/util"
+	"github.com/hyperledger/fabric/msp"
+	mspmgmt "github.com/hyperledger/fabric/msp/mgmt"
+	msptesttools "github.com/hyperledger/fabric/msp/mgmt/testtools"
+	cb "github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/hyperledger/fabric/protoutil/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const channelID = "mychannel"
+
+type chain struct {
+	t      *testing.T
+	signer msp.SigningIdentity
+	blocks []*cb.Block
+	config *cb.Config
+}
+
+func newChain(t *testing.T) *chain {
+	require.NoError(t, msptesttools.LoadMSPSetupForTesting())
+	genesis := encoder.New(configtxgentest.Load(genesisconfig.SampleDevModeSoloProfile)).GenesisBlockForChannel(channelID)
+
+	env, err := protoutil.ExtractEnvelope(genesis, 0)
+	require.NoError(t, err)
+	payload, err := protoutil.UnmarshalPayload(env.Payload)
+	require.NoError(t, err)
+	configEnv := &cb.ConfigEnvelope{}
+	require.NoError(t, proto.Unmarshal(payload.Data, configEnv))
+
+	return &chain{
+		t:      t,
+		signer: mspmgmt.GetLocalSigningIdentityOrPanic(),
+		blocks: []*cb.Block{genesis},
+		config: configEnv.Config,
+	}
+}
+
+// endorserTx returns a transaction created, endorsed and signed by the
+// signer of the chain
+func (c *chain) endorserTx() *cb.Envelope {
+	_, prop, _, err := protoutil.CreateSignedChaincodeProposal(&protoutil.ChaincodeProposal{
+		ChannelID:     channelID,
+		ChaincodeName: "mycc",
+		Args:          [][]byte{[]byte("invoke")},
+	}, c.signer)
+	require.NoError(c.t, err)
+	resp, err := protoutil.CreateProposalResponse(prop.Header, prop.Payload, &pb.Response{Status: 200}, []byte("results"), nil, &pb.ChaincodeID{Name: "mycc"}, nil, c.signer)
+	require.NoError(c.t, err)
+	env, err := protoutil.CreateSignedEndorserTransaction(prop, c.signer, resp)
+	require.NoError(c.t, err)
+	return env
+}
+
+// configTx returns a config transaction with the next sequence of the config
+func (c *chain) configTx() *cb.Envelope {
+	c.config = proto.Clone(c.config).(*cb.Config)
+	c.config.Sequence++
+
+	creator, err := c.signer.Serialize()
+	require.NoError(c.t, err)
+	nonce, err := crypto.GetRandomNonce()
+	require.NoError(c.t, err)
+	payloadBytes := protoutil.MarshalOrPanic(&cb.Payload{
+		Header: protoutil.MakePayloadHeader(
+			protoutil.MakeChannelHeader(cb.HeaderType_CONFIG, 0, channelID, 0),
+			protoutil.MakeSignatureHeader(creator, nonce),
+		),
+		Data: protoutil.MarshalOrPanic(&cb.ConfigEnvelope{Config: c.config}),
+	})
+	signature, err := c.signer.Sign(payloadBytes)
+	require.NoError(c.t, err)
+	return &cb.Envelope{Payload: payloadBytes, Signature: signature}
+}
+
+// addBlock appends a block with the given transactions, signed by the signer
+// of the chain as the orderer does
+func (c *chain) addBlock(lastConfig uint64, envs ...*cb.Envelope) *cb.Block {
+	previous := c.blocks[len(c.blocks)-1]
+	block := protoutil.NewBlock(previous.Header.Number+1, protoutil.BlockHeaderHash(previous.Header))
+	for _, env := range envs {
+		block.Data.Data = append(block.Data.Data, protoutil.MarshalOrPanic(env))
+	}
+	block.Header.DataHash = protoutil.BlockDataHash(block.Data)
+
+	c.signMetadata(block, cb.BlockMetadataIndex_SIGNATURES, nil)
+	c.signMetadata(block, cb.BlockMetadataIndex_LAST_CONFIG, protoutil.MarshalOrPanic(&cb.LastConfig{Index: lastConfig}))
+
+	c.blocks = append(c.blocks, block)
+	return block
+}
+
+func (c *chain) signMetadata(block *cb.Block, index cb.BlockMetadataIndex, value []byte) {
+	creator, err := c.signer.Serialize()
+	require.NoError(c.t, err)
+	nonce, err := crypto.GetRandomNonce()
+	require.NoError(c.t, err)
+	signature := &cb.MetadataSignature{
+		SignatureHeader: protoutil.MarshalOrPanic(protoutil.MakeSignatureHeader(creator, nonce)),
+	}
+	signature.Signature, err = c.signer.Sign(util.ConcatenateBytes(value, signature.SignatureHeader, protoutil.BlockHeaderBytes(block.Header)))
+	require.NoError(c.t, err)
+	block.Metadata.Metadata[index] = protoutil.MarshalOrPanic(&cb.Metadata{
+		Value:      value,
+		Signatures: []*cb.MetadataSignature{signature},
+	})
+}
+
+func TestVerifyBlocks(t *testing.T) {
+	c := newChain(t)
+	c.addBlock(0, c.endorserTx(), c.endorserTx())
+	c.addBlock(2, c.configTx())
+	c.addBlock(2, c.endorserTx())
+
+	v, err := validation.NewVerifier(c.blocks[0])
+	require.NoError(t, err)
+	assert.NoError(t, v.VerifyBlocks(c.blocks[1:]...))
+	assert.Equal(t, uint64(1), v.Resources().ConfigtxValidator().Sequence())
+
+	// the blocks must be verified in order
+	v, err = validation.NewVerifier(c.blocks[0])
+	require.NoError(t, err)
+	assert.EqualError(t, v.VerifyBlock(c.blocks[2]), "block [2] does not follow block [0]")
+}
+
+func TestVerifyBlockTampered(t *testing.T) {
+	c := newChain(t)
+	block := c.addBlock(0, c.endorserTx())
+
+	for _, tc := range []struct {
+		name   string
+		tamper func(block *cb.Block)
+		err    string
+	}{
+		{
+			name: "data",
+			tamper: func(block *cb.Block) {
+				block.Data.Data = append(block.Data.Data, protoutil.MarshalOrPanic(c.endorserTx()))
+			},
+			err: "computed data hash of block [1]",
+		},
+		{
+			name: "previous hash",
+			tamper: func(block *cb.Block) {
+				block.Header.PreviousHash = []byte("garbage")
+			},
+			err: "doesn't match the previous hash in block [1]",
+		},
+		{
+			name: "block signature",
+			tamper: func(block *cb.Block) {
+				block.Metadata.Metadata[cb.BlockMetadataIndex_SIGNATURES] = protoutil.MarshalOrPanic(&cb.Metadata{})
+			},
+			err: "SIGNATURES metadata of block [1] does not satisfy the /Channel/Orderer/BlockValidation policy",
+		},
+		{
+			name: "last config",
+			tamper: func(block *cb.Block) {
+				c.signMetadata(block, cb.BlockMetadataIndex_LAST_CONFIG, protoutil.MarshalOrPanic(&cb.LastConfig{Index: 5}))
+			},
+			err: "last config of block [1] is block [5], expected block [0]",
+		},
+		{
+			name: "endorsement",
+			tamper: func(block *cb.Block) {
+				env := c.endorserTx()
+				payload, err := protoutil.UnmarshalPayload(env.Payload)
+				require.NoError(t, err)
+				tx, err := protoutil.GetTransaction(payload.Data)
+				require.NoError(t, err)
+				ccActionPayload, err := protoutil.GetChaincodeActionPayload(tx.Actions[0].Payload)
+				require.NoError(t, err)
+				ccActionPayload.Action.Endorsements[0].Signature = []byte("garbage")
+				tx.Actions[0].Payload = protoutil.MarshalOrPanic(ccActionPayload)
+				payload.Data = protoutil.MarshalOrPanic(tx)
+				env.Payload = protoutil.MarshalOrPanic(payload)
+				env.Signature, err = c.signer.Sign(env.Payload)
+				require.NoError(t, err)
+
+				block.Data.Data[0] = protoutil.MarshalOrPanic(env)
+				block.Header.DataHash = protoutil.BlockDataHash(block.Data)
+				c.signMetadata(block, cb.BlockMetadataIndex_SIGNATURES, nil)
+				c.signMetadata(block, cb.BlockMetadataIndex_LAST_CONFIG, protoutil.MarshalOrPanic(&cb.LastConfig{Index: 0}))
+			},
+			err: "transaction 0 of block [1] is not valid: invalid endorsement",
+		},
+		{
+			name: "creator signature",
+			tamper: func(block *cb.Block) {
+				env := c.endorserTx()
+				env.Signature = []byte("garbage")
+				block.Data.Data[0] = protoutil.MarshalOrPanic(env)
+				block.Header.DataHash = protoutil.BlockDataHash(block.Data)
+				c.signMetadata(block, cb.BlockMetadataIndex_SIGNATURES, nil)
+				c.signMetadata(block, cb.BlockMetadataIndex_LAST_CONFIG, protoutil.MarshalOrPanic(&cb.LastConfig{Index: 0}))
+			},
+			err: "transaction 0 of block [1] is not valid: invalid creator signature",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tampered := proto.Clone(block).(*cb.Block)
+			tc.tamper(tampered)
+
+			v, err := validation.NewVerifier(c.blocks[0])
+			require.NoError(t, err)
+			err = v.VerifyBlock(tampered)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
+func TestVerifyTransactionsSkipsInvalid(t *testing.T) {
+	c := newChain(t)
+	env := c.endorserTx()
+	env.Signature = []byte("garbage")
+	block := c.addBlock(0, c.endorserTx(), env)
+
+	v, err := validation.NewVerifier(c.blocks[0])
+	require.NoError(t, err)
+	mspManager := v.Resources().MSPManager()
+
+	err = validation.VerifyTransactions(block, channelID, mspManager)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "transaction 1 of block [1] is not valid")
+
+	txFilter := ledgerutil.NewTxValidationFlagsSetValue(2, pb.TxValidationCode_VALID)
+	txFilter.SetFlag(1, pb.TxValidationCode_BAD_PAYLOAD)
+	block.Metadata.Metadata[cb.BlockMetadataIndex_TRANSACTIONS_FILTER] = txFilter
+	assert.NoError(t, validation.VerifyTransactions(block, channelID, mspManager))
+
+	err = validation.VerifyTransactions(block, "otherchannel", mspManager)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "transaction is for channel mychannel, not otherchannel")
+}
+
+func TestNewVerifierNotConfigBlock(t *testing.T) {
+	c := newChain(t)
+	block := c.addBlock(0, c.endorserTx())
+
+	_, err := validation.NewVerifier(block)
+	assert.EqualError(t, err, "block [1] is not a config block")
+	_, err = validation.NewVerifier(&cb.Block{})
+	assert.EqualError(t, err, "block contains no data")
+}
@@ -21,7 +21,7 @@ cat docs/wrappers/license_postscript.md >> $DOC
 DOC=docs/source/commands/peerchaincode.md
 cat docs/wrappers/peer_chaincode_preamble.md > $DOC
 
-for x in "peer chaincode install" "peer chaincode instantiate" "peer chaincode invoke" "peer chaincode list" "peer chaincode package" "peer chaincode query" "peer chaincode signpackage" "peer chaincode upgrade"; do
+for x in "peer chaincode createproposal" "peer chaincode devrun" "peer chaincode install" "peer chaincode instantiate" "peer chaincode invoke" "peer chaincode list" "peer chaincode package" "peer chaincode query" "peer chaincode sendproposal" "peer chaincode sendtx" "peer chaincode signpackage" "peer chaincode signproposal" "peer chaincode signtx" "peer chaincode upgrade"; do
   echo "" >> $DOC
   echo "##" $x >> $DOC
   echo "\`\`\`" >> $DOC
@@ -34,7 +34,7 @@ cat docs/wrappers/peer_chaincode_postscript.md >> $DOC
 DOC=docs/source/commands/peerchannel.md
 cat docs/wrappers/peer_channel_preamble.md > $DOC
 
-for x in "peer channel" "peer channel create" "peer channel fetch" "peer channel getinfo" "peer channel join" "peer channel list" "peer channel signconfigtx" "peer channel update"; do
+for x in "peer channel" "peer channel create" "peer channel fetch" "peer channel fetch-by-txid" "peer channel getinfo" "peer channel join" "peer channel joinbysnapshot" "peer channel list" "peer channel signconfigtx" "peer channel update"; do
   echo "" >> $DOC
   echo "##" $x >> $DOC
   echo "\`\`\`" >> $DOC
@@ -60,7 +60,7 @@ cat docs/wrappers/peer_logging_postscript.md >> $DOC
 DOC=docs/source/commands/peernode.md
 cat docs/wrappers/peer_node_preamble.md > $DOC
 
-for x in "peer node start" "peer node status"; do
+for x in "peer node start" "peer node status" "peer node reset" "peer node rollback" "peer node snapshot" "peer node logspec"; do
   echo "" >> $DOC
   echo "##" $x >> $DOC
   echo "\`\`\`" >> $DOC
@@ -70,6 +70,19 @@ for x in "peer node start" "peer node status"; do
 done
 cat docs/wrappers/peer_node_postscript.md >> $DOC
 
+DOC=docs/source/commands/peerprofile.md
+cat docs/wrappers/peer_profile_preamble.md > $DOC
+
+for x in "peer profile list" "peer profile use"; do
+  echo "" >> $DOC
+  echo "##" $x >> $DOC
+  echo "\`\`\`" >> $DOC
+  .build/bin/${x} --help 1>> $DOC 2>/dev/null
+  echo "\`\`\`" >> $DOC
+  echo "" >> $DOC
+done
+cat docs/wrappers/peer_profile_postscript.md >> $DOC
+
 DOC=${PWD}/docs/source/commands/configtxgen.md
 cat docs/wrappers/configtxgen_preamble.md > $DOC
 
@@ -90,7 +103,7 @@ cat docs/wrappers/cryptogen_preamble.md > $DOC
 
 echo "" >> $DOC
 
-for x in "cryptogen help" "cryptogen generate" "cryptogen showtemplate" "cryptogen extend" "cryptogen version"; do
+for x in "cryptogen help" "cryptogen generate" "cryptogen showtemplate" "cryptogen extend" "cryptogen renew" "cryptogen version"; do
   echo "" >> $DOC
   echo "##" $x >> $DOC
   echo "\`\`\`" >> $DOC
@@ -30,6 +30,28 @@ type DeliverServer struct {
 	deliverFilteredReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeliverFilteredWithPayloadsStub        func(peer.Deliver_DeliverFilteredWithPayloadsServer) error
+	deliverFilteredWithPayloadsMutex       sync.RWMutex
+	deliverFilteredWithPayloadsArgsForCall []struct {
+		arg1 peer.Deliver_DeliverFilteredWithPayloadsServer
+	}
+	deliverFilteredWithPayloadsReturns struct {
+		result1 error
+	}
+	deliverFilteredWithPayloadsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeliverWithPrivateDataStub        func(peer.Deliver_DeliverWithPrivateDataServer) error
+	deliverWithPrivateDataMutex       sync.RWMutex
+	deliverWithPrivateDataArgsForCall []struct {
+		arg1 peer.Deliver_DeliverWithPrivateDataServer
+	}
+	deliverWithPrivateDataReturns struct {
+		result1 error
+	}
+	deliverWithPrivateDataReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -154,6 +176,126 @@ func (fake *DeliverServer) DeliverFilteredReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *DeliverServer) DeliverFilteredWithPayloads(arg1 peer.Deliver_DeliverFilteredWithPayloadsServer) error {
+	fake.deliverFilteredWithPayloadsMutex.Lock()
+	ret, specificReturn := fake.deliverFilteredWithPayloadsReturnsOnCall[len(fake.deliverFilteredWithPayloadsArgsForCall)]
+	fake.deliverFilteredWithPayloadsArgsForCall = append(fake.deliverFilteredWithPayloadsArgsForCall, struct {
+		arg1 peer.Deliver_DeliverFilteredWithPayloadsServer
+	}{arg1})
+	fake.recordInvocation("DeliverFilteredWithPayloads", []interface{}{arg1})
+	fake.deliverFilteredWithPayloadsMutex.Unlock()
+	if fake.DeliverFilteredWithPayloadsStub != nil {
+		return fake.DeliverFilteredWithPayloadsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.deliverFilteredWithPayloadsReturns
+	return fakeReturns.result1
+}
+
+func (fake *DeliverServer) DeliverFilteredWithPayloadsCallCount() int {
+	fake.deliverFilteredWithPayloadsMutex.RLock()
+	defer fake.deliverFilteredWithPayloadsMutex.RUnlock()
+	return len(fake.deliverFilteredWithPayloadsArgsForCall)
+}
+
+func (fake *DeliverServer) DeliverFilteredWithPayloadsCalls(stub func(peer.Deliver_DeliverFilteredWithPayloadsServer) error) {
+	fake.deliverFilteredWithPayloadsMutex.Lock()
+	defer fake.deliverFilteredWithPayloadsMutex.Unlock()
+	fake.DeliverFilteredWithPayloadsStub = stub
+}
+
+func (fake *DeliverServer) DeliverFilteredWithPayloadsArgsForCall(i int) peer.Deliver_DeliverFilteredWithPayloadsServer {
+	fake.deliverFilteredWithPayloadsMutex.RLock()
+	defer fake.deliverFilteredWithPayloadsMutex.RUnlock()
+	argsForCall := fake.deliverFilteredWithPayloadsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *DeliverServer) DeliverFilteredWithPayloadsReturns(result1 error) {
+	fake.deliverFilteredWithPayloadsMutex.Lock()
+	defer fake.deliverFilteredWithPayloadsMutex.Unlock()
+	fake.DeliverFilteredWithPayloadsStub = nil
+	fake.deliverFilteredWithPayloadsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *DeliverServer) DeliverFilteredWithPayloadsReturnsOnCall(i int, result1 error) {
+	fake.deliverFilteredWithPayloadsMutex.Lock()
+	defer fake.deliverFilteredWithPayloadsMutex.Unlock()
+	fake.DeliverFilteredWithPayloadsStub = nil
+	if fake.deliverFilteredWithPayloadsReturnsOnCall == nil {
+		fake.deliverFilteredWithPayloadsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deliverFilteredWithPayloadsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *DeliverServer) DeliverWithPrivateData(arg1 peer.Deliver_DeliverWithPrivateDataServer) error {
+	fake.deliverWithPrivateDataMutex.Lock()
+	ret, specificReturn := fake.deliverWithPrivateDataReturnsOnCall[len(fake.deliverWithPrivateDataArgsForCall)]
+	fake.deliverWithPrivateDataArgsForCall = append(fake.deliverWithPrivateDataArgsForCall, struct {
+		arg1 peer.Deliver_DeliverWithPrivateDataServer
+	}{arg1})
+	fake.recordInvocation("DeliverWithPrivateData", []interface{}{arg1})
+	fake.deliverWithPrivateDataMutex.Unlock()
+	if fake.DeliverWithPrivateDataStub != nil {
+		return fake.DeliverWithPrivateDataStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.deliverWithPrivateDataReturns
+	return fakeReturns.result1
+}
+
+func (fake *DeliverServer) DeliverWithPrivateDataCallCount() int {
+	fake.deliverWithPrivateDataMutex.RLock()
+	defer fake.deliverWithPrivateDataMutex.RUnlock()
+	return len(fake.deliverWithPrivateDataArgsForCall)
+}
+
+func (fake *DeliverServer) DeliverWithPrivateDataCalls(stub func(peer.Deliver_DeliverWithPrivateDataServer) error) {
+	fake.deliverWithPrivateDataMutex.Lock()
+	defer fake.deliverWithPrivateDataMutex.Unlock()
+	fake.DeliverWithPrivateDataStub = stub
+}
+
+func (fake *DeliverServer) DeliverWithPrivateDataArgsForCall(i int) peer.Deliver_DeliverWithPrivateDataServer {
+	fake.deliverWithPrivateDataMutex.RLock()
+	defer fake.deliverWithPrivateDataMutex.RUnlock()
+	argsForCall := fake.deliverWithPrivateDataArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *DeliverServer) DeliverWithPrivateDataReturns(result1 error) {
+	fake.deliverWithPrivateDataMutex.Lock()
+	defer fake.deliverWithPrivateDataMutex.Unlock()
+	fake.DeliverWithPrivateDataStub = nil
+	fake.deliverWithPrivateDataReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *DeliverServer) DeliverWithPrivateDataReturnsOnCall(i int, result1 error) {
+	fake.deliverWithPrivateDataMutex.Lock()
+	defer fake.deliverWithPrivateDataMutex.Unlock()
+	fake.DeliverWithPrivateDataStub = nil
+	if fake.deliverWithPrivateDataReturnsOnCall == nil {
+		fake.deliverWithPrivateDataReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deliverWithPrivateDataReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *DeliverServer) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -161,6 +303,10 @@ func (fake *DeliverServer) Invocations() map[string][][]interface{} {
 	defer fake.deliverMutex.RUnlock()
 	fake.deliverFilteredMutex.RLock()
 	defer fake.deliverFilteredMutex.RUnlock()
+	fake.deliverFilteredWithPayloadsMutex.RLock()
+	defer fake.deliverFilteredWithPayloadsMutex.RUnlock()
+	fake.deliverWithPrivateDataMutex.RLock()
+	defer fake.deliverWithPrivateDataMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
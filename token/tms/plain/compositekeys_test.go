@@ -4,12 +4,13 @@ Copyright IBM Corp. All Rights Reserved.
 SPDX-License-Identifier: Apache-2.0
 */
 
-package plain
+package plain_test
 
 import (
 	"strconv"
 
-	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/hyperledger/fabric/core/chaincode"
+	"github.com/hyperledger/fabric/token/tms/plain"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -19,65 +20,63 @@ var _ = Describe("Composite keys", func() {
 		namespace string
 		txID      string
 		index     int
-		chaincode *shim.ChaincodeStub
 	)
 
 	BeforeEach(func() {
 		namespace = "_fabtoken"
 		txID = "tx0"
 		index = 0
-		chaincode = &shim.ChaincodeStub{}
 	})
 
 	Describe("Copied composite keys generator function", func() {
 		Context("when a composite key for an output is generated", func() {
-			It("the output is the same as from the function in the chaincode shim", func() {
-				verifierKey, err := createCompositeKey(namespace, []string{txID, strconv.Itoa(index)})
+			It("the output is the same as from the function of the peer", func() {
+				verifierKey, err := plain.CreateCompositeKey(namespace, []string{txID, strconv.Itoa(index)})
 				Expect(err).ToNot(HaveOccurred())
-				shimKey, err := chaincode.CreateCompositeKey(namespace, []string{txID, strconv.Itoa(index)})
+				peerKey, err := chaincode.CreateCompositeKey(namespace, []string{txID, strconv.Itoa(index)})
 				Expect(err).ToNot(HaveOccurred())
-				Expect(verifierKey).To(Equal(shimKey))
+				Expect(verifierKey).To(Equal(peerKey))
 			})
 		})
 
 		Context("when a composite key for a transaction is generated", func() {
-			It("the output is the same as from the function in the chaincode shim", func() {
-				verifierKey, err := createCompositeKey(namespace, []string{txID})
+			It("the output is the same as from the function of the peer", func() {
+				verifierKey, err := plain.CreateCompositeKey(namespace, []string{txID})
 				Expect(err).ToNot(HaveOccurred())
-				shimKey, err := chaincode.CreateCompositeKey(namespace, []string{txID})
+				peerKey, err := chaincode.CreateCompositeKey(namespace, []string{txID})
 				Expect(err).ToNot(HaveOccurred())
-				Expect(verifierKey).To(Equal(shimKey))
+				Expect(verifierKey).To(Equal(peerKey))
 			})
 		})
 
 		Context("when a minRune namespace is passed", func() {
-			It("the error string is the same as from the function in the chaincode shim", func() {
-				_, err := createCompositeKey(string(0), []string{txID})
+			It("the error string is the same as from the function of the peer", func() {
+				_, err := plain.CreateCompositeKey(string(0), []string{txID})
 				Expect(err).To(HaveOccurred())
-				_, shimErr := chaincode.CreateCompositeKey(string(0), []string{txID})
-				Expect(shimErr).To(HaveOccurred())
-				Expect(err.Error()).To(Equal(shimErr.Error()))
+				_, peerErr := chaincode.CreateCompositeKey(string(0), []string{txID})
+				Expect(peerErr).To(HaveOccurred())
+				Expect(err.Error()).To(Equal(peerErr.Error()))
 			})
 		})
 
 		Context("when a minRune txID is passed", func() {
-			It("the error string is the same as from the function in the chaincode shim", func() {
-				_, err := createCompositeKey(namespace, []string{string(0)})
+			It("the error string is the same as from the function of the peer", func() {
+				_, err := plain.CreateCompositeKey(namespace, []string{string(0)})
 				Expect(err).To(HaveOccurred())
-				_, shimErr := chaincode.CreateCompositeKey(namespace, []string{string(0)})
-				Expect(shimErr).To(HaveOccurred())
-				Expect(err.Error()).To(Equal(shimErr.Error()))
+				_, peerErr := chaincode.CreateCompositeKey(namespace, []string{string(0)})
+				Expect(peerErr).To(HaveOccurred())
+				Expect(err.Error()).To(Equal(peerErr.Error()))
 			})
 		})
 
 		Context("when a txID with the MSB set is passed", func() {
-			It("the error string is the same as from the function in the chaincode shim", func() {
-				_, err := createCompositeKey(namespace, []string{string([]byte{0x80})})
+			It("the error string is the same as from the function of the peer", func() {
+				_, err := plain.CreateCompositeKey(namespace, []string{string([]byte{0x80})})
 				Expect(err).To(HaveOccurred())
 				Expect(err).To(MatchError("not a valid utf8 string: [80]"))
-				_, shimErr := chaincode.CreateCompositeKey(namespace, []string{string([]byte{0x80})})
-				Expect(shimErr).To(HaveOccurred())
-				Expect(err.Error()).To(Equal(shimErr.Error()))
+				_, peerErr := chaincode.CreateCompositeKey(namespace, []string{string([]byte{0x80})})
+				Expect(peerErr).To(HaveOccurred())
+				Expect(err.Error()).To(Equal(peerErr.Error()))
 			})
 		})
 	})